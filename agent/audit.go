@@ -0,0 +1,418 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zero-day-ai/sdk/finding"
+	"github.com/zero-day-ai/sdk/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// AuditMetadataKey is the Result.Metadata key under which the audit log of an
+// execution is attached.
+const AuditMetadataKey = "agent.audit_log"
+
+// AuditAction identifies the kind of harness operation an audit entry records.
+type AuditAction string
+
+const (
+	// AuditToolCall records a tool invocation, including queued tool work.
+	AuditToolCall AuditAction = "tool_call"
+
+	// AuditCredentialAccess records a credential lookup. Only the credential
+	// name is recorded, never the secret.
+	AuditCredentialAccess AuditAction = "credential_access"
+
+	// AuditFindingSubmitted records a submitted finding.
+	AuditFindingSubmitted AuditAction = "finding_submitted"
+
+	// AuditDelegation records a task delegated to another agent.
+	AuditDelegation AuditAction = "delegation"
+)
+
+// AuditEntry is a single audited harness operation. Entries deliberately
+// carry identifiers only: no secrets, tool payloads, finding evidence, or
+// error messages that could echo them.
+type AuditEntry struct {
+	// Timestamp is when the operation was invoked (UTC)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Action is the kind of operation
+	Action AuditAction `json:"action"`
+
+	// Tool is the tool name for AuditToolCall entries
+	Tool string `json:"tool,omitempty"`
+
+	// Targets are the targets the tool was pointed at, as returned by the
+	// target extractor. URLs have credentials and query strings removed.
+	Targets []string `json:"targets,omitempty"`
+
+	// Credential is the credential name for AuditCredentialAccess entries
+	Credential string `json:"credential,omitempty"`
+
+	// FindingID, Severity, and Category describe AuditFindingSubmitted entries
+	FindingID string `json:"finding_id,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+	Category  string `json:"category,omitempty"`
+
+	// Agent and TaskID describe AuditDelegation entries
+	Agent  string `json:"agent,omitempty"`
+	TaskID string `json:"task_id,omitempty"`
+
+	// Failed is true if the operation returned an error
+	Failed bool `json:"failed,omitempty"`
+}
+
+// AuditLog collects the audit entries of one agent execution.
+// It is safe for concurrent use.
+type AuditLog struct {
+	mu      sync.Mutex
+	entries []AuditEntry
+}
+
+// NewAuditLog creates an empty audit log.
+func NewAuditLog() *AuditLog {
+	return &AuditLog{}
+}
+
+// record appends an entry.
+func (l *AuditLog) record(entry AuditEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+// Entries returns a copy of the recorded entries in invocation order.
+func (l *AuditLog) Entries() []AuditEntry {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entries := make([]AuditEntry, len(l.entries))
+	copy(entries, l.entries)
+	return entries
+}
+
+// MarshalJSON encodes the log as a JSON array of entries.
+func (l *AuditLog) MarshalJSON() ([]byte, error) {
+	entries := l.Entries()
+	if entries == nil {
+		entries = []AuditEntry{}
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON decodes a JSON array of entries.
+func (l *AuditLog) UnmarshalJSON(data []byte) error {
+	var entries []AuditEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = entries
+	return nil
+}
+
+// AttachTo stores the log in result.Metadata under AuditMetadataKey.
+func (l *AuditLog) AttachTo(result *Result) {
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]any)
+	}
+	result.Metadata[AuditMetadataKey] = l
+}
+
+// AuditLogFromResult returns the audit log attached to a result. It accepts
+// the log as attached by AttachTo as well as its JSON form, which is what
+// remains after the result has crossed a process boundary.
+func AuditLogFromResult(result Result) (*AuditLog, bool) {
+	raw, ok := result.Metadata[AuditMetadataKey]
+	if !ok || raw == nil {
+		return nil, false
+	}
+
+	var data []byte
+	switch v := raw.(type) {
+	case *AuditLog:
+		return v, true
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, false
+		}
+	}
+
+	log := NewAuditLog()
+	if err := json.Unmarshal(data, log); err != nil {
+		return nil, false
+	}
+	return log, true
+}
+
+// TargetExtractor returns the targets a tool request is aimed at.
+type TargetExtractor func(toolName string, request proto.Message) []string
+
+// defaultTargetFields are the request fields DefaultTargetExtractor reads.
+var defaultTargetFields = map[string]bool{
+	"target": true, "targets": true,
+	"host": true, "hosts": true, "hostname": true,
+	"url": true, "urls": true,
+	"domain": true, "domains": true,
+	"ip": true, "ips": true,
+	"address": true, "addresses": true,
+	"cidr": true, "network": true,
+}
+
+// DefaultTargetExtractor reads top-level string and repeated string fields
+// with common target names (target, host, url, domain, ip, address, cidr and
+// their plurals) from a request. For google.protobuf.Struct requests the
+// same names are read as keys.
+func DefaultTargetExtractor(toolName string, request proto.Message) []string {
+	if request == nil {
+		return nil
+	}
+
+	var targets []string
+	if s, ok := request.(*structpb.Struct); ok {
+		for key, v := range s.GetFields() {
+			if !defaultTargetFields[key] {
+				continue
+			}
+			if sv, ok := v.GetKind().(*structpb.Value_StringValue); ok {
+				targets = append(targets, sv.StringValue)
+			}
+			for _, item := range v.GetListValue().GetValues() {
+				if sv, ok := item.GetKind().(*structpb.Value_StringValue); ok {
+					targets = append(targets, sv.StringValue)
+				}
+			}
+		}
+		return targets
+	}
+
+	msg := request.ProtoReflect()
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.StringKind || fd.IsMap() || !defaultTargetFields[string(fd.Name())] {
+			return true
+		}
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				targets = append(targets, list.Get(i).String())
+			}
+			return true
+		}
+		targets = append(targets, v.String())
+		return true
+	})
+	return targets
+}
+
+// redactTarget removes credentials, query strings, and fragments from URL
+// targets. Other targets are returned unchanged.
+func redactTarget(target string) string {
+	if !strings.Contains(target, "://") {
+		return target
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// AuditOption configures an AuditHarness.
+type AuditOption func(*AuditHarness)
+
+// WithTargetExtractor sets the function used to extract targets from tool
+// requests. The default is DefaultTargetExtractor.
+func WithTargetExtractor(fn TargetExtractor) AuditOption {
+	return func(h *AuditHarness) {
+		h.extract = fn
+	}
+}
+
+// AuditHarness wraps a Harness and records tool calls, credential lookups,
+// finding submissions, and delegations in an AuditLog. All other methods
+// are passed through unchanged.
+//
+// Example:
+//
+//	audited := agent.NewAuditHarness(harness)
+//	result, err := myAgent.Execute(ctx, audited, task)
+//	audited.AuditLog().AttachTo(&result)
+//
+// Agents built with Config.EnableAudit do this automatically.
+type AuditHarness struct {
+	Harness
+	log     *AuditLog
+	extract TargetExtractor
+}
+
+// NewAuditHarness wraps inner with auditing. Wrapping a harness that is
+// already an *AuditHarness returns it unchanged, so operations are never
+// recorded twice; options are ignored in that case.
+func NewAuditHarness(inner Harness, opts ...AuditOption) *AuditHarness {
+	if existing, ok := inner.(*AuditHarness); ok {
+		return existing
+	}
+
+	h := &AuditHarness{
+		Harness: inner,
+		log:     NewAuditLog(),
+		extract: DefaultTargetExtractor,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// AuditLog returns the log the harness records into.
+func (h *AuditHarness) AuditLog() *AuditLog {
+	return h.log
+}
+
+// recordToolCall records a tool invocation with its redacted targets.
+func (h *AuditHarness) recordToolCall(start time.Time, name string, request proto.Message, err error) {
+	var targets []string
+	if h.extract != nil {
+		for _, t := range h.extract(name, request) {
+			targets = append(targets, redactTarget(t))
+		}
+	}
+	h.log.record(AuditEntry{
+		Timestamp: start,
+		Action:    AuditToolCall,
+		Tool:      name,
+		Targets:   targets,
+		Failed:    err != nil,
+	})
+}
+
+// CallToolProto invokes a tool and records the call.
+func (h *AuditHarness) CallToolProto(ctx context.Context, name string, request proto.Message, response proto.Message) error {
+	start := time.Now().UTC()
+	err := h.Harness.CallToolProto(ctx, name, request, response)
+	h.recordToolCall(start, name, request, err)
+	return err
+}
+
+// CallToolProtoStream invokes a streaming tool and records the call.
+func (h *AuditHarness) CallToolProtoStream(ctx context.Context, toolName string, input proto.Message, output proto.Message, callback ToolStreamCallback) error {
+	start := time.Now().UTC()
+	err := h.Harness.CallToolProtoStream(ctx, toolName, input, output, callback)
+	h.recordToolCall(start, toolName, input, err)
+	return err
+}
+
+// QueueToolWork queues tool executions and records one call per input.
+func (h *AuditHarness) QueueToolWork(ctx context.Context, toolName string, inputs []proto.Message) (string, error) {
+	start := time.Now().UTC()
+	jobID, err := h.Harness.QueueToolWork(ctx, toolName, inputs)
+	for _, input := range inputs {
+		h.recordToolCall(start, toolName, input, err)
+	}
+	return jobID, err
+}
+
+// GetCredential retrieves a credential and records its name.
+func (h *AuditHarness) GetCredential(ctx context.Context, name string) (*types.Credential, error) {
+	start := time.Now().UTC()
+	cred, err := h.Harness.GetCredential(ctx, name)
+	h.log.record(AuditEntry{
+		Timestamp:  start,
+		Action:     AuditCredentialAccess,
+		Credential: name,
+		Failed:     err != nil,
+	})
+	return cred, err
+}
+
+// SubmitFinding submits a finding and records its ID, severity, and category.
+func (h *AuditHarness) SubmitFinding(ctx context.Context, f *finding.Finding) error {
+	start := time.Now().UTC()
+	err := h.Harness.SubmitFinding(ctx, f)
+	entry := AuditEntry{
+		Timestamp: start,
+		Action:    AuditFindingSubmitted,
+		Failed:    err != nil,
+	}
+	if f != nil {
+		entry.FindingID = f.ID
+		entry.Severity = string(f.Severity)
+		entry.Category = string(f.Category)
+	}
+	h.log.record(entry)
+	return err
+}
+
+// DelegateToAgent delegates a task and records the agent and task ID.
+func (h *AuditHarness) DelegateToAgent(ctx context.Context, name string, task Task) (Result, error) {
+	start := time.Now().UTC()
+	result, err := h.Harness.DelegateToAgent(ctx, name, task)
+	h.log.record(AuditEntry{
+		Timestamp: start,
+		Action:    AuditDelegation,
+		Agent:     name,
+		TaskID:    task.ID,
+		Failed:    err != nil,
+	})
+	return result, err
+}
+
+// RecordReasoning forwards reasoning to the wrapped harness. Reasoning is not
+// audited.
+func (h *AuditHarness) RecordReasoning(ctx context.Context, text string) {
+	RecordReasoning(ctx, h.Harness, text)
+}
+
+// auditStreamingHarness applies auditing to a StreamingHarness while keeping
+// its event emission methods.
+type auditStreamingHarness struct {
+	StreamingHarness
+	audit *AuditHarness
+}
+
+func (h *auditStreamingHarness) CallToolProto(ctx context.Context, name string, request proto.Message, response proto.Message) error {
+	return h.audit.CallToolProto(ctx, name, request, response)
+}
+
+func (h *auditStreamingHarness) CallToolProtoStream(ctx context.Context, toolName string, input proto.Message, output proto.Message, callback ToolStreamCallback) error {
+	return h.audit.CallToolProtoStream(ctx, toolName, input, output, callback)
+}
+
+func (h *auditStreamingHarness) QueueToolWork(ctx context.Context, toolName string, inputs []proto.Message) (string, error) {
+	return h.audit.QueueToolWork(ctx, toolName, inputs)
+}
+
+func (h *auditStreamingHarness) GetCredential(ctx context.Context, name string) (*types.Credential, error) {
+	return h.audit.GetCredential(ctx, name)
+}
+
+func (h *auditStreamingHarness) SubmitFinding(ctx context.Context, f *finding.Finding) error {
+	return h.audit.SubmitFinding(ctx, f)
+}
+
+func (h *auditStreamingHarness) DelegateToAgent(ctx context.Context, name string, task Task) (Result, error) {
+	return h.audit.DelegateToAgent(ctx, name, task)
+}
+
+func (h *auditStreamingHarness) RecordReasoning(ctx context.Context, text string) {
+	h.audit.RecordReasoning(ctx, text)
+}
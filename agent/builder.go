@@ -3,6 +3,7 @@ package agent
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/zero-day-ai/sdk/llm"
 	"github.com/zero-day-ai/sdk/types"
@@ -25,6 +26,10 @@ type Config struct {
 	initFunc             InitFunc
 	shutdownFunc         ShutdownFunc
 	healthFunc           HealthFunc
+	auditEnabled         bool
+	auditOpts            []AuditOption
+	requiredTools        []string
+	requiredPlugins      []string
 }
 
 // ExecuteFunc is the function signature for agent task execution.
@@ -191,6 +196,30 @@ func (c *Config) SetHealthFunc(fn HealthFunc) *Config {
 	return c
 }
 
+// EnableAudit wraps the harness of every execution in an AuditHarness and
+// attaches the resulting AuditLog to Result.Metadata under AuditMetadataKey.
+// Use AuditLogFromResult to read it back.
+func (c *Config) EnableAudit(opts ...AuditOption) *Config {
+	c.auditEnabled = true
+	c.auditOpts = opts
+	return c
+}
+
+// RequireTools declares tools the agent needs, such as "http-request". The
+// agent verifies they are available before each execution and fails fast,
+// listing every missing dependency, if they are not. See VerifyRequirements.
+func (c *Config) RequireTools(names ...string) *Config {
+	c.requiredTools = append(c.requiredTools, names...)
+	return c
+}
+
+// RequirePlugins declares plugins the agent needs. They are verified with
+// the required tools; see RequireTools.
+func (c *Config) RequirePlugins(names ...string) *Config {
+	c.requiredPlugins = append(c.requiredPlugins, names...)
+	return c
+}
+
 // Validate checks if the configuration is valid and complete.
 func (c *Config) Validate() error {
 	if c.name == "" {
@@ -251,6 +280,10 @@ func New(cfg *Config) (Agent, error) {
 		initFunc:             initFunc,
 		shutdownFunc:         shutdownFunc,
 		healthFunc:           healthFunc,
+		auditEnabled:         cfg.auditEnabled,
+		auditOpts:            cfg.auditOpts,
+		requiredTools:        cfg.requiredTools,
+		requiredPlugins:      cfg.requiredPlugins,
 	}, nil
 }
 
@@ -272,6 +305,15 @@ type sdkAgent struct {
 	initFunc             InitFunc
 	shutdownFunc         ShutdownFunc
 	healthFunc           HealthFunc
+	auditEnabled         bool
+	auditOpts            []AuditOption
+	requiredTools        []string
+	requiredPlugins      []string
+
+	// missing holds the result of the last requirements check, nil if
+	// nothing was missing
+	mu      sync.Mutex
+	missing *MissingRequirementsError
 }
 
 // Name returns the agent's unique identifier.
@@ -314,9 +356,49 @@ func (a *sdkAgent) LLMSlots() []llm.SlotDefinition {
 	return a.llmSlots
 }
 
+// RequiredTools returns the tools the agent needs.
+func (a *sdkAgent) RequiredTools() []string {
+	return a.requiredTools
+}
+
+// RequiredPlugins returns the plugins the agent needs.
+func (a *sdkAgent) RequiredPlugins() []string {
+	return a.requiredPlugins
+}
+
+// recordMissing stores the result of a requirements check for Health.
+func (a *sdkAgent) recordMissing(missing *MissingRequirementsError) {
+	if len(missing.Tools) == 0 && len(missing.Plugins) == 0 {
+		missing = nil
+	}
+	a.mu.Lock()
+	a.missing = missing
+	a.mu.Unlock()
+}
+
+// verify checks the declared requirements, if any.
+func (a *sdkAgent) verify(ctx context.Context, harness Harness) error {
+	if len(a.requiredTools) == 0 && len(a.requiredPlugins) == 0 {
+		return nil
+	}
+	return VerifyRequirements(ctx, a, harness)
+}
+
 // Execute performs a task using the configured execute function.
+// Declared requirements are verified first.
+// If auditing is enabled, the audit log is attached to the result.
 func (a *sdkAgent) Execute(ctx context.Context, harness Harness, task Task) (Result, error) {
-	return a.executeFunc(ctx, harness, task)
+	if err := a.verify(ctx, harness); err != nil {
+		return NewFailedResult(err), err
+	}
+	if !a.auditEnabled {
+		return a.executeFunc(ctx, harness, task)
+	}
+
+	audited := NewAuditHarness(harness, a.auditOpts...)
+	result, err := a.executeFunc(ctx, audited, task)
+	audited.AuditLog().AttachTo(&result)
+	return result, err
 }
 
 // ExecuteStreaming performs a task using the configured streaming execute function.
@@ -332,7 +414,17 @@ func (a *sdkAgent) ExecuteStreaming(ctx context.Context, harness StreamingHarnes
 			Error:  fmt.Errorf("streaming execute function not configured"),
 		}, fmt.Errorf("streaming execute function not configured")
 	}
-	return a.streamingExecuteFunc(ctx, harness, task)
+	if err := a.verify(ctx, harness); err != nil {
+		return NewFailedResult(err), err
+	}
+	if !a.auditEnabled {
+		return a.streamingExecuteFunc(ctx, harness, task)
+	}
+
+	audited := NewAuditHarness(harness, a.auditOpts...)
+	result, err := a.streamingExecuteFunc(ctx, &auditStreamingHarness{StreamingHarness: harness, audit: audited}, task)
+	audited.AuditLog().AttachTo(&result)
+	return result, err
 }
 
 // Initialize calls the configured init function.
@@ -345,7 +437,20 @@ func (a *sdkAgent) Shutdown(ctx context.Context) error {
 	return a.shutdownFunc(ctx)
 }
 
-// Health calls the configured health function.
+// Health calls the configured health function. A healthy status is
+// reported as degraded while the last requirements check found missing
+// dependencies.
 func (a *sdkAgent) Health(ctx context.Context) types.HealthStatus {
-	return a.healthFunc(ctx)
+	status := a.healthFunc(ctx)
+
+	a.mu.Lock()
+	missing := a.missing
+	a.mu.Unlock()
+	if missing == nil || !status.IsHealthy() {
+		return status
+	}
+	return types.NewDegradedStatus(missing.Error(), map[string]any{
+		"missing_tools":   missing.Tools,
+		"missing_plugins": missing.Plugins,
+	})
 }
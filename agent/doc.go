@@ -49,7 +49,7 @@
 //   - Metadata: Task-specific data
 //
 // Agents return Result objects containing:
-//   - Status: Success, Failed, Partial, Cancelled, or Timeout
+//   - Status: Success, Failed, Partial, Cancelled, Timeout, or Refused
 //   - Output: Task results
 //   - Findings: Security findings discovered
 //   - Metadata: Result-specific data
@@ -131,6 +131,28 @@
 //	resp := &toolspb.HTTPResponse{}
 //	err := harness.CallToolProto(ctx, "http-client", req, resp)
 //
+//	// Parallel tool calls with partial-failure handling
+//	results := agent.CallToolsParallel(ctx, calls, callTool, agent.WithFailFast())
+//	if !results.AllSucceeded() {
+//		logger.Warn("some tools failed", "errors", results.Errors())
+//	}
+//
+//	// Streaming with incremental extraction; a deadline returns partial
+//	// results (resp.Partial) instead of an error
+//	candidates, resp, err := agent.StreamAndExtract(ctx, harness, "primary", messages, extractCVEs)
+//
+//	// Safe retries: a task retried with the same IdempotencyKey returns the
+//	// result recorded in mission memory instead of repeating side effects
+//	cfg.SetExecuteFunc(agent.Idempotent(execute))
+//
+//	// Delegation to the best available agent for a capability; the error
+//	// explains which criterion eliminated each candidate
+//	desc, err := agent.SelectDelegate(ctx, harness, agent.SelectionCriteria{
+//		Capabilities: []string{"sql_injection"},
+//		TargetTypes:  []string{"web"},
+//	})
+//	result, err := harness.DelegateToAgent(ctx, desc.Name, subtask)
+//
 //	// Finding submission
 //	finding := createFinding()
 //	err := harness.SubmitFinding(ctx, finding)
@@ -147,6 +169,39 @@
 //	ctx, span := tracer.Start(ctx, "vulnerability-scan")
 //	defer span.End()
 //
+// # Audit Log
+//
+// An AuditHarness records which tools an execution ran and against which
+// targets, which credentials it looked up, and which findings and delegations
+// it produced. Entries hold names and identifiers only: credential secrets,
+// tool payloads, and finding evidence are never recorded. Agents built with
+// EnableAudit attach the log to Result.Metadata automatically:
+//
+//	cfg.EnableAudit(agent.WithTargetExtractor(myExtractor))
+//
+//	result, _ := a.Execute(ctx, harness, task)
+//	if log, ok := agent.AuditLogFromResult(result); ok {
+//	    for _, e := range log.Entries() {
+//	        fmt.Println(e.Action, e.Tool, e.Targets)
+//	    }
+//	}
+//
+// # Required Tools and Plugins
+//
+// Agents declare the tools and plugins they cannot run without. Before each
+// execution the agent checks them against the harness and fails fast with a
+// single error naming every missing dependency, and Health reports degraded
+// until they are available. The requirements are also advertised in the
+// agent's Descriptor:
+//
+//	cfg.RequireTools("nmap", "http-request").
+//	    RequirePlugins("cve-lookup")
+//
+//	// Or check up front, e.g. at startup:
+//	if err := agent.VerifyRequirements(ctx, a, harness); err != nil {
+//	    log.Fatal(err) // agent recon is missing required tools http-request
+//	}
+//
 // # Agent Lifecycle
 //
 //  1. Creation: Agent is instantiated via New() or custom constructor
@@ -155,6 +210,10 @@
 //  4. Health Checks: Health() is called periodically for monitoring
 //  5. Shutdown: Shutdown() is called when agent is being unloaded
 //
+// Health can reflect whether the agent's dependencies are reachable:
+// HealthFromHarness probes LLM slots, required tools and plugins, and
+// GraphRAG through a harness, and RequirementsOf lists what an agent declares.
+//
 // # Best Practices
 //
 //   - Use structured logging via harness.Logger()
@@ -195,11 +254,28 @@
 //   - Component: Source component identifier
 //   - Stack: Optional stack trace for debugging
 //
+// Failed results should carry one of the result error codes so that an
+// orchestrating agent can decide whether to retry a delegated task:
+// ErrCodeLLMUnavailable, ErrCodeToolFailure and ErrCodeTargetUnreachable
+// are retryable; ErrCodeConstraintViolated and ErrCodeInternal are not.
+// ResultError.IsRetryable applies these defaults unless the error was
+// marked with WithRetryable:
+//
+//	// In the delegated agent
+//	return agent.NewFailedResult(err, agent.ErrCodeTargetUnreachable), err
+//
+//	// In the orchestrator
+//	result, err := harness.DelegateToAgent(ctx, "port-scanner", task)
+//	if err == nil && result.ErrorInfo.IsRetryable() {
+//	    // retry with backoff
+//	}
+//
 // Standard result statuses:
 //   - Return Result with StatusFailed and error for unrecoverable errors
 //   - Return Result with StatusPartial for partially completed tasks
 //   - Return Result with StatusTimeout when hitting time limits
 //   - Return Result with StatusCancelled when context is cancelled
+//   - Return NewRefusedResult(reason) when declining an unsafe or out-of-scope task
 //   - Log errors with sufficient context for debugging
 //
 // # Thread Safety
@@ -208,6 +284,8 @@
 //   - Use mutexes to protect shared state
 //   - Avoid race conditions in concurrent operations
 //   - The harness provides thread-safe access to all resources
+//   - Mission() and Target() share their maps with other callers; modify
+//     a Clone() instead
 //
 // # Testing
 //
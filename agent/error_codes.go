@@ -283,6 +283,47 @@ const (
 	ErrCodeConfigError = "CONFIG_ERROR"
 )
 
+// Result error codes.
+//
+// These are the canonical codes an agent reports in ResultError.Code when a
+// task fails. Orchestrating agents use them, through ResultError.IsRetryable,
+// to decide whether a delegated task is worth retrying.
+const (
+	// ErrCodeLLMUnavailable indicates no LLM could serve the request because
+	// the provider is down, overloaded, or no slot could be resolved.
+	//
+	// This error is retryable.
+	ErrCodeLLMUnavailable = "LLM_UNAVAILABLE"
+
+	// ErrCodeToolFailure indicates a tool the agent depends on failed.
+	//
+	// This error is retryable.
+	ErrCodeToolFailure = "TOOL_FAILURE"
+
+	// ErrCodeConstraintViolated indicates the task could not proceed without
+	// violating its mission constraints (scope, allowed techniques, budgets).
+	//
+	// This error is not retryable: the same task under the same constraints
+	// fails the same way.
+	ErrCodeConstraintViolated = "CONSTRAINT_VIOLATED"
+
+	// ErrCodeTargetUnreachable indicates the target of the task could not be
+	// reached.
+	//
+	// This error is retryable.
+	ErrCodeTargetUnreachable = "TARGET_UNREACHABLE"
+
+	// ErrCodeInternal indicates an unexpected failure inside the agent. It is
+	// the same code as ErrCodeInternalError.
+	//
+	// This error is not retryable.
+	ErrCodeInternal = ErrCodeInternalError
+
+	// ErrCodeUnknown is used when an error carries no code, or a code that
+	// could not be mapped. It is not retryable.
+	ErrCodeUnknown = "UNKNOWN"
+)
+
 // IsRetryable determines whether an error code represents a transient failure
 // that may succeed on retry. This function is used by the orchestrator and
 // harness to implement automatic retry logic with exponential backoff.
@@ -326,6 +367,10 @@ func IsRetryable(code string) bool {
 		return true // May be transient routing issue
 	case ErrCodeLLMAPIError:
 		return true // May be temporary provider outage (check HTTP status)
+	case ErrCodeLLMUnavailable:
+		return true // Provider may recover
+	case ErrCodeTargetUnreachable:
+		return true // Target may come back
 
 	// Potentially retryable - may resolve with different output
 	case ErrCodeAgentTimeout:
@@ -336,6 +381,8 @@ func IsRetryable(code string) bool {
 		return true // LLM may generate valid output on retry
 	case ErrCodeToolExecFailed:
 		return true // May be transient system issue
+	case ErrCodeToolFailure:
+		return true // May be transient system issue
 
 	// Conditionally retryable - depends on context
 	case ErrCodeAgentPanic:
@@ -358,6 +405,8 @@ func IsRetryable(code string) bool {
 		return false // Needs investigation and code fix
 	case ErrCodeConfigError:
 		return false // Needs configuration changes
+	case ErrCodeConstraintViolated:
+		return false // Same constraints, same outcome
 
 	default:
 		// Unknown error codes are conservatively treated as non-retryable
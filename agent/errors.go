@@ -26,7 +26,8 @@ type ResultError struct {
 	// Cause is the wrapped underlying error
 	Cause *ResultError `json:"cause,omitempty"`
 
-	// Retryable indicates whether the operation can be retried
+	// Retryable marks the operation as retryable regardless of Code. Use
+	// IsRetryable to classify the error.
 	Retryable bool `json:"retryable"`
 
 	// Component identifies the source component (agent, tool, or system)
@@ -34,6 +35,42 @@ type ResultError struct {
 
 	// Stack contains an optional stack trace for debugging
 	Stack string `json:"stack,omitempty"`
+
+	// retryableSet records that Retryable was set explicitly, so that a
+	// false value overrides the code default.
+	retryableSet bool
+}
+
+// ResultErrorOption configures a ResultError created by NewResultError.
+type ResultErrorOption func(*ResultError)
+
+// WithErrorDetails adds context to the error. See ResultError.WithDetails.
+func WithErrorDetails(details map[string]any) ResultErrorOption {
+	return func(e *ResultError) {
+		e.WithDetails(details)
+	}
+}
+
+// WithErrorRetryable overrides the retry classification of the error's code.
+// See ResultError.WithRetryable.
+func WithErrorRetryable(retryable bool) ResultErrorOption {
+	return func(e *ResultError) {
+		e.WithRetryable(retryable)
+	}
+}
+
+// WithErrorComponent sets the component that generated the error.
+func WithErrorComponent(component string) ResultErrorOption {
+	return func(e *ResultError) {
+		e.Component = component
+	}
+}
+
+// WithErrorCause sets the underlying error, converted with FromError.
+func WithErrorCause(err error) ResultErrorOption {
+	return func(e *ResultError) {
+		e.Cause = FromError(err)
+	}
 }
 
 // Error implements the error interface.
@@ -68,19 +105,47 @@ func (e *ResultError) Error() string {
 // NewResultError creates a new ResultError with the given code and message.
 //
 // Parameters:
-//   - code: error code from the taxonomy (e.g., "EXECUTION_FAILED")
+//   - code: error code from the taxonomy (e.g., ErrCodeToolFailure)
 //   - message: human-readable error description
+//   - opts: optional details, component, cause, or retry override
 //
 // Example:
 //
-//	err := agent.NewResultError("TIMEOUT", "LLM request timed out after 30s")
-func NewResultError(code, message string) *ResultError {
-	return &ResultError{
+//	err := agent.NewResultError(agent.ErrCodeTargetUnreachable, "connection refused",
+//	    agent.WithErrorComponent("port-scanner"),
+//	    agent.WithErrorDetails(map[string]any{"host": "10.0.0.5"}))
+func NewResultError(code, message string, opts ...ResultErrorOption) *ResultError {
+	e := &ResultError{
 		Code:      code,
 		Message:   message,
 		Details:   nil,
 		Retryable: false,
 	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// IsRetryable reports whether the failed operation may succeed if retried.
+// An explicit WithRetryable call decides; otherwise a true Retryable field
+// wins, and the default for Code applies (see the package-level IsRetryable):
+//
+//   - ErrCodeLLMUnavailable, ErrCodeToolFailure, ErrCodeTargetUnreachable:
+//     retryable
+//   - ErrCodeConstraintViolated, ErrCodeInternal, ErrCodeUnknown: not
+//     retryable
+//
+// Only the Retryable field survives serialization, so a decoded error that
+// was explicitly marked non-retryable falls back to its code default.
+func (e *ResultError) IsRetryable() bool {
+	if e == nil {
+		return false
+	}
+	if e.retryableSet || e.Retryable {
+		return e.Retryable
+	}
+	return IsRetryable(e.Code)
 }
 
 // Wrap creates a new ResultError that wraps an existing error.
@@ -122,7 +187,7 @@ func Wrap(err error, code, message string) *ResultError {
 // FromError converts any error to a ResultError.
 // If the error is already a ResultError, it is returned as-is.
 // If the error is nil, nil is returned.
-// Otherwise, a new ResultError is created with code "UNKNOWN" and the error's message.
+// Otherwise, a new ResultError is created with code ErrCodeUnknown and the error's message.
 //
 // Example:
 //
@@ -141,7 +206,7 @@ func FromError(err error) *ResultError {
 
 	// Convert standard error to ResultError
 	return &ResultError{
-		Code:    "UNKNOWN",
+		Code:    ErrCodeUnknown,
 		Message: err.Error(),
 	}
 }
@@ -192,6 +257,7 @@ func (e *ResultError) WithDetails(details map[string]any) *ResultError {
 //	    WithRetryable(true)
 func (e *ResultError) WithRetryable(retryable bool) *ResultError {
 	e.Retryable = retryable
+	e.retryableSet = true
 	return e
 }
 
@@ -468,3 +468,73 @@ func TestResultError_ComplexErrorChain(t *testing.T) {
 	assert.Equal(t, "UNKNOWN", decoded.Cause.Cause.Code)
 	assert.Contains(t, decoded.Cause.Cause.Message, "connection refused")
 }
+
+func TestResultError_IsRetryable(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{ErrCodeLLMUnavailable, true},
+		{ErrCodeToolFailure, true},
+		{ErrCodeTargetUnreachable, true},
+		{ErrCodeConstraintViolated, false},
+		{ErrCodeInternal, false},
+		{ErrCodeUnknown, false},
+		{ErrCodeAgentTimeout, true},
+		{ErrCodeAgentPanic, false},
+		{ErrCodeAgentInitFailed, false},
+		{ErrCodeLLMRateLimited, true},
+		{ErrCodeLLMContextExceeded, false},
+		{ErrCodeLLMAPIError, true},
+		{ErrCodeLLMParseError, true},
+		{ErrCodeToolNotFound, false},
+		{ErrCodeToolTimeout, true},
+		{ErrCodeToolExecFailed, true},
+		{ErrCodeNetworkTimeout, true},
+		{ErrCodeNetworkUnreachable, true},
+		{ErrCodeTLSError, false},
+		{ErrCodeDelegationFailed, false},
+		{ErrCodeChildAgentFailed, false},
+		{ErrCodeConfigError, false},
+		{"SOMETHING_ELSE", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewResultError(tt.code, "failed").IsRetryable(), "code default")
+			assert.True(t, NewResultError(tt.code, "failed", WithErrorRetryable(true)).IsRetryable(), "override to true")
+			assert.False(t, NewResultError(tt.code, "failed", WithErrorRetryable(false)).IsRetryable(), "override to false")
+			assert.True(t, (&ResultError{Code: tt.code, Retryable: true}).IsRetryable(), "retryable field")
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		var err *ResultError
+		assert.False(t, err.IsRetryable())
+	})
+
+	t.Run("JSON keeps the code default", func(t *testing.T) {
+		data, err := json.Marshal(NewResultError(ErrCodeTargetUnreachable, "down"))
+		require.NoError(t, err)
+		var decoded ResultError
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.True(t, decoded.IsRetryable())
+	})
+}
+
+func TestNewResultError_Options(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := NewResultError(ErrCodeTargetUnreachable, "target down",
+		WithErrorComponent("port-scanner"),
+		WithErrorDetails(map[string]any{"host": "10.0.0.5"}),
+		WithErrorCause(cause),
+		WithErrorRetryable(false))
+
+	assert.Equal(t, ErrCodeTargetUnreachable, err.Code)
+	assert.Equal(t, "port-scanner", err.Component)
+	assert.Equal(t, "10.0.0.5", err.Details["host"])
+	require.NotNil(t, err.Cause)
+	assert.Equal(t, "connection refused", err.Cause.Message)
+	assert.False(t, err.IsRetryable())
+	assert.Equal(t, "port-scanner [TARGET_UNREACHABLE]: target down: [UNKNOWN]: connection refused", err.Error())
+}
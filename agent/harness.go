@@ -593,4 +593,10 @@ type Descriptor struct {
 
 	// TechniqueTypes lists the attack techniques the agent employs.
 	TechniqueTypes []string
+
+	// RequiredTools lists the tools the agent needs to run.
+	RequiredTools []string
+
+	// RequiredPlugins lists the plugins the agent needs to run.
+	RequiredPlugins []string
 }
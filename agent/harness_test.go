@@ -402,10 +402,18 @@ func (s *stubMissionMemory) Search(ctx context.Context, query string, limit int)
 	return nil, memory.ErrNotImplemented
 }
 
+func (s *stubMissionMemory) SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]memory.Result, int, error) {
+	return nil, 0, memory.ErrNotImplemented
+}
+
 func (s *stubMissionMemory) History(ctx context.Context, limit int) ([]memory.Item, error) {
 	return nil, memory.ErrNotImplemented
 }
 
+func (s *stubMissionMemory) HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]memory.Item, int, error) {
+	return nil, 0, memory.ErrNotImplemented
+}
+
 func (s *stubMissionMemory) GetPreviousRunValue(ctx context.Context, key string) (any, error) {
 	return nil, memory.ErrNotImplemented
 }
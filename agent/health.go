@@ -0,0 +1,131 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zero-day-ai/sdk/health"
+	"github.com/zero-day-ai/sdk/llm"
+	"github.com/zero-day-ai/sdk/types"
+)
+
+// Requirements lists the dependencies HealthFromHarness probes.
+type Requirements struct {
+	// LLMSlots are the slots that must answer a completion. Each is probed
+	// with a one-token completion, so probing costs a request per slot.
+	LLMSlots []string
+
+	// Tools and Plugins must be listed by the harness.
+	Tools   []string
+	Plugins []string
+
+	// GraphRAG includes the harness's GraphRAGHealth in the result.
+	GraphRAG bool
+}
+
+// RequirementsOf returns the requirements an agent declares: its required
+// LLM slots and, if it implements RequirementsProvider, its required tools
+// and plugins. GraphRAG is not included.
+func RequirementsOf(a Agent) Requirements {
+	var reqs Requirements
+	for _, slot := range a.LLMSlots() {
+		if slot.Required {
+			reqs.LLMSlots = append(reqs.LLMSlots, slot.Name)
+		}
+	}
+	if p, ok := a.(RequirementsProvider); ok {
+		reqs.Tools = p.RequiredTools()
+		reqs.Plugins = p.RequiredPlugins()
+	}
+	return reqs
+}
+
+// HealthFromHarness probes the dependencies in required through h and
+// combines the results with health.Combine. An LLM slot that fails to
+// complete makes the agent unhealthy; a missing tool or plugin, or a
+// failure to list them, degrades it. The GraphRAG status is included as
+// reported. The status of each dependency is in Details["dependencies"].
+//
+// Agents can call it from Health with the harness of their last task:
+//
+//	func (a *MyAgent) Health(ctx context.Context) types.HealthStatus {
+//	    if a.harness == nil {
+//	        return types.NewHealthyStatus("not yet executed")
+//	    }
+//	    return agent.HealthFromHarness(ctx, a.harness, agent.RequirementsOf(a))
+//	}
+func HealthFromHarness(ctx context.Context, h Harness, required Requirements) types.HealthStatus {
+	dependencies := make(map[string]types.HealthStatus)
+	var checks []types.HealthStatus
+	add := func(name string, status types.HealthStatus) {
+		dependencies[name] = status
+		checks = append(checks, status)
+	}
+
+	for _, slot := range required.LLMSlots {
+		add("llm:"+slot, probeSlot(ctx, h, slot))
+	}
+
+	if len(required.Tools) > 0 {
+		tools, err := h.ListTools(ctx)
+		if err != nil {
+			add("tools", types.NewDegradedStatus(fmt.Sprintf("list tools: %v", err), nil))
+		} else {
+			available := make([]string, len(tools))
+			for i, t := range tools {
+				available[i] = t.Name
+			}
+			for _, name := range required.Tools {
+				add("tool:"+name, presence("tool", name, available))
+			}
+		}
+	}
+
+	if len(required.Plugins) > 0 {
+		plugins, err := h.ListPlugins(ctx)
+		if err != nil {
+			add("plugins", types.NewDegradedStatus(fmt.Sprintf("list plugins: %v", err), nil))
+		} else {
+			available := make([]string, len(plugins))
+			for i, p := range plugins {
+				available[i] = p.Name
+			}
+			for _, name := range required.Plugins {
+				add("plugin:"+name, presence("plugin", name, available))
+			}
+		}
+	}
+
+	if required.GraphRAG {
+		status := h.GraphRAGHealth(ctx)
+		status.Message = "graphrag: " + status.Message
+		add("graphrag", status)
+	}
+
+	status := health.Combine(checks...)
+	if len(checks) == 0 {
+		status.Message = "no dependencies to check"
+	}
+	if status.Details == nil {
+		status.Details = make(map[string]any)
+	}
+	status.Details["dependencies"] = dependencies
+	return status
+}
+
+// probeSlot checks that an LLM slot answers a one-token completion.
+func probeSlot(ctx context.Context, h Harness, slot string) types.HealthStatus {
+	messages := []llm.Message{{Role: llm.RoleUser, Content: "ping"}}
+	if _, err := h.Complete(ctx, slot, messages, llm.WithMaxTokens(1)); err != nil {
+		return types.NewUnhealthyStatus(fmt.Sprintf("llm slot %s: %v", slot, err), nil)
+	}
+	return types.NewHealthyStatus(fmt.Sprintf("llm slot %s available", slot))
+}
+
+// presence reports whether a required tool or plugin is available.
+func presence(kind, name string, available []string) types.HealthStatus {
+	if len(missingNames([]string{name}, available)) > 0 {
+		return types.NewDegradedStatus(fmt.Sprintf("%s %s not available", kind, name), nil)
+	}
+	return types.NewHealthyStatus(fmt.Sprintf("%s %s available", kind, name))
+}
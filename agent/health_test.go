@@ -0,0 +1,134 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zero-day-ai/sdk/llm"
+	"github.com/zero-day-ai/sdk/types"
+)
+
+// healthHarness adds LLM and GraphRAG stubs to requirementsHarness.
+type healthHarness struct {
+	*requirementsHarness
+	downSlots map[string]bool
+	probes    []string
+	graphRAG  types.HealthStatus
+}
+
+func newHealthHarness() *healthHarness {
+	return &healthHarness{
+		requirementsHarness: defaultRequirementsHarness(),
+		graphRAG:            types.NewHealthyStatus("ok"),
+	}
+}
+
+func (h *healthHarness) Complete(ctx context.Context, slot string, messages []llm.Message, opts ...llm.CompletionOption) (*llm.CompletionResponse, error) {
+	h.probes = append(h.probes, slot)
+	if h.downSlots[slot] {
+		return nil, errors.New("no provider configured")
+	}
+	return &llm.CompletionResponse{Content: "p"}, nil
+}
+
+func (h *healthHarness) GraphRAGHealth(ctx context.Context) types.HealthStatus {
+	return h.graphRAG
+}
+
+func TestHealthFromHarness(t *testing.T) {
+	required := Requirements{
+		LLMSlots: []string{"primary"},
+		Tools:    []string{"tool1"},
+		Plugins:  []string{"plugin1"},
+		GraphRAG: true,
+	}
+	dependencies := func(status types.HealthStatus) map[string]types.HealthStatus {
+		deps, ok := status.Details["dependencies"].(map[string]types.HealthStatus)
+		require.True(t, ok)
+		return deps
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		h := newHealthHarness()
+		status := HealthFromHarness(context.Background(), h, required)
+		assert.True(t, status.IsHealthy(), status.Message)
+		assert.Equal(t, []string{"primary"}, h.probes)
+		assert.Len(t, dependencies(status), 4)
+	})
+
+	t.Run("missing tool degrades", func(t *testing.T) {
+		h := newHealthHarness()
+		reqs := required
+		reqs.Tools = []string{"tool1", "nmap"}
+
+		status := HealthFromHarness(context.Background(), h, reqs)
+		assert.True(t, status.IsDegraded())
+		assert.True(t, dependencies(status)["tool:nmap"].IsDegraded())
+		assert.True(t, dependencies(status)["tool:tool1"].IsHealthy())
+		assert.Contains(t, status.Details["degraded_checks"], "tool nmap not available")
+	})
+
+	t.Run("list failure degrades", func(t *testing.T) {
+		h := newHealthHarness()
+		h.listErr = errors.New("callback unavailable")
+
+		status := HealthFromHarness(context.Background(), h, required)
+		assert.True(t, status.IsDegraded())
+		assert.True(t, dependencies(status)["tools"].IsDegraded())
+	})
+
+	t.Run("unavailable slot is unhealthy", func(t *testing.T) {
+		h := newHealthHarness()
+		h.downSlots = map[string]bool{"primary": true}
+
+		status := HealthFromHarness(context.Background(), h, required)
+		assert.True(t, status.IsUnhealthy())
+		assert.Contains(t, dependencies(status)["llm:primary"].Message, "no provider configured")
+	})
+
+	t.Run("graphrag status is included", func(t *testing.T) {
+		h := newHealthHarness()
+		h.graphRAG = types.NewUnhealthyStatus("neo4j unreachable", nil)
+
+		status := HealthFromHarness(context.Background(), h, required)
+		assert.True(t, status.IsUnhealthy())
+		assert.Equal(t, "graphrag: neo4j unreachable", dependencies(status)["graphrag"].Message)
+
+		status = HealthFromHarness(context.Background(), h, Requirements{LLMSlots: []string{"primary"}})
+		assert.True(t, status.IsHealthy(), "graphrag is only checked when required")
+	})
+
+	t.Run("no requirements", func(t *testing.T) {
+		h := newHealthHarness()
+		status := HealthFromHarness(context.Background(), h, Requirements{})
+		assert.True(t, status.IsHealthy())
+		assert.Empty(t, h.probes)
+		assert.False(t, h.listed)
+	})
+}
+
+func TestRequirementsOf(t *testing.T) {
+	cfg := NewConfig().
+		SetName("recon").
+		SetVersion("1.0.0").
+		SetDescription("Recon agent").
+		AddLLMSlot("primary", llm.SlotRequirements{}).
+		AddLLMSlotDefinition(llm.SlotDefinition{Name: "vision"}).
+		RequireTools("nmap").
+		RequirePlugins("shodan").
+		SetExecuteFunc(func(ctx context.Context, harness Harness, task Task) (Result, error) {
+			return NewSuccessResult("done"), nil
+		})
+	a, err := New(cfg)
+	require.NoError(t, err)
+
+	reqs := RequirementsOf(a)
+	assert.Equal(t, []string{"primary"}, reqs.LLMSlots)
+	assert.Equal(t, []string{"nmap"}, reqs.Tools)
+	assert.Equal(t, []string{"shodan"}, reqs.Plugins)
+	assert.False(t, reqs.GraphRAG)
+}
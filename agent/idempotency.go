@@ -0,0 +1,131 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/zero-day-ai/sdk/memory"
+)
+
+// idempotencyKeyPrefix namespaces recorded results in mission memory.
+const idempotencyKeyPrefix = "idempotency:"
+
+// AlreadyProcessed reports whether a result has been recorded for key in
+// mission memory, and returns that result if so. It returns false and no
+// error when nothing has been recorded, so callers can treat it as a cheap
+// check at the start of Execute.
+//
+// Example:
+//
+//	if done, prior, err := agent.AlreadyProcessed(ctx, h, task.IdempotencyKey); err != nil {
+//	    return agent.Result{}, err
+//	} else if done {
+//	    return prior, nil
+//	}
+func AlreadyProcessed(ctx context.Context, h Harness, key string) (bool, Result, error) {
+	if key == "" {
+		return false, Result{}, nil
+	}
+
+	item, err := h.Memory().Mission().Get(ctx, idempotencyKeyPrefix+key)
+	if errors.Is(err, memory.ErrNotFound) {
+		return false, Result{}, nil
+	}
+	if err != nil {
+		return false, Result{}, fmt.Errorf("check idempotency key %q: %w", key, err)
+	}
+
+	// The value may have round-tripped through a remote store as generic
+	// JSON, so decode it through JSON rather than asserting its type.
+	data, err := json.Marshal(item.Value)
+	if err != nil {
+		return false, Result{}, fmt.Errorf("decode result for idempotency key %q: %w", key, err)
+	}
+	var result Result
+	if err := json.Unmarshal(data, &result); err != nil {
+		return false, Result{}, fmt.Errorf("decode result for idempotency key %q: %w", key, err)
+	}
+	if result.ErrorInfo != nil {
+		result.Error = result.ErrorInfo
+	}
+	return true, result, nil
+}
+
+// RecordProcessed stores result in mission memory under key, so later calls
+// to AlreadyProcessed with the same key return it. Recording an empty key is
+// a no-op.
+func RecordProcessed(ctx context.Context, h Harness, key string, result Result) error {
+	if key == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("encode result for idempotency key %q: %w", key, err)
+	}
+	var value map[string]any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("encode result for idempotency key %q: %w", key, err)
+	}
+
+	metadata := map[string]any{
+		"category": "idempotency",
+		"status":   string(result.Status),
+	}
+	if err := h.Memory().Mission().Set(ctx, idempotencyKeyPrefix+key, value, metadata); err != nil {
+		return fmt.Errorf("record idempotency key %q: %w", key, err)
+	}
+	return nil
+}
+
+// Idempotent wraps fn so that retries of a task with the same IdempotencyKey
+// return the recorded result instead of running fn again. Results are
+// recorded when fn returns no error and the status is success, partial, or
+// refused; failed, cancelled, and timed-out executions are not recorded, so a
+// retry runs them again. Tasks without an IdempotencyKey always run.
+//
+// Failing to record a result is logged rather than returned, since the task
+// itself completed.
+//
+// Example:
+//
+//	cfg.SetExecuteFunc(agent.Idempotent(func(ctx context.Context, h agent.Harness, task agent.Task) (agent.Result, error) {
+//	    // submits findings, writes to the graph, ...
+//	}))
+func Idempotent(fn ExecuteFunc) ExecuteFunc {
+	return func(ctx context.Context, h Harness, task Task) (Result, error) {
+		if task.IdempotencyKey == "" {
+			return fn(ctx, h, task)
+		}
+
+		done, prior, err := AlreadyProcessed(ctx, h, task.IdempotencyKey)
+		if err != nil {
+			return Result{}, err
+		}
+		if done {
+			h.Logger().Info("skipping already processed task",
+				"task_id", task.ID,
+				"idempotency_key", task.IdempotencyKey,
+			)
+			return prior, nil
+		}
+
+		result, err := fn(ctx, h, task)
+		if err != nil {
+			return result, err
+		}
+		switch result.Status {
+		case StatusSuccess, StatusPartial, StatusRefused:
+			if recErr := RecordProcessed(ctx, h, task.IdempotencyKey, result); recErr != nil {
+				h.Logger().Warn("failed to record idempotency key",
+					"task_id", task.ID,
+					"idempotency_key", task.IdempotencyKey,
+					"error", recErr,
+				)
+			}
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,179 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zero-day-ai/sdk/memory"
+)
+
+// mapMissionMemory is a map-backed MissionMemory. Values are stored as they
+// would come back from a remote store: decoded from JSON. Methods other than
+// Get and Set panic through the nil embedded interface.
+type mapMissionMemory struct {
+	memory.MissionMemory
+	items map[string]*memory.Item
+}
+
+func (m *mapMissionMemory) Get(ctx context.Context, key string) (*memory.Item, error) {
+	item, ok := m.items[key]
+	if !ok {
+		return nil, memory.ErrNotFound
+	}
+	return item, nil
+}
+
+func (m *mapMissionMemory) Set(ctx context.Context, key string, value any, metadata map[string]any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	m.items[key] = &memory.Item{Key: key, Value: decoded, Metadata: metadata}
+	return nil
+}
+
+type mapMemoryStore struct {
+	memory.Store
+	mission *mapMissionMemory
+}
+
+func (s *mapMemoryStore) Mission() memory.MissionMemory { return s.mission }
+
+// idempotencyHarness stubs Memory and Logger. Any other method panics
+// through the nil embedded Harness.
+type idempotencyHarness struct {
+	Harness
+	store *mapMemoryStore
+}
+
+func newIdempotencyHarness() *idempotencyHarness {
+	return &idempotencyHarness{store: &mapMemoryStore{mission: &mapMissionMemory{items: make(map[string]*memory.Item)}}}
+}
+
+func (h *idempotencyHarness) Memory() memory.Store { return h.store }
+
+func (h *idempotencyHarness) Logger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestAlreadyProcessed_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	h := newIdempotencyHarness()
+
+	done, _, err := AlreadyProcessed(ctx, h, "k1")
+	require.NoError(t, err)
+	assert.False(t, done)
+
+	result := NewSuccessResult(map[string]any{"hosts": 3})
+	result.AddFinding("finding-1")
+	require.NoError(t, RecordProcessed(ctx, h, "k1", result))
+
+	done, prior, err := AlreadyProcessed(ctx, h, "k1")
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, StatusSuccess, prior.Status)
+	assert.Equal(t, []string{"finding-1"}, prior.Findings)
+	assert.Equal(t, map[string]any{"hosts": float64(3)}, prior.Output)
+
+	item := h.store.mission.items["idempotency:k1"]
+	require.NotNil(t, item)
+	assert.Equal(t, "idempotency", item.Metadata["category"])
+}
+
+func TestAlreadyProcessed_EmptyKey(t *testing.T) {
+	h := newIdempotencyHarness()
+
+	require.NoError(t, RecordProcessed(context.Background(), h, "", NewSuccessResult(nil)))
+	assert.Empty(t, h.store.mission.items)
+
+	done, _, err := AlreadyProcessed(context.Background(), h, "")
+	require.NoError(t, err)
+	assert.False(t, done)
+}
+
+func TestIdempotent(t *testing.T) {
+	ctx := context.Background()
+	h := newIdempotencyHarness()
+
+	runs := 0
+	execute := Idempotent(func(ctx context.Context, h Harness, task Task) (Result, error) {
+		runs++
+		result := NewSuccessResult("scanned")
+		result.AddFinding("finding-1")
+		return result, nil
+	})
+
+	task := Task{ID: "t1", IdempotencyKey: "mission-1/recon"}
+	first, err := execute(ctx, h, task)
+	require.NoError(t, err)
+	retry, err := execute(ctx, h, Task{ID: "t1-retry", IdempotencyKey: "mission-1/recon"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, runs, "the retry returns the recorded result without re-running")
+	assert.Equal(t, first.Status, retry.Status)
+	assert.Equal(t, first.Output, retry.Output)
+	assert.Equal(t, first.Findings, retry.Findings)
+
+	_, err = execute(ctx, h, Task{ID: "t2"})
+	require.NoError(t, err)
+	_, err = execute(ctx, h, Task{ID: "t2"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, runs, "tasks without a key always run")
+}
+
+func TestIdempotent_FailuresAreRetried(t *testing.T) {
+	ctx := context.Background()
+	h := newIdempotencyHarness()
+	task := Task{ID: "t1", IdempotencyKey: "k"}
+
+	runs := 0
+	failing := Idempotent(func(ctx context.Context, h Harness, task Task) (Result, error) {
+		runs++
+		return NewFailedResult(errors.New("connection refused")), nil
+	})
+	_, err := failing(ctx, h, task)
+	require.NoError(t, err)
+	_, err = failing(ctx, h, task)
+	require.NoError(t, err)
+	assert.Equal(t, 2, runs)
+
+	erroring := Idempotent(func(ctx context.Context, h Harness, task Task) (Result, error) {
+		runs++
+		return Result{}, errors.New("harness unavailable")
+	})
+	_, err = erroring(ctx, h, task)
+	assert.EqualError(t, err, "harness unavailable")
+	assert.Empty(t, h.store.mission.items)
+}
+
+func TestAlreadyProcessed_RestoresError(t *testing.T) {
+	ctx := context.Background()
+	h := newIdempotencyHarness()
+
+	partial := NewPartialResult("2 of 3 hosts", errors.New("host 10.0.0.3 unreachable"))
+	require.NoError(t, RecordProcessed(ctx, h, "k", partial))
+
+	done, prior, err := AlreadyProcessed(ctx, h, "k")
+	require.NoError(t, err)
+	require.True(t, done)
+	assert.Equal(t, StatusPartial, prior.Status)
+	require.NotNil(t, prior.ErrorInfo)
+	require.Error(t, prior.Error)
+	assert.Contains(t, prior.Error.Error(), "host 10.0.0.3 unreachable")
+
+	require.NoError(t, RecordProcessed(ctx, h, "refused", NewRefusedResult("out_of_scope")))
+	_, prior, err = AlreadyProcessed(ctx, h, "refused")
+	require.NoError(t, err)
+	assert.Equal(t, "out_of_scope", prior.RefusalReason)
+}
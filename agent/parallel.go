@@ -0,0 +1,148 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ToolResults is the ordered set of results from CallToolsParallel.
+// Result i always corresponds to call i, whether it succeeded or failed.
+type ToolResults []ToolResult
+
+// Errors returns the errors of all failed calls in call order, each wrapped
+// with the name of the tool that produced it. Returns nil if every call
+// succeeded.
+func (r ToolResults) Errors() []error {
+	var errs []error
+	for _, res := range r {
+		if res.Error != nil {
+			errs = append(errs, fmt.Errorf("tool %s: %w", res.Name, res.Error))
+		}
+	}
+	return errs
+}
+
+// Successful returns the results of the calls that succeeded, in call order.
+func (r ToolResults) Successful() []ToolResult {
+	var ok []ToolResult
+	for _, res := range r {
+		if res.Error == nil {
+			ok = append(ok, res)
+		}
+	}
+	return ok
+}
+
+// AllSucceeded reports whether every call completed without error.
+func (r ToolResults) AllSucceeded() bool {
+	for _, res := range r {
+		if res.Error != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ToolCallFunc executes a single tool call. Agents typically adapt
+// Harness.CallToolProto to this signature for the tools they invoke.
+type ToolCallFunc func(ctx context.Context, call ToolCall) (map[string]any, error)
+
+// ParallelOption configures CallToolsParallel.
+type ParallelOption func(*parallelConfig)
+
+type parallelConfig struct {
+	failFast       bool
+	maxConcurrency int
+}
+
+// WithFailFast cancels the remaining calls as soon as one call fails.
+// Calls that had not started are reported with an error wrapping
+// context.Canceled. Without this option every call runs to completion and
+// all failures are collected.
+func WithFailFast() ParallelOption {
+	return func(c *parallelConfig) {
+		c.failFast = true
+	}
+}
+
+// WithMaxConcurrency limits how many calls run at once. Values <= 0 mean no
+// limit.
+func WithMaxConcurrency(n int) ParallelOption {
+	return func(c *parallelConfig) {
+		c.maxConcurrency = n
+	}
+}
+
+// CallToolsParallel runs calls concurrently using fn and returns one result
+// per call in the original order. A failing call never discards the results
+// of the others: inspect the returned ToolResults with Errors, Successful or
+// AllSucceeded to handle partial failures.
+//
+// Example:
+//
+//	results := agent.CallToolsParallel(ctx, calls, callTool)
+//	for _, err := range results.Errors() {
+//	    logger.Warn("tool failed", "error", err)
+//	}
+//	for _, res := range results.Successful() {
+//	    process(res.Output)
+//	}
+func CallToolsParallel(ctx context.Context, calls []ToolCall, fn ToolCallFunc, opts ...ParallelOption) ToolResults {
+	cfg := parallelConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	results := make(ToolResults, len(calls))
+	if len(calls) == 0 {
+		return results
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limit := cfg.maxConcurrency
+	if limit <= 0 || limit > len(calls) {
+		limit = len(calls)
+	}
+	sem := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		results[i].Name = call.Name
+
+		// Don't start new calls once cancelled, whether by the caller or
+		// by an earlier failure in fail-fast mode
+		select {
+		case sem <- struct{}{}:
+			if err := ctx.Err(); err != nil {
+				<-sem
+				results[i].Error = fmt.Errorf("not started: %w", err)
+				continue
+			}
+		case <-ctx.Done():
+			results[i].Error = fmt.Errorf("not started: %w", ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, call ToolCall) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			output, err := fn(ctx, call)
+			if err != nil {
+				results[i].Error = err
+				if cfg.failFast {
+					cancel()
+				}
+				return
+			}
+			results[i].Output = output
+		}(i, call)
+	}
+
+	wg.Wait()
+	return results
+}
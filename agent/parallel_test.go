@@ -0,0 +1,121 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolResults_Helpers(t *testing.T) {
+	errScan := errors.New("scan failed")
+	results := ToolResults{
+		{Name: "nmap", Output: map[string]any{"hosts": 2}},
+		{Name: "httpx", Error: errScan},
+		{Name: "dns", Output: map[string]any{"records": 1}},
+	}
+
+	assert.False(t, results.AllSucceeded())
+
+	errs := results.Errors()
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], errScan)
+	assert.Contains(t, errs[0].Error(), "tool httpx")
+
+	ok := results.Successful()
+	require.Len(t, ok, 2)
+	assert.Equal(t, "nmap", ok[0].Name)
+	assert.Equal(t, "dns", ok[1].Name)
+
+	clean := ToolResults{{Name: "nmap"}}
+	assert.True(t, clean.AllSucceeded())
+	assert.Nil(t, clean.Errors())
+}
+
+func TestCallToolsParallel_CollectAll(t *testing.T) {
+	calls := []ToolCall{
+		{Name: "a", Input: map[string]any{"n": 1}},
+		{Name: "b", Input: map[string]any{"n": 2}},
+		{Name: "c", Input: map[string]any{"n": 3}},
+	}
+
+	results := CallToolsParallel(context.Background(), calls, func(ctx context.Context, call ToolCall) (map[string]any, error) {
+		if call.Name == "b" {
+			return nil, errors.New("boom")
+		}
+		return map[string]any{"n": call.Input["n"]}, nil
+	})
+
+	require.Len(t, results, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{results[0].Name, results[1].Name, results[2].Name})
+	assert.Equal(t, 1, results[0].Output["n"])
+	assert.Error(t, results[1].Error)
+	assert.Nil(t, results[1].Output)
+	assert.Equal(t, 3, results[2].Output["n"])
+	assert.Len(t, results.Successful(), 2)
+	assert.Len(t, results.Errors(), 1)
+}
+
+func TestCallToolsParallel_FailFast(t *testing.T) {
+	calls := []ToolCall{{Name: "fails"}, {Name: "slow"}, {Name: "queued"}}
+	errFail := errors.New("fail")
+
+	results := CallToolsParallel(context.Background(), calls, func(ctx context.Context, call ToolCall) (map[string]any, error) {
+		switch call.Name {
+		case "fails":
+			return nil, errFail
+		case "slow":
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(5 * time.Second):
+				return map[string]any{}, nil
+			}
+		default:
+			return map[string]any{}, nil
+		}
+	}, WithFailFast(), WithMaxConcurrency(2))
+
+	assert.ErrorIs(t, results[0].Error, errFail)
+	assert.ErrorIs(t, results[1].Error, context.Canceled)
+	assert.ErrorIs(t, results[2].Error, context.Canceled)
+	assert.Contains(t, results[2].Error.Error(), "not started")
+	assert.Empty(t, results.Successful())
+}
+
+func TestCallToolsParallel_MaxConcurrency(t *testing.T) {
+	calls := make([]ToolCall, 8)
+	for i := range calls {
+		calls[i] = ToolCall{Name: "tool"}
+	}
+
+	var running, peak int32
+	results := CallToolsParallel(context.Background(), calls, func(ctx context.Context, call ToolCall) (map[string]any, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return map[string]any{}, nil
+	}, WithMaxConcurrency(3))
+
+	assert.True(t, results.AllSucceeded())
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+}
+
+func TestCallToolsParallel_Empty(t *testing.T) {
+	results := CallToolsParallel(context.Background(), nil, func(ctx context.Context, call ToolCall) (map[string]any, error) {
+		t.Fatal("should not be called")
+		return nil, nil
+	})
+	assert.Empty(t, results)
+	assert.True(t, results.AllSucceeded())
+}
@@ -0,0 +1,26 @@
+package agent
+
+import "context"
+
+// ReasoningRecorder is implemented by harnesses that record an agent's
+// intermediate reasoning, such as eval.RecordingHarness, so that how the
+// agent thinks can be evaluated and not just what it did.
+type ReasoningRecorder interface {
+	// RecordReasoning records text as the agent's reasoning at this point
+	// of the execution, e.g. its plan before a tool call or its
+	// interpretation of a tool's output.
+	RecordReasoning(ctx context.Context, text string)
+}
+
+// RecordReasoning records text as a reasoning step if h implements
+// ReasoningRecorder, and does nothing otherwise, so agents can call it
+// unconditionally.
+//
+// Example:
+//
+//	agent.RecordReasoning(ctx, h, "Port 443 serves an outdated nginx; checking for CVE-2021-23017 first.")
+func RecordReasoning(ctx context.Context, h Harness, text string) {
+	if r, ok := h.(ReasoningRecorder); ok {
+		r.RecordReasoning(ctx, text)
+	}
+}
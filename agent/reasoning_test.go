@@ -0,0 +1,33 @@
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// reasoningHarness records reasoning passed to RecordReasoning.
+type reasoningHarness struct {
+	Harness
+	reasoning []string
+}
+
+func (h *reasoningHarness) RecordReasoning(ctx context.Context, text string) {
+	h.reasoning = append(h.reasoning, text)
+}
+
+func TestRecordReasoning(t *testing.T) {
+	ctx := context.Background()
+
+	recorder := &reasoningHarness{}
+	RecordReasoning(ctx, recorder, "check the login form first")
+	assert.Equal(t, []string{"check the login form first"}, recorder.reasoning)
+
+	RecordReasoning(ctx, NewAuditHarness(recorder), "then the API")
+	assert.Equal(t, []string{"check the login form first", "then the API"}, recorder.reasoning, "the audit wrapper forwards reasoning")
+
+	assert.NotPanics(t, func() {
+		RecordReasoning(ctx, &auditInnerHarness{}, "dropped")
+	}, "harnesses that do not record reasoning ignore it")
+}
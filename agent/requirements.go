@@ -0,0 +1,107 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// RequirementsProvider is an optional interface for agents that declare the
+// tools and plugins they depend on. Agents built with New implement it; the
+// requirements are set with Config.RequireTools and Config.RequirePlugins.
+type RequirementsProvider interface {
+	// RequiredTools returns the names of the tools the agent needs.
+	RequiredTools() []string
+
+	// RequiredPlugins returns the names of the plugins the agent needs.
+	RequiredPlugins() []string
+}
+
+// MissingRequirementsError lists the declared dependencies of an agent that
+// the harness does not provide.
+type MissingRequirementsError struct {
+	// Agent is the name of the agent.
+	Agent string
+
+	// Tools and Plugins are the missing dependencies, in declaration order.
+	Tools   []string
+	Plugins []string
+}
+
+// Error enumerates every missing dependency.
+func (e *MissingRequirementsError) Error() string {
+	var parts []string
+	if len(e.Tools) > 0 {
+		parts = append(parts, "tools "+strings.Join(e.Tools, ", "))
+	}
+	if len(e.Plugins) > 0 {
+		parts = append(parts, "plugins "+strings.Join(e.Plugins, ", "))
+	}
+	return fmt.Sprintf("agent %s is missing required %s", e.Agent, strings.Join(parts, " and "))
+}
+
+// VerifyRequirements checks that the harness provides every tool and plugin
+// the agent declares, and returns a *MissingRequirementsError listing all
+// that are missing. Agents that do not implement RequirementsProvider always
+// pass.
+//
+// Agents built with New verify their requirements before each execution and
+// report degraded health while the last check found missing dependencies.
+// Call VerifyRequirements directly to check an agent up front:
+//
+//	if err := agent.VerifyRequirements(ctx, a, harness); err != nil {
+//	    return err
+//	}
+func VerifyRequirements(ctx context.Context, a Agent, h Harness) error {
+	reqs, ok := a.(RequirementsProvider)
+	if !ok {
+		return nil
+	}
+
+	missing := &MissingRequirementsError{Agent: a.Name()}
+
+	if required := reqs.RequiredTools(); len(required) > 0 {
+		tools, err := h.ListTools(ctx)
+		if err != nil {
+			return fmt.Errorf("agent %s: list tools: %w", a.Name(), err)
+		}
+		available := make([]string, len(tools))
+		for i, t := range tools {
+			available[i] = t.Name
+		}
+		missing.Tools = missingNames(required, available)
+	}
+
+	if required := reqs.RequiredPlugins(); len(required) > 0 {
+		plugins, err := h.ListPlugins(ctx)
+		if err != nil {
+			return fmt.Errorf("agent %s: list plugins: %w", a.Name(), err)
+		}
+		available := make([]string, len(plugins))
+		for i, p := range plugins {
+			available[i] = p.Name
+		}
+		missing.Plugins = missingNames(required, available)
+	}
+
+	if sa, ok := a.(*sdkAgent); ok {
+		sa.recordMissing(missing)
+	}
+
+	if len(missing.Tools) == 0 && len(missing.Plugins) == 0 {
+		return nil
+	}
+	return missing
+}
+
+// missingNames returns the required names that are not available.
+func missingNames(required, available []string) []string {
+	var missing []string
+	for _, name := range required {
+		if !slices.Contains(available, name) && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,173 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zero-day-ai/sdk/plugin"
+	"github.com/zero-day-ai/sdk/tool"
+	"github.com/zero-day-ai/sdk/types"
+)
+
+// requirementsHarness stubs the list methods VerifyRequirements calls. Any
+// other method panics through the nil embedded Harness.
+type requirementsHarness struct {
+	Harness
+	tools   []string
+	plugins []string
+	listErr error
+	listed  bool
+}
+
+// defaultRequirementsHarness provides tool1, tool2 and plugin1.
+func defaultRequirementsHarness() *requirementsHarness {
+	return &requirementsHarness{tools: []string{"tool1", "tool2"}, plugins: []string{"plugin1"}}
+}
+
+func (h *requirementsHarness) ListTools(ctx context.Context) ([]tool.Descriptor, error) {
+	h.listed = true
+	if h.listErr != nil {
+		return nil, h.listErr
+	}
+	descs := make([]tool.Descriptor, len(h.tools))
+	for i, name := range h.tools {
+		descs[i] = tool.Descriptor{Name: name}
+	}
+	return descs, nil
+}
+
+func (h *requirementsHarness) ListPlugins(ctx context.Context) ([]plugin.Descriptor, error) {
+	h.listed = true
+	descs := make([]plugin.Descriptor, len(h.plugins))
+	for i, name := range h.plugins {
+		descs[i] = plugin.Descriptor{Name: name}
+	}
+	return descs, nil
+}
+
+// newRequiringAgent builds an agent that needs tool1, http-request and
+// plugin1.
+func newRequiringAgent(t *testing.T, executed *bool) *sdkAgent {
+	t.Helper()
+	cfg := NewConfig().
+		SetName("recon").
+		SetVersion("1.0.0").
+		SetDescription("Recon agent").
+		RequireTools("tool1", "http-request").
+		RequirePlugins("plugin1").
+		SetExecuteFunc(func(ctx context.Context, harness Harness, task Task) (Result, error) {
+			*executed = true
+			return NewSuccessResult("done"), nil
+		})
+	a, err := New(cfg)
+	require.NoError(t, err)
+	return a.(*sdkAgent)
+}
+
+func TestConfig_RequireToolsAndPlugins(t *testing.T) {
+	var executed bool
+	a := newRequiringAgent(t, &executed)
+
+	assert.Equal(t, []string{"tool1", "http-request"}, a.RequiredTools())
+	assert.Equal(t, []string{"plugin1"}, a.RequiredPlugins())
+
+	var _ RequirementsProvider = a
+}
+
+func TestVerifyRequirements_MissingOneOfThree(t *testing.T) {
+	var executed bool
+	a := newRequiringAgent(t, &executed)
+
+	err := VerifyRequirements(context.Background(), a, defaultRequirementsHarness())
+	require.Error(t, err)
+	assert.Equal(t, "agent recon is missing required tools http-request", err.Error())
+
+	var missing *MissingRequirementsError
+	require.True(t, errors.As(err, &missing))
+	assert.Equal(t, []string{"http-request"}, missing.Tools)
+	assert.Empty(t, missing.Plugins)
+}
+
+func TestVerifyRequirements_AggregatesAllMissing(t *testing.T) {
+	var executed bool
+	a := newRequiringAgent(t, &executed)
+
+	h := &requirementsHarness{}
+
+	err := VerifyRequirements(context.Background(), a, h)
+	require.Error(t, err)
+	assert.Equal(t, "agent recon is missing required tools tool1, http-request and plugins plugin1", err.Error())
+}
+
+func TestVerifyRequirements_AllPresent(t *testing.T) {
+	var executed bool
+	a := newRequiringAgent(t, &executed)
+
+	h := &requirementsHarness{tools: []string{"tool1", "http-request"}, plugins: []string{"plugin1"}}
+
+	assert.NoError(t, VerifyRequirements(context.Background(), a, h))
+}
+
+func TestVerifyRequirements_ListError(t *testing.T) {
+	var executed bool
+	a := newRequiringAgent(t, &executed)
+
+	listErr := errors.New("harness unavailable")
+	h := &requirementsHarness{listErr: listErr}
+
+	err := VerifyRequirements(context.Background(), a, h)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, listErr)
+	assert.Contains(t, err.Error(), "list tools")
+}
+
+func TestVerifyRequirements_NoRequirements(t *testing.T) {
+	a, err := New(NewConfig().
+		SetName("plain").
+		SetVersion("1.0.0").
+		SetDescription("Plain agent").
+		SetExecuteFunc(func(ctx context.Context, harness Harness, task Task) (Result, error) {
+			return NewSuccessResult("done"), nil
+		}))
+	require.NoError(t, err)
+
+	h := &requirementsHarness{}
+	assert.NoError(t, VerifyRequirements(context.Background(), a, h))
+	assert.False(t, h.listed, "nothing should be listed without requirements")
+}
+
+func TestSDKAgent_ExecuteFailsFastOnMissingRequirements(t *testing.T) {
+	var executed bool
+	a := newRequiringAgent(t, &executed)
+
+	result, err := a.Execute(context.Background(), defaultRequirementsHarness(), *NewTask("task-1"))
+	require.Error(t, err)
+	assert.False(t, executed, "execute function must not run")
+	assert.Equal(t, StatusFailed, result.Status)
+	assert.Contains(t, err.Error(), "http-request")
+}
+
+func TestSDKAgent_HealthReportsMissingRequirements(t *testing.T) {
+	var executed bool
+	a := newRequiringAgent(t, &executed)
+	ctx := context.Background()
+
+	assert.True(t, a.Health(ctx).IsHealthy(), "healthy before any check")
+
+	_ = VerifyRequirements(ctx, a, defaultRequirementsHarness())
+	status := a.Health(ctx)
+	assert.Equal(t, types.StatusDegraded, status.Status)
+	assert.Contains(t, status.Message, "http-request")
+	assert.Equal(t, []string{"http-request"}, status.Details["missing_tools"])
+
+	// Once the tool shows up the agent is healthy again
+	h := &requirementsHarness{tools: []string{"tool1", "http-request"}, plugins: []string{"plugin1"}}
+	_, err := a.Execute(ctx, h, *NewTask("task-2"))
+	require.NoError(t, err)
+	assert.True(t, executed)
+	assert.True(t, a.Health(ctx).IsHealthy())
+}
@@ -171,3 +171,38 @@ func TestResult_BackwardsCompatibility(t *testing.T) {
 		// ErrorInfo won't be populated in manual construction (backwards compat)
 	})
 }
+
+func TestNewFailedResult_Code(t *testing.T) {
+	t.Run("standard error", func(t *testing.T) {
+		testErr := errors.New("connection refused")
+		result := NewFailedResult(testErr, ErrCodeTargetUnreachable)
+
+		assert.Equal(t, testErr, result.Error)
+		require.NotNil(t, result.ErrorInfo)
+		assert.Equal(t, ErrCodeTargetUnreachable, result.ErrorInfo.Code)
+		assert.Equal(t, "connection refused", result.ErrorInfo.Message)
+		assert.True(t, result.ErrorInfo.IsRetryable())
+	})
+
+	t.Run("ResultError is not modified", func(t *testing.T) {
+		testErr := NewResultError(ErrCodeToolFailure, "nmap failed")
+		result := NewFailedResult(testErr, ErrCodeConstraintViolated)
+
+		require.NotNil(t, result.ErrorInfo)
+		assert.Equal(t, ErrCodeConstraintViolated, result.ErrorInfo.Code)
+		assert.Equal(t, "nmap failed", result.ErrorInfo.Message)
+		assert.Equal(t, ErrCodeToolFailure, testErr.Code)
+	})
+
+	t.Run("empty code keeps the error's code", func(t *testing.T) {
+		testErr := NewResultError(ErrCodeToolFailure, "nmap failed")
+		result := NewFailedResult(testErr, "")
+
+		assert.Same(t, testErr, result.ErrorInfo)
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		result := NewFailedResult(nil, ErrCodeInternal)
+		assert.Nil(t, result.ErrorInfo)
+	})
+}
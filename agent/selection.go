@@ -0,0 +1,225 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoMatchingAgent is returned by SelectDelegate when no available agent
+// satisfies the selection criteria. The returned error is a
+// *NoMatchingAgentError that explains why each candidate was rejected.
+var ErrNoMatchingAgent = errors.New("no matching agent")
+
+// AgentListTTL is how long SelectDelegate and SelectDelegates reuse the
+// agent list of a harness before calling ListAgents again.
+const AgentListTTL = 30 * time.Second
+
+// SelectionCriteria describes the agent an orchestrator wants to delegate
+// to. An agent matches when it declares every listed capability, target
+// type, and technique type (compared case-insensitively) and is not
+// excluded.
+type SelectionCriteria struct {
+	// Capabilities the agent must provide.
+	Capabilities []string
+
+	// TargetTypes the agent must support.
+	TargetTypes []string
+
+	// TechniqueTypes the agent must employ.
+	TechniqueTypes []string
+
+	// PreferNames ranks matching agents with these names first, in order.
+	// Other matches follow in name order.
+	PreferNames []string
+
+	// Exclude lists agent names that must not be selected, typically the
+	// calling agent itself.
+	Exclude []string
+
+	// Refresh bypasses the cached agent list and calls ListAgents.
+	Refresh bool
+}
+
+// Rejection records why an agent did not match the selection criteria.
+type Rejection struct {
+	// Agent is the name of the rejected agent.
+	Agent string
+
+	// Reason names the criterion that eliminated the agent, such as
+	// `missing capability "sqli"`.
+	Reason string
+}
+
+// NoMatchingAgentError reports that no agent satisfied the selection
+// criteria, with the reason each candidate was rejected.
+type NoMatchingAgentError struct {
+	// Criteria are the selection criteria that were not met.
+	Criteria SelectionCriteria
+
+	// Rejected lists every available agent in name order with the first
+	// criterion it failed.
+	Rejected []Rejection
+}
+
+// Error lists each candidate and the criterion that eliminated it.
+func (e *NoMatchingAgentError) Error() string {
+	if len(e.Rejected) == 0 {
+		return "no matching agent: no agents available"
+	}
+	reasons := make([]string, len(e.Rejected))
+	for i, r := range e.Rejected {
+		reasons[i] = r.Agent + ": " + r.Reason
+	}
+	return "no matching agent: " + strings.Join(reasons, "; ")
+}
+
+// Is reports whether target is ErrNoMatchingAgent.
+func (e *NoMatchingAgentError) Is(target error) bool {
+	return target == ErrNoMatchingAgent
+}
+
+// SelectDelegate returns the best available agent for criteria: the first
+// preferred name that matches, otherwise the matching agent first in name
+// order. It returns a *NoMatchingAgentError, which matches
+// ErrNoMatchingAgent, if no agent matches.
+//
+// Example:
+//
+//	desc, err := agent.SelectDelegate(ctx, h, agent.SelectionCriteria{
+//	    Capabilities: []string{"sql_injection"},
+//	    TargetTypes:  []string{"web"},
+//	    Exclude:      []string{"orchestrator"},
+//	})
+//	if errors.Is(err, agent.ErrNoMatchingAgent) {
+//	    h.Logger().Warn("no delegate", "reason", err)
+//	    return agent.NewFailedResult(err), nil
+//	}
+//	result, err := h.DelegateToAgent(ctx, desc.Name, subtask)
+func SelectDelegate(ctx context.Context, h Harness, criteria SelectionCriteria) (Descriptor, error) {
+	matches, err := SelectDelegates(ctx, h, criteria)
+	if err != nil {
+		return Descriptor{}, err
+	}
+	return matches[0], nil
+}
+
+// SelectDelegates returns every available agent that matches criteria,
+// ranked as SelectDelegate ranks them. It returns a *NoMatchingAgentError
+// if no agent matches.
+func SelectDelegates(ctx context.Context, h Harness, criteria SelectionCriteria) ([]Descriptor, error) {
+	agents, err := listAgentsCached(ctx, h, criteria.Refresh)
+	if err != nil {
+		return nil, fmt.Errorf("select delegate: list agents: %w", err)
+	}
+
+	agents = slices.Clone(agents)
+	sort.SliceStable(agents, func(i, j int) bool { return agents[i].Name < agents[j].Name })
+
+	var matches []Descriptor
+	noMatch := &NoMatchingAgentError{Criteria: criteria}
+	for _, desc := range agents {
+		if reason := rejectReason(desc, criteria); reason != "" {
+			noMatch.Rejected = append(noMatch.Rejected, Rejection{Agent: desc.Name, Reason: reason})
+			continue
+		}
+		matches = append(matches, desc)
+	}
+	if len(matches) == 0 {
+		return nil, noMatch
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return preferenceRank(matches[i].Name, criteria.PreferNames) < preferenceRank(matches[j].Name, criteria.PreferNames)
+	})
+	return matches, nil
+}
+
+// rejectReason returns the first criterion desc fails, or "" if it matches.
+func rejectReason(desc Descriptor, criteria SelectionCriteria) string {
+	if slices.Contains(criteria.Exclude, desc.Name) {
+		return "excluded"
+	}
+	if missing := firstMissing(criteria.Capabilities, desc.Capabilities); missing != "" {
+		return fmt.Sprintf("missing capability %q", missing)
+	}
+	if missing := firstMissing(criteria.TargetTypes, desc.TargetTypes); missing != "" {
+		return fmt.Sprintf("does not support target type %q", missing)
+	}
+	if missing := firstMissing(criteria.TechniqueTypes, desc.TechniqueTypes); missing != "" {
+		return fmt.Sprintf("does not support technique type %q", missing)
+	}
+	return ""
+}
+
+// firstMissing returns the first required value not in declared, compared
+// case-insensitively, or "" if all are declared.
+func firstMissing(required, declared []string) string {
+	for _, want := range required {
+		if !slices.ContainsFunc(declared, func(have string) bool { return strings.EqualFold(have, want) }) {
+			return want
+		}
+	}
+	return ""
+}
+
+// preferenceRank returns the position of name in preferred, or
+// len(preferred) if it is not preferred.
+func preferenceRank(name string, preferred []string) int {
+	if i := slices.Index(preferred, name); i >= 0 {
+		return i
+	}
+	return len(preferred)
+}
+
+// agentListEntry is a cached ListAgents result.
+type agentListEntry struct {
+	agents  []Descriptor
+	expires time.Time
+}
+
+// agentLists caches ListAgents results per harness for AgentListTTL.
+var agentLists = struct {
+	sync.Mutex
+	entries map[Harness]agentListEntry
+}{entries: make(map[Harness]agentListEntry)}
+
+// listAgentsCached returns the agents listed by h, reusing a list fetched
+// within AgentListTTL unless refresh is set. Harnesses whose dynamic type
+// is not comparable are never cached.
+func listAgentsCached(ctx context.Context, h Harness, refresh bool) ([]Descriptor, error) {
+	cacheable := reflect.TypeOf(h).Comparable()
+	now := time.Now()
+
+	if cacheable && !refresh {
+		agentLists.Lock()
+		entry, ok := agentLists.entries[h]
+		agentLists.Unlock()
+		if ok && now.Before(entry.expires) {
+			return entry.agents, nil
+		}
+	}
+
+	agents, err := h.ListAgents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheable {
+		agentLists.Lock()
+		for key, entry := range agentLists.entries {
+			if !now.Before(entry.expires) {
+				delete(agentLists.entries, key)
+			}
+		}
+		agentLists.entries[h] = agentListEntry{agents: agents, expires: now.Add(AgentListTTL)}
+		agentLists.Unlock()
+	}
+	return agents, nil
+}
@@ -0,0 +1,141 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// selectionHarness stubs ListAgents. Any other method panics through the
+// nil embedded Harness.
+type selectionHarness struct {
+	Harness
+	agents []Descriptor
+	err    error
+	calls  int
+}
+
+func (h *selectionHarness) ListAgents(ctx context.Context) ([]Descriptor, error) {
+	h.calls++
+	return h.agents, h.err
+}
+
+// delegateFixtures is a small fleet of agents with overlapping abilities.
+func delegateFixtures() []Descriptor {
+	return []Descriptor{
+		{Name: "web-scanner", Capabilities: []string{"xss", "sql_injection"}, TargetTypes: []string{"web"}, TechniqueTypes: []string{"T1190"}},
+		{Name: "sqli-expert", Capabilities: []string{"sql_injection"}, TargetTypes: []string{"web", "api"}, TechniqueTypes: []string{"T1190"}},
+		{Name: "recon", Capabilities: []string{"recon"}, TargetTypes: []string{"network", "web"}},
+		{Name: "orchestrator", Capabilities: []string{"sql_injection", "recon"}, TargetTypes: []string{"web"}},
+		{Name: "llm-jailbreaker", Capabilities: []string{"jailbreak"}, TargetTypes: []string{"llm"}, TechniqueTypes: []string{"AML.T0054"}},
+	}
+}
+
+func TestSelectDelegates(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria SelectionCriteria
+		want     []string
+	}{
+		{
+			name:     "capability",
+			criteria: SelectionCriteria{Capabilities: []string{"sql_injection"}},
+			want:     []string{"orchestrator", "sqli-expert", "web-scanner"},
+		},
+		{
+			name:     "multiple criteria",
+			criteria: SelectionCriteria{Capabilities: []string{"SQL_Injection"}, TargetTypes: []string{"api"}, TechniqueTypes: []string{"t1190"}},
+			want:     []string{"sqli-expert"},
+		},
+		{
+			name:     "exclude",
+			criteria: SelectionCriteria{Capabilities: []string{"sql_injection"}, Exclude: []string{"orchestrator"}},
+			want:     []string{"sqli-expert", "web-scanner"},
+		},
+		{
+			name:     "preference order",
+			criteria: SelectionCriteria{Capabilities: []string{"sql_injection"}, PreferNames: []string{"web-scanner", "missing", "sqli-expert"}},
+			want:     []string{"web-scanner", "sqli-expert", "orchestrator"},
+		},
+		{
+			name:     "no criteria",
+			criteria: SelectionCriteria{},
+			want:     []string{"llm-jailbreaker", "orchestrator", "recon", "sqli-expert", "web-scanner"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &selectionHarness{agents: delegateFixtures()}
+			matches, err := SelectDelegates(context.Background(), h, tt.criteria)
+			require.NoError(t, err)
+
+			names := make([]string, len(matches))
+			for i, m := range matches {
+				names[i] = m.Name
+			}
+			assert.Equal(t, tt.want, names)
+
+			best, err := SelectDelegate(context.Background(), h, tt.criteria)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want[0], best.Name)
+		})
+	}
+}
+
+func TestSelectDelegate_NoMatch(t *testing.T) {
+	h := &selectionHarness{agents: delegateFixtures()}
+	_, err := SelectDelegate(context.Background(), h, SelectionCriteria{
+		Capabilities:   []string{"sql_injection"},
+		TargetTypes:    []string{"api"},
+		TechniqueTypes: []string{"T1059"},
+		Exclude:        []string{"orchestrator"},
+	})
+	require.ErrorIs(t, err, ErrNoMatchingAgent)
+
+	var noMatch *NoMatchingAgentError
+	require.ErrorAs(t, err, &noMatch)
+	assert.Equal(t, []Rejection{
+		{Agent: "llm-jailbreaker", Reason: `missing capability "sql_injection"`},
+		{Agent: "orchestrator", Reason: "excluded"},
+		{Agent: "recon", Reason: `missing capability "sql_injection"`},
+		{Agent: "sqli-expert", Reason: `does not support technique type "T1059"`},
+		{Agent: "web-scanner", Reason: `does not support target type "api"`},
+	}, noMatch.Rejected)
+	assert.Equal(t, `no matching agent: llm-jailbreaker: missing capability "sql_injection"; `+
+		`orchestrator: excluded; recon: missing capability "sql_injection"; `+
+		`sqli-expert: does not support technique type "T1059"; web-scanner: does not support target type "api"`, err.Error())
+
+	_, err = SelectDelegate(context.Background(), &selectionHarness{}, SelectionCriteria{})
+	assert.EqualError(t, err, "no matching agent: no agents available")
+}
+
+func TestSelectDelegate_ListError(t *testing.T) {
+	listErr := errors.New("daemon unavailable")
+	_, err := SelectDelegate(context.Background(), &selectionHarness{err: listErr}, SelectionCriteria{})
+	assert.ErrorIs(t, err, listErr)
+	assert.NotErrorIs(t, err, ErrNoMatchingAgent)
+}
+
+func TestSelectDelegate_Cache(t *testing.T) {
+	h := &selectionHarness{agents: delegateFixtures()}
+	ctx := context.Background()
+
+	_, err := SelectDelegate(ctx, h, SelectionCriteria{Capabilities: []string{"recon"}})
+	require.NoError(t, err)
+	_, err = SelectDelegate(ctx, h, SelectionCriteria{Capabilities: []string{"xss"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, h.calls, "the agent list is reused")
+
+	h.agents = append(h.agents, Descriptor{Name: "new-agent", Capabilities: []string{"fuzzing"}})
+	_, err = SelectDelegate(ctx, h, SelectionCriteria{Capabilities: []string{"fuzzing"}})
+	assert.ErrorIs(t, err, ErrNoMatchingAgent, "the cached list does not have the new agent")
+
+	desc, err := SelectDelegate(ctx, h, SelectionCriteria{Capabilities: []string{"fuzzing"}, Refresh: true})
+	require.NoError(t, err)
+	assert.Equal(t, "new-agent", desc.Name)
+	assert.Equal(t, 2, h.calls)
+}
@@ -0,0 +1,158 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+// Candidate is a finding candidate extracted from streamed model output.
+// Start and End are byte offsets of the matched text in the accumulated
+// completion content, so Content[Start:End] is the evidence for the candidate.
+type Candidate struct {
+	// Kind classifies the candidate, e.g. "vulnerability" or "credential".
+	Kind string
+
+	// Value is the extracted value.
+	Value string
+
+	// Start is the byte offset where the candidate begins.
+	Start int
+
+	// End is the byte offset just past the end of the candidate.
+	End int
+}
+
+// CandidateExtractor scans streamed text for candidates. It receives the part
+// of the accumulated content that has not been fully scanned yet, and returns
+// the candidates found in it with offsets relative to that text. Returning
+// true stops the stream early.
+type CandidateExtractor func(accumulated string) ([]Candidate, bool)
+
+const (
+	// extractScanBytes is how much new text triggers a scan when no newline
+	// has arrived.
+	extractScanBytes = 1024
+
+	// extractOverlapBytes is how much of an unterminated line is rescanned
+	// once it grows beyond extractScanBytes, so candidates straddling the
+	// boundary are still seen.
+	extractOverlapBytes = 256
+)
+
+// StreamAndExtract streams a completion from the given slot and runs extractor
+// over the content as it arrives, so findings can be reported before the model
+// finishes. The extractor is rate-limited: it runs when a chunk completes a
+// line, when enough new text has accumulated, and once more at the end of the
+// stream. Each run only sees text from the last scanned line onward, and
+// candidates already reported at the same offsets are dropped.
+//
+// If ctx's deadline passes mid-stream, StreamAndExtract returns the candidates
+// found so far and the partial response with Partial set, and a nil error.
+// The same happens when the extractor asks to stop. Any other cancellation
+// returns the partial results together with ctx.Err().
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+//	defer cancel()
+//	candidates, resp, err := agent.StreamAndExtract(ctx, h, "primary", messages,
+//	    func(text string) ([]agent.Candidate, bool) {
+//	        return findCVEs(text), false
+//	    })
+func StreamAndExtract(ctx context.Context, h Harness, slot string, messages []llm.Message, extractor CandidateExtractor) ([]Candidate, *llm.CompletionResponse, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chunks, err := h.Stream(streamCtx, slot, messages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s := &extractScan{extractor: extractor, seen: make(map[Candidate]bool)}
+	acc := llm.NewStreamAccumulator()
+	stopped := false
+
+loop:
+	for {
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				break loop
+			}
+			acc.Add(chunk)
+			if s.due(acc.Content, chunk.Delta) && s.scan(acc.Content) {
+				stopped = true
+				break loop
+			}
+		case <-ctx.Done():
+			break loop
+		}
+	}
+
+	// Extract from whatever arrived since the last scan, whether the stream
+	// finished or was interrupted.
+	if !stopped {
+		s.scan(acc.Content)
+	}
+
+	resp := acc.ToResponse()
+	if !acc.IsComplete() && (stopped || ctx.Err() != nil) {
+		resp.FinishReason = llm.FinishReasonCancelled
+		resp.Partial = true
+		if acc.Usage == nil {
+			output := llm.EstimateTokens(resp.Content)
+			resp.Usage = llm.TokenUsage{OutputTokens: output, TotalTokens: output}
+		}
+	}
+
+	if err := ctx.Err(); err != nil && !stopped && !errors.Is(err, context.DeadlineExceeded) {
+		return s.candidates, &resp, err
+	}
+	return s.candidates, &resp, nil
+}
+
+// extractScan tracks the incremental state of StreamAndExtract.
+type extractScan struct {
+	extractor  CandidateExtractor
+	offset     int // start of the text not yet fully scanned
+	scannedTo  int // length of the content at the last scan
+	seen       map[Candidate]bool
+	candidates []Candidate
+}
+
+// due reports whether the latest chunk warrants running the extractor.
+func (s *extractScan) due(content, delta string) bool {
+	return strings.Contains(delta, "\n") || len(content)-s.scannedTo >= extractScanBytes
+}
+
+// scan runs the extractor over content[s.offset:], records new candidates
+// with absolute offsets, and advances the offset past the last complete line.
+// It returns the extractor's stop signal.
+func (s *extractScan) scan(content string) bool {
+	if len(content) == s.scannedTo {
+		return false
+	}
+	s.scannedTo = len(content)
+
+	window := content[s.offset:]
+	found, stop := s.extractor(window)
+	for _, c := range found {
+		c.Start += s.offset
+		c.End += s.offset
+		if s.seen[c] {
+			continue
+		}
+		s.seen[c] = true
+		s.candidates = append(s.candidates, c)
+	}
+
+	if i := strings.LastIndexByte(window, '\n'); i >= 0 {
+		s.offset += i + 1
+	} else if len(window) > extractScanBytes {
+		s.offset = len(content) - extractOverlapBytes
+	}
+	return stop
+}
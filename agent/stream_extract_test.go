@@ -0,0 +1,194 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+// streamHarness stubs Stream. Any other method panics through the nil
+// embedded Harness.
+type streamHarness struct {
+	Harness
+	streamFunc func(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error)
+}
+
+func (h *streamHarness) Stream(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+	return h.streamFunc(ctx, slot, messages)
+}
+
+// scriptedStream returns a stream func that sends chunks with a delay between
+// them and stops when the stream context is cancelled. The last chunk carries
+// the finish reason.
+func scriptedStream(delay time.Duration, deltas ...string) func(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+	return func(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+		ch := make(chan llm.StreamChunk)
+		go func() {
+			defer close(ch)
+			for i, delta := range deltas {
+				chunk := llm.StreamChunk{Delta: delta}
+				if i == len(deltas)-1 {
+					chunk.FinishReason = "stop"
+				}
+				select {
+				case <-time.After(delay):
+				case <-ctx.Done():
+					return
+				}
+				select {
+				case ch <- chunk:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return ch, nil
+	}
+}
+
+var cvePattern = regexp.MustCompile(`CVE-\d{4}-\d{4,}`)
+
+// cveExtractor finds CVE identifiers and records every window it was given.
+func cveExtractor(windows *[]string) CandidateExtractor {
+	return func(text string) ([]Candidate, bool) {
+		*windows = append(*windows, text)
+		var found []Candidate
+		for _, loc := range cvePattern.FindAllStringIndex(text, -1) {
+			found = append(found, Candidate{Kind: "cve", Value: text[loc[0]:loc[1]], Start: loc[0], End: loc[1]})
+		}
+		return found, false
+	}
+}
+
+func TestStreamAndExtract(t *testing.T) {
+	h := &streamHarness{streamFunc: scriptedStream(0,
+		"Host runs Apache, affected by CVE-2021-",
+		"41773.\nAlso CVE-2021-42013",
+		" applies.\n",
+		"Done.",
+	)}
+
+	var windows []string
+	candidates, resp, err := StreamAndExtract(context.Background(), h, "primary", nil, cveExtractor(&windows))
+	require.NoError(t, err)
+
+	assert.False(t, resp.Partial)
+	assert.Equal(t, "stop", resp.FinishReason)
+	require.Len(t, candidates, 2)
+	for _, c := range candidates {
+		assert.Equal(t, c.Value, resp.Content[c.Start:c.End], "offsets index the accumulated content")
+	}
+	assert.Equal(t, "CVE-2021-41773", candidates[0].Value)
+	assert.Equal(t, "CVE-2021-42013", candidates[1].Value)
+
+	// Scans run on newlines and at the end; each starts after the last
+	// complete line rather than rescanning everything.
+	assert.Equal(t, []string{
+		"Host runs Apache, affected by CVE-2021-41773.\nAlso CVE-2021-42013",
+		"Also CVE-2021-42013 applies.\n",
+		"Done.",
+	}, windows)
+}
+
+func TestStreamAndExtract_Deadline(t *testing.T) {
+	h := &streamHarness{streamFunc: scriptedStream(20*time.Millisecond,
+		"Found CVE-2023-1234\n",
+		"and CVE-2023-5678",
+		" on port 443\n",
+		"more analysis that never arrives",
+	)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var windows []string
+	candidates, resp, err := StreamAndExtract(ctx, h, "primary", nil, cveExtractor(&windows))
+	require.NoError(t, err, "a deadline returns partial results, not an error")
+
+	assert.True(t, resp.Partial)
+	assert.Equal(t, llm.FinishReasonCancelled, resp.FinishReason)
+	assert.NotContains(t, resp.Content, "never arrives")
+	assert.Positive(t, resp.Usage.OutputTokens, "usage is estimated for partial content")
+
+	require.NotEmpty(t, candidates)
+	assert.Equal(t, "CVE-2023-1234", candidates[0].Value)
+	for _, c := range candidates {
+		assert.Equal(t, c.Value, resp.Content[c.Start:c.End])
+	}
+}
+
+func TestStreamAndExtract_StopEarly(t *testing.T) {
+	h := &streamHarness{streamFunc: scriptedStream(0,
+		"line one\n",
+		"credential: admin\n",
+		"line three\n",
+	)}
+
+	candidates, resp, err := StreamAndExtract(context.Background(), h, "primary", nil, func(text string) ([]Candidate, bool) {
+		i := strings.Index(text, "admin")
+		if i < 0 {
+			return nil, false
+		}
+		return []Candidate{{Kind: "credential", Value: "admin", Start: i, End: i + len("admin")}}, true
+	})
+	require.NoError(t, err)
+
+	assert.True(t, resp.Partial)
+	assert.NotContains(t, resp.Content, "line three")
+	require.Len(t, candidates, 1)
+	assert.Equal(t, "admin", resp.Content[candidates[0].Start:candidates[0].End])
+}
+
+func TestStreamAndExtract_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &streamHarness{streamFunc: func(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+		ch := make(chan llm.StreamChunk, 1)
+		ch <- llm.StreamChunk{Delta: "CVE-2020-0001 partial"}
+		cancel()
+		return ch, nil
+	}}
+
+	var windows []string
+	candidates, resp, err := StreamAndExtract(ctx, h, "primary", nil, cveExtractor(&windows))
+	assert.ErrorIs(t, err, context.Canceled)
+	require.NotNil(t, resp)
+	assert.True(t, resp.Partial)
+	// The buffered chunk may or may not be read before cancellation is seen.
+	if resp.Content != "" {
+		require.Len(t, candidates, 1)
+	}
+}
+
+func TestStreamAndExtract_StreamError(t *testing.T) {
+	h := &streamHarness{streamFunc: func(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+		return nil, errors.New("slot unavailable")
+	}}
+
+	candidates, resp, err := StreamAndExtract(context.Background(), h, "primary", nil, func(string) ([]Candidate, bool) { return nil, false })
+	assert.EqualError(t, err, "slot unavailable")
+	assert.Nil(t, candidates)
+	assert.Nil(t, resp)
+}
+
+func TestStreamAndExtract_LongLine(t *testing.T) {
+	long := strings.Repeat("x", 1500)
+	h := &streamHarness{streamFunc: scriptedStream(0, long, " CVE-2024-9999 ", long)}
+
+	var windows []string
+	candidates, resp, err := StreamAndExtract(context.Background(), h, "primary", nil, cveExtractor(&windows))
+	require.NoError(t, err)
+
+	require.Len(t, candidates, 1)
+	assert.Equal(t, "CVE-2024-9999", resp.Content[candidates[0].Start:candidates[0].End])
+	for _, w := range windows {
+		assert.LessOrEqual(t, len(w), len(long)+extractScanBytes, "unterminated lines are not rescanned from the start")
+	}
+}
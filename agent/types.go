@@ -20,6 +20,12 @@ type Task struct {
 	// Metadata stores additional task-specific information.
 	// This can include priority, timeout, dependencies, etc.
 	Metadata map[string]any
+
+	// IdempotencyKey identifies retries of the same logical task. The
+	// orchestrator sets the same key on every attempt, so an agent wrapped
+	// with Idempotent returns its recorded result instead of repeating side
+	// effects. Empty means every execution runs.
+	IdempotencyKey string
 }
 
 // TaskConstraints defines operational limits for task execution.
@@ -70,6 +76,10 @@ type Result struct {
 	// This field is populated automatically when Fail() is called.
 	// It preserves error details across process boundaries and serialization.
 	ErrorInfo *ResultError `json:"error,omitempty"`
+
+	// RefusalReason is a machine-readable reason for a refused task, such as
+	// "out_of_scope". It is set only when Status is StatusRefused.
+	RefusalReason string `json:"refusal_reason,omitempty"`
 }
 
 // ResultStatus indicates the outcome of task execution.
@@ -91,6 +101,11 @@ const (
 
 	// StatusTimeout indicates the task exceeded time or resource limits.
 	StatusTimeout ResultStatus = "timeout"
+
+	// StatusRefused indicates the agent deliberately declined the task, for
+	// example because it was unsafe or out of scope. Unlike StatusFailed, it
+	// means the agent behaved as intended.
+	StatusRefused ResultStatus = "refused"
 )
 
 // String returns the string representation of the result status.
@@ -101,7 +116,7 @@ func (s ResultStatus) String() string {
 // IsValid checks if the result status is a recognized value.
 func (s ResultStatus) IsValid() bool {
 	switch s {
-	case StatusSuccess, StatusFailed, StatusPartial, StatusCancelled, StatusTimeout:
+	case StatusSuccess, StatusFailed, StatusPartial, StatusCancelled, StatusTimeout, StatusRefused:
 		return true
 	default:
 		return false
@@ -208,17 +223,38 @@ func NewSuccessResult(output any) Result {
 	}
 }
 
-// NewFailedResult creates a failed result with the given error.
-func NewFailedResult(err error) Result {
+// NewFailedResult creates a failed result with the given error. An optional
+// code (e.g. ErrCodeTargetUnreachable) classifies the failure in ErrorInfo;
+// without one, the code of a ResultError is kept and other errors get
+// ErrCodeUnknown.
+//
+// Example:
+//
+//	if err := scan(ctx, target); err != nil {
+//	    return agent.NewFailedResult(err, agent.ErrCodeTargetUnreachable), err
+//	}
+func NewFailedResult(err error, code ...string) Result {
 	return Result{
 		Status:    StatusFailed,
 		Error:     err,
-		ErrorInfo: FromError(err),
+		ErrorInfo: errorInfo(err, code),
 		Findings:  []string{},
 		Metadata:  make(map[string]any),
 	}
 }
 
+// errorInfo converts err to a ResultError, replacing its code with the first
+// of code if one is given. A ResultError passed in is not modified.
+func errorInfo(err error, code []string) *ResultError {
+	info := FromError(err)
+	if info == nil || len(code) == 0 || code[0] == "" || code[0] == info.Code {
+		return info
+	}
+	coded := *info
+	coded.Code = code[0]
+	return &coded
+}
+
 // NewPartialResult creates a partial result with the given output and error.
 func NewPartialResult(output any, err error) Result {
 	return Result{
@@ -249,6 +285,24 @@ func NewTimeoutResult() Result {
 	}
 }
 
+// NewRefusedResult creates a refused result with the given machine-readable
+// reason. Use it when the agent declines an unsafe or out-of-scope task, so
+// the refusal is distinguishable from a failure.
+//
+// Example:
+//
+//	if !scope.Allows(target) {
+//	    return agent.NewRefusedResult("out_of_scope"), nil
+//	}
+func NewRefusedResult(reason string) Result {
+	return Result{
+		Status:        StatusRefused,
+		RefusalReason: reason,
+		Findings:      []string{},
+		Metadata:      make(map[string]any),
+	}
+}
+
 // AddFinding adds a finding ID to the result.
 func (r *Result) AddFinding(findingID string) {
 	if r.Findings == nil {
@@ -17,6 +17,7 @@ func TestResultStatus(t *testing.T) {
 		{"partial", StatusPartial, true, "partial"},
 		{"cancelled", StatusCancelled, true, "cancelled"},
 		{"timeout", StatusTimeout, true, "timeout"},
+		{"refused", StatusRefused, true, "refused"},
 		{"invalid", ResultStatus("invalid"), false, "invalid"},
 	}
 
@@ -34,7 +35,7 @@ func TestResultStatus(t *testing.T) {
 
 func TestResultStatus_IsTerminal(t *testing.T) {
 	statuses := []ResultStatus{
-		StatusSuccess, StatusFailed, StatusPartial, StatusCancelled, StatusTimeout,
+		StatusSuccess, StatusFailed, StatusPartial, StatusCancelled, StatusTimeout, StatusRefused,
 	}
 
 	for _, status := range statuses {
@@ -56,6 +57,7 @@ func TestResultStatus_IsSuccessful(t *testing.T) {
 		{StatusFailed, false},
 		{StatusCancelled, false},
 		{StatusTimeout, false},
+		{StatusRefused, false},
 	}
 
 	for _, tt := range tests {
@@ -319,6 +321,26 @@ func TestNewTimeoutResult(t *testing.T) {
 	}
 }
 
+func TestNewRefusedResult(t *testing.T) {
+	result := NewRefusedResult("out_of_scope")
+
+	if result.Status != StatusRefused {
+		t.Errorf("Status = %v, want %v", result.Status, StatusRefused)
+	}
+	if result.RefusalReason != "out_of_scope" {
+		t.Errorf("RefusalReason = %q, want %q", result.RefusalReason, "out_of_scope")
+	}
+	if result.Error != nil || result.ErrorInfo != nil {
+		t.Error("refusal should not carry an error")
+	}
+	if result.Findings == nil {
+		t.Error("Findings should be initialized")
+	}
+	if result.Metadata == nil {
+		t.Error("Metadata should be initialized")
+	}
+}
+
 func TestResult_AddFinding(t *testing.T) {
 	result := NewSuccessResult(nil)
 
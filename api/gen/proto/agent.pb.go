@@ -238,9 +238,11 @@ type AgentDescriptor struct {
 	TargetSchemas  []*TargetSchemaProto   `protobuf:"bytes,5,rep,name=target_schemas,json=targetSchemas,proto3" json:"target_schemas,omitempty"`
 	TechniqueTypes []string               `protobuf:"bytes,6,rep,name=technique_types,json=techniqueTypes,proto3" json:"technique_types,omitempty"`
 	// Deprecated: Marked as deprecated in agent.proto.
-	TargetTypes   []string `protobuf:"bytes,7,rep,name=target_types,json=targetTypes,proto3" json:"target_types,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	TargetTypes     []string `protobuf:"bytes,7,rep,name=target_types,json=targetTypes,proto3" json:"target_types,omitempty"`
+	RequiredTools   []string `protobuf:"bytes,8,rep,name=required_tools,json=requiredTools,proto3" json:"required_tools,omitempty"`
+	RequiredPlugins []string `protobuf:"bytes,9,rep,name=required_plugins,json=requiredPlugins,proto3" json:"required_plugins,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AgentDescriptor) Reset() {
@@ -323,6 +325,20 @@ func (x *AgentDescriptor) GetTargetTypes() []string {
 	return nil
 }
 
+func (x *AgentDescriptor) GetRequiredTools() []string {
+	if x != nil {
+		return x.RequiredTools
+	}
+	return nil
+}
+
+func (x *AgentDescriptor) GetRequiredPlugins() []string {
+	if x != nil {
+		return x.RequiredPlugins
+	}
+	return nil
+}
+
 type AgentSlotDefinition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -599,6 +615,88 @@ func (x *AgentGetSlotSchemaResponse) GetSlots() []*AgentSlotDefinition {
 	return nil
 }
 
+// Target schemas the agent validates incoming targets against, for
+// rendering target configuration forms.
+type AgentDescribeTargetSchemasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentDescribeTargetSchemasRequest) Reset() {
+	*x = AgentDescribeTargetSchemasRequest{}
+	mi := &file_agent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentDescribeTargetSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentDescribeTargetSchemasRequest) ProtoMessage() {}
+
+func (x *AgentDescribeTargetSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentDescribeTargetSchemasRequest.ProtoReflect.Descriptor instead.
+func (*AgentDescribeTargetSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{8}
+}
+
+type AgentDescribeTargetSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetSchemas []*TargetSchemaProto   `protobuf:"bytes,1,rep,name=target_schemas,json=targetSchemas,proto3" json:"target_schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentDescribeTargetSchemasResponse) Reset() {
+	*x = AgentDescribeTargetSchemasResponse{}
+	mi := &file_agent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentDescribeTargetSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentDescribeTargetSchemasResponse) ProtoMessage() {}
+
+func (x *AgentDescribeTargetSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentDescribeTargetSchemasResponse.ProtoReflect.Descriptor instead.
+func (*AgentDescribeTargetSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AgentDescribeTargetSchemasResponse) GetTargetSchemas() []*TargetSchemaProto {
+	if x != nil {
+		return x.TargetSchemas
+	}
+	return nil
+}
+
 type AgentExecuteRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	Task      *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
@@ -633,7 +731,7 @@ type AgentExecuteRequest struct {
 
 func (x *AgentExecuteRequest) Reset() {
 	*x = AgentExecuteRequest{}
-	mi := &file_agent_proto_msgTypes[8]
+	mi := &file_agent_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +743,7 @@ func (x *AgentExecuteRequest) String() string {
 func (*AgentExecuteRequest) ProtoMessage() {}
 
 func (x *AgentExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[8]
+	mi := &file_agent_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +756,7 @@ func (x *AgentExecuteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentExecuteRequest.ProtoReflect.Descriptor instead.
 func (*AgentExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{8}
+	return file_agent_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AgentExecuteRequest) GetTask() *Task {
@@ -748,7 +846,7 @@ type AgentExecuteResponse struct {
 
 func (x *AgentExecuteResponse) Reset() {
 	*x = AgentExecuteResponse{}
-	mi := &file_agent_proto_msgTypes[9]
+	mi := &file_agent_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -760,7 +858,7 @@ func (x *AgentExecuteResponse) String() string {
 func (*AgentExecuteResponse) ProtoMessage() {}
 
 func (x *AgentExecuteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[9]
+	mi := &file_agent_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +871,7 @@ func (x *AgentExecuteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentExecuteResponse.ProtoReflect.Descriptor instead.
 func (*AgentExecuteResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{9}
+	return file_agent_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AgentExecuteResponse) GetResult() *Result {
@@ -798,7 +896,7 @@ type AgentHealthRequest struct {
 
 func (x *AgentHealthRequest) Reset() {
 	*x = AgentHealthRequest{}
-	mi := &file_agent_proto_msgTypes[10]
+	mi := &file_agent_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +908,7 @@ func (x *AgentHealthRequest) String() string {
 func (*AgentHealthRequest) ProtoMessage() {}
 
 func (x *AgentHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[10]
+	mi := &file_agent_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +921,7 @@ func (x *AgentHealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentHealthRequest.ProtoReflect.Descriptor instead.
 func (*AgentHealthRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{10}
+	return file_agent_proto_rawDescGZIP(), []int{12}
 }
 
 // Client -> Agent messages
@@ -843,7 +941,7 @@ type ClientMessage struct {
 
 func (x *ClientMessage) Reset() {
 	*x = ClientMessage{}
-	mi := &file_agent_proto_msgTypes[11]
+	mi := &file_agent_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -855,7 +953,7 @@ func (x *ClientMessage) String() string {
 func (*ClientMessage) ProtoMessage() {}
 
 func (x *ClientMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[11]
+	mi := &file_agent_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +966,7 @@ func (x *ClientMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientMessage.ProtoReflect.Descriptor instead.
 func (*ClientMessage) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{11}
+	return file_agent_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ClientMessage) GetPayload() isClientMessage_Payload {
@@ -987,7 +1085,7 @@ type StartExecutionRequest struct {
 
 func (x *StartExecutionRequest) Reset() {
 	*x = StartExecutionRequest{}
-	mi := &file_agent_proto_msgTypes[12]
+	mi := &file_agent_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -999,7 +1097,7 @@ func (x *StartExecutionRequest) String() string {
 func (*StartExecutionRequest) ProtoMessage() {}
 
 func (x *StartExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[12]
+	mi := &file_agent_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,7 +1110,7 @@ func (x *StartExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartExecutionRequest.ProtoReflect.Descriptor instead.
 func (*StartExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{12}
+	return file_agent_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StartExecutionRequest) GetTask() *Task {
@@ -1103,7 +1201,7 @@ type SteeringMessage struct {
 
 func (x *SteeringMessage) Reset() {
 	*x = SteeringMessage{}
-	mi := &file_agent_proto_msgTypes[13]
+	mi := &file_agent_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1115,7 +1213,7 @@ func (x *SteeringMessage) String() string {
 func (*SteeringMessage) ProtoMessage() {}
 
 func (x *SteeringMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[13]
+	mi := &file_agent_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1226,7 @@ func (x *SteeringMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SteeringMessage.ProtoReflect.Descriptor instead.
 func (*SteeringMessage) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{13}
+	return file_agent_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SteeringMessage) GetId() string {
@@ -1161,7 +1259,7 @@ type InterruptRequest struct {
 
 func (x *InterruptRequest) Reset() {
 	*x = InterruptRequest{}
-	mi := &file_agent_proto_msgTypes[14]
+	mi := &file_agent_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1173,7 +1271,7 @@ func (x *InterruptRequest) String() string {
 func (*InterruptRequest) ProtoMessage() {}
 
 func (x *InterruptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[14]
+	mi := &file_agent_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,7 +1284,7 @@ func (x *InterruptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterruptRequest.ProtoReflect.Descriptor instead.
 func (*InterruptRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{14}
+	return file_agent_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *InterruptRequest) GetReason() string {
@@ -1205,7 +1303,7 @@ type SetModeRequest struct {
 
 func (x *SetModeRequest) Reset() {
 	*x = SetModeRequest{}
-	mi := &file_agent_proto_msgTypes[15]
+	mi := &file_agent_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1217,7 +1315,7 @@ func (x *SetModeRequest) String() string {
 func (*SetModeRequest) ProtoMessage() {}
 
 func (x *SetModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[15]
+	mi := &file_agent_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +1328,7 @@ func (x *SetModeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetModeRequest.ProtoReflect.Descriptor instead.
 func (*SetModeRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{15}
+	return file_agent_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SetModeRequest) GetMode() AgentMode {
@@ -1249,7 +1347,7 @@ type ResumeRequest struct {
 
 func (x *ResumeRequest) Reset() {
 	*x = ResumeRequest{}
-	mi := &file_agent_proto_msgTypes[16]
+	mi := &file_agent_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1261,7 +1359,7 @@ func (x *ResumeRequest) String() string {
 func (*ResumeRequest) ProtoMessage() {}
 
 func (x *ResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[16]
+	mi := &file_agent_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1372,7 @@ func (x *ResumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeRequest.ProtoReflect.Descriptor instead.
 func (*ResumeRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{16}
+	return file_agent_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ResumeRequest) GetGuidance() string {
@@ -1307,7 +1405,7 @@ type AgentMessage struct {
 
 func (x *AgentMessage) Reset() {
 	*x = AgentMessage{}
-	mi := &file_agent_proto_msgTypes[17]
+	mi := &file_agent_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1319,7 +1417,7 @@ func (x *AgentMessage) String() string {
 func (*AgentMessage) ProtoMessage() {}
 
 func (x *AgentMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[17]
+	mi := &file_agent_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,7 +1430,7 @@ func (x *AgentMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentMessage.ProtoReflect.Descriptor instead.
 func (*AgentMessage) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{17}
+	return file_agent_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AgentMessage) GetPayload() isAgentMessage_Payload {
@@ -1489,7 +1587,7 @@ type OutputChunk struct {
 
 func (x *OutputChunk) Reset() {
 	*x = OutputChunk{}
-	mi := &file_agent_proto_msgTypes[18]
+	mi := &file_agent_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1501,7 +1599,7 @@ func (x *OutputChunk) String() string {
 func (*OutputChunk) ProtoMessage() {}
 
 func (x *OutputChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[18]
+	mi := &file_agent_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +1612,7 @@ func (x *OutputChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutputChunk.ProtoReflect.Descriptor instead.
 func (*OutputChunk) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{18}
+	return file_agent_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *OutputChunk) GetContent() string {
@@ -1542,7 +1640,7 @@ type ToolCallEvent struct {
 
 func (x *ToolCallEvent) Reset() {
 	*x = ToolCallEvent{}
-	mi := &file_agent_proto_msgTypes[19]
+	mi := &file_agent_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1554,7 +1652,7 @@ func (x *ToolCallEvent) String() string {
 func (*ToolCallEvent) ProtoMessage() {}
 
 func (x *ToolCallEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[19]
+	mi := &file_agent_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,7 +1665,7 @@ func (x *ToolCallEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCallEvent.ProtoReflect.Descriptor instead.
 func (*ToolCallEvent) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{19}
+	return file_agent_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ToolCallEvent) GetToolName() string {
@@ -1602,7 +1700,7 @@ type ToolResultEvent struct {
 
 func (x *ToolResultEvent) Reset() {
 	*x = ToolResultEvent{}
-	mi := &file_agent_proto_msgTypes[20]
+	mi := &file_agent_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1614,7 +1712,7 @@ func (x *ToolResultEvent) String() string {
 func (*ToolResultEvent) ProtoMessage() {}
 
 func (x *ToolResultEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[20]
+	mi := &file_agent_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,7 +1725,7 @@ func (x *ToolResultEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResultEvent.ProtoReflect.Descriptor instead.
 func (*ToolResultEvent) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{20}
+	return file_agent_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ToolResultEvent) GetCallId() string {
@@ -1660,7 +1758,7 @@ type FindingEvent struct {
 
 func (x *FindingEvent) Reset() {
 	*x = FindingEvent{}
-	mi := &file_agent_proto_msgTypes[21]
+	mi := &file_agent_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1672,7 +1770,7 @@ func (x *FindingEvent) String() string {
 func (*FindingEvent) ProtoMessage() {}
 
 func (x *FindingEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[21]
+	mi := &file_agent_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1685,7 +1783,7 @@ func (x *FindingEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindingEvent.ProtoReflect.Descriptor instead.
 func (*FindingEvent) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{21}
+	return file_agent_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *FindingEvent) GetFinding() *Finding {
@@ -1705,7 +1803,7 @@ type StatusChange struct {
 
 func (x *StatusChange) Reset() {
 	*x = StatusChange{}
-	mi := &file_agent_proto_msgTypes[22]
+	mi := &file_agent_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1717,7 +1815,7 @@ func (x *StatusChange) String() string {
 func (*StatusChange) ProtoMessage() {}
 
 func (x *StatusChange) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[22]
+	mi := &file_agent_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,7 +1828,7 @@ func (x *StatusChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
 func (*StatusChange) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{22}
+	return file_agent_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *StatusChange) GetStatus() AgentStatus {
@@ -1757,7 +1855,7 @@ type SteeringAck struct {
 
 func (x *SteeringAck) Reset() {
 	*x = SteeringAck{}
-	mi := &file_agent_proto_msgTypes[23]
+	mi := &file_agent_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1769,7 +1867,7 @@ func (x *SteeringAck) String() string {
 func (*SteeringAck) ProtoMessage() {}
 
 func (x *SteeringAck) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[23]
+	mi := &file_agent_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,7 +1880,7 @@ func (x *SteeringAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SteeringAck.ProtoReflect.Descriptor instead.
 func (*SteeringAck) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{23}
+	return file_agent_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SteeringAck) GetMessageId() string {
@@ -1810,7 +1908,7 @@ type ErrorEvent struct {
 
 func (x *ErrorEvent) Reset() {
 	*x = ErrorEvent{}
-	mi := &file_agent_proto_msgTypes[24]
+	mi := &file_agent_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1822,7 +1920,7 @@ func (x *ErrorEvent) String() string {
 func (*ErrorEvent) ProtoMessage() {}
 
 func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[24]
+	mi := &file_agent_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1835,7 +1933,7 @@ func (x *ErrorEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorEvent.ProtoReflect.Descriptor instead.
 func (*ErrorEvent) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{24}
+	return file_agent_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ErrorEvent) GetCode() ErrorCode {
@@ -1870,7 +1968,7 @@ const file_agent_proto_rawDesc = "" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1f\n" +
 	"\vschema_json\x18\x03 \x01(\tR\n" +
 	"schemaJson\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"\x9d\x02\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xef\x02\n" +
 	"\x0fAgentDescriptor\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12 \n" +
@@ -1878,7 +1976,9 @@ const file_agent_proto_rawDesc = "" +
 	"\fcapabilities\x18\x04 \x03(\tR\fcapabilities\x12F\n" +
 	"\x0etarget_schemas\x18\x05 \x03(\v2\x1f.gibson.agent.TargetSchemaProtoR\rtargetSchemas\x12'\n" +
 	"\x0ftechnique_types\x18\x06 \x03(\tR\x0etechniqueTypes\x12%\n" +
-	"\ftarget_types\x18\a \x03(\tB\x02\x18\x01R\vtargetTypes\"\xf3\x01\n" +
+	"\ftarget_types\x18\a \x03(\tB\x02\x18\x01R\vtargetTypes\x12%\n" +
+	"\x0erequired_tools\x18\b \x03(\tR\rrequiredTools\x12)\n" +
+	"\x10required_plugins\x18\t \x03(\tR\x0frequiredPlugins\"\xf3\x01\n" +
 	"\x13AgentSlotDefinition\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
@@ -1896,7 +1996,10 @@ const file_agent_proto_rawDesc = "" +
 	"\x11required_features\x18\x02 \x03(\tR\x10requiredFeatures\"\x1b\n" +
 	"\x19AgentGetSlotSchemaRequest\"U\n" +
 	"\x1aAgentGetSlotSchemaResponse\x127\n" +
-	"\x05slots\x18\x01 \x03(\v2!.gibson.agent.AgentSlotDefinitionR\x05slots\"\xbc\x03\n" +
+	"\x05slots\x18\x01 \x03(\v2!.gibson.agent.AgentSlotDefinitionR\x05slots\"#\n" +
+	"!AgentDescribeTargetSchemasRequest\"l\n" +
+	"\"AgentDescribeTargetSchemasResponse\x12F\n" +
+	"\x0etarget_schemas\x18\x01 \x03(\v2\x1f.gibson.agent.TargetSchemaProtoR\rtargetSchemas\"\xbc\x03\n" +
 	"\x13AgentExecuteRequest\x12&\n" +
 	"\x04task\x18\x01 \x01(\v2\x12.gibson.types.TaskR\x04task\x12\x1d\n" +
 	"\n" +
@@ -2005,10 +2108,11 @@ const file_agent_proto_rawDesc = "" +
 	"\x1eAGENT_STATUS_WAITING_FOR_INPUT\x10\x02\x12\x1c\n" +
 	"\x18AGENT_STATUS_INTERRUPTED\x10\x03\x12\x1a\n" +
 	"\x16AGENT_STATUS_COMPLETED\x10\x04\x12\x17\n" +
-	"\x13AGENT_STATUS_FAILED\x10\x052\xb4\x03\n" +
+	"\x13AGENT_STATUS_FAILED\x10\x052\xb0\x04\n" +
 	"\fAgentService\x12W\n" +
 	"\rGetDescriptor\x12'.gibson.agent.AgentGetDescriptorRequest\x1a\x1d.gibson.agent.AgentDescriptor\x12b\n" +
-	"\rGetSlotSchema\x12'.gibson.agent.AgentGetSlotSchemaRequest\x1a(.gibson.agent.AgentGetSlotSchemaResponse\x12P\n" +
+	"\rGetSlotSchema\x12'.gibson.agent.AgentGetSlotSchemaRequest\x1a(.gibson.agent.AgentGetSlotSchemaResponse\x12z\n" +
+	"\x15DescribeTargetSchemas\x12/.gibson.agent.AgentDescribeTargetSchemasRequest\x1a0.gibson.agent.AgentDescribeTargetSchemasResponse\x12P\n" +
 	"\aExecute\x12!.gibson.agent.AgentExecuteRequest\x1a\".gibson.agent.AgentExecuteResponse\x12G\n" +
 	"\x06Health\x12 .gibson.agent.AgentHealthRequest\x1a\x1b.gibson.common.HealthStatus\x12L\n" +
 	"\rStreamExecute\x12\x1b.gibson.agent.ClientMessage\x1a\x1a.gibson.agent.AgentMessage(\x010\x01B*Z(github.com/zero-day-ai/sdk/api/gen/protob\x06proto3"
@@ -2026,95 +2130,100 @@ func file_agent_proto_rawDescGZIP() []byte {
 }
 
 var file_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_agent_proto_goTypes = []any{
-	(AgentMode)(0),                     // 0: gibson.agent.AgentMode
-	(AgentStatus)(0),                   // 1: gibson.agent.AgentStatus
-	(*AgentGetDescriptorRequest)(nil),  // 2: gibson.agent.AgentGetDescriptorRequest
-	(*TargetSchemaProto)(nil),          // 3: gibson.agent.TargetSchemaProto
-	(*AgentDescriptor)(nil),            // 4: gibson.agent.AgentDescriptor
-	(*AgentSlotDefinition)(nil),        // 5: gibson.agent.AgentSlotDefinition
-	(*AgentSlotConfig)(nil),            // 6: gibson.agent.AgentSlotConfig
-	(*AgentSlotConstraints)(nil),       // 7: gibson.agent.AgentSlotConstraints
-	(*AgentGetSlotSchemaRequest)(nil),  // 8: gibson.agent.AgentGetSlotSchemaRequest
-	(*AgentGetSlotSchemaResponse)(nil), // 9: gibson.agent.AgentGetSlotSchemaResponse
-	(*AgentExecuteRequest)(nil),        // 10: gibson.agent.AgentExecuteRequest
-	(*AgentExecuteResponse)(nil),       // 11: gibson.agent.AgentExecuteResponse
-	(*AgentHealthRequest)(nil),         // 12: gibson.agent.AgentHealthRequest
-	(*ClientMessage)(nil),              // 13: gibson.agent.ClientMessage
-	(*StartExecutionRequest)(nil),      // 14: gibson.agent.StartExecutionRequest
-	(*SteeringMessage)(nil),            // 15: gibson.agent.SteeringMessage
-	(*InterruptRequest)(nil),           // 16: gibson.agent.InterruptRequest
-	(*SetModeRequest)(nil),             // 17: gibson.agent.SetModeRequest
-	(*ResumeRequest)(nil),              // 18: gibson.agent.ResumeRequest
-	(*AgentMessage)(nil),               // 19: gibson.agent.AgentMessage
-	(*OutputChunk)(nil),                // 20: gibson.agent.OutputChunk
-	(*ToolCallEvent)(nil),              // 21: gibson.agent.ToolCallEvent
-	(*ToolResultEvent)(nil),            // 22: gibson.agent.ToolResultEvent
-	(*FindingEvent)(nil),               // 23: gibson.agent.FindingEvent
-	(*StatusChange)(nil),               // 24: gibson.agent.StatusChange
-	(*SteeringAck)(nil),                // 25: gibson.agent.SteeringAck
-	(*ErrorEvent)(nil),                 // 26: gibson.agent.ErrorEvent
-	nil,                                // 27: gibson.agent.SteeringMessage.MetadataEntry
-	nil,                                // 28: gibson.agent.ToolCallEvent.InputEntry
-	(*Task)(nil),                       // 29: gibson.types.Task
-	(*TypedMap)(nil),                   // 30: gibson.common.TypedMap
-	(*Result)(nil),                     // 31: gibson.types.Result
-	(*Error)(nil),                      // 32: gibson.common.Error
-	(*TypedValue)(nil),                 // 33: gibson.common.TypedValue
-	(*Finding)(nil),                    // 34: gibson.types.Finding
-	(ErrorCode)(0),                     // 35: gibson.common.ErrorCode
-	(*HealthStatus)(nil),               // 36: gibson.common.HealthStatus
+	(AgentMode)(0),                             // 0: gibson.agent.AgentMode
+	(AgentStatus)(0),                           // 1: gibson.agent.AgentStatus
+	(*AgentGetDescriptorRequest)(nil),          // 2: gibson.agent.AgentGetDescriptorRequest
+	(*TargetSchemaProto)(nil),                  // 3: gibson.agent.TargetSchemaProto
+	(*AgentDescriptor)(nil),                    // 4: gibson.agent.AgentDescriptor
+	(*AgentSlotDefinition)(nil),                // 5: gibson.agent.AgentSlotDefinition
+	(*AgentSlotConfig)(nil),                    // 6: gibson.agent.AgentSlotConfig
+	(*AgentSlotConstraints)(nil),               // 7: gibson.agent.AgentSlotConstraints
+	(*AgentGetSlotSchemaRequest)(nil),          // 8: gibson.agent.AgentGetSlotSchemaRequest
+	(*AgentGetSlotSchemaResponse)(nil),         // 9: gibson.agent.AgentGetSlotSchemaResponse
+	(*AgentDescribeTargetSchemasRequest)(nil),  // 10: gibson.agent.AgentDescribeTargetSchemasRequest
+	(*AgentDescribeTargetSchemasResponse)(nil), // 11: gibson.agent.AgentDescribeTargetSchemasResponse
+	(*AgentExecuteRequest)(nil),                // 12: gibson.agent.AgentExecuteRequest
+	(*AgentExecuteResponse)(nil),               // 13: gibson.agent.AgentExecuteResponse
+	(*AgentHealthRequest)(nil),                 // 14: gibson.agent.AgentHealthRequest
+	(*ClientMessage)(nil),                      // 15: gibson.agent.ClientMessage
+	(*StartExecutionRequest)(nil),              // 16: gibson.agent.StartExecutionRequest
+	(*SteeringMessage)(nil),                    // 17: gibson.agent.SteeringMessage
+	(*InterruptRequest)(nil),                   // 18: gibson.agent.InterruptRequest
+	(*SetModeRequest)(nil),                     // 19: gibson.agent.SetModeRequest
+	(*ResumeRequest)(nil),                      // 20: gibson.agent.ResumeRequest
+	(*AgentMessage)(nil),                       // 21: gibson.agent.AgentMessage
+	(*OutputChunk)(nil),                        // 22: gibson.agent.OutputChunk
+	(*ToolCallEvent)(nil),                      // 23: gibson.agent.ToolCallEvent
+	(*ToolResultEvent)(nil),                    // 24: gibson.agent.ToolResultEvent
+	(*FindingEvent)(nil),                       // 25: gibson.agent.FindingEvent
+	(*StatusChange)(nil),                       // 26: gibson.agent.StatusChange
+	(*SteeringAck)(nil),                        // 27: gibson.agent.SteeringAck
+	(*ErrorEvent)(nil),                         // 28: gibson.agent.ErrorEvent
+	nil,                                        // 29: gibson.agent.SteeringMessage.MetadataEntry
+	nil,                                        // 30: gibson.agent.ToolCallEvent.InputEntry
+	(*Task)(nil),                               // 31: gibson.types.Task
+	(*TypedMap)(nil),                           // 32: gibson.common.TypedMap
+	(*Result)(nil),                             // 33: gibson.types.Result
+	(*Error)(nil),                              // 34: gibson.common.Error
+	(*TypedValue)(nil),                         // 35: gibson.common.TypedValue
+	(*Finding)(nil),                            // 36: gibson.types.Finding
+	(ErrorCode)(0),                             // 37: gibson.common.ErrorCode
+	(*HealthStatus)(nil),                       // 38: gibson.common.HealthStatus
 }
 var file_agent_proto_depIdxs = []int32{
 	3,  // 0: gibson.agent.AgentDescriptor.target_schemas:type_name -> gibson.agent.TargetSchemaProto
 	6,  // 1: gibson.agent.AgentSlotDefinition.default_config:type_name -> gibson.agent.AgentSlotConfig
 	7,  // 2: gibson.agent.AgentSlotDefinition.constraints:type_name -> gibson.agent.AgentSlotConstraints
 	5,  // 3: gibson.agent.AgentGetSlotSchemaResponse.slots:type_name -> gibson.agent.AgentSlotDefinition
-	29, // 4: gibson.agent.AgentExecuteRequest.task:type_name -> gibson.types.Task
-	30, // 5: gibson.agent.AgentExecuteRequest.mission:type_name -> gibson.common.TypedMap
-	30, // 6: gibson.agent.AgentExecuteRequest.target:type_name -> gibson.common.TypedMap
-	31, // 7: gibson.agent.AgentExecuteResponse.result:type_name -> gibson.types.Result
-	32, // 8: gibson.agent.AgentExecuteResponse.error:type_name -> gibson.common.Error
-	14, // 9: gibson.agent.ClientMessage.start:type_name -> gibson.agent.StartExecutionRequest
-	15, // 10: gibson.agent.ClientMessage.steering:type_name -> gibson.agent.SteeringMessage
-	16, // 11: gibson.agent.ClientMessage.interrupt:type_name -> gibson.agent.InterruptRequest
-	17, // 12: gibson.agent.ClientMessage.set_mode:type_name -> gibson.agent.SetModeRequest
-	18, // 13: gibson.agent.ClientMessage.resume:type_name -> gibson.agent.ResumeRequest
-	29, // 14: gibson.agent.StartExecutionRequest.task:type_name -> gibson.types.Task
-	0,  // 15: gibson.agent.StartExecutionRequest.initial_mode:type_name -> gibson.agent.AgentMode
-	30, // 16: gibson.agent.StartExecutionRequest.mission:type_name -> gibson.common.TypedMap
-	30, // 17: gibson.agent.StartExecutionRequest.target:type_name -> gibson.common.TypedMap
-	27, // 18: gibson.agent.SteeringMessage.metadata:type_name -> gibson.agent.SteeringMessage.MetadataEntry
-	0,  // 19: gibson.agent.SetModeRequest.mode:type_name -> gibson.agent.AgentMode
-	20, // 20: gibson.agent.AgentMessage.output:type_name -> gibson.agent.OutputChunk
-	21, // 21: gibson.agent.AgentMessage.tool_call:type_name -> gibson.agent.ToolCallEvent
-	22, // 22: gibson.agent.AgentMessage.tool_result:type_name -> gibson.agent.ToolResultEvent
-	23, // 23: gibson.agent.AgentMessage.finding:type_name -> gibson.agent.FindingEvent
-	24, // 24: gibson.agent.AgentMessage.status:type_name -> gibson.agent.StatusChange
-	25, // 25: gibson.agent.AgentMessage.steering_ack:type_name -> gibson.agent.SteeringAck
-	26, // 26: gibson.agent.AgentMessage.error:type_name -> gibson.agent.ErrorEvent
-	28, // 27: gibson.agent.ToolCallEvent.input:type_name -> gibson.agent.ToolCallEvent.InputEntry
-	33, // 28: gibson.agent.ToolResultEvent.output:type_name -> gibson.common.TypedValue
-	34, // 29: gibson.agent.FindingEvent.finding:type_name -> gibson.types.Finding
-	1,  // 30: gibson.agent.StatusChange.status:type_name -> gibson.agent.AgentStatus
-	35, // 31: gibson.agent.ErrorEvent.code:type_name -> gibson.common.ErrorCode
-	33, // 32: gibson.agent.ToolCallEvent.InputEntry.value:type_name -> gibson.common.TypedValue
-	2,  // 33: gibson.agent.AgentService.GetDescriptor:input_type -> gibson.agent.AgentGetDescriptorRequest
-	8,  // 34: gibson.agent.AgentService.GetSlotSchema:input_type -> gibson.agent.AgentGetSlotSchemaRequest
-	10, // 35: gibson.agent.AgentService.Execute:input_type -> gibson.agent.AgentExecuteRequest
-	12, // 36: gibson.agent.AgentService.Health:input_type -> gibson.agent.AgentHealthRequest
-	13, // 37: gibson.agent.AgentService.StreamExecute:input_type -> gibson.agent.ClientMessage
-	4,  // 38: gibson.agent.AgentService.GetDescriptor:output_type -> gibson.agent.AgentDescriptor
-	9,  // 39: gibson.agent.AgentService.GetSlotSchema:output_type -> gibson.agent.AgentGetSlotSchemaResponse
-	11, // 40: gibson.agent.AgentService.Execute:output_type -> gibson.agent.AgentExecuteResponse
-	36, // 41: gibson.agent.AgentService.Health:output_type -> gibson.common.HealthStatus
-	19, // 42: gibson.agent.AgentService.StreamExecute:output_type -> gibson.agent.AgentMessage
-	38, // [38:43] is the sub-list for method output_type
-	33, // [33:38] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	3,  // 4: gibson.agent.AgentDescribeTargetSchemasResponse.target_schemas:type_name -> gibson.agent.TargetSchemaProto
+	31, // 5: gibson.agent.AgentExecuteRequest.task:type_name -> gibson.types.Task
+	32, // 6: gibson.agent.AgentExecuteRequest.mission:type_name -> gibson.common.TypedMap
+	32, // 7: gibson.agent.AgentExecuteRequest.target:type_name -> gibson.common.TypedMap
+	33, // 8: gibson.agent.AgentExecuteResponse.result:type_name -> gibson.types.Result
+	34, // 9: gibson.agent.AgentExecuteResponse.error:type_name -> gibson.common.Error
+	16, // 10: gibson.agent.ClientMessage.start:type_name -> gibson.agent.StartExecutionRequest
+	17, // 11: gibson.agent.ClientMessage.steering:type_name -> gibson.agent.SteeringMessage
+	18, // 12: gibson.agent.ClientMessage.interrupt:type_name -> gibson.agent.InterruptRequest
+	19, // 13: gibson.agent.ClientMessage.set_mode:type_name -> gibson.agent.SetModeRequest
+	20, // 14: gibson.agent.ClientMessage.resume:type_name -> gibson.agent.ResumeRequest
+	31, // 15: gibson.agent.StartExecutionRequest.task:type_name -> gibson.types.Task
+	0,  // 16: gibson.agent.StartExecutionRequest.initial_mode:type_name -> gibson.agent.AgentMode
+	32, // 17: gibson.agent.StartExecutionRequest.mission:type_name -> gibson.common.TypedMap
+	32, // 18: gibson.agent.StartExecutionRequest.target:type_name -> gibson.common.TypedMap
+	29, // 19: gibson.agent.SteeringMessage.metadata:type_name -> gibson.agent.SteeringMessage.MetadataEntry
+	0,  // 20: gibson.agent.SetModeRequest.mode:type_name -> gibson.agent.AgentMode
+	22, // 21: gibson.agent.AgentMessage.output:type_name -> gibson.agent.OutputChunk
+	23, // 22: gibson.agent.AgentMessage.tool_call:type_name -> gibson.agent.ToolCallEvent
+	24, // 23: gibson.agent.AgentMessage.tool_result:type_name -> gibson.agent.ToolResultEvent
+	25, // 24: gibson.agent.AgentMessage.finding:type_name -> gibson.agent.FindingEvent
+	26, // 25: gibson.agent.AgentMessage.status:type_name -> gibson.agent.StatusChange
+	27, // 26: gibson.agent.AgentMessage.steering_ack:type_name -> gibson.agent.SteeringAck
+	28, // 27: gibson.agent.AgentMessage.error:type_name -> gibson.agent.ErrorEvent
+	30, // 28: gibson.agent.ToolCallEvent.input:type_name -> gibson.agent.ToolCallEvent.InputEntry
+	35, // 29: gibson.agent.ToolResultEvent.output:type_name -> gibson.common.TypedValue
+	36, // 30: gibson.agent.FindingEvent.finding:type_name -> gibson.types.Finding
+	1,  // 31: gibson.agent.StatusChange.status:type_name -> gibson.agent.AgentStatus
+	37, // 32: gibson.agent.ErrorEvent.code:type_name -> gibson.common.ErrorCode
+	35, // 33: gibson.agent.ToolCallEvent.InputEntry.value:type_name -> gibson.common.TypedValue
+	2,  // 34: gibson.agent.AgentService.GetDescriptor:input_type -> gibson.agent.AgentGetDescriptorRequest
+	8,  // 35: gibson.agent.AgentService.GetSlotSchema:input_type -> gibson.agent.AgentGetSlotSchemaRequest
+	10, // 36: gibson.agent.AgentService.DescribeTargetSchemas:input_type -> gibson.agent.AgentDescribeTargetSchemasRequest
+	12, // 37: gibson.agent.AgentService.Execute:input_type -> gibson.agent.AgentExecuteRequest
+	14, // 38: gibson.agent.AgentService.Health:input_type -> gibson.agent.AgentHealthRequest
+	15, // 39: gibson.agent.AgentService.StreamExecute:input_type -> gibson.agent.ClientMessage
+	4,  // 40: gibson.agent.AgentService.GetDescriptor:output_type -> gibson.agent.AgentDescriptor
+	9,  // 41: gibson.agent.AgentService.GetSlotSchema:output_type -> gibson.agent.AgentGetSlotSchemaResponse
+	11, // 42: gibson.agent.AgentService.DescribeTargetSchemas:output_type -> gibson.agent.AgentDescribeTargetSchemasResponse
+	13, // 43: gibson.agent.AgentService.Execute:output_type -> gibson.agent.AgentExecuteResponse
+	38, // 44: gibson.agent.AgentService.Health:output_type -> gibson.common.HealthStatus
+	21, // 45: gibson.agent.AgentService.StreamExecute:output_type -> gibson.agent.AgentMessage
+	40, // [40:46] is the sub-list for method output_type
+	34, // [34:40] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_agent_proto_init() }
@@ -2124,14 +2233,14 @@ func file_agent_proto_init() {
 	}
 	file_common_proto_init()
 	file_types_proto_init()
-	file_agent_proto_msgTypes[11].OneofWrappers = []any{
+	file_agent_proto_msgTypes[13].OneofWrappers = []any{
 		(*ClientMessage_Start)(nil),
 		(*ClientMessage_Steering)(nil),
 		(*ClientMessage_Interrupt)(nil),
 		(*ClientMessage_SetMode)(nil),
 		(*ClientMessage_Resume)(nil),
 	}
-	file_agent_proto_msgTypes[17].OneofWrappers = []any{
+	file_agent_proto_msgTypes[19].OneofWrappers = []any{
 		(*AgentMessage_Output)(nil),
 		(*AgentMessage_ToolCall)(nil),
 		(*AgentMessage_ToolResult)(nil),
@@ -2146,7 +2255,7 @@ func file_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agent_proto_rawDesc), len(file_agent_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   27,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
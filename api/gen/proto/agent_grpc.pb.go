@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AgentService_GetDescriptor_FullMethodName = "/gibson.agent.AgentService/GetDescriptor"
-	AgentService_GetSlotSchema_FullMethodName = "/gibson.agent.AgentService/GetSlotSchema"
-	AgentService_Execute_FullMethodName       = "/gibson.agent.AgentService/Execute"
-	AgentService_Health_FullMethodName        = "/gibson.agent.AgentService/Health"
-	AgentService_StreamExecute_FullMethodName = "/gibson.agent.AgentService/StreamExecute"
+	AgentService_GetDescriptor_FullMethodName         = "/gibson.agent.AgentService/GetDescriptor"
+	AgentService_GetSlotSchema_FullMethodName         = "/gibson.agent.AgentService/GetSlotSchema"
+	AgentService_DescribeTargetSchemas_FullMethodName = "/gibson.agent.AgentService/DescribeTargetSchemas"
+	AgentService_Execute_FullMethodName               = "/gibson.agent.AgentService/Execute"
+	AgentService_Health_FullMethodName                = "/gibson.agent.AgentService/Health"
+	AgentService_StreamExecute_FullMethodName         = "/gibson.agent.AgentService/StreamExecute"
 )
 
 // AgentServiceClient is the client API for AgentService service.
@@ -32,6 +33,7 @@ const (
 type AgentServiceClient interface {
 	GetDescriptor(ctx context.Context, in *AgentGetDescriptorRequest, opts ...grpc.CallOption) (*AgentDescriptor, error)
 	GetSlotSchema(ctx context.Context, in *AgentGetSlotSchemaRequest, opts ...grpc.CallOption) (*AgentGetSlotSchemaResponse, error)
+	DescribeTargetSchemas(ctx context.Context, in *AgentDescribeTargetSchemasRequest, opts ...grpc.CallOption) (*AgentDescribeTargetSchemasResponse, error)
 	Execute(ctx context.Context, in *AgentExecuteRequest, opts ...grpc.CallOption) (*AgentExecuteResponse, error)
 	Health(ctx context.Context, in *AgentHealthRequest, opts ...grpc.CallOption) (*HealthStatus, error)
 	StreamExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ClientMessage, AgentMessage], error)
@@ -65,6 +67,16 @@ func (c *agentServiceClient) GetSlotSchema(ctx context.Context, in *AgentGetSlot
 	return out, nil
 }
 
+func (c *agentServiceClient) DescribeTargetSchemas(ctx context.Context, in *AgentDescribeTargetSchemasRequest, opts ...grpc.CallOption) (*AgentDescribeTargetSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentDescribeTargetSchemasResponse)
+	err := c.cc.Invoke(ctx, AgentService_DescribeTargetSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentServiceClient) Execute(ctx context.Context, in *AgentExecuteRequest, opts ...grpc.CallOption) (*AgentExecuteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AgentExecuteResponse)
@@ -104,6 +116,7 @@ type AgentService_StreamExecuteClient = grpc.BidiStreamingClient[ClientMessage,
 type AgentServiceServer interface {
 	GetDescriptor(context.Context, *AgentGetDescriptorRequest) (*AgentDescriptor, error)
 	GetSlotSchema(context.Context, *AgentGetSlotSchemaRequest) (*AgentGetSlotSchemaResponse, error)
+	DescribeTargetSchemas(context.Context, *AgentDescribeTargetSchemasRequest) (*AgentDescribeTargetSchemasResponse, error)
 	Execute(context.Context, *AgentExecuteRequest) (*AgentExecuteResponse, error)
 	Health(context.Context, *AgentHealthRequest) (*HealthStatus, error)
 	StreamExecute(grpc.BidiStreamingServer[ClientMessage, AgentMessage]) error
@@ -123,6 +136,9 @@ func (UnimplementedAgentServiceServer) GetDescriptor(context.Context, *AgentGetD
 func (UnimplementedAgentServiceServer) GetSlotSchema(context.Context, *AgentGetSlotSchemaRequest) (*AgentGetSlotSchemaResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSlotSchema not implemented")
 }
+func (UnimplementedAgentServiceServer) DescribeTargetSchemas(context.Context, *AgentDescribeTargetSchemasRequest) (*AgentDescribeTargetSchemasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeTargetSchemas not implemented")
+}
 func (UnimplementedAgentServiceServer) Execute(context.Context, *AgentExecuteRequest) (*AgentExecuteResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Execute not implemented")
 }
@@ -189,6 +205,24 @@ func _AgentService_GetSlotSchema_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentService_DescribeTargetSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentDescribeTargetSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DescribeTargetSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_DescribeTargetSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DescribeTargetSchemas(ctx, req.(*AgentDescribeTargetSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AgentService_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AgentExecuteRequest)
 	if err := dec(in); err != nil {
@@ -247,6 +281,10 @@ var AgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSlotSchema",
 			Handler:    _AgentService_GetSlotSchema_Handler,
 		},
+		{
+			MethodName: "DescribeTargetSchemas",
+			Handler:    _AgentService_DescribeTargetSchemas_Handler,
+		},
 		{
 			MethodName: "Execute",
 			Handler:    _AgentService_Execute_Handler,
@@ -95,6 +95,10 @@ const (
 	ErrorCode_ERROR_CODE_DELEGATION_FAILED    ErrorCode = 23
 	ErrorCode_ERROR_CODE_CHILD_AGENT_FAILED   ErrorCode = 24
 	ErrorCode_ERROR_CODE_CONFIG_ERROR         ErrorCode = 25
+	ErrorCode_ERROR_CODE_LLM_UNAVAILABLE      ErrorCode = 26
+	ErrorCode_ERROR_CODE_TOOL_FAILURE         ErrorCode = 27
+	ErrorCode_ERROR_CODE_CONSTRAINT_VIOLATED  ErrorCode = 28
+	ErrorCode_ERROR_CODE_TARGET_UNREACHABLE   ErrorCode = 29
 )
 
 // Enum value maps for ErrorCode.
@@ -126,6 +130,10 @@ var (
 		23: "ERROR_CODE_DELEGATION_FAILED",
 		24: "ERROR_CODE_CHILD_AGENT_FAILED",
 		25: "ERROR_CODE_CONFIG_ERROR",
+		26: "ERROR_CODE_LLM_UNAVAILABLE",
+		27: "ERROR_CODE_TOOL_FAILURE",
+		28: "ERROR_CODE_CONSTRAINT_VIOLATED",
+		29: "ERROR_CODE_TARGET_UNREACHABLE",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_UNSPECIFIED":          0,
@@ -154,6 +162,10 @@ var (
 		"ERROR_CODE_DELEGATION_FAILED":    23,
 		"ERROR_CODE_CHILD_AGENT_FAILED":   24,
 		"ERROR_CODE_CONFIG_ERROR":         25,
+		"ERROR_CODE_LLM_UNAVAILABLE":      26,
+		"ERROR_CODE_TOOL_FAILURE":         27,
+		"ERROR_CODE_CONSTRAINT_VIOLATED":  28,
+		"ERROR_CODE_TARGET_UNREACHABLE":   29,
 	}
 )
 
@@ -712,7 +724,7 @@ const file_common_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01*\x1b\n" +
 	"\tNullValue\x12\x0e\n" +
 	"\n" +
-	"NULL_VALUE\x10\x00*\xaa\x06\n" +
+	"NULL_VALUE\x10\x00*\xae\a\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13ERROR_CODE_INTERNAL\x10\x01\x12\x1f\n" +
@@ -740,7 +752,11 @@ const file_common_proto_rawDesc = "" +
 	"\x14ERROR_CODE_TLS_ERROR\x10\x16\x12 \n" +
 	"\x1cERROR_CODE_DELEGATION_FAILED\x10\x17\x12!\n" +
 	"\x1dERROR_CODE_CHILD_AGENT_FAILED\x10\x18\x12\x1b\n" +
-	"\x17ERROR_CODE_CONFIG_ERROR\x10\x19*|\n" +
+	"\x17ERROR_CODE_CONFIG_ERROR\x10\x19\x12\x1e\n" +
+	"\x1aERROR_CODE_LLM_UNAVAILABLE\x10\x1a\x12\x1b\n" +
+	"\x17ERROR_CODE_TOOL_FAILURE\x10\x1b\x12\"\n" +
+	"\x1eERROR_CODE_CONSTRAINT_VIOLATED\x10\x1c\x12!\n" +
+	"\x1dERROR_CODE_TARGET_UNREACHABLE\x10\x1d*|\n" +
 	"\vHealthState\x12\x1c\n" +
 	"\x18HEALTH_STATE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14HEALTH_STATE_HEALTHY\x10\x01\x12\x19\n" +
@@ -385,8 +385,11 @@ type ContextInfo struct {
 	// Tool execution ID - unique identifier for tool execution provenance.
 	// Used to create PRODUCED relationships from tool executions to nodes.
 	ToolExecutionId string `protobuf:"bytes,9,opt,name=tool_execution_id,json=toolExecutionId,proto3" json:"tool_execution_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Step ID - identifier of the workflow step this call belongs to.
+	// Used to scope operations when one harness serves nested delegations.
+	StepId        string `protobuf:"bytes,10,opt,name=step_id,json=stepId,proto3" json:"step_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ContextInfo) Reset() {
@@ -482,6 +485,13 @@ func (x *ContextInfo) GetToolExecutionId() string {
 	return ""
 }
 
+func (x *ContextInfo) GetStepId() string {
+	if x != nil {
+		return x.StepId
+	}
+	return ""
+}
+
 type TokenUsage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InputTokens   int32                  `protobuf:"varint,1,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
@@ -542,6 +552,140 @@ func (x *TokenUsage) GetTotalTokens() int32 {
 	return 0
 }
 
+// ReportTokenUsageRequest carries a snapshot of the cumulative token usage
+// of a task, so the orchestrator can account for it if the agent crashes.
+// Totals are cumulative, not deltas, so a report can be retried or
+// delivered twice. Each tracker instance reports under its own session_id;
+// the orchestrator keeps the report with the highest sequence per session
+// and sums the sessions of a task.
+type ReportTokenUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Sequence      int64                  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`                                                                    // increases with every report of a session
+	Slots         map[string]*TokenUsage `protobuf:"bytes,4,rep,name=slots,proto3" json:"slots,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // cumulative usage per LLM slot
+	Total         *TokenUsage            `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`                                                                           // cumulative usage across slots
+	Final         bool                   `protobuf:"varint,6,opt,name=final,proto3" json:"final,omitempty"`                                                                          // set on the report sent when the task ends
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTokenUsageRequest) Reset() {
+	*x = ReportTokenUsageRequest{}
+	mi := &file_harness_callback_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTokenUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTokenUsageRequest) ProtoMessage() {}
+
+func (x *ReportTokenUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTokenUsageRequest.ProtoReflect.Descriptor instead.
+func (*ReportTokenUsageRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReportTokenUsageRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ReportTokenUsageRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReportTokenUsageRequest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ReportTokenUsageRequest) GetSlots() map[string]*TokenUsage {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *ReportTokenUsageRequest) GetTotal() *TokenUsage {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ReportTokenUsageRequest) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+type ReportTokenUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *HarnessError          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTokenUsageResponse) Reset() {
+	*x = ReportTokenUsageResponse{}
+	mi := &file_harness_callback_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTokenUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTokenUsageResponse) ProtoMessage() {}
+
+func (x *ReportTokenUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTokenUsageResponse.ProtoReflect.Descriptor instead.
+func (*ReportTokenUsageResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReportTokenUsageResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 type LLMMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // system, user, assistant, tool
@@ -555,7 +699,7 @@ type LLMMessage struct {
 
 func (x *LLMMessage) Reset() {
 	*x = LLMMessage{}
-	mi := &file_harness_callback_proto_msgTypes[4]
+	mi := &file_harness_callback_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +711,7 @@ func (x *LLMMessage) String() string {
 func (*LLMMessage) ProtoMessage() {}
 
 func (x *LLMMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[4]
+	mi := &file_harness_callback_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +724,7 @@ func (x *LLMMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMMessage.ProtoReflect.Descriptor instead.
 func (*LLMMessage) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{4}
+	return file_harness_callback_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LLMMessage) GetRole() string {
@@ -629,7 +773,7 @@ type ToolCall struct {
 
 func (x *ToolCall) Reset() {
 	*x = ToolCall{}
-	mi := &file_harness_callback_proto_msgTypes[5]
+	mi := &file_harness_callback_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +785,7 @@ func (x *ToolCall) String() string {
 func (*ToolCall) ProtoMessage() {}
 
 func (x *ToolCall) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[5]
+	mi := &file_harness_callback_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +798,7 @@ func (x *ToolCall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall.ProtoReflect.Descriptor instead.
 func (*ToolCall) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{5}
+	return file_harness_callback_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ToolCall) GetId() string {
@@ -689,7 +833,7 @@ type ToolResult struct {
 
 func (x *ToolResult) Reset() {
 	*x = ToolResult{}
-	mi := &file_harness_callback_proto_msgTypes[6]
+	mi := &file_harness_callback_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -701,7 +845,7 @@ func (x *ToolResult) String() string {
 func (*ToolResult) ProtoMessage() {}
 
 func (x *ToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[6]
+	mi := &file_harness_callback_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +858,7 @@ func (x *ToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult.ProtoReflect.Descriptor instead.
 func (*ToolResult) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{6}
+	return file_harness_callback_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ToolResult) GetToolCallId() string {
@@ -749,7 +893,7 @@ type ToolDef struct {
 
 func (x *ToolDef) Reset() {
 	*x = ToolDef{}
-	mi := &file_harness_callback_proto_msgTypes[7]
+	mi := &file_harness_callback_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -761,7 +905,7 @@ func (x *ToolDef) String() string {
 func (*ToolDef) ProtoMessage() {}
 
 func (x *ToolDef) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[7]
+	mi := &file_harness_callback_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,7 +918,7 @@ func (x *ToolDef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolDef.ProtoReflect.Descriptor instead.
 func (*ToolDef) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{7}
+	return file_harness_callback_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ToolDef) GetName() string {
@@ -804,17 +948,21 @@ type LLMCompleteRequest struct {
 	Slot     string                 `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
 	Messages []*LLMMessage          `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
 	// Completion options
-	Temperature   *float64 `protobuf:"fixed64,4,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
-	MaxTokens     *int32   `protobuf:"varint,5,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
-	TopP          *float64 `protobuf:"fixed64,6,opt,name=top_p,json=topP,proto3,oneof" json:"top_p,omitempty"`
-	Stop          []string `protobuf:"bytes,7,rep,name=stop,proto3" json:"stop,omitempty"`
+	Temperature *float64 `protobuf:"fixed64,4,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	MaxTokens   *int32   `protobuf:"varint,5,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
+	TopP        *float64 `protobuf:"fixed64,6,opt,name=top_p,json=topP,proto3,oneof" json:"top_p,omitempty"`
+	Stop        []string `protobuf:"bytes,7,rep,name=stop,proto3" json:"stop,omitempty"`
+	// Model to use instead of the slot's configured model. The orchestrator
+	// rejects it with ERROR_CODE_INVALID_ARGUMENT if it does not meet the
+	// slot's requirements.
+	ModelOverride string `protobuf:"bytes,8,opt,name=model_override,json=modelOverride,proto3" json:"model_override,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LLMCompleteRequest) Reset() {
 	*x = LLMCompleteRequest{}
-	mi := &file_harness_callback_proto_msgTypes[8]
+	mi := &file_harness_callback_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -826,7 +974,7 @@ func (x *LLMCompleteRequest) String() string {
 func (*LLMCompleteRequest) ProtoMessage() {}
 
 func (x *LLMCompleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[8]
+	mi := &file_harness_callback_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,7 +987,7 @@ func (x *LLMCompleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMCompleteRequest.ProtoReflect.Descriptor instead.
 func (*LLMCompleteRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{8}
+	return file_harness_callback_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LLMCompleteRequest) GetContext() *ContextInfo {
@@ -891,6 +1039,13 @@ func (x *LLMCompleteRequest) GetStop() []string {
 	return nil
 }
 
+func (x *LLMCompleteRequest) GetModelOverride() string {
+	if x != nil {
+		return x.ModelOverride
+	}
+	return ""
+}
+
 type LLMCompleteWithToolsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
@@ -903,7 +1058,7 @@ type LLMCompleteWithToolsRequest struct {
 
 func (x *LLMCompleteWithToolsRequest) Reset() {
 	*x = LLMCompleteWithToolsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[9]
+	mi := &file_harness_callback_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -915,7 +1070,7 @@ func (x *LLMCompleteWithToolsRequest) String() string {
 func (*LLMCompleteWithToolsRequest) ProtoMessage() {}
 
 func (x *LLMCompleteWithToolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[9]
+	mi := &file_harness_callback_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1083,7 @@ func (x *LLMCompleteWithToolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMCompleteWithToolsRequest.ProtoReflect.Descriptor instead.
 func (*LLMCompleteWithToolsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{9}
+	return file_harness_callback_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LLMCompleteWithToolsRequest) GetContext() *ContextInfo {
@@ -971,7 +1126,7 @@ type LLMCompleteStructuredRequest struct {
 
 func (x *LLMCompleteStructuredRequest) Reset() {
 	*x = LLMCompleteStructuredRequest{}
-	mi := &file_harness_callback_proto_msgTypes[10]
+	mi := &file_harness_callback_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -983,7 +1138,7 @@ func (x *LLMCompleteStructuredRequest) String() string {
 func (*LLMCompleteStructuredRequest) ProtoMessage() {}
 
 func (x *LLMCompleteStructuredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[10]
+	mi := &file_harness_callback_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1151,7 @@ func (x *LLMCompleteStructuredRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMCompleteStructuredRequest.ProtoReflect.Descriptor instead.
 func (*LLMCompleteStructuredRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{10}
+	return file_harness_callback_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LLMCompleteStructuredRequest) GetContext() *ContextInfo {
@@ -1038,7 +1193,7 @@ type LLMCompleteStructuredResponse struct {
 
 func (x *LLMCompleteStructuredResponse) Reset() {
 	*x = LLMCompleteStructuredResponse{}
-	mi := &file_harness_callback_proto_msgTypes[11]
+	mi := &file_harness_callback_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1050,7 +1205,7 @@ func (x *LLMCompleteStructuredResponse) String() string {
 func (*LLMCompleteStructuredResponse) ProtoMessage() {}
 
 func (x *LLMCompleteStructuredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[11]
+	mi := &file_harness_callback_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,7 +1218,7 @@ func (x *LLMCompleteStructuredResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMCompleteStructuredResponse.ProtoReflect.Descriptor instead.
 func (*LLMCompleteStructuredResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{11}
+	return file_harness_callback_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LLMCompleteStructuredResponse) GetResult() *TypedValue {
@@ -1094,13 +1249,14 @@ type LLMCompleteResponse struct {
 	FinishReason  string                 `protobuf:"bytes,3,opt,name=finish_reason,json=finishReason,proto3" json:"finish_reason,omitempty"`
 	Usage         *TokenUsage            `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
 	Error         *HarnessError          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Model         string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"` // Model that generated the response
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LLMCompleteResponse) Reset() {
 	*x = LLMCompleteResponse{}
-	mi := &file_harness_callback_proto_msgTypes[12]
+	mi := &file_harness_callback_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1112,7 +1268,7 @@ func (x *LLMCompleteResponse) String() string {
 func (*LLMCompleteResponse) ProtoMessage() {}
 
 func (x *LLMCompleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[12]
+	mi := &file_harness_callback_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,7 +1281,7 @@ func (x *LLMCompleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMCompleteResponse.ProtoReflect.Descriptor instead.
 func (*LLMCompleteResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{12}
+	return file_harness_callback_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *LLMCompleteResponse) GetContent() string {
@@ -1163,6 +1319,13 @@ func (x *LLMCompleteResponse) GetError() *HarnessError {
 	return nil
 }
 
+func (x *LLMCompleteResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
 type LLMStreamRequest struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	Context  *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
@@ -1179,7 +1342,7 @@ type LLMStreamRequest struct {
 
 func (x *LLMStreamRequest) Reset() {
 	*x = LLMStreamRequest{}
-	mi := &file_harness_callback_proto_msgTypes[13]
+	mi := &file_harness_callback_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1354,7 @@ func (x *LLMStreamRequest) String() string {
 func (*LLMStreamRequest) ProtoMessage() {}
 
 func (x *LLMStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[13]
+	mi := &file_harness_callback_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1367,7 @@ func (x *LLMStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMStreamRequest.ProtoReflect.Descriptor instead.
 func (*LLMStreamRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{13}
+	return file_harness_callback_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *LLMStreamRequest) GetContext() *ContextInfo {
@@ -1269,7 +1432,7 @@ type LLMStreamChunk struct {
 
 func (x *LLMStreamChunk) Reset() {
 	*x = LLMStreamChunk{}
-	mi := &file_harness_callback_proto_msgTypes[14]
+	mi := &file_harness_callback_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1281,7 +1444,7 @@ func (x *LLMStreamChunk) String() string {
 func (*LLMStreamChunk) ProtoMessage() {}
 
 func (x *LLMStreamChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[14]
+	mi := &file_harness_callback_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +1457,7 @@ func (x *LLMStreamChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LLMStreamChunk.ProtoReflect.Descriptor instead.
 func (*LLMStreamChunk) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{14}
+	return file_harness_callback_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *LLMStreamChunk) GetDelta() string {
@@ -1349,7 +1512,7 @@ type CallToolProtoRequest struct {
 
 func (x *CallToolProtoRequest) Reset() {
 	*x = CallToolProtoRequest{}
-	mi := &file_harness_callback_proto_msgTypes[15]
+	mi := &file_harness_callback_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1361,7 +1524,7 @@ func (x *CallToolProtoRequest) String() string {
 func (*CallToolProtoRequest) ProtoMessage() {}
 
 func (x *CallToolProtoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[15]
+	mi := &file_harness_callback_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1537,7 @@ func (x *CallToolProtoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolProtoRequest.ProtoReflect.Descriptor instead.
 func (*CallToolProtoRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{15}
+	return file_harness_callback_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CallToolProtoRequest) GetContext() *ContextInfo {
@@ -1424,7 +1587,7 @@ type CallToolProtoResponse struct {
 
 func (x *CallToolProtoResponse) Reset() {
 	*x = CallToolProtoResponse{}
-	mi := &file_harness_callback_proto_msgTypes[16]
+	mi := &file_harness_callback_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1436,7 +1599,7 @@ func (x *CallToolProtoResponse) String() string {
 func (*CallToolProtoResponse) ProtoMessage() {}
 
 func (x *CallToolProtoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[16]
+	mi := &file_harness_callback_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,7 +1612,7 @@ func (x *CallToolProtoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolProtoResponse.ProtoReflect.Descriptor instead.
 func (*CallToolProtoResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{16}
+	return file_harness_callback_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CallToolProtoResponse) GetOutputJson() []byte {
@@ -1485,7 +1648,7 @@ type CallToolProtoStreamRequest struct {
 
 func (x *CallToolProtoStreamRequest) Reset() {
 	*x = CallToolProtoStreamRequest{}
-	mi := &file_harness_callback_proto_msgTypes[17]
+	mi := &file_harness_callback_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1497,7 +1660,7 @@ func (x *CallToolProtoStreamRequest) String() string {
 func (*CallToolProtoStreamRequest) ProtoMessage() {}
 
 func (x *CallToolProtoStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[17]
+	mi := &file_harness_callback_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,7 +1673,7 @@ func (x *CallToolProtoStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolProtoStreamRequest.ProtoReflect.Descriptor instead.
 func (*CallToolProtoStreamRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{17}
+	return file_harness_callback_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CallToolProtoStreamRequest) GetContext() *ContextInfo {
@@ -1576,7 +1739,7 @@ type CallToolProtoStreamResponse struct {
 
 func (x *CallToolProtoStreamResponse) Reset() {
 	*x = CallToolProtoStreamResponse{}
-	mi := &file_harness_callback_proto_msgTypes[18]
+	mi := &file_harness_callback_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1588,7 +1751,7 @@ func (x *CallToolProtoStreamResponse) String() string {
 func (*CallToolProtoStreamResponse) ProtoMessage() {}
 
 func (x *CallToolProtoStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[18]
+	mi := &file_harness_callback_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1601,7 +1764,7 @@ func (x *CallToolProtoStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolProtoStreamResponse.ProtoReflect.Descriptor instead.
 func (*CallToolProtoStreamResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{18}
+	return file_harness_callback_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CallToolProtoStreamResponse) GetPayload() isCallToolProtoStreamResponse_Payload {
@@ -1730,7 +1893,7 @@ type ToolProgressEvent struct {
 
 func (x *ToolProgressEvent) Reset() {
 	*x = ToolProgressEvent{}
-	mi := &file_harness_callback_proto_msgTypes[19]
+	mi := &file_harness_callback_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1742,7 +1905,7 @@ func (x *ToolProgressEvent) String() string {
 func (*ToolProgressEvent) ProtoMessage() {}
 
 func (x *ToolProgressEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[19]
+	mi := &file_harness_callback_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +1918,7 @@ func (x *ToolProgressEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolProgressEvent.ProtoReflect.Descriptor instead.
 func (*ToolProgressEvent) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{19}
+	return file_harness_callback_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ToolProgressEvent) GetPercent() int32 {
@@ -1790,7 +1953,7 @@ type ToolPartialResultEvent struct {
 
 func (x *ToolPartialResultEvent) Reset() {
 	*x = ToolPartialResultEvent{}
-	mi := &file_harness_callback_proto_msgTypes[20]
+	mi := &file_harness_callback_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1802,7 +1965,7 @@ func (x *ToolPartialResultEvent) String() string {
 func (*ToolPartialResultEvent) ProtoMessage() {}
 
 func (x *ToolPartialResultEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[20]
+	mi := &file_harness_callback_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1815,7 +1978,7 @@ func (x *ToolPartialResultEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolPartialResultEvent.ProtoReflect.Descriptor instead.
 func (*ToolPartialResultEvent) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{20}
+	return file_harness_callback_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ToolPartialResultEvent) GetOutputJson() []byte {
@@ -1843,7 +2006,7 @@ type ToolWarningEvent struct {
 
 func (x *ToolWarningEvent) Reset() {
 	*x = ToolWarningEvent{}
-	mi := &file_harness_callback_proto_msgTypes[21]
+	mi := &file_harness_callback_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1855,7 +2018,7 @@ func (x *ToolWarningEvent) String() string {
 func (*ToolWarningEvent) ProtoMessage() {}
 
 func (x *ToolWarningEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[21]
+	mi := &file_harness_callback_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +2031,7 @@ func (x *ToolWarningEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolWarningEvent.ProtoReflect.Descriptor instead.
 func (*ToolWarningEvent) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{21}
+	return file_harness_callback_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ToolWarningEvent) GetMessage() string {
@@ -1895,7 +2058,7 @@ type ToolCompleteEvent struct {
 
 func (x *ToolCompleteEvent) Reset() {
 	*x = ToolCompleteEvent{}
-	mi := &file_harness_callback_proto_msgTypes[22]
+	mi := &file_harness_callback_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1907,7 +2070,7 @@ func (x *ToolCompleteEvent) String() string {
 func (*ToolCompleteEvent) ProtoMessage() {}
 
 func (x *ToolCompleteEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[22]
+	mi := &file_harness_callback_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,7 +2083,7 @@ func (x *ToolCompleteEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCompleteEvent.ProtoReflect.Descriptor instead.
 func (*ToolCompleteEvent) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{22}
+	return file_harness_callback_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ToolCompleteEvent) GetOutputJson() []byte {
@@ -1941,7 +2104,7 @@ type ToolErrorEvent struct {
 
 func (x *ToolErrorEvent) Reset() {
 	*x = ToolErrorEvent{}
-	mi := &file_harness_callback_proto_msgTypes[23]
+	mi := &file_harness_callback_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1953,7 +2116,7 @@ func (x *ToolErrorEvent) String() string {
 func (*ToolErrorEvent) ProtoMessage() {}
 
 func (x *ToolErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[23]
+	mi := &file_harness_callback_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,7 +2129,7 @@ func (x *ToolErrorEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolErrorEvent.ProtoReflect.Descriptor instead.
 func (*ToolErrorEvent) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{23}
+	return file_harness_callback_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ToolErrorEvent) GetError() *HarnessError {
@@ -1992,7 +2155,7 @@ type ListToolsRequest struct {
 
 func (x *ListToolsRequest) Reset() {
 	*x = ListToolsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[24]
+	mi := &file_harness_callback_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2004,7 +2167,7 @@ func (x *ListToolsRequest) String() string {
 func (*ListToolsRequest) ProtoMessage() {}
 
 func (x *ListToolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[24]
+	mi := &file_harness_callback_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2017,7 +2180,7 @@ func (x *ListToolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListToolsRequest.ProtoReflect.Descriptor instead.
 func (*ListToolsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{24}
+	return file_harness_callback_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListToolsRequest) GetContext() *ContextInfo {
@@ -2037,7 +2200,7 @@ type ListToolsResponse struct {
 
 func (x *ListToolsResponse) Reset() {
 	*x = ListToolsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[25]
+	mi := &file_harness_callback_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2049,7 +2212,7 @@ func (x *ListToolsResponse) String() string {
 func (*ListToolsResponse) ProtoMessage() {}
 
 func (x *ListToolsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[25]
+	mi := &file_harness_callback_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2062,7 +2225,7 @@ func (x *ListToolsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListToolsResponse.ProtoReflect.Descriptor instead.
 func (*ListToolsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{25}
+	return file_harness_callback_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListToolsResponse) GetTools() []*HarnessToolDescriptor {
@@ -2092,7 +2255,7 @@ type HarnessToolDescriptor struct {
 
 func (x *HarnessToolDescriptor) Reset() {
 	*x = HarnessToolDescriptor{}
-	mi := &file_harness_callback_proto_msgTypes[26]
+	mi := &file_harness_callback_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2104,7 +2267,7 @@ func (x *HarnessToolDescriptor) String() string {
 func (*HarnessToolDescriptor) ProtoMessage() {}
 
 func (x *HarnessToolDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[26]
+	mi := &file_harness_callback_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2117,7 +2280,7 @@ func (x *HarnessToolDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HarnessToolDescriptor.ProtoReflect.Descriptor instead.
 func (*HarnessToolDescriptor) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{26}
+	return file_harness_callback_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *HarnessToolDescriptor) GetName() string {
@@ -2148,6 +2311,130 @@ func (x *HarnessToolDescriptor) GetOutputSchema() *JSONSchemaNode {
 	return nil
 }
 
+// GetToolProtoDescriptorsRequest fetches the proto descriptors of a tool's
+// input and output messages (the tool's DescribeTool RPC).
+type GetToolProtoDescriptorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	ToolName      string                 `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolProtoDescriptorsRequest) Reset() {
+	*x = GetToolProtoDescriptorsRequest{}
+	mi := &file_harness_callback_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolProtoDescriptorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolProtoDescriptorsRequest) ProtoMessage() {}
+
+func (x *GetToolProtoDescriptorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolProtoDescriptorsRequest.ProtoReflect.Descriptor instead.
+func (*GetToolProtoDescriptorsRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetToolProtoDescriptorsRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetToolProtoDescriptorsRequest) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+type GetToolProtoDescriptorsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InputMessageType  string                 `protobuf:"bytes,1,opt,name=input_message_type,json=inputMessageType,proto3" json:"input_message_type,omitempty"`
+	OutputMessageType string                 `protobuf:"bytes,2,opt,name=output_message_type,json=outputMessageType,proto3" json:"output_message_type,omitempty"`
+	// Serialized google.protobuf.FileDescriptorSet holding the files that
+	// define the input and output messages and all their dependencies.
+	FileDescriptorSet []byte        `protobuf:"bytes,3,opt,name=file_descriptor_set,json=fileDescriptorSet,proto3" json:"file_descriptor_set,omitempty"`
+	Error             *HarnessError `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetToolProtoDescriptorsResponse) Reset() {
+	*x = GetToolProtoDescriptorsResponse{}
+	mi := &file_harness_callback_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolProtoDescriptorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolProtoDescriptorsResponse) ProtoMessage() {}
+
+func (x *GetToolProtoDescriptorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolProtoDescriptorsResponse.ProtoReflect.Descriptor instead.
+func (*GetToolProtoDescriptorsResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetToolProtoDescriptorsResponse) GetInputMessageType() string {
+	if x != nil {
+		return x.InputMessageType
+	}
+	return ""
+}
+
+func (x *GetToolProtoDescriptorsResponse) GetOutputMessageType() string {
+	if x != nil {
+		return x.OutputMessageType
+	}
+	return ""
+}
+
+func (x *GetToolProtoDescriptorsResponse) GetFileDescriptorSet() []byte {
+	if x != nil {
+		return x.FileDescriptorSet
+	}
+	return nil
+}
+
+func (x *GetToolProtoDescriptorsResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 // QueueToolWorkRequest initiates parallel execution of multiple tool invocations.
 type QueueToolWorkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2162,7 +2449,7 @@ type QueueToolWorkRequest struct {
 
 func (x *QueueToolWorkRequest) Reset() {
 	*x = QueueToolWorkRequest{}
-	mi := &file_harness_callback_proto_msgTypes[27]
+	mi := &file_harness_callback_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2174,7 +2461,7 @@ func (x *QueueToolWorkRequest) String() string {
 func (*QueueToolWorkRequest) ProtoMessage() {}
 
 func (x *QueueToolWorkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[27]
+	mi := &file_harness_callback_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,7 +2474,7 @@ func (x *QueueToolWorkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueToolWorkRequest.ProtoReflect.Descriptor instead.
 func (*QueueToolWorkRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{27}
+	return file_harness_callback_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueueToolWorkRequest) GetContext() *ContextInfo {
@@ -2236,7 +2523,7 @@ type QueueToolWorkResponse struct {
 
 func (x *QueueToolWorkResponse) Reset() {
 	*x = QueueToolWorkResponse{}
-	mi := &file_harness_callback_proto_msgTypes[28]
+	mi := &file_harness_callback_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2248,7 +2535,7 @@ func (x *QueueToolWorkResponse) String() string {
 func (*QueueToolWorkResponse) ProtoMessage() {}
 
 func (x *QueueToolWorkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[28]
+	mi := &file_harness_callback_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2261,7 +2548,7 @@ func (x *QueueToolWorkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueToolWorkResponse.ProtoReflect.Descriptor instead.
 func (*QueueToolWorkResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{28}
+	return file_harness_callback_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QueueToolWorkResponse) GetJobId() string {
@@ -2289,7 +2576,7 @@ type ToolResultsRequest struct {
 
 func (x *ToolResultsRequest) Reset() {
 	*x = ToolResultsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[29]
+	mi := &file_harness_callback_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2301,7 +2588,7 @@ func (x *ToolResultsRequest) String() string {
 func (*ToolResultsRequest) ProtoMessage() {}
 
 func (x *ToolResultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[29]
+	mi := &file_harness_callback_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2314,7 +2601,7 @@ func (x *ToolResultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResultsRequest.ProtoReflect.Descriptor instead.
 func (*ToolResultsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{29}
+	return file_harness_callback_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ToolResultsRequest) GetContext() *ContextInfo {
@@ -2345,7 +2632,7 @@ type ToolResultResponse struct {
 
 func (x *ToolResultResponse) Reset() {
 	*x = ToolResultResponse{}
-	mi := &file_harness_callback_proto_msgTypes[30]
+	mi := &file_harness_callback_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2357,7 +2644,7 @@ func (x *ToolResultResponse) String() string {
 func (*ToolResultResponse) ProtoMessage() {}
 
 func (x *ToolResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[30]
+	mi := &file_harness_callback_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,7 +2657,7 @@ func (x *ToolResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResultResponse.ProtoReflect.Descriptor instead.
 func (*ToolResultResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{30}
+	return file_harness_callback_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ToolResultResponse) GetIndex() int32 {
@@ -2429,13 +2716,14 @@ type JSONSchemaNode struct {
 	DefaultValue  *string                    `protobuf:"bytes,15,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
 	Nullable      bool                       `protobuf:"varint,16,opt,name=nullable,proto3" json:"nullable,omitempty"`
 	Taxonomy      *TaxonomyMapping           `protobuf:"bytes,17,opt,name=taxonomy,proto3" json:"taxonomy,omitempty"` // Taxonomy mapping for knowledge graph extraction
+	Guards        []string                   `protobuf:"bytes,18,rep,name=guards,proto3" json:"guards,omitempty"`     // Input guards (e.g. "shell_safe") enforced on string values
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JSONSchemaNode) Reset() {
 	*x = JSONSchemaNode{}
-	mi := &file_harness_callback_proto_msgTypes[31]
+	mi := &file_harness_callback_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2447,7 +2735,7 @@ func (x *JSONSchemaNode) String() string {
 func (*JSONSchemaNode) ProtoMessage() {}
 
 func (x *JSONSchemaNode) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[31]
+	mi := &file_harness_callback_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2460,7 +2748,7 @@ func (x *JSONSchemaNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JSONSchemaNode.ProtoReflect.Descriptor instead.
 func (*JSONSchemaNode) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{31}
+	return file_harness_callback_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *JSONSchemaNode) GetType() string {
@@ -2582,6 +2870,13 @@ func (x *JSONSchemaNode) GetTaxonomy() *TaxonomyMapping {
 	return nil
 }
 
+func (x *JSONSchemaNode) GetGuards() []string {
+	if x != nil {
+		return x.Guards
+	}
+	return nil
+}
+
 // TaxonomyMapping defines how tool output maps to knowledge graph nodes.
 // Uses deterministic ID generation based on identifying properties instead of templates.
 type TaxonomyMapping struct {
@@ -2596,7 +2891,7 @@ type TaxonomyMapping struct {
 
 func (x *TaxonomyMapping) Reset() {
 	*x = TaxonomyMapping{}
-	mi := &file_harness_callback_proto_msgTypes[32]
+	mi := &file_harness_callback_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2608,7 +2903,7 @@ func (x *TaxonomyMapping) String() string {
 func (*TaxonomyMapping) ProtoMessage() {}
 
 func (x *TaxonomyMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[32]
+	mi := &file_harness_callback_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2621,7 +2916,7 @@ func (x *TaxonomyMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyMapping.ProtoReflect.Descriptor instead.
 func (*TaxonomyMapping) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{32}
+	return file_harness_callback_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TaxonomyMapping) GetNodeType() string {
@@ -2665,7 +2960,7 @@ type PropertyMapping struct {
 
 func (x *PropertyMapping) Reset() {
 	*x = PropertyMapping{}
-	mi := &file_harness_callback_proto_msgTypes[33]
+	mi := &file_harness_callback_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2677,7 +2972,7 @@ func (x *PropertyMapping) String() string {
 func (*PropertyMapping) ProtoMessage() {}
 
 func (x *PropertyMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[33]
+	mi := &file_harness_callback_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2690,7 +2985,7 @@ func (x *PropertyMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertyMapping.ProtoReflect.Descriptor instead.
 func (*PropertyMapping) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{33}
+	return file_harness_callback_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PropertyMapping) GetSource() string {
@@ -2733,7 +3028,7 @@ type NodeReference struct {
 
 func (x *NodeReference) Reset() {
 	*x = NodeReference{}
-	mi := &file_harness_callback_proto_msgTypes[34]
+	mi := &file_harness_callback_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2745,7 +3040,7 @@ func (x *NodeReference) String() string {
 func (*NodeReference) ProtoMessage() {}
 
 func (x *NodeReference) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[34]
+	mi := &file_harness_callback_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2758,7 +3053,7 @@ func (x *NodeReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeReference.ProtoReflect.Descriptor instead.
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{34}
+	return file_harness_callback_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *NodeReference) GetType() string {
@@ -2789,7 +3084,7 @@ type RelationshipMapping struct {
 
 func (x *RelationshipMapping) Reset() {
 	*x = RelationshipMapping{}
-	mi := &file_harness_callback_proto_msgTypes[35]
+	mi := &file_harness_callback_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2801,7 +3096,7 @@ func (x *RelationshipMapping) String() string {
 func (*RelationshipMapping) ProtoMessage() {}
 
 func (x *RelationshipMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[35]
+	mi := &file_harness_callback_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2814,7 +3109,7 @@ func (x *RelationshipMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelationshipMapping.ProtoReflect.Descriptor instead.
 func (*RelationshipMapping) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{35}
+	return file_harness_callback_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *RelationshipMapping) GetType() string {
@@ -2864,7 +3159,7 @@ type QueryPluginRequest struct {
 
 func (x *QueryPluginRequest) Reset() {
 	*x = QueryPluginRequest{}
-	mi := &file_harness_callback_proto_msgTypes[36]
+	mi := &file_harness_callback_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2876,7 +3171,7 @@ func (x *QueryPluginRequest) String() string {
 func (*QueryPluginRequest) ProtoMessage() {}
 
 func (x *QueryPluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[36]
+	mi := &file_harness_callback_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2889,7 +3184,7 @@ func (x *QueryPluginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryPluginRequest.ProtoReflect.Descriptor instead.
 func (*QueryPluginRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{36}
+	return file_harness_callback_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *QueryPluginRequest) GetContext() *ContextInfo {
@@ -2930,7 +3225,7 @@ type QueryPluginResponse struct {
 
 func (x *QueryPluginResponse) Reset() {
 	*x = QueryPluginResponse{}
-	mi := &file_harness_callback_proto_msgTypes[37]
+	mi := &file_harness_callback_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2942,7 +3237,7 @@ func (x *QueryPluginResponse) String() string {
 func (*QueryPluginResponse) ProtoMessage() {}
 
 func (x *QueryPluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[37]
+	mi := &file_harness_callback_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2955,7 +3250,7 @@ func (x *QueryPluginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryPluginResponse.ProtoReflect.Descriptor instead.
 func (*QueryPluginResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{37}
+	return file_harness_callback_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *QueryPluginResponse) GetResult() *TypedValue {
@@ -2981,7 +3276,7 @@ type ListPluginsRequest struct {
 
 func (x *ListPluginsRequest) Reset() {
 	*x = ListPluginsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[38]
+	mi := &file_harness_callback_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2993,7 +3288,7 @@ func (x *ListPluginsRequest) String() string {
 func (*ListPluginsRequest) ProtoMessage() {}
 
 func (x *ListPluginsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[38]
+	mi := &file_harness_callback_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,7 +3301,7 @@ func (x *ListPluginsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginsRequest.ProtoReflect.Descriptor instead.
 func (*ListPluginsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{38}
+	return file_harness_callback_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListPluginsRequest) GetContext() *ContextInfo {
@@ -3026,7 +3321,7 @@ type ListPluginsResponse struct {
 
 func (x *ListPluginsResponse) Reset() {
 	*x = ListPluginsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[39]
+	mi := &file_harness_callback_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3038,7 +3333,7 @@ func (x *ListPluginsResponse) String() string {
 func (*ListPluginsResponse) ProtoMessage() {}
 
 func (x *ListPluginsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[39]
+	mi := &file_harness_callback_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,7 +3346,7 @@ func (x *ListPluginsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPluginsResponse.ProtoReflect.Descriptor instead.
 func (*ListPluginsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{39}
+	return file_harness_callback_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListPluginsResponse) GetPlugins() []*HarnessPluginDescriptor {
@@ -3080,7 +3375,7 @@ type HarnessPluginDescriptor struct {
 
 func (x *HarnessPluginDescriptor) Reset() {
 	*x = HarnessPluginDescriptor{}
-	mi := &file_harness_callback_proto_msgTypes[40]
+	mi := &file_harness_callback_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3092,7 +3387,7 @@ func (x *HarnessPluginDescriptor) String() string {
 func (*HarnessPluginDescriptor) ProtoMessage() {}
 
 func (x *HarnessPluginDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[40]
+	mi := &file_harness_callback_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3105,7 +3400,7 @@ func (x *HarnessPluginDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HarnessPluginDescriptor.ProtoReflect.Descriptor instead.
 func (*HarnessPluginDescriptor) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{40}
+	return file_harness_callback_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *HarnessPluginDescriptor) GetName() string {
@@ -3147,7 +3442,7 @@ type DelegateToAgentRequest struct {
 
 func (x *DelegateToAgentRequest) Reset() {
 	*x = DelegateToAgentRequest{}
-	mi := &file_harness_callback_proto_msgTypes[41]
+	mi := &file_harness_callback_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3159,7 +3454,7 @@ func (x *DelegateToAgentRequest) String() string {
 func (*DelegateToAgentRequest) ProtoMessage() {}
 
 func (x *DelegateToAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[41]
+	mi := &file_harness_callback_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3172,7 +3467,7 @@ func (x *DelegateToAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelegateToAgentRequest.ProtoReflect.Descriptor instead.
 func (*DelegateToAgentRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{41}
+	return file_harness_callback_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *DelegateToAgentRequest) GetContext() *ContextInfo {
@@ -3206,7 +3501,7 @@ type DelegateToAgentResponse struct {
 
 func (x *DelegateToAgentResponse) Reset() {
 	*x = DelegateToAgentResponse{}
-	mi := &file_harness_callback_proto_msgTypes[42]
+	mi := &file_harness_callback_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3218,7 +3513,7 @@ func (x *DelegateToAgentResponse) String() string {
 func (*DelegateToAgentResponse) ProtoMessage() {}
 
 func (x *DelegateToAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[42]
+	mi := &file_harness_callback_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3231,7 +3526,7 @@ func (x *DelegateToAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelegateToAgentResponse.ProtoReflect.Descriptor instead.
 func (*DelegateToAgentResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{42}
+	return file_harness_callback_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DelegateToAgentResponse) GetResult() *Result {
@@ -3257,7 +3552,7 @@ type ListAgentsRequest struct {
 
 func (x *ListAgentsRequest) Reset() {
 	*x = ListAgentsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[43]
+	mi := &file_harness_callback_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3269,7 +3564,7 @@ func (x *ListAgentsRequest) String() string {
 func (*ListAgentsRequest) ProtoMessage() {}
 
 func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[43]
+	mi := &file_harness_callback_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3282,7 +3577,7 @@ func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{43}
+	return file_harness_callback_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListAgentsRequest) GetContext() *ContextInfo {
@@ -3302,7 +3597,7 @@ type ListAgentsResponse struct {
 
 func (x *ListAgentsResponse) Reset() {
 	*x = ListAgentsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[44]
+	mi := &file_harness_callback_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3314,7 +3609,7 @@ func (x *ListAgentsResponse) String() string {
 func (*ListAgentsResponse) ProtoMessage() {}
 
 func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[44]
+	mi := &file_harness_callback_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3327,7 +3622,7 @@ func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{44}
+	return file_harness_callback_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ListAgentsResponse) GetAgents() []*HarnessAgentDescriptor {
@@ -3345,20 +3640,22 @@ func (x *ListAgentsResponse) GetError() *HarnessError {
 }
 
 type HarnessAgentDescriptor struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version        string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Capabilities   []string               `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
-	TargetTypes    []string               `protobuf:"bytes,5,rep,name=target_types,json=targetTypes,proto3" json:"target_types,omitempty"`
-	TechniqueTypes []string               `protobuf:"bytes,6,rep,name=technique_types,json=techniqueTypes,proto3" json:"technique_types,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version         string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Capabilities    []string               `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	TargetTypes     []string               `protobuf:"bytes,5,rep,name=target_types,json=targetTypes,proto3" json:"target_types,omitempty"`
+	TechniqueTypes  []string               `protobuf:"bytes,6,rep,name=technique_types,json=techniqueTypes,proto3" json:"technique_types,omitempty"`
+	RequiredTools   []string               `protobuf:"bytes,7,rep,name=required_tools,json=requiredTools,proto3" json:"required_tools,omitempty"`
+	RequiredPlugins []string               `protobuf:"bytes,8,rep,name=required_plugins,json=requiredPlugins,proto3" json:"required_plugins,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *HarnessAgentDescriptor) Reset() {
 	*x = HarnessAgentDescriptor{}
-	mi := &file_harness_callback_proto_msgTypes[45]
+	mi := &file_harness_callback_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3370,7 +3667,7 @@ func (x *HarnessAgentDescriptor) String() string {
 func (*HarnessAgentDescriptor) ProtoMessage() {}
 
 func (x *HarnessAgentDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[45]
+	mi := &file_harness_callback_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3383,7 +3680,7 @@ func (x *HarnessAgentDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HarnessAgentDescriptor.ProtoReflect.Descriptor instead.
 func (*HarnessAgentDescriptor) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{45}
+	return file_harness_callback_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *HarnessAgentDescriptor) GetName() string {
@@ -3428,6 +3725,20 @@ func (x *HarnessAgentDescriptor) GetTechniqueTypes() []string {
 	return nil
 }
 
+func (x *HarnessAgentDescriptor) GetRequiredTools() []string {
+	if x != nil {
+		return x.RequiredTools
+	}
+	return nil
+}
+
+func (x *HarnessAgentDescriptor) GetRequiredPlugins() []string {
+	if x != nil {
+		return x.RequiredPlugins
+	}
+	return nil
+}
+
 type SubmitFindingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
@@ -3438,7 +3749,7 @@ type SubmitFindingRequest struct {
 
 func (x *SubmitFindingRequest) Reset() {
 	*x = SubmitFindingRequest{}
-	mi := &file_harness_callback_proto_msgTypes[46]
+	mi := &file_harness_callback_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3450,7 +3761,7 @@ func (x *SubmitFindingRequest) String() string {
 func (*SubmitFindingRequest) ProtoMessage() {}
 
 func (x *SubmitFindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[46]
+	mi := &file_harness_callback_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3463,7 +3774,7 @@ func (x *SubmitFindingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitFindingRequest.ProtoReflect.Descriptor instead.
 func (*SubmitFindingRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{46}
+	return file_harness_callback_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SubmitFindingRequest) GetContext() *ContextInfo {
@@ -3489,7 +3800,7 @@ type SubmitFindingResponse struct {
 
 func (x *SubmitFindingResponse) Reset() {
 	*x = SubmitFindingResponse{}
-	mi := &file_harness_callback_proto_msgTypes[47]
+	mi := &file_harness_callback_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3501,7 +3812,7 @@ func (x *SubmitFindingResponse) String() string {
 func (*SubmitFindingResponse) ProtoMessage() {}
 
 func (x *SubmitFindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[47]
+	mi := &file_harness_callback_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3514,7 +3825,7 @@ func (x *SubmitFindingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitFindingResponse.ProtoReflect.Descriptor instead.
 func (*SubmitFindingResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{47}
+	return file_harness_callback_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *SubmitFindingResponse) GetError() *HarnessError {
@@ -3534,7 +3845,7 @@ type GetFindingsRequest struct {
 
 func (x *GetFindingsRequest) Reset() {
 	*x = GetFindingsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[48]
+	mi := &file_harness_callback_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3546,7 +3857,7 @@ func (x *GetFindingsRequest) String() string {
 func (*GetFindingsRequest) ProtoMessage() {}
 
 func (x *GetFindingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[48]
+	mi := &file_harness_callback_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3559,7 +3870,7 @@ func (x *GetFindingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFindingsRequest.ProtoReflect.Descriptor instead.
 func (*GetFindingsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{48}
+	return file_harness_callback_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetFindingsRequest) GetContext() *ContextInfo {
@@ -3586,7 +3897,7 @@ type GetFindingsResponse struct {
 
 func (x *GetFindingsResponse) Reset() {
 	*x = GetFindingsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[49]
+	mi := &file_harness_callback_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3598,7 +3909,7 @@ func (x *GetFindingsResponse) String() string {
 func (*GetFindingsResponse) ProtoMessage() {}
 
 func (x *GetFindingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[49]
+	mi := &file_harness_callback_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3611,7 +3922,7 @@ func (x *GetFindingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFindingsResponse.ProtoReflect.Descriptor instead.
 func (*GetFindingsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{49}
+	return file_harness_callback_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetFindingsResponse) GetFindings() []*Finding {
@@ -3642,7 +3953,7 @@ type FindingFilter struct {
 
 func (x *FindingFilter) Reset() {
 	*x = FindingFilter{}
-	mi := &file_harness_callback_proto_msgTypes[50]
+	mi := &file_harness_callback_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3654,7 +3965,7 @@ func (x *FindingFilter) String() string {
 func (*FindingFilter) ProtoMessage() {}
 
 func (x *FindingFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[50]
+	mi := &file_harness_callback_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3667,7 +3978,7 @@ func (x *FindingFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindingFilter.ProtoReflect.Descriptor instead.
 func (*FindingFilter) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{50}
+	return file_harness_callback_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *FindingFilter) GetMissionId() string {
@@ -3716,7 +4027,7 @@ type MemoryGetRequest struct {
 
 func (x *MemoryGetRequest) Reset() {
 	*x = MemoryGetRequest{}
-	mi := &file_harness_callback_proto_msgTypes[51]
+	mi := &file_harness_callback_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3728,7 +4039,7 @@ func (x *MemoryGetRequest) String() string {
 func (*MemoryGetRequest) ProtoMessage() {}
 
 func (x *MemoryGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[51]
+	mi := &file_harness_callback_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3741,7 +4052,7 @@ func (x *MemoryGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryGetRequest.ProtoReflect.Descriptor instead.
 func (*MemoryGetRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{51}
+	return file_harness_callback_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *MemoryGetRequest) GetContext() *ContextInfo {
@@ -3779,7 +4090,7 @@ type MemoryGetResponse struct {
 
 func (x *MemoryGetResponse) Reset() {
 	*x = MemoryGetResponse{}
-	mi := &file_harness_callback_proto_msgTypes[52]
+	mi := &file_harness_callback_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3791,7 +4102,7 @@ func (x *MemoryGetResponse) String() string {
 func (*MemoryGetResponse) ProtoMessage() {}
 
 func (x *MemoryGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[52]
+	mi := &file_harness_callback_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3804,7 +4115,7 @@ func (x *MemoryGetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryGetResponse.ProtoReflect.Descriptor instead.
 func (*MemoryGetResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{52}
+	return file_harness_callback_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *MemoryGetResponse) GetValue() *TypedValue {
@@ -3862,7 +4173,7 @@ type MemorySetRequest struct {
 
 func (x *MemorySetRequest) Reset() {
 	*x = MemorySetRequest{}
-	mi := &file_harness_callback_proto_msgTypes[53]
+	mi := &file_harness_callback_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3874,7 +4185,7 @@ func (x *MemorySetRequest) String() string {
 func (*MemorySetRequest) ProtoMessage() {}
 
 func (x *MemorySetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[53]
+	mi := &file_harness_callback_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3887,7 +4198,7 @@ func (x *MemorySetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemorySetRequest.ProtoReflect.Descriptor instead.
 func (*MemorySetRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{53}
+	return file_harness_callback_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *MemorySetRequest) GetContext() *ContextInfo {
@@ -3934,7 +4245,7 @@ type MemorySetResponse struct {
 
 func (x *MemorySetResponse) Reset() {
 	*x = MemorySetResponse{}
-	mi := &file_harness_callback_proto_msgTypes[54]
+	mi := &file_harness_callback_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3946,7 +4257,7 @@ func (x *MemorySetResponse) String() string {
 func (*MemorySetResponse) ProtoMessage() {}
 
 func (x *MemorySetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[54]
+	mi := &file_harness_callback_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3959,7 +4270,7 @@ func (x *MemorySetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemorySetResponse.ProtoReflect.Descriptor instead.
 func (*MemorySetResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{54}
+	return file_harness_callback_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *MemorySetResponse) GetError() *HarnessError {
@@ -3980,7 +4291,7 @@ type MemoryDeleteRequest struct {
 
 func (x *MemoryDeleteRequest) Reset() {
 	*x = MemoryDeleteRequest{}
-	mi := &file_harness_callback_proto_msgTypes[55]
+	mi := &file_harness_callback_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3992,7 +4303,7 @@ func (x *MemoryDeleteRequest) String() string {
 func (*MemoryDeleteRequest) ProtoMessage() {}
 
 func (x *MemoryDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[55]
+	mi := &file_harness_callback_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4005,7 +4316,7 @@ func (x *MemoryDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryDeleteRequest.ProtoReflect.Descriptor instead.
 func (*MemoryDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{55}
+	return file_harness_callback_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *MemoryDeleteRequest) GetContext() *ContextInfo {
@@ -4038,7 +4349,7 @@ type MemoryDeleteResponse struct {
 
 func (x *MemoryDeleteResponse) Reset() {
 	*x = MemoryDeleteResponse{}
-	mi := &file_harness_callback_proto_msgTypes[56]
+	mi := &file_harness_callback_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4050,7 +4361,7 @@ func (x *MemoryDeleteResponse) String() string {
 func (*MemoryDeleteResponse) ProtoMessage() {}
 
 func (x *MemoryDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[56]
+	mi := &file_harness_callback_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4063,7 +4374,7 @@ func (x *MemoryDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryDeleteResponse.ProtoReflect.Descriptor instead.
 func (*MemoryDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{56}
+	return file_harness_callback_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *MemoryDeleteResponse) GetError() *HarnessError {
@@ -4084,7 +4395,7 @@ type MemoryListRequest struct {
 
 func (x *MemoryListRequest) Reset() {
 	*x = MemoryListRequest{}
-	mi := &file_harness_callback_proto_msgTypes[57]
+	mi := &file_harness_callback_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4096,7 +4407,7 @@ func (x *MemoryListRequest) String() string {
 func (*MemoryListRequest) ProtoMessage() {}
 
 func (x *MemoryListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[57]
+	mi := &file_harness_callback_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4109,7 +4420,7 @@ func (x *MemoryListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryListRequest.ProtoReflect.Descriptor instead.
 func (*MemoryListRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{57}
+	return file_harness_callback_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *MemoryListRequest) GetContext() *ContextInfo {
@@ -4143,7 +4454,7 @@ type MemoryListResponse struct {
 
 func (x *MemoryListResponse) Reset() {
 	*x = MemoryListResponse{}
-	mi := &file_harness_callback_proto_msgTypes[58]
+	mi := &file_harness_callback_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4155,7 +4466,7 @@ func (x *MemoryListResponse) String() string {
 func (*MemoryListResponse) ProtoMessage() {}
 
 func (x *MemoryListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[58]
+	mi := &file_harness_callback_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4168,7 +4479,7 @@ func (x *MemoryListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoryListResponse.ProtoReflect.Descriptor instead.
 func (*MemoryListResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{58}
+	return file_harness_callback_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *MemoryListResponse) GetKeys() []string {
@@ -4186,17 +4497,21 @@ func (x *MemoryListResponse) GetError() *HarnessError {
 }
 
 type MissionMemorySearchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Query   string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Limit   int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Metadata filter: exact match per key, or a list / {"$in": [...]} to
+	// match any of several values
+	Filter        map[string]*TypedValue `protobuf:"bytes,4,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MissionMemorySearchRequest) Reset() {
 	*x = MissionMemorySearchRequest{}
-	mi := &file_harness_callback_proto_msgTypes[59]
+	mi := &file_harness_callback_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4208,7 +4523,7 @@ func (x *MissionMemorySearchRequest) String() string {
 func (*MissionMemorySearchRequest) ProtoMessage() {}
 
 func (x *MissionMemorySearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[59]
+	mi := &file_harness_callback_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4221,7 +4536,7 @@ func (x *MissionMemorySearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissionMemorySearchRequest.ProtoReflect.Descriptor instead.
 func (*MissionMemorySearchRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{59}
+	return file_harness_callback_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *MissionMemorySearchRequest) GetContext() *ContextInfo {
@@ -4245,17 +4560,33 @@ func (x *MissionMemorySearchRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *MissionMemorySearchRequest) GetFilter() map[string]*TypedValue {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MissionMemorySearchRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type MissionMemorySearchResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*MissionMemoryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*MissionMemoryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Error   *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Total matches before offset and limit are applied
+	Total         int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MissionMemorySearchResponse) Reset() {
 	*x = MissionMemorySearchResponse{}
-	mi := &file_harness_callback_proto_msgTypes[60]
+	mi := &file_harness_callback_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4267,7 +4598,7 @@ func (x *MissionMemorySearchResponse) String() string {
 func (*MissionMemorySearchResponse) ProtoMessage() {}
 
 func (x *MissionMemorySearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[60]
+	mi := &file_harness_callback_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4280,7 +4611,7 @@ func (x *MissionMemorySearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissionMemorySearchResponse.ProtoReflect.Descriptor instead.
 func (*MissionMemorySearchResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{60}
+	return file_harness_callback_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *MissionMemorySearchResponse) GetResults() []*MissionMemoryResult {
@@ -4297,6 +4628,13 @@ func (x *MissionMemorySearchResponse) GetError() *HarnessError {
 	return nil
 }
 
+func (x *MissionMemorySearchResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type MissionMemoryResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -4311,7 +4649,7 @@ type MissionMemoryResult struct {
 
 func (x *MissionMemoryResult) Reset() {
 	*x = MissionMemoryResult{}
-	mi := &file_harness_callback_proto_msgTypes[61]
+	mi := &file_harness_callback_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4323,7 +4661,7 @@ func (x *MissionMemoryResult) String() string {
 func (*MissionMemoryResult) ProtoMessage() {}
 
 func (x *MissionMemoryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[61]
+	mi := &file_harness_callback_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4336,7 +4674,7 @@ func (x *MissionMemoryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissionMemoryResult.ProtoReflect.Descriptor instead.
 func (*MissionMemoryResult) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{61}
+	return file_harness_callback_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *MissionMemoryResult) GetKey() string {
@@ -4382,16 +4720,19 @@ func (x *MissionMemoryResult) GetUpdatedAt() string {
 }
 
 type MissionMemoryHistoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Limit   int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Metadata filter, same semantics as MissionMemorySearchRequest.filter
+	Filter        map[string]*TypedValue `protobuf:"bytes,3,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MissionMemoryHistoryRequest) Reset() {
 	*x = MissionMemoryHistoryRequest{}
-	mi := &file_harness_callback_proto_msgTypes[62]
+	mi := &file_harness_callback_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4403,7 +4744,7 @@ func (x *MissionMemoryHistoryRequest) String() string {
 func (*MissionMemoryHistoryRequest) ProtoMessage() {}
 
 func (x *MissionMemoryHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[62]
+	mi := &file_harness_callback_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4416,7 +4757,7 @@ func (x *MissionMemoryHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissionMemoryHistoryRequest.ProtoReflect.Descriptor instead.
 func (*MissionMemoryHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{62}
+	return file_harness_callback_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *MissionMemoryHistoryRequest) GetContext() *ContextInfo {
@@ -4433,17 +4774,33 @@ func (x *MissionMemoryHistoryRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *MissionMemoryHistoryRequest) GetFilter() map[string]*TypedValue {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MissionMemoryHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type MissionMemoryHistoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*MissionMemoryItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*MissionMemoryItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Error *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Total matches before offset and limit are applied
+	Total         int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MissionMemoryHistoryResponse) Reset() {
 	*x = MissionMemoryHistoryResponse{}
-	mi := &file_harness_callback_proto_msgTypes[63]
+	mi := &file_harness_callback_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4455,7 +4812,7 @@ func (x *MissionMemoryHistoryResponse) String() string {
 func (*MissionMemoryHistoryResponse) ProtoMessage() {}
 
 func (x *MissionMemoryHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[63]
+	mi := &file_harness_callback_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4468,7 +4825,7 @@ func (x *MissionMemoryHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissionMemoryHistoryResponse.ProtoReflect.Descriptor instead.
 func (*MissionMemoryHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{63}
+	return file_harness_callback_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *MissionMemoryHistoryResponse) GetItems() []*MissionMemoryItem {
@@ -4485,6 +4842,13 @@ func (x *MissionMemoryHistoryResponse) GetError() *HarnessError {
 	return nil
 }
 
+func (x *MissionMemoryHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type MissionMemoryItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -4498,7 +4862,7 @@ type MissionMemoryItem struct {
 
 func (x *MissionMemoryItem) Reset() {
 	*x = MissionMemoryItem{}
-	mi := &file_harness_callback_proto_msgTypes[64]
+	mi := &file_harness_callback_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4510,7 +4874,7 @@ func (x *MissionMemoryItem) String() string {
 func (*MissionMemoryItem) ProtoMessage() {}
 
 func (x *MissionMemoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[64]
+	mi := &file_harness_callback_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4523,7 +4887,7 @@ func (x *MissionMemoryItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissionMemoryItem.ProtoReflect.Descriptor instead.
 func (*MissionMemoryItem) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{64}
+	return file_harness_callback_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *MissionMemoryItem) GetKey() string {
@@ -4571,7 +4935,7 @@ type MissionMemoryGetPreviousRunValueRequest struct {
 
 func (x *MissionMemoryGetPreviousRunValueRequest) Reset() {
 	*x = MissionMemoryGetPreviousRunValueRequest{}
-	mi := &file_harness_callback_proto_msgTypes[65]
+	mi := &file_harness_callback_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4583,7 +4947,7 @@ func (x *MissionMemoryGetPreviousRunValueRequest) String() string {
 func (*MissionMemoryGetPreviousRunValueRequest) ProtoMessage() {}
 
 func (x *MissionMemoryGetPreviousRunValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[65]
+	mi := &file_harness_callback_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4596,7 +4960,7 @@ func (x *MissionMemoryGetPreviousRunValueRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use MissionMemoryGetPreviousRunValueRequest.ProtoReflect.Descriptor instead.
 func (*MissionMemoryGetPreviousRunValueRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{65}
+	return file_harness_callback_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *MissionMemoryGetPreviousRunValueRequest) GetContext() *ContextInfo {
@@ -4624,7 +4988,7 @@ type MissionMemoryGetPreviousRunValueResponse struct {
 
 func (x *MissionMemoryGetPreviousRunValueResponse) Reset() {
 	*x = MissionMemoryGetPreviousRunValueResponse{}
-	mi := &file_harness_callback_proto_msgTypes[66]
+	mi := &file_harness_callback_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4636,7 +5000,7 @@ func (x *MissionMemoryGetPreviousRunValueResponse) String() string {
 func (*MissionMemoryGetPreviousRunValueResponse) ProtoMessage() {}
 
 func (x *MissionMemoryGetPreviousRunValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[66]
+	mi := &file_harness_callback_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4649,7 +5013,7 @@ func (x *MissionMemoryGetPreviousRunValueResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use MissionMemoryGetPreviousRunValueResponse.ProtoReflect.Descriptor instead.
 func (*MissionMemoryGetPreviousRunValueResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{66}
+	return file_harness_callback_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *MissionMemoryGetPreviousRunValueResponse) GetValue() *TypedValue {
@@ -4683,7 +5047,7 @@ type MissionMemoryGetValueHistoryRequest struct {
 
 func (x *MissionMemoryGetValueHistoryRequest) Reset() {
 	*x = MissionMemoryGetValueHistoryRequest{}
-	mi := &file_harness_callback_proto_msgTypes[67]
+	mi := &file_harness_callback_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4695,7 +5059,7 @@ func (x *MissionMemoryGetValueHistoryRequest) String() string {
 func (*MissionMemoryGetValueHistoryRequest) ProtoMessage() {}
 
 func (x *MissionMemoryGetValueHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[67]
+	mi := &file_harness_callback_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4708,7 +5072,7 @@ func (x *MissionMemoryGetValueHistoryRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use MissionMemoryGetValueHistoryRequest.ProtoReflect.Descriptor instead.
 func (*MissionMemoryGetValueHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{67}
+	return file_harness_callback_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *MissionMemoryGetValueHistoryRequest) GetContext() *ContextInfo {
@@ -4735,7 +5099,7 @@ type MissionMemoryGetValueHistoryResponse struct {
 
 func (x *MissionMemoryGetValueHistoryResponse) Reset() {
 	*x = MissionMemoryGetValueHistoryResponse{}
-	mi := &file_harness_callback_proto_msgTypes[68]
+	mi := &file_harness_callback_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4747,7 +5111,7 @@ func (x *MissionMemoryGetValueHistoryResponse) String() string {
 func (*MissionMemoryGetValueHistoryResponse) ProtoMessage() {}
 
 func (x *MissionMemoryGetValueHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[68]
+	mi := &file_harness_callback_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4760,7 +5124,7 @@ func (x *MissionMemoryGetValueHistoryResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use MissionMemoryGetValueHistoryResponse.ProtoReflect.Descriptor instead.
 func (*MissionMemoryGetValueHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{68}
+	return file_harness_callback_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *MissionMemoryGetValueHistoryResponse) GetValues() []*HistoricalValueItem {
@@ -4789,7 +5153,7 @@ type HistoricalValueItem struct {
 
 func (x *HistoricalValueItem) Reset() {
 	*x = HistoricalValueItem{}
-	mi := &file_harness_callback_proto_msgTypes[69]
+	mi := &file_harness_callback_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4801,7 +5165,7 @@ func (x *HistoricalValueItem) String() string {
 func (*HistoricalValueItem) ProtoMessage() {}
 
 func (x *HistoricalValueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[69]
+	mi := &file_harness_callback_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4814,7 +5178,7 @@ func (x *HistoricalValueItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalValueItem.ProtoReflect.Descriptor instead.
 func (*HistoricalValueItem) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{69}
+	return file_harness_callback_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *HistoricalValueItem) GetValue() *TypedValue {
@@ -4854,7 +5218,7 @@ type MissionMemoryContinuityModeRequest struct {
 
 func (x *MissionMemoryContinuityModeRequest) Reset() {
 	*x = MissionMemoryContinuityModeRequest{}
-	mi := &file_harness_callback_proto_msgTypes[70]
+	mi := &file_harness_callback_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4866,7 +5230,7 @@ func (x *MissionMemoryContinuityModeRequest) String() string {
 func (*MissionMemoryContinuityModeRequest) ProtoMessage() {}
 
 func (x *MissionMemoryContinuityModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[70]
+	mi := &file_harness_callback_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4879,7 +5243,7 @@ func (x *MissionMemoryContinuityModeRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MissionMemoryContinuityModeRequest.ProtoReflect.Descriptor instead.
 func (*MissionMemoryContinuityModeRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{70}
+	return file_harness_callback_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *MissionMemoryContinuityModeRequest) GetContext() *ContextInfo {
@@ -4899,7 +5263,7 @@ type MissionMemoryContinuityModeResponse struct {
 
 func (x *MissionMemoryContinuityModeResponse) Reset() {
 	*x = MissionMemoryContinuityModeResponse{}
-	mi := &file_harness_callback_proto_msgTypes[71]
+	mi := &file_harness_callback_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4911,7 +5275,7 @@ func (x *MissionMemoryContinuityModeResponse) String() string {
 func (*MissionMemoryContinuityModeResponse) ProtoMessage() {}
 
 func (x *MissionMemoryContinuityModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[71]
+	mi := &file_harness_callback_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4924,7 +5288,7 @@ func (x *MissionMemoryContinuityModeResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use MissionMemoryContinuityModeResponse.ProtoReflect.Descriptor instead.
 func (*MissionMemoryContinuityModeResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{71}
+	return file_harness_callback_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *MissionMemoryContinuityModeResponse) GetMode() string {
@@ -4952,7 +5316,7 @@ type LongTermMemoryStoreRequest struct {
 
 func (x *LongTermMemoryStoreRequest) Reset() {
 	*x = LongTermMemoryStoreRequest{}
-	mi := &file_harness_callback_proto_msgTypes[72]
+	mi := &file_harness_callback_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4964,7 +5328,7 @@ func (x *LongTermMemoryStoreRequest) String() string {
 func (*LongTermMemoryStoreRequest) ProtoMessage() {}
 
 func (x *LongTermMemoryStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[72]
+	mi := &file_harness_callback_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4977,7 +5341,7 @@ func (x *LongTermMemoryStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongTermMemoryStoreRequest.ProtoReflect.Descriptor instead.
 func (*LongTermMemoryStoreRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{72}
+	return file_harness_callback_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *LongTermMemoryStoreRequest) GetContext() *ContextInfo {
@@ -5011,7 +5375,7 @@ type LongTermMemoryStoreResponse struct {
 
 func (x *LongTermMemoryStoreResponse) Reset() {
 	*x = LongTermMemoryStoreResponse{}
-	mi := &file_harness_callback_proto_msgTypes[73]
+	mi := &file_harness_callback_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5023,7 +5387,7 @@ func (x *LongTermMemoryStoreResponse) String() string {
 func (*LongTermMemoryStoreResponse) ProtoMessage() {}
 
 func (x *LongTermMemoryStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[73]
+	mi := &file_harness_callback_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5036,7 +5400,7 @@ func (x *LongTermMemoryStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongTermMemoryStoreResponse.ProtoReflect.Descriptor instead.
 func (*LongTermMemoryStoreResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{73}
+	return file_harness_callback_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *LongTermMemoryStoreResponse) GetId() string {
@@ -5065,7 +5429,7 @@ type LongTermMemorySearchRequest struct {
 
 func (x *LongTermMemorySearchRequest) Reset() {
 	*x = LongTermMemorySearchRequest{}
-	mi := &file_harness_callback_proto_msgTypes[74]
+	mi := &file_harness_callback_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5077,7 +5441,7 @@ func (x *LongTermMemorySearchRequest) String() string {
 func (*LongTermMemorySearchRequest) ProtoMessage() {}
 
 func (x *LongTermMemorySearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[74]
+	mi := &file_harness_callback_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5090,7 +5454,7 @@ func (x *LongTermMemorySearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongTermMemorySearchRequest.ProtoReflect.Descriptor instead.
 func (*LongTermMemorySearchRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{74}
+	return file_harness_callback_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *LongTermMemorySearchRequest) GetContext() *ContextInfo {
@@ -5131,7 +5495,7 @@ type LongTermMemorySearchResponse struct {
 
 func (x *LongTermMemorySearchResponse) Reset() {
 	*x = LongTermMemorySearchResponse{}
-	mi := &file_harness_callback_proto_msgTypes[75]
+	mi := &file_harness_callback_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5143,7 +5507,7 @@ func (x *LongTermMemorySearchResponse) String() string {
 func (*LongTermMemorySearchResponse) ProtoMessage() {}
 
 func (x *LongTermMemorySearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[75]
+	mi := &file_harness_callback_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5156,7 +5520,7 @@ func (x *LongTermMemorySearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongTermMemorySearchResponse.ProtoReflect.Descriptor instead.
 func (*LongTermMemorySearchResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{75}
+	return file_harness_callback_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *LongTermMemorySearchResponse) GetResults() []*LongTermMemoryResult {
@@ -5186,7 +5550,7 @@ type LongTermMemoryResult struct {
 
 func (x *LongTermMemoryResult) Reset() {
 	*x = LongTermMemoryResult{}
-	mi := &file_harness_callback_proto_msgTypes[76]
+	mi := &file_harness_callback_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5198,7 +5562,7 @@ func (x *LongTermMemoryResult) String() string {
 func (*LongTermMemoryResult) ProtoMessage() {}
 
 func (x *LongTermMemoryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[76]
+	mi := &file_harness_callback_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5211,7 +5575,7 @@ func (x *LongTermMemoryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongTermMemoryResult.ProtoReflect.Descriptor instead.
 func (*LongTermMemoryResult) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{76}
+	return file_harness_callback_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *LongTermMemoryResult) GetId() string {
@@ -5259,7 +5623,7 @@ type LongTermMemoryDeleteRequest struct {
 
 func (x *LongTermMemoryDeleteRequest) Reset() {
 	*x = LongTermMemoryDeleteRequest{}
-	mi := &file_harness_callback_proto_msgTypes[77]
+	mi := &file_harness_callback_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5271,7 +5635,7 @@ func (x *LongTermMemoryDeleteRequest) String() string {
 func (*LongTermMemoryDeleteRequest) ProtoMessage() {}
 
 func (x *LongTermMemoryDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[77]
+	mi := &file_harness_callback_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5284,7 +5648,7 @@ func (x *LongTermMemoryDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongTermMemoryDeleteRequest.ProtoReflect.Descriptor instead.
 func (*LongTermMemoryDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{77}
+	return file_harness_callback_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *LongTermMemoryDeleteRequest) GetContext() *ContextInfo {
@@ -5310,7 +5674,7 @@ type LongTermMemoryDeleteResponse struct {
 
 func (x *LongTermMemoryDeleteResponse) Reset() {
 	*x = LongTermMemoryDeleteResponse{}
-	mi := &file_harness_callback_proto_msgTypes[78]
+	mi := &file_harness_callback_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5322,7 +5686,7 @@ func (x *LongTermMemoryDeleteResponse) String() string {
 func (*LongTermMemoryDeleteResponse) ProtoMessage() {}
 
 func (x *LongTermMemoryDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[78]
+	mi := &file_harness_callback_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5335,7 +5699,7 @@ func (x *LongTermMemoryDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LongTermMemoryDeleteResponse.ProtoReflect.Descriptor instead.
 func (*LongTermMemoryDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{78}
+	return file_harness_callback_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *LongTermMemoryDeleteResponse) GetError() *HarnessError {
@@ -5355,7 +5719,7 @@ type GraphRAGQueryRequest struct {
 
 func (x *GraphRAGQueryRequest) Reset() {
 	*x = GraphRAGQueryRequest{}
-	mi := &file_harness_callback_proto_msgTypes[79]
+	mi := &file_harness_callback_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5367,7 +5731,7 @@ func (x *GraphRAGQueryRequest) String() string {
 func (*GraphRAGQueryRequest) ProtoMessage() {}
 
 func (x *GraphRAGQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[79]
+	mi := &file_harness_callback_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5380,7 +5744,7 @@ func (x *GraphRAGQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphRAGQueryRequest.ProtoReflect.Descriptor instead.
 func (*GraphRAGQueryRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{79}
+	return file_harness_callback_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GraphRAGQueryRequest) GetContext() *ContextInfo {
@@ -5407,7 +5771,7 @@ type GraphRAGQueryResponse struct {
 
 func (x *GraphRAGQueryResponse) Reset() {
 	*x = GraphRAGQueryResponse{}
-	mi := &file_harness_callback_proto_msgTypes[80]
+	mi := &file_harness_callback_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5419,7 +5783,7 @@ func (x *GraphRAGQueryResponse) String() string {
 func (*GraphRAGQueryResponse) ProtoMessage() {}
 
 func (x *GraphRAGQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[80]
+	mi := &file_harness_callback_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5432,7 +5796,7 @@ func (x *GraphRAGQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphRAGQueryResponse.ProtoReflect.Descriptor instead.
 func (*GraphRAGQueryResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{80}
+	return file_harness_callback_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GraphRAGQueryResponse) GetResults() []*GraphRAGResult {
@@ -5449,33 +5813,30 @@ func (x *GraphRAGQueryResponse) GetError() *HarnessError {
 	return nil
 }
 
-type GraphRAGResult struct {
+// GraphRAGQueryBatchRequest runs several queries in one round trip.
+type GraphRAGQueryBatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *GraphNode             `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
-	VectorScore   float64                `protobuf:"fixed64,3,opt,name=vector_score,json=vectorScore,proto3" json:"vector_score,omitempty"`
-	GraphScore    float64                `protobuf:"fixed64,4,opt,name=graph_score,json=graphScore,proto3" json:"graph_score,omitempty"`
-	Path          []string               `protobuf:"bytes,5,rep,name=path,proto3" json:"path,omitempty"`
-	Distance      int32                  `protobuf:"varint,6,opt,name=distance,proto3" json:"distance,omitempty"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Queries       []*GraphQuery          `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GraphRAGResult) Reset() {
-	*x = GraphRAGResult{}
-	mi := &file_harness_callback_proto_msgTypes[81]
+func (x *GraphRAGQueryBatchRequest) Reset() {
+	*x = GraphRAGQueryBatchRequest{}
+	mi := &file_harness_callback_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GraphRAGResult) String() string {
+func (x *GraphRAGQueryBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GraphRAGResult) ProtoMessage() {}
+func (*GraphRAGQueryBatchRequest) ProtoMessage() {}
 
-func (x *GraphRAGResult) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[81]
+func (x *GraphRAGQueryBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5486,82 +5847,50 @@ func (x *GraphRAGResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GraphRAGResult.ProtoReflect.Descriptor instead.
-func (*GraphRAGResult) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use GraphRAGQueryBatchRequest.ProtoReflect.Descriptor instead.
+func (*GraphRAGQueryBatchRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GraphRAGResult) GetNode() *GraphNode {
+func (x *GraphRAGQueryBatchRequest) GetContext() *ContextInfo {
 	if x != nil {
-		return x.Node
+		return x.Context
 	}
 	return nil
 }
 
-func (x *GraphRAGResult) GetScore() float64 {
+func (x *GraphRAGQueryBatchRequest) GetQueries() []*GraphQuery {
 	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *GraphRAGResult) GetVectorScore() float64 {
-	if x != nil {
-		return x.VectorScore
-	}
-	return 0
-}
-
-func (x *GraphRAGResult) GetGraphScore() float64 {
-	if x != nil {
-		return x.GraphScore
-	}
-	return 0
-}
-
-func (x *GraphRAGResult) GetPath() []string {
-	if x != nil {
-		return x.Path
+		return x.Queries
 	}
 	return nil
 }
 
-func (x *GraphRAGResult) GetDistance() int32 {
-	if x != nil {
-		return x.Distance
-	}
-	return 0
-}
-
-type GraphNode struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Properties    map[string]*TypedValue `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	MissionId     string                 `protobuf:"bytes,5,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
-	AgentName     string                 `protobuf:"bytes,6,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
-	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+// GraphRAGQueryBatchResponse holds one item per query, in request order.
+// error is set only if the batch as a whole failed.
+type GraphRAGQueryBatchResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*GraphRAGQueryBatchItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Error         *HarnessError             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GraphNode) Reset() {
-	*x = GraphNode{}
-	mi := &file_harness_callback_proto_msgTypes[82]
+func (x *GraphRAGQueryBatchResponse) Reset() {
+	*x = GraphRAGQueryBatchResponse{}
+	mi := &file_harness_callback_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GraphNode) String() string {
+func (x *GraphRAGQueryBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GraphNode) ProtoMessage() {}
+func (*GraphRAGQueryBatchResponse) ProtoMessage() {}
 
-func (x *GraphNode) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[82]
+func (x *GraphRAGQueryBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5572,91 +5901,105 @@ func (x *GraphNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
-func (*GraphNode) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use GraphRAGQueryBatchResponse.ProtoReflect.Descriptor instead.
+func (*GraphRAGQueryBatchResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GraphNode) GetId() string {
+func (x *GraphRAGQueryBatchResponse) GetItems() []*GraphRAGQueryBatchItem {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *GraphNode) GetType() string {
+func (x *GraphRAGQueryBatchResponse) GetError() *HarnessError {
 	if x != nil {
-		return x.Type
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-func (x *GraphNode) GetProperties() map[string]*TypedValue {
-	if x != nil {
-		return x.Properties
-	}
-	return nil
+// GraphRAGQueryBatchItem is the outcome of one query of a batch: its
+// results, or the error that query failed with.
+type GraphRAGQueryBatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*GraphRAGResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GraphNode) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x *GraphRAGQueryBatchItem) Reset() {
+	*x = GraphRAGQueryBatchItem{}
+	mi := &file_harness_callback_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GraphNode) GetMissionId() string {
-	if x != nil {
-		return x.MissionId
-	}
-	return ""
+func (x *GraphRAGQueryBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GraphNode) GetAgentName() string {
+func (*GraphRAGQueryBatchItem) ProtoMessage() {}
+
+func (x *GraphRAGQueryBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[87]
 	if x != nil {
-		return x.AgentName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GraphNode) GetCreatedAt() int64 {
+// Deprecated: Use GraphRAGQueryBatchItem.ProtoReflect.Descriptor instead.
+func (*GraphRAGQueryBatchItem) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GraphRAGQueryBatchItem) GetResults() []*GraphRAGResult {
 	if x != nil {
-		return x.CreatedAt
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *GraphNode) GetUpdatedAt() int64 {
+func (x *GraphRAGQueryBatchItem) GetError() *HarnessError {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Error
 	}
-	return 0
+	return nil
 }
 
-type FindSimilarAttacksRequest struct {
+// GraphRAGExplainRequest asks the daemon how it would execute a query
+// without running it.
+type GraphRAGExplainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	TopK          int32                  `protobuf:"varint,3,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	Query         *GraphQuery            `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	MaxHops       int32                  `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindSimilarAttacksRequest) Reset() {
-	*x = FindSimilarAttacksRequest{}
-	mi := &file_harness_callback_proto_msgTypes[83]
+func (x *GraphRAGExplainRequest) Reset() {
+	*x = GraphRAGExplainRequest{}
+	mi := &file_harness_callback_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindSimilarAttacksRequest) String() string {
+func (x *GraphRAGExplainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindSimilarAttacksRequest) ProtoMessage() {}
+func (*GraphRAGExplainRequest) ProtoMessage() {}
 
-func (x *FindSimilarAttacksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[83]
+func (x *GraphRAGExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5667,55 +6010,55 @@ func (x *FindSimilarAttacksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindSimilarAttacksRequest.ProtoReflect.Descriptor instead.
-func (*FindSimilarAttacksRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use GraphRAGExplainRequest.ProtoReflect.Descriptor instead.
+func (*GraphRAGExplainRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *FindSimilarAttacksRequest) GetContext() *ContextInfo {
+func (x *GraphRAGExplainRequest) GetContext() *ContextInfo {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *FindSimilarAttacksRequest) GetContent() string {
+func (x *GraphRAGExplainRequest) GetQuery() *GraphQuery {
 	if x != nil {
-		return x.Content
+		return x.Query
 	}
-	return ""
+	return nil
 }
 
-func (x *FindSimilarAttacksRequest) GetTopK() int32 {
+func (x *GraphRAGExplainRequest) GetMaxHops() int32 {
 	if x != nil {
-		return x.TopK
+		return x.MaxHops
 	}
 	return 0
 }
 
-type FindSimilarAttacksResponse struct {
+type GraphRAGExplainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attacks       []*AttackPattern       `protobuf:"bytes,1,rep,name=attacks,proto3" json:"attacks,omitempty"`
+	Plan          *GraphRAGQueryPlan     `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindSimilarAttacksResponse) Reset() {
-	*x = FindSimilarAttacksResponse{}
-	mi := &file_harness_callback_proto_msgTypes[84]
+func (x *GraphRAGExplainResponse) Reset() {
+	*x = GraphRAGExplainResponse{}
+	mi := &file_harness_callback_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindSimilarAttacksResponse) String() string {
+func (x *GraphRAGExplainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindSimilarAttacksResponse) ProtoMessage() {}
+func (*GraphRAGExplainResponse) ProtoMessage() {}
 
-func (x *FindSimilarAttacksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[84]
+func (x *GraphRAGExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5726,52 +6069,60 @@ func (x *FindSimilarAttacksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindSimilarAttacksResponse.ProtoReflect.Descriptor instead.
-func (*FindSimilarAttacksResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use GraphRAGExplainResponse.ProtoReflect.Descriptor instead.
+func (*GraphRAGExplainResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *FindSimilarAttacksResponse) GetAttacks() []*AttackPattern {
+func (x *GraphRAGExplainResponse) GetPlan() *GraphRAGQueryPlan {
 	if x != nil {
-		return x.Attacks
+		return x.Plan
 	}
 	return nil
 }
 
-func (x *FindSimilarAttacksResponse) GetError() *HarnessError {
+func (x *GraphRAGExplainResponse) GetError() *HarnessError {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type AttackPattern struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TechniqueId   string                 `protobuf:"bytes,1,opt,name=technique_id,json=techniqueId,proto3" json:"technique_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Tactics       []string               `protobuf:"bytes,4,rep,name=tactics,proto3" json:"tactics,omitempty"`
-	Platforms     []string               `protobuf:"bytes,5,rep,name=platforms,proto3" json:"platforms,omitempty"`
-	Similarity    float64                `protobuf:"fixed64,6,opt,name=similarity,proto3" json:"similarity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GraphRAGQueryPlan describes the routing decision and effective parameters
+// for a GraphRAG query.
+type GraphRAGQueryPlan struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Route           string                 `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"` // "text", "embedding", or "structured"
+	TopK            int32                  `protobuf:"varint,2,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	MaxHops         int32                  `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	MinScore        float64                `protobuf:"fixed64,4,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	VectorWeight    float64                `protobuf:"fixed64,5,opt,name=vector_weight,json=vectorWeight,proto3" json:"vector_weight,omitempty"`
+	GraphWeight     float64                `protobuf:"fixed64,6,opt,name=graph_weight,json=graphWeight,proto3" json:"graph_weight,omitempty"`
+	NodeTypes       []string               `protobuf:"bytes,7,rep,name=node_types,json=nodeTypes,proto3" json:"node_types,omitempty"`
+	MissionId       string                 `protobuf:"bytes,8,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	MissionRunId    string                 `protobuf:"bytes,9,opt,name=mission_run_id,json=missionRunId,proto3" json:"mission_run_id,omitempty"`
+	PropertyFilters []*PropertyFilter      `protobuf:"bytes,10,rep,name=property_filters,json=propertyFilters,proto3" json:"property_filters,omitempty"`
+	Operations      []string               `protobuf:"bytes,11,rep,name=operations,proto3" json:"operations,omitempty"`
+	Errors          []string               `protobuf:"bytes,12,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AttackPattern) Reset() {
-	*x = AttackPattern{}
-	mi := &file_harness_callback_proto_msgTypes[85]
+func (x *GraphRAGQueryPlan) Reset() {
+	*x = GraphRAGQueryPlan{}
+	mi := &file_harness_callback_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackPattern) String() string {
+func (x *GraphRAGQueryPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackPattern) ProtoMessage() {}
+func (*GraphRAGQueryPlan) ProtoMessage() {}
 
-func (x *AttackPattern) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[85]
+func (x *GraphRAGQueryPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5782,77 +6133,120 @@ func (x *AttackPattern) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackPattern.ProtoReflect.Descriptor instead.
-func (*AttackPattern) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use GraphRAGQueryPlan.ProtoReflect.Descriptor instead.
+func (*GraphRAGQueryPlan) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *AttackPattern) GetTechniqueId() string {
+func (x *GraphRAGQueryPlan) GetRoute() string {
 	if x != nil {
-		return x.TechniqueId
+		return x.Route
 	}
 	return ""
 }
 
-func (x *AttackPattern) GetName() string {
+func (x *GraphRAGQueryPlan) GetTopK() int32 {
 	if x != nil {
-		return x.Name
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *GraphRAGQueryPlan) GetMaxHops() int32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *GraphRAGQueryPlan) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *GraphRAGQueryPlan) GetVectorWeight() float64 {
+	if x != nil {
+		return x.VectorWeight
+	}
+	return 0
+}
+
+func (x *GraphRAGQueryPlan) GetGraphWeight() float64 {
+	if x != nil {
+		return x.GraphWeight
+	}
+	return 0
+}
+
+func (x *GraphRAGQueryPlan) GetNodeTypes() []string {
+	if x != nil {
+		return x.NodeTypes
+	}
+	return nil
+}
+
+func (x *GraphRAGQueryPlan) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
 	}
 	return ""
 }
 
-func (x *AttackPattern) GetDescription() string {
+func (x *GraphRAGQueryPlan) GetMissionRunId() string {
 	if x != nil {
-		return x.Description
+		return x.MissionRunId
 	}
 	return ""
 }
 
-func (x *AttackPattern) GetTactics() []string {
+func (x *GraphRAGQueryPlan) GetPropertyFilters() []*PropertyFilter {
 	if x != nil {
-		return x.Tactics
+		return x.PropertyFilters
 	}
 	return nil
 }
 
-func (x *AttackPattern) GetPlatforms() []string {
+func (x *GraphRAGQueryPlan) GetOperations() []string {
 	if x != nil {
-		return x.Platforms
+		return x.Operations
 	}
 	return nil
 }
 
-func (x *AttackPattern) GetSimilarity() float64 {
+func (x *GraphRAGQueryPlan) GetErrors() []string {
 	if x != nil {
-		return x.Similarity
+		return x.Errors
 	}
-	return 0
+	return nil
 }
 
-type FindSimilarFindingsRequest struct {
+// GraphRAGStatsRequest asks for the size of the knowledge graph within a
+// mission scope. An empty mission_id means the calling agent's mission.
+type GraphRAGStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	FindingId     string                 `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
-	TopK          int32                  `protobuf:"varint,3,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	MissionId     string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindSimilarFindingsRequest) Reset() {
-	*x = FindSimilarFindingsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[86]
+func (x *GraphRAGStatsRequest) Reset() {
+	*x = GraphRAGStatsRequest{}
+	mi := &file_harness_callback_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindSimilarFindingsRequest) String() string {
+func (x *GraphRAGStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindSimilarFindingsRequest) ProtoMessage() {}
+func (*GraphRAGStatsRequest) ProtoMessage() {}
 
-func (x *FindSimilarFindingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[86]
+func (x *GraphRAGStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5863,55 +6257,48 @@ func (x *FindSimilarFindingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindSimilarFindingsRequest.ProtoReflect.Descriptor instead.
-func (*FindSimilarFindingsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use GraphRAGStatsRequest.ProtoReflect.Descriptor instead.
+func (*GraphRAGStatsRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *FindSimilarFindingsRequest) GetContext() *ContextInfo {
+func (x *GraphRAGStatsRequest) GetContext() *ContextInfo {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *FindSimilarFindingsRequest) GetFindingId() string {
+func (x *GraphRAGStatsRequest) GetMissionId() string {
 	if x != nil {
-		return x.FindingId
+		return x.MissionId
 	}
 	return ""
 }
 
-func (x *FindSimilarFindingsRequest) GetTopK() int32 {
-	if x != nil {
-		return x.TopK
-	}
-	return 0
-}
-
-type FindSimilarFindingsResponse struct {
+type GraphRAGStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Findings      []*FindingNode         `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	Stats         *GraphRAGStats         `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindSimilarFindingsResponse) Reset() {
-	*x = FindSimilarFindingsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[87]
+func (x *GraphRAGStatsResponse) Reset() {
+	*x = GraphRAGStatsResponse{}
+	mi := &file_harness_callback_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindSimilarFindingsResponse) String() string {
+func (x *GraphRAGStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindSimilarFindingsResponse) ProtoMessage() {}
+func (*GraphRAGStatsResponse) ProtoMessage() {}
 
-func (x *FindSimilarFindingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[87]
+func (x *GraphRAGStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5922,53 +6309,53 @@ func (x *FindSimilarFindingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindSimilarFindingsResponse.ProtoReflect.Descriptor instead.
-func (*FindSimilarFindingsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use GraphRAGStatsResponse.ProtoReflect.Descriptor instead.
+func (*GraphRAGStatsResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *FindSimilarFindingsResponse) GetFindings() []*FindingNode {
+func (x *GraphRAGStatsResponse) GetStats() *GraphRAGStats {
 	if x != nil {
-		return x.Findings
+		return x.Stats
 	}
 	return nil
 }
 
-func (x *FindSimilarFindingsResponse) GetError() *HarnessError {
+func (x *GraphRAGStatsResponse) GetError() *HarnessError {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type FindingNode struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
-	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	Confidence    float64                `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	Similarity    float64                `protobuf:"fixed64,7,opt,name=similarity,proto3" json:"similarity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GraphRAGStats summarizes node and relationship counts for a mission.
+type GraphRAGStats struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MissionId           string                 `protobuf:"bytes,1,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	NodeCount           int64                  `protobuf:"varint,2,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	RelationshipCount   int64                  `protobuf:"varint,3,opt,name=relationship_count,json=relationshipCount,proto3" json:"relationship_count,omitempty"`
+	NodesByType         map[string]int64       `protobuf:"bytes,4,rep,name=nodes_by_type,json=nodesByType,proto3" json:"nodes_by_type,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	RelationshipsByType map[string]int64       `protobuf:"bytes,5,rep,name=relationships_by_type,json=relationshipsByType,proto3" json:"relationships_by_type,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	AverageDegree       float64                `protobuf:"fixed64,6,opt,name=average_degree,json=averageDegree,proto3" json:"average_degree,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *FindingNode) Reset() {
-	*x = FindingNode{}
-	mi := &file_harness_callback_proto_msgTypes[88]
+func (x *GraphRAGStats) Reset() {
+	*x = GraphRAGStats{}
+	mi := &file_harness_callback_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindingNode) String() string {
+func (x *GraphRAGStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindingNode) ProtoMessage() {}
+func (*GraphRAGStats) ProtoMessage() {}
 
-func (x *FindingNode) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[88]
+func (x *GraphRAGStats) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5979,84 +6366,80 @@ func (x *FindingNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindingNode.ProtoReflect.Descriptor instead.
-func (*FindingNode) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{88}
-}
-
-func (x *FindingNode) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GraphRAGStats.ProtoReflect.Descriptor instead.
+func (*GraphRAGStats) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *FindingNode) GetTitle() string {
+func (x *GraphRAGStats) GetMissionId() string {
 	if x != nil {
-		return x.Title
+		return x.MissionId
 	}
 	return ""
 }
 
-func (x *FindingNode) GetDescription() string {
+func (x *GraphRAGStats) GetNodeCount() int64 {
 	if x != nil {
-		return x.Description
+		return x.NodeCount
 	}
-	return ""
+	return 0
 }
 
-func (x *FindingNode) GetSeverity() string {
+func (x *GraphRAGStats) GetRelationshipCount() int64 {
 	if x != nil {
-		return x.Severity
+		return x.RelationshipCount
 	}
-	return ""
+	return 0
 }
 
-func (x *FindingNode) GetCategory() string {
+func (x *GraphRAGStats) GetNodesByType() map[string]int64 {
 	if x != nil {
-		return x.Category
+		return x.NodesByType
 	}
-	return ""
+	return nil
 }
 
-func (x *FindingNode) GetConfidence() float64 {
+func (x *GraphRAGStats) GetRelationshipsByType() map[string]int64 {
 	if x != nil {
-		return x.Confidence
+		return x.RelationshipsByType
 	}
-	return 0
+	return nil
 }
 
-func (x *FindingNode) GetSimilarity() float64 {
+func (x *GraphRAGStats) GetAverageDegree() float64 {
 	if x != nil {
-		return x.Similarity
+		return x.AverageDegree
 	}
 	return 0
 }
 
-type GetAttackChainsRequest struct {
+type GraphRAGResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	TechniqueId   string                 `protobuf:"bytes,2,opt,name=technique_id,json=techniqueId,proto3" json:"technique_id,omitempty"`
-	MaxDepth      int32                  `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	Node          *GraphNode             `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	VectorScore   float64                `protobuf:"fixed64,3,opt,name=vector_score,json=vectorScore,proto3" json:"vector_score,omitempty"`
+	GraphScore    float64                `protobuf:"fixed64,4,opt,name=graph_score,json=graphScore,proto3" json:"graph_score,omitempty"`
+	Path          []string               `protobuf:"bytes,5,rep,name=path,proto3" json:"path,omitempty"`
+	Distance      int32                  `protobuf:"varint,6,opt,name=distance,proto3" json:"distance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttackChainsRequest) Reset() {
-	*x = GetAttackChainsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[89]
+func (x *GraphRAGResult) Reset() {
+	*x = GraphRAGResult{}
+	mi := &file_harness_callback_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttackChainsRequest) String() string {
+func (x *GraphRAGResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttackChainsRequest) ProtoMessage() {}
+func (*GraphRAGResult) ProtoMessage() {}
 
-func (x *GetAttackChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[89]
+func (x *GraphRAGResult) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6067,109 +6450,82 @@ func (x *GetAttackChainsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttackChainsRequest.ProtoReflect.Descriptor instead.
-func (*GetAttackChainsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use GraphRAGResult.ProtoReflect.Descriptor instead.
+func (*GraphRAGResult) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetAttackChainsRequest) GetContext() *ContextInfo {
+func (x *GraphRAGResult) GetNode() *GraphNode {
 	if x != nil {
-		return x.Context
+		return x.Node
 	}
 	return nil
 }
 
-func (x *GetAttackChainsRequest) GetTechniqueId() string {
+func (x *GraphRAGResult) GetScore() float64 {
 	if x != nil {
-		return x.TechniqueId
+		return x.Score
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAttackChainsRequest) GetMaxDepth() int32 {
+func (x *GraphRAGResult) GetVectorScore() float64 {
 	if x != nil {
-		return x.MaxDepth
+		return x.VectorScore
 	}
 	return 0
 }
 
-type GetAttackChainsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Chains        []*AttackChain         `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
-	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetAttackChainsResponse) Reset() {
-	*x = GetAttackChainsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[90]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetAttackChainsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetAttackChainsResponse) ProtoMessage() {}
-
-func (x *GetAttackChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[90]
+func (x *GraphRAGResult) GetGraphScore() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GraphScore
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetAttackChainsResponse.ProtoReflect.Descriptor instead.
-func (*GetAttackChainsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{90}
+	return 0
 }
 
-func (x *GetAttackChainsResponse) GetChains() []*AttackChain {
+func (x *GraphRAGResult) GetPath() []string {
 	if x != nil {
-		return x.Chains
+		return x.Path
 	}
 	return nil
 }
 
-func (x *GetAttackChainsResponse) GetError() *HarnessError {
+func (x *GraphRAGResult) GetDistance() int32 {
 	if x != nil {
-		return x.Error
+		return x.Distance
 	}
-	return nil
+	return 0
 }
 
-type AttackChain struct {
+type GraphNode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
-	Steps         []*AttackStep          `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Properties    map[string]*TypedValue `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	MissionId     string                 `protobuf:"bytes,5,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	AgentName     string                 `protobuf:"bytes,6,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackChain) Reset() {
-	*x = AttackChain{}
-	mi := &file_harness_callback_proto_msgTypes[91]
+func (x *GraphNode) Reset() {
+	*x = GraphNode{}
+	mi := &file_harness_callback_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackChain) String() string {
+func (x *GraphNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackChain) ProtoMessage() {}
+func (*GraphNode) ProtoMessage() {}
 
-func (x *AttackChain) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[91]
+func (x *GraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6180,65 +6536,1127 @@ func (x *AttackChain) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackChain.ProtoReflect.Descriptor instead.
-func (*AttackChain) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
+func (*GraphNode) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *AttackChain) GetId() string {
+func (x *GraphNode) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AttackChain) GetName() string {
+func (x *GraphNode) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *AttackChain) GetSeverity() string {
+func (x *GraphNode) GetProperties() map[string]*TypedValue {
 	if x != nil {
-		return x.Severity
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *GraphNode) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *AttackChain) GetSteps() []*AttackStep {
+func (x *GraphNode) GetMissionId() string {
 	if x != nil {
-		return x.Steps
+		return x.MissionId
 	}
-	return nil
+	return ""
 }
 
-type AttackStep struct {
+func (x *GraphNode) GetAgentName() string {
+	if x != nil {
+		return x.AgentName
+	}
+	return ""
+}
+
+func (x *GraphNode) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GraphNode) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type FindSimilarAttacksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         int32                  `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
-	TechniqueId   string                 `protobuf:"bytes,2,opt,name=technique_id,json=techniqueId,proto3" json:"technique_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Confidence    float64                `protobuf:"fixed64,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	TopK          int32                  `protobuf:"varint,3,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackStep) Reset() {
-	*x = AttackStep{}
-	mi := &file_harness_callback_proto_msgTypes[92]
+func (x *FindSimilarAttacksRequest) Reset() {
+	*x = FindSimilarAttacksRequest{}
+	mi := &file_harness_callback_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackStep) String() string {
+func (x *FindSimilarAttacksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackStep) ProtoMessage() {}
+func (*FindSimilarAttacksRequest) ProtoMessage() {}
+
+func (x *FindSimilarAttacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarAttacksRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarAttacksRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *FindSimilarAttacksRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *FindSimilarAttacksRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *FindSimilarAttacksRequest) GetTopK() int32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+type FindSimilarAttacksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attacks       []*AttackPattern       `protobuf:"bytes,1,rep,name=attacks,proto3" json:"attacks,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarAttacksResponse) Reset() {
+	*x = FindSimilarAttacksResponse{}
+	mi := &file_harness_callback_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarAttacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarAttacksResponse) ProtoMessage() {}
+
+func (x *FindSimilarAttacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarAttacksResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarAttacksResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *FindSimilarAttacksResponse) GetAttacks() []*AttackPattern {
+	if x != nil {
+		return x.Attacks
+	}
+	return nil
+}
+
+func (x *FindSimilarAttacksResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type AttackPattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TechniqueId   string                 `protobuf:"bytes,1,opt,name=technique_id,json=techniqueId,proto3" json:"technique_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Tactics       []string               `protobuf:"bytes,4,rep,name=tactics,proto3" json:"tactics,omitempty"`
+	Platforms     []string               `protobuf:"bytes,5,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	Similarity    float64                `protobuf:"fixed64,6,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackPattern) Reset() {
+	*x = AttackPattern{}
+	mi := &file_harness_callback_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackPattern) ProtoMessage() {}
+
+func (x *AttackPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackPattern.ProtoReflect.Descriptor instead.
+func (*AttackPattern) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AttackPattern) GetTechniqueId() string {
+	if x != nil {
+		return x.TechniqueId
+	}
+	return ""
+}
+
+func (x *AttackPattern) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttackPattern) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AttackPattern) GetTactics() []string {
+	if x != nil {
+		return x.Tactics
+	}
+	return nil
+}
+
+func (x *AttackPattern) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *AttackPattern) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type FindSimilarFindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	FindingId     string                 `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
+	TopK          int32                  `protobuf:"varint,3,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarFindingsRequest) Reset() {
+	*x = FindSimilarFindingsRequest{}
+	mi := &file_harness_callback_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarFindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarFindingsRequest) ProtoMessage() {}
+
+func (x *FindSimilarFindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarFindingsRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarFindingsRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FindSimilarFindingsRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *FindSimilarFindingsRequest) GetFindingId() string {
+	if x != nil {
+		return x.FindingId
+	}
+	return ""
+}
+
+func (x *FindSimilarFindingsRequest) GetTopK() int32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+type FindSimilarFindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*FindingNode         `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarFindingsResponse) Reset() {
+	*x = FindSimilarFindingsResponse{}
+	mi := &file_harness_callback_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarFindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarFindingsResponse) ProtoMessage() {}
+
+func (x *FindSimilarFindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarFindingsResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarFindingsResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *FindSimilarFindingsResponse) GetFindings() []*FindingNode {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *FindSimilarFindingsResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type FindingNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Similarity    float64                `protobuf:"fixed64,7,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindingNode) Reset() {
+	*x = FindingNode{}
+	mi := &file_harness_callback_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindingNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindingNode) ProtoMessage() {}
+
+func (x *FindingNode) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindingNode.ProtoReflect.Descriptor instead.
+func (*FindingNode) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *FindingNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FindingNode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FindingNode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FindingNode) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *FindingNode) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *FindingNode) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *FindingNode) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type GetAttackChainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	TechniqueId   string                 `protobuf:"bytes,2,opt,name=technique_id,json=techniqueId,proto3" json:"technique_id,omitempty"`
+	MaxDepth      int32                  `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttackChainsRequest) Reset() {
+	*x = GetAttackChainsRequest{}
+	mi := &file_harness_callback_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttackChainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttackChainsRequest) ProtoMessage() {}
+
+func (x *GetAttackChainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttackChainsRequest.ProtoReflect.Descriptor instead.
+func (*GetAttackChainsRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetAttackChainsRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetAttackChainsRequest) GetTechniqueId() string {
+	if x != nil {
+		return x.TechniqueId
+	}
+	return ""
+}
+
+func (x *GetAttackChainsRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type GetAttackChainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chains        []*AttackChain         `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttackChainsResponse) Reset() {
+	*x = GetAttackChainsResponse{}
+	mi := &file_harness_callback_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttackChainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttackChainsResponse) ProtoMessage() {}
+
+func (x *GetAttackChainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttackChainsResponse.ProtoReflect.Descriptor instead.
+func (*GetAttackChainsResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetAttackChainsResponse) GetChains() []*AttackChain {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *GetAttackChainsResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type AttackChain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Steps         []*AttackStep          `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackChain) Reset() {
+	*x = AttackChain{}
+	mi := &file_harness_callback_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackChain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackChain) ProtoMessage() {}
+
+func (x *AttackChain) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackChain.ProtoReflect.Descriptor instead.
+func (*AttackChain) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AttackChain) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttackChain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttackChain) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AttackChain) GetSteps() []*AttackStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type AttackStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         int32                  `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
+	TechniqueId   string                 `protobuf:"bytes,2,opt,name=technique_id,json=techniqueId,proto3" json:"technique_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackStep) Reset() {
+	*x = AttackStep{}
+	mi := &file_harness_callback_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackStep) ProtoMessage() {}
+
+func (x *AttackStep) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackStep.ProtoReflect.Descriptor instead.
+func (*AttackStep) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AttackStep) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *AttackStep) GetTechniqueId() string {
+	if x != nil {
+		return x.TechniqueId
+	}
+	return ""
+}
+
+func (x *AttackStep) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AttackStep) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AttackStep) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type GetRelatedFindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	FindingId     string                 `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedFindingsRequest) Reset() {
+	*x = GetRelatedFindingsRequest{}
+	mi := &file_harness_callback_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedFindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedFindingsRequest) ProtoMessage() {}
+
+func (x *GetRelatedFindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedFindingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedFindingsRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetRelatedFindingsRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetRelatedFindingsRequest) GetFindingId() string {
+	if x != nil {
+		return x.FindingId
+	}
+	return ""
+}
+
+type GetRelatedFindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*FindingNode         `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedFindingsResponse) Reset() {
+	*x = GetRelatedFindingsResponse{}
+	mi := &file_harness_callback_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedFindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedFindingsResponse) ProtoMessage() {}
+
+func (x *GetRelatedFindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedFindingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedFindingsResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetRelatedFindingsResponse) GetFindings() []*FindingNode {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *GetRelatedFindingsResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type StoreGraphNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Node          *GraphNode             `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreGraphNodeRequest) Reset() {
+	*x = StoreGraphNodeRequest{}
+	mi := &file_harness_callback_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreGraphNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreGraphNodeRequest) ProtoMessage() {}
+
+func (x *StoreGraphNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreGraphNodeRequest.ProtoReflect.Descriptor instead.
+func (*StoreGraphNodeRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *StoreGraphNodeRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *StoreGraphNodeRequest) GetNode() *GraphNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type StoreGraphNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreGraphNodeResponse) Reset() {
+	*x = StoreGraphNodeResponse{}
+	mi := &file_harness_callback_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreGraphNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreGraphNodeResponse) ProtoMessage() {}
+
+func (x *StoreGraphNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreGraphNodeResponse.ProtoReflect.Descriptor instead.
+func (*StoreGraphNodeResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *StoreGraphNodeResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StoreGraphNodeResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreateGraphRelationshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Relationship  *Relationship          `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGraphRelationshipRequest) Reset() {
+	*x = CreateGraphRelationshipRequest{}
+	mi := &file_harness_callback_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGraphRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGraphRelationshipRequest) ProtoMessage() {}
+
+func (x *CreateGraphRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGraphRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*CreateGraphRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreateGraphRelationshipRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CreateGraphRelationshipRequest) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type CreateGraphRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *HarnessError          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGraphRelationshipResponse) Reset() {
+	*x = CreateGraphRelationshipResponse{}
+	mi := &file_harness_callback_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGraphRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGraphRelationshipResponse) ProtoMessage() {}
+
+func (x *CreateGraphRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGraphRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*CreateGraphRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CreateGraphRelationshipResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Relationship struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromId        string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId          string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Properties    map[string]*TypedValue `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Bidirectional bool                   `protobuf:"varint,5,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_harness_callback_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Relationship) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *Relationship) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *Relationship) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Relationship) GetProperties() map[string]*TypedValue {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Relationship) GetBidirectional() bool {
+	if x != nil {
+		return x.Bidirectional
+	}
+	return false
+}
+
+type StoreGraphBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Nodes         []*GraphNode           `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Relationships []*Relationship        `protobuf:"bytes,3,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreGraphBatchRequest) Reset() {
+	*x = StoreGraphBatchRequest{}
+	mi := &file_harness_callback_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (x *AttackStep) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[92]
+func (x *StoreGraphBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreGraphBatchRequest) ProtoMessage() {}
+
+func (x *StoreGraphBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6249,69 +7667,108 @@ func (x *AttackStep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackStep.ProtoReflect.Descriptor instead.
-func (*AttackStep) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use StoreGraphBatchRequest.ProtoReflect.Descriptor instead.
+func (*StoreGraphBatchRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *AttackStep) GetOrder() int32 {
+func (x *StoreGraphBatchRequest) GetContext() *ContextInfo {
 	if x != nil {
-		return x.Order
+		return x.Context
 	}
-	return 0
+	return nil
 }
 
-func (x *AttackStep) GetTechniqueId() string {
+func (x *StoreGraphBatchRequest) GetNodes() []*GraphNode {
 	if x != nil {
-		return x.TechniqueId
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (x *AttackStep) GetNodeId() string {
+func (x *StoreGraphBatchRequest) GetRelationships() []*Relationship {
 	if x != nil {
-		return x.NodeId
+		return x.Relationships
 	}
-	return ""
+	return nil
 }
 
-func (x *AttackStep) GetDescription() string {
+type StoreGraphBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeIds       []string               `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreGraphBatchResponse) Reset() {
+	*x = StoreGraphBatchResponse{}
+	mi := &file_harness_callback_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreGraphBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreGraphBatchResponse) ProtoMessage() {}
+
+func (x *StoreGraphBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[114]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AttackStep) GetConfidence() float64 {
+// Deprecated: Use StoreGraphBatchResponse.ProtoReflect.Descriptor instead.
+func (*StoreGraphBatchResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *StoreGraphBatchResponse) GetNodeIds() []string {
 	if x != nil {
-		return x.Confidence
+		return x.NodeIds
 	}
-	return 0
+	return nil
 }
 
-type GetRelatedFindingsRequest struct {
+func (x *StoreGraphBatchResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type TraverseGraphRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	FindingId     string                 `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
+	StartNodeId   string                 `protobuf:"bytes,2,opt,name=start_node_id,json=startNodeId,proto3" json:"start_node_id,omitempty"`
+	Options       *TraversalOptions      `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRelatedFindingsRequest) Reset() {
-	*x = GetRelatedFindingsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[93]
+func (x *TraverseGraphRequest) Reset() {
+	*x = TraverseGraphRequest{}
+	mi := &file_harness_callback_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRelatedFindingsRequest) String() string {
+func (x *TraverseGraphRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRelatedFindingsRequest) ProtoMessage() {}
+func (*TraverseGraphRequest) ProtoMessage() {}
 
-func (x *GetRelatedFindingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[93]
+func (x *TraverseGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6322,48 +7779,55 @@ func (x *GetRelatedFindingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRelatedFindingsRequest.ProtoReflect.Descriptor instead.
-func (*GetRelatedFindingsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use TraverseGraphRequest.ProtoReflect.Descriptor instead.
+func (*TraverseGraphRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetRelatedFindingsRequest) GetContext() *ContextInfo {
+func (x *TraverseGraphRequest) GetContext() *ContextInfo {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetRelatedFindingsRequest) GetFindingId() string {
+func (x *TraverseGraphRequest) GetStartNodeId() string {
 	if x != nil {
-		return x.FindingId
+		return x.StartNodeId
 	}
 	return ""
 }
 
-type GetRelatedFindingsResponse struct {
+func (x *TraverseGraphRequest) GetOptions() *TraversalOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type TraverseGraphResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Findings      []*FindingNode         `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	Results       []*TraversalResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRelatedFindingsResponse) Reset() {
-	*x = GetRelatedFindingsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[94]
+func (x *TraverseGraphResponse) Reset() {
+	*x = TraverseGraphResponse{}
+	mi := &file_harness_callback_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRelatedFindingsResponse) String() string {
+func (x *TraverseGraphResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRelatedFindingsResponse) ProtoMessage() {}
+func (*TraverseGraphResponse) ProtoMessage() {}
 
-func (x *GetRelatedFindingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[94]
+func (x *TraverseGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6374,48 +7838,54 @@ func (x *GetRelatedFindingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRelatedFindingsResponse.ProtoReflect.Descriptor instead.
-func (*GetRelatedFindingsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use TraverseGraphResponse.ProtoReflect.Descriptor instead.
+func (*TraverseGraphResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetRelatedFindingsResponse) GetFindings() []*FindingNode {
+func (x *TraverseGraphResponse) GetResults() []*TraversalResult {
 	if x != nil {
-		return x.Findings
+		return x.Results
 	}
 	return nil
 }
 
-func (x *GetRelatedFindingsResponse) GetError() *HarnessError {
+func (x *TraverseGraphResponse) GetError() *HarnessError {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type StoreGraphNodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Node          *GraphNode             `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type TraversalOptions struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxDepth          int32                  `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	RelationshipTypes []string               `protobuf:"bytes,2,rep,name=relationship_types,json=relationshipTypes,proto3" json:"relationship_types,omitempty"`
+	NodeTypes         []string               `protobuf:"bytes,3,rep,name=node_types,json=nodeTypes,proto3" json:"node_types,omitempty"`
+	Direction         string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"` // outgoing, incoming, both
+	MustPassNodeTypes []string               `protobuf:"bytes,5,rep,name=must_pass_node_types,json=mustPassNodeTypes,proto3" json:"must_pass_node_types,omitempty"`
+	AvoidNodeTypes    []string               `protobuf:"bytes,6,rep,name=avoid_node_types,json=avoidNodeTypes,proto3" json:"avoid_node_types,omitempty"`
+	CycleHandling     string                 `protobuf:"bytes,7,opt,name=cycle_handling,json=cycleHandling,proto3" json:"cycle_handling,omitempty"` // prune (default), allow_revisit
+	MaxRevisits       int32                  `protobuf:"varint,8,opt,name=max_revisits,json=maxRevisits,proto3" json:"max_revisits,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *StoreGraphNodeRequest) Reset() {
-	*x = StoreGraphNodeRequest{}
-	mi := &file_harness_callback_proto_msgTypes[95]
+func (x *TraversalOptions) Reset() {
+	*x = TraversalOptions{}
+	mi := &file_harness_callback_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreGraphNodeRequest) String() string {
+func (x *TraversalOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreGraphNodeRequest) ProtoMessage() {}
+func (*TraversalOptions) ProtoMessage() {}
 
-func (x *StoreGraphNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[95]
+func (x *TraversalOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6426,48 +7896,94 @@ func (x *StoreGraphNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreGraphNodeRequest.ProtoReflect.Descriptor instead.
-func (*StoreGraphNodeRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use TraversalOptions.ProtoReflect.Descriptor instead.
+func (*TraversalOptions) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *StoreGraphNodeRequest) GetContext() *ContextInfo {
+func (x *TraversalOptions) GetMaxDepth() int32 {
 	if x != nil {
-		return x.Context
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *TraversalOptions) GetRelationshipTypes() []string {
+	if x != nil {
+		return x.RelationshipTypes
 	}
 	return nil
 }
 
-func (x *StoreGraphNodeRequest) GetNode() *GraphNode {
+func (x *TraversalOptions) GetNodeTypes() []string {
 	if x != nil {
-		return x.Node
+		return x.NodeTypes
 	}
 	return nil
 }
 
-type StoreGraphNodeResponse struct {
+func (x *TraversalOptions) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TraversalOptions) GetMustPassNodeTypes() []string {
+	if x != nil {
+		return x.MustPassNodeTypes
+	}
+	return nil
+}
+
+func (x *TraversalOptions) GetAvoidNodeTypes() []string {
+	if x != nil {
+		return x.AvoidNodeTypes
+	}
+	return nil
+}
+
+func (x *TraversalOptions) GetCycleHandling() string {
+	if x != nil {
+		return x.CycleHandling
+	}
+	return ""
+}
+
+func (x *TraversalOptions) GetMaxRevisits() int32 {
+	if x != nil {
+		return x.MaxRevisits
+	}
+	return 0
+}
+
+// GraphRAGShortestPathRequest asks for the cheapest relationship path
+// between two nodes.
+type GraphRAGShortestPathRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	FromId        string                 `protobuf:"bytes,2,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId          string                 `protobuf:"bytes,3,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	Options       *PathOptions           `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StoreGraphNodeResponse) Reset() {
-	*x = StoreGraphNodeResponse{}
-	mi := &file_harness_callback_proto_msgTypes[96]
+func (x *GraphRAGShortestPathRequest) Reset() {
+	*x = GraphRAGShortestPathRequest{}
+	mi := &file_harness_callback_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreGraphNodeResponse) String() string {
+func (x *GraphRAGShortestPathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreGraphNodeResponse) ProtoMessage() {}
+func (*GraphRAGShortestPathRequest) ProtoMessage() {}
 
-func (x *StoreGraphNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[96]
+func (x *GraphRAGShortestPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6478,48 +7994,65 @@ func (x *StoreGraphNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreGraphNodeResponse.ProtoReflect.Descriptor instead.
-func (*StoreGraphNodeResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use GraphRAGShortestPathRequest.ProtoReflect.Descriptor instead.
+func (*GraphRAGShortestPathRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GraphRAGShortestPathRequest) GetContext() *ContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GraphRAGShortestPathRequest) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
 }
 
-func (x *StoreGraphNodeResponse) GetNodeId() string {
+func (x *GraphRAGShortestPathRequest) GetToId() string {
 	if x != nil {
-		return x.NodeId
+		return x.ToId
 	}
 	return ""
 }
 
-func (x *StoreGraphNodeResponse) GetError() *HarnessError {
+func (x *GraphRAGShortestPathRequest) GetOptions() *PathOptions {
 	if x != nil {
-		return x.Error
+		return x.Options
 	}
 	return nil
 }
 
-type CreateGraphRelationshipRequest struct {
+// GraphRAGShortestPathResponse carries the path edges in order. found is
+// false when no path exists within the options' limits.
+type GraphRAGShortestPathResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Relationship  *Relationship          `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Edges         []*PathEdge            `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGraphRelationshipRequest) Reset() {
-	*x = CreateGraphRelationshipRequest{}
-	mi := &file_harness_callback_proto_msgTypes[97]
+func (x *GraphRAGShortestPathResponse) Reset() {
+	*x = GraphRAGShortestPathResponse{}
+	mi := &file_harness_callback_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGraphRelationshipRequest) String() string {
+func (x *GraphRAGShortestPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGraphRelationshipRequest) ProtoMessage() {}
+func (*GraphRAGShortestPathResponse) ProtoMessage() {}
 
-func (x *CreateGraphRelationshipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[97]
+func (x *GraphRAGShortestPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6530,47 +8063,57 @@ func (x *CreateGraphRelationshipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGraphRelationshipRequest.ProtoReflect.Descriptor instead.
-func (*CreateGraphRelationshipRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use GraphRAGShortestPathResponse.ProtoReflect.Descriptor instead.
+func (*GraphRAGShortestPathResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *CreateGraphRelationshipRequest) GetContext() *ContextInfo {
+func (x *GraphRAGShortestPathResponse) GetEdges() []*PathEdge {
 	if x != nil {
-		return x.Context
+		return x.Edges
 	}
 	return nil
 }
 
-func (x *CreateGraphRelationshipRequest) GetRelationship() *Relationship {
+func (x *GraphRAGShortestPathResponse) GetFound() bool {
 	if x != nil {
-		return x.Relationship
+		return x.Found
+	}
+	return false
+}
+
+func (x *GraphRAGShortestPathResponse) GetError() *HarnessError {
+	if x != nil {
+		return x.Error
 	}
 	return nil
 }
 
-type CreateGraphRelationshipResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *HarnessError          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PathOptions struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxDepth          int32                  `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	RelationshipTypes []string               `protobuf:"bytes,2,rep,name=relationship_types,json=relationshipTypes,proto3" json:"relationship_types,omitempty"`
+	Direction         string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`                                 // outgoing, incoming, both
+	WeightProperty    string                 `protobuf:"bytes,4,opt,name=weight_property,json=weightProperty,proto3" json:"weight_property,omitempty"` // empty for fewest hops
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CreateGraphRelationshipResponse) Reset() {
-	*x = CreateGraphRelationshipResponse{}
-	mi := &file_harness_callback_proto_msgTypes[98]
+func (x *PathOptions) Reset() {
+	*x = PathOptions{}
+	mi := &file_harness_callback_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGraphRelationshipResponse) String() string {
+func (x *PathOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGraphRelationshipResponse) ProtoMessage() {}
+func (*PathOptions) ProtoMessage() {}
 
-func (x *CreateGraphRelationshipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[98]
+func (x *PathOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6581,44 +8124,68 @@ func (x *CreateGraphRelationshipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGraphRelationshipResponse.ProtoReflect.Descriptor instead.
-func (*CreateGraphRelationshipResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use PathOptions.ProtoReflect.Descriptor instead.
+func (*PathOptions) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *CreateGraphRelationshipResponse) GetError() *HarnessError {
+func (x *PathOptions) GetMaxDepth() int32 {
 	if x != nil {
-		return x.Error
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *PathOptions) GetRelationshipTypes() []string {
+	if x != nil {
+		return x.RelationshipTypes
 	}
 	return nil
 }
 
-type Relationship struct {
+func (x *PathOptions) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *PathOptions) GetWeightProperty() string {
+	if x != nil {
+		return x.WeightProperty
+	}
+	return ""
+}
+
+// PathEdge is a relationship along a path, with from_id and to_id in path
+// order. reversed is set when the stored relationship points the other way.
+type PathEdge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromId        string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
 	ToId          string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
 	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 	Properties    map[string]*TypedValue `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Bidirectional bool                   `protobuf:"varint,5,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Reversed      bool                   `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Relationship) Reset() {
-	*x = Relationship{}
-	mi := &file_harness_callback_proto_msgTypes[99]
+func (x *PathEdge) Reset() {
+	*x = PathEdge{}
+	mi := &file_harness_callback_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Relationship) String() string {
+func (x *PathEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Relationship) ProtoMessage() {}
+func (*PathEdge) ProtoMessage() {}
 
-func (x *Relationship) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[99]
+func (x *PathEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6629,70 +8196,80 @@ func (x *Relationship) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
-func (*Relationship) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use PathEdge.ProtoReflect.Descriptor instead.
+func (*PathEdge) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *Relationship) GetFromId() string {
+func (x *PathEdge) GetFromId() string {
 	if x != nil {
 		return x.FromId
 	}
 	return ""
 }
 
-func (x *Relationship) GetToId() string {
+func (x *PathEdge) GetToId() string {
 	if x != nil {
 		return x.ToId
 	}
 	return ""
 }
 
-func (x *Relationship) GetType() string {
+func (x *PathEdge) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *Relationship) GetProperties() map[string]*TypedValue {
+func (x *PathEdge) GetProperties() map[string]*TypedValue {
 	if x != nil {
 		return x.Properties
 	}
 	return nil
 }
 
-func (x *Relationship) GetBidirectional() bool {
+func (x *PathEdge) GetWeight() float64 {
 	if x != nil {
-		return x.Bidirectional
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PathEdge) GetReversed() bool {
+	if x != nil {
+		return x.Reversed
 	}
 	return false
 }
 
-type StoreGraphBatchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Nodes         []*GraphNode           `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Relationships []*Relationship        `protobuf:"bytes,3,rep,name=relationships,proto3" json:"relationships,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GraphRAGNeighborsRequest asks for the nodes one relationship away from a
+// node.
+type GraphRAGNeighborsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Context          *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	NodeId           string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	RelationshipType string                 `protobuf:"bytes,3,opt,name=relationship_type,json=relationshipType,proto3" json:"relationship_type,omitempty"` // empty for any type
+	Direction        string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`                                       // outgoing, incoming, both
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *StoreGraphBatchRequest) Reset() {
-	*x = StoreGraphBatchRequest{}
-	mi := &file_harness_callback_proto_msgTypes[100]
+func (x *GraphRAGNeighborsRequest) Reset() {
+	*x = GraphRAGNeighborsRequest{}
+	mi := &file_harness_callback_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreGraphBatchRequest) String() string {
+func (x *GraphRAGNeighborsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreGraphBatchRequest) ProtoMessage() {}
+func (*GraphRAGNeighborsRequest) ProtoMessage() {}
 
-func (x *StoreGraphBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[100]
+func (x *GraphRAGNeighborsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6703,55 +8280,62 @@ func (x *StoreGraphBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreGraphBatchRequest.ProtoReflect.Descriptor instead.
-func (*StoreGraphBatchRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use GraphRAGNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*GraphRAGNeighborsRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *StoreGraphBatchRequest) GetContext() *ContextInfo {
+func (x *GraphRAGNeighborsRequest) GetContext() *ContextInfo {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *StoreGraphBatchRequest) GetNodes() []*GraphNode {
+func (x *GraphRAGNeighborsRequest) GetNodeId() string {
 	if x != nil {
-		return x.Nodes
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *StoreGraphBatchRequest) GetRelationships() []*Relationship {
+func (x *GraphRAGNeighborsRequest) GetRelationshipType() string {
 	if x != nil {
-		return x.Relationships
+		return x.RelationshipType
 	}
-	return nil
+	return ""
 }
 
-type StoreGraphBatchResponse struct {
+func (x *GraphRAGNeighborsRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type GraphRAGNeighborsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeIds       []string               `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Nodes         []*GraphNode           `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StoreGraphBatchResponse) Reset() {
-	*x = StoreGraphBatchResponse{}
-	mi := &file_harness_callback_proto_msgTypes[101]
+func (x *GraphRAGNeighborsResponse) Reset() {
+	*x = GraphRAGNeighborsResponse{}
+	mi := &file_harness_callback_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreGraphBatchResponse) String() string {
+func (x *GraphRAGNeighborsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreGraphBatchResponse) ProtoMessage() {}
+func (*GraphRAGNeighborsResponse) ProtoMessage() {}
 
-func (x *StoreGraphBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[101]
+func (x *GraphRAGNeighborsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6762,49 +8346,51 @@ func (x *StoreGraphBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreGraphBatchResponse.ProtoReflect.Descriptor instead.
-func (*StoreGraphBatchResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use GraphRAGNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*GraphRAGNeighborsResponse) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *StoreGraphBatchResponse) GetNodeIds() []string {
+func (x *GraphRAGNeighborsResponse) GetNodes() []*GraphNode {
 	if x != nil {
-		return x.NodeIds
+		return x.Nodes
 	}
 	return nil
 }
 
-func (x *StoreGraphBatchResponse) GetError() *HarnessError {
+func (x *GraphRAGNeighborsResponse) GetError() *HarnessError {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type TraverseGraphRequest struct {
+// GraphRAGWatchRequest subscribes to the nodes stored in a mission's graph.
+// The stream stays open until the client cancels it.
+type GraphRAGWatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	StartNodeId   string                 `protobuf:"bytes,2,opt,name=start_node_id,json=startNodeId,proto3" json:"start_node_id,omitempty"`
-	Options       *TraversalOptions      `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	MissionId     string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	NodeTypes     []string               `protobuf:"bytes,3,rep,name=node_types,json=nodeTypes,proto3" json:"node_types,omitempty"` // empty for all types
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TraverseGraphRequest) Reset() {
-	*x = TraverseGraphRequest{}
-	mi := &file_harness_callback_proto_msgTypes[102]
+func (x *GraphRAGWatchRequest) Reset() {
+	*x = GraphRAGWatchRequest{}
+	mi := &file_harness_callback_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TraverseGraphRequest) String() string {
+func (x *GraphRAGWatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TraverseGraphRequest) ProtoMessage() {}
+func (*GraphRAGWatchRequest) ProtoMessage() {}
 
-func (x *TraverseGraphRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[102]
+func (x *GraphRAGWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6815,109 +8401,57 @@ func (x *TraverseGraphRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TraverseGraphRequest.ProtoReflect.Descriptor instead.
-func (*TraverseGraphRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use GraphRAGWatchRequest.ProtoReflect.Descriptor instead.
+func (*GraphRAGWatchRequest) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *TraverseGraphRequest) GetContext() *ContextInfo {
+func (x *GraphRAGWatchRequest) GetContext() *ContextInfo {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *TraverseGraphRequest) GetStartNodeId() string {
+func (x *GraphRAGWatchRequest) GetMissionId() string {
 	if x != nil {
-		return x.StartNodeId
+		return x.MissionId
 	}
 	return ""
 }
 
-func (x *TraverseGraphRequest) GetOptions() *TraversalOptions {
+func (x *GraphRAGWatchRequest) GetNodeTypes() []string {
 	if x != nil {
-		return x.Options
+		return x.NodeTypes
 	}
 	return nil
 }
 
-type TraverseGraphResponse struct {
+// GraphRAGEvent reports a node created or updated in the watched mission.
+type GraphRAGEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*TraversalResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Error         *HarnessError          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // created, updated
+	Node          *GraphNode             `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Error         *HarnessError          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TraverseGraphResponse) Reset() {
-	*x = TraverseGraphResponse{}
-	mi := &file_harness_callback_proto_msgTypes[103]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TraverseGraphResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TraverseGraphResponse) ProtoMessage() {}
-
-func (x *TraverseGraphResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[103]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TraverseGraphResponse.ProtoReflect.Descriptor instead.
-func (*TraverseGraphResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{103}
-}
-
-func (x *TraverseGraphResponse) GetResults() []*TraversalResult {
-	if x != nil {
-		return x.Results
-	}
-	return nil
-}
-
-func (x *TraverseGraphResponse) GetError() *HarnessError {
-	if x != nil {
-		return x.Error
-	}
-	return nil
-}
-
-type TraversalOptions struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	MaxDepth          int32                  `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
-	RelationshipTypes []string               `protobuf:"bytes,2,rep,name=relationship_types,json=relationshipTypes,proto3" json:"relationship_types,omitempty"`
-	NodeTypes         []string               `protobuf:"bytes,3,rep,name=node_types,json=nodeTypes,proto3" json:"node_types,omitempty"`
-	Direction         string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"` // outgoing, incoming, both
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *TraversalOptions) Reset() {
-	*x = TraversalOptions{}
-	mi := &file_harness_callback_proto_msgTypes[104]
+func (x *GraphRAGEvent) Reset() {
+	*x = GraphRAGEvent{}
+	mi := &file_harness_callback_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TraversalOptions) String() string {
+func (x *GraphRAGEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TraversalOptions) ProtoMessage() {}
-
-func (x *TraversalOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[104]
+func (*GraphRAGEvent) ProtoMessage() {}
+
+func (x *GraphRAGEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_harness_callback_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6928,51 +8462,47 @@ func (x *TraversalOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TraversalOptions.ProtoReflect.Descriptor instead.
-func (*TraversalOptions) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use GraphRAGEvent.ProtoReflect.Descriptor instead.
+func (*GraphRAGEvent) Descriptor() ([]byte, []int) {
+	return file_harness_callback_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *TraversalOptions) GetMaxDepth() int32 {
+func (x *GraphRAGEvent) GetKind() string {
 	if x != nil {
-		return x.MaxDepth
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *TraversalOptions) GetRelationshipTypes() []string {
+func (x *GraphRAGEvent) GetNode() *GraphNode {
 	if x != nil {
-		return x.RelationshipTypes
+		return x.Node
 	}
 	return nil
 }
 
-func (x *TraversalOptions) GetNodeTypes() []string {
+func (x *GraphRAGEvent) GetError() *HarnessError {
 	if x != nil {
-		return x.NodeTypes
+		return x.Error
 	}
 	return nil
 }
 
-func (x *TraversalOptions) GetDirection() string {
-	if x != nil {
-		return x.Direction
-	}
-	return ""
-}
-
 type TraversalResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *GraphNode             `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Path          []string               `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
-	Distance      int32                  `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Node     *GraphNode             `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Path     []string               `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	Distance int32                  `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	// must_pass_satisfied is set when the path passes through all
+	// must_pass_node_types.
+	MustPassSatisfied bool `protobuf:"varint,4,opt,name=must_pass_satisfied,json=mustPassSatisfied,proto3" json:"must_pass_satisfied,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *TraversalResult) Reset() {
 	*x = TraversalResult{}
-	mi := &file_harness_callback_proto_msgTypes[105]
+	mi := &file_harness_callback_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6984,7 +8514,7 @@ func (x *TraversalResult) String() string {
 func (*TraversalResult) ProtoMessage() {}
 
 func (x *TraversalResult) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[105]
+	mi := &file_harness_callback_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6997,7 +8527,7 @@ func (x *TraversalResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TraversalResult.ProtoReflect.Descriptor instead.
 func (*TraversalResult) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{105}
+	return file_harness_callback_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *TraversalResult) GetNode() *GraphNode {
@@ -7021,6 +8551,13 @@ func (x *TraversalResult) GetDistance() int32 {
 	return 0
 }
 
+func (x *TraversalResult) GetMustPassSatisfied() bool {
+	if x != nil {
+		return x.MustPassSatisfied
+	}
+	return false
+}
+
 type GraphRAGHealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextInfo           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
@@ -7030,7 +8567,7 @@ type GraphRAGHealthRequest struct {
 
 func (x *GraphRAGHealthRequest) Reset() {
 	*x = GraphRAGHealthRequest{}
-	mi := &file_harness_callback_proto_msgTypes[106]
+	mi := &file_harness_callback_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7042,7 +8579,7 @@ func (x *GraphRAGHealthRequest) String() string {
 func (*GraphRAGHealthRequest) ProtoMessage() {}
 
 func (x *GraphRAGHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[106]
+	mi := &file_harness_callback_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7055,7 +8592,7 @@ func (x *GraphRAGHealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphRAGHealthRequest.ProtoReflect.Descriptor instead.
 func (*GraphRAGHealthRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{106}
+	return file_harness_callback_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *GraphRAGHealthRequest) GetContext() *ContextInfo {
@@ -7074,7 +8611,7 @@ type GraphRAGHealthResponse struct {
 
 func (x *GraphRAGHealthResponse) Reset() {
 	*x = GraphRAGHealthResponse{}
-	mi := &file_harness_callback_proto_msgTypes[107]
+	mi := &file_harness_callback_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7086,7 +8623,7 @@ func (x *GraphRAGHealthResponse) String() string {
 func (*GraphRAGHealthResponse) ProtoMessage() {}
 
 func (x *GraphRAGHealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[107]
+	mi := &file_harness_callback_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7099,7 +8636,7 @@ func (x *GraphRAGHealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphRAGHealthResponse.ProtoReflect.Descriptor instead.
 func (*GraphRAGHealthResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{107}
+	return file_harness_callback_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *GraphRAGHealthResponse) GetStatus() *HarnessHealthStatus {
@@ -7120,7 +8657,7 @@ type StoreNodeRequest struct {
 
 func (x *StoreNodeRequest) Reset() {
 	*x = StoreNodeRequest{}
-	mi := &file_harness_callback_proto_msgTypes[108]
+	mi := &file_harness_callback_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7132,7 +8669,7 @@ func (x *StoreNodeRequest) String() string {
 func (*StoreNodeRequest) ProtoMessage() {}
 
 func (x *StoreNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[108]
+	mi := &file_harness_callback_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7145,7 +8682,7 @@ func (x *StoreNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreNodeRequest.ProtoReflect.Descriptor instead.
 func (*StoreNodeRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{108}
+	return file_harness_callback_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *StoreNodeRequest) GetContext() *ContextInfo {
@@ -7173,7 +8710,7 @@ type StoreNodeResponse struct {
 
 func (x *StoreNodeResponse) Reset() {
 	*x = StoreNodeResponse{}
-	mi := &file_harness_callback_proto_msgTypes[109]
+	mi := &file_harness_callback_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7185,7 +8722,7 @@ func (x *StoreNodeResponse) String() string {
 func (*StoreNodeResponse) ProtoMessage() {}
 
 func (x *StoreNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[109]
+	mi := &file_harness_callback_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7198,7 +8735,7 @@ func (x *StoreNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreNodeResponse.ProtoReflect.Descriptor instead.
 func (*StoreNodeResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{109}
+	return file_harness_callback_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *StoreNodeResponse) GetNodeId() string {
@@ -7226,7 +8763,7 @@ type QueryNodesRequest struct {
 
 func (x *QueryNodesRequest) Reset() {
 	*x = QueryNodesRequest{}
-	mi := &file_harness_callback_proto_msgTypes[110]
+	mi := &file_harness_callback_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7238,7 +8775,7 @@ func (x *QueryNodesRequest) String() string {
 func (*QueryNodesRequest) ProtoMessage() {}
 
 func (x *QueryNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[110]
+	mi := &file_harness_callback_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7251,7 +8788,7 @@ func (x *QueryNodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesRequest.ProtoReflect.Descriptor instead.
 func (*QueryNodesRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{110}
+	return file_harness_callback_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *QueryNodesRequest) GetContext() *ContextInfo {
@@ -7279,7 +8816,7 @@ type QueryNodesResponse struct {
 
 func (x *QueryNodesResponse) Reset() {
 	*x = QueryNodesResponse{}
-	mi := &file_harness_callback_proto_msgTypes[111]
+	mi := &file_harness_callback_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7291,7 +8828,7 @@ func (x *QueryNodesResponse) String() string {
 func (*QueryNodesResponse) ProtoMessage() {}
 
 func (x *QueryNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[111]
+	mi := &file_harness_callback_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7304,7 +8841,7 @@ func (x *QueryNodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesResponse.ProtoReflect.Descriptor instead.
 func (*QueryNodesResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{111}
+	return file_harness_callback_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *QueryNodesResponse) GetResults() []*graphragpb.QueryResult {
@@ -7330,7 +8867,7 @@ type GetPlanContextRequest struct {
 
 func (x *GetPlanContextRequest) Reset() {
 	*x = GetPlanContextRequest{}
-	mi := &file_harness_callback_proto_msgTypes[112]
+	mi := &file_harness_callback_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7342,7 +8879,7 @@ func (x *GetPlanContextRequest) String() string {
 func (*GetPlanContextRequest) ProtoMessage() {}
 
 func (x *GetPlanContextRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[112]
+	mi := &file_harness_callback_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7355,7 +8892,7 @@ func (x *GetPlanContextRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlanContextRequest.ProtoReflect.Descriptor instead.
 func (*GetPlanContextRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{112}
+	return file_harness_callback_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *GetPlanContextRequest) GetContext() *ContextInfo {
@@ -7375,7 +8912,7 @@ type GetPlanContextResponse struct {
 
 func (x *GetPlanContextResponse) Reset() {
 	*x = GetPlanContextResponse{}
-	mi := &file_harness_callback_proto_msgTypes[113]
+	mi := &file_harness_callback_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7387,7 +8924,7 @@ func (x *GetPlanContextResponse) String() string {
 func (*GetPlanContextResponse) ProtoMessage() {}
 
 func (x *GetPlanContextResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[113]
+	mi := &file_harness_callback_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7400,7 +8937,7 @@ func (x *GetPlanContextResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlanContextResponse.ProtoReflect.Descriptor instead.
 func (*GetPlanContextResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{113}
+	return file_harness_callback_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GetPlanContextResponse) GetPlanContext() *PlanContext {
@@ -7430,7 +8967,7 @@ type PlanContext struct {
 
 func (x *PlanContext) Reset() {
 	*x = PlanContext{}
-	mi := &file_harness_callback_proto_msgTypes[114]
+	mi := &file_harness_callback_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7442,7 +8979,7 @@ func (x *PlanContext) String() string {
 func (*PlanContext) ProtoMessage() {}
 
 func (x *PlanContext) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[114]
+	mi := &file_harness_callback_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7455,7 +8992,7 @@ func (x *PlanContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlanContext.ProtoReflect.Descriptor instead.
 func (*PlanContext) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{114}
+	return file_harness_callback_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *PlanContext) GetCurrentStepIndex() int32 {
@@ -7503,7 +9040,7 @@ type ReportStepHintsRequest struct {
 
 func (x *ReportStepHintsRequest) Reset() {
 	*x = ReportStepHintsRequest{}
-	mi := &file_harness_callback_proto_msgTypes[115]
+	mi := &file_harness_callback_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7515,7 +9052,7 @@ func (x *ReportStepHintsRequest) String() string {
 func (*ReportStepHintsRequest) ProtoMessage() {}
 
 func (x *ReportStepHintsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[115]
+	mi := &file_harness_callback_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7528,7 +9065,7 @@ func (x *ReportStepHintsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportStepHintsRequest.ProtoReflect.Descriptor instead.
 func (*ReportStepHintsRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{115}
+	return file_harness_callback_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *ReportStepHintsRequest) GetContext() *ContextInfo {
@@ -7554,7 +9091,7 @@ type ReportStepHintsResponse struct {
 
 func (x *ReportStepHintsResponse) Reset() {
 	*x = ReportStepHintsResponse{}
-	mi := &file_harness_callback_proto_msgTypes[116]
+	mi := &file_harness_callback_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7566,7 +9103,7 @@ func (x *ReportStepHintsResponse) String() string {
 func (*ReportStepHintsResponse) ProtoMessage() {}
 
 func (x *ReportStepHintsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[116]
+	mi := &file_harness_callback_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7579,7 +9116,7 @@ func (x *ReportStepHintsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportStepHintsResponse.ProtoReflect.Descriptor instead.
 func (*ReportStepHintsResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{116}
+	return file_harness_callback_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *ReportStepHintsResponse) GetError() *HarnessError {
@@ -7601,7 +9138,7 @@ type StepHints struct {
 
 func (x *StepHints) Reset() {
 	*x = StepHints{}
-	mi := &file_harness_callback_proto_msgTypes[117]
+	mi := &file_harness_callback_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7613,7 +9150,7 @@ func (x *StepHints) String() string {
 func (*StepHints) ProtoMessage() {}
 
 func (x *StepHints) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[117]
+	mi := &file_harness_callback_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7626,7 +9163,7 @@ func (x *StepHints) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StepHints.ProtoReflect.Descriptor instead.
 func (*StepHints) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{117}
+	return file_harness_callback_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *StepHints) GetConfidence() float64 {
@@ -7674,7 +9211,7 @@ type AnyValue struct {
 
 func (x *AnyValue) Reset() {
 	*x = AnyValue{}
-	mi := &file_harness_callback_proto_msgTypes[118]
+	mi := &file_harness_callback_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7686,7 +9223,7 @@ func (x *AnyValue) String() string {
 func (*AnyValue) ProtoMessage() {}
 
 func (x *AnyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[118]
+	mi := &file_harness_callback_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7699,7 +9236,7 @@ func (x *AnyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnyValue.ProtoReflect.Descriptor instead.
 func (*AnyValue) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{118}
+	return file_harness_callback_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *AnyValue) GetValue() isAnyValue_Value {
@@ -7799,7 +9336,7 @@ type KeyValue struct {
 
 func (x *KeyValue) Reset() {
 	*x = KeyValue{}
-	mi := &file_harness_callback_proto_msgTypes[119]
+	mi := &file_harness_callback_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7811,7 +9348,7 @@ func (x *KeyValue) String() string {
 func (*KeyValue) ProtoMessage() {}
 
 func (x *KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[119]
+	mi := &file_harness_callback_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7824,7 +9361,7 @@ func (x *KeyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyValue.ProtoReflect.Descriptor instead.
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{119}
+	return file_harness_callback_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *KeyValue) GetKey() string {
@@ -7853,7 +9390,7 @@ type SpanEvent struct {
 
 func (x *SpanEvent) Reset() {
 	*x = SpanEvent{}
-	mi := &file_harness_callback_proto_msgTypes[120]
+	mi := &file_harness_callback_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7865,7 +9402,7 @@ func (x *SpanEvent) String() string {
 func (*SpanEvent) ProtoMessage() {}
 
 func (x *SpanEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[120]
+	mi := &file_harness_callback_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7878,7 +9415,7 @@ func (x *SpanEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpanEvent.ProtoReflect.Descriptor instead.
 func (*SpanEvent) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{120}
+	return file_harness_callback_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *SpanEvent) GetName() string {
@@ -7922,7 +9459,7 @@ type Span struct {
 
 func (x *Span) Reset() {
 	*x = Span{}
-	mi := &file_harness_callback_proto_msgTypes[121]
+	mi := &file_harness_callback_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7934,7 +9471,7 @@ func (x *Span) String() string {
 func (*Span) ProtoMessage() {}
 
 func (x *Span) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[121]
+	mi := &file_harness_callback_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7947,7 +9484,7 @@ func (x *Span) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Span.ProtoReflect.Descriptor instead.
 func (*Span) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{121}
+	return file_harness_callback_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *Span) GetTraceId() string {
@@ -8037,7 +9574,7 @@ type RecordSpanRequest struct {
 
 func (x *RecordSpanRequest) Reset() {
 	*x = RecordSpanRequest{}
-	mi := &file_harness_callback_proto_msgTypes[122]
+	mi := &file_harness_callback_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8049,7 +9586,7 @@ func (x *RecordSpanRequest) String() string {
 func (*RecordSpanRequest) ProtoMessage() {}
 
 func (x *RecordSpanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[122]
+	mi := &file_harness_callback_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8062,7 +9599,7 @@ func (x *RecordSpanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSpanRequest.ProtoReflect.Descriptor instead.
 func (*RecordSpanRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{122}
+	return file_harness_callback_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *RecordSpanRequest) GetContext() *ContextInfo {
@@ -8088,7 +9625,7 @@ type RecordSpanResponse struct {
 
 func (x *RecordSpanResponse) Reset() {
 	*x = RecordSpanResponse{}
-	mi := &file_harness_callback_proto_msgTypes[123]
+	mi := &file_harness_callback_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8100,7 +9637,7 @@ func (x *RecordSpanResponse) String() string {
 func (*RecordSpanResponse) ProtoMessage() {}
 
 func (x *RecordSpanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[123]
+	mi := &file_harness_callback_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8113,7 +9650,7 @@ func (x *RecordSpanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSpanResponse.ProtoReflect.Descriptor instead.
 func (*RecordSpanResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{123}
+	return file_harness_callback_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *RecordSpanResponse) GetError() *HarnessError {
@@ -8133,7 +9670,7 @@ type RecordSpansRequest struct {
 
 func (x *RecordSpansRequest) Reset() {
 	*x = RecordSpansRequest{}
-	mi := &file_harness_callback_proto_msgTypes[124]
+	mi := &file_harness_callback_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8145,7 +9682,7 @@ func (x *RecordSpansRequest) String() string {
 func (*RecordSpansRequest) ProtoMessage() {}
 
 func (x *RecordSpansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[124]
+	mi := &file_harness_callback_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8158,7 +9695,7 @@ func (x *RecordSpansRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSpansRequest.ProtoReflect.Descriptor instead.
 func (*RecordSpansRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{124}
+	return file_harness_callback_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *RecordSpansRequest) GetContext() *ContextInfo {
@@ -8184,7 +9721,7 @@ type RecordSpansResponse struct {
 
 func (x *RecordSpansResponse) Reset() {
 	*x = RecordSpansResponse{}
-	mi := &file_harness_callback_proto_msgTypes[125]
+	mi := &file_harness_callback_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8196,7 +9733,7 @@ func (x *RecordSpansResponse) String() string {
 func (*RecordSpansResponse) ProtoMessage() {}
 
 func (x *RecordSpansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[125]
+	mi := &file_harness_callback_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8209,7 +9746,7 @@ func (x *RecordSpansResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSpansResponse.ProtoReflect.Descriptor instead.
 func (*RecordSpansResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{125}
+	return file_harness_callback_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *RecordSpansResponse) GetError() *HarnessError {
@@ -8229,7 +9766,7 @@ type GetCredentialRequest struct {
 
 func (x *GetCredentialRequest) Reset() {
 	*x = GetCredentialRequest{}
-	mi := &file_harness_callback_proto_msgTypes[126]
+	mi := &file_harness_callback_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8241,7 +9778,7 @@ func (x *GetCredentialRequest) String() string {
 func (*GetCredentialRequest) ProtoMessage() {}
 
 func (x *GetCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[126]
+	mi := &file_harness_callback_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8254,7 +9791,7 @@ func (x *GetCredentialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCredentialRequest.ProtoReflect.Descriptor instead.
 func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{126}
+	return file_harness_callback_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *GetCredentialRequest) GetContext() *ContextInfo {
@@ -8281,7 +9818,7 @@ type GetCredentialResponse struct {
 
 func (x *GetCredentialResponse) Reset() {
 	*x = GetCredentialResponse{}
-	mi := &file_harness_callback_proto_msgTypes[127]
+	mi := &file_harness_callback_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8293,7 +9830,7 @@ func (x *GetCredentialResponse) String() string {
 func (*GetCredentialResponse) ProtoMessage() {}
 
 func (x *GetCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[127]
+	mi := &file_harness_callback_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8306,7 +9843,7 @@ func (x *GetCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCredentialResponse.ProtoReflect.Descriptor instead.
 func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{127}
+	return file_harness_callback_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *GetCredentialResponse) GetCredential() *Credential {
@@ -8342,7 +9879,7 @@ type Credential struct {
 
 func (x *Credential) Reset() {
 	*x = Credential{}
-	mi := &file_harness_callback_proto_msgTypes[128]
+	mi := &file_harness_callback_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8354,7 +9891,7 @@ func (x *Credential) String() string {
 func (*Credential) ProtoMessage() {}
 
 func (x *Credential) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[128]
+	mi := &file_harness_callback_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8367,7 +9904,7 @@ func (x *Credential) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Credential.ProtoReflect.Descriptor instead.
 func (*Credential) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{128}
+	return file_harness_callback_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *Credential) GetName() string {
@@ -8488,7 +10025,7 @@ type BasicAuth struct {
 
 func (x *BasicAuth) Reset() {
 	*x = BasicAuth{}
-	mi := &file_harness_callback_proto_msgTypes[129]
+	mi := &file_harness_callback_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8500,7 +10037,7 @@ func (x *BasicAuth) String() string {
 func (*BasicAuth) ProtoMessage() {}
 
 func (x *BasicAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[129]
+	mi := &file_harness_callback_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8513,7 +10050,7 @@ func (x *BasicAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicAuth.ProtoReflect.Descriptor instead.
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{129}
+	return file_harness_callback_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *BasicAuth) GetUsername() string {
@@ -8543,7 +10080,7 @@ type OAuthCredential struct {
 
 func (x *OAuthCredential) Reset() {
 	*x = OAuthCredential{}
-	mi := &file_harness_callback_proto_msgTypes[130]
+	mi := &file_harness_callback_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8555,7 +10092,7 @@ func (x *OAuthCredential) String() string {
 func (*OAuthCredential) ProtoMessage() {}
 
 func (x *OAuthCredential) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[130]
+	mi := &file_harness_callback_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8568,7 +10105,7 @@ func (x *OAuthCredential) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthCredential.ProtoReflect.Descriptor instead.
 func (*OAuthCredential) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{130}
+	return file_harness_callback_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *OAuthCredential) GetAccessToken() string {
@@ -8608,7 +10145,7 @@ type GetTaxonomySchemaRequest struct {
 
 func (x *GetTaxonomySchemaRequest) Reset() {
 	*x = GetTaxonomySchemaRequest{}
-	mi := &file_harness_callback_proto_msgTypes[131]
+	mi := &file_harness_callback_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8620,7 +10157,7 @@ func (x *GetTaxonomySchemaRequest) String() string {
 func (*GetTaxonomySchemaRequest) ProtoMessage() {}
 
 func (x *GetTaxonomySchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[131]
+	mi := &file_harness_callback_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8633,7 +10170,7 @@ func (x *GetTaxonomySchemaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaxonomySchemaRequest.ProtoReflect.Descriptor instead.
 func (*GetTaxonomySchemaRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{131}
+	return file_harness_callback_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *GetTaxonomySchemaRequest) GetContext() *ContextInfo {
@@ -8659,7 +10196,7 @@ type GetTaxonomySchemaResponse struct {
 
 func (x *GetTaxonomySchemaResponse) Reset() {
 	*x = GetTaxonomySchemaResponse{}
-	mi := &file_harness_callback_proto_msgTypes[132]
+	mi := &file_harness_callback_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8671,7 +10208,7 @@ func (x *GetTaxonomySchemaResponse) String() string {
 func (*GetTaxonomySchemaResponse) ProtoMessage() {}
 
 func (x *GetTaxonomySchemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[132]
+	mi := &file_harness_callback_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8684,7 +10221,7 @@ func (x *GetTaxonomySchemaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaxonomySchemaResponse.ProtoReflect.Descriptor instead.
 func (*GetTaxonomySchemaResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{132}
+	return file_harness_callback_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *GetTaxonomySchemaResponse) GetVersion() string {
@@ -8758,7 +10295,7 @@ type TaxonomyNodeType struct {
 
 func (x *TaxonomyNodeType) Reset() {
 	*x = TaxonomyNodeType{}
-	mi := &file_harness_callback_proto_msgTypes[133]
+	mi := &file_harness_callback_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8770,7 +10307,7 @@ func (x *TaxonomyNodeType) String() string {
 func (*TaxonomyNodeType) ProtoMessage() {}
 
 func (x *TaxonomyNodeType) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[133]
+	mi := &file_harness_callback_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8783,7 +10320,7 @@ func (x *TaxonomyNodeType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyNodeType.ProtoReflect.Descriptor instead.
 func (*TaxonomyNodeType) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{133}
+	return file_harness_callback_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *TaxonomyNodeType) GetId() string {
@@ -8852,7 +10389,7 @@ type TaxonomyRelationshipType struct {
 
 func (x *TaxonomyRelationshipType) Reset() {
 	*x = TaxonomyRelationshipType{}
-	mi := &file_harness_callback_proto_msgTypes[134]
+	mi := &file_harness_callback_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8864,7 +10401,7 @@ func (x *TaxonomyRelationshipType) String() string {
 func (*TaxonomyRelationshipType) ProtoMessage() {}
 
 func (x *TaxonomyRelationshipType) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[134]
+	mi := &file_harness_callback_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8877,7 +10414,7 @@ func (x *TaxonomyRelationshipType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyRelationshipType.ProtoReflect.Descriptor instead.
 func (*TaxonomyRelationshipType) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{134}
+	return file_harness_callback_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *TaxonomyRelationshipType) GetId() string {
@@ -8959,7 +10496,7 @@ type TaxonomyTechnique struct {
 
 func (x *TaxonomyTechnique) Reset() {
 	*x = TaxonomyTechnique{}
-	mi := &file_harness_callback_proto_msgTypes[135]
+	mi := &file_harness_callback_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8971,7 +10508,7 @@ func (x *TaxonomyTechnique) String() string {
 func (*TaxonomyTechnique) ProtoMessage() {}
 
 func (x *TaxonomyTechnique) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[135]
+	mi := &file_harness_callback_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8984,7 +10521,7 @@ func (x *TaxonomyTechnique) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyTechnique.ProtoReflect.Descriptor instead.
 func (*TaxonomyTechnique) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{135}
+	return file_harness_callback_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *TaxonomyTechnique) GetTechniqueId() string {
@@ -9058,7 +10595,7 @@ type TaxonomyTargetType struct {
 
 func (x *TaxonomyTargetType) Reset() {
 	*x = TaxonomyTargetType{}
-	mi := &file_harness_callback_proto_msgTypes[136]
+	mi := &file_harness_callback_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9070,7 +10607,7 @@ func (x *TaxonomyTargetType) String() string {
 func (*TaxonomyTargetType) ProtoMessage() {}
 
 func (x *TaxonomyTargetType) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[136]
+	mi := &file_harness_callback_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9083,7 +10620,7 @@ func (x *TaxonomyTargetType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyTargetType.ProtoReflect.Descriptor instead.
 func (*TaxonomyTargetType) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{136}
+	return file_harness_callback_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *TaxonomyTargetType) GetId() string {
@@ -9150,7 +10687,7 @@ type TaxonomyTechniqueType struct {
 
 func (x *TaxonomyTechniqueType) Reset() {
 	*x = TaxonomyTechniqueType{}
-	mi := &file_harness_callback_proto_msgTypes[137]
+	mi := &file_harness_callback_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9162,7 +10699,7 @@ func (x *TaxonomyTechniqueType) String() string {
 func (*TaxonomyTechniqueType) ProtoMessage() {}
 
 func (x *TaxonomyTechniqueType) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[137]
+	mi := &file_harness_callback_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9175,7 +10712,7 @@ func (x *TaxonomyTechniqueType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyTechniqueType.ProtoReflect.Descriptor instead.
 func (*TaxonomyTechniqueType) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{137}
+	return file_harness_callback_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *TaxonomyTechniqueType) GetId() string {
@@ -9239,7 +10776,7 @@ type TaxonomyCapability struct {
 
 func (x *TaxonomyCapability) Reset() {
 	*x = TaxonomyCapability{}
-	mi := &file_harness_callback_proto_msgTypes[138]
+	mi := &file_harness_callback_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9251,7 +10788,7 @@ func (x *TaxonomyCapability) String() string {
 func (*TaxonomyCapability) ProtoMessage() {}
 
 func (x *TaxonomyCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[138]
+	mi := &file_harness_callback_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9264,7 +10801,7 @@ func (x *TaxonomyCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyCapability.ProtoReflect.Descriptor instead.
 func (*TaxonomyCapability) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{138}
+	return file_harness_callback_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *TaxonomyCapability) GetId() string {
@@ -9309,7 +10846,7 @@ type TaxonomyProperty struct {
 
 func (x *TaxonomyProperty) Reset() {
 	*x = TaxonomyProperty{}
-	mi := &file_harness_callback_proto_msgTypes[139]
+	mi := &file_harness_callback_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9321,7 +10858,7 @@ func (x *TaxonomyProperty) String() string {
 func (*TaxonomyProperty) ProtoMessage() {}
 
 func (x *TaxonomyProperty) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[139]
+	mi := &file_harness_callback_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9334,7 +10871,7 @@ func (x *TaxonomyProperty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaxonomyProperty.ProtoReflect.Descriptor instead.
 func (*TaxonomyProperty) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{139}
+	return file_harness_callback_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *TaxonomyProperty) GetName() string {
@@ -9390,7 +10927,7 @@ type GenerateNodeIDRequest struct {
 
 func (x *GenerateNodeIDRequest) Reset() {
 	*x = GenerateNodeIDRequest{}
-	mi := &file_harness_callback_proto_msgTypes[140]
+	mi := &file_harness_callback_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9402,7 +10939,7 @@ func (x *GenerateNodeIDRequest) String() string {
 func (*GenerateNodeIDRequest) ProtoMessage() {}
 
 func (x *GenerateNodeIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[140]
+	mi := &file_harness_callback_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9415,7 +10952,7 @@ func (x *GenerateNodeIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateNodeIDRequest.ProtoReflect.Descriptor instead.
 func (*GenerateNodeIDRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{140}
+	return file_harness_callback_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *GenerateNodeIDRequest) GetContext() *ContextInfo {
@@ -9449,7 +10986,7 @@ type GenerateNodeIDResponse struct {
 
 func (x *GenerateNodeIDResponse) Reset() {
 	*x = GenerateNodeIDResponse{}
-	mi := &file_harness_callback_proto_msgTypes[141]
+	mi := &file_harness_callback_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9461,7 +10998,7 @@ func (x *GenerateNodeIDResponse) String() string {
 func (*GenerateNodeIDResponse) ProtoMessage() {}
 
 func (x *GenerateNodeIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[141]
+	mi := &file_harness_callback_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9474,7 +11011,7 @@ func (x *GenerateNodeIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateNodeIDResponse.ProtoReflect.Descriptor instead.
 func (*GenerateNodeIDResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{141}
+	return file_harness_callback_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *GenerateNodeIDResponse) GetNodeId() string {
@@ -9501,7 +11038,7 @@ type ValidateFindingRequest struct {
 
 func (x *ValidateFindingRequest) Reset() {
 	*x = ValidateFindingRequest{}
-	mi := &file_harness_callback_proto_msgTypes[142]
+	mi := &file_harness_callback_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9513,7 +11050,7 @@ func (x *ValidateFindingRequest) String() string {
 func (*ValidateFindingRequest) ProtoMessage() {}
 
 func (x *ValidateFindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[142]
+	mi := &file_harness_callback_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9526,7 +11063,7 @@ func (x *ValidateFindingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateFindingRequest.ProtoReflect.Descriptor instead.
 func (*ValidateFindingRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{142}
+	return file_harness_callback_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *ValidateFindingRequest) GetContext() *ContextInfo {
@@ -9554,7 +11091,7 @@ type ValidateGraphNodeRequest struct {
 
 func (x *ValidateGraphNodeRequest) Reset() {
 	*x = ValidateGraphNodeRequest{}
-	mi := &file_harness_callback_proto_msgTypes[143]
+	mi := &file_harness_callback_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9566,7 +11103,7 @@ func (x *ValidateGraphNodeRequest) String() string {
 func (*ValidateGraphNodeRequest) ProtoMessage() {}
 
 func (x *ValidateGraphNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[143]
+	mi := &file_harness_callback_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9579,7 +11116,7 @@ func (x *ValidateGraphNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateGraphNodeRequest.ProtoReflect.Descriptor instead.
 func (*ValidateGraphNodeRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{143}
+	return file_harness_callback_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *ValidateGraphNodeRequest) GetContext() *ContextInfo {
@@ -9616,7 +11153,7 @@ type ValidateRelationshipRequest struct {
 
 func (x *ValidateRelationshipRequest) Reset() {
 	*x = ValidateRelationshipRequest{}
-	mi := &file_harness_callback_proto_msgTypes[144]
+	mi := &file_harness_callback_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9628,7 +11165,7 @@ func (x *ValidateRelationshipRequest) String() string {
 func (*ValidateRelationshipRequest) ProtoMessage() {}
 
 func (x *ValidateRelationshipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[144]
+	mi := &file_harness_callback_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9641,7 +11178,7 @@ func (x *ValidateRelationshipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateRelationshipRequest.ProtoReflect.Descriptor instead.
 func (*ValidateRelationshipRequest) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{144}
+	return file_harness_callback_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *ValidateRelationshipRequest) GetContext() *ContextInfo {
@@ -9691,7 +11228,7 @@ type ValidationResponse struct {
 
 func (x *ValidationResponse) Reset() {
 	*x = ValidationResponse{}
-	mi := &file_harness_callback_proto_msgTypes[145]
+	mi := &file_harness_callback_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9703,7 +11240,7 @@ func (x *ValidationResponse) String() string {
 func (*ValidationResponse) ProtoMessage() {}
 
 func (x *ValidationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[145]
+	mi := &file_harness_callback_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9716,7 +11253,7 @@ func (x *ValidationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidationResponse.ProtoReflect.Descriptor instead.
 func (*ValidationResponse) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{145}
+	return file_harness_callback_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *ValidationResponse) GetValid() bool {
@@ -9758,7 +11295,7 @@ type ValidationError struct {
 
 func (x *ValidationError) Reset() {
 	*x = ValidationError{}
-	mi := &file_harness_callback_proto_msgTypes[146]
+	mi := &file_harness_callback_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9770,7 +11307,7 @@ func (x *ValidationError) String() string {
 func (*ValidationError) ProtoMessage() {}
 
 func (x *ValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_harness_callback_proto_msgTypes[146]
+	mi := &file_harness_callback_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9783,7 +11320,7 @@ func (x *ValidationError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
 func (*ValidationError) Descriptor() ([]byte, []int) {
-	return file_harness_callback_proto_rawDescGZIP(), []int{146}
+	return file_harness_callback_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *ValidationError) GetField() string {
@@ -9820,7 +11357,7 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x05state\x18\x01 \x01(\tR\x05state\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
-	"checked_at\x18\x03 \x01(\x03R\tcheckedAt\"\xab\x02\n" +
+	"checked_at\x18\x03 \x01(\x03R\tcheckedAt\"\xc4\x02\n" +
 	"\vContextInfo\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1d\n" +
 	"\n" +
@@ -9834,12 +11371,28 @@ const file_harness_callback_proto_rawDesc = "" +
 	"agentRunId\x12\x1d\n" +
 	"\n" +
 	"run_number\x18\b \x01(\x05R\trunNumber\x12*\n" +
-	"\x11tool_execution_id\x18\t \x01(\tR\x0ftoolExecutionId\"w\n" +
+	"\x11tool_execution_id\x18\t \x01(\tR\x0ftoolExecutionId\x12\x17\n" +
+	"\astep_id\x18\n" +
+	" \x01(\tR\x06stepId\"w\n" +
 	"\n" +
 	"TokenUsage\x12!\n" +
 	"\finput_tokens\x18\x01 \x01(\x05R\vinputTokens\x12#\n" +
 	"\routput_tokens\x18\x02 \x01(\x05R\foutputTokens\x12!\n" +
-	"\ftotal_tokens\x18\x03 \x01(\x05R\vtotalTokens\"\xc6\x01\n" +
+	"\ftotal_tokens\x18\x03 \x01(\x05R\vtotalTokens\"\xf3\x02\n" +
+	"\x17ReportTokenUsageRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x03R\bsequence\x12H\n" +
+	"\x05slots\x18\x04 \x03(\v22.gibson.harness.ReportTokenUsageRequest.SlotsEntryR\x05slots\x120\n" +
+	"\x05total\x18\x05 \x01(\v2\x1a.gibson.harness.TokenUsageR\x05total\x12\x14\n" +
+	"\x05final\x18\x06 \x01(\bR\x05final\x1aT\n" +
+	"\n" +
+	"SlotsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.gibson.harness.TokenUsageR\x05value:\x028\x01\"N\n" +
+	"\x18ReportTokenUsageResponse\x122\n" +
+	"\x05error\x18\x01 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xc6\x01\n" +
 	"\n" +
 	"LLMMessage\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
@@ -9863,7 +11416,7 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12>\n" +
 	"\n" +
 	"parameters\x18\x03 \x01(\v2\x1e.gibson.harness.JSONSchemaNodeR\n" +
-	"parameters\"\xb9\x02\n" +
+	"parameters\"\xe0\x02\n" +
 	"\x12LLMCompleteRequest\x125\n" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x12\n" +
 	"\x04slot\x18\x02 \x01(\tR\x04slot\x126\n" +
@@ -9872,7 +11425,8 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\n" +
 	"max_tokens\x18\x05 \x01(\x05H\x01R\tmaxTokens\x88\x01\x01\x12\x18\n" +
 	"\x05top_p\x18\x06 \x01(\x01H\x02R\x04topP\x88\x01\x01\x12\x12\n" +
-	"\x04stop\x18\a \x03(\tR\x04stopB\x0e\n" +
+	"\x04stop\x18\a \x03(\tR\x04stop\x12%\n" +
+	"\x0emodel_override\x18\b \x01(\tR\rmodelOverrideB\x0e\n" +
 	"\f_temperatureB\r\n" +
 	"\v_max_tokensB\b\n" +
 	"\x06_top_p\"\xcf\x01\n" +
@@ -9890,14 +11444,15 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x1dLLMCompleteStructuredResponse\x121\n" +
 	"\x06result\x18\x01 \x01(\v2\x19.gibson.common.TypedValueR\x06result\x120\n" +
 	"\x05usage\x18\x03 \x01(\v2\x1a.gibson.harness.TokenUsageR\x05usage\x122\n" +
-	"\x05error\x18\x04 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05errorJ\x04\b\x02\x10\x03\"\xf3\x01\n" +
+	"\x05error\x18\x04 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05errorJ\x04\b\x02\x10\x03\"\x89\x02\n" +
 	"\x13LLMCompleteResponse\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x127\n" +
 	"\n" +
 	"tool_calls\x18\x02 \x03(\v2\x18.gibson.harness.ToolCallR\ttoolCalls\x12#\n" +
 	"\rfinish_reason\x18\x03 \x01(\tR\ffinishReason\x120\n" +
 	"\x05usage\x18\x04 \x01(\v2\x1a.gibson.harness.TokenUsageR\x05usage\x122\n" +
-	"\x05error\x18\x05 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xb7\x02\n" +
+	"\x05error\x18\x05 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\"\xb7\x02\n" +
 	"\x10LLMStreamRequest\x125\n" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x12\n" +
 	"\x04slot\x18\x02 \x01(\tR\x04slot\x126\n" +
@@ -9979,7 +11534,15 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12A\n" +
 	"\finput_schema\x18\x05 \x01(\v2\x1e.gibson.harness.JSONSchemaNodeR\vinputSchema\x12C\n" +
-	"\routput_schema\x18\x06 \x01(\v2\x1e.gibson.harness.JSONSchemaNodeR\foutputSchemaJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"\xcb\x01\n" +
+	"\routput_schema\x18\x06 \x01(\v2\x1e.gibson.harness.JSONSchemaNodeR\foutputSchemaJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"t\n" +
+	"\x1eGetToolProtoDescriptorsRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x1b\n" +
+	"\ttool_name\x18\x02 \x01(\tR\btoolName\"\xe3\x01\n" +
+	"\x1fGetToolProtoDescriptorsResponse\x12,\n" +
+	"\x12input_message_type\x18\x01 \x01(\tR\x10inputMessageType\x12.\n" +
+	"\x13output_message_type\x18\x02 \x01(\tR\x11outputMessageType\x12.\n" +
+	"\x13file_descriptor_set\x18\x03 \x01(\fR\x11fileDescriptorSet\x122\n" +
+	"\x05error\x18\x04 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xcb\x01\n" +
 	"\x14QueueToolWorkRequest\x125\n" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x1b\n" +
 	"\ttool_name\x18\x02 \x01(\tR\btoolName\x12\x1f\n" +
@@ -10002,7 +11565,7 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\voutput_type\x18\x03 \x01(\tR\n" +
 	"outputType\x122\n" +
 	"\x05error\x18\x04 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\x12\x19\n" +
-	"\bis_final\x18\x05 \x01(\bR\aisFinal\"\xdc\x06\n" +
+	"\bis_final\x18\x05 \x01(\bR\aisFinal\"\xf4\x06\n" +
 	"\x0eJSONSchemaNode\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12N\n" +
@@ -10026,7 +11589,8 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\apattern\x18\x0e \x01(\tH\x06R\apattern\x88\x01\x01\x12(\n" +
 	"\rdefault_value\x18\x0f \x01(\tH\aR\fdefaultValue\x88\x01\x01\x12\x1a\n" +
 	"\bnullable\x18\x10 \x01(\bR\bnullable\x12;\n" +
-	"\btaxonomy\x18\x11 \x01(\v2\x1f.gibson.harness.TaxonomyMappingR\btaxonomy\x1a]\n" +
+	"\btaxonomy\x18\x11 \x01(\v2\x1f.gibson.harness.TaxonomyMappingR\btaxonomy\x12\x16\n" +
+	"\x06guards\x18\x12 \x03(\tR\x06guards\x1a]\n" +
 	"\x0fPropertiesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
 	"\x05value\x18\x02 \x01(\v2\x1e.gibson.harness.JSONSchemaNodeR\x05value:\x028\x01B\n" +
@@ -10104,14 +11668,16 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\"\x88\x01\n" +
 	"\x12ListAgentsResponse\x12>\n" +
 	"\x06agents\x18\x01 \x03(\v2&.gibson.harness.HarnessAgentDescriptorR\x06agents\x122\n" +
-	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xd8\x01\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xaa\x02\n" +
 	"\x16HarnessAgentDescriptor\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
 	"\fcapabilities\x18\x04 \x03(\tR\fcapabilities\x12!\n" +
 	"\ftarget_types\x18\x05 \x03(\tR\vtargetTypes\x12'\n" +
-	"\x0ftechnique_types\x18\x06 \x03(\tR\x0etechniqueTypes\"~\n" +
+	"\x0ftechnique_types\x18\x06 \x03(\tR\x0etechniqueTypes\x12%\n" +
+	"\x0erequired_tools\x18\a \x03(\tR\rrequiredTools\x12)\n" +
+	"\x10required_plugins\x18\b \x03(\tR\x0frequiredPlugins\"~\n" +
 	"\x14SubmitFindingRequest\x125\n" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12/\n" +
 	"\afinding\x18\x02 \x01(\v2\x15.gibson.types.FindingR\afinding\"K\n" +
@@ -10170,14 +11736,20 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x04tier\x18\x03 \x01(\x0e2\x1a.gibson.harness.MemoryTierR\x04tier\"\\\n" +
 	"\x12MemoryListResponse\x12\x12\n" +
 	"\x04keys\x18\x01 \x03(\tR\x04keys\x122\n" +
-	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\x7f\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xbd\x02\n" +
 	"\x1aMissionMemorySearchRequest\x125\n" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x14\n" +
 	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x90\x01\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12N\n" +
+	"\x06filter\x18\x04 \x03(\v26.gibson.harness.MissionMemorySearchRequest.FilterEntryR\x06filter\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x1aT\n" +
+	"\vFilterEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01\"\xa6\x01\n" +
 	"\x1bMissionMemorySearchResponse\x12=\n" +
 	"\aresults\x18\x01 \x03(\v2#.gibson.harness.MissionMemoryResultR\aresults\x122\n" +
-	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xd3\x02\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"\xd3\x02\n" +
 	"\x13MissionMemoryResult\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value\x12M\n" +
@@ -10189,13 +11761,19 @@ const file_harness_callback_proto_rawDesc = "" +
 	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x1aV\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01\"j\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01\"\xa9\x02\n" +
 	"\x1bMissionMemoryHistoryRequest\x125\n" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x8b\x01\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12O\n" +
+	"\x06filter\x18\x03 \x03(\v27.gibson.harness.MissionMemoryHistoryRequest.FilterEntryR\x06filter\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x1aT\n" +
+	"\vFilterEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01\"\xa1\x01\n" +
 	"\x1cMissionMemoryHistoryResponse\x127\n" +
 	"\x05items\x18\x01 \x03(\v2!.gibson.harness.MissionMemoryItemR\x05items\x122\n" +
-	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xb9\x02\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"\xb9\x02\n" +
 	"\x11MissionMemoryItem\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value\x12K\n" +
@@ -10273,7 +11851,63 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x05query\x18\x02 \x01(\v2\x18.gibson.types.GraphQueryR\x05query\"\x85\x01\n" +
 	"\x15GraphRAGQueryResponse\x128\n" +
 	"\aresults\x18\x01 \x03(\v2\x1e.gibson.harness.GraphRAGResultR\aresults\x122\n" +
-	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xc9\x01\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\x86\x01\n" +
+	"\x19GraphRAGQueryBatchRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x122\n" +
+	"\aqueries\x18\x02 \x03(\v2\x18.gibson.types.GraphQueryR\aqueries\"\x8e\x01\n" +
+	"\x1aGraphRAGQueryBatchResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.gibson.harness.GraphRAGQueryBatchItemR\x05items\x122\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\x86\x01\n" +
+	"\x16GraphRAGQueryBatchItem\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.gibson.harness.GraphRAGResultR\aresults\x122\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\x9a\x01\n" +
+	"\x16GraphRAGExplainRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12.\n" +
+	"\x05query\x18\x02 \x01(\v2\x18.gibson.types.GraphQueryR\x05query\x12\x19\n" +
+	"\bmax_hops\x18\x03 \x01(\x05R\amaxHops\"\x84\x01\n" +
+	"\x17GraphRAGExplainResponse\x125\n" +
+	"\x04plan\x18\x01 \x01(\v2!.gibson.harness.GraphRAGQueryPlanR\x04plan\x122\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xa3\x03\n" +
+	"\x11GraphRAGQueryPlan\x12\x14\n" +
+	"\x05route\x18\x01 \x01(\tR\x05route\x12\x13\n" +
+	"\x05top_k\x18\x02 \x01(\x05R\x04topK\x12\x19\n" +
+	"\bmax_hops\x18\x03 \x01(\x05R\amaxHops\x12\x1b\n" +
+	"\tmin_score\x18\x04 \x01(\x01R\bminScore\x12#\n" +
+	"\rvector_weight\x18\x05 \x01(\x01R\fvectorWeight\x12!\n" +
+	"\fgraph_weight\x18\x06 \x01(\x01R\vgraphWeight\x12\x1d\n" +
+	"\n" +
+	"node_types\x18\a \x03(\tR\tnodeTypes\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\b \x01(\tR\tmissionId\x12$\n" +
+	"\x0emission_run_id\x18\t \x01(\tR\fmissionRunId\x12G\n" +
+	"\x10property_filters\x18\n" +
+	" \x03(\v2\x1c.gibson.types.PropertyFilterR\x0fpropertyFilters\x12\x1e\n" +
+	"\n" +
+	"operations\x18\v \x03(\tR\n" +
+	"operations\x12\x16\n" +
+	"\x06errors\x18\f \x03(\tR\x06errors\"l\n" +
+	"\x14GraphRAGStatsRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\"\x80\x01\n" +
+	"\x15GraphRAGStatsResponse\x123\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1d.gibson.harness.GraphRAGStatsR\x05stats\x122\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xeb\x03\n" +
+	"\rGraphRAGStats\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x01 \x01(\tR\tmissionId\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x02 \x01(\x03R\tnodeCount\x12-\n" +
+	"\x12relationship_count\x18\x03 \x01(\x03R\x11relationshipCount\x12R\n" +
+	"\rnodes_by_type\x18\x04 \x03(\v2..gibson.harness.GraphRAGStats.NodesByTypeEntryR\vnodesByType\x12j\n" +
+	"\x15relationships_by_type\x18\x05 \x03(\v26.gibson.harness.GraphRAGStats.RelationshipsByTypeEntryR\x13relationshipsByType\x12%\n" +
+	"\x0eaverage_degree\x18\x06 \x01(\x01R\raverageDegree\x1a>\n" +
+	"\x10NodesByTypeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aF\n" +
+	"\x18RelationshipsByTypeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xc9\x01\n" +
 	"\x0eGraphRAGResult\x12-\n" +
 	"\x04node\x18\x01 \x01(\v2\x19.gibson.harness.GraphNodeR\x04node\x12\x14\n" +
 	"\x05score\x18\x02 \x01(\x01R\x05score\x12!\n" +
@@ -10399,17 +12033,66 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\aoptions\x18\x03 \x01(\v2 .gibson.harness.TraversalOptionsR\aoptions\"\x86\x01\n" +
 	"\x15TraverseGraphResponse\x129\n" +
 	"\aresults\x18\x01 \x03(\v2\x1f.gibson.harness.TraversalResultR\aresults\x122\n" +
-	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\x9b\x01\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xc0\x02\n" +
 	"\x10TraversalOptions\x12\x1b\n" +
 	"\tmax_depth\x18\x01 \x01(\x05R\bmaxDepth\x12-\n" +
 	"\x12relationship_types\x18\x02 \x03(\tR\x11relationshipTypes\x12\x1d\n" +
 	"\n" +
 	"node_types\x18\x03 \x03(\tR\tnodeTypes\x12\x1c\n" +
-	"\tdirection\x18\x04 \x01(\tR\tdirection\"p\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12/\n" +
+	"\x14must_pass_node_types\x18\x05 \x03(\tR\x11mustPassNodeTypes\x12(\n" +
+	"\x10avoid_node_types\x18\x06 \x03(\tR\x0eavoidNodeTypes\x12%\n" +
+	"\x0ecycle_handling\x18\a \x01(\tR\rcycleHandling\x12!\n" +
+	"\fmax_revisits\x18\b \x01(\x05R\vmaxRevisits\"\xb9\x01\n" +
+	"\x1bGraphRAGShortestPathRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x17\n" +
+	"\afrom_id\x18\x02 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x03 \x01(\tR\x04toId\x125\n" +
+	"\aoptions\x18\x04 \x01(\v2\x1b.gibson.harness.PathOptionsR\aoptions\"\x98\x01\n" +
+	"\x1cGraphRAGShortestPathResponse\x12.\n" +
+	"\x05edges\x18\x01 \x03(\v2\x18.gibson.harness.PathEdgeR\x05edges\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x122\n" +
+	"\x05error\x18\x03 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xa0\x01\n" +
+	"\vPathOptions\x12\x1b\n" +
+	"\tmax_depth\x18\x01 \x01(\x05R\bmaxDepth\x12-\n" +
+	"\x12relationship_types\x18\x02 \x03(\tR\x11relationshipTypes\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12'\n" +
+	"\x0fweight_property\x18\x04 \x01(\tR\x0eweightProperty\"\xa4\x02\n" +
+	"\bPathEdge\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12H\n" +
+	"\n" +
+	"properties\x18\x04 \x03(\v2(.gibson.harness.PathEdge.PropertiesEntryR\n" +
+	"properties\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12\x1a\n" +
+	"\breversed\x18\x06 \x01(\bR\breversed\x1aX\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01\"\xb5\x01\n" +
+	"\x18GraphRAGNeighborsRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12+\n" +
+	"\x11relationship_type\x18\x03 \x01(\tR\x10relationshipType\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\"\x80\x01\n" +
+	"\x19GraphRAGNeighborsResponse\x12/\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x19.gibson.harness.GraphNodeR\x05nodes\x122\n" +
+	"\x05error\x18\x02 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\x8b\x01\n" +
+	"\x14GraphRAGWatchRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\x12\x1d\n" +
+	"\n" +
+	"node_types\x18\x03 \x03(\tR\tnodeTypes\"\x86\x01\n" +
+	"\rGraphRAGEvent\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12-\n" +
+	"\x04node\x18\x02 \x01(\v2\x19.gibson.harness.GraphNodeR\x04node\x122\n" +
+	"\x05error\x18\x03 \x01(\v2\x1c.gibson.harness.HarnessErrorR\x05error\"\xa0\x01\n" +
 	"\x0fTraversalResult\x12-\n" +
 	"\x04node\x18\x01 \x01(\v2\x19.gibson.harness.GraphNodeR\x04node\x12\x12\n" +
 	"\x04path\x18\x02 \x03(\tR\x04path\x12\x1a\n" +
-	"\bdistance\x18\x03 \x01(\x05R\bdistance\"N\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\x12.\n" +
+	"\x13must_pass_satisfied\x18\x04 \x01(\bR\x11mustPassSatisfied\"N\n" +
 	"\x15GraphRAGHealthRequest\x125\n" +
 	"\acontext\x18\x01 \x01(\v2\x1b.gibson.harness.ContextInfoR\acontext\"U\n" +
 	"\x16GraphRAGHealthResponse\x12;\n" +
@@ -10671,15 +12354,17 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x16CREDENTIAL_TYPE_BEARER\x10\x02\x12\x19\n" +
 	"\x15CREDENTIAL_TYPE_BASIC\x10\x03\x12\x19\n" +
 	"\x15CREDENTIAL_TYPE_OAUTH\x10\x04\x12\x1a\n" +
-	"\x16CREDENTIAL_TYPE_CUSTOM\x10\x052\xcc&\n" +
+	"\x16CREDENTIAL_TYPE_CUSTOM\x10\x052\x93-\n" +
 	"\x16HarnessCallbackService\x12V\n" +
 	"\vLLMComplete\x12\".gibson.harness.LLMCompleteRequest\x1a#.gibson.harness.LLMCompleteResponse\x12h\n" +
 	"\x14LLMCompleteWithTools\x12+.gibson.harness.LLMCompleteWithToolsRequest\x1a#.gibson.harness.LLMCompleteResponse\x12t\n" +
-	"\x15LLMCompleteStructured\x12,.gibson.harness.LLMCompleteStructuredRequest\x1a-.gibson.harness.LLMCompleteStructuredResponse\x12O\n" +
+	"\x15LLMCompleteStructured\x12,.gibson.harness.LLMCompleteStructuredRequest\x1a-.gibson.harness.LLMCompleteStructuredResponse\x12e\n" +
+	"\x10ReportTokenUsage\x12'.gibson.harness.ReportTokenUsageRequest\x1a(.gibson.harness.ReportTokenUsageResponse\x12O\n" +
 	"\tLLMStream\x12 .gibson.harness.LLMStreamRequest\x1a\x1e.gibson.harness.LLMStreamChunk0\x01\x12\\\n" +
 	"\rCallToolProto\x12$.gibson.harness.CallToolProtoRequest\x1a%.gibson.harness.CallToolProtoResponse\x12p\n" +
 	"\x13CallToolProtoStream\x12*.gibson.harness.CallToolProtoStreamRequest\x1a+.gibson.harness.CallToolProtoStreamResponse0\x01\x12P\n" +
-	"\tListTools\x12 .gibson.harness.ListToolsRequest\x1a!.gibson.harness.ListToolsResponse\x12\\\n" +
+	"\tListTools\x12 .gibson.harness.ListToolsRequest\x1a!.gibson.harness.ListToolsResponse\x12z\n" +
+	"\x17GetToolProtoDescriptors\x12..gibson.harness.GetToolProtoDescriptorsRequest\x1a/.gibson.harness.GetToolProtoDescriptorsResponse\x12\\\n" +
 	"\rQueueToolWork\x12$.gibson.harness.QueueToolWorkRequest\x1a%.gibson.harness.QueueToolWorkResponse\x12W\n" +
 	"\vToolResults\x12\".gibson.harness.ToolResultsRequest\x1a\".gibson.harness.ToolResultResponse0\x01\x12V\n" +
 	"\vQueryPlugin\x12\".gibson.harness.QueryPluginRequest\x1a#.gibson.harness.QueryPluginResponse\x12V\n" +
@@ -10703,6 +12388,9 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x14LongTermMemorySearch\x12+.gibson.harness.LongTermMemorySearchRequest\x1a,.gibson.harness.LongTermMemorySearchResponse\x12q\n" +
 	"\x14LongTermMemoryDelete\x12+.gibson.harness.LongTermMemoryDeleteRequest\x1a,.gibson.harness.LongTermMemoryDeleteResponse\x12\\\n" +
 	"\rGraphRAGQuery\x12$.gibson.harness.GraphRAGQueryRequest\x1a%.gibson.harness.GraphRAGQueryResponse\x12k\n" +
+	"\x12GraphRAGQueryBatch\x12).gibson.harness.GraphRAGQueryBatchRequest\x1a*.gibson.harness.GraphRAGQueryBatchResponse\x12b\n" +
+	"\x0fGraphRAGExplain\x12&.gibson.harness.GraphRAGExplainRequest\x1a'.gibson.harness.GraphRAGExplainResponse\x12\\\n" +
+	"\rGraphRAGStats\x12$.gibson.harness.GraphRAGStatsRequest\x1a%.gibson.harness.GraphRAGStatsResponse\x12k\n" +
 	"\x12FindSimilarAttacks\x12).gibson.harness.FindSimilarAttacksRequest\x1a*.gibson.harness.FindSimilarAttacksResponse\x12n\n" +
 	"\x13FindSimilarFindings\x12*.gibson.harness.FindSimilarFindingsRequest\x1a+.gibson.harness.FindSimilarFindingsResponse\x12b\n" +
 	"\x0fGetAttackChains\x12&.gibson.harness.GetAttackChainsRequest\x1a'.gibson.harness.GetAttackChainsResponse\x12k\n" +
@@ -10710,7 +12398,10 @@ const file_harness_callback_proto_rawDesc = "" +
 	"\x0eStoreGraphNode\x12%.gibson.harness.StoreGraphNodeRequest\x1a&.gibson.harness.StoreGraphNodeResponse\x12z\n" +
 	"\x17CreateGraphRelationship\x12..gibson.harness.CreateGraphRelationshipRequest\x1a/.gibson.harness.CreateGraphRelationshipResponse\x12b\n" +
 	"\x0fStoreGraphBatch\x12&.gibson.harness.StoreGraphBatchRequest\x1a'.gibson.harness.StoreGraphBatchResponse\x12\\\n" +
-	"\rTraverseGraph\x12$.gibson.harness.TraverseGraphRequest\x1a%.gibson.harness.TraverseGraphResponse\x12_\n" +
+	"\rTraverseGraph\x12$.gibson.harness.TraverseGraphRequest\x1a%.gibson.harness.TraverseGraphResponse\x12q\n" +
+	"\x14GraphRAGShortestPath\x12+.gibson.harness.GraphRAGShortestPathRequest\x1a,.gibson.harness.GraphRAGShortestPathResponse\x12h\n" +
+	"\x11GraphRAGNeighbors\x12(.gibson.harness.GraphRAGNeighborsRequest\x1a).gibson.harness.GraphRAGNeighborsResponse\x12V\n" +
+	"\rGraphRAGWatch\x12$.gibson.harness.GraphRAGWatchRequest\x1a\x1d.gibson.harness.GraphRAGEvent0\x01\x12_\n" +
 	"\x0eGraphRAGHealth\x12%.gibson.harness.GraphRAGHealthRequest\x1a&.gibson.harness.GraphRAGHealthResponse\x12P\n" +
 	"\tStoreNode\x12 .gibson.harness.StoreNodeRequest\x1a!.gibson.harness.StoreNodeResponse\x12S\n" +
 	"\n" +
@@ -10740,7 +12431,7 @@ func file_harness_callback_proto_rawDescGZIP() []byte {
 }
 
 var file_harness_callback_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_harness_callback_proto_msgTypes = make([]protoimpl.MessageInfo, 164)
+var file_harness_callback_proto_msgTypes = make([]protoimpl.MessageInfo, 191)
 var file_harness_callback_proto_goTypes = []any{
 	(MemoryTier)(0),                                  // 0: gibson.harness.MemoryTier
 	(SpanKind)(0),                                    // 1: gibson.harness.SpanKind
@@ -10750,505 +12441,588 @@ var file_harness_callback_proto_goTypes = []any{
 	(*HarnessHealthStatus)(nil),                      // 5: gibson.harness.HarnessHealthStatus
 	(*ContextInfo)(nil),                              // 6: gibson.harness.ContextInfo
 	(*TokenUsage)(nil),                               // 7: gibson.harness.TokenUsage
-	(*LLMMessage)(nil),                               // 8: gibson.harness.LLMMessage
-	(*ToolCall)(nil),                                 // 9: gibson.harness.ToolCall
-	(*ToolResult)(nil),                               // 10: gibson.harness.ToolResult
-	(*ToolDef)(nil),                                  // 11: gibson.harness.ToolDef
-	(*LLMCompleteRequest)(nil),                       // 12: gibson.harness.LLMCompleteRequest
-	(*LLMCompleteWithToolsRequest)(nil),              // 13: gibson.harness.LLMCompleteWithToolsRequest
-	(*LLMCompleteStructuredRequest)(nil),             // 14: gibson.harness.LLMCompleteStructuredRequest
-	(*LLMCompleteStructuredResponse)(nil),            // 15: gibson.harness.LLMCompleteStructuredResponse
-	(*LLMCompleteResponse)(nil),                      // 16: gibson.harness.LLMCompleteResponse
-	(*LLMStreamRequest)(nil),                         // 17: gibson.harness.LLMStreamRequest
-	(*LLMStreamChunk)(nil),                           // 18: gibson.harness.LLMStreamChunk
-	(*CallToolProtoRequest)(nil),                     // 19: gibson.harness.CallToolProtoRequest
-	(*CallToolProtoResponse)(nil),                    // 20: gibson.harness.CallToolProtoResponse
-	(*CallToolProtoStreamRequest)(nil),               // 21: gibson.harness.CallToolProtoStreamRequest
-	(*CallToolProtoStreamResponse)(nil),              // 22: gibson.harness.CallToolProtoStreamResponse
-	(*ToolProgressEvent)(nil),                        // 23: gibson.harness.ToolProgressEvent
-	(*ToolPartialResultEvent)(nil),                   // 24: gibson.harness.ToolPartialResultEvent
-	(*ToolWarningEvent)(nil),                         // 25: gibson.harness.ToolWarningEvent
-	(*ToolCompleteEvent)(nil),                        // 26: gibson.harness.ToolCompleteEvent
-	(*ToolErrorEvent)(nil),                           // 27: gibson.harness.ToolErrorEvent
-	(*ListToolsRequest)(nil),                         // 28: gibson.harness.ListToolsRequest
-	(*ListToolsResponse)(nil),                        // 29: gibson.harness.ListToolsResponse
-	(*HarnessToolDescriptor)(nil),                    // 30: gibson.harness.HarnessToolDescriptor
-	(*QueueToolWorkRequest)(nil),                     // 31: gibson.harness.QueueToolWorkRequest
-	(*QueueToolWorkResponse)(nil),                    // 32: gibson.harness.QueueToolWorkResponse
-	(*ToolResultsRequest)(nil),                       // 33: gibson.harness.ToolResultsRequest
-	(*ToolResultResponse)(nil),                       // 34: gibson.harness.ToolResultResponse
-	(*JSONSchemaNode)(nil),                           // 35: gibson.harness.JSONSchemaNode
-	(*TaxonomyMapping)(nil),                          // 36: gibson.harness.TaxonomyMapping
-	(*PropertyMapping)(nil),                          // 37: gibson.harness.PropertyMapping
-	(*NodeReference)(nil),                            // 38: gibson.harness.NodeReference
-	(*RelationshipMapping)(nil),                      // 39: gibson.harness.RelationshipMapping
-	(*QueryPluginRequest)(nil),                       // 40: gibson.harness.QueryPluginRequest
-	(*QueryPluginResponse)(nil),                      // 41: gibson.harness.QueryPluginResponse
-	(*ListPluginsRequest)(nil),                       // 42: gibson.harness.ListPluginsRequest
-	(*ListPluginsResponse)(nil),                      // 43: gibson.harness.ListPluginsResponse
-	(*HarnessPluginDescriptor)(nil),                  // 44: gibson.harness.HarnessPluginDescriptor
-	(*DelegateToAgentRequest)(nil),                   // 45: gibson.harness.DelegateToAgentRequest
-	(*DelegateToAgentResponse)(nil),                  // 46: gibson.harness.DelegateToAgentResponse
-	(*ListAgentsRequest)(nil),                        // 47: gibson.harness.ListAgentsRequest
-	(*ListAgentsResponse)(nil),                       // 48: gibson.harness.ListAgentsResponse
-	(*HarnessAgentDescriptor)(nil),                   // 49: gibson.harness.HarnessAgentDescriptor
-	(*SubmitFindingRequest)(nil),                     // 50: gibson.harness.SubmitFindingRequest
-	(*SubmitFindingResponse)(nil),                    // 51: gibson.harness.SubmitFindingResponse
-	(*GetFindingsRequest)(nil),                       // 52: gibson.harness.GetFindingsRequest
-	(*GetFindingsResponse)(nil),                      // 53: gibson.harness.GetFindingsResponse
-	(*FindingFilter)(nil),                            // 54: gibson.harness.FindingFilter
-	(*MemoryGetRequest)(nil),                         // 55: gibson.harness.MemoryGetRequest
-	(*MemoryGetResponse)(nil),                        // 56: gibson.harness.MemoryGetResponse
-	(*MemorySetRequest)(nil),                         // 57: gibson.harness.MemorySetRequest
-	(*MemorySetResponse)(nil),                        // 58: gibson.harness.MemorySetResponse
-	(*MemoryDeleteRequest)(nil),                      // 59: gibson.harness.MemoryDeleteRequest
-	(*MemoryDeleteResponse)(nil),                     // 60: gibson.harness.MemoryDeleteResponse
-	(*MemoryListRequest)(nil),                        // 61: gibson.harness.MemoryListRequest
-	(*MemoryListResponse)(nil),                       // 62: gibson.harness.MemoryListResponse
-	(*MissionMemorySearchRequest)(nil),               // 63: gibson.harness.MissionMemorySearchRequest
-	(*MissionMemorySearchResponse)(nil),              // 64: gibson.harness.MissionMemorySearchResponse
-	(*MissionMemoryResult)(nil),                      // 65: gibson.harness.MissionMemoryResult
-	(*MissionMemoryHistoryRequest)(nil),              // 66: gibson.harness.MissionMemoryHistoryRequest
-	(*MissionMemoryHistoryResponse)(nil),             // 67: gibson.harness.MissionMemoryHistoryResponse
-	(*MissionMemoryItem)(nil),                        // 68: gibson.harness.MissionMemoryItem
-	(*MissionMemoryGetPreviousRunValueRequest)(nil),  // 69: gibson.harness.MissionMemoryGetPreviousRunValueRequest
-	(*MissionMemoryGetPreviousRunValueResponse)(nil), // 70: gibson.harness.MissionMemoryGetPreviousRunValueResponse
-	(*MissionMemoryGetValueHistoryRequest)(nil),      // 71: gibson.harness.MissionMemoryGetValueHistoryRequest
-	(*MissionMemoryGetValueHistoryResponse)(nil),     // 72: gibson.harness.MissionMemoryGetValueHistoryResponse
-	(*HistoricalValueItem)(nil),                      // 73: gibson.harness.HistoricalValueItem
-	(*MissionMemoryContinuityModeRequest)(nil),       // 74: gibson.harness.MissionMemoryContinuityModeRequest
-	(*MissionMemoryContinuityModeResponse)(nil),      // 75: gibson.harness.MissionMemoryContinuityModeResponse
-	(*LongTermMemoryStoreRequest)(nil),               // 76: gibson.harness.LongTermMemoryStoreRequest
-	(*LongTermMemoryStoreResponse)(nil),              // 77: gibson.harness.LongTermMemoryStoreResponse
-	(*LongTermMemorySearchRequest)(nil),              // 78: gibson.harness.LongTermMemorySearchRequest
-	(*LongTermMemorySearchResponse)(nil),             // 79: gibson.harness.LongTermMemorySearchResponse
-	(*LongTermMemoryResult)(nil),                     // 80: gibson.harness.LongTermMemoryResult
-	(*LongTermMemoryDeleteRequest)(nil),              // 81: gibson.harness.LongTermMemoryDeleteRequest
-	(*LongTermMemoryDeleteResponse)(nil),             // 82: gibson.harness.LongTermMemoryDeleteResponse
-	(*GraphRAGQueryRequest)(nil),                     // 83: gibson.harness.GraphRAGQueryRequest
-	(*GraphRAGQueryResponse)(nil),                    // 84: gibson.harness.GraphRAGQueryResponse
-	(*GraphRAGResult)(nil),                           // 85: gibson.harness.GraphRAGResult
-	(*GraphNode)(nil),                                // 86: gibson.harness.GraphNode
-	(*FindSimilarAttacksRequest)(nil),                // 87: gibson.harness.FindSimilarAttacksRequest
-	(*FindSimilarAttacksResponse)(nil),               // 88: gibson.harness.FindSimilarAttacksResponse
-	(*AttackPattern)(nil),                            // 89: gibson.harness.AttackPattern
-	(*FindSimilarFindingsRequest)(nil),               // 90: gibson.harness.FindSimilarFindingsRequest
-	(*FindSimilarFindingsResponse)(nil),              // 91: gibson.harness.FindSimilarFindingsResponse
-	(*FindingNode)(nil),                              // 92: gibson.harness.FindingNode
-	(*GetAttackChainsRequest)(nil),                   // 93: gibson.harness.GetAttackChainsRequest
-	(*GetAttackChainsResponse)(nil),                  // 94: gibson.harness.GetAttackChainsResponse
-	(*AttackChain)(nil),                              // 95: gibson.harness.AttackChain
-	(*AttackStep)(nil),                               // 96: gibson.harness.AttackStep
-	(*GetRelatedFindingsRequest)(nil),                // 97: gibson.harness.GetRelatedFindingsRequest
-	(*GetRelatedFindingsResponse)(nil),               // 98: gibson.harness.GetRelatedFindingsResponse
-	(*StoreGraphNodeRequest)(nil),                    // 99: gibson.harness.StoreGraphNodeRequest
-	(*StoreGraphNodeResponse)(nil),                   // 100: gibson.harness.StoreGraphNodeResponse
-	(*CreateGraphRelationshipRequest)(nil),           // 101: gibson.harness.CreateGraphRelationshipRequest
-	(*CreateGraphRelationshipResponse)(nil),          // 102: gibson.harness.CreateGraphRelationshipResponse
-	(*Relationship)(nil),                             // 103: gibson.harness.Relationship
-	(*StoreGraphBatchRequest)(nil),                   // 104: gibson.harness.StoreGraphBatchRequest
-	(*StoreGraphBatchResponse)(nil),                  // 105: gibson.harness.StoreGraphBatchResponse
-	(*TraverseGraphRequest)(nil),                     // 106: gibson.harness.TraverseGraphRequest
-	(*TraverseGraphResponse)(nil),                    // 107: gibson.harness.TraverseGraphResponse
-	(*TraversalOptions)(nil),                         // 108: gibson.harness.TraversalOptions
-	(*TraversalResult)(nil),                          // 109: gibson.harness.TraversalResult
-	(*GraphRAGHealthRequest)(nil),                    // 110: gibson.harness.GraphRAGHealthRequest
-	(*GraphRAGHealthResponse)(nil),                   // 111: gibson.harness.GraphRAGHealthResponse
-	(*StoreNodeRequest)(nil),                         // 112: gibson.harness.StoreNodeRequest
-	(*StoreNodeResponse)(nil),                        // 113: gibson.harness.StoreNodeResponse
-	(*QueryNodesRequest)(nil),                        // 114: gibson.harness.QueryNodesRequest
-	(*QueryNodesResponse)(nil),                       // 115: gibson.harness.QueryNodesResponse
-	(*GetPlanContextRequest)(nil),                    // 116: gibson.harness.GetPlanContextRequest
-	(*GetPlanContextResponse)(nil),                   // 117: gibson.harness.GetPlanContextResponse
-	(*PlanContext)(nil),                              // 118: gibson.harness.PlanContext
-	(*ReportStepHintsRequest)(nil),                   // 119: gibson.harness.ReportStepHintsRequest
-	(*ReportStepHintsResponse)(nil),                  // 120: gibson.harness.ReportStepHintsResponse
-	(*StepHints)(nil),                                // 121: gibson.harness.StepHints
-	(*AnyValue)(nil),                                 // 122: gibson.harness.AnyValue
-	(*KeyValue)(nil),                                 // 123: gibson.harness.KeyValue
-	(*SpanEvent)(nil),                                // 124: gibson.harness.SpanEvent
-	(*Span)(nil),                                     // 125: gibson.harness.Span
-	(*RecordSpanRequest)(nil),                        // 126: gibson.harness.RecordSpanRequest
-	(*RecordSpanResponse)(nil),                       // 127: gibson.harness.RecordSpanResponse
-	(*RecordSpansRequest)(nil),                       // 128: gibson.harness.RecordSpansRequest
-	(*RecordSpansResponse)(nil),                      // 129: gibson.harness.RecordSpansResponse
-	(*GetCredentialRequest)(nil),                     // 130: gibson.harness.GetCredentialRequest
-	(*GetCredentialResponse)(nil),                    // 131: gibson.harness.GetCredentialResponse
-	(*Credential)(nil),                               // 132: gibson.harness.Credential
-	(*BasicAuth)(nil),                                // 133: gibson.harness.BasicAuth
-	(*OAuthCredential)(nil),                          // 134: gibson.harness.OAuthCredential
-	(*GetTaxonomySchemaRequest)(nil),                 // 135: gibson.harness.GetTaxonomySchemaRequest
-	(*GetTaxonomySchemaResponse)(nil),                // 136: gibson.harness.GetTaxonomySchemaResponse
-	(*TaxonomyNodeType)(nil),                         // 137: gibson.harness.TaxonomyNodeType
-	(*TaxonomyRelationshipType)(nil),                 // 138: gibson.harness.TaxonomyRelationshipType
-	(*TaxonomyTechnique)(nil),                        // 139: gibson.harness.TaxonomyTechnique
-	(*TaxonomyTargetType)(nil),                       // 140: gibson.harness.TaxonomyTargetType
-	(*TaxonomyTechniqueType)(nil),                    // 141: gibson.harness.TaxonomyTechniqueType
-	(*TaxonomyCapability)(nil),                       // 142: gibson.harness.TaxonomyCapability
-	(*TaxonomyProperty)(nil),                         // 143: gibson.harness.TaxonomyProperty
-	(*GenerateNodeIDRequest)(nil),                    // 144: gibson.harness.GenerateNodeIDRequest
-	(*GenerateNodeIDResponse)(nil),                   // 145: gibson.harness.GenerateNodeIDResponse
-	(*ValidateFindingRequest)(nil),                   // 146: gibson.harness.ValidateFindingRequest
-	(*ValidateGraphNodeRequest)(nil),                 // 147: gibson.harness.ValidateGraphNodeRequest
-	(*ValidateRelationshipRequest)(nil),              // 148: gibson.harness.ValidateRelationshipRequest
-	(*ValidationResponse)(nil),                       // 149: gibson.harness.ValidationResponse
-	(*ValidationError)(nil),                          // 150: gibson.harness.ValidationError
-	nil,                                              // 151: gibson.harness.JSONSchemaNode.PropertiesEntry
-	nil,                                              // 152: gibson.harness.TaxonomyMapping.IdentifyingPropertiesEntry
-	nil,                                              // 153: gibson.harness.NodeReference.PropertiesEntry
-	nil,                                              // 154: gibson.harness.QueryPluginRequest.ParamsEntry
-	nil,                                              // 155: gibson.harness.MemoryGetResponse.MetadataEntry
-	nil,                                              // 156: gibson.harness.MemorySetRequest.MetadataEntry
-	nil,                                              // 157: gibson.harness.MissionMemoryResult.MetadataEntry
-	nil,                                              // 158: gibson.harness.MissionMemoryItem.MetadataEntry
-	nil,                                              // 159: gibson.harness.LongTermMemoryStoreRequest.MetadataEntry
-	nil,                                              // 160: gibson.harness.LongTermMemorySearchRequest.FiltersEntry
-	nil,                                              // 161: gibson.harness.LongTermMemoryResult.MetadataEntry
-	nil,                                              // 162: gibson.harness.GraphNode.PropertiesEntry
-	nil,                                              // 163: gibson.harness.Relationship.PropertiesEntry
-	nil,                                              // 164: gibson.harness.Credential.MetadataEntry
-	nil,                                              // 165: gibson.harness.GenerateNodeIDRequest.PropertiesEntry
-	nil,                                              // 166: gibson.harness.ValidateGraphNodeRequest.PropertiesEntry
-	nil,                                              // 167: gibson.harness.ValidateRelationshipRequest.PropertiesEntry
-	(ErrorCode)(0),                                   // 168: gibson.common.ErrorCode
-	(*TypedValue)(nil),                               // 169: gibson.common.TypedValue
-	(*Task)(nil),                                     // 170: gibson.types.Task
-	(*Result)(nil),                                   // 171: gibson.types.Result
-	(*Finding)(nil),                                  // 172: gibson.types.Finding
-	(FindingSeverity)(0),                             // 173: gibson.types.FindingSeverity
-	(FindingStatus)(0),                               // 174: gibson.types.FindingStatus
-	(*GraphQuery)(nil),                               // 175: gibson.types.GraphQuery
-	(*graphragpb.GraphNode)(nil),                     // 176: gibson.graphrag.GraphNode
-	(*graphragpb.GraphQuery)(nil),                    // 177: gibson.graphrag.GraphQuery
-	(*graphragpb.QueryResult)(nil),                   // 178: gibson.graphrag.QueryResult
+	(*ReportTokenUsageRequest)(nil),                  // 8: gibson.harness.ReportTokenUsageRequest
+	(*ReportTokenUsageResponse)(nil),                 // 9: gibson.harness.ReportTokenUsageResponse
+	(*LLMMessage)(nil),                               // 10: gibson.harness.LLMMessage
+	(*ToolCall)(nil),                                 // 11: gibson.harness.ToolCall
+	(*ToolResult)(nil),                               // 12: gibson.harness.ToolResult
+	(*ToolDef)(nil),                                  // 13: gibson.harness.ToolDef
+	(*LLMCompleteRequest)(nil),                       // 14: gibson.harness.LLMCompleteRequest
+	(*LLMCompleteWithToolsRequest)(nil),              // 15: gibson.harness.LLMCompleteWithToolsRequest
+	(*LLMCompleteStructuredRequest)(nil),             // 16: gibson.harness.LLMCompleteStructuredRequest
+	(*LLMCompleteStructuredResponse)(nil),            // 17: gibson.harness.LLMCompleteStructuredResponse
+	(*LLMCompleteResponse)(nil),                      // 18: gibson.harness.LLMCompleteResponse
+	(*LLMStreamRequest)(nil),                         // 19: gibson.harness.LLMStreamRequest
+	(*LLMStreamChunk)(nil),                           // 20: gibson.harness.LLMStreamChunk
+	(*CallToolProtoRequest)(nil),                     // 21: gibson.harness.CallToolProtoRequest
+	(*CallToolProtoResponse)(nil),                    // 22: gibson.harness.CallToolProtoResponse
+	(*CallToolProtoStreamRequest)(nil),               // 23: gibson.harness.CallToolProtoStreamRequest
+	(*CallToolProtoStreamResponse)(nil),              // 24: gibson.harness.CallToolProtoStreamResponse
+	(*ToolProgressEvent)(nil),                        // 25: gibson.harness.ToolProgressEvent
+	(*ToolPartialResultEvent)(nil),                   // 26: gibson.harness.ToolPartialResultEvent
+	(*ToolWarningEvent)(nil),                         // 27: gibson.harness.ToolWarningEvent
+	(*ToolCompleteEvent)(nil),                        // 28: gibson.harness.ToolCompleteEvent
+	(*ToolErrorEvent)(nil),                           // 29: gibson.harness.ToolErrorEvent
+	(*ListToolsRequest)(nil),                         // 30: gibson.harness.ListToolsRequest
+	(*ListToolsResponse)(nil),                        // 31: gibson.harness.ListToolsResponse
+	(*HarnessToolDescriptor)(nil),                    // 32: gibson.harness.HarnessToolDescriptor
+	(*GetToolProtoDescriptorsRequest)(nil),           // 33: gibson.harness.GetToolProtoDescriptorsRequest
+	(*GetToolProtoDescriptorsResponse)(nil),          // 34: gibson.harness.GetToolProtoDescriptorsResponse
+	(*QueueToolWorkRequest)(nil),                     // 35: gibson.harness.QueueToolWorkRequest
+	(*QueueToolWorkResponse)(nil),                    // 36: gibson.harness.QueueToolWorkResponse
+	(*ToolResultsRequest)(nil),                       // 37: gibson.harness.ToolResultsRequest
+	(*ToolResultResponse)(nil),                       // 38: gibson.harness.ToolResultResponse
+	(*JSONSchemaNode)(nil),                           // 39: gibson.harness.JSONSchemaNode
+	(*TaxonomyMapping)(nil),                          // 40: gibson.harness.TaxonomyMapping
+	(*PropertyMapping)(nil),                          // 41: gibson.harness.PropertyMapping
+	(*NodeReference)(nil),                            // 42: gibson.harness.NodeReference
+	(*RelationshipMapping)(nil),                      // 43: gibson.harness.RelationshipMapping
+	(*QueryPluginRequest)(nil),                       // 44: gibson.harness.QueryPluginRequest
+	(*QueryPluginResponse)(nil),                      // 45: gibson.harness.QueryPluginResponse
+	(*ListPluginsRequest)(nil),                       // 46: gibson.harness.ListPluginsRequest
+	(*ListPluginsResponse)(nil),                      // 47: gibson.harness.ListPluginsResponse
+	(*HarnessPluginDescriptor)(nil),                  // 48: gibson.harness.HarnessPluginDescriptor
+	(*DelegateToAgentRequest)(nil),                   // 49: gibson.harness.DelegateToAgentRequest
+	(*DelegateToAgentResponse)(nil),                  // 50: gibson.harness.DelegateToAgentResponse
+	(*ListAgentsRequest)(nil),                        // 51: gibson.harness.ListAgentsRequest
+	(*ListAgentsResponse)(nil),                       // 52: gibson.harness.ListAgentsResponse
+	(*HarnessAgentDescriptor)(nil),                   // 53: gibson.harness.HarnessAgentDescriptor
+	(*SubmitFindingRequest)(nil),                     // 54: gibson.harness.SubmitFindingRequest
+	(*SubmitFindingResponse)(nil),                    // 55: gibson.harness.SubmitFindingResponse
+	(*GetFindingsRequest)(nil),                       // 56: gibson.harness.GetFindingsRequest
+	(*GetFindingsResponse)(nil),                      // 57: gibson.harness.GetFindingsResponse
+	(*FindingFilter)(nil),                            // 58: gibson.harness.FindingFilter
+	(*MemoryGetRequest)(nil),                         // 59: gibson.harness.MemoryGetRequest
+	(*MemoryGetResponse)(nil),                        // 60: gibson.harness.MemoryGetResponse
+	(*MemorySetRequest)(nil),                         // 61: gibson.harness.MemorySetRequest
+	(*MemorySetResponse)(nil),                        // 62: gibson.harness.MemorySetResponse
+	(*MemoryDeleteRequest)(nil),                      // 63: gibson.harness.MemoryDeleteRequest
+	(*MemoryDeleteResponse)(nil),                     // 64: gibson.harness.MemoryDeleteResponse
+	(*MemoryListRequest)(nil),                        // 65: gibson.harness.MemoryListRequest
+	(*MemoryListResponse)(nil),                       // 66: gibson.harness.MemoryListResponse
+	(*MissionMemorySearchRequest)(nil),               // 67: gibson.harness.MissionMemorySearchRequest
+	(*MissionMemorySearchResponse)(nil),              // 68: gibson.harness.MissionMemorySearchResponse
+	(*MissionMemoryResult)(nil),                      // 69: gibson.harness.MissionMemoryResult
+	(*MissionMemoryHistoryRequest)(nil),              // 70: gibson.harness.MissionMemoryHistoryRequest
+	(*MissionMemoryHistoryResponse)(nil),             // 71: gibson.harness.MissionMemoryHistoryResponse
+	(*MissionMemoryItem)(nil),                        // 72: gibson.harness.MissionMemoryItem
+	(*MissionMemoryGetPreviousRunValueRequest)(nil),  // 73: gibson.harness.MissionMemoryGetPreviousRunValueRequest
+	(*MissionMemoryGetPreviousRunValueResponse)(nil), // 74: gibson.harness.MissionMemoryGetPreviousRunValueResponse
+	(*MissionMemoryGetValueHistoryRequest)(nil),      // 75: gibson.harness.MissionMemoryGetValueHistoryRequest
+	(*MissionMemoryGetValueHistoryResponse)(nil),     // 76: gibson.harness.MissionMemoryGetValueHistoryResponse
+	(*HistoricalValueItem)(nil),                      // 77: gibson.harness.HistoricalValueItem
+	(*MissionMemoryContinuityModeRequest)(nil),       // 78: gibson.harness.MissionMemoryContinuityModeRequest
+	(*MissionMemoryContinuityModeResponse)(nil),      // 79: gibson.harness.MissionMemoryContinuityModeResponse
+	(*LongTermMemoryStoreRequest)(nil),               // 80: gibson.harness.LongTermMemoryStoreRequest
+	(*LongTermMemoryStoreResponse)(nil),              // 81: gibson.harness.LongTermMemoryStoreResponse
+	(*LongTermMemorySearchRequest)(nil),              // 82: gibson.harness.LongTermMemorySearchRequest
+	(*LongTermMemorySearchResponse)(nil),             // 83: gibson.harness.LongTermMemorySearchResponse
+	(*LongTermMemoryResult)(nil),                     // 84: gibson.harness.LongTermMemoryResult
+	(*LongTermMemoryDeleteRequest)(nil),              // 85: gibson.harness.LongTermMemoryDeleteRequest
+	(*LongTermMemoryDeleteResponse)(nil),             // 86: gibson.harness.LongTermMemoryDeleteResponse
+	(*GraphRAGQueryRequest)(nil),                     // 87: gibson.harness.GraphRAGQueryRequest
+	(*GraphRAGQueryResponse)(nil),                    // 88: gibson.harness.GraphRAGQueryResponse
+	(*GraphRAGQueryBatchRequest)(nil),                // 89: gibson.harness.GraphRAGQueryBatchRequest
+	(*GraphRAGQueryBatchResponse)(nil),               // 90: gibson.harness.GraphRAGQueryBatchResponse
+	(*GraphRAGQueryBatchItem)(nil),                   // 91: gibson.harness.GraphRAGQueryBatchItem
+	(*GraphRAGExplainRequest)(nil),                   // 92: gibson.harness.GraphRAGExplainRequest
+	(*GraphRAGExplainResponse)(nil),                  // 93: gibson.harness.GraphRAGExplainResponse
+	(*GraphRAGQueryPlan)(nil),                        // 94: gibson.harness.GraphRAGQueryPlan
+	(*GraphRAGStatsRequest)(nil),                     // 95: gibson.harness.GraphRAGStatsRequest
+	(*GraphRAGStatsResponse)(nil),                    // 96: gibson.harness.GraphRAGStatsResponse
+	(*GraphRAGStats)(nil),                            // 97: gibson.harness.GraphRAGStats
+	(*GraphRAGResult)(nil),                           // 98: gibson.harness.GraphRAGResult
+	(*GraphNode)(nil),                                // 99: gibson.harness.GraphNode
+	(*FindSimilarAttacksRequest)(nil),                // 100: gibson.harness.FindSimilarAttacksRequest
+	(*FindSimilarAttacksResponse)(nil),               // 101: gibson.harness.FindSimilarAttacksResponse
+	(*AttackPattern)(nil),                            // 102: gibson.harness.AttackPattern
+	(*FindSimilarFindingsRequest)(nil),               // 103: gibson.harness.FindSimilarFindingsRequest
+	(*FindSimilarFindingsResponse)(nil),              // 104: gibson.harness.FindSimilarFindingsResponse
+	(*FindingNode)(nil),                              // 105: gibson.harness.FindingNode
+	(*GetAttackChainsRequest)(nil),                   // 106: gibson.harness.GetAttackChainsRequest
+	(*GetAttackChainsResponse)(nil),                  // 107: gibson.harness.GetAttackChainsResponse
+	(*AttackChain)(nil),                              // 108: gibson.harness.AttackChain
+	(*AttackStep)(nil),                               // 109: gibson.harness.AttackStep
+	(*GetRelatedFindingsRequest)(nil),                // 110: gibson.harness.GetRelatedFindingsRequest
+	(*GetRelatedFindingsResponse)(nil),               // 111: gibson.harness.GetRelatedFindingsResponse
+	(*StoreGraphNodeRequest)(nil),                    // 112: gibson.harness.StoreGraphNodeRequest
+	(*StoreGraphNodeResponse)(nil),                   // 113: gibson.harness.StoreGraphNodeResponse
+	(*CreateGraphRelationshipRequest)(nil),           // 114: gibson.harness.CreateGraphRelationshipRequest
+	(*CreateGraphRelationshipResponse)(nil),          // 115: gibson.harness.CreateGraphRelationshipResponse
+	(*Relationship)(nil),                             // 116: gibson.harness.Relationship
+	(*StoreGraphBatchRequest)(nil),                   // 117: gibson.harness.StoreGraphBatchRequest
+	(*StoreGraphBatchResponse)(nil),                  // 118: gibson.harness.StoreGraphBatchResponse
+	(*TraverseGraphRequest)(nil),                     // 119: gibson.harness.TraverseGraphRequest
+	(*TraverseGraphResponse)(nil),                    // 120: gibson.harness.TraverseGraphResponse
+	(*TraversalOptions)(nil),                         // 121: gibson.harness.TraversalOptions
+	(*GraphRAGShortestPathRequest)(nil),              // 122: gibson.harness.GraphRAGShortestPathRequest
+	(*GraphRAGShortestPathResponse)(nil),             // 123: gibson.harness.GraphRAGShortestPathResponse
+	(*PathOptions)(nil),                              // 124: gibson.harness.PathOptions
+	(*PathEdge)(nil),                                 // 125: gibson.harness.PathEdge
+	(*GraphRAGNeighborsRequest)(nil),                 // 126: gibson.harness.GraphRAGNeighborsRequest
+	(*GraphRAGNeighborsResponse)(nil),                // 127: gibson.harness.GraphRAGNeighborsResponse
+	(*GraphRAGWatchRequest)(nil),                     // 128: gibson.harness.GraphRAGWatchRequest
+	(*GraphRAGEvent)(nil),                            // 129: gibson.harness.GraphRAGEvent
+	(*TraversalResult)(nil),                          // 130: gibson.harness.TraversalResult
+	(*GraphRAGHealthRequest)(nil),                    // 131: gibson.harness.GraphRAGHealthRequest
+	(*GraphRAGHealthResponse)(nil),                   // 132: gibson.harness.GraphRAGHealthResponse
+	(*StoreNodeRequest)(nil),                         // 133: gibson.harness.StoreNodeRequest
+	(*StoreNodeResponse)(nil),                        // 134: gibson.harness.StoreNodeResponse
+	(*QueryNodesRequest)(nil),                        // 135: gibson.harness.QueryNodesRequest
+	(*QueryNodesResponse)(nil),                       // 136: gibson.harness.QueryNodesResponse
+	(*GetPlanContextRequest)(nil),                    // 137: gibson.harness.GetPlanContextRequest
+	(*GetPlanContextResponse)(nil),                   // 138: gibson.harness.GetPlanContextResponse
+	(*PlanContext)(nil),                              // 139: gibson.harness.PlanContext
+	(*ReportStepHintsRequest)(nil),                   // 140: gibson.harness.ReportStepHintsRequest
+	(*ReportStepHintsResponse)(nil),                  // 141: gibson.harness.ReportStepHintsResponse
+	(*StepHints)(nil),                                // 142: gibson.harness.StepHints
+	(*AnyValue)(nil),                                 // 143: gibson.harness.AnyValue
+	(*KeyValue)(nil),                                 // 144: gibson.harness.KeyValue
+	(*SpanEvent)(nil),                                // 145: gibson.harness.SpanEvent
+	(*Span)(nil),                                     // 146: gibson.harness.Span
+	(*RecordSpanRequest)(nil),                        // 147: gibson.harness.RecordSpanRequest
+	(*RecordSpanResponse)(nil),                       // 148: gibson.harness.RecordSpanResponse
+	(*RecordSpansRequest)(nil),                       // 149: gibson.harness.RecordSpansRequest
+	(*RecordSpansResponse)(nil),                      // 150: gibson.harness.RecordSpansResponse
+	(*GetCredentialRequest)(nil),                     // 151: gibson.harness.GetCredentialRequest
+	(*GetCredentialResponse)(nil),                    // 152: gibson.harness.GetCredentialResponse
+	(*Credential)(nil),                               // 153: gibson.harness.Credential
+	(*BasicAuth)(nil),                                // 154: gibson.harness.BasicAuth
+	(*OAuthCredential)(nil),                          // 155: gibson.harness.OAuthCredential
+	(*GetTaxonomySchemaRequest)(nil),                 // 156: gibson.harness.GetTaxonomySchemaRequest
+	(*GetTaxonomySchemaResponse)(nil),                // 157: gibson.harness.GetTaxonomySchemaResponse
+	(*TaxonomyNodeType)(nil),                         // 158: gibson.harness.TaxonomyNodeType
+	(*TaxonomyRelationshipType)(nil),                 // 159: gibson.harness.TaxonomyRelationshipType
+	(*TaxonomyTechnique)(nil),                        // 160: gibson.harness.TaxonomyTechnique
+	(*TaxonomyTargetType)(nil),                       // 161: gibson.harness.TaxonomyTargetType
+	(*TaxonomyTechniqueType)(nil),                    // 162: gibson.harness.TaxonomyTechniqueType
+	(*TaxonomyCapability)(nil),                       // 163: gibson.harness.TaxonomyCapability
+	(*TaxonomyProperty)(nil),                         // 164: gibson.harness.TaxonomyProperty
+	(*GenerateNodeIDRequest)(nil),                    // 165: gibson.harness.GenerateNodeIDRequest
+	(*GenerateNodeIDResponse)(nil),                   // 166: gibson.harness.GenerateNodeIDResponse
+	(*ValidateFindingRequest)(nil),                   // 167: gibson.harness.ValidateFindingRequest
+	(*ValidateGraphNodeRequest)(nil),                 // 168: gibson.harness.ValidateGraphNodeRequest
+	(*ValidateRelationshipRequest)(nil),              // 169: gibson.harness.ValidateRelationshipRequest
+	(*ValidationResponse)(nil),                       // 170: gibson.harness.ValidationResponse
+	(*ValidationError)(nil),                          // 171: gibson.harness.ValidationError
+	nil,                                              // 172: gibson.harness.ReportTokenUsageRequest.SlotsEntry
+	nil,                                              // 173: gibson.harness.JSONSchemaNode.PropertiesEntry
+	nil,                                              // 174: gibson.harness.TaxonomyMapping.IdentifyingPropertiesEntry
+	nil,                                              // 175: gibson.harness.NodeReference.PropertiesEntry
+	nil,                                              // 176: gibson.harness.QueryPluginRequest.ParamsEntry
+	nil,                                              // 177: gibson.harness.MemoryGetResponse.MetadataEntry
+	nil,                                              // 178: gibson.harness.MemorySetRequest.MetadataEntry
+	nil,                                              // 179: gibson.harness.MissionMemorySearchRequest.FilterEntry
+	nil,                                              // 180: gibson.harness.MissionMemoryResult.MetadataEntry
+	nil,                                              // 181: gibson.harness.MissionMemoryHistoryRequest.FilterEntry
+	nil,                                              // 182: gibson.harness.MissionMemoryItem.MetadataEntry
+	nil,                                              // 183: gibson.harness.LongTermMemoryStoreRequest.MetadataEntry
+	nil,                                              // 184: gibson.harness.LongTermMemorySearchRequest.FiltersEntry
+	nil,                                              // 185: gibson.harness.LongTermMemoryResult.MetadataEntry
+	nil,                                              // 186: gibson.harness.GraphRAGStats.NodesByTypeEntry
+	nil,                                              // 187: gibson.harness.GraphRAGStats.RelationshipsByTypeEntry
+	nil,                                              // 188: gibson.harness.GraphNode.PropertiesEntry
+	nil,                                              // 189: gibson.harness.Relationship.PropertiesEntry
+	nil,                                              // 190: gibson.harness.PathEdge.PropertiesEntry
+	nil,                                              // 191: gibson.harness.Credential.MetadataEntry
+	nil,                                              // 192: gibson.harness.GenerateNodeIDRequest.PropertiesEntry
+	nil,                                              // 193: gibson.harness.ValidateGraphNodeRequest.PropertiesEntry
+	nil,                                              // 194: gibson.harness.ValidateRelationshipRequest.PropertiesEntry
+	(ErrorCode)(0),                                   // 195: gibson.common.ErrorCode
+	(*TypedValue)(nil),                               // 196: gibson.common.TypedValue
+	(*Task)(nil),                                     // 197: gibson.types.Task
+	(*Result)(nil),                                   // 198: gibson.types.Result
+	(*Finding)(nil),                                  // 199: gibson.types.Finding
+	(FindingSeverity)(0),                             // 200: gibson.types.FindingSeverity
+	(FindingStatus)(0),                               // 201: gibson.types.FindingStatus
+	(*GraphQuery)(nil),                               // 202: gibson.types.GraphQuery
+	(*PropertyFilter)(nil),                           // 203: gibson.types.PropertyFilter
+	(*graphragpb.GraphNode)(nil),                     // 204: gibson.graphrag.GraphNode
+	(*graphragpb.GraphQuery)(nil),                    // 205: gibson.graphrag.GraphQuery
+	(*graphragpb.QueryResult)(nil),                   // 206: gibson.graphrag.QueryResult
 }
 var file_harness_callback_proto_depIdxs = []int32{
-	168, // 0: gibson.harness.HarnessError.code:type_name -> gibson.common.ErrorCode
-	9,   // 1: gibson.harness.LLMMessage.tool_calls:type_name -> gibson.harness.ToolCall
-	10,  // 2: gibson.harness.LLMMessage.tool_results:type_name -> gibson.harness.ToolResult
-	35,  // 3: gibson.harness.ToolDef.parameters:type_name -> gibson.harness.JSONSchemaNode
-	6,   // 4: gibson.harness.LLMCompleteRequest.context:type_name -> gibson.harness.ContextInfo
-	8,   // 5: gibson.harness.LLMCompleteRequest.messages:type_name -> gibson.harness.LLMMessage
-	6,   // 6: gibson.harness.LLMCompleteWithToolsRequest.context:type_name -> gibson.harness.ContextInfo
-	8,   // 7: gibson.harness.LLMCompleteWithToolsRequest.messages:type_name -> gibson.harness.LLMMessage
-	11,  // 8: gibson.harness.LLMCompleteWithToolsRequest.tools:type_name -> gibson.harness.ToolDef
-	6,   // 9: gibson.harness.LLMCompleteStructuredRequest.context:type_name -> gibson.harness.ContextInfo
-	8,   // 10: gibson.harness.LLMCompleteStructuredRequest.messages:type_name -> gibson.harness.LLMMessage
-	169, // 11: gibson.harness.LLMCompleteStructuredResponse.result:type_name -> gibson.common.TypedValue
-	7,   // 12: gibson.harness.LLMCompleteStructuredResponse.usage:type_name -> gibson.harness.TokenUsage
-	4,   // 13: gibson.harness.LLMCompleteStructuredResponse.error:type_name -> gibson.harness.HarnessError
-	9,   // 14: gibson.harness.LLMCompleteResponse.tool_calls:type_name -> gibson.harness.ToolCall
-	7,   // 15: gibson.harness.LLMCompleteResponse.usage:type_name -> gibson.harness.TokenUsage
-	4,   // 16: gibson.harness.LLMCompleteResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 17: gibson.harness.LLMStreamRequest.context:type_name -> gibson.harness.ContextInfo
-	8,   // 18: gibson.harness.LLMStreamRequest.messages:type_name -> gibson.harness.LLMMessage
-	9,   // 19: gibson.harness.LLMStreamChunk.tool_calls:type_name -> gibson.harness.ToolCall
-	7,   // 20: gibson.harness.LLMStreamChunk.usage:type_name -> gibson.harness.TokenUsage
-	4,   // 21: gibson.harness.LLMStreamChunk.error:type_name -> gibson.harness.HarnessError
-	6,   // 22: gibson.harness.CallToolProtoRequest.context:type_name -> gibson.harness.ContextInfo
-	4,   // 23: gibson.harness.CallToolProtoResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 24: gibson.harness.CallToolProtoStreamRequest.context:type_name -> gibson.harness.ContextInfo
-	23,  // 25: gibson.harness.CallToolProtoStreamResponse.progress:type_name -> gibson.harness.ToolProgressEvent
-	24,  // 26: gibson.harness.CallToolProtoStreamResponse.partial:type_name -> gibson.harness.ToolPartialResultEvent
-	25,  // 27: gibson.harness.CallToolProtoStreamResponse.warning:type_name -> gibson.harness.ToolWarningEvent
-	26,  // 28: gibson.harness.CallToolProtoStreamResponse.complete:type_name -> gibson.harness.ToolCompleteEvent
-	27,  // 29: gibson.harness.CallToolProtoStreamResponse.error:type_name -> gibson.harness.ToolErrorEvent
-	4,   // 30: gibson.harness.ToolErrorEvent.error:type_name -> gibson.harness.HarnessError
-	6,   // 31: gibson.harness.ListToolsRequest.context:type_name -> gibson.harness.ContextInfo
-	30,  // 32: gibson.harness.ListToolsResponse.tools:type_name -> gibson.harness.HarnessToolDescriptor
-	4,   // 33: gibson.harness.ListToolsResponse.error:type_name -> gibson.harness.HarnessError
-	35,  // 34: gibson.harness.HarnessToolDescriptor.input_schema:type_name -> gibson.harness.JSONSchemaNode
-	35,  // 35: gibson.harness.HarnessToolDescriptor.output_schema:type_name -> gibson.harness.JSONSchemaNode
-	6,   // 36: gibson.harness.QueueToolWorkRequest.context:type_name -> gibson.harness.ContextInfo
-	4,   // 37: gibson.harness.QueueToolWorkResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 38: gibson.harness.ToolResultsRequest.context:type_name -> gibson.harness.ContextInfo
-	4,   // 39: gibson.harness.ToolResultResponse.error:type_name -> gibson.harness.HarnessError
-	151, // 40: gibson.harness.JSONSchemaNode.properties:type_name -> gibson.harness.JSONSchemaNode.PropertiesEntry
-	35,  // 41: gibson.harness.JSONSchemaNode.items:type_name -> gibson.harness.JSONSchemaNode
-	36,  // 42: gibson.harness.JSONSchemaNode.taxonomy:type_name -> gibson.harness.TaxonomyMapping
-	152, // 43: gibson.harness.TaxonomyMapping.identifying_properties:type_name -> gibson.harness.TaxonomyMapping.IdentifyingPropertiesEntry
-	37,  // 44: gibson.harness.TaxonomyMapping.properties:type_name -> gibson.harness.PropertyMapping
-	39,  // 45: gibson.harness.TaxonomyMapping.relationships:type_name -> gibson.harness.RelationshipMapping
-	153, // 46: gibson.harness.NodeReference.properties:type_name -> gibson.harness.NodeReference.PropertiesEntry
-	38,  // 47: gibson.harness.RelationshipMapping.from:type_name -> gibson.harness.NodeReference
-	38,  // 48: gibson.harness.RelationshipMapping.to:type_name -> gibson.harness.NodeReference
-	37,  // 49: gibson.harness.RelationshipMapping.rel_properties:type_name -> gibson.harness.PropertyMapping
-	6,   // 50: gibson.harness.QueryPluginRequest.context:type_name -> gibson.harness.ContextInfo
-	154, // 51: gibson.harness.QueryPluginRequest.params:type_name -> gibson.harness.QueryPluginRequest.ParamsEntry
-	169, // 52: gibson.harness.QueryPluginResponse.result:type_name -> gibson.common.TypedValue
-	4,   // 53: gibson.harness.QueryPluginResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 54: gibson.harness.ListPluginsRequest.context:type_name -> gibson.harness.ContextInfo
-	44,  // 55: gibson.harness.ListPluginsResponse.plugins:type_name -> gibson.harness.HarnessPluginDescriptor
-	4,   // 56: gibson.harness.ListPluginsResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 57: gibson.harness.DelegateToAgentRequest.context:type_name -> gibson.harness.ContextInfo
-	170, // 58: gibson.harness.DelegateToAgentRequest.task:type_name -> gibson.types.Task
-	171, // 59: gibson.harness.DelegateToAgentResponse.result:type_name -> gibson.types.Result
-	4,   // 60: gibson.harness.DelegateToAgentResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 61: gibson.harness.ListAgentsRequest.context:type_name -> gibson.harness.ContextInfo
-	49,  // 62: gibson.harness.ListAgentsResponse.agents:type_name -> gibson.harness.HarnessAgentDescriptor
-	4,   // 63: gibson.harness.ListAgentsResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 64: gibson.harness.SubmitFindingRequest.context:type_name -> gibson.harness.ContextInfo
-	172, // 65: gibson.harness.SubmitFindingRequest.finding:type_name -> gibson.types.Finding
-	4,   // 66: gibson.harness.SubmitFindingResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 67: gibson.harness.GetFindingsRequest.context:type_name -> gibson.harness.ContextInfo
-	54,  // 68: gibson.harness.GetFindingsRequest.filter:type_name -> gibson.harness.FindingFilter
-	172, // 69: gibson.harness.GetFindingsResponse.findings:type_name -> gibson.types.Finding
-	4,   // 70: gibson.harness.GetFindingsResponse.error:type_name -> gibson.harness.HarnessError
-	173, // 71: gibson.harness.FindingFilter.severity:type_name -> gibson.types.FindingSeverity
-	174, // 72: gibson.harness.FindingFilter.status:type_name -> gibson.types.FindingStatus
-	6,   // 73: gibson.harness.MemoryGetRequest.context:type_name -> gibson.harness.ContextInfo
-	0,   // 74: gibson.harness.MemoryGetRequest.tier:type_name -> gibson.harness.MemoryTier
-	169, // 75: gibson.harness.MemoryGetResponse.value:type_name -> gibson.common.TypedValue
-	4,   // 76: gibson.harness.MemoryGetResponse.error:type_name -> gibson.harness.HarnessError
-	155, // 77: gibson.harness.MemoryGetResponse.metadata:type_name -> gibson.harness.MemoryGetResponse.MetadataEntry
-	6,   // 78: gibson.harness.MemorySetRequest.context:type_name -> gibson.harness.ContextInfo
-	169, // 79: gibson.harness.MemorySetRequest.value:type_name -> gibson.common.TypedValue
-	0,   // 80: gibson.harness.MemorySetRequest.tier:type_name -> gibson.harness.MemoryTier
-	156, // 81: gibson.harness.MemorySetRequest.metadata:type_name -> gibson.harness.MemorySetRequest.MetadataEntry
-	4,   // 82: gibson.harness.MemorySetResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 83: gibson.harness.MemoryDeleteRequest.context:type_name -> gibson.harness.ContextInfo
-	0,   // 84: gibson.harness.MemoryDeleteRequest.tier:type_name -> gibson.harness.MemoryTier
-	4,   // 85: gibson.harness.MemoryDeleteResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 86: gibson.harness.MemoryListRequest.context:type_name -> gibson.harness.ContextInfo
-	0,   // 87: gibson.harness.MemoryListRequest.tier:type_name -> gibson.harness.MemoryTier
-	4,   // 88: gibson.harness.MemoryListResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 89: gibson.harness.MissionMemorySearchRequest.context:type_name -> gibson.harness.ContextInfo
-	65,  // 90: gibson.harness.MissionMemorySearchResponse.results:type_name -> gibson.harness.MissionMemoryResult
-	4,   // 91: gibson.harness.MissionMemorySearchResponse.error:type_name -> gibson.harness.HarnessError
-	169, // 92: gibson.harness.MissionMemoryResult.value:type_name -> gibson.common.TypedValue
-	157, // 93: gibson.harness.MissionMemoryResult.metadata:type_name -> gibson.harness.MissionMemoryResult.MetadataEntry
-	6,   // 94: gibson.harness.MissionMemoryHistoryRequest.context:type_name -> gibson.harness.ContextInfo
-	68,  // 95: gibson.harness.MissionMemoryHistoryResponse.items:type_name -> gibson.harness.MissionMemoryItem
-	4,   // 96: gibson.harness.MissionMemoryHistoryResponse.error:type_name -> gibson.harness.HarnessError
-	169, // 97: gibson.harness.MissionMemoryItem.value:type_name -> gibson.common.TypedValue
-	158, // 98: gibson.harness.MissionMemoryItem.metadata:type_name -> gibson.harness.MissionMemoryItem.MetadataEntry
-	6,   // 99: gibson.harness.MissionMemoryGetPreviousRunValueRequest.context:type_name -> gibson.harness.ContextInfo
-	169, // 100: gibson.harness.MissionMemoryGetPreviousRunValueResponse.value:type_name -> gibson.common.TypedValue
-	4,   // 101: gibson.harness.MissionMemoryGetPreviousRunValueResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 102: gibson.harness.MissionMemoryGetValueHistoryRequest.context:type_name -> gibson.harness.ContextInfo
-	73,  // 103: gibson.harness.MissionMemoryGetValueHistoryResponse.values:type_name -> gibson.harness.HistoricalValueItem
-	4,   // 104: gibson.harness.MissionMemoryGetValueHistoryResponse.error:type_name -> gibson.harness.HarnessError
-	169, // 105: gibson.harness.HistoricalValueItem.value:type_name -> gibson.common.TypedValue
-	6,   // 106: gibson.harness.MissionMemoryContinuityModeRequest.context:type_name -> gibson.harness.ContextInfo
-	4,   // 107: gibson.harness.MissionMemoryContinuityModeResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 108: gibson.harness.LongTermMemoryStoreRequest.context:type_name -> gibson.harness.ContextInfo
-	159, // 109: gibson.harness.LongTermMemoryStoreRequest.metadata:type_name -> gibson.harness.LongTermMemoryStoreRequest.MetadataEntry
-	4,   // 110: gibson.harness.LongTermMemoryStoreResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 111: gibson.harness.LongTermMemorySearchRequest.context:type_name -> gibson.harness.ContextInfo
-	160, // 112: gibson.harness.LongTermMemorySearchRequest.filters:type_name -> gibson.harness.LongTermMemorySearchRequest.FiltersEntry
-	80,  // 113: gibson.harness.LongTermMemorySearchResponse.results:type_name -> gibson.harness.LongTermMemoryResult
-	4,   // 114: gibson.harness.LongTermMemorySearchResponse.error:type_name -> gibson.harness.HarnessError
-	161, // 115: gibson.harness.LongTermMemoryResult.metadata:type_name -> gibson.harness.LongTermMemoryResult.MetadataEntry
-	6,   // 116: gibson.harness.LongTermMemoryDeleteRequest.context:type_name -> gibson.harness.ContextInfo
-	4,   // 117: gibson.harness.LongTermMemoryDeleteResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 118: gibson.harness.GraphRAGQueryRequest.context:type_name -> gibson.harness.ContextInfo
-	175, // 119: gibson.harness.GraphRAGQueryRequest.query:type_name -> gibson.types.GraphQuery
-	85,  // 120: gibson.harness.GraphRAGQueryResponse.results:type_name -> gibson.harness.GraphRAGResult
-	4,   // 121: gibson.harness.GraphRAGQueryResponse.error:type_name -> gibson.harness.HarnessError
-	86,  // 122: gibson.harness.GraphRAGResult.node:type_name -> gibson.harness.GraphNode
-	162, // 123: gibson.harness.GraphNode.properties:type_name -> gibson.harness.GraphNode.PropertiesEntry
-	6,   // 124: gibson.harness.FindSimilarAttacksRequest.context:type_name -> gibson.harness.ContextInfo
-	89,  // 125: gibson.harness.FindSimilarAttacksResponse.attacks:type_name -> gibson.harness.AttackPattern
-	4,   // 126: gibson.harness.FindSimilarAttacksResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 127: gibson.harness.FindSimilarFindingsRequest.context:type_name -> gibson.harness.ContextInfo
-	92,  // 128: gibson.harness.FindSimilarFindingsResponse.findings:type_name -> gibson.harness.FindingNode
-	4,   // 129: gibson.harness.FindSimilarFindingsResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 130: gibson.harness.GetAttackChainsRequest.context:type_name -> gibson.harness.ContextInfo
-	95,  // 131: gibson.harness.GetAttackChainsResponse.chains:type_name -> gibson.harness.AttackChain
-	4,   // 132: gibson.harness.GetAttackChainsResponse.error:type_name -> gibson.harness.HarnessError
-	96,  // 133: gibson.harness.AttackChain.steps:type_name -> gibson.harness.AttackStep
-	6,   // 134: gibson.harness.GetRelatedFindingsRequest.context:type_name -> gibson.harness.ContextInfo
-	92,  // 135: gibson.harness.GetRelatedFindingsResponse.findings:type_name -> gibson.harness.FindingNode
-	4,   // 136: gibson.harness.GetRelatedFindingsResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 137: gibson.harness.StoreGraphNodeRequest.context:type_name -> gibson.harness.ContextInfo
-	86,  // 138: gibson.harness.StoreGraphNodeRequest.node:type_name -> gibson.harness.GraphNode
-	4,   // 139: gibson.harness.StoreGraphNodeResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 140: gibson.harness.CreateGraphRelationshipRequest.context:type_name -> gibson.harness.ContextInfo
-	103, // 141: gibson.harness.CreateGraphRelationshipRequest.relationship:type_name -> gibson.harness.Relationship
-	4,   // 142: gibson.harness.CreateGraphRelationshipResponse.error:type_name -> gibson.harness.HarnessError
-	163, // 143: gibson.harness.Relationship.properties:type_name -> gibson.harness.Relationship.PropertiesEntry
-	6,   // 144: gibson.harness.StoreGraphBatchRequest.context:type_name -> gibson.harness.ContextInfo
-	86,  // 145: gibson.harness.StoreGraphBatchRequest.nodes:type_name -> gibson.harness.GraphNode
-	103, // 146: gibson.harness.StoreGraphBatchRequest.relationships:type_name -> gibson.harness.Relationship
-	4,   // 147: gibson.harness.StoreGraphBatchResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 148: gibson.harness.TraverseGraphRequest.context:type_name -> gibson.harness.ContextInfo
-	108, // 149: gibson.harness.TraverseGraphRequest.options:type_name -> gibson.harness.TraversalOptions
-	109, // 150: gibson.harness.TraverseGraphResponse.results:type_name -> gibson.harness.TraversalResult
-	4,   // 151: gibson.harness.TraverseGraphResponse.error:type_name -> gibson.harness.HarnessError
-	86,  // 152: gibson.harness.TraversalResult.node:type_name -> gibson.harness.GraphNode
-	6,   // 153: gibson.harness.GraphRAGHealthRequest.context:type_name -> gibson.harness.ContextInfo
-	5,   // 154: gibson.harness.GraphRAGHealthResponse.status:type_name -> gibson.harness.HarnessHealthStatus
-	6,   // 155: gibson.harness.StoreNodeRequest.context:type_name -> gibson.harness.ContextInfo
-	176, // 156: gibson.harness.StoreNodeRequest.node:type_name -> gibson.graphrag.GraphNode
-	4,   // 157: gibson.harness.StoreNodeResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 158: gibson.harness.QueryNodesRequest.context:type_name -> gibson.harness.ContextInfo
-	177, // 159: gibson.harness.QueryNodesRequest.query:type_name -> gibson.graphrag.GraphQuery
-	178, // 160: gibson.harness.QueryNodesResponse.results:type_name -> gibson.graphrag.QueryResult
-	4,   // 161: gibson.harness.QueryNodesResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 162: gibson.harness.GetPlanContextRequest.context:type_name -> gibson.harness.ContextInfo
-	118, // 163: gibson.harness.GetPlanContextResponse.plan_context:type_name -> gibson.harness.PlanContext
-	4,   // 164: gibson.harness.GetPlanContextResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 165: gibson.harness.ReportStepHintsRequest.context:type_name -> gibson.harness.ContextInfo
-	121, // 166: gibson.harness.ReportStepHintsRequest.hints:type_name -> gibson.harness.StepHints
-	4,   // 167: gibson.harness.ReportStepHintsResponse.error:type_name -> gibson.harness.HarnessError
-	122, // 168: gibson.harness.KeyValue.value:type_name -> gibson.harness.AnyValue
-	123, // 169: gibson.harness.SpanEvent.attributes:type_name -> gibson.harness.KeyValue
-	1,   // 170: gibson.harness.Span.kind:type_name -> gibson.harness.SpanKind
-	2,   // 171: gibson.harness.Span.status_code:type_name -> gibson.harness.StatusCode
-	123, // 172: gibson.harness.Span.attributes:type_name -> gibson.harness.KeyValue
-	124, // 173: gibson.harness.Span.events:type_name -> gibson.harness.SpanEvent
-	6,   // 174: gibson.harness.RecordSpanRequest.context:type_name -> gibson.harness.ContextInfo
-	125, // 175: gibson.harness.RecordSpanRequest.span:type_name -> gibson.harness.Span
-	4,   // 176: gibson.harness.RecordSpanResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 177: gibson.harness.RecordSpansRequest.context:type_name -> gibson.harness.ContextInfo
-	125, // 178: gibson.harness.RecordSpansRequest.spans:type_name -> gibson.harness.Span
-	4,   // 179: gibson.harness.RecordSpansResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 180: gibson.harness.GetCredentialRequest.context:type_name -> gibson.harness.ContextInfo
-	132, // 181: gibson.harness.GetCredentialResponse.credential:type_name -> gibson.harness.Credential
-	4,   // 182: gibson.harness.GetCredentialResponse.error:type_name -> gibson.harness.HarnessError
-	3,   // 183: gibson.harness.Credential.type:type_name -> gibson.harness.CredentialType
-	133, // 184: gibson.harness.Credential.basic:type_name -> gibson.harness.BasicAuth
-	134, // 185: gibson.harness.Credential.oauth:type_name -> gibson.harness.OAuthCredential
-	164, // 186: gibson.harness.Credential.metadata:type_name -> gibson.harness.Credential.MetadataEntry
-	6,   // 187: gibson.harness.GetTaxonomySchemaRequest.context:type_name -> gibson.harness.ContextInfo
-	137, // 188: gibson.harness.GetTaxonomySchemaResponse.node_types:type_name -> gibson.harness.TaxonomyNodeType
-	138, // 189: gibson.harness.GetTaxonomySchemaResponse.relationship_types:type_name -> gibson.harness.TaxonomyRelationshipType
-	139, // 190: gibson.harness.GetTaxonomySchemaResponse.techniques:type_name -> gibson.harness.TaxonomyTechnique
-	140, // 191: gibson.harness.GetTaxonomySchemaResponse.target_types:type_name -> gibson.harness.TaxonomyTargetType
-	141, // 192: gibson.harness.GetTaxonomySchemaResponse.technique_types:type_name -> gibson.harness.TaxonomyTechniqueType
-	142, // 193: gibson.harness.GetTaxonomySchemaResponse.capabilities:type_name -> gibson.harness.TaxonomyCapability
-	4,   // 194: gibson.harness.GetTaxonomySchemaResponse.error:type_name -> gibson.harness.HarnessError
-	143, // 195: gibson.harness.TaxonomyNodeType.properties:type_name -> gibson.harness.TaxonomyProperty
-	143, // 196: gibson.harness.TaxonomyRelationshipType.properties:type_name -> gibson.harness.TaxonomyProperty
-	6,   // 197: gibson.harness.GenerateNodeIDRequest.context:type_name -> gibson.harness.ContextInfo
-	165, // 198: gibson.harness.GenerateNodeIDRequest.properties:type_name -> gibson.harness.GenerateNodeIDRequest.PropertiesEntry
-	4,   // 199: gibson.harness.GenerateNodeIDResponse.error:type_name -> gibson.harness.HarnessError
-	6,   // 200: gibson.harness.ValidateFindingRequest.context:type_name -> gibson.harness.ContextInfo
-	172, // 201: gibson.harness.ValidateFindingRequest.finding:type_name -> gibson.types.Finding
-	6,   // 202: gibson.harness.ValidateGraphNodeRequest.context:type_name -> gibson.harness.ContextInfo
-	166, // 203: gibson.harness.ValidateGraphNodeRequest.properties:type_name -> gibson.harness.ValidateGraphNodeRequest.PropertiesEntry
-	6,   // 204: gibson.harness.ValidateRelationshipRequest.context:type_name -> gibson.harness.ContextInfo
-	167, // 205: gibson.harness.ValidateRelationshipRequest.properties:type_name -> gibson.harness.ValidateRelationshipRequest.PropertiesEntry
-	150, // 206: gibson.harness.ValidationResponse.errors:type_name -> gibson.harness.ValidationError
-	4,   // 207: gibson.harness.ValidationResponse.error:type_name -> gibson.harness.HarnessError
-	35,  // 208: gibson.harness.JSONSchemaNode.PropertiesEntry.value:type_name -> gibson.harness.JSONSchemaNode
-	169, // 209: gibson.harness.QueryPluginRequest.ParamsEntry.value:type_name -> gibson.common.TypedValue
-	169, // 210: gibson.harness.MemoryGetResponse.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	169, // 211: gibson.harness.MemorySetRequest.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	169, // 212: gibson.harness.MissionMemoryResult.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	169, // 213: gibson.harness.MissionMemoryItem.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	169, // 214: gibson.harness.LongTermMemoryStoreRequest.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	169, // 215: gibson.harness.LongTermMemorySearchRequest.FiltersEntry.value:type_name -> gibson.common.TypedValue
-	169, // 216: gibson.harness.LongTermMemoryResult.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	169, // 217: gibson.harness.GraphNode.PropertiesEntry.value:type_name -> gibson.common.TypedValue
-	169, // 218: gibson.harness.Relationship.PropertiesEntry.value:type_name -> gibson.common.TypedValue
-	169, // 219: gibson.harness.Credential.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	169, // 220: gibson.harness.GenerateNodeIDRequest.PropertiesEntry.value:type_name -> gibson.common.TypedValue
-	169, // 221: gibson.harness.ValidateGraphNodeRequest.PropertiesEntry.value:type_name -> gibson.common.TypedValue
-	169, // 222: gibson.harness.ValidateRelationshipRequest.PropertiesEntry.value:type_name -> gibson.common.TypedValue
-	12,  // 223: gibson.harness.HarnessCallbackService.LLMComplete:input_type -> gibson.harness.LLMCompleteRequest
-	13,  // 224: gibson.harness.HarnessCallbackService.LLMCompleteWithTools:input_type -> gibson.harness.LLMCompleteWithToolsRequest
-	14,  // 225: gibson.harness.HarnessCallbackService.LLMCompleteStructured:input_type -> gibson.harness.LLMCompleteStructuredRequest
-	17,  // 226: gibson.harness.HarnessCallbackService.LLMStream:input_type -> gibson.harness.LLMStreamRequest
-	19,  // 227: gibson.harness.HarnessCallbackService.CallToolProto:input_type -> gibson.harness.CallToolProtoRequest
-	21,  // 228: gibson.harness.HarnessCallbackService.CallToolProtoStream:input_type -> gibson.harness.CallToolProtoStreamRequest
-	28,  // 229: gibson.harness.HarnessCallbackService.ListTools:input_type -> gibson.harness.ListToolsRequest
-	31,  // 230: gibson.harness.HarnessCallbackService.QueueToolWork:input_type -> gibson.harness.QueueToolWorkRequest
-	33,  // 231: gibson.harness.HarnessCallbackService.ToolResults:input_type -> gibson.harness.ToolResultsRequest
-	40,  // 232: gibson.harness.HarnessCallbackService.QueryPlugin:input_type -> gibson.harness.QueryPluginRequest
-	42,  // 233: gibson.harness.HarnessCallbackService.ListPlugins:input_type -> gibson.harness.ListPluginsRequest
-	45,  // 234: gibson.harness.HarnessCallbackService.DelegateToAgent:input_type -> gibson.harness.DelegateToAgentRequest
-	47,  // 235: gibson.harness.HarnessCallbackService.ListAgents:input_type -> gibson.harness.ListAgentsRequest
-	50,  // 236: gibson.harness.HarnessCallbackService.SubmitFinding:input_type -> gibson.harness.SubmitFindingRequest
-	52,  // 237: gibson.harness.HarnessCallbackService.GetFindings:input_type -> gibson.harness.GetFindingsRequest
-	55,  // 238: gibson.harness.HarnessCallbackService.MemoryGet:input_type -> gibson.harness.MemoryGetRequest
-	57,  // 239: gibson.harness.HarnessCallbackService.MemorySet:input_type -> gibson.harness.MemorySetRequest
-	59,  // 240: gibson.harness.HarnessCallbackService.MemoryDelete:input_type -> gibson.harness.MemoryDeleteRequest
-	61,  // 241: gibson.harness.HarnessCallbackService.MemoryList:input_type -> gibson.harness.MemoryListRequest
-	63,  // 242: gibson.harness.HarnessCallbackService.MissionMemorySearch:input_type -> gibson.harness.MissionMemorySearchRequest
-	66,  // 243: gibson.harness.HarnessCallbackService.MissionMemoryHistory:input_type -> gibson.harness.MissionMemoryHistoryRequest
-	69,  // 244: gibson.harness.HarnessCallbackService.MissionMemoryGetPreviousRunValue:input_type -> gibson.harness.MissionMemoryGetPreviousRunValueRequest
-	71,  // 245: gibson.harness.HarnessCallbackService.MissionMemoryGetValueHistory:input_type -> gibson.harness.MissionMemoryGetValueHistoryRequest
-	74,  // 246: gibson.harness.HarnessCallbackService.MissionMemoryContinuityMode:input_type -> gibson.harness.MissionMemoryContinuityModeRequest
-	76,  // 247: gibson.harness.HarnessCallbackService.LongTermMemoryStore:input_type -> gibson.harness.LongTermMemoryStoreRequest
-	78,  // 248: gibson.harness.HarnessCallbackService.LongTermMemorySearch:input_type -> gibson.harness.LongTermMemorySearchRequest
-	81,  // 249: gibson.harness.HarnessCallbackService.LongTermMemoryDelete:input_type -> gibson.harness.LongTermMemoryDeleteRequest
-	83,  // 250: gibson.harness.HarnessCallbackService.GraphRAGQuery:input_type -> gibson.harness.GraphRAGQueryRequest
-	87,  // 251: gibson.harness.HarnessCallbackService.FindSimilarAttacks:input_type -> gibson.harness.FindSimilarAttacksRequest
-	90,  // 252: gibson.harness.HarnessCallbackService.FindSimilarFindings:input_type -> gibson.harness.FindSimilarFindingsRequest
-	93,  // 253: gibson.harness.HarnessCallbackService.GetAttackChains:input_type -> gibson.harness.GetAttackChainsRequest
-	97,  // 254: gibson.harness.HarnessCallbackService.GetRelatedFindings:input_type -> gibson.harness.GetRelatedFindingsRequest
-	99,  // 255: gibson.harness.HarnessCallbackService.StoreGraphNode:input_type -> gibson.harness.StoreGraphNodeRequest
-	101, // 256: gibson.harness.HarnessCallbackService.CreateGraphRelationship:input_type -> gibson.harness.CreateGraphRelationshipRequest
-	104, // 257: gibson.harness.HarnessCallbackService.StoreGraphBatch:input_type -> gibson.harness.StoreGraphBatchRequest
-	106, // 258: gibson.harness.HarnessCallbackService.TraverseGraph:input_type -> gibson.harness.TraverseGraphRequest
-	110, // 259: gibson.harness.HarnessCallbackService.GraphRAGHealth:input_type -> gibson.harness.GraphRAGHealthRequest
-	112, // 260: gibson.harness.HarnessCallbackService.StoreNode:input_type -> gibson.harness.StoreNodeRequest
-	114, // 261: gibson.harness.HarnessCallbackService.QueryNodes:input_type -> gibson.harness.QueryNodesRequest
-	116, // 262: gibson.harness.HarnessCallbackService.GetPlanContext:input_type -> gibson.harness.GetPlanContextRequest
-	119, // 263: gibson.harness.HarnessCallbackService.ReportStepHints:input_type -> gibson.harness.ReportStepHintsRequest
-	126, // 264: gibson.harness.HarnessCallbackService.RecordSpan:input_type -> gibson.harness.RecordSpanRequest
-	128, // 265: gibson.harness.HarnessCallbackService.RecordSpans:input_type -> gibson.harness.RecordSpansRequest
-	130, // 266: gibson.harness.HarnessCallbackService.GetCredential:input_type -> gibson.harness.GetCredentialRequest
-	135, // 267: gibson.harness.HarnessCallbackService.GetTaxonomySchema:input_type -> gibson.harness.GetTaxonomySchemaRequest
-	144, // 268: gibson.harness.HarnessCallbackService.GenerateNodeID:input_type -> gibson.harness.GenerateNodeIDRequest
-	146, // 269: gibson.harness.HarnessCallbackService.ValidateFinding:input_type -> gibson.harness.ValidateFindingRequest
-	147, // 270: gibson.harness.HarnessCallbackService.ValidateGraphNode:input_type -> gibson.harness.ValidateGraphNodeRequest
-	148, // 271: gibson.harness.HarnessCallbackService.ValidateRelationship:input_type -> gibson.harness.ValidateRelationshipRequest
-	16,  // 272: gibson.harness.HarnessCallbackService.LLMComplete:output_type -> gibson.harness.LLMCompleteResponse
-	16,  // 273: gibson.harness.HarnessCallbackService.LLMCompleteWithTools:output_type -> gibson.harness.LLMCompleteResponse
-	15,  // 274: gibson.harness.HarnessCallbackService.LLMCompleteStructured:output_type -> gibson.harness.LLMCompleteStructuredResponse
-	18,  // 275: gibson.harness.HarnessCallbackService.LLMStream:output_type -> gibson.harness.LLMStreamChunk
-	20,  // 276: gibson.harness.HarnessCallbackService.CallToolProto:output_type -> gibson.harness.CallToolProtoResponse
-	22,  // 277: gibson.harness.HarnessCallbackService.CallToolProtoStream:output_type -> gibson.harness.CallToolProtoStreamResponse
-	29,  // 278: gibson.harness.HarnessCallbackService.ListTools:output_type -> gibson.harness.ListToolsResponse
-	32,  // 279: gibson.harness.HarnessCallbackService.QueueToolWork:output_type -> gibson.harness.QueueToolWorkResponse
-	34,  // 280: gibson.harness.HarnessCallbackService.ToolResults:output_type -> gibson.harness.ToolResultResponse
-	41,  // 281: gibson.harness.HarnessCallbackService.QueryPlugin:output_type -> gibson.harness.QueryPluginResponse
-	43,  // 282: gibson.harness.HarnessCallbackService.ListPlugins:output_type -> gibson.harness.ListPluginsResponse
-	46,  // 283: gibson.harness.HarnessCallbackService.DelegateToAgent:output_type -> gibson.harness.DelegateToAgentResponse
-	48,  // 284: gibson.harness.HarnessCallbackService.ListAgents:output_type -> gibson.harness.ListAgentsResponse
-	51,  // 285: gibson.harness.HarnessCallbackService.SubmitFinding:output_type -> gibson.harness.SubmitFindingResponse
-	53,  // 286: gibson.harness.HarnessCallbackService.GetFindings:output_type -> gibson.harness.GetFindingsResponse
-	56,  // 287: gibson.harness.HarnessCallbackService.MemoryGet:output_type -> gibson.harness.MemoryGetResponse
-	58,  // 288: gibson.harness.HarnessCallbackService.MemorySet:output_type -> gibson.harness.MemorySetResponse
-	60,  // 289: gibson.harness.HarnessCallbackService.MemoryDelete:output_type -> gibson.harness.MemoryDeleteResponse
-	62,  // 290: gibson.harness.HarnessCallbackService.MemoryList:output_type -> gibson.harness.MemoryListResponse
-	64,  // 291: gibson.harness.HarnessCallbackService.MissionMemorySearch:output_type -> gibson.harness.MissionMemorySearchResponse
-	67,  // 292: gibson.harness.HarnessCallbackService.MissionMemoryHistory:output_type -> gibson.harness.MissionMemoryHistoryResponse
-	70,  // 293: gibson.harness.HarnessCallbackService.MissionMemoryGetPreviousRunValue:output_type -> gibson.harness.MissionMemoryGetPreviousRunValueResponse
-	72,  // 294: gibson.harness.HarnessCallbackService.MissionMemoryGetValueHistory:output_type -> gibson.harness.MissionMemoryGetValueHistoryResponse
-	75,  // 295: gibson.harness.HarnessCallbackService.MissionMemoryContinuityMode:output_type -> gibson.harness.MissionMemoryContinuityModeResponse
-	77,  // 296: gibson.harness.HarnessCallbackService.LongTermMemoryStore:output_type -> gibson.harness.LongTermMemoryStoreResponse
-	79,  // 297: gibson.harness.HarnessCallbackService.LongTermMemorySearch:output_type -> gibson.harness.LongTermMemorySearchResponse
-	82,  // 298: gibson.harness.HarnessCallbackService.LongTermMemoryDelete:output_type -> gibson.harness.LongTermMemoryDeleteResponse
-	84,  // 299: gibson.harness.HarnessCallbackService.GraphRAGQuery:output_type -> gibson.harness.GraphRAGQueryResponse
-	88,  // 300: gibson.harness.HarnessCallbackService.FindSimilarAttacks:output_type -> gibson.harness.FindSimilarAttacksResponse
-	91,  // 301: gibson.harness.HarnessCallbackService.FindSimilarFindings:output_type -> gibson.harness.FindSimilarFindingsResponse
-	94,  // 302: gibson.harness.HarnessCallbackService.GetAttackChains:output_type -> gibson.harness.GetAttackChainsResponse
-	98,  // 303: gibson.harness.HarnessCallbackService.GetRelatedFindings:output_type -> gibson.harness.GetRelatedFindingsResponse
-	100, // 304: gibson.harness.HarnessCallbackService.StoreGraphNode:output_type -> gibson.harness.StoreGraphNodeResponse
-	102, // 305: gibson.harness.HarnessCallbackService.CreateGraphRelationship:output_type -> gibson.harness.CreateGraphRelationshipResponse
-	105, // 306: gibson.harness.HarnessCallbackService.StoreGraphBatch:output_type -> gibson.harness.StoreGraphBatchResponse
-	107, // 307: gibson.harness.HarnessCallbackService.TraverseGraph:output_type -> gibson.harness.TraverseGraphResponse
-	111, // 308: gibson.harness.HarnessCallbackService.GraphRAGHealth:output_type -> gibson.harness.GraphRAGHealthResponse
-	113, // 309: gibson.harness.HarnessCallbackService.StoreNode:output_type -> gibson.harness.StoreNodeResponse
-	115, // 310: gibson.harness.HarnessCallbackService.QueryNodes:output_type -> gibson.harness.QueryNodesResponse
-	117, // 311: gibson.harness.HarnessCallbackService.GetPlanContext:output_type -> gibson.harness.GetPlanContextResponse
-	120, // 312: gibson.harness.HarnessCallbackService.ReportStepHints:output_type -> gibson.harness.ReportStepHintsResponse
-	127, // 313: gibson.harness.HarnessCallbackService.RecordSpan:output_type -> gibson.harness.RecordSpanResponse
-	129, // 314: gibson.harness.HarnessCallbackService.RecordSpans:output_type -> gibson.harness.RecordSpansResponse
-	131, // 315: gibson.harness.HarnessCallbackService.GetCredential:output_type -> gibson.harness.GetCredentialResponse
-	136, // 316: gibson.harness.HarnessCallbackService.GetTaxonomySchema:output_type -> gibson.harness.GetTaxonomySchemaResponse
-	145, // 317: gibson.harness.HarnessCallbackService.GenerateNodeID:output_type -> gibson.harness.GenerateNodeIDResponse
-	149, // 318: gibson.harness.HarnessCallbackService.ValidateFinding:output_type -> gibson.harness.ValidationResponse
-	149, // 319: gibson.harness.HarnessCallbackService.ValidateGraphNode:output_type -> gibson.harness.ValidationResponse
-	149, // 320: gibson.harness.HarnessCallbackService.ValidateRelationship:output_type -> gibson.harness.ValidationResponse
-	272, // [272:321] is the sub-list for method output_type
-	223, // [223:272] is the sub-list for method input_type
-	223, // [223:223] is the sub-list for extension type_name
-	223, // [223:223] is the sub-list for extension extendee
-	0,   // [0:223] is the sub-list for field type_name
+	195, // 0: gibson.harness.HarnessError.code:type_name -> gibson.common.ErrorCode
+	6,   // 1: gibson.harness.ReportTokenUsageRequest.context:type_name -> gibson.harness.ContextInfo
+	172, // 2: gibson.harness.ReportTokenUsageRequest.slots:type_name -> gibson.harness.ReportTokenUsageRequest.SlotsEntry
+	7,   // 3: gibson.harness.ReportTokenUsageRequest.total:type_name -> gibson.harness.TokenUsage
+	4,   // 4: gibson.harness.ReportTokenUsageResponse.error:type_name -> gibson.harness.HarnessError
+	11,  // 5: gibson.harness.LLMMessage.tool_calls:type_name -> gibson.harness.ToolCall
+	12,  // 6: gibson.harness.LLMMessage.tool_results:type_name -> gibson.harness.ToolResult
+	39,  // 7: gibson.harness.ToolDef.parameters:type_name -> gibson.harness.JSONSchemaNode
+	6,   // 8: gibson.harness.LLMCompleteRequest.context:type_name -> gibson.harness.ContextInfo
+	10,  // 9: gibson.harness.LLMCompleteRequest.messages:type_name -> gibson.harness.LLMMessage
+	6,   // 10: gibson.harness.LLMCompleteWithToolsRequest.context:type_name -> gibson.harness.ContextInfo
+	10,  // 11: gibson.harness.LLMCompleteWithToolsRequest.messages:type_name -> gibson.harness.LLMMessage
+	13,  // 12: gibson.harness.LLMCompleteWithToolsRequest.tools:type_name -> gibson.harness.ToolDef
+	6,   // 13: gibson.harness.LLMCompleteStructuredRequest.context:type_name -> gibson.harness.ContextInfo
+	10,  // 14: gibson.harness.LLMCompleteStructuredRequest.messages:type_name -> gibson.harness.LLMMessage
+	196, // 15: gibson.harness.LLMCompleteStructuredResponse.result:type_name -> gibson.common.TypedValue
+	7,   // 16: gibson.harness.LLMCompleteStructuredResponse.usage:type_name -> gibson.harness.TokenUsage
+	4,   // 17: gibson.harness.LLMCompleteStructuredResponse.error:type_name -> gibson.harness.HarnessError
+	11,  // 18: gibson.harness.LLMCompleteResponse.tool_calls:type_name -> gibson.harness.ToolCall
+	7,   // 19: gibson.harness.LLMCompleteResponse.usage:type_name -> gibson.harness.TokenUsage
+	4,   // 20: gibson.harness.LLMCompleteResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 21: gibson.harness.LLMStreamRequest.context:type_name -> gibson.harness.ContextInfo
+	10,  // 22: gibson.harness.LLMStreamRequest.messages:type_name -> gibson.harness.LLMMessage
+	11,  // 23: gibson.harness.LLMStreamChunk.tool_calls:type_name -> gibson.harness.ToolCall
+	7,   // 24: gibson.harness.LLMStreamChunk.usage:type_name -> gibson.harness.TokenUsage
+	4,   // 25: gibson.harness.LLMStreamChunk.error:type_name -> gibson.harness.HarnessError
+	6,   // 26: gibson.harness.CallToolProtoRequest.context:type_name -> gibson.harness.ContextInfo
+	4,   // 27: gibson.harness.CallToolProtoResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 28: gibson.harness.CallToolProtoStreamRequest.context:type_name -> gibson.harness.ContextInfo
+	25,  // 29: gibson.harness.CallToolProtoStreamResponse.progress:type_name -> gibson.harness.ToolProgressEvent
+	26,  // 30: gibson.harness.CallToolProtoStreamResponse.partial:type_name -> gibson.harness.ToolPartialResultEvent
+	27,  // 31: gibson.harness.CallToolProtoStreamResponse.warning:type_name -> gibson.harness.ToolWarningEvent
+	28,  // 32: gibson.harness.CallToolProtoStreamResponse.complete:type_name -> gibson.harness.ToolCompleteEvent
+	29,  // 33: gibson.harness.CallToolProtoStreamResponse.error:type_name -> gibson.harness.ToolErrorEvent
+	4,   // 34: gibson.harness.ToolErrorEvent.error:type_name -> gibson.harness.HarnessError
+	6,   // 35: gibson.harness.ListToolsRequest.context:type_name -> gibson.harness.ContextInfo
+	32,  // 36: gibson.harness.ListToolsResponse.tools:type_name -> gibson.harness.HarnessToolDescriptor
+	4,   // 37: gibson.harness.ListToolsResponse.error:type_name -> gibson.harness.HarnessError
+	39,  // 38: gibson.harness.HarnessToolDescriptor.input_schema:type_name -> gibson.harness.JSONSchemaNode
+	39,  // 39: gibson.harness.HarnessToolDescriptor.output_schema:type_name -> gibson.harness.JSONSchemaNode
+	6,   // 40: gibson.harness.GetToolProtoDescriptorsRequest.context:type_name -> gibson.harness.ContextInfo
+	4,   // 41: gibson.harness.GetToolProtoDescriptorsResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 42: gibson.harness.QueueToolWorkRequest.context:type_name -> gibson.harness.ContextInfo
+	4,   // 43: gibson.harness.QueueToolWorkResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 44: gibson.harness.ToolResultsRequest.context:type_name -> gibson.harness.ContextInfo
+	4,   // 45: gibson.harness.ToolResultResponse.error:type_name -> gibson.harness.HarnessError
+	173, // 46: gibson.harness.JSONSchemaNode.properties:type_name -> gibson.harness.JSONSchemaNode.PropertiesEntry
+	39,  // 47: gibson.harness.JSONSchemaNode.items:type_name -> gibson.harness.JSONSchemaNode
+	40,  // 48: gibson.harness.JSONSchemaNode.taxonomy:type_name -> gibson.harness.TaxonomyMapping
+	174, // 49: gibson.harness.TaxonomyMapping.identifying_properties:type_name -> gibson.harness.TaxonomyMapping.IdentifyingPropertiesEntry
+	41,  // 50: gibson.harness.TaxonomyMapping.properties:type_name -> gibson.harness.PropertyMapping
+	43,  // 51: gibson.harness.TaxonomyMapping.relationships:type_name -> gibson.harness.RelationshipMapping
+	175, // 52: gibson.harness.NodeReference.properties:type_name -> gibson.harness.NodeReference.PropertiesEntry
+	42,  // 53: gibson.harness.RelationshipMapping.from:type_name -> gibson.harness.NodeReference
+	42,  // 54: gibson.harness.RelationshipMapping.to:type_name -> gibson.harness.NodeReference
+	41,  // 55: gibson.harness.RelationshipMapping.rel_properties:type_name -> gibson.harness.PropertyMapping
+	6,   // 56: gibson.harness.QueryPluginRequest.context:type_name -> gibson.harness.ContextInfo
+	176, // 57: gibson.harness.QueryPluginRequest.params:type_name -> gibson.harness.QueryPluginRequest.ParamsEntry
+	196, // 58: gibson.harness.QueryPluginResponse.result:type_name -> gibson.common.TypedValue
+	4,   // 59: gibson.harness.QueryPluginResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 60: gibson.harness.ListPluginsRequest.context:type_name -> gibson.harness.ContextInfo
+	48,  // 61: gibson.harness.ListPluginsResponse.plugins:type_name -> gibson.harness.HarnessPluginDescriptor
+	4,   // 62: gibson.harness.ListPluginsResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 63: gibson.harness.DelegateToAgentRequest.context:type_name -> gibson.harness.ContextInfo
+	197, // 64: gibson.harness.DelegateToAgentRequest.task:type_name -> gibson.types.Task
+	198, // 65: gibson.harness.DelegateToAgentResponse.result:type_name -> gibson.types.Result
+	4,   // 66: gibson.harness.DelegateToAgentResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 67: gibson.harness.ListAgentsRequest.context:type_name -> gibson.harness.ContextInfo
+	53,  // 68: gibson.harness.ListAgentsResponse.agents:type_name -> gibson.harness.HarnessAgentDescriptor
+	4,   // 69: gibson.harness.ListAgentsResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 70: gibson.harness.SubmitFindingRequest.context:type_name -> gibson.harness.ContextInfo
+	199, // 71: gibson.harness.SubmitFindingRequest.finding:type_name -> gibson.types.Finding
+	4,   // 72: gibson.harness.SubmitFindingResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 73: gibson.harness.GetFindingsRequest.context:type_name -> gibson.harness.ContextInfo
+	58,  // 74: gibson.harness.GetFindingsRequest.filter:type_name -> gibson.harness.FindingFilter
+	199, // 75: gibson.harness.GetFindingsResponse.findings:type_name -> gibson.types.Finding
+	4,   // 76: gibson.harness.GetFindingsResponse.error:type_name -> gibson.harness.HarnessError
+	200, // 77: gibson.harness.FindingFilter.severity:type_name -> gibson.types.FindingSeverity
+	201, // 78: gibson.harness.FindingFilter.status:type_name -> gibson.types.FindingStatus
+	6,   // 79: gibson.harness.MemoryGetRequest.context:type_name -> gibson.harness.ContextInfo
+	0,   // 80: gibson.harness.MemoryGetRequest.tier:type_name -> gibson.harness.MemoryTier
+	196, // 81: gibson.harness.MemoryGetResponse.value:type_name -> gibson.common.TypedValue
+	4,   // 82: gibson.harness.MemoryGetResponse.error:type_name -> gibson.harness.HarnessError
+	177, // 83: gibson.harness.MemoryGetResponse.metadata:type_name -> gibson.harness.MemoryGetResponse.MetadataEntry
+	6,   // 84: gibson.harness.MemorySetRequest.context:type_name -> gibson.harness.ContextInfo
+	196, // 85: gibson.harness.MemorySetRequest.value:type_name -> gibson.common.TypedValue
+	0,   // 86: gibson.harness.MemorySetRequest.tier:type_name -> gibson.harness.MemoryTier
+	178, // 87: gibson.harness.MemorySetRequest.metadata:type_name -> gibson.harness.MemorySetRequest.MetadataEntry
+	4,   // 88: gibson.harness.MemorySetResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 89: gibson.harness.MemoryDeleteRequest.context:type_name -> gibson.harness.ContextInfo
+	0,   // 90: gibson.harness.MemoryDeleteRequest.tier:type_name -> gibson.harness.MemoryTier
+	4,   // 91: gibson.harness.MemoryDeleteResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 92: gibson.harness.MemoryListRequest.context:type_name -> gibson.harness.ContextInfo
+	0,   // 93: gibson.harness.MemoryListRequest.tier:type_name -> gibson.harness.MemoryTier
+	4,   // 94: gibson.harness.MemoryListResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 95: gibson.harness.MissionMemorySearchRequest.context:type_name -> gibson.harness.ContextInfo
+	179, // 96: gibson.harness.MissionMemorySearchRequest.filter:type_name -> gibson.harness.MissionMemorySearchRequest.FilterEntry
+	69,  // 97: gibson.harness.MissionMemorySearchResponse.results:type_name -> gibson.harness.MissionMemoryResult
+	4,   // 98: gibson.harness.MissionMemorySearchResponse.error:type_name -> gibson.harness.HarnessError
+	196, // 99: gibson.harness.MissionMemoryResult.value:type_name -> gibson.common.TypedValue
+	180, // 100: gibson.harness.MissionMemoryResult.metadata:type_name -> gibson.harness.MissionMemoryResult.MetadataEntry
+	6,   // 101: gibson.harness.MissionMemoryHistoryRequest.context:type_name -> gibson.harness.ContextInfo
+	181, // 102: gibson.harness.MissionMemoryHistoryRequest.filter:type_name -> gibson.harness.MissionMemoryHistoryRequest.FilterEntry
+	72,  // 103: gibson.harness.MissionMemoryHistoryResponse.items:type_name -> gibson.harness.MissionMemoryItem
+	4,   // 104: gibson.harness.MissionMemoryHistoryResponse.error:type_name -> gibson.harness.HarnessError
+	196, // 105: gibson.harness.MissionMemoryItem.value:type_name -> gibson.common.TypedValue
+	182, // 106: gibson.harness.MissionMemoryItem.metadata:type_name -> gibson.harness.MissionMemoryItem.MetadataEntry
+	6,   // 107: gibson.harness.MissionMemoryGetPreviousRunValueRequest.context:type_name -> gibson.harness.ContextInfo
+	196, // 108: gibson.harness.MissionMemoryGetPreviousRunValueResponse.value:type_name -> gibson.common.TypedValue
+	4,   // 109: gibson.harness.MissionMemoryGetPreviousRunValueResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 110: gibson.harness.MissionMemoryGetValueHistoryRequest.context:type_name -> gibson.harness.ContextInfo
+	77,  // 111: gibson.harness.MissionMemoryGetValueHistoryResponse.values:type_name -> gibson.harness.HistoricalValueItem
+	4,   // 112: gibson.harness.MissionMemoryGetValueHistoryResponse.error:type_name -> gibson.harness.HarnessError
+	196, // 113: gibson.harness.HistoricalValueItem.value:type_name -> gibson.common.TypedValue
+	6,   // 114: gibson.harness.MissionMemoryContinuityModeRequest.context:type_name -> gibson.harness.ContextInfo
+	4,   // 115: gibson.harness.MissionMemoryContinuityModeResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 116: gibson.harness.LongTermMemoryStoreRequest.context:type_name -> gibson.harness.ContextInfo
+	183, // 117: gibson.harness.LongTermMemoryStoreRequest.metadata:type_name -> gibson.harness.LongTermMemoryStoreRequest.MetadataEntry
+	4,   // 118: gibson.harness.LongTermMemoryStoreResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 119: gibson.harness.LongTermMemorySearchRequest.context:type_name -> gibson.harness.ContextInfo
+	184, // 120: gibson.harness.LongTermMemorySearchRequest.filters:type_name -> gibson.harness.LongTermMemorySearchRequest.FiltersEntry
+	84,  // 121: gibson.harness.LongTermMemorySearchResponse.results:type_name -> gibson.harness.LongTermMemoryResult
+	4,   // 122: gibson.harness.LongTermMemorySearchResponse.error:type_name -> gibson.harness.HarnessError
+	185, // 123: gibson.harness.LongTermMemoryResult.metadata:type_name -> gibson.harness.LongTermMemoryResult.MetadataEntry
+	6,   // 124: gibson.harness.LongTermMemoryDeleteRequest.context:type_name -> gibson.harness.ContextInfo
+	4,   // 125: gibson.harness.LongTermMemoryDeleteResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 126: gibson.harness.GraphRAGQueryRequest.context:type_name -> gibson.harness.ContextInfo
+	202, // 127: gibson.harness.GraphRAGQueryRequest.query:type_name -> gibson.types.GraphQuery
+	98,  // 128: gibson.harness.GraphRAGQueryResponse.results:type_name -> gibson.harness.GraphRAGResult
+	4,   // 129: gibson.harness.GraphRAGQueryResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 130: gibson.harness.GraphRAGQueryBatchRequest.context:type_name -> gibson.harness.ContextInfo
+	202, // 131: gibson.harness.GraphRAGQueryBatchRequest.queries:type_name -> gibson.types.GraphQuery
+	91,  // 132: gibson.harness.GraphRAGQueryBatchResponse.items:type_name -> gibson.harness.GraphRAGQueryBatchItem
+	4,   // 133: gibson.harness.GraphRAGQueryBatchResponse.error:type_name -> gibson.harness.HarnessError
+	98,  // 134: gibson.harness.GraphRAGQueryBatchItem.results:type_name -> gibson.harness.GraphRAGResult
+	4,   // 135: gibson.harness.GraphRAGQueryBatchItem.error:type_name -> gibson.harness.HarnessError
+	6,   // 136: gibson.harness.GraphRAGExplainRequest.context:type_name -> gibson.harness.ContextInfo
+	202, // 137: gibson.harness.GraphRAGExplainRequest.query:type_name -> gibson.types.GraphQuery
+	94,  // 138: gibson.harness.GraphRAGExplainResponse.plan:type_name -> gibson.harness.GraphRAGQueryPlan
+	4,   // 139: gibson.harness.GraphRAGExplainResponse.error:type_name -> gibson.harness.HarnessError
+	203, // 140: gibson.harness.GraphRAGQueryPlan.property_filters:type_name -> gibson.types.PropertyFilter
+	6,   // 141: gibson.harness.GraphRAGStatsRequest.context:type_name -> gibson.harness.ContextInfo
+	97,  // 142: gibson.harness.GraphRAGStatsResponse.stats:type_name -> gibson.harness.GraphRAGStats
+	4,   // 143: gibson.harness.GraphRAGStatsResponse.error:type_name -> gibson.harness.HarnessError
+	186, // 144: gibson.harness.GraphRAGStats.nodes_by_type:type_name -> gibson.harness.GraphRAGStats.NodesByTypeEntry
+	187, // 145: gibson.harness.GraphRAGStats.relationships_by_type:type_name -> gibson.harness.GraphRAGStats.RelationshipsByTypeEntry
+	99,  // 146: gibson.harness.GraphRAGResult.node:type_name -> gibson.harness.GraphNode
+	188, // 147: gibson.harness.GraphNode.properties:type_name -> gibson.harness.GraphNode.PropertiesEntry
+	6,   // 148: gibson.harness.FindSimilarAttacksRequest.context:type_name -> gibson.harness.ContextInfo
+	102, // 149: gibson.harness.FindSimilarAttacksResponse.attacks:type_name -> gibson.harness.AttackPattern
+	4,   // 150: gibson.harness.FindSimilarAttacksResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 151: gibson.harness.FindSimilarFindingsRequest.context:type_name -> gibson.harness.ContextInfo
+	105, // 152: gibson.harness.FindSimilarFindingsResponse.findings:type_name -> gibson.harness.FindingNode
+	4,   // 153: gibson.harness.FindSimilarFindingsResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 154: gibson.harness.GetAttackChainsRequest.context:type_name -> gibson.harness.ContextInfo
+	108, // 155: gibson.harness.GetAttackChainsResponse.chains:type_name -> gibson.harness.AttackChain
+	4,   // 156: gibson.harness.GetAttackChainsResponse.error:type_name -> gibson.harness.HarnessError
+	109, // 157: gibson.harness.AttackChain.steps:type_name -> gibson.harness.AttackStep
+	6,   // 158: gibson.harness.GetRelatedFindingsRequest.context:type_name -> gibson.harness.ContextInfo
+	105, // 159: gibson.harness.GetRelatedFindingsResponse.findings:type_name -> gibson.harness.FindingNode
+	4,   // 160: gibson.harness.GetRelatedFindingsResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 161: gibson.harness.StoreGraphNodeRequest.context:type_name -> gibson.harness.ContextInfo
+	99,  // 162: gibson.harness.StoreGraphNodeRequest.node:type_name -> gibson.harness.GraphNode
+	4,   // 163: gibson.harness.StoreGraphNodeResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 164: gibson.harness.CreateGraphRelationshipRequest.context:type_name -> gibson.harness.ContextInfo
+	116, // 165: gibson.harness.CreateGraphRelationshipRequest.relationship:type_name -> gibson.harness.Relationship
+	4,   // 166: gibson.harness.CreateGraphRelationshipResponse.error:type_name -> gibson.harness.HarnessError
+	189, // 167: gibson.harness.Relationship.properties:type_name -> gibson.harness.Relationship.PropertiesEntry
+	6,   // 168: gibson.harness.StoreGraphBatchRequest.context:type_name -> gibson.harness.ContextInfo
+	99,  // 169: gibson.harness.StoreGraphBatchRequest.nodes:type_name -> gibson.harness.GraphNode
+	116, // 170: gibson.harness.StoreGraphBatchRequest.relationships:type_name -> gibson.harness.Relationship
+	4,   // 171: gibson.harness.StoreGraphBatchResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 172: gibson.harness.TraverseGraphRequest.context:type_name -> gibson.harness.ContextInfo
+	121, // 173: gibson.harness.TraverseGraphRequest.options:type_name -> gibson.harness.TraversalOptions
+	130, // 174: gibson.harness.TraverseGraphResponse.results:type_name -> gibson.harness.TraversalResult
+	4,   // 175: gibson.harness.TraverseGraphResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 176: gibson.harness.GraphRAGShortestPathRequest.context:type_name -> gibson.harness.ContextInfo
+	124, // 177: gibson.harness.GraphRAGShortestPathRequest.options:type_name -> gibson.harness.PathOptions
+	125, // 178: gibson.harness.GraphRAGShortestPathResponse.edges:type_name -> gibson.harness.PathEdge
+	4,   // 179: gibson.harness.GraphRAGShortestPathResponse.error:type_name -> gibson.harness.HarnessError
+	190, // 180: gibson.harness.PathEdge.properties:type_name -> gibson.harness.PathEdge.PropertiesEntry
+	6,   // 181: gibson.harness.GraphRAGNeighborsRequest.context:type_name -> gibson.harness.ContextInfo
+	99,  // 182: gibson.harness.GraphRAGNeighborsResponse.nodes:type_name -> gibson.harness.GraphNode
+	4,   // 183: gibson.harness.GraphRAGNeighborsResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 184: gibson.harness.GraphRAGWatchRequest.context:type_name -> gibson.harness.ContextInfo
+	99,  // 185: gibson.harness.GraphRAGEvent.node:type_name -> gibson.harness.GraphNode
+	4,   // 186: gibson.harness.GraphRAGEvent.error:type_name -> gibson.harness.HarnessError
+	99,  // 187: gibson.harness.TraversalResult.node:type_name -> gibson.harness.GraphNode
+	6,   // 188: gibson.harness.GraphRAGHealthRequest.context:type_name -> gibson.harness.ContextInfo
+	5,   // 189: gibson.harness.GraphRAGHealthResponse.status:type_name -> gibson.harness.HarnessHealthStatus
+	6,   // 190: gibson.harness.StoreNodeRequest.context:type_name -> gibson.harness.ContextInfo
+	204, // 191: gibson.harness.StoreNodeRequest.node:type_name -> gibson.graphrag.GraphNode
+	4,   // 192: gibson.harness.StoreNodeResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 193: gibson.harness.QueryNodesRequest.context:type_name -> gibson.harness.ContextInfo
+	205, // 194: gibson.harness.QueryNodesRequest.query:type_name -> gibson.graphrag.GraphQuery
+	206, // 195: gibson.harness.QueryNodesResponse.results:type_name -> gibson.graphrag.QueryResult
+	4,   // 196: gibson.harness.QueryNodesResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 197: gibson.harness.GetPlanContextRequest.context:type_name -> gibson.harness.ContextInfo
+	139, // 198: gibson.harness.GetPlanContextResponse.plan_context:type_name -> gibson.harness.PlanContext
+	4,   // 199: gibson.harness.GetPlanContextResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 200: gibson.harness.ReportStepHintsRequest.context:type_name -> gibson.harness.ContextInfo
+	142, // 201: gibson.harness.ReportStepHintsRequest.hints:type_name -> gibson.harness.StepHints
+	4,   // 202: gibson.harness.ReportStepHintsResponse.error:type_name -> gibson.harness.HarnessError
+	143, // 203: gibson.harness.KeyValue.value:type_name -> gibson.harness.AnyValue
+	144, // 204: gibson.harness.SpanEvent.attributes:type_name -> gibson.harness.KeyValue
+	1,   // 205: gibson.harness.Span.kind:type_name -> gibson.harness.SpanKind
+	2,   // 206: gibson.harness.Span.status_code:type_name -> gibson.harness.StatusCode
+	144, // 207: gibson.harness.Span.attributes:type_name -> gibson.harness.KeyValue
+	145, // 208: gibson.harness.Span.events:type_name -> gibson.harness.SpanEvent
+	6,   // 209: gibson.harness.RecordSpanRequest.context:type_name -> gibson.harness.ContextInfo
+	146, // 210: gibson.harness.RecordSpanRequest.span:type_name -> gibson.harness.Span
+	4,   // 211: gibson.harness.RecordSpanResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 212: gibson.harness.RecordSpansRequest.context:type_name -> gibson.harness.ContextInfo
+	146, // 213: gibson.harness.RecordSpansRequest.spans:type_name -> gibson.harness.Span
+	4,   // 214: gibson.harness.RecordSpansResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 215: gibson.harness.GetCredentialRequest.context:type_name -> gibson.harness.ContextInfo
+	153, // 216: gibson.harness.GetCredentialResponse.credential:type_name -> gibson.harness.Credential
+	4,   // 217: gibson.harness.GetCredentialResponse.error:type_name -> gibson.harness.HarnessError
+	3,   // 218: gibson.harness.Credential.type:type_name -> gibson.harness.CredentialType
+	154, // 219: gibson.harness.Credential.basic:type_name -> gibson.harness.BasicAuth
+	155, // 220: gibson.harness.Credential.oauth:type_name -> gibson.harness.OAuthCredential
+	191, // 221: gibson.harness.Credential.metadata:type_name -> gibson.harness.Credential.MetadataEntry
+	6,   // 222: gibson.harness.GetTaxonomySchemaRequest.context:type_name -> gibson.harness.ContextInfo
+	158, // 223: gibson.harness.GetTaxonomySchemaResponse.node_types:type_name -> gibson.harness.TaxonomyNodeType
+	159, // 224: gibson.harness.GetTaxonomySchemaResponse.relationship_types:type_name -> gibson.harness.TaxonomyRelationshipType
+	160, // 225: gibson.harness.GetTaxonomySchemaResponse.techniques:type_name -> gibson.harness.TaxonomyTechnique
+	161, // 226: gibson.harness.GetTaxonomySchemaResponse.target_types:type_name -> gibson.harness.TaxonomyTargetType
+	162, // 227: gibson.harness.GetTaxonomySchemaResponse.technique_types:type_name -> gibson.harness.TaxonomyTechniqueType
+	163, // 228: gibson.harness.GetTaxonomySchemaResponse.capabilities:type_name -> gibson.harness.TaxonomyCapability
+	4,   // 229: gibson.harness.GetTaxonomySchemaResponse.error:type_name -> gibson.harness.HarnessError
+	164, // 230: gibson.harness.TaxonomyNodeType.properties:type_name -> gibson.harness.TaxonomyProperty
+	164, // 231: gibson.harness.TaxonomyRelationshipType.properties:type_name -> gibson.harness.TaxonomyProperty
+	6,   // 232: gibson.harness.GenerateNodeIDRequest.context:type_name -> gibson.harness.ContextInfo
+	192, // 233: gibson.harness.GenerateNodeIDRequest.properties:type_name -> gibson.harness.GenerateNodeIDRequest.PropertiesEntry
+	4,   // 234: gibson.harness.GenerateNodeIDResponse.error:type_name -> gibson.harness.HarnessError
+	6,   // 235: gibson.harness.ValidateFindingRequest.context:type_name -> gibson.harness.ContextInfo
+	199, // 236: gibson.harness.ValidateFindingRequest.finding:type_name -> gibson.types.Finding
+	6,   // 237: gibson.harness.ValidateGraphNodeRequest.context:type_name -> gibson.harness.ContextInfo
+	193, // 238: gibson.harness.ValidateGraphNodeRequest.properties:type_name -> gibson.harness.ValidateGraphNodeRequest.PropertiesEntry
+	6,   // 239: gibson.harness.ValidateRelationshipRequest.context:type_name -> gibson.harness.ContextInfo
+	194, // 240: gibson.harness.ValidateRelationshipRequest.properties:type_name -> gibson.harness.ValidateRelationshipRequest.PropertiesEntry
+	171, // 241: gibson.harness.ValidationResponse.errors:type_name -> gibson.harness.ValidationError
+	4,   // 242: gibson.harness.ValidationResponse.error:type_name -> gibson.harness.HarnessError
+	7,   // 243: gibson.harness.ReportTokenUsageRequest.SlotsEntry.value:type_name -> gibson.harness.TokenUsage
+	39,  // 244: gibson.harness.JSONSchemaNode.PropertiesEntry.value:type_name -> gibson.harness.JSONSchemaNode
+	196, // 245: gibson.harness.QueryPluginRequest.ParamsEntry.value:type_name -> gibson.common.TypedValue
+	196, // 246: gibson.harness.MemoryGetResponse.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	196, // 247: gibson.harness.MemorySetRequest.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	196, // 248: gibson.harness.MissionMemorySearchRequest.FilterEntry.value:type_name -> gibson.common.TypedValue
+	196, // 249: gibson.harness.MissionMemoryResult.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	196, // 250: gibson.harness.MissionMemoryHistoryRequest.FilterEntry.value:type_name -> gibson.common.TypedValue
+	196, // 251: gibson.harness.MissionMemoryItem.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	196, // 252: gibson.harness.LongTermMemoryStoreRequest.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	196, // 253: gibson.harness.LongTermMemorySearchRequest.FiltersEntry.value:type_name -> gibson.common.TypedValue
+	196, // 254: gibson.harness.LongTermMemoryResult.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	196, // 255: gibson.harness.GraphNode.PropertiesEntry.value:type_name -> gibson.common.TypedValue
+	196, // 256: gibson.harness.Relationship.PropertiesEntry.value:type_name -> gibson.common.TypedValue
+	196, // 257: gibson.harness.PathEdge.PropertiesEntry.value:type_name -> gibson.common.TypedValue
+	196, // 258: gibson.harness.Credential.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	196, // 259: gibson.harness.GenerateNodeIDRequest.PropertiesEntry.value:type_name -> gibson.common.TypedValue
+	196, // 260: gibson.harness.ValidateGraphNodeRequest.PropertiesEntry.value:type_name -> gibson.common.TypedValue
+	196, // 261: gibson.harness.ValidateRelationshipRequest.PropertiesEntry.value:type_name -> gibson.common.TypedValue
+	14,  // 262: gibson.harness.HarnessCallbackService.LLMComplete:input_type -> gibson.harness.LLMCompleteRequest
+	15,  // 263: gibson.harness.HarnessCallbackService.LLMCompleteWithTools:input_type -> gibson.harness.LLMCompleteWithToolsRequest
+	16,  // 264: gibson.harness.HarnessCallbackService.LLMCompleteStructured:input_type -> gibson.harness.LLMCompleteStructuredRequest
+	8,   // 265: gibson.harness.HarnessCallbackService.ReportTokenUsage:input_type -> gibson.harness.ReportTokenUsageRequest
+	19,  // 266: gibson.harness.HarnessCallbackService.LLMStream:input_type -> gibson.harness.LLMStreamRequest
+	21,  // 267: gibson.harness.HarnessCallbackService.CallToolProto:input_type -> gibson.harness.CallToolProtoRequest
+	23,  // 268: gibson.harness.HarnessCallbackService.CallToolProtoStream:input_type -> gibson.harness.CallToolProtoStreamRequest
+	30,  // 269: gibson.harness.HarnessCallbackService.ListTools:input_type -> gibson.harness.ListToolsRequest
+	33,  // 270: gibson.harness.HarnessCallbackService.GetToolProtoDescriptors:input_type -> gibson.harness.GetToolProtoDescriptorsRequest
+	35,  // 271: gibson.harness.HarnessCallbackService.QueueToolWork:input_type -> gibson.harness.QueueToolWorkRequest
+	37,  // 272: gibson.harness.HarnessCallbackService.ToolResults:input_type -> gibson.harness.ToolResultsRequest
+	44,  // 273: gibson.harness.HarnessCallbackService.QueryPlugin:input_type -> gibson.harness.QueryPluginRequest
+	46,  // 274: gibson.harness.HarnessCallbackService.ListPlugins:input_type -> gibson.harness.ListPluginsRequest
+	49,  // 275: gibson.harness.HarnessCallbackService.DelegateToAgent:input_type -> gibson.harness.DelegateToAgentRequest
+	51,  // 276: gibson.harness.HarnessCallbackService.ListAgents:input_type -> gibson.harness.ListAgentsRequest
+	54,  // 277: gibson.harness.HarnessCallbackService.SubmitFinding:input_type -> gibson.harness.SubmitFindingRequest
+	56,  // 278: gibson.harness.HarnessCallbackService.GetFindings:input_type -> gibson.harness.GetFindingsRequest
+	59,  // 279: gibson.harness.HarnessCallbackService.MemoryGet:input_type -> gibson.harness.MemoryGetRequest
+	61,  // 280: gibson.harness.HarnessCallbackService.MemorySet:input_type -> gibson.harness.MemorySetRequest
+	63,  // 281: gibson.harness.HarnessCallbackService.MemoryDelete:input_type -> gibson.harness.MemoryDeleteRequest
+	65,  // 282: gibson.harness.HarnessCallbackService.MemoryList:input_type -> gibson.harness.MemoryListRequest
+	67,  // 283: gibson.harness.HarnessCallbackService.MissionMemorySearch:input_type -> gibson.harness.MissionMemorySearchRequest
+	70,  // 284: gibson.harness.HarnessCallbackService.MissionMemoryHistory:input_type -> gibson.harness.MissionMemoryHistoryRequest
+	73,  // 285: gibson.harness.HarnessCallbackService.MissionMemoryGetPreviousRunValue:input_type -> gibson.harness.MissionMemoryGetPreviousRunValueRequest
+	75,  // 286: gibson.harness.HarnessCallbackService.MissionMemoryGetValueHistory:input_type -> gibson.harness.MissionMemoryGetValueHistoryRequest
+	78,  // 287: gibson.harness.HarnessCallbackService.MissionMemoryContinuityMode:input_type -> gibson.harness.MissionMemoryContinuityModeRequest
+	80,  // 288: gibson.harness.HarnessCallbackService.LongTermMemoryStore:input_type -> gibson.harness.LongTermMemoryStoreRequest
+	82,  // 289: gibson.harness.HarnessCallbackService.LongTermMemorySearch:input_type -> gibson.harness.LongTermMemorySearchRequest
+	85,  // 290: gibson.harness.HarnessCallbackService.LongTermMemoryDelete:input_type -> gibson.harness.LongTermMemoryDeleteRequest
+	87,  // 291: gibson.harness.HarnessCallbackService.GraphRAGQuery:input_type -> gibson.harness.GraphRAGQueryRequest
+	89,  // 292: gibson.harness.HarnessCallbackService.GraphRAGQueryBatch:input_type -> gibson.harness.GraphRAGQueryBatchRequest
+	92,  // 293: gibson.harness.HarnessCallbackService.GraphRAGExplain:input_type -> gibson.harness.GraphRAGExplainRequest
+	95,  // 294: gibson.harness.HarnessCallbackService.GraphRAGStats:input_type -> gibson.harness.GraphRAGStatsRequest
+	100, // 295: gibson.harness.HarnessCallbackService.FindSimilarAttacks:input_type -> gibson.harness.FindSimilarAttacksRequest
+	103, // 296: gibson.harness.HarnessCallbackService.FindSimilarFindings:input_type -> gibson.harness.FindSimilarFindingsRequest
+	106, // 297: gibson.harness.HarnessCallbackService.GetAttackChains:input_type -> gibson.harness.GetAttackChainsRequest
+	110, // 298: gibson.harness.HarnessCallbackService.GetRelatedFindings:input_type -> gibson.harness.GetRelatedFindingsRequest
+	112, // 299: gibson.harness.HarnessCallbackService.StoreGraphNode:input_type -> gibson.harness.StoreGraphNodeRequest
+	114, // 300: gibson.harness.HarnessCallbackService.CreateGraphRelationship:input_type -> gibson.harness.CreateGraphRelationshipRequest
+	117, // 301: gibson.harness.HarnessCallbackService.StoreGraphBatch:input_type -> gibson.harness.StoreGraphBatchRequest
+	119, // 302: gibson.harness.HarnessCallbackService.TraverseGraph:input_type -> gibson.harness.TraverseGraphRequest
+	122, // 303: gibson.harness.HarnessCallbackService.GraphRAGShortestPath:input_type -> gibson.harness.GraphRAGShortestPathRequest
+	126, // 304: gibson.harness.HarnessCallbackService.GraphRAGNeighbors:input_type -> gibson.harness.GraphRAGNeighborsRequest
+	128, // 305: gibson.harness.HarnessCallbackService.GraphRAGWatch:input_type -> gibson.harness.GraphRAGWatchRequest
+	131, // 306: gibson.harness.HarnessCallbackService.GraphRAGHealth:input_type -> gibson.harness.GraphRAGHealthRequest
+	133, // 307: gibson.harness.HarnessCallbackService.StoreNode:input_type -> gibson.harness.StoreNodeRequest
+	135, // 308: gibson.harness.HarnessCallbackService.QueryNodes:input_type -> gibson.harness.QueryNodesRequest
+	137, // 309: gibson.harness.HarnessCallbackService.GetPlanContext:input_type -> gibson.harness.GetPlanContextRequest
+	140, // 310: gibson.harness.HarnessCallbackService.ReportStepHints:input_type -> gibson.harness.ReportStepHintsRequest
+	147, // 311: gibson.harness.HarnessCallbackService.RecordSpan:input_type -> gibson.harness.RecordSpanRequest
+	149, // 312: gibson.harness.HarnessCallbackService.RecordSpans:input_type -> gibson.harness.RecordSpansRequest
+	151, // 313: gibson.harness.HarnessCallbackService.GetCredential:input_type -> gibson.harness.GetCredentialRequest
+	156, // 314: gibson.harness.HarnessCallbackService.GetTaxonomySchema:input_type -> gibson.harness.GetTaxonomySchemaRequest
+	165, // 315: gibson.harness.HarnessCallbackService.GenerateNodeID:input_type -> gibson.harness.GenerateNodeIDRequest
+	167, // 316: gibson.harness.HarnessCallbackService.ValidateFinding:input_type -> gibson.harness.ValidateFindingRequest
+	168, // 317: gibson.harness.HarnessCallbackService.ValidateGraphNode:input_type -> gibson.harness.ValidateGraphNodeRequest
+	169, // 318: gibson.harness.HarnessCallbackService.ValidateRelationship:input_type -> gibson.harness.ValidateRelationshipRequest
+	18,  // 319: gibson.harness.HarnessCallbackService.LLMComplete:output_type -> gibson.harness.LLMCompleteResponse
+	18,  // 320: gibson.harness.HarnessCallbackService.LLMCompleteWithTools:output_type -> gibson.harness.LLMCompleteResponse
+	17,  // 321: gibson.harness.HarnessCallbackService.LLMCompleteStructured:output_type -> gibson.harness.LLMCompleteStructuredResponse
+	9,   // 322: gibson.harness.HarnessCallbackService.ReportTokenUsage:output_type -> gibson.harness.ReportTokenUsageResponse
+	20,  // 323: gibson.harness.HarnessCallbackService.LLMStream:output_type -> gibson.harness.LLMStreamChunk
+	22,  // 324: gibson.harness.HarnessCallbackService.CallToolProto:output_type -> gibson.harness.CallToolProtoResponse
+	24,  // 325: gibson.harness.HarnessCallbackService.CallToolProtoStream:output_type -> gibson.harness.CallToolProtoStreamResponse
+	31,  // 326: gibson.harness.HarnessCallbackService.ListTools:output_type -> gibson.harness.ListToolsResponse
+	34,  // 327: gibson.harness.HarnessCallbackService.GetToolProtoDescriptors:output_type -> gibson.harness.GetToolProtoDescriptorsResponse
+	36,  // 328: gibson.harness.HarnessCallbackService.QueueToolWork:output_type -> gibson.harness.QueueToolWorkResponse
+	38,  // 329: gibson.harness.HarnessCallbackService.ToolResults:output_type -> gibson.harness.ToolResultResponse
+	45,  // 330: gibson.harness.HarnessCallbackService.QueryPlugin:output_type -> gibson.harness.QueryPluginResponse
+	47,  // 331: gibson.harness.HarnessCallbackService.ListPlugins:output_type -> gibson.harness.ListPluginsResponse
+	50,  // 332: gibson.harness.HarnessCallbackService.DelegateToAgent:output_type -> gibson.harness.DelegateToAgentResponse
+	52,  // 333: gibson.harness.HarnessCallbackService.ListAgents:output_type -> gibson.harness.ListAgentsResponse
+	55,  // 334: gibson.harness.HarnessCallbackService.SubmitFinding:output_type -> gibson.harness.SubmitFindingResponse
+	57,  // 335: gibson.harness.HarnessCallbackService.GetFindings:output_type -> gibson.harness.GetFindingsResponse
+	60,  // 336: gibson.harness.HarnessCallbackService.MemoryGet:output_type -> gibson.harness.MemoryGetResponse
+	62,  // 337: gibson.harness.HarnessCallbackService.MemorySet:output_type -> gibson.harness.MemorySetResponse
+	64,  // 338: gibson.harness.HarnessCallbackService.MemoryDelete:output_type -> gibson.harness.MemoryDeleteResponse
+	66,  // 339: gibson.harness.HarnessCallbackService.MemoryList:output_type -> gibson.harness.MemoryListResponse
+	68,  // 340: gibson.harness.HarnessCallbackService.MissionMemorySearch:output_type -> gibson.harness.MissionMemorySearchResponse
+	71,  // 341: gibson.harness.HarnessCallbackService.MissionMemoryHistory:output_type -> gibson.harness.MissionMemoryHistoryResponse
+	74,  // 342: gibson.harness.HarnessCallbackService.MissionMemoryGetPreviousRunValue:output_type -> gibson.harness.MissionMemoryGetPreviousRunValueResponse
+	76,  // 343: gibson.harness.HarnessCallbackService.MissionMemoryGetValueHistory:output_type -> gibson.harness.MissionMemoryGetValueHistoryResponse
+	79,  // 344: gibson.harness.HarnessCallbackService.MissionMemoryContinuityMode:output_type -> gibson.harness.MissionMemoryContinuityModeResponse
+	81,  // 345: gibson.harness.HarnessCallbackService.LongTermMemoryStore:output_type -> gibson.harness.LongTermMemoryStoreResponse
+	83,  // 346: gibson.harness.HarnessCallbackService.LongTermMemorySearch:output_type -> gibson.harness.LongTermMemorySearchResponse
+	86,  // 347: gibson.harness.HarnessCallbackService.LongTermMemoryDelete:output_type -> gibson.harness.LongTermMemoryDeleteResponse
+	88,  // 348: gibson.harness.HarnessCallbackService.GraphRAGQuery:output_type -> gibson.harness.GraphRAGQueryResponse
+	90,  // 349: gibson.harness.HarnessCallbackService.GraphRAGQueryBatch:output_type -> gibson.harness.GraphRAGQueryBatchResponse
+	93,  // 350: gibson.harness.HarnessCallbackService.GraphRAGExplain:output_type -> gibson.harness.GraphRAGExplainResponse
+	96,  // 351: gibson.harness.HarnessCallbackService.GraphRAGStats:output_type -> gibson.harness.GraphRAGStatsResponse
+	101, // 352: gibson.harness.HarnessCallbackService.FindSimilarAttacks:output_type -> gibson.harness.FindSimilarAttacksResponse
+	104, // 353: gibson.harness.HarnessCallbackService.FindSimilarFindings:output_type -> gibson.harness.FindSimilarFindingsResponse
+	107, // 354: gibson.harness.HarnessCallbackService.GetAttackChains:output_type -> gibson.harness.GetAttackChainsResponse
+	111, // 355: gibson.harness.HarnessCallbackService.GetRelatedFindings:output_type -> gibson.harness.GetRelatedFindingsResponse
+	113, // 356: gibson.harness.HarnessCallbackService.StoreGraphNode:output_type -> gibson.harness.StoreGraphNodeResponse
+	115, // 357: gibson.harness.HarnessCallbackService.CreateGraphRelationship:output_type -> gibson.harness.CreateGraphRelationshipResponse
+	118, // 358: gibson.harness.HarnessCallbackService.StoreGraphBatch:output_type -> gibson.harness.StoreGraphBatchResponse
+	120, // 359: gibson.harness.HarnessCallbackService.TraverseGraph:output_type -> gibson.harness.TraverseGraphResponse
+	123, // 360: gibson.harness.HarnessCallbackService.GraphRAGShortestPath:output_type -> gibson.harness.GraphRAGShortestPathResponse
+	127, // 361: gibson.harness.HarnessCallbackService.GraphRAGNeighbors:output_type -> gibson.harness.GraphRAGNeighborsResponse
+	129, // 362: gibson.harness.HarnessCallbackService.GraphRAGWatch:output_type -> gibson.harness.GraphRAGEvent
+	132, // 363: gibson.harness.HarnessCallbackService.GraphRAGHealth:output_type -> gibson.harness.GraphRAGHealthResponse
+	134, // 364: gibson.harness.HarnessCallbackService.StoreNode:output_type -> gibson.harness.StoreNodeResponse
+	136, // 365: gibson.harness.HarnessCallbackService.QueryNodes:output_type -> gibson.harness.QueryNodesResponse
+	138, // 366: gibson.harness.HarnessCallbackService.GetPlanContext:output_type -> gibson.harness.GetPlanContextResponse
+	141, // 367: gibson.harness.HarnessCallbackService.ReportStepHints:output_type -> gibson.harness.ReportStepHintsResponse
+	148, // 368: gibson.harness.HarnessCallbackService.RecordSpan:output_type -> gibson.harness.RecordSpanResponse
+	150, // 369: gibson.harness.HarnessCallbackService.RecordSpans:output_type -> gibson.harness.RecordSpansResponse
+	152, // 370: gibson.harness.HarnessCallbackService.GetCredential:output_type -> gibson.harness.GetCredentialResponse
+	157, // 371: gibson.harness.HarnessCallbackService.GetTaxonomySchema:output_type -> gibson.harness.GetTaxonomySchemaResponse
+	166, // 372: gibson.harness.HarnessCallbackService.GenerateNodeID:output_type -> gibson.harness.GenerateNodeIDResponse
+	170, // 373: gibson.harness.HarnessCallbackService.ValidateFinding:output_type -> gibson.harness.ValidationResponse
+	170, // 374: gibson.harness.HarnessCallbackService.ValidateGraphNode:output_type -> gibson.harness.ValidationResponse
+	170, // 375: gibson.harness.HarnessCallbackService.ValidateRelationship:output_type -> gibson.harness.ValidationResponse
+	319, // [319:376] is the sub-list for method output_type
+	262, // [262:319] is the sub-list for method input_type
+	262, // [262:262] is the sub-list for extension type_name
+	262, // [262:262] is the sub-list for extension extendee
+	0,   // [0:262] is the sub-list for field type_name
 }
 
 func init() { file_harness_callback_proto_init() }
@@ -11258,24 +13032,24 @@ func file_harness_callback_proto_init() {
 	}
 	file_common_proto_init()
 	file_types_proto_init()
-	file_harness_callback_proto_msgTypes[8].OneofWrappers = []any{}
-	file_harness_callback_proto_msgTypes[13].OneofWrappers = []any{}
-	file_harness_callback_proto_msgTypes[18].OneofWrappers = []any{
+	file_harness_callback_proto_msgTypes[10].OneofWrappers = []any{}
+	file_harness_callback_proto_msgTypes[15].OneofWrappers = []any{}
+	file_harness_callback_proto_msgTypes[20].OneofWrappers = []any{
 		(*CallToolProtoStreamResponse_Progress)(nil),
 		(*CallToolProtoStreamResponse_Partial)(nil),
 		(*CallToolProtoStreamResponse_Warning)(nil),
 		(*CallToolProtoStreamResponse_Complete)(nil),
 		(*CallToolProtoStreamResponse_Error)(nil),
 	}
-	file_harness_callback_proto_msgTypes[31].OneofWrappers = []any{}
-	file_harness_callback_proto_msgTypes[118].OneofWrappers = []any{
+	file_harness_callback_proto_msgTypes[35].OneofWrappers = []any{}
+	file_harness_callback_proto_msgTypes[139].OneofWrappers = []any{
 		(*AnyValue_StringValue)(nil),
 		(*AnyValue_BoolValue)(nil),
 		(*AnyValue_IntValue)(nil),
 		(*AnyValue_DoubleValue)(nil),
 		(*AnyValue_BytesValue)(nil),
 	}
-	file_harness_callback_proto_msgTypes[128].OneofWrappers = []any{
+	file_harness_callback_proto_msgTypes[149].OneofWrappers = []any{
 		(*Credential_ApiKey)(nil),
 		(*Credential_BearerToken)(nil),
 		(*Credential_Basic)(nil),
@@ -11288,7 +13062,7 @@ func file_harness_callback_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_harness_callback_proto_rawDesc), len(file_harness_callback_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   164,
+			NumMessages:   191,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,10 +22,12 @@ const (
 	HarnessCallbackService_LLMComplete_FullMethodName                      = "/gibson.harness.HarnessCallbackService/LLMComplete"
 	HarnessCallbackService_LLMCompleteWithTools_FullMethodName             = "/gibson.harness.HarnessCallbackService/LLMCompleteWithTools"
 	HarnessCallbackService_LLMCompleteStructured_FullMethodName            = "/gibson.harness.HarnessCallbackService/LLMCompleteStructured"
+	HarnessCallbackService_ReportTokenUsage_FullMethodName                 = "/gibson.harness.HarnessCallbackService/ReportTokenUsage"
 	HarnessCallbackService_LLMStream_FullMethodName                        = "/gibson.harness.HarnessCallbackService/LLMStream"
 	HarnessCallbackService_CallToolProto_FullMethodName                    = "/gibson.harness.HarnessCallbackService/CallToolProto"
 	HarnessCallbackService_CallToolProtoStream_FullMethodName              = "/gibson.harness.HarnessCallbackService/CallToolProtoStream"
 	HarnessCallbackService_ListTools_FullMethodName                        = "/gibson.harness.HarnessCallbackService/ListTools"
+	HarnessCallbackService_GetToolProtoDescriptors_FullMethodName          = "/gibson.harness.HarnessCallbackService/GetToolProtoDescriptors"
 	HarnessCallbackService_QueueToolWork_FullMethodName                    = "/gibson.harness.HarnessCallbackService/QueueToolWork"
 	HarnessCallbackService_ToolResults_FullMethodName                      = "/gibson.harness.HarnessCallbackService/ToolResults"
 	HarnessCallbackService_QueryPlugin_FullMethodName                      = "/gibson.harness.HarnessCallbackService/QueryPlugin"
@@ -47,6 +49,9 @@ const (
 	HarnessCallbackService_LongTermMemorySearch_FullMethodName             = "/gibson.harness.HarnessCallbackService/LongTermMemorySearch"
 	HarnessCallbackService_LongTermMemoryDelete_FullMethodName             = "/gibson.harness.HarnessCallbackService/LongTermMemoryDelete"
 	HarnessCallbackService_GraphRAGQuery_FullMethodName                    = "/gibson.harness.HarnessCallbackService/GraphRAGQuery"
+	HarnessCallbackService_GraphRAGQueryBatch_FullMethodName               = "/gibson.harness.HarnessCallbackService/GraphRAGQueryBatch"
+	HarnessCallbackService_GraphRAGExplain_FullMethodName                  = "/gibson.harness.HarnessCallbackService/GraphRAGExplain"
+	HarnessCallbackService_GraphRAGStats_FullMethodName                    = "/gibson.harness.HarnessCallbackService/GraphRAGStats"
 	HarnessCallbackService_FindSimilarAttacks_FullMethodName               = "/gibson.harness.HarnessCallbackService/FindSimilarAttacks"
 	HarnessCallbackService_FindSimilarFindings_FullMethodName              = "/gibson.harness.HarnessCallbackService/FindSimilarFindings"
 	HarnessCallbackService_GetAttackChains_FullMethodName                  = "/gibson.harness.HarnessCallbackService/GetAttackChains"
@@ -55,6 +60,9 @@ const (
 	HarnessCallbackService_CreateGraphRelationship_FullMethodName          = "/gibson.harness.HarnessCallbackService/CreateGraphRelationship"
 	HarnessCallbackService_StoreGraphBatch_FullMethodName                  = "/gibson.harness.HarnessCallbackService/StoreGraphBatch"
 	HarnessCallbackService_TraverseGraph_FullMethodName                    = "/gibson.harness.HarnessCallbackService/TraverseGraph"
+	HarnessCallbackService_GraphRAGShortestPath_FullMethodName             = "/gibson.harness.HarnessCallbackService/GraphRAGShortestPath"
+	HarnessCallbackService_GraphRAGNeighbors_FullMethodName                = "/gibson.harness.HarnessCallbackService/GraphRAGNeighbors"
+	HarnessCallbackService_GraphRAGWatch_FullMethodName                    = "/gibson.harness.HarnessCallbackService/GraphRAGWatch"
 	HarnessCallbackService_GraphRAGHealth_FullMethodName                   = "/gibson.harness.HarnessCallbackService/GraphRAGHealth"
 	HarnessCallbackService_StoreNode_FullMethodName                        = "/gibson.harness.HarnessCallbackService/StoreNode"
 	HarnessCallbackService_QueryNodes_FullMethodName                       = "/gibson.harness.HarnessCallbackService/QueryNodes"
@@ -81,11 +89,13 @@ type HarnessCallbackServiceClient interface {
 	LLMComplete(ctx context.Context, in *LLMCompleteRequest, opts ...grpc.CallOption) (*LLMCompleteResponse, error)
 	LLMCompleteWithTools(ctx context.Context, in *LLMCompleteWithToolsRequest, opts ...grpc.CallOption) (*LLMCompleteResponse, error)
 	LLMCompleteStructured(ctx context.Context, in *LLMCompleteStructuredRequest, opts ...grpc.CallOption) (*LLMCompleteStructuredResponse, error)
+	ReportTokenUsage(ctx context.Context, in *ReportTokenUsageRequest, opts ...grpc.CallOption) (*ReportTokenUsageResponse, error)
 	LLMStream(ctx context.Context, in *LLMStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LLMStreamChunk], error)
 	// Tool Operations
 	CallToolProto(ctx context.Context, in *CallToolProtoRequest, opts ...grpc.CallOption) (*CallToolProtoResponse, error)
 	CallToolProtoStream(ctx context.Context, in *CallToolProtoStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CallToolProtoStreamResponse], error)
 	ListTools(ctx context.Context, in *ListToolsRequest, opts ...grpc.CallOption) (*ListToolsResponse, error)
+	GetToolProtoDescriptors(ctx context.Context, in *GetToolProtoDescriptorsRequest, opts ...grpc.CallOption) (*GetToolProtoDescriptorsResponse, error)
 	// Tool Work Queue Operations
 	QueueToolWork(ctx context.Context, in *QueueToolWorkRequest, opts ...grpc.CallOption) (*QueueToolWorkResponse, error)
 	ToolResults(ctx context.Context, in *ToolResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ToolResultResponse], error)
@@ -115,6 +125,9 @@ type HarnessCallbackServiceClient interface {
 	LongTermMemoryDelete(ctx context.Context, in *LongTermMemoryDeleteRequest, opts ...grpc.CallOption) (*LongTermMemoryDeleteResponse, error)
 	// GraphRAG Query Operations
 	GraphRAGQuery(ctx context.Context, in *GraphRAGQueryRequest, opts ...grpc.CallOption) (*GraphRAGQueryResponse, error)
+	GraphRAGQueryBatch(ctx context.Context, in *GraphRAGQueryBatchRequest, opts ...grpc.CallOption) (*GraphRAGQueryBatchResponse, error)
+	GraphRAGExplain(ctx context.Context, in *GraphRAGExplainRequest, opts ...grpc.CallOption) (*GraphRAGExplainResponse, error)
+	GraphRAGStats(ctx context.Context, in *GraphRAGStatsRequest, opts ...grpc.CallOption) (*GraphRAGStatsResponse, error)
 	FindSimilarAttacks(ctx context.Context, in *FindSimilarAttacksRequest, opts ...grpc.CallOption) (*FindSimilarAttacksResponse, error)
 	FindSimilarFindings(ctx context.Context, in *FindSimilarFindingsRequest, opts ...grpc.CallOption) (*FindSimilarFindingsResponse, error)
 	GetAttackChains(ctx context.Context, in *GetAttackChainsRequest, opts ...grpc.CallOption) (*GetAttackChainsResponse, error)
@@ -124,6 +137,9 @@ type HarnessCallbackServiceClient interface {
 	CreateGraphRelationship(ctx context.Context, in *CreateGraphRelationshipRequest, opts ...grpc.CallOption) (*CreateGraphRelationshipResponse, error)
 	StoreGraphBatch(ctx context.Context, in *StoreGraphBatchRequest, opts ...grpc.CallOption) (*StoreGraphBatchResponse, error)
 	TraverseGraph(ctx context.Context, in *TraverseGraphRequest, opts ...grpc.CallOption) (*TraverseGraphResponse, error)
+	GraphRAGShortestPath(ctx context.Context, in *GraphRAGShortestPathRequest, opts ...grpc.CallOption) (*GraphRAGShortestPathResponse, error)
+	GraphRAGNeighbors(ctx context.Context, in *GraphRAGNeighborsRequest, opts ...grpc.CallOption) (*GraphRAGNeighborsResponse, error)
+	GraphRAGWatch(ctx context.Context, in *GraphRAGWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GraphRAGEvent], error)
 	GraphRAGHealth(ctx context.Context, in *GraphRAGHealthRequest, opts ...grpc.CallOption) (*GraphRAGHealthResponse, error)
 	// Proto-canonical GraphRAG Operations (uses graphragpb types)
 	StoreNode(ctx context.Context, in *StoreNodeRequest, opts ...grpc.CallOption) (*StoreNodeResponse, error)
@@ -182,6 +198,16 @@ func (c *harnessCallbackServiceClient) LLMCompleteStructured(ctx context.Context
 	return out, nil
 }
 
+func (c *harnessCallbackServiceClient) ReportTokenUsage(ctx context.Context, in *ReportTokenUsageRequest, opts ...grpc.CallOption) (*ReportTokenUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportTokenUsageResponse)
+	err := c.cc.Invoke(ctx, HarnessCallbackService_ReportTokenUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *harnessCallbackServiceClient) LLMStream(ctx context.Context, in *LLMStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LLMStreamChunk], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &HarnessCallbackService_ServiceDesc.Streams[0], HarnessCallbackService_LLMStream_FullMethodName, cOpts...)
@@ -240,6 +266,16 @@ func (c *harnessCallbackServiceClient) ListTools(ctx context.Context, in *ListTo
 	return out, nil
 }
 
+func (c *harnessCallbackServiceClient) GetToolProtoDescriptors(ctx context.Context, in *GetToolProtoDescriptorsRequest, opts ...grpc.CallOption) (*GetToolProtoDescriptorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetToolProtoDescriptorsResponse)
+	err := c.cc.Invoke(ctx, HarnessCallbackService_GetToolProtoDescriptors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *harnessCallbackServiceClient) QueueToolWork(ctx context.Context, in *QueueToolWorkRequest, opts ...grpc.CallOption) (*QueueToolWorkResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueueToolWorkResponse)
@@ -459,6 +495,36 @@ func (c *harnessCallbackServiceClient) GraphRAGQuery(ctx context.Context, in *Gr
 	return out, nil
 }
 
+func (c *harnessCallbackServiceClient) GraphRAGQueryBatch(ctx context.Context, in *GraphRAGQueryBatchRequest, opts ...grpc.CallOption) (*GraphRAGQueryBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphRAGQueryBatchResponse)
+	err := c.cc.Invoke(ctx, HarnessCallbackService_GraphRAGQueryBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harnessCallbackServiceClient) GraphRAGExplain(ctx context.Context, in *GraphRAGExplainRequest, opts ...grpc.CallOption) (*GraphRAGExplainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphRAGExplainResponse)
+	err := c.cc.Invoke(ctx, HarnessCallbackService_GraphRAGExplain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harnessCallbackServiceClient) GraphRAGStats(ctx context.Context, in *GraphRAGStatsRequest, opts ...grpc.CallOption) (*GraphRAGStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphRAGStatsResponse)
+	err := c.cc.Invoke(ctx, HarnessCallbackService_GraphRAGStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *harnessCallbackServiceClient) FindSimilarAttacks(ctx context.Context, in *FindSimilarAttacksRequest, opts ...grpc.CallOption) (*FindSimilarAttacksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FindSimilarAttacksResponse)
@@ -539,6 +605,45 @@ func (c *harnessCallbackServiceClient) TraverseGraph(ctx context.Context, in *Tr
 	return out, nil
 }
 
+func (c *harnessCallbackServiceClient) GraphRAGShortestPath(ctx context.Context, in *GraphRAGShortestPathRequest, opts ...grpc.CallOption) (*GraphRAGShortestPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphRAGShortestPathResponse)
+	err := c.cc.Invoke(ctx, HarnessCallbackService_GraphRAGShortestPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harnessCallbackServiceClient) GraphRAGNeighbors(ctx context.Context, in *GraphRAGNeighborsRequest, opts ...grpc.CallOption) (*GraphRAGNeighborsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphRAGNeighborsResponse)
+	err := c.cc.Invoke(ctx, HarnessCallbackService_GraphRAGNeighbors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harnessCallbackServiceClient) GraphRAGWatch(ctx context.Context, in *GraphRAGWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GraphRAGEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HarnessCallbackService_ServiceDesc.Streams[3], HarnessCallbackService_GraphRAGWatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GraphRAGWatchRequest, GraphRAGEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HarnessCallbackService_GraphRAGWatchClient = grpc.ServerStreamingClient[GraphRAGEvent]
+
 func (c *harnessCallbackServiceClient) GraphRAGHealth(ctx context.Context, in *GraphRAGHealthRequest, opts ...grpc.CallOption) (*GraphRAGHealthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GraphRAGHealthResponse)
@@ -680,11 +785,13 @@ type HarnessCallbackServiceServer interface {
 	LLMComplete(context.Context, *LLMCompleteRequest) (*LLMCompleteResponse, error)
 	LLMCompleteWithTools(context.Context, *LLMCompleteWithToolsRequest) (*LLMCompleteResponse, error)
 	LLMCompleteStructured(context.Context, *LLMCompleteStructuredRequest) (*LLMCompleteStructuredResponse, error)
+	ReportTokenUsage(context.Context, *ReportTokenUsageRequest) (*ReportTokenUsageResponse, error)
 	LLMStream(*LLMStreamRequest, grpc.ServerStreamingServer[LLMStreamChunk]) error
 	// Tool Operations
 	CallToolProto(context.Context, *CallToolProtoRequest) (*CallToolProtoResponse, error)
 	CallToolProtoStream(*CallToolProtoStreamRequest, grpc.ServerStreamingServer[CallToolProtoStreamResponse]) error
 	ListTools(context.Context, *ListToolsRequest) (*ListToolsResponse, error)
+	GetToolProtoDescriptors(context.Context, *GetToolProtoDescriptorsRequest) (*GetToolProtoDescriptorsResponse, error)
 	// Tool Work Queue Operations
 	QueueToolWork(context.Context, *QueueToolWorkRequest) (*QueueToolWorkResponse, error)
 	ToolResults(*ToolResultsRequest, grpc.ServerStreamingServer[ToolResultResponse]) error
@@ -714,6 +821,9 @@ type HarnessCallbackServiceServer interface {
 	LongTermMemoryDelete(context.Context, *LongTermMemoryDeleteRequest) (*LongTermMemoryDeleteResponse, error)
 	// GraphRAG Query Operations
 	GraphRAGQuery(context.Context, *GraphRAGQueryRequest) (*GraphRAGQueryResponse, error)
+	GraphRAGQueryBatch(context.Context, *GraphRAGQueryBatchRequest) (*GraphRAGQueryBatchResponse, error)
+	GraphRAGExplain(context.Context, *GraphRAGExplainRequest) (*GraphRAGExplainResponse, error)
+	GraphRAGStats(context.Context, *GraphRAGStatsRequest) (*GraphRAGStatsResponse, error)
 	FindSimilarAttacks(context.Context, *FindSimilarAttacksRequest) (*FindSimilarAttacksResponse, error)
 	FindSimilarFindings(context.Context, *FindSimilarFindingsRequest) (*FindSimilarFindingsResponse, error)
 	GetAttackChains(context.Context, *GetAttackChainsRequest) (*GetAttackChainsResponse, error)
@@ -723,6 +833,9 @@ type HarnessCallbackServiceServer interface {
 	CreateGraphRelationship(context.Context, *CreateGraphRelationshipRequest) (*CreateGraphRelationshipResponse, error)
 	StoreGraphBatch(context.Context, *StoreGraphBatchRequest) (*StoreGraphBatchResponse, error)
 	TraverseGraph(context.Context, *TraverseGraphRequest) (*TraverseGraphResponse, error)
+	GraphRAGShortestPath(context.Context, *GraphRAGShortestPathRequest) (*GraphRAGShortestPathResponse, error)
+	GraphRAGNeighbors(context.Context, *GraphRAGNeighborsRequest) (*GraphRAGNeighborsResponse, error)
+	GraphRAGWatch(*GraphRAGWatchRequest, grpc.ServerStreamingServer[GraphRAGEvent]) error
 	GraphRAGHealth(context.Context, *GraphRAGHealthRequest) (*GraphRAGHealthResponse, error)
 	// Proto-canonical GraphRAG Operations (uses graphragpb types)
 	StoreNode(context.Context, *StoreNodeRequest) (*StoreNodeResponse, error)
@@ -760,6 +873,9 @@ func (UnimplementedHarnessCallbackServiceServer) LLMCompleteWithTools(context.Co
 func (UnimplementedHarnessCallbackServiceServer) LLMCompleteStructured(context.Context, *LLMCompleteStructuredRequest) (*LLMCompleteStructuredResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LLMCompleteStructured not implemented")
 }
+func (UnimplementedHarnessCallbackServiceServer) ReportTokenUsage(context.Context, *ReportTokenUsageRequest) (*ReportTokenUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportTokenUsage not implemented")
+}
 func (UnimplementedHarnessCallbackServiceServer) LLMStream(*LLMStreamRequest, grpc.ServerStreamingServer[LLMStreamChunk]) error {
 	return status.Error(codes.Unimplemented, "method LLMStream not implemented")
 }
@@ -772,6 +888,9 @@ func (UnimplementedHarnessCallbackServiceServer) CallToolProtoStream(*CallToolPr
 func (UnimplementedHarnessCallbackServiceServer) ListTools(context.Context, *ListToolsRequest) (*ListToolsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTools not implemented")
 }
+func (UnimplementedHarnessCallbackServiceServer) GetToolProtoDescriptors(context.Context, *GetToolProtoDescriptorsRequest) (*GetToolProtoDescriptorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetToolProtoDescriptors not implemented")
+}
 func (UnimplementedHarnessCallbackServiceServer) QueueToolWork(context.Context, *QueueToolWorkRequest) (*QueueToolWorkResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueueToolWork not implemented")
 }
@@ -835,6 +954,15 @@ func (UnimplementedHarnessCallbackServiceServer) LongTermMemoryDelete(context.Co
 func (UnimplementedHarnessCallbackServiceServer) GraphRAGQuery(context.Context, *GraphRAGQueryRequest) (*GraphRAGQueryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GraphRAGQuery not implemented")
 }
+func (UnimplementedHarnessCallbackServiceServer) GraphRAGQueryBatch(context.Context, *GraphRAGQueryBatchRequest) (*GraphRAGQueryBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GraphRAGQueryBatch not implemented")
+}
+func (UnimplementedHarnessCallbackServiceServer) GraphRAGExplain(context.Context, *GraphRAGExplainRequest) (*GraphRAGExplainResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GraphRAGExplain not implemented")
+}
+func (UnimplementedHarnessCallbackServiceServer) GraphRAGStats(context.Context, *GraphRAGStatsRequest) (*GraphRAGStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GraphRAGStats not implemented")
+}
 func (UnimplementedHarnessCallbackServiceServer) FindSimilarAttacks(context.Context, *FindSimilarAttacksRequest) (*FindSimilarAttacksResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindSimilarAttacks not implemented")
 }
@@ -859,6 +987,15 @@ func (UnimplementedHarnessCallbackServiceServer) StoreGraphBatch(context.Context
 func (UnimplementedHarnessCallbackServiceServer) TraverseGraph(context.Context, *TraverseGraphRequest) (*TraverseGraphResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TraverseGraph not implemented")
 }
+func (UnimplementedHarnessCallbackServiceServer) GraphRAGShortestPath(context.Context, *GraphRAGShortestPathRequest) (*GraphRAGShortestPathResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GraphRAGShortestPath not implemented")
+}
+func (UnimplementedHarnessCallbackServiceServer) GraphRAGNeighbors(context.Context, *GraphRAGNeighborsRequest) (*GraphRAGNeighborsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GraphRAGNeighbors not implemented")
+}
+func (UnimplementedHarnessCallbackServiceServer) GraphRAGWatch(*GraphRAGWatchRequest, grpc.ServerStreamingServer[GraphRAGEvent]) error {
+	return status.Error(codes.Unimplemented, "method GraphRAGWatch not implemented")
+}
 func (UnimplementedHarnessCallbackServiceServer) GraphRAGHealth(context.Context, *GraphRAGHealthRequest) (*GraphRAGHealthResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GraphRAGHealth not implemented")
 }
@@ -974,6 +1111,24 @@ func _HarnessCallbackService_LLMCompleteStructured_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HarnessCallbackService_ReportTokenUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTokenUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarnessCallbackServiceServer).ReportTokenUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HarnessCallbackService_ReportTokenUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarnessCallbackServiceServer).ReportTokenUsage(ctx, req.(*ReportTokenUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HarnessCallbackService_LLMStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(LLMStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1032,6 +1187,24 @@ func _HarnessCallbackService_ListTools_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HarnessCallbackService_GetToolProtoDescriptors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToolProtoDescriptorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarnessCallbackServiceServer).GetToolProtoDescriptors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HarnessCallbackService_GetToolProtoDescriptors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarnessCallbackServiceServer).GetToolProtoDescriptors(ctx, req.(*GetToolProtoDescriptorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HarnessCallbackService_QueueToolWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueueToolWorkRequest)
 	if err := dec(in); err != nil {
@@ -1403,6 +1576,60 @@ func _HarnessCallbackService_GraphRAGQuery_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HarnessCallbackService_GraphRAGQueryBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphRAGQueryBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarnessCallbackServiceServer).GraphRAGQueryBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HarnessCallbackService_GraphRAGQueryBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarnessCallbackServiceServer).GraphRAGQueryBatch(ctx, req.(*GraphRAGQueryBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HarnessCallbackService_GraphRAGExplain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphRAGExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarnessCallbackServiceServer).GraphRAGExplain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HarnessCallbackService_GraphRAGExplain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarnessCallbackServiceServer).GraphRAGExplain(ctx, req.(*GraphRAGExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HarnessCallbackService_GraphRAGStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphRAGStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarnessCallbackServiceServer).GraphRAGStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HarnessCallbackService_GraphRAGStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarnessCallbackServiceServer).GraphRAGStats(ctx, req.(*GraphRAGStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HarnessCallbackService_FindSimilarAttacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindSimilarAttacksRequest)
 	if err := dec(in); err != nil {
@@ -1547,6 +1774,53 @@ func _HarnessCallbackService_TraverseGraph_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HarnessCallbackService_GraphRAGShortestPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphRAGShortestPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarnessCallbackServiceServer).GraphRAGShortestPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HarnessCallbackService_GraphRAGShortestPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarnessCallbackServiceServer).GraphRAGShortestPath(ctx, req.(*GraphRAGShortestPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HarnessCallbackService_GraphRAGNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphRAGNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarnessCallbackServiceServer).GraphRAGNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HarnessCallbackService_GraphRAGNeighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarnessCallbackServiceServer).GraphRAGNeighbors(ctx, req.(*GraphRAGNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HarnessCallbackService_GraphRAGWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GraphRAGWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HarnessCallbackServiceServer).GraphRAGWatch(m, &grpc.GenericServerStream[GraphRAGWatchRequest, GraphRAGEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HarnessCallbackService_GraphRAGWatchServer = grpc.ServerStreamingServer[GraphRAGEvent]
+
 func _HarnessCallbackService_GraphRAGHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GraphRAGHealthRequest)
 	if err := dec(in); err != nil {
@@ -1800,6 +2074,10 @@ var HarnessCallbackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LLMCompleteStructured",
 			Handler:    _HarnessCallbackService_LLMCompleteStructured_Handler,
 		},
+		{
+			MethodName: "ReportTokenUsage",
+			Handler:    _HarnessCallbackService_ReportTokenUsage_Handler,
+		},
 		{
 			MethodName: "CallToolProto",
 			Handler:    _HarnessCallbackService_CallToolProto_Handler,
@@ -1808,6 +2086,10 @@ var HarnessCallbackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTools",
 			Handler:    _HarnessCallbackService_ListTools_Handler,
 		},
+		{
+			MethodName: "GetToolProtoDescriptors",
+			Handler:    _HarnessCallbackService_GetToolProtoDescriptors_Handler,
+		},
 		{
 			MethodName: "QueueToolWork",
 			Handler:    _HarnessCallbackService_QueueToolWork_Handler,
@@ -1888,6 +2170,18 @@ var HarnessCallbackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GraphRAGQuery",
 			Handler:    _HarnessCallbackService_GraphRAGQuery_Handler,
 		},
+		{
+			MethodName: "GraphRAGQueryBatch",
+			Handler:    _HarnessCallbackService_GraphRAGQueryBatch_Handler,
+		},
+		{
+			MethodName: "GraphRAGExplain",
+			Handler:    _HarnessCallbackService_GraphRAGExplain_Handler,
+		},
+		{
+			MethodName: "GraphRAGStats",
+			Handler:    _HarnessCallbackService_GraphRAGStats_Handler,
+		},
 		{
 			MethodName: "FindSimilarAttacks",
 			Handler:    _HarnessCallbackService_FindSimilarAttacks_Handler,
@@ -1920,6 +2214,14 @@ var HarnessCallbackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TraverseGraph",
 			Handler:    _HarnessCallbackService_TraverseGraph_Handler,
 		},
+		{
+			MethodName: "GraphRAGShortestPath",
+			Handler:    _HarnessCallbackService_GraphRAGShortestPath_Handler,
+		},
+		{
+			MethodName: "GraphRAGNeighbors",
+			Handler:    _HarnessCallbackService_GraphRAGNeighbors_Handler,
+		},
 		{
 			MethodName: "GraphRAGHealth",
 			Handler:    _HarnessCallbackService_GraphRAGHealth_Handler,
@@ -1989,6 +2291,11 @@ var HarnessCallbackService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _HarnessCallbackService_ToolResults_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GraphRAGWatch",
+			Handler:       _HarnessCallbackService_GraphRAGWatch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "harness_callback.proto",
 }
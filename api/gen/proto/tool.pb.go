@@ -65,6 +65,7 @@ type ToolDescriptor struct {
 	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
 	InputSchema   *JSONSchema            `protobuf:"bytes,5,opt,name=input_schema,json=inputSchema,proto3" json:"input_schema,omitempty"`
 	OutputSchema  *JSONSchema            `protobuf:"bytes,6,opt,name=output_schema,json=outputSchema,proto3" json:"output_schema,omitempty"`
+	Examples      []*ToolExample         `protobuf:"bytes,7,rep,name=examples,proto3" json:"examples,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,6 +142,186 @@ func (x *ToolDescriptor) GetOutputSchema() *JSONSchema {
 	return nil
 }
 
+func (x *ToolDescriptor) GetExamples() []*ToolExample {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+// ToolExample is a sample invocation of a tool. The input and output are the
+// protojson forms of the tool's input and output messages.
+type ToolExample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	InputJson     string                 `protobuf:"bytes,2,opt,name=input_json,json=inputJson,proto3" json:"input_json,omitempty"`
+	OutputJson    string                 `protobuf:"bytes,3,opt,name=output_json,json=outputJson,proto3" json:"output_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolExample) Reset() {
+	*x = ToolExample{}
+	mi := &file_tool_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolExample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolExample) ProtoMessage() {}
+
+func (x *ToolExample) ProtoReflect() protoreflect.Message {
+	mi := &file_tool_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolExample.ProtoReflect.Descriptor instead.
+func (*ToolExample) Descriptor() ([]byte, []int) {
+	return file_tool_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ToolExample) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ToolExample) GetInputJson() string {
+	if x != nil {
+		return x.InputJson
+	}
+	return ""
+}
+
+func (x *ToolExample) GetOutputJson() string {
+	if x != nil {
+		return x.OutputJson
+	}
+	return ""
+}
+
+type ToolDescribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolDescribeRequest) Reset() {
+	*x = ToolDescribeRequest{}
+	mi := &file_tool_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolDescribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolDescribeRequest) ProtoMessage() {}
+
+func (x *ToolDescribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tool_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolDescribeRequest.ProtoReflect.Descriptor instead.
+func (*ToolDescribeRequest) Descriptor() ([]byte, []int) {
+	return file_tool_proto_rawDescGZIP(), []int{3}
+}
+
+// ToolDescription is a tool's descriptor plus the proto descriptors of its
+// input and output messages, so clients without the tool's generated code
+// can build requests and decode responses dynamically.
+type ToolDescription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tool  *ToolDescriptor        `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	// Fully qualified proto message type names of the input and output.
+	InputMessageType  string `protobuf:"bytes,2,opt,name=input_message_type,json=inputMessageType,proto3" json:"input_message_type,omitempty"`
+	OutputMessageType string `protobuf:"bytes,3,opt,name=output_message_type,json=outputMessageType,proto3" json:"output_message_type,omitempty"`
+	// Serialized google.protobuf.FileDescriptorSet holding the files that
+	// define the input and output messages and all their dependencies.
+	// Empty if the message types are not registered with the tool.
+	FileDescriptorSet []byte `protobuf:"bytes,4,opt,name=file_descriptor_set,json=fileDescriptorSet,proto3" json:"file_descriptor_set,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ToolDescription) Reset() {
+	*x = ToolDescription{}
+	mi := &file_tool_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolDescription) ProtoMessage() {}
+
+func (x *ToolDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_tool_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolDescription.ProtoReflect.Descriptor instead.
+func (*ToolDescription) Descriptor() ([]byte, []int) {
+	return file_tool_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ToolDescription) GetTool() *ToolDescriptor {
+	if x != nil {
+		return x.Tool
+	}
+	return nil
+}
+
+func (x *ToolDescription) GetInputMessageType() string {
+	if x != nil {
+		return x.InputMessageType
+	}
+	return ""
+}
+
+func (x *ToolDescription) GetOutputMessageType() string {
+	if x != nil {
+		return x.OutputMessageType
+	}
+	return ""
+}
+
+func (x *ToolDescription) GetFileDescriptorSet() []byte {
+	if x != nil {
+		return x.FileDescriptorSet
+	}
+	return nil
+}
+
 type ToolExecuteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InputJson     string                 `protobuf:"bytes,1,opt,name=input_json,json=inputJson,proto3" json:"input_json,omitempty"`
@@ -151,7 +332,7 @@ type ToolExecuteRequest struct {
 
 func (x *ToolExecuteRequest) Reset() {
 	*x = ToolExecuteRequest{}
-	mi := &file_tool_proto_msgTypes[2]
+	mi := &file_tool_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -163,7 +344,7 @@ func (x *ToolExecuteRequest) String() string {
 func (*ToolExecuteRequest) ProtoMessage() {}
 
 func (x *ToolExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[2]
+	mi := &file_tool_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +357,7 @@ func (x *ToolExecuteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolExecuteRequest.ProtoReflect.Descriptor instead.
 func (*ToolExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{2}
+	return file_tool_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ToolExecuteRequest) GetInputJson() string {
@@ -203,7 +384,7 @@ type ToolExecuteResponse struct {
 
 func (x *ToolExecuteResponse) Reset() {
 	*x = ToolExecuteResponse{}
-	mi := &file_tool_proto_msgTypes[3]
+	mi := &file_tool_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +396,7 @@ func (x *ToolExecuteResponse) String() string {
 func (*ToolExecuteResponse) ProtoMessage() {}
 
 func (x *ToolExecuteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[3]
+	mi := &file_tool_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +409,7 @@ func (x *ToolExecuteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolExecuteResponse.ProtoReflect.Descriptor instead.
 func (*ToolExecuteResponse) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{3}
+	return file_tool_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ToolExecuteResponse) GetOutputJson() string {
@@ -253,7 +434,7 @@ type ToolHealthRequest struct {
 
 func (x *ToolHealthRequest) Reset() {
 	*x = ToolHealthRequest{}
-	mi := &file_tool_proto_msgTypes[4]
+	mi := &file_tool_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +446,7 @@ func (x *ToolHealthRequest) String() string {
 func (*ToolHealthRequest) ProtoMessage() {}
 
 func (x *ToolHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[4]
+	mi := &file_tool_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +459,7 @@ func (x *ToolHealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolHealthRequest.ProtoReflect.Descriptor instead.
 func (*ToolHealthRequest) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{4}
+	return file_tool_proto_rawDescGZIP(), []int{7}
 }
 
 // Client -> Tool messages for streaming
@@ -295,7 +476,7 @@ type ToolClientMessage struct {
 
 func (x *ToolClientMessage) Reset() {
 	*x = ToolClientMessage{}
-	mi := &file_tool_proto_msgTypes[5]
+	mi := &file_tool_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +488,7 @@ func (x *ToolClientMessage) String() string {
 func (*ToolClientMessage) ProtoMessage() {}
 
 func (x *ToolClientMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[5]
+	mi := &file_tool_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +501,7 @@ func (x *ToolClientMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolClientMessage.ProtoReflect.Descriptor instead.
 func (*ToolClientMessage) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{5}
+	return file_tool_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ToolClientMessage) GetPayload() isToolClientMessage_Payload {
@@ -378,7 +559,7 @@ type ToolStartRequest struct {
 
 func (x *ToolStartRequest) Reset() {
 	*x = ToolStartRequest{}
-	mi := &file_tool_proto_msgTypes[6]
+	mi := &file_tool_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +571,7 @@ func (x *ToolStartRequest) String() string {
 func (*ToolStartRequest) ProtoMessage() {}
 
 func (x *ToolStartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[6]
+	mi := &file_tool_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +584,7 @@ func (x *ToolStartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolStartRequest.ProtoReflect.Descriptor instead.
 func (*ToolStartRequest) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{6}
+	return file_tool_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ToolStartRequest) GetInputJson() string {
@@ -443,7 +624,7 @@ type ToolCancelRequest struct {
 
 func (x *ToolCancelRequest) Reset() {
 	*x = ToolCancelRequest{}
-	mi := &file_tool_proto_msgTypes[7]
+	mi := &file_tool_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +636,7 @@ func (x *ToolCancelRequest) String() string {
 func (*ToolCancelRequest) ProtoMessage() {}
 
 func (x *ToolCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[7]
+	mi := &file_tool_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +649,7 @@ func (x *ToolCancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCancelRequest.ProtoReflect.Descriptor instead.
 func (*ToolCancelRequest) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{7}
+	return file_tool_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ToolCancelRequest) GetReason() string {
@@ -499,7 +680,7 @@ type ToolMessage struct {
 
 func (x *ToolMessage) Reset() {
 	*x = ToolMessage{}
-	mi := &file_tool_proto_msgTypes[8]
+	mi := &file_tool_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -511,7 +692,7 @@ func (x *ToolMessage) String() string {
 func (*ToolMessage) ProtoMessage() {}
 
 func (x *ToolMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[8]
+	mi := &file_tool_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +705,7 @@ func (x *ToolMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolMessage.ProtoReflect.Descriptor instead.
 func (*ToolMessage) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{8}
+	return file_tool_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ToolMessage) GetPayload() isToolMessage_Payload {
@@ -655,7 +836,7 @@ type ToolProgress struct {
 
 func (x *ToolProgress) Reset() {
 	*x = ToolProgress{}
-	mi := &file_tool_proto_msgTypes[9]
+	mi := &file_tool_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +848,7 @@ func (x *ToolProgress) String() string {
 func (*ToolProgress) ProtoMessage() {}
 
 func (x *ToolProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[9]
+	mi := &file_tool_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +861,7 @@ func (x *ToolProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolProgress.ProtoReflect.Descriptor instead.
 func (*ToolProgress) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{9}
+	return file_tool_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ToolProgress) GetPercent() int32 {
@@ -716,7 +897,7 @@ type ToolPartialResult struct {
 
 func (x *ToolPartialResult) Reset() {
 	*x = ToolPartialResult{}
-	mi := &file_tool_proto_msgTypes[10]
+	mi := &file_tool_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -728,7 +909,7 @@ func (x *ToolPartialResult) String() string {
 func (*ToolPartialResult) ProtoMessage() {}
 
 func (x *ToolPartialResult) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[10]
+	mi := &file_tool_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +922,7 @@ func (x *ToolPartialResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolPartialResult.ProtoReflect.Descriptor instead.
 func (*ToolPartialResult) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{10}
+	return file_tool_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ToolPartialResult) GetOutputJson() string {
@@ -770,7 +951,7 @@ type ToolWarning struct {
 
 func (x *ToolWarning) Reset() {
 	*x = ToolWarning{}
-	mi := &file_tool_proto_msgTypes[11]
+	mi := &file_tool_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -782,7 +963,7 @@ func (x *ToolWarning) String() string {
 func (*ToolWarning) ProtoMessage() {}
 
 func (x *ToolWarning) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[11]
+	mi := &file_tool_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,7 +976,7 @@ func (x *ToolWarning) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolWarning.ProtoReflect.Descriptor instead.
 func (*ToolWarning) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{11}
+	return file_tool_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ToolWarning) GetMessage() string {
@@ -822,7 +1003,7 @@ type ToolComplete struct {
 
 func (x *ToolComplete) Reset() {
 	*x = ToolComplete{}
-	mi := &file_tool_proto_msgTypes[12]
+	mi := &file_tool_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -834,7 +1015,7 @@ func (x *ToolComplete) String() string {
 func (*ToolComplete) ProtoMessage() {}
 
 func (x *ToolComplete) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[12]
+	mi := &file_tool_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +1028,7 @@ func (x *ToolComplete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolComplete.ProtoReflect.Descriptor instead.
 func (*ToolComplete) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{12}
+	return file_tool_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ToolComplete) GetOutputJson() string {
@@ -868,7 +1049,7 @@ type ToolError struct {
 
 func (x *ToolError) Reset() {
 	*x = ToolError{}
-	mi := &file_tool_proto_msgTypes[13]
+	mi := &file_tool_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -880,7 +1061,7 @@ func (x *ToolError) String() string {
 func (*ToolError) ProtoMessage() {}
 
 func (x *ToolError) ProtoReflect() protoreflect.Message {
-	mi := &file_tool_proto_msgTypes[13]
+	mi := &file_tool_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,7 +1074,7 @@ func (x *ToolError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolError.ProtoReflect.Descriptor instead.
 func (*ToolError) Descriptor() ([]byte, []int) {
-	return file_tool_proto_rawDescGZIP(), []int{13}
+	return file_tool_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ToolError) GetError() *Error {
@@ -916,14 +1097,27 @@ const file_tool_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"tool.proto\x12\vgibson.tool\x1a\fcommon.proto\"\x1a\n" +
-	"\x18ToolGetDescriptorRequest\"\xf2\x01\n" +
+	"\x18ToolGetDescriptorRequest\"\xa8\x02\n" +
 	"\x0eToolDescriptor\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12\x12\n" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\x12<\n" +
 	"\finput_schema\x18\x05 \x01(\v2\x19.gibson.common.JSONSchemaR\vinputSchema\x12>\n" +
-	"\routput_schema\x18\x06 \x01(\v2\x19.gibson.common.JSONSchemaR\foutputSchema\"R\n" +
+	"\routput_schema\x18\x06 \x01(\v2\x19.gibson.common.JSONSchemaR\foutputSchema\x124\n" +
+	"\bexamples\x18\a \x03(\v2\x18.gibson.tool.ToolExampleR\bexamples\"o\n" +
+	"\vToolExample\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"input_json\x18\x02 \x01(\tR\tinputJson\x12\x1f\n" +
+	"\voutput_json\x18\x03 \x01(\tR\n" +
+	"outputJson\"\x15\n" +
+	"\x13ToolDescribeRequest\"\xd0\x01\n" +
+	"\x0fToolDescription\x12/\n" +
+	"\x04tool\x18\x01 \x01(\v2\x1b.gibson.tool.ToolDescriptorR\x04tool\x12,\n" +
+	"\x12input_message_type\x18\x02 \x01(\tR\x10inputMessageType\x12.\n" +
+	"\x13output_message_type\x18\x03 \x01(\tR\x11outputMessageType\x12.\n" +
+	"\x13file_descriptor_set\x18\x04 \x01(\fR\x11fileDescriptorSet\"R\n" +
 	"\x12ToolExecuteRequest\x12\x1d\n" +
 	"\n" +
 	"input_json\x18\x01 \x01(\tR\tinputJson\x12\x1d\n" +
@@ -975,12 +1169,13 @@ const file_tool_proto_rawDesc = "" +
 	"outputJson\"M\n" +
 	"\tToolError\x12*\n" +
 	"\x05error\x18\x01 \x01(\v2\x14.gibson.common.ErrorR\x05error\x12\x14\n" +
-	"\x05fatal\x18\x02 \x01(\bR\x05fatal2\xc6\x02\n" +
+	"\x05fatal\x18\x02 \x01(\bR\x05fatal2\x96\x03\n" +
 	"\vToolService\x12S\n" +
 	"\rGetDescriptor\x12%.gibson.tool.ToolGetDescriptorRequest\x1a\x1b.gibson.tool.ToolDescriptor\x12L\n" +
 	"\aExecute\x12\x1f.gibson.tool.ToolExecuteRequest\x1a .gibson.tool.ToolExecuteResponse\x12E\n" +
 	"\x06Health\x12\x1e.gibson.tool.ToolHealthRequest\x1a\x1b.gibson.common.HealthStatus\x12M\n" +
-	"\rStreamExecute\x12\x1e.gibson.tool.ToolClientMessage\x1a\x18.gibson.tool.ToolMessage(\x010\x01B*Z(github.com/zero-day-ai/sdk/api/gen/protob\x06proto3"
+	"\rStreamExecute\x12\x1e.gibson.tool.ToolClientMessage\x1a\x18.gibson.tool.ToolMessage(\x010\x01\x12N\n" +
+	"\fDescribeTool\x12 .gibson.tool.ToolDescribeRequest\x1a\x1c.gibson.tool.ToolDescriptionB*Z(github.com/zero-day-ai/sdk/api/gen/protob\x06proto3"
 
 var (
 	file_tool_proto_rawDescOnce sync.Once
@@ -994,51 +1189,58 @@ func file_tool_proto_rawDescGZIP() []byte {
 	return file_tool_proto_rawDescData
 }
 
-var file_tool_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_tool_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_tool_proto_goTypes = []any{
 	(*ToolGetDescriptorRequest)(nil), // 0: gibson.tool.ToolGetDescriptorRequest
 	(*ToolDescriptor)(nil),           // 1: gibson.tool.ToolDescriptor
-	(*ToolExecuteRequest)(nil),       // 2: gibson.tool.ToolExecuteRequest
-	(*ToolExecuteResponse)(nil),      // 3: gibson.tool.ToolExecuteResponse
-	(*ToolHealthRequest)(nil),        // 4: gibson.tool.ToolHealthRequest
-	(*ToolClientMessage)(nil),        // 5: gibson.tool.ToolClientMessage
-	(*ToolStartRequest)(nil),         // 6: gibson.tool.ToolStartRequest
-	(*ToolCancelRequest)(nil),        // 7: gibson.tool.ToolCancelRequest
-	(*ToolMessage)(nil),              // 8: gibson.tool.ToolMessage
-	(*ToolProgress)(nil),             // 9: gibson.tool.ToolProgress
-	(*ToolPartialResult)(nil),        // 10: gibson.tool.ToolPartialResult
-	(*ToolWarning)(nil),              // 11: gibson.tool.ToolWarning
-	(*ToolComplete)(nil),             // 12: gibson.tool.ToolComplete
-	(*ToolError)(nil),                // 13: gibson.tool.ToolError
-	(*JSONSchema)(nil),               // 14: gibson.common.JSONSchema
-	(*Error)(nil),                    // 15: gibson.common.Error
-	(*HealthStatus)(nil),             // 16: gibson.common.HealthStatus
+	(*ToolExample)(nil),              // 2: gibson.tool.ToolExample
+	(*ToolDescribeRequest)(nil),      // 3: gibson.tool.ToolDescribeRequest
+	(*ToolDescription)(nil),          // 4: gibson.tool.ToolDescription
+	(*ToolExecuteRequest)(nil),       // 5: gibson.tool.ToolExecuteRequest
+	(*ToolExecuteResponse)(nil),      // 6: gibson.tool.ToolExecuteResponse
+	(*ToolHealthRequest)(nil),        // 7: gibson.tool.ToolHealthRequest
+	(*ToolClientMessage)(nil),        // 8: gibson.tool.ToolClientMessage
+	(*ToolStartRequest)(nil),         // 9: gibson.tool.ToolStartRequest
+	(*ToolCancelRequest)(nil),        // 10: gibson.tool.ToolCancelRequest
+	(*ToolMessage)(nil),              // 11: gibson.tool.ToolMessage
+	(*ToolProgress)(nil),             // 12: gibson.tool.ToolProgress
+	(*ToolPartialResult)(nil),        // 13: gibson.tool.ToolPartialResult
+	(*ToolWarning)(nil),              // 14: gibson.tool.ToolWarning
+	(*ToolComplete)(nil),             // 15: gibson.tool.ToolComplete
+	(*ToolError)(nil),                // 16: gibson.tool.ToolError
+	(*JSONSchema)(nil),               // 17: gibson.common.JSONSchema
+	(*Error)(nil),                    // 18: gibson.common.Error
+	(*HealthStatus)(nil),             // 19: gibson.common.HealthStatus
 }
 var file_tool_proto_depIdxs = []int32{
-	14, // 0: gibson.tool.ToolDescriptor.input_schema:type_name -> gibson.common.JSONSchema
-	14, // 1: gibson.tool.ToolDescriptor.output_schema:type_name -> gibson.common.JSONSchema
-	15, // 2: gibson.tool.ToolExecuteResponse.error:type_name -> gibson.common.Error
-	6,  // 3: gibson.tool.ToolClientMessage.start:type_name -> gibson.tool.ToolStartRequest
-	7,  // 4: gibson.tool.ToolClientMessage.cancel:type_name -> gibson.tool.ToolCancelRequest
-	9,  // 5: gibson.tool.ToolMessage.progress:type_name -> gibson.tool.ToolProgress
-	10, // 6: gibson.tool.ToolMessage.partial:type_name -> gibson.tool.ToolPartialResult
-	11, // 7: gibson.tool.ToolMessage.warning:type_name -> gibson.tool.ToolWarning
-	12, // 8: gibson.tool.ToolMessage.complete:type_name -> gibson.tool.ToolComplete
-	13, // 9: gibson.tool.ToolMessage.error:type_name -> gibson.tool.ToolError
-	15, // 10: gibson.tool.ToolError.error:type_name -> gibson.common.Error
-	0,  // 11: gibson.tool.ToolService.GetDescriptor:input_type -> gibson.tool.ToolGetDescriptorRequest
-	2,  // 12: gibson.tool.ToolService.Execute:input_type -> gibson.tool.ToolExecuteRequest
-	4,  // 13: gibson.tool.ToolService.Health:input_type -> gibson.tool.ToolHealthRequest
-	5,  // 14: gibson.tool.ToolService.StreamExecute:input_type -> gibson.tool.ToolClientMessage
-	1,  // 15: gibson.tool.ToolService.GetDescriptor:output_type -> gibson.tool.ToolDescriptor
-	3,  // 16: gibson.tool.ToolService.Execute:output_type -> gibson.tool.ToolExecuteResponse
-	16, // 17: gibson.tool.ToolService.Health:output_type -> gibson.common.HealthStatus
-	8,  // 18: gibson.tool.ToolService.StreamExecute:output_type -> gibson.tool.ToolMessage
-	15, // [15:19] is the sub-list for method output_type
-	11, // [11:15] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	17, // 0: gibson.tool.ToolDescriptor.input_schema:type_name -> gibson.common.JSONSchema
+	17, // 1: gibson.tool.ToolDescriptor.output_schema:type_name -> gibson.common.JSONSchema
+	2,  // 2: gibson.tool.ToolDescriptor.examples:type_name -> gibson.tool.ToolExample
+	1,  // 3: gibson.tool.ToolDescription.tool:type_name -> gibson.tool.ToolDescriptor
+	18, // 4: gibson.tool.ToolExecuteResponse.error:type_name -> gibson.common.Error
+	9,  // 5: gibson.tool.ToolClientMessage.start:type_name -> gibson.tool.ToolStartRequest
+	10, // 6: gibson.tool.ToolClientMessage.cancel:type_name -> gibson.tool.ToolCancelRequest
+	12, // 7: gibson.tool.ToolMessage.progress:type_name -> gibson.tool.ToolProgress
+	13, // 8: gibson.tool.ToolMessage.partial:type_name -> gibson.tool.ToolPartialResult
+	14, // 9: gibson.tool.ToolMessage.warning:type_name -> gibson.tool.ToolWarning
+	15, // 10: gibson.tool.ToolMessage.complete:type_name -> gibson.tool.ToolComplete
+	16, // 11: gibson.tool.ToolMessage.error:type_name -> gibson.tool.ToolError
+	18, // 12: gibson.tool.ToolError.error:type_name -> gibson.common.Error
+	0,  // 13: gibson.tool.ToolService.GetDescriptor:input_type -> gibson.tool.ToolGetDescriptorRequest
+	5,  // 14: gibson.tool.ToolService.Execute:input_type -> gibson.tool.ToolExecuteRequest
+	7,  // 15: gibson.tool.ToolService.Health:input_type -> gibson.tool.ToolHealthRequest
+	8,  // 16: gibson.tool.ToolService.StreamExecute:input_type -> gibson.tool.ToolClientMessage
+	3,  // 17: gibson.tool.ToolService.DescribeTool:input_type -> gibson.tool.ToolDescribeRequest
+	1,  // 18: gibson.tool.ToolService.GetDescriptor:output_type -> gibson.tool.ToolDescriptor
+	6,  // 19: gibson.tool.ToolService.Execute:output_type -> gibson.tool.ToolExecuteResponse
+	19, // 20: gibson.tool.ToolService.Health:output_type -> gibson.common.HealthStatus
+	11, // 21: gibson.tool.ToolService.StreamExecute:output_type -> gibson.tool.ToolMessage
+	4,  // 22: gibson.tool.ToolService.DescribeTool:output_type -> gibson.tool.ToolDescription
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_tool_proto_init() }
@@ -1047,11 +1249,11 @@ func file_tool_proto_init() {
 		return
 	}
 	file_common_proto_init()
-	file_tool_proto_msgTypes[5].OneofWrappers = []any{
+	file_tool_proto_msgTypes[8].OneofWrappers = []any{
 		(*ToolClientMessage_Start)(nil),
 		(*ToolClientMessage_Cancel)(nil),
 	}
-	file_tool_proto_msgTypes[8].OneofWrappers = []any{
+	file_tool_proto_msgTypes[11].OneofWrappers = []any{
 		(*ToolMessage_Progress)(nil),
 		(*ToolMessage_Partial)(nil),
 		(*ToolMessage_Warning)(nil),
@@ -1064,7 +1266,7 @@ func file_tool_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tool_proto_rawDesc), len(file_tool_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
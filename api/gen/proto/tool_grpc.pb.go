@@ -23,6 +23,7 @@ const (
 	ToolService_Execute_FullMethodName       = "/gibson.tool.ToolService/Execute"
 	ToolService_Health_FullMethodName        = "/gibson.tool.ToolService/Health"
 	ToolService_StreamExecute_FullMethodName = "/gibson.tool.ToolService/StreamExecute"
+	ToolService_DescribeTool_FullMethodName  = "/gibson.tool.ToolService/DescribeTool"
 )
 
 // ToolServiceClient is the client API for ToolService service.
@@ -33,6 +34,7 @@ type ToolServiceClient interface {
 	Execute(ctx context.Context, in *ToolExecuteRequest, opts ...grpc.CallOption) (*ToolExecuteResponse, error)
 	Health(ctx context.Context, in *ToolHealthRequest, opts ...grpc.CallOption) (*HealthStatus, error)
 	StreamExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ToolClientMessage, ToolMessage], error)
+	DescribeTool(ctx context.Context, in *ToolDescribeRequest, opts ...grpc.CallOption) (*ToolDescription, error)
 }
 
 type toolServiceClient struct {
@@ -86,6 +88,16 @@ func (c *toolServiceClient) StreamExecute(ctx context.Context, opts ...grpc.Call
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ToolService_StreamExecuteClient = grpc.BidiStreamingClient[ToolClientMessage, ToolMessage]
 
+func (c *toolServiceClient) DescribeTool(ctx context.Context, in *ToolDescribeRequest, opts ...grpc.CallOption) (*ToolDescription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToolDescription)
+	err := c.cc.Invoke(ctx, ToolService_DescribeTool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ToolServiceServer is the server API for ToolService service.
 // All implementations must embed UnimplementedToolServiceServer
 // for forward compatibility.
@@ -94,6 +106,7 @@ type ToolServiceServer interface {
 	Execute(context.Context, *ToolExecuteRequest) (*ToolExecuteResponse, error)
 	Health(context.Context, *ToolHealthRequest) (*HealthStatus, error)
 	StreamExecute(grpc.BidiStreamingServer[ToolClientMessage, ToolMessage]) error
+	DescribeTool(context.Context, *ToolDescribeRequest) (*ToolDescription, error)
 	mustEmbedUnimplementedToolServiceServer()
 }
 
@@ -116,6 +129,9 @@ func (UnimplementedToolServiceServer) Health(context.Context, *ToolHealthRequest
 func (UnimplementedToolServiceServer) StreamExecute(grpc.BidiStreamingServer[ToolClientMessage, ToolMessage]) error {
 	return status.Error(codes.Unimplemented, "method StreamExecute not implemented")
 }
+func (UnimplementedToolServiceServer) DescribeTool(context.Context, *ToolDescribeRequest) (*ToolDescription, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeTool not implemented")
+}
 func (UnimplementedToolServiceServer) mustEmbedUnimplementedToolServiceServer() {}
 func (UnimplementedToolServiceServer) testEmbeddedByValue()                     {}
 
@@ -198,6 +214,24 @@ func _ToolService_StreamExecute_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ToolService_StreamExecuteServer = grpc.BidiStreamingServer[ToolClientMessage, ToolMessage]
 
+func _ToolService_DescribeTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToolDescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToolServiceServer).DescribeTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ToolService_DescribeTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToolServiceServer).DescribeTool(ctx, req.(*ToolDescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ToolService_ServiceDesc is the grpc.ServiceDesc for ToolService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +251,10 @@ var ToolService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Health",
 			Handler:    _ToolService_Health_Handler,
 		},
+		{
+			MethodName: "DescribeTool",
+			Handler:    _ToolService_DescribeTool_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -31,6 +31,7 @@ const (
 	ResultStatus_RESULT_STATUS_PARTIAL     ResultStatus = 3
 	ResultStatus_RESULT_STATUS_CANCELLED   ResultStatus = 4
 	ResultStatus_RESULT_STATUS_TIMEOUT     ResultStatus = 5
+	ResultStatus_RESULT_STATUS_REFUSED     ResultStatus = 6
 )
 
 // Enum value maps for ResultStatus.
@@ -42,6 +43,7 @@ var (
 		3: "RESULT_STATUS_PARTIAL",
 		4: "RESULT_STATUS_CANCELLED",
 		5: "RESULT_STATUS_TIMEOUT",
+		6: "RESULT_STATUS_REFUSED",
 	}
 	ResultStatus_value = map[string]int32{
 		"RESULT_STATUS_UNSPECIFIED": 0,
@@ -50,6 +52,7 @@ var (
 		"RESULT_STATUS_PARTIAL":     3,
 		"RESULT_STATUS_CANCELLED":   4,
 		"RESULT_STATUS_TIMEOUT":     5,
+		"RESULT_STATUS_REFUSED":     6,
 	}
 )
 
@@ -312,14 +315,16 @@ func (QueryScope) EnumDescriptor() ([]byte, []int) {
 
 // Task represents a goal-oriented task with context and constraints.
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Goal          string                 `protobuf:"bytes,2,opt,name=goal,proto3" json:"goal,omitempty"`
-	Context       map[string]*TypedValue `protobuf:"bytes,3,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Constraints   *TaskConstraints       `protobuf:"bytes,4,opt,name=constraints,proto3" json:"constraints,omitempty"`
-	Metadata      map[string]*TypedValue `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Goal        string                 `protobuf:"bytes,2,opt,name=goal,proto3" json:"goal,omitempty"`
+	Context     map[string]*TypedValue `protobuf:"bytes,3,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Constraints *TaskConstraints       `protobuf:"bytes,4,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	Metadata    map[string]*TypedValue `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Key identifying retries of the same logical task; empty disables idempotency
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -387,6 +392,13 @@ func (x *Task) GetMetadata() map[string]*TypedValue {
 	return nil
 }
 
+func (x *Task) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // TaskConstraints represents execution constraints for a task.
 type TaskConstraints struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -458,12 +470,14 @@ func (x *TaskConstraints) GetBlockedTools() []string {
 
 // Result represents the outcome of a task execution.
 type Result struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        ResultStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=gibson.types.ResultStatus" json:"status,omitempty"`
-	Output        *TypedValue            `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
-	FindingIds    []string               `protobuf:"bytes,3,rep,name=finding_ids,json=findingIds,proto3" json:"finding_ids,omitempty"`
-	Metadata      map[string]*TypedValue `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Error         *ResultError           `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Status     ResultStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=gibson.types.ResultStatus" json:"status,omitempty"`
+	Output     *TypedValue            `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	FindingIds []string               `protobuf:"bytes,3,rep,name=finding_ids,json=findingIds,proto3" json:"finding_ids,omitempty"`
+	Metadata   map[string]*TypedValue `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error      *ResultError           `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Machine-readable refusal reason, set when status is RESULT_STATUS_REFUSED
+	RefusalReason string `protobuf:"bytes,6,opt,name=refusal_reason,json=refusalReason,proto3" json:"refusal_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -533,6 +547,13 @@ func (x *Result) GetError() *ResultError {
 	return nil
 }
 
+func (x *Result) GetRefusalReason() string {
+	if x != nil {
+		return x.RefusalReason
+	}
+	return ""
+}
+
 // ResultError represents a structured error with retry information.
 type ResultError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -604,33 +625,36 @@ func (x *ResultError) GetRetryable() bool {
 
 // Finding represents a security vulnerability or issue discovered during testing.
 type Finding struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MissionId     string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
-	AgentName     string                 `protobuf:"bytes,3,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
-	DelegatedFrom string                 `protobuf:"bytes,4,opt,name=delegated_from,json=delegatedFrom,proto3" json:"delegated_from,omitempty"`
-	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	Subcategory   string                 `protobuf:"bytes,8,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
-	Severity      FindingSeverity        `protobuf:"varint,9,opt,name=severity,proto3,enum=gibson.types.FindingSeverity" json:"severity,omitempty"`
-	Confidence    float64                `protobuf:"fixed64,10,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	Status        FindingStatus          `protobuf:"varint,11,opt,name=status,proto3,enum=gibson.types.FindingStatus" json:"status,omitempty"`
-	MitreAttack   *MitreMapping          `protobuf:"bytes,12,opt,name=mitre_attack,json=mitreAttack,proto3" json:"mitre_attack,omitempty"`
-	MitreAtlas    *MitreMapping          `protobuf:"bytes,13,opt,name=mitre_atlas,json=mitreAtlas,proto3" json:"mitre_atlas,omitempty"`
-	Evidence      []*Evidence            `protobuf:"bytes,14,rep,name=evidence,proto3" json:"evidence,omitempty"`
-	Reproduction  []*ReproStep           `protobuf:"bytes,15,rep,name=reproduction,proto3" json:"reproduction,omitempty"`
-	CvssScore     float64                `protobuf:"fixed64,16,opt,name=cvss_score,json=cvssScore,proto3" json:"cvss_score,omitempty"`
-	RiskScore     float64                `protobuf:"fixed64,17,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
-	Remediation   string                 `protobuf:"bytes,18,opt,name=remediation,proto3" json:"remediation,omitempty"`
-	References    []string               `protobuf:"bytes,19,rep,name=references,proto3" json:"references,omitempty"`
-	TargetId      string                 `protobuf:"bytes,20,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	Technique     string                 `protobuf:"bytes,21,opt,name=technique,proto3" json:"technique,omitempty"`
-	Tags          []string               `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,23,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp in milliseconds
-	UpdatedAt     int64                  `protobuf:"varint,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp in milliseconds
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MissionId         string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	AgentName         string                 `protobuf:"bytes,3,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
+	DelegatedFrom     string                 `protobuf:"bytes,4,opt,name=delegated_from,json=delegatedFrom,proto3" json:"delegated_from,omitempty"`
+	Title             string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Category          string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Subcategory       string                 `protobuf:"bytes,8,opt,name=subcategory,proto3" json:"subcategory,omitempty"`
+	Severity          FindingSeverity        `protobuf:"varint,9,opt,name=severity,proto3,enum=gibson.types.FindingSeverity" json:"severity,omitempty"`
+	Confidence        float64                `protobuf:"fixed64,10,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Status            FindingStatus          `protobuf:"varint,11,opt,name=status,proto3,enum=gibson.types.FindingStatus" json:"status,omitempty"`
+	MitreAttack       *MitreMapping          `protobuf:"bytes,12,opt,name=mitre_attack,json=mitreAttack,proto3" json:"mitre_attack,omitempty"`
+	MitreAtlas        *MitreMapping          `protobuf:"bytes,13,opt,name=mitre_atlas,json=mitreAtlas,proto3" json:"mitre_atlas,omitempty"`
+	Evidence          []*Evidence            `protobuf:"bytes,14,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Reproduction      []*ReproStep           `protobuf:"bytes,15,rep,name=reproduction,proto3" json:"reproduction,omitempty"`
+	CvssScore         float64                `protobuf:"fixed64,16,opt,name=cvss_score,json=cvssScore,proto3" json:"cvss_score,omitempty"`
+	RiskScore         float64                `protobuf:"fixed64,17,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	Remediation       string                 `protobuf:"bytes,18,opt,name=remediation,proto3" json:"remediation,omitempty"` // Remediation summary, kept for older consumers
+	References        []string               `protobuf:"bytes,19,rep,name=references,proto3" json:"references,omitempty"`
+	TargetId          string                 `protobuf:"bytes,20,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Technique         string                 `protobuf:"bytes,21,opt,name=technique,proto3" json:"technique,omitempty"`
+	Tags              []string               `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt         int64                  `protobuf:"varint,23,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp in milliseconds
+	UpdatedAt         int64                  `protobuf:"varint,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp in milliseconds
+	RemediationDetail *FindingRemediation    `protobuf:"bytes,25,opt,name=remediation_detail,json=remediationDetail,proto3" json:"remediation_detail,omitempty"`
+	Relations         []*FindingRelation     `protobuf:"bytes,26,rep,name=relations,proto3" json:"relations,omitempty"`
+	CvssVector        string                 `protobuf:"bytes,27,opt,name=cvss_vector,json=cvssVector,proto3" json:"cvss_vector,omitempty"` // CVSS v3.1 or v4.0 vector string
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Finding) Reset() {
@@ -831,6 +855,157 @@ func (x *Finding) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Finding) GetRemediationDetail() *FindingRemediation {
+	if x != nil {
+		return x.RemediationDetail
+	}
+	return nil
+}
+
+func (x *Finding) GetRelations() []*FindingRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *Finding) GetCvssVector() string {
+	if x != nil {
+		return x.CvssVector
+	}
+	return ""
+}
+
+// FindingRemediation describes how to fix or mitigate a finding.
+type FindingRemediation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Steps         []string               `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	References    []string               `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+	Effort        string                 `protobuf:"bytes,4,opt,name=effort,proto3" json:"effort,omitempty"` // low, medium, high
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindingRemediation) Reset() {
+	*x = FindingRemediation{}
+	mi := &file_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindingRemediation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindingRemediation) ProtoMessage() {}
+
+func (x *FindingRemediation) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindingRemediation.ProtoReflect.Descriptor instead.
+func (*FindingRemediation) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindingRemediation) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *FindingRemediation) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *FindingRemediation) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *FindingRemediation) GetEffort() string {
+	if x != nil {
+		return x.Effort
+	}
+	return ""
+}
+
+// FindingRelation links a finding to another finding.
+type FindingRelation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // duplicate_of, related_to, superseded_by
+	TargetFindingId string                 `protobuf:"bytes,2,opt,name=target_finding_id,json=targetFindingId,proto3" json:"target_finding_id,omitempty"`
+	Note            string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindingRelation) Reset() {
+	*x = FindingRelation{}
+	mi := &file_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindingRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindingRelation) ProtoMessage() {}
+
+func (x *FindingRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindingRelation.ProtoReflect.Descriptor instead.
+func (*FindingRelation) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindingRelation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FindingRelation) GetTargetFindingId() string {
+	if x != nil {
+		return x.TargetFindingId
+	}
+	return ""
+}
+
+func (x *FindingRelation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 // MitreMapping represents a mapping to MITRE ATT&CK or ATLAS framework.
 type MitreMapping struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -846,7 +1021,7 @@ type MitreMapping struct {
 
 func (x *MitreMapping) Reset() {
 	*x = MitreMapping{}
-	mi := &file_types_proto_msgTypes[5]
+	mi := &file_types_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +1033,7 @@ func (x *MitreMapping) String() string {
 func (*MitreMapping) ProtoMessage() {}
 
 func (x *MitreMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[5]
+	mi := &file_types_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1046,7 @@ func (x *MitreMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MitreMapping.ProtoReflect.Descriptor instead.
 func (*MitreMapping) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{5}
+	return file_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MitreMapping) GetMatrix() string {
@@ -929,7 +1104,7 @@ type Evidence struct {
 
 func (x *Evidence) Reset() {
 	*x = Evidence{}
-	mi := &file_types_proto_msgTypes[6]
+	mi := &file_types_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -941,7 +1116,7 @@ func (x *Evidence) String() string {
 func (*Evidence) ProtoMessage() {}
 
 func (x *Evidence) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[6]
+	mi := &file_types_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,7 +1129,7 @@ func (x *Evidence) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Evidence.ProtoReflect.Descriptor instead.
 func (*Evidence) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{6}
+	return file_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Evidence) GetTitle() string {
@@ -998,7 +1173,7 @@ type ReproStep struct {
 
 func (x *ReproStep) Reset() {
 	*x = ReproStep{}
-	mi := &file_types_proto_msgTypes[7]
+	mi := &file_types_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1010,7 +1185,7 @@ func (x *ReproStep) String() string {
 func (*ReproStep) ProtoMessage() {}
 
 func (x *ReproStep) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[7]
+	mi := &file_types_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1198,7 @@ func (x *ReproStep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReproStep.ProtoReflect.Descriptor instead.
 func (*ReproStep) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{7}
+	return file_types_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReproStep) GetOrder() int32 {
@@ -1068,15 +1243,17 @@ type GraphQuery struct {
 	Scope        QueryScope             `protobuf:"varint,9,opt,name=scope,proto3,enum=gibson.types.QueryScope" json:"scope,omitempty"`
 	Filters      map[string]string      `protobuf:"bytes,10,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Weights for hybrid scoring (must sum to 1.0)
-	VectorWeight  float64 `protobuf:"fixed64,11,opt,name=vector_weight,json=vectorWeight,proto3" json:"vector_weight,omitempty"`
-	GraphWeight   float64 `protobuf:"fixed64,12,opt,name=graph_weight,json=graphWeight,proto3" json:"graph_weight,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	VectorWeight float64 `protobuf:"fixed64,11,opt,name=vector_weight,json=vectorWeight,proto3" json:"vector_weight,omitempty"`
+	GraphWeight  float64 `protobuf:"fixed64,12,opt,name=graph_weight,json=graphWeight,proto3" json:"graph_weight,omitempty"`
+	// Property predicates applied to candidate nodes (AND semantics)
+	PropertyFilters []*PropertyFilter `protobuf:"bytes,13,rep,name=property_filters,json=propertyFilters,proto3" json:"property_filters,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GraphQuery) Reset() {
 	*x = GraphQuery{}
-	mi := &file_types_proto_msgTypes[8]
+	mi := &file_types_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1265,7 @@ func (x *GraphQuery) String() string {
 func (*GraphQuery) ProtoMessage() {}
 
 func (x *GraphQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_types_proto_msgTypes[8]
+	mi := &file_types_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1278,7 @@ func (x *GraphQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphQuery.ProtoReflect.Descriptor instead.
 func (*GraphQuery) Descriptor() ([]byte, []int) {
-	return file_types_proto_rawDescGZIP(), []int{8}
+	return file_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GraphQuery) GetText() string {
@@ -1188,17 +1365,87 @@ func (x *GraphQuery) GetGraphWeight() float64 {
 	return 0
 }
 
+func (x *GraphQuery) GetPropertyFilters() []*PropertyFilter {
+	if x != nil {
+		return x.PropertyFilters
+	}
+	return nil
+}
+
+// PropertyFilter is a predicate on a single node property.
+// Supported operators: eq, gt, gte, lt, lte, contains.
+type PropertyFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Value         *TypedValue            `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyFilter) Reset() {
+	*x = PropertyFilter{}
+	mi := &file_types_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyFilter) ProtoMessage() {}
+
+func (x *PropertyFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_types_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyFilter.ProtoReflect.Descriptor instead.
+func (*PropertyFilter) Descriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PropertyFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PropertyFilter) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *PropertyFilter) GetValue() *TypedValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 var File_types_proto protoreflect.FileDescriptor
 
 const file_types_proto_rawDesc = "" +
 	"\n" +
-	"\vtypes.proto\x12\fgibson.types\x1a\fcommon.proto\"\x93\x03\n" +
+	"\vtypes.proto\x12\fgibson.types\x1a\fcommon.proto\"\xbc\x03\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04goal\x18\x02 \x01(\tR\x04goal\x129\n" +
 	"\acontext\x18\x03 \x03(\v2\x1f.gibson.types.Task.ContextEntryR\acontext\x12?\n" +
 	"\vconstraints\x18\x04 \x01(\v2\x1d.gibson.types.TaskConstraintsR\vconstraints\x12<\n" +
-	"\bmetadata\x18\x05 \x03(\v2 .gibson.types.Task.MetadataEntryR\bmetadata\x1aU\n" +
+	"\bmetadata\x18\x05 \x03(\v2 .gibson.types.Task.MetadataEntryR\bmetadata\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x1aU\n" +
 	"\fContextEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01\x1aV\n" +
@@ -1210,14 +1457,15 @@ const file_types_proto_rawDesc = "" +
 	"\n" +
 	"max_tokens\x18\x02 \x01(\x05R\tmaxTokens\x12#\n" +
 	"\rallowed_tools\x18\x03 \x03(\tR\fallowedTools\x12#\n" +
-	"\rblocked_tools\x18\x04 \x03(\tR\fblockedTools\"\xd9\x02\n" +
+	"\rblocked_tools\x18\x04 \x03(\tR\fblockedTools\"\x80\x03\n" +
 	"\x06Result\x122\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1a.gibson.types.ResultStatusR\x06status\x121\n" +
 	"\x06output\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x06output\x12\x1f\n" +
 	"\vfinding_ids\x18\x03 \x03(\tR\n" +
 	"findingIds\x12>\n" +
 	"\bmetadata\x18\x04 \x03(\v2\".gibson.types.Result.MetadataEntryR\bmetadata\x12/\n" +
-	"\x05error\x18\x05 \x01(\v2\x19.gibson.types.ResultErrorR\x05error\x1aV\n" +
+	"\x05error\x18\x05 \x01(\v2\x19.gibson.types.ResultErrorR\x05error\x12%\n" +
+	"\x0erefusal_reason\x18\x06 \x01(\tR\rrefusalReason\x1aV\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.gibson.common.TypedValueR\x05value:\x028\x01\"\xf1\x01\n" +
@@ -1228,7 +1476,7 @@ const file_types_proto_rawDesc = "" +
 	"\tretryable\x18\x04 \x01(\bR\tretryable\x1a:\n" +
 	"\fDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfe\x06\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xad\b\n" +
 	"\aFinding\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1265,7 +1513,22 @@ const file_types_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x17 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x18 \x01(\x03R\tupdatedAt\"\xd5\x01\n" +
+	"updated_at\x18\x18 \x01(\x03R\tupdatedAt\x12O\n" +
+	"\x12remediation_detail\x18\x19 \x01(\v2 .gibson.types.FindingRemediationR\x11remediationDetail\x12;\n" +
+	"\trelations\x18\x1a \x03(\v2\x1d.gibson.types.FindingRelationR\trelations\x12\x1f\n" +
+	"\vcvss_vector\x18\x1b \x01(\tR\n" +
+	"cvssVector\"|\n" +
+	"\x12FindingRemediation\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12\x14\n" +
+	"\x05steps\x18\x02 \x03(\tR\x05steps\x12\x1e\n" +
+	"\n" +
+	"references\x18\x03 \x03(\tR\n" +
+	"references\x12\x16\n" +
+	"\x06effort\x18\x04 \x01(\tR\x06effort\"e\n" +
+	"\x0fFindingRelation\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12*\n" +
+	"\x11target_finding_id\x18\x02 \x01(\tR\x0ftargetFindingId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xd5\x01\n" +
 	"\fMitreMapping\x12\x16\n" +
 	"\x06matrix\x18\x01 \x01(\tR\x06matrix\x12\x1b\n" +
 	"\ttactic_id\x18\x02 \x01(\tR\btacticId\x12\x1f\n" +
@@ -1286,7 +1549,7 @@ const file_types_proto_rawDesc = "" +
 	"\x05order\x18\x01 \x01(\x05R\x05order\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05input\x18\x03 \x01(\tR\x05input\x12\x16\n" +
-	"\x06output\x18\x04 \x01(\tR\x06output\"\xe6\x03\n" +
+	"\x06output\x18\x04 \x01(\tR\x06output\"\xaf\x04\n" +
 	"\n" +
 	"GraphQuery\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1c\n" +
@@ -1303,17 +1566,23 @@ const file_types_proto_rawDesc = "" +
 	"\afilters\x18\n" +
 	" \x03(\v2%.gibson.types.GraphQuery.FiltersEntryR\afilters\x12#\n" +
 	"\rvector_weight\x18\v \x01(\x01R\fvectorWeight\x12!\n" +
-	"\fgraph_weight\x18\f \x01(\x01R\vgraphWeight\x1a:\n" +
+	"\fgraph_weight\x18\f \x01(\x01R\vgraphWeight\x12G\n" +
+	"\x10property_filters\x18\r \x03(\v2\x1c.gibson.types.PropertyFilterR\x0fpropertyFilters\x1a:\n" +
 	"\fFiltersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xb5\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"c\n" +
+	"\x0ePropertyFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x0e\n" +
+	"\x02op\x18\x02 \x01(\tR\x02op\x12/\n" +
+	"\x05value\x18\x03 \x01(\v2\x19.gibson.common.TypedValueR\x05value*\xd0\x01\n" +
 	"\fResultStatus\x12\x1d\n" +
 	"\x19RESULT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15RESULT_STATUS_SUCCESS\x10\x01\x12\x18\n" +
 	"\x14RESULT_STATUS_FAILED\x10\x02\x12\x19\n" +
 	"\x15RESULT_STATUS_PARTIAL\x10\x03\x12\x1b\n" +
 	"\x17RESULT_STATUS_CANCELLED\x10\x04\x12\x19\n" +
-	"\x15RESULT_STATUS_TIMEOUT\x10\x05*\xbf\x01\n" +
+	"\x15RESULT_STATUS_TIMEOUT\x10\x05\x12\x19\n" +
+	"\x15RESULT_STATUS_REFUSED\x10\x06*\xbf\x01\n" +
 	"\x0fFindingSeverity\x12 \n" +
 	"\x1cFINDING_SEVERITY_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19FINDING_SEVERITY_CRITICAL\x10\x01\x12\x19\n" +
@@ -1355,59 +1624,66 @@ func file_types_proto_rawDescGZIP() []byte {
 }
 
 var file_types_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_types_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_types_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_types_proto_goTypes = []any{
-	(ResultStatus)(0),       // 0: gibson.types.ResultStatus
-	(FindingSeverity)(0),    // 1: gibson.types.FindingSeverity
-	(FindingStatus)(0),      // 2: gibson.types.FindingStatus
-	(EvidenceType)(0),       // 3: gibson.types.EvidenceType
-	(QueryScope)(0),         // 4: gibson.types.QueryScope
-	(*Task)(nil),            // 5: gibson.types.Task
-	(*TaskConstraints)(nil), // 6: gibson.types.TaskConstraints
-	(*Result)(nil),          // 7: gibson.types.Result
-	(*ResultError)(nil),     // 8: gibson.types.ResultError
-	(*Finding)(nil),         // 9: gibson.types.Finding
-	(*MitreMapping)(nil),    // 10: gibson.types.MitreMapping
-	(*Evidence)(nil),        // 11: gibson.types.Evidence
-	(*ReproStep)(nil),       // 12: gibson.types.ReproStep
-	(*GraphQuery)(nil),      // 13: gibson.types.GraphQuery
-	nil,                     // 14: gibson.types.Task.ContextEntry
-	nil,                     // 15: gibson.types.Task.MetadataEntry
-	nil,                     // 16: gibson.types.Result.MetadataEntry
-	nil,                     // 17: gibson.types.ResultError.DetailsEntry
-	nil,                     // 18: gibson.types.Evidence.MetadataEntry
-	nil,                     // 19: gibson.types.GraphQuery.FiltersEntry
-	(*TypedValue)(nil),      // 20: gibson.common.TypedValue
-	(ErrorCode)(0),          // 21: gibson.common.ErrorCode
+	(ResultStatus)(0),          // 0: gibson.types.ResultStatus
+	(FindingSeverity)(0),       // 1: gibson.types.FindingSeverity
+	(FindingStatus)(0),         // 2: gibson.types.FindingStatus
+	(EvidenceType)(0),          // 3: gibson.types.EvidenceType
+	(QueryScope)(0),            // 4: gibson.types.QueryScope
+	(*Task)(nil),               // 5: gibson.types.Task
+	(*TaskConstraints)(nil),    // 6: gibson.types.TaskConstraints
+	(*Result)(nil),             // 7: gibson.types.Result
+	(*ResultError)(nil),        // 8: gibson.types.ResultError
+	(*Finding)(nil),            // 9: gibson.types.Finding
+	(*FindingRemediation)(nil), // 10: gibson.types.FindingRemediation
+	(*FindingRelation)(nil),    // 11: gibson.types.FindingRelation
+	(*MitreMapping)(nil),       // 12: gibson.types.MitreMapping
+	(*Evidence)(nil),           // 13: gibson.types.Evidence
+	(*ReproStep)(nil),          // 14: gibson.types.ReproStep
+	(*GraphQuery)(nil),         // 15: gibson.types.GraphQuery
+	(*PropertyFilter)(nil),     // 16: gibson.types.PropertyFilter
+	nil,                        // 17: gibson.types.Task.ContextEntry
+	nil,                        // 18: gibson.types.Task.MetadataEntry
+	nil,                        // 19: gibson.types.Result.MetadataEntry
+	nil,                        // 20: gibson.types.ResultError.DetailsEntry
+	nil,                        // 21: gibson.types.Evidence.MetadataEntry
+	nil,                        // 22: gibson.types.GraphQuery.FiltersEntry
+	(*TypedValue)(nil),         // 23: gibson.common.TypedValue
+	(ErrorCode)(0),             // 24: gibson.common.ErrorCode
 }
 var file_types_proto_depIdxs = []int32{
-	14, // 0: gibson.types.Task.context:type_name -> gibson.types.Task.ContextEntry
+	17, // 0: gibson.types.Task.context:type_name -> gibson.types.Task.ContextEntry
 	6,  // 1: gibson.types.Task.constraints:type_name -> gibson.types.TaskConstraints
-	15, // 2: gibson.types.Task.metadata:type_name -> gibson.types.Task.MetadataEntry
+	18, // 2: gibson.types.Task.metadata:type_name -> gibson.types.Task.MetadataEntry
 	0,  // 3: gibson.types.Result.status:type_name -> gibson.types.ResultStatus
-	20, // 4: gibson.types.Result.output:type_name -> gibson.common.TypedValue
-	16, // 5: gibson.types.Result.metadata:type_name -> gibson.types.Result.MetadataEntry
+	23, // 4: gibson.types.Result.output:type_name -> gibson.common.TypedValue
+	19, // 5: gibson.types.Result.metadata:type_name -> gibson.types.Result.MetadataEntry
 	8,  // 6: gibson.types.Result.error:type_name -> gibson.types.ResultError
-	21, // 7: gibson.types.ResultError.code:type_name -> gibson.common.ErrorCode
-	17, // 8: gibson.types.ResultError.details:type_name -> gibson.types.ResultError.DetailsEntry
+	24, // 7: gibson.types.ResultError.code:type_name -> gibson.common.ErrorCode
+	20, // 8: gibson.types.ResultError.details:type_name -> gibson.types.ResultError.DetailsEntry
 	1,  // 9: gibson.types.Finding.severity:type_name -> gibson.types.FindingSeverity
 	2,  // 10: gibson.types.Finding.status:type_name -> gibson.types.FindingStatus
-	10, // 11: gibson.types.Finding.mitre_attack:type_name -> gibson.types.MitreMapping
-	10, // 12: gibson.types.Finding.mitre_atlas:type_name -> gibson.types.MitreMapping
-	11, // 13: gibson.types.Finding.evidence:type_name -> gibson.types.Evidence
-	12, // 14: gibson.types.Finding.reproduction:type_name -> gibson.types.ReproStep
-	3,  // 15: gibson.types.Evidence.type:type_name -> gibson.types.EvidenceType
-	18, // 16: gibson.types.Evidence.metadata:type_name -> gibson.types.Evidence.MetadataEntry
-	4,  // 17: gibson.types.GraphQuery.scope:type_name -> gibson.types.QueryScope
-	19, // 18: gibson.types.GraphQuery.filters:type_name -> gibson.types.GraphQuery.FiltersEntry
-	20, // 19: gibson.types.Task.ContextEntry.value:type_name -> gibson.common.TypedValue
-	20, // 20: gibson.types.Task.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	20, // 21: gibson.types.Result.MetadataEntry.value:type_name -> gibson.common.TypedValue
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	12, // 11: gibson.types.Finding.mitre_attack:type_name -> gibson.types.MitreMapping
+	12, // 12: gibson.types.Finding.mitre_atlas:type_name -> gibson.types.MitreMapping
+	13, // 13: gibson.types.Finding.evidence:type_name -> gibson.types.Evidence
+	14, // 14: gibson.types.Finding.reproduction:type_name -> gibson.types.ReproStep
+	10, // 15: gibson.types.Finding.remediation_detail:type_name -> gibson.types.FindingRemediation
+	11, // 16: gibson.types.Finding.relations:type_name -> gibson.types.FindingRelation
+	3,  // 17: gibson.types.Evidence.type:type_name -> gibson.types.EvidenceType
+	21, // 18: gibson.types.Evidence.metadata:type_name -> gibson.types.Evidence.MetadataEntry
+	4,  // 19: gibson.types.GraphQuery.scope:type_name -> gibson.types.QueryScope
+	22, // 20: gibson.types.GraphQuery.filters:type_name -> gibson.types.GraphQuery.FiltersEntry
+	16, // 21: gibson.types.GraphQuery.property_filters:type_name -> gibson.types.PropertyFilter
+	23, // 22: gibson.types.PropertyFilter.value:type_name -> gibson.common.TypedValue
+	23, // 23: gibson.types.Task.ContextEntry.value:type_name -> gibson.common.TypedValue
+	23, // 24: gibson.types.Task.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	23, // 25: gibson.types.Result.MetadataEntry.value:type_name -> gibson.common.TypedValue
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_types_proto_init() }
@@ -1422,7 +1698,7 @@ func file_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_proto_rawDesc), len(file_types_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
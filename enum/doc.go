@@ -32,6 +32,18 @@
 //	normalized := enum.Normalize("nmap", input)
 //	// Result: {"scan_type": "SYN_SCAN", "target": "example.com"}
 //
+// # Listing and Suggestions
+//
+// The registry can be inspected to build help text and validation messages:
+//
+//	enum.Tools()                                // ["nmap", ...]
+//	enum.Fields("nmap")                         // ["scan_type", "timing"]
+//	enum.Values("nmap", "scan_type")            // {"syn": "SYN_SCAN", "udp": "UDP_SCAN"}
+//	enum.Suggest("nmap", "scan_type", "sny")    // ["syn"]
+//
+// Suggest ranks registered shorthands by edit distance to the input, making it
+// suitable for "did you mean" hints when a value is not recognized.
+//
 // # Thread Safety
 //
 // All operations are thread-safe and can be called concurrently from multiple
@@ -2,10 +2,14 @@ package enum
 
 import (
 	"encoding/json"
+	"sort"
 	"strings"
 	"sync"
 )
 
+// maxSuggestions is the maximum number of shorthands returned by Suggest.
+const maxSuggestions = 3
+
 // registry is the global enum mapping registry
 var (
 	registry = make(map[string]map[string]map[string]string)
@@ -140,6 +144,168 @@ func GetMappings(toolName string) map[string]map[string]string {
 	return result
 }
 
+// Values returns the shorthand to proto enum name mappings for a tool field.
+// Shorthand keys are lowercase, as stored by Register.
+// The returned map is a copy; modifying it does not affect the registry.
+// Returns nil if the tool or field has no registered mappings.
+func Values(toolName, fieldName string) map[string]string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	fieldMappings, exists := registry[toolName][fieldName]
+	if !exists {
+		return nil
+	}
+
+	result := make(map[string]string, len(fieldMappings))
+	for shortValue, protoName := range fieldMappings {
+		result[shortValue] = protoName
+	}
+
+	return result
+}
+
+// Fields returns the sorted names of all fields with registered mappings for a tool.
+// Returns nil if the tool has no registered mappings.
+func Fields(toolName string) []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	toolMappings, exists := registry[toolName]
+	if !exists {
+		return nil
+	}
+
+	fields := make([]string, 0, len(toolMappings))
+	for fieldName := range toolMappings {
+		fields = append(fields, fieldName)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// Tools returns the sorted names of all tools with registered mappings.
+func Tools() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	tools := make([]string, 0, len(registry))
+	for toolName := range registry {
+		tools = append(tools, toolName)
+	}
+	sort.Strings(tools)
+
+	return tools
+}
+
+// Suggest returns the registered shorthands for a tool field that most closely
+// resemble input, for use in error messages such as "did you mean ...?".
+//
+// Candidates are ranked by case-insensitive edit distance (insertions, deletions,
+// substitutions, and adjacent transpositions each cost 1). A shorthand is
+// suggested when its distance is at most a third of its length (minimum 1), or
+// when it starts with input. At most three suggestions are returned, closest
+// first, with ties broken alphabetically. Returns nil if nothing is close.
+//
+// Example:
+//
+//	enum.Suggest("nmap", "scan_type", "conect") // ["connect"]
+func Suggest(toolName, fieldName, input string) []string {
+	needle := strings.ToLower(strings.TrimSpace(input))
+	if needle == "" {
+		return nil
+	}
+
+	mu.RLock()
+	fieldMappings := registry[toolName][fieldName]
+	candidates := make([]string, 0, len(fieldMappings))
+	for shortValue := range fieldMappings {
+		candidates = append(candidates, shortValue)
+	}
+	mu.RUnlock()
+
+	type scored struct {
+		value    string
+		distance int
+	}
+
+	var matches []scored
+	for _, candidate := range candidates {
+		if candidate == needle {
+			// Exact matches are valid values, not suggestions
+			continue
+		}
+
+		distance := editDistance(needle, candidate)
+		threshold := max(1, len(candidate)/3)
+		if distance <= threshold || (len(needle) >= 2 && strings.HasPrefix(candidate, needle)) {
+			matches = append(matches, scored{value: candidate, distance: distance})
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].value < matches[j].value
+	})
+
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+
+	result := make([]string, len(matches))
+	for i, m := range matches {
+		result[i] = m.value
+	}
+
+	return result
+}
+
+// editDistance computes the optimal string alignment distance between a and b:
+// the Levenshtein distance extended with adjacent transpositions, which are the
+// most common typing mistake.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Three rolling rows: two back (for transpositions), previous, and current
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(
+				prev[j]+1,      // deletion
+				curr[j-1]+1,    // insertion
+				prev[j-1]+cost, // substitution
+			)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1) // transposition
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(rb)]
+}
+
 // Clear resets the entire enum registry.
 // This is primarily useful for testing.
 func Clear() {
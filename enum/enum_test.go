@@ -886,3 +886,214 @@ func TestNormalizeTypedMapCaseInsensitive(t *testing.T) {
 		})
 	}
 }
+
+func TestValues(t *testing.T) {
+	Clear()
+
+	Register("nmap", "scan_type", map[string]string{
+		"SYN": "SYN_SCAN",
+		"udp": "UDP_SCAN",
+	})
+
+	values := Values("nmap", "scan_type")
+	expected := map[string]string{
+		"syn": "SYN_SCAN",
+		"udp": "UDP_SCAN",
+	}
+	if len(values) != len(expected) {
+		t.Fatalf("Expected %d values, got %d", len(expected), len(values))
+	}
+	for shortValue, protoName := range expected {
+		if values[shortValue] != protoName {
+			t.Errorf("For '%s': expected '%s', got '%s'", shortValue, protoName, values[shortValue])
+		}
+	}
+
+	// Modifying the returned map must not affect the registry
+	values["tcp"] = "TCP_SCAN"
+	delete(values, "syn")
+	fresh := Values("nmap", "scan_type")
+	if _, found := fresh["tcp"]; found {
+		t.Error("Values returned a reference to the registry, not a copy")
+	}
+	if fresh["syn"] != "SYN_SCAN" {
+		t.Error("Deleting from returned map affected the registry")
+	}
+
+	if Values("nmap", "unknown") != nil {
+		t.Error("Expected nil for unknown field")
+	}
+	if Values("unknown", "scan_type") != nil {
+		t.Error("Expected nil for unknown tool")
+	}
+}
+
+func TestFieldsAndTools(t *testing.T) {
+	Clear()
+
+	if tools := Tools(); len(tools) != 0 {
+		t.Errorf("Expected no tools after Clear, got %v", tools)
+	}
+
+	RegisterBatch("nmap", map[string]map[string]string{
+		"timing":    {"fast": "TIMING_FAST"},
+		"scan_type": {"syn": "SYN_SCAN"},
+	})
+	Register("httpx", "method", map[string]string{"get": "METHOD_GET"})
+
+	fields := Fields("nmap")
+	if len(fields) != 2 || fields[0] != "scan_type" || fields[1] != "timing" {
+		t.Errorf("Expected sorted fields [scan_type timing], got %v", fields)
+	}
+
+	if Fields("unknown") != nil {
+		t.Error("Expected nil fields for unknown tool")
+	}
+
+	tools := Tools()
+	if len(tools) != 2 || tools[0] != "httpx" || tools[1] != "nmap" {
+		t.Errorf("Expected sorted tools [httpx nmap], got %v", tools)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	Clear()
+
+	Register("nmap", "scan_type", map[string]string{
+		"syn":     "SYN_SCAN",
+		"ack":     "ACK_SCAN",
+		"connect": "CONNECT_SCAN",
+		"udp":     "UDP_SCAN",
+		"fin":     "FIN_SCAN",
+		"xmas":    "XMAS_SCAN",
+	})
+	Register("nmap", "timing", map[string]string{
+		"paranoid":   "TIMING_PARANOID",
+		"sneaky":     "TIMING_SNEAKY",
+		"polite":     "TIMING_POLITE",
+		"normal":     "TIMING_NORMAL",
+		"aggressive": "TIMING_AGGRESSIVE",
+		"insane":     "TIMING_INSANE",
+	})
+
+	tests := []struct {
+		name     string
+		field    string
+		input    string
+		expected []string
+	}{
+		{name: "transposition", field: "scan_type", input: "sny", expected: []string{"syn"}},
+		{name: "missing letter", field: "scan_type", input: "conect", expected: []string{"connect"}},
+		{name: "extra letter", field: "scan_type", input: "connnect", expected: []string{"connect"}},
+		{name: "wrong case", field: "scan_type", input: "Conect", expected: []string{"connect"}},
+		{name: "prefix", field: "scan_type", input: "conn", expected: []string{"connect"}},
+		{name: "substitution", field: "scan_type", input: "udb", expected: []string{"udp"}},
+		{name: "doubled consonant", field: "timing", input: "agressive", expected: []string{"aggressive"}},
+		{name: "misspelling", field: "timing", input: "paranod", expected: []string{"paranoid"}},
+		{name: "transposed vowels", field: "timing", input: "insnae", expected: []string{"insane"}},
+		{name: "no close match", field: "timing", input: "turbo", expected: nil},
+		{name: "exact match is not a suggestion", field: "scan_type", input: "syn", expected: nil},
+		{name: "empty input", field: "scan_type", input: "", expected: nil},
+		{name: "unknown field", field: "unknown", input: "syn", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Suggest("nmap", tt.field, tt.input)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Suggest(%q): expected %v, got %v", tt.input, tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("Suggest(%q)[%d]: expected %q, got %q", tt.input, i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSuggestRankingAndLimit(t *testing.T) {
+	Clear()
+
+	Register("tool", "mode", map[string]string{
+		"scan":  "MODE_SCAN",
+		"scans": "MODE_SCANS",
+		"scant": "MODE_SCANT",
+		"scar":  "MODE_SCAR",
+		"scat":  "MODE_SCAT",
+	})
+
+	got := Suggest("tool", "mode", "sca")
+	if len(got) != maxSuggestions {
+		t.Fatalf("Expected %d suggestions, got %v", maxSuggestions, got)
+	}
+	// Closest first, ties broken alphabetically
+	expected := []string{"scan", "scar", "scat"}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Position %d: expected %q, got %q (all: %v)", i, expected[i], got[i], got)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"syn", "syn", 0},
+		{"", "abc", 3},
+		{"sny", "syn", 1},
+		{"conect", "connect", 1},
+		{"kitten", "sitting", 3},
+		{"ca", "abc", 3},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.expected {
+			t.Errorf("editDistance(%q, %q): expected %d, got %d", tt.a, tt.b, tt.expected, got)
+		}
+	}
+}
+
+func TestConcurrentRegisterAndRead(t *testing.T) {
+	Clear()
+
+	var wg sync.WaitGroup
+	numGoroutines := 20
+	numOps := 100
+
+	for i := 0; i < numGoroutines; i++ {
+		wg.Add(2)
+
+		go func(id int) {
+			defer wg.Done()
+			for j := 0; j < numOps; j++ {
+				Register("nmap", "scan_type", map[string]string{
+					"syn": "SYN_SCAN",
+					"udp": "UDP_SCAN",
+				})
+				Register("tool", "field", map[string]string{
+					"value": "VALUE",
+				})
+			}
+		}(i)
+
+		go func(id int) {
+			defer wg.Done()
+			for j := 0; j < numOps; j++ {
+				_ = Values("nmap", "scan_type")
+				_ = Fields("nmap")
+				_ = Tools()
+				_ = Suggest("nmap", "scan_type", "sny")
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if got := Suggest("nmap", "scan_type", "sny"); len(got) != 1 || got[0] != "syn" {
+		t.Errorf("Expected [syn] after concurrent registration, got %v", got)
+	}
+}
@@ -0,0 +1,105 @@
+package eval
+
+import (
+	"context"
+
+	"github.com/zero-day-ai/sdk/agent"
+)
+
+// WithBaselineScoring enables baseline-relative scoring. When a sample has a
+// BaselineResult or BaselineTrajectory, Score additionally runs every scorer
+// on the baseline and reports the difference in Result.Improvement, so an
+// agent that merely matches a do-nothing baseline scores zero. This
+// normalizes for task difficulty across a suite that mixes easy and hard
+// samples. The overall score is unaffected.
+//
+// Example:
+//
+//	sample.BaselineResult = &baselineResult
+//	sample.BaselineTrajectory = &baselineTrajectory
+//	result := e.WithBaselineScoring().Score(sample, scorers...)
+//	e.RequireImprovement(result, 0.2)
+func (e *E) WithBaselineScoring() *E {
+	e.baselineScoring = true
+	return e
+}
+
+// RequireImprovement fails the test if the sample's improvement over its
+// baseline is below min. Results without a baseline score, and skipped and
+// expected-failure results, never fail the test.
+//
+// Example:
+//
+//	result := e.Score(sample, scorers...)
+//	e.RequireImprovement(result, 0.1) // Fails test unless the agent beats the baseline by 0.1
+func (e *E) RequireImprovement(result Result, min float64) {
+	if result.Improvement == nil {
+		return
+	}
+	switch result.Status {
+	case SampleStatusSkipped, SampleStatusExpectedFailure, SampleStatusUnexpectedPass:
+		return
+	}
+
+	if *result.Improvement < min {
+		e.T.Errorf("Improvement %+.3f over baseline below %+.3f for sample %s (score %.3f, baseline %.3f)",
+			*result.Improvement, min, result.SampleID, result.OverallScore, result.BaselineScore)
+	}
+}
+
+// scoreBaseline runs every scorer on the sample's baseline and records the
+// baseline scores and the improvement over them in result. It does nothing
+// if the sample has no baseline.
+func (e *E) scoreBaseline(ctx context.Context, sample Sample, scorers []Scorer, result *Result) {
+	baseline, ok := baselineSample(sample)
+	if !ok {
+		return
+	}
+
+	opts := e.scoreOptions()
+	scores := make(map[string]ScoreResult, len(scorers))
+	var total float64
+	var count int
+	for _, scorer := range scorers {
+		scoreResult := runScorer(ctx, scorer, baseline, opts)
+		scores[scorer.Name()] = scoreResult
+		if scoreResult.Status == ScorerStatusErrored {
+			e.T.Logf("Scorer %s failed on baseline: %s", scorer.Name(), scoreResult.Error)
+			if opts.ErroredAsZero {
+				count++
+			}
+			continue
+		}
+		total += scoreResult.Score
+		count++
+	}
+
+	result.BaselineScores = scores
+	if count > 0 {
+		result.BaselineScore = total / float64(count)
+	}
+	improvement := result.OverallScore - result.BaselineScore
+	result.Improvement = &improvement
+}
+
+// baselineSample returns a copy of sample with the baseline's result and
+// trajectory in place of the agent's, and false if the sample has no
+// baseline.
+func baselineSample(sample Sample) (Sample, bool) {
+	if sample.BaselineResult == nil && sample.BaselineTrajectory == nil {
+		return sample, false
+	}
+
+	baseline := sample
+	baseline.Result = agent.Result{}
+	if sample.BaselineResult != nil {
+		baseline.Result = *sample.BaselineResult
+	}
+	baseline.Trajectory = Trajectory{}
+	if sample.BaselineTrajectory != nil {
+		baseline.Trajectory = *sample.BaselineTrajectory
+	}
+	baseline.BaselineResult = nil
+	baseline.BaselineTrajectory = nil
+	return baseline, true
+}
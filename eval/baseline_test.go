@@ -0,0 +1,108 @@
+package eval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+)
+
+// statusScorer scores 1.0 for successful results and 0.2 otherwise.
+type statusScorer struct{}
+
+func (statusScorer) Name() string { return "status" }
+
+func (statusScorer) Score(_ context.Context, sample Sample) (ScoreResult, error) {
+	if sample.Result.Status == agent.StatusSuccess {
+		return ScoreResult{Score: 1.0}, nil
+	}
+	return ScoreResult{Score: 0.2}, nil
+}
+
+func TestEScoreBaseline(t *testing.T) {
+	failed := agent.NewFailedResult(assert.AnError)
+	e := (&E{T: t}).WithBaselineScoring()
+
+	better := e.Score(Sample{
+		ID:             "better",
+		Result:         agent.NewSuccessResult("done"),
+		BaselineResult: &failed,
+	}, statusScorer{})
+	assert.InDelta(t, 1.0, better.OverallScore, 1e-9)
+	assert.InDelta(t, 0.2, better.BaselineScore, 1e-9)
+	require.NotNil(t, better.Improvement)
+	assert.InDelta(t, 0.8, *better.Improvement, 1e-9)
+	assert.InDelta(t, 0.2, better.BaselineScores["status"].Score, 1e-9)
+
+	same := e.Score(Sample{
+		ID:                 "same",
+		Result:             failed,
+		BaselineResult:     &failed,
+		BaselineTrajectory: &Trajectory{},
+	}, statusScorer{})
+	require.NotNil(t, same.Improvement)
+	assert.Zero(t, *same.Improvement, "matching the baseline is no improvement")
+
+	none := e.Score(Sample{ID: "none", Result: agent.NewSuccessResult("done")}, statusScorer{})
+	assert.Nil(t, none.Improvement, "samples without a baseline have no improvement")
+	assert.Nil(t, none.BaselineScores)
+
+	summary := e.Summary()
+	assert.Equal(t, 3, summary.Samples)
+	assert.Equal(t, 2, summary.BaselineSamples)
+	assert.InDelta(t, 0.4, summary.MeanImprovement, 1e-9)
+}
+
+func TestEScoreBaseline_Disabled(t *testing.T) {
+	failed := agent.NewFailedResult(assert.AnError)
+	result := (&E{T: t}).Score(Sample{
+		ID:             "s1",
+		Result:         agent.NewSuccessResult("done"),
+		BaselineResult: &failed,
+	}, statusScorer{})
+	assert.Nil(t, result.Improvement)
+	assert.Nil(t, result.BaselineScores)
+}
+
+func TestERequireImprovement(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	e := &E{T: tb}
+
+	improvement := 0.05
+	e.RequireImprovement(Result{SampleID: "small", Improvement: &improvement}, 0.1)
+	require.Len(t, tb.errors, 1)
+	assert.Contains(t, tb.errors[0], "small")
+
+	e.RequireImprovement(Result{SampleID: "none"}, 0.1)
+	e.RequireImprovement(Result{SampleID: "xfail", Improvement: &improvement, Status: SampleStatusExpectedFailure}, 0.1)
+	assert.Len(t, tb.errors, 1)
+}
+
+func TestJSONLLogger_Baseline(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "eval.jsonl")
+	logger, err := NewJSONLLogger(logPath)
+	require.NoError(t, err)
+
+	improvement := 0.0
+	require.NoError(t, logger.Log(Sample{ID: "s1"}, Result{SampleID: "s1", OverallScore: 0.2, Improvement: &improvement}))
+	require.NoError(t, logger.Log(Sample{ID: "s2"}, Result{SampleID: "s2", OverallScore: 0.2}))
+	require.NoError(t, logger.Close())
+
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var withBaseline, without map[string]any
+	require.NoError(t, json.Unmarshal(lines[0], &withBaseline))
+	require.NoError(t, json.Unmarshal(lines[1], &without))
+	assert.Equal(t, 0.0, withBaseline["improvement"], "zero improvement is still logged")
+	assert.Equal(t, 0.0, withBaseline["baseline_score"])
+	assert.NotContains(t, without, "improvement")
+}
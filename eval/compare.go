@@ -0,0 +1,302 @@
+package eval
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// Comparison defaults.
+const (
+	defaultCompareResamples       = 1000
+	defaultCompareConfidenceLevel = 0.95
+)
+
+// CompareOptions configures Compare.
+type CompareOptions struct {
+	// Threshold is the drop in a scorer's mean score that counts as a
+	// regression. Zero means any drop.
+	Threshold float64
+
+	// Resamples is the number of bootstrap resamples used for confidence
+	// intervals (default: 1000).
+	Resamples int
+
+	// ConfidenceLevel is the confidence level of the intervals, in (0, 1)
+	// (default: 0.95).
+	ConfidenceLevel float64
+
+	// Seed seeds the bootstrap's random number generator. The same inputs
+	// and seed always produce the same report.
+	Seed uint64
+}
+
+// ComparisonReport compares the scores of a run against a baseline run.
+type ComparisonReport struct {
+	// Scorers holds one comparison per scorer present in both runs, sorted
+	// by scorer name.
+	Scorers []ScorerComparison `json:"scorers"`
+
+	// Regressions holds the comparisons whose score dropped by more than
+	// the threshold. Check Significant to tell them from noise.
+	Regressions []ScorerComparison `json:"regressions,omitempty"`
+
+	// OnlyInBaseline lists scorers that appear only in the baseline.
+	OnlyInBaseline []string `json:"only_in_baseline,omitempty"`
+
+	// OnlyInCurrent lists scorers that appear only in the current run.
+	OnlyInCurrent []string `json:"only_in_current,omitempty"`
+}
+
+// ScorerComparison is the change in one scorer's mean score.
+type ScorerComparison struct {
+	// Scorer is the scorer name.
+	Scorer string `json:"scorer"`
+
+	// Paired is true if the comparison is over samples present in both
+	// runs, matched by SampleID.
+	Paired bool `json:"paired"`
+
+	// BaselineCount and CurrentCount are the number of scores compared
+	// from each run. They are equal for a paired comparison.
+	BaselineCount int `json:"baseline_count"`
+	CurrentCount  int `json:"current_count"`
+
+	// BaselineMean and CurrentMean are the mean scores of the compared
+	// samples.
+	BaselineMean float64 `json:"baseline_mean"`
+	CurrentMean  float64 `json:"current_mean"`
+
+	// Delta is CurrentMean minus BaselineMean; negative is a drop.
+	Delta float64 `json:"delta"`
+
+	// CILow and CIHigh bound the bootstrap confidence interval of Delta at
+	// ConfidenceLevel.
+	CILow           float64 `json:"ci_low"`
+	CIHigh          float64 `json:"ci_high"`
+	ConfidenceLevel float64 `json:"confidence_level"`
+
+	// Significant is true if the confidence interval excludes zero. It is
+	// always false with fewer than two scores in either run.
+	Significant bool `json:"significant"`
+}
+
+// SignificantRegressions returns the regressions whose drop is both larger
+// than the threshold and statistically significant.
+func (r ComparisonReport) SignificantRegressions() []ScorerComparison {
+	var regressions []ScorerComparison
+	for _, c := range r.Regressions {
+		if c.Significant {
+			regressions = append(regressions, c)
+		}
+	}
+	return regressions
+}
+
+// Compare compares the per-scorer scores of current against baseline, for
+// example to gate CI on regressions. For each scorer it estimates the change
+// in mean score with a bootstrap confidence interval. When at least two
+// samples with the same SampleID were scored in both runs, the comparison is
+// paired: it resamples the per-sample score differences of those samples,
+// which removes the variation between samples and detects smaller changes.
+// Otherwise the two runs are resampled independently. Skipped samples and
+// errored scores are ignored.
+//
+// Example:
+//
+//	report := eval.Compare(baseline, current, eval.CompareOptions{Threshold: 0.02, Seed: 1})
+//	for _, r := range report.SignificantRegressions() {
+//	    t.Errorf("%s dropped %.3f (%.0f%% CI [%.3f, %.3f])",
+//	        r.Scorer, -r.Delta, r.ConfidenceLevel*100, r.CILow, r.CIHigh)
+//	}
+func Compare(baseline, current []Result, opts CompareOptions) ComparisonReport {
+	if opts.Resamples <= 0 {
+		opts.Resamples = defaultCompareResamples
+	}
+	if opts.ConfidenceLevel <= 0 || opts.ConfidenceLevel >= 1 {
+		opts.ConfidenceLevel = defaultCompareConfidenceLevel
+	}
+
+	baseScores := scoresBySample(baseline)
+	curScores := scoresBySample(current)
+
+	var report ComparisonReport
+	for _, name := range sortedKeys(baseScores) {
+		if _, ok := curScores[name]; !ok {
+			report.OnlyInBaseline = append(report.OnlyInBaseline, name)
+		}
+	}
+	for _, name := range sortedKeys(curScores) {
+		base, ok := baseScores[name]
+		if !ok {
+			report.OnlyInCurrent = append(report.OnlyInCurrent, name)
+			continue
+		}
+
+		c := compareScorer(name, base, curScores[name], opts)
+		report.Scorers = append(report.Scorers, c)
+		if c.Delta < 0 && -c.Delta > opts.Threshold {
+			report.Regressions = append(report.Regressions, c)
+		}
+	}
+	return report
+}
+
+// sampleScore is one scorer's score for one sample.
+type sampleScore struct {
+	sampleID string
+	score    float64
+}
+
+// scoresBySample collects the usable scores of each scorer.
+func scoresBySample(results []Result) map[string][]sampleScore {
+	scores := make(map[string][]sampleScore)
+	for _, r := range results {
+		if r.Status == SampleStatusSkipped {
+			continue
+		}
+		for name, sr := range r.Scores {
+			if sr.Status == ScorerStatusErrored {
+				continue
+			}
+			scores[name] = append(scores[name], sampleScore{sampleID: r.SampleID, score: sr.Score})
+		}
+	}
+	return scores
+}
+
+// compareScorer compares one scorer's scores.
+func compareScorer(name string, base, cur []sampleScore, opts CompareOptions) ScorerComparison {
+	c := ScorerComparison{Scorer: name, ConfidenceLevel: opts.ConfidenceLevel}
+
+	// A per-scorer stream keeps each scorer's result independent of the
+	// other scorers in the runs
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	rng := rand.New(rand.NewPCG(opts.Seed, h.Sum64()))
+
+	var estimates []float64
+	if baseVals, curVals := pairScores(base, cur); len(baseVals) >= 2 {
+		c.Paired = true
+		diffs := make([]float64, len(baseVals))
+		for i := range diffs {
+			diffs[i] = curVals[i] - baseVals[i]
+		}
+		c.BaselineCount, c.CurrentCount = len(baseVals), len(curVals)
+		c.BaselineMean, c.CurrentMean = mean(baseVals), mean(curVals)
+		estimates = bootstrapMeans(rng, diffs, opts.Resamples)
+	} else {
+		baseVals, curVals := scoreValues(base), scoreValues(cur)
+		c.BaselineCount, c.CurrentCount = len(baseVals), len(curVals)
+		c.BaselineMean, c.CurrentMean = mean(baseVals), mean(curVals)
+		if len(baseVals) >= 2 && len(curVals) >= 2 {
+			baseMeans := bootstrapMeans(rng, baseVals, opts.Resamples)
+			curMeans := bootstrapMeans(rng, curVals, opts.Resamples)
+			estimates = make([]float64, opts.Resamples)
+			for i := range estimates {
+				estimates[i] = curMeans[i] - baseMeans[i]
+			}
+		}
+	}
+	c.Delta = c.CurrentMean - c.BaselineMean
+
+	if estimates == nil {
+		c.CILow, c.CIHigh = c.Delta, c.Delta
+		return c
+	}
+	sort.Float64s(estimates)
+	alpha := 1 - opts.ConfidenceLevel
+	c.CILow = percentile(estimates, alpha/2)
+	c.CIHigh = percentile(estimates, 1-alpha/2)
+	c.Significant = c.CILow > 0 || c.CIHigh < 0
+	return c
+}
+
+// pairScores returns the scores of samples present in both runs, aligned by
+// sample. Samples with an empty or repeated SampleID are not paired.
+func pairScores(base, cur []sampleScore) (baseVals, curVals []float64) {
+	index := func(scores []sampleScore) map[string]float64 {
+		m := make(map[string]float64, len(scores))
+		repeated := make(map[string]bool)
+		for _, s := range scores {
+			if _, dup := m[s.sampleID]; dup {
+				repeated[s.sampleID] = true
+			}
+			m[s.sampleID] = s.score
+		}
+		for id := range repeated {
+			delete(m, id)
+		}
+		delete(m, "")
+		return m
+	}
+	baseByID, curByID := index(base), index(cur)
+
+	ids := make([]string, 0, len(baseByID))
+	for id := range baseByID {
+		if _, ok := curByID[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		baseVals = append(baseVals, baseByID[id])
+		curVals = append(curVals, curByID[id])
+	}
+	return baseVals, curVals
+}
+
+// scoreValues returns the scores without their samples.
+func scoreValues(scores []sampleScore) []float64 {
+	values := make([]float64, len(scores))
+	for i, s := range scores {
+		values[i] = s.score
+	}
+	return values
+}
+
+// bootstrapMeans returns the means of resamples of values drawn with
+// replacement.
+func bootstrapMeans(rng *rand.Rand, values []float64, resamples int) []float64 {
+	means := make([]float64, resamples)
+	for i := range means {
+		var sum float64
+		for range values {
+			sum += values[rng.IntN(len(values))]
+		}
+		means[i] = sum / float64(len(values))
+	}
+	return means
+}
+
+// percentile returns the p-th quantile of sorted values, interpolating
+// linearly between ranks.
+func percentile(sorted []float64, p float64) float64 {
+	pos := p * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+}
+
+// mean returns the arithmetic mean of values, or 0 if there are none.
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,170 @@
+package eval
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syntheticRun returns n results whose "quality" scores are normally
+// distributed around mean, clamped to [0, 1]. Sample IDs are prefix-0 ...
+func syntheticRun(rng *rand.Rand, prefix string, n int, mean, stddev float64) []Result {
+	results := make([]Result, n)
+	for i := range results {
+		score := mean + rng.NormFloat64()*stddev
+		score = clampScore(score)
+		results[i] = Result{
+			SampleID: fmt.Sprintf("%s-%d", prefix, i),
+			Scores:   map[string]ScoreResult{"quality": {Score: score}},
+		}
+	}
+	return results
+}
+
+func TestCompare_Detection(t *testing.T) {
+	const trials = 200
+	opts := CompareOptions{Resamples: 500, Seed: 7}
+
+	detections := func(effect float64) int {
+		rng := rand.New(rand.NewPCG(42, uint64(effect*1000)))
+		detected := 0
+		for i := 0; i < trials; i++ {
+			baseline := syntheticRun(rng, "base", 20, 0.7, 0.1)
+			current := syntheticRun(rng, "cur", 20, 0.7-effect, 0.1)
+
+			report := Compare(baseline, current, opts)
+			require.Len(t, report.Scorers, 1)
+			require.False(t, report.Scorers[0].Paired)
+			if len(report.SignificantRegressions()) > 0 {
+				detected++
+			}
+		}
+		return detected
+	}
+
+	// Without an effect, a 95% interval flags about 2.5% of runs as
+	// significant drops
+	falsePositives := detections(0)
+	assert.LessOrEqual(t, falsePositives, trials*8/100, "false positive rate")
+
+	// A drop of 0.03 with 20 samples is mostly noise
+	assert.Less(t, detections(0.03), trials/2, "small effect")
+
+	// A drop of 1.5 standard deviations is nearly always detected
+	assert.GreaterOrEqual(t, detections(0.15), trials*95/100, "true positive rate")
+}
+
+func TestCompare_Paired(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	// Samples differ widely in difficulty, but every sample drops by
+	// about 0.03 in the current run
+	var baseline, current []Result
+	for i := 0; i < 20; i++ {
+		difficulty := 0.3 + 0.6*rng.Float64()
+		id := fmt.Sprintf("sample-%d", i)
+		baseline = append(baseline, Result{SampleID: id, Scores: map[string]ScoreResult{
+			"quality": {Score: difficulty},
+		}})
+		current = append(current, Result{SampleID: id, Scores: map[string]ScoreResult{
+			"quality": {Score: clampScore(difficulty - 0.03 + rng.NormFloat64()*0.01)},
+		}})
+	}
+
+	paired := Compare(baseline, current, CompareOptions{Seed: 1})
+	require.Len(t, paired.Scorers, 1)
+	c := paired.Scorers[0]
+	assert.True(t, c.Paired)
+	assert.Equal(t, 20, c.BaselineCount)
+	assert.InDelta(t, -0.03, c.Delta, 0.01)
+	assert.Less(t, c.CILow, c.Delta)
+	assert.Greater(t, c.CIHigh, c.Delta)
+	assert.True(t, c.Significant, "paired analysis detects the drop")
+	require.Len(t, paired.SignificantRegressions(), 1)
+
+	// The same scores without matching sample IDs are compared unpaired,
+	// and the variation between samples hides the drop
+	for i := range current {
+		current[i].SampleID = fmt.Sprintf("other-%d", i)
+	}
+	unpaired := Compare(baseline, current, CompareOptions{Seed: 1})
+	require.Len(t, unpaired.Scorers, 1)
+	assert.False(t, unpaired.Scorers[0].Paired)
+	assert.InDelta(t, c.Delta, unpaired.Scorers[0].Delta, 1e-9)
+	assert.False(t, unpaired.Scorers[0].Significant)
+	require.Len(t, unpaired.Regressions, 1, "magnitude alone is still a regression")
+	assert.Empty(t, unpaired.SignificantRegressions())
+}
+
+func TestCompare_Deterministic(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	baseline := syntheticRun(rng, "base", 15, 0.6, 0.2)
+	current := syntheticRun(rng, "cur", 15, 0.55, 0.2)
+
+	first := Compare(baseline, current, CompareOptions{Seed: 99})
+	second := Compare(baseline, current, CompareOptions{Seed: 99})
+	assert.Equal(t, first, second)
+
+	other := Compare(baseline, current, CompareOptions{Seed: 100})
+	assert.NotEqual(t, first.Scorers[0].CILow, other.Scorers[0].CILow)
+}
+
+func TestCompare_Threshold(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	baseline := syntheticRun(rng, "s", 30, 0.8, 0.02)
+	current := syntheticRun(rng, "s", 30, 0.75, 0.02)
+
+	report := Compare(baseline, current, CompareOptions{Threshold: 0.02})
+	require.Len(t, report.SignificantRegressions(), 1)
+
+	report = Compare(baseline, current, CompareOptions{Threshold: 0.1})
+	assert.Empty(t, report.Regressions, "drop below threshold")
+	require.Len(t, report.Scorers, 1)
+	assert.True(t, report.Scorers[0].Significant)
+}
+
+func TestCompare_Inputs(t *testing.T) {
+	result := func(id string, scores map[string]ScoreResult) Result {
+		return Result{SampleID: id, Scores: scores}
+	}
+
+	baseline := []Result{
+		result("a", map[string]ScoreResult{"quality": {Score: 0.9}, "removed": {Score: 1}}),
+		result("b", map[string]ScoreResult{"quality": {Score: 0.7}}),
+		{SampleID: "c", Status: SampleStatusSkipped, Scores: map[string]ScoreResult{"quality": {Score: 0}}},
+	}
+	current := []Result{
+		result("a", map[string]ScoreResult{"quality": {Score: 0.8}, "added": {Score: 1}}),
+		result("b", map[string]ScoreResult{"quality": {Score: 0.6}}),
+		result("c", map[string]ScoreResult{"quality": {Status: ScorerStatusErrored, Error: "timeout"}}),
+	}
+
+	report := Compare(baseline, current, CompareOptions{})
+	assert.Equal(t, []string{"removed"}, report.OnlyInBaseline)
+	assert.Equal(t, []string{"added"}, report.OnlyInCurrent)
+
+	require.Len(t, report.Scorers, 1)
+	c := report.Scorers[0]
+	assert.True(t, c.Paired)
+	assert.Equal(t, 2, c.BaselineCount, "skipped and errored samples are ignored")
+	assert.InDelta(t, -0.1, c.Delta, 1e-9)
+	assert.Equal(t, defaultCompareConfidenceLevel, c.ConfidenceLevel)
+	assert.InDelta(t, -0.1, c.CILow, 1e-9)
+	assert.InDelta(t, -0.1, c.CIHigh, 1e-9)
+	assert.True(t, c.Significant, "every sample dropped by the same amount")
+
+	t.Run("too few scores", func(t *testing.T) {
+		report := Compare(baseline[:1], current[1:2], CompareOptions{})
+		require.Len(t, report.Scorers, 1)
+		c := report.Scorers[0]
+		assert.False(t, c.Paired)
+		assert.InDelta(t, -0.3, c.Delta, 1e-9)
+		assert.Equal(t, c.Delta, c.CILow)
+		assert.False(t, c.Significant)
+		require.Len(t, report.Regressions, 1)
+		assert.Empty(t, report.SignificantRegressions())
+	})
+}
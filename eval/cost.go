@@ -0,0 +1,165 @@
+package eval
+
+import (
+	"sort"
+	"sync"
+)
+
+// JudgePricing is the price of LLM-judge tokens, used to attribute a dollar
+// cost to each evaluated sample.
+type JudgePricing struct {
+	// InputPerMillion is the price in USD per million input tokens.
+	InputPerMillion float64
+
+	// OutputPerMillion is the price in USD per million output tokens.
+	OutputPerMillion float64
+}
+
+// Cost returns the price of usage in USD.
+func (p JudgePricing) Cost(usage TokenUsage) float64 {
+	return float64(usage.InputTokens)*p.InputPerMillion/1e6 +
+		float64(usage.OutputTokens)*p.OutputPerMillion/1e6
+}
+
+// SampleCost is the LLM-judge cost of scoring one sample.
+type SampleCost struct {
+	// SampleID identifies the sample.
+	SampleID string `json:"sample_id" yaml:"sample_id"`
+
+	// JudgeTokens is the total number of judge tokens spent on the sample.
+	JudgeTokens int `json:"judge_tokens" yaml:"judge_tokens"`
+
+	// JudgeCostUSD is the judge cost of the sample. Zero unless pricing is
+	// configured with E.WithJudgePricing.
+	JudgeCostUSD float64 `json:"judge_cost_usd,omitempty" yaml:"judge_cost_usd,omitempty"`
+}
+
+// Summary aggregates the results scored by an E.
+type Summary struct {
+	// Samples is the number of samples scored. Skipped samples are not
+	// included here or in any other aggregate.
+	Samples int `json:"samples" yaml:"samples"`
+
+	// Skipped is the number of skipped samples.
+	Skipped int `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+
+	// ExpectedFailures is the number of expected-failure samples that failed.
+	ExpectedFailures int `json:"expected_failures,omitempty" yaml:"expected_failures,omitempty"`
+
+	// UnexpectedPasses lists the expected-failure samples that passed.
+	UnexpectedPasses []string `json:"unexpected_passes,omitempty" yaml:"unexpected_passes,omitempty"`
+
+	// LimitExceeded lists the samples stopped because they exceeded their
+	// resource limits.
+	LimitExceeded []string `json:"limit_exceeded,omitempty" yaml:"limit_exceeded,omitempty"`
+
+	// MeanScore is the mean overall score across samples.
+	MeanScore float64 `json:"mean_score" yaml:"mean_score"`
+
+	// BaselineSamples is the number of samples scored against a baseline.
+	BaselineSamples int `json:"baseline_samples,omitempty" yaml:"baseline_samples,omitempty"`
+
+	// MeanImprovement is the mean Result.Improvement across the samples
+	// scored against a baseline.
+	MeanImprovement float64 `json:"mean_improvement,omitempty" yaml:"mean_improvement,omitempty"`
+
+	// JudgeTokens is the total number of LLM-judge tokens spent.
+	JudgeTokens int `json:"judge_tokens" yaml:"judge_tokens"`
+
+	// JudgeCostUSD is the total judge cost. Zero unless pricing is configured.
+	JudgeCostUSD float64 `json:"judge_cost_usd,omitempty" yaml:"judge_cost_usd,omitempty"`
+
+	// CostliestSamples lists the samples that used judge tokens, most
+	// expensive first.
+	CostliestSamples []SampleCost `json:"costliest_samples,omitempty" yaml:"costliest_samples,omitempty"`
+}
+
+// summaryRecorder accumulates results into a Summary.
+type summaryRecorder struct {
+	mu               sync.Mutex
+	scoreTotal       float64
+	improvementTotal float64
+	summary          Summary
+}
+
+// add records a scored result.
+func (r *summaryRecorder) add(result Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch result.Status {
+	case SampleStatusSkipped:
+		r.summary.Skipped++
+		return
+	case SampleStatusExpectedFailure:
+		r.summary.ExpectedFailures++
+	case SampleStatusUnexpectedPass:
+		r.summary.UnexpectedPasses = append(r.summary.UnexpectedPasses, result.SampleID)
+	case SampleStatusLimitExceeded:
+		r.summary.LimitExceeded = append(r.summary.LimitExceeded, result.SampleID)
+	}
+
+	r.summary.Samples++
+	r.scoreTotal += result.OverallScore
+	if result.Improvement != nil {
+		r.summary.BaselineSamples++
+		r.improvementTotal += *result.Improvement
+	}
+	r.summary.JudgeTokens += result.JudgeTokens
+	r.summary.JudgeCostUSD += result.JudgeCostUSD
+	if result.JudgeTokens > 0 {
+		r.summary.CostliestSamples = append(r.summary.CostliestSamples, SampleCost{
+			SampleID:     result.SampleID,
+			JudgeTokens:  result.JudgeTokens,
+			JudgeCostUSD: result.JudgeCostUSD,
+		})
+	}
+}
+
+// snapshot returns a copy of the summary with samples sorted by cost.
+func (r *summaryRecorder) snapshot() Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.summary
+	if s.Samples > 0 {
+		s.MeanScore = r.scoreTotal / float64(s.Samples)
+	}
+	if s.BaselineSamples > 0 {
+		s.MeanImprovement = r.improvementTotal / float64(s.BaselineSamples)
+	}
+	s.UnexpectedPasses = append([]string(nil), r.summary.UnexpectedPasses...)
+	s.LimitExceeded = append([]string(nil), r.summary.LimitExceeded...)
+	s.CostliestSamples = append([]SampleCost(nil), r.summary.CostliestSamples...)
+	sort.SliceStable(s.CostliestSamples, func(i, j int) bool {
+		return s.CostliestSamples[i].JudgeTokens > s.CostliestSamples[j].JudgeTokens
+	})
+	return s
+}
+
+// judgeUsage sums the judge token usage that scorers reported in their
+// details under "input_tokens" and "output_tokens", as the LLM-judge scorer
+// does.
+func judgeUsage(scores map[string]ScoreResult) TokenUsage {
+	var usage TokenUsage
+	for _, sr := range scores {
+		usage.InputTokens += detailInt(sr.Details, "input_tokens")
+		usage.OutputTokens += detailInt(sr.Details, "output_tokens")
+	}
+	return usage
+}
+
+// detailInt reads an integer detail, accepting the numeric types details
+// take on in memory and after a JSON round trip.
+func detailInt(details map[string]any, key string) int {
+	switch v := details[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,101 @@
+package eval
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+// judgeWithUsage returns an LLM judge whose single response used the given tokens.
+func judgeWithUsage(t *testing.T, input, output int) Scorer {
+	t.Helper()
+	scorer, err := NewLLMJudgeScorer(LLMJudgeOptions{
+		Provider: &mockLLMProvider{response: &llm.CompletionResponse{
+			Content: `{"score": 0.8, "reasoning": "ok"}`,
+			Usage:   llm.TokenUsage{InputTokens: input, OutputTokens: output, TotalTokens: input + output},
+		}},
+		Rubric: "Test rubric",
+	})
+	require.NoError(t, err)
+	return scorer
+}
+
+func TestEScoreJudgeCost(t *testing.T) {
+	e := (&E{T: t}).WithJudgePricing(JudgePricing{InputPerMillion: 3, OutputPerMillion: 15})
+
+	result := e.Score(Sample{ID: "expensive"}, judgeWithUsage(t, 1000, 200), &mockScorer{name: "exact", score: 1.0})
+
+	assert.Equal(t, 1200, result.JudgeTokens)
+	assert.InDelta(t, 0.003+0.003, result.JudgeCostUSD, 1e-9)
+}
+
+func TestEScoreJudgeCostWithoutPricing(t *testing.T) {
+	e := &E{T: t}
+
+	result := e.Score(Sample{ID: "s1"}, judgeWithUsage(t, 100, 50))
+	assert.Equal(t, 150, result.JudgeTokens)
+	assert.Zero(t, result.JudgeCostUSD)
+
+	result = e.Score(Sample{ID: "s2"}, &mockScorer{name: "exact", score: 1.0})
+	assert.Zero(t, result.JudgeTokens, "samples without a judge cost nothing")
+}
+
+func TestESummary(t *testing.T) {
+	e := (&E{T: t}).WithJudgePricing(JudgePricing{InputPerMillion: 1, OutputPerMillion: 1})
+
+	e.Score(Sample{ID: "cheap"}, judgeWithUsage(t, 10, 10))
+	e.Score(Sample{ID: "no-judge"}, &mockScorer{name: "exact", score: 0.0})
+	e.Score(Sample{ID: "costly"}, judgeWithUsage(t, 5000, 1000))
+
+	summary := e.Summary()
+	assert.Equal(t, 3, summary.Samples)
+	assert.InDelta(t, (0.8+0.0+0.8)/3, summary.MeanScore, 1e-9)
+	assert.Equal(t, 6020, summary.JudgeTokens)
+	assert.InDelta(t, 0.00602, summary.JudgeCostUSD, 1e-9)
+
+	require.Len(t, summary.CostliestSamples, 2)
+	assert.Equal(t, "costly", summary.CostliestSamples[0].SampleID)
+	assert.Equal(t, "cheap", summary.CostliestSamples[1].SampleID)
+}
+
+func TestJSONLLogger_JudgeCost(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "evals.jsonl")
+	logger, err := NewJSONLLogger(path)
+	require.NoError(t, err)
+
+	e := (&E{T: t}).WithLogger(logger).WithJudgePricing(JudgePricing{InputPerMillion: 2, OutputPerMillion: 10})
+	e.Score(Sample{ID: "judged"}, judgeWithUsage(t, 500, 100))
+	e.Score(Sample{ID: "unjudged"}, &mockScorer{name: "exact", score: 1.0})
+	require.NoError(t, logger.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, float64(600), entries[0]["judge_tokens"])
+	assert.InDelta(t, 0.002, entries[0]["judge_cost_usd"], 1e-9)
+
+	assert.Equal(t, float64(0), entries[1]["judge_tokens"])
+	assert.NotContains(t, entries[1], "judge_cost_usd")
+}
+
+func TestJudgePricingCost(t *testing.T) {
+	p := JudgePricing{InputPerMillion: 3, OutputPerMillion: 15}
+	assert.InDelta(t, 18.0, p.Cost(TokenUsage{InputTokens: 1_000_000, OutputTokens: 1_000_000}), 1e-9)
+	assert.Zero(t, p.Cost(TokenUsage{}))
+}
@@ -0,0 +1,207 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/zero-day-ai/sdk/agent"
+)
+
+// TrajectoryMetadataKey is the agent.Result metadata key under which a
+// delegated agent returns its recorded trajectory. RecordingHarness moves the
+// value into the Children of the "delegate" step so scorers can attribute
+// the sub-agent's work to it.
+const TrajectoryMetadataKey = "eval.trajectory"
+
+// AttachTrajectory stores traj in result metadata under TrajectoryMetadataKey.
+// Sub-agents running under a RecordingHarness call this before returning so
+// the delegating agent's recording embeds their full trajectory.
+//
+// Example:
+//
+//	rec := eval.NewRecordingHarness(harness)
+//	result, err := runSubAgent(ctx, rec, task)
+//	eval.AttachTrajectory(&result, rec.Trajectory())
+//	return result, err
+func AttachTrajectory(result *agent.Result, traj Trajectory) {
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]any)
+	}
+	result.Metadata[TrajectoryMetadataKey] = traj
+}
+
+// childTrajectory extracts a trajectory embedded in result metadata by a
+// delegated agent. Values decoded from JSON (e.g. a daemon response) are
+// converted through a JSON round trip. Returns nil if none is present.
+func childTrajectory(result agent.Result) (*Trajectory, error) {
+	raw, ok := result.Metadata[TrajectoryMetadataKey]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	switch v := raw.(type) {
+	case Trajectory:
+		return &v, nil
+	case *Trajectory:
+		return v, nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal child trajectory: %w", err)
+		}
+		var traj Trajectory
+		if err := json.Unmarshal(data, &traj); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal child trajectory: %w", err)
+		}
+		return &traj, nil
+	}
+}
+
+// Flatten returns the trajectory with all delegated sub-trajectories inlined
+// depth-first: each "delegate" step is followed by the steps of the agent it
+// delegated to. Every step's Agent is set to the agent that performed it and
+// Children is cleared, so each step appears exactly once.
+func (t Trajectory) Flatten() Trajectory {
+	flat := Trajectory{
+		Agent:     t.Agent,
+		StartTime: t.StartTime,
+		EndTime:   t.EndTime,
+	}
+	t.walk(nil, func(step TrajectoryStep, _ []string) {
+		flat.Steps = append(flat.Steps, step)
+	})
+	return flat
+}
+
+// ByAgent groups the steps of the delegation tree by the agent that performed
+// them. Steps of the root trajectory are keyed by t.Agent, which is empty
+// unless the recording was named. A delegate step belongs to the delegating
+// agent; the delegated work belongs to the child.
+func (t Trajectory) ByAgent() map[string]Trajectory {
+	byAgent := make(map[string]Trajectory)
+	t.walkTrajectories(func(traj Trajectory) {
+		group, ok := byAgent[traj.Agent]
+		if !ok {
+			group = Trajectory{Agent: traj.Agent, StartTime: traj.StartTime, EndTime: traj.EndTime}
+		}
+		if !traj.StartTime.IsZero() && (group.StartTime.IsZero() || traj.StartTime.Before(group.StartTime)) {
+			group.StartTime = traj.StartTime
+		}
+		if traj.EndTime.After(group.EndTime) {
+			group.EndTime = traj.EndTime
+		}
+		for _, step := range traj.Steps {
+			step.Agent = traj.Agent
+			step.Children = nil
+			group.Steps = append(group.Steps, step)
+		}
+		byAgent[traj.Agent] = group
+	})
+	return byAgent
+}
+
+// Subtree returns the flattened steps performed by agentName and every agent
+// it delegated to, directly or transitively. If the agent was delegated to
+// more than once, all of its subtrees are included in order. An empty name
+// returns the whole flattened trajectory.
+func (t Trajectory) Subtree(agentName string) Trajectory {
+	if agentName == "" || agentName == t.Agent {
+		return t.Flatten()
+	}
+
+	sub := Trajectory{Agent: agentName}
+	t.walk(nil, func(step TrajectoryStep, path []string) {
+		if !slices.Contains(path, agentName) {
+			return
+		}
+		sub.Steps = append(sub.Steps, step)
+		if sub.StartTime.IsZero() || step.StartTime.Before(sub.StartTime) {
+			sub.StartTime = step.StartTime
+		}
+		if end := stepEnd(step); end.After(sub.EndTime) {
+			sub.EndTime = end
+		}
+	})
+	return sub
+}
+
+// Agents returns the names of all delegated agents in the tree, sorted.
+// The root agent is not included.
+func (t Trajectory) Agents() []string {
+	seen := make(map[string]bool)
+	for _, step := range t.Flatten().Steps {
+		if step.Type == "delegate" && step.Name != "" {
+			seen[step.Name] = true
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// walk visits every step depth-first. path lists the agents from the root to
+// the agent that performed the step (inclusive).
+func (t Trajectory) walk(path []string, fn func(step TrajectoryStep, path []string)) {
+	path = append(slices.Clip(path), t.Agent)
+	for _, step := range t.Steps {
+		children := step.Children
+		step.Agent = t.Agent
+		step.Children = nil
+		fn(step, path)
+
+		if children != nil {
+			child := *children
+			if child.Agent == "" {
+				child.Agent = step.Name
+			}
+			child.walk(path, fn)
+		}
+	}
+}
+
+// walkTrajectories visits t and every embedded child trajectory depth-first,
+// defaulting each child's Agent to the name of its delegate step.
+func (t Trajectory) walkTrajectories(fn func(traj Trajectory)) {
+	fn(t)
+	for _, step := range t.Steps {
+		if step.Children == nil {
+			continue
+		}
+		child := *step.Children
+		if child.Agent == "" {
+			child.Agent = step.Name
+		}
+		child.walkTrajectories(fn)
+	}
+}
+
+// scopeSample returns a copy of sample whose trajectory is restricted to the
+// subtree of agentName. Findings carried in sample metadata cannot be
+// attributed to an agent, so they are dropped from scoped samples.
+func scopeSample(sample Sample, agentName string) Sample {
+	if agentName == "" {
+		return sample
+	}
+	sample.Trajectory = sample.Trajectory.Subtree(agentName)
+	if _, ok := sample.Metadata["findings"]; ok {
+		meta := make(map[string]any, len(sample.Metadata))
+		for k, v := range sample.Metadata {
+			if k != "findings" {
+				meta[k] = v
+			}
+		}
+		sample.Metadata = meta
+	}
+	return sample
+}
+
+// stepEnd returns when a step finished.
+func stepEnd(step TrajectoryStep) time.Time {
+	return step.StartTime.Add(step.Duration)
+}
@@ -0,0 +1,256 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+)
+
+// loadDelegationSample loads a sample whose trajectory is a two-level
+// delegation tree: orchestrator -> recon -> web-scanner, and
+// orchestrator -> exploit.
+func loadDelegationSample(t *testing.T) Sample {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "delegation_sample.json"))
+	require.NoError(t, err)
+
+	var sample Sample
+	require.NoError(t, json.Unmarshal(data, &sample))
+	return sample
+}
+
+func stepNames(traj Trajectory) []string {
+	names := make([]string, len(traj.Steps))
+	for i, step := range traj.Steps {
+		names[i] = step.Agent + ":" + step.Type + ":" + step.Name
+	}
+	return names
+}
+
+func TestTrajectory_Flatten(t *testing.T) {
+	sample := loadDelegationSample(t)
+
+	flat := sample.Trajectory.Flatten()
+	assert.Equal(t, []string{
+		"orchestrator:llm:primary",
+		"orchestrator:delegate:recon",
+		"recon:tool:nmap",
+		"recon:delegate:web-scanner",
+		"web-scanner:tool:httpx",
+		"web-scanner:finding:submit",
+		"recon:finding:submit",
+		"orchestrator:delegate:exploit",
+		"exploit:tool:sqlmap",
+		"exploit:finding:submit",
+		"orchestrator:finding:submit",
+	}, stepNames(flat))
+
+	for _, step := range flat.Steps {
+		assert.Nil(t, step.Children)
+	}
+	assert.Equal(t, sample.Trajectory.StartTime, flat.StartTime)
+
+	// Flatten must not modify the original tree
+	assert.NotNil(t, sample.Trajectory.Steps[1].Children)
+	assert.Empty(t, sample.Trajectory.Steps[0].Agent)
+}
+
+func TestTrajectory_ByAgent(t *testing.T) {
+	sample := loadDelegationSample(t)
+
+	byAgent := sample.Trajectory.ByAgent()
+	require.Len(t, byAgent, 4)
+
+	assert.Equal(t, []string{
+		"recon:tool:nmap",
+		"recon:delegate:web-scanner",
+		"recon:finding:submit",
+	}, stepNames(byAgent["recon"]))
+	assert.Equal(t, []string{
+		"web-scanner:tool:httpx",
+		"web-scanner:finding:submit",
+	}, stepNames(byAgent["web-scanner"]))
+	assert.Len(t, byAgent["orchestrator"].Steps, 4)
+	assert.Len(t, byAgent["exploit"].Steps, 2)
+	assert.Equal(t, "exploit", byAgent["exploit"].Agent)
+}
+
+func TestTrajectory_Subtree(t *testing.T) {
+	sample := loadDelegationSample(t)
+
+	recon := sample.Trajectory.Subtree("recon")
+	assert.Equal(t, []string{
+		"recon:tool:nmap",
+		"recon:delegate:web-scanner",
+		"web-scanner:tool:httpx",
+		"web-scanner:finding:submit",
+		"recon:finding:submit",
+	}, stepNames(recon))
+	assert.Equal(t, "recon", recon.Agent)
+	assert.False(t, recon.StartTime.IsZero())
+	assert.True(t, recon.EndTime.After(recon.StartTime))
+
+	assert.Len(t, sample.Trajectory.Subtree("web-scanner").Steps, 2)
+	assert.Len(t, sample.Trajectory.Subtree("").Steps, 11)
+	assert.Len(t, sample.Trajectory.Subtree("orchestrator").Steps, 11)
+	assert.Empty(t, sample.Trajectory.Subtree("unknown").Steps)
+
+	assert.Equal(t, []string{"exploit", "recon", "web-scanner"}, sample.Trajectory.Agents())
+}
+
+func TestFindingAccuracyScorer_AgentScope(t *testing.T) {
+	sample := loadDelegationSample(t)
+	ctx := context.Background()
+
+	// Unscoped scoring sees findings from the whole tree
+	result, err := NewFindingAccuracyScorer(FindingAccuracyOptions{}).Score(ctx, sample)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Details["tp_count"])
+	assert.Equal(t, 1, result.Details["fp_count"])
+
+	// The false positive is attributed to the exploit agent
+	result, err = NewFindingAccuracyScorer(FindingAccuracyOptions{AgentScope: "exploit"}).Score(ctx, sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result.Score)
+	assert.Equal(t, 1, result.Details["fp_count"])
+	assert.Equal(t, "exploit", result.Details["agent_scope"])
+
+	// recon's subtree includes the web-scanner finding
+	result, err = NewFindingAccuracyScorer(FindingAccuracyOptions{AgentScope: "recon"}).Score(ctx, sample)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Details["tp_count"])
+	assert.Equal(t, 0, result.Details["fp_count"])
+	assert.InDelta(t, 0.8, result.Score, 0.001)
+}
+
+func TestFindingAccuracyScorer_AgentScopeIgnoresMetadataFindings(t *testing.T) {
+	sample := Sample{
+		ExpectedFindings: []GroundTruthFinding{{ID: "gt-1", Title: "Finding"}},
+		Metadata: map[string]any{
+			"findings": []map[string]any{{"id": "gt-1", "title": "Finding"}},
+		},
+	}
+
+	scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{AgentScope: "recon"})
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Details["actual_count"])
+	assert.Contains(t, sample.Metadata, "findings", "scoping must not modify the caller's metadata")
+}
+
+func TestToolCorrectnessScorer_AgentScope(t *testing.T) {
+	sample := loadDelegationSample(t)
+	ctx := context.Background()
+
+	result, err := NewToolCorrectnessScorer(ToolCorrectnessOptions{}).Score(ctx, sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+
+	result, err = NewToolCorrectnessScorer(ToolCorrectnessOptions{
+		AgentScope: "recon",
+		ExpectedTools: []ExpectedToolCall{
+			{Name: "nmap", Arguments: map[string]any{"target": "example.com"}, Required: true},
+			{Name: "httpx", Required: true},
+		},
+	}).Score(ctx, sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, []string{"nmap", "httpx"}, result.Details["matched_tools"])
+
+	result, err = NewToolCorrectnessScorer(ToolCorrectnessOptions{AgentScope: "exploit"}).Score(ctx, sample)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0/3.0, result.Score, 0.001)
+}
+
+func TestE_WithAgentBreakdown(t *testing.T) {
+	sample := loadDelegationSample(t)
+	e := &E{T: t}
+
+	result := e.Score(sample, NewFindingAccuracyScorer(FindingAccuracyOptions{}))
+	assert.Nil(t, result.AgentScores, "breakdown is opt-in")
+
+	result = e.WithAgentBreakdown().Score(sample,
+		NewFindingAccuracyScorer(FindingAccuracyOptions{}),
+		NewToolCorrectnessScorer(ToolCorrectnessOptions{}),
+	)
+	require.Len(t, result.AgentScores, 3)
+	assert.InDelta(t, (6.0/7.0+1.0)/2, result.OverallScore, 0.001, "breakdown must not change the overall score")
+
+	exploit := result.AgentScores["exploit"]
+	assert.Equal(t, 0.0, exploit["finding_accuracy"].Score)
+	assert.Equal(t, 1, exploit["finding_accuracy"].Details["fp_count"])
+	assert.Equal(t, 1, result.AgentScores["web-scanner"]["finding_accuracy"].Details["tp_count"])
+	assert.Contains(t, result.AgentScores["recon"], "tool_correctness")
+
+	// A sample without delegation has no breakdown
+	plain := Sample{Trajectory: Trajectory{Steps: []TrajectoryStep{{Type: "tool", Name: "nmap"}}}}
+	assert.Nil(t, e.Score(plain, NewToolCorrectnessScorer(ToolCorrectnessOptions{})).AgentScores)
+}
+
+func TestRecordingHarness_DelegateEmbedsChildTrajectory(t *testing.T) {
+	child := Trajectory{Steps: []TrajectoryStep{{Type: "tool", Name: "nmap"}}}
+
+	t.Run("attached trajectory", func(t *testing.T) {
+		mock := &mockHarness{
+			delegateFunc: func(ctx context.Context, name string, task agent.Task) (agent.Result, error) {
+				result := agent.NewSuccessResult("done")
+				result.Metadata = map[string]any{"other": 1}
+				AttachTrajectory(&result, child)
+				return result, nil
+			},
+		}
+		rec := NewRecordingHarness(mock)
+
+		result, err := rec.DelegateToAgent(context.Background(), "recon", agent.Task{})
+		require.NoError(t, err)
+		assert.Contains(t, result.Metadata, TrajectoryMetadataKey, "the caller still receives the result unchanged")
+
+		steps := rec.Trajectory().Steps
+		require.Len(t, steps, 1)
+		require.NotNil(t, steps[0].Children)
+		assert.Equal(t, "recon", steps[0].Children.Agent)
+		assert.Len(t, steps[0].Children.Steps, 1)
+
+		output := steps[0].Output.(agent.Result)
+		assert.NotContains(t, output.Metadata, TrajectoryMetadataKey)
+		assert.Equal(t, 1, output.Metadata["other"])
+
+		assert.Equal(t, []string{"recon"}, rec.Trajectory().Agents())
+	})
+
+	t.Run("json decoded trajectory", func(t *testing.T) {
+		data, err := json.Marshal(child)
+		require.NoError(t, err)
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		mock := &mockHarness{
+			delegateFunc: func(ctx context.Context, name string, task agent.Task) (agent.Result, error) {
+				return agent.Result{Metadata: map[string]any{TrajectoryMetadataKey: decoded}}, errors.New("partial")
+			},
+		}
+		rec := NewRecordingHarness(mock)
+
+		_, err = rec.DelegateToAgent(context.Background(), "recon", agent.Task{})
+		require.Error(t, err)
+
+		step := rec.Trajectory().Steps[0]
+		assert.Equal(t, "partial", step.Error)
+		require.NotNil(t, step.Children)
+		assert.Equal(t, "nmap", step.Children.Steps[0].Name)
+	})
+
+	t.Run("no trajectory", func(t *testing.T) {
+		rec := NewRecordingHarness(&mockHarness{})
+		_, err := rec.DelegateToAgent(context.Background(), "recon", agent.Task{})
+		require.NoError(t, err)
+		assert.Nil(t, rec.Trajectory().Steps[0].Children)
+	})
+}
@@ -98,6 +98,18 @@
 //	    Binary: true,  // Round to 0 or 1
 //	})
 //
+// For structured outputs such as JSON reports, GoldenFile compares the output against
+// a golden file after normalization and reports a unified diff on mismatch. Run with
+// GOEVALS_UPDATE_GOLDEN=1 to rewrite golden files from the current outputs.
+//
+//	scorer := eval.NewTaskCompletionScorer(eval.TaskCompletionOptions{
+//	    GoldenFile: "testdata/report.golden.json",
+//	    GoldenNormalization: eval.GoldenNormalization{
+//	        SortJSONKeys:  true,
+//	        StripPatterns: []string{`\d{4}-\d{2}-\d{2}T[0-9:]+Z`},  // timestamps
+//	    },
+//	})
+//
 // FindingAccuracyScorer evaluates the accuracy of security findings discovered by the agent.
 // It calculates precision, recall, and F1 score by comparing actual findings against ground truth.
 // Supports severity weighting and category matching for fine-grained evaluation.
@@ -108,6 +120,29 @@
 //	    FuzzyTitleThreshold: 0.8,  // Allow fuzzy title matching
 //	})
 //
+// Individual ground truth findings can be weighted by hand so that missing
+// the critical finding costs more than missing an info leak. Unset weights
+// default to 1.0, or to the severity weight with MatchBySeverity. Weighted
+// scoring reports the weighted and unweighted precision, recall and F1 in
+// the result details; the score is the weighted F1:
+//
+//	ExpectedFindings: []eval.GroundTruthFinding{
+//	    {ID: "sqli-login", Title: "SQL Injection in login", Severity: "critical", Weight: 10},
+//	    {ID: "version-leak", Title: "Server version disclosure", Severity: "info"},
+//	}
+//
+// Ground truth findings with a Location match only actual findings at that
+// endpoint, so SQL injections on /login and /admin are told apart. Actual
+// locations come from evidence metadata ("url", "parameter", "method") or HTTP
+// request evidence, or from FindingAccuracyOptions.LocationExtractor, and URLs
+// are compared by exact path, path prefix, or host per LocationMatch. Each
+// unmatched finding in the details carries an "unmatched_reason" such as
+// MismatchLocation or MismatchCategory:
+//
+//	{ID: "sqli-login", Title: "SQL Injection", Location: &eval.FindingLocation{
+//	    URL: "https://app.example/login", Parameter: "username", Method: "POST",
+//	}}
+//
 // TrajectoryScorer evaluates whether the agent's execution path matches expected steps.
 // It supports three matching modes: exact sequence, subset (any order), and ordered subset
 // (maintains relative order but allows extras). Useful for verifying reasoning patterns.
@@ -122,6 +157,60 @@
 //	    PenalizeExtra: 0.05,  // 5% penalty per extra step
 //	})
 //
+// FindingLatencyScorer evaluates whether findings are submitted as they are discovered
+// rather than batched at the end of execution. Each finding step is attributed to the tool
+// result that most plausibly revealed it, and the delay between the two is scored.
+// The mean submission latency is reported in Details["mean_latency_ms"].
+//
+//	scorer := eval.NewFindingLatencyScorer(eval.FindingLatencyOptions{
+//	    TargetLatency: 10 * time.Second,  // Full score within 10s
+//	    MaxLatency: 5 * time.Minute,  // Zero score after 5m
+//	})
+//
+// OutcomeScorer checks the agent's final status against the sample's expected
+// outcome. Samples marked ExpectError are negative tests: the agent should fail
+// or refuse (e.g. an out-of-scope destructive request), and succeeding scores
+// 0.0. Set ExpectedStatus to require a specific status such as "refused", the
+// status of agent.NewRefusedResult, to tell a deliberate refusal from a crash.
+//
+//	sample.ExpectError = true
+//	result := e.Score(sample, eval.NewOutcomeScorer())
+//
+// ConstraintComplianceScorer checks the trajectory against the task's
+// Constraints: calls to blocked tools or tools outside AllowedTools, and LLM
+// turns or tokens beyond MaxTurns and MaxTokens. Each violation is reported
+// in the details.
+//
+//	result := e.Score(sample, eval.NewConstraintComplianceScorer())
+//
+// EfficiencyScorer flags efficiency regressions: agents that make far more LLM
+// or tool calls than a task should need. Exceeding a limit by a factor of two
+// scores 0.5, and the actual counts are reported in the details.
+//
+//	scorer := eval.NewEfficiencyScorer(eval.EfficiencyOptions{
+//	    MaxLLMCalls:  10,
+//	    MaxToolCalls: 20,
+//	})
+//
+// StepRangeScorer compares the trajectory length with the sample's
+// ExpectedStepRange. It catches agents that flounder as well as agents that
+// finish suspiciously fast without doing the work; the score decays
+// proportionally outside the range.
+//
+//	sample.ExpectedStepRange = [2]int{5, 20}
+//	result := e.Score(sample, eval.NewStepRangeScorer())
+//
+// StreamQualityScorer scores streamed output for agents that stream their
+// analysis to users: time to first token, the longest stall between chunks,
+// and how early useful content appeared. RecordingHarness records the timed
+// chunks of each streamed completion; without them the scorer falls back to
+// final responses and reports a lower confidence.
+//
+//	scorer := eval.NewStreamQualityScorer(eval.StreamQualityOptions{
+//	    MaxFirstTokenLatency: 2 * time.Second,
+//	    MaxStallGap:          5 * time.Second,
+//	})
+//
 // LLMJudgeScorer uses an LLM to evaluate agent performance based on a custom rubric.
 // This provides flexible, nuanced evaluation for complex tasks that don't fit rule-based scoring.
 // Includes automatic retry logic for JSON parsing failures and token usage tracking.
@@ -134,6 +223,86 @@
 //	    IncludeTrajectory: true,  // Include execution details
 //	})
 //
+// ReasoningScorer judges how the agent thinks rather than what it did: an
+// LLM judge rates the coherence of the "reasoning" steps the agent recorded
+// with agent.RecordReasoning, against the task and the actions that followed.
+// It takes LLMJudgeOptions; the rubric is optional and adds task-specific
+// criteria to the built-in one.
+//
+//	scorer, err := eval.NewReasoningScorer(eval.LLMJudgeOptions{Provider: llmProvider})
+//
+// MemoryHygieneScorer checks how the agent uses memory: writes whose key is
+// never read back, values matching forbidden patterns such as
+// DefaultSecretPatterns, and working memory growing past a key budget. The
+// details name the offending keys and step indices; values are reported only
+// as hashes.
+//
+//	scorer, err := eval.NewMemoryHygieneScorer(eval.MemoryHygieneOptions{
+//	    RequireReadAfterWrite: true,
+//	    ForbidPatterns:        eval.DefaultSecretPatterns,
+//	    MaxWorkingKeys:        50,
+//	})
+//
+// ExternalScorer runs scoring logic written in any language as a subprocess.
+// Each sample, including its trajectory, is written to the subprocess's stdin
+// as an ExternalScoreRequest, and the subprocess answers on stdout with an
+// ExternalScoreResponse such as {"score": 0.8, "details": {...}}. A non-zero
+// exit, invalid JSON, a reported error, or a timeout fails the evaluation.
+//
+//	scorer, err := eval.NewExternalScorer(eval.ExternalScorerOptions{
+//	    Name:    "exploit_quality",
+//	    Command: "python3",
+//	    Args:    []string{"scorers/exploit_quality.py"},
+//	    Timeout: 10 * time.Second,
+//	})
+//
+// # Combining Scorers
+//
+// All, Any, and Not build composite gates from other scorers without a custom
+// scorer type. All scores the minimum of its sub-scorers, Any the maximum,
+// and Not the complement; the sub-scores are recorded in Details["scores"]:
+//
+//	gate := eval.All(
+//	    toolCorrectness,
+//	    eval.Any(taskCompletion, llmJudge),
+//	)
+//	result := e.Score(sample, gate)
+//
+// # Scorer Failures
+//
+// Score isolates scorers from each other: a scorer that returns an error or
+// panics is recorded with score 0.0, status "errored", and the cause in
+// ScoreResult.Error, and the remaining scorers still run. WithScoreOptions
+// adds a per-scorer timeout, so a judge stuck on an unresponsive provider
+// cannot stall the test run, and chooses whether errored scorers are excluded
+// from the overall score (the default) or count as zero:
+//
+//	e.WithScoreOptions(eval.ScoreOptions{
+//	    ScorerTimeout:   2 * time.Minute,
+//	    ContinueOnError: true,
+//	    ErroredAsZero:   true,
+//	})
+//
+// The JSONL log records the errors of errored scorers in scorer_errors.
+//
+// Scores outside [0.0, 1.0], such as a ratio above 1 from a buggy custom
+// scorer, are clamped into range with a warning naming the scorer and the
+// original score in the "unclamped_score" detail. RejectOutOfRange records
+// them as errored instead; NaN scores are always errored. The overall score is
+// therefore always within [0.0, 1.0].
+//
+// # Scoring Progress
+//
+// ScoreAsync scores a sample in the background and reports each scorer as it
+// finishes, so a run with a slow LLM judge shows progress instead of
+// appearing frozen. The final Result is the same as Score's:
+//
+//	progress, done := e.ScoreAsync(sample, judge, toolScorer)
+//	for p := range progress {
+//	    e.T.Logf("[%d/%d] %s scored %.2f in %s", p.Completed, p.Total, p.Scorer, p.Result.Score, p.Duration)
+//	}
+//	result := <-done
+//
 // # RecordingHarness
 //
 // RecordingHarness is a transparent wrapper around agent.Harness that records all operations
@@ -162,6 +331,28 @@
 //   - "memory": Memory store operations (get, set, delete, list)
 //   - "plugin": Plugin queries
 //   - "graphrag": GraphRAG operations (queries, storage, traversal)
+//   - "reasoning": Intermediate reasoning recorded with agent.RecordReasoning,
+//     which is a no-op on harnesses that do not record
+//
+// # Multi-Agent Evaluation
+//
+// When a sub-agent attaches its own recording to its result with
+// AttachTrajectory, the delegating RecordingHarness embeds it as the Children
+// of the "delegate" step, producing a delegation tree. Trajectory.Flatten
+// inlines the tree, Trajectory.ByAgent groups steps by the agent that
+// performed them, and Trajectory.Subtree selects one agent and everything it
+// delegated to:
+//
+//	// Score only the recon agent's subtree
+//	scorer := eval.NewFindingAccuracyScorer(eval.FindingAccuracyOptions{
+//	    AgentScope: "recon",
+//	})
+//
+//	// Or score every delegated agent alongside the overall result
+//	result := e.WithAgentBreakdown().Score(sample, scorers...)
+//	reconScores := result.AgentScores["recon"]
+//
+// Tool and finding scorers consider the whole tree by default.
 //
 // # Eval Set Loading
 //
@@ -177,6 +368,15 @@
 //	// Filter by tags (e.g., run only "smoke" tests)
 //	filtered := evalSet.FilterByTags([]string{"smoke", "critical"})
 //
+//	// Filter by expected findings, or by any predicate; filters chain
+//	injection := evalSet.FilterByCategory("injection").FilterBySeverity("critical", "high")
+//	large := evalSet.Filter(func(s eval.Sample) bool { return len(s.ExpectedFindings) > 2 })
+//
+//	// Deterministic subsets for quick smoke runs, random or spread
+//	// proportionally across groups
+//	smoke := evalSet.Sample(20, 1)
+//	spread := evalSet.Stratified(20, func(s eval.Sample) string { return strings.Join(s.Tags, ",") })
+//
 //	// Run all samples through scorers
 //	for _, sample := range filtered.Samples {
 //	    // Execute agent and populate sample.Result and sample.Trajectory
@@ -208,6 +408,30 @@
 //	        title: "SQL Injection in Login Form"
 //	    tags: ["smoke", "critical"]
 //
+// Known-broken samples can stay in the set with a marker instead of being
+// deleted. A sample with skip is not scored; it is logged with status
+// "skipped" and excluded from aggregates. A sample with expected_failure
+// (xfail) is scored, but a failing score does not fail RequireScore; if it
+// passes the threshold set with E.WithThreshold (or scores 1.0 without one),
+// it is logged as "unexpected_pass" and listed in the summary so the marker
+// can be removed:
+//
+//	samples:
+//	  - id: "sqli-002"
+//	    skip: "target container is broken, see #412"
+//	  - id: "ssrf-001"
+//	    expected_failure: "agent does not follow redirects yet"
+//
+// Markers do not affect FilterByTags, so skipped samples still show up, with
+// their status, in results for their tags.
+//
+// Ground truth goes stale when the taxonomy renames a category, severity or
+// technique: scorers then never match it. ValidateAgainstTaxonomy reports such
+// values with the closest current names, and RequireTaxonomyClean fails a CI
+// test on them:
+//
+//	eval.RequireTaxonomyClean(t, eval.ValidateAgainstTaxonomy(evalSet, graphrag.GetTaxonomy()))
+//
 // # Results Logging
 //
 // Evaluation results can be persisted to JSONL (JSON Lines) files for analysis, tracking
@@ -230,10 +454,80 @@
 //
 // Each evaluation produces a single JSON line in the log file:
 //
-//	{"timestamp":"2025-01-05T10:30:00Z","sample_id":"sqli-001","scores":{"tool_correctness":0.9,"task_completion":1.0},"overall_score":0.95,"duration_ms":1250}
+//	{"timestamp":"2025-01-05T10:30:00Z","sample_id":"sqli-001","scores":{"tool_correctness":0.9,"task_completion":1.0},"overall_score":0.95,"duration_ms":1250,"judge_tokens":0}
 //
 // The JSONL format is streaming-friendly and easily processed by tools like jq, pandas, or BigQuery.
 //
+// LLM-judge token usage is attributed to each sample as judge_tokens. With
+// pricing configured, entries also carry judge_cost_usd, and E.Summary (logged
+// at the end of Run) lists the costliest samples, making it easy to find the
+// samples worth pruning:
+//
+//	e.WithJudgePricing(eval.JudgePricing{InputPerMillion: 3, OutputPerMillion: 15})
+//	...
+//	for _, c := range e.Summary().CostliestSamples {
+//	    fmt.Printf("%s: %d tokens ($%.4f)\n", c.SampleID, c.JudgeTokens, c.JudgeCostUSD)
+//	}
+//
+// To track scorer trends across runs, load several log files (one per run) into a
+// TimeSeries and export it for a dashboard:
+//
+//	files, _ := filepath.Glob("nightly/*.jsonl")
+//	ts, err := eval.LoadTimeSeries(files)
+//	if err != nil {
+//	    return err
+//	}
+//	ts.WriteCSV(os.Stdout) // run_id,timestamp,scorer,mean,min,max,count
+//
+// For a readable summary at the end of a run, WriteSummaryTable renders the
+// results as an aligned table with per-scorer scores and a pass mark against
+// a threshold, or as a Markdown table for a PR comment:
+//
+//	eval.WriteSummaryTable(os.Stdout, results, eval.TableOptions{
+//	    SortBy:        eval.SortByOverall,
+//	    FailThreshold: 0.8,
+//	    Color:         true,
+//	})
+//
+// # Ground-Truth Suggestions
+//
+// Ground truth is written by hand and is sometimes wrong. Scorers that
+// implement SuggestingScorer, including FindingAccuracyScorer, propose
+// corrections such as adding a finding the agent reported that the ground
+// truth lacks. Suggestions are recorded in Result.Suggestions and the JSONL
+// log but never applied; CollectSuggestions surfaces the ones that recur
+// across runs for review:
+//
+//	for _, s := range eval.CollectSuggestions(entries, 3) {
+//	    fmt.Printf("%s: %s %v (%d/%d runs)\n", s.SampleID, s.Kind, s.Payload, s.Occurrences, s.Runs)
+//	}
+//
+// # Comparing Against a Baseline
+//
+// Compare reports, per scorer, how a run's mean score changed from a
+// baseline run, with a bootstrap confidence interval on the difference. When
+// both runs scored the same sample IDs the comparison is paired, which
+// detects much smaller changes. A regression gate can require a drop to be
+// both larger than a threshold and statistically significant, and a fixed
+// seed makes the report reproducible in CI:
+//
+//	report := eval.Compare(baseline, current, eval.CompareOptions{
+//	    Threshold: 0.02,
+//	    Seed:      1,
+//	})
+//	for _, r := range report.SignificantRegressions() {
+//	    t.Errorf("%s: %.3f -> %.3f", r.Scorer, r.BaselineMean, r.CurrentMean)
+//	}
+//
+// A baseline can also be a naive agent run on each sample. With
+// WithBaselineScoring, a sample's BaselineResult and BaselineTrajectory are
+// scored by the same scorers, and Result.Improvement reports the agent's
+// score minus the baseline's, so easy samples that any agent passes count
+// for little:
+//
+//	result := e.WithBaselineScoring().Score(sample, scorers...)
+//	e.RequireImprovement(result, 0.2)
+//
 // # OpenTelemetry Integration
 //
 // Evaluations can emit metrics and traces to OpenTelemetry for monitoring and alerting:
@@ -357,6 +651,22 @@
 //   - Debounce: Minimum time between evaluations to prevent rapid-fire scoring
 //   - OnThreshold: Always evaluate immediately on threshold breach (requires prior evaluation)
 //
+// Scorers of different cost can run on different schedules. PerScorer
+// overrides the frequency of named scorers, and under a global Debounce each
+// window evaluates only the highest-priority due scorer:
+//
+//	eval.FeedbackOptions{
+//	    Scorers:   []eval.StreamingScorer{toolScorer, trajectoryScorer},
+//	    Frequency: eval.FeedbackFrequency{EveryNSteps: 1, Debounce: time.Second},
+//	    PerScorer: map[string]eval.FeedbackFrequency{
+//	        "trajectory": {EveryNSteps: 10},
+//	    },
+//	    Priority: []string{"trajectory", "tool_correctness"},
+//	}
+//
+// Feedback then holds the latest score of every scorer, and
+// Feedback.EvaluatedAt records when each one was computed.
+//
 // Threshold settings determine when alerts are generated:
 //   - WarningThreshold: Score below this triggers warning alerts (default: 0.5)
 //   - CriticalThreshold: Score below this triggers critical alerts (default: 0.2)
@@ -36,6 +36,7 @@ func Run(t *testing.T, name string, f func(e *E)) {
 			T: t,
 		}
 		f(e)
+		e.logSummary()
 	})
 }
 
@@ -62,16 +63,52 @@ type E struct {
 	langfuseExporter *LangfuseExporter
 
 	// scoreThreshold is the minimum acceptable score (0.0 to 1.0)
-	// Used by OTel span status to mark evaluations as OK or Error
+	// Used by OTel span status to mark evaluations as OK or Error, and to
+	// decide whether expected-failure samples passed
 	scoreThreshold float64
+
+	// agentBreakdown enables per-agent sub-results for delegation trees
+	agentBreakdown bool
+
+	// judgePricing prices LLM-judge tokens, if configured
+	judgePricing *JudgePricing
+
+	// summary accumulates scored results
+	summary summaryRecorder
+
+	// scoreOpts controls how scorers are run, if configured
+	scoreOpts *ScoreOptions
+
+	// sampleLimits are the suite-wide limits for LimitHarness
+	sampleLimits SampleLimits
+
+	// baselineScoring enables scoring samples against their baselines
+	baselineScoring bool
 }
 
 // Score runs all provided scorers on the sample and returns an aggregated result.
 // Each scorer is executed independently, and their scores are combined into a single Result.
 // The overall score is calculated as the mean of all individual scores.
 //
-// If any scorer returns an error, the score for that scorer is recorded as 0.0 and the error
-// is included in the result details.
+// If any scorer returns an error, times out, or panics, the score for that scorer is
+// recorded as 0.0 with status ScorerStatusErrored and the error in ScoreResult.Error.
+// By default errored scorers are excluded from the overall score and the remaining
+// scorers still run; see WithScoreOptions.
+//
+// A scorer score outside [0.0, 1.0] is clamped into range and logged as a
+// warning naming the scorer, or, with ScoreOptions.RejectOutOfRange, recorded
+// as errored. A NaN score is always recorded as errored, so the overall score
+// is always within [0.0, 1.0].
+//
+// Samples with a Skip reason are not scored: the result has status
+// SampleStatusSkipped and is logged but excluded from the summary aggregates.
+// Samples with an ExpectedFailure reason are scored normally; the result has
+// status SampleStatusExpectedFailure if the score is below the pass threshold
+// (see WithThreshold) and SampleStatusUnexpectedPass otherwise.
+// Samples stopped by LimitHarness are scored over their partial trajectory
+// and have status SampleStatusLimitExceeded.
+// Scorers that implement SuggestingScorer add their proposed ground-truth
+// corrections to Result.Suggestions.
 //
 // Example:
 //
@@ -80,6 +117,11 @@ type E struct {
 //	    NewTaskCompletionScorer(taskOpts),
 //	)
 func (e *E) Score(sample Sample, scorers ...Scorer) Result {
+	return e.score(sample, scorers, nil)
+}
+
+// score implements Score, calling progress, if set, as each scorer finishes.
+func (e *E) score(sample Sample, scorers []Scorer, progress func(ScorerProgress)) Result {
 	ctx := context.Background()
 	startTime := time.Now()
 
@@ -89,37 +131,115 @@ func (e *E) Score(sample Sample, scorers ...Scorer) Result {
 		Timestamp: startTime,
 	}
 
+	if sample.Skip != "" {
+		result.Status = SampleStatusSkipped
+		result.StatusReason = sample.Skip
+		e.T.Logf("Skipping sample %s: %s", sample.ID, sample.Skip)
+		e.summary.add(result)
+		if e.logger != nil {
+			if err := e.Log(sample, result); err != nil {
+				e.T.Logf("Failed to log result: %v", err)
+			}
+		}
+		return result
+	}
+
 	// Run each scorer
+	opts := e.scoreOptions()
 	var totalScore float64
 	scorerCount := 0
 
-	for _, scorer := range scorers {
+	for i, scorer := range scorers {
 		scorerName := scorer.Name()
 
-		scoreResult, err := scorer.Score(ctx, sample)
-		if err != nil {
-			// Record error but continue with other scorers
-			result.Scores[scorerName] = ScoreResult{
-				Score: 0.0,
-				Details: map[string]any{
-					"error": err.Error(),
-				},
+		scorerStart := time.Now()
+		scoreResult := runScorer(ctx, scorer, sample, opts)
+		result.Scores[scorerName] = scoreResult
+		if progress != nil {
+			progress(ScorerProgress{
+				SampleID:  sample.ID,
+				Scorer:    scorerName,
+				Result:    scoreResult,
+				Duration:  time.Since(scorerStart),
+				Completed: i + 1,
+				Total:     len(scorers),
+			})
+		}
+		if scoreResult.Status == ScorerStatusErrored {
+			e.T.Logf("Scorer %s failed: %s", scorerName, scoreResult.Error)
+			if opts.ErroredAsZero {
+				scorerCount++
+			}
+			if !opts.ContinueOnError {
+				break
 			}
-			e.T.Logf("Scorer %s failed: %v", scorerName, err)
 			continue
 		}
 
-		result.Scores[scorerName] = scoreResult
+		if unclamped, ok := scoreResult.Details[detailUnclampedScore]; ok {
+			e.T.Logf("Scorer %s returned out-of-range score %v; clamped to %v",
+				scorerName, unclamped, scoreResult.Score)
+		}
+
+		if suggester, ok := scorer.(SuggestingScorer); ok {
+			for _, suggestion := range suggester.Suggestions(sample, scoreResult) {
+				suggestion.Scorer = scorerName
+				result.Suggestions = append(result.Suggestions, suggestion)
+			}
+		}
+
 		totalScore += scoreResult.Score
 		scorerCount++
 	}
 
 	// Calculate overall score as mean
 	if scorerCount > 0 {
-		result.OverallScore = totalScore / float64(scorerCount)
+		result.OverallScore = clampScore(totalScore / float64(scorerCount))
+	}
+
+	if limit, ok := exceededLimit(sample); ok {
+		result.Status = SampleStatusLimitExceeded
+		result.StatusReason = limit
+		if sample.Result.Error != nil {
+			result.StatusReason = sample.Result.Error.Error()
+		}
+		e.T.Logf("Sample %s exceeded its %s limit; scored over the partial trajectory", sample.ID, limit)
+	} else if sample.ExpectedFailure != "" {
+		result.StatusReason = sample.ExpectedFailure
+		if result.OverallScore >= e.passThreshold() {
+			result.Status = SampleStatusUnexpectedPass
+			e.T.Logf("Sample %s passed unexpectedly (score %.3f); remove its expected_failure marker: %s",
+				sample.ID, result.OverallScore, sample.ExpectedFailure)
+		} else {
+			result.Status = SampleStatusExpectedFailure
+		}
+	}
+
+	if e.agentBreakdown {
+		result.AgentScores = e.scoreAgents(ctx, sample, scorers)
+	}
+
+	if e.baselineScoring {
+		e.scoreBaseline(ctx, sample, scorers, &result)
+	}
+
+	// Attribute LLM-judge usage to the sample
+	usage := judgeUsage(result.Scores)
+	for _, scores := range result.AgentScores {
+		agentUsage := judgeUsage(scores)
+		usage.InputTokens += agentUsage.InputTokens
+		usage.OutputTokens += agentUsage.OutputTokens
+	}
+	baselineUsage := judgeUsage(result.BaselineScores)
+	usage.InputTokens += baselineUsage.InputTokens
+	usage.OutputTokens += baselineUsage.OutputTokens
+	result.JudgeTokens = usage.Total()
+	if e.judgePricing != nil {
+		result.JudgeCostUSD = e.judgePricing.Cost(usage)
 	}
 
 	result.Duration = time.Since(startTime)
+	e.summary.add(result)
 
 	// Log the result if logger configured
 	if e.logger != nil {
@@ -147,6 +267,26 @@ func (e *E) Score(sample Sample, scorers ...Scorer) Result {
 	return result
 }
 
+// scoreAgents runs every scorer on the subtree of each delegated agent in the
+// sample trajectory. Returns nil if the trajectory has no delegated agents.
+func (e *E) scoreAgents(ctx context.Context, sample Sample, scorers []Scorer) map[string]map[string]ScoreResult {
+	agents := sample.Trajectory.Agents()
+	if len(agents) == 0 {
+		return nil
+	}
+
+	agentScores := make(map[string]map[string]ScoreResult, len(agents))
+	for _, name := range agents {
+		scoped := scopeSample(sample, name)
+		scores := make(map[string]ScoreResult, len(scorers))
+		for _, scorer := range scorers {
+			scores[scorer.Name()] = runScorer(ctx, scorer, scoped, e.scoreOptions())
+		}
+		agentScores[name] = scores
+	}
+	return agentScores
+}
+
 // ScoreAll runs all provided scorers on multiple samples and returns results for each.
 // This is equivalent to calling Score for each sample but provides a convenient batch interface.
 //
@@ -184,11 +324,22 @@ func (e *E) Log(sample Sample, result Result) error {
 //
 // This uses t.Errorf (not panic) to allow multiple assertions in a single test.
 //
+// Skipped and expected-failure results never fail the test.
+//
 // Example:
 //
 //	result := e.Score(sample, scorers...)
 //	e.RequireScore(result, 0.8) // Fails test if score < 0.8
 func (e *E) RequireScore(result Result, threshold float64) {
+	switch result.Status {
+	case SampleStatusSkipped, SampleStatusUnexpectedPass:
+		return
+	case SampleStatusExpectedFailure:
+		e.T.Logf("Sample %s failed as expected (score %.3f): %s",
+			result.SampleID, result.OverallScore, result.StatusReason)
+		return
+	}
+
 	if result.OverallScore < threshold {
 		e.T.Errorf("Score %.3f below threshold %.3f for sample %s",
 			result.OverallScore, threshold, result.SampleID)
@@ -215,6 +366,104 @@ func (e *E) WithLogger(logger Logger) *E {
 	return e
 }
 
+// WithThreshold sets the minimum acceptable score. It marks OTel spans as OK
+// or Error and decides whether expected-failure samples passed. Without a
+// threshold, an expected-failure sample passes only with a perfect score.
+//
+// Example:
+//
+//	e.WithThreshold(0.8)
+func (e *E) WithThreshold(threshold float64) *E {
+	e.scoreThreshold = threshold
+	return e
+}
+
+// passThreshold returns the score an expected-failure sample must reach to
+// count as passing.
+func (e *E) passThreshold() float64 {
+	if e.scoreThreshold > 0 {
+		return e.scoreThreshold
+	}
+	return 1.0
+}
+
+// WithAgentBreakdown enables per-agent sub-results. When a sample's
+// trajectory contains delegated agents, Score additionally runs every scorer
+// on each agent's subtree and stores the results in Result.AgentScores, so a
+// poor score can be attributed to the sub-agent responsible. The overall
+// score is unaffected.
+//
+// Example:
+//
+//	result := e.WithAgentBreakdown().Score(sample, scorers...)
+//	for agentName, scores := range result.AgentScores {
+//	    e.T.Logf("%s: %.2f", agentName, scores["finding_accuracy"].Score)
+//	}
+func (e *E) WithAgentBreakdown() *E {
+	e.agentBreakdown = true
+	return e
+}
+
+// WithJudgePricing sets the price of LLM-judge tokens. Each result then
+// carries the judge cost of its sample in JudgeCostUSD, which is also written
+// to the log and totalled in the summary.
+//
+// Example:
+//
+//	e.WithJudgePricing(eval.JudgePricing{InputPerMillion: 3, OutputPerMillion: 15})
+func (e *E) WithJudgePricing(pricing JudgePricing) *E {
+	e.judgePricing = &pricing
+	return e
+}
+
+// Summary returns aggregate statistics over all samples scored so far,
+// including LLM-judge token usage and the costliest samples.
+func (e *E) Summary() Summary {
+	return e.summary.snapshot()
+}
+
+// logSummary logs the summary of scored samples to the test output.
+func (e *E) logSummary() {
+	s := e.Summary()
+	if s.Skipped > 0 {
+		e.T.Logf("Skipped %d samples", s.Skipped)
+	}
+	for _, id := range s.UnexpectedPasses {
+		e.T.Logf("Unexpected pass: %s (remove its expected_failure marker)", id)
+	}
+	if s.Samples == 0 {
+		return
+	}
+
+	e.T.Logf("Scored %d samples, mean score %.3f", s.Samples, s.MeanScore)
+	if s.BaselineSamples > 0 {
+		e.T.Logf("Mean improvement over baseline %+.3f across %d samples", s.MeanImprovement, s.BaselineSamples)
+	}
+	if s.ExpectedFailures > 0 {
+		e.T.Logf("%d samples failed as expected", s.ExpectedFailures)
+	}
+	if s.JudgeTokens == 0 {
+		return
+	}
+	if e.judgePricing != nil {
+		e.T.Logf("LLM judge usage: %d tokens ($%.4f)", s.JudgeTokens, s.JudgeCostUSD)
+	} else {
+		e.T.Logf("LLM judge usage: %d tokens", s.JudgeTokens)
+	}
+
+	const maxListed = 5
+	for i, c := range s.CostliestSamples {
+		if i == maxListed {
+			break
+		}
+		if e.judgePricing != nil {
+			e.T.Logf("  %s: %d tokens ($%.4f)", c.SampleID, c.JudgeTokens, c.JudgeCostUSD)
+		} else {
+			e.T.Logf("  %s: %d tokens", c.SampleID, c.JudgeTokens)
+		}
+	}
+}
+
 // WithOTel configures OpenTelemetry integration for evaluation metrics and tracing.
 // This enables automatic span creation and metric emission for evaluation operations.
 //
@@ -1,11 +1,16 @@
 package eval
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 
+	"github.com/zero-day-ai/sdk/agent"
 	"gopkg.in/yaml.v3"
 )
 
@@ -67,6 +72,30 @@ func (e *EvalSet) Validate() error {
 			return fmt.Errorf("sample %s at index %d is missing required field 'task.id'", sample.ID, i)
 		}
 
+		// Check expected outcome is well-formed
+		if sample.ExpectedStatus != "" {
+			if !sample.ExpectedStatus.IsValid() {
+				return fmt.Errorf("sample %s at index %d has invalid expected_status %q", sample.ID, i, sample.ExpectedStatus)
+			}
+			if sample.ExpectError && sample.ExpectedStatus == agent.StatusSuccess {
+				return fmt.Errorf("sample %s at index %d sets expect_error with expected_status %q", sample.ID, i, sample.ExpectedStatus)
+			}
+		}
+
+		if err := validateStepRange(sample.ExpectedStepRange); err != nil {
+			return fmt.Errorf("sample %s at index %d has %w", sample.ID, i, err)
+		}
+
+		for _, gt := range sample.ExpectedFindings {
+			if gt.Weight < 0 {
+				return fmt.Errorf("sample %s at index %d has expected finding %q with negative weight %g", sample.ID, i, gt.ID, gt.Weight)
+			}
+		}
+
+		if sample.Skip != "" && sample.ExpectedFailure != "" {
+			return fmt.Errorf("sample %s at index %d sets both skip and expected_failure", sample.ID, i)
+		}
+
 		// Check for duplicate IDs
 		if seenIDs[sample.ID] {
 			return fmt.Errorf("duplicate sample ID found: %s", sample.ID)
@@ -77,15 +106,14 @@ func (e *EvalSet) Validate() error {
 	return nil
 }
 
-// FilterByTags returns a new EvalSet containing only samples that have all specified tags.
-// The original EvalSet is not modified.
-// If tags is empty or nil, returns a copy of the entire EvalSet.
-func (e *EvalSet) FilterByTags(tags []string) *EvalSet {
-	// If no tags specified, return a copy of the entire set
-	if len(tags) == 0 {
-		return e.copy()
-	}
-
+// Filter returns a new EvalSet containing only the samples for which
+// predicate returns true. The original EvalSet is not modified. Filters
+// compose by chaining:
+//
+//	sqli := evalSet.FilterByTags([]string{"smoke"}).Filter(func(s eval.Sample) bool {
+//	    return strings.Contains(strings.ToLower(s.Task.Goal), "sql")
+//	})
+func (e *EvalSet) Filter(predicate func(Sample) bool) *EvalSet {
 	// Create a new EvalSet with the same metadata
 	filtered := &EvalSet{
 		Name:     e.Name,
@@ -94,9 +122,8 @@ func (e *EvalSet) FilterByTags(tags []string) *EvalSet {
 		Samples:  make([]Sample, 0),
 	}
 
-	// Filter samples by tags
 	for _, sample := range e.Samples {
-		if hasAllTags(sample.Tags, tags) {
+		if predicate(sample) {
 			filtered.Samples = append(filtered.Samples, sample)
 		}
 	}
@@ -104,6 +131,207 @@ func (e *EvalSet) FilterByTags(tags []string) *EvalSet {
 	return filtered
 }
 
+// FilterByTags returns a new EvalSet containing only samples that have all specified tags.
+// The original EvalSet is not modified.
+// If tags is empty or nil, returns a copy of the entire EvalSet.
+func (e *EvalSet) FilterByTags(tags []string) *EvalSet {
+	// If no tags specified, return a copy of the entire set
+	if len(tags) == 0 {
+		return e.copy()
+	}
+
+	return e.Filter(func(sample Sample) bool {
+		return hasAllTags(sample.Tags, tags)
+	})
+}
+
+// FilterBySeverity returns a new EvalSet containing only samples with at
+// least one expected finding of one of the given severities.
+// The original EvalSet is not modified.
+// If severities is empty, returns a copy of the entire EvalSet.
+func (e *EvalSet) FilterBySeverity(severities ...string) *EvalSet {
+	if len(severities) == 0 {
+		return e.copy()
+	}
+
+	return e.Filter(func(sample Sample) bool {
+		return hasExpectedFinding(sample, func(gt GroundTruthFinding) bool {
+			return slices.Contains(severities, gt.Severity)
+		})
+	})
+}
+
+// FilterByCategory returns a new EvalSet containing only samples with at
+// least one expected finding in one of the given categories.
+// The original EvalSet is not modified.
+// If categories is empty, returns a copy of the entire EvalSet.
+func (e *EvalSet) FilterByCategory(categories ...string) *EvalSet {
+	if len(categories) == 0 {
+		return e.copy()
+	}
+
+	return e.Filter(func(sample Sample) bool {
+		return hasExpectedFinding(sample, func(gt GroundTruthFinding) bool {
+			return slices.Contains(categories, gt.Category)
+		})
+	})
+}
+
+// Sample returns a new EvalSet with n samples chosen pseudo-randomly, for
+// quick smoke runs. The choice depends only on seed and the sample IDs, so
+// the same seed selects the same samples on every run. Selected samples keep
+// their order. If n is at least the number of samples, all are returned.
+// The original EvalSet is not modified.
+//
+// Example:
+//
+//	smoke := evalSet.Sample(50, 1)
+func (e *EvalSet) Sample(n int, seed int64) *EvalSet {
+	return e.withIndices(pickSamples(e.Samples, allIndices(len(e.Samples)), n, seed))
+}
+
+// Stratified returns a new EvalSet with n samples spread across the groups
+// returned by by, such as a category or tag, in proportion to the size of
+// each group. When n is at least the number of groups, every group is
+// represented. Within a group, samples are chosen as Sample chooses them
+// with seed 0, so the selection is deterministic. Selected samples keep
+// their order. The original EvalSet is not modified.
+//
+// Example:
+//
+//	smoke := evalSet.Stratified(50, func(s eval.Sample) string {
+//	    if len(s.Tags) == 0 {
+//	        return ""
+//	    }
+//	    return s.Tags[0]
+//	})
+func (e *EvalSet) Stratified(n int, by func(Sample) string) *EvalSet {
+	if n >= len(e.Samples) {
+		return e.copy()
+	}
+
+	groups := make(map[string][]int)
+	var keys []string
+	for i, sample := range e.Samples {
+		key := by(sample)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+	sort.Strings(keys)
+
+	sizes := make([]int, len(keys))
+	for i, key := range keys {
+		sizes[i] = len(groups[key])
+	}
+
+	var indices []int
+	for i, quota := range allocateStrata(n, sizes) {
+		indices = append(indices, pickSamples(e.Samples, groups[keys[i]], quota, 0)...)
+	}
+	sort.Ints(indices)
+	return e.withIndices(indices)
+}
+
+// allocateStrata splits n between groups of the given sizes in proportion
+// to their size, by the largest remainder method. When n is at least the
+// number of groups, groups left empty take one from the largest quota.
+func allocateStrata(n int, sizes []int) []int {
+	quotas := make([]int, len(sizes))
+	total := 0
+	for _, size := range sizes {
+		total += size
+	}
+	if n <= 0 || total == 0 {
+		return quotas
+	}
+
+	fractions := make([]float64, len(sizes))
+	assigned := 0
+	for i, size := range sizes {
+		exact := float64(n) * float64(size) / float64(total)
+		quotas[i] = int(exact)
+		fractions[i] = exact - float64(quotas[i])
+		assigned += quotas[i]
+	}
+	order := allIndices(len(sizes))
+	sort.SliceStable(order, func(a, b int) bool {
+		return fractions[order[a]] > fractions[order[b]]
+	})
+	for _, i := range order[:n-assigned] {
+		quotas[i]++
+	}
+
+	if n < len(sizes) {
+		return quotas
+	}
+	for i := range quotas {
+		if quotas[i] > 0 {
+			continue
+		}
+		largest := 0
+		for j := range quotas {
+			if quotas[j] > quotas[largest] {
+				largest = j
+			}
+		}
+		quotas[largest]--
+		quotas[i]++
+	}
+	return quotas
+}
+
+// pickSamples returns up to n of the given indices into samples, ordered by
+// a hash of seed and each sample's ID, in ascending index order.
+func pickSamples(samples []Sample, indices []int, n int, seed int64) []int {
+	if n <= 0 {
+		return nil
+	}
+	if n >= len(indices) {
+		return slices.Clone(indices)
+	}
+
+	ranks := make(map[int]uint64, len(indices))
+	for _, i := range indices {
+		h := fnv.New64a()
+		binary.Write(h, binary.LittleEndian, seed)
+		h.Write([]byte(samples[i].ID))
+		ranks[i] = h.Sum64()
+	}
+	ranked := slices.Clone(indices)
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return ranks[ranked[a]] < ranks[ranked[b]]
+	})
+
+	picked := ranked[:n]
+	sort.Ints(picked)
+	return picked
+}
+
+// allIndices returns 0 through n-1.
+func allIndices(n int) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
+// withIndices returns a new EvalSet with the samples at the given indices.
+func (e *EvalSet) withIndices(indices []int) *EvalSet {
+	subset := &EvalSet{
+		Name:     e.Name,
+		Version:  e.Version,
+		Metadata: e.Metadata,
+		Samples:  make([]Sample, 0, len(indices)),
+	}
+	for _, i := range indices {
+		subset.Samples = append(subset.Samples, e.Samples[i])
+	}
+	return subset
+}
+
 // copy creates a shallow copy of the EvalSet.
 // This is used when no filtering is needed but a new instance is expected.
 func (e *EvalSet) copy() *EvalSet {
@@ -133,3 +361,9 @@ func hasAllTags(sampleTags, requiredTags []string) bool {
 
 	return true
 }
+
+// hasExpectedFinding reports whether any of the sample's expected findings
+// satisfies match.
+func hasExpectedFinding(sample Sample, match func(GroundTruthFinding) bool) bool {
+	return slices.ContainsFunc(sample.ExpectedFindings, match)
+}
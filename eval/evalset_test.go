@@ -1,8 +1,12 @@
 package eval
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -301,6 +305,65 @@ func TestValidate_DuplicateIDs(t *testing.T) {
 	assert.Contains(t, err.Error(), "duplicate-id")
 }
 
+func TestValidate_ExpectedOutcome(t *testing.T) {
+	tests := []struct {
+		name   string
+		sample Sample
+		errMsg string
+	}{
+		{
+			name:   "invalid status",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, ExpectedStatus: "declined"},
+			errMsg: `invalid expected_status "declined"`,
+		},
+		{
+			name:   "expect error with success",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, ExpectError: true, ExpectedStatus: agent.StatusSuccess},
+			errMsg: "sets expect_error",
+		},
+		{
+			name:   "expect error with failed",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, ExpectError: true, ExpectedStatus: agent.StatusFailed},
+		},
+		{
+			name:   "skip with expected failure",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, Skip: "broken", ExpectedFailure: "known bug"},
+			errMsg: "both skip and expected_failure",
+		},
+		{
+			name:   "expect error with refused",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, ExpectError: true, ExpectedStatus: agent.StatusRefused},
+		},
+		{
+			name:   "inverted step range",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, ExpectedStepRange: [2]int{20, 5}},
+			errMsg: "minimum exceeds maximum",
+		},
+		{
+			name:   "step range without maximum",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, ExpectedStepRange: [2]int{5, 0}},
+		},
+		{
+			name:   "negative finding weight",
+			sample: Sample{ID: "s1", Task: agent.Task{ID: "t1"}, ExpectedFindings: []GroundTruthFinding{{ID: "sqli", Weight: -1}}},
+			errMsg: `expected finding "sqli" with negative weight`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evalSet := &EvalSet{Name: "test", Samples: []Sample{tt.sample}}
+			err := evalSet.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
 func TestValidate_Valid(t *testing.T) {
 	evalSet := &EvalSet{
 		Name:    "test",
@@ -497,6 +560,105 @@ func TestFilterByTags_PreservesMetadata(t *testing.T) {
 	assert.Equal(t, evalSet.Metadata, filtered.Metadata)
 }
 
+// filterTestSet returns an eval set for the predicate filter tests.
+func filterTestSet() *EvalSet {
+	return &EvalSet{
+		Name:     "filters",
+		Version:  "1.0.0",
+		Metadata: map[string]any{"author": "test-author"},
+		Samples: []Sample{
+			{
+				ID:   "sqli-login",
+				Task: agent.Task{Goal: "Find SQL injection in the login form"},
+				ExpectedFindings: []GroundTruthFinding{
+					{ID: "gt-1", Severity: "high", Category: "injection"},
+					{ID: "gt-2", Severity: "low", Category: "exposure"},
+					{ID: "gt-3", Severity: "medium", Category: "misconfiguration"},
+				},
+				Tags: []string{"smoke"},
+			},
+			{
+				ID:   "xss-search",
+				Task: agent.Task{Goal: "Find XSS in the search page"},
+				ExpectedFindings: []GroundTruthFinding{
+					{ID: "gt-4", Severity: "medium", Category: "xss"},
+				},
+				Tags: []string{"smoke"},
+			},
+			{
+				ID:   "sqli-report",
+				Task: agent.Task{Goal: "Check the reports API for sql injection"},
+				ExpectedFindings: []GroundTruthFinding{
+					{ID: "gt-5", Severity: "critical", Category: "injection"},
+				},
+			},
+			{
+				ID:   "recon",
+				Task: agent.Task{Goal: "Enumerate subdomains"},
+			},
+		},
+	}
+}
+
+func sampleIDs(set *EvalSet) []string {
+	ids := make([]string, len(set.Samples))
+	for i, sample := range set.Samples {
+		ids[i] = sample.ID
+	}
+	return ids
+}
+
+func TestEvalSet_Filter(t *testing.T) {
+	evalSet := filterTestSet()
+
+	mentionsSQL := evalSet.Filter(func(s Sample) bool {
+		return strings.Contains(strings.ToLower(s.Task.Goal), "sql")
+	})
+	assert.Equal(t, []string{"sqli-login", "sqli-report"}, sampleIDs(mentionsSQL))
+	assert.Equal(t, evalSet.Name, mentionsSQL.Name)
+	assert.Equal(t, evalSet.Metadata, mentionsSQL.Metadata)
+
+	manyFindings := evalSet.Filter(func(s Sample) bool { return len(s.ExpectedFindings) > 2 })
+	assert.Equal(t, []string{"sqli-login"}, sampleIDs(manyFindings))
+
+	none := evalSet.Filter(func(Sample) bool { return false })
+	assert.NotNil(t, none.Samples)
+	assert.Empty(t, none.Samples)
+
+	// The original set is not modified
+	assert.Len(t, evalSet.Samples, 4)
+}
+
+func TestEvalSet_FilterComposes(t *testing.T) {
+	evalSet := filterTestSet()
+
+	smokeSQL := evalSet.FilterByTags([]string{"smoke"}).Filter(func(s Sample) bool {
+		return strings.Contains(strings.ToLower(s.Task.Goal), "sql")
+	})
+	assert.Equal(t, []string{"sqli-login"}, sampleIDs(smokeSQL))
+
+	mediumSmoke := evalSet.FilterBySeverity("medium").FilterByTags([]string{"smoke"})
+	assert.Equal(t, []string{"sqli-login", "xss-search"}, sampleIDs(mediumSmoke))
+}
+
+func TestEvalSet_FilterBySeverity(t *testing.T) {
+	evalSet := filterTestSet()
+
+	assert.Equal(t, []string{"sqli-report"}, sampleIDs(evalSet.FilterBySeverity("critical")))
+	assert.Equal(t, []string{"sqli-login", "sqli-report"}, sampleIDs(evalSet.FilterBySeverity("critical", "high")))
+	assert.Empty(t, evalSet.FilterBySeverity("info").Samples)
+	assert.Len(t, evalSet.FilterBySeverity().Samples, 4, "no severities returns all samples")
+}
+
+func TestEvalSet_FilterByCategory(t *testing.T) {
+	evalSet := filterTestSet()
+
+	assert.Equal(t, []string{"sqli-login", "sqli-report"}, sampleIDs(evalSet.FilterByCategory("injection")))
+	assert.Equal(t, []string{"sqli-login", "xss-search", "sqli-report"}, sampleIDs(evalSet.FilterByCategory("xss", "injection")))
+	assert.Empty(t, evalSet.FilterByCategory("Injection").Samples, "categories match exactly")
+	assert.Len(t, evalSet.FilterByCategory().Samples, 4, "no categories returns all samples")
+}
+
 func TestHasAllTags(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -621,3 +783,96 @@ func TestLoadEvalSet_Integration(t *testing.T) {
 	filtered = evalSet.FilterByTags([]string{"advanced"})
 	assert.Len(t, filtered.Samples, 0)
 }
+
+// numberedSet returns an eval set of n samples tagged with the given groups
+// in turn.
+func numberedSet(n int, groups ...string) *EvalSet {
+	evalSet := &EvalSet{Name: "numbered", Version: "1.0"}
+	for i := 0; i < n; i++ {
+		sample := Sample{ID: fmt.Sprintf("sample-%03d", i)}
+		if len(groups) > 0 {
+			sample.Tags = []string{groups[i%len(groups)]}
+		}
+		evalSet.Samples = append(evalSet.Samples, sample)
+	}
+	return evalSet
+}
+
+func TestEvalSet_Sample(t *testing.T) {
+	evalSet := numberedSet(100)
+
+	first := evalSet.Sample(10, 1)
+	assert.Len(t, first.Samples, 10)
+	assert.Equal(t, evalSet.Name, first.Name)
+	assert.Equal(t, sampleIDs(first), sampleIDs(evalSet.Sample(10, 1)), "same seed, same samples")
+	assert.NotEqual(t, sampleIDs(first), sampleIDs(evalSet.Sample(10, 2)), "different seed")
+	assert.True(t, sort.StringsAreSorted(sampleIDs(first)), "original order is kept")
+
+	// A larger sample with the same seed extends the smaller one
+	assert.Subset(t, sampleIDs(evalSet.Sample(20, 1)), sampleIDs(first))
+
+	// Selection depends on sample IDs, not positions
+	reversed := &EvalSet{Samples: slices.Clone(evalSet.Samples)}
+	slices.Reverse(reversed.Samples)
+	assert.ElementsMatch(t, sampleIDs(first), sampleIDs(reversed.Sample(10, 1)))
+
+	assert.Len(t, evalSet.Sample(200, 1).Samples, 100)
+	assert.Empty(t, evalSet.Sample(0, 1).Samples)
+	assert.Len(t, evalSet.Samples, 100, "the original set is not modified")
+}
+
+func TestEvalSet_Stratified(t *testing.T) {
+	byTag := func(s Sample) string { return s.Tags[0] }
+	count := func(evalSet *EvalSet) map[string]int {
+		counts := make(map[string]int)
+		for _, s := range evalSet.Samples {
+			counts[byTag(s)]++
+		}
+		return counts
+	}
+
+	// 60 web, 30 network, 10 cloud
+	evalSet := numberedSet(60, "web")
+	evalSet.Samples = append(evalSet.Samples, numberedSet(30, "network").Samples...)
+	evalSet.Samples = append(evalSet.Samples, numberedSet(10, "cloud").Samples...)
+	for i := range evalSet.Samples {
+		evalSet.Samples[i].ID = fmt.Sprintf("s-%03d", i)
+	}
+
+	subset := evalSet.Stratified(20, byTag)
+	assert.Equal(t, map[string]int{"web": 12, "network": 6, "cloud": 2}, count(subset))
+	assert.Equal(t, sampleIDs(subset), sampleIDs(evalSet.Stratified(20, byTag)), "deterministic")
+	assert.True(t, sort.StringsAreSorted(sampleIDs(subset)), "original order is kept")
+
+	// Small groups are represented when there is room for every group
+	assert.Equal(t, map[string]int{"web": 2, "network": 1, "cloud": 1}, count(evalSet.Stratified(4, byTag)))
+
+	// With fewer picks than groups, the largest groups are picked
+	assert.Equal(t, map[string]int{"web": 1, "network": 1}, count(evalSet.Stratified(2, byTag)))
+
+	assert.Len(t, evalSet.Stratified(500, byTag).Samples, 100)
+	assert.Empty(t, evalSet.Stratified(0, byTag).Samples)
+}
+
+func TestAllocateStrata(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		sizes []int
+		want  []int
+	}{
+		{"proportional", 10, []int{50, 30, 20}, []int{5, 3, 2}},
+		{"largest remainder", 3, []int{5, 4}, []int{2, 1}},
+		{"largest remainder with every group", 3, []int{5, 5, 2}, []int{1, 1, 1}},
+		{"every group represented", 5, []int{97, 2, 1}, []int{3, 1, 1}},
+		{"capacity", 6, []int{1, 10}, []int{1, 5}},
+		{"fewer picks than groups", 1, []int{1, 5}, []int{0, 1}},
+		{"zero", 0, []int{3, 3}, []int{0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := allocateStrata(tt.n, tt.sizes)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
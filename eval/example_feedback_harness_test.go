@@ -161,6 +161,20 @@ func (e *exampleHarness) CallToolProto(ctx context.Context, name string, request
 	return nil
 }
 
+func (e *exampleHarness) CallToolProtoStream(ctx context.Context, name string, input protolib.Message, output protolib.Message, callback agent.ToolStreamCallback) error {
+	return nil
+}
+
+func (e *exampleHarness) QueueToolWork(ctx context.Context, toolName string, inputs []protolib.Message) (string, error) {
+	return "", nil
+}
+
+func (e *exampleHarness) ToolResults(ctx context.Context, jobID string) <-chan agent.QueuedToolResult {
+	ch := make(chan agent.QueuedToolResult)
+	close(ch)
+	return ch
+}
+
 func (e *exampleHarness) ListTools(ctx context.Context) ([]tool.Descriptor, error) {
 	return nil, nil
 }
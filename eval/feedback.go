@@ -16,9 +16,14 @@ type Feedback struct {
 	// StepIndex is the trajectory step index when this feedback was generated.
 	StepIndex int `json:"step_index" yaml:"step_index"`
 
-	// Scores contains individual partial scores from each scorer, keyed by scorer name.
+	// Scores contains the latest partial score from each scorer, keyed by scorer name.
+	// Under per-scorer scheduling some scores may come from earlier evaluations.
 	Scores map[string]PartialScore `json:"scores" yaml:"scores"`
 
+	// EvaluatedAt records when each score in Scores was computed, keyed by
+	// scorer name, so consumers can judge the staleness of each signal.
+	EvaluatedAt map[string]time.Time `json:"evaluated_at,omitempty" yaml:"evaluated_at,omitempty"`
+
 	// Overall is the aggregated partial score across all scorers.
 	Overall PartialScore `json:"overall" yaml:"overall"`
 
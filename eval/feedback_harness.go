@@ -3,6 +3,7 @@ package eval
 import (
 	"context"
 	"log/slog"
+	"sort"
 	"sync"
 	"time"
 
@@ -35,6 +36,19 @@ type FeedbackOptions struct {
 	// Frequency controls when feedback evaluations are triggered.
 	Frequency FeedbackFrequency
 
+	// PerScorer overrides Frequency for individual scorers, keyed by scorer
+	// name. When set, each scorer is scheduled on its own frequency and an
+	// evaluation runs only the scorers that are due; scorers without an entry
+	// use Frequency.EveryNSteps and Frequency.OnThreshold. Frequency.Debounce
+	// then limits the harness to one scorer evaluation per debounce window,
+	// taken by the highest-priority due scorer.
+	PerScorer map[string]FeedbackFrequency
+
+	// Priority lists scorer names from most to least important. It orders
+	// evaluations, and decides which due scorer runs when a debounce window
+	// allows only one. Scorers not listed follow in the order of Scorers.
+	Priority []string
+
 	// AutoInject controls whether feedback is automatically injected into LLM calls.
 	// If true, the harness will prepend feedback messages to LLM message history.
 	// Default: false (agent must explicitly call GetFeedback)
@@ -80,6 +94,17 @@ type FeedbackHarness struct {
 	lastEvalStepIndex int
 	stepsSinceEval    int
 
+	// Per-scorer scheduling, used when PerScorer is set
+	prioritized  []StreamingScorer
+	schedules    map[string]*scorerSchedule
+	lastPassTime time.Time
+
+	// Latest score and evaluation time of each scorer
+	latestScores map[string]PartialScore
+	evaluatedAt  map[string]time.Time
+
+	now func() time.Time
+
 	// Background evaluation
 	evalCtx    context.Context
 	evalCancel context.CancelFunc
@@ -91,6 +116,17 @@ type FeedbackHarness struct {
 type evalRequest struct {
 	ctx       context.Context
 	stepIndex int
+
+	// scorers to evaluate, in priority order; nil evaluates all scorers
+	scorers []StreamingScorer
+}
+
+// scorerSchedule tracks when a scorer was last evaluated under per-scorer
+// scheduling.
+type scorerSchedule struct {
+	frequency      FeedbackFrequency
+	stepsSinceEval int
+	lastEval       time.Time
 }
 
 // NewFeedbackHarness creates a new feedback harness that wraps the given inner harness.
@@ -118,6 +154,27 @@ func NewFeedbackHarness(inner agent.Harness, opts FeedbackOptions) *FeedbackHarn
 		evalCancel:   evalCancel,
 		evalQueue:    make(chan evalRequest, 10), // Buffer up to 10 eval requests
 		lastEvalTime: time.Now(),
+		prioritized:  prioritizeScorers(opts.Scorers, opts.Priority),
+		latestScores: make(map[string]PartialScore),
+		evaluatedAt:  make(map[string]time.Time),
+		now:          time.Now,
+	}
+
+	if len(opts.PerScorer) > 0 {
+		fh.schedules = make(map[string]*scorerSchedule)
+		for _, scorer := range opts.Scorers {
+			freq, ok := opts.PerScorer[scorer.Name()]
+			if !ok {
+				freq = FeedbackFrequency{
+					EveryNSteps: opts.Frequency.EveryNSteps,
+					OnThreshold: opts.Frequency.OnThreshold,
+				}
+			}
+			if freq.EveryNSteps <= 0 {
+				freq.EveryNSteps = 1
+			}
+			fh.schedules[scorer.Name()] = &scorerSchedule{frequency: freq}
+		}
 	}
 
 	// Start background evaluation worker
@@ -151,11 +208,15 @@ func (f *FeedbackHarness) processEvaluation(req evalRequest) {
 		return
 	}
 
-	// Evaluate with all scorers
-	scores := make(map[string]PartialScore)
-	var allScores []PartialScore
+	scorers := req.scorers
+	if scorers == nil {
+		scorers = f.prioritized
+	}
 
-	for _, scorer := range f.opts.Scorers {
+	// Evaluate the requested scorers
+	fresh := make(map[string]PartialScore)
+	freshAt := make(map[string]time.Time)
+	for _, scorer := range scorers {
 		if !scorer.SupportsStreaming() {
 			continue
 		}
@@ -166,15 +227,39 @@ func (f *FeedbackHarness) processEvaluation(req evalRequest) {
 			continue
 		}
 
-		scores[scorer.Name()] = score
-		allScores = append(allScores, score)
+		fresh[scorer.Name()] = score
+		freshAt[scorer.Name()] = f.now()
 	}
 
 	// Skip if no scores were generated
-	if len(scores) == 0 {
+	if len(fresh) == 0 {
 		return
 	}
 
+	// The snapshot holds the latest score of every scorer, including those
+	// not evaluated this time; EvaluatedAt tells how fresh each one is.
+	f.mu.Lock()
+	for name, score := range fresh {
+		f.latestScores[name] = score
+		f.evaluatedAt[name] = freshAt[name]
+	}
+	scores := make(map[string]PartialScore, len(f.latestScores))
+	for name, score := range f.latestScores {
+		scores[name] = score
+	}
+	evaluatedAt := make(map[string]time.Time, len(f.evaluatedAt))
+	for name, at := range f.evaluatedAt {
+		evaluatedAt[name] = at
+	}
+	f.mu.Unlock()
+
+	var allScores []PartialScore
+	for _, scorer := range f.opts.Scorers {
+		if score, ok := scores[scorer.Name()]; ok {
+			allScores = append(allScores, score)
+		}
+	}
+
 	// Aggregate scores
 	overall := f.aggregateScores(allScores)
 
@@ -183,12 +268,13 @@ func (f *FeedbackHarness) processEvaluation(req evalRequest) {
 
 	// Create feedback
 	feedback := Feedback{
-		Timestamp: time.Now(),
-		StepIndex: req.stepIndex,
-		Scores:    scores,
-		Overall:   overall,
-		Alerts:    alerts,
-		Consumed:  false,
+		Timestamp:   f.now(),
+		StepIndex:   req.stepIndex,
+		Scores:      scores,
+		EvaluatedAt: evaluatedAt,
+		Overall:     overall,
+		Alerts:      alerts,
+		Consumed:    false,
 	}
 
 	// Store feedback
@@ -410,8 +496,100 @@ func (f *FeedbackHarness) shouldEvaluate() bool {
 	return true
 }
 
+// prioritizeScorers orders scorers by the names in priority, followed by the
+// unlisted scorers in their original order.
+func prioritizeScorers(scorers []StreamingScorer, priority []string) []StreamingScorer {
+	rank := make(map[string]int, len(priority))
+	for i, name := range priority {
+		if _, ok := rank[name]; !ok {
+			rank[name] = i
+		}
+	}
+
+	ordered := make([]StreamingScorer, len(scorers))
+	copy(ordered, scorers)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ri, iok := rank[ordered[i].Name()]
+		rj, jok := rank[ordered[j].Name()]
+		if iok && jok {
+			return ri < rj
+		}
+		return iok && !jok
+	})
+	return ordered
+}
+
+// dueScorers advances every scorer's step count and returns the scorers due
+// for evaluation under per-scorer scheduling, in priority order. Within a
+// Frequency.Debounce window only the first due scorer is returned.
+// The caller must hold f.mu.
+func (f *FeedbackHarness) dueScorers() []StreamingScorer {
+	now := f.now()
+
+	var due []StreamingScorer
+	for _, scorer := range f.prioritized {
+		if !scorer.SupportsStreaming() {
+			continue
+		}
+		sched := f.schedules[scorer.Name()]
+		sched.stepsSinceEval++
+		if sched.stepsSinceEval < sched.frequency.EveryNSteps && !sched.frequency.OnThreshold {
+			continue
+		}
+		if d := sched.frequency.Debounce; d > 0 && !sched.lastEval.IsZero() && now.Sub(sched.lastEval) < d {
+			continue
+		}
+		due = append(due, scorer)
+	}
+
+	if d := f.opts.Frequency.Debounce; d > 0 && len(due) > 0 {
+		if !f.lastPassTime.IsZero() && now.Sub(f.lastPassTime) < d {
+			return nil
+		}
+		due = due[:1]
+	}
+	return due
+}
+
+// triggerScheduledEvaluation queues an evaluation of the due scorers under
+// per-scorer scheduling.
+func (f *FeedbackHarness) triggerScheduledEvaluation(ctx context.Context) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	due := f.dueScorers()
+	if len(due) == 0 {
+		return
+	}
+
+	req := evalRequest{
+		ctx:       ctx,
+		stepIndex: len(f.recording.Trajectory().Steps) - 1,
+		scorers:   due,
+	}
+
+	// Queue evaluation (non-blocking); skipped scorers stay due
+	select {
+	case f.evalQueue <- req:
+		now := f.now()
+		for _, scorer := range due {
+			sched := f.schedules[scorer.Name()]
+			sched.stepsSinceEval = 0
+			sched.lastEval = now
+		}
+		f.lastPassTime = now
+		f.lastEvalTime = now
+	default:
+	}
+}
+
 // triggerEvaluation queues an evaluation request if frequency conditions are met.
 func (f *FeedbackHarness) triggerEvaluation(ctx context.Context) {
+	if f.schedules != nil {
+		f.triggerScheduledEvaluation(ctx)
+		return
+	}
+
 	if !f.shouldEvaluate() {
 		return
 	}
@@ -579,6 +757,12 @@ func (f *FeedbackHarness) SubmitFinding(ctx context.Context, finding *finding.Fi
 	return err
 }
 
+// RecordReasoning records the agent's reasoning as a trajectory step.
+func (f *FeedbackHarness) RecordReasoning(ctx context.Context, text string) {
+	f.recording.RecordReasoning(ctx, text)
+	f.recordAndEvaluate(ctx)
+}
+
 // GetFindings retrieves findings matching the given filter criteria.
 func (f *FeedbackHarness) GetFindings(ctx context.Context, filter finding.Filter) ([]*finding.Finding, error) {
 	return f.recording.GetFindings(ctx, filter)
@@ -2,6 +2,8 @@ package eval
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -628,3 +630,167 @@ func TestFeedbackHarnessToolCallRecording(t *testing.T) {
 	assert.Len(t, traj.Steps, 1)
 	assert.Equal(t, "tool", traj.Steps[0].Type)
 }
+
+// countingStreamingScorer is a streaming scorer that counts its evaluations.
+type countingStreamingScorer struct {
+	mockStreamingScorer
+	calls atomic.Int32
+}
+
+func newCountingStreamingScorer(name string) *countingStreamingScorer {
+	return &countingStreamingScorer{mockStreamingScorer: mockStreamingScorer{
+		name:           name,
+		score:          PartialScore{Score: 0.8, Confidence: 0.9, Status: ScoreStatusPartial, Action: ActionContinue},
+		supportsStream: true,
+	}}
+}
+
+func (c *countingStreamingScorer) ScorePartial(ctx context.Context, trajectory Trajectory) (PartialScore, error) {
+	c.calls.Add(1)
+	return c.score, nil
+}
+
+// feedbackTestClock is a manually advanced clock.
+type feedbackTestClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *feedbackTestClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *feedbackTestClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// runScheduleMatrix performs one operation per row, advancing the clock by a
+// second before each, and checks the cumulative evaluation counts of the
+// scorers after every step.
+func runScheduleMatrix(t *testing.T, fh *FeedbackHarness, clock *feedbackTestClock, scorers []*countingStreamingScorer, want [][]int32) {
+	t.Helper()
+	ctx := context.Background()
+	messages := []llm.Message{{Role: "user", Content: "test"}}
+
+	for step, counts := range want {
+		clock.Advance(time.Second)
+		_, _ = fh.Complete(ctx, "primary", messages)
+
+		require.Eventually(t, func() bool {
+			for i, s := range scorers {
+				if s.calls.Load() != counts[i] {
+					return false
+				}
+			}
+			return true
+		}, time.Second, time.Millisecond, "step %d: want counts %v", step+1, counts)
+	}
+}
+
+// TestFeedbackHarnessPerScorerFrequency tests that each scorer is evaluated
+// on its own step frequency and that feedback records per-scorer timestamps.
+func TestFeedbackHarnessPerScorerFrequency(t *testing.T) {
+	tool := newCountingStreamingScorer("tool")
+	finding := newCountingStreamingScorer("finding")
+	trajectory := newCountingStreamingScorer("trajectory")
+
+	fh := NewFeedbackHarness(&mockHarness{}, FeedbackOptions{
+		Scorers:   []StreamingScorer{tool, finding, trajectory},
+		Frequency: FeedbackFrequency{EveryNSteps: 1},
+		PerScorer: map[string]FeedbackFrequency{
+			"finding":    {EveryNSteps: 3},
+			"trajectory": {EveryNSteps: 10},
+		},
+	})
+	defer fh.Close()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &feedbackTestClock{now: start}
+	fh.now = clock.Now
+
+	// Columns: tool, finding, trajectory
+	runScheduleMatrix(t, fh, clock, []*countingStreamingScorer{tool, finding, trajectory}, [][]int32{
+		{1, 0, 0},
+		{2, 0, 0},
+		{3, 1, 0},
+		{4, 1, 0},
+		{5, 1, 0},
+		{6, 2, 0},
+		{7, 2, 0},
+		{8, 2, 0},
+		{9, 3, 0},
+		{10, 3, 1},
+		{11, 3, 1},
+	})
+
+	require.Eventually(t, func() bool {
+		fb := fh.PeekFeedback()
+		return fb != nil && fb.StepIndex == 10
+	}, time.Second, time.Millisecond)
+
+	fb := fh.GetFeedback()
+	assert.Len(t, fb.Scores, 3, "snapshot carries the latest score of every scorer")
+	assert.Equal(t, start.Add(11*time.Second), fb.EvaluatedAt["tool"])
+	assert.Equal(t, start.Add(9*time.Second), fb.EvaluatedAt["finding"])
+	assert.Equal(t, start.Add(10*time.Second), fb.EvaluatedAt["trajectory"])
+}
+
+// TestFeedbackHarnessPriorityUnderDebounce tests that a debounce window runs
+// only the highest-priority due scorer.
+func TestFeedbackHarnessPriorityUnderDebounce(t *testing.T) {
+	tool := newCountingStreamingScorer("tool")
+	finding := newCountingStreamingScorer("finding")
+	trajectory := newCountingStreamingScorer("trajectory")
+
+	fh := NewFeedbackHarness(&mockHarness{}, FeedbackOptions{
+		Scorers: []StreamingScorer{tool, finding, trajectory},
+		Frequency: FeedbackFrequency{
+			EveryNSteps: 1,
+			Debounce:    2 * time.Second,
+		},
+		PerScorer: map[string]FeedbackFrequency{
+			"finding":    {EveryNSteps: 2},
+			"trajectory": {EveryNSteps: 4},
+		},
+		Priority: []string{"trajectory", "finding"},
+	})
+	defer fh.Close()
+
+	clock := &feedbackTestClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	fh.now = clock.Now
+
+	// Columns: tool, finding, trajectory. Odd steps open a debounce window,
+	// taken by the highest-priority due scorer.
+	runScheduleMatrix(t, fh, clock, []*countingStreamingScorer{tool, finding, trajectory}, [][]int32{
+		{1, 0, 0}, // only tool is due
+		{1, 0, 0}, // debounced
+		{1, 1, 0}, // finding outranks tool
+		{1, 1, 0}, // debounced although trajectory is due
+		{1, 1, 1}, // trajectory outranks both
+		{1, 1, 1},
+		{1, 2, 1},
+		{1, 2, 1},
+		{1, 2, 2},
+	})
+}
+
+func TestPrioritizeScorers(t *testing.T) {
+	a, b, c := newCountingStreamingScorer("a"), newCountingStreamingScorer("b"), newCountingStreamingScorer("c")
+
+	names := func(scorers []StreamingScorer) []string {
+		out := make([]string, len(scorers))
+		for i, s := range scorers {
+			out[i] = s.Name()
+		}
+		return out
+	}
+
+	scorers := []StreamingScorer{a, b, c}
+	assert.Equal(t, []string{"a", "b", "c"}, names(prioritizeScorers(scorers, nil)))
+	assert.Equal(t, []string{"c", "a", "b"}, names(prioritizeScorers(scorers, []string{"c"})))
+	assert.Equal(t, []string{"b", "c", "a"}, names(prioritizeScorers(scorers, []string{"b", "missing", "c"})))
+}
@@ -0,0 +1,192 @@
+package eval
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// UpdateGoldenEnv is the environment variable that, when set to "1", makes
+// golden-file scoring rewrite golden files from the actual output instead of
+// comparing against them.
+//
+//	GOEVALS_UPDATE_GOLDEN=1 go test ./...
+const UpdateGoldenEnv = "GOEVALS_UPDATE_GOLDEN"
+
+// GoldenNormalization configures how golden and actual outputs are normalized
+// before comparison. Normalizations apply to both sides equally.
+type GoldenNormalization struct {
+	// SortJSONKeys compares JSON outputs with object keys in sorted order,
+	// so key order does not affect the result. Without it, JSON outputs are
+	// still reformatted consistently but key order must match.
+	SortJSONKeys bool
+
+	// StripPatterns are regular expressions whose matches are removed before
+	// comparison, e.g. timestamps or generated IDs. Patterns run on the raw
+	// text, so for JSON outputs they should match within values to keep the
+	// document valid.
+	StripPatterns []string
+
+	// CollapseWhitespace collapses runs of whitespace within each line to a
+	// single space and drops blank lines. Only applies to non-JSON outputs.
+	CollapseWhitespace bool
+}
+
+// goldenComparison is the outcome of comparing an output to a golden file.
+type goldenComparison struct {
+	matched bool
+	mode    string // "json" or "text"
+	diff    string
+}
+
+// compareGolden compares the actual output against the golden file at path.
+// If UpdateGoldenEnv is set, the golden file is rewritten with the actual
+// output first, so the comparison always matches.
+func compareGolden(path string, actual any, norm GoldenNormalization) (goldenComparison, bool, error) {
+	actualText, err := goldenText(actual)
+	if err != nil {
+		return goldenComparison{}, false, fmt.Errorf("failed to format actual output: %w", err)
+	}
+
+	updated := false
+	if os.Getenv(UpdateGoldenEnv) == "1" {
+		slog.Warn("updating golden file from actual output", "path", path, "env", UpdateGoldenEnv)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return goldenComparison{}, false, fmt.Errorf("failed to create golden file directory: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(actualText), 0o644); err != nil {
+			return goldenComparison{}, false, fmt.Errorf("failed to update golden file: %w", err)
+		}
+		updated = true
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return goldenComparison{}, false, fmt.Errorf("golden file %s does not exist (set %s=1 to create it): %w", path, UpdateGoldenEnv, err)
+		}
+		return goldenComparison{}, false, fmt.Errorf("failed to read golden file: %w", err)
+	}
+
+	patterns := make([]*regexp.Regexp, len(norm.StripPatterns))
+	for i, p := range norm.StripPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return goldenComparison{}, false, fmt.Errorf("invalid strip pattern %q: %w", p, err)
+		}
+		patterns[i] = re
+	}
+
+	expected := stripPatterns(string(data), patterns)
+	got := stripPatterns(actualText, patterns)
+
+	cmp := goldenComparison{mode: "text"}
+	expectedJSON, expectedOK := normalizeJSON(expected, norm.SortJSONKeys)
+	gotJSON, gotOK := normalizeJSON(got, norm.SortJSONKeys)
+	if expectedOK && gotOK {
+		cmp.mode = "json"
+		expected, got = expectedJSON, gotJSON
+	} else {
+		expected = normalizeText(expected, norm.CollapseWhitespace)
+		got = normalizeText(got, norm.CollapseWhitespace)
+	}
+
+	cmp.matched = expected == got
+	if !cmp.matched {
+		cmp.diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(expected + "\n"),
+			B:        difflib.SplitLines(got + "\n"),
+			FromFile: "golden",
+			ToFile:   "actual",
+			Context:  3,
+		})
+		if err != nil {
+			return goldenComparison{}, updated, fmt.Errorf("failed to diff against golden file: %w", err)
+		}
+	}
+
+	return cmp, updated, nil
+}
+
+// goldenText renders an output the way it is stored in a golden file:
+// strings and bytes as-is, anything else as indented JSON.
+func goldenText(v any) (string, error) {
+	switch out := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return out, nil
+	case []byte:
+		return string(out), nil
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+// stripPatterns removes all matches of patterns from text.
+func stripPatterns(text string, patterns []*regexp.Regexp) string {
+	for _, re := range patterns {
+		text = re.ReplaceAllString(text, "")
+	}
+	return text
+}
+
+// normalizeJSON reformats text as indented JSON, optionally with sorted keys.
+// Returns false if text is not a JSON document.
+func normalizeJSON(text string, sortKeys bool) (string, bool) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" || !json.Valid([]byte(trimmed)) {
+		return "", false
+	}
+
+	if sortKeys {
+		decoder := json.NewDecoder(strings.NewReader(trimmed))
+		decoder.UseNumber()
+		var v any
+		if err := decoder.Decode(&v); err != nil {
+			return "", false
+		}
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(trimmed), "", "  "); err != nil {
+		return "", false
+	}
+	return buf.String(), true
+}
+
+// normalizeText trims trailing whitespace and, if collapse is set, collapses
+// whitespace runs within lines and drops blank lines.
+func normalizeText(text string, collapse bool) string {
+	text = strings.TrimRight(text, " \t\r\n")
+	if !collapse {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			kept = append(kept, strings.Join(fields, " "))
+		}
+	}
+	return strings.Join(kept, "\n")
+}
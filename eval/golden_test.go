@@ -0,0 +1,149 @@
+package eval
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+)
+
+const timestampPattern = `\d{4}-\d{2}-\d{2}T[0-9:.]+Z`
+
+func reconReport(summary string) map[string]any {
+	return map[string]any{
+		"summary":      summary,
+		"generated_at": "2026-03-14T08:15:42Z",
+		"hosts": []any{
+			map[string]any{"open_ports": []any{22, 443}, "address": "10.0.0.5"},
+		},
+	}
+}
+
+func TestTaskCompletionScorer_GoldenFileJSON(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	scorer := NewTaskCompletionScorer(TaskCompletionOptions{
+		GoldenFile: filepath.Join("testdata", "recon_report.golden.json"),
+		GoldenNormalization: GoldenNormalization{
+			SortJSONKeys:  true,
+			StripPatterns: []string{timestampPattern},
+		},
+	})
+
+	t.Run("match ignores key order and timestamps", func(t *testing.T) {
+		sample := Sample{Result: agent.NewSuccessResult(reconReport("1 host with 2 open ports"))}
+		result, err := scorer.Score(context.Background(), sample)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, result.Score)
+		assert.Equal(t, "json", result.Details["golden_mode"])
+		assert.NotContains(t, result.Details, "golden_diff")
+	})
+
+	t.Run("mismatch reports a diff", func(t *testing.T) {
+		sample := Sample{Result: agent.NewSuccessResult(reconReport("1 host with 3 open ports"))}
+		result, err := scorer.Score(context.Background(), sample)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, result.Score)
+		assert.Equal(t, false, result.Details["golden_matched"])
+
+		diff, ok := result.Details["golden_diff"].(string)
+		require.True(t, ok)
+		assert.Contains(t, diff, "--- golden")
+		assert.Contains(t, diff, `-  "summary": "1 host with 2 open ports"`)
+		assert.Contains(t, diff, `+  "summary": "1 host with 3 open ports"`)
+	})
+
+	t.Run("timestamps matter without strip patterns", func(t *testing.T) {
+		strict := NewTaskCompletionScorer(TaskCompletionOptions{
+			GoldenFile:          filepath.Join("testdata", "recon_report.golden.json"),
+			GoldenNormalization: GoldenNormalization{SortJSONKeys: true},
+		})
+		sample := Sample{Result: agent.NewSuccessResult(reconReport("1 host with 2 open ports"))}
+		result, err := strict.Score(context.Background(), sample)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, result.Score)
+		assert.Contains(t, result.Details["golden_diff"], "generated_at")
+	})
+}
+
+func TestTaskCompletionScorer_GoldenFileKeyOrder(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	path := filepath.Join(t.TempDir(), "ordered.golden")
+	require.NoError(t, os.WriteFile(path, []byte(`{"b": 1, "a": 2}`), 0o644))
+
+	sample := Sample{Result: agent.NewSuccessResult(`{"a": 2, "b": 1}`)}
+
+	result, err := NewTaskCompletionScorer(TaskCompletionOptions{GoldenFile: path}).Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result.Score, "key order matters without SortJSONKeys")
+
+	result, err = NewTaskCompletionScorer(TaskCompletionOptions{
+		GoldenFile:          path,
+		GoldenNormalization: GoldenNormalization{SortJSONKeys: true},
+	}).Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+}
+
+func TestTaskCompletionScorer_GoldenFileText(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	path := filepath.Join(t.TempDir(), "summary.golden")
+	require.NoError(t, os.WriteFile(path, []byte("Found  2 hosts\n\nScan\tcomplete\n"), 0o644))
+
+	sample := Sample{Result: agent.NewSuccessResult("Found 2 hosts\nScan complete")}
+
+	result, err := NewTaskCompletionScorer(TaskCompletionOptions{GoldenFile: path}).Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result.Score)
+	assert.Equal(t, "text", result.Details["golden_mode"])
+	assert.Contains(t, result.Details["golden_diff"], "+Found 2 hosts")
+
+	result, err = NewTaskCompletionScorer(TaskCompletionOptions{
+		GoldenFile:          path,
+		GoldenNormalization: GoldenNormalization{CollapseWhitespace: true},
+	}).Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+}
+
+func TestTaskCompletionScorer_GoldenFileUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "report.golden.json")
+	scorer := NewTaskCompletionScorer(TaskCompletionOptions{GoldenFile: path})
+	sample := Sample{Result: agent.NewSuccessResult(map[string]any{"hosts": 3})}
+
+	t.Setenv(UpdateGoldenEnv, "")
+	_, err := scorer.Score(context.Background(), sample)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), UpdateGoldenEnv, "missing golden files suggest the update flag")
+
+	t.Setenv(UpdateGoldenEnv, "1")
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, true, result.Details["golden_updated"])
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"hosts": 3}`, string(data))
+
+	// Subsequent runs compare against the written file
+	t.Setenv(UpdateGoldenEnv, "")
+	result, err = scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+	assert.NotContains(t, result.Details, "golden_updated")
+}
+
+func TestTaskCompletionScorer_GoldenFileInvalidPattern(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "")
+	scorer := NewTaskCompletionScorer(TaskCompletionOptions{
+		GoldenFile:          filepath.Join("testdata", "recon_report.golden.json"),
+		GoldenNormalization: GoldenNormalization{StripPatterns: []string{"("}},
+	})
+	_, err := scorer.Score(context.Background(), Sample{Result: agent.NewSuccessResult("x")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid strip pattern")
+}
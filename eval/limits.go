@@ -0,0 +1,296 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/llm"
+	"google.golang.org/protobuf/proto"
+)
+
+// Limit names reported by LimitExceededError.
+const (
+	LimitDuration  = "duration"
+	LimitTokens    = "tokens"
+	LimitToolCalls = "tool_calls"
+)
+
+// LimitExceededMetadataKey is the agent.Result metadata key under which
+// LimitHarness.Finish records the name of the limit a sample exceeded.
+// Score reports such samples with status SampleStatusLimitExceeded.
+const LimitExceededMetadataKey = "eval_limit_exceeded"
+
+// SampleLimits bounds the resources an agent may use on one sample, so a
+// looping agent cannot starve the rest of a suite. Zero fields are
+// unlimited.
+type SampleLimits struct {
+	// MaxDuration is the wall-clock time the agent may run.
+	MaxDuration time.Duration `json:"max_duration,omitempty" yaml:"max_duration,omitempty"`
+
+	// MaxTokens is the number of LLM tokens, input and output, the agent
+	// may use.
+	MaxTokens int `json:"max_tokens,omitempty" yaml:"max_tokens,omitempty"`
+
+	// MaxToolCalls is the number of tool calls the agent may make.
+	MaxToolCalls int `json:"max_tool_calls,omitempty" yaml:"max_tool_calls,omitempty"`
+}
+
+// IsZero reports whether no limit is set.
+func (l SampleLimits) IsZero() bool {
+	return l == SampleLimits{}
+}
+
+// withDefaults returns l with its zero fields taken from defaults.
+func (l SampleLimits) withDefaults(defaults SampleLimits) SampleLimits {
+	if l.MaxDuration == 0 {
+		l.MaxDuration = defaults.MaxDuration
+	}
+	if l.MaxTokens == 0 {
+		l.MaxTokens = defaults.MaxTokens
+	}
+	if l.MaxToolCalls == 0 {
+		l.MaxToolCalls = defaults.MaxToolCalls
+	}
+	return l
+}
+
+// LimitExceededError reports the limit a sample exceeded.
+type LimitExceededError struct {
+	// Limit is LimitDuration, LimitTokens, or LimitToolCalls.
+	Limit string
+
+	// Detail describes the usage that exceeded the limit.
+	Detail string
+}
+
+// Error names the limit and the usage that exceeded it.
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("sample exceeded %s limit: %s", e.Limit, e.Detail)
+}
+
+// LimitHarness is a RecordingHarness that enforces SampleLimits. When a
+// limit is exceeded it cancels the sample's context with a
+// *LimitExceededError as the cause, and fails every later LLM and tool call
+// with that error. The trajectory recorded up to that point stays available
+// for scoring.
+type LimitHarness struct {
+	*RecordingHarness
+	limits SampleLimits
+	cancel context.CancelCauseFunc
+	timer  *time.Timer
+
+	mu        sync.Mutex
+	tokens    int
+	toolCalls int
+	exceeded  *LimitExceededError
+}
+
+// NewLimitHarness wraps inner in a recording harness that enforces limits.
+// Run the agent with the returned context, which is cancelled when a limit
+// is exceeded, and call Finish when it returns.
+//
+// Example:
+//
+//	h, ctx := eval.NewLimitHarness(ctx, harness, eval.SampleLimits{MaxToolCalls: 20})
+//	result, err := myAgent.Execute(ctx, h, sample.Task)
+//	sample = h.Finish(sample, result, err)
+//	e.Score(sample, scorers...)
+func NewLimitHarness(ctx context.Context, inner agent.Harness, limits SampleLimits) (*LimitHarness, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	h := &LimitHarness{
+		RecordingHarness: NewRecordingHarness(inner),
+		limits:           limits,
+		cancel:           cancel,
+	}
+	if limits.MaxDuration > 0 {
+		h.timer = time.AfterFunc(limits.MaxDuration, func() {
+			h.trip(LimitDuration, fmt.Sprintf("ran longer than %s", limits.MaxDuration))
+		})
+	}
+	return h, ctx
+}
+
+// Exceeded returns the limit the sample exceeded, or nil.
+func (h *LimitHarness) Exceeded() *LimitExceededError {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.exceeded
+}
+
+// Finish stops enforcing limits, releases the sample's context, and returns
+// sample with the agent's result and the recorded trajectory. If a limit
+// was exceeded, the result is marked cancelled with the limit recorded
+// under LimitExceededMetadataKey, so Score reports the sample as
+// SampleStatusLimitExceeded.
+func (h *LimitHarness) Finish(sample Sample, result agent.Result, err error) Sample {
+	if h.timer != nil {
+		h.timer.Stop()
+	}
+	h.cancel(nil)
+
+	if exceeded := h.Exceeded(); exceeded != nil {
+		result.Status = agent.StatusCancelled
+		result.Error = exceeded
+		if result.Metadata == nil {
+			result.Metadata = make(map[string]any)
+		}
+		result.Metadata[LimitExceededMetadataKey] = exceeded.Limit
+	} else if err != nil && result.Error == nil {
+		result.Error = err
+	}
+
+	sample.Result = result
+	sample.Trajectory = h.Trajectory()
+	return sample
+}
+
+// trip records the first exceeded limit and cancels the sample's context.
+func (h *LimitHarness) trip(limit, detail string) *LimitExceededError {
+	h.mu.Lock()
+	if h.exceeded == nil {
+		h.exceeded = &LimitExceededError{Limit: limit, Detail: detail}
+		h.cancel(h.exceeded)
+	}
+	exceeded := h.exceeded
+	h.mu.Unlock()
+	return exceeded
+}
+
+// check returns the exceeded limit, if any, as an error.
+func (h *LimitHarness) check() error {
+	if exceeded := h.Exceeded(); exceeded != nil {
+		return exceeded
+	}
+	return nil
+}
+
+// addTokens counts the tokens of a completion against MaxTokens.
+func (h *LimitHarness) addTokens(resp *llm.CompletionResponse) {
+	if resp == nil {
+		return
+	}
+	used := resp.Usage.TotalTokens
+	if used == 0 {
+		used = resp.Usage.InputTokens + resp.Usage.OutputTokens
+	}
+
+	h.mu.Lock()
+	h.tokens += used
+	total := h.tokens
+	h.mu.Unlock()
+
+	if h.limits.MaxTokens > 0 && total > h.limits.MaxTokens {
+		h.trip(LimitTokens, fmt.Sprintf("used %d tokens, max %d", total, h.limits.MaxTokens))
+	}
+}
+
+// addToolCall counts a tool call against MaxToolCalls and returns an error
+// if the call would exceed it.
+func (h *LimitHarness) addToolCall() error {
+	if err := h.check(); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	h.toolCalls++
+	calls := h.toolCalls
+	h.mu.Unlock()
+
+	if h.limits.MaxToolCalls > 0 && calls > h.limits.MaxToolCalls {
+		return h.trip(LimitToolCalls, fmt.Sprintf("made %d tool calls, max %d", calls, h.limits.MaxToolCalls))
+	}
+	return nil
+}
+
+// Complete performs a completion and counts its tokens.
+func (h *LimitHarness) Complete(ctx context.Context, slot string, messages []llm.Message, opts ...llm.CompletionOption) (*llm.CompletionResponse, error) {
+	if err := h.check(); err != nil {
+		return nil, err
+	}
+	resp, err := h.RecordingHarness.Complete(ctx, slot, messages, opts...)
+	h.addTokens(resp)
+	return resp, err
+}
+
+// CompleteWithTools performs a completion with tools and counts its tokens.
+func (h *LimitHarness) CompleteWithTools(ctx context.Context, slot string, messages []llm.Message, tools []llm.ToolDef) (*llm.CompletionResponse, error) {
+	if err := h.check(); err != nil {
+		return nil, err
+	}
+	resp, err := h.RecordingHarness.CompleteWithTools(ctx, slot, messages, tools)
+	h.addTokens(resp)
+	return resp, err
+}
+
+// CompleteStructured performs a structured completion. Its tokens are not
+// reported by the harness, so it counts only against MaxDuration.
+func (h *LimitHarness) CompleteStructured(ctx context.Context, slot string, messages []llm.Message, schema any) (any, error) {
+	if err := h.check(); err != nil {
+		return nil, err
+	}
+	return h.RecordingHarness.CompleteStructured(ctx, slot, messages, schema)
+}
+
+// CompleteStructuredAny is an alias for CompleteStructured.
+func (h *LimitHarness) CompleteStructuredAny(ctx context.Context, slot string, messages []llm.Message, schema any) (any, error) {
+	return h.CompleteStructured(ctx, slot, messages, schema)
+}
+
+// Stream performs a streaming completion. Its tokens are not reported by
+// the harness, so it counts only against MaxDuration.
+func (h *LimitHarness) Stream(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+	if err := h.check(); err != nil {
+		return nil, err
+	}
+	return h.RecordingHarness.Stream(ctx, slot, messages)
+}
+
+// CallToolProto calls a tool, counting the call against MaxToolCalls.
+func (h *LimitHarness) CallToolProto(ctx context.Context, name string, request proto.Message, response proto.Message) error {
+	if err := h.addToolCall(); err != nil {
+		return err
+	}
+	return h.RecordingHarness.CallToolProto(ctx, name, request, response)
+}
+
+// CallToolProtoStream calls a streaming tool, counting the call against
+// MaxToolCalls.
+func (h *LimitHarness) CallToolProtoStream(ctx context.Context, toolName string, input proto.Message, output proto.Message, callback agent.ToolStreamCallback) error {
+	if err := h.addToolCall(); err != nil {
+		return err
+	}
+	return h.RecordingHarness.CallToolProtoStream(ctx, toolName, input, output, callback)
+}
+
+// WithSampleLimits sets suite-wide limits for LimitHarness. A sample's own
+// Limits take precedence field by field.
+//
+// Example:
+//
+//	e.WithSampleLimits(eval.SampleLimits{MaxDuration: 2 * time.Minute, MaxTokens: 200_000})
+func (e *E) WithSampleLimits(limits SampleLimits) *E {
+	e.sampleLimits = limits
+	return e
+}
+
+// LimitHarness returns a LimitHarness enforcing the sample's limits over the
+// suite-wide limits, and the context to run the agent with.
+//
+// Example:
+//
+//	h, ctx := e.LimitHarness(ctx, sample, harness)
+//	result, err := myAgent.Execute(ctx, h, sample.Task)
+//	scored := e.Score(h.Finish(sample, result, err), scorers...)
+func (e *E) LimitHarness(ctx context.Context, sample Sample, inner agent.Harness) (*LimitHarness, context.Context) {
+	return NewLimitHarness(ctx, inner, sample.Limits.withDefaults(e.sampleLimits))
+}
+
+// exceededLimit returns the limit recorded by LimitHarness.Finish on the
+// sample's result, if any.
+func exceededLimit(sample Sample) (string, bool) {
+	limit, ok := sample.Result.Metadata[LimitExceededMetadataKey].(string)
+	return limit, ok && limit != ""
+}
@@ -0,0 +1,153 @@
+package eval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/api/gen/toolspb"
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+// loopingAgent never finishes on its own: it alternates completions and
+// tool calls until the harness fails a call or its context is cancelled.
+func loopingAgent(ctx context.Context, h agent.Harness) (agent.Result, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return agent.NewFailedResult(err), err
+		}
+		if _, err := h.Complete(ctx, "primary", []llm.Message{{Role: llm.RoleUser, Content: "again"}}); err != nil {
+			return agent.NewFailedResult(err), err
+		}
+		if err := h.CallToolProto(ctx, "nmap", &toolspb.NmapRequest{Targets: []string{"example.com"}}, &toolspb.NmapResponse{}); err != nil {
+			return agent.NewFailedResult(err), err
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func tokenHarness(tokens int) *mockHarness {
+	return &mockHarness{
+		completeFunc: func(context.Context, string, []llm.Message, ...llm.CompletionOption) (*llm.CompletionResponse, error) {
+			return &llm.CompletionResponse{Content: "ok", Usage: llm.TokenUsage{TotalTokens: tokens}}, nil
+		},
+	}
+}
+
+func TestLimitHarness(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits SampleLimits
+		tokens int
+		limit  string
+	}{
+		{"duration", SampleLimits{MaxDuration: 20 * time.Millisecond}, 1, LimitDuration},
+		{"tokens", SampleLimits{MaxTokens: 500}, 100, LimitTokens},
+		{"tool calls", SampleLimits{MaxToolCalls: 3}, 1, LimitToolCalls},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, ctx := NewLimitHarness(context.Background(), tokenHarness(tt.tokens), tt.limits)
+			result, err := loopingAgent(ctx, h)
+			require.Error(t, err)
+
+			var exceeded *LimitExceededError
+			require.True(t, errors.As(context.Cause(ctx), &exceeded), "the context is cancelled with the limit as cause")
+			assert.Equal(t, tt.limit, exceeded.Limit)
+
+			sample := h.Finish(Sample{ID: tt.name}, result, err)
+			assert.Equal(t, agent.StatusCancelled, sample.Result.Status)
+			assert.Equal(t, tt.limit, sample.Result.Metadata[LimitExceededMetadataKey])
+			assert.NotEmpty(t, sample.Trajectory.Steps, "the partial trajectory is kept")
+
+			tb := &recordingTB{TB: t}
+			scorer := &countingScorer{score: 0.4}
+			scored := (&E{T: tb}).Score(sample, scorer)
+			assert.Equal(t, SampleStatusLimitExceeded, scored.Status)
+			assert.Contains(t, scored.StatusReason, tt.limit)
+			assert.Equal(t, 1, scorer.calls, "scorers run over the partial trajectory")
+		})
+	}
+}
+
+func TestLimitHarness_ToolCallCount(t *testing.T) {
+	h, ctx := NewLimitHarness(context.Background(), &mockHarness{}, SampleLimits{MaxToolCalls: 2})
+	for i := 0; i < 2; i++ {
+		require.NoError(t, h.CallToolProto(ctx, "nmap", &toolspb.NmapRequest{}, &toolspb.NmapResponse{}))
+	}
+	assert.Nil(t, h.Exceeded())
+
+	err := h.CallToolProto(ctx, "nmap", &toolspb.NmapRequest{}, &toolspb.NmapResponse{})
+	var exceeded *LimitExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, LimitToolCalls, exceeded.Limit)
+
+	_, err = h.Complete(ctx, "primary", nil)
+	assert.ErrorAs(t, err, &exceeded, "later calls fail with the same error")
+}
+
+func TestLimitHarness_WithinLimits(t *testing.T) {
+	h, ctx := NewLimitHarness(context.Background(), tokenHarness(10), SampleLimits{
+		MaxDuration:  time.Minute,
+		MaxTokens:    100,
+		MaxToolCalls: 5,
+	})
+	_, err := h.Complete(ctx, "primary", nil)
+	require.NoError(t, err)
+
+	sample := h.Finish(Sample{ID: "ok"}, agent.NewSuccessResult("done"), nil)
+	assert.Equal(t, agent.StatusSuccess, sample.Result.Status)
+	assert.NotContains(t, sample.Result.Metadata, LimitExceededMetadataKey)
+	assert.Error(t, ctx.Err(), "Finish releases the context")
+
+	scored := (&E{T: t}).Score(sample, &mockScorer{name: "exact", score: 1.0})
+	assert.Empty(t, scored.Status)
+}
+
+func TestELimitHarness_Defaults(t *testing.T) {
+	e := (&E{T: t}).WithSampleLimits(SampleLimits{MaxTokens: 1000, MaxToolCalls: 10})
+	h, _ := e.LimitHarness(context.Background(), Sample{Limits: SampleLimits{MaxToolCalls: 2}}, &mockHarness{})
+	defer h.Finish(Sample{}, agent.Result{}, nil)
+
+	assert.Equal(t, SampleLimits{MaxTokens: 1000, MaxToolCalls: 2}, h.limits, "sample limits take precedence field by field")
+}
+
+func TestLimitExceededReporting(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	e := (&E{T: tb}).WithThreshold(0.5).WithSampleLimits(SampleLimits{MaxToolCalls: 1})
+
+	h, ctx := e.LimitHarness(context.Background(), Sample{}, &mockHarness{})
+	result, err := loopingAgent(ctx, h)
+	limited := e.Score(h.Finish(Sample{ID: "looping"}, result, err), &mockScorer{name: "exact", score: 0.2})
+	failed := e.Score(Sample{ID: "wrong"}, &mockScorer{name: "exact", score: 0.2})
+
+	summary := e.Summary()
+	assert.Equal(t, []string{"looping"}, summary.LimitExceeded)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSummaryTable(&buf, []Result{limited, failed}, TableOptions{FailThreshold: 0.5}))
+	assert.Contains(t, buf.String(), "0 passed, 1 failed, 1 exceeded limits")
+	assert.Contains(t, buf.String(), "limit")
+
+	logPath := filepath.Join(t.TempDir(), "eval.jsonl")
+	logger, err := NewJSONLLogger(logPath)
+	require.NoError(t, err)
+	require.NoError(t, logger.Log(Sample{ID: "looping"}, limited))
+	require.NoError(t, logger.Close())
+
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	var entry LogEntry
+	require.NoError(t, json.Unmarshal(bytes.TrimSpace(data), &entry))
+	assert.Equal(t, SampleStatusLimitExceeded, entry.Status)
+	assert.Contains(t, entry.StatusReason, LimitToolCalls)
+}
@@ -31,6 +31,36 @@ type LogEntry struct {
 	// Duration is the total time taken for evaluation in milliseconds.
 	Duration int64 `json:"duration_ms"`
 
+	// BaselineScore is the overall score of the sample's baseline, when the
+	// sample was scored against one.
+	BaselineScore *float64 `json:"baseline_score,omitempty"`
+
+	// Improvement is the overall score minus BaselineScore.
+	Improvement *float64 `json:"improvement,omitempty"`
+
+	// JudgeTokens is the number of LLM-judge tokens spent on the sample.
+	JudgeTokens int `json:"judge_tokens"`
+
+	// JudgeCostUSD is the judge cost of the sample, when pricing is configured.
+	JudgeCostUSD float64 `json:"judge_cost_usd,omitempty"`
+
+	// Status is "skipped", "xfail", or "unexpected_pass" for samples with a
+	// skip or expected-failure marker, "limit_exceeded" for samples stopped
+	// by their resource limits, and empty otherwise.
+	Status SampleStatus `json:"status,omitempty"`
+
+	// StatusReason is the sample's skip or expected-failure reason, or the
+	// limit it exceeded.
+	StatusReason string `json:"status_reason,omitempty"`
+
+	// ScorerErrors contains the errors of errored scorers (failed, timed out,
+	// or panicked), keyed by scorer name.
+	ScorerErrors map[string]string `json:"scorer_errors,omitempty"`
+
+	// Suggestions are the ground-truth corrections proposed by scorers.
+	// CollectSuggestions finds the ones that recur across runs.
+	Suggestions []GroundTruthSuggestion `json:"suggestions,omitempty"`
+
 	// Details contains additional diagnostic information.
 	// This can include scorer-specific details, error messages, or metadata.
 	Details map[string]any `json:"details,omitempty"`
@@ -90,8 +120,15 @@ func (l *JSONLLogger) Log(sample Sample, result Result) error {
 	scores := make(map[string]float64, len(result.Scores))
 	details := make(map[string]any)
 
+	var scorerErrors map[string]string
 	for name, scoreResult := range result.Scores {
 		scores[name] = scoreResult.Score
+		if scoreResult.Error != "" {
+			if scorerErrors == nil {
+				scorerErrors = make(map[string]string)
+			}
+			scorerErrors[name] = scoreResult.Error
+		}
 
 		// Include scorer details if present
 		if len(scoreResult.Details) > 0 {
@@ -122,9 +159,21 @@ func (l *JSONLLogger) Log(sample Sample, result Result) error {
 		Scores:       scores,
 		OverallScore: result.OverallScore,
 		Duration:     result.Duration.Milliseconds(),
+		Improvement:  result.Improvement,
+		JudgeTokens:  result.JudgeTokens,
+		JudgeCostUSD: result.JudgeCostUSD,
+		Status:       result.Status,
+		StatusReason: result.StatusReason,
+		ScorerErrors: scorerErrors,
+		Suggestions:  result.Suggestions,
 		Details:      details,
 	}
 
+	if result.Improvement != nil {
+		baseline := result.BaselineScore
+		entry.BaselineScore = &baseline
+	}
+
 	// Marshal to JSON
 	data, err := json.Marshal(entry)
 	if err != nil {
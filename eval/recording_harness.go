@@ -15,6 +15,7 @@ import (
 	"github.com/zero-day-ai/sdk/graphrag"
 	"github.com/zero-day-ai/sdk/llm"
 	"github.com/zero-day-ai/sdk/memory"
+	"github.com/zero-day-ai/sdk/mission"
 	"github.com/zero-day-ai/sdk/planning"
 	"github.com/zero-day-ai/sdk/plugin"
 	"github.com/zero-day-ai/sdk/tool"
@@ -29,9 +30,20 @@ import (
 type RecordingHarness struct {
 	inner      agent.Harness
 	trajectory Trajectory
+	generation int // incremented by Reset, so late stream updates are dropped
 	mu         sync.Mutex
 }
 
+// Limits on the chunks RecordingHarness keeps per streamed completion.
+const (
+	// MaxRecordedStreamChunks is the maximum number of chunks recorded.
+	MaxRecordedStreamChunks = 4096
+
+	// MaxRecordedStreamBytes is the maximum total size of recorded chunk
+	// content.
+	MaxRecordedStreamBytes = 256 * 1024
+)
+
 // NewRecordingHarness creates a new recording harness that wraps the given inner harness.
 // All method calls will be delegated to the inner harness while recording trajectory steps.
 func NewRecordingHarness(inner agent.Harness) *RecordingHarness {
@@ -51,6 +63,26 @@ func (r *RecordingHarness) recordStep(step TrajectoryStep) {
 	r.trajectory.Steps = append(r.trajectory.Steps, step)
 }
 
+// recordPendingStep adds a step that is completed later with updateStep, and
+// returns its position and the recording generation.
+func (r *RecordingHarness) recordPendingStep(step TrajectoryStep) (int, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.trajectory.Steps = append(r.trajectory.Steps, step)
+	return len(r.trajectory.Steps) - 1, r.generation
+}
+
+// updateStep applies fn to a step added by recordPendingStep, unless the
+// recording was reset since.
+func (r *RecordingHarness) updateStep(index, generation int, fn func(step *TrajectoryStep)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if generation != r.generation || index >= len(r.trajectory.Steps) {
+		return
+	}
+	fn(&r.trajectory.Steps[index])
+}
+
 // Trajectory returns the recorded trajectory of operations.
 // This returns a copy to prevent external modification.
 func (r *RecordingHarness) Trajectory() Trajectory {
@@ -77,6 +109,7 @@ func (r *RecordingHarness) Reset() {
 		Steps:     make([]TrajectoryStep, 0),
 		StartTime: time.Now(),
 	}
+	r.generation++
 }
 
 // Complete performs a single LLM completion request and records it.
@@ -104,6 +137,48 @@ func (r *RecordingHarness) Complete(ctx context.Context, slot string, messages [
 	return resp, err
 }
 
+// RecordReasoning records text as a "reasoning" step, capturing the agent's
+// intermediate reasoning for scorers such as the one returned by
+// NewReasoningScorer. Agents call it through agent.RecordReasoning, which is
+// a no-op on harnesses that do not record.
+func (r *RecordingHarness) RecordReasoning(ctx context.Context, text string) {
+	r.recordStep(TrajectoryStep{
+		Type:      "reasoning",
+		Output:    text,
+		StartTime: time.Now(),
+	})
+}
+
+// CompleteStructured performs a completion with a structured output schema and records it.
+func (r *RecordingHarness) CompleteStructured(ctx context.Context, slot string, messages []llm.Message, schema any) (any, error) {
+	startTime := time.Now()
+
+	// Delegate to inner harness
+	result, err := r.inner.CompleteStructured(ctx, slot, messages, schema)
+
+	// Record the step
+	duration := time.Since(startTime)
+	step := TrajectoryStep{
+		Type:      "llm",
+		Name:      slot,
+		Input:     messages,
+		Output:    result,
+		StartTime: startTime,
+		Duration:  duration,
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	r.recordStep(step)
+
+	return result, err
+}
+
+// CompleteStructuredAny is an alias for CompleteStructured.
+func (r *RecordingHarness) CompleteStructuredAny(ctx context.Context, slot string, messages []llm.Message, schema any) (any, error) {
+	return r.CompleteStructured(ctx, slot, messages, schema)
+}
+
 // CompleteWithTools performs a completion with tool calling enabled and records it.
 func (r *RecordingHarness) CompleteWithTools(ctx context.Context, slot string, messages []llm.Message, tools []llm.ToolDef) (*llm.CompletionResponse, error) {
 	startTime := time.Now()
@@ -132,29 +207,89 @@ func (r *RecordingHarness) CompleteWithTools(ctx context.Context, slot string, m
 	return resp, err
 }
 
-// Stream performs a streaming completion request and records it.
+// Stream performs a streaming completion request and records it. The step is
+// recorded when the stream opens, with Output "streaming"; once the stream
+// ends, its Output becomes the accumulated *llm.CompletionResponse, its
+// Duration covers the whole stream, and Stream holds the timed chunks.
 func (r *RecordingHarness) Stream(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
 	startTime := time.Now()
 
 	// Delegate to inner harness
 	ch, err := r.inner.Stream(ctx, slot, messages)
 
-	// Record the step (note: output will be incomplete since streaming is async)
-	duration := time.Since(startTime)
 	step := TrajectoryStep{
 		Type:      "llm",
 		Name:      slot,
 		Input:     messages,
 		Output:    "streaming",
 		StartTime: startTime,
-		Duration:  duration,
+		Duration:  time.Since(startTime),
 	}
-	if err != nil {
-		step.Error = err.Error()
+	if err != nil || ch == nil {
+		if err != nil {
+			step.Error = err.Error()
+		}
+		r.recordStep(step)
+		return ch, err
 	}
-	r.recordStep(step)
 
-	return ch, err
+	index, generation := r.recordPendingStep(step)
+	out := make(chan llm.StreamChunk)
+	go r.forwardStream(ctx, ch, out, startTime, index, generation)
+	return out, nil
+}
+
+// forwardStream copies chunks from in to out, recording their arrival times,
+// and completes the stream's step when in closes or ctx is cancelled.
+func (r *RecordingHarness) forwardStream(ctx context.Context, in <-chan llm.StreamChunk, out chan<- llm.StreamChunk, startTime time.Time, index, generation int) {
+	defer close(out)
+
+	rec := &StreamRecording{Chunks: make([]RecordedChunk, 0)}
+	acc := llm.NewStreamAccumulator()
+	recordedBytes := 0
+
+	defer func() {
+		resp := acc.ToResponse()
+		duration := time.Since(startTime)
+		r.updateStep(index, generation, func(step *TrajectoryStep) {
+			step.Output = &resp
+			step.Duration = duration
+			step.Stream = rec
+		})
+	}()
+
+	for {
+		var chunk llm.StreamChunk
+		var ok bool
+		select {
+		case chunk, ok = <-in:
+			if !ok {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+
+		rec.TotalChunks++
+		if len(rec.Chunks) < MaxRecordedStreamChunks && recordedBytes+len(chunk.Delta) <= MaxRecordedStreamBytes {
+			rec.Chunks = append(rec.Chunks, RecordedChunk{
+				Offset:       time.Since(startTime),
+				Delta:        chunk.Delta,
+				FinishReason: chunk.FinishReason,
+			})
+			recordedBytes += len(chunk.Delta)
+		} else {
+			rec.Truncated = true
+		}
+
+		acc.Add(chunk)
+
+		select {
+		case out <- chunk:
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
 // CallToolProto invokes a tool with proto messages and records the invocation.
@@ -182,6 +317,63 @@ func (r *RecordingHarness) CallToolProto(ctx context.Context, name string, reque
 	return err
 }
 
+// CallToolProtoStream invokes a streaming tool and records the invocation.
+// Intermediate stream events are passed to the callback but not recorded.
+func (r *RecordingHarness) CallToolProtoStream(ctx context.Context, toolName string, input proto.Message, output proto.Message, callback agent.ToolStreamCallback) error {
+	startTime := time.Now()
+
+	// Delegate to inner harness
+	err := r.inner.CallToolProtoStream(ctx, toolName, input, output, callback)
+
+	// Record the step
+	duration := time.Since(startTime)
+	step := TrajectoryStep{
+		Type:      "tool",
+		Name:      toolName,
+		Input:     input,
+		Output:    output,
+		StartTime: startTime,
+		Duration:  duration,
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	r.recordStep(step)
+
+	return err
+}
+
+// QueueToolWork queues tool executions and records the queued inputs.
+func (r *RecordingHarness) QueueToolWork(ctx context.Context, toolName string, inputs []proto.Message) (string, error) {
+	startTime := time.Now()
+
+	// Delegate to inner harness
+	jobID, err := r.inner.QueueToolWork(ctx, toolName, inputs)
+
+	// Record the step
+	duration := time.Since(startTime)
+	step := TrajectoryStep{
+		Type:      "tool_queue",
+		Name:      toolName,
+		Input:     inputs,
+		Output:    jobID,
+		StartTime: startTime,
+		Duration:  duration,
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	r.recordStep(step)
+
+	return jobID, err
+}
+
+// ToolResults returns the results of queued tool work.
+func (r *RecordingHarness) ToolResults(ctx context.Context, jobID string) <-chan agent.QueuedToolResult {
+	// No recording for result streams; the queued work is recorded by QueueToolWork
+	return r.inner.ToolResults(ctx, jobID)
+}
+
 // ListTools returns descriptors for all available tools.
 func (r *RecordingHarness) ListTools(ctx context.Context) ([]tool.Descriptor, error) {
 	// No recording for list operations
@@ -223,6 +415,8 @@ func (r *RecordingHarness) ListPlugins(ctx context.Context) ([]plugin.Descriptor
 }
 
 // DelegateToAgent assigns a task to another agent and records the delegation.
+// If the delegated agent returned its trajectory under TrajectoryMetadataKey,
+// it is embedded as the step's Children rather than kept in the output.
 func (r *RecordingHarness) DelegateToAgent(ctx context.Context, name string, task agent.Task) (agent.Result, error) {
 	startTime := time.Now()
 
@@ -242,6 +436,29 @@ func (r *RecordingHarness) DelegateToAgent(ctx context.Context, name string, tas
 	if err != nil {
 		step.Error = err.Error()
 	}
+
+	child, childErr := childTrajectory(result)
+	if childErr != nil {
+		if logger := r.inner.Logger(); logger != nil {
+			logger.Warn("ignoring invalid delegated trajectory", "agent", name, "error", childErr)
+		}
+	}
+	if child != nil {
+		if child.Agent == "" {
+			child.Agent = name
+		}
+		step.Children = child
+
+		// Keep the recorded output free of the embedded trajectory
+		output := result
+		output.Metadata = make(map[string]any, len(result.Metadata))
+		for k, v := range result.Metadata {
+			if k != TrajectoryMetadataKey {
+				output.Metadata[k] = v
+			}
+		}
+		step.Output = output
+	}
 	r.recordStep(step)
 
 	return result, err
@@ -529,10 +746,40 @@ func (m *recordingMissionMemory) Search(ctx context.Context, query string, limit
 	return results, err
 }
 
+func (m *recordingMissionMemory) SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]memory.Result, int, error) {
+	startTime := time.Now()
+	results, total, err := m.inner.SearchFiltered(ctx, query, filter, limit, offset)
+	duration := time.Since(startTime)
+
+	step := TrajectoryStep{
+		Type: "memory.mission",
+		Name: "search",
+		Input: map[string]any{
+			"query":  query,
+			"filter": filter,
+			"limit":  limit,
+			"offset": offset,
+		},
+		Output:    results,
+		StartTime: startTime,
+		Duration:  duration,
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	m.recorder.recordStep(step)
+
+	return results, total, err
+}
+
 func (m *recordingMissionMemory) History(ctx context.Context, limit int) ([]memory.Item, error) {
 	return m.inner.History(ctx, limit)
 }
 
+func (m *recordingMissionMemory) HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]memory.Item, int, error) {
+	return m.inner.HistoryFiltered(ctx, filter, limit, offset)
+}
+
 func (m *recordingMissionMemory) GetPreviousRunValue(ctx context.Context, key string) (any, error) {
 	return m.inner.GetPreviousRunValue(ctx, key)
 }
@@ -901,3 +1148,45 @@ func (r *RecordingHarness) GetAllRunFindings(ctx context.Context, filter finding
 
 	return findings, err
 }
+
+// GetCredential retrieves a credential from the inner harness.
+func (r *RecordingHarness) GetCredential(ctx context.Context, name string) (*types.Credential, error) {
+	// Not recorded: credentials must never end up in trajectories
+	return r.inner.GetCredential(ctx, name)
+}
+
+// CreateMission creates a mission through the inner harness.
+func (r *RecordingHarness) CreateMission(ctx context.Context, workflow any, targetID string, opts *mission.CreateMissionOpts) (*mission.MissionInfo, error) {
+	// No recording for mission management operations
+	return r.inner.CreateMission(ctx, workflow, targetID, opts)
+}
+
+// RunMission starts a mission through the inner harness.
+func (r *RecordingHarness) RunMission(ctx context.Context, missionID string, opts *mission.RunMissionOpts) error {
+	return r.inner.RunMission(ctx, missionID, opts)
+}
+
+// GetMissionStatus returns a mission's status from the inner harness.
+func (r *RecordingHarness) GetMissionStatus(ctx context.Context, missionID string) (*mission.MissionStatusInfo, error) {
+	return r.inner.GetMissionStatus(ctx, missionID)
+}
+
+// WaitForMission waits for a mission through the inner harness.
+func (r *RecordingHarness) WaitForMission(ctx context.Context, missionID string, timeout time.Duration) (*mission.MissionResult, error) {
+	return r.inner.WaitForMission(ctx, missionID, timeout)
+}
+
+// ListMissions lists missions through the inner harness.
+func (r *RecordingHarness) ListMissions(ctx context.Context, filter *mission.MissionFilter) ([]*mission.MissionInfo, error) {
+	return r.inner.ListMissions(ctx, filter)
+}
+
+// CancelMission cancels a mission through the inner harness.
+func (r *RecordingHarness) CancelMission(ctx context.Context, missionID string) error {
+	return r.inner.CancelMission(ctx, missionID)
+}
+
+// GetMissionResults returns a mission's results from the inner harness.
+func (r *RecordingHarness) GetMissionResults(ctx context.Context, missionID string) (*mission.MissionResult, error) {
+	return r.inner.GetMissionResults(ctx, missionID)
+}
@@ -77,6 +77,20 @@ func (m *minimalMockHarness) CallToolProto(ctx context.Context, name string, req
 	return nil
 }
 
+func (m *minimalMockHarness) CallToolProtoStream(ctx context.Context, name string, input protolib.Message, output protolib.Message, callback agent.ToolStreamCallback) error {
+	return nil
+}
+
+func (m *minimalMockHarness) QueueToolWork(ctx context.Context, toolName string, inputs []protolib.Message) (string, error) {
+	return "", nil
+}
+
+func (m *minimalMockHarness) ToolResults(ctx context.Context, jobID string) <-chan agent.QueuedToolResult {
+	ch := make(chan agent.QueuedToolResult)
+	close(ch)
+	return ch
+}
+
 func (m *minimalMockHarness) Memory() memory.Store {
 	return &minimalMemoryStore{}
 }
@@ -218,9 +232,15 @@ func (m *minimalMissionMemory) Delete(ctx context.Context, key string) error { r
 func (m *minimalMissionMemory) Search(ctx context.Context, query string, limit int) ([]memory.Result, error) {
 	return nil, nil
 }
+func (m *minimalMissionMemory) SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]memory.Result, int, error) {
+	return nil, 0, nil
+}
 func (m *minimalMissionMemory) History(ctx context.Context, limit int) ([]memory.Item, error) {
 	return nil, nil
 }
+func (m *minimalMissionMemory) HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]memory.Item, int, error) {
+	return nil, 0, nil
+}
 
 func (m *minimalMissionMemory) GetPreviousRunValue(ctx context.Context, key string) (any, error) {
 	return nil, memory.ErrNotFound
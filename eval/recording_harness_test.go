@@ -31,6 +31,8 @@ type mockHarness struct {
 	completeFunc      func(ctx context.Context, slot string, messages []llm.Message, opts ...llm.CompletionOption) (*llm.CompletionResponse, error)
 	callToolProtoFunc func(ctx context.Context, name string, request protolib.Message, response protolib.Message) error
 	submitFindingFunc func(ctx context.Context, f *finding.Finding) error
+	delegateFunc      func(ctx context.Context, name string, task agent.Task) (agent.Result, error)
+	streamFunc        func(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error)
 	memStore          memory.Store
 }
 
@@ -54,6 +56,9 @@ func (m *mockHarness) CompleteStructuredAny(ctx context.Context, slot string, me
 }
 
 func (m *mockHarness) Stream(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+	if m.streamFunc != nil {
+		return m.streamFunc(ctx, slot, messages)
+	}
 	ch := make(chan llm.StreamChunk)
 	close(ch)
 	return ch, nil
@@ -66,6 +71,20 @@ func (m *mockHarness) CallToolProto(ctx context.Context, name string, request pr
 	return nil
 }
 
+func (m *mockHarness) CallToolProtoStream(ctx context.Context, name string, input protolib.Message, output protolib.Message, callback agent.ToolStreamCallback) error {
+	return nil
+}
+
+func (m *mockHarness) QueueToolWork(ctx context.Context, toolName string, inputs []protolib.Message) (string, error) {
+	return "", nil
+}
+
+func (m *mockHarness) ToolResults(ctx context.Context, jobID string) <-chan agent.QueuedToolResult {
+	ch := make(chan agent.QueuedToolResult)
+	close(ch)
+	return ch
+}
+
 func (m *mockHarness) ListTools(ctx context.Context) ([]tool.Descriptor, error) {
 	return []tool.Descriptor{}, nil
 }
@@ -79,6 +98,9 @@ func (m *mockHarness) ListPlugins(ctx context.Context) ([]plugin.Descriptor, err
 }
 
 func (m *mockHarness) DelegateToAgent(ctx context.Context, name string, task agent.Task) (agent.Result, error) {
+	if m.delegateFunc != nil {
+		return m.delegateFunc(ctx, name, task)
+	}
 	return agent.NewSuccessResult("mock delegation"), nil
 }
 
@@ -347,10 +369,18 @@ func (m *mockMissionMemory) Search(ctx context.Context, query string, limit int)
 	return nil, nil
 }
 
+func (m *mockMissionMemory) SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]memory.Result, int, error) {
+	return nil, 0, nil
+}
+
 func (m *mockMissionMemory) History(ctx context.Context, limit int) ([]memory.Item, error) {
 	return nil, nil
 }
 
+func (m *mockMissionMemory) HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]memory.Item, int, error) {
+	return nil, 0, nil
+}
+
 func (m *mockMissionMemory) GetPreviousRunValue(ctx context.Context, key string) (any, error) {
 	return nil, memory.ErrNotFound
 }
@@ -394,6 +424,24 @@ func TestRecordingHarnessBasics(t *testing.T) {
 	assert.Len(t, traj.Steps, 0)
 }
 
+// TestRecordingHarnessReasoning tests that reasoning is recorded as a step
+// through the agent helper.
+func TestRecordingHarnessReasoning(t *testing.T) {
+	ctx := context.Background()
+	recorder := NewRecordingHarness(&mockHarness{})
+
+	agent.RecordReasoning(ctx, recorder, "enumerate subdomains before probing")
+	_, err := recorder.Complete(ctx, "primary", []llm.Message{{Role: llm.RoleUser, Content: "go"}})
+	require.NoError(t, err)
+
+	traj := recorder.Trajectory()
+	require.Len(t, traj.Steps, 2)
+	assert.Equal(t, "reasoning", traj.Steps[0].Type)
+	assert.Equal(t, "enumerate subdomains before probing", traj.Steps[0].Output)
+	assert.False(t, traj.Steps[0].StartTime.IsZero())
+	assert.Equal(t, "llm", traj.Steps[1].Type)
+}
+
 // TestRecordingHarnessLLMCalls tests recording of LLM completion calls.
 func TestRecordingHarnessLLMCalls(t *testing.T) {
 	ctx := context.Background()
@@ -420,6 +468,110 @@ func TestRecordingHarnessLLMCalls(t *testing.T) {
 	assert.Greater(t, step.Duration, time.Duration(0))
 }
 
+// scriptedStream returns a Stream function that sends chunks with a delay
+// before each.
+func scriptedStream(delay time.Duration, chunks ...llm.StreamChunk) func(context.Context, string, []llm.Message) (<-chan llm.StreamChunk, error) {
+	return func(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+		ch := make(chan llm.StreamChunk)
+		go func() {
+			defer close(ch)
+			for _, c := range chunks {
+				time.Sleep(delay)
+				select {
+				case ch <- c:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return ch, nil
+	}
+}
+
+// TestRecordingHarnessStream tests that streamed chunks are forwarded and
+// recorded with their arrival times.
+func TestRecordingHarnessStream(t *testing.T) {
+	mock := &mockHarness{streamFunc: scriptedStream(5*time.Millisecond,
+		llm.StreamChunk{Delta: "Found "},
+		llm.StreamChunk{Delta: "open port"},
+		llm.StreamChunk{FinishReason: "stop", Usage: &llm.TokenUsage{InputTokens: 3, OutputTokens: 4}},
+	)}
+	recorder := NewRecordingHarness(mock)
+
+	ch, err := recorder.Stream(context.Background(), "primary", []llm.Message{{Role: "user", Content: "scan"}})
+	require.NoError(t, err)
+
+	// The step is recorded as soon as the stream opens.
+	traj := recorder.Trajectory()
+	require.Len(t, traj.Steps, 1)
+	assert.Equal(t, "streaming", traj.Steps[0].Output)
+
+	var got []string
+	for chunk := range ch {
+		got = append(got, chunk.Delta)
+	}
+	assert.Equal(t, []string{"Found ", "open port", ""}, got)
+
+	step := recorder.Trajectory().Steps[0]
+	resp, ok := step.Output.(*llm.CompletionResponse)
+	require.True(t, ok, "output is %T", step.Output)
+	assert.Equal(t, "Found open port", resp.Content)
+	assert.Equal(t, "stop", resp.FinishReason)
+	assert.Equal(t, 4, resp.Usage.OutputTokens)
+
+	require.NotNil(t, step.Stream)
+	require.Len(t, step.Stream.Chunks, 3)
+	assert.Equal(t, 3, step.Stream.TotalChunks)
+	assert.False(t, step.Stream.Truncated)
+	assert.GreaterOrEqual(t, step.Stream.Chunks[0].Offset, 5*time.Millisecond)
+	assert.Greater(t, step.Stream.Chunks[2].Offset, step.Stream.Chunks[1].Offset)
+	assert.Equal(t, "stop", step.Stream.Chunks[2].FinishReason)
+	assert.GreaterOrEqual(t, step.Duration, step.Stream.Chunks[2].Offset)
+}
+
+// TestRecordingHarnessStreamCap tests that chunks beyond the recording cap
+// are counted but not kept.
+func TestRecordingHarnessStreamCap(t *testing.T) {
+	chunks := make([]llm.StreamChunk, MaxRecordedStreamChunks+10)
+	for i := range chunks {
+		chunks[i] = llm.StreamChunk{Delta: "x"}
+	}
+	mock := &mockHarness{streamFunc: scriptedStream(0, chunks...)}
+	recorder := NewRecordingHarness(mock)
+
+	ch, err := recorder.Stream(context.Background(), "primary", nil)
+	require.NoError(t, err)
+	for range ch {
+	}
+
+	step := recorder.Trajectory().Steps[0]
+	require.NotNil(t, step.Stream)
+	assert.Len(t, step.Stream.Chunks, MaxRecordedStreamChunks)
+	assert.Equal(t, len(chunks), step.Stream.TotalChunks)
+	assert.True(t, step.Stream.Truncated)
+	assert.Len(t, step.Output.(*llm.CompletionResponse).Content, len(chunks))
+}
+
+// TestRecordingHarnessStreamAfterReset tests that a stream finishing after
+// Reset does not touch the new recording.
+func TestRecordingHarnessStreamAfterReset(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockHarness{streamFunc: scriptedStream(time.Millisecond, llm.StreamChunk{Delta: "late"})}
+	recorder := NewRecordingHarness(mock)
+
+	ch, err := recorder.Stream(ctx, "primary", nil)
+	require.NoError(t, err)
+	recorder.Reset()
+	_, _ = recorder.Complete(ctx, "primary", nil)
+	for range ch {
+	}
+
+	traj := recorder.Trajectory()
+	require.Len(t, traj.Steps, 1)
+	assert.Nil(t, traj.Steps[0].Stream)
+	assert.Equal(t, "mock response", traj.Steps[0].Output.(*llm.CompletionResponse).Content)
+}
+
 // TestRecordingHarnessToolCalls tests recording of tool invocations.
 func TestRecordingHarnessToolCalls(t *testing.T) {
 	ctx := context.Background()
@@ -602,3 +754,35 @@ func TestRecordingHarnessThreadSafety(t *testing.T) {
 	traj := recorder.Trajectory()
 	assert.Len(t, traj.Steps, 10)
 }
+
+// TestRecordingHarnessWithAuditHarness tests that recording and auditing can
+// wrap each other in either order without duplicating entries.
+func TestRecordingHarnessWithAuditHarness(t *testing.T) {
+	ctx := context.Background()
+
+	exercise := func(h agent.Harness) {
+		req := &toolspb.HttpxRequest{Targets: []string{"https://example.com"}}
+		require.NoError(t, h.CallToolProto(ctx, "httpx", req, &toolspb.HttpxResponse{}))
+		require.NoError(t, h.SubmitFinding(ctx, finding.NewFinding("m1", "a1", "Title", "Desc", finding.CategoryJailbreak, finding.SeverityLow)))
+	}
+
+	t.Run("recording outside audit", func(t *testing.T) {
+		audited := agent.NewAuditHarness(&mockHarness{})
+		recorder := NewRecordingHarness(audited)
+		exercise(recorder)
+
+		assert.Len(t, recorder.Trajectory().Steps, 2)
+		entries := audited.AuditLog().Entries()
+		require.Len(t, entries, 2)
+		assert.Equal(t, []string{"https://example.com"}, entries[0].Targets)
+	})
+
+	t.Run("audit outside recording", func(t *testing.T) {
+		recorder := NewRecordingHarness(&mockHarness{})
+		audited := agent.NewAuditHarness(recorder)
+		exercise(audited)
+
+		assert.Len(t, recorder.Trajectory().Steps, 2)
+		assert.Len(t, audited.AuditLog().Entries(), 2)
+	})
+}
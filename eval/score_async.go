@@ -0,0 +1,62 @@
+package eval
+
+import "time"
+
+// ScorerProgress reports a scorer that finished scoring a sample during
+// ScoreAsync.
+type ScorerProgress struct {
+	// SampleID identifies the sample being scored.
+	SampleID string
+
+	// Scorer is the name of the scorer that finished.
+	Scorer string
+
+	// Result is the scorer's result, which is errored if the scorer
+	// failed, timed out, or panicked.
+	Result ScoreResult
+
+	// Duration is how long the scorer took.
+	Duration time.Duration
+
+	// Completed is the number of scorers finished so far, including this
+	// one, out of Total.
+	Completed int
+
+	// Total is the number of scorers passed to ScoreAsync.
+	Total int
+}
+
+// ScoreAsync scores sample like Score, but in the background, so a caller
+// watching a long-running scorer such as an LLM judge can report progress.
+// A ScorerProgress is sent on the first channel as each scorer finishes,
+// then the final Result is sent on the second channel and both channels are
+// closed. Scorers run one after another in the order given, exactly as in
+// Score, so the Result is the same. A skipped sample sends no progress.
+// Without ContinueOnError (see WithScoreOptions), scoring stops at the first
+// errored scorer and Completed does not reach Total.
+//
+// The progress channel is buffered for every scorer, so callers that only
+// need the Result may ignore it. Receive the Result before the test ends,
+// since scoring logs to the test.
+//
+// Example:
+//
+//	progress, done := e.ScoreAsync(sample, judge, eval.NewFindingAccuracyScorer(opts))
+//	for p := range progress {
+//	    fmt.Printf("[%d/%d] %s: %.2f (%s)\n", p.Completed, p.Total, p.Scorer, p.Result.Score, p.Duration)
+//	}
+//	result := <-done
+func (e *E) ScoreAsync(sample Sample, scorers ...Scorer) (<-chan ScorerProgress, <-chan Result) {
+	progress := make(chan ScorerProgress, len(scorers))
+	done := make(chan Result, 1)
+
+	go func() {
+		defer close(done)
+		defer close(progress)
+		done <- e.score(sample, scorers, func(p ScorerProgress) {
+			progress <- p
+		})
+	}()
+
+	return progress, done
+}
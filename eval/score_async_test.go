@@ -0,0 +1,99 @@
+package eval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gatedScorer waits for gate to be closed before scoring, like a slow judge.
+type gatedScorer struct {
+	name  string
+	score float64
+	gate  chan struct{}
+}
+
+func (s *gatedScorer) Name() string { return s.name }
+
+func (s *gatedScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	<-s.gate
+	return ScoreResult{Score: s.score}, nil
+}
+
+func TestEScoreAsync(t *testing.T) {
+	e := &E{T: t}
+	judge := &gatedScorer{name: "judge", score: 0.4, gate: make(chan struct{})}
+
+	progress, done := e.ScoreAsync(Sample{ID: "async"}, &mockScorer{name: "fast", score: 0.8}, judge)
+
+	select {
+	case p := <-progress:
+		assert.Equal(t, "async", p.SampleID)
+		assert.Equal(t, "fast", p.Scorer)
+		assert.Equal(t, 0.8, p.Result.Score)
+		assert.Equal(t, 1, p.Completed)
+		assert.Equal(t, 2, p.Total)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no progress before the slow scorer finished")
+	}
+
+	select {
+	case <-done:
+		t.Fatal("result arrived before the slow scorer finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(judge.gate)
+	p, ok := <-progress
+	require.True(t, ok)
+	assert.Equal(t, "judge", p.Scorer)
+	assert.Equal(t, 2, p.Completed)
+	_, ok = <-progress
+	assert.False(t, ok, "progress is closed after the last scorer")
+
+	result := <-done
+	assert.InDelta(t, 0.6, result.OverallScore, 0.001)
+	assert.Equal(t, 0.4, result.Scores["judge"].Score)
+	_, ok = <-done
+	assert.False(t, ok, "result channel is closed after the result")
+}
+
+func TestEScoreAsync_MatchesScore(t *testing.T) {
+	e := &E{T: t}
+	sample := Sample{ID: "same"}
+	scorers := []Scorer{&mockScorer{name: "a", score: 0.2}, &mockScorer{name: "b", score: 1.0}}
+
+	_, done := e.ScoreAsync(sample, scorers...)
+	async := <-done
+	sync := e.Score(sample, scorers...)
+
+	assert.Equal(t, sync.Scores, async.Scores)
+	assert.Equal(t, sync.OverallScore, async.OverallScore)
+}
+
+func TestEScoreAsync_Skipped(t *testing.T) {
+	e := &E{T: t}
+	progress, done := e.ScoreAsync(Sample{ID: "skip", Skip: "flaky target"}, &mockScorer{name: "a", score: 1.0})
+
+	result := <-done
+	assert.Equal(t, SampleStatusSkipped, result.Status)
+	_, ok := <-progress
+	assert.False(t, ok, "skipped samples send no progress")
+}
+
+func TestEScoreAsync_StopsOnError(t *testing.T) {
+	e := (&E{T: t}).WithScoreOptions(ScoreOptions{ContinueOnError: false})
+	progress, done := e.ScoreAsync(Sample{ID: "err"}, &panickingScorer{}, &mockScorer{name: "after", score: 1.0})
+
+	<-done
+	var got []ScorerProgress
+	for p := range progress {
+		got = append(got, p)
+	}
+	require.Len(t, got, 1)
+	assert.Equal(t, ScorerStatusErrored, got[0].Result.Status)
+	assert.Equal(t, 2, got[0].Total)
+}
@@ -5,6 +5,7 @@ package eval
 import (
 	"context"
 	"fmt"
+	"math"
 )
 
 // Scorer evaluates a sample and returns a scored result.
@@ -28,6 +29,13 @@ type ScoreResult struct {
 	// Details contains scorer-specific diagnostic information.
 	// Common keys include: "matched", "missing", "extra", "precision", "recall", "f1"
 	Details map[string]any `json:"details,omitempty" yaml:"details,omitempty"`
+
+	// Status is ScorerStatusErrored if the scorer returned an error, timed
+	// out, or panicked, and empty otherwise.
+	Status ScorerStatus `json:"status,omitempty" yaml:"status,omitempty"`
+
+	// Error describes why the scorer errored.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
 }
 
 // ValidateScore ensures the score is within the valid range [0.0, 1.0].
@@ -45,6 +53,24 @@ func ValidateScore(score float64) error {
 	return nil
 }
 
+// detailUnclampedScore is the ScoreResult detail holding the original score
+// of a scorer whose out-of-range score was clamped.
+const detailUnclampedScore = "unclamped_score"
+
+// clampScore limits score to [0.0, 1.0]. NaN becomes 0.0.
+func clampScore(score float64) float64 {
+	if math.IsNaN(score) {
+		return 0.0
+	}
+	return math.Max(0.0, math.Min(1.0, score))
+}
+
+// usableWeightSum returns true if a sum of weights can normalize them: it
+// must be positive and finite.
+func usableWeightSum(sum float64) bool {
+	return sum > 0 && !math.IsInf(sum, 1)
+}
+
 // AggregateScores combines multiple ScoreResults into a single weighted score.
 // If weights is nil or empty, all scores are weighted equally (average).
 // If weights are provided, only scorers with matching names in the weights map are included.
@@ -82,8 +108,8 @@ func AggregateScores(results []ScoreResult, weights map[string]float64) float64
 		weightSum += w
 	}
 
-	if weightSum == 0.0 {
-		// All weights are zero, fall back to equal weighting
+	if !usableWeightSum(weightSum) {
+		// All weights are zero, or cannot be normalized, fall back to equal weighting
 		var sum float64
 		for _, result := range results {
 			sum += result.Score
@@ -111,7 +137,7 @@ func AggregateScores(results []ScoreResult, weights map[string]float64) float64
 		_ = scorerName // Will be used when ScoreResult includes scorer name
 	}
 
-	return weightedSum
+	return clampScore(weightedSum)
 }
 
 // AggregateScoresWithNames combines multiple named ScoreResults into a single weighted score.
@@ -140,8 +166,8 @@ func AggregateScoresWithNames(results map[string]ScoreResult, weights map[string
 		}
 	}
 
-	if weightSum == 0.0 {
-		// No matching scorers or all weights are zero, fall back to equal weighting
+	if !usableWeightSum(weightSum) {
+		// No matching scorers, or weights that cannot be normalized, fall back to equal weighting
 		var sum float64
 		for _, result := range results {
 			sum += result.Score
@@ -158,5 +184,5 @@ func AggregateScoresWithNames(results map[string]ScoreResult, weights map[string
 		}
 	}
 
-	return weightedSum
+	return clampScore(weightedSum)
 }
@@ -0,0 +1,131 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// combinedScorer reduces the scores of several scorers to one.
+type combinedScorer struct {
+	op      string
+	scorers []Scorer
+	empty   float64
+	reduce  func(a, b float64) float64
+}
+
+// All creates a scorer that passes only as well as its weakest sub-scorer:
+// the score is the minimum of the sub-scores, so a gate on it behaves like a
+// logical AND. With no sub-scorers the score is 1.0.
+//
+// Details returned:
+//   - scores: Map of sub-scorer name to its score. Repeated names are
+//     suffixed with "#2", "#3", and so on.
+//
+// Example:
+//
+//	gate := eval.All(
+//	    eval.NewToolCorrectnessScorer(eval.ToolCorrectnessOptions{}),
+//	    eval.Any(taskCompletion, llmJudge),
+//	)
+func All(scorers ...Scorer) Scorer {
+	return &combinedScorer{op: "all", scorers: scorers, empty: 1.0, reduce: math.Min}
+}
+
+// Any creates a scorer that is as good as its strongest sub-scorer: the score
+// is the maximum of the sub-scores, so a gate on it behaves like a logical
+// OR. With no sub-scorers the score is 0.0. Details are the same as for All.
+func Any(scorers ...Scorer) Scorer {
+	return &combinedScorer{op: "any", scorers: scorers, empty: 0.0, reduce: math.Max}
+}
+
+// Name returns the scorer identifier, e.g. "all(tool_correctness,any(a,b))".
+func (s *combinedScorer) Name() string {
+	names := make([]string, len(s.scorers))
+	for i, scorer := range s.scorers {
+		names[i] = scorer.Name()
+	}
+	return s.op + "(" + strings.Join(names, ",") + ")"
+}
+
+// Score runs every sub-scorer and reduces their scores. An error from any
+// sub-scorer fails the combined score, since the gate cannot be decided
+// without it.
+func (s *combinedScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	score := s.empty
+	scores := make(map[string]float64, len(s.scorers))
+	for i, scorer := range s.scorers {
+		result, err := scorer.Score(ctx, sample)
+		if err != nil {
+			return ScoreResult{}, fmt.Errorf("%s: scorer %s: %w", s.op, scorer.Name(), err)
+		}
+		if i == 0 {
+			score = result.Score
+		} else {
+			score = s.reduce(score, result.Score)
+		}
+		scores[uniqueScoreKey(scores, scorer.Name())] = result.Score
+	}
+
+	if err := ValidateScore(score); err != nil {
+		return ScoreResult{}, fmt.Errorf("invalid %s score: %w", s.op, err)
+	}
+
+	return ScoreResult{
+		Score:   score,
+		Details: map[string]any{"scores": scores},
+	}, nil
+}
+
+// uniqueScoreKey returns name, or name suffixed with "#n" if it is already
+// a key of scores.
+func uniqueScoreKey(scores map[string]float64, name string) string {
+	if _, taken := scores[name]; !taken {
+		return name
+	}
+	for n := 2; ; n++ {
+		key := fmt.Sprintf("%s#%d", name, n)
+		if _, taken := scores[key]; !taken {
+			return key
+		}
+	}
+}
+
+// notScorer inverts a scorer.
+type notScorer struct {
+	scorer Scorer
+}
+
+// Not creates a scorer whose score is the complement of scorer's: 1.0 minus
+// the sub-score. Use it for gates on behaviour that must not happen, such as
+// a judge rubric that detects leaked credentials.
+//
+// Details returned:
+//   - scores: Map of the sub-scorer name to its score
+func Not(scorer Scorer) Scorer {
+	return &notScorer{scorer: scorer}
+}
+
+// Name returns the scorer identifier, e.g. "not(llm_judge)".
+func (s *notScorer) Name() string {
+	return "not(" + s.scorer.Name() + ")"
+}
+
+// Score runs the sub-scorer and returns the complement of its score.
+func (s *notScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	result, err := s.scorer.Score(ctx, sample)
+	if err != nil {
+		return ScoreResult{}, fmt.Errorf("not: scorer %s: %w", s.scorer.Name(), err)
+	}
+
+	score := 1.0 - result.Score
+	if err := ValidateScore(score); err != nil {
+		return ScoreResult{}, fmt.Errorf("invalid not score: %w", err)
+	}
+
+	return ScoreResult{
+		Score:   score,
+		Details: map[string]any{"scores": map[string]float64{s.scorer.Name(): result.Score}},
+	}, nil
+}
@@ -0,0 +1,96 @@
+package eval
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllAnyNot(t *testing.T) {
+	tool := &mockScorer{name: "tool", score: 0.9}
+	task := &mockScorer{name: "task", score: 0.4}
+	judge := &mockScorer{name: "judge", score: 0.7}
+
+	tests := []struct {
+		name       string
+		scorer     Scorer
+		wantName   string
+		wantScore  float64
+		wantScores map[string]float64
+	}{
+		{
+			name:       "all takes the minimum",
+			scorer:     All(tool, task, judge),
+			wantName:   "all(tool,task,judge)",
+			wantScore:  0.4,
+			wantScores: map[string]float64{"tool": 0.9, "task": 0.4, "judge": 0.7},
+		},
+		{
+			name:       "any takes the maximum",
+			scorer:     Any(task, judge),
+			wantName:   "any(task,judge)",
+			wantScore:  0.7,
+			wantScores: map[string]float64{"task": 0.4, "judge": 0.7},
+		},
+		{
+			name:       "not takes the complement",
+			scorer:     Not(task),
+			wantName:   "not(task)",
+			wantScore:  0.6,
+			wantScores: map[string]float64{"task": 0.4},
+		},
+		{
+			name:       "nested",
+			scorer:     All(tool, Any(task, judge)),
+			wantName:   "all(tool,any(task,judge))",
+			wantScore:  0.7,
+			wantScores: map[string]float64{"tool": 0.9, "any(task,judge)": 0.7},
+		},
+		{
+			name:       "repeated names",
+			scorer:     Any(task, task),
+			wantName:   "any(task,task)",
+			wantScore:  0.4,
+			wantScores: map[string]float64{"task": 0.4, "task#2": 0.4},
+		},
+		{
+			name:       "empty all",
+			scorer:     All(),
+			wantName:   "all()",
+			wantScore:  1.0,
+			wantScores: map[string]float64{},
+		},
+		{
+			name:       "empty any",
+			scorer:     Any(),
+			wantName:   "any()",
+			wantScore:  0.0,
+			wantScores: map[string]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantName, tt.scorer.Name())
+
+			result, err := tt.scorer.Score(context.Background(), Sample{})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantScore, result.Score, 1e-9)
+			assert.InDeltaMapValues(t, tt.wantScores, result.Details["scores"], 1e-9)
+		})
+	}
+}
+
+func TestAllAnyNot_SubScorerError(t *testing.T) {
+	failing := &mockScorer{name: "judge", err: errors.New("provider unavailable")}
+	ok := &mockScorer{name: "tool", score: 1.0}
+
+	for _, scorer := range []Scorer{All(ok, failing), Any(ok, failing), Not(failing)} {
+		_, err := scorer.Score(context.Background(), Sample{})
+		require.Error(t, err, scorer.Name())
+		assert.Contains(t, err.Error(), "scorer judge: provider unavailable")
+	}
+}
@@ -0,0 +1,139 @@
+package eval
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+func llmStep(tokens int) TrajectoryStep {
+	return TrajectoryStep{Type: "llm", Name: "primary", Output: &llm.CompletionResponse{
+		Usage: llm.TokenUsage{TotalTokens: tokens},
+	}}
+}
+
+func toolStep(name string) TrajectoryStep {
+	return TrajectoryStep{Type: "tool", Name: name}
+}
+
+func TestConstraintComplianceScorer(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints agent.TaskConstraints
+		steps       []TrajectoryStep
+		want        float64
+		kinds       []string
+	}{
+		{
+			name:  "no constraints",
+			steps: []TrajectoryStep{llmStep(100), toolStep("sqlmap")},
+			want:  1.0,
+		},
+		{
+			name:        "compliant",
+			constraints: agent.TaskConstraints{MaxTurns: 2, MaxTokens: 500, AllowedTools: []string{"nmap", "httpx"}},
+			steps:       []TrajectoryStep{llmStep(200), toolStep("nmap"), llmStep(200), toolStep("httpx")},
+			want:        1.0,
+		},
+		{
+			name:        "blocked tool",
+			constraints: agent.TaskConstraints{BlockedTools: []string{"sqlmap"}},
+			steps:       []TrajectoryStep{toolStep("nmap"), toolStep("sqlmap"), toolStep("httpx"), toolStep("nuclei")},
+			want:        0.75,
+			kinds:       []string{"blocked_tool"},
+		},
+		{
+			name:        "tool outside allowed list",
+			constraints: agent.TaskConstraints{AllowedTools: []string{"nmap"}},
+			steps:       []TrajectoryStep{toolStep("nmap"), {Type: "tool_queue", Name: "nuclei"}},
+			want:        0.5,
+			kinds:       []string{"disallowed_tool"},
+		},
+		{
+			name:        "blocked takes precedence over allowed",
+			constraints: agent.TaskConstraints{AllowedTools: []string{"sqlmap"}, BlockedTools: []string{"sqlmap"}},
+			steps:       []TrajectoryStep{toolStep("sqlmap")},
+			want:        0.0,
+			kinds:       []string{"blocked_tool"},
+		},
+		{
+			name:        "exceeds max turns and tokens",
+			constraints: agent.TaskConstraints{MaxTurns: 2, MaxTokens: 250},
+			steps:       []TrajectoryStep{llmStep(100), llmStep(100), llmStep(100)},
+			want:        0.0,
+			kinds:       []string{"max_turns", "max_tokens"},
+		},
+		{
+			name:        "turn limit only",
+			constraints: agent.TaskConstraints{MaxTurns: 1, BlockedTools: []string{"sqlmap"}},
+			steps:       []TrajectoryStep{llmStep(0), toolStep("nmap"), llmStep(0)},
+			want:        0.5,
+			kinds:       []string{"max_turns"},
+		},
+	}
+
+	scorer := NewConstraintComplianceScorer()
+	assert.Equal(t, "constraint_compliance", scorer.Name())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample := Sample{
+				Task:       agent.Task{Constraints: tt.constraints},
+				Trajectory: Trajectory{Steps: tt.steps},
+			}
+			result, err := scorer.Score(context.Background(), sample)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, result.Score, 1e-9)
+			assert.Equal(t, len(tt.kinds), result.Details["violations"])
+
+			if len(tt.kinds) == 0 {
+				assert.NotContains(t, result.Details, "violation_details")
+				return
+			}
+			violations := result.Details["violation_details"].([]map[string]any)
+			var kinds []string
+			for _, v := range violations {
+				kinds = append(kinds, v["kind"].(string))
+			}
+			assert.Equal(t, tt.kinds, kinds)
+		})
+	}
+}
+
+func TestConstraintComplianceScorer_ViolationDetails(t *testing.T) {
+	sample := Sample{
+		Task: agent.Task{Constraints: agent.TaskConstraints{MaxTurns: 1, BlockedTools: []string{"sqlmap"}}},
+		Trajectory: Trajectory{Steps: []TrajectoryStep{
+			llmStep(10), toolStep("sqlmap"), llmStep(10),
+		}},
+	}
+
+	result, err := NewConstraintComplianceScorer().Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Details["turns"])
+	assert.Equal(t, 20, result.Details["tokens"])
+
+	violations := result.Details["violation_details"].([]map[string]any)
+	require.Len(t, violations, 2)
+	assert.Equal(t, map[string]any{"kind": "blocked_tool", "tool": "sqlmap", "step": 1}, violations[0])
+	assert.Equal(t, map[string]any{"kind": "max_turns", "limit": 1, "actual": 2}, violations[1])
+}
+
+func TestConstraintComplianceScorer_IgnoresDelegatedSteps(t *testing.T) {
+	child := &Trajectory{Steps: []TrajectoryStep{toolStep("sqlmap")}}
+	sample := Sample{
+		Task: agent.Task{Constraints: agent.TaskConstraints{BlockedTools: []string{"sqlmap"}}},
+		Trajectory: Trajectory{Steps: []TrajectoryStep{
+			toolStep("nmap"),
+			{Type: "delegate", Name: "exploiter", Children: child},
+		}},
+	}
+
+	result, err := NewConstraintComplianceScorer().Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+}
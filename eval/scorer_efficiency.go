@@ -0,0 +1,102 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+)
+
+// EfficiencyOptions configures the efficiency scorer. A zero limit is not
+// checked.
+type EfficiencyOptions struct {
+	// MaxLLMCalls is the number of "llm" steps the task should need.
+	MaxLLMCalls int
+
+	// MaxToolCalls is the number of "tool" steps the task should need.
+	MaxToolCalls int
+
+	// MaxTotalSteps is the number of steps of any type the task should need.
+	MaxTotalSteps int
+}
+
+// efficiencyScorer penalizes trajectories that make more calls than a task
+// should need.
+type efficiencyScorer struct {
+	opts EfficiencyOptions
+}
+
+// NewEfficiencyScorer creates a scorer that flags agents making
+// pathologically many LLM or tool calls, an efficiency regression that
+// accuracy-focused scorers miss. Steps of delegated sub-agents count toward
+// the totals.
+//
+// Score calculation:
+//   - Each configured limit scores 1.0 when the count is at or below it,
+//     and limit/count above it, so twice the limit scores 0.5
+//   - Score = the lowest of the per-limit scores
+//   - Score = 1.0 when no limits are configured
+//
+// Details returned:
+//   - llm_calls: Number of LLM calls in the trajectory
+//   - tool_calls: Number of tool calls in the trajectory
+//   - total_steps: Number of steps in the trajectory
+//   - exceeded: Names of the exceeded limits ("llm_calls", "tool_calls",
+//     "total_steps")
+//
+// Example:
+//
+//	scorer := eval.NewEfficiencyScorer(eval.EfficiencyOptions{
+//	    MaxLLMCalls:  10,
+//	    MaxToolCalls: 20,
+//	})
+func NewEfficiencyScorer(opts EfficiencyOptions) Scorer {
+	return &efficiencyScorer{opts: opts}
+}
+
+// Name returns the scorer identifier.
+func (s *efficiencyScorer) Name() string {
+	return "efficiency"
+}
+
+// Score evaluates the call counts of the sample's trajectory.
+func (s *efficiencyScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	steps := sample.Trajectory.Flatten().Steps
+
+	llmCalls, toolCalls := 0, 0
+	for _, step := range steps {
+		switch step.Type {
+		case "llm":
+			llmCalls++
+		case "tool":
+			toolCalls++
+		}
+	}
+
+	score := 1.0
+	exceeded := []string{}
+	check := func(name string, count, limit int) {
+		if limit <= 0 || count <= limit {
+			return
+		}
+		exceeded = append(exceeded, name)
+		if ratio := float64(limit) / float64(count); ratio < score {
+			score = ratio
+		}
+	}
+	check("llm_calls", llmCalls, s.opts.MaxLLMCalls)
+	check("tool_calls", toolCalls, s.opts.MaxToolCalls)
+	check("total_steps", len(steps), s.opts.MaxTotalSteps)
+
+	if err := ValidateScore(score); err != nil {
+		return ScoreResult{}, fmt.Errorf("invalid efficiency score: %w", err)
+	}
+
+	return ScoreResult{
+		Score: score,
+		Details: map[string]any{
+			"llm_calls":   llmCalls,
+			"tool_calls":  toolCalls,
+			"total_steps": len(steps),
+			"exceeded":    exceeded,
+		},
+	}, nil
+}
@@ -0,0 +1,113 @@
+package eval
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// efficiencyTrajectory returns a trajectory with the given numbers of LLM and
+// tool calls followed by one finding.
+func efficiencyTrajectory(llmCalls, toolCalls int) Trajectory {
+	var steps []TrajectoryStep
+	for range llmCalls {
+		steps = append(steps, TrajectoryStep{Type: "llm", Name: "primary"})
+	}
+	for range toolCalls {
+		steps = append(steps, TrajectoryStep{Type: "tool", Name: "nmap"})
+	}
+	steps = append(steps, TrajectoryStep{Type: "finding", Name: "open-port"})
+	return Trajectory{Steps: steps}
+}
+
+func TestEfficiencyScorer_Name(t *testing.T) {
+	assert.Equal(t, "efficiency", NewEfficiencyScorer(EfficiencyOptions{}).Name())
+}
+
+func TestEfficiencyScorer_WithinLimits(t *testing.T) {
+	scorer := NewEfficiencyScorer(EfficiencyOptions{MaxLLMCalls: 5, MaxToolCalls: 5, MaxTotalSteps: 11})
+
+	result, err := scorer.Score(context.Background(), Sample{Trajectory: efficiencyTrajectory(5, 5)})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, 5, result.Details["llm_calls"])
+	assert.Equal(t, 5, result.Details["tool_calls"])
+	assert.Equal(t, 11, result.Details["total_steps"])
+	assert.Empty(t, result.Details["exceeded"])
+}
+
+func TestEfficiencyScorer_ExceededLimits(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         EfficiencyOptions
+		llm, tools   int
+		wantScore    float64
+		wantExceeded []string
+	}{
+		{
+			name:         "twice the LLM limit",
+			opts:         EfficiencyOptions{MaxLLMCalls: 10},
+			llm:          20,
+			tools:        3,
+			wantScore:    0.5,
+			wantExceeded: []string{"llm_calls"},
+		},
+		{
+			name:         "worst overrun decides",
+			opts:         EfficiencyOptions{MaxLLMCalls: 10, MaxToolCalls: 5},
+			llm:          12,
+			tools:        20,
+			wantScore:    0.25,
+			wantExceeded: []string{"llm_calls", "tool_calls"},
+		},
+		{
+			name:         "total steps",
+			opts:         EfficiencyOptions{MaxTotalSteps: 4},
+			llm:          3,
+			tools:        4,
+			wantScore:    0.5,
+			wantExceeded: []string{"total_steps"},
+		},
+		{
+			name:      "no limits configured",
+			opts:      EfficiencyOptions{},
+			llm:       500,
+			tools:     500,
+			wantScore: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewEfficiencyScorer(tt.opts).Score(context.Background(), Sample{Trajectory: efficiencyTrajectory(tt.llm, tt.tools)})
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.wantScore, result.Score, 0.0001)
+			assert.Equal(t, tt.llm, result.Details["llm_calls"])
+			assert.Equal(t, tt.tools, result.Details["tool_calls"])
+			if tt.wantExceeded == nil {
+				assert.Empty(t, result.Details["exceeded"])
+			} else {
+				assert.Equal(t, tt.wantExceeded, result.Details["exceeded"])
+			}
+		})
+	}
+}
+
+func TestEfficiencyScorer_CountsDelegatedSteps(t *testing.T) {
+	child := efficiencyTrajectory(4, 2)
+	sample := Sample{Trajectory: Trajectory{Steps: []TrajectoryStep{
+		{Type: "llm", Name: "primary"},
+		{Type: "delegate", Name: "recon", Children: &child},
+	}}}
+
+	result, err := NewEfficiencyScorer(EfficiencyOptions{MaxLLMCalls: 5}).Score(context.Background(), sample)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, result.Details["llm_calls"])
+	assert.Equal(t, 2, result.Details["tool_calls"])
+	assert.Equal(t, 1.0, result.Score)
+}
@@ -0,0 +1,192 @@
+package eval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ExternalScorerProtocolVersion is the version of the JSON protocol spoken
+// between an ExternalScorer and its subprocess.
+const ExternalScorerProtocolVersion = 1
+
+// maxExternalScorerStderr bounds how much of a failed subprocess's stderr is
+// included in the error.
+const maxExternalScorerStderr = 1024
+
+// ExternalScorerOptions configures a scorer implemented by a subprocess.
+type ExternalScorerOptions struct {
+	// Name is the scorer name reported in results (required).
+	Name string
+
+	// Command is the executable to run (required). It is resolved with
+	// exec.LookPath when it contains no path separator.
+	Command string
+
+	// Args are passed to the command.
+	Args []string
+
+	// Env holds additional "KEY=value" environment variables. The
+	// subprocess inherits the current environment as well.
+	Env []string
+
+	// Dir is the working directory of the subprocess. Empty means the
+	// current directory.
+	Dir string
+
+	// Timeout bounds a single evaluation (default: 30s). The subprocess is
+	// killed when it expires.
+	Timeout time.Duration
+}
+
+// ExternalScoreRequest is written as JSON to the subprocess's stdin. The
+// sample includes the recorded trajectory.
+type ExternalScoreRequest struct {
+	// ProtocolVersion is ExternalScorerProtocolVersion.
+	ProtocolVersion int `json:"protocol_version"`
+
+	// Scorer is the configured scorer name.
+	Scorer string `json:"scorer"`
+
+	// Sample is the sample to evaluate.
+	Sample Sample `json:"sample"`
+}
+
+// ExternalScoreResponse is read as JSON from the subprocess's stdout. A
+// non-empty Error fails the evaluation.
+type ExternalScoreResponse struct {
+	// Score must be in the range [0.0, 1.0].
+	Score float64 `json:"score"`
+
+	// Details contains scorer-specific diagnostic information.
+	Details map[string]any `json:"details,omitempty"`
+
+	// Error reports that the scorer could not evaluate the sample.
+	Error string `json:"error,omitempty"`
+}
+
+// externalScorer runs a subprocess once per sample.
+type externalScorer struct {
+	name    string
+	command string
+	args    []string
+	env     []string
+	dir     string
+	timeout time.Duration
+}
+
+// NewExternalScorer creates a scorer that delegates to a subprocess, so
+// scoring logic can be written in any language. For each sample the
+// subprocess receives an ExternalScoreRequest on stdin and must write an
+// ExternalScoreResponse to stdout and exit with status 0. Anything written
+// to stderr is included in the error when the subprocess fails.
+// Returns an error if Name or Command is not provided.
+//
+// Example:
+//
+//	scorer, err := eval.NewExternalScorer(eval.ExternalScorerOptions{
+//	    Name:    "exploit_quality",
+//	    Command: "python3",
+//	    Args:    []string{"scorers/exploit_quality.py"},
+//	    Timeout: 10 * time.Second,
+//	})
+func NewExternalScorer(opts ExternalScorerOptions) (Scorer, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf("ExternalScorerOptions.Name is required")
+	}
+	if opts.Command == "" {
+		return nil, fmt.Errorf("ExternalScorerOptions.Command is required")
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &externalScorer{
+		name:    opts.Name,
+		command: opts.Command,
+		args:    opts.Args,
+		env:     opts.Env,
+		dir:     opts.Dir,
+		timeout: timeout,
+	}, nil
+}
+
+// Name returns the configured scorer name.
+func (s *externalScorer) Name() string {
+	return s.name
+}
+
+// Score runs the subprocess on the sample and returns its score.
+func (s *externalScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	input, err := json.Marshal(ExternalScoreRequest{
+		ProtocolVersion: ExternalScorerProtocolVersion,
+		Scorer:          s.name,
+		Sample:          sample,
+	})
+	if err != nil {
+		return ScoreResult{}, fmt.Errorf("external scorer %s: encode request: %w", s.name, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Dir = s.dir
+	if len(s.env) > 0 {
+		cmd.Env = append(os.Environ(), s.env...)
+	}
+	// Don't wait forever for grandchildren holding the pipes open
+	cmd.WaitDelay = time.Second
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return ScoreResult{}, fmt.Errorf("external scorer %s: timed out after %s", s.name, s.timeout)
+		}
+		if ctx.Err() != nil {
+			return ScoreResult{}, fmt.Errorf("external scorer %s: %w", s.name, ctx.Err())
+		}
+		return ScoreResult{}, fmt.Errorf("external scorer %s: %w%s", s.name, err, stderrSuffix(stderr.String()))
+	}
+
+	var resp ExternalScoreResponse
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return ScoreResult{}, fmt.Errorf("external scorer %s: invalid response: %w", s.name, err)
+	}
+	if resp.Error != "" {
+		return ScoreResult{}, fmt.Errorf("external scorer %s: %s", s.name, resp.Error)
+	}
+	if err := ValidateScore(resp.Score); err != nil {
+		return ScoreResult{}, fmt.Errorf("external scorer %s: %w", s.name, err)
+	}
+
+	return ScoreResult{
+		Score:   resp.Score,
+		Details: resp.Details,
+	}, nil
+}
+
+// stderrSuffix formats the tail of a subprocess's stderr for an error
+// message.
+func stderrSuffix(stderr string) string {
+	stderr = strings.TrimSpace(stderr)
+	if stderr == "" {
+		return ""
+	}
+	if len(stderr) > maxExternalScorerStderr {
+		stderr = "..." + stderr[len(stderr)-maxExternalScorerStderr:]
+	}
+	return ": " + stderr
+}
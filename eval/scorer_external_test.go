@@ -0,0 +1,127 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+)
+
+// writeScorerScript writes an executable shell script and returns its path.
+func writeScorerScript(t *testing.T, body string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("external scorer tests use shell scripts")
+	}
+	path := filepath.Join(t.TempDir(), "scorer.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o755))
+	return path
+}
+
+func TestNewExternalScorer(t *testing.T) {
+	_, err := NewExternalScorer(ExternalScorerOptions{Command: "scorer"})
+	assert.Error(t, err, "name is required")
+
+	_, err = NewExternalScorer(ExternalScorerOptions{Name: "external"})
+	assert.Error(t, err, "command is required")
+
+	scorer, err := NewExternalScorer(ExternalScorerOptions{Name: "external", Command: "scorer"})
+	require.NoError(t, err)
+	assert.Equal(t, "external", scorer.Name())
+}
+
+func TestExternalScorer_Protocol(t *testing.T) {
+	dir := t.TempDir()
+	requestPath := filepath.Join(dir, "request.json")
+	script := writeScorerScript(t, `cat > "$REQUEST_PATH"
+echo '{"score": 0.75, "details": {"checked": 3}}'`)
+
+	scorer, err := NewExternalScorer(ExternalScorerOptions{
+		Name:    "exploit_quality",
+		Command: script,
+		Env:     []string{"REQUEST_PATH=" + requestPath},
+	})
+	require.NoError(t, err)
+
+	sample := Sample{
+		ID:   "sample-1",
+		Task: agent.Task{Goal: "find sqli"},
+		Trajectory: Trajectory{Steps: []TrajectoryStep{
+			{Type: "tool", Name: "sqlmap"},
+		}},
+	}
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.75, result.Score)
+	assert.Equal(t, float64(3), result.Details["checked"])
+
+	data, err := os.ReadFile(requestPath)
+	require.NoError(t, err)
+	var req ExternalScoreRequest
+	require.NoError(t, json.Unmarshal(data, &req))
+	assert.Equal(t, ExternalScorerProtocolVersion, req.ProtocolVersion)
+	assert.Equal(t, "exploit_quality", req.Scorer)
+	assert.Equal(t, "sample-1", req.Sample.ID)
+	require.Len(t, req.Sample.Trajectory.Steps, 1)
+	assert.Equal(t, "sqlmap", req.Sample.Trajectory.Steps[0].Name)
+}
+
+func TestExternalScorer_Failures(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		timeout time.Duration
+		wantErr string
+	}{
+		{
+			name:    "non-zero exit",
+			script:  "echo 'model file missing' >&2\nexit 3",
+			wantErr: "model file missing",
+		},
+		{
+			name:    "invalid json",
+			script:  "echo 'not json'",
+			wantErr: "invalid response",
+		},
+		{
+			name:    "reported error",
+			script:  `echo '{"error": "sample has no findings"}'`,
+			wantErr: "sample has no findings",
+		},
+		{
+			name:    "score out of range",
+			script:  `echo '{"score": 1.5}'`,
+			wantErr: "out of valid range",
+		},
+		{
+			name:    "timeout",
+			script:  "sleep 5",
+			timeout: 100 * time.Millisecond,
+			wantErr: "timed out",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scorer, err := NewExternalScorer(ExternalScorerOptions{
+				Name:    "external",
+				Command: writeScorerScript(t, "cat > /dev/null\n"+tt.script),
+				Timeout: tt.timeout,
+			})
+			require.NoError(t, err)
+
+			start := time.Now()
+			_, err = scorer.Score(context.Background(), Sample{ID: "sample-1"})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Less(t, time.Since(start), 3*time.Second)
+		})
+	}
+}
@@ -33,6 +33,21 @@ type FindingAccuracyOptions struct {
 	// FuzzyTitleThreshold is the minimum similarity (0.0 to 1.0) for fuzzy title matching.
 	// Default is 0.8. Set to 1.0 to require exact title matches.
 	FuzzyTitleThreshold float64
+
+	// AgentScope restricts scoring to findings submitted within the subtree
+	// of the named agent in a delegation tree. Empty scores every finding in
+	// the tree. Scoped scoring ignores findings in sample metadata since they
+	// cannot be attributed to an agent.
+	AgentScope string
+
+	// LocationMatch is how strictly URLs are compared for ground truth
+	// findings with a Location. Default is LocationExactPath.
+	LocationMatch LocationMatch
+
+	// LocationExtractor returns the location of an actual finding for
+	// ground truth findings with a Location. Default is
+	// DefaultLocationExtractor.
+	LocationExtractor LocationExtractor
 }
 
 // NewFindingAccuracyScorer creates a new finding accuracy scorer with the given options.
@@ -53,6 +68,8 @@ func (s *FindingAccuracyScorer) Name() string {
 
 // Score evaluates finding accuracy against ground truth.
 func (s *FindingAccuracyScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	sample = scopeSample(sample, s.options.AgentScope)
+
 	// Get ground truth findings
 	groundTruth := s.options.GroundTruth
 	if len(groundTruth) == 0 {
@@ -79,35 +96,25 @@ func (s *FindingAccuracyScorer) Score(ctx context.Context, sample Sample) (Score
 	}
 
 	// Match findings and calculate metrics
-	tp, fp, fn := s.matchFindings(actualFindings, groundTruth)
-
-	// Calculate precision, recall, and F1
-	var precision, recall, f1 float64
+	matches, fp, fn := s.matchFindingPairs(actualFindings, groundTruth)
+	tp := make([]*finding.Finding, len(matches))
+	for i, m := range matches {
+		tp[i] = m.actual
+	}
 
-	tpCount := float64(len(tp))
-	fpCount := float64(len(fp))
-	fnCount := float64(len(fn))
+	precision, recall, f1 := accuracyMetrics(float64(len(tp)), float64(len(fp)), float64(len(fn)))
+	unweightedPrecision, unweightedRecall, unweightedF1 := precision, recall, f1
 
-	// Apply severity weighting if enabled
-	if s.options.MatchBySeverity {
-		tpCount = s.calculateWeightedCount(tp)
+	// Apply severity and per-finding weighting if enabled
+	weighted := s.options.MatchBySeverity || hasFindingWeights(groundTruth)
+	var tpCount, fpCount, fnCount float64
+	if weighted {
+		for _, m := range matches {
+			tpCount += s.matchWeight(m)
+		}
 		fpCount = s.calculateWeightedCount(fp)
 		fnCount = s.calculateWeightedCountGroundTruth(fn)
-	}
-
-	// Calculate precision = TP / (TP + FP)
-	if tpCount+fpCount > 0 {
-		precision = tpCount / (tpCount + fpCount)
-	}
-
-	// Calculate recall = TP / (TP + FN)
-	if tpCount+fnCount > 0 {
-		recall = tpCount / (tpCount + fnCount)
-	}
-
-	// Calculate F1 = 2 * (precision * recall) / (precision + recall)
-	if precision+recall > 0 {
-		f1 = 2.0 * (precision * recall) / (precision + recall)
+		precision, recall, f1 = accuracyMetrics(tpCount, fpCount, fnCount)
 	}
 
 	// Build details with lists
@@ -115,21 +122,8 @@ func (s *FindingAccuracyScorer) Score(ctx context.Context, sample Sample) (Score
 	for i, f := range tp {
 		tpList[i] = s.findingToMap(f)
 	}
-
-	fpList := make([]map[string]any, len(fp))
-	for i, f := range fp {
-		fpList[i] = s.findingToMap(f)
-	}
-
-	fnList := make([]map[string]any, len(fn))
-	for i, gt := range fn {
-		fnList[i] = map[string]any{
-			"id":       gt.ID,
-			"title":    gt.Title,
-			"severity": gt.Severity,
-			"category": gt.Category,
-		}
-	}
+	fpList := s.falsePositiveDetails(fp, groundTruth)
+	fnList := s.falseNegativeDetails(fn, actualFindings)
 
 	details := map[string]any{
 		"precision":          precision,
@@ -145,10 +139,20 @@ func (s *FindingAccuracyScorer) Score(ctx context.Context, sample Sample) (Score
 		"actual_count":       len(actualFindings),
 	}
 
-	if s.options.MatchBySeverity {
+	if weighted {
 		details["weighted_tp_count"] = tpCount
 		details["weighted_fp_count"] = fpCount
 		details["weighted_fn_count"] = fnCount
+		details["weighted_precision"] = precision
+		details["weighted_recall"] = recall
+		details["weighted_f1"] = f1
+		details["unweighted_precision"] = unweightedPrecision
+		details["unweighted_recall"] = unweightedRecall
+		details["unweighted_f1"] = unweightedF1
+	}
+
+	if s.options.AgentScope != "" {
+		details["agent_scope"] = s.options.AgentScope
 	}
 
 	return ScoreResult{
@@ -157,12 +161,83 @@ func (s *FindingAccuracyScorer) Score(ctx context.Context, sample Sample) (Score
 	}, nil
 }
 
+// Suggestions implements SuggestingScorer. Each actual finding that matches
+// no ground truth is suggested as a missing ground-truth finding, since
+// agents often find real issues the eval author missed, and each matched
+// finding whose severity differs from its ground truth is suggested as a
+// severity adjustment. A single run proves little; use CollectSuggestions
+// to find suggestions that recur across runs.
+func (s *FindingAccuracyScorer) Suggestions(sample Sample, result ScoreResult) []GroundTruthSuggestion {
+	sample = scopeSample(sample, s.options.AgentScope)
+
+	groundTruth := s.options.GroundTruth
+	if len(groundTruth) == 0 {
+		groundTruth = sample.ExpectedFindings
+	}
+	if len(groundTruth) == 0 {
+		return nil
+	}
+
+	actualFindings, err := s.extractFindings(sample)
+	if err != nil {
+		return nil
+	}
+
+	matches, falsePositives, _ := s.matchFindingPairs(actualFindings, groundTruth)
+
+	var suggestions []GroundTruthSuggestion
+	for _, f := range falsePositives {
+		suggestions = append(suggestions, GroundTruthSuggestion{
+			Kind: SuggestAddFinding,
+			Payload: map[string]any{
+				"title":    f.Title,
+				"severity": string(f.Severity),
+				"category": string(f.Category),
+			},
+			Evidence:   fmt.Sprintf("agent reported %q, which matches no ground-truth finding", f.Title),
+			Confidence: suggestionConfidence(f),
+		})
+	}
+
+	for _, m := range matches {
+		if m.groundTruth.Severity == "" || m.actual.Severity == "" ||
+			strings.EqualFold(m.groundTruth.Severity, string(m.actual.Severity)) {
+			continue
+		}
+		suggestions = append(suggestions, GroundTruthSuggestion{
+			Kind: SuggestAdjustSeverity,
+			Payload: map[string]any{
+				"id":    m.groundTruth.ID,
+				"title": m.groundTruth.Title,
+				"from":  m.groundTruth.Severity,
+				"to":    string(m.actual.Severity),
+			},
+			Evidence: fmt.Sprintf("agent rated %q %s, ground truth says %s",
+				m.actual.Title, m.actual.Severity, m.groundTruth.Severity),
+			Confidence: suggestionConfidence(m.actual),
+		})
+	}
+
+	return suggestions
+}
+
+// suggestionConfidence is the confidence of a suggestion based on f: half
+// the agent's confidence in f, or 0.5 if it did not state one. An unmatched
+// finding is as likely to be an agent mistake as a ground-truth omission.
+func suggestionConfidence(f *finding.Finding) float64 {
+	if f.Confidence > 0 {
+		return f.Confidence / 2
+	}
+	return 0.5
+}
+
 // extractFindings extracts findings from the sample trajectory or metadata.
 func (s *FindingAccuracyScorer) extractFindings(sample Sample) ([]*finding.Finding, error) {
 	var findings []*finding.Finding
 
-	// First, check if findings are in trajectory steps
-	for _, step := range sample.Trajectory.Steps {
+	// First, check if findings are in trajectory steps, including those
+	// submitted by delegated agents
+	for _, step := range sample.Trajectory.Flatten().Steps {
 		if step.Type == "finding" {
 			// Try to parse the output as a finding
 			f, err := s.parseStepFinding(step)
@@ -230,14 +305,20 @@ func (s *FindingAccuracyScorer) parseMetadataFindings(data any) ([]*finding.Find
 	return findings, nil
 }
 
-// matchFindings matches actual findings against ground truth.
-// Returns (true positives, false positives, false negatives).
-func (s *FindingAccuracyScorer) matchFindings(
+// findingMatch pairs an actual finding with the ground truth it matched.
+type findingMatch struct {
+	actual      *finding.Finding
+	groundTruth GroundTruthFinding
+}
+
+// matchFindingPairs matches actual findings against ground truth.
+// Returns (matched pairs, false positives, false negatives).
+func (s *FindingAccuracyScorer) matchFindingPairs(
 	actual []*finding.Finding,
 	groundTruth []GroundTruthFinding,
-) ([]*finding.Finding, []*finding.Finding, []GroundTruthFinding) {
+) ([]findingMatch, []*finding.Finding, []GroundTruthFinding) {
 
-	var truePositives []*finding.Finding
+	var matches []findingMatch
 	var falsePositives []*finding.Finding
 	var falseNegatives []GroundTruthFinding
 
@@ -256,7 +337,7 @@ func (s *FindingAccuracyScorer) matchFindings(
 
 			if s.isMatch(actualFinding, gt) {
 				// Found a match
-				truePositives = append(truePositives, actualFinding)
+				matches = append(matches, findingMatch{actual: actualFinding, groundTruth: gt})
 				matchedGT[i] = true
 				matched = true
 				break
@@ -276,30 +357,12 @@ func (s *FindingAccuracyScorer) matchFindings(
 		}
 	}
 
-	return truePositives, falsePositives, falseNegatives
+	return matches, falsePositives, falseNegatives
 }
 
 // isMatch determines if an actual finding matches a ground truth finding.
 func (s *FindingAccuracyScorer) isMatch(actual *finding.Finding, gt GroundTruthFinding) bool {
-	// First try exact ID match
-	if gt.ID != "" && actual.ID == gt.ID {
-		return true
-	}
-
-	// Try fuzzy title match
-	titleMatch := s.fuzzyTitleMatch(actual.Title, gt.Title)
-	if !titleMatch {
-		return false
-	}
-
-	// If category matching is required, check category
-	if s.options.MatchByCategory {
-		if string(actual.Category) != gt.Category {
-			return false
-		}
-	}
-
-	return true
+	return s.mismatch(actual, gt) == ""
 }
 
 // fuzzyTitleMatch performs fuzzy string matching on titles.
@@ -361,8 +424,13 @@ func (s *FindingAccuracyScorer) jaccardSimilarity(s1, s2 string) float64 {
 	return float64(intersection) / float64(union)
 }
 
-// calculateWeightedCount calculates severity-weighted count of findings.
+// calculateWeightedCount calculates the weighted count of actual findings
+// that match no ground truth: severity-weighted when MatchBySeverity is
+// enabled, otherwise 1.0 each.
 func (s *FindingAccuracyScorer) calculateWeightedCount(findings []*finding.Finding) float64 {
+	if !s.options.MatchBySeverity {
+		return float64(len(findings))
+	}
 	var total float64
 	for _, f := range findings {
 		total += s.severityWeight(f.Severity)
@@ -388,22 +456,77 @@ func (s *FindingAccuracyScorer) severityWeight(sev finding.Severity) float64 {
 	}
 }
 
-// calculateWeightedCountGroundTruth calculates severity-weighted count of ground truth findings.
+// calculateWeightedCountGroundTruth calculates the weighted count of ground truth findings.
 func (s *FindingAccuracyScorer) calculateWeightedCountGroundTruth(findings []GroundTruthFinding) float64 {
 	var total float64
 	for _, f := range findings {
-		// Parse severity from string
-		sev, err := finding.ParseSeverity(f.Severity)
-		if err != nil {
-			// If parsing fails, use default weight of 1.0
-			total += 1.0
-			continue
-		}
-		total += s.severityWeight(sev)
+		total += s.groundTruthWeight(f)
 	}
 	return total
 }
 
+// groundTruthWeight returns the weight of a ground truth finding: its
+// Weight if set, otherwise its severity weight when MatchBySeverity is
+// enabled, otherwise 1.0.
+func (s *FindingAccuracyScorer) groundTruthWeight(gt GroundTruthFinding) float64 {
+	if gt.Weight > 0 {
+		return gt.Weight
+	}
+	if !s.options.MatchBySeverity {
+		return 1.0
+	}
+	// Parse severity from string
+	sev, err := finding.ParseSeverity(gt.Severity)
+	if err != nil {
+		// If parsing fails, use default weight of 1.0
+		return 1.0
+	}
+	return s.severityWeight(sev)
+}
+
+// matchWeight returns the weight of a true positive: the ground truth's
+// Weight if set, otherwise the actual finding's severity weight when
+// MatchBySeverity is enabled, otherwise 1.0.
+func (s *FindingAccuracyScorer) matchWeight(m findingMatch) float64 {
+	if m.groundTruth.Weight > 0 {
+		return m.groundTruth.Weight
+	}
+	if !s.options.MatchBySeverity {
+		return 1.0
+	}
+	return s.severityWeight(m.actual.Severity)
+}
+
+// hasFindingWeights reports whether any ground truth finding sets a Weight.
+func hasFindingWeights(groundTruth []GroundTruthFinding) bool {
+	for _, gt := range groundTruth {
+		if gt.Weight > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// accuracyMetrics returns precision, recall and F1 for the given
+// true positive, false positive and false negative counts.
+func accuracyMetrics(tp, fp, fn float64) (precision, recall, f1 float64) {
+	// Calculate precision = TP / (TP + FP)
+	if tp+fp > 0 {
+		precision = tp / (tp + fp)
+	}
+
+	// Calculate recall = TP / (TP + FN)
+	if tp+fn > 0 {
+		recall = tp / (tp + fn)
+	}
+
+	// Calculate F1 = 2 * (precision * recall) / (precision + recall)
+	if precision+recall > 0 {
+		f1 = 2.0 * (precision * recall) / (precision + recall)
+	}
+	return precision, recall, f1
+}
+
 // findingToMap converts a finding to a map for JSON serialization in details.
 func (s *FindingAccuracyScorer) findingToMap(f *finding.Finding) map[string]any {
 	return map[string]any{
@@ -0,0 +1,180 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FindingLatencyOptions configures the finding submission latency scorer.
+type FindingLatencyOptions struct {
+	// TargetLatency is the submission latency that earns a full score.
+	// Findings submitted within this window after the tool result that
+	// (inferably) revealed them score 1.0.
+	// Default: 10 seconds.
+	TargetLatency time.Duration
+
+	// MaxLatency is the submission latency at which a finding scores 0.0.
+	// Latencies between TargetLatency and MaxLatency are scored linearly.
+	// Default: 5 minutes.
+	MaxLatency time.Duration
+}
+
+// findingLatencyScorer evaluates whether an agent submits findings as they are
+// discovered rather than buffering them until the end of execution.
+type findingLatencyScorer struct {
+	opts FindingLatencyOptions
+}
+
+// NewFindingLatencyScorer creates a scorer that rewards prompt finding submission.
+//
+// The scorer walks the trajectory in order and attributes each "finding" step
+// to the tool result that most plausibly revealed it: the latest preceding
+// successful "tool" step not yet claimed by another finding, falling back to
+// the latest preceding successful tool step when all have been claimed. The
+// submission latency is the time between that tool step completing and the
+// finding step starting.
+//
+// An agent that buffers findings until the end pairs later findings with
+// progressively older tool results, so batching is penalized even when the
+// final tool call happens to precede the batch closely.
+//
+// Score calculation:
+//   - Each attributed finding scores 1.0 at or below TargetLatency,
+//     0.0 at or above MaxLatency, and linearly in between
+//   - Score = mean of per-finding scores
+//   - Findings with no preceding tool result are not scored
+//   - Score = 1.0 when no findings can be attributed
+//
+// Details returned:
+//   - findings: Number of finding steps in the trajectory
+//   - attributed: Number of findings attributed to a tool result
+//   - unattributed: Number of findings with no preceding tool result
+//   - mean_latency_ms: Mean submission latency in milliseconds
+//   - max_latency_ms: Largest submission latency in milliseconds
+//   - late: Number of findings submitted after TargetLatency
+func NewFindingLatencyScorer(opts FindingLatencyOptions) Scorer {
+	if opts.TargetLatency <= 0 {
+		opts.TargetLatency = 10 * time.Second
+	}
+	if opts.MaxLatency <= opts.TargetLatency {
+		opts.MaxLatency = 5 * time.Minute
+		if opts.MaxLatency <= opts.TargetLatency {
+			opts.MaxLatency = 2 * opts.TargetLatency
+		}
+	}
+	return &findingLatencyScorer{opts: opts}
+}
+
+// Name returns the scorer identifier.
+func (s *findingLatencyScorer) Name() string {
+	return "finding_latency"
+}
+
+// Score evaluates finding submission latency for the given sample.
+func (s *findingLatencyScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	latencies, findings := findingSubmissionLatencies(sample.Trajectory)
+
+	details := map[string]any{
+		"findings":     findings,
+		"attributed":   len(latencies),
+		"unattributed": findings - len(latencies),
+	}
+
+	if len(latencies) == 0 {
+		details["mean_latency_ms"] = 0.0
+		details["max_latency_ms"] = 0.0
+		details["late"] = 0
+		return ScoreResult{Score: 1.0, Details: details}, nil
+	}
+
+	var total, worst time.Duration
+	var scoreSum float64
+	late := 0
+	for _, latency := range latencies {
+		total += latency
+		if latency > worst {
+			worst = latency
+		}
+		if latency > s.opts.TargetLatency {
+			late++
+		}
+		scoreSum += s.scoreLatency(latency)
+	}
+
+	score := scoreSum / float64(len(latencies))
+	if err := ValidateScore(score); err != nil {
+		return ScoreResult{}, fmt.Errorf("invalid finding latency score: %w", err)
+	}
+
+	mean := total / time.Duration(len(latencies))
+	details["mean_latency_ms"] = float64(mean) / float64(time.Millisecond)
+	details["max_latency_ms"] = float64(worst) / float64(time.Millisecond)
+	details["late"] = late
+
+	return ScoreResult{
+		Score:   score,
+		Details: details,
+	}, nil
+}
+
+// scoreLatency maps a single submission latency onto [0.0, 1.0].
+func (s *findingLatencyScorer) scoreLatency(latency time.Duration) float64 {
+	switch {
+	case latency <= s.opts.TargetLatency:
+		return 1.0
+	case latency >= s.opts.MaxLatency:
+		return 0.0
+	default:
+		window := float64(s.opts.MaxLatency - s.opts.TargetLatency)
+		return 1.0 - float64(latency-s.opts.TargetLatency)/window
+	}
+}
+
+// findingSubmissionLatencies pairs each finding step with the tool result that
+// most plausibly revealed it and returns the resulting latencies along with the
+// total number of finding steps.
+func findingSubmissionLatencies(trajectory Trajectory) ([]time.Duration, int) {
+	var latencies []time.Duration
+	var toolEnds []time.Time
+	var claimed []bool
+	findings := 0
+
+	for _, step := range trajectory.Steps {
+		switch step.Type {
+		case "tool":
+			if step.Error != "" {
+				continue
+			}
+			toolEnds = append(toolEnds, step.StartTime.Add(step.Duration))
+			claimed = append(claimed, false)
+
+		case "finding":
+			findings++
+			idx := -1
+			for i := len(toolEnds) - 1; i >= 0; i-- {
+				if !claimed[i] && !toolEnds[i].After(step.StartTime) {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				// Every preceding result already produced a finding; the latest
+				// one is the most likely source of this additional finding.
+				for i := len(toolEnds) - 1; i >= 0; i-- {
+					if !toolEnds[i].After(step.StartTime) {
+						idx = i
+						break
+					}
+				}
+			}
+			if idx < 0 {
+				continue
+			}
+			claimed[idx] = true
+			latencies = append(latencies, step.StartTime.Sub(toolEnds[idx]))
+		}
+	}
+
+	return latencies, findings
+}
@@ -0,0 +1,181 @@
+package eval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindingLatencyScorer_Name(t *testing.T) {
+	scorer := NewFindingLatencyScorer(FindingLatencyOptions{})
+	assert.Equal(t, "finding_latency", scorer.Name())
+}
+
+func TestFindingLatencyScorer_RealTimeSubmission(t *testing.T) {
+	scorer := NewFindingLatencyScorer(FindingLatencyOptions{})
+	base := time.Now()
+
+	sample := Sample{
+		ID: "realtime",
+		Trajectory: Trajectory{
+			Steps: []TrajectoryStep{
+				{Type: "tool", Name: "nmap", StartTime: base, Duration: 5 * time.Second},
+				{Type: "finding", Name: "open-port", StartTime: base.Add(6 * time.Second)},
+				{Type: "tool", Name: "httpx", StartTime: base.Add(10 * time.Second), Duration: 2 * time.Second},
+				{Type: "finding", Name: "exposed-admin", StartTime: base.Add(14 * time.Second)},
+			},
+		},
+	}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, 2, result.Details["findings"])
+	assert.Equal(t, 2, result.Details["attributed"])
+	assert.Equal(t, 0, result.Details["late"])
+	assert.InDelta(t, 1500.0, result.Details["mean_latency_ms"], 0.001)
+	assert.InDelta(t, 2000.0, result.Details["max_latency_ms"], 0.001)
+}
+
+func TestFindingLatencyScorer_BatchedSubmissionPenalized(t *testing.T) {
+	scorer := NewFindingLatencyScorer(FindingLatencyOptions{
+		TargetLatency: 5 * time.Second,
+		MaxLatency:    65 * time.Second,
+	})
+	base := time.Now()
+
+	// Three tools, each revealing a finding, but all findings submitted at the end.
+	sample := Sample{
+		ID: "batched",
+		Trajectory: Trajectory{
+			Steps: []TrajectoryStep{
+				{Type: "tool", Name: "nmap", StartTime: base, Duration: 10 * time.Second},
+				{Type: "tool", Name: "httpx", StartTime: base.Add(10 * time.Second), Duration: 10 * time.Second},
+				{Type: "tool", Name: "nuclei", StartTime: base.Add(20 * time.Second), Duration: 10 * time.Second},
+				{Type: "finding", Name: "f1", StartTime: base.Add(31 * time.Second)},
+				{Type: "finding", Name: "f2", StartTime: base.Add(32 * time.Second)},
+				{Type: "finding", Name: "f3", StartTime: base.Add(33 * time.Second)},
+			},
+		},
+	}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+
+	// Latencies: 1s (nuclei), 12s (httpx), 23s (nmap)
+	// Scores:    1.0, 1-(7/60), 1-(18/60)
+	expected := (1.0 + (1.0 - 7.0/60.0) + (1.0 - 18.0/60.0)) / 3.0
+	assert.InDelta(t, expected, result.Score, 0.0001)
+	assert.Equal(t, 2, result.Details["late"])
+	assert.InDelta(t, 12000.0, result.Details["mean_latency_ms"], 0.001)
+	assert.InDelta(t, 23000.0, result.Details["max_latency_ms"], 0.001)
+}
+
+func TestFindingLatencyScorer_MultipleFindingsFromOneTool(t *testing.T) {
+	scorer := NewFindingLatencyScorer(FindingLatencyOptions{})
+	base := time.Now()
+
+	sample := Sample{
+		Trajectory: Trajectory{
+			Steps: []TrajectoryStep{
+				{Type: "tool", Name: "nuclei", StartTime: base, Duration: time.Second},
+				{Type: "finding", Name: "f1", StartTime: base.Add(2 * time.Second)},
+				{Type: "finding", Name: "f2", StartTime: base.Add(3 * time.Second)},
+			},
+		},
+	}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, 2, result.Details["attributed"])
+}
+
+func TestFindingLatencyScorer_IgnoresFailedTools(t *testing.T) {
+	scorer := NewFindingLatencyScorer(FindingLatencyOptions{
+		TargetLatency: time.Second,
+		MaxLatency:    11 * time.Second,
+	})
+	base := time.Now()
+
+	sample := Sample{
+		Trajectory: Trajectory{
+			Steps: []TrajectoryStep{
+				{Type: "tool", Name: "nmap", StartTime: base, Duration: time.Second},
+				{Type: "tool", Name: "httpx", StartTime: base.Add(time.Second), Duration: time.Second, Error: "connection refused"},
+				{Type: "finding", Name: "f1", StartTime: base.Add(7 * time.Second)},
+			},
+		},
+	}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+
+	// Attributed to nmap (ended at 1s), latency 6s -> 1 - 5/10
+	assert.InDelta(t, 0.5, result.Score, 0.0001)
+}
+
+func TestFindingLatencyScorer_NoAttributableFindings(t *testing.T) {
+	scorer := NewFindingLatencyScorer(FindingLatencyOptions{})
+
+	tests := []struct {
+		name         string
+		steps        []TrajectoryStep
+		findings     int
+		unattributed int
+	}{
+		{
+			name:  "empty trajectory",
+			steps: nil,
+		},
+		{
+			name: "finding without preceding tool",
+			steps: []TrajectoryStep{
+				{Type: "finding", Name: "f1", StartTime: time.Now()},
+			},
+			findings:     1,
+			unattributed: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := scorer.Score(context.Background(), Sample{
+				Trajectory: Trajectory{Steps: tt.steps},
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, 1.0, result.Score)
+			assert.Equal(t, tt.findings, result.Details["findings"])
+			assert.Equal(t, tt.unattributed, result.Details["unattributed"])
+			assert.Equal(t, 0.0, result.Details["mean_latency_ms"])
+		})
+	}
+}
+
+func TestFindingLatencyScorer_ExceedsMaxLatency(t *testing.T) {
+	scorer := NewFindingLatencyScorer(FindingLatencyOptions{
+		TargetLatency: time.Second,
+		MaxLatency:    time.Minute,
+	})
+	base := time.Now()
+
+	sample := Sample{
+		Trajectory: Trajectory{
+			Steps: []TrajectoryStep{
+				{Type: "tool", Name: "nmap", StartTime: base, Duration: time.Second},
+				{Type: "finding", Name: "f1", StartTime: base.Add(10 * time.Minute)},
+			},
+		},
+	}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result.Score)
+	assert.Equal(t, 1, result.Details["late"])
+}
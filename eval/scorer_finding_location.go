@@ -0,0 +1,324 @@
+package eval
+
+import (
+	"bufio"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/zero-day-ai/sdk/finding"
+)
+
+// LocationMatch is how strictly FindingAccuracyScorer compares the URL of a
+// ground truth location with the URL of an actual finding. URLs are
+// normalized first: scheme and host are lower-cased, default ports, dot
+// segments, trailing slashes, and fragments are dropped, and query
+// parameters are sorted. Hosts are compared whenever the ground truth URL
+// has one.
+type LocationMatch string
+
+const (
+	// LocationExactPath requires the same path, and the same query when
+	// the ground truth URL has one.
+	LocationExactPath LocationMatch = "exact_path"
+
+	// LocationPathPrefix requires the actual path to be the ground truth
+	// path or below it, so "/api" matches "/api/users".
+	LocationPathPrefix LocationMatch = "path_prefix"
+
+	// LocationHostOnly compares hosts only.
+	LocationHostOnly LocationMatch = "host_only"
+)
+
+// Reasons an unmatched finding did not match, reported as "unmatched_reason"
+// of each false positive and false negative in FindingAccuracyScorer
+// details. The reason is that of the closest candidate: a finding whose
+// title and category agree with some ground truth but whose location does
+// not is reported as MismatchLocation.
+const (
+	// MismatchTitle means no candidate had a matching title.
+	MismatchTitle = "title"
+
+	// MismatchCategory means titles matched but categories differed.
+	MismatchCategory = "category"
+
+	// MismatchLocation means titles and categories matched but locations
+	// differed.
+	MismatchLocation = "location"
+
+	// MismatchAlreadyMatched means a candidate matched but was paired with
+	// another finding, as when two actual findings report the same issue.
+	MismatchAlreadyMatched = "already_matched"
+)
+
+// LocationExtractor returns the location of an actual finding, for matching
+// against ground truth locations.
+type LocationExtractor func(f *finding.Finding) FindingLocation
+
+// Evidence metadata keys DefaultLocationExtractor reads, in order of
+// preference.
+var (
+	locationURLKeys       = []string{"url", "endpoint", "uri", "target_url"}
+	locationParameterKeys = []string{"parameter", "param"}
+	locationMethodKeys    = []string{"method", "http_method"}
+)
+
+// DefaultLocationExtractor returns the location of a finding from its
+// evidence. Each field is taken from the first evidence providing it: from
+// the metadata keys "url", "endpoint", "uri", or "target_url"; "parameter"
+// or "param"; and "method" or "http_method", or else from the request line
+// and Host header of HTTP request evidence.
+func DefaultLocationExtractor(f *finding.Finding) FindingLocation {
+	var loc FindingLocation
+	for _, e := range f.Evidence {
+		if loc.URL == "" {
+			loc.URL = metadataString(e.Metadata, locationURLKeys)
+		}
+		if loc.Parameter == "" {
+			loc.Parameter = metadataString(e.Metadata, locationParameterKeys)
+		}
+		if loc.Method == "" {
+			loc.Method = metadataString(e.Metadata, locationMethodKeys)
+		}
+		if e.Type == finding.EvidenceHTTPRequest && (loc.URL == "" || loc.Method == "") {
+			method, target := parseRequestLine(e.Content)
+			if loc.URL == "" {
+				loc.URL = target
+			}
+			if loc.Method == "" {
+				loc.Method = method
+			}
+		}
+	}
+	return loc
+}
+
+// metadataString returns the first non-empty string value of keys in m.
+func metadataString(m map[string]any, keys []string) string {
+	for _, key := range keys {
+		if v, ok := m[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// parseRequestLine returns the method and URL of a raw HTTP request. A
+// request target that is a path is joined with the Host header.
+func parseRequestLine(raw string) (method, target string) {
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	if !scanner.Scan() {
+		return "", ""
+	}
+	fields := strings.Fields(scanner.Text())
+	if len(fields) < 2 {
+		return "", ""
+	}
+	method, target = fields[0], fields[1]
+	if !strings.HasPrefix(target, "/") {
+		return method, target
+	}
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		if name, value, ok := strings.Cut(line, ":"); ok && strings.EqualFold(strings.TrimSpace(name), "host") {
+			return method, "//" + strings.TrimSpace(value) + target
+		}
+	}
+	return method, target
+}
+
+// normalizedURL is a URL normalized for comparison.
+type normalizedURL struct {
+	host  string
+	path  string
+	query string
+}
+
+// normalizeURL normalizes raw for comparison. A URL without a scheme is
+// read as host and path if it does not start with '/'.
+func normalizeURL(raw string) (normalizedURL, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return normalizedURL{}, false
+	}
+	if !strings.Contains(raw, "://") && !strings.HasPrefix(raw, "/") {
+		raw = "//" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return normalizedURL{}, false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && !isDefaultPort(strings.ToLower(u.Scheme), port) {
+		host = net.JoinHostPort(host, port)
+	}
+
+	p := "/"
+	if u.Path != "" {
+		p = path.Clean("/" + u.Path)
+	}
+
+	return normalizedURL{host: host, path: p, query: u.Query().Encode()}, true
+}
+
+// isDefaultPort reports whether port is the default port of scheme. Without
+// a scheme, both 80 and 443 are treated as default.
+func isDefaultPort(scheme, port string) bool {
+	switch scheme {
+	case "http":
+		return port == "80"
+	case "https":
+		return port == "443"
+	case "":
+		return port == "80" || port == "443"
+	default:
+		return false
+	}
+}
+
+// NormalizeURL returns raw normalized as FindingAccuracyScorer compares
+// URLs, or raw unchanged if it cannot be parsed. See LocationMatch.
+func NormalizeURL(raw string) string {
+	n, ok := normalizeURL(raw)
+	if !ok {
+		return raw
+	}
+	s := n.path
+	if n.host != "" {
+		s = "//" + n.host + s
+	}
+	if n.query != "" {
+		s += "?" + n.query
+	}
+	return s
+}
+
+// matchLocation reports whether actual agrees with every field set in
+// expected, comparing URLs with mode.
+func matchLocation(expected, actual FindingLocation, mode LocationMatch) bool {
+	if expected.Parameter != "" && expected.Parameter != actual.Parameter {
+		return false
+	}
+	if expected.Method != "" && !strings.EqualFold(expected.Method, actual.Method) {
+		return false
+	}
+	if expected.URL == "" {
+		return true
+	}
+
+	want, ok := normalizeURL(expected.URL)
+	if !ok {
+		return false
+	}
+	got, ok := normalizeURL(actual.URL)
+	if !ok {
+		return false
+	}
+	if want.host != "" && want.host != got.host {
+		return false
+	}
+
+	switch mode {
+	case LocationHostOnly:
+		return true
+	case LocationPathPrefix:
+		return want.path == "/" || got.path == want.path || strings.HasPrefix(got.path, want.path+"/")
+	default:
+		return got.path == want.path && (want.query == "" || got.query == want.query)
+	}
+}
+
+// mismatch returns why actual does not match gt, MismatchTitle,
+// MismatchCategory, or MismatchLocation, or "" if it matches. A matching ID
+// matches regardless of the other fields.
+func (s *FindingAccuracyScorer) mismatch(actual *finding.Finding, gt GroundTruthFinding) string {
+	if gt.ID != "" && actual.ID == gt.ID {
+		return ""
+	}
+	if !s.fuzzyTitleMatch(actual.Title, gt.Title) {
+		return MismatchTitle
+	}
+	if s.options.MatchByCategory && string(actual.Category) != gt.Category {
+		return MismatchCategory
+	}
+	if gt.Location != nil && !gt.Location.IsZero() {
+		extract := s.options.LocationExtractor
+		if extract == nil {
+			extract = DefaultLocationExtractor
+		}
+		if !matchLocation(*gt.Location, extract(actual), s.options.LocationMatch) {
+			return MismatchLocation
+		}
+	}
+	return ""
+}
+
+// closerMismatch returns the reason of the closer of two mismatches. A
+// match, "", counts as MismatchAlreadyMatched, since the pair was not
+// matched.
+func closerMismatch(a, b string) string {
+	rank := func(reason string) int {
+		switch reason {
+		case MismatchAlreadyMatched:
+			return 4
+		case MismatchLocation:
+			return 3
+		case MismatchCategory:
+			return 2
+		case MismatchTitle:
+			return 1
+		default:
+			return 0
+		}
+	}
+	if b == "" {
+		b = MismatchAlreadyMatched
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
+
+// falsePositiveDetails describes actual findings that matched no ground
+// truth, with the reason they did not match the closest one.
+func (s *FindingAccuracyScorer) falsePositiveDetails(fp []*finding.Finding, groundTruth []GroundTruthFinding) []map[string]any {
+	list := make([]map[string]any, len(fp))
+	for i, f := range fp {
+		reason := MismatchTitle
+		for _, gt := range groundTruth {
+			reason = closerMismatch(reason, s.mismatch(f, gt))
+		}
+		list[i] = s.findingToMap(f)
+		list[i]["unmatched_reason"] = reason
+	}
+	return list
+}
+
+// falseNegativeDetails describes ground truth findings that no actual
+// finding matched, with the reason the closest one did not match.
+func (s *FindingAccuracyScorer) falseNegativeDetails(fn []GroundTruthFinding, actual []*finding.Finding) []map[string]any {
+	list := make([]map[string]any, len(fn))
+	for i, gt := range fn {
+		reason := MismatchTitle
+		for _, f := range actual {
+			reason = closerMismatch(reason, s.mismatch(f, gt))
+		}
+		list[i] = map[string]any{
+			"id":               gt.ID,
+			"title":            gt.Title,
+			"severity":         gt.Severity,
+			"category":         gt.Category,
+			"unmatched_reason": reason,
+		}
+		if gt.Location != nil {
+			list[i]["location"] = *gt.Location
+		}
+	}
+	return list
+}
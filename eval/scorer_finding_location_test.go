@@ -0,0 +1,158 @@
+package eval
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/finding"
+)
+
+// sqliAt returns a SQL injection finding with HTTP request evidence for the
+// given request line and host.
+func sqliAt(id, requestLine, host string) *finding.Finding {
+	f := finding.NewFindingWithID(id, "m-1", "web", "SQL Injection", "", finding.CategoryDataExtraction, finding.SeverityHigh)
+	f.AddEvidence(*finding.NewEvidence(finding.EvidenceHTTPRequest, "request",
+		requestLine+" HTTP/1.1\r\nHost: "+host+"\r\n\r\nusername=' OR 1=1 --"))
+	return f
+}
+
+func locationSample(findings ...*finding.Finding) Sample {
+	var steps []TrajectoryStep
+	for _, f := range findings {
+		steps = append(steps, TrajectoryStep{Type: "finding", Output: f})
+	}
+	return Sample{Trajectory: Trajectory{Steps: steps}}
+}
+
+func TestFindingAccuracyScorer_Location(t *testing.T) {
+	loginSQLi := GroundTruthFinding{
+		ID:       "gt-login",
+		Title:    "SQL Injection",
+		Category: string(finding.CategoryDataExtraction),
+		Location: &FindingLocation{URL: "https://App.Example/login/", Parameter: "username", Method: "post"},
+	}
+	login := sqliAt("f-login", "POST /login", "app.example")
+	login.Evidence[0].WithMetadata("parameter", "username")
+	admin := sqliAt("f-admin", "POST /admin", "app.example")
+	admin.Evidence[0].WithMetadata("parameter", "username")
+
+	scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{GroundTruth: []GroundTruthFinding{loginSQLi}})
+	result, err := scorer.Score(context.Background(), locationSample(admin, login))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Details["tp_count"])
+	assert.Equal(t, "f-login", result.Details["true_positives"].([]map[string]any)[0]["id"])
+	fp := result.Details["false_positives"].([]map[string]any)
+	require.Len(t, fp, 1)
+	assert.Equal(t, "f-admin", fp[0]["id"])
+	assert.Equal(t, MismatchLocation, fp[0]["unmatched_reason"])
+
+	t.Run("without location both match the title", func(t *testing.T) {
+		gt := loginSQLi
+		gt.Location = nil
+		scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{GroundTruth: []GroundTruthFinding{gt}})
+		result, err := scorer.Score(context.Background(), locationSample(admin, login))
+		require.NoError(t, err)
+		assert.Equal(t, "f-admin", result.Details["true_positives"].([]map[string]any)[0]["id"])
+		fp := result.Details["false_positives"].([]map[string]any)
+		assert.Equal(t, MismatchAlreadyMatched, fp[0]["unmatched_reason"])
+	})
+
+	t.Run("missed location is a false negative", func(t *testing.T) {
+		result, err := scorer.Score(context.Background(), locationSample(admin))
+		require.NoError(t, err)
+		fn := result.Details["false_negatives"].([]map[string]any)
+		require.Len(t, fn, 1)
+		assert.Equal(t, MismatchLocation, fn[0]["unmatched_reason"])
+		assert.Equal(t, *loginSQLi.Location, fn[0]["location"])
+	})
+
+	t.Run("category mismatch is distinguished", func(t *testing.T) {
+		other := sqliAt("f-other", "POST /login", "app.example")
+		other.Category = finding.CategoryJailbreak
+		scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{
+			GroundTruth:     []GroundTruthFinding{loginSQLi},
+			MatchByCategory: true,
+		})
+		result, err := scorer.Score(context.Background(), locationSample(other))
+		require.NoError(t, err)
+		assert.Equal(t, MismatchCategory, result.Details["false_positives"].([]map[string]any)[0]["unmatched_reason"])
+		assert.Equal(t, MismatchCategory, result.Details["false_negatives"].([]map[string]any)[0]["unmatched_reason"])
+	})
+
+	t.Run("parameter and method must agree", func(t *testing.T) {
+		get := sqliAt("f-get", "GET /login?username=x", "app.example")
+		get.Evidence[0].WithMetadata("parameter", "username")
+		password := sqliAt("f-password", "POST /login", "app.example")
+		password.Evidence[0].WithMetadata("parameter", "password")
+
+		result, err := scorer.Score(context.Background(), locationSample(get, password))
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Details["tp_count"])
+		assert.Equal(t, 2, result.Details["fp_count"])
+	})
+
+	t.Run("custom extractor", func(t *testing.T) {
+		scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{
+			GroundTruth: []GroundTruthFinding{{Title: "SQL Injection", Location: &FindingLocation{URL: "/admin"}}},
+			LocationExtractor: func(f *finding.Finding) FindingLocation {
+				return FindingLocation{URL: "/" + f.TargetID}
+			},
+		})
+		f := finding.NewFinding("m-1", "web", "SQL Injection", "", finding.CategoryDataExtraction, finding.SeverityHigh)
+		f.TargetID = "admin"
+		result, err := scorer.Score(context.Background(), locationSample(f))
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Details["tp_count"])
+	})
+}
+
+func TestMatchLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected FindingLocation
+		actual   FindingLocation
+		mode     LocationMatch
+		want     bool
+	}{
+		{"scheme and host case", FindingLocation{URL: "HTTPS://App.Example/login"}, FindingLocation{URL: "https://app.example/login"}, LocationExactPath, true},
+		{"trailing slash", FindingLocation{URL: "https://app.example/login/"}, FindingLocation{URL: "https://app.example/login"}, LocationExactPath, true},
+		{"default port", FindingLocation{URL: "https://app.example/login"}, FindingLocation{URL: "https://app.example:443/login"}, LocationExactPath, true},
+		{"other port", FindingLocation{URL: "https://app.example/login"}, FindingLocation{URL: "https://app.example:8443/login"}, LocationExactPath, false},
+		{"query order", FindingLocation{URL: "/search?q=1&page=2"}, FindingLocation{URL: "//app.example/search?page=2&q=1"}, LocationExactPath, true},
+		{"query ignored when unset", FindingLocation{URL: "/search"}, FindingLocation{URL: "/search?q=1"}, LocationExactPath, true},
+		{"different path", FindingLocation{URL: "/login"}, FindingLocation{URL: "/admin"}, LocationExactPath, false},
+		{"different host", FindingLocation{URL: "https://app.example/login"}, FindingLocation{URL: "https://other.example/login"}, LocationExactPath, false},
+		{"path prefix", FindingLocation{URL: "/api"}, FindingLocation{URL: "/api/users/1"}, LocationPathPrefix, true},
+		{"path prefix is per segment", FindingLocation{URL: "/api"}, FindingLocation{URL: "/apiv2"}, LocationPathPrefix, false},
+		{"exact path is not prefix", FindingLocation{URL: "/api"}, FindingLocation{URL: "/api/users"}, LocationExactPath, false},
+		{"host only", FindingLocation{URL: "app.example"}, FindingLocation{URL: "https://app.example/admin"}, LocationHostOnly, true},
+		{"host only other host", FindingLocation{URL: "app.example"}, FindingLocation{URL: "https://other.example/"}, LocationHostOnly, false},
+		{"missing actual url", FindingLocation{URL: "/login"}, FindingLocation{}, LocationExactPath, false},
+		{"method case", FindingLocation{Method: "POST"}, FindingLocation{Method: "post"}, LocationExactPath, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchLocation(tt.expected, tt.actual, tt.mode))
+		})
+	}
+}
+
+func TestNormalizeURL(t *testing.T) {
+	assert.Equal(t, "//app.example/a/c?x=1&y=2", NormalizeURL("HTTP://App.Example:80/a/b/../c/?y=2&x=1#frag"))
+	assert.Equal(t, "//app.example/", NormalizeURL("https://app.example"))
+}
+
+func TestDefaultLocationExtractor(t *testing.T) {
+	f := sqliAt("f-1", "POST /login", "app.example")
+	assert.Equal(t, FindingLocation{URL: "//app.example/login", Method: "POST"}, DefaultLocationExtractor(f))
+
+	f.Evidence = append([]finding.Evidence{{
+		Type:     finding.EvidencePayload,
+		Metadata: map[string]any{"endpoint": "https://app.example/api/login", "param": "user"},
+	}}, f.Evidence...)
+	assert.Equal(t, FindingLocation{URL: "https://app.example/api/login", Parameter: "user", Method: "POST"}, DefaultLocationExtractor(f))
+}
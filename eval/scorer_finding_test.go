@@ -2,6 +2,7 @@ package eval
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
@@ -440,6 +441,78 @@ func TestFindingAccuracyScorer_SeverityWeighting(t *testing.T) {
 	}
 }
 
+func TestFindingAccuracyScorer_FindingWeights(t *testing.T) {
+	// Missing the critical SQL injection should cost far more than missing
+	// the info leak.
+	groundTruth := []GroundTruthFinding{
+		{ID: "sqli", Title: "SQL Injection", Severity: "critical", Weight: 10},
+		{ID: "leak", Title: "Server Version Disclosure", Severity: "info", Weight: 0.5},
+		{ID: "xss", Title: "Reflected XSS", Severity: "medium"},
+	}
+
+	newSample := func(ids ...string) Sample {
+		sample := Sample{ID: "weighted", ExpectedFindings: groundTruth}
+		for _, id := range ids {
+			f := finding.NewFindingWithID(id, "mission-1", "test-agent", id, "", finding.CategoryPromptInjection, finding.SeverityHigh)
+			sample.Trajectory.Steps = append(sample.Trajectory.Steps, TrajectoryStep{Type: "finding", Output: f})
+		}
+		return sample
+	}
+
+	tests := []struct {
+		name       string
+		ids        []string
+		opts       FindingAccuracyOptions
+		recall     float64
+		unweighted float64
+	}{
+		// Unset weights default to 1.0: recall = (10 + 1) / 11.5
+		{"missed info leak", []string{"sqli", "xss"}, FindingAccuracyOptions{}, 11.0 / 11.5, 2.0 / 3.0},
+		// recall = (0.5 + 1) / 11.5
+		{"missed sqli", []string{"leak", "xss"}, FindingAccuracyOptions{}, 1.5 / 11.5, 2.0 / 3.0},
+		// Unset weights default to the severity weight: the matched XSS is
+		// reported as high (3), the missed leak keeps its weight.
+		{"severity default", []string{"sqli", "xss"}, FindingAccuracyOptions{MatchBySeverity: true}, 13.0 / 13.5, 2.0 / 3.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewFindingAccuracyScorer(tt.opts).Score(context.Background(), newSample(tt.ids...))
+			if err != nil {
+				t.Fatalf("Score failed: %v", err)
+			}
+
+			if got := result.Details["recall"].(float64); math.Abs(got-tt.recall) > 1e-9 {
+				t.Errorf("Expected weighted recall %f, got %f", tt.recall, got)
+			}
+			if got := result.Details["weighted_recall"].(float64); math.Abs(got-tt.recall) > 1e-9 {
+				t.Errorf("Expected weighted_recall %f, got %f", tt.recall, got)
+			}
+			if got := result.Details["unweighted_recall"].(float64); math.Abs(got-tt.unweighted) > 1e-9 {
+				t.Errorf("Expected unweighted_recall %f, got %f", tt.unweighted, got)
+			}
+			if result.Score != result.Details["weighted_f1"].(float64) {
+				t.Errorf("Expected score to be the weighted F1, got %f", result.Score)
+			}
+		})
+	}
+}
+
+func TestFindingAccuracyScorer_UnweightedDetails(t *testing.T) {
+	sample := Sample{
+		ID:               "plain",
+		ExpectedFindings: []GroundTruthFinding{{ID: "sqli", Title: "SQL Injection", Severity: "high"}},
+	}
+
+	result, err := NewFindingAccuracyScorer(FindingAccuracyOptions{}).Score(context.Background(), sample)
+	if err != nil {
+		t.Fatalf("Score failed: %v", err)
+	}
+	if _, ok := result.Details["weighted_f1"]; ok {
+		t.Errorf("Expected no weighted details without weights or severity matching")
+	}
+}
+
 func TestFindingAccuracyScorer_CategoryMatching(t *testing.T) {
 	groundTruth := []GroundTruthFinding{
 		{
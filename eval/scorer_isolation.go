@@ -0,0 +1,145 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"time"
+)
+
+// ScorerStatus reports how a scorer run ended.
+type ScorerStatus string
+
+const (
+	// ScorerStatusErrored indicates the scorer returned an error, timed out,
+	// or panicked. Its score is 0.0 and ScoreResult.Error holds the cause.
+	ScorerStatusErrored ScorerStatus = "errored"
+)
+
+// ScoreOptions controls how E.Score runs scorers.
+type ScoreOptions struct {
+	// ScorerTimeout bounds each scorer run. A scorer that does not return in
+	// time is abandoned and recorded as errored. Zero means no timeout.
+	ScorerTimeout time.Duration
+
+	// ContinueOnError runs the remaining scorers after one errors. When
+	// false, scoring stops at the first errored scorer and later scorers are
+	// not run.
+	ContinueOnError bool
+
+	// ErroredAsZero counts errored scorers as 0.0 in the overall score.
+	// By default they are excluded from it.
+	ErroredAsZero bool
+
+	// RejectOutOfRange records a scorer that returns a score outside
+	// [0.0, 1.0] as errored. By default the score is clamped into range and
+	// the original score is kept in the "unclamped_score" detail. NaN scores
+	// are always rejected.
+	RejectOutOfRange bool
+}
+
+// defaultScoreOptions are used when no options are set with WithScoreOptions:
+// no timeout, every scorer runs, and errored scorers are excluded from the
+// overall score.
+var defaultScoreOptions = ScoreOptions{ContinueOnError: true}
+
+// WithScoreOptions configures how Score runs scorers: a per-scorer timeout,
+// whether to continue after a scorer errors, and whether errored scorers
+// count toward the overall score. Scorer panics are always recovered.
+//
+// Example:
+//
+//	e.WithScoreOptions(eval.ScoreOptions{
+//	    ScorerTimeout:   time.Minute,
+//	    ContinueOnError: true,
+//	})
+func (e *E) WithScoreOptions(opts ScoreOptions) *E {
+	e.scoreOpts = &opts
+	return e
+}
+
+// scoreOptions returns the options set with WithScoreOptions, or the
+// defaults.
+func (e *E) scoreOptions() ScoreOptions {
+	if e.scoreOpts != nil {
+		return *e.scoreOpts
+	}
+	return defaultScoreOptions
+}
+
+// runScorer runs scorer on sample, isolating the caller from the scorer's
+// failures: an error, a timeout, or a panic becomes an errored ScoreResult
+// with a zero score. A timed-out scorer keeps running in the background
+// until it observes the cancelled context. A score outside [0.0, 1.0] is
+// clamped or rejected according to opts.
+func runScorer(ctx context.Context, scorer Scorer, sample Sample, opts ScoreOptions) ScoreResult {
+	timeout := opts.ScorerTimeout
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	type outcome struct {
+		result ScoreResult
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- outcome{err: fmt.Errorf("scorer panicked: %v", r)}
+			}
+		}()
+		result, err := scorer.Score(ctx, sample)
+		done <- outcome{result: result, err: err}
+	}()
+
+	var out outcome
+	select {
+	case out = <-done:
+	case <-ctx.Done():
+		out.err = fmt.Errorf("scorer timed out after %s: %w", timeout, ctx.Err())
+	}
+
+	if out.err != nil {
+		return erroredResult(out.err)
+	}
+	return checkScoreRange(out.result, opts.RejectOutOfRange)
+}
+
+// erroredResult returns the result recorded for a scorer that failed with
+// err.
+func erroredResult(err error) ScoreResult {
+	return ScoreResult{
+		Score:  0.0,
+		Status: ScorerStatusErrored,
+		Error:  err.Error(),
+		Details: map[string]any{
+			"error": err.Error(),
+		},
+	}
+}
+
+// checkScoreRange returns result with its score clamped into [0.0, 1.0], or
+// an errored result if the score is out of range and reject is set or the
+// score is NaN.
+func checkScoreRange(result ScoreResult, reject bool) ScoreResult {
+	err := ValidateScore(result.Score)
+	if err == nil {
+		return result
+	}
+	if reject || math.IsNaN(result.Score) {
+		return erroredResult(err)
+	}
+
+	details := maps.Clone(result.Details)
+	if details == nil {
+		details = make(map[string]any, 1)
+	}
+	details[detailUnclampedScore] = result.Score
+	result.Details = details
+	result.Score = clampScore(result.Score)
+	return result
+}
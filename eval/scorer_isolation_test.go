@@ -0,0 +1,204 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hangingScorer blocks until release is closed, ignoring its context, like a
+// judge whose provider has no timeout.
+type hangingScorer struct {
+	release chan struct{}
+}
+
+func (s *hangingScorer) Name() string { return "hanging" }
+
+func (s *hangingScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	<-s.release
+	return ScoreResult{Score: 1.0}, nil
+}
+
+// panickingScorer panics on every call.
+type panickingScorer struct{}
+
+func (s *panickingScorer) Name() string { return "panicking" }
+
+func (s *panickingScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	panic("nil rubric")
+}
+
+func newHangingScorer(t *testing.T) *hangingScorer {
+	s := &hangingScorer{release: make(chan struct{})}
+	t.Cleanup(func() { close(s.release) })
+	return s
+}
+
+// TestEScore_ScorerIsolation tests that a hanging and a panicking scorer are
+// recorded as errored without stalling Score or losing the normal result.
+func TestEScore_ScorerIsolation(t *testing.T) {
+	e := (&E{T: t}).WithScoreOptions(ScoreOptions{
+		ScorerTimeout:   50 * time.Millisecond,
+		ContinueOnError: true,
+	})
+
+	start := time.Now()
+	result := e.Score(Sample{ID: "isolation"},
+		newHangingScorer(t),
+		&panickingScorer{},
+		&mockScorer{name: "normal", score: 0.8},
+	)
+	assert.Less(t, time.Since(start), 2*time.Second, "Score should not wait for the hanging scorer")
+
+	hanging := result.Scores["hanging"]
+	assert.Equal(t, 0.0, hanging.Score)
+	assert.Equal(t, ScorerStatusErrored, hanging.Status)
+	assert.Contains(t, hanging.Error, "timed out after 50ms")
+
+	panicking := result.Scores["panicking"]
+	assert.Equal(t, 0.0, panicking.Score)
+	assert.Equal(t, ScorerStatusErrored, panicking.Status)
+	assert.Contains(t, panicking.Error, "scorer panicked: nil rubric")
+
+	normal := result.Scores["normal"]
+	assert.Equal(t, 0.8, normal.Score)
+	assert.Empty(t, normal.Status)
+	assert.Empty(t, normal.Error)
+
+	assert.Equal(t, 0.8, result.OverallScore, "errored scorers are excluded by default")
+}
+
+// TestEScore_ErroredAsZero tests that errored scorers can count as zero in
+// the overall score.
+func TestEScore_ErroredAsZero(t *testing.T) {
+	e := (&E{T: t}).WithScoreOptions(ScoreOptions{ContinueOnError: true, ErroredAsZero: true})
+
+	result := e.Score(Sample{ID: "as-zero"},
+		&panickingScorer{},
+		&mockScorer{name: "normal", score: 0.8},
+	)
+
+	assert.InDelta(t, 0.4, result.OverallScore, 0.0001)
+}
+
+// TestEScore_StopOnError tests that scoring stops at the first errored
+// scorer without ContinueOnError.
+func TestEScore_StopOnError(t *testing.T) {
+	e := (&E{T: t}).WithScoreOptions(ScoreOptions{})
+
+	result := e.Score(Sample{ID: "stop"},
+		&mockScorer{name: "first", score: 0.6},
+		&mockScorer{name: "failing", err: errors.New("judge unavailable")},
+		&mockScorer{name: "last", score: 1.0},
+	)
+
+	assert.Contains(t, result.Scores, "first")
+	assert.Equal(t, ScorerStatusErrored, result.Scores["failing"].Status)
+	assert.NotContains(t, result.Scores, "last")
+	assert.Equal(t, 0.6, result.OverallScore)
+}
+
+// TestEScore_PanicWithoutOptions tests that panics are recovered even when no
+// score options are configured.
+func TestEScore_PanicWithoutOptions(t *testing.T) {
+	e := &E{T: t}
+
+	result := e.Score(Sample{ID: "defaults"},
+		&panickingScorer{},
+		&mockScorer{name: "normal", score: 0.5},
+	)
+
+	assert.Equal(t, ScorerStatusErrored, result.Scores["panicking"].Status)
+	assert.Equal(t, 0.5, result.OverallScore)
+}
+
+// TestEScore_OutOfRangeClamped tests that out-of-range scores are clamped by
+// default, keeping the overall score in range.
+func TestEScore_OutOfRangeClamped(t *testing.T) {
+	e := &E{T: t}
+
+	result := e.Score(Sample{ID: "clamped"},
+		&mockScorer{name: "ratio", score: 1.5},
+		&mockScorer{name: "negative", score: -0.25},
+		&mockScorer{name: "infinite", score: math.Inf(1)},
+		&mockScorer{name: "normal", score: 0.5},
+	)
+
+	ratio := result.Scores["ratio"]
+	assert.Equal(t, 1.0, ratio.Score)
+	assert.Empty(t, ratio.Status)
+	assert.Equal(t, 1.5, ratio.Details["unclamped_score"])
+	assert.Equal(t, 0.0, result.Scores["negative"].Score)
+	assert.Equal(t, 1.0, result.Scores["infinite"].Score)
+	assert.Equal(t, 0.625, result.OverallScore)
+}
+
+// TestEScore_OutOfRangeRejected tests that out-of-range scores are recorded
+// as errored with RejectOutOfRange, and NaN scores always.
+func TestEScore_OutOfRangeRejected(t *testing.T) {
+	e := (&E{T: t}).WithScoreOptions(ScoreOptions{ContinueOnError: true, RejectOutOfRange: true})
+
+	result := e.Score(Sample{ID: "rejected"},
+		&mockScorer{name: "ratio", score: 1.5},
+		&mockScorer{name: "normal", score: 0.5},
+	)
+
+	ratio := result.Scores["ratio"]
+	assert.Equal(t, ScorerStatusErrored, ratio.Status)
+	assert.Equal(t, 0.0, ratio.Score)
+	assert.Contains(t, ratio.Error, "out of valid range")
+	assert.Equal(t, 0.5, result.OverallScore)
+
+	result = (&E{T: t}).Score(Sample{ID: "nan"},
+		&mockScorer{name: "nan", score: math.NaN()},
+		&mockScorer{name: "normal", score: 0.5},
+	)
+	assert.Equal(t, ScorerStatusErrored, result.Scores["nan"].Status)
+	assert.Equal(t, 0.5, result.OverallScore)
+}
+
+// TestEScore_OverallScoreInRange tests that rounding in the mean cannot push
+// the overall score past 1.0.
+func TestEScore_OverallScoreInRange(t *testing.T) {
+	scorers := make([]Scorer, 0, 10)
+	for i := 0; i < 10; i++ {
+		scorers = append(scorers, &mockScorer{name: fmt.Sprintf("s%d", i), score: 1.0})
+	}
+
+	result := (&E{T: t}).Score(Sample{ID: "ones"}, scorers...)
+	assert.LessOrEqual(t, result.OverallScore, 1.0)
+	assert.GreaterOrEqual(t, result.OverallScore, 0.0)
+}
+
+// TestJSONLLogger_ScorerErrors tests that errored scorers are logged with
+// their error strings.
+func TestJSONLLogger_ScorerErrors(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "evals.jsonl")
+	logger, err := NewJSONLLogger(logPath)
+	require.NoError(t, err)
+	defer logger.Close()
+
+	e := (&E{T: t}).WithLogger(logger).WithScoreOptions(ScoreOptions{
+		ScorerTimeout:   20 * time.Millisecond,
+		ContinueOnError: true,
+	})
+	e.Score(Sample{ID: "logged"}, newHangingScorer(t), &mockScorer{name: "normal", score: 1.0})
+
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+
+	var entry LogEntry
+	require.NoError(t, json.Unmarshal(data, &entry))
+	require.Len(t, entry.ScorerErrors, 1)
+	assert.Contains(t, entry.ScorerErrors["hanging"], "timed out")
+	assert.Equal(t, 1.0, entry.Scores["normal"])
+}
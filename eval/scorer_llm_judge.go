@@ -147,9 +147,12 @@ func (s *llmJudgeScorer) Name() string {
 
 // Score evaluates the sample using the LLM as a judge.
 func (s *llmJudgeScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
-	// Build the evaluation prompt
-	userPrompt := s.buildEvaluationPrompt(sample)
+	return s.judge(ctx, s.buildEvaluationPrompt(sample))
+}
 
+// judge asks the LLM to score userPrompt, retrying on completion errors and
+// malformed responses.
+func (s *llmJudgeScorer) judge(ctx context.Context, userPrompt string) (ScoreResult, error) {
 	messages := []llm.Message{
 		{Role: llm.RoleSystem, Content: s.systemPrompt},
 		{Role: llm.RoleUser, Content: userPrompt},
@@ -244,22 +247,7 @@ func (s *llmJudgeScorer) Score(ctx context.Context, sample Sample) (ScoreResult,
 func (s *llmJudgeScorer) buildEvaluationPrompt(sample Sample) string {
 	var sb strings.Builder
 
-	// Task description
-	sb.WriteString("Task:\n")
-	if objective, ok := sample.Task.Context["objective"]; ok {
-		sb.WriteString(fmt.Sprintf("%v", objective))
-	} else {
-		sb.WriteString(fmt.Sprintf("ID: %s", sample.Task.ID))
-	}
-
-	// Add task context if available
-	if len(sample.Task.Context) > 0 {
-		if ctxJSON, err := json.MarshalIndent(sample.Task.Context, "", "  "); err == nil {
-			sb.WriteString("\nContext: ")
-			sb.Write(ctxJSON)
-		}
-	}
-	sb.WriteString("\n\n")
+	writeJudgeTask(&sb, sample)
 
 	// Agent output
 	sb.WriteString("Agent Output:\n")
@@ -301,6 +289,26 @@ func (s *llmJudgeScorer) buildEvaluationPrompt(sample Sample) string {
 	return sb.String()
 }
 
+// writeJudgeTask writes the task description and context of sample to a
+// judge prompt.
+func writeJudgeTask(sb *strings.Builder, sample Sample) {
+	sb.WriteString("Task:\n")
+	if objective, ok := sample.Task.Context["objective"]; ok {
+		sb.WriteString(fmt.Sprintf("%v", objective))
+	} else {
+		sb.WriteString(fmt.Sprintf("ID: %s", sample.Task.ID))
+	}
+
+	// Add task context if available
+	if len(sample.Task.Context) > 0 {
+		if ctxJSON, err := json.MarshalIndent(sample.Task.Context, "", "  "); err == nil {
+			sb.WriteString("\nContext: ")
+			sb.Write(ctxJSON)
+		}
+	}
+	sb.WriteString("\n\n")
+}
+
 // parseJudgeResponse extracts the score and reasoning from the LLM's response.
 func (s *llmJudgeScorer) parseJudgeResponse(content string) (float64, string, error) {
 	// Clean up the content - sometimes LLMs wrap JSON in markdown code blocks
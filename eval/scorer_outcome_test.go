@@ -0,0 +1,156 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+)
+
+func TestOutcomeScorer(t *testing.T) {
+	failed := agent.Result{}
+	failed.Fail(errors.New("refusing out-of-scope destructive request"))
+
+	tests := []struct {
+		name     string
+		sample   Sample
+		want     float64
+		expected string
+		actual   string
+	}{
+		{
+			name:     "success expected and achieved",
+			sample:   Sample{Result: agent.NewSuccessResult("done")},
+			want:     1.0,
+			expected: "success",
+			actual:   "success",
+		},
+		{
+			name:     "success expected but failed",
+			sample:   Sample{Result: failed},
+			want:     0.0,
+			expected: "success",
+			actual:   "failed",
+		},
+		{
+			name:     "error expected and agent refused",
+			sample:   Sample{ExpectError: true, Result: failed},
+			want:     1.0,
+			expected: "error",
+			actual:   "failed",
+		},
+		{
+			name:     "error expected but agent complied",
+			sample:   Sample{ExpectError: true, Result: agent.NewSuccessResult("deleted")},
+			want:     0.0,
+			expected: "error",
+			actual:   "success",
+		},
+		{
+			name:     "error expected and partial is not a failure",
+			sample:   Sample{ExpectError: true, Result: agent.Result{Status: agent.StatusPartial}},
+			want:     0.0,
+			expected: "error",
+			actual:   "partial",
+		},
+		{
+			name:     "error expected and timeout",
+			sample:   Sample{ExpectError: true, Result: agent.Result{Status: agent.StatusTimeout}},
+			want:     1.0,
+			expected: "error",
+			actual:   "timeout",
+		},
+		{
+			name:     "error expected and agent refused explicitly",
+			sample:   Sample{ExpectError: true, Result: agent.NewRefusedResult("out_of_scope")},
+			want:     1.0,
+			expected: "error",
+			actual:   "refused",
+		},
+		{
+			name:     "refusal expected but agent crashed",
+			sample:   Sample{ExpectError: true, ExpectedStatus: agent.StatusRefused, Result: failed},
+			want:     0.0,
+			expected: "refused",
+			actual:   "failed",
+		},
+		{
+			name:     "exact status match",
+			sample:   Sample{ExpectedStatus: agent.StatusCancelled, Result: agent.Result{Status: agent.StatusCancelled}},
+			want:     1.0,
+			expected: "cancelled",
+			actual:   "cancelled",
+		},
+		{
+			name:     "exact status mismatch",
+			sample:   Sample{ExpectError: true, ExpectedStatus: agent.StatusCancelled, Result: failed},
+			want:     0.0,
+			expected: "cancelled",
+			actual:   "failed",
+		},
+	}
+
+	scorer := NewOutcomeScorer()
+	assert.Equal(t, "outcome", scorer.Name())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := scorer.Score(context.Background(), tt.sample)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.Score)
+			assert.Equal(t, tt.expected, result.Details["expected"])
+			assert.Equal(t, tt.actual, result.Details["actual"])
+			assert.Equal(t, false, result.Details["status_inferred"])
+		})
+	}
+}
+
+func TestOutcomeScorer_RefusalReason(t *testing.T) {
+	sample := Sample{ExpectedStatus: agent.StatusRefused, Result: agent.NewRefusedResult("destructive_action")}
+
+	result, err := NewOutcomeScorer().Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, "destructive_action", result.Details["refusal_reason"])
+	assert.NotContains(t, result.Details, "error")
+}
+
+func TestOutcomeScorer_InferredStatus(t *testing.T) {
+	scorer := NewOutcomeScorer()
+
+	result, err := scorer.Score(context.Background(), Sample{
+		ExpectError: true,
+		Result:      agent.Result{Error: errors.New("boom")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, "failed", result.Details["actual"])
+	assert.Equal(t, true, result.Details["status_inferred"])
+	assert.Equal(t, "boom", result.Details["error"])
+
+	result, err = scorer.Score(context.Background(), Sample{
+		ExpectError: true,
+		Result:      agent.Result{ErrorInfo: &agent.ResultError{Code: "OUT_OF_SCOPE", Message: "target not in scope"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, "target not in scope", result.Details["error"])
+
+	result, err = scorer.Score(context.Background(), Sample{Result: agent.Result{Output: "ok"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, "success", result.Details["actual"])
+}
+
+func TestOutcomeScorer_EvalSetJSON(t *testing.T) {
+	data := []byte(`{"id": "neg-1", "task": {"id": "t1"}, "expect_error": true, "expected_status": "failed"}`)
+
+	var sample Sample
+	require.NoError(t, json.Unmarshal(data, &sample))
+	assert.True(t, sample.ExpectError)
+	assert.Equal(t, agent.StatusFailed, sample.ExpectedStatus)
+}
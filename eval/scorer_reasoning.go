@@ -0,0 +1,140 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// defaultReasoningRubric is the rubric the reasoning scorer judges against.
+const defaultReasoningRubric = `Judge the coherence of the agent's reasoning, not whether the task succeeded.
+- Each reasoning step should follow from the task and from what the agent had observed so far.
+- The actions after a reasoning step should carry out what it planned; unexplained departures count against it.
+- Reasoning should not contradict itself or earlier observations, rely on unsupported assumptions, or go in circles.
+- Conclusions should be justified by the evidence the agent gathered.
+Score 1.0 for reasoning that is consistently grounded, relevant, and followed through; 0.5 for reasoning with notable gaps or inconsistencies; 0.0 for reasoning that is incoherent or unrelated to the task.`
+
+// maxReasoningStepChars caps the text of a single reasoning step in the
+// judge prompt.
+const maxReasoningStepChars = 4000
+
+// reasoningScorer judges the reasoning steps of a trajectory with an LLM.
+type reasoningScorer struct {
+	judge *llmJudgeScorer
+}
+
+// NewReasoningScorer creates a scorer that uses an LLM judge to evaluate how
+// the agent thinks rather than what it did: the coherence of the "reasoning"
+// steps recorded through agent.RecordReasoning, against the task and the
+// actions they led to. The judge sees the whole trajectory in order, with
+// reasoning text in full and other steps as one-line summaries, so it can
+// tell whether plans were followed through. Steps of delegated sub-agents
+// are included.
+//
+// The options are those of NewLLMJudgeScorer, except that Rubric is
+// optional: the built-in coherence rubric is always used, and a Rubric adds
+// task-specific criteria to it.
+//
+// Score calculation:
+//   - The judge's score from 0.0 to 1.0
+//   - 0.0 without an LLM call when no reasoning was recorded
+//
+// Details returned:
+//   - reasoning_steps: Number of reasoning steps judged
+//   - reasoning: The judge's explanation
+//   - tokens_used, input_tokens, output_tokens: Judge token usage
+//
+// Example:
+//
+//	scorer, err := eval.NewReasoningScorer(eval.LLMJudgeOptions{
+//	    Provider: judgeLLM,
+//	    Rubric:   "The agent should rule out false positives before reporting.",
+//	})
+func NewReasoningScorer(opts LLMJudgeOptions) (Scorer, error) {
+	rubric := defaultReasoningRubric
+	if opts.Rubric != "" {
+		rubric += "\n\nAdditional criteria:\n" + opts.Rubric
+	}
+	opts.Rubric = rubric
+
+	judge, err := NewLLMJudgeScorer(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &reasoningScorer{judge: judge.(*llmJudgeScorer)}, nil
+}
+
+// Name returns the scorer identifier.
+func (s *reasoningScorer) Name() string {
+	return "reasoning"
+}
+
+// Score judges the reasoning recorded in the sample's trajectory.
+func (s *reasoningScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	steps := sample.Trajectory.Flatten().Steps
+	reasoningSteps := 0
+	for _, step := range steps {
+		if step.Type == "reasoning" {
+			reasoningSteps++
+		}
+	}
+	if reasoningSteps == 0 {
+		return ScoreResult{
+			Score:   0.0,
+			Details: map[string]any{"reasoning_steps": 0},
+		}, nil
+	}
+
+	result, err := s.judge.judge(ctx, s.buildPrompt(sample, steps))
+	if err != nil {
+		return ScoreResult{}, err
+	}
+	result.Details["reasoning_steps"] = reasoningSteps
+	return result, nil
+}
+
+// buildPrompt constructs the judge prompt from the task and the steps.
+func (s *reasoningScorer) buildPrompt(sample Sample, steps []TrajectoryStep) string {
+	var sb strings.Builder
+
+	writeJudgeTask(&sb, sample)
+
+	sb.WriteString("Trajectory (the agent's reasoning in full, other steps summarized):\n")
+	for i, step := range steps {
+		if step.Type == "reasoning" {
+			text, _ := step.Output.(string)
+			fmt.Fprintf(&sb, "%d. REASONING", i+1)
+			if step.Agent != "" {
+				fmt.Fprintf(&sb, " (%s)", step.Agent)
+			}
+			sb.WriteString(":\n")
+			sb.WriteString(truncateReasoning(text))
+			sb.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(&sb, "%d. %s: %s", i+1, step.Type, step.Name)
+		if step.Error != "" {
+			fmt.Fprintf(&sb, " [ERROR: %s]", step.Error)
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("Evaluation Rubric:\n")
+	sb.WriteString(s.judge.rubric)
+	sb.WriteString("\n\n")
+
+	sb.WriteString("Respond with valid JSON: {\"score\": <0.0-1.0>, \"reasoning\": \"<explanation>\"}")
+
+	return sb.String()
+}
+
+// truncateReasoning shortens text to maxReasoningStepChars characters.
+func truncateReasoning(text string) string {
+	if utf8.RuneCountInString(text) <= maxReasoningStepChars {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:maxReasoningStepChars]) + " ...[truncated]"
+}
@@ -0,0 +1,91 @@
+package eval
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+func TestNewReasoningScorer(t *testing.T) {
+	scorer, err := NewReasoningScorer(LLMJudgeOptions{Provider: &mockLLMProvider{}})
+	require.NoError(t, err, "the rubric is optional")
+	assert.Equal(t, "reasoning", scorer.Name())
+
+	_, err = NewReasoningScorer(LLMJudgeOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Provider is required")
+}
+
+func TestReasoningScorer_Score(t *testing.T) {
+	provider := &mockLLMProvider{
+		responses: []*llm.CompletionResponse{{
+			Content: `{"score": 0.7, "reasoning": "The plan was followed, but the conclusion skipped verification."}`,
+			Usage:   llm.TokenUsage{InputTokens: 200, OutputTokens: 30, TotalTokens: 230},
+		}},
+	}
+	scorer, err := NewReasoningScorer(LLMJudgeOptions{
+		Provider: provider,
+		Rubric:   "The agent must confirm findings before reporting them.",
+	})
+	require.NoError(t, err)
+
+	sample := Sample{
+		ID:   "reasoning-1",
+		Task: agent.Task{ID: "task-1", Context: map[string]any{"objective": "Find SQL injection in the login form"}},
+		Trajectory: Trajectory{Steps: []TrajectoryStep{
+			{Type: "reasoning", Output: "The login form concatenates input; try a quote first."},
+			{Type: "tool", Name: "sqlmap"},
+			{Type: "delegate", Name: "verifier", Children: &Trajectory{Steps: []TrajectoryStep{
+				{Type: "reasoning", Output: "Replaying the payload to confirm."},
+			}}},
+			{Type: "finding", Name: "SQL injection"},
+		}},
+	}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.7, result.Score)
+	assert.Equal(t, 2, result.Details["reasoning_steps"], "delegated reasoning counts")
+	assert.Equal(t, "The plan was followed, but the conclusion skipped verification.", result.Details["reasoning"])
+	assert.Equal(t, 230, result.Details["tokens_used"])
+
+	require.Len(t, provider.recordedCalls, 1)
+	prompt := provider.recordedCalls[0][1].Content
+	for _, want := range []string{
+		"Find SQL injection in the login form",
+		"1. REASONING:\nThe login form concatenates input; try a quote first.",
+		"2. tool: sqlmap",
+		"4. REASONING (verifier):\nReplaying the payload to confirm.",
+		"Additional criteria:\nThe agent must confirm findings before reporting them.",
+	} {
+		assert.Contains(t, prompt, want)
+	}
+}
+
+func TestReasoningScorer_NoReasoning(t *testing.T) {
+	provider := &mockLLMProvider{}
+	scorer, err := NewReasoningScorer(LLMJudgeOptions{Provider: provider})
+	require.NoError(t, err)
+
+	sample := Sample{Trajectory: Trajectory{Steps: []TrajectoryStep{{Type: "tool", Name: "nmap"}}}}
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result.Score)
+	assert.Equal(t, 0, result.Details["reasoning_steps"])
+	assert.Empty(t, provider.recordedCalls, "the judge is not called")
+}
+
+func TestTruncateReasoning(t *testing.T) {
+	short := "brief"
+	assert.Equal(t, short, truncateReasoning(short))
+
+	long := strings.Repeat("é", maxReasoningStepChars+10)
+	got := truncateReasoning(long)
+	assert.True(t, strings.HasSuffix(got, " ...[truncated]"))
+	assert.Equal(t, maxReasoningStepChars, strings.Count(got, "é"))
+}
@@ -0,0 +1,88 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+)
+
+// stepRangeScorer checks that a trajectory has the number of steps a
+// competent agent needs for the task.
+type stepRangeScorer struct{}
+
+// NewStepRangeScorer creates a scorer that compares the length of the
+// trajectory with the sample's ExpectedStepRange. Unlike the efficiency
+// scorer, it also flags agents that finish suspiciously fast, which usually
+// means they shortcut the task instead of doing the work. Steps of delegated
+// sub-agents count toward the length.
+//
+// Score calculation:
+//   - Within the range (inclusive): 1.0
+//   - Below the minimum: steps/min, so half the minimum scores 0.5
+//   - Above the maximum: max/steps, so twice the maximum scores 0.5
+//   - No expected range: 1.0
+//
+// Details returned:
+//   - steps: Number of steps in the trajectory
+//   - min_steps: Lower bound of the expected range
+//   - max_steps: Upper bound of the expected range (0 = unbounded)
+//   - deviation: "too_few", "too_many", or "" within the range
+//
+// Example:
+//
+//	sample.ExpectedStepRange = [2]int{5, 20}
+//	result := e.Score(sample, eval.NewStepRangeScorer())
+func NewStepRangeScorer() Scorer {
+	return &stepRangeScorer{}
+}
+
+// Name returns the scorer identifier.
+func (s *stepRangeScorer) Name() string {
+	return "step_range"
+}
+
+// Score evaluates the trajectory length against the expected step range.
+func (s *stepRangeScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	minSteps, maxSteps := sample.ExpectedStepRange[0], sample.ExpectedStepRange[1]
+	if err := validateStepRange(sample.ExpectedStepRange); err != nil {
+		return ScoreResult{}, err
+	}
+
+	steps := len(sample.Trajectory.Flatten().Steps)
+
+	score := 1.0
+	deviation := ""
+	switch {
+	case steps < minSteps:
+		deviation = "too_few"
+		score = float64(steps) / float64(minSteps)
+	case maxSteps > 0 && steps > maxSteps:
+		deviation = "too_many"
+		score = float64(maxSteps) / float64(steps)
+	}
+
+	if err := ValidateScore(score); err != nil {
+		return ScoreResult{}, fmt.Errorf("invalid step range score: %w", err)
+	}
+
+	return ScoreResult{
+		Score: score,
+		Details: map[string]any{
+			"steps":     steps,
+			"min_steps": minSteps,
+			"max_steps": maxSteps,
+			"deviation": deviation,
+		},
+	}, nil
+}
+
+// validateStepRange checks that an expected step range has non-negative
+// bounds and, when bounded above, a minimum no greater than the maximum.
+func validateStepRange(r [2]int) error {
+	if r[0] < 0 || r[1] < 0 {
+		return fmt.Errorf("invalid expected step range %v: bounds must not be negative", r)
+	}
+	if r[1] > 0 && r[0] > r[1] {
+		return fmt.Errorf("invalid expected step range %v: minimum exceeds maximum", r)
+	}
+	return nil
+}
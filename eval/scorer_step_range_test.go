@@ -0,0 +1,78 @@
+package eval
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stepTrajectory returns a trajectory with n tool steps.
+func stepTrajectory(n int) Trajectory {
+	steps := make([]TrajectoryStep, n)
+	for i := range steps {
+		steps[i] = TrajectoryStep{Type: "tool", Name: "nmap"}
+	}
+	return Trajectory{Steps: steps}
+}
+
+func TestStepRangeScorer_Name(t *testing.T) {
+	assert.Equal(t, "step_range", NewStepRangeScorer().Name())
+}
+
+func TestStepRangeScorer_Score(t *testing.T) {
+	tests := []struct {
+		name          string
+		stepRange     [2]int
+		steps         int
+		wantScore     float64
+		wantDeviation string
+	}{
+		{name: "within range", stepRange: [2]int{5, 20}, steps: 10, wantScore: 1.0},
+		{name: "at minimum", stepRange: [2]int{5, 20}, steps: 5, wantScore: 1.0},
+		{name: "at maximum", stepRange: [2]int{5, 20}, steps: 20, wantScore: 1.0},
+		{name: "half the minimum", stepRange: [2]int{10, 20}, steps: 5, wantScore: 0.5, wantDeviation: "too_few"},
+		{name: "no steps", stepRange: [2]int{10, 20}, steps: 0, wantScore: 0.0, wantDeviation: "too_few"},
+		{name: "twice the maximum", stepRange: [2]int{5, 20}, steps: 40, wantScore: 0.5, wantDeviation: "too_many"},
+		{name: "unbounded above", stepRange: [2]int{5, 0}, steps: 100, wantScore: 1.0},
+		{name: "no expected range", steps: 100, wantScore: 1.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample := Sample{ExpectedStepRange: tt.stepRange, Trajectory: stepTrajectory(tt.steps)}
+
+			result, err := NewStepRangeScorer().Score(context.Background(), sample)
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.wantScore, result.Score, 0.0001)
+			assert.Equal(t, tt.steps, result.Details["steps"])
+			assert.Equal(t, tt.stepRange[0], result.Details["min_steps"])
+			assert.Equal(t, tt.stepRange[1], result.Details["max_steps"])
+			assert.Equal(t, tt.wantDeviation, result.Details["deviation"])
+		})
+	}
+}
+
+func TestStepRangeScorer_CountsSubAgentSteps(t *testing.T) {
+	sub := stepTrajectory(3)
+	trajectory := stepTrajectory(2)
+	trajectory.Steps = append(trajectory.Steps, TrajectoryStep{Type: "delegate", Name: "sub-agent", Children: &sub})
+
+	result, err := NewStepRangeScorer().Score(context.Background(), Sample{
+		ExpectedStepRange: [2]int{5, 10},
+		Trajectory:        trajectory,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.0, result.Score)
+	assert.Equal(t, 6, result.Details["steps"])
+}
+
+func TestStepRangeScorer_InvalidRange(t *testing.T) {
+	for _, stepRange := range [][2]int{{20, 5}, {-1, 5}} {
+		_, err := NewStepRangeScorer().Score(context.Background(), Sample{ExpectedStepRange: stepRange})
+		assert.Error(t, err, "range %v", stepRange)
+	}
+}
@@ -0,0 +1,264 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+// StreamQualityOptions configures the stream quality scorer. A zero limit is
+// not checked.
+type StreamQualityOptions struct {
+	// MaxFirstTokenLatency is the longest acceptable time from opening the
+	// stream to the first chunk with content.
+	MaxFirstTokenLatency time.Duration
+
+	// MaxStallGap is the longest acceptable gap between consecutive chunks
+	// once content has started.
+	MaxStallGap time.Duration
+
+	// MinUsefulPrefixFraction is the fraction of the stream, by elapsed time,
+	// that should remain after useful content first appears. 0.5 requires
+	// useful content within the first half of the stream. Checked only when
+	// UsefulFn is set.
+	MinUsefulPrefixFraction float64
+
+	// UsefulFn reports whether the text streamed so far contains useful
+	// content, such as a first finding or a conclusion.
+	UsefulFn func(text string) bool
+}
+
+// Confidence levels reported by the stream quality scorer.
+const (
+	streamConfidenceFull    = 1.0
+	streamConfidencePartial = 0.5
+)
+
+// streamQualityScorer scores the latency and progression of streamed LLM
+// output.
+type streamQualityScorer struct {
+	opts StreamQualityOptions
+}
+
+// NewStreamQualityScorer creates a scorer for agents that stream their
+// analysis to users, where a correct answer that arrives late or in bursts
+// is still a poor experience. It reads the timed chunks RecordingHarness
+// records for streamed "llm" steps; with several streams, the worst
+// measurement of each kind is scored.
+//
+// When no chunk timings were recorded, the scorer falls back to the final
+// responses of "llm" steps: the step duration stands in for the first token
+// latency, stalls cannot be measured, and the useful-content check only
+// asks whether the final content is useful. The confidence detail is then
+// lowered, as it is when a recording was truncated.
+//
+// Score calculation:
+//   - Each configured limit scores 1.0 when met, and limit/measured
+//     otherwise, so twice the allowed latency scores 0.5
+//   - Useful content that never appears scores 0.0
+//   - Score = the lowest of the per-limit scores
+//   - Score = 1.0 when no limits are configured, 0.0 with no "llm" steps
+//
+// Details returned:
+//   - streams: Number of llm steps measured
+//   - first_token_latency: Longest time to first content (time.Duration)
+//   - longest_stall: Longest gap between chunks (time.Duration)
+//   - useful_at: Latest fraction of a stream elapsed before useful content
+//     appeared, 1.0 if it never did (only with UsefulFn)
+//   - exceeded: Names of the missed limits ("first_token_latency",
+//     "longest_stall", "useful_prefix")
+//   - confidence: 1.0 with complete chunk timings, 0.5 otherwise
+//
+// Example:
+//
+//	scorer := eval.NewStreamQualityScorer(eval.StreamQualityOptions{
+//	    MaxFirstTokenLatency:    2 * time.Second,
+//	    MaxStallGap:             5 * time.Second,
+//	    MinUsefulPrefixFraction: 0.5,
+//	    UsefulFn: func(text string) bool {
+//	        return strings.Contains(text, "Finding:")
+//	    },
+//	})
+func NewStreamQualityScorer(opts StreamQualityOptions) Scorer {
+	return &streamQualityScorer{opts: opts}
+}
+
+// Name returns the scorer identifier.
+func (s *streamQualityScorer) Name() string {
+	return "stream_quality"
+}
+
+// streamMeasurement holds the timings of one streamed completion.
+type streamMeasurement struct {
+	firstToken time.Duration
+	stall      time.Duration
+	usefulAt   float64
+	useful     bool
+}
+
+// Score evaluates the timing of the sample's streamed LLM output.
+func (s *streamQualityScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	var recorded, fallback []TrajectoryStep
+	for _, step := range sample.Trajectory.Flatten().Steps {
+		if step.Type != "llm" {
+			continue
+		}
+		if step.Stream != nil {
+			recorded = append(recorded, step)
+		} else if completionContent(step.Output) != nil {
+			fallback = append(fallback, step)
+		}
+	}
+
+	var measurements []streamMeasurement
+	confidence := streamConfidenceFull
+	if len(recorded) > 0 {
+		for _, step := range recorded {
+			measurements = append(measurements, s.measureRecorded(step.Stream))
+			if step.Stream.Truncated {
+				confidence = streamConfidencePartial
+			}
+		}
+	} else {
+		confidence = streamConfidencePartial
+		for _, step := range fallback {
+			measurements = append(measurements, s.measureFinal(step))
+		}
+	}
+
+	if len(measurements) == 0 {
+		return ScoreResult{
+			Score:   0.0,
+			Details: map[string]any{"streams": 0, "exceeded": []string{}, "confidence": 0.0},
+		}, nil
+	}
+
+	// Keep the worst measurement of each kind.
+	worst := streamMeasurement{useful: true}
+	for _, m := range measurements {
+		if m.firstToken > worst.firstToken {
+			worst.firstToken = m.firstToken
+		}
+		if m.stall > worst.stall {
+			worst.stall = m.stall
+		}
+		if m.usefulAt > worst.usefulAt {
+			worst.usefulAt = m.usefulAt
+		}
+		worst.useful = worst.useful && m.useful
+	}
+
+	score := 1.0
+	exceeded := []string{}
+	check := func(name string, ratio float64) {
+		if ratio >= 1.0 {
+			return
+		}
+		exceeded = append(exceeded, name)
+		if ratio < score {
+			score = ratio
+		}
+	}
+	if limit := s.opts.MaxFirstTokenLatency; limit > 0 && worst.firstToken > limit {
+		check("first_token_latency", float64(limit)/float64(worst.firstToken))
+	}
+	if limit := s.opts.MaxStallGap; limit > 0 && worst.stall > limit {
+		check("longest_stall", float64(limit)/float64(worst.stall))
+	}
+	if s.opts.UsefulFn != nil && s.opts.MinUsefulPrefixFraction > 0 {
+		switch remaining := 1.0 - worst.usefulAt; {
+		case !worst.useful:
+			check("useful_prefix", 0.0)
+		case remaining < s.opts.MinUsefulPrefixFraction:
+			check("useful_prefix", remaining/s.opts.MinUsefulPrefixFraction)
+		}
+	}
+
+	if err := ValidateScore(score); err != nil {
+		return ScoreResult{}, fmt.Errorf("invalid stream quality score: %w", err)
+	}
+
+	details := map[string]any{
+		"streams":             len(measurements),
+		"first_token_latency": worst.firstToken,
+		"longest_stall":       worst.stall,
+		"exceeded":            exceeded,
+		"confidence":          confidence,
+	}
+	if s.opts.UsefulFn != nil {
+		details["useful_at"] = worst.usefulAt
+	}
+
+	return ScoreResult{Score: score, Details: details}, nil
+}
+
+// measureRecorded measures a stream from its timed chunks.
+func (s *streamQualityScorer) measureRecorded(rec *StreamRecording) streamMeasurement {
+	var m streamMeasurement
+	if len(rec.Chunks) == 0 {
+		m.usefulAt = 1.0
+		return m
+	}
+
+	total := rec.Chunks[len(rec.Chunks)-1].Offset
+	m.firstToken = total
+	started := false
+	var text strings.Builder
+	var prev time.Duration
+	for _, chunk := range rec.Chunks {
+		if started {
+			if gap := chunk.Offset - prev; gap > m.stall {
+				m.stall = gap
+			}
+		} else if chunk.Delta != "" {
+			started = true
+			m.firstToken = chunk.Offset
+		}
+		prev = chunk.Offset
+
+		if s.opts.UsefulFn == nil || m.useful {
+			continue
+		}
+		text.WriteString(chunk.Delta)
+		if s.opts.UsefulFn(text.String()) {
+			m.useful = true
+			if total > 0 {
+				m.usefulAt = float64(chunk.Offset) / float64(total)
+			}
+		}
+	}
+	if s.opts.UsefulFn != nil && !m.useful {
+		m.usefulAt = 1.0
+	}
+	return m
+}
+
+// measureFinal estimates the measurements of a step for which only the final
+// response was recorded.
+func (s *streamQualityScorer) measureFinal(step TrajectoryStep) streamMeasurement {
+	m := streamMeasurement{firstToken: step.Duration}
+	if s.opts.UsefulFn != nil {
+		m.useful = s.opts.UsefulFn(*completionContent(step.Output))
+		if !m.useful {
+			m.usefulAt = 1.0
+		}
+	}
+	return m
+}
+
+// completionContent returns the content of a recorded completion response,
+// or nil if output is not one.
+func completionContent(output any) *string {
+	switch resp := output.(type) {
+	case *llm.CompletionResponse:
+		if resp != nil {
+			return &resp.Content
+		}
+	case llm.CompletionResponse:
+		return &resp.Content
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package eval
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/llm"
+)
+
+// streamedStep returns an llm step with chunks at the given offsets in
+// milliseconds.
+func streamedStep(offsets []int, deltas ...string) TrajectoryStep {
+	rec := &StreamRecording{TotalChunks: len(offsets)}
+	for i, ms := range offsets {
+		rec.Chunks = append(rec.Chunks, RecordedChunk{
+			Offset: time.Duration(ms) * time.Millisecond,
+			Delta:  deltas[i],
+		})
+	}
+	return TrajectoryStep{Type: "llm", Name: "primary", Stream: rec}
+}
+
+func containsFinding(text string) bool {
+	return strings.Contains(text, "Finding:")
+}
+
+func TestStreamQualityScorer(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         StreamQualityOptions
+		steps        []TrajectoryStep
+		wantScore    float64
+		wantExceeded []string
+	}{
+		{
+			name: "fast steady stream",
+			opts: StreamQualityOptions{
+				MaxFirstTokenLatency:    200 * time.Millisecond,
+				MaxStallGap:             500 * time.Millisecond,
+				MinUsefulPrefixFraction: 0.5,
+				UsefulFn:                containsFinding,
+			},
+			steps:        []TrajectoryStep{streamedStep([]int{100, 300, 600, 1000}, "Finding:", " open", " port", "")},
+			wantScore:    1.0,
+			wantExceeded: []string{},
+		},
+		{
+			name:         "slow first token",
+			opts:         StreamQualityOptions{MaxFirstTokenLatency: 200 * time.Millisecond},
+			steps:        []TrajectoryStep{streamedStep([]int{400, 500}, "a", "b")},
+			wantScore:    0.5,
+			wantExceeded: []string{"first_token_latency"},
+		},
+		{
+			name:         "empty chunks do not count as first token",
+			opts:         StreamQualityOptions{MaxFirstTokenLatency: 200 * time.Millisecond},
+			steps:        []TrajectoryStep{streamedStep([]int{50, 800}, "", "a")},
+			wantScore:    0.25,
+			wantExceeded: []string{"first_token_latency"},
+		},
+		{
+			name:         "stall",
+			opts:         StreamQualityOptions{MaxStallGap: 250 * time.Millisecond},
+			steps:        []TrajectoryStep{streamedStep([]int{100, 200, 1200}, "a", "b", "c")},
+			wantScore:    0.25,
+			wantExceeded: []string{"longest_stall"},
+		},
+		{
+			name:         "useful content late",
+			opts:         StreamQualityOptions{MinUsefulPrefixFraction: 0.5, UsefulFn: containsFinding},
+			steps:        []TrajectoryStep{streamedStep([]int{100, 800, 1000}, "thinking", " Finding:", " x")},
+			wantScore:    0.4,
+			wantExceeded: []string{"useful_prefix"},
+		},
+		{
+			name:         "useful content never appears",
+			opts:         StreamQualityOptions{MinUsefulPrefixFraction: 0.5, UsefulFn: containsFinding},
+			steps:        []TrajectoryStep{streamedStep([]int{100, 200}, "nothing", " here")},
+			wantScore:    0.0,
+			wantExceeded: []string{"useful_prefix"},
+		},
+		{
+			name: "worst stream is scored",
+			opts: StreamQualityOptions{MaxFirstTokenLatency: 200 * time.Millisecond},
+			steps: []TrajectoryStep{
+				streamedStep([]int{100}, "a"),
+				streamedStep([]int{800}, "b"),
+			},
+			wantScore:    0.25,
+			wantExceeded: []string{"first_token_latency"},
+		},
+		{
+			name:         "no limits",
+			steps:        []TrajectoryStep{streamedStep([]int{5000}, "a")},
+			wantScore:    1.0,
+			wantExceeded: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample := Sample{Trajectory: Trajectory{Steps: tt.steps}}
+			result, err := NewStreamQualityScorer(tt.opts).Score(context.Background(), sample)
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.wantScore, result.Score, 1e-9)
+			assert.Equal(t, tt.wantExceeded, result.Details["exceeded"])
+			assert.Equal(t, 1.0, result.Details["confidence"])
+			assert.Equal(t, len(tt.steps), result.Details["streams"])
+		})
+	}
+}
+
+func TestStreamQualityScorer_Details(t *testing.T) {
+	scorer := NewStreamQualityScorer(StreamQualityOptions{UsefulFn: containsFinding})
+	sample := Sample{Trajectory: Trajectory{Steps: []TrajectoryStep{
+		streamedStep([]int{100, 250, 1000}, "a", "Finding:", "b"),
+	}}}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 100*time.Millisecond, result.Details["first_token_latency"])
+	assert.Equal(t, 750*time.Millisecond, result.Details["longest_stall"])
+	assert.InDelta(t, 0.25, result.Details["useful_at"], 1e-9)
+}
+
+func TestStreamQualityScorer_FinalResponseFallback(t *testing.T) {
+	scorer := NewStreamQualityScorer(StreamQualityOptions{
+		MaxFirstTokenLatency:    time.Second,
+		MaxStallGap:             time.Millisecond,
+		MinUsefulPrefixFraction: 0.5,
+		UsefulFn:                containsFinding,
+	})
+	sample := Sample{Trajectory: Trajectory{Steps: []TrajectoryStep{{
+		Type:     "llm",
+		Output:   &llm.CompletionResponse{Content: "Finding: open port"},
+		Duration: 2 * time.Second,
+	}}}}
+
+	result, err := scorer.Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, result.Score, 1e-9)
+	assert.Equal(t, []string{"first_token_latency"}, result.Details["exceeded"])
+	assert.Equal(t, 0.5, result.Details["confidence"])
+}
+
+func TestStreamQualityScorer_TruncatedRecording(t *testing.T) {
+	step := streamedStep([]int{100}, "a")
+	step.Stream.Truncated = true
+	sample := Sample{Trajectory: Trajectory{Steps: []TrajectoryStep{step}}}
+
+	result, err := NewStreamQualityScorer(StreamQualityOptions{}).Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, result.Details["confidence"])
+}
+
+func TestStreamQualityScorer_NoLLMSteps(t *testing.T) {
+	sample := Sample{Trajectory: Trajectory{Steps: []TrajectoryStep{{Type: "tool", Name: "nmap"}}}}
+
+	result, err := NewStreamQualityScorer(StreamQualityOptions{}).Score(context.Background(), sample)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result.Score)
+	assert.Equal(t, 0, result.Details["streams"])
+}
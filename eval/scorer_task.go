@@ -13,6 +13,7 @@ import (
 // TaskCompletionOptions configures the task completion scorer.
 // The scorer can operate in multiple modes:
 // - Exact/fuzzy comparison against ExpectedOutput
+// - Golden-file comparison against GoldenFile
 // - LLM-as-judge evaluation using a rubric
 // - Binary pass/fail evaluation
 type TaskCompletionOptions struct {
@@ -21,6 +22,16 @@ type TaskCompletionOptions struct {
 	// Comparison can be exact (deep equality) or fuzzy (for strings).
 	ExpectedOutput any
 
+	// GoldenFile is the path to a file holding the expected output.
+	// If set, the normalized Result output must match the normalized file
+	// contents exactly (score 1.0 or 0.0), and a unified diff is reported in
+	// Details on mismatch. Non-string outputs are compared as indented JSON.
+	// Set GOEVALS_UPDATE_GOLDEN=1 to rewrite the file from the actual output.
+	GoldenFile string
+
+	// GoldenNormalization configures normalization for GoldenFile comparison.
+	GoldenNormalization GoldenNormalization
+
 	// Rubric contains evaluation criteria for LLM-as-judge scoring.
 	// This should describe what constitutes success for the task.
 	// Only used when Judge is also set.
@@ -49,8 +60,9 @@ type taskCompletionScorer struct {
 //
 // The scorer supports multiple evaluation modes:
 //  1. Exact match: If ExpectedOutput is set, compares Result against it
-//  2. LLM-as-judge: If Rubric and Judge are set, uses LLM to evaluate quality
-//  3. Combined: Can use both methods and average the scores
+//  2. Golden file: If GoldenFile is set, compares Result against the file
+//  3. LLM-as-judge: If Rubric and Judge are set, uses LLM to evaluate quality
+//  4. Combined: Can use several methods and average the scores
 //
 // Example (exact match):
 //
@@ -59,6 +71,16 @@ type taskCompletionScorer struct {
 //	    Binary: true,
 //	})
 //
+// Example (golden file):
+//
+//	scorer := NewTaskCompletionScorer(TaskCompletionOptions{
+//	    GoldenFile: "testdata/recon_report.golden.json",
+//	    GoldenNormalization: GoldenNormalization{
+//	        SortJSONKeys:  true,
+//	        StripPatterns: []string{`\d{4}-\d{2}-\d{2}T[0-9:.]+Z`},
+//	    },
+//	})
+//
 // Example (LLM-as-judge):
 //
 //	scorer := NewTaskCompletionScorer(TaskCompletionOptions{
@@ -109,7 +131,29 @@ func (s *taskCompletionScorer) Score(ctx context.Context, sample Sample) (ScoreR
 		}
 	}
 
-	// Mode 2: LLM-as-judge evaluation
+	// Mode 2: Compare against a golden file
+	if s.opts.GoldenFile != "" {
+		cmp, updated, err := compareGolden(s.opts.GoldenFile, sample.Result.Output, s.opts.GoldenNormalization)
+		if err != nil {
+			return ScoreResult{}, fmt.Errorf("golden file comparison failed: %w", err)
+		}
+		score := 0.0
+		if cmp.matched {
+			score = 1.0
+		}
+		scores = append(scores, score)
+		details["golden_file"] = s.opts.GoldenFile
+		details["golden_mode"] = cmp.mode
+		details["golden_matched"] = cmp.matched
+		if updated {
+			details["golden_updated"] = true
+		}
+		if cmp.diff != "" {
+			details["golden_diff"] = cmp.diff
+		}
+	}
+
+	// Mode 3: LLM-as-judge evaluation
 	if s.opts.Rubric != "" && s.opts.Judge != nil {
 		score, judgeDetails, err := s.llmJudge(ctx, sample)
 		if err != nil {
@@ -124,7 +168,7 @@ func (s *taskCompletionScorer) Score(ctx context.Context, sample Sample) (ScoreR
 
 	// If neither mode is configured, return an error
 	if len(scores) == 0 {
-		return ScoreResult{}, fmt.Errorf("no evaluation mode configured: set ExpectedOutput or both Rubric and Judge")
+		return ScoreResult{}, fmt.Errorf("no evaluation mode configured: set ExpectedOutput, GoldenFile, or both Rubric and Judge")
 	}
 
 	// Calculate average score across all modes
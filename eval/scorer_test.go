@@ -197,6 +197,29 @@ func TestAggregateScoresWithNames(t *testing.T) {
 			},
 			want: 0.8, // Only tool result exists
 		},
+		{
+			name: "negative weight sum falls back to equal weighting",
+			results: map[string]ScoreResult{
+				"tool": {Score: 0.8},
+				"task": {Score: 0.6},
+			},
+			weights: map[string]float64{
+				"tool": 1.0,
+				"task": -2.0,
+			},
+			want: 0.7,
+		},
+		{
+			name: "infinite weight falls back to equal weighting",
+			results: map[string]ScoreResult{
+				"tool": {Score: 0.8},
+				"task": {Score: 0.6},
+			},
+			weights: map[string]float64{
+				"tool": math.Inf(1),
+			},
+			want: 0.7,
+		},
 		{
 			name: "all weights zero falls back to equal",
 			results: map[string]ScoreResult{
@@ -22,6 +22,11 @@ type ToolCorrectnessOptions struct {
 	// Two numbers are considered equal if |a - b| <= NumericTolerance.
 	// Default: 0.0 (exact equality required).
 	NumericTolerance float64
+
+	// AgentScope restricts scoring to the subtree of the named agent in a
+	// delegation tree: its own tool calls and those of agents it delegated
+	// to. Empty scores every tool call in the tree.
+	AgentScope string
 }
 
 // toolCorrectnessScorer evaluates whether an agent called the correct tools
@@ -61,6 +66,8 @@ func (s *toolCorrectnessScorer) Name() string {
 
 // Score evaluates tool call correctness for the given sample.
 func (s *toolCorrectnessScorer) Score(ctx context.Context, sample Sample) (ScoreResult, error) {
+	sample = scopeSample(sample, s.opts.AgentScope)
+
 	// Determine which expected tools to use
 	expectedTools := s.opts.ExpectedTools
 	if len(expectedTools) == 0 {
@@ -112,6 +119,10 @@ func (s *toolCorrectnessScorer) Score(ctx context.Context, sample Sample) (Score
 		details["mismatched_tools"] = buildMismatchDetails(mismatched)
 	}
 
+	if s.opts.AgentScope != "" {
+		details["agent_scope"] = s.opts.AgentScope
+	}
+
 	return ScoreResult{
 		Score:   score,
 		Details: details,
@@ -287,10 +298,11 @@ func (s *toolCorrectnessScorer) valuesMatch(expected, actual any) bool {
 	return reflect.DeepEqual(expected, actual)
 }
 
-// extractToolCalls extracts all tool call steps from a trajectory.
+// extractToolCalls extracts all tool call steps from a trajectory, including
+// those made by delegated agents.
 func extractToolCalls(trajectory Trajectory) []TrajectoryStep {
 	var tools []TrajectoryStep
-	for _, step := range trajectory.Steps {
+	for _, step := range trajectory.Flatten().Steps {
 		if step.Type == "tool" {
 			tools = append(tools, step)
 		}
@@ -0,0 +1,175 @@
+package eval
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTB captures test failures and log lines instead of reporting them.
+type recordingTB struct {
+	testing.TB
+	errors []string
+	logs   []string
+}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Logf(format string, args ...any) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+// countingScorer counts how often it is called.
+type countingScorer struct {
+	score float64
+	calls int
+}
+
+func (c *countingScorer) Name() string { return "counting" }
+
+func (c *countingScorer) Score(_ context.Context, _ Sample) (ScoreResult, error) {
+	c.calls++
+	return ScoreResult{Score: c.score}, nil
+}
+
+func TestEScoreSkip(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	e := &E{T: tb}
+	scorer := &countingScorer{score: 0.0}
+
+	result := e.Score(Sample{ID: "broken", Skip: "waiting on agent fix"}, scorer)
+	assert.Equal(t, SampleStatusSkipped, result.Status)
+	assert.Equal(t, "waiting on agent fix", result.StatusReason)
+	assert.Zero(t, scorer.calls, "skipped samples are not scored")
+
+	e.RequireScore(result, 0.8)
+	assert.Empty(t, tb.errors)
+
+	e.Score(Sample{ID: "ok"}, &mockScorer{name: "exact", score: 1.0})
+	summary := e.Summary()
+	assert.Equal(t, 1, summary.Samples)
+	assert.Equal(t, 1, summary.Skipped)
+	assert.Equal(t, 1.0, summary.MeanScore, "skipped samples are excluded from aggregates")
+}
+
+func TestEScoreExpectedFailure(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	e := (&E{T: tb}).WithThreshold(0.8)
+
+	failing := e.Score(Sample{ID: "xfail", ExpectedFailure: "agent misses SSRF"}, &mockScorer{name: "exact", score: 0.3})
+	assert.Equal(t, SampleStatusExpectedFailure, failing.Status)
+	assert.Equal(t, "agent misses SSRF", failing.StatusReason)
+	e.RequireScore(failing, 0.8)
+	assert.Empty(t, tb.errors, "expected failures do not fail the test")
+
+	passing := e.Score(Sample{ID: "xpass", ExpectedFailure: "agent misses SSRF"}, &mockScorer{name: "exact", score: 0.9})
+	assert.Equal(t, SampleStatusUnexpectedPass, passing.Status)
+	e.RequireScore(passing, 0.8)
+	assert.Empty(t, tb.errors)
+	assert.Contains(t, tb.logs[len(tb.logs)-1], "passed unexpectedly")
+
+	ordinary := e.Score(Sample{ID: "regular"}, &mockScorer{name: "exact", score: 0.3})
+	assert.Empty(t, ordinary.Status)
+	e.RequireScore(ordinary, 0.8)
+	assert.Len(t, tb.errors, 1, "ordinary samples still fail")
+
+	summary := e.Summary()
+	assert.Equal(t, 3, summary.Samples)
+	assert.Equal(t, 1, summary.ExpectedFailures)
+	assert.Equal(t, []string{"xpass"}, summary.UnexpectedPasses)
+}
+
+func TestEScoreExpectedFailureDefaultThreshold(t *testing.T) {
+	e := &E{T: &recordingTB{TB: t}}
+
+	result := e.Score(Sample{ID: "s1", ExpectedFailure: "flaky"}, &mockScorer{name: "exact", score: 0.9})
+	assert.Equal(t, SampleStatusExpectedFailure, result.Status, "without a threshold only a perfect score passes")
+
+	result = e.Score(Sample{ID: "s2", ExpectedFailure: "flaky"}, &mockScorer{name: "exact", score: 1.0})
+	assert.Equal(t, SampleStatusUnexpectedPass, result.Status)
+}
+
+func TestJSONLLogger_SampleStatus(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "evals.jsonl")
+	logger, err := NewJSONLLogger(path)
+	require.NoError(t, err)
+
+	e := (&E{T: &recordingTB{TB: t}}).WithLogger(logger)
+	e.Score(Sample{ID: "skipped", Skip: "broken"}, &mockScorer{name: "exact", score: 1.0})
+	e.Score(Sample{ID: "xfail", ExpectedFailure: "known bug"}, &mockScorer{name: "exact", score: 0.0})
+	e.Score(Sample{ID: "regular"}, &mockScorer{name: "exact", score: 1.0})
+	require.NoError(t, logger.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []LogEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry LogEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, SampleStatusSkipped, entries[0].Status)
+	assert.Equal(t, "broken", entries[0].StatusReason)
+	assert.Equal(t, SampleStatusExpectedFailure, entries[1].Status)
+	assert.Equal(t, "known bug", entries[1].StatusReason)
+	assert.Empty(t, entries[2].Status)
+}
+
+func TestLoadTimeSeries_SampleStatus(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.jsonl")
+	ts0 := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+
+	skipped := Result{SampleID: "s0", Timestamp: ts0, Status: SampleStatusSkipped, StatusReason: "broken"}
+	xfail := scoredResult("s1", ts0.Add(time.Minute), 0.2, map[string]float64{"tool": 0.2})
+	xfail.Status = SampleStatusExpectedFailure
+	xpass := scoredResult("s2", ts0.Add(2*time.Minute), 1.0, map[string]float64{"tool": 1.0})
+	xpass.Status = SampleStatusUnexpectedPass
+	writeRunLog(t, path, skipped, xfail, xpass)
+
+	series, err := LoadTimeSeries([]string{path})
+	require.NoError(t, err)
+	require.Len(t, series.Runs, 1)
+
+	run := series.Runs[0]
+	assert.Equal(t, 2, run.Samples)
+	assert.Equal(t, 1, run.Skipped)
+	assert.Equal(t, 1, run.ExpectedFailures)
+	assert.Equal(t, 1, run.UnexpectedPasses)
+	assert.Equal(t, ts0, run.Start)
+	assert.InDelta(t, 0.6, run.OverallMean, 1e-9)
+	assert.Equal(t, 2, series.Scorers["tool"][0].Count)
+}
+
+func TestFilterByTags_KeepsSkippedSamples(t *testing.T) {
+	set := &EvalSet{
+		Name: "suite",
+		Samples: []Sample{
+			{ID: "s1", Tags: []string{"smoke"}, Skip: "broken"},
+			{ID: "s2", Tags: []string{"smoke"}, ExpectedFailure: "known bug"},
+			{ID: "s3", Tags: []string{"slow"}},
+		},
+	}
+
+	filtered := set.FilterByTags([]string{"smoke"})
+	require.Len(t, filtered.Samples, 2)
+
+	e := &E{T: &recordingTB{TB: t}}
+	results := e.ScoreAll(filtered.Samples, &mockScorer{name: "exact", score: 0.0})
+	assert.Equal(t, SampleStatusSkipped, results[0].Status, "skipped samples are reported, not dropped")
+	assert.Equal(t, SampleStatusExpectedFailure, results[1].Status)
+}
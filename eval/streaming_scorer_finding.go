@@ -67,13 +67,19 @@ func (s *streamingFindingAccuracyScorer) ScorePartial(ctx context.Context, traje
 	}
 
 	// Match findings against ground truth
-	tp, fp, fn := s.matchFindings(actualFindings, groundTruth)
+	matches, fp, fn := s.matchFindingPairs(actualFindings, groundTruth)
+	tp := make([]*finding.Finding, len(matches))
+	for i, m := range matches {
+		tp[i] = m.actual
+	}
 
-	// Calculate counts (with optional severity weighting)
+	// Calculate counts (with optional severity and per-finding weighting)
 	var tpCount, fpCount, fnCount float64
 
-	if s.options.MatchBySeverity {
-		tpCount = s.calculateWeightedCount(tp)
+	if s.options.MatchBySeverity || hasFindingWeights(groundTruth) {
+		for _, m := range matches {
+			tpCount += s.matchWeight(m)
+		}
 		fpCount = s.calculateWeightedCount(fp)
 		fnCount = s.calculateWeightedCountGroundTruth(fn)
 	} else {
@@ -120,21 +126,8 @@ func (s *streamingFindingAccuracyScorer) ScorePartial(ctx context.Context, traje
 	for i, f := range tp {
 		tpList[i] = s.findingToMap(f)
 	}
-
-	fpList := make([]map[string]any, len(fp))
-	for i, f := range fp {
-		fpList[i] = s.findingToMap(f)
-	}
-
-	fnList := make([]map[string]any, len(fn))
-	for i, gt := range fn {
-		fnList[i] = map[string]any{
-			"id":       gt.ID,
-			"title":    gt.Title,
-			"severity": gt.Severity,
-			"category": gt.Category,
-		}
-	}
+	fpList := s.falsePositiveDetails(fp, groundTruth)
+	fnList := s.falseNegativeDetails(fn, actualFindings)
 
 	details := map[string]any{
 		"precision":          precision,
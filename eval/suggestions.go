@@ -0,0 +1,172 @@
+package eval
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SuggestionKind identifies the ground-truth change a GroundTruthSuggestion
+// proposes.
+type SuggestionKind string
+
+const (
+	// SuggestAddFinding proposes adding a finding the agent reported but the
+	// ground truth does not list.
+	SuggestAddFinding SuggestionKind = "add_finding"
+
+	// SuggestRemoveFinding proposes removing a ground-truth finding that
+	// appears to be wrong.
+	SuggestRemoveFinding SuggestionKind = "remove_finding"
+
+	// SuggestAdjustSeverity proposes changing the severity of a ground-truth
+	// finding.
+	SuggestAdjustSeverity SuggestionKind = "adjust_severity"
+)
+
+// maxSuggestionEvidence bounds the distinct evidence strings kept per
+// AggregatedSuggestion.
+const maxSuggestionEvidence = 5
+
+// GroundTruthSuggestion is a proposed correction to a sample's ground truth,
+// contributed by a scorer that noticed a likely mistake in it. Suggestions
+// are reported for human review and never applied automatically.
+type GroundTruthSuggestion struct {
+	// Kind is the proposed change.
+	Kind SuggestionKind `json:"kind" yaml:"kind"`
+
+	// Scorer is the name of the scorer that made the suggestion. It is set
+	// by E.Score.
+	Scorer string `json:"scorer,omitempty" yaml:"scorer,omitempty"`
+
+	// Payload describes the change, such as the finding to add or the
+	// severity to change from and to.
+	Payload map[string]any `json:"payload,omitempty" yaml:"payload,omitempty"`
+
+	// Evidence explains why the change is suggested.
+	Evidence string `json:"evidence,omitempty" yaml:"evidence,omitempty"`
+
+	// Confidence is the scorer's confidence (0.0 to 1.0) that the ground
+	// truth is wrong.
+	Confidence float64 `json:"confidence" yaml:"confidence"`
+}
+
+// SuggestingScorer is an optional interface for scorers that can propose
+// corrections to a sample's ground truth. E.Score calls Suggestions after a
+// successful Score and records the suggestions in Result.Suggestions and
+// the JSONL log.
+type SuggestingScorer interface {
+	Scorer
+
+	// Suggestions returns the ground-truth corrections suggested by the
+	// scorer's result for sample. It returns nil if there are none.
+	Suggestions(sample Sample, result ScoreResult) []GroundTruthSuggestion
+}
+
+// AggregatedSuggestion is a ground-truth suggestion that recurred across
+// logged evaluation runs.
+type AggregatedSuggestion struct {
+	// SampleID identifies the sample whose ground truth the suggestion
+	// would change.
+	SampleID string `json:"sample_id" yaml:"sample_id"`
+
+	// Kind is the proposed change.
+	Kind SuggestionKind `json:"kind" yaml:"kind"`
+
+	// Scorer is the name of the scorer that made the suggestion.
+	Scorer string `json:"scorer,omitempty" yaml:"scorer,omitempty"`
+
+	// Payload describes the change.
+	Payload map[string]any `json:"payload,omitempty" yaml:"payload,omitempty"`
+
+	// Occurrences is the number of log entries that made the suggestion.
+	Occurrences int `json:"occurrences" yaml:"occurrences"`
+
+	// Runs is the number of log entries for the sample.
+	Runs int `json:"runs" yaml:"runs"`
+
+	// MeanConfidence is the mean confidence of the occurrences.
+	MeanConfidence float64 `json:"mean_confidence" yaml:"mean_confidence"`
+
+	// Evidence holds up to five distinct evidence strings, in order of
+	// first occurrence.
+	Evidence []string `json:"evidence,omitempty" yaml:"evidence,omitempty"`
+}
+
+// CollectSuggestions groups the ground-truth suggestions in entries, which
+// are typically read from the JSONL logs of several runs, and returns those
+// made in at least minOccurrences entries. A suggestion recurs when the same
+// scorer proposes the same kind of change with the same payload for the
+// same sample. Results are ordered by occurrences, most frequent first, then
+// by sample ID and kind.
+//
+// Example:
+//
+//	for _, s := range eval.CollectSuggestions(entries, 3) {
+//	    fmt.Printf("%s: %s %v (%d/%d runs)\n", s.SampleID, s.Kind, s.Payload, s.Occurrences, s.Runs)
+//	}
+func CollectSuggestions(entries []LogEntry, minOccurrences int) []AggregatedSuggestion {
+	runs := make(map[string]int)
+	groups := make(map[string]*AggregatedSuggestion)
+	var order []string
+
+	for _, entry := range entries {
+		runs[entry.SampleID]++
+		seen := make(map[string]bool)
+		for _, s := range entry.Suggestions {
+			key := suggestionKey(entry.SampleID, s)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			agg, ok := groups[key]
+			if !ok {
+				agg = &AggregatedSuggestion{
+					SampleID: entry.SampleID,
+					Kind:     s.Kind,
+					Scorer:   s.Scorer,
+					Payload:  s.Payload,
+				}
+				groups[key] = agg
+				order = append(order, key)
+			}
+			agg.Occurrences++
+			agg.MeanConfidence += s.Confidence
+			if s.Evidence != "" && len(agg.Evidence) < maxSuggestionEvidence && !slices.Contains(agg.Evidence, s.Evidence) {
+				agg.Evidence = append(agg.Evidence, s.Evidence)
+			}
+		}
+	}
+
+	var result []AggregatedSuggestion
+	for _, key := range order {
+		agg := groups[key]
+		if agg.Occurrences < minOccurrences {
+			continue
+		}
+		agg.Runs = runs[agg.SampleID]
+		agg.MeanConfidence /= float64(agg.Occurrences)
+		result = append(result, *agg)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Occurrences != result[j].Occurrences {
+			return result[i].Occurrences > result[j].Occurrences
+		}
+		if result[i].SampleID != result[j].SampleID {
+			return result[i].SampleID < result[j].SampleID
+		}
+		return result[i].Kind < result[j].Kind
+	})
+	return result
+}
+
+// suggestionKey identifies recurring suggestions. Payloads are compared by
+// their JSON encoding, which sorts map keys and matches values decoded from
+// a log with values recorded in memory.
+func suggestionKey(sampleID string, s GroundTruthSuggestion) string {
+	payload, _ := json.Marshal(s.Payload)
+	return strings.Join([]string{sampleID, s.Scorer, string(s.Kind), string(payload)}, "\x00")
+}
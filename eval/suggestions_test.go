@@ -0,0 +1,152 @@
+package eval
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/finding"
+)
+
+// findingSample returns a sample whose trajectory reports findings against
+// the SQL injection ground truth.
+func findingSample(id string, findings ...*finding.Finding) Sample {
+	sample := Sample{
+		ID: id,
+		ExpectedFindings: []GroundTruthFinding{
+			{ID: "sqli", Title: "SQL Injection in login form", Severity: "medium", Category: "prompt_injection"},
+		},
+	}
+	for _, f := range findings {
+		sample.Trajectory.Steps = append(sample.Trajectory.Steps, TrajectoryStep{Type: "finding", Output: f})
+	}
+	return sample
+}
+
+func TestFindingAccuracyScorer_Suggestions(t *testing.T) {
+	sqli := finding.NewFindingWithID("sqli", "m", "a", "SQL Injection in login form", "", finding.CategoryPromptInjection, finding.SeverityHigh)
+	extra := finding.NewFinding("m", "a", "Open redirect on logout", "", finding.CategoryJailbreak, finding.SeverityLow)
+	extra.Confidence = 0.8
+
+	scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{}).(SuggestingScorer)
+	sample := findingSample("s1", sqli, extra)
+	result, err := scorer.Score(t.Context(), sample)
+	require.NoError(t, err)
+
+	suggestions := scorer.Suggestions(sample, result)
+	require.Len(t, suggestions, 2)
+
+	assert.Equal(t, SuggestAddFinding, suggestions[0].Kind)
+	assert.Equal(t, map[string]any{"title": "Open redirect on logout", "severity": "low", "category": "jailbreak"}, suggestions[0].Payload)
+	assert.InDelta(t, 0.4, suggestions[0].Confidence, 1e-9)
+	assert.Contains(t, suggestions[0].Evidence, "Open redirect on logout")
+
+	assert.Equal(t, SuggestAdjustSeverity, suggestions[1].Kind)
+	assert.Equal(t, map[string]any{"id": "sqli", "title": "SQL Injection in login form", "from": "medium", "to": "high"}, suggestions[1].Payload)
+}
+
+func TestFindingAccuracyScorer_NoSuggestionsOnMatch(t *testing.T) {
+	sqli := finding.NewFindingWithID("sqli", "m", "a", "SQL Injection in login form", "", finding.CategoryPromptInjection, finding.SeverityMedium)
+
+	scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{}).(SuggestingScorer)
+	sample := findingSample("s1", sqli)
+	result, err := scorer.Score(t.Context(), sample)
+	require.NoError(t, err)
+	assert.Empty(t, scorer.Suggestions(sample, result))
+
+	sample.ExpectedFindings = nil
+	assert.Empty(t, scorer.Suggestions(sample, result), "no ground truth, nothing to correct")
+}
+
+func TestScoreRecordsSuggestions(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "eval.jsonl")
+	logger, err := NewJSONLLogger(logPath)
+	require.NoError(t, err)
+
+	e := (&E{T: t}).WithLogger(logger)
+	extra := finding.NewFinding("m", "a", "Open redirect on logout", "", finding.CategoryJailbreak, finding.SeverityLow)
+	result := e.Score(findingSample("s1", extra), NewFindingAccuracyScorer(FindingAccuracyOptions{}), &mockScorer{name: "exact", score: 1.0})
+	require.NoError(t, logger.Close())
+
+	require.Len(t, result.Suggestions, 1)
+	assert.Equal(t, "finding_accuracy", result.Suggestions[0].Scorer)
+	assert.Equal(t, SuggestAddFinding, result.Suggestions[0].Kind)
+
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	var entry LogEntry
+	require.NoError(t, json.Unmarshal(bytes.TrimSpace(data), &entry))
+	require.Len(t, entry.Suggestions, 1)
+	assert.Equal(t, "Open redirect on logout", entry.Suggestions[0].Payload["title"])
+}
+
+func TestCollectSuggestions(t *testing.T) {
+	redirect := func(severity string) *finding.Finding {
+		return finding.NewFinding("m", "a", "Open redirect on logout", "", finding.CategoryJailbreak, finding.Severity(severity))
+	}
+	scorer := NewFindingAccuracyScorer(FindingAccuracyOptions{})
+
+	// Five runs over the same samples: s1 reports the same unlisted finding
+	// in four of them, s2 reports a different one-off finding once.
+	logPath := filepath.Join(t.TempDir(), "eval.jsonl")
+	logger, err := NewJSONLLogger(logPath)
+	require.NoError(t, err)
+	e := (&E{T: t}).WithLogger(logger)
+	for run := 0; run < 5; run++ {
+		if run < 4 {
+			e.Score(findingSample("s1", redirect("low")), scorer)
+		} else {
+			e.Score(findingSample("s1"), scorer)
+		}
+		if run == 0 {
+			e.Score(findingSample("s2", redirect("high")), scorer)
+		} else {
+			e.Score(findingSample("s2"), scorer)
+		}
+	}
+	require.NoError(t, logger.Close())
+
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	var entries []LogEntry
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+		var entry LogEntry
+		require.NoError(t, json.Unmarshal(line, &entry))
+		entries = append(entries, entry)
+	}
+
+	recurring := CollectSuggestions(entries, 3)
+	require.Len(t, recurring, 1, "one-off suggestions are filtered out")
+	s := recurring[0]
+	assert.Equal(t, "s1", s.SampleID)
+	assert.Equal(t, SuggestAddFinding, s.Kind)
+	assert.Equal(t, "finding_accuracy", s.Scorer)
+	assert.Equal(t, "low", s.Payload["severity"])
+	assert.Equal(t, 4, s.Occurrences)
+	assert.Equal(t, 5, s.Runs)
+	assert.InDelta(t, 0.5, s.MeanConfidence, 1e-9)
+	assert.Len(t, s.Evidence, 1, "identical evidence is kept once")
+
+	all := CollectSuggestions(entries, 1)
+	require.Len(t, all, 2)
+	assert.Equal(t, "s1", all[0].SampleID, "most frequent first")
+	assert.Equal(t, "s2", all[1].SampleID)
+	assert.Equal(t, 1, all[1].Occurrences)
+}
+
+func TestCollectSuggestions_CountsEntryOnce(t *testing.T) {
+	dup := GroundTruthSuggestion{Kind: SuggestRemoveFinding, Scorer: "custom", Payload: map[string]any{"id": "x"}, Confidence: 0.6}
+	entries := []LogEntry{
+		{SampleID: "s1", Suggestions: []GroundTruthSuggestion{dup, dup}},
+		{SampleID: "s1", Suggestions: []GroundTruthSuggestion{dup}},
+	}
+
+	recurring := CollectSuggestions(entries, 2)
+	require.Len(t, recurring, 1)
+	assert.Equal(t, 2, recurring[0].Occurrences)
+	assert.Equal(t, 2, recurring[0].Runs)
+}
@@ -0,0 +1,355 @@
+package eval
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Sort orders for TableOptions.SortBy. Any other non-empty value is taken as
+// a scorer name and sorts by that scorer's score.
+const (
+	// SortBySample sorts rows by sample ID.
+	SortBySample = "sample"
+
+	// SortByOverall sorts rows by overall score, lowest first.
+	SortByOverall = "overall"
+
+	// SortByDuration sorts rows by duration, slowest first.
+	SortByDuration = "duration"
+)
+
+// defaultMaxIDWidth is the sample ID column width used when
+// TableOptions.MaxIDWidth is not set.
+const defaultMaxIDWidth = 40
+
+// TableOptions configures WriteSummaryTable.
+type TableOptions struct {
+	// SortBy orders the rows: SortBySample, SortByOverall, SortByDuration, or
+	// a scorer name to sort by that score, lowest first. Empty keeps the
+	// order of the results.
+	SortBy string
+
+	// FailThreshold is the overall score a sample must reach to pass.
+	FailThreshold float64
+
+	// Color highlights pass and fail marks with ANSI colors. It only takes
+	// effect when the writer is a terminal, so CI logs stay plain.
+	Color bool
+
+	// MaxIDWidth truncates longer sample IDs with an ellipsis. Defaults to 40.
+	MaxIDWidth int
+
+	// Markdown renders a GitHub-flavored Markdown table, e.g. for a PR
+	// comment, instead of an aligned text table. Color is ignored.
+	Markdown bool
+}
+
+// ANSI escape sequences used when TableOptions.Color is in effect.
+const (
+	ansiReset  = "\x1b[0m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+)
+
+// WriteSummaryTable renders results as a table with one row per sample: the
+// sample ID, each scorer's score, the overall score, the duration, and a ✓ or
+// ✗ against opts.FailThreshold. Skipped and expected-failure samples are
+// marked as such. A footer gives the mean scores and duration, and the pass
+// and fail counts; skipped samples are left out of the aggregates, as in
+// Summary.
+//
+// Example, at the end of a CI run:
+//
+//	results := e.ScoreAll(evalSet.Samples, scorers...)
+//	eval.WriteSummaryTable(os.Stdout, results, eval.TableOptions{
+//	    SortBy:        eval.SortByOverall,
+//	    FailThreshold: 0.8,
+//	    Color:         true,
+//	})
+func WriteSummaryTable(w io.Writer, results []Result, opts TableOptions) error {
+	color := opts.Color && !opts.Markdown && isTerminal(w)
+	return writeSummaryTable(w, results, opts, color)
+}
+
+// writeSummaryTable renders the table, with color decided by the caller.
+func writeSummaryTable(w io.Writer, results []Result, opts TableOptions, color bool) error {
+	if opts.MaxIDWidth <= 0 {
+		opts.MaxIDWidth = defaultMaxIDWidth
+	}
+
+	scorers := scorerNames(results)
+	rows := sortedResults(results, opts.SortBy)
+
+	header := append([]string{"SAMPLE"}, scorers...)
+	header = append(header, "OVERALL", "DURATION", "RESULT")
+	rightAligned := make([]bool, len(header))
+	for i := 1; i < len(header)-1; i++ {
+		rightAligned[i] = true
+	}
+
+	var body [][]string
+	var marks []string
+	var passed, failed, skipped, limited int
+	var overallSum float64
+	var totalDuration time.Duration
+	scoreSums := make(map[string]float64, len(scorers))
+	scoreCounts := make(map[string]int, len(scorers))
+
+	for _, r := range rows {
+		row := []string{truncateID(r.SampleID, opts.MaxIDWidth)}
+		if r.Status == SampleStatusSkipped {
+			for range scorers {
+				row = append(row, "-")
+			}
+			row = append(row, "-", "-", "skip")
+			body = append(body, row)
+			marks = append(marks, ansiYellow)
+			skipped++
+			continue
+		}
+
+		for _, name := range scorers {
+			score, ok := r.Scores[name]
+			if !ok {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, formatTableScore(score.Score))
+			scoreSums[name] += score.Score
+			scoreCounts[name]++
+		}
+		row = append(row, formatTableScore(r.OverallScore), formatTableDuration(r.Duration))
+		overallSum += r.OverallScore
+		totalDuration += r.Duration
+
+		switch {
+		case r.Status == SampleStatusExpectedFailure:
+			row = append(row, "xfail")
+			marks = append(marks, ansiYellow)
+		case r.Status == SampleStatusLimitExceeded:
+			row = append(row, "limit")
+			marks = append(marks, ansiRed)
+			limited++
+		case r.Error == "" && r.OverallScore >= opts.FailThreshold:
+			row = append(row, "✓")
+			marks = append(marks, ansiGreen)
+			passed++
+		default:
+			row = append(row, "✗")
+			marks = append(marks, ansiRed)
+			failed++
+		}
+		body = append(body, row)
+	}
+
+	scored := len(rows) - skipped
+	footer := []string{"mean"}
+	for _, name := range scorers {
+		if scoreCounts[name] == 0 {
+			footer = append(footer, "-")
+			continue
+		}
+		footer = append(footer, formatTableScore(scoreSums[name]/float64(scoreCounts[name])))
+	}
+	if scored > 0 {
+		footer = append(footer, formatTableScore(overallSum/float64(scored)), formatTableDuration(totalDuration/time.Duration(scored)), "")
+	} else {
+		footer = append(footer, "-", "-", "")
+	}
+
+	totals := fmt.Sprintf("%d passed, %d failed", passed, failed)
+	if limited > 0 {
+		totals += fmt.Sprintf(", %d exceeded limits", limited)
+	}
+	if expected := scored - passed - failed - limited; expected > 0 {
+		totals += fmt.Sprintf(", %d failed as expected", expected)
+	}
+	if skipped > 0 {
+		totals += fmt.Sprintf(", %d skipped", skipped)
+	}
+	totals += fmt.Sprintf(" (threshold %.2f)", opts.FailThreshold)
+
+	if opts.Markdown {
+		return writeMarkdownTable(w, header, body, footer, rightAligned, totals)
+	}
+	if !color {
+		marks = nil
+	}
+	return writeTextTable(w, header, body, footer, rightAligned, marks, totals)
+}
+
+// writeTextTable writes an aligned text table. When marks is set, the last
+// cell of each body row is wrapped in the corresponding color.
+func writeTextTable(w io.Writer, header []string, body [][]string, footer []string, rightAligned []bool, marks []string, totals string) error {
+	widths := make([]int, len(header))
+	for _, row := range append(append([][]string{header}, body...), footer) {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	rule := make([]string, len(widths))
+	for i, width := range widths {
+		rule[i] = strings.Repeat("-", width)
+	}
+
+	var sb strings.Builder
+	writeRow := func(row []string, color string) {
+		var line strings.Builder
+		for i, cell := range row {
+			pad := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+			if i == len(row)-1 && color != "" && cell != "" {
+				cell = color + cell + ansiReset
+			}
+			if i > 0 {
+				line.WriteString("  ")
+			}
+			if rightAligned[i] {
+				line.WriteString(pad + cell)
+			} else {
+				line.WriteString(cell + pad)
+			}
+		}
+		sb.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+	}
+
+	writeRow(header, "")
+	writeRow(rule, "")
+	for i, row := range body {
+		color := ""
+		if marks != nil {
+			color = marks[i]
+		}
+		writeRow(row, color)
+	}
+	writeRow(rule, "")
+	writeRow(footer, "")
+	sb.WriteString("\n" + totals + "\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writeMarkdownTable writes a GitHub-flavored Markdown table.
+func writeMarkdownTable(w io.Writer, header []string, body [][]string, footer []string, rightAligned []bool, totals string) error {
+	var sb strings.Builder
+	writeRow := func(row []string) {
+		sb.WriteString("|")
+		for _, cell := range row {
+			sb.WriteString(" " + strings.ReplaceAll(cell, "|", `\|`) + " |")
+		}
+		sb.WriteString("\n")
+	}
+
+	writeRow(header)
+	sb.WriteString("|")
+	for _, right := range rightAligned {
+		if right {
+			sb.WriteString(" ---: |")
+		} else {
+			sb.WriteString(" --- |")
+		}
+	}
+	sb.WriteString("\n")
+	for _, row := range body {
+		writeRow(row)
+	}
+
+	bold := make([]string, len(footer))
+	for i, cell := range footer {
+		if cell != "" {
+			bold[i] = "**" + cell + "**"
+		}
+	}
+	writeRow(bold)
+	sb.WriteString("\n" + totals + "\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// scorerNames returns the names of all scorers in results, sorted.
+func scorerNames(results []Result) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, r := range results {
+		for name := range r.Scores {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedResults returns a copy of results in the order given by sortBy.
+func sortedResults(results []Result, sortBy string) []Result {
+	rows := append([]Result(nil), results...)
+	var less func(a, b Result) bool
+	switch sortBy {
+	case "":
+		return rows
+	case SortBySample:
+		less = func(a, b Result) bool { return a.SampleID < b.SampleID }
+	case SortByOverall:
+		less = func(a, b Result) bool { return a.OverallScore < b.OverallScore }
+	case SortByDuration:
+		less = func(a, b Result) bool { return a.Duration > b.Duration }
+	default:
+		// Samples without the score sort last
+		less = func(a, b Result) bool {
+			sa, okA := a.Scores[sortBy]
+			sb, okB := b.Scores[sortBy]
+			if okA != okB {
+				return okA
+			}
+			return sa.Score < sb.Score
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return less(rows[i], rows[j]) })
+	return rows
+}
+
+// truncateID shortens id to at most width runes, ending in an ellipsis.
+func truncateID(id string, width int) string {
+	if utf8.RuneCountInString(id) <= width {
+		return id
+	}
+	runes := []rune(id)
+	return string(runes[:width-1]) + "…"
+}
+
+// formatTableScore formats a score for the table.
+func formatTableScore(score float64) string {
+	return fmt.Sprintf("%.3f", score)
+}
+
+// formatTableDuration formats a duration for the table, in milliseconds
+// below one second.
+func formatTableDuration(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
+
+// isTerminal reports whether w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
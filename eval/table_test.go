@@ -0,0 +1,135 @@
+package eval
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ansiPattern matches ANSI color escape sequences.
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// tableResults covers passing, failing, errored, skipped and expected-failure
+// samples, a missing score and an over-long sample ID.
+func tableResults() []Result {
+	return []Result{
+		{
+			SampleID:     "sqli-login-form",
+			Scores:       map[string]ScoreResult{"finding_accuracy": {Score: 1.0}, "tool_correctness": {Score: 0.8}},
+			OverallScore: 0.9,
+			Duration:     1500 * time.Millisecond,
+		},
+		{
+			SampleID:     "xss-reflected-search-parameter-with-a-very-long-identifier",
+			Scores:       map[string]ScoreResult{"finding_accuracy": {Score: 0.5}, "tool_correctness": {Score: 0.6}},
+			OverallScore: 0.55,
+			Duration:     820 * time.Millisecond,
+		},
+		{
+			SampleID:     "ssrf-metadata",
+			Scores:       map[string]ScoreResult{"finding_accuracy": {Score: 0.9}},
+			OverallScore: 0.9,
+			Duration:     2 * time.Second,
+			Error:        "scorer timed out",
+		},
+		{
+			SampleID: "idor-orders",
+			Status:   SampleStatusSkipped,
+		},
+		{
+			SampleID:     "rce-upload",
+			Scores:       map[string]ScoreResult{"finding_accuracy": {Score: 0.2}, "tool_correctness": {Score: 0.4}},
+			OverallScore: 0.3,
+			Duration:     3 * time.Second,
+			Status:       SampleStatusExpectedFailure,
+		},
+	}
+}
+
+// assertGolden compares got to the golden file at testdata/name, rewriting
+// it when UpdateGoldenEnv is set.
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if os.Getenv(UpdateGoldenEnv) == "1" {
+		require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), got)
+}
+
+func TestWriteSummaryTable_Text(t *testing.T) {
+	opts := TableOptions{FailThreshold: 0.7, MaxIDWidth: 30}
+
+	var plain bytes.Buffer
+	require.NoError(t, WriteSummaryTable(&plain, tableResults(), opts))
+	assert.NotContains(t, plain.String(), "\x1b[", "color is off for writers that are not terminals")
+	assertGolden(t, "summary_table.golden.txt", plain.String())
+
+	// With color forced, only the escape codes differ
+	var colored bytes.Buffer
+	require.NoError(t, writeSummaryTable(&colored, tableResults(), opts, true))
+	assert.Contains(t, colored.String(), ansiGreen+"✓"+ansiReset)
+	assert.Contains(t, colored.String(), ansiRed+"✗"+ansiReset)
+	assertGolden(t, "summary_table.golden.txt", ansiPattern.ReplaceAllString(colored.String(), ""))
+}
+
+func TestWriteSummaryTable_Markdown(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteSummaryTable(&buf, tableResults(), TableOptions{
+		FailThreshold: 0.7,
+		MaxIDWidth:    30,
+		Markdown:      true,
+		Color:         true,
+	}))
+	assert.NotContains(t, buf.String(), "\x1b[")
+	assertGolden(t, "summary_table.golden.md", buf.String())
+}
+
+func TestWriteSummaryTable_Sort(t *testing.T) {
+	firstColumn := func(opts TableOptions) []string {
+		var buf bytes.Buffer
+		require.NoError(t, WriteSummaryTable(&buf, tableResults(), opts))
+		lines := strings.Split(buf.String(), "\n")
+		var ids []string
+		for _, line := range lines[2 : 2+len(tableResults())] {
+			ids = append(ids, strings.Fields(line)[0])
+		}
+		return ids
+	}
+
+	assert.Equal(t,
+		[]string{"idor-orders", "rce-upload", "sqli-login-form", "ssrf-metadata", "xss-reflected-search-parameter-with-a-very-long-identifier"},
+		firstColumn(TableOptions{SortBy: SortBySample, MaxIDWidth: 100}))
+	assert.Equal(t,
+		[]string{"idor-orders", "rce-upload", "xss-reflected-search-parameter-with-a-very-long-identifier", "sqli-login-form", "ssrf-metadata"},
+		firstColumn(TableOptions{SortBy: SortByOverall, MaxIDWidth: 100}))
+	assert.Equal(t,
+		[]string{"rce-upload", "ssrf-metadata", "sqli-login-form", "xss-reflected-search-parameter-with-a-very-long-identifier", "idor-orders"},
+		firstColumn(TableOptions{SortBy: SortByDuration, MaxIDWidth: 100}))
+	assert.Equal(t,
+		[]string{"rce-upload", "xss-reflected-search-parameter-with-a-very-long-identifier", "sqli-login-form", "ssrf-metadata", "idor-orders"},
+		firstColumn(TableOptions{SortBy: "tool_correctness", MaxIDWidth: 100}),
+		"samples without the score sort last")
+}
+
+func TestWriteSummaryTable_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteSummaryTable(&buf, nil, TableOptions{FailThreshold: 0.5}))
+	assert.Contains(t, buf.String(), "0 passed, 0 failed (threshold 0.50)")
+}
+
+func TestTruncateID(t *testing.T) {
+	assert.Equal(t, "short", truncateID("short", 10))
+	assert.Equal(t, "exactly-10", truncateID("exactly-10", 10))
+	assert.Equal(t, "much-long…", truncateID("much-longer-id", 10))
+	assert.Equal(t, "ünïcödé-i…", truncateID("ünïcödé-identifier", 10))
+}
@@ -0,0 +1,223 @@
+package eval
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/zero-day-ai/sdk/finding"
+	"github.com/zero-day-ai/sdk/graphrag"
+)
+
+// maxTaxonomySuggestions caps the nearest-match suggestions per issue.
+const maxTaxonomySuggestions = 3
+
+// techniqueMetadataKeys are the sample metadata keys holding technique
+// references, either a single ID or a list of IDs.
+var techniqueMetadataKeys = []string{"technique", "technique_id", "techniques", "technique_ids"}
+
+// TaxonomyIssue is an eval-set value that does not exist in the taxonomy,
+// typically because the taxonomy renamed it.
+type TaxonomyIssue struct {
+	// SampleID identifies the sample holding the value.
+	SampleID string `json:"sample_id"`
+
+	// Field is the path of the value within the sample, e.g.
+	// "expected_findings[0].category" or "metadata.technique".
+	Field string `json:"field"`
+
+	// Value is the unknown value.
+	Value string `json:"value"`
+
+	// Suggestions are the closest known values, best first.
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// String describes the issue, including any suggestions.
+func (i TaxonomyIssue) String() string {
+	s := fmt.Sprintf("sample %s: %s %q is not in the taxonomy", i.SampleID, i.Field, i.Value)
+	if len(i.Suggestions) > 0 {
+		s += fmt.Sprintf(" (did you mean %s?)", strings.Join(i.Suggestions, ", "))
+	}
+	return s
+}
+
+// ValidateAgainstTaxonomy checks the ground truth of an eval set against the
+// taxonomy, so that renamed categories, severities and techniques are caught
+// before scorers silently stop matching them. It checks:
+//   - the category and severity of every expected finding, against the enum
+//     values of the finding node type's properties
+//   - the technique IDs referenced in sample metadata under the technique,
+//     technique_id, techniques and technique_ids keys
+//
+// Severities fall back to finding.AllSeverities when tax is nil or does not
+// define them. Categories and techniques are only checked when the taxonomy
+// defines them. Samples are reported in order.
+func ValidateAgainstTaxonomy(set *EvalSet, tax graphrag.TaxonomyIntrospector) []TaxonomyIssue {
+	if set == nil {
+		return nil
+	}
+
+	severities := findingEnum(tax, "severity")
+	if len(severities) == 0 {
+		for _, s := range finding.AllSeverities() {
+			severities = append(severities, s.String())
+		}
+	}
+	categories := findingEnum(tax, "category")
+
+	var techniques []string
+	if tax != nil {
+		techniques = tax.TechniqueIDs("")
+	}
+
+	known := func(values []string) map[string]bool {
+		set := make(map[string]bool, len(values))
+		for _, v := range values {
+			set[v] = true
+		}
+		return set
+	}
+	knownSeverities := known(severities)
+	knownCategories := known(categories)
+	knownTechniques := known(techniques)
+
+	var issues []TaxonomyIssue
+	check := func(sampleID, field, value string, known map[string]bool, candidates []string) {
+		if value == "" || len(known) == 0 || known[value] {
+			return
+		}
+		issues = append(issues, TaxonomyIssue{
+			SampleID:    sampleID,
+			Field:       field,
+			Value:       value,
+			Suggestions: nearestMatches(value, candidates),
+		})
+	}
+
+	for _, sample := range set.Samples {
+		for i, gt := range sample.ExpectedFindings {
+			check(sample.ID, fmt.Sprintf("expected_findings[%d].category", i), gt.Category, knownCategories, categories)
+			check(sample.ID, fmt.Sprintf("expected_findings[%d].severity", i), gt.Severity, knownSeverities, severities)
+		}
+
+		for _, key := range techniqueMetadataKeys {
+			switch v := sample.Metadata[key].(type) {
+			case string:
+				check(sample.ID, "metadata."+key, v, knownTechniques, techniques)
+			case []string:
+				for i, id := range v {
+					check(sample.ID, fmt.Sprintf("metadata.%s[%d]", key, i), id, knownTechniques, techniques)
+				}
+			case []any:
+				for i, item := range v {
+					if id, ok := item.(string); ok {
+						check(sample.ID, fmt.Sprintf("metadata.%s[%d]", key, i), id, knownTechniques, techniques)
+					}
+				}
+			}
+		}
+	}
+
+	return issues
+}
+
+// RequireTaxonomyClean fails the test if there are taxonomy issues, listing
+// each of them. It is meant for CI checks of eval sets:
+//
+//	set, _ := eval.LoadEvalSet("testdata/evalset.json")
+//	eval.RequireTaxonomyClean(t, eval.ValidateAgainstTaxonomy(set, graphrag.GetTaxonomy()))
+func RequireTaxonomyClean(t testing.TB, issues []TaxonomyIssue) {
+	t.Helper()
+	if len(issues) == 0 {
+		return
+	}
+	for _, issue := range issues {
+		t.Errorf("%s", issue)
+	}
+	t.FailNow()
+}
+
+// findingEnum returns the enum values of a finding node property, or nil if
+// the taxonomy does not define them.
+func findingEnum(tax graphrag.TaxonomyIntrospector, property string) []string {
+	if tax == nil {
+		return nil
+	}
+	info := tax.NodeTypeInfo(graphrag.NodeTypeFinding)
+	if info == nil {
+		return nil
+	}
+	for _, prop := range info.Properties {
+		if prop.Name == property {
+			return prop.Enum
+		}
+	}
+	return nil
+}
+
+// nearestMatches returns the candidates closest to value, ignoring case. A
+// candidate is suggested when it is within a third of its length in edits,
+// or when one value contains the other (e.g. "sqli" and "sqli_blind").
+func nearestMatches(value string, candidates []string) []string {
+	needle := strings.ToLower(value)
+
+	type scored struct {
+		value    string
+		distance int
+	}
+	var matches []scored
+	for _, candidate := range candidates {
+		lower := strings.ToLower(candidate)
+		distance := editDistance(needle, lower)
+		if distance <= max(1, len(lower)/3) ||
+			(len(needle) >= 3 && (strings.Contains(lower, needle) || strings.Contains(needle, lower))) {
+			matches = append(matches, scored{value: candidate, distance: distance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].value < matches[j].value
+	})
+	if len(matches) > maxTaxonomySuggestions {
+		matches = matches[:maxTaxonomySuggestions]
+	}
+
+	var result []string
+	for _, m := range matches {
+		result = append(result, m.value)
+	}
+	return result
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b: the Levenshtein distance with adjacent transpositions counting as one
+// edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(min(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,158 @@
+package eval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/graphrag"
+)
+
+// fixtureTaxonomy is a taxonomy after an update that renamed the "injection"
+// category to "sql_injection", "info" severity to "informational" and
+// technique T1190 to T1190.001.
+type fixtureTaxonomy struct{}
+
+func (fixtureTaxonomy) Version() string                                            { return "4.0.0" }
+func (fixtureTaxonomy) NodeTypes() []string                                        { return []string{graphrag.NodeTypeFinding} }
+func (fixtureTaxonomy) RelationshipTypes() []string                                { return nil }
+func (fixtureTaxonomy) RelationshipTypeInfo(string) *graphrag.RelationshipTypeInfo { return nil }
+
+func (fixtureTaxonomy) NodeTypeInfo(nodeType string) *graphrag.NodeTypeInfo {
+	if nodeType != graphrag.NodeTypeFinding {
+		return nil
+	}
+	return &graphrag.NodeTypeInfo{
+		Type: graphrag.NodeTypeFinding,
+		Properties: []graphrag.PropertyInfo{
+			{Name: "title", Type: "string"},
+			{Name: "category", Type: "string", Enum: []string{"sql_injection", "xss", "misconfiguration", "exposure"}},
+			{Name: "severity", Type: "string", Enum: []string{"critical", "high", "medium", "low", "informational"}},
+		},
+	}
+}
+
+func (fixtureTaxonomy) TechniqueIDs(string) []string {
+	return []string{"T1190.001", "T1059", "T1078"}
+}
+
+func (t fixtureTaxonomy) TechniqueInfo(id string) *graphrag.TechniqueInfo {
+	for _, known := range t.TechniqueIDs("") {
+		if known == id {
+			return &graphrag.TechniqueInfo{ID: id}
+		}
+	}
+	return nil
+}
+
+// staleEvalSet references values the fixture taxonomy renamed.
+func staleEvalSet() *EvalSet {
+	return &EvalSet{
+		Name: "stale",
+		Samples: []Sample{
+			{
+				ID: "sqli",
+				ExpectedFindings: []GroundTruthFinding{
+					{ID: "gt-1", Category: "injection", Severity: "high"},
+					{ID: "gt-2", Category: "xss", Severity: "info"},
+				},
+				Metadata: map[string]any{"technique": "T1190"},
+			},
+			{
+				ID: "clean",
+				ExpectedFindings: []GroundTruthFinding{
+					{ID: "gt-3", Category: "exposure", Severity: "low"},
+				},
+				Metadata: map[string]any{"techniques": []any{"T1059", "T1087"}},
+			},
+		},
+	}
+}
+
+func TestValidateAgainstTaxonomy(t *testing.T) {
+	issues := ValidateAgainstTaxonomy(staleEvalSet(), fixtureTaxonomy{})
+
+	require.Len(t, issues, 4)
+	assert.Equal(t, TaxonomyIssue{
+		SampleID:    "sqli",
+		Field:       "expected_findings[0].category",
+		Value:       "injection",
+		Suggestions: []string{"sql_injection"},
+	}, issues[0])
+	assert.Equal(t, TaxonomyIssue{
+		SampleID:    "sqli",
+		Field:       "expected_findings[1].severity",
+		Value:       "info",
+		Suggestions: []string{"informational"},
+	}, issues[1])
+	assert.Equal(t, TaxonomyIssue{
+		SampleID:    "sqli",
+		Field:       "metadata.technique",
+		Value:       "T1190",
+		Suggestions: []string{"T1190.001"},
+	}, issues[2])
+
+	// T1087 is close to nothing in the taxonomy
+	assert.Equal(t, "clean", issues[3].SampleID)
+	assert.Equal(t, "metadata.techniques[1]", issues[3].Field)
+	assert.Equal(t, "T1087", issues[3].Value)
+
+	assert.Equal(t,
+		`sample sqli: expected_findings[0].category "injection" is not in the taxonomy (did you mean sql_injection?)`,
+		issues[0].String())
+}
+
+func TestValidateAgainstTaxonomy_NoTaxonomy(t *testing.T) {
+	set := staleEvalSet()
+	set.Samples[1].ExpectedFindings[0].Severity = "hgih"
+
+	// Without a taxonomy only severities are checked, against the finding
+	// package's canonical list, where "info" is valid
+	issues := ValidateAgainstTaxonomy(set, nil)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "expected_findings[0].severity", issues[0].Field)
+	assert.Equal(t, []string{"high"}, issues[0].Suggestions)
+}
+
+func TestValidateAgainstTaxonomy_Clean(t *testing.T) {
+	set := &EvalSet{
+		Samples: []Sample{{
+			ID:               "ok",
+			ExpectedFindings: []GroundTruthFinding{{Category: "sql_injection", Severity: "informational"}},
+			Metadata:         map[string]any{"technique_ids": []string{"T1190.001"}},
+		}},
+	}
+	assert.Empty(t, ValidateAgainstTaxonomy(set, fixtureTaxonomy{}))
+	assert.Empty(t, ValidateAgainstTaxonomy(nil, fixtureTaxonomy{}))
+}
+
+// failNowTB records FailNow instead of stopping the test.
+type failNowTB struct {
+	*recordingTB
+	failed bool
+}
+
+func (f *failNowTB) FailNow() { f.failed = true }
+
+func TestRequireTaxonomyClean(t *testing.T) {
+	tb := &failNowTB{recordingTB: &recordingTB{TB: t}}
+	RequireTaxonomyClean(tb, nil)
+	assert.False(t, tb.failed)
+	assert.Empty(t, tb.errors)
+
+	RequireTaxonomyClean(tb, ValidateAgainstTaxonomy(staleEvalSet(), fixtureTaxonomy{}))
+	assert.True(t, tb.failed)
+	require.Len(t, tb.errors, 4)
+	assert.Contains(t, tb.errors[0], "did you mean sql_injection?")
+}
+
+func BenchmarkValidateAgainstTaxonomy(b *testing.B) {
+	set := &EvalSet{}
+	for range 1000 {
+		set.Samples = append(set.Samples, staleEvalSet().Samples...)
+	}
+	b.ResetTimer()
+	for b.Loop() {
+		ValidateAgainstTaxonomy(set, fixtureTaxonomy{})
+	}
+}
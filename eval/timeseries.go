@@ -0,0 +1,300 @@
+package eval
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLogLineSize bounds a single JSONL line read by LoadTimeSeries.
+// Entries with large scorer details can exceed bufio.Scanner's default.
+const maxLogLineSize = 10 * 1024 * 1024
+
+// TimeSeries holds per-scorer score trends across multiple evaluation runs.
+// Each JSONL log file loaded by LoadTimeSeries is treated as one run.
+type TimeSeries struct {
+	// Runs contains metadata for each run, ordered by start time.
+	Runs []RunInfo `json:"runs"`
+
+	// Scorers maps each scorer name to its series of per-run points,
+	// ordered by run start time. Runs in which a scorer did not appear
+	// have no point for that scorer.
+	Scorers map[string][]SeriesPoint `json:"scorers"`
+}
+
+// RunInfo describes a single evaluation run loaded from a JSONL log file.
+type RunInfo struct {
+	// ID identifies the run. It is the log file name without its extension.
+	ID string `json:"id"`
+
+	// Source is the path of the log file the run was loaded from.
+	Source string `json:"source"`
+
+	// Start is the earliest entry timestamp in the run.
+	Start time.Time `json:"start"`
+
+	// End is the latest entry timestamp in the run.
+	End time.Time `json:"end"`
+
+	// Samples is the number of scored log entries in the run. Skipped
+	// entries are excluded from this and all score aggregates.
+	Samples int `json:"samples"`
+
+	// Skipped is the number of skipped entries in the run.
+	Skipped int `json:"skipped,omitempty"`
+
+	// ExpectedFailures is the number of expected-failure entries that failed.
+	ExpectedFailures int `json:"expected_failures,omitempty"`
+
+	// UnexpectedPasses is the number of expected-failure entries that passed.
+	UnexpectedPasses int `json:"unexpected_passes,omitempty"`
+
+	// Errors is the number of entries that recorded an evaluation error.
+	Errors int `json:"errors"`
+
+	// OverallMean is the mean OverallScore across the run's entries.
+	OverallMean float64 `json:"overall_mean"`
+}
+
+// SeriesPoint is the aggregate of one scorer's results within a single run.
+type SeriesPoint struct {
+	// RunID identifies the run this point belongs to.
+	RunID string `json:"run_id"`
+
+	// Timestamp is the run's start time, used as the point's x-axis value.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Mean is the mean score across the run's entries for this scorer.
+	Mean float64 `json:"mean"`
+
+	// Min is the lowest score for this scorer in the run.
+	Min float64 `json:"min"`
+
+	// Max is the highest score for this scorer in the run.
+	Max float64 `json:"max"`
+
+	// Count is the number of entries that reported this scorer.
+	Count int `json:"count"`
+}
+
+// LoadTimeSeries reads JSONL evaluation logs written by JSONLLogger and builds
+// per-scorer mean-over-time series. Each file is treated as one run; runs are
+// ordered by their earliest entry timestamp. Skipped samples are counted but
+// not aggregated, and files without scored entries are skipped.
+//
+// Example:
+//
+//	files, _ := filepath.Glob("nightly/*.jsonl")
+//	ts, err := eval.LoadTimeSeries(files)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, point := range ts.Scorers["tool_correctness"] {
+//	    fmt.Printf("%s %.3f\n", point.Timestamp.Format(time.DateOnly), point.Mean)
+//	}
+func LoadTimeSeries(files []string) (*TimeSeries, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no log files provided")
+	}
+
+	var runs []*runAggregate
+	for _, path := range files {
+		run, err := loadRun(path)
+		if err != nil {
+			return nil, err
+		}
+		if run.info.Samples > 0 {
+			runs = append(runs, run)
+		}
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		if runs[i].info.Start.Equal(runs[j].info.Start) {
+			return runs[i].info.ID < runs[j].info.ID
+		}
+		return runs[i].info.Start.Before(runs[j].info.Start)
+	})
+
+	ts := &TimeSeries{
+		Runs:    make([]RunInfo, 0, len(runs)),
+		Scorers: make(map[string][]SeriesPoint),
+	}
+	for _, run := range runs {
+		ts.Runs = append(ts.Runs, run.info)
+		for name, agg := range run.scorers {
+			ts.Scorers[name] = append(ts.Scorers[name], SeriesPoint{
+				RunID:     run.info.ID,
+				Timestamp: run.info.Start,
+				Mean:      agg.sum / float64(agg.count),
+				Min:       agg.min,
+				Max:       agg.max,
+				Count:     agg.count,
+			})
+		}
+	}
+
+	return ts, nil
+}
+
+// ScorerNames returns the names of all scorers in the series, sorted.
+func (ts *TimeSeries) ScorerNames() []string {
+	names := make([]string, 0, len(ts.Scorers))
+	for name := range ts.Scorers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WriteCSV writes the series in long format, one row per run and scorer:
+//
+//	run_id,timestamp,scorer,mean,min,max,count
+//
+// Rows are grouped by scorer name and ordered by time within each scorer.
+// Timestamps are formatted as RFC 3339.
+func (ts *TimeSeries) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"run_id", "timestamp", "scorer", "mean", "min", "max", "count"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, name := range ts.ScorerNames() {
+		for _, p := range ts.Scorers[name] {
+			record := []string{
+				p.RunID,
+				p.Timestamp.Format(time.RFC3339),
+				name,
+				strconv.FormatFloat(p.Mean, 'f', -1, 64),
+				strconv.FormatFloat(p.Min, 'f', -1, 64),
+				strconv.FormatFloat(p.Max, 'f', -1, 64),
+				strconv.Itoa(p.Count),
+			}
+			if err := cw.Write(record); err != nil {
+				return fmt.Errorf("failed to write CSV record: %w", err)
+			}
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+	return nil
+}
+
+// WriteJSON writes the series, including run metadata, as indented JSON.
+func (ts *TimeSeries) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(ts); err != nil {
+		return fmt.Errorf("failed to encode time series: %w", err)
+	}
+	return nil
+}
+
+// runAggregate accumulates the entries of a single log file.
+type runAggregate struct {
+	info    RunInfo
+	scorers map[string]*scoreAggregate
+}
+
+// scoreAggregate accumulates one scorer's scores within a run.
+type scoreAggregate struct {
+	sum   float64
+	min   float64
+	max   float64
+	count int
+}
+
+// loadRun reads and aggregates a single JSONL log file.
+func loadRun(path string) (*runAggregate, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	run := &runAggregate{
+		info: RunInfo{
+			ID:     strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+			Source: path,
+		},
+		scorers: make(map[string]*scoreAggregate),
+	}
+
+	var overallSum float64
+	entries := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var entry LogEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse %s line %d: %w", path, lineNum, err)
+		}
+
+		if entries == 0 || entry.Timestamp.Before(run.info.Start) {
+			run.info.Start = entry.Timestamp
+		}
+		if entries == 0 || entry.Timestamp.After(run.info.End) {
+			run.info.End = entry.Timestamp
+		}
+		entries++
+
+		switch entry.Status {
+		case SampleStatusSkipped:
+			run.info.Skipped++
+			continue
+		case SampleStatusExpectedFailure:
+			run.info.ExpectedFailures++
+		case SampleStatusUnexpectedPass:
+			run.info.UnexpectedPasses++
+		}
+
+		run.info.Samples++
+		if _, ok := entry.Details["error"]; ok {
+			run.info.Errors++
+		}
+		overallSum += entry.OverallScore
+
+		for name, score := range entry.Scores {
+			agg, ok := run.scorers[name]
+			if !ok {
+				agg = &scoreAggregate{min: score, max: score}
+				run.scorers[name] = agg
+			}
+			agg.sum += score
+			agg.count++
+			if score < agg.min {
+				agg.min = score
+			}
+			if score > agg.max {
+				agg.max = score
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read log file %s: %w", path, err)
+	}
+
+	if run.info.Samples > 0 {
+		run.info.OverallMean = overallSum / float64(run.info.Samples)
+	}
+
+	return run, nil
+}
@@ -0,0 +1,169 @@
+package eval
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeRunLog writes results to a JSONL file using JSONLLogger.
+func writeRunLog(t *testing.T, path string, results ...Result) {
+	t.Helper()
+
+	logger, err := NewJSONLLogger(path)
+	require.NoError(t, err)
+	for _, r := range results {
+		require.NoError(t, logger.Log(Sample{ID: r.SampleID}, r))
+	}
+	require.NoError(t, logger.(*JSONLLogger).Close())
+}
+
+func scoredResult(id string, ts time.Time, overall float64, scores map[string]float64) Result {
+	result := Result{
+		SampleID:     id,
+		Timestamp:    ts,
+		OverallScore: overall,
+		Scores:       make(map[string]ScoreResult, len(scores)),
+	}
+	for name, score := range scores {
+		result.Scores[name] = ScoreResult{Score: score}
+	}
+	return result
+}
+
+func TestLoadTimeSeries(t *testing.T) {
+	dir := t.TempDir()
+	day1 := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+
+	// Files are passed out of order; runs are aligned by timestamp.
+	night2 := filepath.Join(dir, "nightly-2.jsonl")
+	writeRunLog(t, night2,
+		scoredResult("s1", day2, 0.9, map[string]float64{"tool": 1.0, "finding": 0.8}),
+		scoredResult("s2", day2.Add(time.Minute), 0.7, map[string]float64{"tool": 0.6, "finding": 0.8}),
+	)
+	night1 := filepath.Join(dir, "nightly-1.jsonl")
+	failed := scoredResult("s2", day1.Add(time.Minute), 0.0, map[string]float64{"tool": 0.0})
+	failed.Error = "agent crashed"
+	writeRunLog(t, night1,
+		scoredResult("s1", day1.Add(2*time.Minute), 0.5, map[string]float64{"tool": 0.5}),
+		failed,
+	)
+
+	ts, err := LoadTimeSeries([]string{night2, night1})
+	require.NoError(t, err)
+
+	require.Len(t, ts.Runs, 2)
+	assert.Equal(t, "nightly-1", ts.Runs[0].ID)
+	assert.Equal(t, night1, ts.Runs[0].Source)
+	assert.True(t, ts.Runs[0].Start.Equal(day1.Add(time.Minute)))
+	assert.True(t, ts.Runs[0].End.Equal(day1.Add(2*time.Minute)))
+	assert.Equal(t, 2, ts.Runs[0].Samples)
+	assert.Equal(t, 1, ts.Runs[0].Errors)
+	assert.InDelta(t, 0.25, ts.Runs[0].OverallMean, 0.0001)
+	assert.Equal(t, "nightly-2", ts.Runs[1].ID)
+	assert.InDelta(t, 0.8, ts.Runs[1].OverallMean, 0.0001)
+
+	assert.Equal(t, []string{"finding", "tool"}, ts.ScorerNames())
+
+	tool := ts.Scorers["tool"]
+	require.Len(t, tool, 2)
+	assert.Equal(t, "nightly-1", tool[0].RunID)
+	assert.InDelta(t, 0.25, tool[0].Mean, 0.0001)
+	assert.Equal(t, 0.0, tool[0].Min)
+	assert.Equal(t, 0.5, tool[0].Max)
+	assert.Equal(t, 2, tool[0].Count)
+	assert.Equal(t, "nightly-2", tool[1].RunID)
+	assert.InDelta(t, 0.8, tool[1].Mean, 0.0001)
+
+	// The finding scorer only ran in the second run
+	finding := ts.Scorers["finding"]
+	require.Len(t, finding, 1)
+	assert.Equal(t, "nightly-2", finding[0].RunID)
+	assert.True(t, finding[0].Timestamp.Equal(ts.Runs[1].Start))
+}
+
+func TestLoadTimeSeries_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("no files", func(t *testing.T) {
+		_, err := LoadTimeSeries(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadTimeSeries([]string{filepath.Join(dir, "missing.jsonl")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to open log file")
+	})
+
+	t.Run("malformed line", func(t *testing.T) {
+		path := filepath.Join(dir, "bad.jsonl")
+		require.NoError(t, os.WriteFile(path, []byte("{\"sample_id\":\"s1\"}\n\nnot json\n"), 0644))
+
+		_, err := LoadTimeSeries([]string{path})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+}
+
+func TestLoadTimeSeries_SkipsEmptyFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	empty := filepath.Join(dir, "empty.jsonl")
+	require.NoError(t, os.WriteFile(empty, nil, 0644))
+	run := filepath.Join(dir, "run.jsonl")
+	writeRunLog(t, run, scoredResult("s1", time.Now(), 1.0, map[string]float64{"tool": 1.0}))
+
+	ts, err := LoadTimeSeries([]string{empty, run})
+	require.NoError(t, err)
+	require.Len(t, ts.Runs, 1)
+	assert.Equal(t, "run", ts.Runs[0].ID)
+}
+
+func TestTimeSeries_Export(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+
+	path := filepath.Join(dir, "nightly.jsonl")
+	writeRunLog(t, path,
+		scoredResult("s1", start, 0.75, map[string]float64{"tool": 1.0, "finding": 0.5}),
+		scoredResult("s2", start, 0.5, map[string]float64{"tool": 0.5}),
+	)
+
+	ts, err := LoadTimeSeries([]string{path})
+	require.NoError(t, err)
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, ts.WriteCSV(&buf))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"run_id", "timestamp", "scorer", "mean", "min", "max", "count"},
+			{"nightly", "2024-03-01T02:00:00Z", "finding", "0.5", "0.5", "0.5", "1"},
+			{"nightly", "2024-03-01T02:00:00Z", "tool", "0.75", "0.5", "1", "2"},
+		}, records)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, ts.WriteJSON(&buf))
+
+		var decoded TimeSeries
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Len(t, decoded.Runs, 1)
+		assert.Equal(t, "nightly", decoded.Runs[0].ID)
+		assert.Equal(t, 2, decoded.Runs[0].Samples)
+		require.Len(t, decoded.Scorers["tool"], 1)
+		assert.Equal(t, 0.75, decoded.Scorers["tool"][0].Mean)
+	})
+}
@@ -33,6 +33,45 @@ type Sample struct {
 	// ExpectedFindings lists the security findings the agent should discover.
 	ExpectedFindings []GroundTruthFinding `json:"expected_findings,omitempty" yaml:"expected_findings,omitempty"`
 
+	// ExpectError marks a negative test: the agent should fail or refuse the
+	// task (e.g. an out-of-scope destructive request). Any non-successful
+	// outcome satisfies it unless ExpectedStatus narrows it down.
+	ExpectError bool `json:"expect_error,omitempty" yaml:"expect_error,omitempty"`
+
+	// ExpectedStatus is the exact result status the agent should report.
+	// When empty, success is expected unless ExpectError is set.
+	ExpectedStatus agent.ResultStatus `json:"expected_status,omitempty" yaml:"expected_status,omitempty"`
+
+	// ExpectedStepRange is the inclusive [min, max] number of trajectory
+	// steps a competent agent needs for the task. Far fewer steps suggest a
+	// shortcut, far more suggest floundering. A zero max leaves the range
+	// unbounded above; the zero value disables the check.
+	ExpectedStepRange [2]int `json:"expected_step_range,omitzero" yaml:"expected_step_range,omitempty"`
+
+	// Skip is the reason the sample is skipped, e.g. a known-broken sample
+	// awaiting an agent fix. Skipped samples are not scored; they are logged
+	// with status "skipped" and excluded from aggregates.
+	Skip string `json:"skip,omitempty" yaml:"skip,omitempty"`
+
+	// ExpectedFailure marks the sample as expected to fail (xfail), with the
+	// reason. A failing score does not fail RequireScore; a passing one is
+	// flagged as an unexpected pass so the marker can be removed.
+	ExpectedFailure string `json:"expected_failure,omitempty" yaml:"expected_failure,omitempty"`
+
+	// Limits bounds the resources the agent may use on this sample. Zero
+	// fields fall back to the suite-wide limits set with E.WithSampleLimits.
+	// See LimitHarness.
+	Limits SampleLimits `json:"limits,omitzero" yaml:"limits,omitempty"`
+
+	// BaselineResult is the result of a naive baseline agent, such as one
+	// that does nothing, on the same task. With E.WithBaselineScoring the
+	// sample is scored against it and Result.Improvement reports the delta.
+	BaselineResult *agent.Result `json:"baseline_result,omitempty" yaml:"baseline_result,omitempty"`
+
+	// BaselineTrajectory is the recorded execution path of the baseline
+	// agent. See BaselineResult.
+	BaselineTrajectory *Trajectory `json:"baseline_trajectory,omitempty" yaml:"baseline_trajectory,omitempty"`
+
 	// Metadata stores additional sample-specific information.
 	// This can include difficulty level, author, creation date, etc.
 	Metadata map[string]any `json:"metadata,omitempty" yaml:"metadata,omitempty"`
@@ -62,11 +101,77 @@ type Result struct {
 	// Error contains error information if evaluation failed.
 	// This is serialized as a string since error type isn't JSON-serializable.
 	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	// AgentScores contains per-agent sub-results for samples whose trajectory
+	// includes delegated agents, keyed by agent name and then scorer name.
+	// Each agent is scored on its own subtree. Populated only when enabled
+	// with E.WithAgentBreakdown.
+	AgentScores map[string]map[string]ScoreResult `json:"agent_scores,omitempty" yaml:"agent_scores,omitempty"`
+
+	// BaselineScores contains the results of each scorer on the sample's
+	// baseline. Populated only when enabled with E.WithBaselineScoring and
+	// the sample has a baseline.
+	BaselineScores map[string]ScoreResult `json:"baseline_scores,omitempty" yaml:"baseline_scores,omitempty"`
+
+	// BaselineScore is the overall score of the sample's baseline.
+	BaselineScore float64 `json:"baseline_score,omitempty" yaml:"baseline_score,omitempty"`
+
+	// Improvement is OverallScore minus BaselineScore: zero for an agent
+	// that merely matches the baseline, negative for one that does worse.
+	// Nil unless BaselineScores is populated.
+	Improvement *float64 `json:"improvement,omitempty" yaml:"improvement,omitempty"`
+
+	// JudgeTokens is the number of LLM-judge tokens spent scoring the sample,
+	// including judge calls made for AgentScores and BaselineScores.
+	JudgeTokens int `json:"judge_tokens,omitempty" yaml:"judge_tokens,omitempty"`
+
+	// JudgeCostUSD is the cost of JudgeTokens. Populated only when pricing is
+	// configured with E.WithJudgePricing.
+	JudgeCostUSD float64 `json:"judge_cost_usd,omitempty" yaml:"judge_cost_usd,omitempty"`
+
+	// Status reports how a skipped, expected-failure, or limit-exceeded
+	// sample was handled. It is empty for ordinary samples.
+	Status SampleStatus `json:"status,omitempty" yaml:"status,omitempty"`
+
+	// StatusReason is the sample's Skip or ExpectedFailure reason, or the
+	// limit it exceeded.
+	StatusReason string `json:"status_reason,omitempty" yaml:"status_reason,omitempty"`
+
+	// Suggestions are the ground-truth corrections proposed by scorers that
+	// implement SuggestingScorer.
+	Suggestions []GroundTruthSuggestion `json:"suggestions,omitempty" yaml:"suggestions,omitempty"`
 }
 
+// SampleStatus reports how a sample with a skip or expected-failure marker,
+// or one that exceeded its resource limits, was handled.
+type SampleStatus string
+
+const (
+	// SampleStatusSkipped indicates the sample was skipped and not scored.
+	SampleStatusSkipped SampleStatus = "skipped"
+
+	// SampleStatusExpectedFailure indicates an expected-failure sample that
+	// failed as expected.
+	SampleStatusExpectedFailure SampleStatus = "xfail"
+
+	// SampleStatusUnexpectedPass indicates an expected-failure sample that
+	// passed; its ExpectedFailure marker is probably stale.
+	SampleStatusUnexpectedPass SampleStatus = "unexpected_pass"
+
+	// SampleStatusLimitExceeded indicates the agent was stopped because it
+	// exceeded one of the sample's limits. The sample is scored over its
+	// partial trajectory; StatusReason names the limit.
+	SampleStatusLimitExceeded SampleStatus = "limit_exceeded"
+)
+
 // Trajectory represents the recorded execution path of an agent.
 // It captures all operations performed during task execution.
 type Trajectory struct {
+	// Agent is the name of the agent that produced this trajectory.
+	// It is empty for an unnamed root recording; embedded child trajectories
+	// default to the name of the delegate step that contains them.
+	Agent string `json:"agent,omitempty" yaml:"agent,omitempty"`
+
 	// Steps contains the sequence of operations performed.
 	Steps []TrajectoryStep `json:"steps" yaml:"steps"`
 
@@ -81,7 +186,8 @@ type Trajectory struct {
 // This could be a tool call, LLM completion, finding submission, etc.
 type TrajectoryStep struct {
 	// Type identifies the kind of operation.
-	// Common values: "tool", "llm", "delegate", "finding", "memory"
+	// Common values: "tool", "llm", "delegate", "finding", "memory",
+	// "reasoning"
 	Type string `json:"type" yaml:"type"`
 
 	// Name is the specific name of the operation.
@@ -93,7 +199,8 @@ type TrajectoryStep struct {
 	Input any `json:"input,omitempty" yaml:"input,omitempty"`
 
 	// Output contains the output data from this operation.
-	// The structure depends on the operation type.
+	// The structure depends on the operation type; for "reasoning" steps it
+	// is the reasoning text.
 	Output any `json:"output,omitempty" yaml:"output,omitempty"`
 
 	// Error contains error information if the operation failed.
@@ -105,6 +212,47 @@ type TrajectoryStep struct {
 
 	// Duration is how long this operation took to complete.
 	Duration time.Duration `json:"duration" yaml:"duration"`
+
+	// Children contains the full trajectory of the delegated agent for
+	// "delegate" steps, when the sub-agent returned one. See AttachTrajectory.
+	Children *Trajectory `json:"children,omitempty" yaml:"children,omitempty"`
+
+	// Agent is the name of the agent that performed this step. It is set by
+	// Trajectory.Flatten, ByAgent and Subtree; recorded steps leave it empty.
+	Agent string `json:"agent,omitempty" yaml:"agent,omitempty"`
+
+	// Stream holds the chunk sequence of streamed "llm" steps, with the time
+	// each chunk arrived. Nil for non-streamed steps.
+	Stream *StreamRecording `json:"stream,omitempty" yaml:"stream,omitempty"`
+}
+
+// StreamRecording is the chunk sequence of a streamed LLM completion as the
+// agent received it. RecordingHarness caps the recording at
+// MaxRecordedStreamChunks chunks and MaxRecordedStreamBytes bytes of content;
+// later chunks are counted but not kept.
+type StreamRecording struct {
+	// Chunks are the recorded chunks in arrival order.
+	Chunks []RecordedChunk `json:"chunks" yaml:"chunks"`
+
+	// TotalChunks is the number of chunks in the stream, including those
+	// dropped by the cap.
+	TotalChunks int `json:"total_chunks" yaml:"total_chunks"`
+
+	// Truncated is true if chunks were dropped by the cap.
+	Truncated bool `json:"truncated,omitempty" yaml:"truncated,omitempty"`
+}
+
+// RecordedChunk is a single chunk of a StreamRecording.
+type RecordedChunk struct {
+	// Offset is the arrival time of the chunk relative to the step's
+	// StartTime.
+	Offset time.Duration `json:"offset" yaml:"offset"`
+
+	// Delta is the text content of the chunk.
+	Delta string `json:"delta,omitempty" yaml:"delta,omitempty"`
+
+	// FinishReason is set on the final chunk.
+	FinishReason string `json:"finish_reason,omitempty" yaml:"finish_reason,omitempty"`
 }
 
 // EvalSet is a collection of evaluation samples with metadata.
@@ -154,4 +302,34 @@ type GroundTruthFinding struct {
 	// Title is the expected finding title.
 	// This can be used for fuzzy matching when ID matching fails.
 	Title string `json:"title" yaml:"title"`
+
+	// Weight is how much finding or missing this finding counts in
+	// FindingAccuracyScorer's precision and recall, relative to other
+	// findings. Zero means unset: 1.0, or the severity weight when
+	// MatchBySeverity is enabled. Weight must not be negative.
+	Weight float64 `json:"weight,omitempty" yaml:"weight,omitempty"`
+
+	// Location is where the finding is expected, such as the endpoint and
+	// parameter of an injection. When set, FindingAccuracyScorer matches
+	// only actual findings at that location; see FindingAccuracyOptions.
+	Location *FindingLocation `json:"location,omitempty" yaml:"location,omitempty"`
+}
+
+// FindingLocation identifies where a finding occurs. Empty fields are not
+// compared.
+type FindingLocation struct {
+	// URL is the affected endpoint. It may be absolute or a path, in which
+	// case any host matches.
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+
+	// Parameter is the affected request parameter.
+	Parameter string `json:"parameter,omitempty" yaml:"parameter,omitempty"`
+
+	// Method is the HTTP method of the affected request.
+	Method string `json:"method,omitempty" yaml:"method,omitempty"`
+}
+
+// IsZero returns true if no field of the location is set.
+func (l FindingLocation) IsZero() bool {
+	return l == FindingLocation{}
 }
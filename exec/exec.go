@@ -134,11 +134,11 @@ func Run(ctx context.Context, cfg Config) (*Result, error) {
 	if err != nil {
 		// Check for context errors first (timeout/cancellation)
 		if ctx.Err() == context.DeadlineExceeded {
-			return result, fmt.Errorf("command timed out after %v", cfg.Timeout)
+			return result, fmt.Errorf("command timed out after %v: %w", cfg.Timeout, context.DeadlineExceeded)
 		}
 
 		if ctx.Err() == context.Canceled {
-			return result, fmt.Errorf("command cancelled")
+			return result, fmt.Errorf("command cancelled: %w", context.Canceled)
 		}
 
 		// Check for normal exit with non-zero code
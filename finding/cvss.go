@@ -0,0 +1,290 @@
+package finding
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// CVSS versions supported by ParseCVSS.
+const (
+	// CVSSVersion31 is CVSS v3.1.
+	CVSSVersion31 = "3.1"
+
+	// CVSSVersion40 is CVSS v4.0.
+	CVSSVersion40 = "4.0"
+)
+
+// CVSS is a Common Vulnerability Scoring System vector and the score
+// computed from it. Create it with ParseCVSS so the score matches the vector.
+type CVSS struct {
+	// Version is CVSSVersion31 or CVSSVersion40.
+	Version string `json:"version"`
+
+	// Vector is the vector string, e.g.
+	// "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H".
+	Vector string `json:"vector"`
+
+	// Score is the score computed from Vector (0.0 to 10.0). For CVSS v3.1
+	// it is the base score; temporal and environmental metrics are accepted
+	// but not scored. For CVSS v4.0 it is the score of all metrics in the
+	// vector, as the specification defines.
+	Score float64 `json:"score"`
+}
+
+// cvssMetric is a metric allowed in a vector string.
+type cvssMetric struct {
+	name     string
+	values   []string
+	required bool
+}
+
+// cvss31Metrics are the CVSS v3.1 metrics in specification order.
+var cvss31Metrics = []cvssMetric{
+	// Base
+	{name: "AV", values: []string{"N", "A", "L", "P"}, required: true},
+	{name: "AC", values: []string{"L", "H"}, required: true},
+	{name: "PR", values: []string{"N", "L", "H"}, required: true},
+	{name: "UI", values: []string{"N", "R"}, required: true},
+	{name: "S", values: []string{"U", "C"}, required: true},
+	{name: "C", values: []string{"H", "L", "N"}, required: true},
+	{name: "I", values: []string{"H", "L", "N"}, required: true},
+	{name: "A", values: []string{"H", "L", "N"}, required: true},
+	// Temporal
+	{name: "E", values: []string{"X", "H", "F", "P", "U"}},
+	{name: "RL", values: []string{"X", "U", "W", "T", "O"}},
+	{name: "RC", values: []string{"X", "C", "R", "U"}},
+	// Environmental
+	{name: "CR", values: []string{"X", "H", "M", "L"}},
+	{name: "IR", values: []string{"X", "H", "M", "L"}},
+	{name: "AR", values: []string{"X", "H", "M", "L"}},
+	{name: "MAV", values: []string{"X", "N", "A", "L", "P"}},
+	{name: "MAC", values: []string{"X", "L", "H"}},
+	{name: "MPR", values: []string{"X", "N", "L", "H"}},
+	{name: "MUI", values: []string{"X", "N", "R"}},
+	{name: "MS", values: []string{"X", "U", "C"}},
+	{name: "MC", values: []string{"X", "H", "L", "N"}},
+	{name: "MI", values: []string{"X", "H", "L", "N"}},
+	{name: "MA", values: []string{"X", "H", "L", "N"}},
+}
+
+// cvss40Metrics are the CVSS v4.0 metrics in specification order.
+var cvss40Metrics = []cvssMetric{
+	// Base
+	{name: "AV", values: []string{"N", "A", "L", "P"}, required: true},
+	{name: "AC", values: []string{"L", "H"}, required: true},
+	{name: "AT", values: []string{"N", "P"}, required: true},
+	{name: "PR", values: []string{"N", "L", "H"}, required: true},
+	{name: "UI", values: []string{"N", "P", "A"}, required: true},
+	{name: "VC", values: []string{"H", "L", "N"}, required: true},
+	{name: "VI", values: []string{"H", "L", "N"}, required: true},
+	{name: "VA", values: []string{"H", "L", "N"}, required: true},
+	{name: "SC", values: []string{"H", "L", "N"}, required: true},
+	{name: "SI", values: []string{"H", "L", "N"}, required: true},
+	{name: "SA", values: []string{"H", "L", "N"}, required: true},
+	// Threat
+	{name: "E", values: []string{"X", "A", "P", "U"}},
+	// Environmental
+	{name: "CR", values: []string{"X", "H", "M", "L"}},
+	{name: "IR", values: []string{"X", "H", "M", "L"}},
+	{name: "AR", values: []string{"X", "H", "M", "L"}},
+	{name: "MAV", values: []string{"X", "N", "A", "L", "P"}},
+	{name: "MAC", values: []string{"X", "L", "H"}},
+	{name: "MAT", values: []string{"X", "N", "P"}},
+	{name: "MPR", values: []string{"X", "N", "L", "H"}},
+	{name: "MUI", values: []string{"X", "N", "P", "A"}},
+	{name: "MVC", values: []string{"X", "H", "L", "N"}},
+	{name: "MVI", values: []string{"X", "H", "L", "N"}},
+	{name: "MVA", values: []string{"X", "H", "L", "N"}},
+	{name: "MSC", values: []string{"X", "H", "L", "N"}},
+	{name: "MSI", values: []string{"X", "S", "H", "L", "N"}},
+	{name: "MSA", values: []string{"X", "S", "H", "L", "N"}},
+	// Supplemental
+	{name: "S", values: []string{"X", "N", "P"}},
+	{name: "AU", values: []string{"X", "N", "Y"}},
+	{name: "R", values: []string{"X", "A", "U", "I"}},
+	{name: "V", values: []string{"X", "D", "C"}},
+	{name: "RE", values: []string{"X", "L", "M", "H"}},
+	{name: "U", values: []string{"X", "Clear", "Green", "Amber", "Red"}},
+}
+
+// ParseCVSS parses and validates a CVSS v3.1 or v4.0 vector string and
+// computes its score. Unknown metrics, invalid values, repeated metrics and
+// missing base metrics are rejected. Metrics may appear in any order; the
+// returned Vector lists them in specification order.
+//
+// Example:
+//
+//	cvss, err := finding.ParseCVSS("CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N")
+//	// cvss.Score == 9.3, cvss.Severity() == finding.SeverityCritical
+func ParseCVSS(vector string) (*CVSS, error) {
+	version, metrics, err := parseCVSSVector(vector)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CVSS{Version: version}
+	switch version {
+	case CVSSVersion31:
+		c.Vector = formatCVSSVector(version, cvss31Metrics, metrics)
+		c.Score = cvss31BaseScore(metrics)
+	case CVSSVersion40:
+		c.Vector = formatCVSSVector(version, cvss40Metrics, metrics)
+		c.Score = cvss40Score(metrics)
+	}
+	return c, nil
+}
+
+// Validate checks that the vector is valid, that Version matches it, and
+// that Score is the score computed from it.
+func (c *CVSS) Validate() error {
+	parsed, err := ParseCVSS(c.Vector)
+	if err != nil {
+		return err
+	}
+	if c.Version != parsed.Version {
+		return fmt.Errorf("CVSS version %q does not match vector version %q", c.Version, parsed.Version)
+	}
+	if math.Abs(c.Score-parsed.Score) > 0.001 {
+		return fmt.Errorf("CVSS score %.1f does not match vector score %.1f", c.Score, parsed.Score)
+	}
+	return nil
+}
+
+// Severity returns the severity of the score per the CVSS qualitative
+// severity rating scale.
+func (c *CVSS) Severity() Severity {
+	return SeverityFromScore(c.Score)
+}
+
+// SeverityFromScore maps a CVSS score to a severity per the qualitative
+// severity rating scale shared by CVSS v3.1 and v4.0: 0.0 is info (None),
+// 0.1-3.9 low, 4.0-6.9 medium, 7.0-8.9 high and 9.0-10.0 critical.
+func SeverityFromScore(score float64) Severity {
+	switch {
+	case score >= 9.0:
+		return SeverityCritical
+	case score >= 7.0:
+		return SeverityHigh
+	case score >= 4.0:
+		return SeverityMedium
+	case score >= 0.1:
+		return SeverityLow
+	default:
+		return SeverityInfo
+	}
+}
+
+// parseCVSSVector splits a vector string into its version and metric values,
+// validating them against the version's metrics.
+func parseCVSSVector(vector string) (string, map[string]string, error) {
+	prefix, rest, ok := strings.Cut(vector, "/")
+	if !ok || !strings.HasPrefix(prefix, "CVSS:") {
+		return "", nil, fmt.Errorf("invalid CVSS vector %q: must start with \"CVSS:<version>/\"", vector)
+	}
+
+	version := strings.TrimPrefix(prefix, "CVSS:")
+	var defs []cvssMetric
+	switch version {
+	case CVSSVersion31:
+		defs = cvss31Metrics
+	case CVSSVersion40:
+		defs = cvss40Metrics
+	default:
+		return "", nil, fmt.Errorf("unsupported CVSS version %q", version)
+	}
+
+	metrics := make(map[string]string)
+	for _, part := range strings.Split(rest, "/") {
+		name, value, ok := strings.Cut(part, ":")
+		if !ok {
+			return "", nil, fmt.Errorf("invalid CVSS metric %q", part)
+		}
+		i := slices.IndexFunc(defs, func(m cvssMetric) bool { return m.name == name })
+		if i < 0 {
+			return "", nil, fmt.Errorf("unknown CVSS %s metric %q", version, name)
+		}
+		if !slices.Contains(defs[i].values, value) {
+			return "", nil, fmt.Errorf("invalid value %q for CVSS %s metric %s", value, version, name)
+		}
+		if _, dup := metrics[name]; dup {
+			return "", nil, fmt.Errorf("CVSS metric %s is repeated", name)
+		}
+		metrics[name] = value
+	}
+
+	for _, m := range defs {
+		if _, ok := metrics[m.name]; m.required && !ok {
+			return "", nil, fmt.Errorf("missing mandatory CVSS %s metric %s", version, m.name)
+		}
+	}
+	return version, metrics, nil
+}
+
+// formatCVSSVector writes metrics as a vector string in specification order.
+func formatCVSSVector(version string, defs []cvssMetric, metrics map[string]string) string {
+	var b strings.Builder
+	b.WriteString("CVSS:" + version)
+	for _, m := range defs {
+		if value, ok := metrics[m.name]; ok {
+			b.WriteString("/" + m.name + ":" + value)
+		}
+	}
+	return b.String()
+}
+
+// CVSS v3.1 base metric weights.
+var (
+	cvss31AV  = map[string]float64{"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2}
+	cvss31AC  = map[string]float64{"L": 0.77, "H": 0.44}
+	cvss31UI  = map[string]float64{"N": 0.85, "R": 0.62}
+	cvss31CIA = map[string]float64{"H": 0.56, "L": 0.22, "N": 0}
+)
+
+// cvss31BaseScore computes the CVSS v3.1 base score.
+func cvss31BaseScore(m map[string]string) float64 {
+	changed := m["S"] == "C"
+
+	var pr float64
+	switch m["PR"] {
+	case "N":
+		pr = 0.85
+	case "L":
+		pr = 0.62
+		if changed {
+			pr = 0.68
+		}
+	case "H":
+		pr = 0.27
+		if changed {
+			pr = 0.5
+		}
+	}
+
+	iss := 1 - (1-cvss31CIA[m["C"]])*(1-cvss31CIA[m["I"]])*(1-cvss31CIA[m["A"]])
+	impact := 6.42 * iss
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0
+	}
+
+	exploitability := 8.22 * cvss31AV[m["AV"]] * cvss31AC[m["AC"]] * pr * cvss31UI[m["UI"]]
+	if changed {
+		return cvss31Roundup(math.Min(1.08*(impact+exploitability), 10))
+	}
+	return cvss31Roundup(math.Min(impact+exploitability, 10))
+}
+
+// cvss31Roundup returns the smallest number with one decimal place that is
+// equal to or higher than x, as defined in CVSS v3.1 Appendix A to avoid
+// floating point errors.
+func cvss31Roundup(x float64) float64 {
+	i := int64(math.Round(x * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return (math.Floor(float64(i)/10000) + 1) / 10
+}
@@ -0,0 +1,306 @@
+package finding
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"strings"
+)
+
+// cvss40Score computes the CVSS v4.0 score of a vector's metrics with the
+// MacroVector interpolation of the specification's reference calculator.
+func cvss40Score(metrics map[string]string) float64 {
+	m := cvss40Effective(metrics)
+
+	// no impact on the vulnerable or subsequent system
+	if m("VC") == "N" && m("VI") == "N" && m("VA") == "N" && m("SC") == "N" && m("SI") == "N" && m("SA") == "N" {
+		return 0
+	}
+
+	eq1, eq2, eq3, eq4, eq5, eq6 := cvss40MacroVector(m)
+	value, _ := cvss40Lookup(eq1, eq2, eq3, eq4, eq5, eq6)
+
+	// scores of the next lower MacroVector of each equivalence class
+	lowerEQ1, okEQ1 := cvss40Lookup(eq1+1, eq2, eq3, eq4, eq5, eq6)
+	lowerEQ2, okEQ2 := cvss40Lookup(eq1, eq2+1, eq3, eq4, eq5, eq6)
+	lowerEQ4, okEQ4 := cvss40Lookup(eq1, eq2, eq3, eq4+1, eq5, eq6)
+	lowerEQ5, okEQ5 := cvss40Lookup(eq1, eq2, eq3, eq4, eq5+1, eq6)
+	var lowerEQ3EQ6 float64
+	var okEQ3EQ6 bool
+	switch {
+	case eq3 == 0 && eq6 == 0:
+		left, _ := cvss40Lookup(eq1, eq2, eq3, eq4, eq5, eq6+1)
+		right, _ := cvss40Lookup(eq1, eq2, eq3+1, eq4, eq5, eq6)
+		lowerEQ3EQ6, okEQ3EQ6 = max(left, right), true
+	case eq3 == 1 && eq6 == 0:
+		lowerEQ3EQ6, okEQ3EQ6 = cvss40Lookup(eq1, eq2, eq3, eq4, eq5, eq6+1)
+	default:
+		lowerEQ3EQ6, okEQ3EQ6 = cvss40Lookup(eq1, eq2, eq3+1, eq4, eq5, eq6)
+	}
+
+	// severity distances of the vector from the highest severity vector of
+	// its MacroVector
+	var distEQ1, distEQ2, distEQ3EQ6, distEQ4 int
+	for _, maxVector := range cvss40MaxVectors(eq1, eq2, eq3, eq4, eq5, eq6) {
+		d := func(metric string) int {
+			levels := cvss40Levels[metric]
+			return levels[m(metric)] - levels[maxVector[metric]]
+		}
+		av, pr, ui := d("AV"), d("PR"), d("UI")
+		ac, at := d("AC"), d("AT")
+		vc, vi, va, cr, ir, ar := d("VC"), d("VI"), d("VA"), d("CR"), d("IR"), d("AR")
+		sc, si, sa := d("SC"), d("SI"), d("SA")
+
+		distEQ1 = av + pr + ui
+		distEQ2 = ac + at
+		distEQ3EQ6 = vc + vi + va + cr + ir + ar
+		distEQ4 = sc + si + sa
+		if min(av, pr, ui, ac, at, vc, vi, va, cr, ir, ar, sc, si, sa) >= 0 {
+			break
+		}
+	}
+
+	// interpolate between the MacroVector and the next lower ones by the
+	// proportion of the MacroVector's depth the vector is from its top
+	var total float64
+	var lower int
+	add := func(lowerScore float64, ok bool, distance, depth int) {
+		if !ok {
+			return
+		}
+		lower++
+		total += (value - lowerScore) * float64(distance) / float64(depth)
+	}
+	add(lowerEQ1, okEQ1, distEQ1, cvss40DepthEQ1[eq1])
+	add(lowerEQ2, okEQ2, distEQ2, cvss40DepthEQ2[eq2])
+	add(lowerEQ3EQ6, okEQ3EQ6, distEQ3EQ6, cvss40DepthEQ3EQ6[eq3][eq6])
+	add(lowerEQ4, okEQ4, distEQ4, cvss40DepthEQ4[eq4])
+	add(lowerEQ5, okEQ5, 0, 1)
+	if lower > 0 {
+		value -= total / float64(lower)
+	}
+
+	value = math.Max(0, math.Min(10, value))
+	return math.Floor(value*10+0.5) / 10
+}
+
+// cvss40Effective returns a function giving the value of a base or threat
+// metric used for scoring: the modified metric's value if set, and the
+// worst case for unset threat and security requirement metrics.
+func cvss40Effective(metrics map[string]string) func(metric string) string {
+	return func(metric string) string {
+		if v := metrics["M"+metric]; v != "" && v != "X" {
+			return v
+		}
+		v := metrics[metric]
+		if v == "" || v == "X" {
+			switch metric {
+			case "E":
+				return "A"
+			case "CR", "IR", "AR":
+				return "H"
+			}
+		}
+		return v
+	}
+}
+
+// cvss40MacroVector returns the levels of the six equivalence classes of
+// the vector, which select its MacroVector.
+func cvss40MacroVector(m func(string) string) (eq1, eq2, eq3, eq4, eq5, eq6 int) {
+	switch {
+	case m("AV") == "N" && m("PR") == "N" && m("UI") == "N":
+		eq1 = 0
+	case (m("AV") == "N" || m("PR") == "N" || m("UI") == "N") && m("AV") != "P":
+		eq1 = 1
+	default:
+		eq1 = 2
+	}
+
+	if m("AC") != "L" || m("AT") != "N" {
+		eq2 = 1
+	}
+
+	switch {
+	case m("VC") == "H" && m("VI") == "H":
+		eq3 = 0
+	case m("VC") == "H" || m("VI") == "H" || m("VA") == "H":
+		eq3 = 1
+	default:
+		eq3 = 2
+	}
+
+	switch {
+	case m("SI") == "S" || m("SA") == "S":
+		eq4 = 0
+	case m("SC") == "H" || m("SI") == "H" || m("SA") == "H":
+		eq4 = 1
+	default:
+		eq4 = 2
+	}
+
+	switch m("E") {
+	case "P":
+		eq5 = 1
+	case "U":
+		eq5 = 2
+	}
+
+	if !(m("CR") == "H" && m("VC") == "H") && !(m("IR") == "H" && m("VI") == "H") && !(m("AR") == "H" && m("VA") == "H") {
+		eq6 = 1
+	}
+	return eq1, eq2, eq3, eq4, eq5, eq6
+}
+
+// cvss40Lookup returns the score of a MacroVector, and false if the
+// MacroVector does not exist.
+func cvss40Lookup(eq1, eq2, eq3, eq4, eq5, eq6 int) (float64, bool) {
+	score, ok := cvss40MacroVectorScores[fmt.Sprintf("%d%d%d%d%d%d", eq1, eq2, eq3, eq4, eq5, eq6)]
+	return score, ok
+}
+
+// cvss40MaxVectors returns the highest severity vectors of a MacroVector,
+// as the combinations of the highest severity metric values of its
+// equivalence classes.
+func cvss40MaxVectors(eq1, eq2, eq3, eq4, eq5, eq6 int) []map[string]string {
+	vectors := []map[string]string{{}}
+	for _, class := range [][]string{
+		cvss40MaxEQ1[eq1],
+		cvss40MaxEQ2[eq2],
+		cvss40MaxEQ3EQ6[eq3][eq6],
+		cvss40MaxEQ4[eq4],
+		cvss40MaxEQ5[eq5],
+	} {
+		var combined []map[string]string
+		for _, vector := range vectors {
+			for _, values := range class {
+				next := maps.Clone(vector)
+				for _, part := range strings.Split(values, "/") {
+					name, value, _ := strings.Cut(part, ":")
+					next[name] = value
+				}
+				combined = append(combined, next)
+			}
+		}
+		vectors = combined
+	}
+	return vectors
+}
+
+// cvss40Levels are the severity levels of metric values used for severity
+// distances, in steps of 0.1 from the most severe value.
+var cvss40Levels = map[string]map[string]int{
+	"AV": {"N": 0, "A": 1, "L": 2, "P": 3},
+	"PR": {"N": 0, "L": 1, "H": 2},
+	"UI": {"N": 0, "P": 1, "A": 2},
+	"AC": {"L": 0, "H": 1},
+	"AT": {"N": 0, "P": 1},
+	"VC": {"H": 0, "L": 1, "N": 2},
+	"VI": {"H": 0, "L": 1, "N": 2},
+	"VA": {"H": 0, "L": 1, "N": 2},
+	"SC": {"H": 1, "L": 2, "N": 3},
+	"SI": {"S": 0, "H": 1, "L": 2, "N": 3},
+	"SA": {"S": 0, "H": 1, "L": 2, "N": 3},
+	"CR": {"H": 0, "M": 1, "L": 2},
+	"IR": {"H": 0, "M": 1, "L": 2},
+	"AR": {"H": 0, "M": 1, "L": 2},
+}
+
+// Highest severity metric values of each equivalence class level.
+var (
+	cvss40MaxEQ1 = map[int][]string{
+		0: {"AV:N/PR:N/UI:N"},
+		1: {"AV:A/PR:N/UI:N", "AV:N/PR:L/UI:N", "AV:N/PR:N/UI:P"},
+		2: {"AV:P/PR:N/UI:N", "AV:A/PR:L/UI:P"},
+	}
+	cvss40MaxEQ2 = map[int][]string{
+		0: {"AC:L/AT:N"},
+		1: {"AC:H/AT:N", "AC:L/AT:P"},
+	}
+	cvss40MaxEQ3EQ6 = map[int]map[int][]string{
+		0: {
+			0: {"VC:H/VI:H/VA:H/CR:H/IR:H/AR:H"},
+			1: {"VC:H/VI:H/VA:L/CR:M/IR:M/AR:H", "VC:H/VI:H/VA:H/CR:M/IR:M/AR:M"},
+		},
+		1: {
+			0: {"VC:L/VI:H/VA:H/CR:H/IR:H/AR:H", "VC:H/VI:L/VA:H/CR:H/IR:H/AR:H"},
+			1: {
+				"VC:L/VI:H/VA:H/CR:H/IR:M/AR:M", "VC:L/VI:H/VA:L/CR:H/IR:M/AR:H", "VC:H/VI:L/VA:H/CR:M/IR:H/AR:M",
+				"VC:H/VI:L/VA:L/CR:M/IR:H/AR:H", "VC:L/VI:L/VA:H/CR:H/IR:H/AR:M",
+			},
+		},
+		2: {
+			1: {"VC:L/VI:L/VA:L/CR:H/IR:H/AR:H"},
+		},
+	}
+	cvss40MaxEQ4 = map[int][]string{
+		0: {"SC:H/SI:S/SA:S"},
+		1: {"SC:H/SI:H/SA:H"},
+		2: {"SC:L/SI:L/SA:L"},
+	}
+	cvss40MaxEQ5 = map[int][]string{
+		0: {"E:A"},
+		1: {"E:P"},
+		2: {"E:U"},
+	}
+)
+
+// Depths of the equivalence class levels: the largest severity distance
+// within the level, plus one.
+var (
+	cvss40DepthEQ1    = map[int]int{0: 1, 1: 4, 2: 5}
+	cvss40DepthEQ2    = map[int]int{0: 1, 1: 2}
+	cvss40DepthEQ3EQ6 = map[int]map[int]int{0: {0: 7, 1: 6}, 1: {0: 8, 1: 8}, 2: {1: 10}}
+	cvss40DepthEQ4    = map[int]int{0: 6, 1: 5, 2: 4}
+)
+
+// cvss40MacroVectorScores are the scores of the 270 MacroVectors, keyed by
+// their equivalence class levels, from the specification's reference
+// calculator.
+var cvss40MacroVectorScores = map[string]float64{
+	"000000": 10, "000001": 9.9, "000010": 9.8, "000011": 9.5, "000020": 9.5, "000021": 9.2,
+	"000100": 10, "000101": 9.6, "000110": 9.3, "000111": 8.7, "000120": 9.1, "000121": 8.1,
+	"000200": 9.3, "000201": 9, "000210": 8.9, "000211": 8, "000220": 8.1, "000221": 6.8,
+	"001000": 9.8, "001001": 9.5, "001010": 9.5, "001011": 9.2, "001020": 9, "001021": 8.4,
+	"001100": 9.3, "001101": 9.2, "001110": 8.9, "001111": 8.1, "001120": 8.1, "001121": 6.5,
+	"001200": 8.8, "001201": 8, "001210": 7.8, "001211": 7, "001220": 6.9, "001221": 4.8,
+	"002001": 9.2, "002011": 8.2, "002021": 7.2, "002101": 7.9, "002111": 6.9, "002121": 5,
+	"002201": 6.9, "002211": 5.5, "002221": 2.7, "010000": 9.9, "010001": 9.7, "010010": 9.5,
+	"010011": 9.2, "010020": 9.2, "010021": 8.5, "010100": 9.5, "010101": 9.1, "010110": 9,
+	"010111": 8.3, "010120": 8.4, "010121": 7.1, "010200": 9.2, "010201": 8.1, "010210": 8.2,
+	"010211": 7.1, "010220": 7.2, "010221": 5.3, "011000": 9.5, "011001": 9.3, "011010": 9.2,
+	"011011": 8.5, "011020": 8.5, "011021": 7.3, "011100": 9.2, "011101": 8.2, "011110": 8,
+	"011111": 7.2, "011120": 7, "011121": 5.9, "011200": 8.4, "011201": 7, "011210": 7.1,
+	"011211": 5.2, "011220": 5, "011221": 3, "012001": 8.6, "012011": 7.5, "012021": 5.2,
+	"012101": 7.1, "012111": 5.2, "012121": 2.9, "012201": 6.3, "012211": 2.9, "012221": 1.7,
+	"100000": 9.8, "100001": 9.5, "100010": 9.4, "100011": 8.7, "100020": 9.1, "100021": 8.1,
+	"100100": 9.4, "100101": 8.9, "100110": 8.6, "100111": 7.4, "100120": 7.7, "100121": 6.4,
+	"100200": 8.7, "100201": 7.5, "100210": 7.4, "100211": 6.3, "100220": 6.3, "100221": 4.9,
+	"101000": 9.4, "101001": 8.9, "101010": 8.8, "101011": 7.7, "101020": 7.6, "101021": 6.7,
+	"101100": 8.6, "101101": 7.6, "101110": 7.4, "101111": 5.8, "101120": 5.9, "101121": 5,
+	"101200": 7.2, "101201": 5.7, "101210": 5.7, "101211": 5.2, "101220": 5.2, "101221": 2.5,
+	"102001": 8.3, "102011": 7, "102021": 5.4, "102101": 6.5, "102111": 5.8, "102121": 2.6,
+	"102201": 5.3, "102211": 2.1, "102221": 1.3, "110000": 9.5, "110001": 9, "110010": 8.8,
+	"110011": 7.6, "110020": 7.6, "110021": 7, "110100": 9, "110101": 7.7, "110110": 7.5,
+	"110111": 6.2, "110120": 6.1, "110121": 5.3, "110200": 7.7, "110201": 6.6, "110210": 6.8,
+	"110211": 5.9, "110220": 5.2, "110221": 3, "111000": 8.9, "111001": 7.8, "111010": 7.6,
+	"111011": 6.7, "111020": 6.2, "111021": 5.8, "111100": 7.4, "111101": 5.9, "111110": 5.7,
+	"111111": 5.7, "111120": 4.7, "111121": 2.3, "111200": 6.1, "111201": 5.2, "111210": 5.7,
+	"111211": 2.9, "111220": 2.4, "111221": 1.6, "112001": 7.1, "112011": 5.9, "112021": 3,
+	"112101": 5.8, "112111": 2.6, "112121": 1.5, "112201": 2.3, "112211": 1.3, "112221": 0.6,
+	"200000": 9.3, "200001": 8.7, "200010": 8.6, "200011": 7.2, "200020": 7.5, "200021": 5.8,
+	"200100": 8.6, "200101": 7.4, "200110": 7.4, "200111": 6.1, "200120": 5.6, "200121": 3.4,
+	"200200": 7, "200201": 5.4, "200210": 5.2, "200211": 4, "200220": 4, "200221": 2.2,
+	"201000": 8.5, "201001": 7.5, "201010": 7.4, "201011": 5.5, "201020": 6.2, "201021": 5.1,
+	"201100": 7.2, "201101": 5.7, "201110": 5.5, "201111": 4.1, "201120": 4.6, "201121": 1.9,
+	"201200": 5.3, "201201": 3.6, "201210": 3.4, "201211": 1.9, "201220": 1.9, "201221": 0.8,
+	"202001": 6.4, "202011": 5.1, "202021": 2, "202101": 4.7, "202111": 2.1, "202121": 1.1,
+	"202201": 2.4, "202211": 0.9, "202221": 0.4, "210000": 8.8, "210001": 7.5, "210010": 7.3,
+	"210011": 5.3, "210020": 6, "210021": 5, "210100": 7.3, "210101": 5.5, "210110": 5.9,
+	"210111": 4, "210120": 4.1, "210121": 2, "210200": 5.4, "210201": 4.3, "210210": 4.5,
+	"210211": 2.2, "210220": 2, "210221": 1.1, "211000": 7.5, "211001": 5.5, "211010": 5.8,
+	"211011": 4.5, "211020": 4, "211021": 2.1, "211100": 6.1, "211101": 5.1, "211110": 4.8,
+	"211111": 1.8, "211120": 2, "211121": 0.9, "211200": 4.6, "211201": 1.8, "211210": 1.7,
+	"211211": 0.7, "211220": 0.8, "211221": 0.2, "212001": 5.3, "212011": 2.4, "212021": 1.4,
+	"212101": 2.4, "212111": 1.2, "212121": 0.5, "212201": 1, "212211": 0.3, "212221": 0.1,
+}
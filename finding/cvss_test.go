@@ -0,0 +1,208 @@
+package finding
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseCVSS_Scores(t *testing.T) {
+	// Examples from the CVSS v3.1 and v4.0 specifications and the FIRST
+	// calculators.
+	tests := []struct {
+		vector string
+		want   float64
+	}{
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N", 6.1},
+		{"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:L/I:L/A:N", 6.4},
+		{"CVSS:3.1/AV:N/AC:H/PR:N/UI:R/S:U/C:L/I:N/A:N", 3.1},
+		{"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:H/I:H/A:H", 9.9},
+		{"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H", 8.8},
+		{"CVSS:3.1/AV:L/AC:L/PR:N/UI:R/S:U/C:H/I:H/A:H", 7.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N", 7.5},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8},
+		{"CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:C/C:N/I:H/A:N", 6.8},
+		{"CVSS:3.1/AV:P/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 6.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:L/I:N/A:N", 5.8},
+		{"CVSS:3.1/AV:A/AC:L/PR:N/UI:N/S:C/C:H/I:N/A:H", 9.3},
+		{"CVSS:3.1/AV:L/AC:L/PR:H/UI:N/S:U/C:L/I:L/A:L", 4.2},
+		{"CVSS:3.1/AV:A/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 8.8},
+		{"CVSS:3.1/AV:L/AC:L/PR:H/UI:N/S:C/C:H/I:H/A:H", 8.2},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", 0},
+		{"CVSS:4.0/AV:L/AC:L/AT:N/PR:L/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N", 8.5},
+		{"CVSS:4.0/AV:N/AC:L/AT:P/PR:N/UI:P/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N", 7.7},
+		{"CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:N/VA:N/SC:N/SI:N/SA:N", 8.7},
+		{"CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:N/VI:L/VA:N/SC:N/SI:N/SA:N", 6.9},
+		{"CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:H/SI:H/SA:H", 10},
+		{"CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N", 9.3},
+		{"CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N/E:P", 8.9},
+		{"CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N/E:U", 8.1},
+		{"CVSS:4.0/AV:N/AC:H/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N", 9.2},
+		{"CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:N/VI:N/VA:N/SC:N/SI:N/SA:N", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.vector, func(t *testing.T) {
+			cvss, err := ParseCVSS(tt.vector)
+			if err != nil {
+				t.Fatalf("ParseCVSS() error = %v", err)
+			}
+			if math.Abs(cvss.Score-tt.want) > 0.005 {
+				t.Errorf("ParseCVSS() Score = %.2f, want %.2f", cvss.Score, tt.want)
+			}
+			if cvss.Vector != tt.vector {
+				t.Errorf("ParseCVSS() Vector = %q, want %q", cvss.Vector, tt.vector)
+			}
+			if err := cvss.Validate(); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestParseCVSS_CanonicalOrder(t *testing.T) {
+	cvss, err := ParseCVSS("CVSS:3.1/A:H/I:H/C:H/S:U/UI:N/PR:N/AC:L/AV:N")
+	if err != nil {
+		t.Fatalf("ParseCVSS() error = %v", err)
+	}
+	want := "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+	if cvss.Vector != want || cvss.Version != CVSSVersion31 {
+		t.Errorf("ParseCVSS() = %+v, want version 3.1 and vector %q", cvss, want)
+	}
+}
+
+func TestParseCVSS_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		vector  string
+		wantErr string
+	}{
+		{"empty", "", "must start with"},
+		{"no prefix", "AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "must start with"},
+		{"unsupported version", "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "unsupported CVSS version"},
+		{"unknown metric", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/XX:N", "unknown CVSS 3.1 metric"},
+		{"v4 metric in v3.1", "CVSS:3.1/AV:N/AC:L/AT:N/PR:N/UI:N/S:U/C:H/I:H/A:H", "unknown CVSS 3.1 metric"},
+		{"invalid value", "CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "invalid value"},
+		{"malformed metric", "CVSS:3.1/AV/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "invalid CVSS metric"},
+		{"repeated metric", "CVSS:3.1/AV:N/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "repeated"},
+		{"missing base metric", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/C:H/I:H/A:H", "missing mandatory CVSS 3.1 metric S"},
+		{"missing v4 base metric", "CVSS:4.0/AV:N/AC:L/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N", "missing mandatory CVSS 4.0 metric AT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCVSS(tt.vector)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseCVSS() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCVSS_Validate(t *testing.T) {
+	vector := "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+	tests := []struct {
+		name    string
+		cvss    CVSS
+		wantErr bool
+	}{
+		{"consistent", CVSS{Version: CVSSVersion31, Vector: vector, Score: 9.8}, false},
+		{"score mismatch", CVSS{Version: CVSSVersion31, Vector: vector, Score: 5.0}, true},
+		{"version mismatch", CVSS{Version: CVSSVersion40, Vector: vector, Score: 9.8}, true},
+		{"invalid vector", CVSS{Version: CVSSVersion31, Vector: "CVSS:3.1/AV:N", Score: 9.8}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cvss.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSeverityFromScore(t *testing.T) {
+	tests := []struct {
+		score float64
+		want  Severity
+	}{
+		{0, SeverityInfo},
+		{0.1, SeverityLow},
+		{3.9, SeverityLow},
+		{4.0, SeverityMedium},
+		{6.9, SeverityMedium},
+		{7.0, SeverityHigh},
+		{8.9, SeverityHigh},
+		{9.0, SeverityCritical},
+		{10, SeverityCritical},
+	}
+
+	for _, tt := range tests {
+		if got := SeverityFromScore(tt.score); got != tt.want {
+			t.Errorf("SeverityFromScore(%v) = %v, want %v", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestFinding_SetCVSS(t *testing.T) {
+	f := NewFinding("mission-1", "agent", "title", "desc", CategoryDataExtraction, SeverityLow)
+	cvss, err := ParseCVSS("CVSS:4.0/AV:N/AC:L/AT:P/PR:N/UI:P/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N")
+	if err != nil {
+		t.Fatalf("ParseCVSS() error = %v", err)
+	}
+
+	if err := f.SetCVSS(cvss); err != nil {
+		t.Fatalf("SetCVSS() error = %v", err)
+	}
+	if f.Severity != SeverityHigh {
+		t.Errorf("Severity = %v, want high", f.Severity)
+	}
+	if f.CVSSScore == nil || *f.CVSSScore != 7.7 {
+		t.Errorf("CVSSScore = %v, want 7.7", f.CVSSScore)
+	}
+	if f.RiskScore != SeverityHigh.Weight()*f.Confidence {
+		t.Errorf("RiskScore = %v, want it recalculated for high severity", f.RiskScore)
+	}
+	if err := f.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	if err := f.SetCVSS(&CVSS{Version: CVSSVersion31, Vector: "CVSS:3.1/AV:N", Score: 9.8}); err == nil {
+		t.Error("SetCVSS() expected error for an invalid vector")
+	}
+	if f.CVSS != cvss {
+		t.Error("SetCVSS() replaced the CVSS despite the error")
+	}
+
+	f.CVSS = &CVSS{Version: CVSSVersion40, Vector: cvss.Vector, Score: 1.0}
+	if err := f.Validate(); err == nil || !strings.Contains(err.Error(), "invalid CVSS") {
+		t.Errorf("Validate() error = %v, want invalid CVSS", err)
+	}
+}
+
+func TestFinding_CVSSJSON(t *testing.T) {
+	f := NewFinding("mission-1", "agent", "title", "desc", CategoryDataExtraction, SeverityLow)
+	cvss, err := ParseCVSS("CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N")
+	if err != nil {
+		t.Fatalf("ParseCVSS() error = %v", err)
+	}
+	if err := f.SetCVSS(cvss); err != nil {
+		t.Fatalf("SetCVSS() error = %v", err)
+	}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var back Finding
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if back.CVSS == nil || *back.CVSS != *cvss {
+		t.Errorf("round-tripped CVSS = %+v, want %+v", back.CVSS, cvss)
+	}
+	if err := back.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
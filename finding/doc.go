@@ -37,6 +37,50 @@
 //   - Payloads
 //   - Conversation transcripts
 //
+// Large evidence can be stored by reference. Once SetEvidenceStore is
+// called, AddEvidence moves content larger than the threshold to the
+// EvidenceStore and keeps its reference in Evidence.ExternalRef. JSON
+// exports carry the reference; ExportHTML includes the content when
+// HTMLOptions.EvidenceStore is set and shows the reference otherwise:
+//
+//	store, err := finding.NewFileEvidenceStore("evidence")
+//	finding.SetEvidenceStore(store, 64*1024)
+//
+//	err = finding.ExportHTML(w, findings, finding.HTMLOptions{EvidenceStore: store})
+//
+// # Remediation
+//
+// A Remediation records how to fix a finding: a summary, ordered steps,
+// references, and an effort estimate. Exporters render it with
+// Remediation.String so it reads the same in every format. Validate can
+// enforce that findings at or above a severity carry one:
+//
+//	f.SetRemediation(finding.NewRemediation("Use parameterized queries",
+//		"Replace string concatenation in the login handler",
+//		"Add a regression test with a quote in the username"))
+//
+//	err := f.Validate(finding.RequireRemediationAtOrAbove(finding.SeverityHigh))
+//
+// # CVSS
+//
+// ParseCVSS validates a CVSS v3.1 or v4.0 vector string and computes its
+// score. SetCVSS stores it on a finding and derives Severity from the score
+// with SeverityFromScore, so the two cannot disagree. Exporters include the
+// vector and score:
+//
+//	cvss, err := finding.ParseCVSS("CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N")
+//	err = f.SetCVSS(cvss) // cvss.Score is 9.3, f.Severity is critical
+//
+// # Relations
+//
+// Relations link a finding to other findings it duplicates, relates to, or
+// is superseded by, so reports can group them. RelationsToGraph mirrors them
+// into the knowledge graph as SIMILAR_TO and SUPERSEDES relationships and
+// rejects supersession cycles:
+//
+//	f.AddDuplicateOf(original.ID, "same login form")
+//	rels, err := finding.RelationsToGraph(findings)
+//
 // # Export and Filtering
 //
 // Findings can be exported in multiple formats (JSON, SARIF, CSV, HTML)
@@ -62,4 +106,12 @@
 //	if err := finding.Validate(); err != nil {
 //		log.Fatal(err)
 //	}
+//
+// Summarize counts findings by severity, category, and status. ExportHTML
+// builds a single-file mission report from findings: the summary with
+// inline SVG charts, a table of contents by category, CSS-only severity
+// filters, and a collapsible section per finding. The embedded template can
+// be replaced with one parsed by ParseHTMLTemplate:
+//
+//	err := finding.ExportHTML(w, findings, finding.HTMLOptions{Title: "Mission 42"})
 package finding
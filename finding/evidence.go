@@ -13,9 +13,17 @@ type Evidence struct {
 	// Title is a brief description of the evidence.
 	Title string `json:"title"`
 
-	// Content contains the actual evidence data.
+	// Content contains the actual evidence data. It is empty when the
+	// content is stored by reference in ExternalRef.
 	Content string `json:"content"`
 
+	// ExternalRef references the evidence content in an EvidenceStore,
+	// such as a URI or blob ID, when it is not stored inline.
+	ExternalRef string `json:"external_ref,omitempty"`
+
+	// Size is the length in bytes of the content stored by reference.
+	Size int64 `json:"size,omitempty"`
+
 	// Timestamp indicates when the evidence was collected.
 	Timestamp time.Time `json:"timestamp"`
 
@@ -94,8 +102,8 @@ func (e *Evidence) Validate() error {
 	if e.Title == "" {
 		return fmt.Errorf("evidence title is required")
 	}
-	if e.Content == "" {
-		return fmt.Errorf("evidence content is required")
+	if e.Content == "" && e.ExternalRef == "" {
+		return fmt.Errorf("evidence content or external reference is required")
 	}
 	if e.Timestamp.IsZero() {
 		return fmt.Errorf("evidence timestamp is required")
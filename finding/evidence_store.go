@@ -0,0 +1,168 @@
+package finding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultEvidenceThreshold is the content size, in bytes, above which
+// AddEvidence moves evidence to the evidence store when SetEvidenceStore is
+// given no threshold.
+const DefaultEvidenceThreshold = 256 * 1024
+
+// ErrEvidenceNotFound indicates an evidence store has no content for a
+// reference.
+var ErrEvidenceNotFound = errors.New("evidence not found")
+
+// EvidenceStore holds evidence content outside of findings, so that large
+// captures such as full HTTP responses do not bloat every serialized
+// finding. Implementations must be safe for concurrent use.
+type EvidenceStore interface {
+	// Put stores content and returns a reference to it, such as a URI or
+	// blob ID, for Evidence.ExternalRef.
+	Put(ctx context.Context, content []byte) (string, error)
+
+	// Get returns the content stored under ref. It returns an error
+	// wrapping ErrEvidenceNotFound if there is none.
+	Get(ctx context.Context, ref string) ([]byte, error)
+}
+
+var (
+	evidenceStoreMu        sync.RWMutex
+	evidenceStore          EvidenceStore
+	evidenceStoreThreshold int
+)
+
+// SetEvidenceStore sets the store AddEvidence moves evidence content to
+// when it is larger than threshold bytes. A threshold of 0 or less means
+// DefaultEvidenceThreshold. Pass a nil store to keep all evidence inline.
+//
+// Example:
+//
+//	store, err := finding.NewFileEvidenceStore("/var/lib/gibson/evidence")
+//	if err != nil {
+//	    return err
+//	}
+//	finding.SetEvidenceStore(store, 0)
+func SetEvidenceStore(store EvidenceStore, threshold int) {
+	if threshold <= 0 {
+		threshold = DefaultEvidenceThreshold
+	}
+	evidenceStoreMu.Lock()
+	defer evidenceStoreMu.Unlock()
+	evidenceStore = store
+	evidenceStoreThreshold = threshold
+}
+
+// DefaultEvidenceStore returns the store set with SetEvidenceStore and its
+// threshold, or nil.
+func DefaultEvidenceStore() (EvidenceStore, int) {
+	evidenceStoreMu.RLock()
+	defer evidenceStoreMu.RUnlock()
+	return evidenceStore, evidenceStoreThreshold
+}
+
+// IsExternal returns true if the evidence content is stored by reference.
+func (e *Evidence) IsExternal() bool {
+	return e.ExternalRef != ""
+}
+
+// Externalize moves the evidence content to store, leaving ExternalRef and
+// Size in its place. Evidence already stored by reference is unchanged.
+func (e *Evidence) Externalize(ctx context.Context, store EvidenceStore) error {
+	if e.IsExternal() {
+		return nil
+	}
+	ref, err := store.Put(ctx, []byte(e.Content))
+	if err != nil {
+		return fmt.Errorf("failed to store evidence %q: %w", e.Title, err)
+	}
+	e.ExternalRef = ref
+	e.Size = int64(len(e.Content))
+	e.Content = ""
+	return nil
+}
+
+// ResolveContent returns the evidence content, reading it from store if it
+// is stored by reference.
+func (e *Evidence) ResolveContent(ctx context.Context, store EvidenceStore) (string, error) {
+	if !e.IsExternal() {
+		return e.Content, nil
+	}
+	content, err := store.Get(ctx, e.ExternalRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve evidence %q: %w", e.Title, err)
+	}
+	return string(content), nil
+}
+
+// FileEvidenceStore is an EvidenceStore that keeps each piece of content in
+// a file of a directory, named by its SHA-256 hash, so identical content is
+// stored once. References are file:// URIs.
+type FileEvidenceStore struct {
+	dir string
+}
+
+// NewFileEvidenceStore creates a FileEvidenceStore in dir, creating the
+// directory if needed.
+func NewFileEvidenceStore(dir string) (*FileEvidenceStore, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid evidence directory %q: %w", dir, err)
+	}
+	if err := os.MkdirAll(abs, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create evidence directory: %w", err)
+	}
+	return &FileEvidenceStore{dir: abs}, nil
+}
+
+// Put writes content to the store and returns its file:// URI.
+func (s *FileEvidenceStore) Put(ctx context.Context, content []byte) (string, error) {
+	sum := sha256.Sum256(content)
+	path := filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+
+	if _, err := os.Stat(path); err != nil {
+		tmp, err := os.CreateTemp(s.dir, ".evidence-*")
+		if err != nil {
+			return "", err
+		}
+		_, err = tmp.Write(content)
+		if closeErr := tmp.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(tmp.Name(), path)
+		}
+		if err != nil {
+			os.Remove(tmp.Name())
+			return "", err
+		}
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String(), nil
+}
+
+// Get reads the content referenced by a file:// URI returned by Put.
+func (s *FileEvidenceStore) Get(ctx context.Context, ref string) ([]byte, error) {
+	u, err := url.Parse(ref)
+	if err != nil || u.Scheme != "file" {
+		return nil, fmt.Errorf("%w: %q is not a file evidence reference", ErrEvidenceNotFound, ref)
+	}
+	path := filepath.FromSlash(u.Path)
+	if filepath.Dir(path) != s.dir || strings.HasPrefix(filepath.Base(path), ".") {
+		return nil, fmt.Errorf("%w: %q is outside the evidence directory", ErrEvidenceNotFound, ref)
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrEvidenceNotFound, ref)
+	}
+	return content, err
+}
@@ -0,0 +1,164 @@
+package finding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// memoryEvidenceStore is an in-memory EvidenceStore for tests.
+type memoryEvidenceStore struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+	err   error
+}
+
+func newMemoryEvidenceStore() *memoryEvidenceStore {
+	return &memoryEvidenceStore{blobs: make(map[string][]byte)}
+}
+
+func (s *memoryEvidenceStore) Put(ctx context.Context, content []byte) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return "", s.err
+	}
+	ref := fmt.Sprintf("blob:%d", len(s.blobs)+1)
+	s.blobs[ref] = bytes.Clone(content)
+	return ref, nil
+}
+
+func (s *memoryEvidenceStore) Get(ctx context.Context, ref string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	content, ok := s.blobs[ref]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEvidenceNotFound, ref)
+	}
+	return content, nil
+}
+
+func TestFinding_AddEvidence_ExternalStore(t *testing.T) {
+	store := newMemoryEvidenceStore()
+	SetEvidenceStore(store, 16)
+	t.Cleanup(func() { SetEvidenceStore(nil, 0) })
+
+	large := strings.Repeat("A", 17)
+	f := NewFinding("m-1", "agent", "Leak", "desc", CategoryDataExtraction, SeverityHigh)
+	f.AddEvidence(*NewEvidence(EvidenceHTTPResponse, "small", "HTTP/1.1 200 OK"))
+	f.AddEvidence(*NewEvidence(EvidenceHTTPResponse, "large", large))
+
+	if f.Evidence[0].IsExternal() || f.Evidence[0].Content != "HTTP/1.1 200 OK" {
+		t.Errorf("small evidence = %+v, want inline content", f.Evidence[0])
+	}
+	e := f.Evidence[1]
+	if e.ExternalRef != "blob:1" || e.Content != "" || e.Size != 17 {
+		t.Fatalf("large evidence = %+v, want reference blob:1 of size 17", e)
+	}
+	if err := e.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	content, err := e.ResolveContent(context.Background(), store)
+	if err != nil || content != large {
+		t.Errorf("ResolveContent() = %q, %v, want %q", content, err, large)
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"external_ref":"blob:1","size":17`) {
+		t.Errorf("JSON = %s, want external_ref and size", data)
+	}
+
+	store.err = errors.New("store unavailable")
+	f.AddEvidence(*NewEvidence(EvidenceLog, "kept", large))
+	if got := f.Evidence[2]; got.IsExternal() || got.Content != large {
+		t.Errorf("evidence = %+v, want inline content when the store fails", got)
+	}
+}
+
+func TestSetEvidenceStore_DefaultThreshold(t *testing.T) {
+	SetEvidenceStore(newMemoryEvidenceStore(), 0)
+	t.Cleanup(func() { SetEvidenceStore(nil, 0) })
+
+	if _, threshold := DefaultEvidenceStore(); threshold != DefaultEvidenceThreshold {
+		t.Errorf("threshold = %d, want %d", threshold, DefaultEvidenceThreshold)
+	}
+}
+
+func TestFileEvidenceStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileEvidenceStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileEvidenceStore() error = %v", err)
+	}
+
+	ref, err := store.Put(ctx, []byte("captured response"))
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if !strings.HasPrefix(ref, "file://") {
+		t.Errorf("ref = %q, want a file:// URI", ref)
+	}
+	if again, _ := store.Put(ctx, []byte("captured response")); again != ref {
+		t.Errorf("Put() of identical content = %q, want %q", again, ref)
+	}
+
+	content, err := store.Get(ctx, ref)
+	if err != nil || string(content) != "captured response" {
+		t.Errorf("Get() = %q, %v", content, err)
+	}
+
+	for _, bad := range []string{"blob:1", "file:///etc/passwd", ref + "0"} {
+		if _, err := store.Get(ctx, bad); !errors.Is(err, ErrEvidenceNotFound) {
+			t.Errorf("Get(%q) error = %v, want ErrEvidenceNotFound", bad, err)
+		}
+	}
+}
+
+func TestExportHTML_ExternalEvidence(t *testing.T) {
+	store := newMemoryEvidenceStore()
+	f := reportFindings()[0]
+	f.Evidence = []Evidence{
+		{Type: EvidenceHTTPResponse, Title: "Full response", Content: "<html>secret page</html>"},
+		{Type: EvidenceScreenshot, Title: "Screen", ExternalRef: "https://evidence.example/shots/2.png", Size: 2048},
+	}
+	if err := f.Evidence[0].Externalize(context.Background(), store); err != nil {
+		t.Fatalf("Externalize() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ExportHTML(&buf, []Finding{f}, HTMLOptions{}); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+	out := buf.String()
+	checkWellFormed(t, out)
+	for _, want := range []string{"<code>blob:1</code> (24 bytes)", `<a href="https://evidence.example/shots/2.png">`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report without a store is missing %q", want)
+		}
+	}
+
+	store.blobs["https://evidence.example/shots/2.png"] = []byte("PNG")
+	buf.Reset()
+	if err := ExportHTML(&buf, []Finding{f}, HTMLOptions{EvidenceStore: store}); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "&lt;html&gt;secret page&lt;/html&gt;") || strings.Contains(out, "blob:1") {
+		t.Error("report with a store does not include the resolved content")
+	}
+	if !f.Evidence[0].IsExternal() {
+		t.Error("ExportHTML modified the finding's evidence")
+	}
+
+	delete(store.blobs, "blob:1")
+	if err := ExportHTML(&buf, []Finding{f}, HTMLOptions{EvidenceStore: store}); !errors.Is(err, ErrEvidenceNotFound) {
+		t.Errorf("ExportHTML() error = %v, want ErrEvidenceNotFound", err)
+	}
+}
@@ -1,6 +1,7 @@
 package finding
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -54,11 +55,15 @@ type Finding struct {
 	// CVSSScore is the Common Vulnerability Scoring System score (0.0 to 10.0).
 	CVSSScore *float64 `json:"cvss_score,omitempty"`
 
+	// CVSS is the CVSS vector the score was computed from. Set it with
+	// SetCVSS to keep CVSSScore and Severity consistent with it.
+	CVSS *CVSS `json:"cvss,omitempty"`
+
 	// RiskScore is a calculated risk score based on severity, confidence, and other factors.
 	RiskScore float64 `json:"risk_score"`
 
 	// Remediation provides guidance on fixing or mitigating the issue.
-	Remediation string `json:"remediation,omitempty"`
+	Remediation *Remediation `json:"remediation,omitempty"`
 
 	// References contains links to relevant documentation or resources.
 	References []string `json:"references,omitempty"`
@@ -75,6 +80,10 @@ type Finding struct {
 	// Status indicates the current state of the finding.
 	Status Status `json:"status"`
 
+	// Relations links the finding to duplicate, related, or superseding
+	// findings.
+	Relations []FindingRelation `json:"relations,omitempty"`
+
 	// CreatedAt is the timestamp when the finding was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -156,8 +165,34 @@ func NewFindingWithID(id, missionID, agentName, title, description string, categ
 	}
 }
 
+// ValidateOption configures optional checks in Finding.Validate.
+type ValidateOption func(*validateOptions)
+
+// validateOptions holds the optional checks enabled for Validate.
+type validateOptions struct {
+	remediationSeverity Severity
+}
+
+// RequireRemediationAtOrAbove makes Validate reject findings of the given
+// severity or higher that have no remediation, to enforce policies such as
+// "every high finding has a fix":
+//
+//	err := f.Validate(finding.RequireRemediationAtOrAbove(finding.SeverityHigh))
+func RequireRemediationAtOrAbove(severity Severity) ValidateOption {
+	return func(o *validateOptions) {
+		o.remediationSeverity = severity
+	}
+}
+
 // Validate checks if the finding has all required fields and valid values.
-func (f *Finding) Validate() error {
+// A remediation, when present, is always validated; options add stricter
+// policy checks.
+func (f *Finding) Validate(opts ...ValidateOption) error {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	if f.ID == "" {
 		return fmt.Errorf("finding ID is required")
 	}
@@ -188,6 +223,11 @@ func (f *Finding) Validate() error {
 	if f.CVSSScore != nil && (*f.CVSSScore < 0.0 || *f.CVSSScore > 10.0) {
 		return fmt.Errorf("CVSS score must be between 0.0 and 10.0, got %f", *f.CVSSScore)
 	}
+	if f.CVSS != nil {
+		if err := f.CVSS.Validate(); err != nil {
+			return fmt.Errorf("invalid CVSS: %w", err)
+		}
+	}
 	if f.CreatedAt.IsZero() {
 		return fmt.Errorf("created_at timestamp is required")
 	}
@@ -221,11 +261,31 @@ func (f *Finding) Validate() error {
 		}
 	}
 
+	// Validate relations
+	if err := f.validateRelations(); err != nil {
+		return err
+	}
+
+	// Validate remediation
+	if f.Remediation != nil {
+		if err := f.Remediation.Validate(); err != nil {
+			return fmt.Errorf("invalid remediation: %w", err)
+		}
+	} else if o.remediationSeverity != "" && CompareSeverity(f.Severity, o.remediationSeverity) >= 0 {
+		return fmt.Errorf("remediation is required for %s severity findings", f.Severity)
+	}
+
 	return nil
 }
 
 // AddEvidence adds a piece of evidence to the finding and updates the timestamp.
+// If an evidence store is set with SetEvidenceStore and the content is larger
+// than its threshold, the content is moved to the store and the evidence keeps
+// a reference to it. If the store fails, the content stays inline.
 func (f *Finding) AddEvidence(evidence Evidence) {
+	if store, threshold := DefaultEvidenceStore(); store != nil && len(evidence.Content) > threshold {
+		_ = evidence.Externalize(context.Background(), store)
+	}
 	f.Evidence = append(f.Evidence, evidence)
 	f.UpdatedAt = time.Now()
 }
@@ -280,6 +340,38 @@ func (f *Finding) SetMitreAtlas(mapping *MitreMapping) {
 	f.UpdatedAt = time.Now()
 }
 
+// SetRemediation validates and sets the remediation guidance.
+func (f *Finding) SetRemediation(remediation *Remediation) error {
+	if err := remediation.Validate(); err != nil {
+		return err
+	}
+	f.Remediation = remediation
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetCVSS validates and sets the CVSS vector. The CVSS score and the
+// severity are derived from it, and the risk score is recalculated.
+//
+// Example:
+//
+//	cvss, err := finding.ParseCVSS("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+//	if err == nil {
+//	    err = f.SetCVSS(cvss) // f.Severity is now critical
+//	}
+func (f *Finding) SetCVSS(cvss *CVSS) error {
+	if err := cvss.Validate(); err != nil {
+		return err
+	}
+	score := cvss.Score
+	f.CVSS = cvss
+	f.CVSSScore = &score
+	f.Severity = cvss.Severity()
+	f.RiskScore = calculateRiskScore(f.Severity, f.Confidence)
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
 // calculateRiskScore computes a risk score based on severity and confidence.
 // Formula: severity_weight * confidence
 func calculateRiskScore(severity Severity, confidence float64) float64 {
@@ -0,0 +1,514 @@
+package finding
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultHTMLTitle is the report title used when HTMLOptions.Title is empty.
+const defaultHTMLTitle = "Security Findings Report"
+
+// Timeline chart geometry, in SVG user units.
+const (
+	timelineWidth     = 600.0
+	timelineBaseline  = 140.0
+	timelineMaxHeight = 120.0
+	timelineMaxBars   = 30
+	timelineMaxLabels = 6
+)
+
+//go:embed templates/report.html.tmpl
+var templateFS embed.FS
+
+// HTMLOptions configures ExportHTML.
+type HTMLOptions struct {
+	// Title is the report title. Defaults to "Security Findings Report".
+	Title string
+
+	// GeneratedAt is the time shown as the report's generation time.
+	// Defaults to the current time.
+	GeneratedAt time.Time
+
+	// Template replaces the embedded report template. It is executed with
+	// an *HTMLReport; parse it with ParseHTMLTemplate so the report's
+	// helper functions are available.
+	Template *template.Template
+
+	// EvidenceStore resolves evidence stored by reference, so the report
+	// includes its content. Without it, the report shows the reference,
+	// as a link if it is an http(s) URL.
+	EvidenceStore EvidenceStore
+}
+
+// HTMLReport is the data an HTML report template is executed with.
+type HTMLReport struct {
+	// Title is the report title.
+	Title string
+
+	// GeneratedAt is the report's generation time.
+	GeneratedAt time.Time
+
+	// Summary aggregates the findings; see Summarize.
+	Summary Summary
+
+	// Severities holds the severities present, from critical to info, with
+	// their share of the findings and donut chart geometry.
+	Severities []SeverityShare
+
+	// Timeline buckets the findings by creation time for the bar chart.
+	Timeline []TimelineBucket
+
+	// Categories groups the findings by category, in AllCategories order
+	// followed by other categories alphabetically. Findings within a
+	// category are ordered by severity, then risk score, then title.
+	Categories []CategoryGroup
+}
+
+// SeverityShare is a severity's share of the findings in an HTML report.
+type SeverityShare struct {
+	Severity Severity
+	Count    int
+
+	// Percent is the share of findings, from 0 to 100. It is also the arc
+	// length in a donut chart of circumference 100.
+	Percent float64
+
+	// Gap is the rest of the donut's circumference, 100 - Percent.
+	Gap float64
+
+	// Offset is the stroke-dashoffset that starts the arc where the
+	// previous severity's arc ends, beginning at the top of the donut.
+	Offset float64
+}
+
+// TimelineBucket is one bar of an HTML report's findings-over-time chart.
+// Coordinates are in a 600x170 viewBox with the baseline at y=140.
+type TimelineBucket struct {
+	// Start is the first day covered by the bucket.
+	Start time.Time
+
+	// Label is Start formatted as a date.
+	Label string
+
+	// Count is the number of findings created in the bucket.
+	Count int
+
+	// X, Y, Width, and Height position the bar.
+	X, Y, Width, Height float64
+
+	// LabelX is the horizontal center of the bar.
+	LabelX float64
+
+	// ShowLabel is set on the buckets whose label is drawn on the axis.
+	ShowLabel bool
+}
+
+// CategoryGroup is the findings of one category in an HTML report.
+type CategoryGroup struct {
+	Category Category
+
+	// Anchor is the element ID of the category's section.
+	Anchor string
+
+	Findings []ReportFinding
+}
+
+// ReportFinding is a finding in an HTML report.
+type ReportFinding struct {
+	Finding
+
+	// Anchor is the element ID of the finding's section.
+	Anchor string
+}
+
+// ExportHTML writes findings as a self-contained HTML report: a summary
+// with a severity donut and a findings-over-time bar chart drawn in inline
+// SVG, a table of contents grouped by category, and a collapsible section
+// per finding with its description (a Markdown subset), reproduction steps,
+// MITRE mappings, evidence, remediation, and references. Severity filters
+// work without JavaScript, and the report loads no external resources.
+//
+// All finding content is escaped, so it cannot inject markup or scripts.
+// Evidence stored by reference is resolved through opts.EvidenceStore when
+// it is set; findings are not modified.
+//
+// Example:
+//
+//	f, err := os.Create("report.html")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	err = finding.ExportHTML(f, findings, finding.HTMLOptions{Title: "Mission 42"})
+func ExportHTML(w io.Writer, findings []Finding, opts HTMLOptions) error {
+	tmpl := opts.Template
+	if tmpl == nil {
+		var err error
+		if tmpl, err = defaultHTMLTemplate(); err != nil {
+			return err
+		}
+	}
+
+	if opts.EvidenceStore != nil {
+		var err error
+		if findings, err = resolveEvidence(context.Background(), findings, opts.EvidenceStore); err != nil {
+			return err
+		}
+	}
+
+	if err := tmpl.Execute(w, newHTMLReport(findings, opts)); err != nil {
+		return fmt.Errorf("failed to render HTML report: %w", err)
+	}
+	return nil
+}
+
+// ParseHTMLTemplate parses text as an HTML report template for
+// HTMLOptions.Template. Besides the standard functions, templates may use:
+//
+//	markdown    renders a Markdown subset (paragraphs, headings, lists,
+//	            code, emphasis, and http(s) links) as HTML
+//	linkURL     returns its argument if it is an http(s) URL, else ""
+//	formatTime  formats a time as "2006-01-02 15:04 UTC"
+//	percent     formats a 0-1 fraction as a percentage
+//	capitalize  upper-cases the first letter of a string
+//	join        joins a []string with a separator
+//	deref       dereferences a *float64 such as Finding.CVSSScore
+func ParseHTMLTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("report").Funcs(htmlFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML report template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// resolveEvidence returns a copy of findings whose evidence stored by
+// reference has its content read from store.
+func resolveEvidence(ctx context.Context, findings []Finding, store EvidenceStore) ([]Finding, error) {
+	resolved := slices.Clone(findings)
+	for i := range resolved {
+		f := &resolved[i]
+		if !slices.ContainsFunc(f.Evidence, func(e Evidence) bool { return e.IsExternal() }) {
+			continue
+		}
+		f.Evidence = slices.Clone(f.Evidence)
+		for j := range f.Evidence {
+			e := &f.Evidence[j]
+			content, err := e.ResolveContent(ctx, store)
+			if err != nil {
+				return nil, err
+			}
+			e.Content, e.ExternalRef, e.Size = content, "", 0
+		}
+	}
+	return resolved, nil
+}
+
+// defaultHTMLTemplate parses the embedded report template once.
+var defaultHTMLTemplate = sync.OnceValues(func() (*template.Template, error) {
+	text, err := templateFS.ReadFile("templates/report.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HTML report template: %w", err)
+	}
+	return ParseHTMLTemplate(string(text))
+})
+
+// htmlFuncs are the helper functions available to report templates.
+var htmlFuncs = template.FuncMap{
+	"markdown":   renderMarkdown,
+	"linkURL":    linkURL,
+	"formatTime": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 UTC") },
+	"percent":    func(f float64) string { return fmt.Sprintf("%.0f%%", f*100) },
+	"capitalize": capitalize,
+	"join":       strings.Join,
+	"deref":      func(f *float64) float64 { return *f },
+}
+
+// newHTMLReport prepares the template data for findings.
+func newHTMLReport(findings []Finding, opts HTMLOptions) *HTMLReport {
+	report := &HTMLReport{
+		Title:       opts.Title,
+		GeneratedAt: opts.GeneratedAt,
+		Summary:     Summarize(findings),
+	}
+	if report.Title == "" {
+		report.Title = defaultHTMLTitle
+	}
+	if report.GeneratedAt.IsZero() {
+		report.GeneratedAt = time.Now()
+	}
+
+	offset := 25.0 // a quarter turn back, so the first arc starts at the top
+	for _, sev := range AllSeverities() {
+		count := report.Summary.BySeverity[sev]
+		if count == 0 {
+			continue
+		}
+		percent := 100 * float64(count) / float64(report.Summary.Total)
+		report.Severities = append(report.Severities, SeverityShare{
+			Severity: sev,
+			Count:    count,
+			Percent:  percent,
+			Gap:      100 - percent,
+			Offset:   offset,
+		})
+		offset -= percent
+	}
+
+	report.Timeline = timelineBuckets(findings, report.Summary)
+	report.Categories = groupByCategory(findings)
+	return report
+}
+
+// timelineBuckets counts findings per day between the first and last
+// creation time, widening buckets to several days so there are at most
+// timelineMaxBars, and lays out the bars.
+func timelineBuckets(findings []Finding, summary Summary) []TimelineBucket {
+	if summary.FirstSeen.IsZero() {
+		return nil
+	}
+	day := 24 * time.Hour
+	first := summary.FirstSeen.UTC().Truncate(day)
+	days := int(summary.LastSeen.UTC().Truncate(day).Sub(first)/day) + 1
+	width := (days + timelineMaxBars - 1) / timelineMaxBars
+	n := (days + width - 1) / width
+
+	buckets := make([]TimelineBucket, n)
+	for i := range buckets {
+		buckets[i].Start = first.Add(time.Duration(i*width) * day)
+		buckets[i].Label = buckets[i].Start.Format("2006-01-02")
+	}
+	for _, f := range findings {
+		if f.CreatedAt.IsZero() {
+			continue
+		}
+		i := int(f.CreatedAt.UTC().Sub(first)/day) / width
+		buckets[i].Count++
+	}
+
+	maxCount := 0
+	for _, b := range buckets {
+		maxCount = max(maxCount, b.Count)
+	}
+	slot := timelineWidth / float64(n)
+	labelEvery := (n + timelineMaxLabels - 1) / timelineMaxLabels
+	for i := range buckets {
+		b := &buckets[i]
+		b.Width = round2(slot * 0.8)
+		b.X = round2(float64(i)*slot + slot*0.1)
+		b.LabelX = round2(float64(i)*slot + slot/2)
+		b.Height = round2(timelineMaxHeight * float64(b.Count) / float64(maxCount))
+		b.Y = round2(timelineBaseline - b.Height)
+		b.ShowLabel = i%labelEvery == 0 || i == n-1
+	}
+	return buckets
+}
+
+// round2 rounds f to two decimals, keeping SVG output short and stable.
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// groupByCategory groups findings by category and orders each group by
+// severity, risk score, title, and ID.
+func groupByCategory(findings []Finding) []CategoryGroup {
+	byCategory := make(map[Category][]Finding)
+	for _, f := range findings {
+		byCategory[f.Category] = append(byCategory[f.Category], f)
+	}
+
+	order := AllCategories()
+	var others []Category
+	for cat := range byCategory {
+		if !slices.Contains(order, cat) {
+			others = append(others, cat)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+	order = append(order, others...)
+
+	var groups []CategoryGroup
+	n := 0
+	for _, cat := range order {
+		group := byCategory[cat]
+		if len(group) == 0 {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			a, b := group[i], group[j]
+			if c := CompareSeverity(a.Severity, b.Severity); c != 0 {
+				return c > 0
+			}
+			if a.RiskScore != b.RiskScore {
+				return a.RiskScore > b.RiskScore
+			}
+			if a.Title != b.Title {
+				return a.Title < b.Title
+			}
+			return a.ID < b.ID
+		})
+
+		cg := CategoryGroup{Category: cat, Anchor: "category-" + anchorID(string(cat))}
+		for _, f := range group {
+			n++
+			f.Reproduction = slices.Clone(f.Reproduction)
+			sort.SliceStable(f.Reproduction, func(i, j int) bool { return f.Reproduction[i].Order < f.Reproduction[j].Order })
+			cg.Findings = append(cg.Findings, ReportFinding{Finding: f, Anchor: fmt.Sprintf("finding-%d", n)})
+		}
+		groups = append(groups, cg)
+	}
+	return groups
+}
+
+// anchorID replaces characters that are not letters, digits, '-', or '_'
+// so s can be used in an element ID.
+func anchorID(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, s)
+}
+
+// linkURL returns s if it is an absolute http or https URL, so the report
+// renders it as a link, and "" otherwise.
+func linkURL(s string) string {
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, " \t\r\n") {
+		return ""
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return s
+}
+
+// capitalize upper-cases the first letter of s.
+func capitalize(s fmt.Stringer) string {
+	str := s.String()
+	if str == "" {
+		return str
+	}
+	return strings.ToUpper(str[:1]) + str[1:]
+}
+
+// Markdown subset patterns, matched against escaped text.
+var (
+	mdHeading = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	mdBullet  = regexp.MustCompile(`^[-*+]\s+(.*)$`)
+	mdOrdered = regexp.MustCompile(`^\d+[.)]\s+(.*)$`)
+	mdLink    = regexp.MustCompile(`\[([^\[\]]+)\]\((https?://[^\s()*]+)\)`)
+	mdStrong  = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	mdEm      = regexp.MustCompile(`\*([^*\s][^*]*)\*`)
+)
+
+// renderMarkdown renders the Markdown subset used in finding descriptions
+// as HTML: paragraphs, headings (rendered as h4 to h6), bulleted and
+// numbered lists, fenced code blocks, inline code, strong and emphasized
+// text, and http(s) links. Everything else, including raw HTML, is escaped.
+func renderMarkdown(text string) template.HTML {
+	var b strings.Builder
+	var para []string
+	list := ""
+
+	flushPara := func() {
+		if len(para) > 0 {
+			b.WriteString("<p>" + renderInline(strings.Join(para, "\n")) + "</p>\n")
+			para = nil
+		}
+	}
+	closeList := func() {
+		if list != "" {
+			b.WriteString("</" + list + ">\n")
+			list = ""
+		}
+	}
+
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, "```") {
+			flushPara()
+			closeList()
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+			continue
+		}
+		if line == "" {
+			flushPara()
+			closeList()
+			continue
+		}
+		if m := mdHeading.FindStringSubmatch(line); m != nil {
+			flushPara()
+			closeList()
+			level := min(max(len(m[1])+2, 4), 6)
+			fmt.Fprintf(&b, "<h%d>%s</h%d>\n", level, renderInline(m[2]), level)
+			continue
+		}
+
+		kind, item := "", ""
+		if m := mdBullet.FindStringSubmatch(line); m != nil {
+			kind, item = "ul", m[1]
+		} else if m := mdOrdered.FindStringSubmatch(line); m != nil {
+			kind, item = "ol", m[1]
+		}
+		if kind != "" {
+			flushPara()
+			if list != kind {
+				closeList()
+				b.WriteString("<" + kind + ">\n")
+				list = kind
+			}
+			b.WriteString("<li>" + renderInline(item) + "</li>\n")
+			continue
+		}
+
+		closeList()
+		para = append(para, line)
+	}
+	flushPara()
+	closeList()
+	return template.HTML(b.String())
+}
+
+// renderInline escapes s and renders its inline code, links, and emphasis.
+func renderInline(s string) string {
+	parts := strings.Split(s, "`")
+	if len(parts)%2 == 0 {
+		// an unmatched backtick is literal
+		last := len(parts) - 1
+		parts[last-1] += "`" + parts[last]
+		parts = parts[:last]
+	}
+
+	var b strings.Builder
+	for i, part := range parts {
+		escaped := html.EscapeString(part)
+		if i%2 == 1 {
+			b.WriteString("<code>" + escaped + "</code>")
+			continue
+		}
+		escaped = mdLink.ReplaceAllString(escaped, `<a href="$2">$1</a>`)
+		escaped = mdStrong.ReplaceAllString(escaped, "<strong>$1</strong>")
+		escaped = mdEm.ReplaceAllString(escaped, "<em>$1</em>")
+		b.WriteString(escaped)
+	}
+	return b.String()
+}
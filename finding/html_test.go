@@ -0,0 +1,339 @@
+package finding
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// reportFindings returns findings covering every section of the HTML report.
+func reportFindings() []Finding {
+	base := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	cvss := 9.1
+	return []Finding{
+		{
+			ID:          "f-sqli",
+			MissionID:   "mission-1",
+			AgentName:   "agent-sql",
+			Title:       "SQL injection in login form",
+			Description: "The `username` parameter is concatenated into a query.\n\n## Impact\n\nAn attacker can:\n- dump the **users** table\n- bypass *authentication*\n\n```\n' OR 1=1 --\n```\n\nSee [OWASP](https://owasp.org/www-community/attacks/SQL_Injection).",
+			Category:    CategoryDataExtraction,
+			Severity:    SeverityCritical,
+			Confidence:  0.95,
+			CVSSScore:   &cvss,
+			RiskScore:   9.5,
+			MitreAttack: &MitreMapping{Matrix: "enterprise", TacticID: "TA0001", TacticName: "Initial Access", TechniqueID: "T1190", TechniqueName: "Exploit Public-Facing Application"},
+			Evidence: []Evidence{
+				{Type: EvidenceHTTPRequest, Title: "Injected request", Content: "POST /login HTTP/1.1\nHost: app.example\n\nusername=' OR 1=1 --&password=x"},
+				{Type: EvidenceScreenshot, Title: "Admin console", Content: "https://evidence.example/shots/1.png"},
+			},
+			Reproduction: []ReproStep{
+				{Order: 2, Description: "Submit the form", Output: "302 Found"},
+				{Order: 1, Description: "Open the login page", Input: "GET /login"},
+			},
+			Remediation: &Remediation{Summary: "Use parameterized queries", Steps: []string{"Replace string concatenation"}, Effort: EffortLow},
+			References:  []string{"https://cwe.mitre.org/data/definitions/89.html", "CWE-89"},
+			TargetID:    "app.example",
+			Tags:        []string{"sqli", "auth"},
+			Status:      StatusConfirmed,
+			CreatedAt:   base,
+		},
+		{
+			ID:          "f-jailbreak",
+			Title:       "Roleplay jailbreak bypasses refusal",
+			Description: "The model follows instructions framed as fiction.",
+			Category:    CategoryJailbreak,
+			Severity:    SeverityMedium,
+			Confidence:  0.6,
+			RiskScore:   3,
+			MitreAtlas:  &MitreMapping{Matrix: "atlas", TacticID: "AML.TA0005", TacticName: "Execution", TechniqueID: "AML.T0054", TechniqueName: "LLM Jailbreak", SubTechniques: []string{"AML.T0054.000"}},
+			Evidence:    []Evidence{{Type: EvidenceConversation, Title: "Transcript", Content: "user: pretend you are...\nassistant: sure"}},
+			Status:      StatusOpen,
+			CreatedAt:   base.Add(3 * 24 * time.Hour),
+		},
+		{
+			ID:          "f-leak",
+			Title:       "System prompt disclosed",
+			Description: "Asking for the instructions verbatim returns them.",
+			Category:    CategoryDataExtraction,
+			Severity:    SeverityHigh,
+			Confidence:  0.8,
+			RiskScore:   6.4,
+			Status:      StatusOpen,
+			CreatedAt:   base.Add(26 * time.Hour),
+		},
+	}
+}
+
+func TestExportHTML_Golden(t *testing.T) {
+	var buf bytes.Buffer
+	opts := HTMLOptions{Title: "Mission 1", GeneratedAt: time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)}
+	if err := ExportHTML(&buf, reportFindings(), opts); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+
+	golden := filepath.Join("testdata", "report.golden.html")
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("ExportHTML() output differs from %s; run go test -run TestExportHTML_Golden -update and review the diff", golden)
+	}
+}
+
+func TestExportHTML_Structure(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportHTML(&buf, reportFindings(), HTMLOptions{}); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+	out := buf.String()
+
+	ids := checkWellFormed(t, out)
+	for _, id := range []string{"summary", "contents", "severity-chart", "timeline-chart", "filter-all", "filter-critical", "filter-high", "filter-medium", "finding-1", "finding-2", "finding-3", "category-jailbreak", "category-data_extraction"} {
+		if !ids[id] {
+			t.Errorf("report has no element with id %q", id)
+		}
+	}
+	if ids["filter-low"] {
+		t.Error("report has a filter for a severity without findings")
+	}
+
+	for _, want := range []string{
+		"<title>Security Findings Report</title>",
+		"<code>username</code>",
+		"<h4>Impact</h4>",
+		"<li>dump the <strong>users</strong> table</li>",
+		"<em>authentication</em>",
+		"<pre><code>&#39; OR 1=1 --</code></pre>",
+		`<a href="https://owasp.org/www-community/attacks/SQL_Injection">OWASP</a>`,
+		`<a href="https://evidence.example/shots/1.png">`,
+		"<td>TA0001 Initial Access</td>",
+		"AML.T0054 LLM Jailbreak (AML.T0054.000)",
+		"<li>CWE-89</li>",
+		"Effort: low",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report does not contain %q", want)
+		}
+	}
+
+	// Jailbreak precedes data extraction in AllCategories; within data
+	// extraction the critical finding precedes the high one.
+	order := []string{`id="finding-1"`, `id="finding-2"`, `id="finding-3"`}
+	titles := []string{"Roleplay jailbreak bypasses refusal", "SQL injection in login form", "System prompt disclosed"}
+	for i := range order {
+		pos := strings.Index(out, order[i])
+		if pos < 0 || !strings.Contains(out[pos:pos+300], titles[i]) {
+			t.Errorf("%s is not %q", order[i], titles[i])
+		}
+	}
+	if strings.Index(out, "Open the login page") > strings.Index(out, "Submit the form") {
+		t.Error("reproduction steps are not in order")
+	}
+}
+
+func TestExportHTML_CVSSVector(t *testing.T) {
+	f := NewFindingWithID("f-1", "m-1", "agent", "SQL injection", "desc", CategoryDataExtraction, SeverityLow)
+	cvss, err := ParseCVSS("CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N")
+	if err != nil {
+		t.Fatalf("ParseCVSS() error = %v", err)
+	}
+	if err := f.SetCVSS(cvss); err != nil {
+		t.Fatalf("SetCVSS() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ExportHTML(&buf, []Finding{*f}, HTMLOptions{}); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+	want := "<dt>CVSS 4.0</dt><dd>9.3 <code>" + cvss.Vector + "</code></dd>"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("report does not contain %q", want)
+	}
+}
+
+func TestExportHTML_Sanitizes(t *testing.T) {
+	f := Finding{
+		ID:          "f-xss",
+		Title:       `<script>alert("title")</script>`,
+		Description: "<img src=x onerror=alert(1)> [click](javascript:alert(1)) <script>alert(2)</script>",
+		Category:    Category(`"><script>alert(3)</script>`),
+		Severity:    SeverityHigh,
+		Evidence: []Evidence{
+			{Type: EvidencePayload, Title: "payload", Content: "</pre><script>alert(4)</script>"},
+			{Type: EvidencePayload, Title: "link", Content: "javascript:alert(5)"},
+		},
+		References: []string{"javascript:alert(6)", `https://example.com/"onmouseover="alert(7)`},
+		CreatedAt:  time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	var buf bytes.Buffer
+	if err := ExportHTML(&buf, []Finding{f}, HTMLOptions{Title: "<b>Mission</b>"}); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+	out := buf.String()
+
+	checkWellFormed(t, out)
+	for _, bad := range []string{"<script", "<img", "<b>Mission", `href="javascript`, `"onmouseover=`} {
+		if strings.Contains(out, bad) {
+			t.Errorf("report contains unescaped %q", bad)
+		}
+	}
+	if !strings.Contains(out, "&lt;script&gt;alert(&#34;title&#34;)&lt;/script&gt;") {
+		t.Error("escaped title missing from report")
+	}
+}
+
+func TestExportHTML_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportHTML(&buf, nil, HTMLOptions{}); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+	checkWellFormed(t, buf.String())
+	if !strings.Contains(buf.String(), "No findings.") {
+		t.Error("empty report does not say there are no findings")
+	}
+}
+
+func TestExportHTML_CustomTemplate(t *testing.T) {
+	tmpl, err := ParseHTMLTemplate(`{{.Title}}: {{.Summary.Total}}{{range .Categories}} {{.Category}}={{len .Findings}}{{end}} {{markdown "**x**"}}`)
+	if err != nil {
+		t.Fatalf("ParseHTMLTemplate() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ExportHTML(&buf, reportFindings(), HTMLOptions{Title: "T", Template: tmpl}); err != nil {
+		t.Fatalf("ExportHTML() error = %v", err)
+	}
+	want := "T: 3 jailbreak=1 data_extraction=2 <p><strong>x</strong></p>\n"
+	if got := buf.String(); got != want {
+		t.Errorf("ExportHTML() = %q, want %q", got, want)
+	}
+
+	if _, err := ParseHTMLTemplate("{{.Title"); err == nil {
+		t.Error("ParseHTMLTemplate() accepted an invalid template")
+	}
+}
+
+func TestTimelineBuckets(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var findings []Finding
+	for day := 0; day < 90; day += 10 {
+		findings = append(findings, Finding{CreatedAt: start.Add(time.Duration(day) * 24 * time.Hour)})
+	}
+	findings = append(findings, Finding{}) // no creation time
+
+	buckets := timelineBuckets(findings, Summarize(findings))
+	if len(buckets) > timelineMaxBars {
+		t.Fatalf("got %d buckets, want at most %d", len(buckets), timelineMaxBars)
+	}
+	total := 0
+	labels := 0
+	for _, b := range buckets {
+		total += b.Count
+		if b.ShowLabel {
+			labels++
+		}
+		if b.Y+b.Height != timelineBaseline {
+			t.Errorf("bucket %s does not sit on the baseline", b.Label)
+		}
+	}
+	if total != 9 {
+		t.Errorf("buckets hold %d findings, want 9", total)
+	}
+	if labels > timelineMaxLabels+1 {
+		t.Errorf("%d axis labels, want at most %d", labels, timelineMaxLabels+1)
+	}
+	if got := buckets[0].Label; got != "2026-01-01" {
+		t.Errorf("first bucket = %s, want 2026-01-01", got)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"paragraphs", "one\ntwo\n\nthree", "<p>one\ntwo</p>\n<p>three</p>\n"},
+		{"numbered list", "1. a\n2) b", "<ol>\n<li>a</li>\n<li>b</li>\n</ol>\n"},
+		{"unmatched backtick", "a ` b", "<p>a ` b</p>\n"},
+		{"unterminated fence", "```\n<x>", "<pre><code>&lt;x&gt;</code></pre>\n"},
+		{"non-http link", "[a](ftp://x)", "<p>[a](ftp://x)</p>\n"},
+		{"emphasis inside code", "`**a**`", "<p><code>**a**</code></p>\n"},
+		{"loose asterisks", "2 * 3 * 4", "<p>2 * 3 * 4</p>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(renderMarkdown(tt.in)); got != tt.want {
+				t.Errorf("renderMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"https://example.com/a?b=c", "https://example.com/a?b=c"},
+		{" http://example.com ", "http://example.com"},
+		{"javascript:alert(1)", ""},
+		{"https://", ""},
+		{"see https://example.com", ""},
+		{"/relative", ""},
+	}
+	for _, tt := range tests {
+		if got := linkURL(tt.in); got != tt.want {
+			t.Errorf("linkURL(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// checkWellFormed fails the test unless out parses as XML with unique
+// element IDs, and returns the IDs.
+func checkWellFormed(t *testing.T, out string) map[string]bool {
+	t.Helper()
+	out = strings.TrimPrefix(out, "<!DOCTYPE html>")
+	dec := xml.NewDecoder(strings.NewReader(out))
+	ids := make(map[string]bool)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("report is not well-formed: %v", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		for _, attr := range start.Attr {
+			if attr.Name.Local != "id" {
+				continue
+			}
+			if ids[attr.Value] {
+				t.Errorf("duplicate element id %q", attr.Value)
+			}
+			ids[attr.Value] = true
+		}
+	}
+	return ids
+}
@@ -0,0 +1,286 @@
+package finding
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zero-day-ai/sdk/graphrag"
+	"github.com/zero-day-ai/sdk/graphrag/id"
+)
+
+// RelationType describes how a finding relates to another finding.
+type RelationType string
+
+const (
+	// RelationDuplicateOf marks the finding as a duplicate of the target.
+	RelationDuplicateOf RelationType = "duplicate_of"
+
+	// RelationRelatedTo links findings that should be reviewed together,
+	// such as two issues with the same root cause.
+	RelationRelatedTo RelationType = "related_to"
+
+	// RelationSupersededBy marks the finding as replaced by the target,
+	// typically a later, more complete finding.
+	RelationSupersededBy RelationType = "superseded_by"
+)
+
+// Relationship types written to the knowledge graph by RelationsToGraph.
+const (
+	graphRelSimilarTo  = "SIMILAR_TO"
+	graphRelSupersedes = "SUPERSEDES"
+)
+
+// ErrSupersessionCycle is returned by RelationsToGraph when findings
+// supersede each other in a cycle.
+var ErrSupersessionCycle = errors.New("supersession cycle")
+
+// IsValid returns true if the relation type is valid.
+func (r RelationType) IsValid() bool {
+	switch r {
+	case RelationDuplicateOf, RelationRelatedTo, RelationSupersededBy:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the relation type.
+func (r RelationType) String() string {
+	return string(r)
+}
+
+// DisplayName returns a human-readable display name for the relation type.
+func (r RelationType) DisplayName() string {
+	switch r {
+	case RelationDuplicateOf:
+		return "Duplicate of"
+	case RelationRelatedTo:
+		return "Related to"
+	case RelationSupersededBy:
+		return "Superseded by"
+	default:
+		return string(r)
+	}
+}
+
+// AllRelationTypes returns all valid relation types in display order.
+func AllRelationTypes() []RelationType {
+	return []RelationType{
+		RelationDuplicateOf,
+		RelationSupersededBy,
+		RelationRelatedTo,
+	}
+}
+
+// FindingRelation links a finding to another finding.
+type FindingRelation struct {
+	// Type describes the relation.
+	Type RelationType `json:"type"`
+
+	// TargetFindingID is the ID of the related finding.
+	TargetFindingID string `json:"target_finding_id"`
+
+	// Note optionally explains the relation.
+	Note string `json:"note,omitempty"`
+}
+
+// RelationGroup is the set of findings a finding relates to in one way.
+type RelationGroup struct {
+	// Type is the relation shared by the group.
+	Type RelationType `json:"type"`
+
+	// TargetFindingIDs are the related findings, in the order they were added.
+	TargetFindingIDs []string `json:"target_finding_ids"`
+}
+
+// validateRelations checks the relations of f: each must have a valid type
+// and a target other than f itself, and f can be superseded by at most one
+// finding.
+func (f *Finding) validateRelations() error {
+	supersededBy := ""
+	for i, rel := range f.Relations {
+		if !rel.Type.IsValid() {
+			return fmt.Errorf("invalid relation at index %d: invalid type: %s", i, rel.Type)
+		}
+		if rel.TargetFindingID == "" {
+			return fmt.Errorf("invalid relation at index %d: target finding ID is required", i)
+		}
+		if rel.TargetFindingID == f.ID {
+			return fmt.Errorf("invalid relation at index %d: finding cannot be %s itself", i, rel.Type)
+		}
+		if rel.Type == RelationSupersededBy {
+			if supersededBy != "" && supersededBy != rel.TargetFindingID {
+				return fmt.Errorf("invalid relation at index %d: finding is already superseded by %s", i, supersededBy)
+			}
+			supersededBy = rel.TargetFindingID
+		}
+	}
+	return nil
+}
+
+// AddRelation links the finding to another finding and updates the
+// timestamp. Adding a relation that already exists only updates its note.
+func (f *Finding) AddRelation(relType RelationType, targetFindingID, note string) error {
+	rel := FindingRelation{Type: relType, TargetFindingID: targetFindingID, Note: note}
+	for i, existing := range f.Relations {
+		if existing.Type == relType && existing.TargetFindingID == targetFindingID {
+			f.Relations[i].Note = note
+			f.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+
+	f.Relations = append(f.Relations, rel)
+	if err := f.validateRelations(); err != nil {
+		f.Relations = f.Relations[:len(f.Relations)-1]
+		return err
+	}
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// AddDuplicateOf marks the finding as a duplicate of another finding.
+func (f *Finding) AddDuplicateOf(targetFindingID, note string) error {
+	return f.AddRelation(RelationDuplicateOf, targetFindingID, note)
+}
+
+// AddRelatedTo links the finding to a related finding.
+func (f *Finding) AddRelatedTo(targetFindingID, note string) error {
+	return f.AddRelation(RelationRelatedTo, targetFindingID, note)
+}
+
+// AddSupersededBy marks the finding as replaced by another finding.
+func (f *Finding) AddSupersededBy(targetFindingID, note string) error {
+	return f.AddRelation(RelationSupersededBy, targetFindingID, note)
+}
+
+// RelationGroups groups the finding's relations by type, in the order of
+// AllRelationTypes. Types without relations are omitted.
+func (f *Finding) RelationGroups() []RelationGroup {
+	var groups []RelationGroup
+	for _, relType := range AllRelationTypes() {
+		group := RelationGroup{Type: relType}
+		for _, rel := range f.Relations {
+			if rel.Type == relType {
+				group.TargetFindingIDs = append(group.TargetFindingIDs, rel.TargetFindingID)
+			}
+		}
+		if len(group.TargetFindingIDs) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// RelationsToGraph converts the relations of findings into knowledge graph
+// relationships between their finding nodes, so the graph stays in sync
+// with the findings:
+//
+//   - duplicate_of becomes SIMILAR_TO from the duplicate to the original
+//   - related_to becomes a bidirectional SIMILAR_TO
+//   - superseded_by becomes SUPERSEDES from the newer finding to the older one
+//
+// Each relationship carries the original relation type in its "relation"
+// property and the note, if any, in "note". Node IDs are the deterministic
+// IDs of the "finding" node type, using the finding ID as the fingerprint.
+// A target that is not in findings is assumed to belong to the same mission
+// as the finding that references it.
+//
+// RelationsToGraph returns an error wrapping ErrSupersessionCycle if findings
+// supersede each other in a cycle.
+func RelationsToGraph(findings []*Finding) ([]graphrag.Relationship, error) {
+	missions := make(map[string]string, len(findings))
+	for _, f := range findings {
+		missions[f.ID] = f.MissionID
+	}
+
+	for _, f := range findings {
+		if err := f.validateRelations(); err != nil {
+			return nil, fmt.Errorf("finding %s: %w", f.ID, err)
+		}
+	}
+	if err := checkSupersessionCycles(findings); err != nil {
+		return nil, err
+	}
+
+	gen := id.NewGenerator(graphrag.NewDefaultNodeTypeRegistry())
+	nodeID := func(findingID, missionID string) (string, error) {
+		return gen.Generate(graphrag.NodeTypeFinding, map[string]any{
+			"mission_id":  missionID,
+			"fingerprint": findingID,
+		})
+	}
+
+	var rels []graphrag.Relationship
+	for _, f := range findings {
+		if len(f.Relations) == 0 {
+			continue
+		}
+		fromID, err := nodeID(f.ID, f.MissionID)
+		if err != nil {
+			return nil, fmt.Errorf("finding %s: %w", f.ID, err)
+		}
+
+		for _, rel := range f.Relations {
+			targetMission, ok := missions[rel.TargetFindingID]
+			if !ok {
+				targetMission = f.MissionID
+			}
+			toID, err := nodeID(rel.TargetFindingID, targetMission)
+			if err != nil {
+				return nil, fmt.Errorf("finding %s: %w", rel.TargetFindingID, err)
+			}
+
+			var r *graphrag.Relationship
+			switch rel.Type {
+			case RelationDuplicateOf:
+				r = graphrag.NewRelationship(fromID, toID, graphRelSimilarTo)
+			case RelationRelatedTo:
+				r = graphrag.NewRelationship(fromID, toID, graphRelSimilarTo).WithBidirectional(true)
+			case RelationSupersededBy:
+				r = graphrag.NewRelationship(toID, fromID, graphRelSupersedes)
+			}
+			r.WithProperty("relation", string(rel.Type))
+			if rel.Note != "" {
+				r.WithProperty("note", rel.Note)
+			}
+			rels = append(rels, *r)
+		}
+	}
+	return rels, nil
+}
+
+// checkSupersessionCycles follows the superseded_by chain of every finding
+// and reports the first cycle found, e.g. "a -> b -> a".
+func checkSupersessionCycles(findings []*Finding) error {
+	next := make(map[string]string)
+	for _, f := range findings {
+		for _, rel := range f.Relations {
+			if rel.Type == RelationSupersededBy {
+				next[f.ID] = rel.TargetFindingID
+			}
+		}
+	}
+
+	done := make(map[string]bool)
+	for _, f := range findings {
+		var chain []string
+		onChain := make(map[string]bool)
+		for current := f.ID; current != "" && !done[current]; current = next[current] {
+			if onChain[current] {
+				start := slices.Index(chain, current)
+				cycle := append(chain[start:], current)
+				return fmt.Errorf("%w: %s", ErrSupersessionCycle, strings.Join(cycle, " -> "))
+			}
+			onChain[current] = true
+			chain = append(chain, current)
+		}
+		for _, findingID := range chain {
+			done[findingID] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package finding
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zero-day-ai/sdk/graphrag"
+	"github.com/zero-day-ai/sdk/graphrag/id"
+)
+
+func newRelationFinding(findingID string) *Finding {
+	return NewFindingWithID(findingID, "mission-1", "agent", "Title", "desc", CategoryDataExtraction, SeverityHigh)
+}
+
+func TestRelationType_IsValid(t *testing.T) {
+	for _, relType := range AllRelationTypes() {
+		if !relType.IsValid() {
+			t.Errorf("%s.IsValid() = false, want true", relType)
+		}
+	}
+	if RelationType("caused_by").IsValid() {
+		t.Error("unknown relation type should be invalid")
+	}
+}
+
+func TestFinding_AddRelation(t *testing.T) {
+	f := newRelationFinding("f-2")
+
+	if err := f.AddDuplicateOf("f-1", "same endpoint"); err != nil {
+		t.Fatalf("AddDuplicateOf() error = %v", err)
+	}
+	if err := f.AddRelatedTo("f-3", ""); err != nil {
+		t.Fatalf("AddRelatedTo() error = %v", err)
+	}
+	if err := f.AddDuplicateOf("f-1", "same login form"); err != nil {
+		t.Fatalf("AddDuplicateOf() again error = %v", err)
+	}
+
+	want := []FindingRelation{
+		{Type: RelationDuplicateOf, TargetFindingID: "f-1", Note: "same login form"},
+		{Type: RelationRelatedTo, TargetFindingID: "f-3"},
+	}
+	if !reflect.DeepEqual(f.Relations, want) {
+		t.Errorf("Relations = %+v, want %+v", f.Relations, want)
+	}
+
+	tests := []struct {
+		name    string
+		add     func() error
+		wantErr string
+	}{
+		{"self relation", func() error { return f.AddRelatedTo("f-2", "") }, "cannot be related_to itself"},
+		{"empty target", func() error { return f.AddDuplicateOf("", "") }, "target finding ID is required"},
+		{"invalid type", func() error { return f.AddRelation("caused_by", "f-4", "") }, "invalid type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.add()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want error containing %q", err, tt.wantErr)
+			}
+			if len(f.Relations) != 2 {
+				t.Errorf("invalid relation was kept: %+v", f.Relations)
+			}
+		})
+	}
+}
+
+func TestFinding_AddSupersededBy(t *testing.T) {
+	f := newRelationFinding("f-1")
+	if err := f.AddSupersededBy("f-2", ""); err != nil {
+		t.Fatalf("AddSupersededBy() error = %v", err)
+	}
+	if err := f.AddSupersededBy("f-3", ""); err == nil {
+		t.Error("a finding should only be superseded by one finding")
+	}
+}
+
+func TestFinding_ValidateRelations(t *testing.T) {
+	f := newRelationFinding("f-1")
+	f.Relations = []FindingRelation{{Type: RelationDuplicateOf, TargetFindingID: "f-1"}}
+	if err := f.Validate(); err == nil {
+		t.Error("Validate() should reject a finding related to itself")
+	}
+
+	f.Relations = []FindingRelation{{Type: RelationDuplicateOf, TargetFindingID: "f-0"}}
+	if err := f.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestFinding_RelationGroups(t *testing.T) {
+	f := newRelationFinding("f-5")
+	f.Relations = []FindingRelation{
+		{Type: RelationRelatedTo, TargetFindingID: "f-3"},
+		{Type: RelationDuplicateOf, TargetFindingID: "f-1"},
+		{Type: RelationRelatedTo, TargetFindingID: "f-4"},
+	}
+
+	want := []RelationGroup{
+		{Type: RelationDuplicateOf, TargetFindingIDs: []string{"f-1"}},
+		{Type: RelationRelatedTo, TargetFindingIDs: []string{"f-3", "f-4"}},
+	}
+	if got := f.RelationGroups(); !reflect.DeepEqual(got, want) {
+		t.Errorf("RelationGroups() = %+v, want %+v", got, want)
+	}
+	if groups := newRelationFinding("f-6").RelationGroups(); groups != nil {
+		t.Errorf("RelationGroups() without relations = %+v, want nil", groups)
+	}
+}
+
+func TestRelationsToGraph(t *testing.T) {
+	older := newRelationFinding("f-1")
+	newer := newRelationFinding("f-2")
+	dup := newRelationFinding("f-3")
+	if err := older.AddSupersededBy("f-2", "retested with auth"); err != nil {
+		t.Fatal(err)
+	}
+	if err := dup.AddDuplicateOf("f-2", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := dup.AddRelatedTo("f-other", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	rels, err := RelationsToGraph([]*Finding{older, newer, dup})
+	if err != nil {
+		t.Fatalf("RelationsToGraph() error = %v", err)
+	}
+
+	gen := id.NewGenerator(graphrag.NewDefaultNodeTypeRegistry())
+	nodeID := func(findingID string) string {
+		nid, err := gen.Generate("finding", map[string]any{"mission_id": "mission-1", "fingerprint": findingID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return nid
+	}
+
+	want := []graphrag.Relationship{
+		{FromID: nodeID("f-2"), ToID: nodeID("f-1"), Type: "SUPERSEDES", Properties: map[string]any{"relation": "superseded_by", "note": "retested with auth"}},
+		{FromID: nodeID("f-3"), ToID: nodeID("f-2"), Type: "SIMILAR_TO", Properties: map[string]any{"relation": "duplicate_of"}},
+		{FromID: nodeID("f-3"), ToID: nodeID("f-other"), Type: "SIMILAR_TO", Properties: map[string]any{"relation": "related_to"}, Bidirectional: true},
+	}
+	if !reflect.DeepEqual(rels, want) {
+		t.Errorf("RelationsToGraph() =\n%+v\nwant\n%+v", rels, want)
+	}
+}
+
+func TestRelationsToGraph_SupersessionCycles(t *testing.T) {
+	chain := func(edges ...[2]string) []*Finding {
+		byID := map[string]*Finding{}
+		var findings []*Finding
+		get := func(findingID string) *Finding {
+			if f, ok := byID[findingID]; ok {
+				return f
+			}
+			f := newRelationFinding(findingID)
+			byID[findingID] = f
+			findings = append(findings, f)
+			return f
+		}
+		for _, e := range edges {
+			get(e[1])
+			if err := get(e[0]).AddSupersededBy(e[1], ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return findings
+	}
+
+	tests := []struct {
+		name      string
+		findings  []*Finding
+		wantCycle string
+	}{
+		{
+			name:     "linear chain",
+			findings: chain([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "d"}),
+		},
+		{
+			name:     "converging chains",
+			findings: chain([2]string{"a", "c"}, [2]string{"b", "c"}, [2]string{"c", "d"}),
+		},
+		{
+			name:      "two findings",
+			findings:  chain([2]string{"a", "b"}, [2]string{"b", "a"}),
+			wantCycle: "b -> a -> b",
+		},
+		{
+			name:      "cycle after a tail",
+			findings:  chain([2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "d"}, [2]string{"d", "b"}),
+			wantCycle: "b -> c -> d -> b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RelationsToGraph(tt.findings)
+			if tt.wantCycle == "" {
+				if err != nil {
+					t.Errorf("RelationsToGraph() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrSupersessionCycle) {
+				t.Fatalf("RelationsToGraph() error = %v, want ErrSupersessionCycle", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantCycle) {
+				t.Errorf("error = %q, want cycle %q", err, tt.wantCycle)
+			}
+		})
+	}
+}
+
+func TestRelationsToGraph_InvalidRelation(t *testing.T) {
+	f := newRelationFinding("f-1")
+	f.Relations = []FindingRelation{{Type: RelationRelatedTo, TargetFindingID: "f-1"}}
+	if _, err := RelationsToGraph([]*Finding{f}); err == nil {
+		t.Error("RelationsToGraph() should reject a self relation")
+	}
+}
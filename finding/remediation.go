@@ -0,0 +1,113 @@
+package finding
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Remediation describes how to fix or mitigate a finding.
+type Remediation struct {
+	// Summary is a short description of the fix.
+	Summary string `json:"summary"`
+
+	// Steps lists the concrete actions to take, in order.
+	Steps []string `json:"steps,omitempty"`
+
+	// References contains links to fix guidance, advisories, or patches.
+	References []string `json:"references,omitempty"`
+
+	// Effort estimates how much work the fix takes.
+	Effort Effort `json:"effort,omitempty"`
+}
+
+// Effort estimates the work needed to apply a remediation.
+type Effort string
+
+const (
+	// EffortLow indicates a configuration change or one-line fix.
+	EffortLow Effort = "low"
+
+	// EffortMedium indicates a code change confined to one component.
+	EffortMedium Effort = "medium"
+
+	// EffortHigh indicates a redesign or changes across components.
+	EffortHigh Effort = "high"
+)
+
+// IsValid returns true if the effort is valid.
+func (e Effort) IsValid() bool {
+	switch e {
+	case EffortLow, EffortMedium, EffortHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the effort.
+func (e Effort) String() string {
+	return string(e)
+}
+
+// NewRemediation creates a remediation with a summary and ordered steps.
+func NewRemediation(summary string, steps ...string) *Remediation {
+	return &Remediation{
+		Summary: summary,
+		Steps:   steps,
+	}
+}
+
+// Validate checks if the remediation is valid.
+func (r *Remediation) Validate() error {
+	if strings.TrimSpace(r.Summary) == "" {
+		return fmt.Errorf("remediation summary is required")
+	}
+	for i, step := range r.Steps {
+		if strings.TrimSpace(step) == "" {
+			return fmt.Errorf("remediation step %d is empty", i+1)
+		}
+	}
+	if r.Effort != "" && !r.Effort.IsValid() {
+		return fmt.Errorf("invalid remediation effort: %s", r.Effort)
+	}
+	return nil
+}
+
+// String renders the remediation as plain text: the summary, numbered steps,
+// effort, and references, one per line. Exporters use it so remediation reads
+// the same in every format.
+func (r *Remediation) String() string {
+	if r == nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(r.Summary)
+	for i, step := range r.Steps {
+		fmt.Fprintf(&sb, "\n%d. %s", i+1, step)
+	}
+	if r.Effort != "" {
+		fmt.Fprintf(&sb, "\nEffort: %s", r.Effort)
+	}
+	if len(r.References) > 0 {
+		fmt.Fprintf(&sb, "\nReferences: %s", strings.Join(r.References, ", "))
+	}
+	return sb.String()
+}
+
+// UnmarshalJSON accepts either a Remediation object or, for findings stored
+// before remediation was structured, a plain string used as the summary.
+func (r *Remediation) UnmarshalJSON(data []byte) error {
+	var summary string
+	if err := json.Unmarshal(data, &summary); err == nil {
+		*r = Remediation{Summary: summary}
+		return nil
+	}
+	type plain Remediation
+	var p plain
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	*r = Remediation(p)
+	return nil
+}
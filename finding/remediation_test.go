@@ -0,0 +1,173 @@
+package finding
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRemediation_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		remediation Remediation
+		wantErr     string
+	}{
+		{
+			name:        "summary only",
+			remediation: Remediation{Summary: "Use parameterized queries"},
+		},
+		{
+			name: "complete",
+			remediation: Remediation{
+				Summary:    "Use parameterized queries",
+				Steps:      []string{"Replace string concatenation", "Add a regression test"},
+				References: []string{"https://owasp.org/www-community/attacks/SQL_Injection"},
+				Effort:     EffortLow,
+			},
+		},
+		{
+			name:        "missing summary",
+			remediation: Remediation{Steps: []string{"Patch it"}},
+			wantErr:     "summary is required",
+		},
+		{
+			name:        "empty step",
+			remediation: Remediation{Summary: "Patch", Steps: []string{"Upgrade", " "}},
+			wantErr:     "step 2 is empty",
+		},
+		{
+			name:        "invalid effort",
+			remediation: Remediation{Summary: "Patch", Effort: "trivial"},
+			wantErr:     "invalid remediation effort",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.remediation.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRemediation_String(t *testing.T) {
+	r := &Remediation{
+		Summary:    "Use parameterized queries",
+		Steps:      []string{"Replace string concatenation", "Add a regression test"},
+		References: []string{"https://a.example", "https://b.example"},
+		Effort:     EffortMedium,
+	}
+	want := "Use parameterized queries\n" +
+		"1. Replace string concatenation\n" +
+		"2. Add a regression test\n" +
+		"Effort: medium\n" +
+		"References: https://a.example, https://b.example"
+	if got := r.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	var none *Remediation
+	if got := none.String(); got != "" {
+		t.Errorf("nil String() = %q, want empty", got)
+	}
+}
+
+func TestRemediation_UnmarshalJSON(t *testing.T) {
+	t.Run("structured", func(t *testing.T) {
+		var f Finding
+		data := `{"remediation": {"summary": "Patch", "steps": ["Upgrade to 2.4.1"], "effort": "low"}}`
+		if err := json.Unmarshal([]byte(data), &f); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if f.Remediation == nil || f.Remediation.Summary != "Patch" || f.Remediation.Effort != EffortLow {
+			t.Errorf("Remediation = %+v", f.Remediation)
+		}
+		if len(f.Remediation.Steps) != 1 || f.Remediation.Steps[0] != "Upgrade to 2.4.1" {
+			t.Errorf("Steps = %v", f.Remediation.Steps)
+		}
+	})
+
+	t.Run("legacy string", func(t *testing.T) {
+		var f Finding
+		if err := json.Unmarshal([]byte(`{"remediation": "Use parameterized queries"}`), &f); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if f.Remediation == nil || f.Remediation.Summary != "Use parameterized queries" {
+			t.Errorf("Remediation = %+v, want summary from string", f.Remediation)
+		}
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		var f Finding
+		if err := json.Unmarshal([]byte(`{"title": "x"}`), &f); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if f.Remediation != nil {
+			t.Errorf("Remediation = %+v, want nil", f.Remediation)
+		}
+	})
+}
+
+func TestFinding_SetRemediation(t *testing.T) {
+	f := NewFinding("mission-1", "agent", "SQLi", "desc", CategoryDataExtraction, SeverityHigh)
+	before := f.UpdatedAt
+
+	if err := f.SetRemediation(&Remediation{}); err == nil {
+		t.Error("SetRemediation() with empty summary should fail")
+	}
+	if f.Remediation != nil {
+		t.Error("invalid remediation should not be set")
+	}
+
+	if err := f.SetRemediation(NewRemediation("Use parameterized queries", "Replace concatenation")); err != nil {
+		t.Fatalf("SetRemediation() error = %v", err)
+	}
+	if f.Remediation.Summary != "Use parameterized queries" || len(f.Remediation.Steps) != 1 {
+		t.Errorf("Remediation = %+v", f.Remediation)
+	}
+	if f.UpdatedAt.Before(before) {
+		t.Error("UpdatedAt should be refreshed")
+	}
+}
+
+func TestFinding_ValidateRequireRemediation(t *testing.T) {
+	policy := RequireRemediationAtOrAbove(SeverityHigh)
+
+	tests := []struct {
+		name        string
+		severity    Severity
+		remediation *Remediation
+		wantErr     bool
+	}{
+		{"critical without remediation", SeverityCritical, nil, true},
+		{"high without remediation", SeverityHigh, nil, true},
+		{"medium without remediation", SeverityMedium, nil, false},
+		{"high with remediation", SeverityHigh, NewRemediation("Patch"), false},
+		{"high with invalid remediation", SeverityHigh, &Remediation{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFinding("mission-1", "agent", "Title", "desc", CategoryDataExtraction, tt.severity)
+			f.Remediation = tt.remediation
+			err := f.Validate(policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Without the option a missing remediation is fine
+	f := NewFinding("mission-1", "agent", "Title", "desc", CategoryDataExtraction, SeverityCritical)
+	if err := f.Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil without the policy", err)
+	}
+}
@@ -0,0 +1,64 @@
+package finding
+
+import "time"
+
+// Summary aggregates a set of findings for reports and dashboards.
+type Summary struct {
+	// Total is the number of findings.
+	Total int `json:"total"`
+
+	// BySeverity counts findings per severity.
+	BySeverity map[Severity]int `json:"by_severity"`
+
+	// ByCategory counts findings per category.
+	ByCategory map[Category]int `json:"by_category"`
+
+	// ByStatus counts findings per status.
+	ByStatus map[Status]int `json:"by_status"`
+
+	// MaxRiskScore is the highest risk score of any finding.
+	MaxRiskScore float64 `json:"max_risk_score"`
+
+	// AverageRiskScore is the mean risk score of the findings.
+	AverageRiskScore float64 `json:"average_risk_score"`
+
+	// FirstSeen and LastSeen are the earliest and latest creation times;
+	// findings without a creation time are ignored.
+	FirstSeen time.Time `json:"first_seen,omitempty"`
+	LastSeen  time.Time `json:"last_seen,omitempty"`
+}
+
+// Summarize counts findings by severity, category, and status and computes
+// their risk score range and time span.
+func Summarize(findings []Finding) Summary {
+	s := Summary{
+		Total:      len(findings),
+		BySeverity: make(map[Severity]int),
+		ByCategory: make(map[Category]int),
+		ByStatus:   make(map[Status]int),
+	}
+
+	var totalRisk float64
+	for _, f := range findings {
+		s.BySeverity[f.Severity]++
+		s.ByCategory[f.Category]++
+		s.ByStatus[f.Status]++
+
+		totalRisk += f.RiskScore
+		s.MaxRiskScore = max(s.MaxRiskScore, f.RiskScore)
+
+		if f.CreatedAt.IsZero() {
+			continue
+		}
+		if s.FirstSeen.IsZero() || f.CreatedAt.Before(s.FirstSeen) {
+			s.FirstSeen = f.CreatedAt
+		}
+		if f.CreatedAt.After(s.LastSeen) {
+			s.LastSeen = f.CreatedAt
+		}
+	}
+	if s.Total > 0 {
+		s.AverageRiskScore = totalRisk / float64(s.Total)
+	}
+	return s
+}
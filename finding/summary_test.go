@@ -0,0 +1,40 @@
+package finding
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	first := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	findings := []Finding{
+		{Severity: SeverityHigh, Category: CategoryJailbreak, Status: StatusOpen, RiskScore: 6, CreatedAt: first.Add(time.Hour)},
+		{Severity: SeverityHigh, Category: CategoryDataExtraction, Status: StatusConfirmed, RiskScore: 8, CreatedAt: first},
+		{Severity: SeverityLow, Category: CategoryJailbreak, Status: StatusOpen, RiskScore: 1},
+	}
+
+	s := Summarize(findings)
+	if s.Total != 3 {
+		t.Errorf("Total = %d, want 3", s.Total)
+	}
+	if s.BySeverity[SeverityHigh] != 2 || s.BySeverity[SeverityLow] != 1 {
+		t.Errorf("BySeverity = %v", s.BySeverity)
+	}
+	if s.ByCategory[CategoryJailbreak] != 2 || s.ByCategory[CategoryDataExtraction] != 1 {
+		t.Errorf("ByCategory = %v", s.ByCategory)
+	}
+	if s.ByStatus[StatusOpen] != 2 || s.ByStatus[StatusConfirmed] != 1 {
+		t.Errorf("ByStatus = %v", s.ByStatus)
+	}
+	if s.MaxRiskScore != 8 || s.AverageRiskScore != 5 {
+		t.Errorf("risk scores = max %v avg %v, want 8 and 5", s.MaxRiskScore, s.AverageRiskScore)
+	}
+	if !s.FirstSeen.Equal(first) || !s.LastSeen.Equal(first.Add(time.Hour)) {
+		t.Errorf("time span = %v to %v, findings without a creation time must be ignored", s.FirstSeen, s.LastSeen)
+	}
+
+	empty := Summarize(nil)
+	if empty.Total != 0 || empty.AverageRiskScore != 0 || !empty.FirstSeen.IsZero() {
+		t.Errorf("Summarize(nil) = %+v", empty)
+	}
+}
@@ -2,10 +2,13 @@ package sdk
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -385,18 +388,23 @@ func (f *defaultFramework) ExportFindings(ctx context.Context, format finding.Ex
 
 	case finding.FormatCSV:
 		// Simple CSV export (headers + one line per finding)
-		_, err := fmt.Fprintf(w, "ID,Title,Severity,Category,Status,CreatedAt\n")
-		if err != nil {
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"ID", "Title", "Severity", "Category", "Status", "CreatedAt", "Remediation", "Relations", "CVSS Score", "CVSS Vector"}); err != nil {
 			return err
 		}
 		for _, f := range allFindings {
-			_, err := fmt.Fprintf(w, "%s,%s,%s,%s,%s,%s\n",
-				f.ID, f.Title, f.Severity, f.Category, f.Status, f.CreatedAt.Format(time.RFC3339))
+			score, vector := formatCVSS(f)
+			err := cw.Write([]string{
+				f.ID, f.Title, string(f.Severity), string(f.Category), string(f.Status),
+				f.CreatedAt.Format(time.RFC3339), f.Remediation.String(), formatRelations(f),
+				score, vector,
+			})
 			if err != nil {
 				return err
 			}
 		}
-		return nil
+		cw.Flush()
+		return cw.Error()
 
 	case finding.FormatHTML:
 		// Simple HTML export
@@ -407,14 +415,21 @@ func (f *defaultFramework) ExportFindings(ctx context.Context, format finding.Ex
 <h1>Security Findings Report</h1>
 <p>Generated: %s</p>
 <table border="1">
-<tr><th>ID</th><th>Title</th><th>Severity</th><th>Category</th><th>Status</th></tr>
+<tr><th>ID</th><th>Title</th><th>Severity</th><th>Category</th><th>Status</th><th>Remediation</th><th>Relations</th><th>CVSS</th></tr>
 `, time.Now().Format(time.RFC3339))
 		if err != nil {
 			return err
 		}
 		for _, f := range allFindings {
-			_, err := fmt.Fprintf(w, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
-				f.ID, f.Title, f.Severity, f.Category, f.Status)
+			remediation := strings.ReplaceAll(html.EscapeString(f.Remediation.String()), "\n", "<br>")
+			relations := html.EscapeString(formatRelations(f))
+			score, vector := formatCVSS(f)
+			cvss := score
+			if vector != "" {
+				cvss += "<br><code>" + html.EscapeString(vector) + "</code>"
+			}
+			_, err := fmt.Fprintf(w, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				f.ID, f.Title, f.Severity, f.Category, f.Status, remediation, relations, cvss)
 			if err != nil {
 				return err
 			}
@@ -447,6 +462,31 @@ func (f *defaultFramework) ExportFindings(ctx context.Context, format finding.Ex
 	}
 }
 
+// formatRelations renders the relation groups of a finding for exports,
+// e.g. "Duplicate of: f-1; Related to: f-2, f-3".
+func formatRelations(f finding.Finding) string {
+	groups := f.RelationGroups()
+	parts := make([]string, len(groups))
+	for i, group := range groups {
+		parts[i] = group.Type.DisplayName() + ": " + strings.Join(group.TargetFindingIDs, ", ")
+	}
+	return strings.Join(parts, "; ")
+}
+
+// formatCVSS renders the CVSS score and vector of a finding for exports.
+// Findings with only a CVSSScore have no vector; findings with neither
+// render as empty strings.
+func formatCVSS(f finding.Finding) (score, vector string) {
+	switch {
+	case f.CVSS != nil:
+		return fmt.Sprintf("%.1f", f.CVSS.Score), f.CVSS.Vector
+	case f.CVSSScore != nil:
+		return fmt.Sprintf("%.1f", *f.CVSSScore), ""
+	default:
+		return "", ""
+	}
+}
+
 // Start initializes the framework.
 func (f *defaultFramework) Start(ctx context.Context) error {
 	if f.started {
@@ -6,6 +6,7 @@ package sdk
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"strings"
 	"testing"
 	"time"
@@ -598,6 +599,147 @@ func TestFramework_Findings(t *testing.T) {
 		}
 	})
 
+	t.Run("export findings with remediation", func(t *testing.T) {
+		df := fw.(*defaultFramework)
+		f := finding.NewFindingWithID("f-1", "mission-1", "sqli-agent", "SQL injection", "desc", finding.CategoryDataExtraction, finding.SeverityHigh)
+		if err := f.SetRemediation(&finding.Remediation{
+			Summary: "Use parameterized queries, not concatenation",
+			Steps:   []string{"Replace string building", "Add a test"},
+			Effort:  finding.EffortLow,
+		}); err != nil {
+			t.Fatalf("failed to set remediation: %v", err)
+		}
+		df.findingsMu.Lock()
+		df.findingsStore["mission-1"] = []findingRecord{{MissionID: "mission-1", Finding: *f}}
+		df.findingsMu.Unlock()
+		defer func() {
+			df.findingsMu.Lock()
+			delete(df.findingsStore, "mission-1")
+			df.findingsMu.Unlock()
+		}()
+
+		var csvBuf bytes.Buffer
+		if err := fw.ExportFindings(ctx, finding.FormatCSV, &csvBuf); err != nil {
+			t.Fatalf("failed to export findings: %v", err)
+		}
+		records, err := csv.NewReader(&csvBuf).ReadAll()
+		if err != nil {
+			t.Fatalf("CSV export is not valid CSV: %v", err)
+		}
+		if len(records) != 2 || records[0][6] != "Remediation" {
+			t.Fatalf("unexpected CSV records: %v", records)
+		}
+		if records[1][6] != f.Remediation.String() {
+			t.Errorf("CSV remediation = %q, want %q", records[1][6], f.Remediation.String())
+		}
+
+		var htmlBuf bytes.Buffer
+		if err := fw.ExportFindings(ctx, finding.FormatHTML, &htmlBuf); err != nil {
+			t.Fatalf("failed to export findings: %v", err)
+		}
+		if !strings.Contains(htmlBuf.String(), "Use parameterized queries, not concatenation<br>1. Replace string building<br>2. Add a test<br>Effort: low") {
+			t.Errorf("HTML export missing remediation: %s", htmlBuf.String())
+		}
+	})
+
+	t.Run("export findings with relations", func(t *testing.T) {
+		df := fw.(*defaultFramework)
+		f := finding.NewFindingWithID("f-2", "mission-1", "sqli-agent", "SQL injection", "desc", finding.CategoryDataExtraction, finding.SeverityHigh)
+		for _, err := range []error{
+			f.AddDuplicateOf("f-1", ""),
+			f.AddRelatedTo("f-3", ""),
+			f.AddRelatedTo("f-4", ""),
+		} {
+			if err != nil {
+				t.Fatalf("failed to add relation: %v", err)
+			}
+		}
+		df.findingsMu.Lock()
+		df.findingsStore["mission-1"] = []findingRecord{{MissionID: "mission-1", Finding: *f}}
+		df.findingsMu.Unlock()
+		defer func() {
+			df.findingsMu.Lock()
+			delete(df.findingsStore, "mission-1")
+			df.findingsMu.Unlock()
+		}()
+
+		want := "Duplicate of: f-1; Related to: f-3, f-4"
+
+		var csvBuf bytes.Buffer
+		if err := fw.ExportFindings(ctx, finding.FormatCSV, &csvBuf); err != nil {
+			t.Fatalf("failed to export findings: %v", err)
+		}
+		records, err := csv.NewReader(&csvBuf).ReadAll()
+		if err != nil {
+			t.Fatalf("CSV export is not valid CSV: %v", err)
+		}
+		if len(records) != 2 || records[0][7] != "Relations" {
+			t.Fatalf("unexpected CSV records: %v", records)
+		}
+		if records[1][7] != want {
+			t.Errorf("CSV relations = %q, want %q", records[1][7], want)
+		}
+
+		var htmlBuf bytes.Buffer
+		if err := fw.ExportFindings(ctx, finding.FormatHTML, &htmlBuf); err != nil {
+			t.Fatalf("failed to export findings: %v", err)
+		}
+		if !strings.Contains(htmlBuf.String(), "<td>"+want+"</td>") {
+			t.Errorf("HTML export missing relations: %s", htmlBuf.String())
+		}
+	})
+
+	t.Run("export findings with CVSS", func(t *testing.T) {
+		df := fw.(*defaultFramework)
+		f := finding.NewFindingWithID("f-5", "mission-1", "sqli-agent", "SQL injection", "desc", finding.CategoryDataExtraction, finding.SeverityLow)
+		cvss, err := finding.ParseCVSS("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+		if err != nil {
+			t.Fatalf("failed to parse CVSS: %v", err)
+		}
+		if err := f.SetCVSS(cvss); err != nil {
+			t.Fatalf("failed to set CVSS: %v", err)
+		}
+		df.findingsMu.Lock()
+		df.findingsStore["mission-1"] = []findingRecord{{MissionID: "mission-1", Finding: *f}}
+		df.findingsMu.Unlock()
+		defer func() {
+			df.findingsMu.Lock()
+			delete(df.findingsStore, "mission-1")
+			df.findingsMu.Unlock()
+		}()
+
+		var csvBuf bytes.Buffer
+		if err := fw.ExportFindings(ctx, finding.FormatCSV, &csvBuf); err != nil {
+			t.Fatalf("failed to export findings: %v", err)
+		}
+		records, err := csv.NewReader(&csvBuf).ReadAll()
+		if err != nil {
+			t.Fatalf("CSV export is not valid CSV: %v", err)
+		}
+		if len(records) != 2 || records[0][8] != "CVSS Score" || records[0][9] != "CVSS Vector" {
+			t.Fatalf("unexpected CSV records: %v", records)
+		}
+		if records[1][2] != "critical" || records[1][8] != "9.8" || records[1][9] != cvss.Vector {
+			t.Errorf("CSV severity and CVSS = %q, %q, %q, want critical, 9.8, %q", records[1][2], records[1][8], records[1][9], cvss.Vector)
+		}
+
+		var htmlBuf bytes.Buffer
+		if err := fw.ExportFindings(ctx, finding.FormatHTML, &htmlBuf); err != nil {
+			t.Fatalf("failed to export findings: %v", err)
+		}
+		if !strings.Contains(htmlBuf.String(), "<td>9.8<br><code>"+cvss.Vector+"</code></td>") {
+			t.Errorf("HTML export missing CVSS: %s", htmlBuf.String())
+		}
+
+		var jsonBuf bytes.Buffer
+		if err := fw.ExportFindings(ctx, finding.FormatJSON, &jsonBuf); err != nil {
+			t.Fatalf("failed to export findings: %v", err)
+		}
+		if !strings.Contains(jsonBuf.String(), `"vector": "`+cvss.Vector+`"`) {
+			t.Errorf("JSON export missing CVSS vector: %s", jsonBuf.String())
+		}
+	})
+
 	t.Run("export findings SARIF", func(t *testing.T) {
 		var buf bytes.Buffer
 		err := fw.ExportFindings(ctx, finding.FormatSARIF, &buf)
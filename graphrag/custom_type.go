@@ -0,0 +1,102 @@
+package graphrag
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidCustomType indicates a custom node type registration is malformed
+// or conflicts with an earlier one.
+var ErrInvalidCustomType = errors.New("invalid custom node type")
+
+// customTypes maps "namespace:type" to the identifying properties declared
+// with RegisterCustomType.
+var (
+	customTypes   = make(map[string][]string)
+	customTypesMu sync.RWMutex
+)
+
+// CustomType returns the node type name of a custom type, "namespace:type".
+func CustomType(namespace, typ string) string {
+	return namespace + ":" + typ
+}
+
+// RegisterCustomType declares the identifying properties of the custom node
+// type "namespace:type", so that every agent storing nodes of that type
+// identifies them the same way. Once registered, GraphNode.Validate, and with
+// it storage through the harness, rejects nodes of the type that lack any of
+// the properties. Custom types that are never registered stay free-form.
+//
+// Registering a type again with the same properties is a no-op, so agents
+// sharing a type can each register it. Registering it with different
+// properties returns an error wrapping ErrInvalidCustomType, as does an empty
+// namespace or type, a name containing ':', or no identifying properties.
+//
+// Example:
+//
+//	err := graphrag.RegisterCustomType("llmsec", "jailbreak_attempt",
+//	    []string{"mission_id", "prompt_hash"})
+//	node := graphrag.NewGraphNode(graphrag.CustomType("llmsec", "jailbreak_attempt"))
+func RegisterCustomType(namespace, typ string, identifyingProps []string) error {
+	switch {
+	case namespace == "" || typ == "":
+		return fmt.Errorf("%w: namespace and type are required", ErrInvalidCustomType)
+	case strings.Contains(namespace, ":") || strings.Contains(typ, ":"):
+		return fmt.Errorf("%w: %q and %q must not contain ':'", ErrInvalidCustomType, namespace, typ)
+	case len(identifyingProps) == 0:
+		return fmt.Errorf("%w: %s needs at least one identifying property", ErrInvalidCustomType, CustomType(namespace, typ))
+	}
+	for _, prop := range identifyingProps {
+		if strings.TrimSpace(prop) == "" {
+			return fmt.Errorf("%w: %s has an empty identifying property", ErrInvalidCustomType, CustomType(namespace, typ))
+		}
+	}
+
+	nodeType := CustomType(namespace, typ)
+	customTypesMu.Lock()
+	defer customTypesMu.Unlock()
+
+	if existing, ok := customTypes[nodeType]; ok {
+		if slices.Equal(existing, identifyingProps) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s already registered with identifying properties %v", ErrInvalidCustomType, nodeType, existing)
+	}
+	customTypes[nodeType] = slices.Clone(identifyingProps)
+	return nil
+}
+
+// UnregisterCustomType removes the registration of a custom node type, whose
+// nodes become free-form again. It is mainly useful in tests.
+func UnregisterCustomType(namespace, typ string) {
+	customTypesMu.Lock()
+	defer customTypesMu.Unlock()
+	delete(customTypes, CustomType(namespace, typ))
+}
+
+// CustomTypeIdentifyingProperties returns the identifying properties
+// registered for a custom node type given as "namespace:type", and whether
+// the type is registered.
+func CustomTypeIdentifyingProperties(nodeType string) ([]string, bool) {
+	customTypesMu.RLock()
+	defer customTypesMu.RUnlock()
+
+	props, ok := customTypes[nodeType]
+	return slices.Clone(props), ok
+}
+
+// validateCustomType checks a node of a registered custom type for its
+// identifying properties. Other nodes pass.
+func validateCustomType(n *GraphNode) error {
+	props, ok := CustomTypeIdentifyingProperties(n.Type)
+	if !ok {
+		return nil
+	}
+	if missing := missingProperties(props, n.Properties); len(missing) > 0 {
+		return fmt.Errorf("%w for node type '%s': %v", ErrMissingIdentifyingProperties, n.Type, missing)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package graphrag_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/graphrag"
+	"github.com/zero-day-ai/sdk/graphrag/id"
+)
+
+func TestRegisterCustomType(t *testing.T) {
+	t.Cleanup(func() { graphrag.UnregisterCustomType("llmsec", "jailbreak") })
+
+	require.NoError(t, graphrag.RegisterCustomType("llmsec", "jailbreak", []string{"mission_id", "prompt_hash"}))
+	require.NoError(t, graphrag.RegisterCustomType("llmsec", "jailbreak", []string{"mission_id", "prompt_hash"}),
+		"registering the same identity again is a no-op")
+
+	err := graphrag.RegisterCustomType("llmsec", "jailbreak", []string{"prompt_hash"})
+	require.ErrorIs(t, err, graphrag.ErrInvalidCustomType)
+	assert.Contains(t, err.Error(), "already registered")
+
+	props, ok := graphrag.CustomTypeIdentifyingProperties("llmsec:jailbreak")
+	require.True(t, ok)
+	assert.Equal(t, []string{"mission_id", "prompt_hash"}, props)
+
+	for name, args := range map[string][3]any{
+		"empty namespace": {"", "jailbreak", []string{"id"}},
+		"empty type":      {"llmsec", "", []string{"id"}},
+		"colon":           {"llm:sec", "jailbreak", []string{"id"}},
+		"no properties":   {"llmsec", "probe", []string(nil)},
+		"blank property":  {"llmsec", "probe", []string{"id", " "}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := graphrag.RegisterCustomType(args[0].(string), args[1].(string), args[2].([]string))
+			assert.ErrorIs(t, err, graphrag.ErrInvalidCustomType)
+		})
+	}
+}
+
+func TestGraphNodeValidate_CustomType(t *testing.T) {
+	require.NoError(t, graphrag.RegisterCustomType("llmsec", "jailbreak", []string{"mission_id", "prompt_hash"}))
+	t.Cleanup(func() { graphrag.UnregisterCustomType("llmsec", "jailbreak") })
+
+	nodeType := graphrag.CustomType("llmsec", "jailbreak")
+	assert.Equal(t, "llmsec:jailbreak", nodeType)
+
+	node := graphrag.NewGraphNode(nodeType).
+		WithProperty("mission_id", "m-1").
+		WithProperty("prompt_hash", "")
+	err := node.Validate()
+	require.ErrorIs(t, err, graphrag.ErrMissingIdentifyingProperties)
+	assert.Contains(t, err.Error(), "prompt_hash")
+
+	node.WithProperty("prompt_hash", "abc123")
+	assert.NoError(t, node.Validate())
+
+	free := graphrag.NewGraphNode("llmsec:unregistered").WithProperty("anything", 1)
+	assert.NoError(t, free.Validate(), "unregistered custom types stay free-form")
+}
+
+func TestCustomTypeDeterministicIDs(t *testing.T) {
+	require.NoError(t, graphrag.RegisterCustomType("llmsec", "jailbreak", []string{"mission_id", "prompt_hash"}))
+	t.Cleanup(func() { graphrag.UnregisterCustomType("llmsec", "jailbreak") })
+
+	registry := graphrag.NewDefaultNodeTypeRegistry()
+	assert.True(t, registry.IsRegistered("llmsec:jailbreak"))
+
+	gen := id.NewGenerator(registry)
+	props := map[string]any{"mission_id": "m-1", "prompt_hash": "abc123", "payload": "ignore previous"}
+	first, err := gen.Generate("llmsec:jailbreak", props)
+	require.NoError(t, err)
+	props["payload"] = "something else"
+	second, err := gen.Generate("llmsec:jailbreak", props)
+	require.NoError(t, err)
+	assert.Equal(t, first, second, "only identifying properties determine the ID")
+}
@@ -61,6 +61,24 @@
 //
 // The MissionID and AgentName fields are auto-populated by the Gibson harness.
 //
+// Typed domain nodes (anything implementing DomainNode, such as domain.Port)
+// can be stored directly. The harness derives a deterministic ID from the
+// node's type, identifying properties, and parent, and stores the node with
+// its parent relationship in one batch:
+//
+//	port := domain.NewPort(443, "tcp").BelongsTo(host)
+//	portID, err := harness.StoreDomainNode(ctx, port)
+//
+// Read properties of returned nodes with the typed accessors rather than type
+// assertions, since backends may return numbers as json.Number, int64, or
+// strings. Keys may be dotted paths into nested property maps:
+//
+//	score := node.GetFloat("cvss_score", 0)
+//	status := node.GetInt("http.status", 0)
+//	if node.HasProperty("verified_at") {
+//	    verified := node.GetTime("verified_at", time.RFC3339)
+//	}
+//
 // # Query Operations
 //
 // Create queries using the fluent Query builder:
@@ -86,12 +104,64 @@
 //   - MaxHops: Maximum graph traversal depth (default: 3)
 //   - MinScore: Minimum similarity threshold 0.0-1.0 (default: 0.7)
 //   - NodeTypes: Filter by specific node types (optional)
+//   - PropertyFilters: Filter by node property predicates, ANDed together (optional)
 //   - MissionID: Filter by mission context (optional)
 //   - VectorWeight: Weight for semantic similarity (default: 0.6)
 //   - GraphWeight: Weight for graph structure (default: 0.4)
 //
 // The weights must sum to 1.0 for proper hybrid scoring.
 //
+// Property filters narrow results server-side instead of filtering client-side:
+//
+//	query := graphrag.NewStructuredQuery().
+//	    WithNodeTypes("finding").
+//	    WithPropertyFilter("severity", graphrag.FilterOpEq, "high").
+//	    WithPropertyFilter("confidence", graphrag.FilterOpGte, 0.8)
+//
+// Supported operators are eq, gt, gte, lt, lte, and contains.
+//
+// When near-identical nodes crowd a result set, cap the results per group or
+// re-rank them for diversity. The harness fetches TopK times OverFetch
+// (DefaultOverFetch unless set) candidates and re-ranks them client-side with
+// RerankResults; each returned Result records its backend position in
+// OriginalRank:
+//
+//	query := graphrag.NewQuery("authentication weaknesses").
+//	    WithMaxPerGroup("host_id", 2). // or graphrag.GroupByNodeType
+//	    WithDiversity(0.7)             // maximal marginal relevance lambda
+//
+// Validate reports every violation at once as a joined error. To see how a
+// query will be executed without running it, use Explain:
+//
+//	plan := query.Explain()
+//	fmt.Println(plan.Route)      // "text", "embedding", or "structured"
+//	fmt.Println(plan.Operations) // estimated backend operations
+//
+// Harnesses connected to a daemon expose ExplainQuery, which returns the
+// daemon's own plan when supported and falls back to Explain otherwise.
+//
+// GraphStats reports node and relationship counts for a mission, which helps
+// pick a traversal depth that suits the graph's density:
+//
+//	stats, err := harness.GraphStats(ctx, "") // current mission
+//	query.WithMaxHops(stats.SuggestMaxHops(3, 500))
+//
+// # Embeddings
+//
+// An Embedder turns text into fixed-size vectors. HashingEmbedder is a
+// deterministic, dependency-free embedder that hashes words and character
+// trigrams, suitable for tests and offline evals; it captures lexical overlap,
+// not meaning. Set it, or a real model, with SetDefaultEmbedder to embed
+// queries client-side for backends that accept raw vectors, and to make
+// StoreSemantic and QuerySemantic work in the standalone harness:
+//
+//	graphrag.SetDefaultEmbedder(graphrag.NewHashingEmbedder(0))
+//
+//	query, err := graphrag.NewQuery("exposed admin panels").WithTextEmbedded(ctx)
+//
+// Vectors of different lengths cannot be compared; CosineSimilarity and
+// EmbedText report them as ErrDimensionMismatch.
+//
 // # Relationship Management
 //
 // Create and manage graph relationships:
@@ -116,6 +186,19 @@
 //	    log.Fatal(err)
 //	}
 //
+// For the common finding links, prefer the typed helpers. They use the
+// canonical property names (PropConfidence, PropSequence, PropSimilarity)
+// and reject out-of-range values:
+//
+//	rel, err := graphrag.UsesTechnique("finding-123", "technique-T1190", 0.95)
+//	rel, err := graphrag.LeadsTo(recon.ID, bruteforce.ID, 1)
+//	rel, err := graphrag.SimilarTo("finding-123", "finding-456", 0.87)
+//	rel, err := graphrag.Mitigates("control-mfa", "finding-123")
+//	rel, err := graphrag.Elicited("prompt-42", "finding-123")
+//
+// Validate logs a warning when a documented relationship type uses a known
+// misspelling of a canonical property, such as "conf" or "score".
+//
 // ## Relationship Types
 //
 // Canonical relationship types defined in the taxonomy:
@@ -172,6 +255,41 @@
 // Batch operations are more efficient than individual operations when
 // creating multiple nodes and relationships simultaneously.
 //
+// Queries can be batched too. Harnesses connected to a daemon expose
+// QueryBatch, which runs several queries in one round trip and returns their
+// results aligned by index. A failed query does not fail the batch; it is
+// reported in a *BatchQueryError:
+//
+//	results, err := harness.QueryBatch(ctx, queries)
+//	var batchErr *graphrag.BatchQueryError
+//	if err != nil && !errors.As(err, &batchErr) {
+//	    return err
+//	}
+//
+// # Exporting and Importing Missions
+//
+// ExportMission snapshots the nodes and relationships of a mission as NDJSON,
+// for offline analysis or as a test fixture, and ImportMission loads such a
+// snapshot back through any GraphRAGHarness. Each line is a record with a
+// "kind" discriminator: a "mission" header, then "node" and "relationship"
+// records. Import validates every record before writing, stores in batches,
+// and can move the graph into another mission:
+//
+//	stats, err := graphrag.ImportMission(ctx, store, f, graphrag.ImportOptions{
+//	    RemapMissionID: "fixture-mission",
+//	    DryRun:         true, // report what would be created
+//	})
+//
+// Profile reports which properties the nodes of a mission actually carry:
+// per node type, how often each property is present, the kinds of its
+// values, how many distinct values it takes, and examples. Properties whose
+// values are of mixed kinds are flagged. Sampling is bounded per type and
+// the report is deterministic, rendering as JSON or Markdown:
+//
+//	report, err := graphrag.ProfileWithOptions(ctx, store, missionID, nil,
+//	    graphrag.ProfileOptions{MaxNodesPerType: 500})
+//	fmt.Println(report.Markdown())
+//
 // # Graph Traversal
 //
 // Configure graph traversal with TraversalOptions:
@@ -187,6 +305,47 @@
 //   - "incoming": Follow relationships from target to source
 //   - "both": Follow relationships in both directions
 //
+// Paths can be constrained to pass through nodes of given types, such as
+// assets reached from a finding through a technique, and to avoid others.
+// By default a path never revisits a node, so SIMILAR_TO cycles cannot blow
+// up the search; WithAllowRevisit permits a bounded number of revisits.
+// Traverse is the reference implementation of these semantics:
+//
+//	opts := graphrag.NewTraversalOptions().
+//	    WithNodeTypes([]string{graphrag.NodeTypeHost}).
+//	    WithMustPassNodeTypes([]string{graphrag.NodeTypeTechnique}).
+//	    WithAvoidNodeTypes([]string{graphrag.NodeTypeCertificate})
+//	results, err := harness.TraverseGraph(ctx, findingID, *opts)
+//
+// To link two known nodes, such as a finding and a technique, ask for the
+// shortest path instead of traversing. Set WeightProperty to minimize a
+// numeric relationship property rather than the hop count. Searches are
+// capped at MaxPathDepth hops, and ErrPathNotFound is returned when no path
+// exists within the limits:
+//
+//	opts := graphrag.NewPathOptions().
+//	    WithDirection("both").
+//	    WithWeightProperty("cost")
+//	edges, err := harness.ShortestPath(ctx, findingID, techniqueID, *opts)
+//
+// For the common one-hop question, such as which findings use a technique,
+// ask for a node's neighbors instead of a depth-1 traversal. The direction
+// is "outgoing", "incoming", or "both", and an empty relationship type
+// follows any relationship:
+//
+//	findings, err := harness.Neighbors(ctx, techniqueID, graphrag.RelTypeUSESTECHNIQUE, "incoming")
+//
+// # Watching for Changes
+//
+// Agents of a mission can coordinate through the graph. WatchGraph streams a
+// GraphEvent whenever a node of the watched types is created or updated in
+// the mission, by any agent, until the context is cancelled:
+//
+//	events, err := harness.WatchGraph(ctx, missionID, []string{graphrag.NodeTypePort})
+//	for event := range events {
+//	    // an open port was stored; exploit it
+//	}
+//
 // # Taxonomy System
 //
 // GraphRAG uses a YAML-driven taxonomy system for node and relationship types.
@@ -228,6 +387,20 @@
 // The validation helpers encourage use of canonical types while allowing
 // flexibility for agent-specific custom types.
 //
+// ## Custom Types
+//
+// Custom types are named "namespace:type". They are free-form unless
+// registered with RegisterCustomType, which declares their identifying
+// properties. GraphNode.Validate, and therefore storage through the harness,
+// then rejects nodes of the type without those properties, and the
+// NodeTypeRegistry reports them, so deterministic IDs work as for canonical
+// types:
+//
+//	graphrag.RegisterCustomType("llmsec", "jailbreak", []string{"mission_id", "prompt_hash"})
+//	node := graphrag.NewGraphNode(graphrag.CustomType("llmsec", "jailbreak")).
+//	    WithProperty("mission_id", missionID).
+//	    WithProperty("prompt_hash", hash)
+//
 // ## Node Types
 //
 // Canonical node types defined in the taxonomy:
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/zero-day-ai/sdk/api/gen/taxonomypb"
+	"github.com/zero-day-ai/sdk/graphrag"
 	"github.com/zero-day-ai/sdk/graphrag/validation"
 )
 
@@ -41,12 +42,9 @@ type GraphNode interface {
 	SetID(id string)
 }
 
-// NodeRef references a parent node.
-type NodeRef struct {
-	NodeType     string
-	Properties   map[string]any
-	Relationship string
-}
+// NodeRef references a parent node. It is an alias of graphrag.NodeRef so
+// domain types satisfy graphrag.DomainNode.
+type NodeRef = graphrag.NodeRef
 
 // ==================== HELPER FUNCTIONS ====================
 
@@ -0,0 +1,37 @@
+package graphrag
+
+// DomainNode is implemented by typed domain nodes such as the generated
+// domain.Host and domain.Port. It carries everything needed to store the
+// node without building a GraphNode by hand: the node type, the properties
+// that identify it, all of its properties, and a reference to its parent.
+type DomainNode interface {
+	// NodeType returns the taxonomy node type, e.g. "host".
+	NodeType() string
+
+	// IdentifyingProperties returns the natural key of the node. For child
+	// nodes the key is relative to the parent, e.g. a port's number and
+	// protocol.
+	IdentifyingProperties() map[string]any
+
+	// Properties returns all properties of the node.
+	Properties() map[string]any
+
+	// ParentRef returns the reference to the parent node, or nil for root
+	// nodes.
+	ParentRef() *NodeRef
+}
+
+// NodeRef references the parent of a DomainNode and the relationship that
+// links the parent to it.
+type NodeRef struct {
+	// NodeType is the parent's node type.
+	NodeType string
+
+	// Properties identify the parent: either {"id": <node ID>} or the
+	// parent's identifying properties.
+	Properties map[string]any
+
+	// Relationship is the type of the relationship from the parent to the
+	// child, e.g. "HAS_PORT".
+	Relationship string
+}
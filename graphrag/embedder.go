@@ -0,0 +1,200 @@
+package graphrag
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// DefaultHashingDimensions is the vector size of a HashingEmbedder created
+// with zero dimensions.
+const DefaultHashingDimensions = 256
+
+// Embedder turns text into embedding vectors.
+type Embedder interface {
+	// Embed returns one vector of Dimensions() values per text, in order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+
+	// Dimensions returns the length of the vectors Embed returns.
+	Dimensions() int
+}
+
+var (
+	defaultEmbedderMu sync.RWMutex
+	defaultEmbedder   Embedder
+)
+
+// SetDefaultEmbedder sets the embedder used where the SDK embeds text
+// itself: Query.WithTextEmbedded and the standalone harness's semantic
+// storage. Pass nil to unset it.
+//
+// Example:
+//
+//	graphrag.SetDefaultEmbedder(graphrag.NewHashingEmbedder(0))
+func SetDefaultEmbedder(e Embedder) {
+	defaultEmbedderMu.Lock()
+	defer defaultEmbedderMu.Unlock()
+	defaultEmbedder = e
+}
+
+// DefaultEmbedder returns the embedder set with SetDefaultEmbedder, or nil.
+func DefaultEmbedder() Embedder {
+	defaultEmbedderMu.RLock()
+	defer defaultEmbedderMu.RUnlock()
+	return defaultEmbedder
+}
+
+// EmbedText embeds texts with e and checks that it returned one vector of
+// e.Dimensions() values per text. Errors wrap ErrEmbeddingFailed or
+// ErrDimensionMismatch.
+func EmbedText(ctx context.Context, e Embedder, texts ...string) ([][]float32, error) {
+	vectors, err := e.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEmbeddingFailed, err)
+	}
+	if len(vectors) != len(texts) {
+		return nil, fmt.Errorf("%w: embedder returned %d vectors for %d texts", ErrEmbeddingFailed, len(vectors), len(texts))
+	}
+	for i, v := range vectors {
+		if len(v) != e.Dimensions() {
+			return nil, fmt.Errorf("%w: embedder returned a vector of %d dimensions for text %d, want %d",
+				ErrDimensionMismatch, len(v), i, e.Dimensions())
+		}
+	}
+	return vectors, nil
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b, from -1
+// to 1, or 0 if either is a zero vector. Vectors of different lengths are
+// an ErrDimensionMismatch.
+func CosineSimilarity(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("%w: %d and %d dimensions", ErrDimensionMismatch, len(a), len(b))
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0, nil
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB)), nil
+}
+
+// HashingEmbedder is a deterministic, dependency-free Embedder for tests,
+// offline evals, and the standalone harness. It hashes the words and
+// character trigrams of a text into a fixed number of signed buckets and
+// normalizes the result, so texts sharing words and word fragments have a
+// high cosine similarity. It captures lexical overlap only, not meaning.
+type HashingEmbedder struct {
+	dimensions int
+}
+
+// NewHashingEmbedder creates a HashingEmbedder producing vectors of the
+// given size, or DefaultHashingDimensions if dimensions is zero or less.
+func NewHashingEmbedder(dimensions int) *HashingEmbedder {
+	if dimensions <= 0 {
+		dimensions = DefaultHashingDimensions
+	}
+	return &HashingEmbedder{dimensions: dimensions}
+}
+
+// Dimensions returns the length of the vectors Embed returns.
+func (e *HashingEmbedder) Dimensions() int {
+	return e.dimensions
+}
+
+// Embed returns the feature-hashed vector of each text. The same text always
+// produces the same vector; empty text produces a zero vector.
+func (e *HashingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		vectors[i] = e.embed(text)
+	}
+	return vectors, nil
+}
+
+// embed hashes the features of one text.
+func (e *HashingEmbedder) embed(text string) []float32 {
+	vector := make([]float32, e.dimensions)
+	add := func(feature string) {
+		h := fnv.New64a()
+		h.Write([]byte(feature))
+		sum := h.Sum64()
+		// The top bit picks the sign so that colliding features tend to
+		// cancel rather than accumulate
+		if sum>>63 == 0 {
+			vector[sum%uint64(e.dimensions)]++
+		} else {
+			vector[sum%uint64(e.dimensions)]--
+		}
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		add("w:" + word)
+		padded := []rune(" " + word + " ")
+		for j := 0; j+3 <= len(padded); j++ {
+			add("t:" + string(padded[j:j+3]))
+		}
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm > 0 {
+		scale := float32(1 / math.Sqrt(norm))
+		for i := range vector {
+			vector[i] *= scale
+		}
+	}
+	return vector
+}
+
+// WithTextEmbedded replaces the query's Text with its embedding from the
+// default embedder, for backends that accept raw vectors. A query that
+// already has an Embedding is returned unchanged. Errors wrap
+// ErrInvalidQuery when there is no text, and ErrEmbeddingFailed when no
+// default embedder is set or embedding fails.
+//
+// Example:
+//
+//	query, err := graphrag.NewQuery("exposed admin panels").WithTextEmbedded(ctx)
+//	if err != nil {
+//	    return err
+//	}
+func (q *Query) WithTextEmbedded(ctx context.Context) (*Query, error) {
+	if len(q.Embedding) > 0 {
+		return q, nil
+	}
+	if q.Text == "" {
+		return nil, fmt.Errorf("%w: no text to embed", ErrInvalidQuery)
+	}
+	embedder := DefaultEmbedder()
+	if embedder == nil {
+		return nil, fmt.Errorf("%w: no default embedder set", ErrEmbeddingFailed)
+	}
+
+	vectors, err := EmbedText(ctx, embedder, q.Text)
+	if err != nil {
+		return nil, err
+	}
+	q.Embedding = make([]float64, len(vectors[0]))
+	for i, v := range vectors[0] {
+		q.Embedding[i] = float64(v)
+	}
+	q.Text = ""
+	return q, nil
+}
@@ -0,0 +1,150 @@
+package graphrag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shortEmbedder returns vectors one value shorter than it declares.
+type shortEmbedder struct{ dims int }
+
+func (e shortEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i := range vectors {
+		vectors[i] = make([]float32, e.dims-1)
+	}
+	return vectors, nil
+}
+
+func (e shortEmbedder) Dimensions() int { return e.dims }
+
+// withDefaultEmbedder sets the default embedder for the duration of a test.
+func withDefaultEmbedder(t *testing.T, e Embedder) {
+	t.Helper()
+	prev := DefaultEmbedder()
+	SetDefaultEmbedder(e)
+	t.Cleanup(func() { SetDefaultEmbedder(prev) })
+}
+
+func TestHashingEmbedder(t *testing.T) {
+	ctx := context.Background()
+	e := NewHashingEmbedder(0)
+	assert.Equal(t, DefaultHashingDimensions, e.Dimensions())
+
+	texts := []string{
+		"SQL injection in the login form",
+		"SQL injection in the login form",
+		"sql INJECTION in login forms",
+		"Open SSH port on bastion host",
+		"",
+	}
+	vectors, err := EmbedText(ctx, e, texts...)
+	require.NoError(t, err)
+	require.Len(t, vectors, len(texts))
+
+	assert.Equal(t, vectors[0], vectors[1], "same text, same vector")
+	again, err := NewHashingEmbedder(0).Embed(ctx, texts[:1])
+	require.NoError(t, err)
+	assert.Equal(t, vectors[0], again[0], "deterministic across instances")
+
+	self, err := CosineSimilarity(vectors[0], vectors[0])
+	require.NoError(t, err)
+	assert.InDelta(t, 1, self, 1e-6, "vectors are normalized")
+
+	similar, err := CosineSimilarity(vectors[0], vectors[2])
+	require.NoError(t, err)
+	unrelated, err := CosineSimilarity(vectors[0], vectors[3])
+	require.NoError(t, err)
+	assert.Greater(t, similar, 0.6)
+	assert.Greater(t, similar, unrelated+0.3)
+
+	empty, err := CosineSimilarity(vectors[0], vectors[4])
+	require.NoError(t, err)
+	assert.Zero(t, empty, "empty text is a zero vector")
+
+	small := NewHashingEmbedder(32)
+	smallVectors, err := small.Embed(ctx, texts[:1])
+	require.NoError(t, err)
+	assert.Len(t, smallVectors[0], 32)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = e.Embed(cancelled, texts)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDimensionMismatch(t *testing.T) {
+	_, err := CosineSimilarity(make([]float32, 3), make([]float32, 4))
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	assert.Contains(t, err.Error(), "3 and 4 dimensions")
+
+	_, err = EmbedText(context.Background(), shortEmbedder{dims: 8}, "text")
+	assert.ErrorIs(t, err, ErrDimensionMismatch)
+	assert.Contains(t, err.Error(), "7 dimensions")
+}
+
+func TestQuery_WithTextEmbedded(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no default embedder", func(t *testing.T) {
+		withDefaultEmbedder(t, nil)
+		_, err := NewQuery("open ports").WithTextEmbedded(ctx)
+		assert.ErrorIs(t, err, ErrEmbeddingFailed)
+	})
+
+	t.Run("embeds text", func(t *testing.T) {
+		withDefaultEmbedder(t, NewHashingEmbedder(64))
+		q, err := NewQuery("open ports").WithTextEmbedded(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, q.Text)
+		assert.Len(t, q.Embedding, 64)
+		assert.NoError(t, q.Validate())
+
+		vectors, err := NewHashingEmbedder(64).Embed(ctx, []string{"open ports"})
+		require.NoError(t, err)
+		for i, v := range vectors[0] {
+			assert.Equal(t, float64(v), q.Embedding[i])
+		}
+	})
+
+	t.Run("existing embedding", func(t *testing.T) {
+		withDefaultEmbedder(t, nil)
+		q, err := NewQueryFromEmbedding([]float64{0.1, 0.2}).WithTextEmbedded(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0.1, 0.2}, q.Embedding)
+	})
+
+	t.Run("no text", func(t *testing.T) {
+		withDefaultEmbedder(t, NewHashingEmbedder(0))
+		_, err := NewStructuredQuery().WithTextEmbedded(ctx)
+		assert.ErrorIs(t, err, ErrInvalidQuery)
+	})
+
+	t.Run("embedder error", func(t *testing.T) {
+		withDefaultEmbedder(t, shortEmbedder{dims: 4})
+		_, err := NewQuery("open ports").WithTextEmbedded(ctx)
+		assert.True(t, errors.Is(err, ErrDimensionMismatch))
+	})
+}
+
+func BenchmarkHashingEmbedder(b *testing.B) {
+	docs := make([]string, 10000)
+	for i := range docs {
+		docs[i] = fmt.Sprintf("host-%d exposes port %d running nginx 1.%d on 10.0.%d.%d",
+			i, 1024+i%5000, i%30, i/256%256, i%256)
+	}
+	e := NewHashingEmbedder(0)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := e.Embed(ctx, docs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
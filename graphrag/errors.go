@@ -1,6 +1,11 @@
 package graphrag
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
 
 // Sentinel errors for GraphRAG operations.
 // These errors can be used with errors.Is() for error checking.
@@ -58,6 +63,17 @@ var (
 	//	}
 	ErrEmbeddingFailed = errors.New("embedding generation failed")
 
+	// ErrDimensionMismatch indicates that two embedding vectors, or an
+	// embedding and the dimensions its embedder declares, differ in length.
+	// Vectors from different embedders cannot be compared.
+	//
+	// Example:
+	//	score, err := graphrag.CosineSimilarity(a, b)
+	//	if errors.Is(err, graphrag.ErrDimensionMismatch) {
+	//	    log.Errorf("Embeddings come from different models: %v", err)
+	//	}
+	ErrDimensionMismatch = errors.New("embedding dimension mismatch")
+
 	// ErrStorageFailed indicates that a storage operation failed. This can occur during:
 	//   - Node creation or updates
 	//   - Relationship creation
@@ -109,4 +125,63 @@ var (
 	//	    log.Errorf("Failed to create relationship: %v", err)
 	//	}
 	ErrRelationshipFailed = errors.New("relationship operation failed")
+
+	// ErrPathNotFound indicates that no path connects two nodes within the
+	// search depth and relationship filters of a shortest path query.
+	//
+	// Example:
+	//	edges, err := harness.ShortestPath(ctx, findingID, techniqueID, opts)
+	//	if errors.Is(err, graphrag.ErrPathNotFound) {
+	//	    log.Info("finding is not linked to the technique")
+	//	}
+	ErrPathNotFound = errors.New("path not found")
 )
+
+// BatchQueryError reports the queries of a batch that failed. The other
+// queries of the batch succeeded and their results are valid.
+//
+// Example:
+//
+//	results, err := harness.QueryBatch(ctx, queries)
+//	var batchErr *graphrag.BatchQueryError
+//	if errors.As(err, &batchErr) {
+//	    for i, qerr := range batchErr.Errors {
+//	        log.Warn("query failed", "index", i, "error", qerr)
+//	    }
+//	} else if err != nil {
+//	    return err
+//	}
+type BatchQueryError struct {
+	// Errors maps the index of each failed query to its error.
+	Errors map[int]error
+}
+
+// Error summarizes the failed queries, in index order.
+func (e *BatchQueryError) Error() string {
+	indexes := e.indexes()
+	parts := make([]string, len(indexes))
+	for n, i := range indexes {
+		parts[n] = fmt.Sprintf("query %d: %v", i, e.Errors[i])
+	}
+	return fmt.Sprintf("%d batch queries failed: %s", len(indexes), strings.Join(parts, "; "))
+}
+
+// Unwrap returns the errors of the failed queries, so that errors.Is
+// matches any of them.
+func (e *BatchQueryError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, i := range e.indexes() {
+		errs = append(errs, e.Errors[i])
+	}
+	return errs
+}
+
+// indexes returns the indexes of the failed queries in order.
+func (e *BatchQueryError) indexes() []int {
+	indexes := make([]int, 0, len(e.Errors))
+	for i := range e.Errors {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
@@ -0,0 +1,494 @@
+package graphrag
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// exportVersion is the version of the mission export format.
+const exportVersion = 1
+
+// Record kinds of the mission export format.
+const (
+	recordKindMission      = "mission"
+	recordKindNode         = "node"
+	recordKindRelationship = "relationship"
+)
+
+// DefaultImportBatchSize is the number of records ImportMission stores per
+// batch when ImportOptions.BatchSize is not set.
+const DefaultImportBatchSize = 500
+
+// ErrInvalidRecord indicates that a record of a mission export is malformed
+// or inconsistent with the rest of the export.
+var ErrInvalidRecord = errors.New("invalid export record")
+
+// GraphRAGHarness is the graph access ExportMission and ImportMission need.
+// Graph stores and harness adapters implement it to snapshot and restore
+// missions.
+type GraphRAGHarness interface {
+	// MissionNodes returns all nodes of the mission.
+	MissionNodes(ctx context.Context, missionID string) ([]GraphNode, error)
+
+	// MissionRelationships returns all relationships with at least one
+	// endpoint in the mission.
+	MissionRelationships(ctx context.Context, missionID string) ([]Relationship, error)
+
+	// StoreGraphBatch stores nodes and relationships and returns the IDs of
+	// the stored nodes, in batch order.
+	StoreGraphBatch(ctx context.Context, batch Batch) ([]string, error)
+}
+
+// exportRecord is one line of a mission export. Kind discriminates the
+// record: the mission header comes first, followed by node records and then
+// relationship records.
+type exportRecord struct {
+	Kind         string        `json:"kind"`
+	MissionID    string        `json:"mission_id,omitempty"`
+	Version      int           `json:"version,omitempty"`
+	Node         *GraphNode    `json:"node,omitempty"`
+	Relationship *Relationship `json:"relationship,omitempty"`
+}
+
+// ExportStats summarizes a mission export.
+type ExportStats struct {
+	// Nodes is the number of node records written
+	Nodes int `json:"nodes"`
+
+	// Relationships is the number of relationship records written
+	Relationships int `json:"relationships"`
+
+	// NodesByType maps node types to the number of nodes written
+	NodesByType map[string]int `json:"nodes_by_type,omitempty"`
+}
+
+// ExportMission writes the nodes and relationships of a mission to w as
+// NDJSON, one record per line. The first record is a header carrying the
+// mission ID; node records follow, ordered by ID, then relationship records,
+// ordered by endpoints and type, so exports of the same graph are identical.
+//
+// Integral float properties are written with a decimal point so that
+// ImportMission restores integers and floats as they were; deterministic
+// IDs depend on the difference.
+//
+// Example:
+//
+//	f, _ := os.Create("testdata/mission.ndjson")
+//	defer f.Close()
+//	stats, err := graphrag.ExportMission(ctx, store, missionID, f)
+func ExportMission(ctx context.Context, h GraphRAGHarness, missionID string, w io.Writer) (ExportStats, error) {
+	stats := ExportStats{NodesByType: make(map[string]int)}
+	if missionID == "" {
+		return stats, fmt.Errorf("%w: mission ID is required", ErrInvalidQuery)
+	}
+
+	nodes, err := h.MissionNodes(ctx, missionID)
+	if err != nil {
+		return stats, fmt.Errorf("failed to list nodes of mission %s: %w", missionID, err)
+	}
+	rels, err := h.MissionRelationships(ctx, missionID)
+	if err != nil {
+		return stats, fmt.Errorf("failed to list relationships of mission %s: %w", missionID, err)
+	}
+
+	slices.SortFunc(nodes, func(a, b GraphNode) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(rels, func(a, b Relationship) int {
+		return cmp.Or(
+			strings.Compare(a.FromID, b.FromID),
+			strings.Compare(a.ToID, b.ToID),
+			strings.Compare(a.Type, b.Type),
+		)
+	})
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(exportRecord{Kind: recordKindMission, MissionID: missionID, Version: exportVersion}); err != nil {
+		return stats, fmt.Errorf("failed to write export header: %w", err)
+	}
+	for _, node := range nodes {
+		node.Properties = encodeNumbers(node.Properties)
+		if err := enc.Encode(exportRecord{Kind: recordKindNode, Node: &node}); err != nil {
+			return stats, fmt.Errorf("failed to write node %s: %w", node.ID, err)
+		}
+		stats.Nodes++
+		stats.NodesByType[node.Type]++
+	}
+	for _, rel := range rels {
+		rel.Properties = encodeNumbers(rel.Properties)
+		if err := enc.Encode(exportRecord{Kind: recordKindRelationship, Relationship: &rel}); err != nil {
+			return stats, fmt.Errorf("failed to write relationship %s -[%s]-> %s: %w", rel.FromID, rel.Type, rel.ToID, err)
+		}
+		stats.Relationships++
+	}
+
+	if err := bw.Flush(); err != nil {
+		return stats, fmt.Errorf("failed to write export: %w", err)
+	}
+	return stats, nil
+}
+
+// ImportOptions configures ImportMission.
+type ImportOptions struct {
+	// RemapMissionID imports the graph into another mission. Node mission
+	// IDs and "mission_id" properties are rewritten, and node IDs are left
+	// to the store to assign, so the copy does not collide with the original.
+	RemapMissionID string
+
+	// DryRun validates the export and reports what would be created without
+	// writing anything.
+	DryRun bool
+
+	// BatchSize is the number of nodes or relationships stored per batch.
+	// Defaults to DefaultImportBatchSize.
+	BatchSize int
+}
+
+// RecordError reports an invalid record of a mission export.
+type RecordError struct {
+	// Line is the 1-based line number of the record
+	Line int
+
+	// Err describes the problem
+	Err error
+}
+
+// Error returns the line number and the problem.
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// ImportStats summarizes a mission import.
+type ImportStats struct {
+	// MissionID is the mission the graph was imported into
+	MissionID string `json:"mission_id"`
+
+	// Nodes is the number of nodes created, or that would be created in a
+	// dry run
+	Nodes int `json:"nodes"`
+
+	// Relationships is the number of relationships created, or that would
+	// be created in a dry run
+	Relationships int `json:"relationships"`
+
+	// Batches is the number of batches stored
+	Batches int `json:"batches"`
+
+	// Errors lists the invalid records
+	Errors []*RecordError `json:"-"`
+}
+
+// ImportMission reads a mission export written by ExportMission from r and
+// stores it through h. Every record is validated before anything is written:
+// if any record is invalid, nothing is stored, the problems are listed in
+// ImportStats.Errors, and the returned error wraps ErrInvalidRecord. A dry
+// run reports invalid records only in ImportStats.Errors.
+//
+// Nodes are stored first, in batches of opts.BatchSize, and relationship
+// endpoints are rewritten to the node IDs the store returns. Relationships
+// to nodes outside the export keep their endpoint.
+//
+// Example:
+//
+//	f, _ := os.Open("testdata/mission.ndjson")
+//	defer f.Close()
+//	stats, err := graphrag.ImportMission(ctx, store, f, graphrag.ImportOptions{
+//	    RemapMissionID: "test-mission",
+//	})
+func ImportMission(ctx context.Context, h GraphRAGHarness, r io.Reader, opts ImportOptions) (ImportStats, error) {
+	var stats ImportStats
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+
+	missionID, nodes, rels, errs := readExport(r)
+	stats.Errors = errs
+	if missionID == "" {
+		if len(errs) == 0 {
+			return stats, fmt.Errorf("%w: export is empty", ErrInvalidRecord)
+		}
+		return stats, fmt.Errorf("%w", errs[0])
+	}
+
+	stats.MissionID = missionID
+	if opts.RemapMissionID != "" {
+		stats.MissionID = opts.RemapMissionID
+		for i := range nodes {
+			remapNode(&nodes[i], missionID, opts.RemapMissionID)
+		}
+		for i := range rels {
+			rels[i].Properties = remapProperties(rels[i].Properties, missionID, opts.RemapMissionID)
+		}
+	}
+	stats.Nodes = len(nodes)
+	stats.Relationships = len(rels)
+
+	if opts.DryRun {
+		return stats, nil
+	}
+	if len(errs) > 0 {
+		return stats, fmt.Errorf("%w: %d invalid records, first at %v", ErrInvalidRecord, len(errs), errs[0])
+	}
+
+	// Store nodes first to learn the IDs the store assigns
+	nodeIDs := make(map[string]string, len(nodes))
+	for start := 0; start < len(nodes); start += batchSize {
+		chunk := nodes[start:min(start+batchSize, len(nodes))]
+		originalIDs := make([]string, len(chunk))
+		batch := Batch{Nodes: make([]GraphNode, len(chunk))}
+		for i, node := range chunk {
+			originalIDs[i] = node.ID
+			if opts.RemapMissionID != "" {
+				node.ID = ""
+			}
+			batch.Nodes[i] = node
+		}
+
+		ids, err := h.StoreGraphBatch(ctx, batch)
+		if err != nil {
+			return stats, fmt.Errorf("failed to store nodes %d-%d: %w", start, start+len(chunk)-1, err)
+		}
+		if len(ids) != len(chunk) {
+			return stats, fmt.Errorf("%w: store returned %d IDs for %d nodes", ErrStorageFailed, len(ids), len(chunk))
+		}
+		for i, id := range ids {
+			nodeIDs[originalIDs[i]] = id
+		}
+		stats.Batches++
+	}
+
+	for start := 0; start < len(rels); start += batchSize {
+		chunk := rels[start:min(start+batchSize, len(rels))]
+		batch := Batch{Relationships: make([]Relationship, len(chunk))}
+		for i, rel := range chunk {
+			if id, ok := nodeIDs[rel.FromID]; ok {
+				rel.FromID = id
+			}
+			if id, ok := nodeIDs[rel.ToID]; ok {
+				rel.ToID = id
+			}
+			batch.Relationships[i] = rel
+		}
+
+		if _, err := h.StoreGraphBatch(ctx, batch); err != nil {
+			return stats, fmt.Errorf("failed to store relationships %d-%d: %w", start, start+len(chunk)-1, err)
+		}
+		stats.Batches++
+	}
+
+	return stats, nil
+}
+
+// readExport reads and validates all records of a mission export. It returns
+// the mission ID of the header, the valid nodes and relationships, and the
+// invalid records.
+func readExport(r io.Reader) (missionID string, nodes []GraphNode, rels []Relationship, errs []*RecordError) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	nodeIDs := make(map[string]bool)
+	type pendingRel struct {
+		line int
+		rel  Relationship
+	}
+	var pending []pendingRel
+
+	line := 0
+	fail := func(format string, args ...any) {
+		errs = append(errs, &RecordError{Line: line, Err: fmt.Errorf("%w: "+format, append([]any{ErrInvalidRecord}, args...)...)})
+	}
+
+	for scanner.Scan() {
+		line++
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+
+		var rec exportRecord
+		dec := json.NewDecoder(strings.NewReader(scanner.Text()))
+		dec.UseNumber()
+		if err := dec.Decode(&rec); err != nil {
+			fail("%v", err)
+			continue
+		}
+
+		if missionID == "" {
+			if rec.Kind != recordKindMission {
+				fail("export must start with a %q record, got %q", recordKindMission, rec.Kind)
+				return missionID, nodes, rels, errs
+			}
+			if rec.MissionID == "" {
+				fail("mission ID is required")
+				return missionID, nodes, rels, errs
+			}
+			if rec.Version != exportVersion {
+				fail("unsupported export version %d", rec.Version)
+				return missionID, nodes, rels, errs
+			}
+			missionID = rec.MissionID
+			continue
+		}
+
+		switch rec.Kind {
+		case recordKindNode:
+			node := rec.Node
+			switch {
+			case node == nil:
+				fail("node record without node")
+			case node.ID == "":
+				fail("node ID is required")
+			case node.Validate() != nil:
+				fail("node %s: %v", node.ID, node.Validate())
+			case node.MissionID != missionID:
+				fail("node %s belongs to mission %q, not %q", node.ID, node.MissionID, missionID)
+			case nodeIDs[node.ID]:
+				fail("duplicate node %s", node.ID)
+			default:
+				node.Properties = decodeNumbers(node.Properties)
+				nodeIDs[node.ID] = true
+				nodes = append(nodes, *node)
+			}
+		case recordKindRelationship:
+			rel := rec.Relationship
+			if rel == nil {
+				fail("relationship record without relationship")
+				continue
+			}
+			if err := rel.Validate(); err != nil {
+				fail("%v", err)
+				continue
+			}
+			rel.Properties = decodeNumbers(rel.Properties)
+			pending = append(pending, pendingRel{line: line, rel: *rel})
+		case recordKindMission:
+			fail("duplicate %q record", recordKindMission)
+		default:
+			fail("unknown record kind %q", rec.Kind)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		line++
+		fail("%v", err)
+	}
+
+	// Relationships are checked once all nodes are known
+	for _, p := range pending {
+		if !nodeIDs[p.rel.FromID] && !nodeIDs[p.rel.ToID] {
+			line = p.line
+			fail("relationship %s -[%s]-> %s has no endpoint in the mission", p.rel.FromID, p.rel.Type, p.rel.ToID)
+			continue
+		}
+		rels = append(rels, p.rel)
+	}
+	slices.SortFunc(errs, func(a, b *RecordError) int { return cmp.Compare(a.Line, b.Line) })
+	return missionID, nodes, rels, errs
+}
+
+// remapNode moves a node from mission oldID to mission newID.
+func remapNode(node *GraphNode, oldID, newID string) {
+	node.MissionID = newID
+	node.Properties = remapProperties(node.Properties, oldID, newID)
+}
+
+// remapProperties rewrites a "mission_id" property equal to oldID to newID.
+func remapProperties(props map[string]any, oldID, newID string) map[string]any {
+	if v, ok := props["mission_id"].(string); ok && v == oldID {
+		props["mission_id"] = newID
+	}
+	return props
+}
+
+// encodeNumbers returns a copy of v in which integral floats are json.Number
+// values with a decimal point, so that decodeNumbers can tell them from
+// integers. Maps and slices are copied; other values are returned as is.
+func encodeNumbers[T any](v T) T {
+	out, _ := encodeNumber(any(v)).(T)
+	return out
+}
+
+func encodeNumber(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if v == nil {
+			return v
+		}
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = encodeNumber(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = encodeNumber(e)
+		}
+		return out
+	case float64:
+		return floatNumber(v)
+	case float32:
+		return floatNumber(float64(v))
+	default:
+		return v
+	}
+}
+
+// floatNumber returns f as a json.Number that always contains a decimal
+// point or exponent. Non-finite values are returned as is.
+func floatNumber(f float64) any {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return f
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return json.Number(s)
+}
+
+// decodeNumbers converts the json.Number values in v to int64 when they are
+// integers and float64 otherwise.
+func decodeNumbers[T any](v T) T {
+	out, _ := decodeNumber(any(v)).(T)
+	return out
+}
+
+func decodeNumber(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = decodeNumber(e)
+		}
+		return v
+	case []any:
+		for i, e := range v {
+			v[i] = decodeNumber(e)
+		}
+		return v
+	case json.Number:
+		if !strings.ContainsAny(string(v), ".eE") {
+			if i, err := v.Int64(); err == nil {
+				return i
+			}
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
@@ -0,0 +1,236 @@
+package graphrag
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryGraph is an in-memory GraphRAGHarness. Nodes stored without an ID
+// get a sequential one.
+type memoryGraph struct {
+	nodes   map[string]GraphNode
+	rels    []Relationship
+	batches []Batch
+	nextID  int
+}
+
+func newMemoryGraph() *memoryGraph {
+	return &memoryGraph{nodes: make(map[string]GraphNode)}
+}
+
+func (g *memoryGraph) MissionNodes(ctx context.Context, missionID string) ([]GraphNode, error) {
+	var nodes []GraphNode
+	for _, node := range g.nodes {
+		if node.MissionID == missionID {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+func (g *memoryGraph) MissionRelationships(ctx context.Context, missionID string) ([]Relationship, error) {
+	var rels []Relationship
+	for _, rel := range g.rels {
+		if g.nodes[rel.FromID].MissionID == missionID || g.nodes[rel.ToID].MissionID == missionID {
+			rels = append(rels, rel)
+		}
+	}
+	return rels, nil
+}
+
+func (g *memoryGraph) StoreGraphBatch(ctx context.Context, batch Batch) ([]string, error) {
+	g.batches = append(g.batches, batch)
+	ids := make([]string, len(batch.Nodes))
+	for i, node := range batch.Nodes {
+		if node.ID == "" {
+			g.nextID++
+			node.ID = fmt.Sprintf("%s:%d", node.Type, g.nextID)
+		}
+		g.nodes[node.ID] = node
+		ids[i] = node.ID
+	}
+	g.rels = append(g.rels, batch.Relationships...)
+	return ids, nil
+}
+
+// missionGraph returns a store holding a small mission graph, a node of
+// another mission, and a technique node shared across missions.
+func missionGraph(t *testing.T) *memoryGraph {
+	t.Helper()
+	created := time.Date(2026, 3, 1, 12, 0, 0, 123456789, time.UTC)
+	node := func(id, nodeType, missionID string, props map[string]any) GraphNode {
+		return GraphNode{ID: id, Type: nodeType, MissionID: missionID, AgentName: "recon", Properties: props, CreatedAt: created, UpdatedAt: created}
+	}
+
+	g := newMemoryGraph()
+	_, err := g.StoreGraphBatch(context.Background(), Batch{
+		Nodes: []GraphNode{
+			node("host:a", NodeTypeHost, "m-1", map[string]any{"ip": "10.0.0.1", "tags": []any{"dmz", 2}}),
+			node("port:b", NodeTypePort, "m-1", map[string]any{"host_id": "host:a", "number": 443, "protocol": "tcp"}),
+			node("finding:c", NodeTypeFinding, "m-1", map[string]any{"mission_id": "m-1", "fingerprint": "f-1", "confidence": 1.0, "cvss": 7.5}),
+			node("host:d", NodeTypeHost, "m-2", map[string]any{"ip": "10.0.0.2"}),
+			node("technique:T1190", NodeTypeTechnique, "", map[string]any{"technique_id": "T1190"}),
+		},
+		Relationships: []Relationship{
+			{FromID: "host:a", ToID: "port:b", Type: "HAS_PORT"},
+			{FromID: "finding:c", ToID: "port:b", Type: "AFFECTS", Properties: map[string]any{"weight": 2.0}},
+			{FromID: "finding:c", ToID: "technique:T1190", Type: "USES_TECHNIQUE", Properties: map[string]any{"confidence": 0.9}},
+			{FromID: "host:d", ToID: "technique:T1190", Type: "USES_TECHNIQUE"},
+		},
+	})
+	require.NoError(t, err)
+	g.batches = nil
+	return g
+}
+
+func exportString(t *testing.T, g *memoryGraph, missionID string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	_, err := ExportMission(context.Background(), g, missionID, &buf)
+	require.NoError(t, err)
+	return buf.String()
+}
+
+func TestExportMission(t *testing.T) {
+	g := missionGraph(t)
+	var buf bytes.Buffer
+
+	stats, err := ExportMission(context.Background(), g, "m-1", &buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, stats.Nodes)
+	assert.Equal(t, 3, stats.Relationships)
+	assert.Equal(t, map[string]int{NodeTypeHost: 1, NodeTypePort: 1, NodeTypeFinding: 1}, stats.NodesByType)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 7)
+	assert.Equal(t, `{"kind":"mission","mission_id":"m-1","version":1}`, lines[0])
+	assert.Contains(t, lines[1], `"kind":"node"`)
+	assert.Contains(t, lines[1], `"id":"finding:c"`)
+	assert.Contains(t, lines[1], `"confidence":1.0`, "integral floats keep a decimal point")
+	assert.Contains(t, lines[3], `"number":443,`)
+	assert.Contains(t, lines[4], `"kind":"relationship"`)
+	assert.NotContains(t, buf.String(), "host:d", "other missions are not exported")
+
+	assert.Equal(t, buf.String(), exportString(t, g, "m-1"), "exports are deterministic")
+}
+
+func TestImportMission_RoundTrip(t *testing.T) {
+	exported := exportString(t, missionGraph(t), "m-1")
+
+	g := newMemoryGraph()
+	stats, err := ImportMission(context.Background(), g, strings.NewReader(exported), ImportOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "m-1", stats.MissionID)
+	assert.Equal(t, 3, stats.Nodes)
+	assert.Equal(t, 3, stats.Relationships)
+	assert.Empty(t, stats.Errors)
+
+	port := g.nodes["port:b"]
+	assert.Equal(t, int64(443), port.Properties["number"])
+	assert.Equal(t, 1.0, g.nodes["finding:c"].Properties["confidence"])
+	assert.Equal(t, []any{"dmz", int64(2)}, g.nodes["host:a"].Properties["tags"])
+
+	assert.Equal(t, exported, exportString(t, g, "m-1"))
+}
+
+func TestImportMission_Remap(t *testing.T) {
+	exported := exportString(t, missionGraph(t), "m-1")
+
+	g := newMemoryGraph()
+	stats, err := ImportMission(context.Background(), g, strings.NewReader(exported), ImportOptions{RemapMissionID: "fixture"})
+	require.NoError(t, err)
+	assert.Equal(t, "fixture", stats.MissionID)
+
+	nodes, err := g.MissionNodes(context.Background(), "fixture")
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+
+	byType := make(map[string]GraphNode)
+	for _, node := range nodes {
+		byType[node.Type] = node
+	}
+	finding := byType[NodeTypeFinding]
+	assert.NotEqual(t, "finding:c", finding.ID, "remapped nodes get new IDs")
+	assert.Equal(t, "fixture", finding.Properties["mission_id"])
+
+	want := map[string]bool{
+		byType[NodeTypeHost].ID + " HAS_PORT " + byType[NodeTypePort].ID: true,
+		finding.ID + " AFFECTS " + byType[NodeTypePort].ID:               true,
+		finding.ID + " USES_TECHNIQUE technique:T1190":                   true,
+	}
+	got := make(map[string]bool)
+	for _, rel := range g.rels {
+		got[rel.FromID+" "+rel.Type+" "+rel.ToID] = true
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestImportMission_Batches(t *testing.T) {
+	exported := exportString(t, missionGraph(t), "m-1")
+
+	g := newMemoryGraph()
+	stats, err := ImportMission(context.Background(), g, strings.NewReader(exported), ImportOptions{BatchSize: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, stats.Batches)
+	require.Len(t, g.batches, 4)
+	assert.Len(t, g.batches[0].Nodes, 2)
+	assert.Len(t, g.batches[1].Nodes, 1)
+	assert.Len(t, g.batches[2].Relationships, 2)
+	assert.Len(t, g.batches[3].Relationships, 1)
+}
+
+func TestImportMission_InvalidRecords(t *testing.T) {
+	export := strings.Join([]string{
+		`{"kind":"mission","mission_id":"m-1","version":1}`,
+		`{"kind":"node","node":{"id":"host:a","type":"host","mission_id":"m-1"}}`,
+		`{"kind":"node","node":{"id":"host:b","type":"host","mission_id":"m-2"}}`,
+		`{"kind":"node","node":{"id":"","type":"host","mission_id":"m-1"}}`,
+		`{"kind":"edge"}`,
+		`not json`,
+		`{"kind":"relationship","relationship":{"from_id":"x","to_id":"y","type":"RELATED_TO"}}`,
+		`{"kind":"relationship","relationship":{"from_id":"host:a","to_id":"y","type":"RELATED_TO"}}`,
+	}, "\n")
+
+	t.Run("dry run", func(t *testing.T) {
+		g := newMemoryGraph()
+		stats, err := ImportMission(context.Background(), g, strings.NewReader(export), ImportOptions{DryRun: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, stats.Nodes)
+		assert.Equal(t, 1, stats.Relationships)
+		lines := make([]int, len(stats.Errors))
+		for i, recErr := range stats.Errors {
+			lines[i] = recErr.Line
+			assert.ErrorIs(t, recErr, ErrInvalidRecord)
+		}
+		assert.Equal(t, []int{3, 4, 5, 6, 7}, lines)
+		assert.Empty(t, g.batches)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		g := newMemoryGraph()
+		stats, err := ImportMission(context.Background(), g, strings.NewReader(export), ImportOptions{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrInvalidRecord))
+		assert.Contains(t, err.Error(), "5 invalid records, first at line 3")
+		assert.Len(t, stats.Errors, 5)
+		assert.Empty(t, g.batches, "nothing is written when a record is invalid")
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		_, err := ImportMission(context.Background(), newMemoryGraph(), strings.NewReader(`{"kind":"node","node":{"id":"a","type":"host"}}`), ImportOptions{DryRun: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `must start with a "mission" record`)
+	})
+}
@@ -37,6 +37,15 @@
 // This guarantees that the same logical node always produces the same ID,
 // regardless of how the properties are passed or stored.
 //
+// When two nodes that should be identical get different IDs, Canonical
+// returns the exact string that is hashed for each, so the normalization
+// difference (e.g. 443 vs 443.0) can be seen by diffing them:
+//
+//	a, _ := gen.Canonical("port", propsA)
+//	b, _ := gen.Canonical("port", propsB)
+//	fmt.Println(a) // port:host_id=host:abc|number=443|protocol=tcp
+//	fmt.Println(b) // port:host_id=host:abc|number=443.000000|protocol=tcp
+//
 // # Usage
 //
 // Basic usage with the default registry:
@@ -59,6 +68,15 @@
 // The generator validates that all identifying properties are present
 // and returns clear errors if validation fails.
 //
+// Typed nodes that carry their own natural key can skip the registry with
+// FromIdentifying, which treats every given property as identifying:
+//
+//	portID, err := id.FromIdentifying("port", map[string]any{
+//	    "parent_id": hostID,
+//	    "number":    443,
+//	    "protocol":  "tcp",
+//	})
+//
 // # Determinism Guarantees
 //
 // The generator guarantees:
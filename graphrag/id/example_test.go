@@ -157,3 +157,19 @@ func ExampleDeterministicGenerator_Generate_unknownNodeType() {
 	// Output:
 	// Error occurred: true
 }
+
+// ExampleDeterministicGenerator_Canonical demonstrates inspecting the
+// canonical form behind an ID to debug unexpected deduplication misses.
+func ExampleDeterministicGenerator_Canonical() {
+	registry := graphrag.NewDefaultNodeTypeRegistry()
+	gen := id.NewGenerator(registry)
+
+	// The same port recorded with an integer and a float port number
+	a, _ := gen.Canonical("port", map[string]any{"host_id": "host:abc", "number": 443, "protocol": "tcp"})
+	b, _ := gen.Canonical("port", map[string]any{"host_id": "host:abc", "number": 443.0, "protocol": "TCP "})
+	fmt.Println(a)
+	fmt.Println(b)
+	// Output:
+	// port:host_id=host:abc|number=443|protocol=tcp
+	// port:host_id=host:abc|number=443.000000|protocol=tcp
+}
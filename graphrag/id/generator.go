@@ -27,6 +27,16 @@ type Generator interface {
 	//   id, err := gen.Generate("host", map[string]any{"ip": "10.0.0.1"})
 	//   // id = "host:ABC123xyz789"
 	Generate(nodeType string, properties map[string]any) (string, error)
+
+	// Canonical returns the exact canonical string that Generate hashes for
+	// the node type and properties. It is a debugging aid: when two nodes
+	// that should be identical get different IDs, diff their canonical forms
+	// to spot the normalization difference.
+	//
+	// Example:
+	//   a, _ := gen.Canonical("port", propsA) // port:host_id=host:abc|number=443|protocol=tcp
+	//   b, _ := gen.Canonical("port", propsB) // port:host_id=host:abc|number=443.000000|protocol=tcp
+	Canonical(nodeType string, properties map[string]any) (string, error)
 }
 
 // DeterministicGenerator implements Generator using SHA-256 hashing.
@@ -65,6 +75,61 @@ func NewGenerator(registry graphrag.NodeTypeRegistry) *DeterministicGenerator {
 
 // Generate creates a deterministic ID from node type and properties.
 func (g *DeterministicGenerator) Generate(nodeType string, properties map[string]any) (string, error) {
+	// Steps 1-3: Build the canonical string
+	canonical, err := g.Canonical(nodeType, properties)
+	if err != nil {
+		return "", err
+	}
+
+	return hashCanonical(nodeType, canonical), nil
+}
+
+// FromIdentifying creates a deterministic ID from a node type and the
+// properties that identify the node, without consulting a registry: every
+// key in identifying is part of the identity. It uses the same canonical
+// form and hash as Generate, so it suits typed nodes that carry their own
+// natural key.
+//
+// Example:
+//
+//	portID, err := id.FromIdentifying("port", map[string]any{
+//	    "parent_id": hostID,
+//	    "number":    443,
+//	    "protocol":  "tcp",
+//	})
+func FromIdentifying(nodeType string, identifying map[string]any) (string, error) {
+	if nodeType == "" {
+		return "", fmt.Errorf("node type is required")
+	}
+	if len(identifying) == 0 {
+		return "", fmt.Errorf("node type %q: %w", nodeType, graphrag.ErrMissingIdentifyingProperties)
+	}
+
+	props := make([]string, 0, len(identifying))
+	for prop := range identifying {
+		props = append(props, prop)
+	}
+
+	var g DeterministicGenerator
+	canonical, err := g.buildCanonicalString(nodeType, props, identifying)
+	if err != nil {
+		return "", fmt.Errorf("failed to build canonical string for node type %q: %w", nodeType, err)
+	}
+	return hashCanonical(nodeType, canonical), nil
+}
+
+// hashCanonical turns a canonical string into an ID: the node type followed
+// by the base64url encoding of the first 12 bytes (96 bits) of its SHA-256
+// hash.
+func hashCanonical(nodeType, canonical string) string {
+	hash := sha256.Sum256([]byte(canonical))
+	encoded := base64.RawURLEncoding.EncodeToString(hash[:12])
+	return fmt.Sprintf("%s:%s", nodeType, encoded)
+}
+
+// Canonical returns the canonical string that Generate hashes for the node
+// type and properties. Only identifying properties appear in it.
+func (g *DeterministicGenerator) Canonical(nodeType string, properties map[string]any) (string, error) {
 	// Step 1: Get identifying properties from registry
 	identifyingProps, err := g.registry.GetIdentifyingProperties(nodeType)
 	if err != nil {
@@ -82,15 +147,7 @@ func (g *DeterministicGenerator) Generate(nodeType string, properties map[string
 	if err != nil {
 		return "", fmt.Errorf("failed to build canonical string for node type %q: %w", nodeType, err)
 	}
-
-	// Step 4: SHA-256 hash the canonical string
-	hash := sha256.Sum256([]byte(canonical))
-
-	// Step 5: Base64url encode first 12 bytes (96 bits)
-	encoded := base64.RawURLEncoding.EncodeToString(hash[:12])
-
-	// Step 6: Return formatted ID
-	return fmt.Sprintf("%s:%s", nodeType, encoded), nil
+	return canonical, nil
 }
 
 // buildCanonicalString creates a canonical string representation of the identifying properties.
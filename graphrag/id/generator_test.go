@@ -1,6 +1,7 @@
 package id
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -449,3 +450,82 @@ func TestGenerateWithExtraProperties(t *testing.T) {
 		t.Errorf("IDs should match (extra properties ignored): %q != %q", id, idMinimal)
 	}
 }
+
+func TestCanonical(t *testing.T) {
+	registry := graphrag.NewDefaultNodeTypeRegistry()
+	gen := NewGenerator(registry)
+
+	canonical, err := gen.Canonical("port", map[string]any{
+		"protocol": " TCP ",
+		"number":   443,
+		"host_id":  "host:abc",
+		"banner":   "ignored, not identifying",
+	})
+	if err != nil {
+		t.Fatalf("Canonical() error = %v", err)
+	}
+	if want := "port:host_id=host:abc|number=443|protocol=tcp"; canonical != want {
+		t.Errorf("Canonical() = %q, want %q", canonical, want)
+	}
+
+	// The canonical form shows why these two ports get different IDs
+	asFloat, err := gen.Canonical("port", map[string]any{"protocol": "tcp", "number": 443.0, "host_id": "host:abc"})
+	if err != nil {
+		t.Fatalf("Canonical() error = %v", err)
+	}
+	if !strings.Contains(asFloat, "number=443.000000") {
+		t.Errorf("Canonical() = %q, want the float formatting visible", asFloat)
+	}
+
+	// Generate hashes exactly the canonical string
+	id1, _ := gen.Generate("port", map[string]any{"protocol": " TCP ", "number": 443, "host_id": "host:abc"})
+	id2, _ := gen.Generate("port", map[string]any{"protocol": "tcp", "number": int64(443), "host_id": "host:abc"})
+	canonical2, _ := gen.Canonical("port", map[string]any{"protocol": "tcp", "number": int64(443), "host_id": "host:abc"})
+	if (id1 == id2) != (canonical == canonical2) {
+		t.Errorf("IDs equal = %v but canonical forms equal = %v", id1 == id2, canonical == canonical2)
+	}
+
+	if _, err := gen.Canonical("host", map[string]any{}); err == nil {
+		t.Error("Canonical() should fail when identifying properties are missing")
+	}
+	if _, err := gen.Canonical("custom_type", map[string]any{"foo": "bar"}); err == nil {
+		t.Error("Canonical() should fail for unknown node types")
+	}
+}
+
+func TestFromIdentifying(t *testing.T) {
+	gen := NewGenerator(graphrag.NewDefaultNodeTypeRegistry())
+
+	// With the registry's identifying properties, both produce the same ID
+	want, err := gen.Generate("host", map[string]any{"ip": "10.0.0.1"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	got, err := FromIdentifying("host", map[string]any{"ip": " 10.0.0.1 "})
+	if err != nil {
+		t.Fatalf("FromIdentifying() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("FromIdentifying() = %q, want %q", got, want)
+	}
+
+	// Types the registry does not know work too, and every key counts
+	a, err := FromIdentifying("port", map[string]any{"parent_id": "host:a", "number": 443, "protocol": "tcp"})
+	if err != nil {
+		t.Fatalf("FromIdentifying() error = %v", err)
+	}
+	b, _ := FromIdentifying("port", map[string]any{"parent_id": "host:b", "number": 443, "protocol": "tcp"})
+	if a == b {
+		t.Error("FromIdentifying() gave ports on different hosts the same ID")
+	}
+	if !strings.HasPrefix(a, "port:") {
+		t.Errorf("FromIdentifying() = %q, want a port: prefix", a)
+	}
+
+	if _, err := FromIdentifying("host", nil); !errors.Is(err, graphrag.ErrMissingIdentifyingProperties) {
+		t.Errorf("FromIdentifying() error = %v, want ErrMissingIdentifyingProperties", err)
+	}
+	if _, err := FromIdentifying("", map[string]any{"ip": "10.0.0.1"}); err == nil {
+		t.Error("FromIdentifying() should fail without a node type")
+	}
+}
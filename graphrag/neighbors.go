@@ -0,0 +1,75 @@
+package graphrag
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidateNeighborQuery checks the arguments of a one-hop neighbor query:
+// nodeID is required and direction must be "outgoing", "incoming", "both",
+// or empty for "outgoing".
+func ValidateNeighborQuery(nodeID, direction string) error {
+	if nodeID == "" {
+		return fmt.Errorf("%w: node ID is required", ErrInvalidQuery)
+	}
+	switch direction {
+	case "", "outgoing", "incoming", "both":
+		return nil
+	default:
+		return fmt.Errorf("%w: invalid direction %q (must be outgoing, incoming, or both)", ErrInvalidQuery, direction)
+	}
+}
+
+// FindNeighbors returns the nodes one relationship away from nodeID, listing
+// relationships with neighbors and looking up nodes with nodes. It is the
+// reference implementation of the neighbor query that graph backends follow:
+//
+//   - Only relationships of type relType are followed; empty follows any type.
+//   - direction is "outgoing", "incoming", or "both", and defaults to
+//     "outgoing". Bidirectional relationships are followed either way.
+//   - Each neighbor is returned once, in the order of the first relationship
+//     that reaches it. The node itself is returned only for a self-loop.
+//   - Relationships to nodes that no longer exist are skipped.
+//
+// It is equivalent to a depth-1 Traverse restricted to relType, without the
+// path bookkeeping.
+//
+// Example:
+//
+//	findings, err := graphrag.FindNeighbors(ctx, store.Relationships, store.Node,
+//	    techniqueID, graphrag.RelTypeUSESTECHNIQUE, "incoming")
+func FindNeighbors(ctx context.Context, neighbors NeighborFunc, nodes NodeFunc, nodeID, relType, direction string) ([]GraphNode, error) {
+	if err := ValidateNeighborQuery(nodeID, direction); err != nil {
+		return nil, err
+	}
+	if direction == "" {
+		direction = "outgoing"
+	}
+
+	rels, err := neighbors(ctx, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand node %s: %w", nodeID, err)
+	}
+
+	seen := make(map[string]bool)
+	var result []GraphNode
+	for _, rel := range rels {
+		if relType != "" && rel.Type != relType {
+			continue
+		}
+		edge, ok := followRelationship(rel, nodeID, direction)
+		if !ok || seen[edge.ToID] {
+			continue
+		}
+		seen[edge.ToID] = true
+
+		node, err := nodes(ctx, edge.ToID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up node %s: %w", edge.ToID, err)
+		}
+		if node != nil {
+			result = append(result, *node)
+		}
+	}
+	return result, nil
+}
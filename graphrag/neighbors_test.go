@@ -0,0 +1,80 @@
+package graphrag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nodeIDs returns the IDs of nodes in order.
+func nodeIDs(nodes []GraphNode) []string {
+	ids := make([]string, len(nodes))
+	for i, n := range nodes {
+		ids[i] = n.ID
+	}
+	return ids
+}
+
+func TestFindNeighbors_Direction(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		nodeID    string
+		relType   string
+		direction string
+		want      []string
+	}{
+		{"outgoing by default", "host-1", "", "", []string{"service", "host-2"}},
+		{"incoming", "host-1", "", "incoming", []string{"finding", "host-3"}},
+		{"both", "host-1", "", "both", []string{"finding", "service", "host-2", "host-3"}},
+		{"relationship type", "host-1", "SIMILAR_TO", "both", []string{"host-2", "host-3"}},
+		{"findings using a technique", "technique", RelTypeUSESTECHNIQUE, "incoming", []string{"finding"}},
+		{"no neighbors", "host-4", "", "outgoing", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindNeighbors(ctx, neighbors, nodes, tt.nodeID, tt.relType, tt.direction)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, nodeIDs(got))
+		})
+	}
+}
+
+func TestFindNeighbors_Bidirectional(t *testing.T) {
+	neighbors := staticGraph([]Relationship{
+		{FromID: "host-1", ToID: "host-2", Type: "SIMILAR_TO", Bidirectional: true},
+		{FromID: "host-2", ToID: "host-1", Type: "SIMILAR_TO", Bidirectional: true},
+		{FromID: "host-2", ToID: "gone", Type: "SIMILAR_TO"},
+	}, nil)
+	nodes := staticNodes(map[string]string{"host-1": NodeTypeHost, "host-2": NodeTypeHost})
+
+	got, err := FindNeighbors(context.Background(), neighbors, nodes, "host-2", "", "incoming")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host-1"}, nodeIDs(got), "bidirectional relationships are followed once either way")
+
+	got, err = FindNeighbors(context.Background(), neighbors, nodes, "host-2", "", "outgoing")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host-1"}, nodeIDs(got), "missing nodes are skipped")
+}
+
+func TestFindNeighbors_Errors(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+	ctx := context.Background()
+
+	_, err := FindNeighbors(ctx, neighbors, nodes, "", "", "outgoing")
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+
+	_, err = FindNeighbors(ctx, neighbors, nodes, "host-1", "", "sideways")
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+
+	boom := errors.New("store unavailable")
+	failing := func(ctx context.Context, nodeID string) ([]Relationship, error) { return nil, boom }
+	_, err = FindNeighbors(ctx, failing, nodes, "host-1", "", "outgoing")
+	assert.ErrorIs(t, err, boom)
+}
@@ -3,6 +3,8 @@ package graphrag
 import (
 	"errors"
 	"time"
+
+	"github.com/zero-day-ai/sdk/input"
 )
 
 // GraphNode represents a node in the GraphRAG knowledge graph.
@@ -76,10 +78,105 @@ func (n *GraphNode) WithContent(content string) *GraphNode {
 }
 
 // Validate checks that the node has all required fields set correctly.
-// Returns an error if Type is empty.
+// Returns an error if Type is empty, or if Type is a custom type registered
+// with RegisterCustomType and an identifying property is missing.
 func (n *GraphNode) Validate() error {
 	if n.Type == "" {
 		return errors.New("node type is required")
 	}
-	return nil
+	return validateCustomType(n)
+}
+
+// The property accessors below read Properties with the coercion rules of
+// the input package, so values that arrive as json.Number, strings, or
+// int64 after a round trip through the backend read the same as native
+// values. Keys may be dotted paths into nested property maps, such as
+// "http.status"; a property whose own name contains the dots takes
+// precedence. All accessors are safe on a nil or zero GraphNode.
+
+// GetString returns the string property at key, or defaultVal if it is
+// absent or not a string.
+func (n *GraphNode) GetString(key string, defaultVal string) string {
+	m, k := n.property(key)
+	return input.GetString(m, k, defaultVal)
+}
+
+// GetInt returns the property at key as an int, or defaultVal if it is
+// absent or not numeric.
+func (n *GraphNode) GetInt(key string, defaultVal int) int {
+	m, k := n.property(key)
+	return input.GetInt(m, k, defaultVal)
+}
+
+// GetFloat returns the property at key as a float64, or defaultVal if it is
+// absent or not numeric.
+func (n *GraphNode) GetFloat(key string, defaultVal float64) float64 {
+	m, k := n.property(key)
+	return input.GetFloat64(m, k, defaultVal)
+}
+
+// GetBool returns the bool property at key, or defaultVal if it is absent
+// or not a bool.
+func (n *GraphNode) GetBool(key string, defaultVal bool) bool {
+	m, k := n.property(key)
+	return input.GetBool(m, k, defaultVal)
+}
+
+// GetTime returns the property at key as a time. Strings are parsed with
+// layout (time.RFC3339 if empty) and numbers are read as Unix seconds. It
+// returns the zero time if the property is absent or cannot be converted.
+func (n *GraphNode) GetTime(key string, layout string) time.Time {
+	m, k := n.property(key)
+	return input.GetTime(m, k, layout)
+}
+
+// GetStringSlice returns the property at key as a []string, converting the
+// elements of a []any and wrapping a single string. It returns nil if the
+// property is absent or cannot be converted.
+func (n *GraphNode) GetStringSlice(key string) []string {
+	m, k := n.property(key)
+	return input.GetStringSlice(m, k)
+}
+
+// HasProperty reports whether the property at key is set, distinguishing an
+// absent property from one holding a zero value. A nil value counts as set.
+func (n *GraphNode) HasProperty(key string) bool {
+	m, k := n.property(key)
+	_, ok := m[k]
+	return ok
+}
+
+// property resolves a possibly dotted key to the map holding it and the
+// key within that map. It returns a nil map if the path does not exist.
+func (n *GraphNode) property(key string) (map[string]any, string) {
+	if n == nil {
+		return nil, key
+	}
+	return resolvePropertyPath(n.Properties, key)
+}
+
+// resolvePropertyPath walks nested maps along the dots in key. An exact
+// match of the remaining key wins over descending into a nested map.
+func resolvePropertyPath(m map[string]any, key string) (map[string]any, string) {
+	if m == nil {
+		return nil, key
+	}
+	if _, ok := m[key]; ok {
+		return m, key
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] != '.' {
+			continue
+		}
+		nested, ok := m[key[:i]].(map[string]any)
+		if !ok {
+			continue
+		}
+		if found, k := resolvePropertyPath(nested, key[i+1:]); found != nil {
+			if _, ok := found[k]; ok {
+				return found, k
+			}
+		}
+	}
+	return m, key
 }
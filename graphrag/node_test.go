@@ -1,6 +1,7 @@
 package graphrag
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 )
@@ -113,3 +114,155 @@ func TestGraphNode_WithProperty_NilMap(t *testing.T) {
 		t.Errorf("expected Properties['key'] to be 'value', got %v", node.Properties["key"])
 	}
 }
+
+func TestGraphNode_PropertyAccessors(t *testing.T) {
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Values in the shapes FromTypedMap and JSON decoding produce
+	node := NewGraphNode("Finding").WithProperties(map[string]any{
+		"severity":    "high",
+		"cvss_score":  9.8,
+		"cvss_number": json.Number("7.5"),
+		"cvss_string": "6.1",
+		"port":        int64(443),
+		"port_float":  float64(8080),
+		"port_string": "22",
+		"exploitable": true,
+		"created_at":  "2026-03-01T12:00:00Z",
+		"seen_unix":   created.Unix(),
+		"tags":        []any{"web", "auth"},
+		"cwe":         "CWE-89",
+		"zero":        int64(0),
+		"empty":       nil,
+		"http": map[string]any{
+			"status": int64(500),
+			"headers": map[string]any{
+				"server": "nginx",
+			},
+		},
+		"http.method": "POST",
+	})
+
+	stringTests := []struct {
+		key  string
+		want string
+	}{
+		{"severity", "high"},
+		{"http.headers.server", "nginx"},
+		{"http.method", "POST"},
+		{"cvss_score", "fallback"},
+		{"missing", "fallback"},
+		{"http.missing", "fallback"},
+	}
+	for _, tt := range stringTests {
+		if got := node.GetString(tt.key, "fallback"); got != tt.want {
+			t.Errorf("GetString(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+
+	floatTests := []struct {
+		key  string
+		want float64
+	}{
+		{"cvss_score", 9.8},
+		{"cvss_number", 7.5},
+		{"cvss_string", 6.1},
+		{"port", 443},
+		{"severity", -1},
+		{"missing", -1},
+	}
+	for _, tt := range floatTests {
+		if got := node.GetFloat(tt.key, -1); got != tt.want {
+			t.Errorf("GetFloat(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+
+	intTests := []struct {
+		key  string
+		want int
+	}{
+		{"port", 443},
+		{"port_float", 8080},
+		{"port_string", 22},
+		{"http.status", 500},
+		{"zero", 0},
+		{"severity", -1},
+		{"empty", -1},
+	}
+	for _, tt := range intTests {
+		if got := node.GetInt(tt.key, -1); got != tt.want {
+			t.Errorf("GetInt(%q) = %d, want %d", tt.key, got, tt.want)
+		}
+	}
+
+	if !node.GetBool("exploitable", false) {
+		t.Error("GetBool(exploitable) = false, want true")
+	}
+	if !node.GetBool("severity", true) {
+		t.Error("GetBool(severity) should return the default for a non-bool")
+	}
+
+	if got := node.GetTime("created_at", ""); !got.Equal(created) {
+		t.Errorf("GetTime(created_at) = %v, want %v", got, created)
+	}
+	if got := node.GetTime("seen_unix", ""); !got.Equal(created) {
+		t.Errorf("GetTime(seen_unix) = %v, want %v", got, created)
+	}
+	if got := node.GetTime("severity", ""); !got.IsZero() {
+		t.Errorf("GetTime(severity) = %v, want zero", got)
+	}
+
+	if got := node.GetStringSlice("tags"); len(got) != 2 || got[0] != "web" || got[1] != "auth" {
+		t.Errorf("GetStringSlice(tags) = %v", got)
+	}
+	if got := node.GetStringSlice("cwe"); len(got) != 1 || got[0] != "CWE-89" {
+		t.Errorf("GetStringSlice(cwe) = %v", got)
+	}
+
+	hasTests := []struct {
+		key  string
+		want bool
+	}{
+		{"zero", true},
+		{"empty", true},
+		{"http.status", true},
+		{"http", true},
+		{"missing", false},
+		{"http.missing", false},
+		{"severity.nested", false},
+	}
+	for _, tt := range hasTests {
+		if got := node.HasProperty(tt.key); got != tt.want {
+			t.Errorf("HasProperty(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestGraphNode_PropertyAccessorsZeroNode(t *testing.T) {
+	var zero GraphNode
+	var nilNode *GraphNode
+
+	for _, n := range []*GraphNode{&zero, nilNode} {
+		if got := n.GetString("a.b", "def"); got != "def" {
+			t.Errorf("GetString() = %q, want default", got)
+		}
+		if got := n.GetFloat("a", 1.5); got != 1.5 {
+			t.Errorf("GetFloat() = %v, want default", got)
+		}
+		if got := n.GetInt("a", 3); got != 3 {
+			t.Errorf("GetInt() = %v, want default", got)
+		}
+		if !n.GetBool("a", true) {
+			t.Error("GetBool() should return the default")
+		}
+		if !n.GetTime("a", "").IsZero() {
+			t.Error("GetTime() should return the zero time")
+		}
+		if n.GetStringSlice("a") != nil {
+			t.Error("GetStringSlice() should return nil")
+		}
+		if n.HasProperty("a") {
+			t.Error("HasProperty() should be false")
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package graphrag
 
+import (
+	"fmt"
+	"slices"
+)
+
 // Batch represents a collection of nodes and relationships to be created or updated together.
 // It supports builder pattern methods for easy construction.
 type Batch struct {
@@ -50,8 +55,41 @@ type TraversalOptions struct {
 	// - "incoming": follow relationships from target to source
 	// - "both": follow relationships in both directions
 	Direction string `json:"direction"`
+
+	// MustPassNodeTypes requires result paths to pass through at least one
+	// node of each listed type between the origin and the result node.
+	// Results whose path satisfies the constraint have MustPassSatisfied set;
+	// other nodes are not returned.
+	MustPassNodeTypes []string `json:"must_pass_node_types,omitempty"`
+
+	// AvoidNodeTypes lists node types the traversal never enters. Nodes of
+	// these types are neither returned nor traversed through.
+	AvoidNodeTypes []string `json:"avoid_node_types,omitempty"`
+
+	// CycleHandling controls whether a path may revisit nodes. Defaults to
+	// CyclePrune.
+	CycleHandling CycleHandling `json:"cycle_handling,omitempty"`
+
+	// MaxRevisits is the number of times a path may revisit the same node
+	// with CycleAllowRevisit. It must be positive with CycleAllowRevisit and
+	// is ignored otherwise.
+	MaxRevisits int `json:"max_revisits,omitempty"`
 }
 
+// CycleHandling controls how a traversal treats cycles, such as chains of
+// SIMILAR_TO relationships.
+type CycleHandling string
+
+const (
+	// CyclePrune never revisits a node within a path.
+	CyclePrune CycleHandling = "prune"
+
+	// CycleAllowRevisit lets a path revisit a node up to
+	// TraversalOptions.MaxRevisits times, e.g. to pass through a technique
+	// and return to the asset it was reached from.
+	CycleAllowRevisit CycleHandling = "allow_revisit"
+)
+
 // NewTraversalOptions creates a new TraversalOptions with sensible defaults.
 // Default values: MaxDepth=3, Direction="outgoing"
 func NewTraversalOptions() *TraversalOptions {
@@ -87,3 +125,67 @@ func (t *TraversalOptions) WithDirection(direction string) *TraversalOptions {
 	t.Direction = direction
 	return t
 }
+
+// WithMustPassNodeTypes sets the node types result paths must pass through
+// and returns the options for chaining.
+func (t *TraversalOptions) WithMustPassNodeTypes(types []string) *TraversalOptions {
+	t.MustPassNodeTypes = types
+	return t
+}
+
+// WithAvoidNodeTypes sets the node types the traversal never enters and
+// returns the options for chaining.
+func (t *TraversalOptions) WithAvoidNodeTypes(types []string) *TraversalOptions {
+	t.AvoidNodeTypes = types
+	return t
+}
+
+// WithAllowRevisit lets paths revisit a node up to n times and returns the
+// options for chaining.
+func (t *TraversalOptions) WithAllowRevisit(n int) *TraversalOptions {
+	t.CycleHandling = CycleAllowRevisit
+	t.MaxRevisits = n
+	return t
+}
+
+// Normalize returns a copy of the options with defaults applied: MaxDepth 3,
+// Direction "outgoing" and CycleHandling CyclePrune.
+func (t TraversalOptions) Normalize() TraversalOptions {
+	if t.MaxDepth <= 0 {
+		t.MaxDepth = 3
+	}
+	if t.Direction == "" {
+		t.Direction = "outgoing"
+	}
+	if t.CycleHandling == "" {
+		t.CycleHandling = CyclePrune
+	}
+	return t
+}
+
+// Validate checks the direction and cycle handling, and that no node type is
+// both required and avoided.
+func (t TraversalOptions) Validate() error {
+	switch t.Direction {
+	case "", "outgoing", "incoming", "both":
+	default:
+		return fmt.Errorf("%w: invalid direction %q (must be outgoing, incoming, or both)", ErrInvalidQuery, t.Direction)
+	}
+
+	switch t.CycleHandling {
+	case "", CyclePrune:
+	case CycleAllowRevisit:
+		if t.MaxRevisits <= 0 {
+			return fmt.Errorf("%w: cycle handling %q requires a positive max revisits", ErrInvalidQuery, t.CycleHandling)
+		}
+	default:
+		return fmt.Errorf("%w: invalid cycle handling %q (must be %s or %s)", ErrInvalidQuery, t.CycleHandling, CyclePrune, CycleAllowRevisit)
+	}
+
+	for _, avoid := range t.AvoidNodeTypes {
+		if slices.Contains(t.MustPassNodeTypes, avoid) {
+			return fmt.Errorf("%w: node type %q is both required and avoided", ErrInvalidQuery, avoid)
+		}
+	}
+	return nil
+}
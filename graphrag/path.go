@@ -0,0 +1,304 @@
+package graphrag
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+)
+
+const (
+	// DefaultPathMaxDepth is the search depth used when PathOptions.MaxDepth is unset.
+	DefaultPathMaxDepth = 6
+
+	// MaxPathDepth is the largest search depth a shortest path query may use.
+	// Larger values are capped.
+	MaxPathDepth = 12
+)
+
+// PathOptions configures a shortest path query.
+type PathOptions struct {
+	// MaxDepth is the maximum number of relationships in the path.
+	// Defaults to DefaultPathMaxDepth and is capped at MaxPathDepth.
+	MaxDepth int `json:"max_depth"`
+
+	// RelationshipTypes restricts which relationships the path may follow.
+	// If empty, all relationship types are followed.
+	RelationshipTypes []string `json:"relationship_types,omitempty"`
+
+	// Direction specifies which way relationships may be followed:
+	// "outgoing" (default), "incoming", or "both".
+	Direction string `json:"direction"`
+
+	// WeightProperty names a numeric relationship property used as the edge
+	// cost. If empty, every relationship costs 1 and the path with the fewest
+	// hops is returned. Relationships without the property cost 1.
+	WeightProperty string `json:"weight_property,omitempty"`
+}
+
+// NewPathOptions creates PathOptions with defaults: MaxDepth=DefaultPathMaxDepth,
+// Direction="outgoing".
+func NewPathOptions() *PathOptions {
+	return &PathOptions{
+		MaxDepth:          DefaultPathMaxDepth,
+		Direction:         "outgoing",
+		RelationshipTypes: make([]string, 0),
+	}
+}
+
+// WithMaxDepth sets the maximum path length and returns the options for chaining.
+func (o *PathOptions) WithMaxDepth(depth int) *PathOptions {
+	o.MaxDepth = depth
+	return o
+}
+
+// WithRelationshipTypes sets the relationship type filter and returns the options for chaining.
+func (o *PathOptions) WithRelationshipTypes(types []string) *PathOptions {
+	o.RelationshipTypes = types
+	return o
+}
+
+// WithDirection sets the direction and returns the options for chaining.
+// Valid values: "outgoing", "incoming", "both"
+func (o *PathOptions) WithDirection(direction string) *PathOptions {
+	o.Direction = direction
+	return o
+}
+
+// WithWeightProperty sets the relationship property used as edge cost and
+// returns the options for chaining.
+func (o *PathOptions) WithWeightProperty(property string) *PathOptions {
+	o.WeightProperty = property
+	return o
+}
+
+// Normalize returns a copy of the options with defaults applied and MaxDepth
+// capped at MaxPathDepth.
+func (o PathOptions) Normalize() PathOptions {
+	if o.MaxDepth <= 0 {
+		o.MaxDepth = DefaultPathMaxDepth
+	}
+	if o.MaxDepth > MaxPathDepth {
+		o.MaxDepth = MaxPathDepth
+	}
+	if o.Direction == "" {
+		o.Direction = "outgoing"
+	}
+	return o
+}
+
+// Validate checks the direction. Depth is not validated since Normalize
+// always produces a usable value.
+func (o PathOptions) Validate() error {
+	switch o.Direction {
+	case "", "outgoing", "incoming", "both":
+		return nil
+	default:
+		return fmt.Errorf("%w: invalid direction %q (must be outgoing, incoming, or both)", ErrInvalidQuery, o.Direction)
+	}
+}
+
+// PathEdge is one relationship along a path. FromID and ToID are in path
+// order; Reversed is true when the relationship is stored in the opposite
+// direction, i.e. it was followed as an incoming relationship.
+type PathEdge struct {
+	// FromID is the node the edge leaves, in path order
+	FromID string `json:"from_id"`
+
+	// ToID is the node the edge reaches, in path order
+	ToID string `json:"to_id"`
+
+	// Type is the relationship type
+	Type string `json:"type"`
+
+	// Properties are the relationship properties
+	Properties map[string]any `json:"properties,omitempty"`
+
+	// Weight is the cost of the edge: the weight property, or 1
+	Weight float64 `json:"weight"`
+
+	// Reversed is true if the stored relationship points from ToID to FromID
+	Reversed bool `json:"reversed,omitempty"`
+}
+
+// NeighborFunc returns the relationships attached to a node, in either
+// direction. Implementations typically query a graph store.
+type NeighborFunc func(ctx context.Context, nodeID string) ([]Relationship, error)
+
+// FindShortestPath searches for the cheapest path from fromID to toID,
+// expanding nodes with neighbors. Without a weight property this is a
+// breadth-first search for the fewest hops; with one it is Dijkstra's
+// algorithm. Paths longer than opts.MaxDepth (after Normalize) are not
+// considered. Returns ErrPathNotFound if no path exists within the limits and
+// an empty path if fromID equals toID.
+//
+// Example:
+//
+//	edges, err := graphrag.FindShortestPath(ctx, store.Relationships, "finding-1", "T1190",
+//	    *graphrag.NewPathOptions().WithDirection("both"))
+func FindShortestPath(ctx context.Context, neighbors NeighborFunc, fromID, toID string, opts PathOptions) ([]PathEdge, error) {
+	if fromID == "" || toID == "" {
+		return nil, fmt.Errorf("%w: both from and to node IDs are required", ErrInvalidQuery)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.Normalize()
+	if fromID == toID {
+		return []PathEdge{}, nil
+	}
+
+	allowed := make(map[string]bool, len(opts.RelationshipTypes))
+	for _, t := range opts.RelationshipTypes {
+		allowed[t] = true
+	}
+
+	// Search states are (node, hops) so that a cheap path exceeding the depth
+	// cap does not hide a costlier one within it.
+	type state struct {
+		node string
+		hops int
+	}
+	best := map[state]float64{{fromID, 0}: 0}
+	prev := map[state]PathEdge{}
+	// expandedAt holds the fewest hops each node was expanded at. With
+	// non-negative weights an earlier expansion was at least as cheap, so
+	// expanding again at the same or more hops cannot find a better path.
+	expandedAt := map[string]int{}
+	adjacency := map[string][]Relationship{}
+
+	queue := &pathQueue{{node: fromID}}
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		cur := heap.Pop(queue).(pathItem)
+		curState := state{cur.node, cur.hops}
+		if cur.cost > best[curState] {
+			continue
+		}
+		if cur.node == toID {
+			edges := make([]PathEdge, cur.hops)
+			for s := curState; s.hops > 0; {
+				edge := prev[s]
+				edges[s.hops-1] = edge
+				s = state{edge.FromID, s.hops - 1}
+			}
+			return edges, nil
+		}
+		if cur.hops == opts.MaxDepth {
+			continue
+		}
+		if h, ok := expandedAt[cur.node]; ok && h <= cur.hops {
+			continue
+		}
+		expandedAt[cur.node] = cur.hops
+
+		rels, ok := adjacency[cur.node]
+		if !ok {
+			var err error
+			if rels, err = neighbors(ctx, cur.node); err != nil {
+				return nil, fmt.Errorf("failed to expand node %s: %w", cur.node, err)
+			}
+			adjacency[cur.node] = rels
+		}
+
+		for _, rel := range rels {
+			if len(allowed) > 0 && !allowed[rel.Type] {
+				continue
+			}
+			edge, ok := followRelationship(rel, cur.node, opts.Direction)
+			if !ok {
+				continue
+			}
+			weight, err := relationshipWeight(rel, opts.WeightProperty)
+			if err != nil {
+				return nil, err
+			}
+			edge.Weight = weight
+
+			next := state{edge.ToID, cur.hops + 1}
+			cost := cur.cost + weight
+			if c, seen := best[next]; seen && c <= cost {
+				continue
+			}
+			best[next] = cost
+			prev[next] = edge
+			heap.Push(queue, pathItem{node: next.node, hops: next.hops, cost: cost})
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no path from %s to %s within %d hops", ErrPathNotFound, fromID, toID, opts.MaxDepth)
+}
+
+// followRelationship returns the edge for leaving nodeID along rel in the
+// given direction, or false if rel cannot be followed from nodeID.
+func followRelationship(rel Relationship, nodeID, direction string) (PathEdge, bool) {
+	edge := PathEdge{Type: rel.Type, Properties: rel.Properties, FromID: nodeID}
+	outgoing := rel.FromID == nodeID
+	incoming := rel.ToID == nodeID
+
+	switch {
+	case outgoing && (direction != "incoming" || rel.Bidirectional):
+		edge.ToID = rel.ToID
+	case incoming && (direction != "outgoing" || rel.Bidirectional):
+		edge.ToID = rel.FromID
+		edge.Reversed = true
+	default:
+		return PathEdge{}, false
+	}
+	return edge, true
+}
+
+// relationshipWeight returns the cost of following rel.
+func relationshipWeight(rel Relationship, property string) (float64, error) {
+	if property == "" {
+		return 1, nil
+	}
+
+	var w float64
+	switch v := rel.Properties[property].(type) {
+	case float64:
+		w = v
+	case float32:
+		w = float64(v)
+	case int:
+		w = float64(v)
+	case int64:
+		w = float64(v)
+	case int32:
+		w = float64(v)
+	default:
+		return 1, nil
+	}
+	if w < 0 {
+		return 0, fmt.Errorf("%w: relationship %s -[%s]-> %s has negative weight %v", ErrInvalidQuery, rel.FromID, rel.Type, rel.ToID, w)
+	}
+	return w, nil
+}
+
+// pathItem is a search state in the priority queue.
+type pathItem struct {
+	node string
+	hops int
+	cost float64
+}
+
+// pathQueue is a min-heap of search states ordered by cost, then hops.
+type pathQueue []pathItem
+
+func (q pathQueue) Len() int { return len(q) }
+func (q pathQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	return q[i].hops < q[j].hops
+}
+func (q pathQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x any)   { *q = append(*q, x.(pathItem)) }
+func (q *pathQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,191 @@
+package graphrag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticGraph returns a NeighborFunc over a fixed set of relationships and
+// counts how many nodes were expanded.
+func staticGraph(rels []Relationship, expansions *int) NeighborFunc {
+	return func(ctx context.Context, nodeID string) ([]Relationship, error) {
+		if expansions != nil {
+			*expansions++
+		}
+		var out []Relationship
+		for _, r := range rels {
+			if r.FromID == nodeID || r.ToID == nodeID {
+				out = append(out, r)
+			}
+		}
+		return out, nil
+	}
+}
+
+func rel(from, to, relType string, props map[string]any) Relationship {
+	return Relationship{FromID: from, ToID: to, Type: relType, Properties: props}
+}
+
+func pathNodes(edges []PathEdge) []string {
+	if len(edges) == 0 {
+		return nil
+	}
+	nodes := []string{edges[0].FromID}
+	for _, e := range edges {
+		nodes = append(nodes, e.ToID)
+	}
+	return nodes
+}
+
+func TestFindShortestPath_FewestHops(t *testing.T) {
+	graph := staticGraph([]Relationship{
+		rel("finding", "host", "AFFECTS", nil),
+		rel("host", "service", "RUNS", nil),
+		rel("service", "technique", "EXPLOITED_BY", nil),
+		rel("finding", "technique", "USES_TECHNIQUE", nil),
+	}, nil)
+
+	edges, err := FindShortestPath(context.Background(), graph, "finding", "technique", *NewPathOptions())
+	require.NoError(t, err)
+	require.Len(t, edges, 1)
+	assert.Equal(t, "USES_TECHNIQUE", edges[0].Type)
+	assert.Equal(t, 1.0, edges[0].Weight)
+}
+
+func TestFindShortestPath_Weighted(t *testing.T) {
+	graph := staticGraph([]Relationship{
+		rel("a", "d", "DIRECT", map[string]any{"cost": 10.0}),
+		rel("a", "b", "STEP", map[string]any{"cost": 1}),
+		rel("b", "c", "STEP", map[string]any{"cost": int64(2)}),
+		rel("c", "d", "STEP", map[string]any{"cost": 3.0}),
+	}, nil)
+
+	edges, err := FindShortestPath(context.Background(), graph, "a", "d", *NewPathOptions())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "d"}, pathNodes(edges), "unweighted search minimizes hops")
+
+	edges, err = FindShortestPath(context.Background(), graph, "a", "d", *NewPathOptions().WithWeightProperty("cost"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, pathNodes(edges), "weighted search minimizes cost")
+	assert.Equal(t, []float64{1, 2, 3}, []float64{edges[0].Weight, edges[1].Weight, edges[2].Weight})
+
+	// The cheap path is out of reach with a depth cap of 2
+	edges, err = FindShortestPath(context.Background(), graph, "a", "d", *NewPathOptions().WithWeightProperty("cost").WithMaxDepth(2))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "d"}, pathNodes(edges))
+}
+
+func TestFindShortestPath_Direction(t *testing.T) {
+	graph := staticGraph([]Relationship{
+		rel("finding", "host", "AFFECTS", nil),
+		rel("technique", "host", "TARGETS", nil),
+	}, nil)
+
+	_, err := FindShortestPath(context.Background(), graph, "finding", "technique", *NewPathOptions())
+	assert.True(t, errors.Is(err, ErrPathNotFound), "outgoing search cannot go against TARGETS")
+
+	edges, err := FindShortestPath(context.Background(), graph, "finding", "technique", *NewPathOptions().WithDirection("both"))
+	require.NoError(t, err)
+	require.Len(t, edges, 2)
+	assert.False(t, edges[0].Reversed)
+	assert.True(t, edges[1].Reversed)
+	assert.Equal(t, "host", edges[1].FromID)
+	assert.Equal(t, "technique", edges[1].ToID)
+
+	edges, err = FindShortestPath(context.Background(), graph, "technique", "finding", *NewPathOptions().WithDirection("incoming"))
+	assert.True(t, errors.Is(err, ErrPathNotFound), "incoming search cannot follow TARGETS forward")
+	assert.Nil(t, edges)
+}
+
+func TestFindShortestPath_Bidirectional(t *testing.T) {
+	r := rel("a", "b", "SIMILAR_TO", nil)
+	r.Bidirectional = true
+	graph := staticGraph([]Relationship{r}, nil)
+
+	edges, err := FindShortestPath(context.Background(), graph, "b", "a", *NewPathOptions())
+	require.NoError(t, err)
+	require.Len(t, edges, 1)
+	assert.True(t, edges[0].Reversed)
+}
+
+func TestFindShortestPath_RelationshipTypes(t *testing.T) {
+	graph := staticGraph([]Relationship{
+		rel("a", "c", "SIMILAR_TO", nil),
+		rel("a", "b", "LEADS_TO", nil),
+		rel("b", "c", "LEADS_TO", nil),
+	}, nil)
+
+	edges, err := FindShortestPath(context.Background(), graph, "a", "c", *NewPathOptions().WithRelationshipTypes([]string{"LEADS_TO"}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, pathNodes(edges))
+}
+
+func TestFindShortestPath_DepthCap(t *testing.T) {
+	var chain []Relationship
+	ids := []string{"n0", "n1", "n2", "n3", "n4", "n5", "n6", "n7", "n8", "n9", "n10", "n11", "n12", "n13", "n14"}
+	for i := 0; i+1 < len(ids); i++ {
+		chain = append(chain, rel(ids[i], ids[i+1], "NEXT", nil))
+	}
+	graph := staticGraph(chain, nil)
+
+	_, err := FindShortestPath(context.Background(), graph, "n0", "n3", *NewPathOptions().WithMaxDepth(2))
+	assert.True(t, errors.Is(err, ErrPathNotFound))
+
+	edges, err := FindShortestPath(context.Background(), graph, "n0", "n3", *NewPathOptions().WithMaxDepth(3))
+	require.NoError(t, err)
+	assert.Len(t, edges, 3)
+
+	// Requested depths beyond MaxPathDepth are capped
+	_, err = FindShortestPath(context.Background(), graph, "n0", "n14", *NewPathOptions().WithMaxDepth(100))
+	assert.True(t, errors.Is(err, ErrPathNotFound))
+	assert.Equal(t, MaxPathDepth, PathOptions{MaxDepth: 100}.Normalize().MaxDepth)
+}
+
+func TestFindShortestPath_ExpandsEachNodeOnce(t *testing.T) {
+	// Diamond with a cycle back to the start
+	expansions := 0
+	graph := staticGraph([]Relationship{
+		rel("a", "b", "R", nil),
+		rel("a", "c", "R", nil),
+		rel("b", "d", "R", nil),
+		rel("c", "d", "R", nil),
+		rel("d", "a", "R", nil),
+	}, &expansions)
+
+	_, err := FindShortestPath(context.Background(), graph, "a", "missing", *NewPathOptions())
+	assert.True(t, errors.Is(err, ErrPathNotFound))
+	assert.Equal(t, 4, expansions)
+}
+
+func TestFindShortestPath_Errors(t *testing.T) {
+	graph := staticGraph(nil, nil)
+
+	edges, err := FindShortestPath(context.Background(), graph, "a", "a", *NewPathOptions())
+	require.NoError(t, err)
+	assert.Empty(t, edges)
+
+	_, err = FindShortestPath(context.Background(), graph, "", "a", *NewPathOptions())
+	assert.True(t, errors.Is(err, ErrInvalidQuery))
+
+	_, err = FindShortestPath(context.Background(), graph, "a", "b", PathOptions{Direction: "sideways"})
+	assert.True(t, errors.Is(err, ErrInvalidQuery))
+
+	negative := staticGraph([]Relationship{rel("a", "b", "R", map[string]any{"cost": -1.0})}, nil)
+	_, err = FindShortestPath(context.Background(), negative, "a", "b", PathOptions{WeightProperty: "cost"})
+	assert.True(t, errors.Is(err, ErrInvalidQuery))
+
+	failing := func(ctx context.Context, nodeID string) ([]Relationship, error) {
+		return nil, ErrStorageFailed
+	}
+	_, err = FindShortestPath(context.Background(), failing, "a", "b", PathOptions{})
+	assert.True(t, errors.Is(err, ErrStorageFailed))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = FindShortestPath(ctx, graph, "a", "b", PathOptions{})
+	assert.True(t, errors.Is(err, context.Canceled))
+}
@@ -0,0 +1,180 @@
+package graphrag
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Query routes reported by QueryPlan.Route.
+const (
+	// RouteText embeds the query text and runs a hybrid vector + graph search.
+	RouteText = "text"
+
+	// RouteEmbedding runs a hybrid vector + graph search with a pre-computed embedding.
+	RouteEmbedding = "embedding"
+
+	// RouteStructured matches nodes by type, mission, and property filters
+	// without any vector search.
+	RouteStructured = "structured"
+)
+
+// Default values applied by Explain when a Query leaves them unset.
+const (
+	defaultSemanticTopK    = 10
+	defaultStructuredTopK  = 100
+	defaultVectorWeight    = 0.6
+	defaultGraphWeight     = 0.4
+	structuredGraphWeight  = 1.0
+	structuredVectorWeight = 0.0
+)
+
+// QueryPlan describes how a Query will be executed without running it.
+// It is produced by Query.Explain locally, or by the daemon through
+// the harness when the daemon supports query explanation.
+type QueryPlan struct {
+	// Route is the routing decision: RouteText, RouteEmbedding, or RouteStructured
+	Route string `json:"route"`
+
+	// TopK is the effective number of results after defaults
+	TopK int `json:"top_k"`
+
+	// MaxHops is the effective graph traversal depth
+	MaxHops int `json:"max_hops"`
+
+	// MinScore is the effective similarity threshold (always 0 for structured queries)
+	MinScore float64 `json:"min_score"`
+
+	// VectorWeight is the effective weight for semantic similarity scoring
+	VectorWeight float64 `json:"vector_weight"`
+
+	// GraphWeight is the effective weight for graph structure scoring
+	GraphWeight float64 `json:"graph_weight"`
+
+	// NodeTypes lists the node type filters that will be applied
+	NodeTypes []string `json:"node_types,omitempty"`
+
+	// MissionID is the mission filter that will be applied, if any
+	MissionID string `json:"mission_id,omitempty"`
+
+	// MissionRunID is the mission run filter that will be applied, if any
+	MissionRunID string `json:"mission_run_id,omitempty"`
+
+	// PropertyFilters lists the property predicates that will be applied
+	PropertyFilters []PropertyFilter `json:"property_filters,omitempty"`
+
+	// Operations is the estimated sequence of backend operations
+	Operations []string `json:"operations"`
+
+	// Errors lists every validation problem; a plan with errors will not execute
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Valid returns true if the planned query passed validation.
+func (p QueryPlan) Valid() bool {
+	return len(p.Errors) == 0
+}
+
+// Explain returns the execution plan for the query without running it.
+// Agents and tests can use it to assert routing decisions and effective
+// parameters. Validation problems are reported in QueryPlan.Errors rather
+// than preventing the plan from being built.
+//
+// Routing:
+//   - Text set: RouteText (query text is embedded first)
+//   - Embedding set: RouteEmbedding
+//   - Neither set: RouteStructured
+//
+// Defaults applied to the effective values:
+//   - TopK: 10 for semantic routes, 100 for structured, when not positive
+//   - VectorWeight/GraphWeight: 0.6/0.4 for semantic routes when both are zero
+//   - Structured routes ignore MinScore and weights (reported as 0.0 and 0.0/1.0)
+//
+// Example:
+//
+//	plan := graphrag.NewStructuredQuery().WithNodeTypes("host").Explain()
+//	if plan.Route != graphrag.RouteStructured {
+//	    // unexpected routing
+//	}
+func (q *Query) Explain() QueryPlan {
+	plan := QueryPlan{
+		TopK:            q.TopK,
+		MaxHops:         q.MaxHops,
+		MinScore:        q.MinScore,
+		VectorWeight:    q.VectorWeight,
+		GraphWeight:     q.GraphWeight,
+		NodeTypes:       q.NodeTypes,
+		MissionID:       q.MissionID,
+		MissionRunID:    q.MissionRunID,
+		PropertyFilters: q.PropertyFilters,
+	}
+
+	switch {
+	case q.Text != "":
+		plan.Route = RouteText
+	case len(q.Embedding) > 0:
+		plan.Route = RouteEmbedding
+	default:
+		plan.Route = RouteStructured
+	}
+
+	if plan.Route == RouteStructured {
+		if plan.TopK <= 0 {
+			plan.TopK = defaultStructuredTopK
+		}
+		plan.MinScore = 0.0
+		plan.VectorWeight = structuredVectorWeight
+		plan.GraphWeight = structuredGraphWeight
+	} else {
+		if plan.TopK <= 0 {
+			plan.TopK = defaultSemanticTopK
+		}
+		if plan.VectorWeight == 0 && plan.GraphWeight == 0 {
+			plan.VectorWeight = defaultVectorWeight
+			plan.GraphWeight = defaultGraphWeight
+		}
+	}
+
+	plan.Operations = plan.operations()
+
+	for _, err := range q.validationErrors() {
+		plan.Errors = append(plan.Errors, err.Error())
+	}
+
+	return plan
+}
+
+// operations estimates the backend operations for the plan, in execution order.
+func (p QueryPlan) operations() []string {
+	var ops []string
+
+	switch p.Route {
+	case RouteText:
+		ops = append(ops, "embed query text")
+		fallthrough
+	case RouteEmbedding:
+		ops = append(ops, fmt.Sprintf("vector similarity search (top %d, min score %.2f)", p.TopK, p.MinScore))
+	case RouteStructured:
+		ops = append(ops, fmt.Sprintf("node scan (limit %d)", p.TopK))
+	}
+
+	if len(p.NodeTypes) > 0 {
+		ops = append(ops, fmt.Sprintf("filter node types [%s]", strings.Join(p.NodeTypes, ", ")))
+	}
+	if p.MissionID != "" {
+		ops = append(ops, fmt.Sprintf("filter mission %s", p.MissionID))
+	}
+	if p.MissionRunID != "" {
+		ops = append(ops, fmt.Sprintf("filter mission run %s", p.MissionRunID))
+	}
+	for _, f := range p.PropertyFilters {
+		ops = append(ops, fmt.Sprintf("filter property %s %s %v", f.Key, f.Op, f.Value))
+	}
+	if p.MaxHops > 0 {
+		ops = append(ops, fmt.Sprintf("graph traversal (max %d hops)", p.MaxHops))
+	}
+	if p.Route != RouteStructured {
+		ops = append(ops, fmt.Sprintf("hybrid rerank (vector %.2f, graph %.2f)", p.VectorWeight, p.GraphWeight))
+	}
+
+	return ops
+}
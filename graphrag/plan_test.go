@@ -0,0 +1,122 @@
+package graphrag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryExplain_Routing(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     *Query
+		wantRoute string
+		wantFirst string
+	}{
+		{
+			name:      "text query",
+			query:     NewQuery("open ports"),
+			wantRoute: RouteText,
+			wantFirst: "embed query text",
+		},
+		{
+			name:      "text query with node types stays semantic",
+			query:     NewQuery("open ports").WithNodeTypes("port"),
+			wantRoute: RouteText,
+			wantFirst: "embed query text",
+		},
+		{
+			name:      "embedding query",
+			query:     NewQueryFromEmbedding([]float64{0.1, 0.2}),
+			wantRoute: RouteEmbedding,
+			wantFirst: "vector similarity search (top 10, min score 0.70)",
+		},
+		{
+			name:      "node types only",
+			query:     NewStructuredQuery().WithNodeTypes("host"),
+			wantRoute: RouteStructured,
+			wantFirst: "node scan (limit 100)",
+		},
+		{
+			name:      "property filters only",
+			query:     NewStructuredQuery().WithPropertyFilter("severity", FilterOpEq, "high"),
+			wantRoute: RouteStructured,
+			wantFirst: "node scan (limit 100)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tt.query.Explain()
+			assert.Equal(t, tt.wantRoute, plan.Route)
+			require.NotEmpty(t, plan.Operations)
+			assert.Equal(t, tt.wantFirst, plan.Operations[0])
+			assert.True(t, plan.Valid(), "unexpected errors: %v", plan.Errors)
+		})
+	}
+}
+
+func TestQueryExplain_EffectiveDefaults(t *testing.T) {
+	t.Run("semantic query with unset fields", func(t *testing.T) {
+		plan := (&Query{Text: "test"}).Explain()
+		assert.Equal(t, RouteText, plan.Route)
+		assert.Equal(t, 10, plan.TopK)
+		assert.Equal(t, 0.6, plan.VectorWeight)
+		assert.Equal(t, 0.4, plan.GraphWeight)
+	})
+
+	t.Run("structured query ignores scoring parameters", func(t *testing.T) {
+		plan := (&Query{NodeTypes: []string{"host"}, MinScore: 0.9, VectorWeight: 0.5, GraphWeight: 0.5}).Explain()
+		assert.Equal(t, RouteStructured, plan.Route)
+		assert.Equal(t, 100, plan.TopK)
+		assert.Equal(t, 0.0, plan.MinScore)
+		assert.Equal(t, 0.0, plan.VectorWeight)
+		assert.Equal(t, 1.0, plan.GraphWeight)
+		assert.NotContains(t, plan.Operations, "hybrid rerank (vector 0.50, graph 0.50)")
+	})
+
+	t.Run("explicit values are kept", func(t *testing.T) {
+		plan := NewQuery("test").WithTopK(5).WithMinScore(0.5).WithWeights(0.8, 0.2).Explain()
+		assert.Equal(t, 5, plan.TopK)
+		assert.Equal(t, 0.5, plan.MinScore)
+		assert.Equal(t, 0.8, plan.VectorWeight)
+		assert.Equal(t, 0.2, plan.GraphWeight)
+	})
+}
+
+func TestQueryExplain_Operations(t *testing.T) {
+	q := NewQuery("admin panels").
+		WithNodeTypes("endpoint", "service").
+		WithMission("mission-123").
+		WithMissionRun("run-1").
+		WithPropertyFilter("status_code", FilterOpEq, 200).
+		WithMaxHops(2)
+
+	plan := q.Explain()
+	assert.Equal(t, []string{
+		"embed query text",
+		"vector similarity search (top 10, min score 0.70)",
+		"filter node types [endpoint, service]",
+		"filter mission mission-123",
+		"filter mission run run-1",
+		"filter property status_code eq 200",
+		"graph traversal (max 2 hops)",
+		"hybrid rerank (vector 0.60, graph 0.40)",
+	}, plan.Operations)
+	assert.Equal(t, []string{"endpoint", "service"}, plan.NodeTypes)
+	assert.Equal(t, "mission-123", plan.MissionID)
+	assert.Equal(t, "run-1", plan.MissionRunID)
+	assert.Len(t, plan.PropertyFilters, 1)
+}
+
+func TestQueryExplain_ReportsValidationErrors(t *testing.T) {
+	plan := NewQuery("test").WithWeights(0.9, 0.3).WithMinScore(2).Explain()
+
+	assert.False(t, plan.Valid())
+	require.Len(t, plan.Errors, 2)
+	assert.Contains(t, plan.Errors[0], "MinScore must be between 0.0 and 1.0")
+	assert.Contains(t, plan.Errors[1], "VectorWeight + GraphWeight must equal 1.0")
+	// The plan is still built so callers can see how the query would route
+	assert.Equal(t, RouteText, plan.Route)
+}
@@ -0,0 +1,373 @@
+package graphrag
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// DefaultProfileMaxNodes is the number of nodes per type Profile samples
+// when ProfileOptions.MaxNodesPerType is not set.
+const DefaultProfileMaxNodes = 1000
+
+// DefaultProfileMaxExamples is the number of example values Profile reports
+// per property when ProfileOptions.MaxExamples is not set.
+const DefaultProfileMaxExamples = 3
+
+// profileExampleLength is the length example values are truncated to.
+const profileExampleLength = 60
+
+// Value kinds reported by Profile.
+const (
+	ValueKindString = "string"
+	ValueKindNumber = "number"
+	ValueKindBool   = "bool"
+	ValueKindList   = "list"
+	ValueKindObject = "object"
+	ValueKindNull   = "null"
+)
+
+// GraphQuerier answers GraphRAG queries. Harnesses that implement it let
+// Profile fetch a bounded sample of each node type with a structured query
+// instead of listing every node of the mission.
+type GraphQuerier interface {
+	// QueryGraphRAG returns the nodes matching query.
+	QueryGraphRAG(ctx context.Context, query Query) ([]Result, error)
+}
+
+// ProfileOptions configures ProfileWithOptions.
+type ProfileOptions struct {
+	// MaxNodesPerType is the most nodes of each type that are sampled.
+	// Defaults to DefaultProfileMaxNodes.
+	MaxNodesPerType int
+
+	// MaxExamples is the most example values reported per property.
+	// Defaults to DefaultProfileMaxExamples.
+	MaxExamples int
+}
+
+// ProfileReport describes the properties that nodes of a mission actually
+// carry. It marshals to JSON and renders as Markdown with Markdown.
+type ProfileReport struct {
+	// MissionID is the profiled mission
+	MissionID string `json:"mission_id"`
+
+	// Types holds one profile per node type, ordered by type
+	Types []TypeProfile `json:"types"`
+}
+
+// TypeProfile describes the properties of the sampled nodes of one type.
+type TypeProfile struct {
+	// NodeType is the profiled node type
+	NodeType string `json:"node_type"`
+
+	// Sampled is the number of nodes the profile is based on
+	Sampled int `json:"sampled"`
+
+	// Truncated is set when the type has more nodes than were sampled
+	Truncated bool `json:"truncated,omitempty"`
+
+	// Properties holds one profile per property name, ordered by name
+	Properties []PropertyProfile `json:"properties"`
+
+	// Warnings describe properties whose values are of mixed kinds
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// PropertyProfile describes the values one property takes across the
+// sampled nodes of a type.
+type PropertyProfile struct {
+	// Name is the property name
+	Name string `json:"name"`
+
+	// Count is the number of sampled nodes that have the property
+	Count int `json:"count"`
+
+	// Presence is Count as a fraction of the sampled nodes
+	Presence float64 `json:"presence"`
+
+	// Kinds counts the values by kind: "string", "number", "bool",
+	// "list", "object", or "null"
+	Kinds map[string]int `json:"kinds"`
+
+	// Distinct is the number of distinct values
+	Distinct int `json:"distinct"`
+
+	// Examples are the smallest distinct values, as JSON, truncated
+	Examples []string `json:"examples,omitempty"`
+}
+
+// Inconsistent returns true if the property's values are of more than one
+// kind, not counting nulls.
+func (p PropertyProfile) Inconsistent() bool {
+	kinds := 0
+	for kind := range p.Kinds {
+		if kind != ValueKindNull {
+			kinds++
+		}
+	}
+	return kinds > 1
+}
+
+// Profile samples the nodes of a mission and reports, per node type, which
+// properties they carry, how often, with values of which kinds, how many
+// distinct values, and examples, flagging properties whose values are of
+// mixed kinds. It profiles nodeTypes, or every type in the mission if none
+// are given, sampling up to DefaultProfileMaxNodes nodes per type; see
+// ProfileWithOptions.
+//
+// Example:
+//
+//	report, err := graphrag.Profile(ctx, store, missionID, []string{"host", "finding"})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(report.Markdown())
+func Profile(ctx context.Context, h GraphRAGHarness, missionID string, nodeTypes []string) (ProfileReport, error) {
+	return ProfileWithOptions(ctx, h, missionID, nodeTypes, ProfileOptions{})
+}
+
+// ProfileWithOptions is Profile configured by opts.
+//
+// When nodeTypes are given and h implements GraphQuerier, each type is
+// fetched with a structured query bounded by MaxNodesPerType; otherwise all
+// nodes of the mission are listed and the sample of a type is its nodes
+// with the smallest IDs. Either way, nodes are profiled in ID order, so
+// profiles of the same data are identical. A type without nodes is
+// reported with no properties.
+func ProfileWithOptions(ctx context.Context, h GraphRAGHarness, missionID string, nodeTypes []string, opts ProfileOptions) (ProfileReport, error) {
+	report := ProfileReport{MissionID: missionID}
+	if missionID == "" {
+		return report, fmt.Errorf("%w: mission ID is required", ErrInvalidQuery)
+	}
+	if opts.MaxNodesPerType <= 0 {
+		opts.MaxNodesPerType = DefaultProfileMaxNodes
+	}
+	if opts.MaxExamples <= 0 {
+		opts.MaxExamples = DefaultProfileMaxExamples
+	}
+
+	byType, err := sampleNodes(ctx, h, missionID, nodeTypes, opts.MaxNodesPerType)
+	if err != nil {
+		return report, err
+	}
+
+	for _, nodeType := range slices.Sorted(maps.Keys(byType)) {
+		nodes := byType[nodeType]
+		slices.SortFunc(nodes, func(a, b GraphNode) int { return strings.Compare(a.ID, b.ID) })
+		truncated := len(nodes) > opts.MaxNodesPerType
+		if truncated {
+			nodes = nodes[:opts.MaxNodesPerType]
+		}
+		profile := profileType(nodeType, nodes, opts.MaxExamples)
+		profile.Truncated = truncated
+		report.Types = append(report.Types, profile)
+	}
+	return report, nil
+}
+
+// sampleNodes returns the nodes of each requested type, or of every type,
+// with more than max nodes for types that were truncated.
+func sampleNodes(ctx context.Context, h GraphRAGHarness, missionID string, nodeTypes []string, max int) (map[string][]GraphNode, error) {
+	byType := make(map[string][]GraphNode)
+	for _, nodeType := range nodeTypes {
+		byType[nodeType] = nil
+	}
+
+	if querier, ok := h.(GraphQuerier); ok && len(nodeTypes) > 0 {
+		for _, nodeType := range nodeTypes {
+			// one node over the bound tells whether the type was truncated
+			query := NewStructuredQuery().WithMission(missionID).WithNodeTypes(nodeType).WithTopK(max + 1)
+			results, err := querier.QueryGraphRAG(ctx, *query)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query %s nodes of mission %s: %w", nodeType, missionID, err)
+			}
+			for _, r := range results {
+				if r.Node.Type == nodeType {
+					byType[nodeType] = append(byType[nodeType], r.Node)
+				}
+			}
+		}
+		return byType, nil
+	}
+
+	nodes, err := h.MissionNodes(ctx, missionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes of mission %s: %w", missionID, err)
+	}
+	for _, node := range nodes {
+		if _, ok := byType[node.Type]; ok || len(nodeTypes) == 0 {
+			byType[node.Type] = append(byType[node.Type], node)
+		}
+	}
+	return byType, nil
+}
+
+// profileType profiles the properties of nodes, which are of nodeType.
+func profileType(nodeType string, nodes []GraphNode, maxExamples int) TypeProfile {
+	profile := TypeProfile{NodeType: nodeType, Sampled: len(nodes), Properties: []PropertyProfile{}}
+
+	values := make(map[string][]any)
+	for _, node := range nodes {
+		for name, value := range node.Properties {
+			values[name] = append(values[name], value)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		prop := PropertyProfile{
+			Name:     name,
+			Count:    len(values[name]),
+			Presence: round4(float64(len(values[name])) / float64(len(nodes))),
+			Kinds:    make(map[string]int),
+		}
+		distinct := make(map[string]bool)
+		for _, v := range values[name] {
+			prop.Kinds[valueKind(v)]++
+			distinct[canonicalValue(v)] = true
+		}
+		prop.Distinct = len(distinct)
+		for _, example := range slices.Sorted(maps.Keys(distinct)) {
+			if len(prop.Examples) == maxExamples {
+				break
+			}
+			prop.Examples = append(prop.Examples, truncateExample(example))
+		}
+
+		if prop.Inconsistent() {
+			profile.Warnings = append(profile.Warnings, inconsistencyWarning(prop))
+		}
+		profile.Properties = append(profile.Properties, prop)
+	}
+	return profile
+}
+
+// valueKind returns the kind of a property value.
+func valueKind(v any) string {
+	switch v.(type) {
+	case nil:
+		return ValueKindNull
+	case string:
+		return ValueKindString
+	case bool:
+		return ValueKindBool
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, json.Number:
+		return ValueKindNumber
+	case []any, []string, []int, []float64:
+		return ValueKindList
+	case map[string]any:
+		return ValueKindObject
+	}
+
+	// decode other types, such as typed slices and structs, as JSON would
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ValueKindString
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return ValueKindString
+	}
+	return valueKind(decoded)
+}
+
+// canonicalValue returns v as JSON, so equal values compare equal.
+func canonicalValue(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// truncateExample shortens an example value to profileExampleLength runes.
+func truncateExample(s string) string {
+	runes := []rune(s)
+	if len(runes) <= profileExampleLength {
+		return s
+	}
+	return string(runes[:profileExampleLength-1]) + "…"
+}
+
+// inconsistencyWarning describes the kinds of a property with values of
+// mixed kinds, most common first.
+func inconsistencyWarning(p PropertyProfile) string {
+	kinds := slices.SortedFunc(maps.Keys(p.Kinds), func(a, b string) int {
+		return cmp.Or(cmp.Compare(p.Kinds[b], p.Kinds[a]), strings.Compare(a, b))
+	})
+	parts := make([]string, len(kinds))
+	for i, kind := range kinds {
+		parts[i] = fmt.Sprintf("%s in %s of nodes", kind, formatPercent(float64(p.Kinds[kind])/float64(p.Count)))
+	}
+	return fmt.Sprintf("%s is %s", p.Name, joinWords(parts))
+}
+
+// joinWords joins parts as "a", "a and b", or "a, b and c".
+func joinWords(parts []string) string {
+	if len(parts) <= 1 {
+		return strings.Join(parts, "")
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
+
+// formatPercent formats a 0-1 fraction as a whole percentage.
+func formatPercent(f float64) string {
+	return fmt.Sprintf("%.0f%%", f*100)
+}
+
+// round4 rounds f to four decimals, keeping reports short and stable.
+func round4(f float64) float64 {
+	return math.Round(f*10000) / 10000
+}
+
+// Markdown renders the report as Markdown: a section per node type with a
+// table of its properties and a list of its warnings.
+func (r ProfileReport) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Graph profile of mission %s\n", r.MissionID)
+
+	for _, t := range r.Types {
+		fmt.Fprintf(&b, "\n## %s\n\n", t.NodeType)
+		sampled := fmt.Sprintf("%d nodes sampled", t.Sampled)
+		if t.Truncated {
+			sampled += " (truncated)"
+		}
+		b.WriteString(sampled + ".\n")
+		if len(t.Properties) == 0 {
+			continue
+		}
+
+		b.WriteString("\n| Property | Presence | Kinds | Distinct | Examples |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+		for _, p := range t.Properties {
+			kinds := slices.Sorted(maps.Keys(p.Kinds))
+			examples := make([]string, len(p.Examples))
+			for i, e := range p.Examples {
+				examples[i] = "`" + markdownCell(e) + "`"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %d | %s |\n",
+				markdownCell(p.Name), formatPercent(p.Presence), strings.Join(kinds, ", "), p.Distinct, strings.Join(examples, ", "))
+		}
+
+		if len(t.Warnings) > 0 {
+			b.WriteString("\nWarnings:\n\n")
+			for _, w := range t.Warnings {
+				fmt.Fprintf(&b, "- %s\n", markdownCell(w))
+			}
+		}
+	}
+	return b.String()
+}
+
+// markdownCell escapes the characters that would break a table cell or a
+// code span.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "`", "'")
+	return strings.ReplaceAll(s, "\n", " ")
+}
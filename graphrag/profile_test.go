@@ -0,0 +1,149 @@
+package graphrag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// queryingGraph is a memoryGraph that also answers structured queries.
+type queryingGraph struct {
+	*memoryGraph
+	queries []Query
+}
+
+func (g *queryingGraph) QueryGraphRAG(ctx context.Context, query Query) ([]Result, error) {
+	g.queries = append(g.queries, query)
+	nodes, _ := g.MissionNodes(ctx, query.MissionID)
+	var results []Result
+	for _, node := range nodes {
+		if node.Type == query.NodeTypes[0] && len(results) < query.TopK {
+			results = append(results, Result{Node: node})
+		}
+	}
+	return results, nil
+}
+
+// severityGraph returns a store holding ten findings of mission m-1, eight
+// with a string severity and two with a numeric one.
+func severityGraph(t *testing.T) *memoryGraph {
+	t.Helper()
+	g := missionGraph(t)
+	for i := 0; i < 10; i++ {
+		props := map[string]any{"severity": "high", "title": fmt.Sprintf("finding %d", i)}
+		if i >= 8 {
+			props["severity"] = 9.1
+		}
+		if i%2 == 0 {
+			props["cwe"] = nil
+		}
+		g.nodes[fmt.Sprintf("finding:%02d", i)] = GraphNode{ID: fmt.Sprintf("finding:%02d", i), Type: NodeTypeFinding, MissionID: "m-1", Properties: props}
+	}
+	return g
+}
+
+func TestProfile(t *testing.T) {
+	report, err := Profile(context.Background(), severityGraph(t), "m-1", nil)
+	require.NoError(t, err)
+
+	require.Len(t, report.Types, 3)
+	assert.Equal(t, NodeTypeFinding, report.Types[0].NodeType)
+	assert.Equal(t, NodeTypeHost, report.Types[1].NodeType)
+	assert.Equal(t, NodeTypePort, report.Types[2].NodeType)
+
+	findings := report.Types[0]
+	assert.Equal(t, 11, findings.Sampled)
+	assert.False(t, findings.Truncated)
+	assert.Equal(t, []string{"severity is string in 80% of nodes and number in 20% of nodes"}, findings.Warnings)
+
+	props := make(map[string]PropertyProfile)
+	for _, p := range findings.Properties {
+		props[p.Name] = p
+	}
+	severity := props["severity"]
+	assert.Equal(t, 10, severity.Count)
+	assert.Equal(t, 0.9091, severity.Presence)
+	assert.Equal(t, map[string]int{ValueKindString: 8, ValueKindNumber: 2}, severity.Kinds)
+	assert.Equal(t, 2, severity.Distinct)
+	assert.Equal(t, []string{`"high"`, `9.1`}, severity.Examples)
+	assert.True(t, severity.Inconsistent())
+
+	cwe := props["cwe"]
+	assert.Equal(t, map[string]int{ValueKindNull: 5}, cwe.Kinds)
+	assert.False(t, cwe.Inconsistent())
+
+	title := props["title"]
+	assert.Equal(t, 10, title.Distinct)
+	assert.Len(t, title.Examples, DefaultProfileMaxExamples)
+
+	host := report.Types[1]
+	assert.Equal(t, map[string]int{ValueKindList: 1}, host.Properties[1].Kinds)
+}
+
+func TestProfile_Deterministic(t *testing.T) {
+	first, err := Profile(context.Background(), severityGraph(t), "m-1", nil)
+	require.NoError(t, err)
+	second, err := Profile(context.Background(), severityGraph(t), "m-1", nil)
+	require.NoError(t, err)
+
+	firstJSON, err := json.Marshal(first)
+	require.NoError(t, err)
+	secondJSON, err := json.Marshal(second)
+	require.NoError(t, err)
+	assert.Equal(t, string(firstJSON), string(secondJSON))
+	assert.Equal(t, first.Markdown(), second.Markdown())
+}
+
+func TestProfile_Bounded(t *testing.T) {
+	report, err := ProfileWithOptions(context.Background(), severityGraph(t), "m-1", []string{NodeTypeFinding, NodeTypeDomain},
+		ProfileOptions{MaxNodesPerType: 4, MaxExamples: 1})
+	require.NoError(t, err)
+
+	require.Len(t, report.Types, 2)
+	assert.Equal(t, NodeTypeDomain, report.Types[0].NodeType)
+	assert.Zero(t, report.Types[0].Sampled)
+	assert.Empty(t, report.Types[0].Properties)
+
+	findings := report.Types[1]
+	assert.Equal(t, 4, findings.Sampled)
+	assert.True(t, findings.Truncated)
+	assert.Empty(t, findings.Warnings, "only the first four findings by ID are sampled")
+	for _, p := range findings.Properties {
+		assert.LessOrEqual(t, len(p.Examples), 1)
+	}
+}
+
+func TestProfile_Querier(t *testing.T) {
+	g := &queryingGraph{memoryGraph: severityGraph(t)}
+	report, err := ProfileWithOptions(context.Background(), g, "m-1", []string{NodeTypeFinding}, ProfileOptions{MaxNodesPerType: 5})
+	require.NoError(t, err)
+
+	require.Len(t, g.queries, 1)
+	assert.Equal(t, 6, g.queries[0].TopK)
+	assert.Equal(t, []string{NodeTypeFinding}, g.queries[0].NodeTypes)
+	require.NoError(t, g.queries[0].Validate())
+
+	require.Len(t, report.Types, 1)
+	assert.Equal(t, 5, report.Types[0].Sampled)
+	assert.True(t, report.Types[0].Truncated)
+}
+
+func TestProfile_RequiresMission(t *testing.T) {
+	_, err := Profile(context.Background(), newMemoryGraph(), "", nil)
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+}
+
+func TestProfileReport_Markdown(t *testing.T) {
+	report, err := Profile(context.Background(), severityGraph(t), "m-1", []string{NodeTypeFinding})
+	require.NoError(t, err)
+
+	md := report.Markdown()
+	assert.Contains(t, md, "# Graph profile of mission m-1\n")
+	assert.Contains(t, md, "## finding\n\n11 nodes sampled.\n")
+	assert.Contains(t, md, "| severity | 91% | number, string | 2 | `\"high\"`, `9.1` |\n")
+	assert.Contains(t, md, "- severity is string in 80% of nodes and number in 20% of nodes\n")
+}
@@ -5,6 +5,52 @@ import (
 	"fmt"
 )
 
+// Property filter operators supported by WithPropertyFilter.
+const (
+	// FilterOpEq matches nodes whose property equals the value.
+	FilterOpEq = "eq"
+
+	// FilterOpGt matches nodes whose property is greater than the value.
+	FilterOpGt = "gt"
+
+	// FilterOpGte matches nodes whose property is greater than or equal to the value.
+	FilterOpGte = "gte"
+
+	// FilterOpLt matches nodes whose property is less than the value.
+	FilterOpLt = "lt"
+
+	// FilterOpLte matches nodes whose property is less than or equal to the value.
+	FilterOpLte = "lte"
+
+	// FilterOpContains matches nodes whose string property contains the value
+	// as a substring, or whose list property contains the value as an element.
+	FilterOpContains = "contains"
+)
+
+// validFilterOps is the set of operators accepted by Validate.
+var validFilterOps = map[string]bool{
+	FilterOpEq:       true,
+	FilterOpGt:       true,
+	FilterOpGte:      true,
+	FilterOpLt:       true,
+	FilterOpLte:      true,
+	FilterOpContains: true,
+}
+
+// PropertyFilter is a predicate on a single node property.
+// Multiple filters on a Query are combined with AND semantics and
+// evaluated server-side.
+type PropertyFilter struct {
+	// Key is the node property name (e.g., "severity", "port")
+	Key string `json:"key"`
+
+	// Op is the comparison operator (eq, gt, gte, lt, lte, contains)
+	Op string `json:"op"`
+
+	// Value is the operand the property is compared against
+	Value any `json:"value"`
+}
+
 // Query represents a GraphRAG query with fluent builder pattern.
 // It supports both natural language text queries (which will be embedded)
 // and pre-computed embeddings, along with various filtering and scoring options.
@@ -36,6 +82,27 @@ type Query struct {
 	// GraphWeight is the weight for graph structure scoring
 	GraphWeight float64 `json:"graph_weight"`
 
+	// PropertyFilters narrows results by node property predicates (AND semantics)
+	PropertyFilters []PropertyFilter `json:"property_filters,omitempty"`
+
+	// GroupBy selects how MaxPerGroup groups results: GroupByNodeType for
+	// the node type, or the name of a node property such as "host_id"
+	GroupBy string `json:"group_by,omitempty"`
+
+	// MaxPerGroup caps the results returned per group (0 for no cap).
+	// Applied by the harness after the backend returns.
+	MaxPerGroup int `json:"max_per_group,omitempty"`
+
+	// Diversity is the maximal-marginal-relevance lambda used to re-rank
+	// results, from 0 (most diverse) to 1 (most relevant). Nil disables
+	// re-ranking.
+	Diversity *float64 `json:"diversity,omitempty"`
+
+	// OverFetch multiplies TopK to size the candidate set fetched from the
+	// backend when results are re-ranked or capped (0 for
+	// DefaultOverFetch)
+	OverFetch int `json:"over_fetch,omitempty"`
+
 	// MissionRunID is set by harness (not agent) for mission-run scoped queries
 	MissionRunID string `json:"-"`
 
@@ -138,6 +205,26 @@ func (q *Query) WithNodeTypes(types ...string) *Query {
 	return q
 }
 
+// WithPropertyFilter adds a node property predicate to the query.
+// Multiple calls are combined with AND semantics. Supported operators are
+// eq, gt, gte, lt, lte, and contains; unknown operators are rejected by Validate.
+// Returns the Query for method chaining.
+//
+// Example:
+//
+//	q := NewStructuredQuery().
+//	    WithNodeTypes("finding").
+//	    WithPropertyFilter("severity", FilterOpEq, "high").
+//	    WithPropertyFilter("confidence", FilterOpGte, 0.8)
+func (q *Query) WithPropertyFilter(key string, op string, value any) *Query {
+	q.PropertyFilters = append(q.PropertyFilters, PropertyFilter{
+		Key:   key,
+		Op:    op,
+		Value: value,
+	})
+	return q
+}
+
 // WithMission sets the mission ID to filter by.
 // Returns the Query for method chaining.
 func (q *Query) WithMission(missionID string) *Query {
@@ -153,6 +240,42 @@ func (q *Query) WithWeights(vector, graph float64) *Query {
 	return q
 }
 
+// WithMaxPerGroup returns at most n results per group, so a cluster of
+// similar nodes, such as the findings of one host, cannot crowd out the
+// rest. groupBy is GroupByNodeType or the name of a node property; results
+// without the property are not capped. The harness fetches extra candidates
+// to fill TopK (see WithOverFetch).
+// Returns the Query for method chaining.
+//
+// Example:
+//
+//	q := NewQuery("exposed admin interfaces").
+//	    WithMaxPerGroup("host_id", 2)
+func (q *Query) WithMaxPerGroup(groupBy string, n int) *Query {
+	q.GroupBy = groupBy
+	q.MaxPerGroup = n
+	return q
+}
+
+// WithDiversity re-ranks results with maximal marginal relevance, trading
+// each result's score against its similarity to the results ranked above
+// it. lambda ranges from 0 (most diverse) to 1 (most relevant). Similarity
+// is computed client-side from node content with the default embedder. The
+// harness fetches extra candidates to choose from (see WithOverFetch).
+// Returns the Query for method chaining.
+func (q *Query) WithDiversity(lambda float64) *Query {
+	q.Diversity = &lambda
+	return q
+}
+
+// WithOverFetch sets the factor TopK is multiplied by to size the candidate
+// set fetched for WithDiversity and WithMaxPerGroup.
+// Returns the Query for method chaining.
+func (q *Query) WithOverFetch(factor int) *Query {
+	q.OverFetch = factor
+	return q
+}
+
 // WithMissionRun queries a specific mission run by ID.
 // Returns the Query for method chaining.
 func (q *Query) WithMissionRun(runID string) *Query {
@@ -182,9 +305,11 @@ func (q *Query) WithIncludeRunMetadata(include bool) *Query {
 }
 
 // Validate ensures the Query is properly configured.
+// Every rule is checked and all violations are returned together as a joined
+// error (see errors.Join), so a caller can fix a query in one pass.
 // Returns an error if:
 //   - Both Text and Embedding are provided
-//   - Neither Text nor Embedding is provided (UNLESS NodeTypes are specified for structured queries)
+//   - Neither Text nor Embedding is provided (UNLESS NodeTypes or PropertyFilters are specified for structured queries)
 //   - Text is empty when provided
 //   - Embedding is empty when provided
 //   - TopK is less than or equal to 0
@@ -193,33 +318,45 @@ func (q *Query) WithIncludeRunMetadata(include bool) *Query {
 //   - VectorWeight is negative (only for semantic queries)
 //   - GraphWeight is negative (only for semantic queries)
 //   - VectorWeight + GraphWeight does not equal 1.0 (only for semantic queries)
+//   - RunNumber is set and less than 1
+//   - A PropertyFilter has an empty Key, an unknown Op, or a nil Value
+//   - MaxPerGroup is negative, or positive without GroupBy
+//   - Diversity is not between 0 and 1
+//   - OverFetch is negative
 func (q *Query) Validate() error {
+	return errors.Join(q.validationErrors()...)
+}
+
+// validationErrors checks every validation rule and returns one error per violation.
+func (q *Query) validationErrors() []error {
+	var errs []error
+
 	// Check that exactly one of Text or Embedding is provided
 	hasText := q.Text != ""
 	hasEmbedding := len(q.Embedding) > 0
 
 	if hasText && hasEmbedding {
-		return errors.New("query must have either Text or Embedding, not both")
+		errs = append(errs, errors.New("query must have either Text or Embedding, not both"))
 	}
 
-	// Allow structured queries without Text/Embedding if NodeTypes are specified
-	if !hasText && !hasEmbedding && len(q.NodeTypes) == 0 {
-		return errors.New("query must have either Text, Embedding, or NodeTypes")
+	// Allow structured queries without Text/Embedding if NodeTypes or PropertyFilters are specified
+	if !hasText && !hasEmbedding && len(q.NodeTypes) == 0 && len(q.PropertyFilters) == 0 {
+		errs = append(errs, errors.New("query must have either Text, Embedding, or NodeTypes"))
 	}
 
 	// Validate TopK
 	if q.TopK <= 0 {
-		return fmt.Errorf("TopK must be greater than 0, got %d", q.TopK)
+		errs = append(errs, fmt.Errorf("TopK must be greater than 0, got %d", q.TopK))
 	}
 
 	// Validate MaxHops (0 is valid for structured queries without traversal)
 	if q.MaxHops < 0 {
-		return fmt.Errorf("MaxHops must be non-negative, got %d", q.MaxHops)
+		errs = append(errs, fmt.Errorf("MaxHops must be non-negative, got %d", q.MaxHops))
 	}
 
 	// Validate MinScore
 	if q.MinScore < 0.0 || q.MinScore > 1.0 {
-		return fmt.Errorf("MinScore must be between 0.0 and 1.0, got %f", q.MinScore)
+		errs = append(errs, fmt.Errorf("MinScore must be between 0.0 and 1.0, got %f", q.MinScore))
 	}
 
 	// Weight validation only applies to semantic queries (those with Text or Embedding).
@@ -228,26 +365,53 @@ func (q *Query) Validate() error {
 	if isSemanticQuery {
 		// Validate VectorWeight
 		if q.VectorWeight < 0.0 {
-			return fmt.Errorf("VectorWeight must be non-negative, got %f", q.VectorWeight)
+			errs = append(errs, fmt.Errorf("VectorWeight must be non-negative, got %f", q.VectorWeight))
 		}
 
 		// Validate GraphWeight
 		if q.GraphWeight < 0.0 {
-			return fmt.Errorf("GraphWeight must be non-negative, got %f", q.GraphWeight)
+			errs = append(errs, fmt.Errorf("GraphWeight must be non-negative, got %f", q.GraphWeight))
 		}
 
 		// Validate that weights sum to 1.0 (with small epsilon for floating point)
 		const epsilon = 0.0001
 		weightSum := q.VectorWeight + q.GraphWeight
 		if weightSum < 1.0-epsilon || weightSum > 1.0+epsilon {
-			return fmt.Errorf("VectorWeight + GraphWeight must equal 1.0, got %f", weightSum)
+			errs = append(errs, fmt.Errorf("VectorWeight + GraphWeight must equal 1.0, got %f", weightSum))
 		}
 	}
 
 	// Validate RunNumber if set (nil is valid - means all runs)
 	if q.RunNumber != nil && *q.RunNumber < 1 {
-		return fmt.Errorf("RunNumber must be greater than 0, got %d", *q.RunNumber)
+		errs = append(errs, fmt.Errorf("RunNumber must be greater than 0, got %d", *q.RunNumber))
+	}
+
+	// Validate property filters
+	for i, f := range q.PropertyFilters {
+		if f.Key == "" {
+			errs = append(errs, fmt.Errorf("PropertyFilters[%d]: key is required", i))
+		}
+		if !validFilterOps[f.Op] {
+			errs = append(errs, fmt.Errorf("PropertyFilters[%d]: unknown operator %q (valid: eq, gt, gte, lt, lte, contains)", i, f.Op))
+		}
+		if f.Value == nil {
+			errs = append(errs, fmt.Errorf("PropertyFilters[%d]: value is required for key %q", i, f.Key))
+		}
+	}
+
+	// Validate client-side re-ranking
+	if q.MaxPerGroup < 0 {
+		errs = append(errs, fmt.Errorf("MaxPerGroup must be non-negative, got %d", q.MaxPerGroup))
+	}
+	if q.MaxPerGroup > 0 && q.GroupBy == "" {
+		errs = append(errs, errors.New("GroupBy is required with MaxPerGroup"))
+	}
+	if q.Diversity != nil && (*q.Diversity < 0.0 || *q.Diversity > 1.0) {
+		errs = append(errs, fmt.Errorf("Diversity must be between 0.0 and 1.0, got %f", *q.Diversity))
+	}
+	if q.OverFetch < 0 {
+		errs = append(errs, fmt.Errorf("OverFetch must be non-negative, got %d", q.OverFetch))
 	}
 
-	return nil
+	return errs
 }
@@ -192,6 +192,47 @@ func TestQueryValidate(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "valid structured query with property filters only",
+			query: NewStructuredQuery().
+				WithPropertyFilter("severity", FilterOpEq, "high"),
+			wantErr: false,
+		},
+		{
+			name: "valid: every supported operator",
+			query: NewQuery("test").
+				WithPropertyFilter("severity", FilterOpEq, "high").
+				WithPropertyFilter("port", FilterOpGt, 1024).
+				WithPropertyFilter("port", FilterOpGte, 1025).
+				WithPropertyFilter("confidence", FilterOpLt, 1.0).
+				WithPropertyFilter("confidence", FilterOpLte, 0.9).
+				WithPropertyFilter("title", FilterOpContains, "injection"),
+			wantErr: false,
+		},
+		{
+			name: "invalid: unknown property filter operator",
+			query: NewStructuredQuery().
+				WithNodeTypes("finding").
+				WithPropertyFilter("severity", "like", "high"),
+			wantErr: true,
+			errMsg:  `unknown operator "like"`,
+		},
+		{
+			name: "invalid: empty property filter key",
+			query: NewStructuredQuery().
+				WithNodeTypes("finding").
+				WithPropertyFilter("", FilterOpEq, "high"),
+			wantErr: true,
+			errMsg:  "key is required",
+		},
+		{
+			name: "invalid: nil property filter value",
+			query: NewStructuredQuery().
+				WithNodeTypes("finding").
+				WithPropertyFilter("severity", FilterOpEq, nil),
+			wantErr: true,
+			errMsg:  "value is required",
+		},
 	}
 
 	for _, tt := range tests {
@@ -207,6 +248,35 @@ func TestQueryValidate(t *testing.T) {
 	}
 }
 
+func TestQueryValidate_ReportsAllViolations(t *testing.T) {
+	q := NewQuery("test").
+		WithTopK(0).
+		WithMaxHops(-1).
+		WithMinScore(1.5).
+		WithWeights(0.9, 0.3).
+		WithPropertyFilter("", "between", nil)
+
+	err := q.Validate()
+	require.Error(t, err)
+
+	for _, want := range []string{
+		"TopK must be greater than 0",
+		"MaxHops must be non-negative",
+		"MinScore must be between 0.0 and 1.0",
+		"VectorWeight + GraphWeight must equal 1.0",
+		"PropertyFilters[0]: key is required",
+		"PropertyFilters[0]: unknown operator \"between\"",
+		"PropertyFilters[0]: value is required",
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+
+	// A single violation is reported unchanged
+	err = NewQuery("test").WithTopK(0).Validate()
+	require.Error(t, err)
+	assert.Equal(t, "TopK must be greater than 0, got 0", err.Error())
+}
+
 func TestQueryBuilderChaining(t *testing.T) {
 	q := NewQuery("test query").
 		WithTopK(20).
@@ -233,6 +303,18 @@ func TestQueryBuilderChaining(t *testing.T) {
 	assert.True(t, q.IncludeRunMetadata)
 }
 
+func TestWithPropertyFilter(t *testing.T) {
+	q := NewStructuredQuery().
+		WithNodeTypes("finding").
+		WithPropertyFilter("severity", FilterOpEq, "high").
+		WithPropertyFilter("confidence", FilterOpGte, 0.8)
+
+	require.Len(t, q.PropertyFilters, 2)
+	assert.Equal(t, PropertyFilter{Key: "severity", Op: "eq", Value: "high"}, q.PropertyFilters[0])
+	assert.Equal(t, PropertyFilter{Key: "confidence", Op: "gte", Value: 0.8}, q.PropertyFilters[1])
+	require.NoError(t, q.Validate())
+}
+
 func TestStructuredQueryChaining(t *testing.T) {
 	q := NewStructuredQuery().
 		WithNodeTypes("host", "port").
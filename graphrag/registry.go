@@ -184,6 +184,7 @@ func (r *DefaultNodeTypeRegistry) register(nodeType string, properties []string)
 }
 
 // GetIdentifyingProperties returns the property names that uniquely identify a node of the given type.
+// Custom types registered with RegisterCustomType are included.
 // Thread-safe for concurrent access.
 func (r *DefaultNodeTypeRegistry) GetIdentifyingProperties(nodeType string) ([]string, error) {
 	r.mu.RLock()
@@ -191,6 +192,9 @@ func (r *DefaultNodeTypeRegistry) GetIdentifyingProperties(nodeType string) ([]s
 
 	props, ok := r.registry[nodeType]
 	if !ok {
+		if props, ok := CustomTypeIdentifyingProperties(nodeType); ok {
+			return props, nil
+		}
 		return nil, fmt.Errorf("%w: %s", ErrNodeTypeNotRegistered, nodeType)
 	}
 
@@ -200,13 +204,17 @@ func (r *DefaultNodeTypeRegistry) GetIdentifyingProperties(nodeType string) ([]s
 	return result, nil
 }
 
-// IsRegistered checks if a node type exists in the registry.
+// IsRegistered checks if a node type exists in the registry, or is a
+// custom type registered with RegisterCustomType.
 // Thread-safe for concurrent access.
 func (r *DefaultNodeTypeRegistry) IsRegistered(nodeType string) bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	_, ok := r.registry[nodeType]
+	if _, ok := r.registry[nodeType]; ok {
+		return true
+	}
+	_, ok := CustomTypeIdentifyingProperties(nodeType)
 	return ok
 }
 
@@ -219,14 +227,7 @@ func (r *DefaultNodeTypeRegistry) ValidateProperties(nodeType string, properties
 		return nil, err
 	}
 
-	var missing []string
-	for _, prop := range identifyingProps {
-		if val, ok := properties[prop]; !ok || val == nil || (isString(val) && strings.TrimSpace(val.(string)) == "") {
-			missing = append(missing, prop)
-		}
-	}
-
-	if len(missing) > 0 {
+	if missing := missingProperties(identifyingProps, properties); len(missing) > 0 {
 		return missing, fmt.Errorf("%w for node type '%s': %v", ErrMissingIdentifyingProperties, nodeType, missing)
 	}
 
@@ -248,6 +249,18 @@ func (r *DefaultNodeTypeRegistry) AllNodeTypes() []string {
 	return types
 }
 
+// missingProperties returns the names in props that are absent from
+// properties, nil, or blank strings.
+func missingProperties(props []string, properties map[string]any) []string {
+	var missing []string
+	for _, prop := range props {
+		if val, ok := properties[prop]; !ok || val == nil || (isString(val) && strings.TrimSpace(val.(string)) == "") {
+			missing = append(missing, prop)
+		}
+	}
+	return missing
+}
+
 // isString checks if a value is a string type.
 func isString(val any) bool {
 	_, ok := val.(string)
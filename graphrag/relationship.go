@@ -57,6 +57,11 @@ func (r *Relationship) WithBidirectional(bi bool) *Relationship {
 
 // Validate checks that the relationship has all required fields populated.
 // Returns an error if FromID, ToID, or Type are empty.
+//
+// For documented relationship types, Validate also logs a warning for
+// properties spelled differently from their canonical name, such as "conf"
+// instead of PropConfidence on USES_TECHNIQUE. Such relationships are still
+// valid.
 func (r *Relationship) Validate() error {
 	if r.FromID == "" {
 		return fmt.Errorf("relationship FromID cannot be empty")
@@ -67,5 +72,6 @@ func (r *Relationship) Validate() error {
 	if r.Type == "" {
 		return fmt.Errorf("relationship Type cannot be empty")
 	}
+	warnNonCanonicalProperties(r)
 	return nil
 }
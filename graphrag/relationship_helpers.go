@@ -0,0 +1,151 @@
+package graphrag
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+)
+
+// Relationship types from the documented vocabulary that have no generated
+// taxonomy constant.
+const (
+	// RelTypeSIMILARTO is the "SIMILAR_TO" relationship type.
+	RelTypeSIMILARTO = "SIMILAR_TO"
+	// RelTypeMITIGATES is the "MITIGATES" relationship type.
+	RelTypeMITIGATES = "MITIGATES"
+	// RelTypeELICITED is the "ELICITED" relationship type.
+	RelTypeELICITED = "ELICITED"
+)
+
+// Canonical relationship property names. Use these instead of ad-hoc
+// spellings so that queries and scorers can rely on them.
+const (
+	// PropConfidence is the confidence of a USES_TECHNIQUE mapping, in [0, 1].
+	PropConfidence = "confidence"
+
+	// PropSequence is the 1-based position of a LEADS_TO step in an attack chain.
+	PropSequence = "sequence"
+
+	// PropSimilarity is the similarity score of a SIMILAR_TO relationship, in [0, 1].
+	PropSimilarity = "similarity"
+)
+
+// propertyAliases maps known relationship types to common misspellings of
+// their canonical property names.
+var propertyAliases = map[string]map[string]string{
+	RelTypeUSESTECHNIQUE: {
+		"conf":             PropConfidence,
+		"score":            PropConfidence,
+		"confidence_score": PropConfidence,
+	},
+	RelTypeLEADSTO: {
+		"seq":   PropSequence,
+		"step":  PropSequence,
+		"order": PropSequence,
+	},
+	RelTypeSIMILARTO: {
+		"score":            PropSimilarity,
+		"sim":              PropSimilarity,
+		"similarity_score": PropSimilarity,
+	},
+}
+
+// UsesTechnique creates a USES_TECHNIQUE relationship from a finding to an
+// attack technique. Confidence must be in [0, 1].
+func UsesTechnique(findingID, techniqueID string, confidence float64) (*Relationship, error) {
+	if err := checkUnitInterval(PropConfidence, confidence); err != nil {
+		return nil, err
+	}
+	return validated(NewRelationship(findingID, techniqueID, RelTypeUSESTECHNIQUE).
+		WithProperty(PropConfidence, confidence))
+}
+
+// LeadsTo creates a LEADS_TO relationship between consecutive findings of an
+// attack chain. Sequence is the 1-based position of the step in the chain.
+func LeadsTo(fromID, toID string, sequence int) (*Relationship, error) {
+	if sequence < 1 {
+		return nil, fmt.Errorf("relationship %s must be at least 1, got %d", PropSequence, sequence)
+	}
+	return validated(NewRelationship(fromID, toID, RelTypeLEADSTO).
+		WithProperty(PropSequence, sequence))
+}
+
+// SimilarTo creates a bidirectional SIMILAR_TO relationship between two
+// findings. Similarity must be in [0, 1].
+func SimilarTo(aID, bID string, similarity float64) (*Relationship, error) {
+	if err := checkUnitInterval(PropSimilarity, similarity); err != nil {
+		return nil, err
+	}
+	return validated(NewRelationship(aID, bID, RelTypeSIMILARTO).
+		WithProperty(PropSimilarity, similarity).
+		WithBidirectional(true))
+}
+
+// Mitigates creates a MITIGATES relationship from a control or mitigation to
+// the finding it addresses.
+func Mitigates(controlID, findingID string) (*Relationship, error) {
+	return validated(NewRelationship(controlID, findingID, RelTypeMITIGATES))
+}
+
+// Elicited creates an ELICITED relationship from a prompt or probe to the
+// response or finding it elicited.
+func Elicited(promptID, responseID string) (*Relationship, error) {
+	return validated(NewRelationship(promptID, responseID, RelTypeELICITED))
+}
+
+// validated returns rel if it passes Validate.
+func validated(rel *Relationship) (*Relationship, error) {
+	if err := rel.Validate(); err != nil {
+		return nil, err
+	}
+	return rel, nil
+}
+
+// checkUnitInterval checks that a score property is within [0, 1].
+func checkUnitInterval(name string, v float64) error {
+	if math.IsNaN(v) || v < 0 || v > 1 {
+		return fmt.Errorf("relationship %s must be between 0 and 1, got %v", name, v)
+	}
+	return nil
+}
+
+// nonCanonicalProperties returns the properties of r that are known
+// misspellings of a canonical property name for its type, mapped to the
+// canonical name.
+func nonCanonicalProperties(r *Relationship) map[string]string {
+	aliases := propertyAliases[r.Type]
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	var found map[string]string
+	for key := range r.Properties {
+		if canonical, ok := aliases[key]; ok {
+			if found == nil {
+				found = make(map[string]string)
+			}
+			found[key] = canonical
+		}
+	}
+	return found
+}
+
+// warnNonCanonicalProperties logs a warning for each property of r that
+// should use its canonical name.
+func warnNonCanonicalProperties(r *Relationship) {
+	found := nonCanonicalProperties(r)
+	keys := make([]string, 0, len(found))
+	for key := range found {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		slog.Warn("relationship property is not canonical",
+			"type", r.Type,
+			"property", key,
+			"canonical", found[key],
+		)
+	}
+}
@@ -0,0 +1,173 @@
+package graphrag
+
+import (
+	"bytes"
+	"log/slog"
+	"math"
+	"strings"
+	"testing"
+)
+
+// captureWarnings redirects the default slog logger to a buffer for the
+// duration of the test.
+func captureWarnings(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn})))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+	return &buf
+}
+
+func TestUsesTechnique(t *testing.T) {
+	rel, err := UsesTechnique("finding-1", "technique-T1190", 0.95)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rel.Type != RelTypeUSESTECHNIQUE {
+		t.Errorf("expected Type %s, got %s", RelTypeUSESTECHNIQUE, rel.Type)
+	}
+	if rel.FromID != "finding-1" || rel.ToID != "technique-T1190" {
+		t.Errorf("unexpected endpoints %s -> %s", rel.FromID, rel.ToID)
+	}
+	if rel.Properties[PropConfidence] != 0.95 {
+		t.Errorf("expected confidence 0.95, got %v", rel.Properties[PropConfidence])
+	}
+
+	for _, confidence := range []float64{0, 1} {
+		if _, err := UsesTechnique("finding-1", "technique-T1190", confidence); err != nil {
+			t.Errorf("confidence %v: unexpected error: %v", confidence, err)
+		}
+	}
+	for _, confidence := range []float64{-0.01, 1.01, math.NaN()} {
+		if _, err := UsesTechnique("finding-1", "technique-T1190", confidence); err == nil {
+			t.Errorf("confidence %v: expected error", confidence)
+		}
+	}
+	if _, err := UsesTechnique("", "technique-T1190", 0.5); err == nil {
+		t.Error("expected error for empty finding ID")
+	}
+}
+
+func TestLeadsTo(t *testing.T) {
+	rel, err := LeadsTo("recon", "bruteforce", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rel.Type != RelTypeLEADSTO {
+		t.Errorf("expected Type %s, got %s", RelTypeLEADSTO, rel.Type)
+	}
+	if rel.Properties[PropSequence] != 1 {
+		t.Errorf("expected sequence 1, got %v", rel.Properties[PropSequence])
+	}
+	if rel.Bidirectional {
+		t.Error("expected LEADS_TO to be unidirectional")
+	}
+
+	for _, sequence := range []int{0, -1} {
+		if _, err := LeadsTo("recon", "bruteforce", sequence); err == nil {
+			t.Errorf("sequence %d: expected error", sequence)
+		}
+	}
+	if _, err := LeadsTo("recon", "", 2); err == nil {
+		t.Error("expected error for empty to ID")
+	}
+}
+
+func TestSimilarTo(t *testing.T) {
+	rel, err := SimilarTo("finding-1", "finding-2", 0.87)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rel.Type != RelTypeSIMILARTO {
+		t.Errorf("expected Type %s, got %s", RelTypeSIMILARTO, rel.Type)
+	}
+	if rel.Properties[PropSimilarity] != 0.87 {
+		t.Errorf("expected similarity 0.87, got %v", rel.Properties[PropSimilarity])
+	}
+	if !rel.Bidirectional {
+		t.Error("expected SIMILAR_TO to be bidirectional")
+	}
+
+	for _, similarity := range []float64{0, 1} {
+		if _, err := SimilarTo("finding-1", "finding-2", similarity); err != nil {
+			t.Errorf("similarity %v: unexpected error: %v", similarity, err)
+		}
+	}
+	for _, similarity := range []float64{-0.5, 2, math.Inf(1), math.NaN()} {
+		if _, err := SimilarTo("finding-1", "finding-2", similarity); err == nil {
+			t.Errorf("similarity %v: expected error", similarity)
+		}
+	}
+}
+
+func TestMitigates(t *testing.T) {
+	rel, err := Mitigates("control-mfa", "finding-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rel.Type != RelTypeMITIGATES {
+		t.Errorf("expected Type %s, got %s", RelTypeMITIGATES, rel.Type)
+	}
+	if rel.FromID != "control-mfa" || rel.ToID != "finding-1" {
+		t.Errorf("unexpected endpoints %s -> %s", rel.FromID, rel.ToID)
+	}
+	if len(rel.Properties) != 0 {
+		t.Errorf("expected no properties, got %v", rel.Properties)
+	}
+
+	if _, err := Mitigates("", "finding-1"); err == nil {
+		t.Error("expected error for empty control ID")
+	}
+}
+
+func TestElicited(t *testing.T) {
+	rel, err := Elicited("prompt-1", "finding-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rel.Type != RelTypeELICITED {
+		t.Errorf("expected Type %s, got %s", RelTypeELICITED, rel.Type)
+	}
+
+	if _, err := Elicited("prompt-1", ""); err == nil {
+		t.Error("expected error for empty response ID")
+	}
+}
+
+func TestRelationshipValidate_NonCanonicalProperties(t *testing.T) {
+	buf := captureWarnings(t)
+
+	rel := NewRelationship("finding-1", "technique-T1190", RelTypeUSESTECHNIQUE).
+		WithProperty("conf", 0.9)
+	if err := rel.Validate(); err != nil {
+		t.Fatalf("non-canonical properties should not fail validation: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "property=conf") || !strings.Contains(out, "canonical=confidence") {
+		t.Errorf("expected warning about conf, got %q", out)
+	}
+
+	// "score" means similarity on SIMILAR_TO
+	buf.Reset()
+	rel = NewRelationship("finding-1", "finding-2", RelTypeSIMILARTO).WithProperty("score", 0.8)
+	_ = rel.Validate()
+	if !strings.Contains(buf.String(), "canonical=similarity") {
+		t.Errorf("expected warning about score, got %q", buf.String())
+	}
+
+	// Canonical names, unknown properties, and unknown types do not warn
+	buf.Reset()
+	for _, r := range []*Relationship{
+		NewRelationship("finding-1", "technique-T1190", RelTypeUSESTECHNIQUE).WithProperty(PropConfidence, 0.9),
+		NewRelationship("finding-1", "finding-2", RelTypeLEADSTO).WithProperty("note", "pivot"),
+		NewRelationship("a", "b", "CUSTOM_LINK").WithProperty("conf", 0.9),
+	} {
+		if err := r.Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no warnings, got %q", buf.String())
+	}
+}
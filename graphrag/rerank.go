@@ -0,0 +1,177 @@
+package graphrag
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GroupByNodeType groups results by GraphNode.Type in Query.WithMaxPerGroup.
+// Any other GroupBy value names a node property.
+const GroupByNodeType = "type"
+
+// DefaultOverFetch is the factor TopK is multiplied by to size the
+// candidate set of a re-ranked or capped query when Query.OverFetch is 0.
+const DefaultOverFetch = 3
+
+// Reranked reports whether the query's results are re-ranked or capped
+// client-side, by WithDiversity or WithMaxPerGroup.
+func (q *Query) Reranked() bool {
+	return q.Diversity != nil || q.MaxPerGroup > 0
+}
+
+// CandidateTopK returns the number of results to fetch from the backend:
+// TopK inflated by OverFetch (or DefaultOverFetch) when the query is
+// re-ranked, and TopK otherwise.
+func (q *Query) CandidateTopK() int {
+	if !q.Reranked() {
+		return q.TopK
+	}
+	factor := q.OverFetch
+	if factor <= 0 {
+		factor = DefaultOverFetch
+	}
+	return q.TopK * factor
+}
+
+// RerankResults applies the query's WithMaxPerGroup and WithDiversity
+// settings to results, a candidate set fetched with CandidateTopK results in
+// backend order, and returns at most TopK of them. Each returned result
+// records its backend position in OriginalRank. Results are returned
+// unchanged when the query is not re-ranked.
+//
+// With Diversity set, results are chosen greedily by maximal marginal
+// relevance:
+//
+//	lambda*Score - (1-lambda)*max similarity to the results already chosen
+//
+// where similarity is the cosine similarity of node content embedded with
+// e, or with the default embedder if e is nil, or with a HashingEmbedder if
+// neither is set. Nodes without content are compared by type and
+// properties. Without Diversity, backend order is kept. Either way, a
+// result whose group already holds MaxPerGroup results is skipped, and ties
+// go to the result the backend ranked first, so the output is deterministic.
+// Errors wrap ErrEmbeddingFailed.
+func RerankResults(ctx context.Context, q Query, results []Result, e Embedder) ([]Result, error) {
+	if !q.Reranked() {
+		return results, nil
+	}
+
+	candidates := make([]Result, len(results))
+	for i, r := range results {
+		r.OriginalRank = i + 1
+		candidates[i] = r
+	}
+
+	lambda := 1.0
+	var vectors [][]float32
+	if q.Diversity != nil {
+		lambda = *q.Diversity
+		if lambda < 1 && len(candidates) > 1 {
+			var err error
+			if vectors, err = embedResults(ctx, candidates, e); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// redundancy[i] is the highest similarity of candidate i to a chosen
+	// result
+	redundancy := make([]float64, len(candidates))
+	groups := make(map[string]int)
+	remaining := make([]int, len(candidates))
+	for i := range remaining {
+		remaining[i] = i
+	}
+
+	selected := make([]Result, 0, min(q.TopK, len(candidates)))
+	for len(selected) < q.TopK {
+		best, bestAt := -1, -1
+		var bestScore float64
+		eligible := remaining[:0]
+		for _, i := range remaining {
+			group, grouped := resultGroup(candidates[i].Node, q.GroupBy)
+			if q.MaxPerGroup > 0 && grouped && groups[group] >= q.MaxPerGroup {
+				continue
+			}
+			eligible = append(eligible, i)
+
+			score := -float64(i)
+			if q.Diversity != nil {
+				score = lambda*candidates[i].Score - (1-lambda)*redundancy[i]
+			}
+			if best < 0 || score > bestScore {
+				best, bestAt, bestScore = i, len(eligible)-1, score
+			}
+		}
+		remaining = eligible
+		if best < 0 {
+			break
+		}
+
+		selected = append(selected, candidates[best])
+		remaining = append(remaining[:bestAt], remaining[bestAt+1:]...)
+		if group, grouped := resultGroup(candidates[best].Node, q.GroupBy); grouped && q.MaxPerGroup > 0 {
+			groups[group]++
+		}
+		if vectors != nil {
+			for _, i := range remaining {
+				sim, _ := CosineSimilarity(vectors[i], vectors[best])
+				redundancy[i] = max(redundancy[i], sim)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// resultGroup returns the MaxPerGroup group of node, or false if it has no
+// value for groupBy.
+func resultGroup(node GraphNode, groupBy string) (string, bool) {
+	if groupBy == GroupByNodeType {
+		return node.Type, true
+	}
+	m, k := node.property(groupBy)
+	v, ok := m[k]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(v), true
+}
+
+// embedResults embeds the node text of each result with e, the default
+// embedder, or a HashingEmbedder.
+func embedResults(ctx context.Context, results []Result, e Embedder) ([][]float32, error) {
+	if e == nil {
+		e = DefaultEmbedder()
+	}
+	if e == nil {
+		e = NewHashingEmbedder(0)
+	}
+
+	texts := make([]string, len(results))
+	for i, r := range results {
+		texts[i] = nodeText(r.Node)
+	}
+	return EmbedText(ctx, e, texts...)
+}
+
+// nodeText returns the text compared for diversity: the node's content, or
+// its type and sorted properties if it has none.
+func nodeText(node GraphNode) string {
+	if node.Content != "" {
+		return node.Content
+	}
+	keys := make([]string, 0, len(node.Properties))
+	for k := range node.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(node.Type)
+	for _, k := range keys {
+		fmt.Fprintf(&b, " %s=%v", k, node.Properties[k])
+	}
+	return b.String()
+}
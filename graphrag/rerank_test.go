@@ -0,0 +1,136 @@
+package graphrag
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clusteredResults returns a backend ranking dominated by one cluster: eight
+// near-identical SQL injection findings on host-1 score highest, followed by
+// distinct results on other hosts.
+func clusteredResults() []Result {
+	var results []Result
+	for i := 0; i < 8; i++ {
+		results = append(results, Result{
+			Node: GraphNode{
+				ID:         fmt.Sprintf("sqli-%d", i),
+				Type:       "finding",
+				Content:    fmt.Sprintf("SQL injection in login form parameter username on host-1 variant %d", i),
+				Properties: map[string]any{"host_id": "host-1"},
+			},
+			Score: 0.95 - float64(i)*0.001,
+		})
+	}
+	others := []GraphNode{
+		{ID: "xss", Type: "finding", Content: "Reflected cross-site scripting in search page", Properties: map[string]any{"host_id": "host-2"}},
+		{ID: "ssh", Type: "port", Content: "OpenSSH 7.2 listening on port 22", Properties: map[string]any{"host_id": "host-3"}},
+		{ID: "creds", Type: "credential", Content: "Default admin password accepted by router web console"},
+		{ID: "tls", Type: "finding", Content: "Expired TLS certificate served by mail gateway", Properties: map[string]any{"host_id": "host-4"}},
+	}
+	for i, node := range others {
+		results = append(results, Result{Node: node, Score: 0.8 - float64(i)*0.01})
+	}
+	return results
+}
+
+func resultIDs(results []Result) []string {
+	ids := make([]string, len(results))
+	for i, r := range results {
+		ids[i] = r.Node.ID
+	}
+	return ids
+}
+
+func TestQuery_CandidateTopK(t *testing.T) {
+	assert.Equal(t, 10, NewQuery("x").CandidateTopK(), "plain queries are not inflated")
+	assert.Equal(t, 30, NewQuery("x").WithDiversity(0.5).CandidateTopK())
+	assert.Equal(t, 50, NewQuery("x").WithMaxPerGroup(GroupByNodeType, 2).WithOverFetch(5).CandidateTopK())
+}
+
+func TestQuery_ValidateRerank(t *testing.T) {
+	assert.NoError(t, NewQuery("x").WithDiversity(0).WithMaxPerGroup("host_id", 1).Validate())
+	assert.Error(t, NewQuery("x").WithDiversity(1.5).Validate())
+	assert.Error(t, NewQuery("x").WithMaxPerGroup("", 2).Validate())
+	assert.Error(t, NewQuery("x").WithMaxPerGroup("host_id", -1).Validate())
+	assert.Error(t, NewQuery("x").WithOverFetch(-1).Validate())
+}
+
+func TestRerankResults_NotReranked(t *testing.T) {
+	results := clusteredResults()
+	got, err := RerankResults(context.Background(), *NewQuery("sqli").WithTopK(3), results, nil)
+	require.NoError(t, err)
+	assert.Equal(t, results, got, "results pass through untouched")
+}
+
+func TestRerankResults_MaxPerGroup(t *testing.T) {
+	ctx := context.Background()
+
+	q := NewQuery("login issues").WithTopK(5).WithMaxPerGroup("host_id", 2)
+	got, err := RerankResults(ctx, *q, clusteredResults(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sqli-0", "sqli-1", "xss", "ssh", "creds"}, resultIDs(got),
+		"backend order is kept and the node without host_id is not capped")
+	assert.Equal(t, []int{1, 2, 9, 10, 11}, []int{got[0].OriginalRank, got[1].OriginalRank, got[2].OriginalRank, got[3].OriginalRank, got[4].OriginalRank})
+
+	q = NewQuery("login issues").WithTopK(10).WithMaxPerGroup(GroupByNodeType, 1)
+	got, err = RerankResults(ctx, *q, clusteredResults(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sqli-0", "ssh", "creds"}, resultIDs(got), "fewer than TopK when groups run out")
+}
+
+func TestRerankResults_Diversity(t *testing.T) {
+	ctx := context.Background()
+	embedder := NewHashingEmbedder(0)
+
+	relevant, err := RerankResults(ctx, *NewQuery("sqli").WithTopK(4).WithDiversity(1), clusteredResults(), embedder)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sqli-0", "sqli-1", "sqli-2", "sqli-3"}, resultIDs(relevant), "lambda 1 ranks by score")
+
+	diverse, err := RerankResults(ctx, *NewQuery("sqli").WithTopK(4).WithDiversity(0.5), clusteredResults(), embedder)
+	require.NoError(t, err)
+	require.Len(t, diverse, 4)
+	assert.Equal(t, "sqli-0", diverse[0].Node.ID, "the most relevant result leads")
+	sqli := 0
+	for _, r := range diverse {
+		if r.Node.Properties["host_id"] == "host-1" {
+			sqli++
+		}
+	}
+	assert.Equal(t, 1, sqli, "near-duplicates give way to distinct results: %v", resultIDs(diverse))
+	assert.Equal(t, 1, diverse[0].OriginalRank)
+	assert.Greater(t, diverse[1].OriginalRank, 8)
+
+	again, err := RerankResults(ctx, *NewQuery("sqli").WithTopK(4).WithDiversity(0.5), clusteredResults(), embedder)
+	require.NoError(t, err)
+	assert.Equal(t, resultIDs(diverse), resultIDs(again), "re-ranking is deterministic")
+}
+
+func TestRerankResults_TiesKeepBackendOrder(t *testing.T) {
+	results := []Result{
+		{Node: GraphNode{ID: "a", Content: "same"}, Score: 0.9},
+		{Node: GraphNode{ID: "b", Content: "same"}, Score: 0.9},
+		{Node: GraphNode{ID: "c", Content: "same"}, Score: 0.9},
+	}
+	got, err := RerankResults(context.Background(), *NewQuery("x").WithTopK(3).WithDiversity(0.3), results, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, resultIDs(got))
+}
+
+func TestRerankResults_EmbeddingError(t *testing.T) {
+	q := NewQuery("x").WithDiversity(0.5)
+	_, err := RerankResults(context.Background(), *q, clusteredResults(), failingEmbedder{})
+	assert.ErrorIs(t, err, ErrEmbeddingFailed)
+}
+
+// failingEmbedder is an Embedder whose Embed always fails.
+type failingEmbedder struct{}
+
+func (failingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	return nil, fmt.Errorf("model unavailable")
+}
+
+func (failingEmbedder) Dimensions() int { return 8 }
@@ -23,6 +23,11 @@ type Result struct {
 	// Distance is the number of hops from the query origin to this node
 	Distance int `json:"distance"`
 
+	// OriginalRank is the 1-based position of the result in the backend's
+	// ranking when the harness re-ranked or capped the results (see
+	// Query.WithDiversity and Query.WithMaxPerGroup), and 0 otherwise.
+	OriginalRank int `json:"original_rank,omitempty"`
+
 	// RunMetadata contains run provenance information if requested via IncludeRunMetadata.
 	// This field will be nil if the query did not request run metadata or if the node
 	// has no mission context.
@@ -53,4 +58,8 @@ type TraversalResult struct {
 
 	// Distance is the number of hops from the traversal origin to this node
 	Distance int `json:"distance"`
+
+	// MustPassSatisfied is true when the traversal had MustPassNodeTypes and
+	// Path passes through a node of each of them.
+	MustPassSatisfied bool `json:"must_pass_satisfied,omitempty"`
 }
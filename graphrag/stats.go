@@ -0,0 +1,66 @@
+package graphrag
+
+import "math"
+
+// GraphStats summarizes the size of the knowledge graph within a mission scope.
+// Agents use it before a traversal to pick query parameters, e.g. a lower
+// MaxHops on a dense graph.
+type GraphStats struct {
+	// MissionID is the mission the statistics were computed for
+	MissionID string `json:"mission_id"`
+
+	// NodeCount is the total number of nodes in scope
+	NodeCount int64 `json:"node_count"`
+
+	// RelationshipCount is the total number of relationships in scope
+	RelationshipCount int64 `json:"relationship_count"`
+
+	// NodesByType maps node types (e.g. "host", "port") to their counts
+	NodesByType map[string]int64 `json:"nodes_by_type,omitempty"`
+
+	// RelationshipsByType maps relationship types (e.g. "HAS_PORT") to their counts
+	RelationshipsByType map[string]int64 `json:"relationships_by_type,omitempty"`
+
+	// AverageDegree is the mean number of relationships per node
+	AverageDegree float64 `json:"average_degree"`
+}
+
+// ComputeAverageDegree returns the mean number of relationships touching
+// a node, counting each relationship at both ends. Returns 0 for an empty graph.
+func ComputeAverageDegree(nodeCount, relationshipCount int64) float64 {
+	if nodeCount <= 0 {
+		return 0
+	}
+	return 2 * float64(relationshipCount) / float64(nodeCount)
+}
+
+// SuggestMaxHops returns the largest traversal depth, up to maxHops, whose
+// estimated reach stays within maxNodes. Reach is estimated as
+// AverageDegree^hops, so dense graphs get shallower traversals. At least one
+// hop is always suggested when maxHops is positive, and maxNodes <= 0
+// means no limit.
+//
+// Example:
+//
+//	stats, _ := harness.GraphStats(ctx, "")
+//	query := graphrag.NewQuery("exposed services").
+//	    WithMaxHops(stats.SuggestMaxHops(3, 500))
+func (s *GraphStats) SuggestMaxHops(maxHops int, maxNodes int64) int {
+	if maxHops <= 0 {
+		return 0
+	}
+	if s == nil || s.AverageDegree <= 1 || maxNodes <= 0 {
+		return maxHops
+	}
+
+	// Small epsilon so exact powers (e.g. 10^3 = 1000) aren't rounded down
+	limit := math.Log(float64(maxNodes)) / math.Log(s.AverageDegree)
+	hops := int(math.Floor(limit + 1e-9))
+	if hops < 1 {
+		return 1
+	}
+	if hops > maxHops {
+		return maxHops
+	}
+	return hops
+}
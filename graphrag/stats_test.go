@@ -0,0 +1,38 @@
+package graphrag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeAverageDegree(t *testing.T) {
+	assert.Equal(t, 0.0, ComputeAverageDegree(0, 10))
+	assert.Equal(t, 5.0, ComputeAverageDegree(40, 100))
+	assert.Equal(t, 0.0, ComputeAverageDegree(10, 0))
+}
+
+func TestGraphStats_SuggestMaxHops(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    *GraphStats
+		maxHops  int
+		maxNodes int64
+		want     int
+	}{
+		{"nil stats", nil, 3, 100, 3},
+		{"sparse graph keeps max", &GraphStats{AverageDegree: 1}, 3, 100, 3},
+		{"no node limit", &GraphStats{AverageDegree: 50}, 3, 0, 3},
+		{"exact power", &GraphStats{AverageDegree: 10}, 5, 1000, 3},
+		{"dense graph lowers hops", &GraphStats{AverageDegree: 20}, 4, 500, 2},
+		{"very dense still one hop", &GraphStats{AverageDegree: 1000}, 3, 100, 1},
+		{"capped at max", &GraphStats{AverageDegree: 2}, 3, 10000, 3},
+		{"zero max hops", &GraphStats{AverageDegree: 2}, 0, 100, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.stats.SuggestMaxHops(tt.maxHops, tt.maxNodes))
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package graphrag
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// NodeFunc returns the node with the given ID, or nil if it does not exist.
+// Implementations typically query a graph store.
+type NodeFunc func(ctx context.Context, nodeID string) (*GraphNode, error)
+
+// Traverse walks the graph from startID, expanding nodes with neighbors and
+// looking up node types with nodes. It is the reference implementation of
+// the TraversalOptions semantics that graph backends follow:
+//
+//   - Each reachable node is returned once, with the shortest path that
+//     satisfies the constraints, in breadth-first order.
+//   - MustPassNodeTypes is satisfied when the nodes strictly between the
+//     origin and the result node include each listed type. Nodes reached only
+//     by paths that do not satisfy it are traversed through but not returned.
+//   - Nodes of an AvoidNodeTypes type are never entered. The origin is exempt.
+//   - With CyclePrune a path never revisits a node; with CycleAllowRevisit it
+//     may revisit each node up to MaxRevisits times.
+//   - NodeTypes only filters the results, not the nodes traversed through.
+//
+// The search keeps one path per node and must-pass progress, so it
+// terminates on cyclic graphs in time bounded by the graph size and
+// MaxDepth rather than by the number of paths.
+//
+// Example:
+//
+//	results, err := graphrag.Traverse(ctx, store.Relationships, store.Node, "finding-1",
+//	    *graphrag.NewTraversalOptions().
+//	        WithDirection("both").
+//	        WithMustPassNodeTypes([]string{graphrag.NodeTypeTechnique}))
+func Traverse(ctx context.Context, neighbors NeighborFunc, nodes NodeFunc, startID string, opts TraversalOptions) ([]TraversalResult, error) {
+	if startID == "" {
+		return nil, fmt.Errorf("%w: start node ID is required", ErrInvalidQuery)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.Normalize()
+
+	// Must-pass progress is a bit set over the required types
+	mustPass := make(map[string]uint64)
+	for _, t := range opts.MustPassNodeTypes {
+		if _, ok := mustPass[t]; !ok {
+			if len(mustPass) == 64 {
+				return nil, fmt.Errorf("%w: at most 64 must-pass node types are supported", ErrInvalidQuery)
+			}
+			mustPass[t] = 1 << len(mustPass)
+		}
+	}
+	satisfied := uint64(1)<<len(mustPass) - 1
+
+	allowedRels := toSet(opts.RelationshipTypes)
+	resultTypes := toSet(opts.NodeTypes)
+	avoid := toSet(opts.AvoidNodeTypes)
+	maxVisits := 1
+	if opts.CycleHandling == CycleAllowRevisit {
+		maxVisits += opts.MaxRevisits
+	}
+
+	nodeCache := map[string]*GraphNode{}
+	lookup := func(id string) (*GraphNode, error) {
+		if n, ok := nodeCache[id]; ok {
+			return n, nil
+		}
+		n, err := nodes(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up node %s: %w", id, err)
+		}
+		nodeCache[id] = n
+		return n, nil
+	}
+	adjacency := map[string][]Relationship{}
+
+	type state struct {
+		node     string
+		progress uint64
+	}
+	type entry struct {
+		path     []string
+		progress uint64
+	}
+
+	seen := map[state]bool{{startID, 0}: true}
+	reported := map[string]bool{startID: true}
+	frontier := []entry{{path: []string{startID}}}
+	var results []TraversalResult
+
+	for depth := 1; depth <= opts.MaxDepth && len(frontier) > 0; depth++ {
+		var next []entry
+		for _, cur := range frontier {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			curID := cur.path[len(cur.path)-1]
+			progress := cur.progress
+			if len(cur.path) > 1 && len(mustPass) > 0 {
+				// The current node is now between the origin and any result
+				curNode, err := lookup(curID)
+				if err != nil {
+					return nil, err
+				}
+				if curNode != nil {
+					progress |= mustPass[curNode.Type]
+				}
+			}
+
+			rels, ok := adjacency[curID]
+			if !ok {
+				var err error
+				if rels, err = neighbors(ctx, curID); err != nil {
+					return nil, fmt.Errorf("failed to expand node %s: %w", curID, err)
+				}
+				adjacency[curID] = rels
+			}
+
+			for _, rel := range rels {
+				if len(allowedRels) > 0 && !allowedRels[rel.Type] {
+					continue
+				}
+				edge, ok := followRelationship(rel, curID, opts.Direction)
+				if !ok {
+					continue
+				}
+				nextID := edge.ToID
+
+				if countOf(cur.path, nextID) >= maxVisits {
+					continue
+				}
+				st := state{nextID, progress}
+				if seen[st] {
+					continue
+				}
+
+				nextNode, err := lookup(nextID)
+				if err != nil {
+					return nil, err
+				}
+				if nextNode == nil || avoid[nextNode.Type] {
+					continue
+				}
+				seen[st] = true
+
+				path := append(slices.Clone(cur.path), nextID)
+				next = append(next, entry{path: path, progress: progress})
+
+				if reported[nextID] || progress != satisfied {
+					continue
+				}
+				if len(resultTypes) > 0 && !resultTypes[nextNode.Type] {
+					continue
+				}
+				reported[nextID] = true
+				results = append(results, TraversalResult{
+					Node:              *nextNode,
+					Path:              path,
+					Distance:          depth,
+					MustPassSatisfied: len(mustPass) > 0,
+				})
+			}
+		}
+		frontier = next
+	}
+
+	return results, nil
+}
+
+// toSet returns the values as a set.
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// countOf returns how many times id occurs in path.
+func countOf(path []string, id string) int {
+	n := 0
+	for _, p := range path {
+		if p == id {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,251 @@
+package graphrag
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticNodes returns a NodeFunc over nodes given as ID to type.
+func staticNodes(types map[string]string) NodeFunc {
+	return func(ctx context.Context, nodeID string) (*GraphNode, error) {
+		t, ok := types[nodeID]
+		if !ok {
+			return nil, nil
+		}
+		return &GraphNode{ID: nodeID, Type: t}, nil
+	}
+}
+
+// attackGraph is a small graph with SIMILAR_TO cycles between hosts:
+//
+//	finding -USES_TECHNIQUE-> technique -TARGETS-> host-2
+//	finding -AFFECTS-> host-1 -RUNS-> service -RUNS_ON-> host-4
+//	host-1 -SIMILAR_TO-> host-2 -SIMILAR_TO-> host-3 -SIMILAR_TO-> host-1
+func attackGraph(expansions *int) (NeighborFunc, NodeFunc) {
+	neighbors := staticGraph([]Relationship{
+		rel("finding", "technique", "USES_TECHNIQUE", nil),
+		rel("technique", "host-2", "TARGETS", nil),
+		rel("finding", "host-1", "AFFECTS", nil),
+		rel("host-1", "service", "RUNS", nil),
+		rel("service", "host-4", "RUNS_ON", nil),
+		rel("host-1", "host-2", "SIMILAR_TO", nil),
+		rel("host-2", "host-3", "SIMILAR_TO", nil),
+		rel("host-3", "host-1", "SIMILAR_TO", nil),
+	}, expansions)
+	nodes := staticNodes(map[string]string{
+		"finding":   NodeTypeFinding,
+		"technique": NodeTypeTechnique,
+		"host-1":    NodeTypeHost,
+		"host-2":    NodeTypeHost,
+		"host-3":    NodeTypeHost,
+		"host-4":    NodeTypeHost,
+		"service":   NodeTypeService,
+	})
+	return neighbors, nodes
+}
+
+// resultPaths maps each result node to its path.
+func resultPaths(results []TraversalResult) map[string][]string {
+	paths := make(map[string][]string, len(results))
+	for _, r := range results {
+		paths[r.Node.ID] = r.Path
+	}
+	return paths
+}
+
+func TestTraverse_ShortestPathPerNode(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+
+	results, err := Traverse(context.Background(), neighbors, nodes, "finding", *NewTraversalOptions().WithMaxDepth(5))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"technique": {"finding", "technique"},
+		"host-1":    {"finding", "host-1"},
+		"host-2":    {"finding", "technique", "host-2"},
+		"service":   {"finding", "host-1", "service"},
+		"host-3":    {"finding", "technique", "host-2", "host-3"},
+		"host-4":    {"finding", "host-1", "service", "host-4"},
+	}, resultPaths(results))
+
+	for _, r := range results {
+		assert.Equal(t, len(r.Path)-1, r.Distance)
+		assert.False(t, r.MustPassSatisfied, "no must-pass constraint was given")
+	}
+}
+
+func TestTraverse_MustPass(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+
+	opts := NewTraversalOptions().
+		WithMaxDepth(5).
+		WithNodeTypes([]string{NodeTypeHost}).
+		WithMustPassNodeTypes([]string{NodeTypeTechnique})
+	results, err := Traverse(context.Background(), neighbors, nodes, "finding", *opts)
+	require.NoError(t, err)
+
+	// Hosts reachable through the technique, by their shortest such path.
+	// host-1 is reached directly first, but that path does not qualify, and
+	// host-4 is only reachable without passing the technique.
+	assert.Equal(t, map[string][]string{
+		"host-2": {"finding", "technique", "host-2"},
+		"host-3": {"finding", "technique", "host-2", "host-3"},
+		"host-1": {"finding", "technique", "host-2", "host-3", "host-1"},
+	}, resultPaths(results))
+	for _, r := range results {
+		assert.True(t, r.MustPassSatisfied)
+		assert.Contains(t, r.Path[1:len(r.Path)-1], "technique")
+	}
+}
+
+func TestTraverse_MustPassMultipleTypes(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+
+	opts := NewTraversalOptions().
+		WithMaxDepth(6).
+		WithMustPassNodeTypes([]string{NodeTypeTechnique, NodeTypeService})
+	results, err := Traverse(context.Background(), neighbors, nodes, "finding", *opts)
+	require.NoError(t, err)
+
+	// The only path through both a technique and a service
+	assert.Equal(t, map[string][]string{
+		"host-4": {"finding", "technique", "host-2", "host-3", "host-1", "service", "host-4"},
+	}, resultPaths(results))
+}
+
+func TestTraverse_AvoidNodeTypes(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+
+	opts := NewTraversalOptions().
+		WithMaxDepth(5).
+		WithAvoidNodeTypes([]string{NodeTypeTechnique, NodeTypeService})
+	results, err := Traverse(context.Background(), neighbors, nodes, "finding", *opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"host-1": {"finding", "host-1"},
+		"host-2": {"finding", "host-1", "host-2"},
+		"host-3": {"finding", "host-1", "host-2", "host-3"},
+	}, resultPaths(results))
+}
+
+func TestTraverse_CycleHandling(t *testing.T) {
+	// The technique hangs off the finding, so reaching the host through it
+	// means returning to the finding
+	neighbors := staticGraph([]Relationship{
+		rel("finding", "technique", "USES_TECHNIQUE", nil),
+		rel("finding", "host", "AFFECTS", nil),
+	}, nil)
+	nodes := staticNodes(map[string]string{
+		"finding":   NodeTypeFinding,
+		"technique": NodeTypeTechnique,
+		"host":      NodeTypeHost,
+	})
+	opts := NewTraversalOptions().
+		WithDirection("both").
+		WithNodeTypes([]string{NodeTypeHost}).
+		WithMustPassNodeTypes([]string{NodeTypeTechnique})
+
+	results, err := Traverse(context.Background(), neighbors, nodes, "finding", *opts)
+	require.NoError(t, err)
+	assert.Empty(t, results, "pruning cycles forbids returning to the finding")
+
+	results, err = Traverse(context.Background(), neighbors, nodes, "finding", *opts.WithAllowRevisit(1))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"finding", "technique", "finding", "host"}, results[0].Path)
+	assert.Equal(t, 3, results[0].Distance)
+	assert.True(t, results[0].MustPassSatisfied)
+}
+
+func TestTraverse_TerminatesOnDenseCycles(t *testing.T) {
+	// A clique of hosts all SIMILAR_TO each other in both directions has
+	// factorially many simple paths
+	const size = 12
+	var rels []Relationship
+	types := map[string]string{}
+	for i := range size {
+		id := fmt.Sprintf("host-%d", i)
+		types[id] = NodeTypeHost
+		for j := range size {
+			if i != j {
+				rels = append(rels, rel(id, fmt.Sprintf("host-%d", j), "SIMILAR_TO", nil))
+			}
+		}
+	}
+	types["technique"] = NodeTypeTechnique
+	rels = append(rels, rel("host-5", "technique", "USES_TECHNIQUE", nil))
+
+	for _, opts := range []*TraversalOptions{
+		NewTraversalOptions().WithMaxDepth(10),
+		NewTraversalOptions().WithMaxDepth(10).WithAllowRevisit(3),
+		NewTraversalOptions().WithMaxDepth(10).WithDirection("both").WithMustPassNodeTypes([]string{NodeTypeTechnique}),
+	} {
+		var expansions int
+		results, err := Traverse(context.Background(), staticGraph(rels, &expansions), staticNodes(types), "host-0", *opts)
+		require.NoError(t, err)
+
+		// Each node is expanded at most once per must-pass progress and depth
+		assert.LessOrEqual(t, expansions, 2*(size+1)*opts.MaxDepth)
+		for _, r := range results {
+			if len(opts.MustPassNodeTypes) > 0 {
+				assert.Contains(t, r.Path[1:len(r.Path)-1], "technique")
+			}
+		}
+	}
+}
+
+func TestTraverse_RelationshipAndDirection(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+
+	opts := NewTraversalOptions().
+		WithDirection("incoming").
+		WithRelationshipTypes([]string{"SIMILAR_TO"})
+	results, err := Traverse(context.Background(), neighbors, nodes, "host-1", *opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"host-3": {"host-1", "host-3"},
+		"host-2": {"host-1", "host-3", "host-2"},
+	}, resultPaths(results))
+}
+
+func TestTraverse_Validation(t *testing.T) {
+	neighbors, nodes := attackGraph(nil)
+
+	tests := []struct {
+		name    string
+		start   string
+		opts    TraversalOptions
+		message string
+	}{
+		{"empty start", "", TraversalOptions{}, "start node ID is required"},
+		{"bad direction", "finding", TraversalOptions{Direction: "sideways"}, "invalid direction"},
+		{"bad cycle handling", "finding", TraversalOptions{CycleHandling: "ignore"}, "invalid cycle handling"},
+		{"revisit without limit", "finding", TraversalOptions{CycleHandling: CycleAllowRevisit}, "positive max revisits"},
+		{
+			"required and avoided", "finding",
+			TraversalOptions{MustPassNodeTypes: []string{NodeTypeTechnique}, AvoidNodeTypes: []string{NodeTypeTechnique}},
+			`node type "technique" is both required and avoided`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Traverse(context.Background(), neighbors, nodes, tt.start, tt.opts)
+			require.ErrorIs(t, err, ErrInvalidQuery)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
+
+func TestTraversalOptions_Normalize(t *testing.T) {
+	opts := TraversalOptions{}.Normalize()
+	assert.Equal(t, 3, opts.MaxDepth)
+	assert.Equal(t, "outgoing", opts.Direction)
+	assert.Equal(t, CyclePrune, opts.CycleHandling)
+}
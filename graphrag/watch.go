@@ -0,0 +1,49 @@
+package graphrag
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Kinds of graph change events.
+const (
+	// GraphEventCreated reports a node stored for the first time.
+	GraphEventCreated = "created"
+
+	// GraphEventUpdated reports a stored node whose properties changed.
+	GraphEventUpdated = "updated"
+)
+
+// GraphEvent reports a node stored in a mission's graph, by any agent of
+// the mission. Agents receive them from the harness's WatchGraph to react
+// to each other's discoveries through the shared graph.
+type GraphEvent struct {
+	// Kind is GraphEventCreated or GraphEventUpdated
+	Kind string `json:"kind"`
+
+	// Node is the node as stored; Node.AgentName identifies the agent that
+	// stored it
+	Node GraphNode `json:"node"`
+}
+
+// ValidateWatch checks the arguments of a graph watch: missionID is
+// required and node types must not be empty strings.
+func ValidateWatch(missionID string, nodeTypes []string) error {
+	if missionID == "" {
+		return fmt.Errorf("%w: mission ID is required", ErrInvalidQuery)
+	}
+	if slices.Contains(nodeTypes, "") {
+		return fmt.Errorf("%w: node types must not be empty", ErrInvalidQuery)
+	}
+	return nil
+}
+
+// WatchMatches returns true if a watch of missionID filtered by nodeTypes
+// receives events for node. An empty nodeTypes matches every type. Graph
+// backends use it to route events to subscribers.
+func WatchMatches(node GraphNode, missionID string, nodeTypes []string) bool {
+	if node.MissionID != missionID {
+		return false
+	}
+	return len(nodeTypes) == 0 || slices.Contains(nodeTypes, node.Type)
+}
@@ -0,0 +1,23 @@
+package graphrag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWatch(t *testing.T) {
+	assert.NoError(t, ValidateWatch("m-1", nil))
+	assert.NoError(t, ValidateWatch("m-1", []string{NodeTypePort}))
+	assert.ErrorIs(t, ValidateWatch("", nil), ErrInvalidQuery)
+	assert.ErrorIs(t, ValidateWatch("m-1", []string{NodeTypePort, ""}), ErrInvalidQuery)
+}
+
+func TestWatchMatches(t *testing.T) {
+	port := GraphNode{ID: "port:1", Type: NodeTypePort, MissionID: "m-1"}
+
+	assert.True(t, WatchMatches(port, "m-1", nil))
+	assert.True(t, WatchMatches(port, "m-1", []string{NodeTypeHost, NodeTypePort}))
+	assert.False(t, WatchMatches(port, "m-1", []string{NodeTypeHost}))
+	assert.False(t, WatchMatches(port, "m-2", nil), "events of other missions are never delivered")
+}
@@ -6,11 +6,12 @@
 //
 // # Health Check Functions
 //
-// The package provides five main health check functions:
+// The package provides six main health check functions:
 //
 //   - BinaryCheck: Verify a binary exists in PATH
 //   - BinaryVersionCheck: Verify a binary meets minimum version requirements
 //   - NetworkCheck: Verify TCP connectivity to a host:port
+//   - DNSCheck: Verify a hostname resolves
 //   - FileCheck: Verify a file or directory exists
 //   - Combine: Aggregate multiple health checks into a single status
 //
@@ -56,8 +57,13 @@
 //
 // # Context and Timeouts
 //
-// NetworkCheck accepts a context for timeout and cancellation control.
-// If nil is passed, a default 5-second timeout is used.
+// NetworkCheck and DNSCheck accept a context for timeout and cancellation
+// control. If nil is passed, a default 5-second timeout is used.
+//
+// DNSCheck reports a name that does not exist as unhealthy, but a resolver
+// timeout as degraded, so tools can tell a broken resolver from a missing
+// host. Run it alongside NetworkCheck to tell a resolution failure from a
+// connectivity failure.
 //
 // BinaryVersionCheck has a built-in 5-second timeout when executing
 // binaries to check their version.
@@ -4,6 +4,7 @@ package health
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -170,6 +171,82 @@ func NetworkCheck(ctx context.Context, host string, port int) types.HealthStatus
 	)
 }
 
+// lookupHost resolves a hostname. It is a variable so tests can replace the
+// system resolver.
+var lookupHost = net.DefaultResolver.LookupHost
+
+// DNSCheck verifies that a hostname resolves, separating name-resolution
+// failures from the connectivity failures reported by NetworkCheck.
+// It returns healthy with the resolved addresses in Details, unhealthy if the
+// name does not exist (NXDOMAIN), and degraded if the resolver timed out or
+// failed temporarily.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	status := health.DNSCheck(ctx, "example.com")
+//	if status.IsDegraded() {
+//	    log.Println("DNS resolver is not responding")
+//	}
+func DNSCheck(ctx context.Context, hostname string) types.HealthStatus {
+	if hostname == "" {
+		return types.NewUnhealthyStatus("hostname cannot be empty", nil)
+	}
+
+	// Use context with timeout if not already set
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+	}
+
+	addrs, err := lookupHost(ctx, hostname)
+	if err != nil {
+		details := map[string]any{
+			"hostname": hostname,
+			"error":    err.Error(),
+		}
+
+		var dnsErr *net.DNSError
+		isDNSErr := errors.As(err, &dnsErr)
+		switch {
+		case isDNSErr && dnsErr.IsNotFound:
+			return types.NewUnhealthyStatus(
+				fmt.Sprintf("hostname '%s' does not exist", hostname),
+				details,
+			)
+		case isDNSErr && (dnsErr.IsTimeout || dnsErr.IsTemporary),
+			errors.Is(err, context.DeadlineExceeded):
+			return types.NewDegradedStatus(
+				fmt.Sprintf("DNS resolution of '%s' timed out or failed temporarily", hostname),
+				details,
+			)
+		default:
+			return types.NewUnhealthyStatus(
+				fmt.Sprintf("failed to resolve '%s'", hostname),
+				details,
+			)
+		}
+	}
+
+	if len(addrs) == 0 {
+		return types.NewUnhealthyStatus(
+			fmt.Sprintf("hostname '%s' resolved to no addresses", hostname),
+			map[string]any{"hostname": hostname},
+		)
+	}
+
+	status := types.NewHealthyStatus(
+		fmt.Sprintf("hostname '%s' resolved to %d address(es)", hostname, len(addrs)),
+	)
+	status.Details = map[string]any{
+		"hostname":  hostname,
+		"addresses": addrs,
+	}
+	return status
+}
+
 // FileCheck verifies that a file or directory exists at the specified path.
 // It returns healthy if the path exists, unhealthy otherwise.
 //
@@ -204,6 +204,86 @@ func TestNetworkCheckWithNilContext(t *testing.T) {
 	}
 }
 
+func TestDNSCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		hostname   string
+		addrs      []string
+		err        error
+		wantStatus string
+	}{
+		{
+			name:       "resolved",
+			hostname:   "example.com",
+			addrs:      []string{"93.184.216.34", "2606:2800:220:1::"},
+			wantStatus: types.StatusHealthy,
+		},
+		{
+			name:       "nxdomain",
+			hostname:   "missing.example.com",
+			err:        &net.DNSError{Err: "no such host", Name: "missing.example.com", IsNotFound: true},
+			wantStatus: types.StatusUnhealthy,
+		},
+		{
+			name:       "resolver timeout",
+			hostname:   "example.com",
+			err:        &net.DNSError{Err: "i/o timeout", Name: "example.com", IsTimeout: true},
+			wantStatus: types.StatusDegraded,
+		},
+		{
+			name:       "context deadline",
+			hostname:   "example.com",
+			err:        context.DeadlineExceeded,
+			wantStatus: types.StatusDegraded,
+		},
+		{
+			name:       "no addresses",
+			hostname:   "example.com",
+			wantStatus: types.StatusUnhealthy,
+		},
+		{
+			name:       "empty hostname",
+			hostname:   "",
+			wantStatus: types.StatusUnhealthy,
+		},
+	}
+
+	original := lookupHost
+	defer func() { lookupHost = original }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookupHost = func(ctx context.Context, host string) ([]string, error) {
+				return tt.addrs, tt.err
+			}
+
+			status := DNSCheck(context.Background(), tt.hostname)
+			if status.Status != tt.wantStatus {
+				t.Errorf("DNSCheck() status = %v, want %v (message: %s)", status.Status, tt.wantStatus, status.Message)
+			}
+			if tt.wantStatus == types.StatusHealthy {
+				addrs, ok := status.Details["addresses"].([]string)
+				if !ok || len(addrs) != len(tt.addrs) {
+					t.Errorf("DNSCheck() addresses = %v, want %v", status.Details["addresses"], tt.addrs)
+				}
+			}
+		})
+	}
+}
+
+func TestDNSCheckLocalhost(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	status := DNSCheck(ctx, "localhost")
+	if !status.IsHealthy() {
+		t.Skipf("localhost does not resolve in this environment: %s", status.Message)
+	}
+	if _, ok := status.Details["addresses"]; !ok {
+		t.Error("DNSCheck() details missing addresses")
+	}
+}
+
 func TestFileCheck(t *testing.T) {
 	// Create a temporary file for testing
 	tmpDir := t.TempDir()
@@ -34,8 +34,9 @@
 //
 // The package handles common type coercion scenarios:
 //
-//   - GetInt: Handles int, int64, float64, and numeric strings
-//   - GetFloat64: Handles float64, float32, int, int64, and numeric strings
+//   - GetInt: Handles int, int32, int64, float64, json.Number, and numeric strings
+//   - GetFloat64: Handles float64, float32, int, int32, int64, json.Number, and numeric strings
+//   - GetTime: Handles time.Time, strings in a given layout, and Unix seconds
 //   - GetStringSlice: Handles []string, []interface{}, and single strings
 //   - GetTimeout: Handles time.Duration, int (as seconds), and duration strings like "5m"
 //
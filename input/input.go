@@ -6,7 +6,9 @@
 package input
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 )
@@ -32,7 +34,7 @@ func GetString(m map[string]any, key string, defaultVal string) string {
 }
 
 // GetInt extracts an int value from the map with type coercion and default fallback.
-// Handles int, int64, float64, and string types.
+// Handles int, int32, int64, float64, json.Number, and string types.
 // Returns defaultVal if the key doesn't exist, the value is nil, or cannot be converted.
 func GetInt(m map[string]any, key string, defaultVal int) int {
 	if m == nil {
@@ -47,10 +49,20 @@ func GetInt(m map[string]any, key string, defaultVal int) int {
 	switch v := val.(type) {
 	case int:
 		return v
+	case int32:
+		return int(v)
 	case int64:
 		return int(v)
 	case float64:
 		return int(v)
+	case json.Number:
+		if parsed, err := v.Int64(); err == nil {
+			return int(parsed)
+		}
+		if parsed, err := v.Float64(); err == nil {
+			return int(parsed)
+		}
+		return defaultVal
 	case string:
 		// Try to parse string as integer
 		if parsed, err := strconv.Atoi(v); err == nil {
@@ -83,7 +95,7 @@ func GetBool(m map[string]any, key string, defaultVal bool) bool {
 }
 
 // GetFloat64 extracts a float64 value from the map with type coercion and default fallback.
-// Handles float64, int, int64, and string types.
+// Handles float64, float32, int, int32, int64, json.Number, and string types.
 // Returns defaultVal if the key doesn't exist, the value is nil, or cannot be converted.
 func GetFloat64(m map[string]any, key string, defaultVal float64) float64 {
 	if m == nil {
@@ -102,8 +114,15 @@ func GetFloat64(m map[string]any, key string, defaultVal float64) float64 {
 		return float64(v)
 	case int:
 		return float64(v)
+	case int32:
+		return float64(v)
 	case int64:
 		return float64(v)
+	case json.Number:
+		if parsed, err := v.Float64(); err == nil {
+			return parsed
+		}
+		return defaultVal
 	case string:
 		// Try to parse string as float
 		if parsed, err := strconv.ParseFloat(v, 64); err == nil {
@@ -215,6 +234,49 @@ func GetTimeout(m map[string]any, key string, defaultVal time.Duration) time.Dur
 	}
 }
 
+// GetTime extracts a time.Time value from the map.
+// Handles time.Time, strings parsed with layout (time.RFC3339 if empty), and
+// int, int64, float64, and json.Number values interpreted as Unix seconds.
+// Returns the zero time if the key doesn't exist, the value is nil, or cannot be converted.
+func GetTime(m map[string]any, key string, layout string) time.Time {
+	if m == nil {
+		return time.Time{}
+	}
+
+	val, ok := m[key]
+	if !ok || val == nil {
+		return time.Time{}
+	}
+
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	switch v := val.(type) {
+	case time.Time:
+		return v
+	case string:
+		if parsed, err := time.Parse(layout, v); err == nil {
+			return parsed
+		}
+		return time.Time{}
+	case int:
+		return time.Unix(int64(v), 0)
+	case int64:
+		return time.Unix(v, 0)
+	case float64:
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9))
+	case json.Number:
+		if parsed, err := v.Int64(); err == nil {
+			return time.Unix(parsed, 0)
+		}
+		return time.Time{}
+	default:
+		return time.Time{}
+	}
+}
+
 // DefaultTimeout returns the default execution timeout (5 minutes).
 // This is commonly used as a fallback when no timeout is specified.
 func DefaultTimeout() time.Duration {
@@ -1,6 +1,7 @@
 package input
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -82,6 +83,20 @@ func TestGetInt(t *testing.T) {
 			defVal:   0,
 			expected: 42,
 		},
+		{
+			name:     "json.Number value",
+			m:        map[string]any{"key": json.Number("7")},
+			key:      "key",
+			defVal:   0,
+			expected: 7,
+		},
+		{
+			name:     "json.Number float value",
+			m:        map[string]any{"key": json.Number("7.9")},
+			key:      "key",
+			defVal:   0,
+			expected: 7,
+		},
 		{
 			name:     "int64 value",
 			m:        map[string]any{"key": int64(100)},
@@ -230,6 +245,13 @@ func TestGetFloat64(t *testing.T) {
 		defVal   float64
 		expected float64
 	}{
+		{
+			name:     "json.Number value",
+			m:        map[string]any{"key": json.Number("9.8")},
+			key:      "key",
+			defVal:   0,
+			expected: 9.8,
+		},
 		{
 			name:     "float64 value",
 			m:        map[string]any{"key": 3.14},
@@ -623,3 +645,32 @@ func BenchmarkGetTimeout(b *testing.B) {
 		_ = GetTimeout(m, "key", 0)
 	}
 }
+
+func TestGetTime(t *testing.T) {
+	want := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		m        map[string]any
+		layout   string
+		expected time.Time
+	}{
+		{"time.Time value", map[string]any{"key": want}, "", want},
+		{"RFC3339 string", map[string]any{"key": "2026-03-01T12:30:00Z"}, "", want},
+		{"custom layout", map[string]any{"key": "2026-03-01 12:30"}, "2006-01-02 15:04", want},
+		{"unix seconds int64", map[string]any{"key": want.Unix()}, "", time.Unix(want.Unix(), 0)},
+		{"unix seconds float64", map[string]any{"key": float64(want.Unix())}, "", time.Unix(want.Unix(), 0)},
+		{"unix seconds json.Number", map[string]any{"key": json.Number("1772368200")}, "", time.Unix(1772368200, 0)},
+		{"unparseable string", map[string]any{"key": "yesterday"}, "", time.Time{}},
+		{"wrong type", map[string]any{"key": true}, "", time.Time{}},
+		{"missing key", map[string]any{}, "", time.Time{}},
+		{"nil map", nil, "", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GetTime(tt.m, "key", tt.layout)
+			assert.True(t, tt.expected.Equal(result), "got %v, want %v", result, tt.expected)
+		})
+	}
+}
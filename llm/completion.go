@@ -22,6 +22,14 @@ type CompletionRequest struct {
 
 	// Tools contains tool definitions available for the model to use.
 	Tools []ToolDef
+
+	// ModelOverride replaces the slot's configured model for this request.
+	// Empty uses the slot's model. See WithModelOverride.
+	ModelOverride string
+
+	// ResponseFilters classify the response content before it is returned.
+	// See WithResponseFilter.
+	ResponseFilters []ResponseFilter
 }
 
 // CompletionResponse represents a response from an LLM completion.
@@ -38,6 +46,19 @@ type CompletionResponse struct {
 
 	// Usage contains token usage statistics.
 	Usage TokenUsage
+
+	// Model identifies the model that generated the response, including
+	// when it was chosen by a model override. Empty if the harness does not
+	// report it.
+	Model string
+
+	// Partial is true when the response was cut short, by cancellation or a
+	// deadline, before the model finished generating.
+	Partial bool
+
+	// FilterVerdicts records the verdict of every response filter that
+	// ran on the response, in order. See WithResponseFilter.
+	FilterVerdicts []FilterVerdict
 }
 
 // TokenUsage tracks token consumption for a request.
@@ -92,6 +113,18 @@ func WithTools(tools ...ToolDef) CompletionOption {
 	}
 }
 
+// WithModelOverride runs the request on the given model instead of the
+// slot's configured one, e.g. a cheaper model for a throwaway classification
+// call, without declaring a new slot. The override must still meet the slot's
+// requirements; the harness rejects it with an error wrapping
+// ErrModelOverrideRejected otherwise. The model used is reported in
+// CompletionResponse.Model.
+func WithModelOverride(model string) CompletionOption {
+	return func(r *CompletionRequest) {
+		r.ModelOverride = model
+	}
+}
+
 // ApplyOptions applies a set of options to the completion request.
 func (r *CompletionRequest) ApplyOptions(opts ...CompletionOption) {
 	for _, opt := range opts {
@@ -55,6 +55,20 @@ func TestWithStopSequences(t *testing.T) {
 	}
 }
 
+func TestWithModelOverride(t *testing.T) {
+	req := &CompletionRequest{}
+	WithModelOverride("cheap-model")(req)
+
+	if req.ModelOverride != "cheap-model" {
+		t.Errorf("ModelOverride = %q, want %q", req.ModelOverride, "cheap-model")
+	}
+
+	plain := NewCompletionRequest(nil)
+	if plain.ModelOverride != "" {
+		t.Errorf("ModelOverride = %q, want empty without the option", plain.ModelOverride)
+	}
+}
+
 func TestWithTools(t *testing.T) {
 	req := &CompletionRequest{}
 	tools := []ToolDef{
@@ -0,0 +1,192 @@
+package llm
+
+import "slices"
+
+// Conversation is an append-only message history that can be forked and
+// rolled back cheaply.
+//
+// Forks share the messages they have in common: Fork and Checkpoint freeze
+// the current messages into an immutable segment that both sides reference,
+// so neither copies the history. Messages appended afterwards go into a
+// buffer owned by one conversation only.
+//
+// A Conversation must be used by one goroutine at a time. Forks are
+// independent of each other and of their parent, and may be used from
+// different goroutines.
+//
+// Example:
+//
+//	base := llm.NewConversation(
+//	    llm.Message{Role: llm.RoleSystem, Content: systemPrompt},
+//	    llm.Message{Role: llm.RoleUser, Content: recon},
+//	)
+//	for _, payload := range payloads {
+//	    branch := base.Fork()
+//	    branch.Append(llm.Message{Role: llm.RoleUser, Content: payload})
+//	    resp, err := harness.Complete(ctx, "primary", branch.Messages())
+//	    // ...
+//	}
+type Conversation struct {
+	// base holds the frozen messages, shared with forks and checkpoints.
+	base *conversationSegment
+
+	// tail holds the messages appended since base was frozen. It is owned
+	// by this conversation.
+	tail []Message
+}
+
+// conversationSegment is an immutable run of messages following its parent.
+type conversationSegment struct {
+	parent   *conversationSegment
+	messages []Message
+
+	// length is the number of messages up to and including this segment.
+	length int
+}
+
+// ConversationCheckpoint is a saved conversation state that Restore returns
+// to. The zero value is the empty conversation.
+type ConversationCheckpoint struct {
+	segment *conversationSegment
+}
+
+// Len returns the number of messages in the checkpoint.
+func (cp ConversationCheckpoint) Len() int {
+	return cp.segment.len()
+}
+
+// NewConversation creates a conversation holding messages.
+func NewConversation(messages ...Message) *Conversation {
+	c := &Conversation{}
+	c.Append(messages...)
+	return c
+}
+
+// Append adds messages to the end of the conversation. The messages are
+// copied, including their tool calls and results, so later changes to the
+// arguments do not affect the conversation.
+func (c *Conversation) Append(messages ...Message) {
+	for _, m := range messages {
+		m.ToolCalls = slices.Clone(m.ToolCalls)
+		m.ToolResults = slices.Clone(m.ToolResults)
+		c.tail = append(c.tail, m)
+	}
+}
+
+// Len returns the number of messages in the conversation.
+func (c *Conversation) Len() int {
+	return c.base.len() + len(c.tail)
+}
+
+// At returns the message at index i. It panics if i is out of range.
+func (c *Conversation) At(i int) Message {
+	if i < 0 || i >= c.Len() {
+		panic("llm: conversation index out of range")
+	}
+	if n := c.base.len(); i >= n {
+		return c.tail[i-n]
+	}
+	seg := c.base
+	for i < seg.length-len(seg.messages) {
+		seg = seg.parent
+	}
+	return seg.messages[i-(seg.length-len(seg.messages))]
+}
+
+// Messages returns the conversation as a new slice, ready to pass to a
+// completion call. Changing the slice does not affect the conversation, but
+// the tool calls and results of the messages are shared and must not be
+// modified.
+func (c *Conversation) Messages() []Message {
+	out := make([]Message, c.Len())
+	copy(out[c.base.len():], c.tail)
+	for seg := c.base; seg != nil; seg = seg.parent {
+		copy(out[seg.length-len(seg.messages):], seg.messages)
+	}
+	return out
+}
+
+// Fork returns an independent copy of the conversation. Appending to either
+// conversation does not affect the other. The shared history is not copied.
+func (c *Conversation) Fork() *Conversation {
+	c.freeze()
+	return &Conversation{base: c.base}
+}
+
+// Checkpoint saves the current state of the conversation for Restore.
+func (c *Conversation) Checkpoint() ConversationCheckpoint {
+	c.freeze()
+	return ConversationCheckpoint{segment: c.base}
+}
+
+// Restore rolls the conversation back to cp, discarding the messages
+// appended since. cp may come from this conversation or from any of its
+// forks.
+func (c *Conversation) Restore(cp ConversationCheckpoint) {
+	c.base = cp.segment
+	c.tail = nil
+}
+
+// Diverged returns the index of the first message that differs between c
+// and other, or -1 if they hold the same messages. If one conversation is a
+// prefix of the other, the index is the length of the shorter one.
+func (c *Conversation) Diverged(other *Conversation) int {
+	// Skip the segments both conversations share without comparing them
+	start := 0
+	mine, theirs := c.base.chain(), other.base.chain()
+	for i := 0; i < len(mine) && i < len(theirs) && mine[i] == theirs[i]; i++ {
+		start = mine[i].length
+	}
+
+	a, b := c.Messages(), other.Messages()
+	for i := start; i < len(a) && i < len(b); i++ {
+		if !messagesEqual(a[i], b[i]) {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return min(len(a), len(b))
+	}
+	return -1
+}
+
+// freeze moves the tail into a new segment shared by later forks and
+// checkpoints.
+func (c *Conversation) freeze() {
+	if len(c.tail) == 0 {
+		return
+	}
+	c.base = &conversationSegment{
+		parent:   c.base,
+		messages: c.tail,
+		length:   c.base.len() + len(c.tail),
+	}
+	c.tail = nil
+}
+
+// len returns the number of messages up to and including s.
+func (s *conversationSegment) len() int {
+	if s == nil {
+		return 0
+	}
+	return s.length
+}
+
+// chain returns the segments from the first to s.
+func (s *conversationSegment) chain() []*conversationSegment {
+	var chain []*conversationSegment
+	for ; s != nil; s = s.parent {
+		chain = append(chain, s)
+	}
+	slices.Reverse(chain)
+	return chain
+}
+
+// messagesEqual reports whether two messages are identical.
+func messagesEqual(a, b Message) bool {
+	return a.Role == b.Role &&
+		a.Content == b.Content &&
+		a.Name == b.Name &&
+		slices.Equal(a.ToolCalls, b.ToolCalls) &&
+		slices.Equal(a.ToolResults, b.ToolResults)
+}
@@ -0,0 +1,246 @@
+package llm
+
+import (
+	"fmt"
+	"testing"
+)
+
+// userMessages returns n user messages numbered from start.
+func userMessages(start, n int) []Message {
+	msgs := make([]Message, n)
+	for i := range msgs {
+		msgs[i] = Message{Role: RoleUser, Content: fmt.Sprintf("message %d", start+i)}
+	}
+	return msgs
+}
+
+// contents returns the content of each message of c.
+func contents(c *Conversation) []string {
+	var out []string
+	for _, m := range c.Messages() {
+		out = append(out, m.Content)
+	}
+	return out
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestConversation_AppendAndRead(t *testing.T) {
+	c := NewConversation(userMessages(0, 2)...)
+	c.Append(userMessages(2, 1)...)
+
+	if c.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", c.Len())
+	}
+	want := []string{"message 0", "message 1", "message 2"}
+	if got := contents(c); !equalStrings(got, want) {
+		t.Errorf("Messages() = %v, want %v", got, want)
+	}
+	for i, w := range want {
+		if got := c.At(i).Content; got != w {
+			t.Errorf("At(%d) = %q, want %q", i, got, w)
+		}
+	}
+
+	// The returned slice is a copy
+	msgs := c.Messages()
+	msgs[0].Content = "changed"
+	if got := c.At(0).Content; got != "message 0" {
+		t.Errorf("At(0) after changing Messages() = %q, want unchanged", got)
+	}
+}
+
+func TestConversation_AppendCopiesToolCalls(t *testing.T) {
+	calls := []ToolCall{{ID: "1", Name: "nmap", Arguments: `{"target":"10.0.0.1"}`}}
+	c := NewConversation(Message{Role: RoleAssistant, ToolCalls: calls})
+
+	calls[0].Name = "changed"
+	if got := c.At(0).ToolCalls[0].Name; got != "nmap" {
+		t.Errorf("tool call name = %q, want nmap", got)
+	}
+}
+
+func TestConversation_Fork(t *testing.T) {
+	base := NewConversation(userMessages(0, 3)...)
+
+	a := base.Fork()
+	b := base.Fork()
+	a.Append(Message{Role: RoleUser, Content: "payload A"})
+	b.Append(Message{Role: RoleUser, Content: "payload B"})
+	base.Append(Message{Role: RoleAssistant, Content: "base continues"})
+
+	prefix := []string{"message 0", "message 1", "message 2"}
+	tests := []struct {
+		name string
+		conv *Conversation
+		want []string
+	}{
+		{"fork a", a, append(append([]string{}, prefix...), "payload A")},
+		{"fork b", b, append(append([]string{}, prefix...), "payload B")},
+		{"base", base, append(append([]string{}, prefix...), "base continues")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := contents(tt.conv); !equalStrings(got, tt.want) {
+				t.Errorf("Messages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Forks of forks stay independent
+	aa := a.Fork()
+	aa.Append(Message{Role: RoleUser, Content: "payload AA"})
+	if a.Len() != 4 || aa.Len() != 5 {
+		t.Errorf("Len() = %d and %d, want 4 and 5", a.Len(), aa.Len())
+	}
+	if got := aa.At(3).Content; got != "payload A" {
+		t.Errorf("aa.At(3) = %q, want payload A", got)
+	}
+}
+
+func TestConversation_ForkDoesNotCopyHistory(t *testing.T) {
+	base := NewConversation(userMessages(0, 200)...)
+	base.Fork()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		base.Fork()
+	})
+	if allocs > 1 {
+		t.Errorf("Fork() allocations = %v, want at most 1", allocs)
+	}
+}
+
+func TestConversation_CheckpointRestore(t *testing.T) {
+	c := NewConversation(userMessages(0, 2)...)
+	cp := c.Checkpoint()
+	if cp.Len() != 2 {
+		t.Errorf("checkpoint Len() = %d, want 2", cp.Len())
+	}
+
+	c.Append(userMessages(2, 3)...)
+	c.Restore(cp)
+
+	want := []string{"message 0", "message 1"}
+	if got := contents(c); !equalStrings(got, want) {
+		t.Errorf("Messages() after Restore = %v, want %v", got, want)
+	}
+
+	// The conversation can continue after a restore, and restore again
+	c.Append(Message{Role: RoleUser, Content: "second branch"})
+	if c.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", c.Len())
+	}
+	c.Restore(cp)
+	if c.Len() != 2 {
+		t.Errorf("Len() after second Restore = %d, want 2", c.Len())
+	}
+
+	// The zero checkpoint is the empty conversation
+	c.Restore(ConversationCheckpoint{})
+	if c.Len() != 0 {
+		t.Errorf("Len() after restoring zero checkpoint = %d, want 0", c.Len())
+	}
+}
+
+func TestConversation_RestoreFromFork(t *testing.T) {
+	c := NewConversation(userMessages(0, 2)...)
+	fork := c.Fork()
+	fork.Append(Message{Role: RoleUser, Content: "fork only"})
+	cp := fork.Checkpoint()
+
+	c.Restore(cp)
+	want := []string{"message 0", "message 1", "fork only"}
+	if got := contents(c); !equalStrings(got, want) {
+		t.Errorf("Messages() = %v, want %v", got, want)
+	}
+
+	// Appending to one does not affect the other
+	c.Append(Message{Role: RoleUser, Content: "after restore"})
+	if fork.Len() != 3 {
+		t.Errorf("fork Len() = %d, want 3", fork.Len())
+	}
+}
+
+func TestConversation_Diverged(t *testing.T) {
+	base := NewConversation(userMessages(0, 3)...)
+	same := base.Fork()
+	longer := base.Fork()
+	longer.Append(Message{Role: RoleUser, Content: "extra"})
+	differs := base.Fork()
+	differs.Append(Message{Role: RoleUser, Content: "payload A"})
+	other := base.Fork()
+	other.Append(Message{Role: RoleUser, Content: "payload B"})
+	rebuilt := NewConversation(userMessages(0, 3)...)
+	edited := NewConversation(Message{Role: RoleUser, Content: "message 0"}, Message{Role: RoleUser, Content: "edited"})
+
+	tests := []struct {
+		name string
+		a, b *Conversation
+		want int
+	}{
+		{"same messages", base, same, -1},
+		{"same messages built separately", base, rebuilt, -1},
+		{"prefix", base, longer, 3},
+		{"prefix reversed", longer, base, 3},
+		{"different branches", differs, other, 3},
+		{"differ in unshared history", base, edited, 1},
+		{"empty", NewConversation(), base, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Diverged(tt.b); got != tt.want {
+				t.Errorf("Diverged() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConversation_AtOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("At() did not panic for an out of range index")
+		}
+	}()
+	NewConversation(userMessages(0, 1)...).At(1)
+}
+
+// BenchmarkConversation_Fork forks a 200-message conversation a hundred
+// times and appends one message to each fork.
+func BenchmarkConversation_Fork(b *testing.B) {
+	base := NewConversation(userMessages(0, 200)...)
+	payload := Message{Role: RoleUser, Content: "payload"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100; j++ {
+			fork := base.Fork()
+			fork.Append(payload)
+		}
+	}
+}
+
+// BenchmarkConversation_CopySlices is the same as BenchmarkConversation_Fork
+// with hand-copied message slices, for comparison.
+func BenchmarkConversation_CopySlices(b *testing.B) {
+	base := userMessages(0, 200)
+	payload := Message{Role: RoleUser, Content: "payload"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100; j++ {
+			fork := make([]Message, len(base), len(base)+1)
+			copy(fork, base)
+			_ = append(fork, payload)
+		}
+	}
+}
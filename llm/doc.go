@@ -20,6 +20,18 @@
 //	    Content: "What is the weather in San Francisco?",
 //	}
 //
+// # Conversations
+//
+// Conversation holds a message history that can be forked to explore
+// alternative branches from the same state, and checkpointed to roll back
+// after a failed branch. Forks share their common history instead of copying
+// it, so forking a long conversation many times is cheap.
+//
+//	cp := conv.Checkpoint()
+//	conv.Append(llm.Message{Role: llm.RoleUser, Content: payloadA})
+//	// ... payload A failed
+//	conv.Restore(cp)
+//
 // # Completion Requests
 //
 // CompletionRequest represents a request to an LLM for text generation.
@@ -31,6 +43,44 @@
 //	    llm.WithTools(tools...),
 //	)
 //
+// WithModelOverride runs a single request on a different model than the
+// slot's, e.g. a cheaper one for a throwaway classification, without
+// declaring a new slot. Overrides that do not meet the slot's requirements
+// are rejected with an error wrapping ErrModelOverrideRejected, and the model
+// used is reported in CompletionResponse.Model:
+//
+//	resp, err := harness.Complete(ctx, "primary", messages,
+//	    llm.WithModelOverride("small-fast-model"))
+//
+// # Response Filters
+//
+// A ResponseFilter classifies response content before the agent acts on it.
+// Its FilterVerdict can annotate the response, redact its content, or reject
+// it, which fails the completion with an error wrapping ErrResponseRejected.
+// Every verdict is recorded in CompletionResponse.FilterVerdicts. Filters are
+// added per request with WithResponseFilter, or for every completion of an
+// agent with serve.WithResponseFilter:
+//
+//	resp, err := harness.Complete(ctx, "primary", messages,
+//	    llm.WithResponseFilter(policy.Classify))
+//	if errors.Is(err, llm.ErrResponseRejected) {
+//	    // do not act on the response
+//	}
+//
+// # System Preamble
+//
+// A PreambleConfig describes a system message that the harness injects into
+// every completion, such as the rules of engagement of an authorized test, so
+// agents cannot leave it out. It is set by the embedder with
+// serve.WithSystemPreamble, placed first or after the leading system
+// messages, and not injected again into messages that already carry it. Its
+// tokens are tracked under PreambleTokenSlot:
+//
+//	serve.Agent(myAgent, serve.WithSystemPreamble(llm.PreambleConfig{
+//	    Content:   "Never target systems outside the mission scope.",
+//	    SkipSlots: []string{"classifier"},
+//	}))
+//
 // # Streaming Responses
 //
 // For streaming completions, use StreamChunk and StreamAccumulator to process
@@ -45,6 +95,18 @@
 //	    }
 //	}
 //
+// Harnesses connected to an orchestrator also offer StreamControlled, which
+// returns a StreamHandle. Cancel stops generation early, and Partial returns
+// whatever was generated up to that point:
+//
+//	handle, err := harness.StreamControlled(ctx, "primary", messages)
+//	for chunk := range handle.Chunks() {
+//	    if offTrack(chunk.Delta) {
+//	        handle.Cancel()
+//	    }
+//	}
+//	partial := handle.Partial() // FinishReason is llm.FinishReasonCancelled
+//
 // # Tool Calling
 //
 // Tools allow LLMs to invoke external functions. Define tools with ToolDef
@@ -65,6 +127,17 @@
 //	    },
 //	}
 //
+// CompleteWithToolLoop runs the usual function-calling loop against any
+// ToolCompleter, such as an agent harness: it executes the requested tool
+// calls, feeds the results back, and completes again until the model stops
+// requesting tools or the iteration cap is reached:
+//
+//	resp, err := llm.CompleteWithToolLoop(ctx, harness, "primary", messages,
+//	    []llm.ToolDef{tool}, executeTool, 5)
+//	if errors.Is(err, llm.ErrMaxToolIterations) {
+//	    // The model was still calling tools
+//	}
+//
 // # Slot Definitions
 //
 // Slots represent different LLM capabilities needed by a Gibson agent.
@@ -0,0 +1,148 @@
+package llm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FilterAction is what a response filter does with a completion response.
+type FilterAction string
+
+const (
+	// FilterAllow passes the response through unchanged.
+	FilterAllow FilterAction = "allow"
+
+	// FilterAnnotate passes the response through unchanged but flags it;
+	// the verdict's labels and reason describe what was found.
+	FilterAnnotate FilterAction = "annotate"
+
+	// FilterRedact replaces the response content with the verdict's
+	// Redacted text.
+	FilterRedact FilterAction = "redact"
+
+	// FilterReject turns the response into a *ResponseRejectedError.
+	FilterReject FilterAction = "reject"
+)
+
+// IsValid returns true if the action is valid.
+func (a FilterAction) IsValid() bool {
+	switch a {
+	case FilterAllow, FilterAnnotate, FilterRedact, FilterReject:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the action.
+func (a FilterAction) String() string {
+	return string(a)
+}
+
+// FilterVerdict is a response filter's decision about a completion response.
+type FilterVerdict struct {
+	// Action is what to do with the response. Empty means FilterAllow.
+	Action FilterAction
+
+	// Labels classify the content that triggered the verdict, e.g.
+	// "pii" or "exploit-code".
+	Labels []string
+
+	// Reason explains the verdict for audit logs.
+	Reason string
+
+	// Redacted is the content that replaces the response's content when
+	// Action is FilterRedact.
+	Redacted string
+}
+
+// ResponseFilter classifies the content of a completion response before the
+// agent acts on it. An error fails the completion; the filter is never
+// skipped.
+type ResponseFilter func(content string) (FilterVerdict, error)
+
+// ErrResponseRejected indicates a response filter rejected a completion
+// response.
+var ErrResponseRejected = errors.New("response rejected by filter")
+
+// ResponseRejectedError carries the verdict of a filter that rejected a
+// response. It wraps ErrResponseRejected.
+type ResponseRejectedError struct {
+	// Verdict is the rejecting verdict.
+	Verdict FilterVerdict
+
+	// Response is the rejected response, with the verdicts recorded up to
+	// and including the rejection. Agents must not act on its content.
+	Response *CompletionResponse
+}
+
+// Error implements the error interface.
+func (e *ResponseRejectedError) Error() string {
+	msg := ErrResponseRejected.Error()
+	if len(e.Verdict.Labels) > 0 {
+		msg += " [" + strings.Join(e.Verdict.Labels, ", ") + "]"
+	}
+	if e.Verdict.Reason != "" {
+		msg += ": " + e.Verdict.Reason
+	}
+	return msg
+}
+
+// Unwrap returns ErrResponseRejected.
+func (e *ResponseRejectedError) Unwrap() error {
+	return ErrResponseRejected
+}
+
+// WithResponseFilter runs filter on the content of the completion response
+// before it is returned. Filters run in the order they were added, each on
+// the content left by the previous one, and every verdict is recorded in
+// CompletionResponse.FilterVerdicts. A rejecting verdict stops the chain and
+// the completion fails with a *ResponseRejectedError.
+//
+// Harnesses may also apply filters of their own to every completion; those
+// run before the filters of the request.
+func WithResponseFilter(filter ResponseFilter) CompletionOption {
+	return func(r *CompletionRequest) {
+		r.ResponseFilters = append(r.ResponseFilters, filter)
+	}
+}
+
+// FilterResponse runs filters on resp as described in WithResponseFilter,
+// updating its content and verdicts in place. It returns a
+// *ResponseRejectedError if a filter rejects the response, or the filter's
+// error if one fails. Harnesses call it on every completion result.
+func FilterResponse(resp *CompletionResponse, filters ...ResponseFilter) error {
+	for _, filter := range filters {
+		verdict, err := filter(resp.Content)
+		if err != nil {
+			return fmt.Errorf("response filter failed: %w", err)
+		}
+		if verdict.Action == "" {
+			verdict.Action = FilterAllow
+		}
+		if !verdict.Action.IsValid() {
+			return fmt.Errorf("response filter returned invalid action %q", verdict.Action)
+		}
+
+		resp.FilterVerdicts = append(resp.FilterVerdicts, verdict)
+		switch verdict.Action {
+		case FilterRedact:
+			resp.Content = verdict.Redacted
+		case FilterReject:
+			return &ResponseRejectedError{Verdict: verdict, Response: resp}
+		}
+	}
+	return nil
+}
+
+// Flagged returns true if any response filter annotated, redacted, or
+// rejected the response.
+func (r *CompletionResponse) Flagged() bool {
+	for _, v := range r.FilterVerdicts {
+		if v.Action != FilterAllow {
+			return true
+		}
+	}
+	return false
+}
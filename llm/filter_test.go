@@ -0,0 +1,94 @@
+package llm
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFilterResponse(t *testing.T) {
+	redactSecrets := func(content string) (FilterVerdict, error) {
+		if !strings.Contains(content, "sk-") {
+			return FilterVerdict{Action: FilterAllow}, nil
+		}
+		return FilterVerdict{Action: FilterRedact, Labels: []string{"secret"}, Redacted: strings.ReplaceAll(content, "sk-123", "[REDACTED]")}, nil
+	}
+	var sawContent string
+	observe := func(content string) (FilterVerdict, error) {
+		sawContent = content
+		return FilterVerdict{}, nil
+	}
+
+	resp := &CompletionResponse{Content: "key is sk-123"}
+	if err := FilterResponse(resp, redactSecrets, observe); err != nil {
+		t.Fatalf("FilterResponse() error = %v", err)
+	}
+	if resp.Content != "key is [REDACTED]" {
+		t.Errorf("Content = %q, want redacted", resp.Content)
+	}
+	if sawContent != "key is [REDACTED]" {
+		t.Errorf("second filter saw %q, want the redacted content", sawContent)
+	}
+	if len(resp.FilterVerdicts) != 2 || resp.FilterVerdicts[1].Action != FilterAllow {
+		t.Errorf("FilterVerdicts = %+v, want redact then allow", resp.FilterVerdicts)
+	}
+	if !resp.Flagged() {
+		t.Error("Flagged() = false for a redacted response")
+	}
+
+	clean := &CompletionResponse{Content: "nothing to see"}
+	if err := FilterResponse(clean, redactSecrets); err != nil || clean.Flagged() {
+		t.Errorf("clean response: err = %v, flagged = %v", err, clean.Flagged())
+	}
+}
+
+func TestFilterResponse_Reject(t *testing.T) {
+	reject := func(content string) (FilterVerdict, error) {
+		return FilterVerdict{Action: FilterReject, Labels: []string{"exploit-code"}, Reason: "working exploit"}, nil
+	}
+	called := false
+	after := func(content string) (FilterVerdict, error) {
+		called = true
+		return FilterVerdict{}, nil
+	}
+
+	resp := &CompletionResponse{Content: "payload"}
+	err := FilterResponse(resp, reject, after)
+	if !errors.Is(err, ErrResponseRejected) {
+		t.Fatalf("FilterResponse() error = %v, want ErrResponseRejected", err)
+	}
+	var rejected *ResponseRejectedError
+	if !errors.As(err, &rejected) || rejected.Response != resp || rejected.Verdict.Reason != "working exploit" {
+		t.Errorf("error = %#v, want the rejecting verdict and response", err)
+	}
+	if want := "response rejected by filter [exploit-code]: working exploit"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if called {
+		t.Error("filters after a rejection must not run")
+	}
+}
+
+func TestFilterResponse_Errors(t *testing.T) {
+	failing := func(content string) (FilterVerdict, error) {
+		return FilterVerdict{}, errors.New("classifier down")
+	}
+	if err := FilterResponse(&CompletionResponse{}, failing); err == nil || !strings.Contains(err.Error(), "classifier down") {
+		t.Errorf("FilterResponse() error = %v, want the filter's error", err)
+	}
+
+	invalid := func(content string) (FilterVerdict, error) {
+		return FilterVerdict{Action: "quarantine"}, nil
+	}
+	if err := FilterResponse(&CompletionResponse{}, invalid); err == nil {
+		t.Error("FilterResponse() accepted an invalid action")
+	}
+}
+
+func TestWithResponseFilter(t *testing.T) {
+	allow := func(content string) (FilterVerdict, error) { return FilterVerdict{}, nil }
+	req := NewCompletionRequest(nil, WithResponseFilter(allow), WithResponseFilter(allow))
+	if len(req.ResponseFilters) != 2 {
+		t.Errorf("len(ResponseFilters) = %d, want 2", len(req.ResponseFilters))
+	}
+}
@@ -0,0 +1,91 @@
+package llm
+
+import (
+	"slices"
+	"strings"
+)
+
+// PreambleTokenSlot is the token tracker entry the tokens of a system
+// preamble are counted against, instead of the slot of the completion.
+const PreambleTokenSlot = "preamble"
+
+// PreamblePosition is where a system preamble is placed in the messages of a
+// completion.
+type PreamblePosition string
+
+const (
+	// PreamblePrepend places the preamble as a system message before all
+	// other messages.
+	PreamblePrepend PreamblePosition = "prepend"
+
+	// PreambleAfterSystem places the preamble as a system message after the
+	// leading system messages, before the first message of another role.
+	PreambleAfterSystem PreamblePosition = "after_system"
+)
+
+// IsValid returns true if the position is valid.
+func (p PreamblePosition) IsValid() bool {
+	switch p {
+	case PreamblePrepend, PreambleAfterSystem:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the position.
+func (p PreamblePosition) String() string {
+	return string(p)
+}
+
+// PreambleConfig configures a system preamble that a harness injects into
+// the messages of every completion, such as the rules of engagement of an
+// authorized test. Because the harness injects it, agents cannot leave it
+// out.
+type PreambleConfig struct {
+	// Content is the preamble text. Empty disables the preamble.
+	Content string
+
+	// Position is where the preamble is placed. Empty, or any unknown
+	// position, means PreamblePrepend.
+	Position PreamblePosition
+
+	// SkipSlots are the LLM slots whose completions get no preamble.
+	SkipSlots []string
+}
+
+// Enabled returns true if the preamble is injected into completions of slot.
+func (c PreambleConfig) Enabled(slot string) bool {
+	return c.Content != "" && !slices.Contains(c.SkipSlots, slot)
+}
+
+// ApplyPreamble returns messages with the preamble of cfg injected as a
+// system message, and whether it was injected. Messages already carrying the
+// preamble are returned unchanged: with PreamblePrepend, when the first
+// message is a system message starting with it; with PreambleAfterSystem,
+// when one of the leading system messages contains it. The messages passed
+// in are never modified.
+func ApplyPreamble(messages []Message, cfg PreambleConfig) ([]Message, bool) {
+	if cfg.Content == "" {
+		return messages, false
+	}
+
+	leading := 0
+	for leading < len(messages) && messages[leading].Role == RoleSystem {
+		leading++
+	}
+
+	at := 0
+	if cfg.Position == PreambleAfterSystem {
+		for _, msg := range messages[:leading] {
+			if strings.Contains(msg.Content, cfg.Content) {
+				return messages, false
+			}
+		}
+		at = leading
+	} else if leading > 0 && strings.HasPrefix(messages[0].Content, cfg.Content) {
+		return messages, false
+	}
+
+	return slices.Insert(slices.Clip(messages), at, Message{Role: RoleSystem, Content: cfg.Content}), true
+}
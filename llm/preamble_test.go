@@ -0,0 +1,80 @@
+package llm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplyPreamble(t *testing.T) {
+	const preamble = "Authorized test. Stay in scope."
+	sys := Message{Role: RoleSystem, Content: "You are a recon agent."}
+	user := Message{Role: RoleUser, Content: "Scan the target."}
+	injected := Message{Role: RoleSystem, Content: preamble}
+
+	tests := []struct {
+		name     string
+		position PreamblePosition
+		messages []Message
+		want     []Message
+		injected bool
+	}{
+		{"prepend", PreamblePrepend, []Message{sys, user}, []Message{injected, sys, user}, true},
+		{"empty position prepends", "", []Message{sys, user}, []Message{injected, sys, user}, true},
+		{"unknown position prepends", "middle", []Message{user}, []Message{injected, user}, true},
+		{"after system", PreambleAfterSystem, []Message{sys, sys, user}, []Message{sys, sys, injected, user}, true},
+		{"after system without system messages", PreambleAfterSystem, []Message{user}, []Message{injected, user}, true},
+		{"prepend already present", PreamblePrepend, []Message{{Role: RoleSystem, Content: preamble + "\nExtra."}, user}, nil, false},
+		{"prepend present later is injected", PreamblePrepend, []Message{sys, injected, user}, []Message{injected, sys, injected, user}, true},
+		{"after system already present", PreambleAfterSystem, []Message{sys, {Role: RoleSystem, Content: "Rules: " + preamble}, user}, nil, false},
+		{"after system in user message is injected", PreambleAfterSystem, []Message{{Role: RoleUser, Content: preamble}}, []Message{injected, {Role: RoleUser, Content: preamble}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]Message(nil), tt.messages...)
+			got, ok := ApplyPreamble(tt.messages, PreambleConfig{Content: preamble, Position: tt.position})
+			if ok != tt.injected {
+				t.Errorf("ApplyPreamble() injected = %v, want %v", ok, tt.injected)
+			}
+			want := tt.want
+			if !tt.injected {
+				want = original
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ApplyPreamble() = %+v, want %+v", got, want)
+			}
+			if !reflect.DeepEqual(tt.messages, original) {
+				t.Errorf("ApplyPreamble() modified its input: %+v", tt.messages)
+			}
+		})
+	}
+}
+
+func TestPreambleConfig_Enabled(t *testing.T) {
+	cfg := PreambleConfig{Content: "Stay in scope.", SkipSlots: []string{"classifier"}}
+	if !cfg.Enabled("primary") {
+		t.Error("Enabled(primary) = false, want true")
+	}
+	if cfg.Enabled("classifier") {
+		t.Error("Enabled(classifier) = true, want false for a skipped slot")
+	}
+	if (PreambleConfig{}).Enabled("primary") {
+		t.Error("Enabled() = true, want false without content")
+	}
+
+	messages := []Message{{Role: RoleUser, Content: "hi"}}
+	if got, ok := ApplyPreamble(messages, PreambleConfig{}); ok || len(got) != 1 {
+		t.Errorf("ApplyPreamble() without content = %+v, %v, want messages unchanged", got, ok)
+	}
+}
+
+func TestPreamblePosition_IsValid(t *testing.T) {
+	for _, p := range []PreamblePosition{PreamblePrepend, PreambleAfterSystem} {
+		if !p.IsValid() {
+			t.Errorf("%q.IsValid() = false, want true", p)
+		}
+	}
+	if PreamblePosition("middle").IsValid() {
+		t.Error(`"middle".IsValid() = true, want false`)
+	}
+}
@@ -1,5 +1,11 @@
 package llm
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 // SlotDefinition defines requirements for an LLM slot in the Gibson framework.
 // Slots represent different LLM capabilities needed by an agent (e.g., "primary", "vision", "code").
 type SlotDefinition struct {
@@ -124,3 +130,66 @@ type ValidationError struct {
 func (e *ValidationError) Error() string {
 	return e.Field + ": " + e.Message
 }
+
+// ErrModelOverrideRejected indicates a model override does not meet the
+// requirements of the slot it was used with.
+var ErrModelOverrideRejected = errors.New("model override rejected")
+
+// ModelOverrideError describes why a model override was rejected. It wraps
+// ErrModelOverrideRejected.
+type ModelOverrideError struct {
+	// Slot is the slot the override was used with.
+	Slot string
+
+	// Model is the rejected model.
+	Model string
+
+	// Reason explains which requirement the model does not meet.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *ModelOverrideError) Error() string {
+	return fmt.Sprintf("model override %q rejected for slot %q: %s", e.Model, e.Slot, e.Reason)
+}
+
+// Unwrap returns ErrModelOverrideRejected.
+func (e *ModelOverrideError) Unwrap() error {
+	return ErrModelOverrideRejected
+}
+
+// CheckModelOverride checks that a model with the given features and context
+// window meets this slot's requirements, and returns a *ModelOverrideError
+// naming every unmet requirement otherwise. Harnesses call it before running
+// a request with WithModelOverride.
+func (s *SlotDefinition) CheckModelOverride(model string, features []string, contextWindow int) error {
+	if model == "" {
+		return &ModelOverrideError{Slot: s.Name, Model: model, Reason: "model name cannot be empty"}
+	}
+
+	var reasons []string
+	if contextWindow < s.MinContextWindow {
+		reasons = append(reasons, fmt.Sprintf("context window %d is below the required %d", contextWindow, s.MinContextWindow))
+	}
+	var missing []string
+	for _, required := range s.RequiredFeatures {
+		found := false
+		for _, available := range features {
+			if available == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, required)
+		}
+	}
+	if len(missing) > 0 {
+		reasons = append(reasons, "missing required features "+strings.Join(missing, ", "))
+	}
+
+	if len(reasons) > 0 {
+		return &ModelOverrideError{Slot: s.Name, Model: model, Reason: strings.Join(reasons, "; ")}
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package llm
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -282,3 +284,75 @@ func TestSlotDefinition_EmptyModels(t *testing.T) {
 		t.Error("PrefersModel should return false for empty models list")
 	}
 }
+
+func TestSlotDefinition_CheckModelOverride(t *testing.T) {
+	slot := SlotDefinition{
+		Name:             "primary",
+		MinContextWindow: 32000,
+		RequiredFeatures: []string{"function_calling", "json_mode"},
+	}
+
+	tests := []struct {
+		name          string
+		model         string
+		features      []string
+		contextWindow int
+		wantReasons   []string
+	}{
+		{
+			name:          "meets requirements",
+			model:         "cheap-model",
+			features:      []string{"function_calling", "json_mode", "streaming"},
+			contextWindow: 128000,
+		},
+		{
+			name:          "context window too small",
+			model:         "tiny-model",
+			features:      []string{"function_calling", "json_mode"},
+			contextWindow: 8000,
+			wantReasons:   []string{"context window 8000 is below the required 32000"},
+		},
+		{
+			name:          "missing features and context",
+			model:         "tiny-model",
+			features:      []string{"json_mode"},
+			contextWindow: 4000,
+			wantReasons:   []string{"context window 4000", "missing required features function_calling"},
+		},
+		{
+			name:          "empty model",
+			model:         "",
+			features:      []string{"function_calling", "json_mode"},
+			contextWindow: 128000,
+			wantReasons:   []string{"model name cannot be empty"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := slot.CheckModelOverride(tt.model, tt.features, tt.contextWindow)
+			if len(tt.wantReasons) == 0 {
+				if err != nil {
+					t.Fatalf("CheckModelOverride() error = %v, want nil", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrModelOverrideRejected) {
+				t.Fatalf("CheckModelOverride() error = %v, want ErrModelOverrideRejected", err)
+			}
+			var overrideErr *ModelOverrideError
+			if !errors.As(err, &overrideErr) {
+				t.Fatalf("CheckModelOverride() error type = %T, want *ModelOverrideError", err)
+			}
+			if overrideErr.Slot != "primary" || overrideErr.Model != tt.model {
+				t.Errorf("error slot/model = %q/%q, want %q/%q", overrideErr.Slot, overrideErr.Model, "primary", tt.model)
+			}
+			for _, reason := range tt.wantReasons {
+				if !strings.Contains(err.Error(), reason) {
+					t.Errorf("error %q does not contain %q", err.Error(), reason)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package llm
+
+import (
+	"context"
+	"sync"
+)
+
+// FinishReasonCancelled is the finish reason of a partial response whose
+// stream was cancelled before the model finished generating.
+const FinishReasonCancelled = "cancelled"
+
+// StreamFunc starts a streaming completion bound to ctx. The returned channel
+// must stop delivering chunks once ctx is cancelled.
+type StreamFunc func(ctx context.Context) (<-chan StreamChunk, error)
+
+// StreamHandle controls a streaming completion. It forwards chunks to the
+// caller while accumulating them, so the caller can stop generation early
+// with Cancel and still use the text produced so far via Partial.
+//
+// Example:
+//
+//	handle, err := harness.StreamControlled(ctx, "primary", messages)
+//	if err != nil {
+//	    return err
+//	}
+//	for chunk := range handle.Chunks() {
+//	    if strings.Contains(chunk.Delta, "I cannot") {
+//	        handle.Cancel()
+//	    }
+//	}
+//	partial := handle.Partial()
+type StreamHandle struct {
+	chunks chan StreamChunk
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu        sync.Mutex
+	acc       *StreamAccumulator
+	cancelled bool
+}
+
+// NewStreamHandle starts a stream with start and returns a handle to it.
+// onDone, if non-nil, is called once with the final or partial response when
+// the stream ends, before Done is closed. Harnesses use it to record token
+// usage, including usage of cancelled streams.
+func NewStreamHandle(ctx context.Context, start StreamFunc, onDone func(CompletionResponse)) (*StreamHandle, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	src, err := start(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	h := &StreamHandle{
+		chunks: make(chan StreamChunk, 10),
+		cancel: cancel,
+		done:   make(chan struct{}),
+		acc:    NewStreamAccumulator(),
+	}
+	go h.pump(ctx, src, onDone)
+	return h, nil
+}
+
+// pump accumulates chunks from src and forwards them until the stream ends
+// or ctx is cancelled.
+func (h *StreamHandle) pump(ctx context.Context, src <-chan StreamChunk, onDone func(CompletionResponse)) {
+	defer close(h.done)
+	defer close(h.chunks)
+	defer h.cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.markCancelled()
+			h.finish(onDone)
+			return
+		case chunk, ok := <-src:
+			if !ok {
+				// The source may close first when it sees the cancellation
+				if ctx.Err() != nil {
+					h.markCancelled()
+				}
+				h.finish(onDone)
+				return
+			}
+
+			h.mu.Lock()
+			h.acc.Add(chunk)
+			h.mu.Unlock()
+
+			select {
+			case h.chunks <- chunk:
+			case <-ctx.Done():
+				h.markCancelled()
+				h.finish(onDone)
+				return
+			}
+		}
+	}
+}
+
+// markCancelled records a cancellation unless the stream already finished.
+func (h *StreamHandle) markCancelled() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.acc.IsComplete() {
+		h.cancelled = true
+	}
+}
+
+// finish reports the final response to onDone.
+func (h *StreamHandle) finish(onDone func(CompletionResponse)) {
+	if onDone != nil {
+		onDone(*h.Partial())
+	}
+}
+
+// Chunks returns the stream's chunks. The channel is closed when the stream
+// completes or is cancelled.
+func (h *StreamHandle) Chunks() <-chan StreamChunk {
+	return h.chunks
+}
+
+// Cancel stops generation and waits for the stream to shut down, so that
+// Partial reflects everything received before cancellation. Cancelling a
+// finished stream has no effect. Cancel is safe to call more than once and
+// from any goroutine.
+func (h *StreamHandle) Cancel() {
+	h.cancel()
+	<-h.done
+}
+
+// Done returns a channel that is closed once the stream has ended, either
+// because the model finished or because it was cancelled.
+func (h *StreamHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Cancelled reports whether the stream was cancelled before it finished.
+func (h *StreamHandle) Cancelled() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.cancelled
+}
+
+// Partial returns the response accumulated so far. It can be called at any
+// time; after Cancel or Done it is the final state of the stream. A cancelled
+// response has FinishReason set to FinishReasonCancelled and Partial set, and
+// if the provider reported no usage before cancellation, its output tokens are
+// estimated from the generated content.
+func (h *StreamHandle) Partial() *CompletionResponse {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	resp := h.acc.ToResponse()
+	if h.acc.IsComplete() {
+		return &resp
+	}
+
+	if h.cancelled {
+		resp.FinishReason = FinishReasonCancelled
+		resp.Partial = true
+	}
+	if h.acc.Usage == nil {
+		output := EstimateTokens(resp.Content)
+		for _, tc := range resp.ToolCalls {
+			output += EstimateTokens(tc.Name) + EstimateTokens(tc.Arguments)
+		}
+		resp.Usage = TokenUsage{OutputTokens: output, TotalTokens: output}
+	}
+	return &resp
+}
+
+// EstimateTokens roughly estimates the number of tokens in text, at about
+// four characters per token. It is meant for accounting when a provider does
+// not report usage, not for enforcing context limits.
+func EstimateTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+	return (len(text) + 3) / 4
+}
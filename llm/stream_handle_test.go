@@ -0,0 +1,174 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// chunkSource returns a StreamFunc that sends chunks and then, when hang is
+// set, blocks until cancelled like a model that is still generating.
+func chunkSource(chunks []StreamChunk, hang bool) StreamFunc {
+	return func(ctx context.Context) (<-chan StreamChunk, error) {
+		ch := make(chan StreamChunk)
+		go func() {
+			defer close(ch)
+			for _, c := range chunks {
+				select {
+				case ch <- c:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if hang {
+				<-ctx.Done()
+			}
+		}()
+		return ch, nil
+	}
+}
+
+func TestStreamHandle_Complete(t *testing.T) {
+	chunks := []StreamChunk{
+		{Delta: "Hello"},
+		{Delta: " world", FinishReason: "stop", Usage: &TokenUsage{InputTokens: 5, OutputTokens: 2, TotalTokens: 7}},
+	}
+
+	var reported *CompletionResponse
+	handle, err := NewStreamHandle(context.Background(), chunkSource(chunks, false), func(resp CompletionResponse) {
+		reported = &resp
+	})
+	if err != nil {
+		t.Fatalf("NewStreamHandle() error = %v", err)
+	}
+
+	var received int
+	for range handle.Chunks() {
+		received++
+	}
+	<-handle.Done()
+
+	if received != 2 {
+		t.Errorf("received %d chunks, want 2", received)
+	}
+	if handle.Cancelled() {
+		t.Error("Cancelled() = true for a completed stream")
+	}
+
+	resp := handle.Partial()
+	if resp.Content != "Hello world" || resp.FinishReason != "stop" {
+		t.Errorf("Partial() = %q (%s), want %q (stop)", resp.Content, resp.FinishReason, "Hello world")
+	}
+	if resp.Usage.TotalTokens != 7 {
+		t.Errorf("Usage.TotalTokens = %d, want reported 7", resp.Usage.TotalTokens)
+	}
+	if reported == nil || reported.Usage.TotalTokens != 7 {
+		t.Errorf("onDone reported %+v, want usage 7", reported)
+	}
+
+	// Cancelling a finished stream is a no-op
+	handle.Cancel()
+	if handle.Cancelled() {
+		t.Error("Cancel() after completion marked the stream cancelled")
+	}
+}
+
+func TestStreamHandle_Cancel(t *testing.T) {
+	chunks := []StreamChunk{{Delta: "The target "}, {Delta: "is vulnerable"}}
+
+	calls := 0
+	var reported CompletionResponse
+	handle, err := NewStreamHandle(context.Background(), chunkSource(chunks, true), func(resp CompletionResponse) {
+		calls++
+		reported = resp
+	})
+	if err != nil {
+		t.Fatalf("NewStreamHandle() error = %v", err)
+	}
+
+	// Read both chunks, then stop the model mid-generation
+	<-handle.Chunks()
+	<-handle.Chunks()
+	handle.Cancel()
+	handle.Cancel()
+
+	select {
+	case <-handle.Done():
+	default:
+		t.Fatal("Done() not closed after Cancel()")
+	}
+	if _, ok := <-handle.Chunks(); ok {
+		t.Error("Chunks() still open after Cancel()")
+	}
+	if !handle.Cancelled() {
+		t.Error("Cancelled() = false after Cancel()")
+	}
+
+	resp := handle.Partial()
+	if resp.Content != "The target is vulnerable" {
+		t.Errorf("Partial().Content = %q", resp.Content)
+	}
+	if resp.FinishReason != FinishReasonCancelled {
+		t.Errorf("Partial().FinishReason = %q, want %q", resp.FinishReason, FinishReasonCancelled)
+	}
+	if !resp.Partial {
+		t.Error("Partial().Partial = false after Cancel()")
+	}
+	if want := EstimateTokens("The target is vulnerable"); resp.Usage.OutputTokens != want {
+		t.Errorf("Partial().Usage.OutputTokens = %d, want estimate %d", resp.Usage.OutputTokens, want)
+	}
+	if calls != 1 || reported.FinishReason != FinishReasonCancelled {
+		t.Errorf("onDone called %d times with %+v, want once with the partial response", calls, reported)
+	}
+}
+
+func TestStreamHandle_ParentContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	handle, err := NewStreamHandle(ctx, chunkSource([]StreamChunk{{Delta: "abc"}}, true), nil)
+	if err != nil {
+		t.Fatalf("NewStreamHandle() error = %v", err)
+	}
+
+	<-handle.Chunks()
+	cancel()
+
+	select {
+	case <-handle.Done():
+	case <-time.After(time.Second):
+		t.Fatal("stream did not stop when the parent context was cancelled")
+	}
+	if !handle.Cancelled() {
+		t.Error("Cancelled() = false after parent context cancellation")
+	}
+	if got := handle.Partial().Content; got != "abc" {
+		t.Errorf("Partial().Content = %q, want %q", got, "abc")
+	}
+}
+
+func TestStreamHandle_StartError(t *testing.T) {
+	wantErr := errors.New("stream unavailable")
+	_, err := NewStreamHandle(context.Background(), func(ctx context.Context) (<-chan StreamChunk, error) {
+		return nil, wantErr
+	}, nil)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("NewStreamHandle() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"a", 1},
+		{"abcd", 1},
+		{"abcde", 2},
+	}
+	for _, tt := range tests {
+		if got := EstimateTokens(tt.text); got != tt.want {
+			t.Errorf("EstimateTokens(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultMaxToolIterations is the completion cap CompleteWithToolLoop uses
+// when maxIterations is not positive.
+const DefaultMaxToolIterations = 10
+
+// ErrMaxToolIterations is returned by CompleteWithToolLoop when the model is
+// still requesting tools after the iteration cap.
+var ErrMaxToolIterations = errors.New("tool loop reached the iteration limit")
+
+// ToolCompleter performs completions with tool calling enabled. agent.Harness
+// implements it.
+type ToolCompleter interface {
+	CompleteWithTools(ctx context.Context, slot string, messages []Message, tools []ToolDef) (*CompletionResponse, error)
+}
+
+// ToolExecutor runs a single tool call requested by the model. A tool that
+// fails should return a ToolResult with IsError set, which is fed back to
+// the model; a returned error stops the loop.
+type ToolExecutor func(ctx context.Context, call ToolCall) (ToolResult, error)
+
+// CompleteWithToolLoop runs the function-calling loop: it completes, executes
+// every tool call in the response with executor, appends the assistant
+// message and the tool results to the conversation, and completes again
+// until the model stops requesting tools. At most maxIterations completions
+// are made (DefaultMaxToolIterations if not positive).
+//
+// The final response carries the token usage summed over all completions.
+// When the cap is hit, the last response is returned together with
+// ErrMaxToolIterations. The caller's messages slice is not modified.
+//
+// Example:
+//
+//	resp, err := llm.CompleteWithToolLoop(ctx, harness, "primary", messages, tools,
+//	    func(ctx context.Context, call llm.ToolCall) (llm.ToolResult, error) {
+//	        output, err := runTool(ctx, call.Name, call.Arguments)
+//	        if err != nil {
+//	            return llm.ToolResult{Content: err.Error(), IsError: true}, nil
+//	        }
+//	        return llm.ToolResult{Content: output}, nil
+//	    }, 5)
+func CompleteWithToolLoop(ctx context.Context, c ToolCompleter, slot string, messages []Message, tools []ToolDef, executor ToolExecutor, maxIterations int) (*CompletionResponse, error) {
+	if executor == nil {
+		return nil, fmt.Errorf("tool loop: executor is required")
+	}
+	if maxIterations <= 0 {
+		maxIterations = DefaultMaxToolIterations
+	}
+
+	conversation := make([]Message, len(messages), len(messages)+2*maxIterations)
+	copy(conversation, messages)
+
+	var usage TokenUsage
+	for iteration := 1; ; iteration++ {
+		resp, err := c.CompleteWithTools(ctx, slot, conversation, tools)
+		if err != nil {
+			return nil, fmt.Errorf("tool loop: completion %d: %w", iteration, err)
+		}
+		usage.InputTokens += resp.Usage.InputTokens
+		usage.OutputTokens += resp.Usage.OutputTokens
+		usage.TotalTokens += resp.Usage.TotalTokens
+
+		if len(resp.ToolCalls) == 0 {
+			resp.Usage = usage
+			return resp, nil
+		}
+		if iteration >= maxIterations {
+			resp.Usage = usage
+			return resp, fmt.Errorf("tool loop: %w (%d)", ErrMaxToolIterations, maxIterations)
+		}
+
+		conversation = append(conversation, Message{
+			Role:      RoleAssistant,
+			Content:   resp.Content,
+			ToolCalls: resp.ToolCalls,
+		})
+		for _, call := range resp.ToolCalls {
+			if err := ctx.Err(); err != nil {
+				return nil, fmt.Errorf("tool loop: %w", err)
+			}
+			result, err := executor(ctx, call)
+			if err != nil {
+				return nil, fmt.Errorf("tool loop: tool %s: %w", call.Name, err)
+			}
+			if result.ToolCallID == "" {
+				result.ToolCallID = call.ID
+			}
+			conversation = append(conversation, Message{
+				Role:        RoleTool,
+				Name:        call.Name,
+				ToolResults: []ToolResult{result},
+			})
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// scriptedCompleter returns its responses in order and records the
+// conversation passed to each call.
+type scriptedCompleter struct {
+	responses []*CompletionResponse
+	calls     [][]Message
+}
+
+func (s *scriptedCompleter) CompleteWithTools(ctx context.Context, slot string, messages []Message, tools []ToolDef) (*CompletionResponse, error) {
+	s.calls = append(s.calls, append([]Message(nil), messages...))
+	if len(s.calls) > len(s.responses) {
+		return nil, errors.New("unexpected completion")
+	}
+	return s.responses[len(s.calls)-1], nil
+}
+
+func toolCallResponse(calls ...ToolCall) *CompletionResponse {
+	return &CompletionResponse{
+		ToolCalls:    calls,
+		FinishReason: "tool_calls",
+		Usage:        TokenUsage{InputTokens: 10, OutputTokens: 2, TotalTokens: 12},
+	}
+}
+
+func echoExecutor(ctx context.Context, call ToolCall) (ToolResult, error) {
+	return ToolResult{Content: call.Name + ":" + call.Arguments}, nil
+}
+
+func TestCompleteWithToolLoop(t *testing.T) {
+	completer := &scriptedCompleter{responses: []*CompletionResponse{
+		toolCallResponse(
+			ToolCall{ID: "call-1", Name: "nmap", Arguments: `{"host":"10.0.0.1"}`},
+			ToolCall{ID: "call-2", Name: "whois", Arguments: `{"domain":"example.com"}`},
+		),
+		toolCallResponse(ToolCall{ID: "call-3", Name: "httpx", Arguments: `{}`}),
+		{Content: "done", FinishReason: "stop", Usage: TokenUsage{InputTokens: 30, OutputTokens: 5, TotalTokens: 35}},
+	}}
+	messages := []Message{{Role: RoleUser, Content: "scan the target"}}
+
+	resp, err := CompleteWithToolLoop(context.Background(), completer, "primary", messages, nil, echoExecutor, 5)
+	if err != nil {
+		t.Fatalf("CompleteWithToolLoop() error = %v", err)
+	}
+	if resp.Content != "done" {
+		t.Errorf("Content = %q, want done", resp.Content)
+	}
+	if resp.Usage.TotalTokens != 59 || resp.Usage.InputTokens != 50 {
+		t.Errorf("Usage = %+v, want summed usage", resp.Usage)
+	}
+	if len(messages) != 1 {
+		t.Errorf("caller's messages were modified: %d messages", len(messages))
+	}
+
+	if len(completer.calls) != 3 {
+		t.Fatalf("completions = %d, want 3", len(completer.calls))
+	}
+	second := completer.calls[1]
+	if len(second) != 4 {
+		t.Fatalf("second completion got %d messages, want 4", len(second))
+	}
+	if second[1].Role != RoleAssistant || len(second[1].ToolCalls) != 2 {
+		t.Errorf("message 1 = %+v, want the assistant tool calls", second[1])
+	}
+	for i, want := range []ToolResult{
+		{ToolCallID: "call-1", Content: `nmap:{"host":"10.0.0.1"}`},
+		{ToolCallID: "call-2", Content: `whois:{"domain":"example.com"}`},
+	} {
+		msg := second[2+i]
+		if !msg.IsValid() || msg.Role != RoleTool || msg.ToolResults[0] != want {
+			t.Errorf("message %d = %+v, want tool result %+v", 2+i, msg, want)
+		}
+	}
+	if len(completer.calls[2]) != 6 {
+		t.Errorf("third completion got %d messages, want 6", len(completer.calls[2]))
+	}
+}
+
+func TestCompleteWithToolLoop_MaxIterations(t *testing.T) {
+	completer := &scriptedCompleter{responses: []*CompletionResponse{
+		toolCallResponse(ToolCall{ID: "1", Name: "nmap"}),
+		toolCallResponse(ToolCall{ID: "2", Name: "nmap"}),
+		toolCallResponse(ToolCall{ID: "3", Name: "nmap"}),
+	}}
+
+	resp, err := CompleteWithToolLoop(context.Background(), completer, "primary", nil, nil, echoExecutor, 2)
+	if !errors.Is(err, ErrMaxToolIterations) {
+		t.Fatalf("error = %v, want ErrMaxToolIterations", err)
+	}
+	if resp == nil || len(resp.ToolCalls) != 1 || resp.ToolCalls[0].ID != "2" {
+		t.Errorf("resp = %+v, want the last response", resp)
+	}
+	if len(completer.calls) != 2 {
+		t.Errorf("completions = %d, want 2", len(completer.calls))
+	}
+}
+
+func TestCompleteWithToolLoop_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := CompleteWithToolLoop(ctx, &scriptedCompleter{}, "primary", nil, nil, nil, 1)
+	if err == nil {
+		t.Error("expected an error without an executor")
+	}
+
+	completer := &scriptedCompleter{responses: []*CompletionResponse{
+		toolCallResponse(ToolCall{ID: "1", Name: "nmap"}),
+	}}
+	failing := func(ctx context.Context, call ToolCall) (ToolResult, error) {
+		return ToolResult{}, errors.New("executor crashed")
+	}
+	_, err = CompleteWithToolLoop(ctx, completer, "primary", nil, nil, failing, 3)
+	if err == nil || !strings.Contains(err.Error(), "executor crashed") {
+		t.Errorf("error = %v, want executor error", err)
+	}
+
+	_, err = CompleteWithToolLoop(ctx, &scriptedCompleter{}, "primary", nil, nil, echoExecutor, 3)
+	if err == nil || !strings.Contains(err.Error(), "completion 1") {
+		t.Errorf("error = %v, want completion error", err)
+	}
+}
@@ -58,8 +58,20 @@
 //	// Get recent history
 //	history, err := mission.History(ctx, 20)
 //
+//	// Filter by metadata and page through results
+//	filter := map[string]any{"category": []string{"credentials", "tokens"}}
+//	for offset := 0; ; offset += 50 {
+//	    page, total, err := mission.SearchFiltered(ctx, "admin", filter, 50, offset)
+//	    if err != nil || offset+len(page) >= total {
+//	        break
+//	    }
+//	}
+//
 // Mission memory persists data to disk and maintains metadata including creation
 // and update timestamps. It supports full-text search across stored values.
+// SearchFiltered and HistoryFiltered narrow results by metadata: scalar filter
+// values match exactly, and a list (or {"$in": [...]}) matches any element.
+// See MatchesFilter.
 //
 // # Long-Term Memory
 //
@@ -101,6 +113,18 @@
 // Implementations of Store are responsible for managing the lifecycle and
 // persistence of each memory tier according to their respective semantics.
 //
+// # Searching All Tiers
+//
+// When an agent does not know which tier holds a piece of information,
+// SearchAll queries mission and long-term memory and merges the results into
+// one ranked list. Each UnifiedResult records its tier; scores can be
+// weighted per tier:
+//
+//	results, err := memory.SearchAll(ctx, store, "admin credentials", 10,
+//	    memory.WithTierWeight(memory.TierLongTerm, 0.8))
+//
+// Working memory is exact-key only and is not searched.
+//
 // # Context and Cancellation
 //
 // All memory operations accept a context.Context parameter, allowing for
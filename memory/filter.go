@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FilterIn is the metadata filter operator matching any of a list of values:
+//
+//	filter := map[string]any{"category": map[string]any{memory.FilterIn: []any{"credentials", "tokens"}}}
+//
+// A bare list is shorthand for the same thing:
+//
+//	filter := map[string]any{"category": []string{"credentials", "tokens"}}
+const FilterIn = "$in"
+
+// MatchesFilter reports whether item metadata satisfies a metadata filter.
+// Every filter key must be present in metadata (logical AND). A scalar filter
+// value requires an exact match; a list value, or a map with the FilterIn
+// operator, matches if the metadata value equals any element. Numbers compare
+// by value regardless of type, so 3 matches 3.0. A nil or empty filter
+// matches everything.
+//
+// Stores implementing SearchFiltered and HistoryFiltered use this to give
+// filters consistent semantics.
+func MatchesFilter(metadata map[string]any, filter map[string]any) bool {
+	for key, want := range filter {
+		got, ok := metadata[key]
+		if !ok {
+			return false
+		}
+
+		if candidates, isList := filterCandidates(want); isList {
+			matched := false
+			for _, c := range candidates {
+				if filterValueEqual(got, c) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+
+		if !filterValueEqual(got, want) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateFilter checks that a filter only uses supported operators.
+// Map filter values must contain exactly the FilterIn operator with a list.
+// Errors wrap ErrInvalidFilter.
+func ValidateFilter(filter map[string]any) error {
+	for key, want := range filter {
+		op, ok := want.(map[string]any)
+		if !ok {
+			continue
+		}
+		if len(op) != 1 {
+			return fmt.Errorf("%w: %q: expected a single operator", ErrInvalidFilter, key)
+		}
+		in, ok := op[FilterIn]
+		if !ok {
+			for name := range op {
+				return fmt.Errorf("%w: %q: unsupported operator %q", ErrInvalidFilter, key, name)
+			}
+		}
+		if in == nil || !isList(in) {
+			return fmt.Errorf("%w: %q: %s requires a list", ErrInvalidFilter, key, FilterIn)
+		}
+	}
+	return nil
+}
+
+// filterCandidates returns the values a filter entry accepts when it is a
+// list or a FilterIn operator.
+func filterCandidates(want any) ([]any, bool) {
+	if op, ok := want.(map[string]any); ok {
+		in, ok := op[FilterIn]
+		if !ok {
+			return nil, false
+		}
+		want = in
+	}
+	if !isList(want) {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(want)
+	candidates := make([]any, v.Len())
+	for i := range candidates {
+		candidates[i] = v.Index(i).Interface()
+	}
+	return candidates, true
+}
+
+// isList reports whether v is a slice or array, excluding byte slices.
+func isList(v any) bool {
+	if v == nil {
+		return false
+	}
+	if _, ok := v.([]byte); ok {
+		return false
+	}
+	kind := reflect.TypeOf(v).Kind()
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// filterValueEqual compares a metadata value to a filter value, treating all
+// numeric types as equal when their values are.
+func filterValueEqual(got, want any) bool {
+	if gf, ok := toFloat(got); ok {
+		if wf, ok := toFloat(want); ok {
+			return gf == wf
+		}
+		return false
+	}
+	return reflect.DeepEqual(got, want)
+}
+
+// toFloat converts numeric values to float64.
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
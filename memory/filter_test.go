@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	metadata := map[string]any{
+		"category": "credentials",
+		"port":     float64(22), // as decoded from JSON
+		"verified": true,
+		"tags":     []any{"ssh", "prod"},
+	}
+
+	tests := []struct {
+		name   string
+		filter map[string]any
+		want   bool
+	}{
+		{"nil filter", nil, true},
+		{"empty filter", map[string]any{}, true},
+		{"exact string", map[string]any{"category": "credentials"}, true},
+		{"string mismatch", map[string]any{"category": "tokens"}, false},
+		{"missing key", map[string]any{"owner": "alice"}, false},
+		{"number across types", map[string]any{"port": 22}, true},
+		{"number mismatch", map[string]any{"port": int64(23)}, false},
+		{"number vs string", map[string]any{"port": "22"}, false},
+		{"bool", map[string]any{"verified": true}, true},
+		{"all keys must match", map[string]any{"category": "credentials", "verified": false}, false},
+		{"list shorthand", map[string]any{"category": []string{"tokens", "credentials"}}, true},
+		{"list shorthand miss", map[string]any{"category": []string{"tokens", "notes"}}, false},
+		{"in operator", map[string]any{"port": map[string]any{FilterIn: []any{22, 443}}}, true},
+		{"in operator miss", map[string]any{"port": map[string]any{FilterIn: []int{80, 443}}}, false},
+		{"list value exact match", map[string]any{"tags": map[string]any{FilterIn: []any{[]any{"ssh", "prod"}}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchesFilter(metadata, tt.filter); got != tt.want {
+				t.Errorf("MatchesFilter(%v) = %v, want %v", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	valid := []map[string]any{
+		nil,
+		{"category": "credentials"},
+		{"category": []string{"a", "b"}},
+		{"port": map[string]any{FilterIn: []any{22, 443}}},
+	}
+	for _, filter := range valid {
+		if err := ValidateFilter(filter); err != nil {
+			t.Errorf("ValidateFilter(%v) error = %v", filter, err)
+		}
+	}
+
+	invalid := []map[string]any{
+		{"category": map[string]any{"$regex": "cred"}},
+		{"category": map[string]any{FilterIn: "credentials"}},
+		{"category": map[string]any{FilterIn: []any{"a"}, "$nin": []any{"b"}}},
+	}
+	for _, filter := range invalid {
+		if err := ValidateFilter(filter); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("ValidateFilter(%v) error = %v, want ErrInvalidFilter", filter, err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Tier identifies a searchable memory tier.
+type Tier string
+
+const (
+	// TierMission is mission memory.
+	TierMission Tier = "mission"
+
+	// TierLongTerm is long-term memory.
+	TierLongTerm Tier = "long_term"
+)
+
+// UnifiedResult is a search result from SearchAll, tagged with the tier it
+// came from.
+type UnifiedResult struct {
+	Result
+
+	// Tier is the memory tier holding the item.
+	Tier Tier `json:"tier"`
+
+	// WeightedScore is Score multiplied by the tier's weight. SearchAll ranks
+	// results by it.
+	WeightedScore float64 `json:"weighted_score"`
+}
+
+// SearchAllOption configures SearchAll.
+type SearchAllOption func(*searchAllConfig)
+
+// searchAllConfig holds the tier weights used by SearchAll.
+type searchAllConfig struct {
+	weights map[Tier]float64
+}
+
+// WithTierWeight sets the weight that scores from tier are multiplied by
+// before ranking. The default weight of each tier is 1.0. A weight of zero or
+// less excludes the tier from the search.
+func WithTierWeight(tier Tier, weight float64) SearchAllOption {
+	return func(c *searchAllConfig) {
+		c.weights[tier] = weight
+	}
+}
+
+// SearchAll searches mission and long-term memory for query and returns up
+// to limit results merged into one list, ranked by weighted score. Working
+// memory is exact-key only and is not searched.
+//
+// Tiers whose search returns ErrNotImplemented are skipped; if no searched
+// tier is implemented, SearchAll returns ErrNotImplemented. Any other tier
+// error fails the whole search.
+//
+// Example:
+//
+//	// Prefer facts from the current mission over older knowledge
+//	results, err := memory.SearchAll(ctx, store, "admin credentials", 10,
+//	    memory.WithTierWeight(memory.TierLongTerm, 0.8))
+//	for _, r := range results {
+//	    fmt.Printf("[%s] %s (%.2f)\n", r.Tier, r.Key, r.WeightedScore)
+//	}
+func SearchAll(ctx context.Context, store Store, query string, limit int, opts ...SearchAllOption) ([]UnifiedResult, error) {
+	cfg := searchAllConfig{
+		weights: map[Tier]float64{TierMission: 1.0, TierLongTerm: 1.0},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if limit <= 0 {
+		return []UnifiedResult{}, nil
+	}
+
+	searches := []struct {
+		tier   Tier
+		search func() ([]Result, error)
+	}{
+		{TierMission, func() ([]Result, error) { return store.Mission().Search(ctx, query, limit) }},
+		{TierLongTerm, func() ([]Result, error) { return store.LongTerm().Search(ctx, query, limit, nil) }},
+	}
+
+	merged := []UnifiedResult{}
+	searched, unsupported := 0, 0
+	for _, s := range searches {
+		weight := cfg.weights[s.tier]
+		if weight <= 0 {
+			continue
+		}
+
+		searched++
+		results, err := s.search()
+		if errors.Is(err, ErrNotImplemented) {
+			unsupported++
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("memory: search %s tier: %w", s.tier, err)
+		}
+
+		for _, r := range results {
+			merged = append(merged, UnifiedResult{
+				Result:        r,
+				Tier:          s.tier,
+				WeightedScore: r.Score * weight,
+			})
+		}
+	}
+
+	if searched > 0 && unsupported == searched {
+		return nil, ErrNotImplemented
+	}
+
+	// Stable sort keeps each tier's own order, and mission results first, on ties
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].WeightedScore > merged[j].WeightedScore
+	})
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged, nil
+}
@@ -0,0 +1,165 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fixedMission returns fixed mission search results.
+type fixedMission struct {
+	MissionMemory
+	results []Result
+	err     error
+}
+
+func (f *fixedMission) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	return f.results, f.err
+}
+
+// fixedLongTerm returns fixed long-term search results.
+type fixedLongTerm struct {
+	LongTermMemory
+	results []Result
+	err     error
+}
+
+func (f *fixedLongTerm) Search(ctx context.Context, query string, topK int, filters map[string]any) ([]Result, error) {
+	return f.results, f.err
+}
+
+// fixedStore is a Store whose searchable tiers return fixed results.
+type fixedStore struct {
+	mission  *fixedMission
+	longTerm *fixedLongTerm
+}
+
+func (s *fixedStore) Working() WorkingMemory   { return nil }
+func (s *fixedStore) Mission() MissionMemory   { return s.mission }
+func (s *fixedStore) LongTerm() LongTermMemory { return s.longTerm }
+
+func scored(key string, score float64) Result {
+	return Result{Item: Item{Key: key}, Score: score}
+}
+
+func newFixedStore() *fixedStore {
+	return &fixedStore{
+		mission: &fixedMission{results: []Result{
+			scored("mission-high", 0.9),
+			scored("mission-low", 0.4),
+		}},
+		longTerm: &fixedLongTerm{results: []Result{
+			scored("lt-high", 0.8),
+			scored("lt-low", 0.5),
+		}},
+	}
+}
+
+func resultKeys(results []UnifiedResult) []string {
+	keys := make([]string, len(results))
+	for i, r := range results {
+		keys[i] = r.Key
+	}
+	return keys
+}
+
+func equalKeys(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSearchAll(t *testing.T) {
+	ctx := context.Background()
+
+	results, err := SearchAll(ctx, newFixedStore(), "admin", 10)
+	if err != nil {
+		t.Fatalf("SearchAll failed: %v", err)
+	}
+
+	want := []string{"mission-high", "lt-high", "lt-low", "mission-low"}
+	if got := resultKeys(results); !equalKeys(got, want) {
+		t.Errorf("ranking = %v, want %v", got, want)
+	}
+	if results[0].Tier != TierMission || results[1].Tier != TierLongTerm {
+		t.Errorf("unexpected tiers %s, %s", results[0].Tier, results[1].Tier)
+	}
+	if results[1].Score != 0.8 || results[1].WeightedScore != 0.8 {
+		t.Errorf("score = %v, weighted = %v, want 0.8 for both", results[1].Score, results[1].WeightedScore)
+	}
+}
+
+func TestSearchAll_Limit(t *testing.T) {
+	results, err := SearchAll(context.Background(), newFixedStore(), "admin", 2)
+	if err != nil {
+		t.Fatalf("SearchAll failed: %v", err)
+	}
+	if got := resultKeys(results); !equalKeys(got, []string{"mission-high", "lt-high"}) {
+		t.Errorf("results = %v", got)
+	}
+
+	results, err = SearchAll(context.Background(), newFixedStore(), "admin", 0)
+	if err != nil || len(results) != 0 {
+		t.Errorf("limit 0: results = %v, err = %v", results, err)
+	}
+}
+
+func TestSearchAll_TierWeights(t *testing.T) {
+	ctx := context.Background()
+
+	results, err := SearchAll(ctx, newFixedStore(), "admin", 10, WithTierWeight(TierLongTerm, 0.5))
+	if err != nil {
+		t.Fatalf("SearchAll failed: %v", err)
+	}
+	want := []string{"mission-high", "mission-low", "lt-high", "lt-low"}
+	if got := resultKeys(results); !equalKeys(got, want) {
+		t.Errorf("ranking = %v, want %v", got, want)
+	}
+	if results[2].Score != 0.8 || results[2].WeightedScore != 0.4 {
+		t.Errorf("score = %v, weighted = %v, want 0.8 and 0.4", results[2].Score, results[2].WeightedScore)
+	}
+
+	results, err = SearchAll(ctx, newFixedStore(), "admin", 10, WithTierWeight(TierMission, 0))
+	if err != nil {
+		t.Fatalf("SearchAll failed: %v", err)
+	}
+	for _, r := range results {
+		if r.Tier != TierLongTerm {
+			t.Errorf("excluded tier %s returned %s", r.Tier, r.Key)
+		}
+	}
+}
+
+func TestSearchAll_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	// An unimplemented tier is skipped
+	store := newFixedStore()
+	store.longTerm.err = ErrNotImplemented
+	results, err := SearchAll(ctx, store, "admin", 10)
+	if err != nil {
+		t.Fatalf("SearchAll failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected only mission results, got %v", resultKeys(results))
+	}
+
+	// No implemented tier
+	store.mission.err = ErrNotImplemented
+	if _, err := SearchAll(ctx, store, "admin", 10); !errors.Is(err, ErrNotImplemented) {
+		t.Errorf("expected ErrNotImplemented, got %v", err)
+	}
+
+	// Other errors fail the search
+	store = newFixedStore()
+	store.mission.err = ErrStorageFailed
+	if _, err := SearchAll(ctx, store, "admin", 10); !errors.Is(err, ErrStorageFailed) {
+		t.Errorf("expected ErrStorageFailed, got %v", err)
+	}
+}
@@ -19,6 +19,9 @@ var (
 	// ErrStorageFailed is returned when the underlying storage backend fails.
 	ErrStorageFailed = errors.New("memory: storage operation failed")
 
+	// ErrInvalidFilter is returned when a metadata filter uses an unsupported operator.
+	ErrInvalidFilter = errors.New("memory: invalid filter")
+
 	// ErrNotImplemented is returned when an operation is not supported by the implementation.
 	ErrNotImplemented = errors.New("memory: operation not implemented")
 
@@ -159,6 +162,10 @@ type WorkingMemory interface {
 //
 //	// Get recent history
 //	history, err := mission.History(ctx, 20)
+//
+//	// Filter by metadata and page through results
+//	page, total, err := mission.SearchFiltered(ctx, "admin",
+//	    map[string]any{"category": "credentials"}, 20, 40)
 type MissionMemory interface {
 	// Get retrieves an item by key with full metadata.
 	// Returns ErrNotFound if the key does not exist.
@@ -179,13 +186,28 @@ type MissionMemory interface {
 	// returning up to 'limit' results ordered by relevance.
 	// The query string is matched against both keys and values.
 	// Returns an empty slice if no matches are found.
+	// It is equivalent to SearchFiltered with a nil filter and no offset.
 	Search(ctx context.Context, query string, limit int) ([]Result, error)
 
+	// SearchFiltered performs a full-text search restricted to items whose
+	// metadata matches filter (see MatchesFilter), skipping the first
+	// 'offset' results and returning up to 'limit'. The second return value
+	// is the total number of matches before pagination, so callers can page
+	// until offset >= total.
+	// Returns ErrInvalidFilter if the filter uses an unsupported operator.
+	SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]Result, int, error)
+
 	// History returns the most recently updated items, up to 'limit'.
 	// Items are ordered by UpdatedAt in descending order (most recent first).
 	// Returns an empty slice if no items exist.
 	History(ctx context.Context, limit int) ([]Item, error)
 
+	// HistoryFiltered returns recently updated items whose metadata matches
+	// filter, in the same order as History, skipping the first 'offset'
+	// items and returning up to 'limit', along with the total match count.
+	// Returns ErrInvalidFilter if the filter uses an unsupported operator.
+	HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]Item, int, error)
+
 	// Memory Continuity Methods
 	//
 	// These methods enable access to memory across mission runs
@@ -196,8 +196,16 @@ func (m *mockMissionMemory) Delete(ctx context.Context, key string) error {
 }
 
 func (m *mockMissionMemory) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	results, _, err := m.SearchFiltered(ctx, query, nil, limit, 0)
+	return results, err
+}
+
+func (m *mockMissionMemory) SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]Result, int, error) {
 	if err := ctx.Err(); err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	if err := ValidateFilter(filter); err != nil {
+		return nil, 0, err
 	}
 
 	m.mu.RLock()
@@ -207,6 +215,10 @@ func (m *mockMissionMemory) Search(ctx context.Context, query string, limit int)
 	queryLower := strings.ToLower(query)
 
 	for _, item := range m.items {
+		if !MatchesFilter(item.Metadata, filter) {
+			continue
+		}
+
 		score := 0.0
 
 		// Simple scoring: check if query appears in key or value
@@ -230,22 +242,29 @@ func (m *mockMissionMemory) Search(ctx context.Context, query string, limit int)
 		}
 	}
 
-	// Sort by score descending
+	// Sort by score descending, then key for stable pages
 	sort.Slice(results, func(i, j int) bool {
-		return results[i].Score > results[j].Score
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Key < results[j].Key
 	})
 
-	// Apply limit
-	if limit > 0 && len(results) > limit {
-		results = results[:limit]
-	}
-
-	return results, nil
+	total := len(results)
+	return paginate(results, limit, offset), total, nil
 }
 
 func (m *mockMissionMemory) History(ctx context.Context, limit int) ([]Item, error) {
+	items, _, err := m.HistoryFiltered(ctx, nil, limit, 0)
+	return items, err
+}
+
+func (m *mockMissionMemory) HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]Item, int, error) {
 	if err := ctx.Err(); err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	if err := ValidateFilter(filter); err != nil {
+		return nil, 0, err
 	}
 
 	m.mu.RLock()
@@ -253,7 +272,9 @@ func (m *mockMissionMemory) History(ctx context.Context, limit int) ([]Item, err
 
 	items := make([]Item, 0, len(m.items))
 	for _, item := range m.items {
-		items = append(items, *item.Clone())
+		if MatchesFilter(item.Metadata, filter) {
+			items = append(items, *item.Clone())
+		}
 	}
 
 	// Sort by UpdatedAt descending
@@ -261,12 +282,22 @@ func (m *mockMissionMemory) History(ctx context.Context, limit int) ([]Item, err
 		return items[i].UpdatedAt.After(items[j].UpdatedAt)
 	})
 
-	// Apply limit
+	total := len(items)
+	return paginate(items, limit, offset), total, nil
+}
+
+// paginate applies offset and limit to a sorted result set.
+func paginate[T any](items []T, limit, offset int) []T {
+	if offset > 0 {
+		if offset >= len(items) {
+			return []T{}
+		}
+		items = items[offset:]
+	}
 	if limit > 0 && len(items) > limit {
 		items = items[:limit]
 	}
-
-	return items, nil
+	return items
 }
 
 // GetPreviousRunValue implements the continuity interface.
@@ -850,3 +881,107 @@ func TestErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestMissionMemoryFiltered(t *testing.T) {
+	ctx := context.Background()
+	mission := newMockMissionMemory()
+
+	for i := 0; i < 5; i++ {
+		mission.Set(ctx, fmt.Sprintf("cred-%d", i), "admin credential", map[string]any{"category": "credentials", "port": i})
+		time.Sleep(time.Millisecond)
+	}
+	mission.Set(ctx, "token-1", "admin token", map[string]any{"category": "tokens"})
+	mission.Set(ctx, "note-1", "admin note", map[string]any{"category": "notes"})
+
+	t.Run("SearchFiltered exact match", func(t *testing.T) {
+		results, total, err := mission.SearchFiltered(ctx, "admin", map[string]any{"category": "credentials"}, 0, 0)
+		if err != nil {
+			t.Fatalf("SearchFiltered() error = %v", err)
+		}
+		if total != 5 || len(results) != 5 {
+			t.Errorf("SearchFiltered() = %d results, total %d, want 5 and 5", len(results), total)
+		}
+	})
+
+	t.Run("SearchFiltered pagination", func(t *testing.T) {
+		filter := map[string]any{"category": "credentials"}
+		seen := make(map[string]bool)
+		for offset := 0; ; offset += 2 {
+			page, total, err := mission.SearchFiltered(ctx, "admin", filter, 2, offset)
+			if err != nil {
+				t.Fatalf("SearchFiltered() error = %v", err)
+			}
+			if total != 5 {
+				t.Errorf("total = %d, want 5", total)
+			}
+			for _, r := range page {
+				seen[r.Key] = true
+			}
+			if offset+len(page) >= total {
+				break
+			}
+		}
+		if len(seen) != 5 {
+			t.Errorf("paged through %d distinct items, want 5", len(seen))
+		}
+
+		page, total, err := mission.SearchFiltered(ctx, "admin", filter, 2, 10)
+		if err != nil {
+			t.Fatalf("SearchFiltered() error = %v", err)
+		}
+		if len(page) != 0 || total != 5 {
+			t.Errorf("offset past end = %d results, total %d, want 0 and 5", len(page), total)
+		}
+	})
+
+	t.Run("SearchFiltered in filters", func(t *testing.T) {
+		for _, filter := range []map[string]any{
+			{"category": []string{"tokens", "notes"}},
+			{"category": map[string]any{FilterIn: []any{"tokens", "notes"}}},
+		} {
+			_, total, err := mission.SearchFiltered(ctx, "admin", filter, 10, 0)
+			if err != nil {
+				t.Fatalf("SearchFiltered(%v) error = %v", filter, err)
+			}
+			if total != 2 {
+				t.Errorf("SearchFiltered(%v) total = %d, want 2", filter, total)
+			}
+		}
+	})
+
+	t.Run("Search matches nil filter", func(t *testing.T) {
+		results, err := mission.Search(ctx, "admin", 100)
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		_, total, _ := mission.SearchFiltered(ctx, "admin", nil, 100, 0)
+		if len(results) != total {
+			t.Errorf("Search() = %d results, SearchFiltered total = %d", len(results), total)
+		}
+	})
+
+	t.Run("HistoryFiltered", func(t *testing.T) {
+		items, total, err := mission.HistoryFiltered(ctx, map[string]any{"category": "credentials"}, 2, 1)
+		if err != nil {
+			t.Fatalf("HistoryFiltered() error = %v", err)
+		}
+		if total != 5 || len(items) != 2 {
+			t.Fatalf("HistoryFiltered() = %d items, total %d, want 2 and 5", len(items), total)
+		}
+		// Most recent first, skipping cred-4
+		if items[0].Key != "cred-3" || items[1].Key != "cred-2" {
+			t.Errorf("HistoryFiltered() keys = %s, %s, want cred-3, cred-2", items[0].Key, items[1].Key)
+		}
+	})
+
+	t.Run("Invalid filter", func(t *testing.T) {
+		_, _, err := mission.SearchFiltered(ctx, "admin", map[string]any{"category": map[string]any{"$regex": "cred"}}, 10, 0)
+		if !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("SearchFiltered() error = %v, want ErrInvalidFilter", err)
+		}
+		_, _, err = mission.HistoryFiltered(ctx, map[string]any{"category": map[string]any{FilterIn: "credentials"}}, 10, 0)
+		if !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("HistoryFiltered() error = %v, want ErrInvalidFilter", err)
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package mission
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// NewCreateOpts returns empty CreateMissionOpts for fluent construction.
+//
+// Example:
+//
+//	opts := mission.NewCreateOpts().
+//	    WithName("Subdomain Enumeration").
+//	    WithWorkflow(workflow).
+//	    WithPriority(5).
+//	    WithConstraints(mission.MissionConstraints{MaxDuration: 30 * time.Minute})
+//	info, err := harness.CreateMission(ctx, nil, targetID, opts)
+func NewCreateOpts() *CreateMissionOpts {
+	return &CreateMissionOpts{}
+}
+
+// WithName sets the mission name.
+func (o *CreateMissionOpts) WithName(name string) *CreateMissionOpts {
+	o.Name = name
+	return o
+}
+
+// WithWorkflow sets the workflow definition.
+func (o *CreateMissionOpts) WithWorkflow(workflow any) *CreateMissionOpts {
+	o.Workflow = workflow
+	return o
+}
+
+// WithPriority sets the scheduling priority.
+func (o *CreateMissionOpts) WithPriority(priority int) *CreateMissionOpts {
+	o.Priority = priority
+	return o
+}
+
+// WithConstraints sets the execution limits.
+func (o *CreateMissionOpts) WithConstraints(constraints MissionConstraints) *CreateMissionOpts {
+	o.Constraints = &constraints
+	return o
+}
+
+// WithMetadata sets a metadata entry.
+func (o *CreateMissionOpts) WithMetadata(key string, value any) *CreateMissionOpts {
+	if o.Metadata == nil {
+		o.Metadata = make(map[string]any)
+	}
+	o.Metadata[key] = value
+	return o
+}
+
+// WithTags appends tags.
+func (o *CreateMissionOpts) WithTags(tags ...string) *CreateMissionOpts {
+	o.Tags = append(o.Tags, tags...)
+	return o
+}
+
+// Validate checks that the options are usable: a non-negative priority,
+// valid constraints, and non-empty tags. A workflow that has its own
+// Validate method is validated too.
+func (o *CreateMissionOpts) Validate() error {
+	if o.Priority < 0 {
+		return fmt.Errorf("%w: priority %d is negative", ErrInvalidOptions, o.Priority)
+	}
+	if o.Constraints != nil {
+		if err := o.Constraints.Validate(); err != nil {
+			return err
+		}
+	}
+	if slices.Contains(o.Tags, "") {
+		return fmt.Errorf("%w: empty tag", ErrInvalidOptions)
+	}
+	if o.Workflow != nil {
+		return ValidateWorkflow(o.Workflow)
+	}
+	return nil
+}
+
+// ValidateWorkflow checks a workflow passed to CreateMission: it must not be
+// nil, and a workflow with a Validate method must pass it.
+func ValidateWorkflow(workflow any) error {
+	if workflow == nil {
+		return fmt.Errorf("%w: no workflow provided", ErrInvalidWorkflow)
+	}
+	if v, ok := workflow.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidWorkflow, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that no limit is negative.
+func (c *MissionConstraints) Validate() error {
+	switch {
+	case c.MaxDuration < 0:
+		return fmt.Errorf("%w: max duration %v is negative", ErrInvalidOptions, c.MaxDuration)
+	case c.MaxTokens < 0:
+		return fmt.Errorf("%w: max tokens %d is negative", ErrInvalidOptions, c.MaxTokens)
+	case c.MaxCost < 0:
+		return fmt.Errorf("%w: max cost %.2f is negative", ErrInvalidOptions, c.MaxCost)
+	case c.MaxFindings < 0:
+		return fmt.Errorf("%w: max findings %d is negative", ErrInvalidOptions, c.MaxFindings)
+	}
+	return nil
+}
+
+// Validate checks that the timeout is not negative.
+func (o *RunMissionOpts) Validate() error {
+	if o.Timeout < 0 {
+		return fmt.Errorf("%w: timeout %v is negative", ErrInvalidOptions, o.Timeout)
+	}
+	return nil
+}
+
+// NewFilter returns an empty MissionFilter, which matches every mission, for
+// fluent construction.
+//
+// Example:
+//
+//	filter := mission.NewFilter().
+//	    WithStatus(mission.MissionStatusRunning).
+//	    WithParentMissionID(parentID).
+//	    WithLimit(10)
+//	missions, err := harness.ListMissions(ctx, filter)
+func NewFilter() *MissionFilter {
+	return &MissionFilter{}
+}
+
+// WithStatus filters by status.
+func (f *MissionFilter) WithStatus(status MissionStatus) *MissionFilter {
+	f.Status = &status
+	return f
+}
+
+// WithTargetID filters by target.
+func (f *MissionFilter) WithTargetID(targetID string) *MissionFilter {
+	f.TargetID = &targetID
+	return f
+}
+
+// WithParentMissionID filters by parent mission.
+func (f *MissionFilter) WithParentMissionID(parentID string) *MissionFilter {
+	f.ParentMissionID = &parentID
+	return f
+}
+
+// WithCreatedAfter filters to missions created after t.
+func (f *MissionFilter) WithCreatedAfter(t time.Time) *MissionFilter {
+	f.CreatedAfter = &t
+	return f
+}
+
+// WithCreatedBefore filters to missions created before t.
+func (f *MissionFilter) WithCreatedBefore(t time.Time) *MissionFilter {
+	f.CreatedBefore = &t
+	return f
+}
+
+// WithTags filters to missions having all of tags.
+func (f *MissionFilter) WithTags(tags ...string) *MissionFilter {
+	f.Tags = append(f.Tags, tags...)
+	return f
+}
+
+// WithLimit sets the maximum number of results.
+func (f *MissionFilter) WithLimit(limit int) *MissionFilter {
+	f.Limit = limit
+	return f
+}
+
+// WithOffset sets the number of results to skip.
+func (f *MissionFilter) WithOffset(offset int) *MissionFilter {
+	f.Offset = offset
+	return f
+}
+
+// Validate checks that the status is known, the time range is not inverted,
+// and pagination values are not negative.
+func (f *MissionFilter) Validate() error {
+	if f.Status != nil && !f.Status.IsValid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidOptions, *f.Status)
+	}
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && f.CreatedAfter.After(*f.CreatedBefore) {
+		return fmt.Errorf("%w: created after %v is later than created before %v", ErrInvalidOptions, *f.CreatedAfter, *f.CreatedBefore)
+	}
+	if f.Limit < 0 {
+		return fmt.Errorf("%w: limit %d is negative", ErrInvalidOptions, f.Limit)
+	}
+	if f.Offset < 0 {
+		return fmt.Errorf("%w: offset %d is negative", ErrInvalidOptions, f.Offset)
+	}
+	return nil
+}
+
+// Matches reports whether info satisfies the filter's criteria. Limit and
+// Offset are not considered.
+func (f *MissionFilter) Matches(info *MissionInfo) bool {
+	switch {
+	case f.Status != nil && info.Status != *f.Status:
+		return false
+	case f.TargetID != nil && info.TargetID != *f.TargetID:
+		return false
+	case f.ParentMissionID != nil && info.ParentMissionID != *f.ParentMissionID:
+		return false
+	case f.CreatedAfter != nil && !info.CreatedAt.After(*f.CreatedAfter):
+		return false
+	case f.CreatedBefore != nil && !info.CreatedAt.Before(*f.CreatedBefore):
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !slices.Contains(info.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package mission
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type validatingWorkflow struct {
+	err error
+}
+
+func (w validatingWorkflow) Validate() error { return w.err }
+
+func TestNewCreateOpts(t *testing.T) {
+	opts := NewCreateOpts().
+		WithName("recon").
+		WithWorkflow("workflow").
+		WithPriority(5).
+		WithConstraints(MissionConstraints{MaxDuration: time.Minute}).
+		WithMetadata("owner", "team-a").
+		WithTags("a").
+		WithTags("b")
+
+	if opts.Name != "recon" || opts.Workflow != "workflow" || opts.Priority != 5 {
+		t.Errorf("opts = %+v", opts)
+	}
+	if opts.Constraints == nil || opts.Constraints.MaxDuration != time.Minute {
+		t.Errorf("Constraints = %+v", opts.Constraints)
+	}
+	if opts.Metadata["owner"] != "team-a" {
+		t.Errorf("Metadata = %v", opts.Metadata)
+	}
+	if len(opts.Tags) != 2 {
+		t.Errorf("Tags = %v", opts.Tags)
+	}
+	if err := opts.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+}
+
+func TestCreateMissionOpts_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *CreateMissionOpts
+		wantErr error
+	}{
+		{"empty", NewCreateOpts(), nil},
+		{"negative priority", NewCreateOpts().WithPriority(-1), ErrInvalidOptions},
+		{"negative constraint", NewCreateOpts().WithConstraints(MissionConstraints{MaxCost: -1}), ErrInvalidOptions},
+		{"empty tag", NewCreateOpts().WithTags(""), ErrInvalidOptions},
+		{"valid workflow", NewCreateOpts().WithWorkflow(validatingWorkflow{}), nil},
+		{"invalid workflow", NewCreateOpts().WithWorkflow(validatingWorkflow{err: errors.New("no nodes")}), ErrInvalidWorkflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateWorkflow(t *testing.T) {
+	if err := ValidateWorkflow(nil); !errors.Is(err, ErrInvalidWorkflow) {
+		t.Errorf("ValidateWorkflow(nil) = %v", err)
+	}
+	if err := ValidateWorkflow("opaque"); err != nil {
+		t.Errorf("ValidateWorkflow(opaque) = %v", err)
+	}
+}
+
+func TestRunMissionOpts_Validate(t *testing.T) {
+	if err := (&RunMissionOpts{Wait: true, Timeout: time.Minute}).Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+	if err := (&RunMissionOpts{Timeout: -time.Second}).Validate(); !errors.Is(err, ErrInvalidOptions) {
+		t.Errorf("Validate() = %v, want ErrInvalidOptions", err)
+	}
+}
+
+func TestMissionFilter_Validate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		filter  *MissionFilter
+		wantErr bool
+	}{
+		{"empty", NewFilter(), false},
+		{"valid", NewFilter().WithStatus(MissionStatusRunning).WithLimit(10).WithOffset(5), false},
+		{"unknown status", NewFilter().WithStatus("exploded"), true},
+		{"inverted range", NewFilter().WithCreatedAfter(now).WithCreatedBefore(now.Add(-time.Hour)), true},
+		{"negative limit", NewFilter().WithLimit(-1), true},
+		{"negative offset", NewFilter().WithOffset(-1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Errorf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidOptions) {
+				t.Errorf("Validate() = %v, want ErrInvalidOptions", err)
+			}
+		})
+	}
+}
+
+func TestMissionFilter_Matches(t *testing.T) {
+	created := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	info := &MissionInfo{
+		Status:          MissionStatusRunning,
+		TargetID:        "target-1",
+		ParentMissionID: "parent-1",
+		CreatedAt:       created,
+		Tags:            []string{"recon", "web"},
+	}
+
+	tests := []struct {
+		name   string
+		filter *MissionFilter
+		want   bool
+	}{
+		{"empty", NewFilter(), true},
+		{"all criteria", NewFilter().WithStatus(MissionStatusRunning).WithTargetID("target-1").WithParentMissionID("parent-1").WithTags("web"), true},
+		{"status", NewFilter().WithStatus(MissionStatusCompleted), false},
+		{"target", NewFilter().WithTargetID("target-2"), false},
+		{"parent", NewFilter().WithParentMissionID("parent-2"), false},
+		{"missing tag", NewFilter().WithTags("recon", "api"), false},
+		{"in range", NewFilter().WithCreatedAfter(created.Add(-time.Hour)).WithCreatedBefore(created.Add(time.Hour)), true},
+		{"too old", NewFilter().WithCreatedAfter(created), false},
+		{"too new", NewFilter().WithCreatedBefore(created), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(info); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
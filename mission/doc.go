@@ -0,0 +1,37 @@
+// Package mission defines the types agents use to create and orchestrate
+// sub-missions through the harness mission methods (agent.MissionManager).
+//
+// # Building Requests
+//
+// CreateMissionOpts and MissionFilter have fluent builders, and every input
+// type has a Validate method. Validation failures wrap ErrInvalidOptions or
+// ErrInvalidWorkflow:
+//
+//	opts := mission.NewCreateOpts().
+//	    WithName("Subdomain Enumeration").
+//	    WithPriority(5).
+//	    WithConstraints(mission.MissionConstraints{MaxDuration: 30 * time.Minute})
+//	info, err := harness.CreateMission(ctx, workflow, targetID, opts)
+//
+//	children, err := harness.ListMissions(ctx,
+//	    mission.NewFilter().WithParentMissionID(parentID).WithStatus(mission.MissionStatusRunning))
+//
+// # Errors
+//
+// Mission managers report unknown missions with ErrMissionNotFound, results
+// requested before a mission finished with ErrMissionNotComplete, and
+// impossible state changes, such as running a finished mission, with
+// ErrInvalidTransition. Check them with errors.Is.
+//
+// # Testing
+//
+// FakeManager is an in-memory mission manager. Tests drive its missions to a
+// terminal state with Complete, Fail and Pause, and control WaitForMission
+// timeouts with a FakeClock:
+//
+//	clock := mission.NewFakeClock(time.Now())
+//	fake := mission.NewFakeManager(mission.WithClock(clock))
+//
+// The missiontest package holds the conformance suite that FakeManager and
+// the harness implementations pass.
+package mission
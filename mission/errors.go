@@ -0,0 +1,28 @@
+package mission
+
+import "errors"
+
+// Sentinel errors returned by mission managers.
+// These errors can be used with errors.Is() for error checking.
+var (
+	// ErrMissionNotFound indicates that no mission has the given ID.
+	ErrMissionNotFound = errors.New("mission not found")
+
+	// ErrInvalidWorkflow indicates that the workflow passed to CreateMission
+	// is missing or fails its own validation.
+	ErrInvalidWorkflow = errors.New("invalid workflow")
+
+	// ErrMissionNotComplete indicates that results were requested for a
+	// mission that has not reached a terminal state. Use WaitForMission to
+	// wait for it.
+	ErrMissionNotComplete = errors.New("mission not complete")
+
+	// ErrInvalidOptions indicates that CreateMissionOpts, RunMissionOpts or
+	// MissionFilter failed validation.
+	ErrInvalidOptions = errors.New("invalid mission options")
+
+	// ErrInvalidTransition indicates that the mission cannot move to the
+	// requested state, such as running a mission that is already running or
+	// has finished.
+	ErrInvalidTransition = errors.New("invalid mission state transition")
+)
@@ -0,0 +1,427 @@
+package mission
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FakeManager is an in-memory implementation of the mission management
+// methods of agent.Harness (agent.MissionManager), for developing and testing
+// agents that orchestrate sub-missions without a daemon.
+//
+// Missions move through their states as they would in the daemon: created
+// missions are pending, RunMission makes them running, and the test drives
+// them to a terminal state with Complete or Fail, or the agent cancels them.
+// Timeouts and timestamps use the manager's Clock.
+//
+// Example:
+//
+//	fake := mission.NewFakeManager()
+//	info, _ := fake.CreateMission(ctx, workflow, "target-1", nil)
+//	_ = fake.RunMission(ctx, info.ID, nil)
+//	_ = fake.Complete(info.ID, mission.MissionResult{Output: map[string]any{"hosts": 3}})
+//	result, _ := fake.WaitForMission(ctx, info.ID, time.Minute)
+//
+// FakeManager is safe for concurrent use.
+type FakeManager struct {
+	mu       sync.Mutex
+	clock    Clock
+	parentID string
+	nextID   int
+	missions map[string]*fakeMission
+}
+
+// fakeMission is the state of one mission in a FakeManager.
+type fakeMission struct {
+	info      MissionInfo
+	opts      CreateMissionOpts
+	workflow  any
+	status    MissionStatusInfo
+	startedAt time.Time
+	result    *MissionResult
+	done      chan struct{}
+}
+
+// FakeOption configures a FakeManager.
+type FakeOption func(*FakeManager)
+
+// WithClock sets the clock used for timestamps, durations and WaitForMission
+// timeouts. The default is the system clock.
+func WithClock(clock Clock) FakeOption {
+	return func(m *FakeManager) {
+		m.clock = clock
+	}
+}
+
+// WithParentMissionID sets the ParentMissionID recorded on created missions,
+// as a harness running inside that mission would.
+func WithParentMissionID(parentID string) FakeOption {
+	return func(m *FakeManager) {
+		m.parentID = parentID
+	}
+}
+
+// NewFakeManager creates an empty FakeManager.
+func NewFakeManager(opts ...FakeOption) *FakeManager {
+	m := &FakeManager{
+		clock:    realClock{},
+		missions: make(map[string]*fakeMission),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateMission creates a pending mission. The workflow argument takes
+// precedence over opts.Workflow; one of them must be set.
+func (m *FakeManager) CreateMission(ctx context.Context, workflow any, targetID string, opts *CreateMissionOpts) (*MissionInfo, error) {
+	var o CreateMissionOpts
+	if opts != nil {
+		if err := opts.Validate(); err != nil {
+			return nil, err
+		}
+		o = *opts
+	}
+	if workflow == nil {
+		workflow = o.Workflow
+	}
+	if err := ValidateWorkflow(workflow); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	id := fmt.Sprintf("mission-%d", m.nextID)
+	name := o.Name
+	if name == "" {
+		name = id
+	}
+	fm := &fakeMission{
+		info: MissionInfo{
+			ID:              id,
+			Name:            name,
+			Status:          MissionStatusPending,
+			TargetID:        targetID,
+			ParentMissionID: m.parentID,
+			CreatedAt:       m.clock.Now(),
+			Tags:            append([]string(nil), o.Tags...),
+		},
+		opts:     o,
+		workflow: workflow,
+		status:   MissionStatusInfo{Status: MissionStatusPending},
+		done:     make(chan struct{}),
+	}
+	m.missions[id] = fm
+
+	info := fm.info
+	return &info, nil
+}
+
+// RunMission starts a pending or paused mission. With opts.Wait it blocks
+// like WaitForMission and returns its error.
+func (m *FakeManager) RunMission(ctx context.Context, missionID string, opts *RunMissionOpts) error {
+	if opts != nil {
+		if err := opts.Validate(); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	fm, err := m.lookup(missionID)
+	if err == nil {
+		switch fm.info.Status {
+		case MissionStatusPending:
+			fm.startedAt = m.clock.Now()
+			m.setStatus(fm, MissionStatusRunning)
+		case MissionStatusPaused:
+			m.setStatus(fm, MissionStatusRunning)
+		default:
+			err = fmt.Errorf("%w: cannot run mission %s in status %s", ErrInvalidTransition, missionID, fm.info.Status)
+		}
+	}
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if opts != nil && opts.Wait {
+		_, err := m.WaitForMission(ctx, missionID, opts.Timeout)
+		return err
+	}
+	return nil
+}
+
+// GetMissionStatus returns the mission's current status.
+func (m *FakeManager) GetMissionStatus(ctx context.Context, missionID string) (*MissionStatusInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return nil, err
+	}
+	status := fm.status
+	status.FindingCounts = copyCounts(fm.status.FindingCounts)
+	if !fm.startedAt.IsZero() && fm.result == nil {
+		status.Duration = m.clock.Now().Sub(fm.startedAt)
+	}
+	return &status, nil
+}
+
+// WaitForMission blocks until the mission reaches a terminal state and
+// returns its result. It returns context.DeadlineExceeded if timeout elapses
+// on the manager's clock first, and ctx.Err() if ctx is done first. A zero
+// timeout waits indefinitely.
+func (m *FakeManager) WaitForMission(ctx context.Context, missionID string, timeout time.Duration) (*MissionResult, error) {
+	m.mu.Lock()
+	fm, err := m.lookup(missionID)
+	m.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-fm.done:
+		return m.GetMissionResults(ctx, missionID)
+	default:
+	}
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		expired = m.clock.After(timeout)
+	}
+
+	select {
+	case <-fm.done:
+		return m.GetMissionResults(ctx, missionID)
+	case <-expired:
+		return nil, context.DeadlineExceeded
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ListMissions returns the missions matching filter, oldest first.
+func (m *FakeManager) ListMissions(ctx context.Context, filter *MissionFilter) ([]*MissionInfo, error) {
+	if filter == nil {
+		filter = NewFilter()
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	matched := make([]*MissionInfo, 0)
+	for _, fm := range m.missions {
+		if filter.Matches(&fm.info) {
+			info := fm.info
+			info.Tags = append([]string(nil), fm.info.Tags...)
+			matched = append(matched, &info)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.Before(matched[j].CreatedAt)
+		}
+		return missionSeq(matched[i].ID) < missionSeq(matched[j].ID)
+	})
+
+	if filter.Offset >= len(matched) {
+		return []*MissionInfo{}, nil
+	}
+	matched = matched[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(matched) {
+		matched = matched[:filter.Limit]
+	}
+	return matched, nil
+}
+
+// CancelMission cancels a mission that has not finished. Cancelling a
+// finished mission is a no-op.
+func (m *FakeManager) CancelMission(ctx context.Context, missionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return err
+	}
+	if fm.info.Status.IsTerminal() {
+		return nil
+	}
+	m.finish(fm, MissionStatusCancelled, MissionResult{})
+	return nil
+}
+
+// GetMissionResults returns the result of a finished mission, or
+// ErrMissionNotComplete if it has not finished.
+func (m *FakeManager) GetMissionResults(ctx context.Context, missionID string) (*MissionResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return nil, err
+	}
+	if fm.result == nil {
+		return nil, fmt.Errorf("%w: mission %s is %s", ErrMissionNotComplete, missionID, fm.info.Status)
+	}
+	result := *fm.result
+	return &result, nil
+}
+
+// Complete finishes a running or paused mission successfully with result.
+// The result's MissionID, Status and CompletedAt are filled in, and so is
+// its duration if zero.
+func (m *FakeManager) Complete(missionID string, result MissionResult) error {
+	return m.finishActive(missionID, MissionStatusCompleted, result)
+}
+
+// Fail finishes a running or paused mission with an error.
+func (m *FakeManager) Fail(missionID string, reason string) error {
+	return m.finishActive(missionID, MissionStatusFailed, MissionResult{Error: reason})
+}
+
+// Pause suspends a running mission. RunMission resumes it.
+func (m *FakeManager) Pause(missionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return err
+	}
+	if fm.info.Status != MissionStatusRunning {
+		return fmt.Errorf("%w: cannot pause mission %s in status %s", ErrInvalidTransition, missionID, fm.info.Status)
+	}
+	m.setStatus(fm, MissionStatusPaused)
+	return nil
+}
+
+// SetProgress reports progress of a running mission, as GetMissionStatus
+// would show it.
+func (m *FakeManager) SetProgress(missionID string, progress float64, phase string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return err
+	}
+	fm.status.Progress = progress
+	fm.status.Phase = phase
+	return nil
+}
+
+// Workflow returns the workflow a mission was created with.
+func (m *FakeManager) Workflow(missionID string) (any, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return nil, err
+	}
+	return fm.workflow, nil
+}
+
+// Options returns the options a mission was created with.
+func (m *FakeManager) Options(missionID string) (CreateMissionOpts, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return CreateMissionOpts{}, err
+	}
+	return fm.opts, nil
+}
+
+// finishActive moves a running or paused mission to a terminal status.
+func (m *FakeManager) finishActive(missionID string, status MissionStatus, result MissionResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fm, err := m.lookup(missionID)
+	if err != nil {
+		return err
+	}
+	if fm.info.Status != MissionStatusRunning && fm.info.Status != MissionStatusPaused {
+		return fmt.Errorf("%w: cannot finish mission %s in status %s", ErrInvalidTransition, missionID, fm.info.Status)
+	}
+	m.finish(fm, status, result)
+	return nil
+}
+
+// finish records the mission's result and wakes its waiters. The caller
+// holds m.mu.
+func (m *FakeManager) finish(fm *fakeMission, status MissionStatus, result MissionResult) {
+	now := m.clock.Now()
+	result.MissionID = fm.info.ID
+	result.Status = status
+	result.CompletedAt = now
+	if result.Metrics.Duration == 0 && !fm.startedAt.IsZero() {
+		result.Metrics.Duration = now.Sub(fm.startedAt)
+	}
+	if result.Metrics.FindingsCount == 0 {
+		result.Metrics.FindingsCount = len(result.Findings)
+	}
+
+	fm.result = &result
+	m.setStatus(fm, status)
+	fm.status.Duration = result.Metrics.Duration
+	fm.status.TokenUsage = result.Metrics.TokensUsed
+	fm.status.Error = result.Error
+	if len(result.Findings) > 0 {
+		fm.status.FindingCounts = make(map[string]int)
+		for _, f := range result.Findings {
+			fm.status.FindingCounts[string(f.Severity)]++
+		}
+	}
+	if status == MissionStatusCompleted {
+		fm.status.Progress = 1.0
+	}
+	close(fm.done)
+}
+
+// setStatus updates both status fields. The caller holds m.mu.
+func (m *FakeManager) setStatus(fm *fakeMission, status MissionStatus) {
+	fm.info.Status = status
+	fm.status.Status = status
+}
+
+// lookup returns the mission with the given ID. The caller holds m.mu.
+func (m *FakeManager) lookup(missionID string) (*fakeMission, error) {
+	fm, ok := m.missions[missionID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissionNotFound, missionID)
+	}
+	return fm, nil
+}
+
+// missionSeq returns the sequence number of a FakeManager mission ID, for
+// ordering missions created at the same instant.
+func missionSeq(id string) int {
+	var n int
+	_, _ = fmt.Sscanf(id, "mission-%d", &n)
+	return n
+}
+
+func copyCounts(counts map[string]int) map[string]int {
+	if counts == nil {
+		return nil
+	}
+	out := make(map[string]int, len(counts))
+	for k, v := range counts {
+		out[k] = v
+	}
+	return out
+}
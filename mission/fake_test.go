@@ -0,0 +1,183 @@
+package mission
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zero-day-ai/sdk/finding"
+)
+
+// waitForWaiters blocks until the clock has n pending After calls.
+func waitForWaiters(t *testing.T, clock *FakeClock, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for clock.Waiters() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("clock has %d waiters, want %d", clock.Waiters(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFakeManager_WaitTimeoutUsesClock(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	fake := NewFakeManager(WithClock(clock))
+
+	info, err := fake.CreateMission(ctx, "workflow", "target-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fake.RunMission(ctx, info.ID, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := fake.WaitForMission(ctx, info.ID, 10*time.Minute)
+		errs <- err
+	}()
+	waitForWaiters(t, clock, 1)
+
+	clock.Advance(9 * time.Minute)
+	select {
+	case err := <-errs:
+		t.Fatalf("WaitForMission returned before the timeout: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	clock.Advance(time.Minute)
+	if err := <-errs; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForMission() error = %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestFakeManager_DurationsFollowClock(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	fake := NewFakeManager(WithClock(clock))
+
+	info, _ := fake.CreateMission(ctx, "workflow", "target-1", nil)
+	if !info.CreatedAt.Equal(start) {
+		t.Errorf("CreatedAt = %v, want %v", info.CreatedAt, start)
+	}
+	_ = fake.RunMission(ctx, info.ID, nil)
+
+	clock.Advance(3 * time.Minute)
+	status, _ := fake.GetMissionStatus(ctx, info.ID)
+	if status.Duration != 3*time.Minute {
+		t.Errorf("running Duration = %v, want 3m", status.Duration)
+	}
+
+	clock.Advance(2 * time.Minute)
+	err := fake.Complete(info.ID, MissionResult{
+		Findings: []finding.Finding{{Severity: finding.SeverityHigh}, {Severity: finding.SeverityHigh}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := fake.GetMissionResults(ctx, info.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Metrics.Duration != 5*time.Minute {
+		t.Errorf("Metrics.Duration = %v, want 5m", result.Metrics.Duration)
+	}
+	if result.Metrics.FindingsCount != 2 {
+		t.Errorf("Metrics.FindingsCount = %d, want 2", result.Metrics.FindingsCount)
+	}
+	if !result.CompletedAt.Equal(start.Add(5 * time.Minute)) {
+		t.Errorf("CompletedAt = %v", result.CompletedAt)
+	}
+
+	status, _ = fake.GetMissionStatus(ctx, info.ID)
+	if status.Progress != 1.0 || status.FindingCounts["high"] != 2 {
+		t.Errorf("status = %+v, want progress 1.0 and 2 high findings", status)
+	}
+}
+
+func TestFakeManager_PauseAndResume(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeManager()
+	info, _ := fake.CreateMission(ctx, "workflow", "target-1", nil)
+
+	if err := fake.Pause(info.ID); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Pause(pending) error = %v, want ErrInvalidTransition", err)
+	}
+	_ = fake.RunMission(ctx, info.ID, nil)
+	if err := fake.Pause(info.ID); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := fake.GetMissionStatus(ctx, info.ID); status.Status != MissionStatusPaused {
+		t.Errorf("status = %s, want paused", status.Status)
+	}
+	if err := fake.RunMission(ctx, info.ID, nil); err != nil {
+		t.Errorf("RunMission(paused) error = %v", err)
+	}
+
+	if err := fake.Fail(info.ID, "target unreachable"); err != nil {
+		t.Fatal(err)
+	}
+	result, _ := fake.GetMissionResults(ctx, info.ID)
+	if result.Status != MissionStatusFailed || result.Error != "target unreachable" {
+		t.Errorf("result = %+v", result)
+	}
+	if err := fake.Complete(info.ID, MissionResult{}); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Complete(failed) error = %v, want ErrInvalidTransition", err)
+	}
+}
+
+func TestFakeManager_RunAndWait(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeManager()
+	info, _ := fake.CreateMission(ctx, "workflow", "target-1", nil)
+
+	go func() {
+		for {
+			if status, _ := fake.GetMissionStatus(ctx, info.ID); status.Status == MissionStatusRunning {
+				_ = fake.Complete(info.ID, MissionResult{})
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	if err := fake.RunMission(ctx, info.ID, &RunMissionOpts{Wait: true, Timeout: 5 * time.Second}); err != nil {
+		t.Errorf("RunMission(wait) error = %v", err)
+	}
+}
+
+func TestFakeManager_RecordsCreation(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeManager(WithParentMissionID("parent-1"))
+	workflow := map[string]any{"nodes": 3}
+
+	info, err := fake.CreateMission(ctx, nil, "target-1", NewCreateOpts().WithWorkflow(workflow).WithPriority(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ParentMissionID != "parent-1" {
+		t.Errorf("ParentMissionID = %q", info.ParentMissionID)
+	}
+	if info.Name != info.ID {
+		t.Errorf("Name = %q, want generated %q", info.Name, info.ID)
+	}
+
+	got, _ := fake.Workflow(info.ID)
+	if got.(map[string]any)["nodes"] != 3 {
+		t.Errorf("Workflow() = %v", got)
+	}
+	opts, _ := fake.Options(info.ID)
+	if opts.Priority != 7 {
+		t.Errorf("Options().Priority = %d, want 7", opts.Priority)
+	}
+
+	children, _ := fake.ListMissions(ctx, NewFilter().WithParentMissionID("parent-1"))
+	if len(children) != 1 {
+		t.Errorf("ListMissions(parent) = %d missions, want 1", len(children))
+	}
+}
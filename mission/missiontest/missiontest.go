@@ -0,0 +1,293 @@
+// Package missiontest provides conformance tests for implementations of the
+// mission management methods of agent.Harness (agent.MissionManager), such as
+// mission.FakeManager and the callback harness.
+//
+// Run the suite from a test in the implementation's package, passing a
+// factory that returns a fixture backed by an empty mission store:
+//
+//	func TestConformance(t *testing.T) {
+//		missiontest.RunManagerTests(t, func(t *testing.T) missiontest.Fixture {
+//			fake := mission.NewFakeManager()
+//			return missiontest.Fixture{
+//				Manager:  fake,
+//				Workflow: testWorkflow,
+//				TargetID: "target-1",
+//				Complete: func(t *testing.T, id string) {
+//					require.NoError(t, fake.Complete(id, mission.MissionResult{}))
+//				},
+//			}
+//		})
+//	}
+package missiontest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/mission"
+)
+
+// Fixture is the implementation under test and what the suite needs to
+// drive it.
+type Fixture struct {
+	// Manager is the implementation under test.
+	Manager agent.MissionManager
+
+	// Workflow is a valid workflow definition for CreateMission.
+	Workflow any
+
+	// TargetID is a valid target for CreateMission.
+	TargetID string
+
+	// Complete drives a running mission to the completed state.
+	Complete func(t *testing.T, missionID string)
+}
+
+// Factory returns a fixture backed by an empty mission store.
+type Factory func(t *testing.T) Fixture
+
+// shortTimeout is the WaitForMission timeout used where the wait is expected
+// to time out.
+const shortTimeout = 50 * time.Millisecond
+
+// waitTimeout bounds how long a test waits for a mission to finish.
+const waitTimeout = 10 * time.Second
+
+// RunManagerTests runs the mission manager conformance tests: creation and
+// validation, state transitions, waiting, cancellation, results, and
+// listing.
+func RunManagerTests(t *testing.T, newFixture Factory) {
+	t.Run("CreatePending", func(t *testing.T) {
+		testCreatePending(t, newFixture(t))
+	})
+	t.Run("CreateValidation", func(t *testing.T) {
+		testCreateValidation(t, newFixture(t))
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		testNotFound(t, newFixture(t))
+	})
+	t.Run("RunTransitions", func(t *testing.T) {
+		testRunTransitions(t, newFixture(t))
+	})
+	t.Run("CompleteAndWait", func(t *testing.T) {
+		testCompleteAndWait(t, newFixture(t))
+	})
+	t.Run("WaitTimeout", func(t *testing.T) {
+		testWaitTimeout(t, newFixture(t))
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		testCancel(t, newFixture(t))
+	})
+	t.Run("List", func(t *testing.T) {
+		testList(t, newFixture(t))
+	})
+}
+
+// create creates a mission from the fixture's workflow and target.
+func create(t *testing.T, f Fixture, opts *mission.CreateMissionOpts) *mission.MissionInfo {
+	t.Helper()
+	info, err := f.Manager.CreateMission(context.Background(), f.Workflow, f.TargetID, opts)
+	require.NoError(t, err)
+	return info
+}
+
+// testCreatePending checks that a created mission is pending and reports
+// its options.
+func testCreatePending(t *testing.T, f Fixture) {
+	ctx := context.Background()
+	info := create(t, f, mission.NewCreateOpts().WithName("recon").WithTags("smoke"))
+
+	assert.NotEmpty(t, info.ID)
+	assert.Equal(t, "recon", info.Name)
+	assert.Equal(t, mission.MissionStatusPending, info.Status)
+	assert.Equal(t, f.TargetID, info.TargetID)
+	assert.Contains(t, info.Tags, "smoke")
+	assert.False(t, info.CreatedAt.IsZero())
+
+	status, err := f.Manager.GetMissionStatus(ctx, info.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mission.MissionStatusPending, status.Status)
+
+	_, err = f.Manager.GetMissionResults(ctx, info.ID)
+	assert.True(t, errors.Is(err, mission.ErrMissionNotComplete), "got %v", err)
+}
+
+// testCreateValidation checks that a missing workflow and invalid options
+// are rejected with the sentinel errors.
+func testCreateValidation(t *testing.T, f Fixture) {
+	ctx := context.Background()
+
+	_, err := f.Manager.CreateMission(ctx, nil, f.TargetID, nil)
+	assert.True(t, errors.Is(err, mission.ErrInvalidWorkflow), "got %v", err)
+
+	opts := mission.NewCreateOpts().WithConstraints(mission.MissionConstraints{MaxTokens: -1})
+	_, err = f.Manager.CreateMission(ctx, f.Workflow, f.TargetID, opts)
+	assert.True(t, errors.Is(err, mission.ErrInvalidOptions), "got %v", err)
+
+	_, err = f.Manager.CreateMission(ctx, nil, f.TargetID, mission.NewCreateOpts().WithWorkflow(f.Workflow))
+	assert.NoError(t, err, "workflow from options")
+}
+
+// testNotFound checks that every method reports unknown missions with
+// ErrMissionNotFound.
+func testNotFound(t *testing.T, f Fixture) {
+	ctx := context.Background()
+	const id = "no-such-mission"
+
+	checks := map[string]error{
+		"RunMission":    f.Manager.RunMission(ctx, id, nil),
+		"CancelMission": f.Manager.CancelMission(ctx, id),
+	}
+	_, checks["GetMissionStatus"] = f.Manager.GetMissionStatus(ctx, id)
+	_, checks["GetMissionResults"] = f.Manager.GetMissionResults(ctx, id)
+	_, checks["WaitForMission"] = f.Manager.WaitForMission(ctx, id, shortTimeout)
+
+	for method, err := range checks {
+		assert.True(t, errors.Is(err, mission.ErrMissionNotFound), "%s: got %v", method, err)
+	}
+}
+
+// testRunTransitions checks that a mission can be run once and not after it
+// has finished.
+func testRunTransitions(t *testing.T, f Fixture) {
+	ctx := context.Background()
+	info := create(t, f, nil)
+
+	require.NoError(t, f.Manager.RunMission(ctx, info.ID, nil))
+	status, err := f.Manager.GetMissionStatus(ctx, info.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mission.MissionStatusRunning, status.Status)
+
+	err = f.Manager.RunMission(ctx, info.ID, nil)
+	assert.True(t, errors.Is(err, mission.ErrInvalidTransition), "run while running: got %v", err)
+
+	f.Complete(t, info.ID)
+	err = f.Manager.RunMission(ctx, info.ID, nil)
+	assert.True(t, errors.Is(err, mission.ErrInvalidTransition), "run after completion: got %v", err)
+}
+
+// testCompleteAndWait checks that a waiter is released when the mission
+// completes and that the result is then available.
+func testCompleteAndWait(t *testing.T, f Fixture) {
+	ctx := context.Background()
+	info := create(t, f, nil)
+	require.NoError(t, f.Manager.RunMission(ctx, info.ID, nil))
+
+	type waited struct {
+		result *mission.MissionResult
+		err    error
+	}
+	done := make(chan waited, 1)
+	go func() {
+		result, err := f.Manager.WaitForMission(ctx, info.ID, 0)
+		done <- waited{result, err}
+	}()
+
+	f.Complete(t, info.ID)
+
+	select {
+	case w := <-done:
+		require.NoError(t, w.err)
+		assert.Equal(t, info.ID, w.result.MissionID)
+		assert.Equal(t, mission.MissionStatusCompleted, w.result.Status)
+	case <-time.After(waitTimeout):
+		t.Fatal("WaitForMission did not return after the mission completed")
+	}
+
+	result, err := f.Manager.GetMissionResults(ctx, info.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mission.MissionStatusCompleted, result.Status)
+	assert.False(t, result.CompletedAt.IsZero())
+
+	// Waiting on a finished mission returns immediately.
+	result, err = f.Manager.WaitForMission(ctx, info.ID, shortTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, mission.MissionStatusCompleted, result.Status)
+}
+
+// testWaitTimeout checks that WaitForMission gives up with
+// context.DeadlineExceeded, and honours context cancellation.
+func testWaitTimeout(t *testing.T, f Fixture) {
+	ctx := context.Background()
+	info := create(t, f, nil)
+	require.NoError(t, f.Manager.RunMission(ctx, info.ID, nil))
+
+	_, err := f.Manager.WaitForMission(ctx, info.ID, shortTimeout)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = f.Manager.WaitForMission(cancelled, info.ID, 0)
+	assert.True(t, errors.Is(err, context.Canceled), "got %v", err)
+}
+
+// testCancel checks that cancellation is terminal, idempotent, and releases
+// waiters.
+func testCancel(t *testing.T, f Fixture) {
+	ctx := context.Background()
+	info := create(t, f, nil)
+	require.NoError(t, f.Manager.RunMission(ctx, info.ID, nil))
+
+	require.NoError(t, f.Manager.CancelMission(ctx, info.ID))
+	require.NoError(t, f.Manager.CancelMission(ctx, info.ID), "cancel is idempotent")
+
+	status, err := f.Manager.GetMissionStatus(ctx, info.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mission.MissionStatusCancelled, status.Status)
+
+	result, err := f.Manager.WaitForMission(ctx, info.ID, shortTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, mission.MissionStatusCancelled, result.Status)
+
+	err = f.Manager.RunMission(ctx, info.ID, nil)
+	assert.True(t, errors.Is(err, mission.ErrInvalidTransition), "got %v", err)
+}
+
+// testList checks filtering and pagination.
+func testList(t *testing.T, f Fixture) {
+	ctx := context.Background()
+	first := create(t, f, mission.NewCreateOpts().WithTags("recon"))
+	second := create(t, f, mission.NewCreateOpts().WithTags("recon", "web"))
+	third := create(t, f, nil)
+	require.NoError(t, f.Manager.RunMission(ctx, third.ID, nil))
+
+	ids := func(infos []*mission.MissionInfo) []string {
+		out := make([]string, len(infos))
+		for i, info := range infos {
+			out[i] = info.ID
+		}
+		return out
+	}
+
+	all, err := f.Manager.ListMissions(ctx, mission.NewFilter())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{first.ID, second.ID, third.ID}, ids(all))
+
+	tagged, err := f.Manager.ListMissions(ctx, mission.NewFilter().WithTags("recon"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{first.ID, second.ID}, ids(tagged))
+
+	running, err := f.Manager.ListMissions(ctx, mission.NewFilter().WithStatus(mission.MissionStatusRunning))
+	require.NoError(t, err)
+	assert.Equal(t, []string{third.ID}, ids(running))
+
+	none, err := f.Manager.ListMissions(ctx, mission.NewFilter().WithTargetID("other-target"))
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	page, err := f.Manager.ListMissions(ctx, mission.NewFilter().WithLimit(2))
+	require.NoError(t, err)
+	assert.Len(t, page, 2)
+	rest, err := f.Manager.ListMissions(ctx, mission.NewFilter().WithOffset(2))
+	require.NoError(t, err)
+	assert.Len(t, rest, 1)
+	assert.NotContains(t, ids(page), rest[0].ID)
+
+	_, err = f.Manager.ListMissions(ctx, mission.NewFilter().WithLimit(-1))
+	assert.True(t, errors.Is(err, mission.ErrInvalidOptions), "got %v", err)
+}
@@ -0,0 +1,23 @@
+package missiontest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/mission"
+	"github.com/zero-day-ai/sdk/mission/missiontest"
+)
+
+func TestFakeManager(t *testing.T) {
+	missiontest.RunManagerTests(t, func(t *testing.T) missiontest.Fixture {
+		fake := mission.NewFakeManager()
+		return missiontest.Fixture{
+			Manager:  fake,
+			Workflow: map[string]any{"name": "recon"},
+			TargetID: "target-1",
+			Complete: func(t *testing.T, missionID string) {
+				require.NoError(t, fake.Complete(missionID, mission.MissionResult{}))
+			},
+		}
+	})
+}
@@ -13,6 +13,15 @@ type CreateMissionOpts struct {
 	// If empty, a name will be auto-generated based on the workflow.
 	Name string `json:"name,omitempty"`
 
+	// Workflow is the workflow definition to run. It is used when the
+	// workflow argument of CreateMission is nil, so that a mission can be
+	// described entirely by its options.
+	Workflow any `json:"workflow,omitempty"`
+
+	// Priority orders queued missions; higher values run first. Zero is the
+	// default priority and negative values are invalid.
+	Priority int `json:"priority,omitempty"`
+
 	// Constraints defines execution limits for the mission.
 	// If nil, default constraints will be applied.
 	Constraints *MissionConstraints `json:"constraints,omitempty"`
@@ -223,6 +223,15 @@ func WithExecuteProtoHandler(fn func(ctx context.Context, input proto.Message) (
 	}
 }
 
+// WithInputGuards sets input guards enforced on the tool's proto input before
+// the execute handler runs. Keys are field paths and values are guard names
+// such as schema.GuardShellSafe. See tool.CheckInputGuards.
+func WithInputGuards(guards map[string][]string) ToolOption {
+	return func(c *tool.Config) {
+		c.SetInputGuards(guards)
+	}
+}
+
 // PluginOption configures a Plugin.
 // Note: Plugin infrastructure is not yet implemented in the SDK.
 // These options are placeholders for future functionality.
@@ -0,0 +1,205 @@
+package parser
+
+import "io"
+
+// StripANSI removes ANSI escape sequences and non-printable control
+// characters from s. It removes SGR (color) and other CSI sequences, OSC
+// sequences such as terminal titles and hyperlinks, DCS/SOS/PM/APC strings,
+// two-character escapes, and C0/C1 control characters other than newline
+// and tab. Carriage returns are removed too, so CRLF line endings become LF.
+func StripANSI(s string) string {
+	var st ansiStripper
+	out := st.strip(make([]byte, 0, len(s)), []byte(s))
+	return string(st.flush(out))
+}
+
+// StripANSIReader returns a reader that strips ANSI escape sequences and
+// control characters from r as StripANSI does. Sequences split across
+// reads are handled.
+func StripANSIReader(r io.Reader) io.Reader {
+	return &ansiReader{r: r}
+}
+
+// ansiReader strips ANSI sequences from an underlying reader.
+type ansiReader struct {
+	r   io.Reader
+	st  ansiStripper
+	buf []byte
+	out []byte
+	err error
+}
+
+// Read fills p with stripped output.
+func (a *ansiReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(a.out) == 0 && a.err == nil {
+		if a.buf == nil {
+			a.buf = make([]byte, 4096)
+		}
+		n, err := a.r.Read(a.buf)
+		a.out = a.st.strip(a.out[:0], a.buf[:n])
+		if err != nil {
+			a.out = a.st.flush(a.out)
+			a.err = err
+		}
+	}
+
+	n := copy(p, a.out)
+	a.out = a.out[n:]
+	if len(a.out) == 0 && a.err != nil {
+		return n, a.err
+	}
+	return n, nil
+}
+
+// ansiState is the position of an ansiStripper within an escape sequence.
+type ansiState int
+
+const (
+	ansiGround       ansiState = iota // ordinary text
+	ansiLead                          // after a 0xC2 byte that may start a C1 control
+	ansiEscape                        // after ESC
+	ansiIntermediate                  // in ESC + intermediate bytes, before the final byte
+	ansiCSI                           // in a control sequence, before the final byte
+	ansiString                        // in an OSC, DCS, SOS, PM or APC string
+	ansiStringEscape                  // after ESC in a string, possibly ST
+	ansiStringLead                    // after a 0xC2 byte in a string, possibly ST
+)
+
+// ansiStripper is a byte-level state machine that removes escape sequences
+// and control characters. It keeps its state between calls to strip, so
+// input may be split anywhere.
+type ansiStripper struct {
+	state ansiState
+}
+
+// strip appends src, with escape sequences and control characters removed,
+// to dst.
+func (s *ansiStripper) strip(dst, src []byte) []byte {
+	for _, b := range src {
+		dst = s.step(dst, b)
+	}
+	return dst
+}
+
+// flush appends any byte held back at the end of the input to dst and
+// resets the state. An unterminated escape sequence is dropped.
+func (s *ansiStripper) flush(dst []byte) []byte {
+	if s.state == ansiLead {
+		dst = append(dst, 0xC2)
+	}
+	s.state = ansiGround
+	return dst
+}
+
+// step consumes one byte.
+func (s *ansiStripper) step(dst []byte, b byte) []byte {
+	switch s.state {
+	case ansiGround:
+		switch {
+		case b == 0x1B:
+			s.state = ansiEscape
+		case b == 0xC2:
+			// C1 controls U+0080-U+009F are encoded as 0xC2 0x80-0x9F
+			s.state = ansiLead
+		case b == '\n' || b == '\t':
+			dst = append(dst, b)
+		case b < 0x20 || b == 0x7F:
+			// drop other C0 controls and DEL
+		default:
+			dst = append(dst, b)
+		}
+
+	case ansiLead:
+		if b >= 0x80 && b <= 0x9F {
+			s.state = c1State(b)
+			return dst
+		}
+		s.state = ansiGround
+		return s.step(append(dst, 0xC2), b)
+
+	case ansiEscape:
+		switch {
+		case b == '[':
+			s.state = ansiCSI
+		case b == ']' || b == 'P' || b == 'X' || b == '^' || b == '_':
+			s.state = ansiString
+		case b == 0x1B:
+			// ESC ESC restarts the sequence
+		case b >= 0x20 && b <= 0x2F:
+			s.state = ansiIntermediate
+		case b >= 0x30 && b <= 0x7E:
+			s.state = ansiGround
+		default:
+			s.state = ansiGround
+			return s.step(dst, b)
+		}
+
+	case ansiIntermediate:
+		switch {
+		case b >= 0x20 && b <= 0x2F:
+		case b >= 0x30 && b <= 0x7E:
+			s.state = ansiGround
+		default:
+			s.state = ansiGround
+			return s.step(dst, b)
+		}
+
+	case ansiCSI:
+		switch {
+		case b == 0x1B:
+			s.state = ansiEscape
+		case b >= 0x40 && b <= 0x7E:
+			s.state = ansiGround
+		case b < 0x40:
+			// parameter and intermediate bytes, and controls embedded in
+			// the sequence
+		default:
+			s.state = ansiGround
+			return s.step(dst, b)
+		}
+
+	case ansiString:
+		switch b {
+		case 0x07:
+			// BEL terminates OSC strings in common use
+			s.state = ansiGround
+		case 0x1B:
+			s.state = ansiStringEscape
+		case 0xC2:
+			s.state = ansiStringLead
+		}
+
+	case ansiStringEscape:
+		if b == '\\' {
+			s.state = ansiGround
+			return dst
+		}
+		// an unterminated string followed by a new escape sequence
+		s.state = ansiEscape
+		return s.step(dst, b)
+
+	case ansiStringLead:
+		if b == 0x9C {
+			s.state = ansiGround
+			return dst
+		}
+		s.state = ansiString
+		return s.step(dst, b)
+	}
+	return dst
+}
+
+// c1State returns the state after the C1 control 0xC2 b.
+func c1State(b byte) ansiState {
+	switch b {
+	case 0x9B: // CSI
+		return ansiCSI
+	case 0x90, 0x98, 0x9D, 0x9E, 0x9F: // DCS, SOS, OSC, PM, APC
+		return ansiString
+	default:
+		return ansiGround
+	}
+}
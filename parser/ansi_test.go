@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ansiCases = []struct {
+	name string
+	in   string
+	want string
+}{
+	{"plain", "80/tcp open http", "80/tcp open http"},
+	{"sgr", "\x1b[1;32m[+]\x1b[0m found", "[+] found"},
+	{"256 color", "\x1b[38;5;196mcritical\x1b[m", "critical"},
+	{"cursor movement", "\x1b[2K\x1b[1Gdone\x1b[?25h", "done"},
+	{"osc title bel", "\x1b]0;scanner\x07out", "out"},
+	{"osc hyperlink st", "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+	{"charset", "\x1b(Bline", "line"},
+	{"two char escape", "\x1b7saved\x1b8", "saved"},
+	{"c1 csi", "\u009b31mred\u009b0m", "red"},
+	{"c1 control", "a\u0085b", "ab"},
+	{"controls", "a\x00b\x08c\rd\x7f", "abcd"},
+	{"newlines and tabs", "a\tb\r\nc\n", "a\tb\nc\n"},
+	{"utf8", "\x1b[33mné ✓ – ü\x1b[0m", "né ✓ – ü"},
+	{"dcs", "\x1bPq#0;2;0;0;0\x1b\\after", "after"},
+	{"unterminated", "text\x1b[31", "text"},
+}
+
+func TestStripANSI(t *testing.T) {
+	for _, tt := range ansiCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, StripANSI(tt.in))
+		})
+	}
+}
+
+func TestStripANSIReader(t *testing.T) {
+	for _, tt := range ansiCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// one byte per read splits every sequence and multi-byte rune
+			got, err := io.ReadAll(StripANSIReader(iotest.OneByteReader(strings.NewReader(tt.in))))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestStripANSIReader_SmallBuffer(t *testing.T) {
+	r := StripANSIReader(strings.NewReader("\x1b[1m" + strings.Repeat("x", 10000) + "\x1b[0m"))
+	got, err := io.ReadAll(iotest.HalfReader(r))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("x", 10000), string(got))
+}
@@ -0,0 +1,344 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/schema"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrMethodNotFound is returned when a plugin does not provide the called
+// method.
+var ErrMethodNotFound = errors.New("plugin method not found")
+
+// SchemaMismatchError is returned when a plugin result does not match the
+// method's advertised output schema, or does not fit the type requested with
+// CallAs.
+type SchemaMismatchError struct {
+	// Plugin and Method identify the call.
+	Plugin string
+	Method string
+
+	// Fields describes each offending field as "path: problem". The path is
+	// dotted, with array indexes in brackets; "(result)" is the result itself.
+	Fields []string
+}
+
+// Error lists the offending fields.
+func (e *SchemaMismatchError) Error() string {
+	return fmt.Sprintf("plugin %s method %s: result does not match schema: %s",
+		e.Plugin, e.Method, strings.Join(e.Fields, "; "))
+}
+
+// Client calls the methods of a plugin served over gRPC (see serve.Plugin).
+// It fetches the plugin's method descriptors on first use and caches them;
+// the cache is refreshed when the plugin reports a method as unknown or the
+// connection reports the service as unimplemented.
+//
+// A Client is safe for concurrent use. It never dials or closes the
+// connection, so several clients may share one connection.
+type Client struct {
+	name   string
+	client proto.PluginServiceClient
+
+	mu      sync.Mutex
+	methods map[string]MethodDescriptor
+}
+
+// NewClient creates a client for the plugin served on conn. The name is used
+// in error messages.
+//
+// Example:
+//
+//	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+//	if err != nil {
+//	    return err
+//	}
+//	defer conn.Close()
+//
+//	client := plugin.NewClient(conn, "cve-lookup")
+//	cve, err := plugin.CallAs[CVE](ctx, client, "get", map[string]any{"id": "CVE-2024-3094"})
+func NewClient(conn grpc.ClientConnInterface, pluginName string) *Client {
+	return &Client{
+		name:   pluginName,
+		client: proto.NewPluginServiceClient(conn),
+	}
+}
+
+// Methods returns the plugin's method descriptors, fetching them if they are
+// not cached.
+func (c *Client) Methods(ctx context.Context) ([]MethodDescriptor, error) {
+	methods, err := c.descriptors(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]MethodDescriptor, 0, len(methods))
+	for _, m := range methods {
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// Call invokes method with params and returns the decoded JSON result.
+func (c *Client) Call(ctx context.Context, method string, params map[string]any) (any, error) {
+	desc, err := c.method(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := c.query(ctx, desc, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("plugin %s method %s: decode result: %w", c.name, method, err)
+	}
+	return result, nil
+}
+
+// CallAs invokes method with params and decodes the result into T.
+//
+// T is checked against the method's advertised output schema before the
+// call, so that a wrong type parameter fails with a *SchemaMismatchError
+// naming the offending fields without invoking the method. The result is
+// checked against the schema too.
+func CallAs[T any](ctx context.Context, c *Client, method string, params map[string]any) (T, error) {
+	var zero T
+
+	desc, err := c.method(ctx, method)
+	if err != nil {
+		return zero, err
+	}
+	if fields := typeMismatches(desc.OutputSchema, schema.FromType(zero), ""); len(fields) > 0 {
+		return zero, &SchemaMismatchError{Plugin: c.name, Method: method, Fields: fields}
+	}
+
+	raw, err := c.query(ctx, desc, params)
+	if err != nil {
+		return zero, err
+	}
+
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return zero, fmt.Errorf("plugin %s method %s: decode result: %w", c.name, method, err)
+	}
+	if fields := schemaMismatches(desc.OutputSchema, value, ""); len(fields) > 0 {
+		return zero, &SchemaMismatchError{Plugin: c.name, Method: method, Fields: fields}
+	}
+
+	var result T
+	if err := json.Unmarshal(raw, &result); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return zero, &SchemaMismatchError{
+				Plugin: c.name,
+				Method: method,
+				Fields: []string{fmt.Sprintf("%s: cannot decode JSON %s into %s",
+					fieldPath(typeErr.Field), typeErr.Value, typeErr.Type)},
+			}
+		}
+		return zero, fmt.Errorf("plugin %s method %s: decode result: %w", c.name, method, err)
+	}
+	return result, nil
+}
+
+// query invokes the method described by desc and returns the raw JSON
+// result.
+func (c *Client) query(ctx context.Context, desc MethodDescriptor, params map[string]any) ([]byte, error) {
+	method := desc.Name
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s method %s: encode params: %w", c.name, method, err)
+	}
+
+	resp, err := c.client.Query(ctx, &proto.PluginQueryRequest{
+		Method:     method,
+		ParamsJson: string(paramsJSON),
+	})
+	if err != nil {
+		if code := status.Code(err); code == codes.Unimplemented || code == codes.NotFound {
+			c.invalidate()
+		}
+		return nil, fmt.Errorf("plugin %s method %s: %w", c.name, method, err)
+	}
+
+	if resp.Error != nil {
+		if strings.HasPrefix(resp.Error.Message, "method not found") {
+			// The plugin changed since the descriptors were fetched
+			c.invalidate()
+			return nil, fmt.Errorf("plugin %s method %s: %w", c.name, method, ErrMethodNotFound)
+		}
+		return nil, fmt.Errorf("plugin %s method %s: %s", c.name, method, resp.Error.Message)
+	}
+
+	if resp.ResultJson == "" {
+		return []byte("null"), nil
+	}
+	return []byte(resp.ResultJson), nil
+}
+
+// method returns the descriptor of method, refreshing the cached
+// descriptors once if the method is unknown.
+func (c *Client) method(ctx context.Context, method string) (MethodDescriptor, error) {
+	for _, refresh := range []bool{false, true} {
+		methods, err := c.descriptors(ctx, refresh)
+		if err != nil {
+			return MethodDescriptor{}, err
+		}
+		if desc, ok := methods[method]; ok {
+			return desc, nil
+		}
+	}
+	return MethodDescriptor{}, fmt.Errorf("plugin %s method %s: %w", c.name, method, ErrMethodNotFound)
+}
+
+// descriptors returns the cached method descriptors, fetching them if they
+// are not cached or refresh is set.
+func (c *Client) descriptors(ctx context.Context, refresh bool) (map[string]MethodDescriptor, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.methods != nil && !refresh {
+		return c.methods, nil
+	}
+
+	resp, err := c.client.ListMethods(ctx, &proto.PluginListMethodsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: list methods: %w", c.name, err)
+	}
+
+	methods := make(map[string]MethodDescriptor, len(resp.Methods))
+	for _, m := range resp.Methods {
+		desc := MethodDescriptor{
+			Name:        m.Name,
+			Description: m.Description,
+		}
+		if err := decodeSchema(m.InputSchema, &desc.InputSchema); err != nil {
+			return nil, fmt.Errorf("plugin %s method %s: decode input schema: %w", c.name, m.Name, err)
+		}
+		if err := decodeSchema(m.OutputSchema, &desc.OutputSchema); err != nil {
+			return nil, fmt.Errorf("plugin %s method %s: decode output schema: %w", c.name, m.Name, err)
+		}
+		methods[m.Name] = desc
+	}
+
+	c.methods = methods
+	return methods, nil
+}
+
+// invalidate drops the cached descriptors.
+func (c *Client) invalidate() {
+	c.mu.Lock()
+	c.methods = nil
+	c.mu.Unlock()
+}
+
+// decodeSchema decodes a schema sent by the plugin server. A missing schema
+// accepts anything.
+func decodeSchema(s *proto.JSONSchema, out *schema.JSON) error {
+	if s == nil || s.Json == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(s.Json), out)
+}
+
+// schemaMismatches returns the fields of value that violate s.
+func schemaMismatches(s schema.JSON, value any, path string) []string {
+	err := s.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	// Narrow the error down to the offending fields where possible
+	var fields []string
+	switch v := value.(type) {
+	case map[string]any:
+		if s.Type == "object" {
+			for _, name := range s.Required {
+				if _, ok := v[name]; !ok {
+					fields = append(fields, fieldPath(joinPath(path, name))+": required field is missing")
+				}
+			}
+			for _, name := range sortedKeys(s.Properties) {
+				if fv, ok := v[name]; ok {
+					fields = append(fields, schemaMismatches(s.Properties[name], fv, joinPath(path, name))...)
+				}
+			}
+		}
+	case []any:
+		if s.Type == "array" && s.Items != nil {
+			for i, item := range v {
+				fields = append(fields, schemaMismatches(*s.Items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+	if len(fields) == 0 {
+		fields = []string{fmt.Sprintf("%s: %v", fieldPath(path), err)}
+	}
+	return fields
+}
+
+// typeMismatches returns the fields whose type in target, a schema generated
+// from a Go type, cannot hold the type advertised in s.
+func typeMismatches(s, target schema.JSON, path string) []string {
+	if s.Type == "" || target.Type == "" {
+		return nil
+	}
+	if s.Type != target.Type && !(s.Type == "integer" && target.Type == "number") {
+		return []string{fmt.Sprintf("%s: plugin returns %s, requested type has %s", fieldPath(path), s.Type, target.Type)}
+	}
+
+	var fields []string
+	switch s.Type {
+	case "object":
+		for _, name := range sortedKeys(s.Properties) {
+			if targetProp, ok := target.Properties[name]; ok {
+				fields = append(fields, typeMismatches(s.Properties[name], targetProp, joinPath(path, name))...)
+			}
+		}
+	case "array":
+		if s.Items != nil && target.Items != nil {
+			fields = append(fields, typeMismatches(*s.Items, *target.Items, path+"[]")...)
+		}
+	}
+	return fields
+}
+
+// joinPath appends a field name to a dotted path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// fieldPath returns path, or "(result)" for the result itself.
+func fieldPath(path string) string {
+	if path == "" {
+		return "(result)"
+	}
+	return path
+}
+
+// sortedKeys returns the keys of props in order, for stable error messages.
+func sortedKeys(props map[string]schema.JSON) []string {
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -70,6 +70,19 @@
 //	// Shutdown when done
 //	err = p.Shutdown(ctx)
 //
+// # Calling a Served Plugin
+//
+// A plugin served with serve.Plugin can be called from another process with
+// Client. CallAs decodes the result into a Go type, after checking the type
+// against the method's output schema:
+//
+//	client := plugin.NewClient(conn, "cve-lookup")
+//	cve, err := plugin.CallAs[CVE](ctx, client, "get", map[string]any{"id": id})
+//	var mismatch *plugin.SchemaMismatchError
+//	if errors.As(err, &mismatch) {
+//	    log.Printf("unexpected result fields: %v", mismatch.Fields)
+//	}
+//
 // # Schema Validation
 //
 // All method inputs and outputs are validated against their JSON schemas.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -21,6 +22,9 @@ type Client interface {
 	// Blocks until an item is available or context is cancelled.
 	Pop(ctx context.Context, queue string) (*WorkItem, error)
 
+	// QueueDepth returns the number of work items waiting in a queue (LLEN).
+	QueueDepth(ctx context.Context, queue string) (int64, error)
+
 	// Publish sends a result to a pub/sub channel.
 	Publish(ctx context.Context, channel string, result Result) error
 
@@ -28,6 +32,22 @@ type Client interface {
 	// Returns a channel that receives results until the subscription is closed.
 	Subscribe(ctx context.Context, channel string) (<-chan Result, error)
 
+	// PublishResult delivers a job result using the configured ResultDelivery
+	// mode, either to the results:<jobID> channel or the job's result stream.
+	PublishResult(ctx context.Context, jobID string, result Result) error
+
+	// PublishStream appends a result to the job's result stream (XADD).
+	PublishStream(ctx context.Context, jobID string, result Result) error
+
+	// SubscribeGroup reads the job's result stream as a member of a consumer
+	// group (XREADGROUP). Each result is delivered to one consumer in the
+	// group and acknowledged once received from the returned channel.
+	SubscribeGroup(ctx context.Context, jobID, group, consumer string) (<-chan Result, error)
+
+	// ClaimStale claims and acknowledges results that consumers in the group
+	// read but did not acknowledge within minIdle (XAUTOCLAIM).
+	ClaimStale(ctx context.Context, jobID, group string, minIdle time.Duration) ([]Result, error)
+
 	// RegisterTool writes tool metadata to Redis and adds to available set.
 	RegisterTool(ctx context.Context, meta ToolMeta) error
 
@@ -66,11 +86,27 @@ type RedisOptions struct {
 
 	// WriteTimeout is the maximum time to wait for write operations
 	WriteTimeout time.Duration
+
+	// ResultDelivery selects how PublishResult delivers results.
+	// Defaults to ResultDeliveryPubSub.
+	ResultDelivery ResultDelivery
+
+	// CheckMessageTypes makes Push look up the target tool's registered
+	// metadata and reject work items whose InputType or OutputType differs
+	// from the tool's message types. Work for unregistered tools is pushed
+	// unchecked.
+	CheckMessageTypes bool
 }
 
+// ErrMessageTypeMismatch is returned by Push when CheckMessageTypes is
+// enabled and a work item's message types do not match the registered tool.
+var ErrMessageTypeMismatch = errors.New("message type mismatch")
+
 // RedisClient implements the Client interface using go-redis/v9.
 type RedisClient struct {
-	client *redis.Client
+	client     *redis.Client
+	delivery   ResultDelivery
+	checkTypes bool
 }
 
 // NewRedisClient creates a new Redis queue client with the given options.
@@ -91,6 +127,14 @@ func NewRedisClient(opts RedisOptions) (*RedisClient, error) {
 		opts.WriteTimeout = 5 * time.Second
 	}
 
+	switch opts.ResultDelivery {
+	case "":
+		opts.ResultDelivery = ResultDeliveryPubSub
+	case ResultDeliveryPubSub, ResultDeliveryStream:
+	default:
+		return nil, fmt.Errorf("invalid result delivery mode %q", opts.ResultDelivery)
+	}
+
 	redisOpts, err := redis.ParseURL(opts.URL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse Redis URL: %w", err)
@@ -111,11 +155,20 @@ func NewRedisClient(opts RedisOptions) (*RedisClient, error) {
 		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
 	}
 
-	return &RedisClient{client: client}, nil
+	return &RedisClient{client: client, delivery: opts.ResultDelivery, checkTypes: opts.CheckMessageTypes}, nil
 }
 
-// Push adds a work item to the end of a queue.
+// Push adds a work item to the end of a queue. With CheckMessageTypes
+// enabled, it first checks the item's message types against the tool's
+// registered metadata and returns an error wrapping ErrMessageTypeMismatch
+// on a mismatch.
 func (c *RedisClient) Push(ctx context.Context, queue string, item WorkItem) error {
+	if c.checkTypes {
+		if err := c.checkMessageTypes(ctx, item); err != nil {
+			return err
+		}
+	}
+
 	data, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal work item: %w", err)
@@ -128,6 +181,30 @@ func (c *RedisClient) Push(ctx context.Context, queue string, item WorkItem) err
 	return nil
 }
 
+// checkMessageTypes compares the message types of a work item with the
+// types its tool registered. Empty types on either side are not compared.
+func (c *RedisClient) checkMessageTypes(ctx context.Context, item WorkItem) error {
+	metaKey := fmt.Sprintf("tool:%s:meta", item.Tool)
+	types, err := c.client.HMGet(ctx, metaKey, "input_type", "output_type").Result()
+	if err != nil {
+		return fmt.Errorf("failed to look up tool %s: %w", item.Tool, err)
+	}
+
+	registered := func(i int) string {
+		s, _ := types[i].(string)
+		return s
+	}
+	if want := registered(0); want != "" && item.InputType != "" && item.InputType != want {
+		return fmt.Errorf("%w: work item for tool %s has input type %s, tool expects %s",
+			ErrMessageTypeMismatch, item.Tool, item.InputType, want)
+	}
+	if want := registered(1); want != "" && item.OutputType != "" && item.OutputType != want {
+		return fmt.Errorf("%w: work item for tool %s has output type %s, tool produces %s",
+			ErrMessageTypeMismatch, item.Tool, item.OutputType, want)
+	}
+	return nil
+}
+
 // Pop removes and returns a work item from the front of a queue.
 // Blocks until an item is available or context is cancelled.
 func (c *RedisClient) Pop(ctx context.Context, queue string) (*WorkItem, error) {
@@ -152,6 +229,15 @@ func (c *RedisClient) Pop(ctx context.Context, queue string) (*WorkItem, error)
 	return &item, nil
 }
 
+// QueueDepth returns the number of work items waiting in a queue.
+func (c *RedisClient) QueueDepth(ctx context.Context, queue string) (int64, error) {
+	depth, err := c.client.LLen(ctx, queue).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get depth of queue %s: %w", queue, err)
+	}
+	return depth, nil
+}
+
 // Publish sends a result to a pub/sub channel.
 func (c *RedisClient) Publish(ctx context.Context, channel string, result Result) error {
 	data, err := json.Marshal(result)
@@ -220,6 +220,103 @@ func TestPushPop(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, item.InputJSON, popped.InputJSON)
 	})
+
+	t.Run("queue depth", func(t *testing.T) {
+		client, _ := setupTestClient(t)
+		ctx := context.Background()
+
+		depth, err := client.QueueDepth(ctx, "depth-queue")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), depth, "a missing queue is empty")
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, client.Push(ctx, "depth-queue", WorkItem{JobID: "job-1", Index: i}))
+		}
+		_, err = client.Pop(ctx, "depth-queue")
+		require.NoError(t, err)
+
+		depth, err = client.QueueDepth(ctx, "depth-queue")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), depth)
+	})
+}
+
+// TestPushMessageTypeCheck tests that Push rejects work items whose message
+// types do not match the registered tool when CheckMessageTypes is enabled.
+func TestPushMessageTypeCheck(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client, err := NewRedisClient(RedisOptions{
+		URL:               fmt.Sprintf("redis://%s", mr.Addr()),
+		CheckMessageTypes: true,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+
+	require.NoError(t, client.RegisterTool(ctx, ToolMeta{
+		Name:              "nmap",
+		InputMessageType:  "gibson.tools.nmap.v1.ScanRequest",
+		OutputMessageType: "gibson.tools.nmap.v1.ScanResponse",
+	}))
+
+	item := func(tool, inputType, outputType string) WorkItem {
+		return WorkItem{
+			JobID:       "job-123",
+			Total:       1,
+			Tool:        tool,
+			InputJSON:   `{"targets":["10.0.0.1"]}`,
+			InputType:   inputType,
+			OutputType:  outputType,
+			SubmittedAt: time.Now().UnixMilli(),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		item    WorkItem
+		wantErr string
+	}{
+		{
+			name: "matching types",
+			item: item("nmap", "gibson.tools.nmap.v1.ScanRequest", "gibson.tools.nmap.v1.ScanResponse"),
+		},
+		{
+			name:    "wrong input type",
+			item:    item("nmap", "gibson.tools.httpx.v1.ProbeRequest", "gibson.tools.nmap.v1.ScanResponse"),
+			wantErr: "work item for tool nmap has input type gibson.tools.httpx.v1.ProbeRequest, tool expects gibson.tools.nmap.v1.ScanRequest",
+		},
+		{
+			name:    "wrong output type",
+			item:    item("nmap", "gibson.tools.nmap.v1.ScanRequest", "gibson.tools.httpx.v1.ProbeResponse"),
+			wantErr: "tool produces gibson.tools.nmap.v1.ScanResponse",
+		},
+		{
+			name: "unregistered tool",
+			item: item("httpx", "gibson.tools.httpx.v1.ProbeRequest", "gibson.tools.httpx.v1.ProbeResponse"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.Push(ctx, "tool:"+tt.item.Tool+":queue", tt.item)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrMessageTypeMismatch)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	length, err := client.client.LLen(ctx, "tool:nmap:queue").Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), length, "rejected work items are not queued")
+
+	t.Run("disabled by default", func(t *testing.T) {
+		unchecked, _ := setupTestClient(t)
+		require.NoError(t, unchecked.RegisterTool(ctx, ToolMeta{Name: "nmap", InputMessageType: "gibson.tools.nmap.v1.ScanRequest"}))
+		assert.NoError(t, unchecked.Push(ctx, "tool:nmap:queue", item("nmap", "gibson.tools.httpx.v1.ProbeRequest", "")))
+	})
 }
 
 // TestPublishSubscribe tests pub/sub operations.
@@ -897,6 +994,63 @@ func TestClose(t *testing.T) {
 	})
 }
 
+// TestPublishResult tests that results are routed by delivery mode.
+func TestPublishResult(t *testing.T) {
+	ctx := context.Background()
+	result := Result{JobID: "job-1", Index: 0, OutputJSON: `{"ok":true}`}
+
+	t.Run("pubsub by default", func(t *testing.T) {
+		client, mr := setupTestClient(t)
+
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		results, err := client.Subscribe(subCtx, "results:job-1")
+		require.NoError(t, err)
+		time.Sleep(50 * time.Millisecond)
+
+		require.NoError(t, client.PublishResult(ctx, "job-1", result))
+
+		select {
+		case got := <-results:
+			assert.Equal(t, result, got)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for result")
+		}
+		assert.False(t, mr.Exists("results:job-1:stream"))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		mr := miniredis.RunT(t)
+		client, err := NewRedisClient(RedisOptions{
+			URL:            fmt.Sprintf("redis://%s", mr.Addr()),
+			ResultDelivery: ResultDeliveryStream,
+		})
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.PublishResult(ctx, "job-1", result))
+
+		entries, err := mr.Stream("results:job-1:stream")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		var got Result
+		require.Len(t, entries[0].Values, 2)
+		assert.Equal(t, "result", entries[0].Values[0])
+		require.NoError(t, json.Unmarshal([]byte(entries[0].Values[1]), &got))
+		assert.Equal(t, result, got)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		mr := miniredis.RunT(t)
+		_, err := NewRedisClient(RedisOptions{
+			URL:            fmt.Sprintf("redis://%s", mr.Addr()),
+			ResultDelivery: "carrier-pigeon",
+		})
+		assert.Error(t, err)
+	})
+}
+
 // TestRealWorldScenarios tests realistic usage patterns.
 func TestRealWorldScenarios(t *testing.T) {
 	t.Run("complete workflow: worker lifecycle and job processing", func(t *testing.T) {
@@ -27,6 +27,7 @@
 //   - tool:<name>:workers - Integer counter for active workers
 //   - tools:available - Set of all registered tool names
 //   - results:<jobID> - Pub/Sub channel for job results
+//   - results:<jobID>:stream - Stream for job results delivered to consumer groups
 //
 // # Usage
 //
@@ -50,6 +51,11 @@
 //		SubmittedAt: time.Now().UnixMilli(),
 //	})
 //
+// To catch wiring mistakes at submission time rather than in the worker, set
+// RedisOptions.CheckMessageTypes: Push then rejects work items whose
+// InputType or OutputType differs from the registered tool's message types
+// with an error wrapping ErrMessageTypeMismatch.
+//
 // Popping work from a queue (blocking):
 //
 //	item, err := client.Pop(ctx, "tool:nmap:queue")
@@ -104,6 +110,31 @@
 //		}
 //	}
 //
+// # Consumer Groups
+//
+// Pub/sub delivers every result to every subscriber, so replicated daemons
+// would each process every result. With ResultDelivery set to
+// ResultDeliveryStream, PublishResult appends results to the job's stream
+// instead, and daemons sharing a consumer group split them between
+// themselves:
+//
+//	client, err := queue.NewRedisClient(queue.RedisOptions{
+//		URL:            "redis://localhost:6379",
+//		ResultDelivery: queue.ResultDeliveryStream,
+//	})
+//
+//	results, err := client.SubscribeGroup(ctx, "job-123", "daemons", hostname)
+//	for result := range results {
+//		// Acknowledged once received; process result...
+//	}
+//
+// Delivery is at-least-once. Results read by a consumer that crashed before
+// receiving them stay pending; another daemon recovers them with ClaimStale:
+//
+//	stale, err := client.ClaimStale(ctx, "job-123", "daemons", time.Minute)
+//
+// The queuetest package contains conformance tests for Client implementations.
+//
 // # Error Handling
 //
 // All methods return errors for Redis connection failures, serialization
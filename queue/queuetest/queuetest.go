@@ -0,0 +1,217 @@
+// Package queuetest provides conformance tests for queue.Client
+// implementations.
+//
+// Run the suite from a test in the implementation's package, passing a
+// factory that returns a client connected to an empty backend:
+//
+//	func TestConformance(t *testing.T) {
+//		queuetest.RunResultStreamTests(t, func(t *testing.T) queue.Client {
+//			mr := miniredis.RunT(t)
+//			client, err := queue.NewRedisClient(queue.RedisOptions{URL: "redis://" + mr.Addr()})
+//			require.NoError(t, err)
+//			t.Cleanup(func() { client.Close() })
+//			return client
+//		})
+//	}
+package queuetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/queue"
+)
+
+// Factory returns a client connected to an empty backend. Clients returned
+// within one test share the backend.
+type Factory func(t *testing.T) queue.Client
+
+const (
+	// waitTimeout bounds how long a test waits for results.
+	waitTimeout = 10 * time.Second
+
+	// quietPeriod is how long a test waits to make sure no further results
+	// arrive.
+	quietPeriod = 200 * time.Millisecond
+
+	// staleIdle is the idle time after which pending results count as stale.
+	staleIdle = 100 * time.Millisecond
+)
+
+// RunResultStreamTests runs the consumer group result delivery conformance
+// tests: competing consumers, independent groups, and recovery of results
+// left pending by a crashed consumer.
+func RunResultStreamTests(t *testing.T, newClient Factory) {
+	t.Run("CompetingConsumers", func(t *testing.T) {
+		testCompetingConsumers(t, newClient(t))
+	})
+	t.Run("IndependentGroups", func(t *testing.T) {
+		testIndependentGroups(t, newClient(t))
+	})
+	t.Run("ReclaimAfterCrash", func(t *testing.T) {
+		testReclaimAfterCrash(t, newClient(t))
+	})
+	t.Run("RedeliverOnResubscribe", func(t *testing.T) {
+		testRedeliverOnResubscribe(t, newClient(t))
+	})
+}
+
+// testCompetingConsumers checks that two consumers in one group receive
+// every result, and each result only once.
+func testCompetingConsumers(t *testing.T, client queue.Client) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const jobID, total = "job-competing", 20
+	first, err := client.SubscribeGroup(ctx, jobID, "daemons", "daemon-1")
+	require.NoError(t, err)
+	second, err := client.SubscribeGroup(ctx, jobID, "daemons", "daemon-2")
+	require.NoError(t, err)
+
+	publish(t, client, jobID, total)
+
+	var (
+		mu     sync.Mutex
+		counts = map[int]int{}
+		seen   = 0
+		done   = make(chan struct{})
+	)
+	consume := func(results <-chan queue.Result) {
+		for result := range results {
+			mu.Lock()
+			counts[result.Index]++
+			seen++
+			if seen == total {
+				close(done)
+			}
+			mu.Unlock()
+		}
+	}
+	go consume(first)
+	go consume(second)
+
+	select {
+	case <-done:
+	case <-time.After(waitTimeout):
+		t.Fatalf("timed out waiting for %d results", total)
+	}
+
+	// Give a duplicate delivery the chance to show up
+	time.Sleep(quietPeriod)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, total, seen, "each result is delivered once")
+	for i := 0; i < total; i++ {
+		assert.Equal(t, 1, counts[i], "result %d", i)
+	}
+}
+
+// testIndependentGroups checks that each consumer group receives every result.
+func testIndependentGroups(t *testing.T, client queue.Client) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const jobID, total = "job-groups", 5
+	publish(t, client, jobID, total)
+
+	for _, group := range []string{"daemons", "auditors"} {
+		results, err := client.SubscribeGroup(ctx, jobID, group, "consumer")
+		require.NoError(t, err)
+
+		got := receive(t, results, total)
+		for i, result := range got {
+			assert.Equal(t, i, result.Index, "group %s", group)
+		}
+	}
+}
+
+// testReclaimAfterCrash checks that results read by a consumer that stopped
+// before receiving them are recovered by ClaimStale once idle long enough.
+func testReclaimAfterCrash(t *testing.T, client queue.Client) {
+	const jobID, total = "job-crash", 3
+	publish(t, client, jobID, total)
+
+	// The consumer reads all results but only processes the first
+	ctx, crash := context.WithCancel(context.Background())
+	results, err := client.SubscribeGroup(ctx, jobID, "daemons", "daemon-1")
+	require.NoError(t, err)
+	got := receive(t, results, 1)
+	assert.Equal(t, 0, got[0].Index)
+	crash()
+
+	bg := context.Background()
+	claimed, err := client.ClaimStale(bg, jobID, "daemons", time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, claimed, "results are not stale before minIdle")
+
+	time.Sleep(2 * staleIdle)
+
+	claimed, err = client.ClaimStale(bg, jobID, "daemons", staleIdle)
+	require.NoError(t, err)
+	require.Len(t, claimed, total-1)
+	assert.Equal(t, 1, claimed[0].Index)
+	assert.Equal(t, 2, claimed[1].Index)
+
+	claimed, err = client.ClaimStale(bg, jobID, "daemons", 0)
+	require.NoError(t, err)
+	assert.Empty(t, claimed, "claimed results are acknowledged")
+}
+
+// testRedeliverOnResubscribe checks that a consumer restarting under the same
+// name receives the results it read but never received.
+func testRedeliverOnResubscribe(t *testing.T, client queue.Client) {
+	const jobID, total = "job-restart", 3
+	publish(t, client, jobID, total)
+
+	ctx, crash := context.WithCancel(context.Background())
+	results, err := client.SubscribeGroup(ctx, jobID, "daemons", "daemon-1")
+	require.NoError(t, err)
+	receive(t, results, 1)
+	crash()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results, err = client.SubscribeGroup(ctx, jobID, "daemons", "daemon-1")
+	require.NoError(t, err)
+
+	got := receive(t, results, total-1)
+	assert.Equal(t, 1, got[0].Index)
+	assert.Equal(t, 2, got[1].Index)
+}
+
+// publish adds total results for jobID to its stream.
+func publish(t *testing.T, client queue.Client, jobID string, total int) {
+	t.Helper()
+	for i := 0; i < total; i++ {
+		err := client.PublishStream(context.Background(), jobID, queue.Result{
+			JobID:       jobID,
+			Index:       i,
+			OutputJSON:  fmt.Sprintf(`{"index":%d}`, i),
+			WorkerID:    "worker-1",
+			CompletedAt: time.Now().UnixMilli(),
+		})
+		require.NoError(t, err)
+	}
+}
+
+// receive reads n results from results, failing the test on timeout.
+func receive(t *testing.T, results <-chan queue.Result, n int) []queue.Result {
+	t.Helper()
+	got := make([]queue.Result, 0, n)
+	for len(got) < n {
+		select {
+		case result, ok := <-results:
+			require.True(t, ok, "results channel closed after %d of %d results", len(got), n)
+			got = append(got, result)
+		case <-time.After(waitTimeout):
+			t.Fatalf("timed out after %d of %d results", len(got), n)
+		}
+	}
+	return got
+}
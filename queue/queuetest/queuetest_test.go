@@ -0,0 +1,24 @@
+package queuetest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/queue"
+	"github.com/zero-day-ai/sdk/queue/queuetest"
+)
+
+func TestRedisClientResultStreams(t *testing.T) {
+	queuetest.RunResultStreamTests(t, func(t *testing.T) queue.Client {
+		mr := miniredis.RunT(t)
+		client, err := queue.NewRedisClient(queue.RedisOptions{
+			URL:            fmt.Sprintf("redis://%s", mr.Addr()),
+			ResultDelivery: queue.ResultDeliveryStream,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = client.Close() })
+		return client
+	})
+}
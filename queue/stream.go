@@ -0,0 +1,211 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ResultDelivery selects how workers deliver results to the daemon.
+type ResultDelivery string
+
+const (
+	// ResultDeliveryPubSub publishes results to the results:<jobID> pub/sub
+	// channel. Every subscriber receives every result. This is the default.
+	ResultDeliveryPubSub ResultDelivery = "pubsub"
+
+	// ResultDeliveryStream appends results to the results:<jobID>:stream
+	// Redis Stream. Subscribers in the same consumer group share the results,
+	// so replicated daemons process each result once, and results of crashed
+	// consumers can be reclaimed with ClaimStale.
+	ResultDeliveryStream ResultDelivery = "stream"
+)
+
+const (
+	// streamReadCount is the maximum number of entries read per XREADGROUP.
+	streamReadCount = 10
+
+	// streamBlock is how long XREADGROUP waits for new entries before the
+	// subscription loop checks for cancellation again.
+	streamBlock = time.Second
+
+	// claimConsumer is the consumer that ClaimStale moves stale entries to.
+	claimConsumer = "claim-stale"
+)
+
+// resultStreamKey returns the stream key for a job's results.
+func resultStreamKey(jobID string) string {
+	return formatKeyName("results", jobID, "stream")
+}
+
+// resultField is the stream entry field holding the JSON-encoded result.
+const resultField = "result"
+
+// PublishResult delivers a job result using the client's configured
+// ResultDelivery mode: Publish to results:<jobID>, or PublishStream.
+func (c *RedisClient) PublishResult(ctx context.Context, jobID string, result Result) error {
+	if c.delivery == ResultDeliveryStream {
+		return c.PublishStream(ctx, jobID, result)
+	}
+	return c.Publish(ctx, formatKeyName("results", jobID), result)
+}
+
+// PublishStream appends a result to the job's result stream (XADD).
+func (c *RedisClient) PublishStream(ctx context.Context, jobID string, result Result) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	key := resultStreamKey(jobID)
+	if err := c.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		Values: map[string]any{resultField: data},
+	}).Err(); err != nil {
+		return fmt.Errorf("failed to add result to stream %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// SubscribeGroup reads a job's result stream as consumer in a consumer
+// group, creating the group if needed. Consumers in the same group share
+// the results; each result goes to one of them. A new group starts at the
+// beginning of the stream, so results published before subscribing are
+// delivered too.
+//
+// A result is acknowledged once it has been received from the returned
+// channel. Results read but not yet delivered when ctx is cancelled stay
+// pending: resubscribing with the same consumer name delivers them again,
+// and ClaimStale recovers them for other consumers. Delivery is therefore
+// at-least-once.
+func (c *RedisClient) SubscribeGroup(ctx context.Context, jobID, group, consumer string) (<-chan Result, error) {
+	key := resultStreamKey(jobID)
+	if err := c.client.XGroupCreateMkStream(ctx, key, group, "0").Err(); err != nil && !isBusyGroup(err) {
+		return nil, fmt.Errorf("failed to create consumer group %s on %s: %w", group, key, err)
+	}
+
+	resultChan := make(chan Result)
+
+	go func() {
+		defer close(resultChan)
+
+		// Deliver this consumer's pending entries first, then new ones
+		lastID := "0"
+		for ctx.Err() == nil {
+			streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+				Group:    group,
+				Consumer: consumer,
+				Streams:  []string{key, lastID},
+				Count:    streamReadCount,
+				Block:    streamBlock,
+			}).Result()
+			if err != nil {
+				if errors.Is(err, redis.Nil) {
+					continue
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				// Back off on transient errors
+				select {
+				case <-time.After(streamBlock):
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			var messages []redis.XMessage
+			for _, s := range streams {
+				messages = append(messages, s.Messages...)
+			}
+			if lastID == "0" && len(messages) == 0 {
+				lastID = ">"
+				continue
+			}
+
+			for _, msg := range messages {
+				result, ok := decodeStreamResult(msg)
+				if ok {
+					select {
+					case resultChan <- result:
+					case <-ctx.Done():
+						return
+					}
+				}
+				// Undecodable entries are acknowledged too, so they are
+				// not redelivered forever
+				_ = c.client.XAck(context.WithoutCancel(ctx), key, group, msg.ID).Err()
+			}
+		}
+	}()
+
+	return resultChan, nil
+}
+
+// ClaimStale takes over results that were read by a consumer of group but
+// not acknowledged for at least minIdle, typically because the consumer
+// crashed. The claimed results are acknowledged and returned, so the caller
+// becomes responsible for processing them.
+func (c *RedisClient) ClaimStale(ctx context.Context, jobID, group string, minIdle time.Duration) ([]Result, error) {
+	key := resultStreamKey(jobID)
+
+	var results []Result
+	start := "0-0"
+	for {
+		messages, next, err := c.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   key,
+			Group:    group,
+			Consumer: claimConsumer,
+			MinIdle:  minIdle,
+			Start:    start,
+			Count:    100,
+		}).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim stale results from %s: %w", key, err)
+		}
+
+		ids := make([]string, 0, len(messages))
+		for _, msg := range messages {
+			if result, ok := decodeStreamResult(msg); ok {
+				results = append(results, result)
+			}
+			ids = append(ids, msg.ID)
+		}
+		if len(ids) > 0 {
+			if err := c.client.XAck(ctx, key, group, ids...).Err(); err != nil {
+				return nil, fmt.Errorf("failed to acknowledge claimed results on %s: %w", key, err)
+			}
+		}
+
+		if next == "" || next == "0-0" {
+			return results, nil
+		}
+		start = next
+	}
+}
+
+// decodeStreamResult decodes the result stored in a stream entry.
+func decodeStreamResult(msg redis.XMessage) (Result, bool) {
+	raw, ok := msg.Values[resultField].(string)
+	if !ok {
+		return Result{}, false
+	}
+
+	var result Result
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		return Result{}, false
+	}
+	return result, true
+}
+
+// isBusyGroup reports whether err is Redis' error for an existing group.
+func isBusyGroup(err error) bool {
+	return strings.HasPrefix(err.Error(), "BUSYGROUP")
+}
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -61,6 +62,11 @@ type Result struct {
 	// Empty if execution succeeded
 	Error string `json:"error,omitempty"`
 
+	// ErrorDetail is the structured JSON form of a *toolerr.Error returned
+	// by the tool, including its command line, exit code, duration and
+	// stderr tail. Empty for other errors
+	ErrorDetail json.RawMessage `json:"error_detail,omitempty"`
+
 	// WorkerID is the unique identifier of the worker that processed this item
 	WorkerID string `json:"worker_id"`
 
@@ -0,0 +1,380 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ChangeKind classifies a difference between two schemas.
+type ChangeKind string
+
+const (
+	// ChangeFieldAdded indicates a property present only in the new schema.
+	ChangeFieldAdded ChangeKind = "field_added"
+
+	// ChangeFieldRemoved indicates a property present only in the old schema.
+	ChangeFieldRemoved ChangeKind = "field_removed"
+
+	// ChangeTypeChanged indicates the type (or $ref) of a value changed.
+	ChangeTypeChanged ChangeKind = "type_changed"
+
+	// ChangeRequiredAdded indicates a property became required.
+	ChangeRequiredAdded ChangeKind = "required_added"
+
+	// ChangeRequiredRemoved indicates a property is no longer required.
+	ChangeRequiredRemoved ChangeKind = "required_removed"
+
+	// ChangeEnumNarrowed indicates allowed enum values were removed, or an
+	// enum was introduced on a value that had none.
+	ChangeEnumNarrowed ChangeKind = "enum_narrowed"
+
+	// ChangeEnumWidened indicates enum values were added, or the enum was
+	// dropped.
+	ChangeEnumWidened ChangeKind = "enum_widened"
+
+	// ChangeConstraintTightened indicates a constraint (minimum, maximum,
+	// minLength, maxLength, pattern, format, or guard) now rejects values
+	// it accepted before.
+	ChangeConstraintTightened ChangeKind = "constraint_tightened"
+
+	// ChangeConstraintLoosened indicates a constraint was relaxed or removed.
+	ChangeConstraintLoosened ChangeKind = "constraint_loosened"
+)
+
+// CompatLevel reports how inputs written against an old schema fare under a
+// new one.
+type CompatLevel int
+
+const (
+	// CompatFull means every input valid under the old schema is valid under
+	// the new one, with nothing ignored.
+	CompatFull CompatLevel = iota
+
+	// CompatPartial means some old inputs may be rejected, such as values
+	// outside a tightened constraint or a removed enum value, or may have
+	// part of their data ignored because a property was removed.
+	CompatPartial
+
+	// CompatBreaking means old inputs are rejected regardless of their
+	// values, such as when a property becomes required or changes type.
+	CompatBreaking
+)
+
+// String returns the level name: "full", "partial", or "breaking".
+func (l CompatLevel) String() string {
+	switch l {
+	case CompatFull:
+		return "full"
+	case CompatPartial:
+		return "partial"
+	case CompatBreaking:
+		return "breaking"
+	default:
+		return fmt.Sprintf("CompatLevel(%d)", int(l))
+	}
+}
+
+// Change is a single difference between two schemas.
+type Change struct {
+	// Path locates the change: property names separated by dots, with "[]"
+	// for array items (e.g. "options.ports[]"). Empty for the root.
+	Path string
+
+	// Kind classifies the change.
+	Kind ChangeKind
+
+	// Level is the compatibility impact of this change on old inputs.
+	Level CompatLevel
+
+	// Detail describes the change, e.g. "minimum 1 -> 10".
+	Detail string
+}
+
+// String formats the change as "path: kind (level): detail".
+func (c Change) String() string {
+	path := c.Path
+	if path == "" {
+		path = "(root)"
+	}
+	s := fmt.Sprintf("%s: %s (%s)", path, c.Kind, c.Level)
+	if c.Detail != "" {
+		s += ": " + c.Detail
+	}
+	return s
+}
+
+// Diff lists the differences between two schemas that affect validation,
+// recursing into object properties and array items. Changes to descriptions
+// and defaults are not reported. Changes are ordered by path, so the result
+// is deterministic.
+func Diff(oldSchema, newSchema JSON) []Change {
+	var d differ
+	d.diff("", oldSchema, newSchema)
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Path < d.changes[j].Path
+	})
+	return d.changes
+}
+
+// Compatibility reports whether inputs valid under oldSchema remain valid
+// under newSchema. The level is the most severe of the changes found; the
+// returned changes are those that reduce compatibility. Schemas that differ
+// only in ways that accept more inputs are CompatFull.
+//
+// Example:
+//
+//	level, reasons := schema.Compatibility(oldInput, newInput)
+//	if level == schema.CompatBreaking {
+//	    for _, c := range reasons {
+//	        fmt.Println(c)
+//	    }
+//	}
+func Compatibility(oldSchema, newSchema JSON) (CompatLevel, []Change) {
+	level := CompatFull
+	var reasons []Change
+	for _, c := range Diff(oldSchema, newSchema) {
+		if c.Level == CompatFull {
+			continue
+		}
+		reasons = append(reasons, c)
+		if c.Level > level {
+			level = c.Level
+		}
+	}
+	return level, reasons
+}
+
+// differ accumulates changes while walking two schemas.
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(path string, kind ChangeKind, level CompatLevel, format string, args ...any) {
+	d.changes = append(d.changes, Change{Path: path, Kind: kind, Level: level, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (d *differ) diff(path string, oldS, newS JSON) {
+	if oldS.Ref != newS.Ref {
+		d.add(path, ChangeTypeChanged, CompatBreaking, "$ref %q -> %q", oldS.Ref, newS.Ref)
+		return
+	}
+	if oldS.Type != newS.Type {
+		level := typeChangeLevel(oldS.Type, newS.Type)
+		d.add(path, ChangeTypeChanged, level, "type %s -> %s", typeName(oldS.Type), typeName(newS.Type))
+		if level == CompatBreaking {
+			// Nested differences are moot once the type itself is incompatible.
+			return
+		}
+	}
+
+	d.diffEnum(path, oldS.Enum, newS.Enum)
+	d.diffLowerBound(path, "minimum", oldS.Minimum, newS.Minimum)
+	d.diffUpperBound(path, "maximum", oldS.Maximum, newS.Maximum)
+	d.diffLowerBound(path, "minLength", intBound(oldS.MinLength), intBound(newS.MinLength))
+	d.diffUpperBound(path, "maxLength", intBound(oldS.MaxLength), intBound(newS.MaxLength))
+	d.diffString(path, "pattern", oldS.Pattern, newS.Pattern)
+	d.diffString(path, "format", oldS.Format, newS.Format)
+	d.diffGuards(path, oldS.Guards, newS.Guards)
+	d.diffProperties(path, oldS.Properties, newS.Properties)
+	d.diffRequired(path, oldS.Required, newS.Required)
+
+	switch {
+	case oldS.Items != nil && newS.Items != nil:
+		d.diff(path+"[]", *oldS.Items, *newS.Items)
+	case oldS.Items == nil && newS.Items != nil:
+		d.diff(path+"[]", JSON{}, *newS.Items)
+	case oldS.Items != nil && newS.Items == nil:
+		d.diff(path+"[]", *oldS.Items, JSON{})
+	}
+}
+
+// typeChangeLevel classifies a type change. Dropping the type, or widening
+// integer to number, accepts every old value; adding a type to an untyped
+// value or narrowing number to integer rejects only some.
+func typeChangeLevel(oldType, newType string) CompatLevel {
+	switch {
+	case newType == "":
+		return CompatFull
+	case oldType == "integer" && newType == "number":
+		return CompatFull
+	case oldType == "", oldType == "number" && newType == "integer":
+		return CompatPartial
+	default:
+		return CompatBreaking
+	}
+}
+
+func typeName(t string) string {
+	if t == "" {
+		return "any"
+	}
+	return t
+}
+
+func (d *differ) diffEnum(path string, oldEnum, newEnum []any) {
+	switch {
+	case len(oldEnum) == 0 && len(newEnum) == 0:
+		return
+	case len(oldEnum) == 0:
+		d.add(path, ChangeEnumNarrowed, CompatPartial, "enum %v added", newEnum)
+		return
+	case len(newEnum) == 0:
+		d.add(path, ChangeEnumWidened, CompatFull, "enum %v removed", oldEnum)
+		return
+	}
+
+	if removed := missingValues(oldEnum, newEnum); len(removed) > 0 {
+		d.add(path, ChangeEnumNarrowed, CompatPartial, "values %v removed", removed)
+	}
+	if added := missingValues(newEnum, oldEnum); len(added) > 0 {
+		d.add(path, ChangeEnumWidened, CompatFull, "values %v added", added)
+	}
+}
+
+// missingValues returns the values of from that are not in to.
+func missingValues(from, to []any) []any {
+	var missing []any
+	for _, v := range from {
+		found := false
+		for _, w := range to {
+			if reflect.DeepEqual(v, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, v)
+		}
+	}
+	return missing
+}
+
+// diffLowerBound compares a constraint that rejects values below it.
+func (d *differ) diffLowerBound(path, name string, oldV, newV *float64) {
+	switch {
+	case oldV == nil && newV == nil:
+	case oldV == nil:
+		d.add(path, ChangeConstraintTightened, CompatPartial, "%s %v added", name, *newV)
+	case newV == nil:
+		d.add(path, ChangeConstraintLoosened, CompatFull, "%s %v removed", name, *oldV)
+	case *newV > *oldV:
+		d.add(path, ChangeConstraintTightened, CompatPartial, "%s %v -> %v", name, *oldV, *newV)
+	case *newV < *oldV:
+		d.add(path, ChangeConstraintLoosened, CompatFull, "%s %v -> %v", name, *oldV, *newV)
+	}
+}
+
+// diffUpperBound compares a constraint that rejects values above it.
+func (d *differ) diffUpperBound(path, name string, oldV, newV *float64) {
+	switch {
+	case oldV == nil && newV == nil:
+	case oldV == nil:
+		d.add(path, ChangeConstraintTightened, CompatPartial, "%s %v added", name, *newV)
+	case newV == nil:
+		d.add(path, ChangeConstraintLoosened, CompatFull, "%s %v removed", name, *oldV)
+	case *newV < *oldV:
+		d.add(path, ChangeConstraintTightened, CompatPartial, "%s %v -> %v", name, *oldV, *newV)
+	case *newV > *oldV:
+		d.add(path, ChangeConstraintLoosened, CompatFull, "%s %v -> %v", name, *oldV, *newV)
+	}
+}
+
+// diffString compares a pattern or format. A changed value is treated as a
+// tightening, since whether it accepts every old value cannot be decided.
+func (d *differ) diffString(path, name, oldV, newV string) {
+	switch {
+	case oldV == newV:
+	case oldV == "":
+		d.add(path, ChangeConstraintTightened, CompatPartial, "%s %q added", name, newV)
+	case newV == "":
+		d.add(path, ChangeConstraintLoosened, CompatFull, "%s %q removed", name, oldV)
+	default:
+		d.add(path, ChangeConstraintTightened, CompatPartial, "%s %q -> %q", name, oldV, newV)
+	}
+}
+
+func (d *differ) diffGuards(path string, oldGuards, newGuards []string) {
+	for _, g := range newGuards {
+		if !containsString(oldGuards, g) {
+			d.add(path, ChangeConstraintTightened, CompatPartial, "guard %q added", g)
+		}
+	}
+	for _, g := range oldGuards {
+		if !containsString(newGuards, g) {
+			d.add(path, ChangeConstraintLoosened, CompatFull, "guard %q removed", g)
+		}
+	}
+}
+
+func (d *differ) diffRequired(path string, oldRequired, newRequired []string) {
+	for _, name := range sortedCopy(newRequired) {
+		if !containsString(oldRequired, name) {
+			d.add(joinPath(path, name), ChangeRequiredAdded, CompatBreaking, "%q is now required", name)
+		}
+	}
+	for _, name := range sortedCopy(oldRequired) {
+		if !containsString(newRequired, name) {
+			d.add(joinPath(path, name), ChangeRequiredRemoved, CompatFull, "%q is no longer required", name)
+		}
+	}
+}
+
+func (d *differ) diffProperties(path string, oldProps, newProps map[string]JSON) {
+	names := make([]string, 0, len(oldProps)+len(newProps))
+	for name := range oldProps {
+		names = append(names, name)
+	}
+	for name := range newProps {
+		if _, ok := oldProps[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		oldProp, inOld := oldProps[name]
+		newProp, inNew := newProps[name]
+		propPath := joinPath(path, name)
+		switch {
+		case !inOld:
+			d.add(propPath, ChangeFieldAdded, CompatFull, "type %s", typeName(newProp.Type))
+		case !inNew:
+			// Unknown properties are not rejected, but the value is ignored.
+			d.add(propPath, ChangeFieldRemoved, CompatPartial, "type %s", typeName(oldProp.Type))
+		default:
+			d.diff(propPath, oldProp, newProp)
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// intBound converts an integer constraint for the bound comparisons.
+func intBound(v *int) *float64 {
+	if v == nil {
+		return nil
+	}
+	f := float64(*v)
+	return &f
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedCopy(list []string) []string {
+	out := append([]string(nil), list...)
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,242 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func floatp(v float64) *float64 { return &v }
+func intp(v int) *int           { return &v }
+
+// kinds returns "path kind level" for each change, for compact comparison.
+func kinds(changes []Change) []string {
+	out := make([]string, len(changes))
+	for i, c := range changes {
+		out[i] = c.Path + " " + string(c.Kind) + " " + c.Level.String()
+	}
+	return out
+}
+
+func TestDiff_ChangeClasses(t *testing.T) {
+	tests := []struct {
+		name string
+		old  JSON
+		new  JSON
+		want []string
+	}{
+		{
+			name: "identical",
+			old:  Object(map[string]JSON{"target": String()}, "target"),
+			new:  Object(map[string]JSON{"target": StringWithDesc("host to scan")}, "target"),
+			want: []string{},
+		},
+		{
+			name: "optional field added",
+			old:  Object(map[string]JSON{"target": String()}),
+			new:  Object(map[string]JSON{"target": String(), "timeout": Int()}),
+			want: []string{"timeout field_added full"},
+		},
+		{
+			name: "required field added",
+			old:  Object(map[string]JSON{"target": String()}),
+			new:  Object(map[string]JSON{"target": String(), "mode": String()}, "mode"),
+			want: []string{"mode field_added full", "mode required_added breaking"},
+		},
+		{
+			name: "field removed",
+			old:  Object(map[string]JSON{"target": String(), "verbose": Bool()}),
+			new:  Object(map[string]JSON{"target": String()}),
+			want: []string{"verbose field_removed partial"},
+		},
+		{
+			name: "type changed",
+			old:  Object(map[string]JSON{"port": Int()}),
+			new:  Object(map[string]JSON{"port": String()}),
+			want: []string{"port type_changed breaking"},
+		},
+		{
+			name: "integer widened to number",
+			old:  Object(map[string]JSON{"rate": Int()}),
+			new:  Object(map[string]JSON{"rate": Number()}),
+			want: []string{"rate type_changed full"},
+		},
+		{
+			name: "number narrowed to integer",
+			old:  Object(map[string]JSON{"rate": Number()}),
+			new:  Object(map[string]JSON{"rate": Int()}),
+			want: []string{"rate type_changed partial"},
+		},
+		{
+			name: "existing field becomes required",
+			old:  Object(map[string]JSON{"target": String()}),
+			new:  Object(map[string]JSON{"target": String()}, "target"),
+			want: []string{"target required_added breaking"},
+		},
+		{
+			name: "required dropped",
+			old:  Object(map[string]JSON{"target": String()}, "target"),
+			new:  Object(map[string]JSON{"target": String()}),
+			want: []string{"target required_removed full"},
+		},
+		{
+			name: "enum narrowed",
+			old:  Object(map[string]JSON{"proto": Enum("tcp", "udp")}),
+			new:  Object(map[string]JSON{"proto": Enum("tcp")}),
+			want: []string{"proto enum_narrowed partial"},
+		},
+		{
+			name: "enum widened",
+			old:  Object(map[string]JSON{"proto": Enum("tcp")}),
+			new:  Object(map[string]JSON{"proto": Enum("tcp", "udp")}),
+			want: []string{"proto enum_widened full"},
+		},
+		{
+			name: "enum replaced",
+			old:  Object(map[string]JSON{"proto": Enum("tcp", "udp")}),
+			new:  Object(map[string]JSON{"proto": Enum("tcp", "sctp")}),
+			want: []string{"proto enum_narrowed partial", "proto enum_widened full"},
+		},
+		{
+			name: "enum introduced",
+			old:  Object(map[string]JSON{"proto": String()}),
+			new:  Object(map[string]JSON{"proto": {Type: "string", Enum: []any{"tcp"}}}),
+			want: []string{"proto enum_narrowed partial"},
+		},
+		{
+			name: "numeric bounds tightened",
+			old:  Object(map[string]JSON{"port": {Type: "integer", Minimum: floatp(0), Maximum: floatp(65535)}}),
+			new:  Object(map[string]JSON{"port": {Type: "integer", Minimum: floatp(1), Maximum: floatp(1024)}}),
+			want: []string{"port constraint_tightened partial", "port constraint_tightened partial"},
+		},
+		{
+			name: "length bounds loosened",
+			old:  Object(map[string]JSON{"name": {Type: "string", MinLength: intp(3), MaxLength: intp(10)}}),
+			new:  Object(map[string]JSON{"name": {Type: "string", MinLength: intp(1)}}),
+			want: []string{"name constraint_loosened full", "name constraint_loosened full"},
+		},
+		{
+			name: "pattern and guard added",
+			old:  Object(map[string]JSON{"host": String()}),
+			new:  Object(map[string]JSON{"host": {Type: "string", Pattern: "^[a-z.]+$", Guards: []string{GuardShellSafe}}}),
+			want: []string{"host constraint_tightened partial", "host constraint_tightened partial"},
+		},
+		{
+			name: "format removed",
+			old:  Object(map[string]JSON{"url": {Type: "string", Format: "uri"}}),
+			new:  Object(map[string]JSON{"url": String()}),
+			want: []string{"url constraint_loosened full"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := kinds(Diff(tt.old, tt.new))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff_Nested(t *testing.T) {
+	old := Object(map[string]JSON{
+		"options": Object(map[string]JSON{
+			"retries": Int(),
+			"headers": Array(Object(map[string]JSON{
+				"name":  String(),
+				"value": String(),
+			}, "name")),
+		}),
+		"ports": Array(Int()),
+	})
+	new := Object(map[string]JSON{
+		"options": Object(map[string]JSON{
+			"retries": {Type: "integer", Maximum: floatp(5)},
+			"headers": Array(Object(map[string]JSON{
+				"name": String(),
+			}, "name", "value")),
+		}),
+		"ports": Array(String()),
+	})
+
+	got := kinds(Diff(old, new))
+	want := []string{
+		"options.headers[].value field_removed partial",
+		"options.headers[].value required_added breaking",
+		"options.retries constraint_tightened partial",
+		"ports[] type_changed breaking",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %q, want %q", got, want)
+	}
+}
+
+func TestDiff_TypeChangeStopsDescent(t *testing.T) {
+	old := Object(map[string]JSON{"a": String()})
+	new := Array(String())
+
+	got := kinds(Diff(old, new))
+	want := []string{" type_changed breaking"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %q, want %q", got, want)
+	}
+}
+
+func TestCompatibility(t *testing.T) {
+	base := Object(map[string]JSON{
+		"target": String(),
+		"mode":   Enum("fast", "full"),
+	}, "target")
+
+	tests := []struct {
+		name        string
+		new         JSON
+		wantLevel   CompatLevel
+		wantReasons int
+	}{
+		{
+			name:      "only additions",
+			new:       Object(map[string]JSON{"target": String(), "mode": Enum("fast", "full", "stealth"), "timeout": Int()}, "target"),
+			wantLevel: CompatFull,
+		},
+		{
+			name:        "narrowed enum",
+			new:         Object(map[string]JSON{"target": String(), "mode": Enum("fast")}, "target"),
+			wantLevel:   CompatPartial,
+			wantReasons: 1,
+		},
+		{
+			name:        "new required field",
+			new:         Object(map[string]JSON{"target": String(), "mode": Enum("fast"), "scope": String()}, "target", "scope"),
+			wantLevel:   CompatBreaking,
+			wantReasons: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, reasons := Compatibility(base, tt.new)
+			if level != tt.wantLevel {
+				t.Errorf("Compatibility() level = %v, want %v (reasons %v)", level, tt.wantLevel, reasons)
+			}
+			if len(reasons) != tt.wantReasons {
+				t.Errorf("Compatibility() reasons = %v, want %d", reasons, tt.wantReasons)
+			}
+			for _, r := range reasons {
+				if r.Level == CompatFull {
+					t.Errorf("reason %v does not reduce compatibility", r)
+				}
+			}
+		})
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	c := Change{Path: "options.retries", Kind: ChangeConstraintTightened, Level: CompatPartial, Detail: "maximum 5 added"}
+	if got, want := c.String(), "options.retries: constraint_tightened (partial): maximum 5 added"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := (Change{Kind: ChangeTypeChanged, Level: CompatBreaking}).String(); got != "(root): type_changed (breaking)" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -74,6 +74,47 @@
 //	err := statusSchema.Validate("active")  // nil (valid)
 //	err = statusSchema.Validate("invalid")  // error: not in allowed values
 //
+// # Input Guards
+//
+// String schemas can list guards that reject common injection payloads, such as
+// path traversal or shell metacharacters, during validation:
+//
+//	hostSchema := schema.JSON{
+//		Type:   "string",
+//		Guards: []string{schema.GuardHostnameOnly, schema.GuardPrivateIPForbidden},
+//	}
+//	err := hostSchema.Validate("localhost; curl evil") // error: guard hostname_only: ...
+//
+// Guards are conservative and fail closed: an unknown guard name is a validation error.
+//
+// # Generating Schemas from Go Types
+//
+// FromType derives a schema from a Go struct, so tool and plugin inputs can be
+// defined once. Constraints are read from the jsonschema struct tag:
+//
+//	type ScanInput struct {
+//		Target string `json:"target" jsonschema:"minLength=1,maxLength=253" description:"Host to scan"`
+//		Ports  []int  `json:"ports,omitempty" jsonschema:"minimum=1,maximum=65535"`
+//		Mode   string `json:"mode,omitempty" jsonschema:"enum=fast|full,required"`
+//	}
+//
+//	inputSchema := schema.FromType(ScanInput{})
+//
+// # Compatibility
+//
+// Diff lists the differences between two versions of a schema, and
+// Compatibility summarizes them: CompatFull if every input valid under the
+// old schema is still valid, CompatPartial if some may be rejected (a
+// narrowed enum, a tightened bound), and CompatBreaking if existing inputs
+// are rejected outright (a new required field, a changed type):
+//
+//	level, reasons := schema.Compatibility(oldSchema, newSchema)
+//	if level == schema.CompatBreaking {
+//		for _, c := range reasons {
+//			fmt.Println(c) // mode: required_added (breaking): ...
+//		}
+//	}
+//
 // # Type Safety
 //
 // The JSON struct uses Go's type system to represent JSON Schema definitions,
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -11,33 +12,66 @@ import (
 //
 // This is used when agents call CompleteStructured with a Go struct type -
 // the schema is sent to the daemon which forwards it to the LLM provider.
+// Tool and plugin authors can also use it to define input schemas once as
+// Go structs instead of maintaining a parallel Object definition by hand.
 //
 // Supported types:
 //   - struct: generates an object schema with properties from exported fields
+//   - embedded struct: its fields are promoted into the parent object,
+//     matching encoding/json
 //   - slice/array: generates an array schema
 //   - map: generates an object schema with additionalProperties
 //   - string, int*, uint*, float*, bool: generates primitive schemas
 //   - time.Time: generates string schema with date-time format
 //   - interface{}/any: generates empty schema (allows any)
 //
+// Recursive types are cut off at the first repeated struct, which is
+// described as a plain object schema.
+//
 // Struct tags:
 //   - `json:"name"`: uses the JSON tag name for the property
 //   - `json:"-"`: skips the field
 //   - `json:"name,omitempty"`: field is optional (not in required list)
+//   - `description:"..."`: sets the property description
+//   - `jsonschema:"..."`: comma-separated constraints (see below)
+//
+// The jsonschema tag accepts:
+//   - required: field is required even if it is omitempty
+//   - minLength=N, maxLength=N, pattern=REGEX: string constraints
+//   - minimum=N, maximum=N: numeric constraints
+//   - enum=a|b|c: allowed values, converted to the field's type
+//   - format=NAME: string format (e.g. "email", "uri")
+//   - guards=a|b: input guards such as shell_safe (see CheckGuard)
+//
+// For slice and array fields the value constraints apply to the items.
+// A pattern may contain commas; text after a comma that is not a recognized
+// constraint is treated as part of the preceding value. Malformed numeric
+// values are ignored.
+//
+// Example:
+//
+//	type ScanInput struct {
+//	    Target string   `json:"target" jsonschema:"minLength=1,pattern=^[a-z0-9.-]+$" description:"Host to scan"`
+//	    Ports  []int    `json:"ports,omitempty" jsonschema:"minimum=1,maximum=65535"`
+//	    Mode   string   `json:"mode,omitempty" jsonschema:"enum=fast|full,required"`
+//	}
+//
+//	inputSchema := schema.FromType(ScanInput{})
 func FromType(t any) JSON {
 	if t == nil {
 		return JSON{}
 	}
 
 	rt := reflect.TypeOf(t)
-	return fromReflectType(rt)
+	return fromReflectType(rt, map[reflect.Type]bool{})
 }
 
-// fromReflectType generates a JSON schema from a reflect.Type
-func fromReflectType(t reflect.Type) JSON {
+// fromReflectType generates a JSON schema from a reflect.Type.
+// visiting holds the struct types currently being expanded, to stop recursion.
+func fromReflectType(t reflect.Type, visiting map[reflect.Type]bool) JSON {
 	// Handle pointer types
 	if t.Kind() == reflect.Ptr {
-		return fromReflectType(t.Elem())
+		return fromReflectType(t.Elem(), visiting)
 	}
 
 	// Special handling for time.Time
@@ -50,9 +84,14 @@ func fromReflectType(t reflect.Type) JSON {
 
 	switch t.Kind() {
 	case reflect.Struct:
-		return fromStruct(t)
+		if visiting[t] {
+			return JSON{Type: "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		return fromStruct(t, visiting)
 	case reflect.Slice, reflect.Array:
-		itemSchema := fromReflectType(t.Elem())
+		itemSchema := fromReflectType(t.Elem(), visiting)
 		return JSON{
 			Type:  "array",
 			Items: &itemSchema,
@@ -81,18 +120,42 @@ func fromReflectType(t reflect.Type) JSON {
 }
 
 // fromStruct generates a JSON schema from a struct type
-func fromStruct(t reflect.Type) JSON {
-	properties := make(map[string]JSON)
+func fromStruct(t reflect.Type, visiting map[reflect.Type]bool) JSON {
+	fields := &structFields{
+		properties: make(map[string]JSON),
+		required:   make(map[string]bool),
+		depth:      make(map[string]int),
+	}
+	fields.collect(t, 0, visiting)
+
 	var required []string
+	for _, name := range fields.order {
+		if fields.required[name] {
+			required = append(required, name)
+		}
+	}
+
+	return JSON{
+		Type:       "object",
+		Properties: fields.properties,
+		Required:   required,
+	}
+}
+
+// structFields accumulates the properties of a struct, including those
+// promoted from embedded structs, in declaration order.
+type structFields struct {
+	properties map[string]JSON
+	required   map[string]bool
+	depth      map[string]int
+	order      []string
+}
 
+// collect adds the fields of t found at the given embedding depth.
+func (f *structFields) collect(t reflect.Type, depth int, visiting map[reflect.Type]bool) {
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 
-		// Skip unexported fields
-		if !field.IsExported() {
-			continue
-		}
-
 		// Parse json tag
 		jsonTag := field.Tag.Get("json")
 		if jsonTag == "-" {
@@ -101,11 +164,13 @@ func fromStruct(t reflect.Type) JSON {
 
 		// Get field name from json tag or field name
 		fieldName := field.Name
+		hasTagName := false
 		isOmitempty := false
 		if jsonTag != "" {
 			parts := strings.Split(jsonTag, ",")
 			if parts[0] != "" {
 				fieldName = parts[0]
+				hasTagName = true
 			}
 			for _, part := range parts[1:] {
 				if part == "omitempty" {
@@ -115,25 +180,153 @@ func fromStruct(t reflect.Type) JSON {
 			}
 		}
 
+		// Promote fields of untagged embedded structs, as encoding/json does.
+		// This applies even when the embedded type itself is unexported.
+		if field.Anonymous && !hasTagName {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if !visiting[embedded] {
+					visiting[embedded] = true
+					f.collect(embedded, depth+1, visiting)
+					delete(visiting, embedded)
+				}
+				continue
+			}
+		}
+
+		// Skip unexported fields
+		if !field.IsExported() {
+			continue
+		}
+
 		// Generate schema for the field type
-		fieldSchema := fromReflectType(field.Type)
+		fieldSchema := fromReflectType(field.Type, visiting)
 
 		// Add description from doc tag if present
 		if desc := field.Tag.Get("description"); desc != "" {
 			fieldSchema.Description = desc
 		}
 
-		properties[fieldName] = fieldSchema
+		// Apply validation constraints from the jsonschema tag
+		if tag := field.Tag.Get("jsonschema"); tag != "" {
+			if applyConstraints(&fieldSchema, tag) {
+				isOmitempty = false
+			}
+		}
+
+		f.add(fieldName, fieldSchema, !isOmitempty, depth)
+	}
+}
 
-		// Non-omitempty fields are required
-		if !isOmitempty {
-			required = append(required, fieldName)
+// add records a property. As in encoding/json, a field at a shallower
+// embedding depth takes precedence over a promoted field with the same name.
+func (f *structFields) add(name string, prop JSON, required bool, depth int) {
+	if existing, exists := f.depth[name]; exists {
+		if existing <= depth {
+			return
 		}
+	} else {
+		f.order = append(f.order, name)
+	}
+	f.properties[name] = prop
+	f.required[name] = required
+	f.depth[name] = depth
+}
+
+// applyConstraints applies a jsonschema struct tag to s and reports whether
+// the tag marks the field as required.
+func applyConstraints(s *JSON, tag string) bool {
+	// Constraints on arrays describe their items
+	target := s
+	if s.Type == "array" && s.Items != nil {
+		target = s.Items
 	}
 
-	return JSON{
-		Type:       "object",
-		Properties: properties,
-		Required:   required,
+	required := false
+	for _, entry := range splitConstraints(tag) {
+		key, value, _ := strings.Cut(entry, "=")
+		switch key {
+		case "required":
+			required = true
+		case "minLength":
+			if n, err := strconv.Atoi(value); err == nil {
+				target.MinLength = &n
+			}
+		case "maxLength":
+			if n, err := strconv.Atoi(value); err == nil {
+				target.MaxLength = &n
+			}
+		case "minimum":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				target.Minimum = &n
+			}
+		case "maximum":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				target.Maximum = &n
+			}
+		case "pattern":
+			target.Pattern = value
+		case "format":
+			target.Format = value
+		case "guards":
+			target.Guards = strings.Split(value, "|")
+		case "enum":
+			target.Enum = nil
+			for _, v := range strings.Split(value, "|") {
+				target.Enum = append(target.Enum, enumValue(target.Type, v))
+			}
+		}
+	}
+	return required
+}
+
+// constraintKeys are the entries recognized in a jsonschema tag.
+var constraintKeys = map[string]bool{
+	"required":  true,
+	"minLength": true,
+	"maxLength": true,
+	"minimum":   true,
+	"maximum":   true,
+	"pattern":   true,
+	"format":    true,
+	"enum":      true,
+	"guards":    true,
+}
+
+// splitConstraints splits a jsonschema tag on commas, rejoining segments that
+// do not start a recognized constraint so values such as "^[a-z]{1,3}$" survive.
+func splitConstraints(tag string) []string {
+	var entries []string
+	for _, part := range strings.Split(tag, ",") {
+		key, _, _ := strings.Cut(part, "=")
+		if constraintKeys[key] || len(entries) == 0 {
+			entries = append(entries, part)
+			continue
+		}
+		entries[len(entries)-1] += "," + part
+	}
+	return entries
+}
+
+// enumValue converts an enum tag value to the schema's type. Numbers become
+// float64 to match values decoded by encoding/json.
+func enumValue(typ, v string) any {
+	switch typ {
+	case "integer":
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return float64(n)
+		}
+	case "number":
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return n
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
 	}
+	return v
 }
@@ -0,0 +1,249 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFromTypePrimitives(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		wantType string
+	}{
+		{"string", "", "string"},
+		{"int", 0, "integer"},
+		{"uint16", uint16(0), "integer"},
+		{"float", 0.0, "number"},
+		{"bool", false, "boolean"},
+		{"slice", []string{}, "array"},
+		{"map", map[string]int{}, "object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := FromType(tt.value)
+			if s.Type != tt.wantType {
+				t.Errorf("expected Type %q, got %q", tt.wantType, s.Type)
+			}
+		})
+	}
+
+	if s := FromType(time.Time{}); s.Type != "string" || s.Format != "date-time" {
+		t.Errorf("expected date-time string for time.Time, got %+v", s)
+	}
+	if s := FromType(nil); !reflect.DeepEqual(s, JSON{}) {
+		t.Errorf("expected empty schema for nil, got %+v", s)
+	}
+}
+
+func TestFromTypeJSONTags(t *testing.T) {
+	type input struct {
+		Name     string `json:"name" description:"Display name"`
+		Nickname string `json:"nickname,omitempty"`
+		Secret   string `json:"-"`
+		Plain    int
+		internal string
+	}
+
+	s := FromType(input{})
+
+	if s.Type != "object" {
+		t.Fatalf("expected object, got %q", s.Type)
+	}
+	for _, name := range []string{"name", "nickname", "Plain"} {
+		if _, ok := s.Properties[name]; !ok {
+			t.Errorf("expected property %q", name)
+		}
+	}
+	for _, name := range []string{"Secret", "-", "internal"} {
+		if _, ok := s.Properties[name]; ok {
+			t.Errorf("unexpected property %q", name)
+		}
+	}
+	if got := s.Properties["name"].Description; got != "Display name" {
+		t.Errorf("expected description %q, got %q", "Display name", got)
+	}
+	if want := []string{"name", "Plain"}; !reflect.DeepEqual(s.Required, want) {
+		t.Errorf("expected required %v, got %v", want, s.Required)
+	}
+}
+
+func TestFromTypeConstraintTags(t *testing.T) {
+	type input struct {
+		Name  string  `json:"name" jsonschema:"minLength=1,maxLength=50,pattern=^[a-z]+$"`
+		Code  string  `json:"code,omitempty" jsonschema:"pattern=^[A-Z]{2,3}$,required"`
+		Email string  `json:"email,omitempty" jsonschema:"format=email"`
+		Port  int     `json:"port" jsonschema:"minimum=1,maximum=65535"`
+		Ratio float64 `json:"ratio" jsonschema:"minimum=0.5"`
+		Mode  string  `json:"mode" jsonschema:"enum=fast|full"`
+		Level int     `json:"level" jsonschema:"enum=1|2|3"`
+	}
+
+	s := FromType(input{})
+
+	name := s.Properties["name"]
+	if name.MinLength == nil || *name.MinLength != 1 {
+		t.Errorf("expected minLength 1, got %v", name.MinLength)
+	}
+	if name.MaxLength == nil || *name.MaxLength != 50 {
+		t.Errorf("expected maxLength 50, got %v", name.MaxLength)
+	}
+	if name.Pattern != "^[a-z]+$" {
+		t.Errorf("expected pattern %q, got %q", "^[a-z]+$", name.Pattern)
+	}
+
+	// Commas inside a pattern are preserved, and required overrides omitempty
+	if got := s.Properties["code"].Pattern; got != "^[A-Z]{2,3}$" {
+		t.Errorf("expected pattern %q, got %q", "^[A-Z]{2,3}$", got)
+	}
+	if got := s.Properties["email"].Format; got != "email" {
+		t.Errorf("expected format email, got %q", got)
+	}
+	wantRequired := []string{"name", "code", "port", "ratio", "mode", "level"}
+	if !reflect.DeepEqual(s.Required, wantRequired) {
+		t.Errorf("expected required %v, got %v", wantRequired, s.Required)
+	}
+
+	port := s.Properties["port"]
+	if port.Minimum == nil || *port.Minimum != 1 || port.Maximum == nil || *port.Maximum != 65535 {
+		t.Errorf("expected port range 1-65535, got %v-%v", port.Minimum, port.Maximum)
+	}
+	if ratio := s.Properties["ratio"]; ratio.Minimum == nil || *ratio.Minimum != 0.5 {
+		t.Errorf("expected ratio minimum 0.5, got %v", ratio.Minimum)
+	}
+
+	if got := s.Properties["mode"].Enum; !reflect.DeepEqual(got, []any{"fast", "full"}) {
+		t.Errorf("expected mode enum [fast full], got %v", got)
+	}
+	if got := s.Properties["level"].Enum; !reflect.DeepEqual(got, []any{1.0, 2.0, 3.0}) {
+		t.Errorf("expected level enum [1 2 3], got %v", got)
+	}
+
+	// The generated schema enforces the constraints
+	valid := map[string]any{
+		"name": "scanner", "code": "US", "port": 443,
+		"ratio": 0.7, "mode": "fast", "level": 2.0,
+	}
+	if err := s.Validate(valid); err != nil {
+		t.Errorf("expected valid input, got error: %v", err)
+	}
+	invalid := map[string]any{
+		"name": "Scanner", "code": "US", "port": 443,
+		"ratio": 0.7, "mode": "fast", "level": 2.0,
+	}
+	if err := s.Validate(invalid); err == nil {
+		t.Error("expected pattern violation, got nil")
+	}
+}
+
+func TestFromTypeMalformedConstraints(t *testing.T) {
+	type input struct {
+		Name string `json:"name" jsonschema:"minLength=abc,unknown=1"`
+	}
+
+	name := FromType(input{}).Properties["name"]
+	if name.MinLength != nil {
+		t.Errorf("expected malformed minLength to be ignored, got %v", *name.MinLength)
+	}
+}
+
+type baseInput struct {
+	Target  string `json:"target" jsonschema:"minLength=1"`
+	Timeout int    `json:"timeout,omitempty"`
+}
+
+type Labels struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+func TestFromTypeEmbeddedStructs(t *testing.T) {
+	type input struct {
+		baseInput
+		*Labels
+		Timeout string `json:"timeout"`
+		Mode    string `json:"mode"`
+	}
+
+	s := FromType(input{})
+
+	for _, name := range []string{"target", "tags", "timeout", "mode"} {
+		if _, ok := s.Properties[name]; !ok {
+			t.Errorf("expected promoted property %q", name)
+		}
+	}
+	if _, ok := s.Properties["baseInput"]; ok {
+		t.Error("embedded struct should be flattened, not nested")
+	}
+	if got := s.Properties["target"].MinLength; got == nil || *got != 1 {
+		t.Errorf("expected constraint on promoted field, got %v", got)
+	}
+	// The outer timeout shadows the promoted one, as in encoding/json
+	if got := s.Properties["timeout"].Type; got != "string" {
+		t.Errorf("expected outer timeout field to win, got type %q", got)
+	}
+	if want := []string{"target", "timeout", "mode"}; !reflect.DeepEqual(s.Required, want) {
+		t.Errorf("expected required %v, got %v", want, s.Required)
+	}
+}
+
+func TestFromTypeNamedEmbeddedStruct(t *testing.T) {
+	type input struct {
+		Labels `json:"labels"`
+	}
+
+	s := FromType(input{})
+	labels, ok := s.Properties["labels"]
+	if !ok || labels.Type != "object" {
+		t.Fatalf("expected nested labels object, got %+v", s.Properties)
+	}
+	if _, ok := labels.Properties["tags"]; !ok {
+		t.Error("expected tags inside labels")
+	}
+}
+
+func TestFromTypeSlices(t *testing.T) {
+	type port struct {
+		Number int `json:"number" jsonschema:"minimum=1,maximum=65535"`
+	}
+	type input struct {
+		Ports []port   `json:"ports"`
+		Hosts []string `json:"hosts" jsonschema:"minLength=1,format=hostname"`
+	}
+
+	s := FromType(input{})
+
+	ports := s.Properties["ports"]
+	if ports.Type != "array" || ports.Items == nil || ports.Items.Type != "object" {
+		t.Fatalf("expected array of objects, got %+v", ports)
+	}
+	if n := ports.Items.Properties["number"]; n.Minimum == nil || *n.Minimum != 1 {
+		t.Errorf("expected item constraint, got %+v", n)
+	}
+
+	hosts := s.Properties["hosts"]
+	if hosts.Items == nil || hosts.Items.MinLength == nil || *hosts.Items.MinLength != 1 {
+		t.Errorf("expected constraints to apply to items, got %+v", hosts.Items)
+	}
+	if hosts.Items.Format != "hostname" {
+		t.Errorf("expected item format hostname, got %q", hosts.Items.Format)
+	}
+}
+
+type treeNode struct {
+	Name     string      `json:"name"`
+	Children []*treeNode `json:"children,omitempty"`
+}
+
+func TestFromTypeRecursive(t *testing.T) {
+	s := FromType(treeNode{})
+
+	children := s.Properties["children"]
+	if children.Items == nil || children.Items.Type != "object" {
+		t.Fatalf("expected children to be an array of objects, got %+v", children)
+	}
+	if children.Items.Properties != nil {
+		t.Errorf("expected recursion to stop at the repeated type, got %+v", children.Items.Properties)
+	}
+}
@@ -0,0 +1,446 @@
+package schema
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Input guards reject string values commonly used to abuse tools through
+// prompt injection. Guards are listed in JSON.Guards and are checked by
+// Validate after the other string constraints. All guards are conservative:
+// when a value is ambiguous it is rejected.
+const (
+	// GuardNoPathTraversal rejects values containing parent directory
+	// references ("..") in any path segment, including percent-encoded,
+	// overlong UTF-8, backslash-separated, and unicode look-alike forms.
+	// Absolute paths are not rejected by this guard.
+	GuardNoPathTraversal = "no_path_traversal"
+
+	// GuardHostnameOnly accepts only a bare DNS hostname or IP address:
+	// no scheme, port, path, user info, whitespace, or non-ASCII characters.
+	// Numeric hosts that resolvers would interpret as non-canonical IPv4
+	// addresses (e.g. "2130706433", "0x7f.1") are rejected.
+	GuardHostnameOnly = "hostname_only"
+
+	// GuardPrivateIPForbidden rejects hosts, host:port pairs, and URLs that
+	// refer to loopback, private, link-local, or otherwise reserved addresses,
+	// including IPv4-mapped and embedded IPv6 forms and legacy numeric IPv4
+	// notations. Hostnames are not resolved; only "localhost" names are
+	// rejected, so pair this guard with network-level egress controls.
+	GuardPrivateIPForbidden = "private_ip_forbidden"
+
+	// GuardShellSafe accepts only ASCII letters, digits, and the characters
+	// "-_.,:/=@+%", and rejects values starting with "-" to prevent option
+	// injection. Whitespace, shell metacharacters, and all non-ASCII
+	// characters (including full-width look-alikes such as "；") are rejected.
+	GuardShellSafe = "shell_safe"
+)
+
+// guardChecks maps guard names to their implementations.
+var guardChecks = map[string]func(string) error{
+	GuardNoPathTraversal:    checkNoPathTraversal,
+	GuardHostnameOnly:       checkHostnameOnly,
+	GuardPrivateIPForbidden: checkPrivateIPForbidden,
+	GuardShellSafe:          checkShellSafe,
+}
+
+// maxDecodeRounds bounds repeated percent-decoding of guarded values.
+const maxDecodeRounds = 4
+
+// IsKnownGuard returns true if name is a supported guard.
+func IsKnownGuard(name string) bool {
+	_, ok := guardChecks[name]
+	return ok
+}
+
+// CheckGuard applies the named guard to value.
+// Returns an error describing the violation, or an error for unknown guards
+// so that a misspelled guard never silently disables protection.
+func CheckGuard(guard string, value string) error {
+	check, ok := guardChecks[guard]
+	if !ok {
+		return fmt.Errorf("unknown guard %q", guard)
+	}
+	if err := check(value); err != nil {
+		return fmt.Errorf("guard %s: %w", guard, err)
+	}
+	return nil
+}
+
+// checkGuards applies every guard in the schema to a string value.
+func (s JSON) checkGuards(value string) error {
+	for _, guard := range s.Guards {
+		if err := CheckGuard(guard, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pathConfusables maps characters that some filesystems, proxies, or
+// normalization steps treat as "." or a path separator.
+var pathConfusables = map[rune]rune{
+	'．': '.',  // FULLWIDTH FULL STOP
+	'․': '.',  // ONE DOT LEADER
+	'﹒': '.',  // SMALL FULL STOP
+	'。': '.',  // IDEOGRAPHIC FULL STOP
+	'｡': '.',  // HALFWIDTH IDEOGRAPHIC FULL STOP
+	'／': '/',  // FULLWIDTH SOLIDUS
+	'∕': '/',  // DIVISION SLASH
+	'⁄': '/',  // FRACTION SLASH
+	'⧸': '/',  // BIG SOLIDUS
+	'＼': '\\', // FULLWIDTH REVERSE SOLIDUS
+	'⧵': '\\', // REVERSE SOLIDUS OPERATOR
+	'﹨': '\\', // SMALL REVERSE SOLIDUS
+}
+
+// percentDecode repeatedly percent-decodes value until it is stable, so that
+// double-encoded input such as "%252e%252e" is inspected in its final form.
+func percentDecode(value string) (string, error) {
+	for i := 0; i < maxDecodeRounds; i++ {
+		if !strings.Contains(value, "%") {
+			return value, nil
+		}
+		decoded, err := url.PathUnescape(value)
+		if err != nil || decoded == value {
+			// Not valid percent-encoding; inspect the value as-is
+			return value, nil
+		}
+		value = decoded
+	}
+	if strings.Contains(value, "%") {
+		if decoded, err := url.PathUnescape(value); err == nil && decoded != value {
+			return "", fmt.Errorf("value is percent-encoded more than %d times", maxDecodeRounds)
+		}
+	}
+	return value, nil
+}
+
+// checkNoPathTraversal implements GuardNoPathTraversal.
+func checkNoPathTraversal(value string) error {
+	decoded, err := percentDecode(value)
+	if err != nil {
+		return err
+	}
+	if !utf8.ValidString(decoded) {
+		return fmt.Errorf("value contains invalid UTF-8 after decoding (possible overlong encoding)")
+	}
+	if strings.ContainsRune(decoded, 0) {
+		return fmt.Errorf("value contains a NUL byte")
+	}
+
+	normalized := strings.Map(func(r rune) rune {
+		if replacement, ok := pathConfusables[r]; ok {
+			return replacement
+		}
+		return r
+	}, decoded)
+
+	segments := strings.FieldsFunc(normalized, func(r rune) bool {
+		return r == '/' || r == '\\'
+	})
+	for _, segment := range segments {
+		// Servlet containers ignore path parameters, and Windows ignores
+		// trailing spaces, so "..;x" and ".. " both traverse.
+		name, _, _ := strings.Cut(segment, ";")
+		name = strings.TrimRight(name, " \t")
+		if len(name) >= 2 && strings.Trim(name, ".") == "" {
+			return fmt.Errorf("path segment %q references a parent directory", segment)
+		}
+	}
+	return nil
+}
+
+// checkHostnameOnly implements GuardHostnameOnly.
+func checkHostnameOnly(value string) error {
+	if value == "" {
+		return fmt.Errorf("hostname is empty")
+	}
+
+	// IP literals are accepted, but not with a zone, which can smuggle
+	// arbitrary text.
+	if addr, err := netip.ParseAddr(value); err == nil {
+		if addr.Zone() != "" {
+			return fmt.Errorf("IPv6 zone identifiers are not allowed")
+		}
+		return nil
+	}
+
+	name := strings.TrimSuffix(value, ".")
+	if len(name) > 253 {
+		return fmt.Errorf("hostname is longer than 253 characters")
+	}
+
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+		case r >= utf8.RuneSelf:
+			return fmt.Errorf("non-ASCII character %q at offset %d is not allowed (use the punycode form)", r, i)
+		default:
+			return fmt.Errorf("character %q at offset %d is not allowed in a hostname", r, i)
+		}
+	}
+
+	labels := strings.Split(name, ".")
+	for _, label := range labels {
+		if label == "" {
+			return fmt.Errorf("hostname contains an empty label")
+		}
+		if len(label) > 63 {
+			return fmt.Errorf("hostname label %q is longer than 63 characters", label)
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("hostname label %q must not start or end with '-'", label)
+		}
+	}
+
+	if _, ok := parseLegacyIPv4(name); ok {
+		return fmt.Errorf("%q is a non-canonical numeric IPv4 address", value)
+	}
+	if isDecimal(labels[len(labels)-1]) {
+		return fmt.Errorf("top-level label %q must not be numeric", labels[len(labels)-1])
+	}
+
+	return nil
+}
+
+// localhostNames are hostnames that always resolve to the local machine.
+var localhostNames = map[string]bool{
+	"localhost":               true,
+	"localhost.localdomain":   true,
+	"ip6-localhost":           true,
+	"ip6-loopback":            true,
+	"localhost6":              true,
+	"localhost6.localdomain6": true,
+}
+
+// checkPrivateIPForbidden implements GuardPrivateIPForbidden.
+func checkPrivateIPForbidden(value string) error {
+	host, err := extractHost(value)
+	if err != nil {
+		return err
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(host, "."))
+	if localhostNames[name] || strings.HasSuffix(name, ".localhost") {
+		return fmt.Errorf("host %q refers to the local machine", host)
+	}
+
+	addr, ok := parseHostAddr(host)
+	if !ok {
+		// A hostname; it is not resolved here.
+		return nil
+	}
+	if reason := reservedReason(addr); reason != "" {
+		return fmt.Errorf("address %s is %s", addr, reason)
+	}
+	return nil
+}
+
+// extractHost returns the host part of a bare host, host:port pair, or URL.
+// Values without a scheme are parsed as network-path references so that
+// user info ("user@host") and ports are stripped the same way as in URLs.
+func extractHost(value string) (string, error) {
+	decoded, err := percentDecode(value)
+	if err != nil {
+		return "", err
+	}
+
+	// Bare IP addresses, including IPv6 which contains colons
+	if _, err := netip.ParseAddr(strings.Trim(decoded, "[]")); err == nil {
+		return strings.Trim(decoded, "[]"), nil
+	}
+
+	ref := decoded
+	if !strings.Contains(decoded, "://") {
+		ref = "//" + decoded
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse host: %v", err)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return "", fmt.Errorf("value has no host")
+	}
+	return host, nil
+}
+
+// parseHostAddr parses host as an IP address, accepting IPv6 zones and the
+// legacy numeric IPv4 forms that inet_aton-style resolvers accept.
+func parseHostAddr(host string) (netip.Addr, bool) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr.WithZone(""), true
+	}
+	return parseLegacyIPv4(host)
+}
+
+// parseLegacyIPv4 parses inet_aton-style IPv4 notations: one to four parts,
+// each decimal, octal (leading 0), or hexadecimal (leading 0x), with the last
+// part filling the remaining bytes. Examples: "2130706433", "0x7f.1", "0177.0.0.1".
+func parseLegacyIPv4(host string) (netip.Addr, bool) {
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+
+	values := make([]uint64, len(parts))
+	for i, part := range parts {
+		if part == "" {
+			return netip.Addr{}, false
+		}
+		base := 10
+		digits := part
+		switch {
+		case strings.HasPrefix(strings.ToLower(part), "0x"):
+			base, digits = 16, part[2:]
+			if digits == "" {
+				digits = "0"
+			}
+		case len(part) > 1 && part[0] == '0':
+			base, digits = 8, part[1:]
+		}
+		n, err := strconv.ParseUint(digits, base, 32)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		values[i] = n
+	}
+
+	var ip uint64
+	for i, v := range values[:len(values)-1] {
+		if v > 0xff {
+			return netip.Addr{}, false
+		}
+		ip |= v << (8 * (3 - i))
+	}
+	last := values[len(values)-1]
+	if last >= 1<<(8*(5-len(values))) {
+		return netip.Addr{}, false
+	}
+	ip |= last
+
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true
+}
+
+// reservedPrefixes are IPv4 ranges not covered by the netip.Addr predicates.
+var reservedPrefixes = []struct {
+	prefix netip.Prefix
+	reason string
+}{
+	{netip.MustParsePrefix("0.0.0.0/8"), "in the \"this network\" range"},
+	{netip.MustParsePrefix("100.64.0.0/10"), "in the carrier-grade NAT range"},
+	{netip.MustParsePrefix("192.0.0.0/24"), "in the IETF protocol assignments range"},
+	{netip.MustParsePrefix("198.18.0.0/15"), "in the benchmarking range"},
+	{netip.MustParsePrefix("240.0.0.0/4"), "in the reserved range"},
+	{netip.MustParsePrefix("fec0::/10"), "a site-local address"},
+}
+
+// embeddedIPv4Prefixes are IPv6 ranges that embed an IPv4 address, which is
+// checked in turn.
+var embeddedIPv4Prefixes = []struct {
+	prefix netip.Prefix
+	offset int
+}{
+	{netip.MustParsePrefix("64:ff9b::/96"), 12}, // NAT64
+	{netip.MustParsePrefix("2002::/16"), 2},     // 6to4
+	{netip.MustParsePrefix("::/96"), 12},        // IPv4-compatible (deprecated)
+}
+
+// reservedReason returns why addr is not a public address, or "" if it is.
+func reservedReason(addr netip.Addr) string {
+	addr = addr.Unmap()
+
+	if addr.Is6() {
+		for _, e := range embeddedIPv4Prefixes {
+			if !e.prefix.Contains(addr) || addr.IsUnspecified() || addr.IsLoopback() {
+				continue
+			}
+			b := addr.As16()
+			embedded := netip.AddrFrom4([4]byte{b[e.offset], b[e.offset+1], b[e.offset+2], b[e.offset+3]})
+			if reason := reservedReason(embedded); reason != "" {
+				return reason + " (embedded in " + addr.String() + ")"
+			}
+		}
+	}
+
+	switch {
+	case addr.IsLoopback():
+		return "a loopback address"
+	case addr.IsUnspecified():
+		return "an unspecified address"
+	case addr.IsPrivate():
+		return "a private address"
+	case addr.IsLinkLocalUnicast():
+		return "a link-local address"
+	case addr.IsLinkLocalMulticast(), addr.IsInterfaceLocalMulticast(), addr.IsMulticast():
+		return "a multicast address"
+	}
+	for _, r := range reservedPrefixes {
+		if r.prefix.Contains(addr) {
+			return r.reason
+		}
+	}
+	return ""
+}
+
+// shellConfusables maps non-ASCII characters to the shell metacharacter they
+// resemble, to produce clearer error messages.
+var shellConfusables = map[rune]rune{
+	'；': ';', // FULLWIDTH SEMICOLON
+	'﹔': ';', // SMALL SEMICOLON
+	';': ';', // GREEK QUESTION MARK
+	'＆': '&', // FULLWIDTH AMPERSAND
+	'﹠': '&', // SMALL AMPERSAND
+	'｜': '|', // FULLWIDTH VERTICAL LINE
+	'ǀ': '|', // LATIN LETTER DENTAL CLICK
+	'∣': '|', // DIVIDES
+	'＄': '$', // FULLWIDTH DOLLAR SIGN
+	'﹩': '$', // SMALL DOLLAR SIGN
+	'｀': '`', // FULLWIDTH GRAVE ACCENT
+	'ˋ': '`', // MODIFIER LETTER GRAVE ACCENT
+	'＜': '<', // FULLWIDTH LESS-THAN SIGN
+	'＞': '>', // FULLWIDTH GREATER-THAN SIGN
+	'（': '(', // FULLWIDTH LEFT PARENTHESIS
+	'）': ')', // FULLWIDTH RIGHT PARENTHESIS
+}
+
+// checkShellSafe implements GuardShellSafe.
+func checkShellSafe(value string) error {
+	if strings.HasPrefix(value, "-") {
+		return fmt.Errorf("value must not start with '-' (option injection)")
+	}
+	for i, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("-_.,:/=@+%", r):
+		case r == utf8.RuneError:
+			return fmt.Errorf("invalid UTF-8 at offset %d", i)
+		case r >= utf8.RuneSelf:
+			if meta, ok := shellConfusables[r]; ok {
+				return fmt.Errorf("character %q at offset %d resembles shell metacharacter %q", r, i, meta)
+			}
+			return fmt.Errorf("non-ASCII character %q at offset %d is not allowed", r, i)
+		default:
+			return fmt.Errorf("character %q at offset %d is not allowed", r, i)
+		}
+	}
+	return nil
+}
+
+// isDecimal returns true if s is a non-empty string of ASCII digits.
+func isDecimal(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,298 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+// guardCase is a single input for a guard along with whether it must pass.
+type guardCase struct {
+	value string
+	allow bool
+}
+
+func runGuardCases(t *testing.T, guard string, cases []guardCase) {
+	t.Helper()
+	for _, tc := range cases {
+		err := CheckGuard(guard, tc.value)
+		if tc.allow && err != nil {
+			t.Errorf("%s: expected %q to be allowed, got error: %v", guard, tc.value, err)
+		}
+		if !tc.allow && err == nil {
+			t.Errorf("%s: expected %q to be rejected, got nil", guard, tc.value)
+		}
+	}
+}
+
+func TestGuardNoPathTraversal(t *testing.T) {
+	runGuardCases(t, GuardNoPathTraversal, []guardCase{
+		// Legitimate paths
+		{"reports/scan.txt", true},
+		{"/var/log/app.log", true},
+		{"file..name.txt", true},
+		{".hidden/config", true},
+		{"./relative", true},
+		{"100%", true},
+
+		// Plain traversal
+		{"../../etc/passwd", false},
+		{"..", false},
+		{"logs/../../etc/shadow", false},
+		{`..\..\windows\win.ini`, false},
+		{"logs/...", false},
+
+		// Percent-encoded traversal
+		{"%2e%2e/%2e%2e/etc/passwd", false},
+		{"%2E%2E%2Fetc%2Fpasswd", false},
+		{"..%2fetc%2fpasswd", false},
+		{"%252e%252e%252fetc", false},
+		{"%25252e%25252e/etc", false},
+		{"..%5cwindows", false},
+
+		// Overlong UTF-8 encodings of '.' and '/'
+		{"%c0%ae%c0%ae/etc/passwd", false},
+		{"..%c0%afetc", false},
+
+		// Unicode look-alikes
+		{"．．/etc/passwd", false},
+		{"..／etc／passwd", false},
+		{"․․/etc", false},
+		{"..＼windows", false},
+
+		// Path parameters, trailing spaces, NUL bytes
+		{"..;/admin", false},
+		{".. /etc", false},
+		{"safe.txt%00../", false},
+	})
+}
+
+func TestGuardHostnameOnly(t *testing.T) {
+	runGuardCases(t, GuardHostnameOnly, []guardCase{
+		// Legitimate hosts
+		{"example.com", true},
+		{"api.example.com.", true},
+		{"host-1", true},
+		{"xn--bcher-kva.example", true},
+		{"192.168.1.10", true},
+		{"2001:db8::1", true},
+
+		// Injection and non-host values
+		{"", false},
+		{"localhost; curl evil", false},
+		{"example.com && id", false},
+		{"$(whoami).example.com", false},
+		{"http://example.com", false},
+		{"example.com:8080", false},
+		{"example.com/path", false},
+		{"user@example.com", false},
+		{"example .com", false},
+		{"example.com\n", false},
+		{"-oProxyCommand", false},
+		{"a..b", false},
+		{strings.Repeat("a", 64) + ".com", false},
+		{"fe80::1%eth0", false},
+
+		// Non-ASCII and homoglyphs
+		{"exаmple.com", false}, // Cyrillic 'а'
+		{"example．com", false},
+
+		// Non-canonical numeric IPv4
+		{"2130706433", false},
+		{"0x7f.1", false},
+		{"0177.0.0.1", false},
+		{"127.1", false},
+		{"1.2.3.04", false},
+		{"example.123", false},
+	})
+}
+
+func TestGuardPrivateIPForbidden(t *testing.T) {
+	runGuardCases(t, GuardPrivateIPForbidden, []guardCase{
+		// Public addresses and hostnames
+		{"8.8.8.8", true},
+		{"example.com", true},
+		{"https://example.com/path", true},
+		{"example.com:443", true},
+		{"2606:4700:4700::1111", true},
+		{"[2606:4700:4700::1111]:443", true},
+		{"http://93.184.216.34/", true},
+
+		// Loopback names
+		{"localhost", false},
+		{"LOCALHOST.", false},
+		{"app.localhost", false},
+		{"ip6-localhost", false},
+		{"http://localhost:8080/admin", false},
+
+		// Private and reserved IPv4
+		{"127.0.0.1", false},
+		{"10.0.0.5", false},
+		{"172.16.3.4", false},
+		{"192.168.0.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"http://169.254.169.254/latest/meta-data/", false},
+		{"127.0.0.1:6379", false},
+
+		// Legacy numeric IPv4 forms
+		{"2130706433", false},
+		{"0x7f000001", false},
+		{"0x7f.1", false},
+		{"0177.0.0.1", false},
+		{"127.1", false},
+		{"http://2130706433/", false},
+
+		// IPv6 loopback and private forms
+		{"::1", false},
+		{"[::1]", false},
+		{"[::1]:80", false},
+		{"0:0:0:0:0:0:0:1", false},
+		{"::", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:7f00:1", false},
+		{"[::ffff:10.0.0.1]:22", false},
+		{"::127.0.0.1", false},
+		{"64:ff9b::7f00:1", false},
+		{"2002:7f00:1::", false},
+		{"fc00::1", false},
+		{"fd12:3456::1", false},
+		{"fe80::1", false},
+		{"fe80::1%eth0", false},
+		{"http://[::1]/", false},
+
+		// Obfuscation through URLs
+		{"http://example.com@127.0.0.1/", false},
+		{"http://%31%32%37.0.0.1/", false},
+		{"user@10.0.0.1", false},
+	})
+}
+
+func TestGuardShellSafe(t *testing.T) {
+	runGuardCases(t, GuardShellSafe, []guardCase{
+		// Legitimate arguments
+		{"example.com", true},
+		{"10.0.0.1/24", true},
+		{"https://example.com/a", true},
+		{"user@host:22", true},
+		{"key=value,other+1", true},
+		{"report_2024-01.txt", true},
+
+		// ASCII metacharacters
+		{"localhost; curl evil", false},
+		{"a&&b", false},
+		{"a|b", false},
+		{"$(id)", false},
+		{"`id`", false},
+		{"a>b", false},
+		{"a<b", false},
+		{"a b", false},
+		{"a\nb", false},
+		{"a\tb", false},
+		{"'quoted'", false},
+		{"\"quoted\"", false},
+		{"a\\b", false},
+		{"*", false},
+		{"~root", false},
+		{"a\x00b", false},
+
+		// Option injection
+		{"-oProxyCommand=id", false},
+		{"--output=/etc/passwd", false},
+
+		// Unicode homoglyphs of metacharacters
+		{"localhost；curl evil", false},
+		{"a＆＆b", false},
+		{"a｜b", false},
+		{"＄(id)", false},
+		{"｀id｀", false},
+		{"a\u037eb", false}, // Greek question mark
+		{"a\u00a0b", false}, // no-break space
+		{"a\u2028b", false}, // line separator
+		{"\xff", false},
+	})
+}
+
+func TestGuardErrorMessages(t *testing.T) {
+	tests := []struct {
+		guard string
+		value string
+		want  string
+	}{
+		{GuardNoPathTraversal, "%2e%2e/etc", `guard no_path_traversal: path segment ".." references a parent directory`},
+		{GuardHostnameOnly, "host;id", `guard hostname_only: character ';' at offset 4 is not allowed in a hostname`},
+		{GuardPrivateIPForbidden, "::ffff:127.0.0.1", "guard private_ip_forbidden: address ::ffff:127.0.0.1 is a loopback address"},
+		{GuardShellSafe, "a；b", `guard shell_safe: character '；' at offset 1 resembles shell metacharacter ';'`},
+		{"no_such_guard", "x", `unknown guard "no_such_guard"`},
+	}
+
+	for _, tt := range tests {
+		err := CheckGuard(tt.guard, tt.value)
+		if err == nil {
+			t.Errorf("%s(%q): expected error, got nil", tt.guard, tt.value)
+			continue
+		}
+		if err.Error() != tt.want {
+			t.Errorf("%s(%q): expected error %q, got %q", tt.guard, tt.value, tt.want, err.Error())
+		}
+	}
+}
+
+func TestValidateEnforcesGuards(t *testing.T) {
+	s := Object(map[string]JSON{
+		"host":  {Type: "string", Guards: []string{GuardHostnameOnly, GuardPrivateIPForbidden}},
+		"paths": Array(JSON{Type: "string", Guards: []string{GuardNoPathTraversal}}),
+	}, "host")
+
+	if err := s.Validate(map[string]any{"host": "example.com", "paths": []any{"a.txt"}}); err != nil {
+		t.Errorf("expected valid input, got error: %v", err)
+	}
+
+	err := s.Validate(map[string]any{"host": "localhost; curl evil"})
+	if err == nil || !strings.Contains(err.Error(), "hostname_only") {
+		t.Errorf("expected hostname_only violation, got %v", err)
+	}
+
+	err = s.Validate(map[string]any{"host": "10.0.0.1"})
+	if err == nil || !strings.Contains(err.Error(), "private_ip_forbidden") {
+		t.Errorf("expected private_ip_forbidden violation, got %v", err)
+	}
+
+	err = s.Validate(map[string]any{"host": "example.com", "paths": []any{"ok.txt", "../secret"}})
+	if err == nil || !strings.Contains(err.Error(), "no_path_traversal") {
+		t.Errorf("expected no_path_traversal violation, got %v", err)
+	}
+
+	unknown := JSON{Type: "string", Guards: []string{"shell_sfe"}}
+	if err := unknown.Validate("anything"); err == nil {
+		t.Error("expected unknown guard to fail closed, got nil")
+	}
+}
+
+func TestFromTypeGuardsTag(t *testing.T) {
+	type input struct {
+		Target string   `json:"target" jsonschema:"guards=hostname_only|private_ip_forbidden"`
+		Files  []string `json:"files" jsonschema:"guards=no_path_traversal"`
+	}
+
+	s := FromType(input{})
+	if got := s.Properties["target"].Guards; len(got) != 2 || got[0] != GuardHostnameOnly || got[1] != GuardPrivateIPForbidden {
+		t.Errorf("expected target guards, got %v", got)
+	}
+	if got := s.Properties["files"].Items.Guards; len(got) != 1 || got[0] != GuardNoPathTraversal {
+		t.Errorf("expected item guards on files, got %v", got)
+	}
+}
+
+func TestIsKnownGuard(t *testing.T) {
+	for _, g := range []string{GuardNoPathTraversal, GuardHostnameOnly, GuardPrivateIPForbidden, GuardShellSafe} {
+		if !IsKnownGuard(g) {
+			t.Errorf("expected %q to be known", g)
+		}
+	}
+	if IsKnownGuard("shell") {
+		t.Error("expected unknown guard to be reported")
+	}
+}
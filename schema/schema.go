@@ -25,6 +25,10 @@ type JSON struct {
 	Pattern     string          `json:"pattern,omitempty"`
 	Format      string          `json:"format,omitempty"`
 	Ref         string          `json:"$ref,omitempty"`
+
+	// Guards lists input guards (e.g. GuardShellSafe) enforced on string
+	// values during validation. See CheckGuard.
+	Guards []string `json:"x-guards,omitempty"`
 }
 
 // Any creates a JSON schema that accepts any type.
@@ -238,6 +242,11 @@ func (s JSON) validateString(value any) error {
 		}
 	}
 
+	// Validate input guards
+	if err := s.checkGuards(str); err != nil {
+		return err
+	}
+
 	return nil
 }
 
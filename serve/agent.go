@@ -4,17 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"strings"
+	"sync"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/zero-day-ai/sdk/agent"
 	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/types"
 	"go.opentelemetry.io/otel/sdk/trace"
 	otelTrace "go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
@@ -33,111 +32,94 @@ import (
 //	    log.Fatal(err)
 //	}
 func Agent(a agent.Agent, opts ...Option) error {
-	// Build configuration
-	cfg := DefaultConfig()
-	for _, opt := range opts {
-		opt(cfg)
-	}
-
-	// Create server
-	srv, err := NewServer(cfg)
+	srv, err := New(opts...)
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
-
-	// Create and register agent service
-	agentSvc := &agentServiceServer{
-		agent: a,
-	}
-	proto.RegisterAgentServiceServer(srv.GRPCServer(), agentSvc)
-
-	// Set health status to serving
-	srv.HealthServer().SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
-
-	slog.Info("agent server started", "component", "agent", "name", a.Name(), "version", a.Version(), "port", srv.Port())
-
-	// Register with registry if configured
-	var serviceInfo interface{}
-	if cfg.Registry != nil {
-		// Build endpoint based on LocalMode, AdvertiseAddr, or TCP
-		endpoint := ""
-		if cfg.LocalMode != "" {
-			endpoint = fmt.Sprintf("unix://%s", cfg.LocalMode)
-		} else if cfg.AdvertiseAddr != "" {
-			// Use advertise address - append port if not present
-			if strings.Contains(cfg.AdvertiseAddr, ":") {
-				endpoint = cfg.AdvertiseAddr
-			} else {
-				endpoint = fmt.Sprintf("%s:%d", cfg.AdvertiseAddr, srv.Port())
-			}
-		} else {
-			endpoint = fmt.Sprintf("localhost:%d", srv.Port())
-		}
-
-		// Extract agent metadata (already strings)
-		capabilities := a.Capabilities()
-		targetTypes := a.TargetTypes()
-		techniqueTypes := a.TechniqueTypes()
-
-		// Create ServiceInfo struct (using map to avoid circular dependency)
-		serviceInfo = map[string]interface{}{
-			"kind":        "agent",
-			"name":        a.Name(),
-			"version":     a.Version(),
-			"instance_id": uuid.New().String(),
-			"endpoint":    endpoint,
-			"metadata": map[string]string{
-				"description":     a.Description(),
-				"capabilities":    strings.Join(capabilities, ","),
-				"target_types":    strings.Join(targetTypes, ","),
-				"technique_types": strings.Join(techniqueTypes, ","),
-			},
-			"started_at": time.Now(),
-		}
-
-		// Register with the registry
-		ctx := context.Background()
-		if err := cfg.Registry.Register(ctx, serviceInfo); err != nil {
-			slog.Warn("failed to register with registry", "error", err, "endpoint", endpoint, "component", "agent", "name", a.Name())
-		} else {
-			slog.Info("registered with registry", "endpoint", endpoint, "component", "agent", "name", a.Name())
-			// Deregister on shutdown
-			defer func() {
-				ctx := context.Background()
-				if err := cfg.Registry.Deregister(ctx, serviceInfo); err != nil {
-					slog.Warn("failed to deregister from registry", "error", err, "endpoint", endpoint, "component", "agent", "name", a.Name())
-				}
-			}()
-		}
+	if err := srv.RegisterAgent(a); err != nil {
+		return err
 	}
-
-	// Start serving
 	return srv.Serve(context.Background())
 }
 
+// harnessShutdownTimeout bounds the final token usage report sent when a
+// task ends.
+const harnessShutdownTimeout = 5 * time.Second
+
 // agentServiceServer implements the gRPC AgentService for an SDK agent.
 // It bridges the gRPC protocol to the agent.Agent interface.
+//
+// Execute calls may run concurrently. Each call gets a fresh CallbackHarness
+// (token tracker, list caches, task-scoped memory), while the connection to
+// each callback endpoint is shared across calls.
 type agentServiceServer struct {
 	proto.UnimplementedAgentServiceServer
 	agent agent.Agent
+
+	// slots bounds in-flight Execute calls; nil means unlimited
+	slots chan struct{}
+
+	// harnessOpts configures the callback harness of each task
+	harnessOpts HarnessOptions
+
+	// skipTargetValidation disables the pre-flight check of task targets
+	// against the agent's target schemas
+	skipTargetValidation bool
+
+	// queryCache is shared by the harnesses of all tasks, so that tasks of
+	// the same mission reuse each other's GraphRAG query results; nil
+	// disables caching
+	queryCache *queryCache
+
+	// callbackClients holds one shared connection per endpoint and token
+	clientsMu       sync.Mutex
+	callbackClients map[callbackKey]*CallbackClient
+}
+
+// callbackKey identifies a shared callback connection.
+type callbackKey struct {
+	endpoint string
+	token    string
+}
+
+// newAgentServiceServer creates an agent service that runs at most
+// maxConcurrentTasks Execute calls at once. Zero or less means no limit.
+func newAgentServiceServer(a agent.Agent, maxConcurrentTasks int) *agentServiceServer {
+	s := &agentServiceServer{agent: a}
+	if maxConcurrentTasks > 0 {
+		s.slots = make(chan struct{}, maxConcurrentTasks)
+	}
+	return s
 }
 
 // GetDescriptor returns the agent's descriptor including name, version,
-// capabilities, target types, and technique types.
+// capabilities, target types and schemas, technique types, and required
+// tools and plugins.
 func (s *agentServiceServer) GetDescriptor(ctx context.Context, req *proto.AgentGetDescriptorRequest) (*proto.AgentDescriptor, error) {
 	// Agent methods now return []string directly
 	capabilities := s.agent.Capabilities()
 	targetTypes := s.agent.TargetTypes()
 	techniqueTypes := s.agent.TechniqueTypes()
 
-	return &proto.AgentDescriptor{
+	targetSchemas, err := TargetSchemasToProto(s.agent.TargetSchemas())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	desc := &proto.AgentDescriptor{
 		Name:           s.agent.Name(),
 		Version:        s.agent.Version(),
 		Description:    s.agent.Description(),
 		Capabilities:   capabilities,
+		TargetSchemas:  targetSchemas,
 		TargetTypes:    targetTypes,
 		TechniqueTypes: techniqueTypes,
-	}, nil
+	}
+	if reqs, ok := s.agent.(agent.RequirementsProvider); ok {
+		desc.RequiredTools = reqs.RequiredTools()
+		desc.RequiredPlugins = reqs.RequiredPlugins()
+	}
+	return desc, nil
 }
 
 // GetSlotSchema returns the LLM slot definitions required by the agent.
@@ -179,10 +161,45 @@ func (s *agentServiceServer) GetSlotSchema(ctx context.Context, req *proto.Agent
 	}, nil
 }
 
+// DescribeTargetSchemas returns the target schemas the agent declares, so
+// the daemon or UI can render target configuration forms. Tasks whose target
+// does not match them are rejected unless target validation is skipped.
+func (s *agentServiceServer) DescribeTargetSchemas(ctx context.Context, req *proto.AgentDescribeTargetSchemasRequest) (*proto.AgentDescribeTargetSchemasResponse, error) {
+	targetSchemas, err := TargetSchemasToProto(s.agent.TargetSchemas())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &proto.AgentDescribeTargetSchemasResponse{TargetSchemas: targetSchemas}, nil
+}
+
+// validateTarget checks the target of a task against the agent's target
+// schemas before the agent runs. A task without a target, or an agent that
+// declares no schemas, passes. A mismatch fails with codes.InvalidArgument
+// listing every offending field.
+func (s *agentServiceServer) validateTarget(target *proto.TypedMap) error {
+	if s.skipTargetValidation || target == nil {
+		return nil
+	}
+	if err := types.ValidateTargetConfig(ProtoToTargetInfo(target), s.agent.TargetSchemas()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
 // Execute runs the agent with the provided task.
 // The task is provided as a typed proto message and the result is
 // returned as a typed proto message.
 func (s *agentServiceServer) Execute(ctx context.Context, req *proto.AgentExecuteRequest) (*proto.AgentExecuteResponse, error) {
+	release, err := s.acquireSlot()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := s.validateTarget(req.Target); err != nil {
+		return nil, err
+	}
+
 	// Convert proto task to SDK task
 	task := ProtoToTask(req.Task)
 
@@ -203,9 +220,15 @@ func (s *agentServiceServer) Execute(ctx context.Context, req *proto.AgentExecut
 		}
 		harness = callbackHarness
 		tracerProvider = tp
-		// Clean up callback client and tracer provider when done
+		// Release the task's client view and tracer provider when done;
+		// the shared connection stays open for other tasks
 		defer func() {
 			if ch, ok := harness.(*CallbackHarness); ok && ch.client != nil {
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), harnessShutdownTimeout)
+				if err := ch.Shutdown(shutdownCtx); err != nil {
+					slog.Warn("failed to shutdown callback harness", "error", err)
+				}
+				cancel()
 				ch.client.Close()
 			}
 			if tracerProvider != nil {
@@ -239,21 +262,11 @@ func (s *agentServiceServer) Execute(ctx context.Context, req *proto.AgentExecut
 }
 
 // createCallbackHarness creates a CallbackHarness connected to the orchestrator.
+// The harness uses a task-scoped view of the shared callback connection.
 func (s *agentServiceServer) createCallbackHarness(ctx context.Context, req *proto.AgentExecuteRequest, task agent.Task) (*CallbackHarness, *trace.TracerProvider, error) {
-	// Create callback client options
-	var clientOpts []CallbackClientOption
-	if req.CallbackToken != "" {
-		clientOpts = append(clientOpts, WithCallbackToken(req.CallbackToken))
-	}
-
-	// Create and connect callback client
-	client, err := NewCallbackClient(req.CallbackEndpoint, clientOpts...)
+	shared, err := s.callbackClient(ctx, req.CallbackEndpoint, req.CallbackToken)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create callback client: %w", err)
-	}
-
-	if err := client.Connect(ctx); err != nil {
-		return nil, nil, fmt.Errorf("failed to connect to orchestrator: %w", err)
+		return nil, nil, err
 	}
 
 	// Convert mission context from proto TypedMap
@@ -262,7 +275,7 @@ func (s *agentServiceServer) createCallbackHarness(ctx context.Context, req *pro
 	// Set full task context for callback requests including mission-scoped storage fields
 	// Pass all context explicitly so the callback service can use them directly
 	// for mission-based harness lookup and GraphRAG storage
-	client.SetFullContext(TaskContextParams{
+	client := shared.ForTask(TaskContextParams{
 		TaskID:       task.ID,
 		AgentName:    s.agent.Name(),
 		MissionID:    mission.ID,
@@ -300,11 +313,83 @@ func (s *agentServiceServer) createCallbackHarness(ctx context.Context, req *pro
 	}
 
 	// Create the callback harness
-	harness := NewCallbackHarness(client, logger, tracer, mission, target)
+	harness := s.newHarness(client, logger, tracer, mission, target)
 
 	return harness, tracerProvider, nil
 }
 
+// newHarness creates the callback harness of a task.
+func (s *agentServiceServer) newHarness(client *CallbackClient, logger *slog.Logger, tracer otelTrace.Tracer, mission types.MissionContext, target types.TargetInfo) *CallbackHarness {
+	harness := NewCallbackHarnessWithOptions(client, logger, tracer, mission, target, s.harnessOpts)
+	if s.queryCache != nil {
+		harness.queryCache = s.queryCache
+	}
+	return harness
+}
+
+// acquireSlot reserves an in-flight task slot. It fails with
+// codes.ResourceExhausted rather than queueing when all slots are taken.
+func (s *agentServiceServer) acquireSlot() (func(), error) {
+	if s.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case s.slots <- struct{}{}:
+		return func() { <-s.slots }, nil
+	default:
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"agent %s is at its limit of %d concurrent tasks", s.agent.Name(), cap(s.slots))
+	}
+}
+
+// callbackClient returns the shared, connected callback client for an
+// endpoint and token, dialing it on first use or after the connection drops.
+func (s *agentServiceServer) callbackClient(ctx context.Context, endpoint, token string) (*CallbackClient, error) {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+
+	key := callbackKey{endpoint: endpoint, token: token}
+	if client, ok := s.callbackClients[key]; ok {
+		if client.IsConnected() {
+			return client, nil
+		}
+		client.Close()
+		delete(s.callbackClients, key)
+	}
+
+	var clientOpts []CallbackClientOption
+	if token != "" {
+		clientOpts = append(clientOpts, WithCallbackToken(token))
+	}
+
+	client, err := NewCallbackClient(endpoint, clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create callback client: %w", err)
+	}
+
+	if err := client.Connect(ctx); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to orchestrator: %w", err)
+	}
+
+	if s.callbackClients == nil {
+		s.callbackClients = make(map[callbackKey]*CallbackClient)
+	}
+	s.callbackClients[key] = client
+	return client, nil
+}
+
+// closeCallbackClients closes all shared callback connections.
+func (s *agentServiceServer) closeCallbackClients() {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+
+	for key, client := range s.callbackClients {
+		client.Close()
+		delete(s.callbackClients, key)
+	}
+}
+
 // Health returns the current health status of the agent.
 func (s *agentServiceServer) Health(ctx context.Context, req *proto.AgentHealthRequest) (*proto.HealthStatus, error) {
 	health := s.agent.Health(ctx)
@@ -2,17 +2,27 @@ package serve
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zero-day-ai/sdk/agent"
 	"github.com/zero-day-ai/sdk/api/gen/proto"
 	"github.com/zero-day-ai/sdk/llm"
+	"github.com/zero-day-ai/sdk/schema"
+	"github.com/zero-day-ai/sdk/target"
 	"github.com/zero-day-ai/sdk/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -82,11 +92,15 @@ func (m *mockAgent) Health(ctx context.Context) types.HealthStatus {
 
 // setupAgentTestServer creates an in-memory gRPC server for testing using bufconn.
 func setupAgentTestServer(t *testing.T, a agent.Agent) (*grpc.ClientConn, func()) {
+	return setupAgentServiceTestServer(t, &agentServiceServer{agent: a})
+}
+
+// setupAgentServiceTestServer serves a preconfigured agent service over bufconn.
+func setupAgentServiceTestServer(t *testing.T, agentSvc *agentServiceServer) (*grpc.ClientConn, func()) {
 	const bufSize = 1024 * 1024
 	lis := bufconn.Listen(bufSize)
 
 	srv := grpc.NewServer()
-	agentSvc := &agentServiceServer{agent: a}
 	proto.RegisterAgentServiceServer(srv, agentSvc)
 
 	// Start server
@@ -137,6 +151,27 @@ func TestAgentServiceServer_GetDescriptor(t *testing.T) {
 	assert.Contains(t, resp.TechniqueTypes, "prompt_injection")
 }
 
+func TestAgentServiceServer_GetDescriptorRequirements(t *testing.T) {
+	a, err := agent.New(agent.NewConfig().
+		SetName("recon").
+		SetVersion("1.0.0").
+		SetDescription("Recon agent").
+		RequireTools("nmap", "http-request").
+		RequirePlugins("cve-lookup").
+		SetExecuteFunc(func(ctx context.Context, harness agent.Harness, task agent.Task) (agent.Result, error) {
+			return agent.NewSuccessResult("done"), nil
+		}))
+	require.NoError(t, err)
+
+	conn, cleanup := setupAgentTestServer(t, a)
+	defer cleanup()
+
+	resp, err := proto.NewAgentServiceClient(conn).GetDescriptor(context.Background(), &proto.AgentGetDescriptorRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nmap", "http-request"}, resp.RequiredTools)
+	assert.Equal(t, []string{"cve-lookup"}, resp.RequiredPlugins)
+}
+
 func TestAgentServiceServer_GetSlotSchema(t *testing.T) {
 	mockA := &mockAgent{
 		name:    "test-agent",
@@ -192,6 +227,26 @@ func TestAgentServiceServer_Execute(t *testing.T) {
 				assert.Equal(t, agent.StatusSuccess, result.Status)
 			},
 		},
+		{
+			name: "refused execution",
+			task: agent.Task{
+				ID:      "test-task-refused",
+				Context: map[string]any{"objective": "Wipe the production database"},
+			},
+			executeFunc: func(ctx context.Context, harness agent.Harness, task agent.Task) (agent.Result, error) {
+				return agent.NewRefusedResult("destructive_action"), nil
+			},
+			wantErr: false,
+			checkResult: func(t *testing.T, resp *proto.AgentExecuteResponse) {
+				assert.Nil(t, resp.Error)
+				assert.Equal(t, proto.ResultStatus_RESULT_STATUS_REFUSED, resp.Result.GetStatus())
+				assert.Equal(t, "destructive_action", resp.Result.GetRefusalReason())
+
+				result := ProtoToResult(resp.Result)
+				assert.Equal(t, agent.StatusRefused, result.Status)
+				assert.Equal(t, "destructive_action", result.RefusalReason)
+			},
+		},
 		{
 			name: "execution with error",
 			task: agent.Task{
@@ -313,3 +368,250 @@ func TestAgentServiceServer_Health(t *testing.T) {
 		})
 	}
 }
+
+// tokenCountingServer answers LLM completions with a usage that depends on
+// the calling task, so leaked state between tasks shows up in token totals.
+type tokenCountingServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+}
+
+func (s *tokenCountingServer) LLMComplete(ctx context.Context, req *proto.LLMCompleteRequest) (*proto.LLMCompleteResponse, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(req.GetContext().GetTaskId(), "task-"))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LLMCompleteResponse{
+		Content: "ok",
+		Usage:   &proto.TokenUsage{InputTokens: int32(n), OutputTokens: 1, TotalTokens: int32(n + 1)},
+	}, nil
+}
+
+func TestAgentServiceServer_ConcurrentExecuteIsolation(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	callbackServer := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(callbackServer, &tokenCountingServer{})
+	go func() {
+		_ = callbackServer.Serve(lis)
+	}()
+	defer callbackServer.Stop()
+
+	const tasks = 8
+	const callsPerTask = 3
+
+	// Every task waits for all others to start so executions overlap
+	var started sync.WaitGroup
+	started.Add(tasks)
+
+	mockA := &mockAgent{
+		name:    "test-agent",
+		version: "1.0.0",
+		executeFunc: func(ctx context.Context, harness agent.Harness, task agent.Task) (agent.Result, error) {
+			started.Done()
+			started.Wait()
+			for i := 0; i < callsPerTask; i++ {
+				if _, err := harness.Complete(ctx, "primary", []llm.Message{{Role: llm.RoleUser, Content: "hi"}}); err != nil {
+					return agent.Result{}, err
+				}
+			}
+			return agent.NewSuccessResult(map[string]any{
+				"input_tokens": harness.TokenUsage().Total().InputTokens,
+			}), nil
+		},
+	}
+
+	svc := newAgentServiceServer(mockA, 0)
+	defer svc.closeCallbackClients()
+	conn, cleanup := setupAgentServiceTestServer(t, svc)
+	defer cleanup()
+	client := proto.NewAgentServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	results := make([]*proto.AgentExecuteResponse, tasks)
+	errs := make([]error, tasks)
+	for i := 0; i < tasks; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = client.Execute(ctx, &proto.AgentExecuteRequest{
+				Task:             TaskToProto(agent.Task{ID: fmt.Sprintf("task-%d", i+1)}),
+				CallbackEndpoint: lis.Addr().String(),
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < tasks; i++ {
+		require.NoError(t, errs[i])
+		require.Nil(t, results[i].Error, "task %d failed", i+1)
+		output, ok := ProtoToResult(results[i].Result).Output.(map[string]any)
+		require.True(t, ok)
+		assert.EqualValues(t, (i+1)*callsPerTask, output["input_tokens"], "task %d token usage", i+1)
+	}
+
+	svc.clientsMu.Lock()
+	assert.Len(t, svc.callbackClients, 1, "tasks share one callback connection")
+	svc.clientsMu.Unlock()
+}
+
+func TestAgentServiceServer_MaxConcurrentTasks(t *testing.T) {
+	running := make(chan struct{})
+	finish := make(chan struct{})
+	mockA := &mockAgent{
+		name:    "test-agent",
+		version: "1.0.0",
+		executeFunc: func(ctx context.Context, harness agent.Harness, task agent.Task) (agent.Result, error) {
+			if task.ID == "slow" {
+				close(running)
+				<-finish
+			}
+			return agent.NewSuccessResult("done"), nil
+		},
+	}
+
+	conn, cleanup := setupAgentServiceTestServer(t, newAgentServiceServer(mockA, 1))
+	defer cleanup()
+	client := proto.NewAgentServiceClient(conn)
+	ctx := context.Background()
+
+	slowDone := make(chan error, 1)
+	go func() {
+		_, err := client.Execute(ctx, &proto.AgentExecuteRequest{Task: TaskToProto(agent.Task{ID: "slow"})})
+		slowDone <- err
+	}()
+	<-running
+
+	_, err := client.Execute(ctx, &proto.AgentExecuteRequest{Task: TaskToProto(agent.Task{ID: "fast"})})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	close(finish)
+	require.NoError(t, <-slowDone)
+
+	// The slot is released once the slow task completes
+	resp, err := client.Execute(ctx, &proto.AgentExecuteRequest{Task: TaskToProto(agent.Task{ID: "fast"})})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Error)
+}
+
+// newKubernetesAgent creates an agent that declares the kubernetes target
+// schema and counts its executions.
+func newKubernetesAgent(t *testing.T, executed *int) agent.Agent {
+	a, err := agent.New(agent.NewConfig().
+		SetName("k8skiller").
+		SetVersion("1.0.0").
+		SetDescription("Kubernetes agent").
+		AddTargetSchema(target.KubernetesSchema).
+		SetExecuteFunc(func(ctx context.Context, harness agent.Harness, task agent.Task) (agent.Result, error) {
+			*executed++
+			return agent.NewSuccessResult("done"), nil
+		}))
+	require.NoError(t, err)
+	return a
+}
+
+func TestAgentServiceServer_TargetValidation(t *testing.T) {
+	executed := 0
+	conn, cleanup := setupAgentTestServer(t, newKubernetesAgent(t, &executed))
+	defer cleanup()
+	client := proto.NewAgentServiceClient(conn)
+	ctx := context.Background()
+
+	execute := func(ti types.TargetInfo) error {
+		_, err := client.Execute(ctx, &proto.AgentExecuteRequest{
+			Task:   TaskToProto(agent.Task{ID: "task-1"}),
+			Target: TargetInfoToProto(ti),
+		})
+		return err
+	}
+
+	t.Run("valid config", func(t *testing.T) {
+		err := execute(types.TargetInfo{Type: "kubernetes", Connection: map[string]any{"cluster": "prod", "namespace": "default"}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, executed)
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		err := execute(types.TargetInfo{Type: "kubernetes", Connection: map[string]any{"namespace": "default", "kubeconfig": 42}})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "connection.cluster: is required")
+		assert.Contains(t, status.Convert(err).Message(), "connection.kubeconfig:")
+		assert.Equal(t, 1, executed, "the agent must not run")
+	})
+
+	t.Run("unknown target type", func(t *testing.T) {
+		err := execute(types.TargetInfo{Type: "smart_contract", Connection: map[string]any{"address": "0xabc"}})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), `unsupported target type "smart_contract"`)
+		assert.Equal(t, 1, executed)
+	})
+
+	t.Run("no target", func(t *testing.T) {
+		_, err := client.Execute(ctx, &proto.AgentExecuteRequest{Task: TaskToProto(agent.Task{ID: "task-2"})})
+		require.NoError(t, err)
+		assert.Equal(t, 2, executed)
+	})
+}
+
+func TestAgentServiceServer_SkipTargetValidation(t *testing.T) {
+	executed := 0
+	svc := &agentServiceServer{agent: newKubernetesAgent(t, &executed), skipTargetValidation: true}
+	conn, cleanup := setupAgentServiceTestServer(t, svc)
+	defer cleanup()
+
+	_, err := proto.NewAgentServiceClient(conn).Execute(context.Background(), &proto.AgentExecuteRequest{
+		Task:   TaskToProto(agent.Task{ID: "task-1"}),
+		Target: TargetInfoToProto(types.TargetInfo{Type: "smart_contract"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, executed)
+}
+
+func TestAgentServiceServer_StreamExecuteTargetValidation(t *testing.T) {
+	executed := 0
+	server := &agentServiceServer{agent: newKubernetesAgent(t, &executed)}
+
+	stream := newMockStreamServer(context.Background())
+	stream.sendClientMessage(&proto.ClientMessage{
+		Payload: &proto.ClientMessage_Start{
+			Start: &proto.StartExecutionRequest{
+				Task:   TaskToProto(agent.Task{ID: "task-1"}),
+				Target: TargetInfoToProto(types.TargetInfo{Type: "kubernetes"}),
+			},
+		},
+	})
+
+	err := server.StreamExecute(stream)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Zero(t, executed)
+}
+
+func TestAgentServiceServer_DescribeTargetSchemas(t *testing.T) {
+	executed := 0
+	conn, cleanup := setupAgentTestServer(t, newKubernetesAgent(t, &executed))
+	defer cleanup()
+	client := proto.NewAgentServiceClient(conn)
+
+	resp, err := client.DescribeTargetSchemas(context.Background(), &proto.AgentDescribeTargetSchemasRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.TargetSchemas, 1)
+	ts := resp.TargetSchemas[0]
+	assert.Equal(t, "kubernetes", ts.Type)
+	assert.Equal(t, target.KubernetesSchema.Version, ts.Version)
+
+	var decoded schema.JSON
+	require.NoError(t, json.Unmarshal([]byte(ts.SchemaJson), &decoded))
+	assert.Equal(t, []string{"cluster"}, decoded.Required)
+	assert.Contains(t, decoded.Properties, "namespace")
+
+	desc, err := client.GetDescriptor(context.Background(), &proto.AgentGetDescriptorRequest{})
+	require.NoError(t, err)
+	require.Len(t, desc.TargetSchemas, 1)
+	assert.Equal(t, ts.SchemaJson, desc.TargetSchemas[0].SchemaJson)
+}
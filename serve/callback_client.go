@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
@@ -39,10 +40,19 @@ type CallbackClient struct {
 	agentRunID      string // Unique ID for this agent execution
 	runNumber       int32  // Sequential run number (1, 2, 3...)
 	toolExecutionID string // ID for tool execution provenance
+	stepID          string // Workflow step this execution belongs to
 
 	// Connection lifecycle
 	connected bool
 	closed    bool
+
+	// borrowed is set on task views created by ForTask. A borrowed client
+	// shares its parent's connection and never closes or redials it.
+	borrowed bool
+
+	// Load shedding, shared with task views
+	throttle      *loadShedder
+	meterProvider metric.MeterProvider
 }
 
 // NewCallbackClient creates a new callback client with the given endpoint.
@@ -54,6 +64,7 @@ func NewCallbackClient(endpoint string, opts ...CallbackClientOption) (*Callback
 
 	client := &CallbackClient{
 		endpoint: endpoint,
+		throttle: newLoadShedder(LoadShedConfig{}),
 	}
 
 	// Apply options
@@ -61,6 +72,13 @@ func NewCallbackClient(endpoint string, opts ...CallbackClientOption) (*Callback
 		opt(client)
 	}
 
+	if client.meterProvider != nil {
+		meter := client.meterProvider.Meter("github.com/zero-day-ai/sdk/serve")
+		if err := client.throttle.initMetrics(meter); err != nil {
+			return nil, fmt.Errorf("failed to create load shedding metrics: %w", err)
+		}
+	}
+
 	return client, nil
 }
 
@@ -91,6 +109,17 @@ func (c *CallbackClient) Connect(ctx context.Context) error {
 		return fmt.Errorf("client is closed")
 	}
 
+	// Task views cannot manage the shared connection
+	if c.borrowed {
+		if c.conn == nil {
+			return fmt.Errorf("shared connection is not established")
+		}
+		if state := c.conn.GetState(); state == connectivity.TransientFailure || state == connectivity.Shutdown {
+			return fmt.Errorf("shared connection is unavailable: state=%s", state)
+		}
+		return nil
+	}
+
 	// Check if already connected AND connection is actually healthy
 	if c.connected && c.conn != nil {
 		state := c.conn.GetState()
@@ -120,6 +149,12 @@ func (c *CallbackClient) Connect(ctx context.Context) error {
 		PermitWithoutStream: true,
 	}))
 
+	// Shed calls locally while the daemon is overloaded
+	dialOpts = append(dialOpts,
+		grpc.WithChainUnaryInterceptor(c.throttle.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(c.throttle.streamInterceptor),
+	)
+
 	// Create context with timeout for connection establishment
 	connCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -191,12 +226,93 @@ func (c *CallbackClient) SetFullContext(params TaskContextParams) {
 	c.toolExecutionID = params.ToolExecutionID
 }
 
-// contextInfo builds the ContextInfo proto message with current task context.
-func (c *CallbackClient) contextInfo() *proto.ContextInfo {
+// ForTask returns a task-scoped view of the client that shares the underlying
+// gRPC connection but carries its own task context. Setting context on the
+// view does not affect the parent or other views, so concurrent tasks can
+// share one connection without racing on SetFullContext. Closing the view
+// releases only the view; the connection stays open until the parent is closed.
+func (c *CallbackClient) ForTask(params TaskContextParams) *CallbackClient {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	return &proto.ContextInfo{
+	view := &CallbackClient{
+		conn:      c.conn,
+		client:    c.client,
+		endpoint:  c.endpoint,
+		tlsConf:   c.tlsConf,
+		token:     c.token,
+		connected: c.connected,
+		closed:    c.closed,
+		borrowed:  true,
+		throttle:  c.throttle,
+	}
+	view.SetFullContext(params)
+	return view
+}
+
+// ExecutionContext identifies the execution scope that callback requests are
+// attributed to. The daemon uses these IDs to scope GraphRAG storage, memory,
+// and provenance relationships.
+type ExecutionContext struct {
+	MissionID    string // Mission the execution belongs to
+	MissionRunID string // Unique ID for this mission execution
+	AgentRunID   string // Unique ID for this agent execution
+	StepID       string // Workflow step this execution belongs to
+}
+
+// executionContextKey is the context key for per-call ExecutionContext overrides.
+type executionContextKey struct{}
+
+// WithExecutionContext returns a copy of ctx carrying ec as a per-call override.
+// Callback requests made with the returned context use the non-empty fields of
+// ec in place of the client-level defaults set via SetExecutionContext or
+// SetFullContext. This is needed when one harness serves nested delegations,
+// so that calls made on behalf of a child agent carry the child's run IDs.
+func WithExecutionContext(ctx context.Context, ec ExecutionContext) context.Context {
+	return context.WithValue(ctx, executionContextKey{}, ec)
+}
+
+// ExecutionContextFromContext returns the ExecutionContext override stored in
+// ctx by WithExecutionContext, if any.
+func ExecutionContextFromContext(ctx context.Context) (ExecutionContext, bool) {
+	if ctx == nil {
+		return ExecutionContext{}, false
+	}
+	ec, ok := ctx.Value(executionContextKey{}).(ExecutionContext)
+	return ec, ok
+}
+
+// SetExecutionContext sets the client-level execution context used by
+// subsequent RPC calls. Per-call values set with WithExecutionContext take
+// precedence over these defaults.
+func (c *CallbackClient) SetExecutionContext(ec ExecutionContext) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.missionID = ec.MissionID
+	c.missionRunID = ec.MissionRunID
+	c.agentRunID = ec.AgentRunID
+	c.stepID = ec.StepID
+}
+
+// ExecutionContext returns the client-level execution context.
+func (c *CallbackClient) ExecutionContext() ExecutionContext {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return ExecutionContext{
+		MissionID:    c.missionID,
+		MissionRunID: c.missionRunID,
+		AgentRunID:   c.agentRunID,
+		StepID:       c.stepID,
+	}
+}
+
+// contextInfo builds the ContextInfo proto message with current task context.
+// Execution context values carried by ctx override the client-level defaults.
+func (c *CallbackClient) contextInfo(ctx context.Context) *proto.ContextInfo {
+	c.mu.RLock()
+	info := &proto.ContextInfo{
 		TaskId:          c.taskID,
 		AgentName:       c.agentName,
 		MissionId:       c.missionID,
@@ -206,7 +322,26 @@ func (c *CallbackClient) contextInfo() *proto.ContextInfo {
 		AgentRunId:      c.agentRunID,
 		RunNumber:       c.runNumber,
 		ToolExecutionId: c.toolExecutionID,
+		StepId:          c.stepID,
+	}
+	c.mu.RUnlock()
+
+	if ec, ok := ExecutionContextFromContext(ctx); ok {
+		if ec.MissionID != "" {
+			info.MissionId = ec.MissionID
+		}
+		if ec.MissionRunID != "" {
+			info.MissionRunId = ec.MissionRunID
+		}
+		if ec.AgentRunID != "" {
+			info.AgentRunId = ec.AgentRunID
+		}
+		if ec.StepID != "" {
+			info.StepId = ec.StepID
+		}
 	}
+
+	return info
 }
 
 // contextWithMetadata creates a context with authentication metadata if a token is set.
@@ -234,7 +369,7 @@ func (c *CallbackClient) Close() error {
 	c.closed = true
 	c.connected = false
 
-	if c.conn != nil {
+	if c.conn != nil && !c.borrowed {
 		return c.conn.Close()
 	}
 
@@ -267,7 +402,7 @@ func (c *CallbackClient) LLMComplete(ctx context.Context, req *proto.LLMComplete
 		return nil, fmt.Errorf("LLMComplete: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.LLMComplete(ctx, req)
 	if err != nil {
@@ -282,7 +417,7 @@ func (c *CallbackClient) LLMCompleteWithTools(ctx context.Context, req *proto.LL
 		return nil, fmt.Errorf("LLMCompleteWithTools: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.LLMCompleteWithTools(ctx, req)
 	if err != nil {
@@ -297,7 +432,7 @@ func (c *CallbackClient) LLMCompleteStructured(ctx context.Context, req *proto.L
 		return nil, fmt.Errorf("LLMCompleteStructured: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.LLMCompleteStructured(ctx, req)
 	if err != nil {
@@ -306,13 +441,28 @@ func (c *CallbackClient) LLMCompleteStructured(ctx context.Context, req *proto.L
 	return resp, nil
 }
 
+// ReportTokenUsage reports a snapshot of the task's cumulative token usage to the orchestrator.
+func (c *CallbackClient) ReportTokenUsage(ctx context.Context, req *proto.ReportTokenUsageRequest) (*proto.ReportTokenUsageResponse, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("ReportTokenUsage: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	resp, err := c.client.ReportTokenUsage(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("ReportTokenUsage: %w", err)
+	}
+	return resp, nil
+}
+
 // LLMStream performs a streaming LLM completion request.
 func (c *CallbackClient) LLMStream(ctx context.Context, req *proto.LLMStreamRequest) (proto.HarnessCallbackService_LLMStreamClient, error) {
 	if !c.IsConnected() {
 		return nil, fmt.Errorf("LLMStream: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.LLMStream(ctx, req)
 	if err != nil {
@@ -334,7 +484,7 @@ func (c *CallbackClient) CallToolProto(ctx context.Context, req *proto.CallToolP
 		}
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.CallToolProto(ctx, req)
 	if err != nil {
@@ -349,7 +499,7 @@ func (c *CallbackClient) ListTools(ctx context.Context, req *proto.ListToolsRequ
 		return nil, fmt.Errorf("ListTools: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.ListTools(ctx, req)
 	if err != nil {
@@ -358,6 +508,22 @@ func (c *CallbackClient) ListTools(ctx context.Context, req *proto.ListToolsRequ
 	return resp, nil
 }
 
+// GetToolProtoDescriptors fetches the proto descriptors of a tool's input and
+// output messages via the orchestrator.
+func (c *CallbackClient) GetToolProtoDescriptors(ctx context.Context, req *proto.GetToolProtoDescriptorsRequest) (*proto.GetToolProtoDescriptorsResponse, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("GetToolProtoDescriptors: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	resp, err := c.client.GetToolProtoDescriptors(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GetToolProtoDescriptors: %w", err)
+	}
+	return resp, nil
+}
+
 // ============================================================================
 // Plugin Operations
 // ============================================================================
@@ -368,7 +534,7 @@ func (c *CallbackClient) QueryPlugin(ctx context.Context, req *proto.QueryPlugin
 		return nil, fmt.Errorf("QueryPlugin: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.QueryPlugin(ctx, req)
 	if err != nil {
@@ -383,7 +549,7 @@ func (c *CallbackClient) ListPlugins(ctx context.Context, req *proto.ListPlugins
 		return nil, fmt.Errorf("ListPlugins: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.ListPlugins(ctx, req)
 	if err != nil {
@@ -402,7 +568,7 @@ func (c *CallbackClient) DelegateToAgent(ctx context.Context, req *proto.Delegat
 		return nil, fmt.Errorf("DelegateToAgent: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.DelegateToAgent(ctx, req)
 	if err != nil {
@@ -417,7 +583,7 @@ func (c *CallbackClient) ListAgents(ctx context.Context, req *proto.ListAgentsRe
 		return nil, fmt.Errorf("ListAgents: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.ListAgents(ctx, req)
 	if err != nil {
@@ -436,7 +602,7 @@ func (c *CallbackClient) SubmitFinding(ctx context.Context, req *proto.SubmitFin
 		return nil, fmt.Errorf("SubmitFinding: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.SubmitFinding(ctx, req)
 	if err != nil {
@@ -451,7 +617,7 @@ func (c *CallbackClient) GetFindings(ctx context.Context, req *proto.GetFindings
 		return nil, fmt.Errorf("GetFindings: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GetFindings(ctx, req)
 	if err != nil {
@@ -470,7 +636,7 @@ func (c *CallbackClient) MemoryGet(ctx context.Context, req *proto.MemoryGetRequ
 		return nil, fmt.Errorf("MemoryGet: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MemoryGet(ctx, req)
 	if err != nil {
@@ -485,7 +651,7 @@ func (c *CallbackClient) MemorySet(ctx context.Context, req *proto.MemorySetRequ
 		return nil, fmt.Errorf("MemorySet: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MemorySet(ctx, req)
 	if err != nil {
@@ -500,7 +666,7 @@ func (c *CallbackClient) MemoryDelete(ctx context.Context, req *proto.MemoryDele
 		return nil, fmt.Errorf("MemoryDelete: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MemoryDelete(ctx, req)
 	if err != nil {
@@ -515,7 +681,7 @@ func (c *CallbackClient) MemoryList(ctx context.Context, req *proto.MemoryListRe
 		return nil, fmt.Errorf("MemoryList: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MemoryList(ctx, req)
 	if err != nil {
@@ -534,7 +700,7 @@ func (c *CallbackClient) MissionMemorySearch(ctx context.Context, req *proto.Mis
 		return nil, fmt.Errorf("MissionMemorySearch: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MissionMemorySearch(ctx, req)
 	if err != nil {
@@ -549,7 +715,7 @@ func (c *CallbackClient) MissionMemoryHistory(ctx context.Context, req *proto.Mi
 		return nil, fmt.Errorf("MissionMemoryHistory: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MissionMemoryHistory(ctx, req)
 	if err != nil {
@@ -564,7 +730,7 @@ func (c *CallbackClient) MissionMemoryGetPreviousRunValue(ctx context.Context, r
 		return nil, fmt.Errorf("MissionMemoryGetPreviousRunValue: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MissionMemoryGetPreviousRunValue(ctx, req)
 	if err != nil {
@@ -579,7 +745,7 @@ func (c *CallbackClient) MissionMemoryGetValueHistory(ctx context.Context, req *
 		return nil, fmt.Errorf("MissionMemoryGetValueHistory: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MissionMemoryGetValueHistory(ctx, req)
 	if err != nil {
@@ -594,7 +760,7 @@ func (c *CallbackClient) MissionMemoryContinuityMode(ctx context.Context, req *p
 		return nil, fmt.Errorf("MissionMemoryContinuityMode: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.MissionMemoryContinuityMode(ctx, req)
 	if err != nil {
@@ -613,7 +779,7 @@ func (c *CallbackClient) LongTermMemoryStore(ctx context.Context, req *proto.Lon
 		return nil, fmt.Errorf("LongTermMemoryStore: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.LongTermMemoryStore(ctx, req)
 	if err != nil {
@@ -628,7 +794,7 @@ func (c *CallbackClient) LongTermMemorySearch(ctx context.Context, req *proto.Lo
 		return nil, fmt.Errorf("LongTermMemorySearch: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.LongTermMemorySearch(ctx, req)
 	if err != nil {
@@ -643,7 +809,7 @@ func (c *CallbackClient) LongTermMemoryDelete(ctx context.Context, req *proto.Lo
 		return nil, fmt.Errorf("LongTermMemoryDelete: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.LongTermMemoryDelete(ctx, req)
 	if err != nil {
@@ -662,7 +828,7 @@ func (c *CallbackClient) GraphRAGQuery(ctx context.Context, req *proto.GraphRAGQ
 		return nil, fmt.Errorf("GraphRAGQuery: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GraphRAGQuery(ctx, req)
 	if err != nil {
@@ -671,13 +837,104 @@ func (c *CallbackClient) GraphRAGQuery(ctx context.Context, req *proto.GraphRAGQ
 	return resp, nil
 }
 
+// GraphRAGQueryBatch runs several GraphRAG queries in one round trip.
+func (c *CallbackClient) GraphRAGQueryBatch(ctx context.Context, req *proto.GraphRAGQueryBatchRequest) (*proto.GraphRAGQueryBatchResponse, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("GraphRAGQueryBatch: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	resp, err := c.client.GraphRAGQueryBatch(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAGQueryBatch: %w", err)
+	}
+	return resp, nil
+}
+
+// GraphRAGExplain asks the daemon for the execution plan of a GraphRAG query.
+func (c *CallbackClient) GraphRAGExplain(ctx context.Context, req *proto.GraphRAGExplainRequest) (*proto.GraphRAGExplainResponse, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("GraphRAGExplain: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	resp, err := c.client.GraphRAGExplain(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAGExplain: %w", err)
+	}
+	return resp, nil
+}
+
+// GraphRAGStats retrieves node and relationship counts for a mission's knowledge graph.
+func (c *CallbackClient) GraphRAGStats(ctx context.Context, req *proto.GraphRAGStatsRequest) (*proto.GraphRAGStatsResponse, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("GraphRAGStats: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	resp, err := c.client.GraphRAGStats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAGStats: %w", err)
+	}
+	return resp, nil
+}
+
+// GraphRAGShortestPath finds the shortest relationship path between two nodes.
+func (c *CallbackClient) GraphRAGShortestPath(ctx context.Context, req *proto.GraphRAGShortestPathRequest) (*proto.GraphRAGShortestPathResponse, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("GraphRAGShortestPath: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	resp, err := c.client.GraphRAGShortestPath(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAGShortestPath: %w", err)
+	}
+	return resp, nil
+}
+
+// GraphRAGNeighbors returns the nodes one relationship away from a node.
+func (c *CallbackClient) GraphRAGNeighbors(ctx context.Context, req *proto.GraphRAGNeighborsRequest) (*proto.GraphRAGNeighborsResponse, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("GraphRAGNeighbors: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	resp, err := c.client.GraphRAGNeighbors(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAGNeighbors: %w", err)
+	}
+	return resp, nil
+}
+
+// GraphRAGWatch returns a stream of the nodes stored in a mission's graph.
+// The stream ends when ctx is cancelled.
+func (c *CallbackClient) GraphRAGWatch(ctx context.Context, req *proto.GraphRAGWatchRequest) (proto.HarnessCallbackService_GraphRAGWatchClient, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("GraphRAGWatch: client not connected")
+	}
+
+	req.Context = c.contextInfo(ctx)
+	ctx = c.contextWithMetadata(ctx)
+	stream, err := c.client.GraphRAGWatch(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAGWatch: %w", err)
+	}
+	return stream, nil
+}
+
 // FindSimilarAttacks searches for similar attack patterns.
 func (c *CallbackClient) FindSimilarAttacks(ctx context.Context, req *proto.FindSimilarAttacksRequest) (*proto.FindSimilarAttacksResponse, error) {
 	if !c.IsConnected() {
 		return nil, fmt.Errorf("FindSimilarAttacks: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.FindSimilarAttacks(ctx, req)
 	if err != nil {
@@ -692,7 +949,7 @@ func (c *CallbackClient) FindSimilarFindings(ctx context.Context, req *proto.Fin
 		return nil, fmt.Errorf("FindSimilarFindings: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.FindSimilarFindings(ctx, req)
 	if err != nil {
@@ -707,7 +964,7 @@ func (c *CallbackClient) GetAttackChains(ctx context.Context, req *proto.GetAtta
 		return nil, fmt.Errorf("GetAttackChains: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GetAttackChains(ctx, req)
 	if err != nil {
@@ -722,7 +979,7 @@ func (c *CallbackClient) GetRelatedFindings(ctx context.Context, req *proto.GetR
 		return nil, fmt.Errorf("GetRelatedFindings: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GetRelatedFindings(ctx, req)
 	if err != nil {
@@ -741,7 +998,7 @@ func (c *CallbackClient) StoreGraphNode(ctx context.Context, req *proto.StoreGra
 		return nil, fmt.Errorf("StoreGraphNode: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.StoreGraphNode(ctx, req)
 	if err != nil {
@@ -756,7 +1013,7 @@ func (c *CallbackClient) CreateGraphRelationship(ctx context.Context, req *proto
 		return nil, fmt.Errorf("CreateGraphRelationship: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.CreateGraphRelationship(ctx, req)
 	if err != nil {
@@ -771,7 +1028,7 @@ func (c *CallbackClient) StoreGraphBatch(ctx context.Context, req *proto.StoreGr
 		return nil, fmt.Errorf("StoreGraphBatch: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.StoreGraphBatch(ctx, req)
 	if err != nil {
@@ -786,7 +1043,7 @@ func (c *CallbackClient) TraverseGraph(ctx context.Context, req *proto.TraverseG
 		return nil, fmt.Errorf("TraverseGraph: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.TraverseGraph(ctx, req)
 	if err != nil {
@@ -801,7 +1058,7 @@ func (c *CallbackClient) GraphRAGHealth(ctx context.Context, req *proto.GraphRAG
 		return nil, fmt.Errorf("GraphRAGHealth: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GraphRAGHealth(ctx, req)
 	if err != nil {
@@ -820,7 +1077,7 @@ func (c *CallbackClient) GetPlanContext(ctx context.Context, req *proto.GetPlanC
 		return nil, fmt.Errorf("GetPlanContext: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GetPlanContext(ctx, req)
 	if err != nil {
@@ -835,7 +1092,7 @@ func (c *CallbackClient) ReportStepHints(ctx context.Context, req *proto.ReportS
 		return nil, fmt.Errorf("ReportStepHints: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.ReportStepHints(ctx, req)
 	if err != nil {
@@ -854,7 +1111,7 @@ func (c *CallbackClient) RecordSpans(ctx context.Context, req *proto.RecordSpans
 		return nil, fmt.Errorf("RecordSpans: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.RecordSpans(ctx, req)
 	if err != nil {
@@ -873,7 +1130,7 @@ func (c *CallbackClient) GetCredential(ctx context.Context, req *proto.GetCreden
 		return nil, fmt.Errorf("GetCredential: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GetCredential(ctx, req)
 	if err != nil {
@@ -892,7 +1149,7 @@ func (c *CallbackClient) GetTaxonomySchema(ctx context.Context, req *proto.GetTa
 		return nil, fmt.Errorf("GetTaxonomySchema: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GetTaxonomySchema(ctx, req)
 	if err != nil {
@@ -907,7 +1164,7 @@ func (c *CallbackClient) GenerateNodeID(ctx context.Context, req *proto.Generate
 		return nil, fmt.Errorf("GenerateNodeID: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.GenerateNodeID(ctx, req)
 	if err != nil {
@@ -922,7 +1179,7 @@ func (c *CallbackClient) ValidateFinding(ctx context.Context, req *proto.Validat
 		return nil, fmt.Errorf("ValidateFinding: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.ValidateFinding(ctx, req)
 	if err != nil {
@@ -937,7 +1194,7 @@ func (c *CallbackClient) ValidateGraphNode(ctx context.Context, req *proto.Valid
 		return nil, fmt.Errorf("ValidateGraphNode: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.ValidateGraphNode(ctx, req)
 	if err != nil {
@@ -952,7 +1209,7 @@ func (c *CallbackClient) ValidateRelationship(ctx context.Context, req *proto.Va
 		return nil, fmt.Errorf("ValidateRelationship: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.ValidateRelationship(ctx, req)
 	if err != nil {
@@ -971,7 +1228,7 @@ func (c *CallbackClient) StoreNode(ctx context.Context, req *proto.StoreNodeRequ
 		return nil, fmt.Errorf("StoreNode: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.StoreNode(ctx, req)
 	if err != nil {
@@ -986,7 +1243,7 @@ func (c *CallbackClient) QueryNodes(ctx context.Context, req *proto.QueryNodesRe
 		return nil, fmt.Errorf("QueryNodes: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.QueryNodes(ctx, req)
 	if err != nil {
@@ -1006,7 +1263,7 @@ func (c *CallbackClient) QueueToolWork(ctx context.Context, req *proto.QueueTool
 		return nil, fmt.Errorf("QueueToolWork: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	resp, err := c.client.QueueToolWork(ctx, req)
 	if err != nil {
@@ -1022,7 +1279,7 @@ func (c *CallbackClient) ToolResults(ctx context.Context, req *proto.ToolResults
 		return nil, fmt.Errorf("ToolResults: client not connected")
 	}
 
-	req.Context = c.contextInfo()
+	req.Context = c.contextInfo(ctx)
 	ctx = c.contextWithMetadata(ctx)
 	stream, err := c.client.ToolResults(ctx, req)
 	if err != nil {
@@ -3,13 +3,26 @@ package serve
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/finding"
+	"github.com/zero-day-ai/sdk/graphrag"
+	"github.com/zero-day-ai/sdk/llm"
+	"github.com/zero-day-ai/sdk/types"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
 )
 
@@ -68,7 +81,7 @@ func TestCallbackClientContextInfo(t *testing.T) {
 
 	client.SetTaskContext("task-123", "test-agent", "mission-abc", "trace-456", "span-789")
 
-	ctx := client.contextInfo()
+	ctx := client.contextInfo(context.Background())
 	assert.NotNil(t, ctx)
 	assert.Equal(t, "task-123", ctx.TaskId)
 	assert.Equal(t, "test-agent", ctx.AgentName)
@@ -77,6 +90,1315 @@ func TestCallbackClientContextInfo(t *testing.T) {
 	assert.Equal(t, "span-789", ctx.SpanId)
 }
 
+// TestCallbackClientExecutionContext tests client-level and per-call execution context.
+func TestCallbackClientExecutionContext(t *testing.T) {
+	client, err := NewCallbackClient("localhost:50051")
+	require.NoError(t, err)
+
+	client.SetFullContext(TaskContextParams{
+		TaskID:    "task-123",
+		AgentName: "parent-agent",
+	})
+	client.SetExecutionContext(ExecutionContext{
+		MissionID:    "mission-abc",
+		MissionRunID: "mission-run-1",
+		AgentRunID:   "agent-run-parent",
+		StepID:       "step-1",
+	})
+
+	t.Run("client-level defaults", func(t *testing.T) {
+		info := client.contextInfo(context.Background())
+		assert.Equal(t, "task-123", info.TaskId)
+		assert.Equal(t, "mission-abc", info.MissionId)
+		assert.Equal(t, "mission-run-1", info.MissionRunId)
+		assert.Equal(t, "agent-run-parent", info.AgentRunId)
+		assert.Equal(t, "step-1", info.StepId)
+		assert.Equal(t, ExecutionContext{
+			MissionID:    "mission-abc",
+			MissionRunID: "mission-run-1",
+			AgentRunID:   "agent-run-parent",
+			StepID:       "step-1",
+		}, client.ExecutionContext())
+	})
+
+	t.Run("context values override defaults", func(t *testing.T) {
+		ctx := WithExecutionContext(context.Background(), ExecutionContext{
+			AgentRunID: "agent-run-child",
+			StepID:     "step-2",
+		})
+		info := client.contextInfo(ctx)
+		assert.Equal(t, "agent-run-child", info.AgentRunId)
+		assert.Equal(t, "step-2", info.StepId)
+		// Empty override fields fall back to client-level values
+		assert.Equal(t, "mission-abc", info.MissionId)
+		assert.Equal(t, "mission-run-1", info.MissionRunId)
+		assert.Equal(t, "task-123", info.TaskId)
+	})
+
+	t.Run("no override in context", func(t *testing.T) {
+		_, ok := ExecutionContextFromContext(context.Background())
+		assert.False(t, ok)
+	})
+}
+
+// contextCapturingServer records the ContextInfo attached to callback requests.
+type contextCapturingServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	mu       sync.Mutex
+	contexts map[string][]*proto.ContextInfo
+}
+
+func (s *contextCapturingServer) record(method string, info *proto.ContextInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.contexts == nil {
+		s.contexts = make(map[string][]*proto.ContextInfo)
+	}
+	s.contexts[method] = append(s.contexts[method], info)
+}
+
+func (s *contextCapturingServer) captured(method string) []*proto.ContextInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.contexts[method]
+}
+
+func (s *contextCapturingServer) DelegateToAgent(ctx context.Context, req *proto.DelegateToAgentRequest) (*proto.DelegateToAgentResponse, error) {
+	s.record("DelegateToAgent", req.GetContext())
+	return &proto.DelegateToAgentResponse{
+		Result: &proto.Result{Status: proto.ResultStatus_RESULT_STATUS_SUCCESS},
+	}, nil
+}
+
+func (s *contextCapturingServer) GraphRAGQuery(ctx context.Context, req *proto.GraphRAGQueryRequest) (*proto.GraphRAGQueryResponse, error) {
+	s.record("GraphRAGQuery", req.GetContext())
+	return &proto.GraphRAGQueryResponse{}, nil
+}
+
+// TestCallbackHarness_NestedDelegationContext verifies that calls made on behalf
+// of a nested delegation carry the child's agent run ID rather than the parent's.
+func TestCallbackHarness_NestedDelegationContext(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	fake := &contextCapturingServer{}
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, fake)
+	defer server.Stop()
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	client, err := NewCallbackClient(lis.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+
+	client.SetExecutionContext(ExecutionContext{
+		MissionID:    "mission-abc",
+		MissionRunID: "mission-run-1",
+		AgentRunID:   "agent-run-parent",
+	})
+
+	logger := slog.New(slog.NewTextHandler(&strings.Builder{}, nil))
+	harness := NewCallbackHarness(client, logger, nil, types.MissionContext{ID: "mission-abc"}, types.TargetInfo{})
+
+	// Parent delegates using the client-level context
+	_, err = harness.DelegateToAgent(ctx, "child-agent", agent.Task{ID: "task-1", Goal: "recon"})
+	require.NoError(t, err)
+
+	// The child's calls are scoped to its own agent run
+	childCtx := WithExecutionContext(ctx, ExecutionContext{
+		AgentRunID: "agent-run-child",
+		StepID:     "step-recon",
+	})
+	_, err = harness.DelegateToAgent(childCtx, "grandchild-agent", agent.Task{ID: "task-2", Goal: "scan"})
+	require.NoError(t, err)
+	_, err = client.GraphRAGQuery(childCtx, &proto.GraphRAGQueryRequest{})
+	require.NoError(t, err)
+
+	delegations := fake.captured("DelegateToAgent")
+	require.Len(t, delegations, 2)
+	assert.Equal(t, "agent-run-parent", delegations[0].GetAgentRunId())
+	assert.Empty(t, delegations[0].GetStepId())
+	assert.Equal(t, "agent-run-child", delegations[1].GetAgentRunId())
+	assert.Equal(t, "step-recon", delegations[1].GetStepId())
+	assert.Equal(t, "mission-run-1", delegations[1].GetMissionRunId())
+
+	queries := fake.captured("GraphRAGQuery")
+	require.Len(t, queries, 1)
+	assert.Equal(t, "agent-run-child", queries[0].GetAgentRunId())
+	assert.Equal(t, "mission-abc", queries[0].GetMissionId())
+}
+
+// TestCallbackClient_ForTask verifies that task views share the parent's
+// connection but keep their own context and lifecycle.
+func TestCallbackClient_ForTask(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	fake := &contextCapturingServer{}
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, fake)
+	defer server.Stop()
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	parent, err := NewCallbackClient(lis.Addr().String())
+	require.NoError(t, err)
+	defer parent.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, parent.Connect(ctx))
+
+	first := parent.ForTask(TaskContextParams{TaskID: "task-1", AgentName: "agent", AgentRunID: "run-1"})
+	second := parent.ForTask(TaskContextParams{TaskID: "task-2", AgentName: "agent", AgentRunID: "run-2"})
+	assert.Same(t, parent.conn, first.conn)
+	require.NoError(t, first.Connect(ctx), "connecting a view checks the shared connection")
+
+	_, err = first.GraphRAGQuery(ctx, &proto.GraphRAGQueryRequest{})
+	require.NoError(t, err)
+	_, err = second.GraphRAGQuery(ctx, &proto.GraphRAGQueryRequest{})
+	require.NoError(t, err)
+
+	queries := fake.captured("GraphRAGQuery")
+	require.Len(t, queries, 2)
+	assert.Equal(t, "task-1", queries[0].GetTaskId())
+	assert.Equal(t, "run-1", queries[0].GetAgentRunId())
+	assert.Equal(t, "task-2", queries[1].GetTaskId())
+	assert.Empty(t, parent.contextInfo(ctx).GetTaskId(), "views do not modify the parent")
+
+	// Closing a view leaves the shared connection open
+	require.NoError(t, first.Close())
+	assert.False(t, first.IsConnected())
+	assert.True(t, parent.IsConnected())
+	_, err = second.GraphRAGQuery(ctx, &proto.GraphRAGQueryRequest{})
+	assert.NoError(t, err)
+}
+
+// explainServer returns a fixed plan for GraphRAGExplain requests.
+type explainServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	lastReq *proto.GraphRAGExplainRequest
+}
+
+func (s *explainServer) GraphRAGExplain(ctx context.Context, req *proto.GraphRAGExplainRequest) (*proto.GraphRAGExplainResponse, error) {
+	s.lastReq = req
+	return &proto.GraphRAGExplainResponse{
+		Plan: &proto.GraphRAGQueryPlan{
+			Route:      graphrag.RouteStructured,
+			TopK:       25,
+			NodeTypes:  req.GetQuery().GetNodeTypes(),
+			Operations: []string{"daemon node scan"},
+		},
+	}, nil
+}
+
+// TestCallbackHarness_ExplainQuery tests daemon-provided plans and the local fallback.
+func TestCallbackHarness_ExplainQuery(t *testing.T) {
+	newHarness := func(t *testing.T, srv proto.HarnessCallbackServiceServer) *CallbackHarness {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		server := grpc.NewServer()
+		proto.RegisterHarnessCallbackServiceServer(server, srv)
+		go func() {
+			_ = server.Serve(lis)
+		}()
+		t.Cleanup(server.Stop)
+
+		client, err := NewCallbackClient(lis.Addr().String())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = client.Close() })
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, client.Connect(ctx))
+
+		logger := slog.New(slog.NewTextHandler(&strings.Builder{}, nil))
+		return NewCallbackHarness(client, logger, nil, types.MissionContext{}, types.TargetInfo{})
+	}
+
+	t.Run("daemon plan", func(t *testing.T) {
+		fake := &explainServer{}
+		harness := newHarness(t, fake)
+
+		query := graphrag.NewStructuredQuery().WithNodeTypes("host").WithMaxHops(2)
+		plan, err := harness.ExplainQuery(context.Background(), *query)
+		require.NoError(t, err)
+
+		assert.Equal(t, graphrag.RouteStructured, plan.Route)
+		assert.Equal(t, 25, plan.TopK)
+		assert.Equal(t, []string{"host"}, plan.NodeTypes)
+		assert.Equal(t, []string{"daemon node scan"}, plan.Operations)
+		require.NotNil(t, fake.lastReq)
+		assert.Equal(t, int32(2), fake.lastReq.GetMaxHops())
+	})
+
+	t.Run("falls back to local plan when unsupported", func(t *testing.T) {
+		harness := newHarness(t, &contextCapturingServer{})
+
+		query := graphrag.NewQuery("exposed admin panels")
+		plan, err := harness.ExplainQuery(context.Background(), *query)
+		require.NoError(t, err)
+
+		assert.Equal(t, query.Explain(), plan)
+		assert.Equal(t, graphrag.RouteText, plan.Route)
+	})
+}
+
+// queryServer answers GraphRAG queries with one result per query, named
+// after the query text. Queries with the text "bad" fail.
+type queryServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	mu           sync.Mutex
+	batchCalls   int
+	singleCalls  int
+	unsupported  bool
+	batchFailure string
+}
+
+func (s *queryServer) answer(q *proto.GraphQuery) ([]*proto.GraphRAGResult, *proto.HarnessError) {
+	if q.GetText() == "bad" {
+		return nil, &proto.HarnessError{Message: "query rejected"}
+	}
+	return []*proto.GraphRAGResult{{
+		Node:  &proto.GraphNode{Id: "node-" + q.GetText(), Type: "host"},
+		Score: 0.9,
+	}}, nil
+}
+
+func (s *queryServer) GraphRAGQuery(ctx context.Context, req *proto.GraphRAGQueryRequest) (*proto.GraphRAGQueryResponse, error) {
+	s.mu.Lock()
+	s.singleCalls++
+	s.mu.Unlock()
+
+	results, herr := s.answer(req.GetQuery())
+	return &proto.GraphRAGQueryResponse{Results: results, Error: herr}, nil
+}
+
+func (s *queryServer) GraphRAGQueryBatch(ctx context.Context, req *proto.GraphRAGQueryBatchRequest) (*proto.GraphRAGQueryBatchResponse, error) {
+	if s.unsupported {
+		return s.UnimplementedHarnessCallbackServiceServer.GraphRAGQueryBatch(ctx, req)
+	}
+	s.mu.Lock()
+	s.batchCalls++
+	s.mu.Unlock()
+
+	if s.batchFailure != "" {
+		return &proto.GraphRAGQueryBatchResponse{Error: &proto.HarnessError{Message: s.batchFailure}}, nil
+	}
+
+	items := make([]*proto.GraphRAGQueryBatchItem, len(req.GetQueries()))
+	for i, q := range req.GetQueries() {
+		results, herr := s.answer(q)
+		items[i] = &proto.GraphRAGQueryBatchItem{Results: results, Error: herr}
+	}
+	return &proto.GraphRAGQueryBatchResponse{Items: items}, nil
+}
+
+// newFakeCallbackHarness serves srv on a local port and returns a harness
+// connected to it.
+func newFakeCallbackHarness(t *testing.T, srv proto.HarnessCallbackServiceServer) *CallbackHarness {
+	t.Helper()
+	return newFakeCallbackHarnessWithOptions(t, srv, HarnessOptions{})
+}
+
+// newFakeCallbackHarnessWithOptions is newFakeCallbackHarness with harness
+// options.
+func newFakeCallbackHarnessWithOptions(t *testing.T, srv proto.HarnessCallbackServiceServer, opts HarnessOptions) *CallbackHarness {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, srv)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := NewCallbackClient(lis.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+
+	logger := slog.New(slog.NewTextHandler(&strings.Builder{}, nil))
+	return NewCallbackHarnessWithOptions(client, logger, noop.NewTracerProvider().Tracer("test"), types.MissionContext{}, types.TargetInfo{}, opts)
+}
+
+// TestCallbackHarness_QueryBatch tests batched GraphRAG queries.
+func TestCallbackHarness_QueryBatch(t *testing.T) {
+	queries := func(texts ...string) []graphrag.Query {
+		out := make([]graphrag.Query, len(texts))
+		for i, text := range texts {
+			out[i] = *graphrag.NewQuery(text)
+		}
+		return out
+	}
+
+	t.Run("results aligned by index in one call", func(t *testing.T) {
+		fake := &queryServer{}
+		harness := newFakeCallbackHarness(t, fake)
+
+		results, err := harness.QueryBatch(context.Background(), queries("a", "b", "c"))
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		for i, id := range []string{"node-a", "node-b", "node-c"} {
+			require.Len(t, results[i], 1)
+			assert.Equal(t, id, results[i][0].Node.ID)
+		}
+		assert.Equal(t, 1, fake.batchCalls)
+		assert.Zero(t, fake.singleCalls)
+	})
+
+	t.Run("per-query errors do not fail the batch", func(t *testing.T) {
+		harness := newFakeCallbackHarness(t, &queryServer{})
+
+		results, err := harness.QueryBatch(context.Background(), queries("a", "bad", "c"))
+		var batchErr *graphrag.BatchQueryError
+		require.ErrorAs(t, err, &batchErr)
+		require.Len(t, batchErr.Errors, 1)
+		assert.Contains(t, batchErr.Errors[1].Error(), "query rejected")
+		assert.Contains(t, err.Error(), "query 1:")
+
+		require.Len(t, results, 3)
+		assert.Equal(t, "node-a", results[0][0].Node.ID)
+		assert.Nil(t, results[1])
+		assert.Equal(t, "node-c", results[2][0].Node.ID)
+	})
+
+	t.Run("batch failure", func(t *testing.T) {
+		harness := newFakeCallbackHarness(t, &queryServer{batchFailure: "graph unavailable"})
+
+		results, err := harness.QueryBatch(context.Background(), queries("a", "b"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "graph unavailable")
+		var batchErr *graphrag.BatchQueryError
+		assert.False(t, errors.As(err, &batchErr))
+		assert.Nil(t, results)
+	})
+
+	t.Run("falls back to single queries when unsupported", func(t *testing.T) {
+		fake := &queryServer{unsupported: true}
+		harness := newFakeCallbackHarness(t, fake)
+
+		results, err := harness.QueryBatch(context.Background(), queries("a", "bad"))
+		var batchErr *graphrag.BatchQueryError
+		require.ErrorAs(t, err, &batchErr)
+		assert.Contains(t, batchErr.Errors, 1)
+		assert.Equal(t, "node-a", results[0][0].Node.ID)
+		assert.Equal(t, 2, fake.singleCalls)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		fake := &queryServer{}
+		harness := newFakeCallbackHarness(t, fake)
+
+		results, err := harness.QueryBatch(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		assert.Zero(t, fake.batchCalls)
+	})
+}
+
+// traverseServer answers traversals with fixed results and records the
+// options it received.
+type traverseServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	opts    *proto.TraversalOptions
+	results []*proto.TraversalResult
+}
+
+func (s *traverseServer) TraverseGraph(ctx context.Context, req *proto.TraverseGraphRequest) (*proto.TraverseGraphResponse, error) {
+	s.opts = req.Options
+	return &proto.TraverseGraphResponse{Results: s.results}, nil
+}
+
+// TestCallbackHarness_TraverseGraphConstraints tests that path constraints
+// and cycle handling reach the orchestrator and are enforced on results.
+func TestCallbackHarness_TraverseGraphConstraints(t *testing.T) {
+	fake := &traverseServer{results: []*proto.TraversalResult{
+		{Node: &proto.GraphNode{Id: "host-2", Type: "host"}, Path: []string{"finding", "technique", "host-2"}, Distance: 2, MustPassSatisfied: true},
+		{Node: &proto.GraphNode{Id: "host-1", Type: "host"}, Path: []string{"finding", "host-1"}, Distance: 1},
+	}}
+	harness := newFakeCallbackHarness(t, fake)
+
+	opts := graphrag.NewTraversalOptions().
+		WithMustPassNodeTypes([]string{"technique"}).
+		WithAvoidNodeTypes([]string{"service"}).
+		WithAllowRevisit(2)
+	results, err := harness.TraverseGraph(context.Background(), "finding", *opts)
+	require.NoError(t, err)
+
+	require.NotNil(t, fake.opts)
+	assert.Equal(t, []string{"technique"}, fake.opts.MustPassNodeTypes)
+	assert.Equal(t, []string{"service"}, fake.opts.AvoidNodeTypes)
+	assert.Equal(t, "allow_revisit", fake.opts.CycleHandling)
+	assert.Equal(t, int32(2), fake.opts.MaxRevisits)
+
+	// The result that does not satisfy the must-pass constraint is dropped
+	require.Len(t, results, 1)
+	assert.Equal(t, "host-2", results[0].Node.ID)
+	assert.True(t, results[0].MustPassSatisfied)
+
+	t.Run("invalid options are rejected before the call", func(t *testing.T) {
+		fake.opts = nil
+		_, err := harness.TraverseGraph(context.Background(), "finding", graphrag.TraversalOptions{
+			MustPassNodeTypes: []string{"technique"},
+			AvoidNodeTypes:    []string{"technique"},
+		})
+		require.ErrorIs(t, err, graphrag.ErrInvalidQuery)
+		assert.Nil(t, fake.opts)
+	})
+}
+
+// clusterServer answers GraphRAG queries with as many results as requested:
+// findings on host-1 ranked first, then one finding on each other host.
+type clusterServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+	topK []int32
+}
+
+func (s *clusterServer) answer(q *proto.GraphQuery) []*proto.GraphRAGResult {
+	s.topK = append(s.topK, q.GetTopK())
+	results := make([]*proto.GraphRAGResult, q.GetTopK())
+	for i := range results {
+		host := "host-1"
+		if i >= int(q.GetTopK())/2 {
+			host = fmt.Sprintf("host-%d", i)
+		}
+		results[i] = &proto.GraphRAGResult{
+			Node: &proto.GraphNode{
+				Id:         fmt.Sprintf("finding-%d", i),
+				Type:       "finding",
+				Content:    "finding on " + host,
+				Properties: ToTypedMap(map[string]any{"host_id": host}),
+			},
+			Score: 1 - float64(i)/100,
+		}
+	}
+	return results
+}
+
+func (s *clusterServer) GraphRAGQuery(ctx context.Context, req *proto.GraphRAGQueryRequest) (*proto.GraphRAGQueryResponse, error) {
+	return &proto.GraphRAGQueryResponse{Results: s.answer(req.GetQuery())}, nil
+}
+
+func (s *clusterServer) GraphRAGQueryBatch(ctx context.Context, req *proto.GraphRAGQueryBatchRequest) (*proto.GraphRAGQueryBatchResponse, error) {
+	items := make([]*proto.GraphRAGQueryBatchItem, len(req.GetQueries()))
+	for i, q := range req.GetQueries() {
+		items[i] = &proto.GraphRAGQueryBatchItem{Results: s.answer(q)}
+	}
+	return &proto.GraphRAGQueryBatchResponse{Items: items}, nil
+}
+
+// TestCallbackHarness_QueryRerank tests that capped and diversified queries
+// over-fetch candidates and are re-ranked by the harness.
+func TestCallbackHarness_QueryRerank(t *testing.T) {
+	fake := &clusterServer{}
+	harness := newFakeCallbackHarness(t, fake)
+	ctx := context.Background()
+
+	results, err := harness.QueryGraphRAG(ctx, *graphrag.NewQuery("findings").WithTopK(4).WithMaxPerGroup("host_id", 1))
+	require.NoError(t, err)
+	assert.Equal(t, []int32{12}, fake.topK, "TopK is inflated by the default over-fetch factor")
+	require.Len(t, results, 4)
+	assert.Equal(t, "finding-0", results[0].Node.ID)
+	assert.Equal(t, 1, results[0].OriginalRank)
+	assert.Equal(t, "finding-6", results[1].Node.ID, "host-1 is capped at one result")
+	assert.Equal(t, 7, results[1].OriginalRank)
+
+	batch, err := harness.QueryBatch(ctx, []graphrag.Query{
+		*graphrag.NewQuery("findings").WithTopK(3),
+		*graphrag.NewQuery("findings").WithTopK(3).WithDiversity(0.5).WithOverFetch(4),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{12, 3, 12}, fake.topK)
+	require.Len(t, batch[0], 3)
+	assert.Zero(t, batch[0][1].OriginalRank, "plain queries are not re-ranked")
+	require.Len(t, batch[1], 3)
+	assert.Equal(t, "finding-0", batch[1][0].Node.ID)
+	assert.NotEqual(t, "finding-1", batch[1][1].Node.ID, "a near-duplicate does not come second")
+}
+
+// neighborServer answers GraphRAGNeighbors requests over a fixed set of
+// relationships with graphrag.FindNeighbors.
+type neighborServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	rels    []graphrag.Relationship
+	types   map[string]string
+	lastReq *proto.GraphRAGNeighborsRequest
+}
+
+func (s *neighborServer) GraphRAGNeighbors(ctx context.Context, req *proto.GraphRAGNeighborsRequest) (*proto.GraphRAGNeighborsResponse, error) {
+	s.lastReq = req
+	neighbors := func(ctx context.Context, nodeID string) ([]graphrag.Relationship, error) {
+		var out []graphrag.Relationship
+		for _, r := range s.rels {
+			if r.FromID == nodeID || r.ToID == nodeID {
+				out = append(out, r)
+			}
+		}
+		return out, nil
+	}
+	nodes := func(ctx context.Context, nodeID string) (*graphrag.GraphNode, error) {
+		return &graphrag.GraphNode{ID: nodeID, Type: s.types[nodeID]}, nil
+	}
+
+	found, err := graphrag.FindNeighbors(ctx, neighbors, nodes, req.GetNodeId(), req.GetRelationshipType(), req.GetDirection())
+	if err != nil {
+		return &proto.GraphRAGNeighborsResponse{Error: &proto.HarnessError{Message: err.Error()}}, nil
+	}
+	resp := &proto.GraphRAGNeighborsResponse{}
+	for _, n := range found {
+		resp.Nodes = append(resp.Nodes, &proto.GraphNode{Id: n.ID, Type: n.Type})
+	}
+	return resp, nil
+}
+
+// TestCallbackHarness_Neighbors tests one-hop neighbor queries.
+func TestCallbackHarness_Neighbors(t *testing.T) {
+	fake := &neighborServer{
+		rels: []graphrag.Relationship{
+			{FromID: "finding-1", ToID: "T1190", Type: "USES_TECHNIQUE"},
+			{FromID: "finding-2", ToID: "T1190", Type: "USES_TECHNIQUE"},
+			{FromID: "T1190", ToID: "host-1", Type: "TARGETS"},
+		},
+		types: map[string]string{"finding-1": "finding", "finding-2": "finding", "host-1": "host"},
+	}
+	harness := newFakeCallbackHarness(t, fake)
+	ctx := context.Background()
+
+	findings, err := harness.Neighbors(ctx, "T1190", "USES_TECHNIQUE", "incoming")
+	require.NoError(t, err)
+	require.Len(t, findings, 2)
+	assert.Equal(t, "finding-1", findings[0].ID)
+	assert.Equal(t, "finding", findings[0].Type)
+	assert.Equal(t, "finding-2", findings[1].ID)
+
+	targets, err := harness.Neighbors(ctx, "T1190", "", "")
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	assert.Equal(t, "host-1", targets[0].ID)
+	assert.Equal(t, "outgoing", fake.lastReq.GetDirection(), "direction defaults to outgoing")
+
+	all, err := harness.Neighbors(ctx, "T1190", "", "both")
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	t.Run("invalid queries are rejected before the call", func(t *testing.T) {
+		fake.lastReq = nil
+		_, err := harness.Neighbors(ctx, "T1190", "", "sideways")
+		require.ErrorIs(t, err, graphrag.ErrInvalidQuery)
+		_, err = harness.Neighbors(ctx, "", "", "both")
+		require.ErrorIs(t, err, graphrag.ErrInvalidQuery)
+		assert.Nil(t, fake.lastReq)
+	})
+}
+
+// watchServer streams a fixed set of graph events to each watcher, then
+// keeps the stream open until the watcher cancels it.
+type watchServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	events  []*proto.GraphRAGEvent
+	lastReq *proto.GraphRAGWatchRequest
+	done    chan struct{}
+}
+
+func (s *watchServer) GraphRAGWatch(req *proto.GraphRAGWatchRequest, stream proto.HarnessCallbackService_GraphRAGWatchServer) error {
+	s.lastReq = req
+	for _, event := range s.events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	close(s.done)
+	return nil
+}
+
+// TestCallbackHarness_WatchGraph tests streaming graph change events.
+func TestCallbackHarness_WatchGraph(t *testing.T) {
+	fake := &watchServer{
+		events: []*proto.GraphRAGEvent{
+			{Kind: graphrag.GraphEventCreated, Node: &proto.GraphNode{Id: "port-1", Type: "port", MissionId: "mission-1", AgentName: "recon"}},
+			{Kind: graphrag.GraphEventCreated, Node: &proto.GraphNode{Id: "host-1", Type: "host", MissionId: "mission-1"}},
+			{Kind: graphrag.GraphEventUpdated, Node: &proto.GraphNode{Id: "port-2", Type: "port", MissionId: "mission-2"}},
+			{Kind: graphrag.GraphEventUpdated, Node: &proto.GraphNode{Id: "port-1", Type: "port", MissionId: "mission-1"}},
+		},
+		done: make(chan struct{}),
+	}
+	harness := newFakeCallbackHarness(t, fake)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := harness.WatchGraph(ctx, "mission-1", []string{"port"})
+	require.NoError(t, err)
+
+	first := <-events
+	assert.Equal(t, graphrag.GraphEventCreated, first.Kind)
+	assert.Equal(t, "port-1", first.Node.ID)
+	assert.Equal(t, "recon", first.Node.AgentName)
+	second := <-events
+	assert.Equal(t, graphrag.GraphEventUpdated, second.Kind, "other types and missions are filtered out")
+	assert.Equal(t, "port-1", second.Node.ID)
+	assert.Equal(t, "mission-1", fake.lastReq.GetMissionId())
+	assert.Equal(t, []string{"port"}, fake.lastReq.GetNodeTypes())
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok, "the channel closes when the context is cancelled")
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel not closed after cancel")
+	}
+	select {
+	case <-fake.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server stream not ended after cancel")
+	}
+
+	t.Run("mission ID is required", func(t *testing.T) {
+		_, err := harness.WatchGraph(context.Background(), "", nil)
+		require.ErrorIs(t, err, graphrag.ErrInvalidQuery)
+	})
+}
+
+// batchServer records stored graph batches and echoes the node IDs.
+type batchServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	batches []*proto.StoreGraphBatchRequest
+}
+
+func (s *batchServer) StoreGraphBatch(ctx context.Context, req *proto.StoreGraphBatchRequest) (*proto.StoreGraphBatchResponse, error) {
+	s.batches = append(s.batches, req)
+	ids := make([]string, len(req.Nodes))
+	for i, node := range req.Nodes {
+		ids[i] = node.Id
+	}
+	return &proto.StoreGraphBatchResponse{NodeIds: ids}, nil
+}
+
+// testHost and testPort are minimal typed domain nodes.
+type testHost struct {
+	ip string
+	id string
+}
+
+func (n *testHost) NodeType() string                      { return "host" }
+func (n *testHost) IdentifyingProperties() map[string]any { return map[string]any{"ip": n.ip} }
+func (n *testHost) Properties() map[string]any            { return map[string]any{"ip": n.ip, "os": "linux"} }
+func (n *testHost) ParentRef() *graphrag.NodeRef          { return nil }
+func (n *testHost) SetID(id string)                       { n.id = id }
+
+type testPort struct {
+	number int
+	parent *graphrag.NodeRef
+}
+
+func (n *testPort) NodeType() string { return "port" }
+func (n *testPort) IdentifyingProperties() map[string]any {
+	return map[string]any{"number": n.number, "protocol": "tcp"}
+}
+func (n *testPort) Properties() map[string]any {
+	return map[string]any{"number": n.number, "protocol": "tcp", "state": "open"}
+}
+func (n *testPort) ParentRef() *graphrag.NodeRef { return n.parent }
+
+// TestCallbackHarness_StoreDomainNode tests that domain nodes are stored with
+// deterministic IDs and their parent relationship in a single batch.
+func TestCallbackHarness_StoreDomainNode(t *testing.T) {
+	fake := &batchServer{}
+	harness := newFakeCallbackHarness(t, fake)
+	ctx := context.Background()
+
+	host := &testHost{ip: "10.0.0.1"}
+	hostID, err := harness.StoreDomainNode(ctx, host)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hostID, "host:"))
+	assert.Equal(t, hostID, host.id, "SetID is called with the stored ID")
+
+	again, err := harness.StoreDomainNode(ctx, &testHost{ip: "10.0.0.1"})
+	require.NoError(t, err)
+	assert.Equal(t, hostID, again, "IDs are deterministic")
+
+	port := &testPort{number: 443, parent: &graphrag.NodeRef{
+		NodeType:     "host",
+		Properties:   map[string]any{"id": hostID},
+		Relationship: "HAS_PORT",
+	}}
+	portID, err := harness.StoreDomainNode(ctx, port)
+	require.NoError(t, err)
+
+	require.Len(t, fake.batches, 3)
+	batch := fake.batches[2]
+	require.Len(t, batch.Nodes, 1)
+	assert.Equal(t, portID, batch.Nodes[0].Id)
+	assert.Equal(t, "port", batch.Nodes[0].Type)
+	assert.Equal(t, "open", FromTypedValue(batch.Nodes[0].Properties["state"]))
+	require.Len(t, batch.Relationships, 1)
+	assert.Equal(t, hostID, batch.Relationships[0].FromId)
+	assert.Equal(t, portID, batch.Relationships[0].ToId)
+	assert.Equal(t, "HAS_PORT", batch.Relationships[0].Type)
+
+	t.Run("parent by identifying properties", func(t *testing.T) {
+		byProps := &testPort{number: 443, parent: &graphrag.NodeRef{
+			NodeType:     "host",
+			Properties:   map[string]any{"ip": "10.0.0.1"},
+			Relationship: "HAS_PORT",
+		}}
+		id, err := harness.StoreDomainNode(ctx, byProps)
+		require.NoError(t, err)
+		assert.Equal(t, portID, id, "the parent ID matches the stored root node")
+	})
+
+	t.Run("same key under another parent", func(t *testing.T) {
+		other := &testPort{number: 443, parent: &graphrag.NodeRef{
+			NodeType:     "host",
+			Properties:   map[string]any{"id": "host:other"},
+			Relationship: "HAS_PORT",
+		}}
+		id, err := harness.StoreDomainNode(ctx, other)
+		require.NoError(t, err)
+		assert.NotEqual(t, portID, id)
+	})
+
+	t.Run("parent without relationship type", func(t *testing.T) {
+		stored := len(fake.batches)
+		_, err := harness.StoreDomainNode(ctx, &testPort{number: 22, parent: &graphrag.NodeRef{
+			NodeType:   "host",
+			Properties: map[string]any{"id": hostID},
+		}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no relationship type")
+		assert.Len(t, fake.batches, stored, "nothing is stored")
+	})
+}
+
+// TestCallbackHarness_StoreGraphBatchCustomType tests that nodes of a
+// registered custom type are checked before a batch is sent.
+func TestCallbackHarness_StoreGraphBatchCustomType(t *testing.T) {
+	require.NoError(t, graphrag.RegisterCustomType("llmsec", "jailbreak", []string{"prompt_hash"}))
+	t.Cleanup(func() { graphrag.UnregisterCustomType("llmsec", "jailbreak") })
+
+	fake := &batchServer{}
+	harness := newFakeCallbackHarness(t, fake)
+	ctx := context.Background()
+
+	valid := *graphrag.NewGraphNode("llmsec:jailbreak").WithID("j1").WithProperty("prompt_hash", "abc")
+	invalid := *graphrag.NewGraphNode("llmsec:jailbreak").WithID("j2")
+	_, err := harness.StoreGraphBatch(ctx, graphrag.Batch{Nodes: []graphrag.GraphNode{valid, invalid}})
+	require.ErrorIs(t, err, graphrag.ErrMissingIdentifyingProperties)
+	assert.Contains(t, err.Error(), "invalid graph node 1")
+	assert.Empty(t, fake.batches, "an invalid node fails the whole batch")
+
+	free := *graphrag.NewGraphNode("llmsec:probe").WithID("p1")
+	ids, err := harness.StoreGraphBatch(ctx, graphrag.Batch{Nodes: []graphrag.GraphNode{valid, free}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"j1", "p1"}, ids)
+}
+
+// findingServer records submitted findings.
+type findingServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	findings []*proto.Finding
+}
+
+func (s *findingServer) SubmitFinding(ctx context.Context, req *proto.SubmitFindingRequest) (*proto.SubmitFindingResponse, error) {
+	s.findings = append(s.findings, req.Finding)
+	return &proto.SubmitFindingResponse{}, nil
+}
+
+// TestCallbackHarness_SubmitFindingBlockedTechnique tests that findings
+// produced by techniques the mission blocks are rejected before submission.
+func TestCallbackHarness_SubmitFindingBlockedTechnique(t *testing.T) {
+	srv := &findingServer{}
+	h := newFakeCallbackHarness(t, srv)
+	h.mission.Constraints = types.NewMissionConstraints().WithBlockedTechniques("dos")
+	ctx := context.Background()
+
+	err := h.SubmitFinding(ctx, &finding.Finding{Title: "Service crash", Technique: "dos"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "do not permit")
+	assert.Empty(t, srv.findings)
+
+	require.NoError(t, h.SubmitFinding(ctx, &finding.Finding{Title: "Prompt leak", Technique: "prompt_injection"}))
+	require.NoError(t, h.SubmitFinding(ctx, &finding.Finding{Title: "No technique"}))
+	assert.Len(t, srv.findings, 2)
+}
+
+// toolDescriptorServer answers GetToolProtoDescriptors by forwarding to a
+// tool's DescribeTool RPC, as the orchestrator does.
+type toolDescriptorServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	tools map[string]proto.ToolServiceClient
+}
+
+func (s *toolDescriptorServer) GetToolProtoDescriptors(ctx context.Context, req *proto.GetToolProtoDescriptorsRequest) (*proto.GetToolProtoDescriptorsResponse, error) {
+	client, ok := s.tools[req.ToolName]
+	if !ok {
+		return &proto.GetToolProtoDescriptorsResponse{Error: &proto.HarnessError{
+			Code:    proto.ErrorCode_ERROR_CODE_NOT_FOUND,
+			Message: "tool not found: " + req.ToolName,
+		}}, nil
+	}
+	desc, err := client.DescribeTool(ctx, &proto.ToolDescribeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetToolProtoDescriptorsResponse{
+		InputMessageType:  desc.InputMessageType,
+		OutputMessageType: desc.OutputMessageType,
+		FileDescriptorSet: desc.FileDescriptorSet,
+	}, nil
+}
+
+// TestCallbackHarness_GetToolProtoDescriptors tests that the harness parses
+// the descriptors a tool serves.
+func TestCallbackHarness_GetToolProtoDescriptors(t *testing.T) {
+	conn, cleanup := setupToolTestServer(t, &mockTool{name: "echo"})
+	defer cleanup()
+
+	h := newFakeCallbackHarness(t, &toolDescriptorServer{
+		tools: map[string]proto.ToolServiceClient{"echo": proto.NewToolServiceClient(conn)},
+	})
+	ctx := context.Background()
+
+	descriptors, err := h.GetToolProtoDescriptors(ctx, "echo")
+	require.NoError(t, err)
+	assert.Equal(t, "gibson.common.TypedMap", descriptors.InputMessageType)
+
+	input, err := descriptors.NewInput()
+	require.NoError(t, err)
+	assert.Equal(t, "gibson.common.TypedMap", string(input.Descriptor().FullName()))
+
+	_, err = h.GetToolProtoDescriptors(ctx, "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tool not found")
+}
+
+// modelOverrideServer serves completions on the requested override model and
+// rejects the models in rejected.
+type modelOverrideServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	req      *proto.LLMCompleteRequest
+	rejected map[string]string
+}
+
+func (s *modelOverrideServer) LLMComplete(ctx context.Context, req *proto.LLMCompleteRequest) (*proto.LLMCompleteResponse, error) {
+	s.req = req
+	if reason, ok := s.rejected[req.ModelOverride]; ok {
+		return &proto.LLMCompleteResponse{Error: &proto.HarnessError{
+			Code:    proto.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			Message: reason,
+		}}, nil
+	}
+	model := "slot-default-model"
+	if req.ModelOverride != "" {
+		model = req.ModelOverride
+	}
+	return &proto.LLMCompleteResponse{
+		Content:      "benign",
+		FinishReason: "stop",
+		Usage:        &proto.TokenUsage{InputTokens: 10, OutputTokens: 1, TotalTokens: 11},
+		Model:        model,
+	}, nil
+}
+
+// TestCallbackHarness_CompleteModelOverride tests that a per-request model
+// override reaches the orchestrator and is recorded in the response.
+func TestCallbackHarness_CompleteModelOverride(t *testing.T) {
+	fake := &modelOverrideServer{rejected: map[string]string{
+		"tiny-model": "missing required features function_calling",
+	}}
+	harness := newFakeCallbackHarness(t, fake)
+	ctx := context.Background()
+	messages := []llm.Message{{Role: llm.RoleUser, Content: "classify this"}}
+
+	resp, err := harness.Complete(ctx, "primary", messages, llm.WithModelOverride("cheap-model"))
+	require.NoError(t, err)
+	assert.Equal(t, "primary", fake.req.Slot)
+	assert.Equal(t, "cheap-model", fake.req.ModelOverride)
+	assert.Equal(t, "cheap-model", resp.Model)
+
+	t.Run("without override", func(t *testing.T) {
+		resp, err := harness.Complete(ctx, "primary", messages)
+		require.NoError(t, err)
+		assert.Empty(t, fake.req.ModelOverride)
+		assert.Equal(t, "slot-default-model", resp.Model)
+	})
+
+	t.Run("override violating slot requirements is rejected", func(t *testing.T) {
+		_, err := harness.Complete(ctx, "primary", messages, llm.WithModelOverride("tiny-model"))
+		require.ErrorIs(t, err, llm.ErrModelOverrideRejected)
+
+		var overrideErr *llm.ModelOverrideError
+		require.True(t, errors.As(err, &overrideErr))
+		assert.Equal(t, "primary", overrideErr.Slot)
+		assert.Equal(t, "tiny-model", overrideErr.Model)
+		assert.Contains(t, err.Error(), "function_calling")
+	})
+}
+
+// TestCallbackHarness_CompleteResponseFilter tests that harness and request
+// response filters run in order and that their verdicts are recorded.
+func TestCallbackHarness_CompleteResponseFilter(t *testing.T) {
+	var seen []string
+	annotate := func(content string) (llm.FilterVerdict, error) {
+		seen = append(seen, "harness:"+content)
+		return llm.FilterVerdict{Action: llm.FilterAnnotate, Labels: []string{"reviewed"}}, nil
+	}
+	harness := newFakeCallbackHarnessWithOptions(t, &modelOverrideServer{}, HarnessOptions{
+		ResponseFilters: []llm.ResponseFilter{annotate},
+	})
+	ctx := context.Background()
+	messages := []llm.Message{{Role: llm.RoleUser, Content: "classify this"}}
+
+	redact := func(content string) (llm.FilterVerdict, error) {
+		seen = append(seen, "request:"+content)
+		return llm.FilterVerdict{Action: llm.FilterRedact, Redacted: "[redacted]"}, nil
+	}
+	resp, err := harness.Complete(ctx, "primary", messages, llm.WithResponseFilter(redact))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"harness:benign", "request:benign"}, seen)
+	assert.Equal(t, "[redacted]", resp.Content)
+	require.Len(t, resp.FilterVerdicts, 2)
+	assert.Equal(t, llm.FilterAnnotate, resp.FilterVerdicts[0].Action)
+	assert.Equal(t, llm.FilterRedact, resp.FilterVerdicts[1].Action)
+	assert.True(t, resp.Flagged())
+
+	t.Run("rejection fails the completion", func(t *testing.T) {
+		reject := func(content string) (llm.FilterVerdict, error) {
+			return llm.FilterVerdict{Action: llm.FilterReject, Labels: []string{"policy"}, Reason: "disallowed content"}, nil
+		}
+		before := harness.TokenUsage().Total().TotalTokens
+		_, err := harness.Complete(ctx, "primary", messages, llm.WithResponseFilter(reject))
+		require.ErrorIs(t, err, llm.ErrResponseRejected)
+		assert.Contains(t, err.Error(), "disallowed content")
+		assert.Equal(t, before+11, harness.TokenUsage().Total().TotalTokens, "rejected responses still count against the budget")
+	})
+
+	t.Run("filter error fails the completion", func(t *testing.T) {
+		broken := func(content string) (llm.FilterVerdict, error) {
+			return llm.FilterVerdict{}, errors.New("classifier unavailable")
+		}
+		_, err := harness.Complete(ctx, "primary", messages, llm.WithResponseFilter(broken))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "classifier unavailable")
+	})
+}
+
+// preambleServer records the messages of LLM requests and answers with
+// fixed usage.
+type preambleServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	mu       sync.Mutex
+	messages []*proto.LLMMessage
+}
+
+func (s *preambleServer) record(messages []*proto.LLMMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = messages
+}
+
+func (s *preambleServer) roles() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var roles []string
+	for _, m := range s.messages {
+		roles = append(roles, m.Role+":"+m.Content)
+	}
+	return roles
+}
+
+func (s *preambleServer) LLMComplete(ctx context.Context, req *proto.LLMCompleteRequest) (*proto.LLMCompleteResponse, error) {
+	s.record(req.Messages)
+	return &proto.LLMCompleteResponse{Content: "ok", FinishReason: "stop", Usage: &proto.TokenUsage{InputTokens: 100, OutputTokens: 5, TotalTokens: 105}}, nil
+}
+
+func (s *preambleServer) LLMCompleteWithTools(ctx context.Context, req *proto.LLMCompleteWithToolsRequest) (*proto.LLMCompleteResponse, error) {
+	s.record(req.Messages)
+	return &proto.LLMCompleteResponse{Content: "ok", FinishReason: "stop", Usage: &proto.TokenUsage{InputTokens: 100, OutputTokens: 5, TotalTokens: 105}}, nil
+}
+
+func (s *preambleServer) LLMStream(req *proto.LLMStreamRequest, stream proto.HarnessCallbackService_LLMStreamServer) error {
+	s.record(req.Messages)
+	return stream.Send(&proto.LLMStreamChunk{Delta: "ok", FinishReason: "stop", Usage: &proto.TokenUsage{InputTokens: 100, OutputTokens: 5, TotalTokens: 105}})
+}
+
+// TestCallbackHarness_SystemPreamble tests that the harness injects its
+// system preamble into every kind of completion and tracks its tokens.
+func TestCallbackHarness_SystemPreamble(t *testing.T) {
+	const preamble = "Authorized test. Never target out-of-scope systems."
+	preambleTokens := llm.EstimateTokens(preamble)
+	ctx := context.Background()
+	messages := []llm.Message{
+		{Role: llm.RoleSystem, Content: "You are a recon agent."},
+		{Role: llm.RoleUser, Content: "Scan the target."},
+	}
+
+	t.Run("prepend", func(t *testing.T) {
+		fake := &preambleServer{}
+		harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+			SystemPreamble: llm.PreambleConfig{Content: preamble, Position: llm.PreamblePrepend},
+		})
+
+		_, err := harness.Complete(ctx, "primary", messages)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"system:" + preamble, "system:You are a recon agent.", "user:Scan the target."}, fake.roles())
+		assert.Len(t, messages, 2, "the agent's messages are not modified")
+
+		usage := harness.TokenUsage()
+		assert.Equal(t, preambleTokens, usage.BySlot(llm.PreambleTokenSlot).InputTokens)
+		assert.Equal(t, 100-preambleTokens, usage.BySlot("primary").InputTokens)
+		assert.Equal(t, 105, usage.Total().TotalTokens)
+	})
+
+	t.Run("after system", func(t *testing.T) {
+		fake := &preambleServer{}
+		harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+			SystemPreamble: llm.PreambleConfig{Content: preamble, Position: llm.PreambleAfterSystem},
+		})
+
+		_, err := harness.CompleteWithTools(ctx, "primary", messages, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"system:You are a recon agent.", "system:" + preamble, "user:Scan the target."}, fake.roles())
+		assert.Equal(t, preambleTokens, harness.TokenUsage().BySlot(llm.PreambleTokenSlot).InputTokens)
+	})
+
+	t.Run("already present", func(t *testing.T) {
+		fake := &preambleServer{}
+		harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+			SystemPreamble: llm.PreambleConfig{Content: preamble},
+		})
+
+		carrying := []llm.Message{{Role: llm.RoleSystem, Content: preamble}, {Role: llm.RoleUser, Content: "Scan the target."}}
+		_, err := harness.Complete(ctx, "primary", carrying)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"system:" + preamble, "user:Scan the target."}, fake.roles())
+		assert.Zero(t, harness.TokenUsage().BySlot(llm.PreambleTokenSlot))
+	})
+
+	t.Run("skipped slot", func(t *testing.T) {
+		fake := &preambleServer{}
+		harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+			SystemPreamble: llm.PreambleConfig{Content: preamble, SkipSlots: []string{"classifier"}},
+		})
+
+		_, err := harness.Complete(ctx, "classifier", messages)
+		require.NoError(t, err)
+		assert.Len(t, fake.roles(), 2)
+		assert.Equal(t, 100, harness.TokenUsage().BySlot("classifier").InputTokens)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		fake := &preambleServer{}
+		harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+			SystemPreamble: llm.PreambleConfig{Content: preamble, Position: llm.PreambleAfterSystem},
+		})
+		harness.tracer = tp.Tracer("test")
+
+		chunks, err := harness.Stream(ctx, "primary", messages)
+		require.NoError(t, err)
+		for range chunks {
+		}
+		assert.Equal(t, []string{"system:You are a recon agent.", "system:" + preamble, "user:Scan the target."}, fake.roles())
+		assert.Equal(t, preambleTokens, harness.TokenUsage().BySlot(llm.PreambleTokenSlot).InputTokens)
+
+		require.Eventually(t, func() bool { return len(recorder.Ended()) == 1 }, time.Second, 10*time.Millisecond)
+		var injected bool
+		for _, kv := range recorder.Ended()[0].Attributes() {
+			if kv.Key == "gibson.llm.preamble_injected" {
+				injected = kv.Value.AsBool()
+			}
+		}
+		assert.True(t, injected, "the span records the injection")
+	})
+}
+
+// statsServer returns fixed statistics for GraphRAGStats requests.
+type statsServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	lastReq *proto.GraphRAGStatsRequest
+}
+
+func (s *statsServer) GraphRAGStats(ctx context.Context, req *proto.GraphRAGStatsRequest) (*proto.GraphRAGStatsResponse, error) {
+	s.lastReq = req
+	if req.GetMissionId() == "missing" {
+		return &proto.GraphRAGStatsResponse{
+			Error: &proto.HarnessError{Message: "mission not found"},
+		}, nil
+	}
+	return &proto.GraphRAGStatsResponse{
+		Stats: &proto.GraphRAGStats{
+			NodeCount:           40,
+			RelationshipCount:   100,
+			NodesByType:         map[string]int64{"host": 10, "port": 30},
+			RelationshipsByType: map[string]int64{"HAS_PORT": 100},
+		},
+	}, nil
+}
+
+// TestCallbackHarness_GraphStats tests retrieving knowledge graph statistics.
+func TestCallbackHarness_GraphStats(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	fake := &statsServer{}
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, fake)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := NewCallbackClient(lis.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+
+	logger := slog.New(slog.NewTextHandler(&strings.Builder{}, nil))
+	harness := NewCallbackHarness(client, logger, nil, types.MissionContext{}, types.TargetInfo{})
+
+	stats, err := harness.GraphStats(ctx, "mission-1")
+	require.NoError(t, err)
+	assert.Equal(t, "mission-1", stats.MissionID)
+	assert.Equal(t, int64(40), stats.NodeCount)
+	assert.Equal(t, int64(100), stats.RelationshipCount)
+	assert.Equal(t, int64(30), stats.NodesByType["port"])
+	assert.Equal(t, int64(100), stats.RelationshipsByType["HAS_PORT"])
+	assert.InDelta(t, 5.0, stats.AverageDegree, 0.001, "average degree derived from counts")
+	require.NotNil(t, fake.lastReq)
+	assert.Equal(t, "mission-1", fake.lastReq.GetMissionId())
+
+	_, err = harness.GraphStats(ctx, "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mission not found")
+}
+
+// pathServer answers GraphRAGShortestPath requests by searching a fixed
+// set of relationships with graphrag.FindShortestPath.
+type pathServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	rels    []graphrag.Relationship
+	lastReq *proto.GraphRAGShortestPathRequest
+}
+
+func (s *pathServer) GraphRAGShortestPath(ctx context.Context, req *proto.GraphRAGShortestPathRequest) (*proto.GraphRAGShortestPathResponse, error) {
+	s.lastReq = req
+	neighbors := func(ctx context.Context, nodeID string) ([]graphrag.Relationship, error) {
+		var out []graphrag.Relationship
+		for _, r := range s.rels {
+			if r.FromID == nodeID || r.ToID == nodeID {
+				out = append(out, r)
+			}
+		}
+		return out, nil
+	}
+
+	opts := graphrag.PathOptions{
+		MaxDepth:          int(req.GetOptions().GetMaxDepth()),
+		RelationshipTypes: req.GetOptions().GetRelationshipTypes(),
+		Direction:         req.GetOptions().GetDirection(),
+		WeightProperty:    req.GetOptions().GetWeightProperty(),
+	}
+	edges, err := graphrag.FindShortestPath(ctx, neighbors, req.GetFromId(), req.GetToId(), opts)
+	if errors.Is(err, graphrag.ErrPathNotFound) {
+		return &proto.GraphRAGShortestPathResponse{Found: false}, nil
+	}
+	if err != nil {
+		return &proto.GraphRAGShortestPathResponse{Error: &proto.HarnessError{Message: err.Error()}}, nil
+	}
+
+	resp := &proto.GraphRAGShortestPathResponse{Found: true}
+	for _, e := range edges {
+		resp.Edges = append(resp.Edges, &proto.PathEdge{
+			FromId:     e.FromID,
+			ToId:       e.ToID,
+			Type:       e.Type,
+			Properties: ToTypedMap(e.Properties),
+			Weight:     e.Weight,
+			Reversed:   e.Reversed,
+		})
+	}
+	return resp, nil
+}
+
+// TestCallbackHarness_ShortestPath tests finding a path between two nodes.
+func TestCallbackHarness_ShortestPath(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	fake := &pathServer{rels: []graphrag.Relationship{
+		{FromID: "finding-1", ToID: "host-1", Type: "AFFECTS", Properties: map[string]any{"confidence": 0.9}},
+		{FromID: "T1190", ToID: "host-1", Type: "TARGETS"},
+		{FromID: "finding-2", ToID: "host-2", Type: "AFFECTS"},
+	}}
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, fake)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := NewCallbackClient(lis.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+
+	logger := slog.New(slog.NewTextHandler(&strings.Builder{}, nil))
+	harness := NewCallbackHarness(client, logger, nil, types.MissionContext{}, types.TargetInfo{})
+
+	edges, err := harness.ShortestPath(ctx, "finding-1", "T1190", *graphrag.NewPathOptions().WithDirection("both").WithMaxDepth(50))
+	require.NoError(t, err)
+	require.Len(t, edges, 2)
+	assert.Equal(t, "AFFECTS", edges[0].Type)
+	assert.Equal(t, 0.9, edges[0].Properties["confidence"])
+	assert.Equal(t, "host-1", edges[1].FromID)
+	assert.Equal(t, "T1190", edges[1].ToID)
+	assert.True(t, edges[1].Reversed)
+	assert.Equal(t, int32(graphrag.MaxPathDepth), fake.lastReq.GetOptions().GetMaxDepth(), "depth is capped before sending")
+
+	_, err = harness.ShortestPath(ctx, "finding-2", "T1190", *graphrag.NewPathOptions().WithDirection("both"))
+	assert.ErrorIs(t, err, graphrag.ErrPathNotFound)
+
+	_, err = harness.ShortestPath(ctx, "finding-1", "T1190", graphrag.PathOptions{Direction: "up"})
+	assert.ErrorIs(t, err, graphrag.ErrInvalidQuery)
+}
+
 // TestCallbackClientConnectionLifecycle tests connect/close lifecycle.
 func TestCallbackClientConnectionLifecycle(t *testing.T) {
 	client, err := NewCallbackClient("localhost:50051")
@@ -434,3 +1756,119 @@ func TestCallbackClientNotConnectedErrorMessages(t *testing.T) {
 		})
 	}
 }
+
+// hangingStreamServer streams a few chunks and then keeps the stream open
+// without finishing, like a model that is still generating.
+type hangingStreamServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	chunks []*proto.LLMStreamChunk
+}
+
+func (s *hangingStreamServer) LLMStream(req *proto.LLMStreamRequest, stream proto.HarnessCallbackService_LLMStreamServer) error {
+	for _, chunk := range s.chunks {
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return stream.Context().Err()
+}
+
+func TestCallbackHarness_StreamControlled(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, &hangingStreamServer{
+		chunks: []*proto.LLMStreamChunk{{Delta: "Port 22 "}, {Delta: "is open"}},
+	})
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	client, err := NewCallbackClient(lis.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+
+	logger := slog.New(slog.NewTextHandler(&strings.Builder{}, nil))
+	harness := NewCallbackHarness(client, logger, noop.NewTracerProvider().Tracer("test"), types.MissionContext{}, types.TargetInfo{})
+
+	messages := []llm.Message{{Role: llm.RoleUser, Content: "Summarize the scan results"}}
+	handle, err := harness.StreamControlled(ctx, "primary", messages)
+	require.NoError(t, err)
+
+	<-handle.Chunks()
+	<-handle.Chunks()
+	handle.Cancel()
+
+	partial := handle.Partial()
+	assert.Equal(t, "Port 22 is open", partial.Content)
+	assert.Equal(t, llm.FinishReasonCancelled, partial.FinishReason)
+
+	// Usage up to cancellation is tracked, estimated since none was reported
+	usage := harness.TokenUsage().BySlot("primary")
+	assert.Equal(t, llm.EstimateTokens("Port 22 is open"), usage.OutputTokens)
+	assert.Equal(t, llm.EstimateTokens("Summarize the scan results"), usage.InputTokens)
+	assert.Equal(t, usage.InputTokens+usage.OutputTokens, usage.TotalTokens)
+}
+
+// failedDelegationServer answers DelegateToAgent with a failed result.
+type failedDelegationServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+	code      proto.ErrorCode
+	retryable bool
+}
+
+func (s *failedDelegationServer) DelegateToAgent(ctx context.Context, req *proto.DelegateToAgentRequest) (*proto.DelegateToAgentResponse, error) {
+	return &proto.DelegateToAgentResponse{
+		Result: &proto.Result{
+			Status: proto.ResultStatus_RESULT_STATUS_FAILED,
+			Error: &proto.ResultError{
+				Code:      s.code,
+				Message:   "delegated task failed",
+				Retryable: s.retryable,
+			},
+		},
+	}, nil
+}
+
+func TestCallbackHarness_DelegateToAgentErrorCode(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      proto.ErrorCode
+		retryable bool
+		wantCode  string
+		wantRetry bool
+	}{
+		{"llm unavailable", proto.ErrorCode_ERROR_CODE_LLM_UNAVAILABLE, false, agent.ErrCodeLLMUnavailable, true},
+		{"tool failure", proto.ErrorCode_ERROR_CODE_TOOL_FAILURE, false, agent.ErrCodeToolFailure, true},
+		{"constraint violated", proto.ErrorCode_ERROR_CODE_CONSTRAINT_VIOLATED, false, agent.ErrCodeConstraintViolated, false},
+		{"target unreachable", proto.ErrorCode_ERROR_CODE_TARGET_UNREACHABLE, false, agent.ErrCodeTargetUnreachable, true},
+		{"internal", proto.ErrorCode_ERROR_CODE_INTERNAL, false, agent.ErrCodeInternal, false},
+		{"internal marked retryable", proto.ErrorCode_ERROR_CODE_INTERNAL, true, agent.ErrCodeInternal, true},
+		{"unspecified", proto.ErrorCode_ERROR_CODE_UNSPECIFIED, false, agent.ErrCodeUnknown, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			harness := newFakeCallbackHarness(t, &failedDelegationServer{code: tt.code, retryable: tt.retryable})
+
+			result, err := harness.DelegateToAgent(context.Background(), "child", agent.Task{ID: "task-1"})
+			require.NoError(t, err)
+			assert.Equal(t, agent.StatusFailed, result.Status)
+			require.NotNil(t, result.ErrorInfo)
+			assert.Equal(t, tt.wantCode, result.ErrorInfo.Code)
+			assert.Equal(t, tt.wantRetry, result.ErrorInfo.IsRetryable())
+
+			var resultErr *agent.ResultError
+			require.ErrorAs(t, result.Error, &resultErr)
+			assert.Equal(t, tt.wantCode, resultErr.Code)
+		})
+	}
+}
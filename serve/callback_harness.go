@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/zero-day-ai/sdk/api/gen/proto"
 	"github.com/zero-day-ai/sdk/finding"
 	"github.com/zero-day-ai/sdk/graphrag"
+	"github.com/zero-day-ai/sdk/graphrag/id"
 	"github.com/zero-day-ai/sdk/llm"
 	"github.com/zero-day-ai/sdk/memory"
 	"github.com/zero-day-ai/sdk/mission"
@@ -24,6 +27,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	protolib "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -52,10 +57,24 @@ type CallbackHarness struct {
 	taxonomyInitOnce sync.Once
 
 	// Caching for list operations
-	cacheMu      sync.RWMutex
-	toolsCache   []tool.Descriptor
-	pluginsCache []plugin.Descriptor
-	agentsCache  []agent.Descriptor
+	toolsCache     *listCache[tool.Descriptor]
+	pluginsCache   *listCache[plugin.Descriptor]
+	agentsCache    *listCache[agent.Descriptor]
+	cacheRefreshes *cacheRefreshCounter
+
+	// queryCache caches GraphRAG query results; nil disables caching
+	queryCache *queryCache
+
+	// tokenReports reports token usage to the orchestrator; nil disables
+	// reporting
+	tokenReports *tokenReporter
+
+	// responseFilters run on every completion response before the filters
+	// of the request
+	responseFilters []llm.ResponseFilter
+
+	// preamble is injected into the messages of every completion
+	preamble llm.PreambleConfig
 }
 
 // NewCallbackHarness creates a new callback-based harness.
@@ -68,15 +87,48 @@ func NewCallbackHarness(
 	mission types.MissionContext,
 	target types.TargetInfo,
 ) *CallbackHarness {
+	return NewCallbackHarnessWithOptions(client, logger, tracer, mission, target, HarnessOptions{})
+}
+
+// NewCallbackHarnessWithOptions creates a new callback-based harness
+// configured by opts. See NewCallbackHarness.
+func NewCallbackHarnessWithOptions(
+	client *CallbackClient,
+	logger *slog.Logger,
+	tracer trace.Tracer,
+	mission types.MissionContext,
+	target types.TargetInfo,
+	opts HarnessOptions,
+) *CallbackHarness {
+	tokenTracker := NewCallbackTokenTracker()
 	h := &CallbackHarness{
 		client:       client,
 		memory:       NewCallbackMemoryStore(client, tracer),
-		tokenTracker: NewCallbackTokenTracker(),
+		tokenTracker: tokenTracker,
 		logger:       logger,
 		tracer:       tracer,
 		mission:      mission,
 		target:       target,
 		planContext:  nil, // Set via SetPlanContext if planning is enabled
+
+		responseFilters: opts.ResponseFilters,
+		preamble:        opts.SystemPreamble,
+	}
+
+	refreshes, err := newCacheRefreshCounter(opts.MeterProvider)
+	if err != nil {
+		logger.Warn("failed to create cache refresh metrics", "error", err)
+		refreshes, _ = newCacheRefreshCounter(nil)
+	}
+	h.cacheRefreshes = refreshes
+	h.toolsCache = newHarnessListCache(h, "tools", opts.ToolCacheTTL, h.fetchTools)
+	h.pluginsCache = newHarnessListCache(h, "plugins", opts.ToolCacheTTL, h.fetchPlugins)
+	h.agentsCache = newHarnessListCache(h, "agents", opts.ToolCacheTTL, h.fetchAgents)
+	if opts.QueryCache != nil {
+		h.queryCache = newQueryCache(*opts.QueryCache)
+	}
+	if opts.TokenReport != nil {
+		h.tokenReports = tokenTracker.startReporting(h.reportTokenUsage, *opts.TokenReport, logger)
 	}
 
 	// Fetch taxonomy at startup (non-blocking, with graceful degradation)
@@ -85,6 +137,47 @@ func NewCallbackHarness(
 	return h
 }
 
+// Shutdown stops the background work of the harness. If token usage
+// reporting is enabled, it sends a final report, retrying until it succeeds
+// or ctx is done. The harness must not be used afterwards.
+func (h *CallbackHarness) Shutdown(ctx context.Context) error {
+	if h.tokenReports == nil {
+		return nil
+	}
+	return h.tokenReports.shutdown(ctx)
+}
+
+// reportTokenUsage sends a token usage report to the orchestrator.
+func (h *CallbackHarness) reportTokenUsage(ctx context.Context, req *proto.ReportTokenUsageRequest) error {
+	resp, err := h.client.ReportTokenUsage(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("ReportTokenUsage: %s", resp.Error.Message)
+	}
+	return nil
+}
+
+// newHarnessListCache creates a list cache that reports refreshes to h.
+func newHarnessListCache[T any](h *CallbackHarness, kind string, ttl time.Duration, fetch func(context.Context) ([]T, error)) *listCache[T] {
+	return &listCache[T]{
+		kind:      kind,
+		ttl:       ttl,
+		fetch:     fetch,
+		logger:    h.logger,
+		onRefresh: h.cacheRefreshes.record,
+		now:       time.Now,
+	}
+}
+
+// CacheRefreshes returns how many times the tool, plugin, and agent lists
+// have been refreshed after their initial fetch, keyed by "tools",
+// "plugins", and "agents".
+func (h *CallbackHarness) CacheRefreshes() map[string]int64 {
+	return h.cacheRefreshes.snapshot()
+}
+
 // initTaxonomy fetches the taxonomy from the orchestrator and sets it globally.
 // This is called automatically at startup. If fetch fails, the harness will
 // continue to work but without full taxonomy support.
@@ -153,6 +246,12 @@ func (h *CallbackHarness) TokenUsage() llm.TokenTracker {
 	return h.tokenTracker
 }
 
+// LoadShedStats returns the load shedding state of the callback connection,
+// keyed by method class. See CallbackClient.LoadShedStats.
+func (h *CallbackHarness) LoadShedStats() map[string]LoadShedClassStats {
+	return h.client.LoadShedStats()
+}
+
 // Mission returns the current mission context.
 func (h *CallbackHarness) Mission() types.MissionContext {
 	return h.mission
@@ -174,6 +273,8 @@ func (h *CallbackHarness) Memory() memory.Store {
 
 // Complete performs a single LLM completion request via the orchestrator.
 func (h *CallbackHarness) Complete(ctx context.Context, slot string, messages []llm.Message, opts ...llm.CompletionOption) (*llm.CompletionResponse, error) {
+	messages, preambleTokens := h.injectPreamble(slot, messages)
+
 	// Start span for LLM completion
 	ctx, span := h.tracer.Start(ctx, "gen_ai.chat",
 		trace.WithSpanKind(trace.SpanKindClient),
@@ -184,6 +285,7 @@ func (h *CallbackHarness) Complete(ctx context.Context, slot string, messages []
 		),
 	)
 	defer span.End()
+	h.recordPreamble(span, slot, preambleTokens)
 
 	// Add prompt attribute for observability
 	span.SetAttributes(attribute.String("gen_ai.prompt", formatMessagesForPrompt(messages)))
@@ -214,6 +316,10 @@ func (h *CallbackHarness) Complete(ctx context.Context, slot string, messages []
 		span.SetAttributes(attribute.Float64("gen_ai.request.top_p", float64(topP)))
 	}
 	protoReq.Stop = req.Stop
+	if req.ModelOverride != "" {
+		protoReq.ModelOverride = req.ModelOverride
+		span.SetAttributes(attribute.String("gibson.llm.model_override", req.ModelOverride))
+	}
 
 	// Call orchestrator
 	resp, err := h.client.LLMComplete(ctx, protoReq)
@@ -224,7 +330,12 @@ func (h *CallbackHarness) Complete(ctx context.Context, slot string, messages []
 	}
 
 	if resp.Error != nil {
-		err := fmt.Errorf("LLM complete error: %s", resp.Error.Message)
+		var err error
+		if req.ModelOverride != "" && resp.Error.Code == proto.ErrorCode_ERROR_CODE_INVALID_ARGUMENT {
+			err = &llm.ModelOverrideError{Slot: slot, Model: req.ModelOverride, Reason: resp.Error.Message}
+		} else {
+			err = fmt.Errorf("LLM complete error: %s", resp.Error.Message)
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, resp.Error.Message)
 		return nil, err
@@ -240,6 +351,19 @@ func (h *CallbackHarness) Complete(ctx context.Context, slot string, messages []
 			OutputTokens: int(resp.Usage.OutputTokens),
 			TotalTokens:  int(resp.Usage.TotalTokens),
 		},
+		Model: resp.Model,
+	}
+
+	// Track token usage, which a rejected response consumed as well
+	h.trackUsage(slot, result.Usage, preambleTokens)
+
+	if err := h.filterResponse(span, slot, result, req.ResponseFilters); err != nil {
+		return nil, err
+	}
+
+	responseModel := slot
+	if result.Model != "" {
+		responseModel = result.Model
 	}
 
 	// Record token usage and response in span
@@ -248,17 +372,16 @@ func (h *CallbackHarness) Complete(ctx context.Context, slot string, messages []
 		attribute.Int("gen_ai.usage.output_tokens", result.Usage.OutputTokens),
 		attribute.String("gen_ai.response.finish_reason", result.FinishReason),
 		attribute.String("gen_ai.completion", result.Content),
-		attribute.String("gen_ai.response.model", slot),
+		attribute.String("gen_ai.response.model", responseModel),
 	)
 
-	// Track token usage
-	h.tokenTracker.Add(slot, result.Usage)
-
 	return result, nil
 }
 
 // CompleteWithTools performs a completion with tool calling enabled.
 func (h *CallbackHarness) CompleteWithTools(ctx context.Context, slot string, messages []llm.Message, tools []llm.ToolDef) (*llm.CompletionResponse, error) {
+	messages, preambleTokens := h.injectPreamble(slot, messages)
+
 	// Start span for LLM completion with tools
 	ctx, span := h.tracer.Start(ctx, "gen_ai.chat",
 		trace.WithSpanKind(trace.SpanKindClient),
@@ -270,6 +393,7 @@ func (h *CallbackHarness) CompleteWithTools(ctx context.Context, slot string, me
 		),
 	)
 	defer span.End()
+	h.recordPreamble(span, slot, preambleTokens)
 
 	// Add prompt attribute for observability
 	span.SetAttributes(attribute.String("gen_ai.prompt", formatMessagesForPrompt(messages)))
@@ -305,6 +429,13 @@ func (h *CallbackHarness) CompleteWithTools(ctx context.Context, slot string, me
 		},
 	}
 
+	// Track token usage, which a rejected response consumed as well
+	h.trackUsage(slot, result.Usage, preambleTokens)
+
+	if err := h.filterResponse(span, slot, result, nil); err != nil {
+		return nil, err
+	}
+
 	// Record token usage and response in span
 	span.SetAttributes(
 		attribute.Int("gen_ai.usage.input_tokens", result.Usage.InputTokens),
@@ -315,12 +446,76 @@ func (h *CallbackHarness) CompleteWithTools(ctx context.Context, slot string, me
 		attribute.String("gen_ai.response.model", slot),
 	)
 
-	// Track token usage
-	h.tokenTracker.Add(slot, result.Usage)
-
 	return result, nil
 }
 
+// filterResponse runs the harness's response filters, then those of the
+// request, on result. Verdicts other than allow are recorded as span events
+// and logged.
+func (h *CallbackHarness) filterResponse(span trace.Span, slot string, result *llm.CompletionResponse, requestFilters []llm.ResponseFilter) error {
+	filters := append(slices.Clip(h.responseFilters), requestFilters...)
+	if len(filters) == 0 {
+		return nil
+	}
+
+	err := llm.FilterResponse(result, filters...)
+	for _, v := range result.FilterVerdicts {
+		if v.Action == llm.FilterAllow {
+			continue
+		}
+		span.AddEvent("gibson.llm.response_filtered", trace.WithAttributes(
+			attribute.String("gibson.llm.filter.action", v.Action.String()),
+			attribute.StringSlice("gibson.llm.filter.labels", v.Labels),
+			attribute.String("gibson.llm.filter.reason", v.Reason),
+		))
+		h.logger.Warn("LLM response flagged by filter",
+			"slot", slot,
+			"action", v.Action,
+			"labels", v.Labels,
+			"reason", v.Reason,
+		)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// injectPreamble injects the harness's system preamble into the messages of
+// a completion of slot. It returns the messages to send and the estimated
+// tokens of the preamble, which are 0 if it was not injected.
+func (h *CallbackHarness) injectPreamble(slot string, messages []llm.Message) ([]llm.Message, int) {
+	if !h.preamble.Enabled(slot) {
+		return messages, 0
+	}
+	messages, injected := llm.ApplyPreamble(messages, h.preamble)
+	if !injected {
+		return messages, 0
+	}
+	return messages, llm.EstimateTokens(h.preamble.Content)
+}
+
+// recordPreamble records on span whether the preamble was injected into a
+// completion of slot for which it is enabled.
+func (h *CallbackHarness) recordPreamble(span trace.Span, slot string, preambleTokens int) {
+	if h.preamble.Enabled(slot) {
+		span.SetAttributes(attribute.Bool("gibson.llm.preamble_injected", preambleTokens > 0))
+	}
+}
+
+// trackUsage adds the token usage of a completion of slot to the token
+// tracker, counting the input tokens of an injected preamble against
+// llm.PreambleTokenSlot instead of slot.
+func (h *CallbackHarness) trackUsage(slot string, usage llm.TokenUsage, preambleTokens int) {
+	if preambleTokens = min(preambleTokens, usage.InputTokens); preambleTokens > 0 {
+		usage.InputTokens -= preambleTokens
+		usage.TotalTokens -= preambleTokens
+		h.tokenTracker.Add(llm.PreambleTokenSlot, llm.TokenUsage{InputTokens: preambleTokens, TotalTokens: preambleTokens})
+	}
+	h.tokenTracker.Add(slot, usage)
+}
+
 // CompleteStructured performs a completion with provider-native structured output.
 // This forwards the request to the orchestrator which handles schema conversion
 // and provider-specific structured output mechanisms.
@@ -332,6 +527,7 @@ func (h *CallbackHarness) CompleteStructured(ctx context.Context, slot string, m
 	// Generate JSON schema from the Go type
 	// This converts the struct definition to a proper JSON schema that the LLM can use
 	jsonSchema := schema.FromType(schemaType)
+	messages, preambleTokens := h.injectPreamble(slot, messages)
 
 	// Serialize the schema to JSON for transmission
 	schemaJSON, err := json.Marshal(jsonSchema)
@@ -364,7 +560,7 @@ func (h *CallbackHarness) CompleteStructured(ctx context.Context, slot string, m
 			OutputTokens: int(resp.Usage.OutputTokens),
 			TotalTokens:  int(resp.Usage.TotalTokens),
 		}
-		h.tokenTracker.Add(slot, usage)
+		h.trackUsage(slot, usage, preambleTokens)
 	}
 
 	return result, nil
@@ -377,6 +573,36 @@ func (h *CallbackHarness) CompleteStructuredAny(ctx context.Context, slot string
 
 // Stream performs a streaming completion request.
 func (h *CallbackHarness) Stream(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
+	messages, preambleTokens := h.injectPreamble(slot, messages)
+	return h.stream(ctx, slot, messages, preambleTokens, true)
+}
+
+// StreamControlled performs a streaming completion that can be cancelled
+// mid-stream. The returned handle keeps the content generated so far, and
+// token usage is recorded when the stream ends, including when it is
+// cancelled. Usage the orchestrator did not report before cancellation is
+// estimated from the prompt and the partial output.
+func (h *CallbackHarness) StreamControlled(ctx context.Context, slot string, messages []llm.Message) (*llm.StreamHandle, error) {
+	messages, preambleTokens := h.injectPreamble(slot, messages)
+	start := func(ctx context.Context) (<-chan llm.StreamChunk, error) {
+		return h.stream(ctx, slot, messages, preambleTokens, false)
+	}
+	return llm.NewStreamHandle(ctx, start, func(resp llm.CompletionResponse) {
+		usage := resp.Usage
+		if usage.InputTokens == 0 {
+			for _, msg := range messages {
+				usage.InputTokens += llm.EstimateTokens(msg.Content)
+			}
+			usage.TotalTokens = usage.InputTokens + usage.OutputTokens
+		}
+		h.trackUsage(slot, usage, preambleTokens)
+	})
+}
+
+// stream starts a streaming completion of messages, which carry an injected
+// preamble of preambleTokens. When trackUsage is set, usage from the final
+// chunk is added to the token tracker.
+func (h *CallbackHarness) stream(ctx context.Context, slot string, messages []llm.Message, preambleTokens int, trackUsage bool) (<-chan llm.StreamChunk, error) {
 	// Start span for streaming LLM completion
 	ctx, span := h.tracer.Start(ctx, "gen_ai.chat.stream",
 		trace.WithSpanKind(trace.SpanKindClient),
@@ -386,6 +612,7 @@ func (h *CallbackHarness) Stream(ctx context.Context, slot string, messages []ll
 			attribute.Int("gen_ai.request.message_count", len(messages)),
 		),
 	)
+	h.recordPreamble(span, slot, preambleTokens)
 
 	protoReq := &proto.LLMStreamRequest{
 		Slot:     slot,
@@ -443,7 +670,9 @@ func (h *CallbackHarness) Stream(ctx context.Context, slot string, messages []ll
 
 				// Track token usage on final chunk
 				if chunk.FinishReason != "" {
-					h.tokenTracker.Add(slot, usage)
+					if trackUsage {
+						h.trackUsage(slot, usage, preambleTokens)
+					}
 					// Record final token usage in span
 					span.SetAttributes(
 						attribute.Int("gen_ai.usage.input_tokens", usage.InputTokens),
@@ -502,8 +731,13 @@ func (h *CallbackHarness) CallToolProto(ctx context.Context, name string, reques
 		OutputType: string(response.ProtoReflect().Descriptor().FullName()),
 	}
 
-	// Call via the callback client
+	// Call via the callback client. A not-found error may mean the cached
+	// tool list is stale; refresh it and retry once if the tool now exists.
 	resp, err := h.client.CallToolProto(ctx, protoReq)
+	if isNotFound(err, resp.GetError()) && refreshAndFind(ctx, h.toolsCache, name, toolName) {
+		span.AddEvent("tool cache refreshed")
+		resp, err = h.client.CallToolProto(ctx, protoReq)
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -706,17 +940,13 @@ func (h *CallbackHarness) CallToolProtoStream(ctx context.Context, toolName stri
 }
 
 // ListTools returns descriptors for all available tools.
-// Results are cached per task execution.
+// Results are cached per task execution, or for HarnessOptions.ToolCacheTTL.
 func (h *CallbackHarness) ListTools(ctx context.Context) ([]tool.Descriptor, error) {
-	// Check cache first
-	h.cacheMu.RLock()
-	if h.toolsCache != nil {
-		defer h.cacheMu.RUnlock()
-		return h.toolsCache, nil
-	}
-	h.cacheMu.RUnlock()
+	return h.toolsCache.get(ctx)
+}
 
-	// Fetch from orchestrator
+// fetchTools fetches the tool list from the orchestrator.
+func (h *CallbackHarness) fetchTools(ctx context.Context) ([]tool.Descriptor, error) {
 	protoReq := &proto.ListToolsRequest{}
 	resp, err := h.client.ListTools(ctx, protoReq)
 	if err != nil {
@@ -740,14 +970,32 @@ func (h *CallbackHarness) ListTools(ctx context.Context) ([]tool.Descriptor, err
 		}
 	}
 
-	// Cache results
-	h.cacheMu.Lock()
-	h.toolsCache = tools
-	h.cacheMu.Unlock()
-
 	return tools, nil
 }
 
+// GetToolProtoDescriptors returns the proto descriptors of a tool's input and
+// output messages, as served by the tool's DescribeTool RPC. Use them to
+// build CallToolProto requests and decode responses for tools whose
+// generated Go package is not linked into the agent.
+func (h *CallbackHarness) GetToolProtoDescriptors(ctx context.Context, name string) (*tool.ProtoDescriptors, error) {
+	resp, err := h.client.GetToolProtoDescriptors(ctx, &proto.GetToolProtoDescriptorsRequest{ToolName: name})
+	if err != nil {
+		return nil, fmt.Errorf("get tool proto descriptors callback failed: %w", err)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("get tool proto descriptors error: %s", resp.Error.Message)
+	}
+
+	descriptors, err := tool.ParseProtoDescriptors(resp.InputMessageType, resp.OutputMessageType, resp.FileDescriptorSet)
+	if err != nil {
+		return nil, fmt.Errorf("tool %s: %w", name, err)
+	}
+	return descriptors, nil
+}
+
+// toolName returns the name of a tool descriptor.
+func toolName(d tool.Descriptor) string { return d.Name }
+
 // ============================================================================
 // Plugin Operations
 // ============================================================================
@@ -761,6 +1009,9 @@ func (h *CallbackHarness) QueryPlugin(ctx context.Context, name string, method s
 	}
 
 	resp, err := h.client.QueryPlugin(ctx, protoReq)
+	if isNotFound(err, resp.GetError()) && refreshAndFind(ctx, h.pluginsCache, name, pluginName) {
+		resp, err = h.client.QueryPlugin(ctx, protoReq)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("query plugin callback failed: %w", err)
 	}
@@ -774,17 +1025,13 @@ func (h *CallbackHarness) QueryPlugin(ctx context.Context, name string, method s
 }
 
 // ListPlugins returns descriptors for all available plugins.
-// Results are cached per task execution.
+// Results are cached per task execution, or for HarnessOptions.ToolCacheTTL.
 func (h *CallbackHarness) ListPlugins(ctx context.Context) ([]plugin.Descriptor, error) {
-	// Check cache first
-	h.cacheMu.RLock()
-	if h.pluginsCache != nil {
-		defer h.cacheMu.RUnlock()
-		return h.pluginsCache, nil
-	}
-	h.cacheMu.RUnlock()
+	return h.pluginsCache.get(ctx)
+}
 
-	// Fetch from orchestrator
+// fetchPlugins fetches the plugin list from the orchestrator.
+func (h *CallbackHarness) fetchPlugins(ctx context.Context) ([]plugin.Descriptor, error) {
 	protoReq := &proto.ListPluginsRequest{}
 	resp, err := h.client.ListPlugins(ctx, protoReq)
 	if err != nil {
@@ -816,20 +1063,21 @@ func (h *CallbackHarness) ListPlugins(ctx context.Context) ([]plugin.Descriptor,
 		}
 	}
 
-	// Cache results
-	h.cacheMu.Lock()
-	h.pluginsCache = plugins
-	h.cacheMu.Unlock()
-
 	return plugins, nil
 }
 
+// pluginName returns the name of a plugin descriptor.
+func pluginName(d plugin.Descriptor) string { return d.Name }
+
 // ============================================================================
 // Agent Delegation Operations
 // ============================================================================
 
 // DelegateToAgent assigns a task to another agent for execution.
 func (h *CallbackHarness) DelegateToAgent(ctx context.Context, name string, task agent.Task) (agent.Result, error) {
+	// The delegated agent stores into the same mission's graph
+	defer h.invalidateQueryCache(ctx)
+
 	// Convert task to proto
 	protoTask := &proto.Task{
 		Id:       task.ID,
@@ -842,6 +1090,7 @@ func (h *CallbackHarness) DelegateToAgent(ctx context.Context, name string, task
 			AllowedTools: task.Constraints.AllowedTools,
 			BlockedTools: task.Constraints.BlockedTools,
 		},
+		IdempotencyKey: task.IdempotencyKey,
 	}
 
 	protoReq := &proto.DelegateToAgentRequest{
@@ -850,6 +1099,9 @@ func (h *CallbackHarness) DelegateToAgent(ctx context.Context, name string, task
 	}
 
 	resp, err := h.client.DelegateToAgent(ctx, protoReq)
+	if isNotFound(err, resp.GetError()) && refreshAndFind(ctx, h.agentsCache, name, agentName) {
+		resp, err = h.client.DelegateToAgent(ctx, protoReq)
+	}
 	if err != nil {
 		return agent.Result{}, fmt.Errorf("delegate to agent callback failed: %w", err)
 	}
@@ -861,37 +1113,23 @@ func (h *CallbackHarness) DelegateToAgent(ctx context.Context, name string, task
 	// Convert proto result to SDK result using the helper function
 	result := ProtoToResult(resp.Result)
 
-	// Convert error if present
-	if resp.Result.Error != nil {
-		// Convert map[string]string to map[string]any
-		details := make(map[string]any)
-		for k, v := range resp.Result.Error.Details {
-			details[k] = v
-		}
-
-		result.Error = &agent.ResultError{
-			Code:      resp.Result.Error.Code.String(),
-			Message:   resp.Result.Error.Message,
-			Details:   details,
-			Retryable: resp.Result.Error.Retryable,
-		}
+	// Surface the delegated agent's error with its code mapped to the
+	// agent.ErrCode constants
+	if result.ErrorInfo != nil {
+		result.Error = result.ErrorInfo
 	}
 
 	return result, nil
 }
 
 // ListAgents returns descriptors for all available agents.
-// Results are cached per task execution.
+// Results are cached per task execution, or for HarnessOptions.ToolCacheTTL.
 func (h *CallbackHarness) ListAgents(ctx context.Context) ([]agent.Descriptor, error) {
-	// Check cache first
-	h.cacheMu.RLock()
-	if h.agentsCache != nil {
-		defer h.cacheMu.RUnlock()
-		return h.agentsCache, nil
-	}
-	h.cacheMu.RUnlock()
+	return h.agentsCache.get(ctx)
+}
 
-	// Fetch from orchestrator
+// fetchAgents fetches the agent list from the orchestrator.
+func (h *CallbackHarness) fetchAgents(ctx context.Context) ([]agent.Descriptor, error) {
 	protoReq := &proto.ListAgentsRequest{}
 	resp, err := h.client.ListAgents(ctx, protoReq)
 	if err != nil {
@@ -907,23 +1145,23 @@ func (h *CallbackHarness) ListAgents(ctx context.Context) ([]agent.Descriptor, e
 	for i, protoAgent := range resp.Agents {
 		// Proto returns strings, which match the new agent interface
 		agents[i] = agent.Descriptor{
-			Name:           protoAgent.Name,
-			Version:        protoAgent.Version,
-			Description:    protoAgent.Description,
-			Capabilities:   protoAgent.Capabilities,
-			TargetTypes:    protoAgent.TargetTypes,
-			TechniqueTypes: protoAgent.TechniqueTypes,
+			Name:            protoAgent.Name,
+			Version:         protoAgent.Version,
+			Description:     protoAgent.Description,
+			Capabilities:    protoAgent.Capabilities,
+			TargetTypes:     protoAgent.TargetTypes,
+			TechniqueTypes:  protoAgent.TechniqueTypes,
+			RequiredTools:   protoAgent.RequiredTools,
+			RequiredPlugins: protoAgent.RequiredPlugins,
 		}
 	}
 
-	// Cache results
-	h.cacheMu.Lock()
-	h.agentsCache = agents
-	h.cacheMu.Unlock()
-
 	return agents, nil
 }
 
+// agentName returns the name of an agent descriptor.
+func agentName(d agent.Descriptor) string { return d.Name }
+
 // ============================================================================
 // Finding Operations
 // ============================================================================
@@ -940,6 +1178,18 @@ func (h *CallbackHarness) SubmitFinding(ctx context.Context, f *finding.Finding)
 	)
 	defer span.End()
 
+	// Enforce the mission's rules of engagement before the finding leaves
+	// the agent.
+	if f.Technique != "" && !h.mission.Constraints.PermitsTechnique(types.TechniqueType(f.Technique)) {
+		err := fmt.Errorf("finding %q uses technique %q, which the mission constraints do not permit", f.Title, f.Technique)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	// Findings are graph nodes
+	defer h.invalidateQueryCache(ctx)
+
 	// Convert finding to proto
 	protoReq := &proto.SubmitFindingRequest{
 		Finding: FindingToProto(f),
@@ -1020,7 +1270,7 @@ func (h *CallbackHarness) QueryNodes(ctx context.Context, query *graphragpb.Grap
 	defer span.End()
 
 	protoReq := &proto.QueryNodesRequest{
-		Context: h.client.contextInfo(),
+		Context: h.client.contextInfo(ctx),
 		Query:   query,
 	}
 
@@ -1060,14 +1310,41 @@ func (h *CallbackHarness) QueryGraphRAG(ctx context.Context, query graphrag.Quer
 	)
 	defer span.End()
 
-	// Convert query to proto
-	protoQuery := GraphQueryToProto(query)
+	// Convert query to proto, over-fetching candidates for client-side
+	// re-ranking
+	fetch := query
+	fetch.TopK = query.CandidateTopK()
+	protoQuery := GraphQueryToProto(fetch)
 
 	protoReq := &proto.GraphRAGQueryRequest{
-		Context: h.client.contextInfo(),
+		Context: h.client.contextInfo(ctx),
 		Query:   protoQuery,
 	}
 
+	// Serve repeated queries from the cache until the mission's graph is
+	// stored into
+	var cacheKey string
+	var cacheGeneration uint64
+	cacheable := false
+	if h.queryCache != nil {
+		missionID := protoReq.Context.GetMissionId()
+		if cacheKey, cacheable = queryCacheKey(missionID, protoQuery); cacheable {
+			cached, generation, hit := h.queryCache.get(missionID, cacheKey)
+			span.SetAttributes(attribute.Bool("gibson.graphrag.cache_hit", hit))
+			if hit {
+				results, err := graphrag.RerankResults(ctx, query, h.graphRAGResultsFromProto(cached), nil)
+				if err != nil {
+					span.RecordError(err)
+					span.SetStatus(codes.Error, err.Error())
+					return nil, fmt.Errorf("GraphRAG query rerank failed: %w", err)
+				}
+				span.SetAttributes(attribute.Int("gibson.graphrag.result_count", len(results)))
+				return results, nil
+			}
+			cacheGeneration = generation
+		}
+	}
+
 	resp, err := h.client.GraphRAGQuery(ctx, protoReq)
 	if err != nil {
 		span.RecordError(err)
@@ -1082,9 +1359,134 @@ func (h *CallbackHarness) QueryGraphRAG(ctx context.Context, query graphrag.Quer
 		return nil, err
 	}
 
-	// Convert results
-	results := make([]graphrag.Result, len(resp.Results))
-	for i, protoResult := range resp.Results {
+	if cacheable {
+		h.queryCache.put(protoReq.Context.GetMissionId(), cacheKey, cacheGeneration, resp.Results)
+	}
+
+	results, err := graphrag.RerankResults(ctx, query, h.graphRAGResultsFromProto(resp.Results), nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("GraphRAG query rerank failed: %w", err)
+	}
+
+	// Record result count in span
+	span.SetAttributes(
+		attribute.Int("gibson.graphrag.result_count", len(results)),
+	)
+
+	return results, nil
+}
+
+// QueryBatch runs several GraphRAG queries in one callback and returns their
+// results aligned by input index. Queries that fail do not fail the batch:
+// their results are nil and the returned error is a *graphrag.BatchQueryError
+// holding the error of each failed query. Other errors mean the whole batch
+// failed. If the daemon does not support batches, the queries are run one by
+// one.
+func (h *CallbackHarness) QueryBatch(ctx context.Context, queries []graphrag.Query) ([][]graphrag.Result, error) {
+	if len(queries) == 0 {
+		return [][]graphrag.Result{}, nil
+	}
+
+	ctx, span := h.tracer.Start(ctx, "gibson.graphrag.query_batch",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int("gibson.graphrag.query_count", len(queries)),
+		),
+	)
+	defer span.End()
+
+	protoQueries := make([]*proto.GraphQuery, len(queries))
+	for i, query := range queries {
+		query.TopK = query.CandidateTopK()
+		protoQueries[i] = GraphQueryToProto(query)
+	}
+
+	resp, err := h.client.GraphRAGQueryBatch(ctx, &proto.GraphRAGQueryBatchRequest{
+		Queries: protoQueries,
+	})
+	if err != nil {
+		if status.Code(err) == grpccodes.Unimplemented {
+			return h.queryEach(ctx, queries)
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("GraphRAG batch query callback failed: %w", err)
+	}
+
+	if resp.Error != nil {
+		err := fmt.Errorf("GraphRAG batch query error: %s", resp.Error.Message)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, resp.Error.Message)
+		return nil, err
+	}
+
+	if len(resp.Items) != len(queries) {
+		err := fmt.Errorf("GraphRAG batch query returned %d results for %d queries", len(resp.Items), len(queries))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	results := make([][]graphrag.Result, len(queries))
+	var batchErr *graphrag.BatchQueryError
+	for i, item := range resp.Items {
+		if item.Error != nil {
+			if batchErr == nil {
+				batchErr = &graphrag.BatchQueryError{Errors: make(map[int]error)}
+			}
+			batchErr.Errors[i] = fmt.Errorf("GraphRAG query error: %s", item.Error.Message)
+			continue
+		}
+		reranked, err := graphrag.RerankResults(ctx, queries[i], h.graphRAGResultsFromProto(item.Results), nil)
+		if err != nil {
+			if batchErr == nil {
+				batchErr = &graphrag.BatchQueryError{Errors: make(map[int]error)}
+			}
+			batchErr.Errors[i] = fmt.Errorf("GraphRAG query rerank failed: %w", err)
+			continue
+		}
+		results[i] = reranked
+	}
+
+	if batchErr != nil {
+		span.SetAttributes(attribute.Int("gibson.graphrag.failed_count", len(batchErr.Errors)))
+		return results, batchErr
+	}
+	return results, nil
+}
+
+// queryEach runs queries one by one with QueryGraphRAG, collecting their
+// errors like QueryBatch.
+func (h *CallbackHarness) queryEach(ctx context.Context, queries []graphrag.Query) ([][]graphrag.Result, error) {
+	results := make([][]graphrag.Result, len(queries))
+	var batchErr *graphrag.BatchQueryError
+	for i, query := range queries {
+		queryResults, err := h.QueryGraphRAG(ctx, query)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			if batchErr == nil {
+				batchErr = &graphrag.BatchQueryError{Errors: make(map[int]error)}
+			}
+			batchErr.Errors[i] = err
+			continue
+		}
+		results[i] = queryResults
+	}
+
+	if batchErr != nil {
+		return results, batchErr
+	}
+	return results, nil
+}
+
+// graphRAGResultsFromProto converts GraphRAG query results from proto.
+func (h *CallbackHarness) graphRAGResultsFromProto(protoResults []*proto.GraphRAGResult) []graphrag.Result {
+	results := make([]graphrag.Result, len(protoResults))
+	for i, protoResult := range protoResults {
 		results[i] = graphrag.Result{
 			Node:        h.graphNodeFromProto(protoResult.Node),
 			Score:       protoResult.Score,
@@ -1094,13 +1496,54 @@ func (h *CallbackHarness) QueryGraphRAG(ctx context.Context, query graphrag.Quer
 			Distance:    int(protoResult.Distance),
 		}
 	}
+	return results
+}
 
-	// Record result count in span
-	span.SetAttributes(
-		attribute.Int("gibson.graphrag.result_count", len(results)),
-	)
+// ExplainQuery returns the execution plan for a GraphRAG query without running it.
+// The daemon is asked for its plan so the result reflects server-side routing;
+// if the daemon does not implement query explanation, the plan is computed
+// locally with query.Explain().
+func (h *CallbackHarness) ExplainQuery(ctx context.Context, query graphrag.Query) (graphrag.QueryPlan, error) {
+	protoReq := &proto.GraphRAGExplainRequest{
+		Query:   GraphQueryToProto(query),
+		MaxHops: int32(query.MaxHops),
+	}
 
-	return results, nil
+	resp, err := h.client.GraphRAGExplain(ctx, protoReq)
+	if err != nil {
+		if status.Code(err) == grpccodes.Unimplemented {
+			return query.Explain(), nil
+		}
+		return graphrag.QueryPlan{}, fmt.Errorf("GraphRAG explain callback failed: %w", err)
+	}
+
+	if resp.Error != nil {
+		return graphrag.QueryPlan{}, fmt.Errorf("GraphRAG explain error: %s", resp.Error.Message)
+	}
+
+	return ProtoToQueryPlan(resp.Plan), nil
+}
+
+// GraphStats returns node and relationship counts for the knowledge graph
+// within a mission scope. An empty missionID uses the current mission.
+// Use it before traversals to size MaxHops to the graph's density.
+func (h *CallbackHarness) GraphStats(ctx context.Context, missionID string) (*graphrag.GraphStats, error) {
+	resp, err := h.client.GraphRAGStats(ctx, &proto.GraphRAGStatsRequest{
+		MissionId: missionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAG stats callback failed: %w", err)
+	}
+
+	if resp.Error != nil {
+		return nil, fmt.Errorf("GraphRAG stats error: %s", resp.Error.Message)
+	}
+
+	stats := ProtoToGraphStats(resp.Stats)
+	if stats.MissionID == "" {
+		stats.MissionID = missionID
+	}
+	return stats, nil
 }
 
 // QuerySemantic performs a semantic query using vector embeddings.
@@ -1272,9 +1715,10 @@ func (h *CallbackHarness) StoreNode(ctx context.Context, node *graphragpb.GraphN
 		),
 	)
 	defer span.End()
+	defer h.invalidateQueryCache(ctx)
 
 	protoReq := &proto.StoreNodeRequest{
-		Context: h.client.contextInfo(),
+		Context: h.client.contextInfo(ctx),
 		Node:    node,
 	}
 
@@ -1295,7 +1739,14 @@ func (h *CallbackHarness) StoreNode(ctx context.Context, node *graphragpb.GraphN
 	return resp.NodeId, nil
 }
 
+// StoreGraphNode validates the node with GraphNode.Validate, which checks
+// registered custom types for their identifying properties, and stores it.
 func (h *CallbackHarness) StoreGraphNode(ctx context.Context, node graphrag.GraphNode) (string, error) {
+	if err := node.Validate(); err != nil {
+		return "", fmt.Errorf("invalid graph node: %w", err)
+	}
+	defer h.invalidateQueryCache(ctx)
+
 	protoReq := &proto.StoreGraphNodeRequest{
 		Node: h.graphNodeToProto(node),
 	}
@@ -1328,6 +1779,8 @@ func (h *CallbackHarness) StoreStructured(ctx context.Context, node graphrag.Gra
 
 // CreateGraphRelationship creates a relationship between two existing nodes.
 func (h *CallbackHarness) CreateGraphRelationship(ctx context.Context, rel graphrag.Relationship) error {
+	defer h.invalidateQueryCache(ctx)
+
 	protoReq := &proto.CreateGraphRelationshipRequest{
 		Relationship: h.relationshipToProto(rel),
 	}
@@ -1344,14 +1797,20 @@ func (h *CallbackHarness) CreateGraphRelationship(ctx context.Context, rel graph
 	return nil
 }
 
-// StoreGraphBatch stores multiple nodes and relationships atomically.
+// StoreGraphBatch stores multiple nodes and relationships atomically. Nodes
+// are validated as in StoreGraphNode, and an invalid node fails the batch.
 func (h *CallbackHarness) StoreGraphBatch(ctx context.Context, batch graphrag.Batch) ([]string, error) {
 	// Convert nodes
 	protoNodes := make([]*proto.GraphNode, len(batch.Nodes))
 	for i, node := range batch.Nodes {
+		if err := node.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid graph node %d: %w", i, err)
+		}
 		protoNodes[i] = h.graphNodeToProto(node)
 	}
 
+	defer h.invalidateQueryCache(ctx)
+
 	// Convert relationships
 	protoRels := make([]*proto.Relationship, len(batch.Relationships))
 	for i, rel := range batch.Relationships {
@@ -1375,8 +1834,95 @@ func (h *CallbackHarness) StoreGraphBatch(ctx context.Context, batch graphrag.Ba
 	return resp.NodeIds, nil
 }
 
+// StoreDomainNode stores a typed domain node, such as a domain.Port, together
+// with the relationship from its parent in one atomic batch. The node gets a
+// deterministic ID derived from its type, its identifying properties, and
+// its parent's ID, so storing the same node twice updates it rather than
+// duplicating it. If the node has a SetID method, it is called with the
+// stored ID.
+//
+// A parent referenced by {"id": ...} is linked by that ID. Otherwise the
+// parent ID is derived from the parent's type and properties, which matches
+// the ID StoreDomainNode gives a root node.
+func (h *CallbackHarness) StoreDomainNode(ctx context.Context, node graphrag.DomainNode) (string, error) {
+	batch, nodeID, err := domainNodeBatch(node)
+	if err != nil {
+		return "", err
+	}
+
+	ids, err := h.StoreGraphBatch(ctx, batch)
+	if err != nil {
+		return "", err
+	}
+	if len(ids) > 0 && ids[0] != "" {
+		nodeID = ids[0]
+	}
+
+	if n, ok := node.(interface{ SetID(id string) }); ok {
+		n.SetID(nodeID)
+	}
+	return nodeID, nil
+}
+
+// domainNodeBatch converts a domain node into a batch holding the node and,
+// for child nodes, the relationship from the parent. It returns the node's
+// deterministic ID.
+func domainNodeBatch(node graphrag.DomainNode) (graphrag.Batch, string, error) {
+	nodeType := node.NodeType()
+	identifying := make(map[string]any, len(node.IdentifyingProperties())+1)
+	for k, v := range node.IdentifyingProperties() {
+		identifying[k] = v
+	}
+
+	var parentRel *graphrag.Relationship
+	if ref := node.ParentRef(); ref != nil {
+		if ref.Relationship == "" {
+			return graphrag.Batch{}, "", fmt.Errorf("%s node: parent %s reference has no relationship type", nodeType, ref.NodeType)
+		}
+		parentID, err := domainParentID(ref)
+		if err != nil {
+			return graphrag.Batch{}, "", fmt.Errorf("%s node: %w", nodeType, err)
+		}
+		// Child keys are only unique within the parent, e.g. port 443/tcp.
+		identifying["parent_id"] = parentID
+		parentRel = graphrag.NewRelationship(parentID, "", ref.Relationship)
+	}
+
+	nodeID, err := id.FromIdentifying(nodeType, identifying)
+	if err != nil {
+		return graphrag.Batch{}, "", fmt.Errorf("%s node: %w", nodeType, err)
+	}
+
+	batch := graphrag.Batch{
+		Nodes: []graphrag.GraphNode{*graphrag.NewGraphNode(nodeType).WithID(nodeID).WithProperties(node.Properties())},
+	}
+	if parentRel != nil {
+		parentRel.ToID = nodeID
+		batch.Relationships = []graphrag.Relationship{*parentRel}
+	}
+	return batch, nodeID, nil
+}
+
+// domainParentID resolves a parent reference to a node ID.
+func domainParentID(ref *graphrag.NodeRef) (string, error) {
+	if parentID, ok := ref.Properties["id"].(string); ok && parentID != "" {
+		return parentID, nil
+	}
+	parentID, err := id.FromIdentifying(ref.NodeType, ref.Properties)
+	if err != nil {
+		return "", fmt.Errorf("parent %s: %w", ref.NodeType, err)
+	}
+	return parentID, nil
+}
+
 // TraverseGraph walks the graph from a starting node following relationships.
+// The options are validated before the call. With MustPassNodeTypes, only
+// results the orchestrator marks as satisfying the constraint are returned.
 func (h *CallbackHarness) TraverseGraph(ctx context.Context, startNodeID string, opts graphrag.TraversalOptions) ([]graphrag.TraversalResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	protoReq := &proto.TraverseGraphRequest{
 		StartNodeId: startNodeID,
 		Options: &proto.TraversalOptions{
@@ -1384,6 +1930,10 @@ func (h *CallbackHarness) TraverseGraph(ctx context.Context, startNodeID string,
 			RelationshipTypes: opts.RelationshipTypes,
 			NodeTypes:         opts.NodeTypes,
 			Direction:         opts.Direction,
+			MustPassNodeTypes: opts.MustPassNodeTypes,
+			AvoidNodeTypes:    opts.AvoidNodeTypes,
+			CycleHandling:     string(opts.CycleHandling),
+			MaxRevisits:       int32(opts.MaxRevisits),
 		},
 	}
 
@@ -1397,18 +1947,154 @@ func (h *CallbackHarness) TraverseGraph(ctx context.Context, startNodeID string,
 	}
 
 	// Convert results
-	results := make([]graphrag.TraversalResult, len(resp.Results))
-	for i, protoResult := range resp.Results {
-		results[i] = graphrag.TraversalResult{
-			Node:     h.graphNodeFromProto(protoResult.Node),
-			Path:     protoResult.Path,
-			Distance: int(protoResult.Distance),
+	results := make([]graphrag.TraversalResult, 0, len(resp.Results))
+	for _, protoResult := range resp.Results {
+		if len(opts.MustPassNodeTypes) > 0 && !protoResult.MustPassSatisfied {
+			continue
 		}
+		results = append(results, graphrag.TraversalResult{
+			Node:              h.graphNodeFromProto(protoResult.Node),
+			Path:              protoResult.Path,
+			Distance:          int(protoResult.Distance),
+			MustPassSatisfied: protoResult.MustPassSatisfied,
+		})
 	}
 
 	return results, nil
 }
 
+// ShortestPath returns the cheapest relationship path from fromID to toID,
+// e.g. to link a finding to a technique during attack-chain analysis.
+// The search depth is capped at graphrag.MaxPathDepth. Returns an error
+// wrapping graphrag.ErrPathNotFound if the nodes are not connected within
+// the options' limits.
+func (h *CallbackHarness) ShortestPath(ctx context.Context, fromID, toID string, opts graphrag.PathOptions) ([]graphrag.PathEdge, error) {
+	if fromID == "" || toID == "" {
+		return nil, fmt.Errorf("%w: both from and to node IDs are required", graphrag.ErrInvalidQuery)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.Normalize()
+
+	resp, err := h.client.GraphRAGShortestPath(ctx, &proto.GraphRAGShortestPathRequest{
+		FromId:  fromID,
+		ToId:    toID,
+		Options: PathOptionsToProto(opts),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("shortest path callback failed: %w", err)
+	}
+
+	if resp.Error != nil {
+		return nil, fmt.Errorf("shortest path error: %s", resp.Error.Message)
+	}
+
+	if !resp.Found {
+		return nil, fmt.Errorf("%w: no path from %s to %s within %d hops", graphrag.ErrPathNotFound, fromID, toID, opts.MaxDepth)
+	}
+
+	return ProtoToPathEdges(resp.Edges), nil
+}
+
+// Neighbors returns the nodes one relationship away from nodeID, such as the
+// findings that use a technique. Only relationships of type relType are
+// followed; empty follows any type. direction is "outgoing", "incoming", or
+// "both", and defaults to "outgoing". This is a single cheap lookup, unlike
+// a depth-1 TraverseGraph.
+func (h *CallbackHarness) Neighbors(ctx context.Context, nodeID, relType, direction string) ([]graphrag.GraphNode, error) {
+	if err := graphrag.ValidateNeighborQuery(nodeID, direction); err != nil {
+		return nil, err
+	}
+	if direction == "" {
+		direction = "outgoing"
+	}
+
+	resp, err := h.client.GraphRAGNeighbors(ctx, &proto.GraphRAGNeighborsRequest{
+		NodeId:           nodeID,
+		RelationshipType: relType,
+		Direction:        direction,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("neighbors callback failed: %w", err)
+	}
+
+	if resp.Error != nil {
+		return nil, fmt.Errorf("neighbors error: %s", resp.Error.Message)
+	}
+
+	nodes := make([]graphrag.GraphNode, 0, len(resp.Nodes))
+	for _, protoNode := range resp.Nodes {
+		nodes = append(nodes, h.graphNodeFromProto(protoNode))
+	}
+	return nodes, nil
+}
+
+// WatchGraph streams the nodes other agents store in the graph of a mission,
+// such as the open ports a recon agent discovers, so that an agent can react
+// to them. Only nodes of nodeTypes are delivered; empty delivers every type.
+// An empty missionID watches the current mission. The channel is closed when
+// ctx is cancelled or the orchestrator ends the stream.
+//
+// Example:
+//
+//	events, err := h.WatchGraph(ctx, "", []string{graphrag.NodeTypePort})
+//	for event := range events {
+//	    if event.Kind == graphrag.GraphEventCreated {
+//	        // probe event.Node
+//	    }
+//	}
+func (h *CallbackHarness) WatchGraph(ctx context.Context, missionID string, nodeTypes []string) (<-chan graphrag.GraphEvent, error) {
+	if missionID == "" {
+		missionID = h.mission.ID
+	}
+	if err := graphrag.ValidateWatch(missionID, nodeTypes); err != nil {
+		return nil, err
+	}
+
+	stream, err := h.client.GraphRAGWatch(ctx, &proto.GraphRAGWatchRequest{
+		MissionId: missionID,
+		NodeTypes: nodeTypes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("watch graph callback failed: %w", err)
+	}
+
+	events := make(chan graphrag.GraphEvent, 10)
+	go func() {
+		defer close(events)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					h.logger.Warn("graph watch stream failed", "error", err, "mission_id", missionID)
+				}
+				return
+			}
+			if resp.Error != nil {
+				h.logger.Warn("graph watch error", "error", resp.Error.Message, "mission_id", missionID)
+				return
+			}
+			if resp.Node == nil {
+				continue
+			}
+
+			// the orchestrator filters too; this guards against a daemon
+			// that streams more than was asked for
+			node := h.graphNodeFromProto(resp.Node)
+			if !graphrag.WatchMatches(node, missionID, nodeTypes) {
+				continue
+			}
+			select {
+			case events <- graphrag.GraphEvent{Kind: resp.Kind, Node: node}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
 // GraphRAGHealth returns the health status of the GraphRAG subsystem.
 func (h *CallbackHarness) GraphRAGHealth(ctx context.Context) types.HealthStatus {
 	protoReq := &proto.GraphRAGHealthRequest{}
@@ -1767,6 +2453,9 @@ func protoToSchema(node *proto.JSONSchemaNode) schema.JSON {
 		s.Pattern = *node.Pattern
 	}
 
+	// Carry input guards so served tools enforce them
+	s.Guards = node.Guards
+
 	// Convert properties recursively
 	if len(node.Properties) > 0 {
 		s.Properties = make(map[string]schema.JSON)
@@ -2005,7 +2694,7 @@ func (h *CallbackHarness) QueueToolWork(ctx context.Context, toolName string, in
 
 	// Create the proto request
 	protoReq := &proto.QueueToolWorkRequest{
-		Context:    h.client.contextInfo(),
+		Context:    h.client.contextInfo(ctx),
 		ToolName:   toolName,
 		InputJsons: inputJSONs,
 		InputType:  inputType,
@@ -2057,7 +2746,7 @@ func (h *CallbackHarness) ToolResults(ctx context.Context, jobID string) <-chan
 
 		// Create streaming request
 		protoReq := &proto.ToolResultsRequest{
-			Context: h.client.contextInfo(),
+			Context: h.client.contextInfo(ctx),
 			JobId:   jobID,
 		}
 
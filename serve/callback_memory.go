@@ -123,7 +123,7 @@ func (m *callbackWorkingMemory) Set(ctx context.Context, key string, value any)
 
 	// Convert value to TypedValue
 	req := &proto.MemorySetRequest{
-		Context: m.client.contextInfo(),
+		Context: m.client.contextInfo(ctx),
 		Key:     key,
 		Value:   ToTypedValue(value),
 	}
@@ -379,40 +379,55 @@ func (m *callbackMissionMemory) Delete(ctx context.Context, key string) error {
 }
 
 func (m *callbackMissionMemory) Search(ctx context.Context, query string, limit int) ([]memory.Result, error) {
+	results, _, err := m.SearchFiltered(ctx, query, nil, limit, 0)
+	return results, err
+}
+
+func (m *callbackMissionMemory) SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]memory.Result, int, error) {
 	ctx, span := m.tracer.Start(ctx, "gibson.memory.mission.search",
 		trace.WithSpanKind(trace.SpanKindClient),
 		trace.WithAttributes(
 			attribute.String("gibson.memory.query", query),
 			attribute.Int("gibson.memory.limit", limit),
+			attribute.Int("gibson.memory.offset", offset),
+			attribute.Int("gibson.memory.filter_keys", len(filter)),
 			attribute.String("gibson.memory.tier", "mission"),
 		),
 	)
 	defer span.End()
 
+	if err := memory.ValidateFilter(filter); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+
 	if !m.client.IsConnected() {
 		err := fmt.Errorf("callback client not connected")
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, 0, err
 	}
 
 	req := &proto.MissionMemorySearchRequest{
-		Query: query,
-		Limit: int32(limit),
+		Query:  query,
+		Limit:  int32(limit),
+		Filter: ToTypedMap(filter),
+		Offset: int32(offset),
 	}
 
 	resp, err := m.client.MissionMemorySearch(ctx, req)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, fmt.Errorf("mission memory search failed: %w", err)
+		return nil, 0, fmt.Errorf("mission memory search failed: %w", err)
 	}
 
 	if resp.Error != nil {
 		err := fmt.Errorf("mission memory search error: %s", resp.Error.Message)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, resp.Error.Message)
-		return nil, err
+		return nil, 0, err
 	}
 
 	results := make([]memory.Result, 0, len(resp.Results))
@@ -444,43 +459,62 @@ func (m *callbackMissionMemory) Search(ctx context.Context, query string, limit
 		})
 	}
 
-	span.SetAttributes(attribute.Int("gibson.memory.results", len(results)))
-	return results, nil
+	total := paginationTotal(resp.Total, offset, len(results))
+	span.SetAttributes(
+		attribute.Int("gibson.memory.results", len(results)),
+		attribute.Int("gibson.memory.total", total),
+	)
+	return results, total, nil
 }
 
 func (m *callbackMissionMemory) History(ctx context.Context, limit int) ([]memory.Item, error) {
+	items, _, err := m.HistoryFiltered(ctx, nil, limit, 0)
+	return items, err
+}
+
+func (m *callbackMissionMemory) HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]memory.Item, int, error) {
 	ctx, span := m.tracer.Start(ctx, "gibson.memory.mission.history",
 		trace.WithSpanKind(trace.SpanKindClient),
 		trace.WithAttributes(
 			attribute.Int("gibson.memory.limit", limit),
+			attribute.Int("gibson.memory.offset", offset),
+			attribute.Int("gibson.memory.filter_keys", len(filter)),
 			attribute.String("gibson.memory.tier", "mission"),
 		),
 	)
 	defer span.End()
 
+	if err := memory.ValidateFilter(filter); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+
 	if !m.client.IsConnected() {
 		err := fmt.Errorf("callback client not connected")
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, 0, err
 	}
 
 	req := &proto.MissionMemoryHistoryRequest{
-		Limit: int32(limit),
+		Limit:  int32(limit),
+		Filter: ToTypedMap(filter),
+		Offset: int32(offset),
 	}
 
 	resp, err := m.client.MissionMemoryHistory(ctx, req)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, fmt.Errorf("mission memory history failed: %w", err)
+		return nil, 0, fmt.Errorf("mission memory history failed: %w", err)
 	}
 
 	if resp.Error != nil {
 		err := fmt.Errorf("mission memory history error: %s", resp.Error.Message)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, resp.Error.Message)
-		return nil, err
+		return nil, 0, err
 	}
 
 	items := make([]memory.Item, 0, len(resp.Items))
@@ -509,8 +543,25 @@ func (m *callbackMissionMemory) History(ctx context.Context, limit int) ([]memor
 		})
 	}
 
-	span.SetAttributes(attribute.Int("gibson.memory.items", len(items)))
-	return items, nil
+	total := paginationTotal(resp.Total, offset, len(items))
+	span.SetAttributes(
+		attribute.Int("gibson.memory.items", len(items)),
+		attribute.Int("gibson.memory.total", total),
+	)
+	return items, total, nil
+}
+
+// paginationTotal returns the total match count reported by the daemon.
+// Daemons that predate pagination leave it unset, in which case the count
+// seen so far is the best available lower bound.
+func paginationTotal(reported int32, offset, returned int) int {
+	if total := int(reported); total > 0 {
+		return total
+	}
+	if returned == 0 {
+		return 0
+	}
+	return offset + returned
 }
 
 func (m *callbackMissionMemory) GetPreviousRunValue(ctx context.Context, key string) (any, error) {
@@ -0,0 +1,122 @@
+package serve
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/memory"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+)
+
+// missionMemoryServer records filtered search and history requests.
+type missionMemoryServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	lastSearch  *proto.MissionMemorySearchRequest
+	lastHistory *proto.MissionMemoryHistoryRequest
+	total       int32
+}
+
+func (s *missionMemoryServer) MissionMemorySearch(ctx context.Context, req *proto.MissionMemorySearchRequest) (*proto.MissionMemorySearchResponse, error) {
+	s.lastSearch = req
+	return &proto.MissionMemorySearchResponse{
+		Results: []*proto.MissionMemoryResult{
+			{Key: "cred-2", Value: ToTypedValue("admin:admin"), Score: 0.9},
+		},
+		Total: s.total,
+	}, nil
+}
+
+func (s *missionMemoryServer) MissionMemoryHistory(ctx context.Context, req *proto.MissionMemoryHistoryRequest) (*proto.MissionMemoryHistoryResponse, error) {
+	s.lastHistory = req
+	return &proto.MissionMemoryHistoryResponse{
+		Items: []*proto.MissionMemoryItem{
+			{Key: "cred-4", Value: ToTypedValue("root:toor")},
+			{Key: "cred-3", Value: ToTypedValue("user:pass")},
+		},
+		Total: s.total,
+	}, nil
+}
+
+func newTestMissionMemory(t *testing.T, srv proto.HarnessCallbackServiceServer) memory.MissionMemory {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, srv)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := NewCallbackClient(lis.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+
+	return NewCallbackMemoryStore(client, noop.NewTracerProvider().Tracer("test")).Mission()
+}
+
+func TestCallbackMissionMemory_SearchFiltered(t *testing.T) {
+	fake := &missionMemoryServer{total: 12}
+	mission := newTestMissionMemory(t, fake)
+	ctx := context.Background()
+
+	filter := map[string]any{"category": "credentials", "port": []any{22, 2222}}
+	results, total, err := mission.SearchFiltered(ctx, "admin", filter, 5, 5)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "cred-2", results[0].Key)
+	assert.Equal(t, 12, total)
+
+	require.NotNil(t, fake.lastSearch)
+	assert.Equal(t, int32(5), fake.lastSearch.GetOffset())
+	assert.Equal(t, int32(5), fake.lastSearch.GetLimit())
+	assert.Equal(t, filter["category"], FromTypedValue(fake.lastSearch.GetFilter()["category"]))
+	assert.Len(t, fake.lastSearch.GetFilter(), 2)
+
+	// Search delegates with no filter and no offset
+	_, err = mission.Search(ctx, "admin", 3)
+	require.NoError(t, err)
+	assert.Empty(t, fake.lastSearch.GetFilter())
+	assert.Equal(t, int32(0), fake.lastSearch.GetOffset())
+}
+
+func TestCallbackMissionMemory_HistoryFiltered(t *testing.T) {
+	t.Run("reported total", func(t *testing.T) {
+		fake := &missionMemoryServer{total: 7}
+		mission := newTestMissionMemory(t, fake)
+
+		items, total, err := mission.HistoryFiltered(context.Background(), map[string]any{"category": "credentials"}, 2, 0)
+		require.NoError(t, err)
+		assert.Len(t, items, 2)
+		assert.Equal(t, 7, total)
+		assert.Equal(t, "credentials", FromTypedValue(fake.lastHistory.GetFilter()["category"]))
+	})
+
+	t.Run("daemon without totals", func(t *testing.T) {
+		mission := newTestMissionMemory(t, &missionMemoryServer{})
+
+		_, total, err := mission.HistoryFiltered(context.Background(), nil, 2, 4)
+		require.NoError(t, err)
+		assert.Equal(t, 6, total, "falls back to the count seen so far")
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		fake := &missionMemoryServer{}
+		mission := newTestMissionMemory(t, fake)
+
+		_, _, err := mission.HistoryFiltered(context.Background(), map[string]any{"category": map[string]any{"$regex": "c.*"}}, 2, 0)
+		assert.ErrorIs(t, err, memory.ErrInvalidFilter)
+		assert.Nil(t, fake.lastHistory, "invalid filters are rejected before the callback")
+	})
+}
@@ -3,15 +3,29 @@ package serve
 import (
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/zero-day-ai/sdk/llm"
 )
 
 // CallbackTokenTracker implements llm.TokenTracker with thread-safe tracking
 // of token usage across different LLM slots during callback-based execution.
+//
+// With reporting enabled (see HarnessOptions.TokenReport), the tracker also
+// sends snapshots of its cumulative usage to the orchestrator in the
+// background, so the usage of a task survives an agent crash.
 type CallbackTokenTracker struct {
 	mu    sync.RWMutex
 	slots map[string]llm.TokenUsage
 	total llm.TokenUsage
+
+	// version advances with every change, so unchanged usage is not
+	// reported again
+	version uint64
+
+	// session and sequence identify reports; nil reporter disables them
+	session  string
+	sequence int64
+	reporter *tokenReporter
 }
 
 // NewCallbackTokenTracker creates a new thread-safe token tracker.
@@ -32,7 +46,13 @@ func (t *CallbackTokenTracker) Add(slot string, usage llm.TokenUsage) {
 	t.slots[slot] = current.Add(usage)
 
 	// Update total usage
+	previous := t.total.TotalTokens
 	t.total = t.total.Add(usage)
+	t.version++
+
+	if t.reporter != nil && t.reporter.crossesThreshold(previous, t.total.TotalTokens) {
+		t.reporter.trigger()
+	}
 }
 
 // Total returns the aggregate token usage across all slots.
@@ -60,6 +80,14 @@ func (t *CallbackTokenTracker) Reset() {
 
 	t.slots = make(map[string]llm.TokenUsage)
 	t.total = llm.TokenUsage{}
+	t.version++
+
+	// Usage reported so far stays accounted for: later reports start a new
+	// session rather than lowering the totals of the reported one
+	if t.reporter != nil {
+		t.session = uuid.NewString()
+		t.sequence = 0
+	}
 }
 
 // Slots returns a list of all tracked slot names.
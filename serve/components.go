@@ -0,0 +1,271 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/plugin"
+	"github.com/zero-day-ai/sdk/tool"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// component is an agent, tool, or plugin hosted by a Server.
+type component struct {
+	kind    string
+	name    string
+	version string
+
+	// metadata is the registry metadata of the component
+	metadata func() map[string]string
+
+	// serviceInfo is set while the component is registered with the registry
+	serviceInfo map[string]interface{}
+}
+
+// New creates a server configured by opts that can host an agent, a tool,
+// and a plugin on one port. Register the components with RegisterAgent,
+// RegisterTool, and RegisterPlugin, then call Serve. The components share
+// the listener, health service, interceptors, and graceful shutdown.
+//
+// Example:
+//
+//	srv, err := serve.New(serve.WithPort(50051))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := srv.RegisterAgent(myAgent); err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := srv.RegisterTool(myTool); err != nil {
+//	    log.Fatal(err)
+//	}
+//	log.Fatal(srv.Serve(context.Background()))
+func New(opts ...Option) (*Server, error) {
+	cfg := DefaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return NewServer(cfg)
+}
+
+// RegisterAgent hosts an agent on the server. A server hosts at most one
+// agent, and components must be registered before Serve is called.
+func (s *Server) RegisterAgent(a agent.Agent) error {
+	if err := s.checkRegistration("agent"); err != nil {
+		return err
+	}
+
+	agentSvc := newAgentServiceServer(a, s.config.MaxConcurrentTasks)
+	agentSvc.harnessOpts = s.config.Harness
+	agentSvc.skipTargetValidation = s.config.SkipTargetValidation
+	if s.config.Harness.QueryCache != nil {
+		agentSvc.queryCache = newQueryCache(*s.config.Harness.QueryCache)
+	}
+	proto.RegisterAgentServiceServer(s.grpcServer, agentSvc)
+	s.agentSvc = agentSvc
+
+	s.addComponent(proto.AgentService_ServiceDesc.ServiceName, component{
+		kind:    "agent",
+		name:    a.Name(),
+		version: a.Version(),
+		metadata: func() map[string]string {
+			return map[string]string{
+				"description":     a.Description(),
+				"capabilities":    strings.Join(a.Capabilities(), ","),
+				"target_types":    strings.Join(a.TargetTypes(), ","),
+				"technique_types": strings.Join(a.TechniqueTypes(), ","),
+			}
+		},
+	})
+	return nil
+}
+
+// RegisterTool hosts a tool on the server. A server hosts at most one tool,
+// and components must be registered before Serve is called. Unless disabled
+// with WithReflection(false), the server also serves gRPC reflection.
+func (s *Server) RegisterTool(t tool.Tool) error {
+	if err := s.checkRegistration("tool"); err != nil {
+		return err
+	}
+
+	proto.RegisterToolServiceServer(s.grpcServer, &toolServiceServer{tool: t})
+	if !s.config.DisableReflection {
+		reflection.Register(s.grpcServer)
+	}
+
+	s.addComponent(proto.ToolService_ServiceDesc.ServiceName, component{
+		kind:    "tool",
+		name:    t.Name(),
+		version: t.Version(),
+		metadata: func() map[string]string {
+			return toolMetadata(t)
+		},
+	})
+	return nil
+}
+
+// RegisterPlugin hosts a plugin on the server. A server hosts at most one
+// plugin, and components must be registered before Serve is called.
+func (s *Server) RegisterPlugin(p plugin.Plugin) error {
+	if err := s.checkRegistration("plugin"); err != nil {
+		return err
+	}
+
+	proto.RegisterPluginServiceServer(s.grpcServer, &pluginServiceServer{plugin: p})
+
+	s.addComponent(proto.PluginService_ServiceDesc.ServiceName, component{
+		kind:    "plugin",
+		name:    p.Name(),
+		version: p.Version(),
+		metadata: func() map[string]string {
+			methods := p.Methods()
+			methodNames := make([]string, len(methods))
+			for i, method := range methods {
+				methodNames[i] = method.Name
+			}
+			return map[string]string{
+				"description": p.Description(),
+				"methods":     strings.Join(methodNames, ","),
+			}
+		},
+	})
+	return nil
+}
+
+// toolMetadata returns the registry metadata of a tool.
+func toolMetadata(t tool.Tool) map[string]string {
+	metadata := map[string]string{
+		"description": t.Description(),
+	}
+
+	// Add tags if available
+	if len(t.Tags()) > 0 {
+		metadata["tags"] = strings.Join(t.Tags(), ",")
+	}
+
+	// Add proto message types
+	metadata["input_message_type"] = t.InputMessageType()
+	metadata["output_message_type"] = t.OutputMessageType()
+
+	// Capture capabilities if the tool implements CapabilityProvider
+	if caps := tool.GetCapabilities(context.Background(), t); caps != nil {
+		// Serialize capabilities to JSON for storage in metadata
+		if capsJSON, err := json.Marshal(caps); err == nil {
+			metadata["capabilities"] = string(capsJSON)
+		} else {
+			slog.Warn("failed to serialize tool capabilities", "error", err, "tool", t.Name())
+		}
+	}
+	return metadata
+}
+
+// checkRegistration returns an error if a component of kind cannot be
+// registered: the server already hosts one, or it is already serving.
+func (s *Server) checkRegistration(kind string) error {
+	s.componentsMu.Lock()
+	defer s.componentsMu.Unlock()
+
+	if s.serving {
+		return fmt.Errorf("cannot register %s: server is already serving", kind)
+	}
+	for _, c := range s.components {
+		if c.kind == kind {
+			return fmt.Errorf("cannot register %s: server already hosts %s %q", kind, kind, c.name)
+		}
+	}
+	return nil
+}
+
+// addComponent records a registered component and marks its gRPC service
+// and the server as serving.
+func (s *Server) addComponent(serviceName string, c component) {
+	s.componentsMu.Lock()
+	s.components = append(s.components, &c)
+	s.componentsMu.Unlock()
+
+	s.healthServer.SetServingStatus(serviceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	s.healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+
+	slog.Info(c.kind+" server started", "component", c.kind, "name", c.name, "version", c.version, "port", s.Port())
+}
+
+// startComponents marks the server as serving and registers its components
+// with the registry, if one is configured. Registry failures are logged and
+// do not stop the server.
+func (s *Server) startComponents() {
+	s.componentsMu.Lock()
+	s.serving = true
+	components := s.components
+	s.componentsMu.Unlock()
+
+	if s.config.Registry == nil {
+		return
+	}
+
+	endpoint := s.advertisedEndpoint()
+	for _, c := range components {
+		serviceInfo := map[string]interface{}{
+			"kind":        c.kind,
+			"name":        c.name,
+			"version":     c.version,
+			"instance_id": uuid.New().String(),
+			"endpoint":    endpoint,
+			"metadata":    c.metadata(),
+			"started_at":  time.Now(),
+		}
+
+		if err := s.config.Registry.Register(context.Background(), serviceInfo); err != nil {
+			slog.Warn("failed to register with registry", "error", err, "endpoint", endpoint, "component", c.kind, "name", c.name)
+			continue
+		}
+		slog.Info("registered with registry", "endpoint", endpoint, "component", c.kind, "name", c.name)
+		c.serviceInfo = serviceInfo
+	}
+}
+
+// stopComponents deregisters the components from the registry and releases
+// the agent's callback connections.
+func (s *Server) stopComponents() {
+	s.componentsMu.Lock()
+	components := s.components
+	s.componentsMu.Unlock()
+
+	for _, c := range components {
+		if c.serviceInfo == nil {
+			continue
+		}
+		if err := s.config.Registry.Deregister(context.Background(), c.serviceInfo); err != nil {
+			slog.Warn("failed to deregister from registry", "error", err, "endpoint", c.serviceInfo["endpoint"], "component", c.kind, "name", c.name)
+		}
+		c.serviceInfo = nil
+	}
+
+	if s.agentSvc != nil {
+		s.agentSvc.closeCallbackClients()
+	}
+}
+
+// advertisedEndpoint returns the address other components use to reach the
+// server: the Unix socket in LocalMode, otherwise AdvertiseAddr or
+// localhost, with the server's port appended if missing.
+func (s *Server) advertisedEndpoint() string {
+	switch {
+	case s.config.LocalMode != "":
+		return fmt.Sprintf("unix://%s", s.config.LocalMode)
+	case s.config.AdvertiseAddr != "":
+		if strings.Contains(s.config.AdvertiseAddr, ":") {
+			return s.config.AdvertiseAddr
+		}
+		return fmt.Sprintf("%s:%d", s.config.AdvertiseAddr, s.Port())
+	default:
+		return fmt.Sprintf("localhost:%d", s.Port())
+	}
+}
@@ -0,0 +1,125 @@
+package serve
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// TestServer_MultipleComponents tests that an agent, a tool, and a plugin
+// share one listener, health service, interceptor chain, and registry
+// lifecycle.
+func TestServer_MultipleComponents(t *testing.T) {
+	reg := newMockRegistry()
+	var calls atomic.Int32
+	countCalls := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		calls.Add(1)
+		return handler(ctx, req)
+	}
+
+	srv, err := New(
+		WithPort(0),
+		WithGracefulShutdown(time.Second),
+		WithRegistry(reg),
+		WithUnaryInterceptors(countCalls),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, srv.RegisterAgent(&mockAgent{name: "recon-agent", version: "1.0.0"}))
+	require.NoError(t, srv.RegisterTool(&mockTool{name: "nmap", version: "7.94"}))
+	require.NoError(t, srv.RegisterPlugin(&mockPlugin{}))
+
+	err = srv.RegisterTool(&mockTool{name: "httpx"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `already hosts tool "nmap"`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(ctx) }()
+
+	conn, err := grpc.NewClient(srv.advertisedEndpoint(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	callCtx, callCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer callCancel()
+
+	agentDesc, err := proto.NewAgentServiceClient(conn).GetDescriptor(callCtx, &proto.AgentGetDescriptorRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "recon-agent", agentDesc.Name)
+
+	toolDesc, err := proto.NewToolServiceClient(conn).GetDescriptor(callCtx, &proto.ToolGetDescriptorRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "nmap", toolDesc.Name)
+
+	methods, err := proto.NewPluginServiceClient(conn).ListMethods(callCtx, &proto.PluginListMethodsRequest{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, methods.Methods)
+
+	health := grpc_health_v1.NewHealthClient(conn)
+	for _, service := range []string{
+		"",
+		proto.AgentService_ServiceDesc.ServiceName,
+		proto.ToolService_ServiceDesc.ServiceName,
+		proto.PluginService_ServiceDesc.ServiceName,
+	} {
+		resp, err := health.Check(callCtx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.NoError(t, err, "service %q", service)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status, "service %q", service)
+	}
+
+	// Component and health calls all go through the shared chain
+	assert.Equal(t, int32(7), calls.Load())
+
+	err = srv.RegisterAgent(&mockAgent{name: "late-agent"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already serving")
+
+	cancel()
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after cancellation")
+	}
+
+	require.Len(t, reg.registered, 3)
+	kinds := make([]string, 0, 3)
+	for _, info := range reg.registered {
+		serviceInfo := info.(map[string]interface{})
+		kinds = append(kinds, serviceInfo["kind"].(string))
+		assert.Equal(t, srv.advertisedEndpoint(), serviceInfo["endpoint"])
+	}
+	assert.Equal(t, []string{"agent", "tool", "plugin"}, kinds)
+	assert.Len(t, reg.deregistered, 3)
+}
+
+// TestServer_AdvertisedEndpoint tests the endpoint announced to the registry.
+func TestServer_AdvertisedEndpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{"local mode", Config{LocalMode: "/tmp/agent.sock", AdvertiseAddr: "agent"}, "unix:///tmp/agent.sock"},
+		{"advertise host", Config{AdvertiseAddr: "agent"}, "agent:50051"},
+		{"advertise host and port", Config{AdvertiseAddr: "agent:9000"}, "agent:9000"},
+		{"default", Config{}, "localhost:50051"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.cfg
+			cfg.Port = 50051
+			srv := &Server{config: &cfg}
+			assert.Equal(t, tt.want, srv.advertisedEndpoint())
+		})
+	}
+}
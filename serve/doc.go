@@ -59,13 +59,123 @@
 //   - WithHealthEndpoint: Set the health check endpoint path (default: /health)
 //   - WithGracefulShutdown: Set the graceful shutdown timeout (default: 30s)
 //   - WithTLS: Enable TLS with certificate and key files
+//   - WithMaxConcurrentTasks: Limit in-flight agent executions (default: unlimited)
+//   - WithSkipTargetValidation: Run tasks whose target does not match the agent's target schemas
+//   - WithHarnessOptions: Configure the per-task callback harness
+//   - WithQueryCache: Cache GraphRAG query results within a mission
+//   - WithTokenReport: Report task token usage to the orchestrator
+//   - WithSystemPreamble: Inject a system preamble into every LLM call
+//   - WithUnaryInterceptors, WithStreamInterceptors: Add gRPC server interceptors
+//   - WithLifecycleEvents, WithTracer: Observe server state transitions
+//
+// # Multiple Components
+//
+// One process can host an agent, a tool, and a plugin on a single port. Create
+// the server with New, register each component, then call Serve:
+//
+//	srv, err := serve.New(serve.WithPort(50051))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	srv.RegisterAgent(myAgent)
+//	srv.RegisterTool(myTool)
+//	log.Fatal(srv.Serve(context.Background()))
+//
+// The components share the listener, TLS, interceptors, and graceful
+// shutdown. The health service reports each component's gRPC service name
+// as well as the server as a whole, and each component is announced to the
+// registry separately under the same endpoint. Agent, Tool, and Plugin are
+// shorthands for a server hosting a single component.
+//
+// # Concurrent Execution
+//
+// An agent server may run several Execute calls at once. Each call gets its
+// own harness, so token usage, list caches, and task-scoped working memory are
+// never shared between tasks; only the connection to the orchestrator's
+// callback endpoint is reused. The agent implementation is responsible for
+// its own thread safety, since the same agent value serves every task.
+//
+// With WithMaxConcurrentTasks, calls beyond the limit are rejected with
+// codes.ResourceExhausted instead of being queued.
+//
+// # Target Validation
+//
+// Before an agent runs, the target of its task is checked against the
+// agent's TargetSchemas with types.ValidateTargetConfig. A target of an
+// undeclared type, or with missing or mistyped connection parameters, fails
+// the Execute or StreamExecute call with codes.InvalidArgument naming every
+// offending field, instead of failing mid-execution. The schemas are also
+// served by the DescribeTargetSchemas RPC and in the agent descriptor, so
+// the daemon can render target configuration forms. Agents that declare no
+// schemas accept any target.
+//
+// # Tool, Plugin, and Agent Lists
+//
+// The callback harness caches the results of ListTools, ListPlugins, and
+// ListAgents for the task. When a tool call, plugin query, or delegation
+// fails with a not-found error, the matching list is re-fetched, and the call
+// is retried once if the name now exists, so components registered mid-mission
+// become usable and removed ones disappear from the lists. Set
+// HarnessOptions.ToolCacheTTL to also refresh the lists in the background once
+// they are older than the TTL. CallbackHarness.CacheRefreshes counts the
+// refreshes; HarnessOptions.MeterProvider exports them as metrics.
+//
+// # GraphRAG Query Cache
+//
+// WithQueryCache lets an agent server answer repeated QueryGraphRAG calls
+// from a cache shared by all its tasks. Entries are keyed by mission and by
+// a hash of the whole query (text, embedding, filters, weights), and live
+// for QueryCacheOptions.TTL. Any store into a mission's graph made through
+// the harness (StoreNode, StoreGraphNode, StoreGraphBatch,
+// CreateGraphRelationship, SubmitFinding, or a DelegateToAgent call) drops
+// that mission's entries once it completes, and the result of a query that
+// was in flight during the store is not cached. Writes by other agents and
+// tools are only seen after the TTL:
+//
+//	serve.Agent(myAgent, serve.WithQueryCache(serve.QueryCacheOptions{
+//	    TTL: 30 * time.Second,
+//	}))
+//
+// # Token Usage Reports
+//
+// With WithTokenReport, each task reports the cumulative token usage of its
+// harness to the orchestrator with ReportTokenUsage: every interval while
+// usage changes, as soon as usage crosses one of the configured thresholds,
+// and once more, marked final, when the task ends. Reports carry totals per
+// LLM slot rather than deltas, under a session ID and an increasing
+// sequence, so the orchestrator keeps the latest report of each session and
+// sums the sessions of a task. Retried, duplicated, or reordered reports
+// therefore never lower the accounted usage, and the usage of an agent that
+// crashed is kept when the task is restarted. Failed reports are retried in
+// the background with backoff; LLM calls never wait for them.
+//
+// # Tool Descriptors
+//
+// Tool servers answer DescribeTool with the tool's descriptor and a
+// serialized FileDescriptorSet of its input and output messages, and serve
+// gRPC reflection unless WithReflection(false) is set. Agents fetch the
+// descriptors of a tool through the orchestrator with
+// CallbackHarness.GetToolProtoDescriptors and build CallToolProto requests
+// from them without linking the tool's generated code.
+//
+// # Load Shedding
+//
+// The callback client tracks how often the daemon answers ResourceExhausted
+// or Unavailable, separately for LLM, tool, memory, and GraphRAG calls. When
+// a class is overloaded, the client rejects some of its calls locally with
+// ErrLoadShed instead of adding to the overload: low-priority calls (listings,
+// statistics, telemetry) first, normal calls at twice the error rate. Finding
+// submission and memory writes are high priority and never shed. Override
+// the priority of a call with WithCallPriority, and tune the throttle with
+// WithCallbackLoadShedding. CallbackHarness.LoadShedStats reports the state
+// for debugging; WithCallbackMeterProvider exports it as metrics.
 //
 // # Graceful Shutdown
 //
 // All servers handle SIGINT and SIGTERM signals for graceful shutdown:
 //
 //  1. Signal received
-//  2. Server stops accepting new connections
+//  2. Services are marked NOT_SERVING and the server stops accepting new connections
 //  3. Active requests complete within timeout period
 //  4. Resources are cleaned up
 //  5. Process exits
@@ -75,4 +185,25 @@
 // All servers automatically expose gRPC health checks compatible with
 // the standard gRPC health checking protocol. This allows load balancers
 // and orchestration systems to monitor server health.
+//
+// # Lifecycle Events
+//
+// Server.Events reports state transitions so supervisors need not parse
+// logs: LifecycleStarted with the listen address, LifecycleHealthChanged
+// for each serving status change, LifecycleDraining when graceful shutdown
+// begins, and finally LifecycleStopped with the error, if any, that stopped
+// the server. Sends never block; events that do not fit in the channel are
+// counted by DroppedEvents. Events are also logged, and recorded as span
+// events when WithTracer is set. Agent, Tool, and Plugin deliver them to the
+// channel given with WithLifecycleEvents:
+//
+//	events := make(chan serve.LifecycleEvent, 16)
+//	go func() {
+//	    for ev := range events {
+//	        if ev.Type == serve.LifecycleHealthChanged {
+//	            setHeartbeat(ev.Status == grpc_health_v1.HealthCheckResponse_SERVING)
+//	        }
+//	    }
+//	}()
+//	err := serve.Agent(myAgent, serve.WithLifecycleEvents(events))
 package serve
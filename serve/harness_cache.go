@@ -0,0 +1,225 @@
+package serve
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/llm"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HarnessOptions configures the callback harness created for each task.
+type HarnessOptions struct {
+	// ToolCacheTTL is how long the tool, plugin, and agent lists returned by
+	// ListTools, ListPlugins, and ListAgents are served before they are
+	// refreshed. Once a list is older than the TTL, the next call still
+	// returns it and a fresh list is fetched in the background. Zero caches
+	// the lists for the whole task execution.
+	//
+	// Regardless of the TTL, a list is refreshed immediately when a call to
+	// a tool, plugin, or agent fails with a not-found error.
+	ToolCacheTTL time.Duration
+
+	// MeterProvider enables the harness.cache.refreshes counter, which
+	// counts list refreshes by cache ("tools", "plugins", "agents") and
+	// reason ("not_found", "expired").
+	MeterProvider metric.MeterProvider
+
+	// QueryCache enables caching of GraphRAG query results within a
+	// mission. Nil disables it. See WithQueryCache.
+	QueryCache *QueryCacheOptions
+
+	// TokenReport enables periodic reports of the task's token usage to
+	// the orchestrator. Nil disables them. See WithTokenReport.
+	TokenReport *TokenReportOptions
+
+	// ResponseFilters run, in order, on every completion response of
+	// Complete and CompleteWithTools before the filters of the request.
+	// See WithResponseFilter.
+	ResponseFilters []llm.ResponseFilter
+
+	// SystemPreamble is injected into the messages of every completion,
+	// stream, and structured completion. Its tokens are tracked under
+	// llm.PreambleTokenSlot. See WithSystemPreamble.
+	SystemPreamble llm.PreambleConfig
+}
+
+// Reasons a list cache is refreshed.
+const (
+	cacheRefreshNotFound = "not_found"
+	cacheRefreshExpired  = "expired"
+)
+
+// backgroundRefreshTimeout bounds a background list refresh, which runs
+// detached from any caller's context.
+const backgroundRefreshTimeout = 10 * time.Second
+
+// listCache caches a list fetched from the orchestrator.
+type listCache[T any] struct {
+	kind      string
+	ttl       time.Duration
+	fetch     func(ctx context.Context) ([]T, error)
+	logger    *slog.Logger
+	onRefresh func(kind, reason string)
+
+	mu         sync.Mutex
+	items      []T
+	loaded     bool
+	fetchedAt  time.Time
+	refreshing bool
+
+	// now is replaceable for tests
+	now func() time.Time
+}
+
+// get returns the cached list, fetching it on first use. A list older than
+// the TTL is returned as is and refreshed in the background.
+func (c *listCache[T]) get(ctx context.Context) ([]T, error) {
+	c.mu.Lock()
+	if !c.loaded {
+		c.mu.Unlock()
+		items, err := c.fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.store(items)
+		return items, nil
+	}
+
+	items := c.items
+	if c.ttl > 0 && !c.refreshing && c.now().Sub(c.fetchedAt) >= c.ttl {
+		c.refreshing = true
+		go c.refreshInBackground()
+	}
+	c.mu.Unlock()
+	return items, nil
+}
+
+// refresh discards the cached list and fetches it again.
+func (c *listCache[T]) refresh(ctx context.Context, reason string) ([]T, error) {
+	items, err := c.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.store(items)
+	c.onRefresh(c.kind, reason)
+	return items, nil
+}
+
+// refreshInBackground refreshes an expired list. On failure the stale list
+// is kept and the next attempt waits another TTL.
+func (c *listCache[T]) refreshInBackground() {
+	ctx, cancel := context.WithTimeout(context.Background(), backgroundRefreshTimeout)
+	defer cancel()
+
+	if _, err := c.refresh(ctx, cacheRefreshExpired); err != nil {
+		c.logger.Warn("background cache refresh failed", "cache", c.kind, "error", err)
+		c.mu.Lock()
+		c.fetchedAt = c.now()
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	c.refreshing = false
+	c.mu.Unlock()
+}
+
+// store replaces the cached list.
+func (c *listCache[T]) store(items []T) {
+	c.mu.Lock()
+	c.items = items
+	c.loaded = true
+	c.fetchedAt = c.now()
+	c.mu.Unlock()
+}
+
+// refreshAndFind refreshes cache after a not-found error and reports whether
+// an entry with the given name exists in the fresh list.
+func refreshAndFind[T any](ctx context.Context, cache *listCache[T], name string, nameOf func(T) string) bool {
+	items, err := cache.refresh(ctx, cacheRefreshNotFound)
+	if err != nil {
+		cache.logger.Warn("cache refresh after not-found error failed", "cache", cache.kind, "error", err)
+		return false
+	}
+	for _, item := range items {
+		if nameOf(item) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isNotFound reports whether a callback failed because the named tool,
+// plugin, or agent does not exist, either as a gRPC status or as an error in
+// the response.
+func isNotFound(err error, respErr *proto.HarnessError) bool {
+	if err != nil {
+		return status.Code(err) == grpccodes.NotFound
+	}
+	if respErr == nil {
+		return false
+	}
+	switch respErr.Code {
+	case proto.ErrorCode_ERROR_CODE_NOT_FOUND, proto.ErrorCode_ERROR_CODE_TOOL_NOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// cacheRefreshCounter counts list cache refreshes.
+type cacheRefreshCounter struct {
+	mu     sync.Mutex
+	counts map[string]int64
+
+	counter metric.Int64Counter
+}
+
+// newCacheRefreshCounter creates a counter, recording to mp when it is set.
+func newCacheRefreshCounter(mp metric.MeterProvider) (*cacheRefreshCounter, error) {
+	c := &cacheRefreshCounter{counts: make(map[string]int64)}
+	if mp == nil {
+		return c, nil
+	}
+	var err error
+	c.counter, err = mp.Meter("github.com/zero-day-ai/sdk/serve").Int64Counter(
+		"harness.cache.refreshes",
+		metric.WithDescription("Number of tool, plugin, and agent list refreshes after the initial fetch"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create cache refresh counter: %w", err)
+	}
+	return c, nil
+}
+
+// record counts one refresh of the named cache.
+func (c *cacheRefreshCounter) record(kind, reason string) {
+	c.mu.Lock()
+	c.counts[kind]++
+	c.mu.Unlock()
+
+	if c.counter != nil {
+		c.counter.Add(context.Background(), 1, metric.WithAttributes(
+			attribute.String("cache", kind),
+			attribute.String("reason", reason),
+		))
+	}
+}
+
+// snapshot returns the number of refreshes per cache.
+func (c *cacheRefreshCounter) snapshot() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := make(map[string]int64, len(c.counts))
+	for kind, n := range c.counts {
+		counts[kind] = n
+	}
+	return counts
+}
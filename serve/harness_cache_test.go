@@ -0,0 +1,214 @@
+package serve
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/api/gen/toolspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lateRegistrationServer is a callback server whose tools and plugins can be
+// registered after the harness has cached its lists. Names in pending are
+// not found by the first call for them, which registers them, as when a
+// registration completes while the call is in flight.
+type lateRegistrationServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	mu          sync.Mutex
+	tools       map[string]bool
+	plugins     map[string]bool
+	pending     map[string]bool
+	toolLists   int
+	pluginLists int
+	toolCalls   int
+}
+
+func newLateRegistrationServer(tools ...string) *lateRegistrationServer {
+	s := &lateRegistrationServer{
+		tools:   make(map[string]bool),
+		plugins: make(map[string]bool),
+		pending: make(map[string]bool),
+	}
+	for _, name := range tools {
+		s.tools[name] = true
+	}
+	return s
+}
+
+func (s *lateRegistrationServer) registerTool(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tools[name] = true
+}
+
+func (s *lateRegistrationServer) unregisterTool(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tools, name)
+}
+
+func (s *lateRegistrationServer) registerPending(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[name] = true
+}
+
+func (s *lateRegistrationServer) ListTools(ctx context.Context, req *proto.ListToolsRequest) (*proto.ListToolsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.toolLists++
+	resp := &proto.ListToolsResponse{}
+	for name := range s.tools {
+		resp.Tools = append(resp.Tools, &proto.HarnessToolDescriptor{Name: name})
+	}
+	return resp, nil
+}
+
+func (s *lateRegistrationServer) CallToolProto(ctx context.Context, req *proto.CallToolProtoRequest) (*proto.CallToolProtoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.toolCalls++
+	if s.pending[req.Name] {
+		delete(s.pending, req.Name)
+		s.tools[req.Name] = true
+		return &proto.CallToolProtoResponse{Error: &proto.HarnessError{
+			Code:    proto.ErrorCode_ERROR_CODE_TOOL_NOT_FOUND,
+			Message: "tool " + req.Name + " not found",
+		}}, nil
+	}
+	if !s.tools[req.Name] {
+		return &proto.CallToolProtoResponse{Error: &proto.HarnessError{
+			Code:    proto.ErrorCode_ERROR_CODE_TOOL_NOT_FOUND,
+			Message: "tool " + req.Name + " not found",
+		}}, nil
+	}
+	return &proto.CallToolProtoResponse{OutputJson: []byte(`{}`)}, nil
+}
+
+func (s *lateRegistrationServer) ListPlugins(ctx context.Context, req *proto.ListPluginsRequest) (*proto.ListPluginsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pluginLists++
+	resp := &proto.ListPluginsResponse{}
+	for name := range s.plugins {
+		resp.Plugins = append(resp.Plugins, &proto.HarnessPluginDescriptor{Name: name})
+	}
+	return resp, nil
+}
+
+func (s *lateRegistrationServer) QueryPlugin(ctx context.Context, req *proto.QueryPluginRequest) (*proto.QueryPluginResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending[req.Name] {
+		delete(s.pending, req.Name)
+		s.plugins[req.Name] = true
+		return nil, status.Errorf(codes.NotFound, "plugin %s not found", req.Name)
+	}
+	if !s.plugins[req.Name] {
+		return nil, status.Errorf(codes.NotFound, "plugin %s not found", req.Name)
+	}
+	return &proto.QueryPluginResponse{Result: ToTypedValue("ok")}, nil
+}
+
+func (s *lateRegistrationServer) counts() (toolLists, toolCalls int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.toolLists, s.toolCalls
+}
+
+func toolNames(t *testing.T, h *CallbackHarness) []string {
+	t.Helper()
+	tools, err := h.ListTools(context.Background())
+	require.NoError(t, err)
+	var names []string
+	for _, d := range tools {
+		names = append(names, d.Name)
+	}
+	return names
+}
+
+// TestCallbackHarness_LateToolRegistration tests that a tool registered after
+// the tool list was cached can be called without restarting the agent.
+func TestCallbackHarness_LateToolRegistration(t *testing.T) {
+	fake := newLateRegistrationServer("nmap")
+	harness := newFakeCallbackHarness(t, fake)
+	ctx := context.Background()
+
+	assert.Equal(t, []string{"nmap"}, toolNames(t, harness))
+
+	fake.registerPending("httpx")
+
+	err := harness.CallToolProto(ctx, "httpx", &toolspb.HttpxRequest{Targets: []string{"example.com"}}, &toolspb.HttpxResponse{})
+	require.NoError(t, err)
+
+	toolLists, toolCalls := fake.counts()
+	assert.Equal(t, 2, toolLists, "tool list should be re-fetched once")
+	assert.Equal(t, 2, toolCalls, "tool call should be retried once")
+	assert.Equal(t, map[string]int64{"tools": 1}, harness.CacheRefreshes())
+	assert.ElementsMatch(t, []string{"nmap", "httpx"}, toolNames(t, harness))
+}
+
+// TestCallbackHarness_UnregisteredToolNotRetried tests that a tool that no
+// longer exists fails without a retry and is dropped from the cached list.
+func TestCallbackHarness_UnregisteredToolNotRetried(t *testing.T) {
+	fake := newLateRegistrationServer("nmap", "httpx")
+	harness := newFakeCallbackHarness(t, fake)
+
+	assert.ElementsMatch(t, []string{"nmap", "httpx"}, toolNames(t, harness))
+	fake.unregisterTool("httpx")
+
+	err := harness.CallToolProto(context.Background(), "httpx", &toolspb.HttpxRequest{}, &toolspb.HttpxResponse{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tool httpx not found")
+
+	toolLists, toolCalls := fake.counts()
+	assert.Equal(t, 2, toolLists)
+	assert.Equal(t, 1, toolCalls)
+	assert.Equal(t, []string{"nmap"}, toolNames(t, harness))
+}
+
+// TestCallbackHarness_LatePluginRegistration tests that a gRPC NotFound from
+// QueryPlugin refreshes the plugin list and retries the query.
+func TestCallbackHarness_LatePluginRegistration(t *testing.T) {
+	fake := newLateRegistrationServer()
+	harness := newFakeCallbackHarness(t, fake)
+	ctx := context.Background()
+
+	plugins, err := harness.ListPlugins(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+
+	fake.registerPending("shodan")
+
+	result, err := harness.QueryPlugin(ctx, "shodan", "search", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", result)
+	assert.Equal(t, map[string]int64{"plugins": 1}, harness.CacheRefreshes())
+}
+
+// TestCallbackHarness_ToolCacheTTL tests that an expired tool list is served
+// while it is refreshed in the background.
+func TestCallbackHarness_ToolCacheTTL(t *testing.T) {
+	fake := newLateRegistrationServer("nmap")
+	harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{ToolCacheTTL: time.Hour})
+
+	assert.Equal(t, []string{"nmap"}, toolNames(t, harness))
+	fake.registerTool("httpx")
+	assert.Equal(t, []string{"nmap"}, toolNames(t, harness), "list is fresh within the TTL")
+
+	// Expire the cached list
+	harness.toolsCache.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+
+	assert.Equal(t, []string{"nmap"}, toolNames(t, harness), "stale list is served during the refresh")
+	assert.Eventually(t, func() bool {
+		return len(toolNames(t, harness)) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(1), harness.CacheRefreshes()["tools"])
+}
@@ -0,0 +1,150 @@
+package serve
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// lifecycleEventBuffer is the capacity of the server's own event channel.
+const lifecycleEventBuffer = 64
+
+// LifecycleEventType identifies a server state transition.
+type LifecycleEventType string
+
+// Lifecycle event types, in the order a server emits them.
+const (
+	// LifecycleStarted is emitted once the server accepts connections.
+	// Addr is set.
+	LifecycleStarted LifecycleEventType = "started"
+
+	// LifecycleHealthChanged is emitted when the server changes a serving
+	// status: SERVING after it starts, NOT_SERVING when it drains, and on
+	// every Server.SetServingStatus call. Service and Status are set.
+	LifecycleHealthChanged LifecycleEventType = "health_changed"
+
+	// LifecycleDraining is emitted when graceful shutdown begins. The
+	// server stops accepting connections and waits for active calls.
+	LifecycleDraining LifecycleEventType = "draining"
+
+	// LifecycleStopped is the last event, emitted when Serve returns. Err
+	// is the error that stopped the server, or nil after a graceful
+	// shutdown.
+	LifecycleStopped LifecycleEventType = "stopped"
+)
+
+// LifecycleEvent is a server state transition.
+type LifecycleEvent struct {
+	Type LifecycleEventType
+	Time time.Time
+
+	// Addr is the TCP listen address (LifecycleStarted).
+	Addr string
+
+	// Service is the gRPC service whose status changed, empty for the
+	// server as a whole (LifecycleHealthChanged).
+	Service string
+
+	// Status is the new serving status (LifecycleHealthChanged).
+	Status grpc_health_v1.HealthCheckResponse_ServingStatus
+
+	// Err is the error that stopped the server (LifecycleStopped).
+	Err error
+}
+
+// Events returns the channel on which the server reports lifecycle events.
+// Sends never block: when the channel is full the event is dropped and
+// counted in DroppedEvents. LifecycleStopped is the last event; the channel
+// is not closed.
+//
+// Example:
+//
+//	go func() {
+//	    for ev := range srv.Events() {
+//	        if ev.Type == serve.LifecycleStarted {
+//	            close(ready)
+//	        }
+//	    }
+//	}()
+func (s *Server) Events() <-chan LifecycleEvent {
+	return s.events
+}
+
+// DroppedEvents returns the number of lifecycle events dropped because the
+// events channel was full.
+func (s *Server) DroppedEvents() uint64 {
+	return s.droppedEvents.Load()
+}
+
+// SetServingStatus sets the health status of service, or of the server as
+// a whole if service is empty, and emits LifecycleHealthChanged.
+func (s *Server) SetServingStatus(service string, status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	s.healthServer.SetServingStatus(service, status)
+	s.emit(LifecycleEvent{Type: LifecycleHealthChanged, Service: service, Status: status})
+}
+
+// startLifecycleSpan starts the span that lifecycle events are recorded on
+// while the server runs, if a tracer is configured.
+func (s *Server) startLifecycleSpan(ctx context.Context) {
+	if s.config.Tracer == nil {
+		return
+	}
+	_, span := s.config.Tracer.Start(context.WithoutCancel(ctx), "gibson.serve",
+		trace.WithSpanKind(trace.SpanKindServer))
+	s.lifecycleMu.Lock()
+	s.lifecycleSpan = span
+	s.lifecycleMu.Unlock()
+}
+
+// emit reports a lifecycle event on the events channel, the default
+// logger, and the lifecycle span. It ends the span on LifecycleStopped.
+func (s *Server) emit(ev LifecycleEvent) {
+	ev.Time = time.Now()
+
+	select {
+	case s.events <- ev:
+	default:
+		s.droppedEvents.Add(1)
+	}
+
+	attrs := []slog.Attr{slog.String("event", string(ev.Type))}
+	var spanAttrs []attribute.KeyValue
+	switch ev.Type {
+	case LifecycleStarted:
+		attrs = append(attrs, slog.String("addr", ev.Addr))
+		spanAttrs = append(spanAttrs, attribute.String("gibson.serve.addr", ev.Addr))
+	case LifecycleHealthChanged:
+		attrs = append(attrs, slog.String("service", ev.Service), slog.String("status", ev.Status.String()))
+		spanAttrs = append(spanAttrs,
+			attribute.String("gibson.serve.service", ev.Service),
+			attribute.String("gibson.serve.status", ev.Status.String()))
+	case LifecycleStopped:
+		if ev.Err != nil {
+			attrs = append(attrs, slog.String("error", ev.Err.Error()))
+		}
+	}
+	slog.LogAttrs(context.Background(), slog.LevelInfo, "server lifecycle", attrs...)
+
+	s.lifecycleMu.Lock()
+	span := s.lifecycleSpan
+	if ev.Type == LifecycleStopped {
+		s.lifecycleSpan = nil
+	}
+	s.lifecycleMu.Unlock()
+	if span == nil {
+		return
+	}
+	span.AddEvent(string(ev.Type), trace.WithAttributes(spanAttrs...))
+	if ev.Type == LifecycleStopped {
+		if ev.Err != nil {
+			span.RecordError(ev.Err)
+			span.SetStatus(codes.Error, ev.Err.Error())
+		}
+		span.End()
+	}
+}
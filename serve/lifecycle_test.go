@@ -0,0 +1,116 @@
+package serve
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// nextEvent returns the next lifecycle event, failing the test if none
+// arrives in time.
+func nextEvent(t *testing.T, events <-chan LifecycleEvent) LifecycleEvent {
+	t.Helper()
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for lifecycle event")
+		return LifecycleEvent{}
+	}
+}
+
+func TestServerLifecycleEvents(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	cfg := DefaultConfig()
+	cfg.Port = 0
+	cfg.GracefulTimeout = time.Second
+	cfg.Tracer = tp.Tracer("test")
+	srv, err := NewServer(cfg)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ctx)
+	}()
+
+	started := nextEvent(t, srv.Events())
+	assert.Equal(t, LifecycleStarted, started.Type)
+	assert.Equal(t, srv.listener.Addr().String(), started.Addr)
+	assert.False(t, started.Time.IsZero())
+
+	serving := nextEvent(t, srv.Events())
+	assert.Equal(t, LifecycleHealthChanged, serving.Type)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, serving.Status)
+
+	srv.SetServingStatus("gibson.Custom", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	custom := nextEvent(t, srv.Events())
+	assert.Equal(t, LifecycleHealthChanged, custom.Type)
+	assert.Equal(t, "gibson.Custom", custom.Service)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, custom.Status)
+
+	cancel()
+	assert.Equal(t, LifecycleDraining, nextEvent(t, srv.Events()).Type)
+
+	notServing := nextEvent(t, srv.Events())
+	assert.Equal(t, LifecycleHealthChanged, notServing.Type)
+	assert.Empty(t, notServing.Service)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, notServing.Status)
+
+	stopped := nextEvent(t, srv.Events())
+	assert.Equal(t, LifecycleStopped, stopped.Type)
+	assert.NoError(t, stopped.Err, "graceful shutdown is not an error")
+
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+	assert.Zero(t, srv.DroppedEvents())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	var names []string
+	for _, ev := range spans[0].Events() {
+		names = append(names, ev.Name)
+	}
+	assert.Equal(t, []string{"started", "health_changed", "health_changed", "draining", "health_changed", "stopped"}, names)
+}
+
+func TestServerLifecycleEvents_Dropped(t *testing.T) {
+	events := make(chan LifecycleEvent, 1)
+
+	cfg := DefaultConfig()
+	cfg.Port = 0
+	cfg.GracefulTimeout = time.Second
+	WithLifecycleEvents(events)(cfg)
+	srv, err := NewServer(cfg)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ctx)
+	}()
+
+	require.Eventually(t, func() bool { return srv.DroppedEvents() >= 1 }, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-errCh
+
+	// Only the first event fit; the others were dropped without blocking
+	assert.Equal(t, uint64(4), srv.DroppedEvents())
+	require.Len(t, events, 1)
+	assert.Equal(t, LifecycleStarted, (<-events).Type)
+}
+
+func TestWithTracer(t *testing.T) {
+	tracer := sdktrace.NewTracerProvider().Tracer("test")
+
+	cfg := DefaultConfig()
+	WithTracer(tracer)(cfg)
+	assert.Equal(t, tracer, cfg.Tracer)
+}
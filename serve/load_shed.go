@@ -0,0 +1,461 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLoadShed is returned by callback calls that the client rejected locally
+// because the daemon is overloaded. The call was never sent.
+var ErrLoadShed = errors.New("call shed locally: daemon is overloaded")
+
+// CallPriority ranks callback calls for load shedding. When the daemon is
+// saturated, low-priority calls are shed first; high-priority calls are never
+// shed.
+type CallPriority int
+
+const (
+	// PriorityLow marks calls that can be dropped without losing results,
+	// such as listings, statistics, and telemetry.
+	PriorityLow CallPriority = iota - 1
+
+	// PriorityNormal is the default priority.
+	PriorityNormal
+
+	// PriorityHigh marks calls whose loss loses results, such as finding
+	// submission and memory writes. They are never shed.
+	PriorityHigh
+)
+
+// String returns the priority name.
+func (p CallPriority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("priority(%d)", int(p))
+	}
+}
+
+// defaultCallPriorities holds the priority of callback methods that are not
+// PriorityNormal.
+var defaultCallPriorities = map[string]CallPriority{
+	"SubmitFinding":        PriorityHigh,
+	"MemorySet":            PriorityHigh,
+	"MemoryDelete":         PriorityHigh,
+	"LongTermMemoryStore":  PriorityHigh,
+	"LongTermMemoryDelete": PriorityHigh,
+
+	"ListTools":       PriorityLow,
+	"ListPlugins":     PriorityLow,
+	"ListAgents":      PriorityLow,
+	"GraphRAGStats":   PriorityLow,
+	"GraphRAGExplain": PriorityLow,
+	"GraphRAGHealth":  PriorityLow,
+	"ReportStepHints": PriorityLow,
+	"RecordSpan":      PriorityLow,
+	"RecordSpans":     PriorityLow,
+}
+
+// callPriorityKey is the context key for per-call priority overrides.
+type callPriorityKey struct{}
+
+// WithCallPriority returns a copy of ctx carrying p as the priority of the
+// callback calls made with it, overriding the method's default priority.
+//
+// Example:
+//
+//	// This tool call must go through even if the daemon is overloaded
+//	err := harness.CallToolProto(serve.WithCallPriority(ctx, serve.PriorityHigh), "nmap", req, resp)
+func WithCallPriority(ctx context.Context, p CallPriority) context.Context {
+	return context.WithValue(ctx, callPriorityKey{}, p)
+}
+
+// callPriorityFromContext returns the priority override stored in ctx by
+// WithCallPriority, if any.
+func callPriorityFromContext(ctx context.Context) (CallPriority, bool) {
+	p, ok := ctx.Value(callPriorityKey{}).(CallPriority)
+	return p, ok
+}
+
+// Method classes that overload is tracked for. Each class is throttled
+// independently, so an overloaded LLM backend does not shed memory calls.
+const (
+	callClassLLM      = "llm"
+	callClassTool     = "tool"
+	callClassMemory   = "memory"
+	callClassGraphRAG = "graphrag"
+	callClassOther    = "other"
+)
+
+// callClass returns the class of a callback method.
+func callClass(method string) string {
+	switch {
+	case strings.HasPrefix(method, "LLM"):
+		return callClassLLM
+	case method == "CallToolProto", method == "CallToolProtoStream", method == "ListTools",
+		method == "QueueToolWork", method == "ToolResults":
+		return callClassTool
+	case strings.Contains(method, "Memory"):
+		return callClassMemory
+	case strings.Contains(method, "Graph"), strings.HasPrefix(method, "FindSimilar"),
+		strings.HasSuffix(method, "Node"),
+		method == "GetAttackChains", method == "GetRelatedFindings",
+		method == "QueryNodes", method == "GenerateNodeID", method == "ValidateRelationship":
+		return callClassGraphRAG
+	default:
+		return callClassOther
+	}
+}
+
+// LoadShedConfig configures how CallbackClient sheds calls when the daemon is
+// overloaded. Zero fields use the defaults.
+type LoadShedConfig struct {
+	// Window is how far back overload errors are counted. Shedding stops at
+	// most one Window after the daemon recovers. Default: 10s.
+	Window time.Duration
+
+	// MinRequests is the number of calls a class needs within the window
+	// before it can be throttled. Default: 10.
+	MinRequests int
+
+	// Threshold is the overload error rate (ResourceExhausted and Unavailable
+	// responses per call) above which low-priority calls are shed. Normal
+	// calls are shed above twice the threshold. Default: 0.2.
+	Threshold float64
+
+	// Priorities overrides the default priority of callback methods, keyed
+	// by method name (e.g. "GraphRAGQuery").
+	Priorities map[string]CallPriority
+}
+
+const (
+	defaultShedWindow      = 10 * time.Second
+	defaultShedMinRequests = 10
+	defaultShedThreshold   = 0.2
+
+	// shedBuckets is the number of buckets the window is divided into.
+	shedBuckets = 10
+
+	// maxShedProbability keeps some calls flowing while shedding, so that
+	// recovery is noticed before the window expires.
+	maxShedProbability = 0.9
+)
+
+// withDefaults returns cfg with zero fields set to their defaults.
+func (cfg LoadShedConfig) withDefaults() LoadShedConfig {
+	if cfg.Window <= 0 {
+		cfg.Window = defaultShedWindow
+	}
+	if cfg.MinRequests <= 0 {
+		cfg.MinRequests = defaultShedMinRequests
+	}
+	if cfg.Threshold <= 0 {
+		cfg.Threshold = defaultShedThreshold
+	}
+	return cfg
+}
+
+// WithCallbackLoadShedding configures client-side load shedding.
+//
+// Example:
+//
+//	client, err := serve.NewCallbackClient(endpoint, serve.WithCallbackLoadShedding(serve.LoadShedConfig{
+//	    Threshold:  0.3,
+//	    Priorities: map[string]serve.CallPriority{"GraphRAGQuery": serve.PriorityLow},
+//	}))
+func WithCallbackLoadShedding(cfg LoadShedConfig) CallbackClientOption {
+	return func(c *CallbackClient) {
+		c.throttle = newLoadShedder(cfg)
+	}
+}
+
+// WithCallbackMeterProvider enables load shedding metrics:
+// callback.load_shed.shed counts shed calls by class, method, and priority,
+// and callback.load_shed.error_rate reports the overload error rate of each
+// class.
+func WithCallbackMeterProvider(mp metric.MeterProvider) CallbackClientOption {
+	return func(c *CallbackClient) {
+		c.meterProvider = mp
+	}
+}
+
+// LoadShedClassStats is the throttle state of one method class.
+type LoadShedClassStats struct {
+	// Requests is the number of calls sent within the window.
+	Requests int `json:"requests"`
+
+	// Overloaded is the number of those calls that failed with
+	// ResourceExhausted or Unavailable.
+	Overloaded int `json:"overloaded"`
+
+	// ErrorRate is Overloaded / Requests, or 0 below MinRequests.
+	ErrorRate float64 `json:"error_rate"`
+
+	// ShedProbability is the current probability that a low-priority call
+	// is shed.
+	ShedProbability float64 `json:"shed_probability"`
+
+	// Shed is the total number of calls shed since the client was created.
+	Shed int64 `json:"shed"`
+}
+
+// shedBucket counts calls within one slice of the window.
+type shedBucket struct {
+	epoch      int64
+	requests   int
+	overloaded int
+}
+
+// classState tracks calls of one method class.
+type classState struct {
+	buckets [shedBuckets]shedBucket
+	shed    int64
+}
+
+// loadShedder is an adaptive client-side throttle. It tracks the overload
+// error rate of each method class over a sliding window and sheds calls
+// with a probability that grows with the error rate beyond the threshold.
+type loadShedder struct {
+	cfg         LoadShedConfig
+	bucketWidth time.Duration
+
+	mu      sync.Mutex
+	classes map[string]*classState
+
+	// now and random are replaceable for tests
+	now    func() time.Time
+	random func() float64
+
+	shedCounter metric.Int64Counter
+}
+
+// newLoadShedder creates a throttle with cfg.
+func newLoadShedder(cfg LoadShedConfig) *loadShedder {
+	cfg = cfg.withDefaults()
+	return &loadShedder{
+		cfg:         cfg,
+		bucketWidth: cfg.Window / shedBuckets,
+		classes:     make(map[string]*classState),
+		now:         time.Now,
+		random:      rand.Float64,
+	}
+}
+
+// initMetrics creates the load shedding instruments on meter.
+func (s *loadShedder) initMetrics(meter metric.Meter) error {
+	var err error
+	s.shedCounter, err = meter.Int64Counter(
+		"callback.load_shed.shed",
+		metric.WithDescription("Number of callback calls shed locally because the daemon is overloaded"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return fmt.Errorf("create shed counter: %w", err)
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"callback.load_shed.error_rate",
+		metric.WithDescription("Rate of overload errors from the daemon per method class"),
+		metric.WithUnit("1"),
+		metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			for class, stats := range s.stats() {
+				o.Observe(stats.ErrorRate, metric.WithAttributes(attribute.String("class", class)))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("create error rate gauge: %w", err)
+	}
+	return nil
+}
+
+// priority returns the priority of a call to method made with ctx.
+func (s *loadShedder) priority(ctx context.Context, method string) CallPriority {
+	if p, ok := callPriorityFromContext(ctx); ok {
+		return p
+	}
+	if p, ok := s.cfg.Priorities[method]; ok {
+		return p
+	}
+	if p, ok := defaultCallPriorities[method]; ok {
+		return p
+	}
+	return PriorityNormal
+}
+
+// allow decides whether a call to method may be sent. Calls it rejects are
+// counted as shed.
+func (s *loadShedder) allow(ctx context.Context, method string) bool {
+	priority := s.priority(ctx, method)
+	if priority >= PriorityHigh {
+		return true
+	}
+
+	class := callClass(method)
+
+	s.mu.Lock()
+	state := s.class(class)
+	requests, overloaded := s.window(state)
+	p := s.shedProbability(requests, overloaded, priority)
+	shed := p > 0 && s.random() < p
+	if shed {
+		state.shed++
+	}
+	s.mu.Unlock()
+
+	if shed && s.shedCounter != nil {
+		s.shedCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("class", class),
+			attribute.String("method", method),
+			attribute.String("priority", priority.String()),
+		))
+	}
+	return !shed
+}
+
+// record counts the outcome of a sent call to method.
+func (s *loadShedder) record(method string, err error) {
+	code := status.Code(err)
+	overloaded := code == codes.ResourceExhausted || code == codes.Unavailable
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket := s.bucket(s.class(callClass(method)))
+	bucket.requests++
+	if overloaded {
+		bucket.overloaded++
+	}
+}
+
+// shedProbability returns the probability of shedding a call of priority
+// given the calls counted in the window. Low-priority calls are shed above
+// the threshold, normal calls above twice the threshold; the probability
+// grows linearly to maxShedProbability at an error rate of 1.
+func (s *loadShedder) shedProbability(requests, overloaded int, priority CallPriority) float64 {
+	if requests < s.cfg.MinRequests || priority >= PriorityHigh {
+		return 0
+	}
+
+	threshold := s.cfg.Threshold
+	if priority == PriorityNormal {
+		threshold *= 2
+	}
+	if threshold >= 1 {
+		return 0
+	}
+
+	rate := float64(overloaded) / float64(requests)
+	if rate <= threshold {
+		return 0
+	}
+	return min((rate-threshold)/(1-threshold), maxShedProbability)
+}
+
+// stats returns a snapshot of the state of every class seen so far.
+func (s *loadShedder) stats() map[string]LoadShedClassStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := make(map[string]LoadShedClassStats, len(s.classes))
+	for class, state := range s.classes {
+		requests, overloaded := s.window(state)
+		classStats := LoadShedClassStats{
+			Requests:        requests,
+			Overloaded:      overloaded,
+			ShedProbability: s.shedProbability(requests, overloaded, PriorityLow),
+			Shed:            state.shed,
+		}
+		if requests >= s.cfg.MinRequests {
+			classStats.ErrorRate = float64(overloaded) / float64(requests)
+		}
+		stats[class] = classStats
+	}
+	return stats
+}
+
+// class returns the state of class, creating it if needed. s.mu must be held.
+func (s *loadShedder) class(class string) *classState {
+	state, ok := s.classes[class]
+	if !ok {
+		state = &classState{}
+		s.classes[class] = state
+	}
+	return state
+}
+
+// epoch returns the index of the bucket-width slice that now falls in.
+func (s *loadShedder) epoch() int64 {
+	return s.now().UnixNano() / int64(s.bucketWidth)
+}
+
+// bucket returns the current bucket of state, resetting it if it holds an
+// expired slice. s.mu must be held.
+func (s *loadShedder) bucket(state *classState) *shedBucket {
+	epoch := s.epoch()
+	bucket := &state.buckets[epoch%shedBuckets]
+	if bucket.epoch != epoch {
+		*bucket = shedBucket{epoch: epoch}
+	}
+	return bucket
+}
+
+// window sums the calls of state within the window. s.mu must be held.
+func (s *loadShedder) window(state *classState) (requests, overloaded int) {
+	epoch := s.epoch()
+	for _, bucket := range state.buckets {
+		if epoch-bucket.epoch < shedBuckets {
+			requests += bucket.requests
+			overloaded += bucket.overloaded
+		}
+	}
+	return requests, overloaded
+}
+
+// unaryInterceptor sheds unary calls and records their outcome.
+func (s *loadShedder) unaryInterceptor(ctx context.Context, fullMethod string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	method := path.Base(fullMethod)
+	if !s.allow(ctx, method) {
+		return fmt.Errorf("%s: %w", method, ErrLoadShed)
+	}
+	err := invoker(ctx, fullMethod, req, reply, cc, opts...)
+	s.record(method, err)
+	return err
+}
+
+// streamInterceptor sheds streaming calls and records whether they could be
+// opened.
+func (s *loadShedder) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, fullMethod string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	method := path.Base(fullMethod)
+	if !s.allow(ctx, method) {
+		return nil, fmt.Errorf("%s: %w", method, ErrLoadShed)
+	}
+	stream, err := streamer(ctx, desc, cc, fullMethod, opts...)
+	s.record(method, err)
+	return stream, err
+}
+
+// LoadShedStats returns the load shedding state of each method class the
+// client has called, keyed by class ("llm", "tool", "memory", "graphrag", or
+// "other"). It is meant for debug endpoints and logging.
+func (c *CallbackClient) LoadShedStats() map[string]LoadShedClassStats {
+	return c.throttle.stats()
+}
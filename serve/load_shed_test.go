@@ -0,0 +1,266 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"go.opentelemetry.io/otel/metric/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakyServer fails a fixed share of LLM calls with ResourceExhausted while
+// overloaded, and always accepts findings.
+type flakyServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	overloaded atomic.Bool
+	calls      atomic.Int64
+
+	mu       sync.Mutex
+	findings int
+}
+
+func (s *flakyServer) LLMComplete(ctx context.Context, req *proto.LLMCompleteRequest) (*proto.LLMCompleteResponse, error) {
+	n := s.calls.Add(1)
+	// Fail 3 of every 5 calls while overloaded
+	if s.overloaded.Load() && n%5 < 3 {
+		return nil, status.Error(codes.ResourceExhausted, "daemon saturated")
+	}
+	return &proto.LLMCompleteResponse{Content: "ok"}, nil
+}
+
+func (s *flakyServer) SubmitFinding(ctx context.Context, req *proto.SubmitFindingRequest) (*proto.SubmitFindingResponse, error) {
+	s.mu.Lock()
+	s.findings++
+	s.mu.Unlock()
+	return nil, status.Error(codes.ResourceExhausted, "daemon saturated")
+}
+
+// newFlakyClient starts a flakyServer and returns a client connected to it
+// with a deterministic throttle.
+func newFlakyClient(t *testing.T, cfg LoadShedConfig) (*CallbackClient, *flakyServer) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	fake := &flakyServer{}
+	server := grpc.NewServer()
+	proto.RegisterHarnessCallbackServiceServer(server, fake)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := NewCallbackClient(lis.Addr().String(), WithCallbackLoadShedding(cfg))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	client.throttle.random = rand.New(rand.NewPCG(1, 2)).Float64
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+	return client, fake
+}
+
+// complete makes an LLM call with priority and reports whether it was shed.
+func complete(t *testing.T, client *CallbackClient, priority CallPriority) bool {
+	t.Helper()
+	ctx := WithCallPriority(context.Background(), priority)
+	_, err := client.LLMComplete(ctx, &proto.LLMCompleteRequest{Slot: "primary"})
+	return errors.Is(err, ErrLoadShed)
+}
+
+// TestLoadShed_ConvergesUnderOverload checks that with 60% of calls failing
+// and a 0.2 threshold, the shed rate of low-priority calls settles at
+// (0.6-0.2)/(1-0.2) = 0.5.
+func TestLoadShed_ConvergesUnderOverload(t *testing.T) {
+	client, fake := newFlakyClient(t, LoadShedConfig{
+		Window:      time.Minute,
+		MinRequests: 20,
+		Threshold:   0.2,
+	})
+	fake.overloaded.Store(true)
+
+	// Warm up the window, then measure
+	for i := 0; i < 200; i++ {
+		complete(t, client, PriorityLow)
+	}
+	shed := 0
+	const measured = 1000
+	for i := 0; i < measured; i++ {
+		if complete(t, client, PriorityLow) {
+			shed++
+		}
+	}
+
+	assert.InDelta(t, 0.5, float64(shed)/measured, 0.05)
+
+	stats := client.LoadShedStats()[callClassLLM]
+	assert.InDelta(t, 0.6, stats.ErrorRate, 0.01)
+	assert.InDelta(t, 0.5, stats.ShedProbability, 0.02)
+	assert.Positive(t, stats.Shed)
+}
+
+// TestLoadShed_PriorityOrdering checks that normal calls are shed less than
+// low ones and high-priority calls are never shed.
+func TestLoadShed_PriorityOrdering(t *testing.T) {
+	client, fake := newFlakyClient(t, LoadShedConfig{
+		Window:      time.Minute,
+		MinRequests: 20,
+		Threshold:   0.2,
+	})
+	fake.overloaded.Store(true)
+
+	for i := 0; i < 200; i++ {
+		complete(t, client, PriorityLow)
+	}
+
+	lowShed, normalShed, highShed := 0, 0, 0
+	for i := 0; i < 500; i++ {
+		if complete(t, client, PriorityLow) {
+			lowShed++
+		}
+		if complete(t, client, PriorityNormal) {
+			normalShed++
+		}
+		if complete(t, client, PriorityHigh) {
+			highShed++
+		}
+	}
+
+	assert.Positive(t, normalShed, "normal calls are shed above twice the threshold")
+	assert.Less(t, normalShed, lowShed)
+	assert.Zero(t, highShed)
+
+	// SubmitFinding is high priority by default, even while it is overloaded
+	for i := 0; i < 50; i++ {
+		_, err := client.SubmitFinding(context.Background(), &proto.SubmitFindingRequest{})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrLoadShed)
+	}
+	assert.Equal(t, 50, fake.findings)
+}
+
+// TestLoadShed_RecoversWhenErrorsStop checks that shedding stops promptly
+// once the daemon stops returning overload errors.
+func TestLoadShed_RecoversWhenErrorsStop(t *testing.T) {
+	window := 500 * time.Millisecond
+	client, fake := newFlakyClient(t, LoadShedConfig{
+		Window:      window,
+		MinRequests: 20,
+		Threshold:   0.2,
+	})
+	fake.overloaded.Store(true)
+
+	for i := 0; i < 200; i++ {
+		complete(t, client, PriorityLow)
+	}
+	require.Positive(t, client.LoadShedStats()[callClassLLM].ShedProbability)
+
+	fake.overloaded.Store(false)
+	recovered := time.Now()
+
+	// Calls that still get through report success, so shedding fades out
+	// before the overload errors leave the window
+	require.Eventually(t, func() bool {
+		complete(t, client, PriorityLow)
+		return client.LoadShedStats()[callClassLLM].ShedProbability == 0
+	}, 2*window, time.Millisecond)
+	assert.Less(t, time.Since(recovered), window+window/shedBuckets)
+
+	for i := 0; i < 100; i++ {
+		assert.False(t, complete(t, client, PriorityLow), "call %d shed after recovery", i)
+	}
+}
+
+// TestLoadShed_ClassesAreIndependent checks that overload of one method
+// class does not shed calls of another.
+func TestLoadShed_ClassesAreIndependent(t *testing.T) {
+	s := newLoadShedder(LoadShedConfig{MinRequests: 10, Threshold: 0.2})
+	s.random = func() float64 { return 0 }
+
+	overloaded := status.Error(codes.ResourceExhausted, "saturated")
+	for i := 0; i < 20; i++ {
+		s.record("LLMComplete", overloaded)
+		s.record("MemoryGet", nil)
+	}
+
+	ctx := context.Background()
+	assert.False(t, s.allow(ctx, "LLMComplete"))
+	assert.True(t, s.allow(ctx, "MemoryGet"))
+	assert.True(t, s.allow(ctx, "MemorySet"), "memory writes are high priority")
+
+	stats := s.stats()
+	assert.Equal(t, 20, stats[callClassLLM].Overloaded)
+	assert.Equal(t, int64(1), stats[callClassLLM].Shed)
+	assert.Zero(t, stats[callClassMemory].ErrorRate)
+}
+
+func TestLoadShed_BelowMinRequests(t *testing.T) {
+	s := newLoadShedder(LoadShedConfig{MinRequests: 10})
+	s.random = func() float64 { return 0 }
+
+	for i := 0; i < 9; i++ {
+		s.record("LLMComplete", status.Error(codes.Unavailable, "down"))
+	}
+	assert.True(t, s.allow(context.Background(), "LLMComplete"))
+	assert.Zero(t, s.stats()[callClassLLM].ErrorRate)
+}
+
+func TestLoadShed_Priority(t *testing.T) {
+	s := newLoadShedder(LoadShedConfig{
+		Priorities: map[string]CallPriority{"GraphRAGQuery": PriorityLow},
+	})
+	ctx := context.Background()
+
+	assert.Equal(t, PriorityHigh, s.priority(ctx, "SubmitFinding"))
+	assert.Equal(t, PriorityLow, s.priority(ctx, "RecordSpans"))
+	assert.Equal(t, PriorityNormal, s.priority(ctx, "LLMComplete"))
+	assert.Equal(t, PriorityLow, s.priority(ctx, "GraphRAGQuery"), "config overrides defaults")
+	assert.Equal(t, PriorityHigh, s.priority(WithCallPriority(ctx, PriorityHigh), "RecordSpans"), "context overrides config")
+}
+
+func TestCallClass(t *testing.T) {
+	tests := map[string]string{
+		"LLMComplete":             callClassLLM,
+		"LLMStream":               callClassLLM,
+		"CallToolProto":           callClassTool,
+		"QueueToolWork":           callClassTool,
+		"ToolResults":             callClassTool,
+		"MemorySet":               callClassMemory,
+		"MissionMemorySearch":     callClassMemory,
+		"LongTermMemoryStore":     callClassMemory,
+		"GraphRAGQuery":           callClassGraphRAG,
+		"StoreGraphBatch":         callClassGraphRAG,
+		"CreateGraphRelationship": callClassGraphRAG,
+		"FindSimilarAttacks":      callClassGraphRAG,
+		"StoreNode":               callClassGraphRAG,
+		"QueryNodes":              callClassGraphRAG,
+		"SubmitFinding":           callClassOther,
+		"GetCredential":           callClassOther,
+	}
+	for method, want := range tests {
+		assert.Equal(t, want, callClass(method), method)
+	}
+}
+
+func TestWithCallbackMeterProvider(t *testing.T) {
+	client, err := NewCallbackClient("localhost:50051", WithCallbackMeterProvider(noop.NewMeterProvider()))
+	require.NoError(t, err)
+	require.NotNil(t, client.throttle.shedCounter)
+
+	client.throttle.record("LLMComplete", nil)
+	assert.Equal(t, 1, client.LoadShedStats()[callClassLLM].Requests)
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/zero-day-ai/sdk/agent"
 	"github.com/zero-day-ai/sdk/api/gen/graphragpb"
 	"github.com/zero-day-ai/sdk/finding"
@@ -25,8 +28,9 @@ import (
 )
 
 // LocalHarness provides a minimal harness implementation for standalone agent execution.
-// It implements the full agent.Harness interface but only provides in-memory memory storage.
-// All LLM, tool, plugin, and finding operations return "not available" errors.
+// It implements the full agent.Harness interface but only provides in-memory memory storage,
+// plus in-memory StoreSemantic and QuerySemantic once graphrag.SetDefaultEmbedder is called.
+// All other LLM, tool, plugin, finding, and GraphRAG operations return "not available" errors.
 //
 // This is used when agents run without an orchestrator connection, allowing them to
 // execute basic operations without requiring full framework infrastructure.
@@ -42,6 +46,16 @@ type LocalHarness struct {
 	mission      types.MissionContext
 	target       types.TargetInfo
 	tokenTracker llm.TokenTracker
+
+	// In-memory semantic index, used when a default embedder is set
+	semanticMu    sync.RWMutex
+	semanticNodes []semanticNode
+}
+
+// semanticNode is a node stored with StoreSemantic and its embedding.
+type semanticNode struct {
+	node   graphrag.GraphNode
+	vector []float32
 }
 
 // newLocalHarness creates a new local harness with in-memory storage.
@@ -112,6 +126,12 @@ func (h *LocalHarness) Stream(ctx context.Context, slot string, messages []llm.M
 	return nil, fmt.Errorf("LLM operations not available in standalone mode (no orchestrator connected)")
 }
 
+// StreamControlled returns an error indicating LLM operations are not available.
+func (h *LocalHarness) StreamControlled(ctx context.Context, slot string, messages []llm.Message) (*llm.StreamHandle, error) {
+	h.logger.Warn("LLM StreamControlled not available in standalone mode", "slot", slot)
+	return nil, fmt.Errorf("LLM operations not available in standalone mode (no orchestrator connected)")
+}
+
 // CompleteStructured returns an error indicating LLM operations are not available.
 func (h *LocalHarness) CompleteStructured(ctx context.Context, slot string, messages []llm.Message, schema any) (any, error) {
 	h.logger.Warn("LLM CompleteStructured not available in standalone mode", "slot", slot)
@@ -230,12 +250,95 @@ func (h *LocalHarness) QueryGraphRAG(ctx context.Context, query graphrag.Query)
 	return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
 }
 
-// QuerySemantic returns an error indicating GraphRAG is not available.
-func (h *LocalHarness) QuerySemantic(ctx context.Context, query graphrag.Query) ([]graphrag.Result, error) {
-	h.logger.Warn("QuerySemantic not available in standalone mode")
+// QueryBatch returns an error indicating GraphRAG is not available.
+func (h *LocalHarness) QueryBatch(ctx context.Context, queries []graphrag.Query) ([][]graphrag.Result, error) {
+	h.logger.Warn("QueryBatch not available in standalone mode")
+	return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+}
+
+// ExplainQuery returns the locally computed plan for a GraphRAG query.
+// Planning does not require an orchestrator, so this works in standalone mode.
+func (h *LocalHarness) ExplainQuery(ctx context.Context, query graphrag.Query) (graphrag.QueryPlan, error) {
+	return query.Explain(), nil
+}
+
+// GraphStats returns an error indicating GraphRAG is not available.
+func (h *LocalHarness) GraphStats(ctx context.Context, missionID string) (*graphrag.GraphStats, error) {
+	h.logger.Warn("GraphStats not available in standalone mode")
 	return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
 }
 
+// ShortestPath returns an error indicating GraphRAG is not available.
+func (h *LocalHarness) ShortestPath(ctx context.Context, fromID, toID string, opts graphrag.PathOptions) ([]graphrag.PathEdge, error) {
+	h.logger.Warn("ShortestPath not available in standalone mode")
+	return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+}
+
+// Neighbors returns an error indicating GraphRAG is not available.
+func (h *LocalHarness) Neighbors(ctx context.Context, nodeID, relType, direction string) ([]graphrag.GraphNode, error) {
+	h.logger.Warn("Neighbors not available in standalone mode")
+	return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+}
+
+// WatchGraph returns an error indicating GraphRAG is not available.
+func (h *LocalHarness) WatchGraph(ctx context.Context, missionID string, nodeTypes []string) (<-chan graphrag.GraphEvent, error) {
+	h.logger.Warn("WatchGraph not available in standalone mode")
+	return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+}
+
+// QuerySemantic ranks the nodes stored with StoreSemantic by cosine
+// similarity to the query's embedding, or to its text embedded with the
+// default embedder. Without a default embedder it returns an error
+// indicating GraphRAG is not available.
+func (h *LocalHarness) QuerySemantic(ctx context.Context, query graphrag.Query) ([]graphrag.Result, error) {
+	embedder := graphrag.DefaultEmbedder()
+	if embedder == nil {
+		h.logger.Warn("QuerySemantic not available in standalone mode")
+		return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+	}
+
+	var vector []float32
+	if len(query.Embedding) > 0 {
+		vector = make([]float32, len(query.Embedding))
+		for i, v := range query.Embedding {
+			vector[i] = float32(v)
+		}
+	} else {
+		vectors, err := graphrag.EmbedText(ctx, embedder, query.Text)
+		if err != nil {
+			return nil, err
+		}
+		vector = vectors[0]
+	}
+
+	h.semanticMu.RLock()
+	defer h.semanticMu.RUnlock()
+
+	var results []graphrag.Result
+	for _, sn := range h.semanticNodes {
+		if len(query.NodeTypes) > 0 && !slices.Contains(query.NodeTypes, sn.node.Type) {
+			continue
+		}
+		if query.MissionID != "" && sn.node.MissionID != query.MissionID {
+			continue
+		}
+		score, err := graphrag.CosineSimilarity(vector, sn.vector)
+		if err != nil {
+			return nil, fmt.Errorf("query node %s: %w", sn.node.ID, err)
+		}
+		if score < query.MinScore {
+			continue
+		}
+		results = append(results, graphrag.Result{Node: sn.node, Score: score, VectorScore: score})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if query.TopK > 0 && len(results) > query.TopK {
+		results = results[:query.TopK]
+	}
+	return results, nil
+}
+
 // QueryStructured returns an error indicating GraphRAG is not available.
 func (h *LocalHarness) QueryStructured(ctx context.Context, query graphrag.Query) ([]graphrag.Result, error) {
 	h.logger.Warn("QueryStructured not available in standalone mode")
@@ -282,10 +385,39 @@ func (h *LocalHarness) StoreGraphNode(ctx context.Context, node graphrag.GraphNo
 	return "", fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
 }
 
-// StoreSemantic returns an error indicating GraphRAG is not available.
+// StoreSemantic stores the node in memory with the embedding of its content,
+// for QuerySemantic. Without a default embedder it returns an error
+// indicating GraphRAG is not available.
 func (h *LocalHarness) StoreSemantic(ctx context.Context, node graphrag.GraphNode) (string, error) {
-	h.logger.Warn("StoreSemantic not available in standalone mode")
-	return "", fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+	embedder := graphrag.DefaultEmbedder()
+	if embedder == nil {
+		h.logger.Warn("StoreSemantic not available in standalone mode")
+		return "", fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+	}
+	if node.Content == "" {
+		return "", fmt.Errorf("StoreSemantic requires node content to embed")
+	}
+
+	vectors, err := graphrag.EmbedText(ctx, embedder, node.Content)
+	if err != nil {
+		return "", err
+	}
+
+	if node.ID == "" {
+		node.ID = uuid.New().String()
+	}
+	if node.CreatedAt.IsZero() {
+		node.CreatedAt = time.Now()
+		node.UpdatedAt = node.CreatedAt
+	}
+
+	h.semanticMu.Lock()
+	defer h.semanticMu.Unlock()
+	h.semanticNodes = slices.DeleteFunc(h.semanticNodes, func(sn semanticNode) bool {
+		return sn.node.ID == node.ID
+	})
+	h.semanticNodes = append(h.semanticNodes, semanticNode{node: node, vector: vectors[0]})
+	return node.ID, nil
 }
 
 // StoreStructured returns an error indicating GraphRAG is not available.
@@ -306,6 +438,12 @@ func (h *LocalHarness) StoreGraphBatch(ctx context.Context, batch graphrag.Batch
 	return nil, fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
 }
 
+// StoreDomainNode returns an error indicating GraphRAG is not available.
+func (h *LocalHarness) StoreDomainNode(ctx context.Context, node graphrag.DomainNode) (string, error) {
+	h.logger.Warn("StoreDomainNode not available in standalone mode")
+	return "", fmt.Errorf("GraphRAG not available in standalone mode (no orchestrator connected)")
+}
+
 // TraverseGraph returns an error indicating GraphRAG is not available.
 func (h *LocalHarness) TraverseGraph(ctx context.Context, startNodeID string, opts graphrag.TraversalOptions) ([]graphrag.TraversalResult, error) {
 	h.logger.Warn("TraverseGraph not available in standalone mode")
@@ -480,10 +618,18 @@ func (s *stubMissionMemory) Search(ctx context.Context, query string, limit int)
 	return nil, memory.ErrNotImplemented
 }
 
+func (s *stubMissionMemory) SearchFiltered(ctx context.Context, query string, filter map[string]any, limit, offset int) ([]memory.Result, int, error) {
+	return nil, 0, memory.ErrNotImplemented
+}
+
 func (s *stubMissionMemory) History(ctx context.Context, limit int) ([]memory.Item, error) {
 	return nil, memory.ErrNotImplemented
 }
 
+func (s *stubMissionMemory) HistoryFiltered(ctx context.Context, filter map[string]any, limit, offset int) ([]memory.Item, int, error) {
+	return nil, 0, memory.ErrNotImplemented
+}
+
 func (s *stubMissionMemory) GetPreviousRunValue(ctx context.Context, key string) (any, error) {
 	return nil, memory.ErrNotImplemented
 }
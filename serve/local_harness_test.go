@@ -134,6 +134,11 @@ func TestLocalHarness_LLMOperations_NotAvailable(t *testing.T) {
 	_, err = h.Stream(ctx, "primary", []llm.Message{})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not available in standalone mode")
+
+	// StreamControlled should return error
+	_, err = h.StreamControlled(ctx, "primary", []llm.Message{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not available in standalone mode")
 }
 
 func TestLocalHarness_ToolOperations_NotAvailable(t *testing.T) {
@@ -216,6 +221,36 @@ func TestLocalHarness_GraphRAGOperations_NotAvailable(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not available in standalone mode")
 
+	// QueryBatch should return error
+	_, err = h.QueryBatch(ctx, []graphrag.Query{*graphrag.NewQuery("test")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not available in standalone mode")
+
+	// ExplainQuery works without an orchestrator
+	plan, err := h.ExplainQuery(ctx, *graphrag.NewQuery("test"))
+	assert.NoError(t, err)
+	assert.Equal(t, graphrag.RouteText, plan.Route)
+
+	// GraphStats should return error
+	_, err = h.GraphStats(ctx, "mission-1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not available in standalone mode")
+
+	// ShortestPath should return error
+	_, err = h.ShortestPath(ctx, "finding-1", "T1190", *graphrag.NewPathOptions())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not available in standalone mode")
+
+	// Neighbors should return error
+	_, err = h.Neighbors(ctx, "T1190", "USES_TECHNIQUE", "incoming")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not available in standalone mode")
+
+	// WatchGraph should return error
+	_, err = h.WatchGraph(ctx, "mission-1", nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not available in standalone mode")
+
 	// FindSimilarAttacks should return error
 	_, err = h.FindSimilarAttacks(ctx, "test", 5)
 	assert.Error(t, err)
@@ -251,6 +286,11 @@ func TestLocalHarness_GraphRAGOperations_NotAvailable(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not available in standalone mode")
 
+	// StoreDomainNode should return error
+	_, err = h.StoreDomainNode(ctx, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not available in standalone mode")
+
 	// TraverseGraph should return error
 	_, err = h.TraverseGraph(ctx, "node-id", graphrag.TraversalOptions{})
 	assert.Error(t, err)
@@ -327,3 +367,56 @@ func TestLocalTokenTracker_Concurrent(t *testing.T) {
 	assert.Equal(t, 50, total.OutputTokens)
 	assert.Equal(t, 150, total.TotalTokens)
 }
+
+func TestLocalHarness_Semantic(t *testing.T) {
+	h := newLocalHarness()
+	ctx := context.Background()
+
+	_, err := h.StoreSemantic(ctx, graphrag.GraphNode{Type: "finding", Content: "SQL injection"})
+	assert.Error(t, err, "not available without a default embedder")
+
+	graphrag.SetDefaultEmbedder(graphrag.NewHashingEmbedder(128))
+	t.Cleanup(func() { graphrag.SetDefaultEmbedder(nil) })
+
+	sqliID, err := h.StoreSemantic(ctx, graphrag.GraphNode{Type: "finding", Content: "SQL injection in the login form", MissionID: "m1"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, sqliID)
+	_, err = h.StoreSemantic(ctx, graphrag.GraphNode{ID: "ssh", Type: "finding", Content: "Open SSH port on the bastion host", MissionID: "m1"})
+	require.NoError(t, err)
+	_, err = h.StoreSemantic(ctx, graphrag.GraphNode{ID: "host", Type: "host", Content: "login server for the SQL database", MissionID: "m2"})
+	require.NoError(t, err)
+	_, err = h.StoreSemantic(ctx, graphrag.GraphNode{Type: "finding"})
+	assert.Error(t, err, "content is required")
+
+	query := graphrag.Query{Text: "sql injection login", TopK: 2, MinScore: 0}
+	results, err := h.QuerySemantic(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, sqliID, results[0].Node.ID)
+	assert.GreaterOrEqual(t, results[0].Score, results[1].Score)
+
+	query.NodeTypes = []string{"finding"}
+	query.MissionID = "m1"
+	query.MinScore = 0.3
+	results, err = h.QuerySemantic(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, sqliID, results[0].Node.ID)
+
+	// Re-storing a node replaces it
+	_, err = h.StoreSemantic(ctx, graphrag.GraphNode{ID: "ssh", Type: "finding", Content: "SQL injection login bypass", MissionID: "m1"})
+	require.NoError(t, err)
+	results, err = h.QuerySemantic(ctx, query)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	// A query embedded elsewhere must match the stored dimensions
+	_, err = h.QuerySemantic(ctx, graphrag.Query{Embedding: []float64{0.1, 0.2}, TopK: 5})
+	assert.ErrorIs(t, err, graphrag.ErrDimensionMismatch)
+
+	embedded, err := graphrag.NewQuery("sql injection login").WithTextEmbedded(ctx)
+	require.NoError(t, err)
+	results, err = h.QuerySemantic(ctx, *embedded.WithMinScore(0))
+	require.NoError(t, err)
+	assert.Len(t, results, 3)
+}
@@ -6,7 +6,10 @@ import (
 	"os"
 	"time"
 
+	"github.com/zero-day-ai/sdk/llm"
 	"github.com/zero-day-ai/sdk/registry"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 )
 
 // Option is a functional option for configuring a Server.
@@ -57,6 +60,202 @@ func WithGracefulShutdown(timeout time.Duration) Option {
 	}
 }
 
+// WithMaxConcurrentTasks limits how many tasks an agent server executes at
+// once. Execute calls beyond the limit are rejected immediately with
+// codes.ResourceExhausted so the orchestrator can retry or schedule the task
+// elsewhere. A value of 0 or less means no limit.
+//
+// Each task gets its own harness, so SDK-managed state such as token usage
+// and caches is never shared between tasks. The agent implementation itself
+// must still be safe for concurrent Execute calls.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithMaxConcurrentTasks(4))
+func WithMaxConcurrentTasks(n int) Option {
+	return func(c *Config) {
+		c.MaxConcurrentTasks = n
+	}
+}
+
+// WithSkipTargetValidation turns off pre-flight target validation. By
+// default, an agent server checks the target of each task against the
+// agent's TargetSchemas with types.ValidateTargetConfig and rejects a
+// mismatched task with codes.InvalidArgument before the agent runs. Use
+// this for agents that validate targets themselves.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithSkipTargetValidation())
+func WithSkipTargetValidation() Option {
+	return func(c *Config) {
+		c.SkipTargetValidation = true
+	}
+}
+
+// WithHarnessOptions configures the callback harness an agent server
+// creates for each task, such as how long tool lists are cached.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithHarnessOptions(serve.HarnessOptions{
+//	    ToolCacheTTL: time.Minute,
+//	}))
+func WithHarnessOptions(opts HarnessOptions) Option {
+	return func(c *Config) {
+		c.Harness = opts
+	}
+}
+
+// WithQueryCache caches GraphRAG query results of an agent server, so
+// identical QueryGraphRAG calls within a mission are answered without a
+// callback. The cache is shared by all tasks of the server and keyed by
+// mission and query. A mission's entries are dropped whenever one of its
+// tasks stores into the graph (StoreNode, StoreGraphNode, StoreGraphBatch,
+// CreateGraphRelationship, SubmitFinding) or delegates to another agent;
+// changes made by other writers are picked up once entries expire.
+//
+// It sets HarnessOptions.QueryCache, so it must follow WithHarnessOptions.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithQueryCache(serve.QueryCacheOptions{
+//	    TTL: 30 * time.Second,
+//	}))
+func WithQueryCache(opts QueryCacheOptions) Option {
+	return func(c *Config) {
+		c.Harness.QueryCache = &opts
+	}
+}
+
+// WithTokenReport makes each task of an agent server report its cumulative
+// token usage to the orchestrator while it runs, every interval, when usage
+// crosses a threshold, and once more when the task ends. The orchestrator
+// can then account for the usage of a task whose agent crashed.
+//
+// It sets HarnessOptions.TokenReport, so it must follow WithHarnessOptions.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithTokenReport(serve.TokenReportOptions{
+//	    Interval:   15 * time.Second,
+//	    Thresholds: []int{50_000, 90_000},
+//	}))
+func WithTokenReport(opts TokenReportOptions) Option {
+	return func(c *Config) {
+		c.Harness.TokenReport = &opts
+	}
+}
+
+// WithResponseFilter runs filter on every LLM completion response of an
+// agent server's tasks before the agent sees it, giving a single enforcement
+// point for output safety policies. A filter can annotate, redact, or reject
+// a response; see llm.WithResponseFilter for the semantics. Filters added
+// with this option run before those passed to individual completions.
+//
+// It appends to HarnessOptions.ResponseFilters, so it must follow
+// WithHarnessOptions.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithResponseFilter(func(content string) (llm.FilterVerdict, error) {
+//	    if apiKeyPattern.MatchString(content) {
+//	        return llm.FilterVerdict{
+//	            Action:   llm.FilterRedact,
+//	            Labels:   []string{"secret"},
+//	            Redacted: apiKeyPattern.ReplaceAllString(content, "[REDACTED]"),
+//	        }, nil
+//	    }
+//	    return llm.FilterVerdict{Action: llm.FilterAllow}, nil
+//	}))
+func WithResponseFilter(filter llm.ResponseFilter) Option {
+	return func(c *Config) {
+		c.Harness.ResponseFilters = append(c.Harness.ResponseFilters, filter)
+	}
+}
+
+// WithSystemPreamble injects a system preamble into the messages of every
+// LLM call of an agent server's tasks, so that rules of engagement reach the
+// model even if an agent leaves them out. Agents cannot remove it: the
+// harness injects it after the agent has built its messages, unless they
+// already carry it. See llm.ApplyPreamble for the placement.
+//
+// It sets HarnessOptions.SystemPreamble, so it must follow
+// WithHarnessOptions.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithSystemPreamble(llm.PreambleConfig{
+//	    Content:  "You are operating in an authorized test. Never target out-of-scope systems.",
+//	    Position: llm.PreambleAfterSystem,
+//	}))
+func WithSystemPreamble(cfg llm.PreambleConfig) Option {
+	return func(c *Config) {
+		c.Harness.SystemPreamble = cfg
+	}
+}
+
+// WithUnaryInterceptors adds unary interceptors that run, in order, on
+// every call to the server's components.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithUnaryInterceptors(loggingInterceptor))
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(c *Config) {
+		c.UnaryInterceptors = append(c.UnaryInterceptors, interceptors...)
+	}
+}
+
+// WithStreamInterceptors adds stream interceptors that run, in order, on
+// every streaming call to the server's components.
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) Option {
+	return func(c *Config) {
+		c.StreamInterceptors = append(c.StreamInterceptors, interceptors...)
+	}
+}
+
+// WithLifecycleEvents sends the server's lifecycle events to ch. It is how
+// supervisors observe servers started with Agent, Tool, and Plugin, which
+// do not return the Server. Sends never block, so give ch enough buffer to
+// hold the events the consumer may fall behind on.
+//
+// Example:
+//
+//	events := make(chan serve.LifecycleEvent, 16)
+//	go superviseAgent(events)
+//	serve.Agent(myAgent, serve.WithLifecycleEvents(events))
+func WithLifecycleEvents(ch chan LifecycleEvent) Option {
+	return func(c *Config) {
+		c.LifecycleEvents = ch
+	}
+}
+
+// WithTracer records the server's lifecycle events as OpenTelemetry span
+// events on a span that lasts while the server runs.
+//
+// Example:
+//
+//	serve.Agent(myAgent, serve.WithTracer(otel.Tracer("my-agent")))
+func WithTracer(tracer trace.Tracer) Option {
+	return func(c *Config) {
+		c.Tracer = tracer
+	}
+}
+
+// WithReflection enables or disables gRPC server reflection. Servers
+// hosting a tool enable reflection by default so clients can discover the
+// tool's services and message types at runtime.
+//
+// Example:
+//
+//	serve.Tool(myTool, serve.WithReflection(false))
+func WithReflection(enable bool) Option {
+	return func(c *Config) {
+		c.DisableReflection = !enable
+	}
+}
+
 // WithTLS enables TLS encryption for the gRPC server.
 // Both certFile and keyFile must be valid paths to PEM-encoded files.
 // If either path is empty, TLS will be disabled.
@@ -60,3 +60,19 @@ func TestMultipleOptions(t *testing.T) {
 	assert.Equal(t, "cert.pem", cfg.TLSCertFile)
 	assert.Equal(t, "key.pem", cfg.TLSKeyFile)
 }
+
+func TestWithMaxConcurrentTasks(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, 0, cfg.MaxConcurrentTasks, "unlimited by default")
+
+	WithMaxConcurrentTasks(4)(cfg)
+	assert.Equal(t, 4, cfg.MaxConcurrentTasks)
+}
+
+func TestWithSkipTargetValidation(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.False(t, cfg.SkipTargetValidation, "targets are validated by default")
+
+	WithSkipTargetValidation()(cfg)
+	assert.True(t, cfg.SkipTargetValidation)
+}
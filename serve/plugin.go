@@ -4,15 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log/slog"
-	"strings"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/zero-day-ai/sdk/api/gen/proto"
 	"github.com/zero-day-ai/sdk/plugin"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
@@ -31,85 +27,13 @@ import (
 //	    log.Fatal(err)
 //	}
 func PluginFunc(p plugin.Plugin, opts ...Option) error {
-	// Build configuration
-	cfg := DefaultConfig()
-	for _, opt := range opts {
-		opt(cfg)
-	}
-
-	// Create server
-	srv, err := NewServer(cfg)
+	srv, err := New(opts...)
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
-
-	// Create and register plugin service
-	pluginSvc := &pluginServiceServer{
-		plugin: p,
-	}
-	proto.RegisterPluginServiceServer(srv.GRPCServer(), pluginSvc)
-
-	// Set health status to serving
-	srv.HealthServer().SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
-
-	slog.Info("plugin server started", "component", "plugin", "name", p.Name(), "version", p.Version(), "port", srv.Port())
-
-	// Register with registry if configured
-	var serviceInfo interface{}
-	if cfg.Registry != nil {
-		// Build endpoint based on LocalMode, AdvertiseAddr, or TCP
-		endpoint := ""
-		if cfg.LocalMode != "" {
-			endpoint = fmt.Sprintf("unix://%s", cfg.LocalMode)
-		} else if cfg.AdvertiseAddr != "" {
-			// Use advertise address - append port if not present
-			if strings.Contains(cfg.AdvertiseAddr, ":") {
-				endpoint = cfg.AdvertiseAddr
-			} else {
-				endpoint = fmt.Sprintf("%s:%d", cfg.AdvertiseAddr, srv.Port())
-			}
-		} else {
-			endpoint = fmt.Sprintf("localhost:%d", srv.Port())
-		}
-
-		// Extract plugin metadata - get method names from Methods()
-		methods := p.Methods()
-		methodNames := make([]string, len(methods))
-		for i, method := range methods {
-			methodNames[i] = method.Name
-		}
-
-		// Create ServiceInfo struct (using map to avoid circular dependency)
-		serviceInfo = map[string]interface{}{
-			"kind":        "plugin",
-			"name":        p.Name(),
-			"version":     p.Version(),
-			"instance_id": uuid.New().String(),
-			"endpoint":    endpoint,
-			"metadata": map[string]string{
-				"description": p.Description(),
-				"methods":     strings.Join(methodNames, ","),
-			},
-			"started_at": time.Now(),
-		}
-
-		// Register with the registry
-		ctx := context.Background()
-		if err := cfg.Registry.Register(ctx, serviceInfo); err != nil {
-			slog.Warn("failed to register with registry", "error", err, "endpoint", endpoint, "component", "plugin", "name", p.Name())
-		} else {
-			slog.Info("registered with registry", "endpoint", endpoint, "component", "plugin", "name", p.Name())
-			// Deregister on shutdown
-			defer func() {
-				ctx := context.Background()
-				if err := cfg.Registry.Deregister(ctx, serviceInfo); err != nil {
-					slog.Warn("failed to deregister from registry", "error", err, "endpoint", endpoint, "component", "plugin", "name", p.Name())
-				}
-			}()
-		}
+	if err := srv.RegisterPlugin(p); err != nil {
+		return err
 	}
-
-	// Start serving
 	return srv.Serve(context.Background())
 }
 
@@ -0,0 +1,208 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/plugin"
+	"github.com/zero-day-ai/sdk/schema"
+)
+
+// cveRecord is the typed result of the example plugin's "get" method.
+type cveRecord struct {
+	ID       string   `json:"id"`
+	Score    float64  `json:"score"`
+	Products []string `json:"products"`
+}
+
+// newCVEPlugin builds an example plugin with a single "get" method.
+func newCVEPlugin(t *testing.T) plugin.Plugin {
+	t.Helper()
+
+	cfg := plugin.NewConfig()
+	cfg.SetName("cve-lookup")
+	cfg.SetVersion("1.0.0")
+	cfg.AddMethodWithDesc("get", "Look up a CVE",
+		func(ctx context.Context, params map[string]any) (any, error) {
+			return map[string]any{
+				"id":       params["id"],
+				"score":    9,
+				"products": []any{"xz-utils"},
+			}, nil
+		},
+		schema.Object(map[string]schema.JSON{
+			"id": schema.String(),
+		}, "id"),
+		schema.Object(map[string]schema.JSON{
+			"id":       schema.String(),
+			"score":    schema.Number(),
+			"products": schema.Array(schema.String()),
+		}, "id", "score"),
+	)
+
+	p, err := plugin.New(cfg)
+	require.NoError(t, err)
+	return p
+}
+
+func TestPluginClient_Call(t *testing.T) {
+	conn, cleanup := setupPluginTestServer(t, newCVEPlugin(t))
+	defer cleanup()
+
+	client := plugin.NewClient(conn, "cve-lookup")
+	result, err := client.Call(context.Background(), "get", map[string]any{"id": "CVE-2024-3094"})
+	require.NoError(t, err)
+
+	record, ok := result.(map[string]any)
+	require.True(t, ok, "result should be a JSON object, got %T", result)
+	assert.Equal(t, "CVE-2024-3094", record["id"])
+	assert.Equal(t, float64(9), record["score"])
+}
+
+func TestPluginClient_CallAs(t *testing.T) {
+	conn, cleanup := setupPluginTestServer(t, newCVEPlugin(t))
+	defer cleanup()
+
+	client := plugin.NewClient(conn, "cve-lookup")
+	record, err := plugin.CallAs[cveRecord](context.Background(), client, "get", map[string]any{"id": "CVE-2024-3094"})
+	require.NoError(t, err)
+	assert.Equal(t, cveRecord{ID: "CVE-2024-3094", Score: 9, Products: []string{"xz-utils"}}, record)
+
+	// Pointer and untyped results work too
+	ptr, err := plugin.CallAs[*cveRecord](context.Background(), client, "get", map[string]any{"id": "CVE-2021-44228"})
+	require.NoError(t, err)
+	assert.Equal(t, "CVE-2021-44228", ptr.ID)
+
+	raw, err := plugin.CallAs[map[string]any](context.Background(), client, "get", map[string]any{"id": "CVE-2021-44228"})
+	require.NoError(t, err)
+	assert.Equal(t, "CVE-2021-44228", raw["id"])
+}
+
+func TestPluginClient_CallAsWrongType(t *testing.T) {
+	var calls int
+	mock := &mockPlugin{
+		methods: newCVEPlugin(t).Methods(),
+		queryFunc: func(ctx context.Context, method string, params map[string]any) (any, error) {
+			calls++
+			return map[string]any{"id": "CVE-2024-3094", "score": 9.8}, nil
+		},
+	}
+	conn, cleanup := setupPluginTestServer(t, mock)
+	defer cleanup()
+
+	type wrongRecord struct {
+		ID       int    `json:"id"`
+		Score    string `json:"score"`
+		Products string `json:"products"`
+	}
+
+	client := plugin.NewClient(conn, "cve-lookup")
+	_, err := plugin.CallAs[wrongRecord](context.Background(), client, "get", map[string]any{"id": "CVE-2024-3094"})
+	require.Error(t, err)
+
+	var mismatch *plugin.SchemaMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, "cve-lookup", mismatch.Plugin)
+	assert.Equal(t, "get", mismatch.Method)
+	require.Len(t, mismatch.Fields, 3)
+	assert.Contains(t, mismatch.Fields[0], "id")
+	assert.Contains(t, mismatch.Fields[1], "products")
+	assert.Contains(t, mismatch.Fields[2], "score")
+	assert.Zero(t, calls, "a wrong type parameter should fail before the method is invoked")
+
+	// A scalar type for an object result is reported for the result itself
+	_, err = plugin.CallAs[string](context.Background(), client, "get", map[string]any{"id": "CVE-2024-3094"})
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, []string{"(result): plugin returns object, requested type has string"}, mismatch.Fields)
+}
+
+func TestPluginClient_ResultViolatesSchema(t *testing.T) {
+	mock := &mockPlugin{
+		methods: newCVEPlugin(t).Methods(),
+		queryFunc: func(ctx context.Context, method string, params map[string]any) (any, error) {
+			// score is missing and products holds a number
+			return map[string]any{"id": "CVE-2024-3094", "products": []any{"xz-utils", 5}}, nil
+		},
+	}
+	conn, cleanup := setupPluginTestServer(t, mock)
+	defer cleanup()
+
+	client := plugin.NewClient(conn, "cve-lookup")
+	_, err := plugin.CallAs[cveRecord](context.Background(), client, "get", map[string]any{"id": "CVE-2024-3094"})
+
+	var mismatch *plugin.SchemaMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	require.Len(t, mismatch.Fields, 2)
+	assert.Contains(t, mismatch.Fields[0], "score: required field is missing")
+	assert.Contains(t, mismatch.Fields[1], "products[1]")
+}
+
+func TestPluginClient_MethodNotFound(t *testing.T) {
+	conn, cleanup := setupPluginTestServer(t, newCVEPlugin(t))
+	defer cleanup()
+
+	client := plugin.NewClient(conn, "cve-lookup")
+
+	_, err := client.Call(context.Background(), "search", nil)
+	assert.ErrorIs(t, err, plugin.ErrMethodNotFound)
+
+	_, err = plugin.CallAs[cveRecord](context.Background(), client, "search", nil)
+	assert.ErrorIs(t, err, plugin.ErrMethodNotFound)
+}
+
+func TestPluginClient_RefreshesDescriptors(t *testing.T) {
+	mock := &mockPlugin{
+		methods: newCVEPlugin(t).Methods(),
+		queryFunc: func(ctx context.Context, method string, params map[string]any) (any, error) {
+			if method == "removed" {
+				return nil, fmt.Errorf("method not found: %s", method)
+			}
+			return map[string]any{"id": "CVE-2024-3094", "score": 9.8}, nil
+		},
+	}
+	conn, cleanup := setupPluginTestServer(t, mock)
+	defer cleanup()
+
+	client := plugin.NewClient(conn, "cve-lookup")
+	methods, err := client.Methods(context.Background())
+	require.NoError(t, err)
+	require.Len(t, methods, 1)
+
+	// A method added after the descriptors were cached is found by refreshing
+	mock.methods = append(mock.methods,
+		plugin.MethodDescriptor{Name: "search"},
+		plugin.MethodDescriptor{Name: "removed"},
+	)
+	_, err = client.Call(context.Background(), "search", nil)
+	require.NoError(t, err)
+
+	methods, err = client.Methods(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, methods, 3)
+
+	// A method the plugin no longer has drops the cache
+	mock.methods = mock.methods[:2]
+	_, err = client.Call(context.Background(), "removed", nil)
+	assert.True(t, errors.Is(err, plugin.ErrMethodNotFound), "got %v", err)
+
+	methods, err = client.Methods(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, methods, 2)
+}
+
+func TestPluginClient_SharedConnection(t *testing.T) {
+	conn, cleanup := setupPluginTestServer(t, newCVEPlugin(t))
+	defer cleanup()
+
+	first := plugin.NewClient(conn, "cve-lookup")
+	second := plugin.NewClient(conn, "cve-lookup")
+
+	_, err := first.Call(context.Background(), "get", map[string]any{"id": "CVE-2024-3094"})
+	require.NoError(t, err)
+	_, err = second.Call(context.Background(), "get", map[string]any{"id": "CVE-2024-3094"})
+	require.NoError(t, err)
+}
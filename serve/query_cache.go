@@ -0,0 +1,173 @@
+package serve
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	protolib "google.golang.org/protobuf/proto"
+)
+
+// Query cache defaults.
+const (
+	defaultQueryCacheTTL        = time.Minute
+	defaultQueryCacheMaxEntries = 1000
+)
+
+// QueryCacheOptions configures the GraphRAG query cache of the callback
+// harness. See WithQueryCache.
+type QueryCacheOptions struct {
+	// TTL is how long a query result is served from the cache (default:
+	// 1 minute). It bounds how stale a result can be when the graph is
+	// changed by someone other than the agent itself.
+	TTL time.Duration
+
+	// MaxEntries bounds the number of cached results (default: 1000).
+	// When the cache is full, expired entries are dropped first, then the
+	// entry closest to expiry.
+	MaxEntries int
+}
+
+// queryCacheEntry is a cached query result.
+type queryCacheEntry struct {
+	missionID string
+	results   []*proto.GraphRAGResult
+	expires   time.Time
+}
+
+// queryCache caches GraphRAG query results by mission and query. Each
+// mission has a generation that a store into its graph advances, which
+// drops the mission's entries and keeps queries that were in flight during
+// the store from caching what they read.
+type queryCache struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu          sync.Mutex
+	entries     map[string]*queryCacheEntry
+	generations map[string]uint64
+
+	// now is replaceable for tests
+	now func() time.Time
+}
+
+// newQueryCache creates a query cache, applying defaults to opts.
+func newQueryCache(opts QueryCacheOptions) *queryCache {
+	if opts.TTL <= 0 {
+		opts.TTL = defaultQueryCacheTTL
+	}
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = defaultQueryCacheMaxEntries
+	}
+	return &queryCache{
+		ttl:         opts.TTL,
+		maxEntries:  opts.MaxEntries,
+		entries:     make(map[string]*queryCacheEntry),
+		generations: make(map[string]uint64),
+		now:         time.Now,
+	}
+}
+
+// queryCacheKey hashes a query together with its mission. The query is
+// marshaled deterministically, so every field that affects the result
+// (text, embedding, filters, weights, limits) is part of the key. ok is
+// false if the query cannot be marshaled.
+func queryCacheKey(missionID string, query *proto.GraphQuery) (key string, ok bool) {
+	data, err := protolib.MarshalOptions{Deterministic: true}.Marshal(query)
+	if err != nil {
+		return "", false
+	}
+	h := sha256.New()
+	h.Write([]byte(missionID))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// get returns the cached results for key, if present and not expired, and
+// the mission's current generation to pass to put.
+func (c *queryCache) get(missionID, key string) ([]*proto.GraphRAGResult, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	generation := c.generations[missionID]
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, generation, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, generation, false
+	}
+	return entry.results, generation, true
+}
+
+// put caches results read at the given generation of the mission. Results
+// are dropped if the mission's graph was stored into since.
+func (c *queryCache) put(missionID, key string, generation uint64, results []*proto.GraphRAGResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[missionID] != generation {
+		return
+	}
+	now := c.now()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = &queryCacheEntry{
+		missionID: missionID,
+		results:   results,
+		expires:   now.Add(c.ttl),
+	}
+}
+
+// evict makes room for one entry. The caller must hold c.mu.
+func (c *queryCache) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.expires.Before(oldest) {
+			oldestKey, oldest = key, entry.expires
+		}
+	}
+	if len(c.entries) >= c.maxEntries && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// invalidate drops the mission's entries and advances its generation.
+func (c *queryCache) invalidate(missionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[missionID]++
+	for key, entry := range c.entries {
+		if entry.missionID == missionID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// queryCacheMission returns the mission a callback made with ctx is scoped
+// to, which is the scope of the query cache.
+func (h *CallbackHarness) queryCacheMission(ctx context.Context) string {
+	return h.client.contextInfo(ctx).GetMissionId()
+}
+
+// invalidateQueryCache drops cached query results for the mission ctx is
+// scoped to. Store methods defer it so that it runs once the store has
+// completed, whether or not it succeeded.
+func (h *CallbackHarness) invalidateQueryCache(ctx context.Context) {
+	if h.queryCache == nil {
+		return
+	}
+	h.queryCache.invalidate(h.queryCacheMission(ctx))
+}
@@ -0,0 +1,261 @@
+package serve
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/finding"
+	"github.com/zero-day-ai/sdk/graphrag"
+)
+
+func TestQueryCacheKey(t *testing.T) {
+	key := func(missionID string, q graphrag.Query) string {
+		k, ok := queryCacheKey(missionID, GraphQueryToProto(q))
+		require.True(t, ok)
+		return k
+	}
+
+	base := graphrag.Query{Text: "open ports", TopK: 5}
+	assert.Equal(t, key("m1", base), key("m1", base))
+	assert.NotEqual(t, key("m1", base), key("m2", base), "mission is part of the key")
+
+	for name, q := range map[string]graphrag.Query{
+		"text":      {Text: "closed ports", TopK: 5},
+		"top k":     {Text: "open ports", TopK: 6},
+		"embedding": {Text: "open ports", TopK: 5, Embedding: []float64{0.1, 0.2}},
+		"weights":   {Text: "open ports", TopK: 5, VectorWeight: 0.7, GraphWeight: 0.3},
+		"filters":   {Text: "open ports", TopK: 5, NodeTypes: []string{"port"}},
+	} {
+		assert.NotEqual(t, key("m1", base), key("m1", q), name)
+	}
+}
+
+func TestQueryCache(t *testing.T) {
+	results := []*proto.GraphRAGResult{{Node: &proto.GraphNode{Id: "n1"}}}
+
+	t.Run("hit until expiry", func(t *testing.T) {
+		now := time.Unix(1000, 0)
+		c := newQueryCache(QueryCacheOptions{TTL: time.Minute})
+		c.now = func() time.Time { return now }
+
+		_, gen, ok := c.get("m1", "k")
+		assert.False(t, ok)
+		c.put("m1", "k", gen, results)
+
+		got, _, ok := c.get("m1", "k")
+		require.True(t, ok)
+		assert.Equal(t, results, got)
+
+		now = now.Add(time.Minute)
+		_, _, ok = c.get("m1", "k")
+		assert.False(t, ok)
+	})
+
+	t.Run("invalidate is scoped to mission", func(t *testing.T) {
+		c := newQueryCache(QueryCacheOptions{})
+		c.put("m1", "k1", 0, results)
+		c.put("m2", "k2", 0, results)
+
+		c.invalidate("m1")
+
+		_, _, ok := c.get("m1", "k1")
+		assert.False(t, ok)
+		_, _, ok = c.get("m2", "k2")
+		assert.True(t, ok)
+	})
+
+	t.Run("store during query", func(t *testing.T) {
+		c := newQueryCache(QueryCacheOptions{})
+		_, gen, _ := c.get("m1", "k")
+
+		// A store completes while the query is in flight
+		c.invalidate("m1")
+		c.put("m1", "k", gen, results)
+
+		_, _, ok := c.get("m1", "k")
+		assert.False(t, ok, "result read before the store must not be cached")
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		now := time.Unix(1000, 0)
+		c := newQueryCache(QueryCacheOptions{TTL: time.Minute, MaxEntries: 2})
+		c.now = func() time.Time { return now }
+
+		c.put("m1", "k1", 0, results)
+		now = now.Add(time.Second)
+		c.put("m1", "k2", 0, results)
+		now = now.Add(time.Second)
+		c.put("m1", "k3", 0, results)
+
+		assert.Len(t, c.entries, 2)
+		_, _, ok := c.get("m1", "k1")
+		assert.False(t, ok, "entry closest to expiry is evicted")
+		_, _, ok = c.get("m1", "k3")
+		assert.True(t, ok)
+	})
+}
+
+// graphQueryCountingServer counts GraphRAG queries and accepts stores.
+// While block is set, queries wait for it to be closed.
+type graphQueryCountingServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+	queries atomic.Int32
+	started chan struct{}
+	block   chan struct{}
+}
+
+func (s *graphQueryCountingServer) GraphRAGQuery(ctx context.Context, req *proto.GraphRAGQueryRequest) (*proto.GraphRAGQueryResponse, error) {
+	n := s.queries.Add(1)
+	if s.block != nil {
+		s.started <- struct{}{}
+		<-s.block
+	}
+	return &proto.GraphRAGQueryResponse{
+		Results: []*proto.GraphRAGResult{{
+			Node:  &proto.GraphNode{Id: "host-1", Type: "host"},
+			Score: float64(n),
+		}},
+	}, nil
+}
+
+func (s *graphQueryCountingServer) StoreGraphNode(ctx context.Context, req *proto.StoreGraphNodeRequest) (*proto.StoreGraphNodeResponse, error) {
+	return &proto.StoreGraphNodeResponse{NodeId: "node-1"}, nil
+}
+
+func (s *graphQueryCountingServer) CreateGraphRelationship(ctx context.Context, req *proto.CreateGraphRelationshipRequest) (*proto.CreateGraphRelationshipResponse, error) {
+	return &proto.CreateGraphRelationshipResponse{}, nil
+}
+
+func (s *graphQueryCountingServer) StoreGraphBatch(ctx context.Context, req *proto.StoreGraphBatchRequest) (*proto.StoreGraphBatchResponse, error) {
+	return &proto.StoreGraphBatchResponse{}, nil
+}
+
+func (s *graphQueryCountingServer) SubmitFinding(ctx context.Context, req *proto.SubmitFindingRequest) (*proto.SubmitFindingResponse, error) {
+	return &proto.SubmitFindingResponse{}, nil
+}
+
+func (s *graphQueryCountingServer) DelegateToAgent(ctx context.Context, req *proto.DelegateToAgentRequest) (*proto.DelegateToAgentResponse, error) {
+	return &proto.DelegateToAgentResponse{
+		Result: &proto.Result{Status: proto.ResultStatus_RESULT_STATUS_SUCCESS},
+	}, nil
+}
+
+func TestCallbackHarness_QueryCache(t *testing.T) {
+	mission := func(id string) context.Context {
+		return WithExecutionContext(context.Background(), ExecutionContext{MissionID: id})
+	}
+	query := graphrag.Query{Text: "exposed services", TopK: 5}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		srv := &graphQueryCountingServer{}
+		harness := newFakeCallbackHarness(t, srv)
+
+		for i := 0; i < 2; i++ {
+			_, err := harness.QueryGraphRAG(mission("m1"), query)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), srv.queries.Load())
+	})
+
+	t.Run("repeated query is cached per mission", func(t *testing.T) {
+		srv := &graphQueryCountingServer{}
+		harness := newFakeCallbackHarnessWithOptions(t, srv, HarnessOptions{QueryCache: &QueryCacheOptions{}})
+
+		first, err := harness.QueryGraphRAG(mission("m1"), query)
+		require.NoError(t, err)
+		second, err := harness.QueryGraphRAG(mission("m1"), query)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), srv.queries.Load())
+		assert.Equal(t, first, second)
+
+		_, err = harness.QueryGraphRAG(mission("m1"), graphrag.Query{Text: "exposed services", TopK: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), srv.queries.Load(), "different query")
+
+		_, err = harness.QueryGraphRAG(mission("m2"), query)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), srv.queries.Load(), "different mission")
+	})
+
+	stores := map[string]func(ctx context.Context, h *CallbackHarness) error{
+		"StoreGraphNode": func(ctx context.Context, h *CallbackHarness) error {
+			_, err := h.StoreGraphNode(ctx, graphrag.GraphNode{Type: "host"})
+			return err
+		},
+		"CreateGraphRelationship": func(ctx context.Context, h *CallbackHarness) error {
+			return h.CreateGraphRelationship(ctx, graphrag.Relationship{FromID: "a", ToID: "b", Type: "HAS_PORT"})
+		},
+		"StoreGraphBatch": func(ctx context.Context, h *CallbackHarness) error {
+			_, err := h.StoreGraphBatch(ctx, graphrag.Batch{})
+			return err
+		},
+		"SubmitFinding": func(ctx context.Context, h *CallbackHarness) error {
+			return h.SubmitFinding(ctx, &finding.Finding{Title: "open port"})
+		},
+		"DelegateToAgent": func(ctx context.Context, h *CallbackHarness) error {
+			_, err := h.DelegateToAgent(ctx, "port-scanner", agent.Task{ID: "task-1"})
+			return err
+		},
+	}
+	for name, store := range stores {
+		t.Run(name+" invalidates", func(t *testing.T) {
+			srv := &graphQueryCountingServer{}
+			harness := newFakeCallbackHarnessWithOptions(t, srv, HarnessOptions{QueryCache: &QueryCacheOptions{}})
+
+			_, err := harness.QueryGraphRAG(mission("m1"), query)
+			require.NoError(t, err)
+			_, err = harness.QueryGraphRAG(mission("m2"), query)
+			require.NoError(t, err)
+
+			require.NoError(t, store(mission("m1"), harness))
+
+			results, err := harness.QueryGraphRAG(mission("m1"), query)
+			require.NoError(t, err)
+			assert.Equal(t, int32(3), srv.queries.Load())
+			assert.Equal(t, float64(3), results[0].Score, "fresh result")
+
+			_, err = harness.QueryGraphRAG(mission("m2"), query)
+			require.NoError(t, err)
+			assert.Equal(t, int32(3), srv.queries.Load(), "other missions stay cached")
+		})
+	}
+
+	t.Run("store during query", func(t *testing.T) {
+		srv := &graphQueryCountingServer{started: make(chan struct{}), block: make(chan struct{})}
+		harness := newFakeCallbackHarnessWithOptions(t, srv, HarnessOptions{QueryCache: &QueryCacheOptions{}})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := harness.QueryGraphRAG(mission("m1"), query)
+			assert.NoError(t, err)
+		}()
+
+		<-srv.started
+		_, err := harness.StoreGraphNode(mission("m1"), graphrag.GraphNode{Type: "host"})
+		require.NoError(t, err)
+		close(srv.block)
+		wg.Wait()
+
+		srv.block = nil
+		_, err = harness.QueryGraphRAG(mission("m1"), query)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), srv.queries.Load(), "result of the in-flight query is not cached")
+	})
+}
+
+func TestWithQueryCache(t *testing.T) {
+	cfg := DefaultConfig()
+	WithQueryCache(QueryCacheOptions{TTL: 30 * time.Second})(cfg)
+
+	require.NotNil(t, cfg.Harness.QueryCache)
+	assert.Equal(t, 30*time.Second, cfg.Harness.QueryCache.TTL)
+}
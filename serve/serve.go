@@ -8,9 +8,12 @@ import (
 	"os/signal"
 	"path/filepath"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
@@ -66,6 +69,37 @@ type Config struct {
 		Deregister(ctx context.Context, info interface{}) error
 		Close() error
 	}
+
+	// MaxConcurrentTasks limits how many Execute calls an agent server runs
+	// at once. Calls beyond the limit fail with codes.ResourceExhausted.
+	// Default: 0 (unlimited)
+	MaxConcurrentTasks int
+
+	// SkipTargetValidation disables the check of each task's target against
+	// the agent's declared target schemas before the agent runs.
+	SkipTargetValidation bool
+
+	// Harness configures the callback harness created for each task.
+	Harness HarnessOptions
+
+	// DisableReflection turns off gRPC server reflection, which servers
+	// hosting a tool enable by default so clients can discover the tool's
+	// services and message types.
+	DisableReflection bool
+
+	// UnaryInterceptors and StreamInterceptors run, in order, on every call
+	// to the server's components.
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
+
+	// LifecycleEvents, if set, receives the server's lifecycle events in
+	// place of the channel the server would otherwise create. See
+	// Server.Events.
+	LifecycleEvents chan LifecycleEvent
+
+	// Tracer, if set, records lifecycle events as events of a span that
+	// lasts while the server runs.
+	Tracer trace.Tracer
 }
 
 // DefaultConfig returns default serve configuration.
@@ -124,6 +158,18 @@ type Server struct {
 	config         *Config
 	healthServer   *health.Server
 	unixSocketPath string // Path to Unix socket for cleanup
+
+	// Components hosted by the server
+	componentsMu sync.Mutex
+	components   []*component
+	serving      bool
+	agentSvc     *agentServiceServer
+
+	// Lifecycle events
+	events        chan LifecycleEvent
+	droppedEvents atomic.Uint64
+	lifecycleMu   sync.Mutex
+	lifecycleSpan trace.Span
 }
 
 // NewServer creates a new gRPC server with the provided configuration.
@@ -192,6 +238,14 @@ func NewServer(cfg *Config) (*Server, error) {
 		opts = append(opts, grpc.Creds(creds))
 	}
 
+	// Chain interceptors shared by all components
+	if len(cfg.UnaryInterceptors) > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(cfg.UnaryInterceptors...))
+	}
+	if len(cfg.StreamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(cfg.StreamInterceptors...))
+	}
+
 	// Create gRPC server
 	grpcServer := grpc.NewServer(opts...)
 
@@ -199,6 +253,11 @@ func NewServer(cfg *Config) (*Server, error) {
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 
+	events := cfg.LifecycleEvents
+	if events == nil {
+		events = make(chan LifecycleEvent, lifecycleEventBuffer)
+	}
+
 	return &Server{
 		grpcServer:     grpcServer,
 		listener:       listener,
@@ -206,6 +265,7 @@ func NewServer(cfg *Config) (*Server, error) {
 		config:         cfg,
 		healthServer:   healthServer,
 		unixSocketPath: unixSocketPath,
+		events:         events,
 	}, nil
 }
 
@@ -225,7 +285,22 @@ func (s *Server) HealthServer() *health.Server {
 // It handles graceful shutdown on SIGINT/SIGTERM signals.
 // The context can be used to initiate shutdown programmatically.
 // When LocalMode is enabled, the server listens on both TCP and Unix socket.
-func (s *Server) Serve(ctx context.Context) error {
+//
+// Registered components are announced to the configured registry when
+// serving starts and deregistered when Serve returns. State transitions are
+// reported on Events.
+func (s *Server) Serve(ctx context.Context) (err error) {
+	s.startLifecycleSpan(ctx)
+	s.startComponents()
+	defer func() {
+		s.stopComponents()
+		stopErr := err
+		if ctx.Err() != nil && err == ctx.Err() {
+			stopErr = nil
+		}
+		s.emit(LifecycleEvent{Type: LifecycleStopped, Err: stopErr})
+	}()
+
 	// Create error channel for serve errors (buffer size 2 for TCP and Unix listeners)
 	errCh := make(chan error, 2)
 
@@ -245,9 +320,13 @@ func (s *Server) Serve(ctx context.Context) error {
 		}()
 	}
 
+	s.emit(LifecycleEvent{Type: LifecycleStarted, Addr: s.listener.Addr().String()})
+	s.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+
 	// Setup signal handling for graceful shutdown
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
 
 	// Wait for shutdown signal, context cancellation, or error
 	select {
@@ -276,9 +355,13 @@ func (s *Server) Stop() {
 }
 
 // GracefulStop gracefully stops the gRPC server.
-// It stops accepting new connections and waits for active RPCs
-// to complete within the configured timeout period.
+// It marks every service NOT_SERVING, stops accepting new connections, and
+// waits for active RPCs to complete within the configured timeout period.
 func (s *Server) GracefulStop() {
+	s.emit(LifecycleEvent{Type: LifecycleDraining})
+	s.healthServer.Shutdown()
+	s.emit(LifecycleEvent{Type: LifecycleHealthChanged, Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING})
+
 	// Create a timeout context for graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), s.config.GracefulTimeout)
 	defer cancel()
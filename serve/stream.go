@@ -54,6 +54,12 @@ type StreamingAgent interface {
 func (s *agentServiceServer) StreamExecute(stream proto.AgentService_StreamExecuteServer) error {
 	ctx := stream.Context()
 
+	release, err := s.acquireSlot()
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	// Wait for the initial StartExecutionRequest
 	firstMsg, err := stream.Recv()
 	if err != nil {
@@ -68,6 +74,10 @@ func (s *agentServiceServer) StreamExecute(stream proto.AgentService_StreamExecu
 		return status.Error(codes.InvalidArgument, "first message must be StartExecutionRequest")
 	}
 
+	if err := s.validateTarget(startReq.Start.Target); err != nil {
+		return err
+	}
+
 	// Parse task from proto
 	task := ProtoToTask(startReq.Start.Task)
 
@@ -236,7 +246,7 @@ func (s *agentServiceServer) StreamExecute(stream proto.AgentService_StreamExecu
 		if sh, ok := streamingHarness.(concreteStreaming); ok {
 			ctx := context.Background()
 			traceID, spanID := sh.getTraceInfo(ctx)
-			errMsg := BuildErrorEvent("EXECUTION_ERROR", execErr.Error(), true, sh.nextSequence(), traceID, spanID)
+			errMsg := BuildErrorEvent(agent.ErrCodeInternal, execErr.Error(), true, sh.nextSequence(), traceID, spanID)
 			if emitErr := sh.send(errMsg); emitErr != nil {
 				// Log but don't fail the RPC
 			}
@@ -286,20 +296,9 @@ func (s *agentServiceServer) StreamExecute(stream proto.AgentService_StreamExecu
 func (s *agentServiceServer) createStreamingHarness(ctx context.Context, req *proto.StartExecutionRequest) (agent.Harness, func(), error) {
 	// Check if callback endpoint is provided
 	if req.CallbackEndpoint != "" {
-		// Create callback client
-		var opts []CallbackClientOption
-		if req.CallbackToken != "" {
-			opts = append(opts, WithCallbackToken(req.CallbackToken))
-		}
-
-		client, err := NewCallbackClient(req.CallbackEndpoint, opts...)
+		shared, err := s.callbackClient(ctx, req.CallbackEndpoint, req.CallbackToken)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to create callback client: %w", err)
-		}
-
-		// Connect to orchestrator
-		if err := client.Connect(ctx); err != nil {
-			return nil, nil, fmt.Errorf("failed to connect to orchestrator: %w", err)
+			return nil, nil, err
 		}
 
 		// Parse mission context if provided
@@ -318,10 +317,18 @@ func (s *agentServiceServer) createStreamingHarness(ctx context.Context, req *pr
 		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelInfo}))
 		tracer := noop.NewTracerProvider().Tracer("callback-harness")
 
+		// Scope callback requests to this task on the shared connection
+		task := ProtoToTask(req.Task)
+		client := shared.ForTask(TaskContextParams{
+			TaskID:    task.ID,
+			AgentName: s.agent.Name(),
+			MissionID: mission.ID,
+		})
+
 		// Create callback harness
-		harness := NewCallbackHarness(client, logger, tracer, mission, target)
+		harness := s.newHarness(client, logger, tracer, mission, target)
 
-		// Return harness with cleanup function that closes the client
+		// Return harness with cleanup function that releases the client view
 		cleanup := func() {
 			if err := client.Close(); err != nil {
 				logger.Error("failed to close callback client", "error", err)
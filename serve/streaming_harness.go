@@ -341,6 +341,15 @@ func (h *streamingHarness) Complete(ctx context.Context, slot string, messages [
 	return resp, nil
 }
 
+// RecordReasoning emits the agent's reasoning as a reasoning output event and
+// forwards it to the underlying harness, if that records reasoning.
+func (h *streamingHarness) RecordReasoning(ctx context.Context, text string) {
+	if err := h.EmitOutput(text, true); err != nil {
+		h.logger.Warn("failed to emit reasoning event", "error", err)
+	}
+	agent.RecordReasoning(ctx, h.Harness, text)
+}
+
 // Stream overrides the base harness Stream to emit output events for each chunk
 func (h *streamingHarness) Stream(ctx context.Context, slot string, messages []llm.Message) (<-chan llm.StreamChunk, error) {
 	// Get the underlying stream channel
@@ -201,6 +201,16 @@ func (m *mockStreamHarness) TokenUsage() llm.TokenTracker {
 	return llm.NewTokenTracker()
 }
 
+func (m *mockStreamHarness) QueueToolWork(ctx context.Context, toolName string, inputs []protolib.Message) (string, error) {
+	return "", nil
+}
+
+func (m *mockStreamHarness) ToolResults(ctx context.Context, jobID string) <-chan agent.QueuedToolResult {
+	ch := make(chan agent.QueuedToolResult)
+	close(ch)
+	return ch
+}
+
 func (m *mockStreamHarness) QueryNodes(ctx context.Context, query *graphragpb.GraphQuery) ([]*graphragpb.QueryResult, error) {
 	return nil, nil
 }
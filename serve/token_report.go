@@ -0,0 +1,255 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/llm"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Token report defaults.
+const (
+	defaultTokenReportInterval = 30 * time.Second
+	tokenReportInitialBackoff  = time.Second
+	tokenReportTimeout         = 10 * time.Second
+)
+
+// TokenReportOptions configures the token usage reports a callback harness
+// sends to the orchestrator, so the usage of a task is accounted for even if
+// the agent crashes before the task ends.
+//
+// Reports carry cumulative usage, not deltas, so a failed report is simply
+// superseded by the next one. Reports are sent in the background and never
+// block LLM calls.
+type TokenReportOptions struct {
+	// Interval is how often usage is reported while it changes. Zero uses
+	// 30 seconds.
+	Interval time.Duration
+
+	// Thresholds are total token counts, typically fractions of a budget,
+	// that are reported as soon as usage crosses them instead of at the
+	// next interval.
+	Thresholds []int
+
+	// MaxBackoff bounds the delay between retries of a failed report, which
+	// starts at one second and doubles. Zero uses Interval.
+	MaxBackoff time.Duration
+}
+
+// tokenReporter sends the usage recorded by a CallbackTokenTracker to the
+// orchestrator.
+type tokenReporter struct {
+	tracker    *CallbackTokenTracker
+	send       func(ctx context.Context, req *proto.ReportTokenUsageRequest) error
+	interval   time.Duration
+	maxBackoff time.Duration
+	thresholds []int
+	logger     *slog.Logger
+
+	kick     chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+
+	// sendMu serializes reports, so sequences reach the orchestrator in
+	// order; reported is the tracker version last reported
+	sendMu   sync.Mutex
+	reported uint64
+
+	// disabled is set when the orchestrator does not implement reports
+	disabled atomic.Bool
+}
+
+// startReporting starts reporting the tracker's usage through send and
+// returns the reporter, which must be stopped with shutdown.
+func (t *CallbackTokenTracker) startReporting(send func(context.Context, *proto.ReportTokenUsageRequest) error, opts TokenReportOptions, logger *slog.Logger) *tokenReporter {
+	r := &tokenReporter{
+		tracker:    t,
+		send:       send,
+		interval:   opts.Interval,
+		maxBackoff: opts.MaxBackoff,
+		thresholds: append([]int(nil), opts.Thresholds...),
+		logger:     logger,
+		kick:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	if r.interval <= 0 {
+		r.interval = defaultTokenReportInterval
+	}
+	if r.maxBackoff <= 0 {
+		r.maxBackoff = r.interval
+	}
+	sort.Ints(r.thresholds)
+
+	t.mu.Lock()
+	t.reporter = r
+	t.session = uuid.NewString()
+	t.sequence = 0
+	t.mu.Unlock()
+
+	go r.run()
+	return r
+}
+
+// snapshot returns a report of the tracker's usage and the version it
+// reflects, assigning it the next sequence of the session.
+func (t *CallbackTokenTracker) snapshot() (*proto.ReportTokenUsageRequest, uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.sequence++
+	req := &proto.ReportTokenUsageRequest{
+		SessionId: t.session,
+		Sequence:  t.sequence,
+		Slots:     make(map[string]*proto.TokenUsage, len(t.slots)),
+		Total:     toProtoTokenUsage(t.total),
+	}
+	for slot, usage := range t.slots {
+		req.Slots[slot] = toProtoTokenUsage(usage)
+	}
+	return req, t.version
+}
+
+// currentVersion returns the tracker's version.
+func (t *CallbackTokenTracker) currentVersion() uint64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.version
+}
+
+// toProtoTokenUsage converts usage to its proto form.
+func toProtoTokenUsage(usage llm.TokenUsage) *proto.TokenUsage {
+	return &proto.TokenUsage{
+		InputTokens:  int32(usage.InputTokens),
+		OutputTokens: int32(usage.OutputTokens),
+		TotalTokens:  int32(usage.TotalTokens),
+	}
+}
+
+// crossesThreshold reports whether a total going from previous to current
+// reaches a threshold.
+func (r *tokenReporter) crossesThreshold(previous, current int) bool {
+	i := sort.SearchInts(r.thresholds, previous+1)
+	return i < len(r.thresholds) && r.thresholds[i] <= current
+}
+
+// trigger requests an immediate report without blocking.
+func (r *tokenReporter) trigger() {
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+}
+
+// run reports usage every interval and when triggered, retrying failed
+// reports with backoff, until the reporter is stopped.
+func (r *tokenReporter) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	var retry <-chan time.Time
+	var backoff time.Duration
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		case <-r.kick:
+		case <-retry:
+		}
+
+		retry = nil
+		err := r.flush(context.Background(), false)
+		if err == nil {
+			backoff = 0
+			continue
+		}
+		if r.disabled.Load() {
+			return
+		}
+		backoff = nextTokenReportBackoff(backoff, r.maxBackoff)
+		r.logger.Debug("failed to report token usage, retrying", "error", err, "backoff", backoff)
+		retry = time.After(backoff)
+	}
+}
+
+// nextTokenReportBackoff doubles backoff, starting at one second and
+// bounded by maxBackoff.
+func nextTokenReportBackoff(backoff, maxBackoff time.Duration) time.Duration {
+	if backoff == 0 {
+		backoff = tokenReportInitialBackoff
+	} else {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// flush reports the tracker's usage if it changed since the last report.
+// A final report is sent regardless, unless no usage was ever recorded.
+func (r *tokenReporter) flush(ctx context.Context, final bool) error {
+	if r.disabled.Load() {
+		return nil
+	}
+
+	r.sendMu.Lock()
+	defer r.sendMu.Unlock()
+
+	version := r.tracker.currentVersion()
+	if version == r.reported && (!final || version == 0) {
+		return nil
+	}
+
+	req, version := r.tracker.snapshot()
+	req.Final = final
+
+	ctx, cancel := context.WithTimeout(ctx, tokenReportTimeout)
+	defer cancel()
+	if err := r.send(ctx, req); err != nil {
+		if status.Code(err) == grpccodes.Unimplemented {
+			r.disabled.Store(true)
+			r.logger.Info("orchestrator does not accept token usage reports - reporting disabled")
+		}
+		return err
+	}
+	r.reported = version
+	return nil
+}
+
+// shutdown stops periodic reporting and sends a final report, retrying
+// with backoff until it succeeds or ctx is done.
+func (r *tokenReporter) shutdown(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		return fmt.Errorf("final token usage report: %w", ctx.Err())
+	}
+
+	var backoff time.Duration
+	for {
+		err := r.flush(ctx, true)
+		if err == nil || r.disabled.Load() {
+			return nil
+		}
+
+		backoff = nextTokenReportBackoff(backoff, r.maxBackoff)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("final token usage report: %w", errors.Join(err, ctx.Err()))
+		case <-time.After(backoff):
+		}
+	}
+}
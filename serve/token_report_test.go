@@ -0,0 +1,238 @@
+package serve
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/llm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tokenReportServer accounts token usage reports as the orchestrator does:
+// it keeps the report with the highest sequence per session and sums the
+// sessions of a task.
+type tokenReportServer struct {
+	proto.UnimplementedHarnessCallbackServiceServer
+
+	mu       sync.Mutex
+	sessions map[string]*proto.ReportTokenUsageRequest
+	calls    int
+	final    bool
+
+	// totals records the task total after every report
+	totals []int32
+
+	// failures is the number of reports to reject before accepting them
+	failures int
+
+	// block, if set, delays every report until it is closed
+	block chan struct{}
+}
+
+func (s *tokenReportServer) ReportTokenUsage(ctx context.Context, req *proto.ReportTokenUsageRequest) (*proto.ReportTokenUsageResponse, error) {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.failures > 0 {
+		s.failures--
+		return nil, status.Error(codes.Unavailable, "daemon restarting")
+	}
+
+	if s.sessions == nil {
+		s.sessions = make(map[string]*proto.ReportTokenUsageRequest)
+	}
+	if prev, ok := s.sessions[req.SessionId]; !ok || req.Sequence > prev.Sequence {
+		s.sessions[req.SessionId] = req
+	}
+	s.final = s.final || req.Final
+	s.totals = append(s.totals, s.totalLocked())
+	return &proto.ReportTokenUsageResponse{}, nil
+}
+
+func (s *tokenReportServer) totalLocked() int32 {
+	var total int32
+	for _, req := range s.sessions {
+		total += req.GetTotal().GetTotalTokens()
+	}
+	return total
+}
+
+func (s *tokenReportServer) total() int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.totalLocked()
+}
+
+func (s *tokenReportServer) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func tokens(n int) llm.TokenUsage {
+	return llm.TokenUsage{InputTokens: n - 1, OutputTokens: 1, TotalTokens: n}
+}
+
+func TestTokenReport_Periodic(t *testing.T) {
+	fake := &tokenReportServer{}
+	harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+		TokenReport: &TokenReportOptions{Interval: 20 * time.Millisecond},
+	})
+
+	harness.TokenUsage().Add("primary", tokens(100))
+	harness.TokenUsage().Add("fast", tokens(20))
+	require.Eventually(t, func() bool { return fake.total() == 120 }, 2*time.Second, 10*time.Millisecond)
+
+	fake.mu.Lock()
+	var report *proto.ReportTokenUsageRequest
+	for _, req := range fake.sessions {
+		report = req
+	}
+	fake.mu.Unlock()
+	assert.Equal(t, int32(100), report.Slots["primary"].GetTotalTokens())
+	assert.Equal(t, int32(20), report.Slots["fast"].GetTotalTokens())
+	assert.False(t, report.Final)
+
+	// unchanged usage is not reported again
+	calls := fake.callCount()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, calls, fake.callCount())
+
+	require.NoError(t, harness.Shutdown(context.Background()))
+}
+
+func TestTokenReport_Threshold(t *testing.T) {
+	fake := &tokenReportServer{}
+	harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+		TokenReport: &TokenReportOptions{Interval: time.Hour, Thresholds: []int{100, 1000}},
+	})
+
+	harness.TokenUsage().Add("primary", tokens(60))
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, fake.callCount(), "below every threshold, usage waits for the interval")
+
+	harness.TokenUsage().Add("primary", tokens(50))
+	require.Eventually(t, func() bool { return fake.total() == 110 }, 2*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, harness.Shutdown(context.Background()))
+}
+
+func TestTokenReport_FinalOnShutdown(t *testing.T) {
+	fake := &tokenReportServer{}
+	harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+		TokenReport: &TokenReportOptions{Interval: time.Hour},
+	})
+
+	harness.TokenUsage().Add("primary", tokens(42))
+	require.NoError(t, harness.Shutdown(context.Background()))
+
+	assert.Equal(t, int32(42), fake.total())
+	assert.True(t, fake.final)
+	assert.Equal(t, 1, fake.callCount())
+}
+
+func TestTokenReport_ShutdownWithoutUsage(t *testing.T) {
+	fake := &tokenReportServer{}
+	harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+		TokenReport: &TokenReportOptions{Interval: time.Hour},
+	})
+
+	require.NoError(t, harness.Shutdown(context.Background()))
+	assert.Zero(t, fake.callCount())
+}
+
+func TestTokenReport_RetriesWithoutBlocking(t *testing.T) {
+	fake := &tokenReportServer{failures: 3, block: make(chan struct{})}
+	harness := newFakeCallbackHarnessWithOptions(t, fake, HarnessOptions{
+		TokenReport: &TokenReportOptions{Interval: time.Hour, Thresholds: []int{10}, MaxBackoff: 10 * time.Millisecond},
+	})
+
+	// the first report is stuck in the orchestrator; recording usage must
+	// not wait for it
+	added := make(chan struct{})
+	go func() {
+		for i := 0; i < 1000; i++ {
+			harness.TokenUsage().Add("primary", tokens(1))
+		}
+		close(added)
+	}()
+	select {
+	case <-added:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Add blocked on a pending report")
+	}
+
+	close(fake.block)
+	require.Eventually(t, func() bool { return fake.total() == 1000 }, 2*time.Second, 10*time.Millisecond)
+	assert.GreaterOrEqual(t, fake.callCount(), 4, "three failed reports are retried")
+
+	require.NoError(t, harness.Shutdown(context.Background()))
+}
+
+func TestTokenReport_MonotonicAcrossRestarts(t *testing.T) {
+	fake := &tokenReportServer{}
+	opts := HarnessOptions{TokenReport: &TokenReportOptions{Interval: 10 * time.Millisecond}}
+
+	// the first agent process reports and crashes without a final report
+	crashed := newFakeCallbackHarnessWithOptions(t, fake, opts)
+	crashed.TokenUsage().Add("primary", tokens(100))
+	require.Eventually(t, func() bool { return fake.total() == 100 }, 2*time.Second, 10*time.Millisecond)
+	crashed.TokenUsage().Add("primary", tokens(50))
+	require.Eventually(t, func() bool { return fake.total() == 150 }, 2*time.Second, 10*time.Millisecond)
+
+	fake.mu.Lock()
+	var stale *proto.ReportTokenUsageRequest
+	for _, req := range fake.sessions {
+		stale = &proto.ReportTokenUsageRequest{SessionId: req.SessionId, Sequence: 1, Total: &proto.TokenUsage{TotalTokens: 100}}
+	}
+	fake.mu.Unlock()
+
+	// the restarted agent counts from zero in a new session
+	restarted := newFakeCallbackHarnessWithOptions(t, fake, opts)
+	restarted.TokenUsage().Add("primary", tokens(30))
+	require.Eventually(t, func() bool { return fake.total() == 180 }, 2*time.Second, 10*time.Millisecond)
+
+	// a delayed duplicate of an early report does not lower the total
+	_, err := fake.ReportTokenUsage(context.Background(), stale)
+	require.NoError(t, err)
+	assert.Equal(t, int32(180), fake.total())
+
+	// resetting the tracker keeps what was already reported
+	restarted.TokenUsage().Reset()
+	restarted.TokenUsage().Add("primary", tokens(5))
+	require.NoError(t, restarted.Shutdown(context.Background()))
+	assert.Equal(t, int32(185), fake.total())
+
+	fake.mu.Lock()
+	totals := append([]int32(nil), fake.totals...)
+	fake.mu.Unlock()
+	for i := 1; i < len(totals); i++ {
+		assert.GreaterOrEqual(t, totals[i], totals[i-1], "task total decreased: %v", totals)
+	}
+
+	require.NoError(t, crashed.Shutdown(context.Background()))
+}
+
+func TestTokenReport_Unimplemented(t *testing.T) {
+	harness := newFakeCallbackHarnessWithOptions(t, &proto.UnimplementedHarnessCallbackServiceServer{}, HarnessOptions{
+		TokenReport: &TokenReportOptions{Interval: 10 * time.Millisecond},
+	})
+
+	harness.TokenUsage().Add("primary", tokens(10))
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, harness.Shutdown(context.Background()), "an orchestrator without reports is not an error")
+}
@@ -3,20 +3,18 @@ package serve
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log/slog"
 	"os"
-	"strings"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/zero-day-ai/sdk/api/gen/proto"
 	"github.com/zero-day-ai/sdk/enum"
 	"github.com/zero-day-ai/sdk/tool"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	protolib "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 )
@@ -92,100 +90,13 @@ func isSubprocessMode() bool {
 // serveToolGRPC starts the tool as a gRPC server.
 // This is the traditional mode where the tool listens on a port and handles RPC requests.
 func serveToolGRPC(t tool.Tool, opts ...Option) error {
-	// Build configuration
-	cfg := DefaultConfig()
-	for _, opt := range opts {
-		opt(cfg)
-	}
-
-	// Create server
-	srv, err := NewServer(cfg)
+	srv, err := New(opts...)
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
-
-	// Create and register tool service
-	toolSvc := &toolServiceServer{
-		tool: t,
+	if err := srv.RegisterTool(t); err != nil {
+		return err
 	}
-	proto.RegisterToolServiceServer(srv.GRPCServer(), toolSvc)
-
-	// Set health status to serving
-	srv.HealthServer().SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
-
-	slog.Info("tool server started", "component", "tool", "name", t.Name(), "version", t.Version(), "port", srv.Port())
-
-	// Register with registry if configured
-	var serviceInfo interface{}
-	if cfg.Registry != nil {
-		// Build endpoint based on LocalMode, AdvertiseAddr, or TCP
-		endpoint := ""
-		if cfg.LocalMode != "" {
-			endpoint = fmt.Sprintf("unix://%s", cfg.LocalMode)
-		} else if cfg.AdvertiseAddr != "" {
-			// Use advertise address - append port if not present
-			if strings.Contains(cfg.AdvertiseAddr, ":") {
-				endpoint = cfg.AdvertiseAddr
-			} else {
-				endpoint = fmt.Sprintf("%s:%d", cfg.AdvertiseAddr, srv.Port())
-			}
-		} else {
-			endpoint = fmt.Sprintf("localhost:%d", srv.Port())
-		}
-
-		// Extract tool metadata
-		metadata := map[string]string{
-			"description": t.Description(),
-		}
-
-		// Add tags if available
-		if len(t.Tags()) > 0 {
-			metadata["tags"] = strings.Join(t.Tags(), ",")
-		}
-
-		// Add proto message types
-		metadata["input_message_type"] = t.InputMessageType()
-		metadata["output_message_type"] = t.OutputMessageType()
-
-		// Capture capabilities if the tool implements CapabilityProvider
-		capCtx := context.Background()
-		if caps := tool.GetCapabilities(capCtx, t); caps != nil {
-			// Serialize capabilities to JSON for storage in metadata
-			if capsJSON, err := json.Marshal(caps); err == nil {
-				metadata["capabilities"] = string(capsJSON)
-			} else {
-				slog.Warn("failed to serialize tool capabilities", "error", err, "tool", t.Name())
-			}
-		}
-
-		// Create ServiceInfo struct (using map to avoid circular dependency)
-		serviceInfo = map[string]interface{}{
-			"kind":        "tool",
-			"name":        t.Name(),
-			"version":     t.Version(),
-			"instance_id": uuid.New().String(),
-			"endpoint":    endpoint,
-			"metadata":    metadata,
-			"started_at":  time.Now(),
-		}
-
-		// Register with the registry
-		ctx := context.Background()
-		if err := cfg.Registry.Register(ctx, serviceInfo); err != nil {
-			slog.Warn("failed to register with registry", "error", err, "endpoint", endpoint, "component", "tool", "name", t.Name())
-		} else {
-			slog.Info("registered with registry", "endpoint", endpoint, "component", "tool", "name", t.Name())
-			// Deregister on shutdown
-			defer func() {
-				ctx := context.Background()
-				if err := cfg.Registry.Deregister(ctx, serviceInfo); err != nil {
-					slog.Warn("failed to deregister from registry", "error", err, "endpoint", endpoint, "component", "tool", "name", t.Name())
-				}
-			}()
-		}
-	}
-
-	// Start serving
 	return srv.Serve(context.Background())
 }
 
@@ -197,8 +108,13 @@ type toolServiceServer struct {
 }
 
 // GetDescriptor returns the tool's descriptor including name, version,
-// description, and tags. Input/output schemas are left empty as tools now use proto messages.
+// description, tags and examples. Input/output schemas are left empty as tools now use proto messages.
 func (s *toolServiceServer) GetDescriptor(ctx context.Context, req *proto.ToolGetDescriptorRequest) (*proto.ToolDescriptor, error) {
+	examples, err := toolExamplesToProto(tool.ToDescriptor(s.tool).Examples)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode examples: %v", err)
+	}
+
 	return &proto.ToolDescriptor{
 		Name:        s.tool.Name(),
 		Description: s.tool.Description(),
@@ -208,9 +124,66 @@ func (s *toolServiceServer) GetDescriptor(ctx context.Context, req *proto.ToolGe
 		// Clients should use InputMessageType() and OutputMessageType() instead
 		InputSchema:  &proto.JSONSchema{Json: "{}"},
 		OutputSchema: &proto.JSONSchema{Json: "{}"},
+		Examples:     examples,
 	}, nil
 }
 
+// DescribeTool returns the tool's descriptor together with the serialized
+// proto descriptors of its input and output messages, so that clients can
+// construct requests and decode responses without the tool's generated code.
+// The descriptor set is empty if the message types are not registered.
+func (s *toolServiceServer) DescribeTool(ctx context.Context, req *proto.ToolDescribeRequest) (*proto.ToolDescription, error) {
+	descriptor, err := s.GetDescriptor(ctx, &proto.ToolGetDescriptorRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ToolDescription{
+		Tool:              descriptor,
+		InputMessageType:  s.tool.InputMessageType(),
+		OutputMessageType: s.tool.OutputMessageType(),
+	}
+
+	set, err := tool.FileDescriptorSet(s.tool)
+	if err != nil {
+		// Tools whose messages are not registered still describe themselves
+		return resp, nil
+	}
+	resp.FileDescriptorSet, err = protolib.Marshal(set)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode file descriptor set: %v", err)
+	}
+	return resp, nil
+}
+
+// toolExamplesToProto converts tool examples to their wire form.
+func toolExamplesToProto(examples []tool.ToolExample) ([]*proto.ToolExample, error) {
+	if len(examples) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*proto.ToolExample, len(examples))
+	for i, ex := range examples {
+		input, err := json.Marshal(ex.Input)
+		if err != nil {
+			return nil, fmt.Errorf("example %d input: %w", i, err)
+		}
+		pb := &proto.ToolExample{
+			Description: ex.Description,
+			InputJson:   string(input),
+		}
+		if ex.Output != nil {
+			output, err := json.Marshal(ex.Output)
+			if err != nil {
+				return nil, fmt.Errorf("example %d output: %w", i, err)
+			}
+			pb.OutputJson = string(output)
+		}
+		result[i] = pb
+	}
+	return result, nil
+}
+
 // Execute runs the tool with the provided input.
 // The input is serialized as JSON in the request and the output is
 // serialized as JSON in the response.
@@ -237,8 +210,14 @@ func (s *toolServiceServer) Execute(ctx context.Context, req *proto.ToolExecuteR
 	// Create a new instance of the proto message
 	protoReq := messageType.New().Interface()
 
+	// Upgrade inputs sent in an older schema version
+	inputJSON, err := tool.MigrateInputJSON(s.tool, req.InputJson)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid input for type %s: %v", inputTypeName, err)
+	}
+
 	// Apply enum normalization using the centralized enum.Normalize function
-	normalizedJSON := enum.Normalize(s.tool.Name(), req.InputJson)
+	normalizedJSON := enum.Normalize(s.tool.Name(), inputJSON)
 
 	// Unmarshal JSON input into the proto message with lenient settings
 	unmarshaler := protojson.UnmarshalOptions{
@@ -263,9 +242,13 @@ func (s *toolServiceServer) Execute(ctx context.Context, req *proto.ToolExecuteR
 		}
 		resp.OutputJson = string(outputJSON)
 	} else {
-		// Map error to proto error
+		// Map error to proto error, keeping output shaping failures distinct
+		code := "EXECUTION_ERROR"
+		if errors.Is(err, tool.ErrPostProcess) {
+			code = "POST_PROCESS_ERROR"
+		}
 		resp.Error = &proto.Error{
-			Code:      "EXECUTION_ERROR",
+			Code:      code,
 			Message:   err.Error(),
 			Retryable: false,
 		}
@@ -165,6 +165,12 @@ func (s *toolServiceServer) parseToolInput(inputJSON string) (protolib.Message,
 	// Create a new instance of the proto message
 	protoMsg := messageType.New().Interface()
 
+	// Upgrade inputs sent in an older schema version
+	inputJSON, err = tool.MigrateInputJSON(s.tool, inputJSON)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input for type %s: %w", inputTypeName, err)
+	}
+
 	// Apply enum normalization using the centralized enum.Normalize function
 	normalizedJSON := enum.Normalize(s.tool.Name(), inputJSON)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
 	"os"
 	"testing"
@@ -15,9 +16,14 @@ import (
 	"github.com/zero-day-ai/sdk/tool"
 	"github.com/zero-day-ai/sdk/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
 	protolib "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // mockTool is a mock implementation of tool.Tool for testing.
@@ -136,6 +142,28 @@ func TestToolServiceServer_GetDescriptor(t *testing.T) {
 	assert.Equal(t, "{}", resp.OutputSchema.Json, "OutputSchema should be empty JSON object (deprecated)")
 }
 
+func TestToolServiceServer_GetDescriptorExamples(t *testing.T) {
+	cfg := tool.NewConfig().
+		SetName("example-tool").
+		AddExample("Scan one host", map[string]any{"target": "10.0.0.5"}, map[string]any{"open_ports": []any{22, 443}}).
+		AddExample("Input only", map[string]any{"target": "example.com"}, nil)
+	exampleTool, err := tool.New(cfg)
+	require.NoError(t, err)
+
+	conn, cleanup := setupToolTestServer(t, exampleTool)
+	defer cleanup()
+
+	client := proto.NewToolServiceClient(conn)
+	resp, err := client.GetDescriptor(context.Background(), &proto.ToolGetDescriptorRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, resp.Examples, 2)
+	assert.Equal(t, "Scan one host", resp.Examples[0].Description)
+	assert.JSONEq(t, `{"target":"10.0.0.5"}`, resp.Examples[0].InputJson)
+	assert.JSONEq(t, `{"open_ports":[22,443]}`, resp.Examples[0].OutputJson)
+	assert.Empty(t, resp.Examples[1].OutputJson)
+}
+
 func TestToolServiceServer_Execute(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -216,6 +244,18 @@ func TestToolServiceServer_Execute(t *testing.T) {
 				assert.Equal(t, "EXECUTION_ERROR", resp.Error.Code)
 			},
 		},
+		{
+			name:  "post-process error",
+			input: map[string]any{},
+			executeProtoFunc: func(ctx context.Context, input protolib.Message) (protolib.Message, error) {
+				return nil, fmt.Errorf("%w: unsorted hosts", tool.ErrPostProcess)
+			},
+			wantErr: false,
+			checkResult: func(t *testing.T, resp *proto.ToolExecuteResponse) {
+				assert.NotNil(t, resp.Error)
+				assert.Equal(t, "POST_PROCESS_ERROR", resp.Error.Code)
+			},
+		},
 		{
 			name:  "empty input",
 			input: map[string]any{},
@@ -669,3 +709,164 @@ func TestToolExecute_WithoutEnumMappings(t *testing.T) {
 	assert.Equal(t, "localhost", receivedInput.Entries["target"].GetStringValue(),
 		"target should pass through unchanged")
 }
+
+// TestToolServiceServer_DescribeTool tests that a client can build a request
+// from the served descriptors alone, execute it, and decode the response.
+func TestToolServiceServer_DescribeTool(t *testing.T) {
+	mockT := &mockTool{name: "echo", version: "1.0.0", description: "Echoes its input"}
+
+	conn, cleanup := setupToolTestServer(t, mockT)
+	defer cleanup()
+
+	ctx := context.Background()
+	client := proto.NewToolServiceClient(conn)
+	desc, err := client.DescribeTool(ctx, &proto.ToolDescribeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "echo", desc.Tool.Name)
+	assert.Equal(t, "gibson.common.TypedMap", desc.InputMessageType)
+	require.NotEmpty(t, desc.FileDescriptorSet)
+
+	descriptors, err := tool.ParseProtoDescriptors(desc.InputMessageType, desc.OutputMessageType, desc.FileDescriptorSet)
+	require.NoError(t, err)
+
+	// Build {"entries": {"target": {"string_value": "10.0.0.1"}}} via protoreflect
+	input, err := descriptors.NewInput()
+	require.NoError(t, err)
+	entries := input.Descriptor().Fields().ByName("entries")
+	value := input.NewField(entries).Map().NewValue().Message()
+	value.Set(value.Descriptor().Fields().ByName("string_value"), protoreflect.ValueOfString("10.0.0.1"))
+	entriesMap := input.Mutable(entries).Map()
+	entriesMap.Set(protoreflect.ValueOfString("target").MapKey(), protoreflect.ValueOfMessage(value))
+
+	inputJSON, err := protojson.Marshal(input)
+	require.NoError(t, err)
+	resp, err := client.Execute(ctx, &proto.ToolExecuteRequest{InputJson: string(inputJSON)})
+	require.NoError(t, err)
+	require.Nil(t, resp.Error)
+
+	output, err := descriptors.NewOutput()
+	require.NoError(t, err)
+	require.NoError(t, protojson.Unmarshal([]byte(resp.OutputJson), output))
+
+	outEntries := output.Get(output.Descriptor().Fields().ByName("entries")).Map()
+	for key, want := range map[string]string{"target": "10.0.0.1", "result": "success"} {
+		v := outEntries.Get(protoreflect.ValueOfString(key).MapKey())
+		require.True(t, v.IsValid(), "entry %q", key)
+		msg := v.Message()
+		assert.Equal(t, want, msg.Get(msg.Descriptor().Fields().ByName("string_value")).String(), "entry %q", key)
+	}
+}
+
+// TestToolServiceServer_DescribeToolUnregisteredTypes tests that a tool whose
+// messages are not registered still describes itself.
+func TestToolServiceServer_DescribeToolUnregisteredTypes(t *testing.T) {
+	unregistered, err := tool.New(tool.NewConfig().
+		SetName("opaque").
+		SetInputMessageType("example.Missing").
+		SetOutputMessageType("example.Missing"))
+	require.NoError(t, err)
+
+	conn, cleanup := setupToolTestServer(t, unregistered)
+	defer cleanup()
+
+	desc, err := proto.NewToolServiceClient(conn).DescribeTool(context.Background(), &proto.ToolDescribeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "opaque", desc.Tool.Name)
+	assert.Empty(t, desc.FileDescriptorSet)
+}
+
+// TestServer_ToolReflection tests that tool servers serve gRPC reflection by
+// default and that WithReflection(false) turns it off.
+func TestServer_ToolReflection(t *testing.T) {
+	listServices := func(t *testing.T, opts ...Option) ([]string, error) {
+		srv, err := New(append([]Option{WithPort(0)}, opts...)...)
+		require.NoError(t, err)
+		require.NoError(t, srv.RegisterTool(&mockTool{name: "nmap"}))
+
+		lis := bufconn.Listen(1024 * 1024)
+		go srv.GRPCServer().Serve(lis)
+		defer srv.Stop()
+
+		conn, err := grpc.NewClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		stream, err := grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+		require.NoError(t, err)
+		err = stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+			MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{},
+		})
+		require.NoError(t, err)
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, svc := range resp.GetListServicesResponse().GetService() {
+			names = append(names, svc.Name)
+		}
+		return names, nil
+	}
+
+	names, err := listServices(t)
+	require.NoError(t, err)
+	assert.Contains(t, names, proto.ToolService_ServiceDesc.ServiceName)
+
+	_, err = listServices(t, WithReflection(false))
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestToolServiceServer_ExecuteSchemaMigration(t *testing.T) {
+	// v2 renamed the "host" entry to "target"
+	migrating, err := tool.New(tool.NewConfig().
+		SetName("scanner").
+		SetVersion("2.0.0").
+		SetInputMessageType("gibson.common.TypedMap").
+		SetOutputMessageType("gibson.common.TypedMap").
+		AddSchemaMigration("1", func(in map[string]any) map[string]any {
+			entries, _ := in["entries"].(map[string]any)
+			if host, ok := entries["host"]; ok {
+				entries["target"] = host
+				delete(entries, "host")
+			}
+			return in
+		}).
+		SetExecuteProtoFunc(func(ctx context.Context, input protolib.Message) (protolib.Message, error) {
+			return input, nil
+		}))
+	require.NoError(t, err)
+
+	conn, cleanup := setupToolTestServer(t, migrating)
+	defer cleanup()
+	client := proto.NewToolServiceClient(conn)
+
+	t.Run("legacy input", func(t *testing.T) {
+		resp, err := client.Execute(context.Background(), &proto.ToolExecuteRequest{
+			InputJson: `{"_schema_version":"1","entries":{"host":{"stringValue":"10.0.0.5"}}}`,
+		})
+		require.NoError(t, err)
+		require.Nil(t, resp.Error)
+		assert.JSONEq(t, `{"entries":{"target":{"stringValue":"10.0.0.5"}}}`, resp.OutputJson)
+	})
+
+	t.Run("current input", func(t *testing.T) {
+		resp, err := client.Execute(context.Background(), &proto.ToolExecuteRequest{
+			InputJson: `{"entries":{"target":{"stringValue":"10.0.0.5"}}}`,
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"entries":{"target":{"stringValue":"10.0.0.5"}}}`, resp.OutputJson)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := client.Execute(context.Background(), &proto.ToolExecuteRequest{
+			InputJson: `{"_schema_version":{},"entries":{}}`,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
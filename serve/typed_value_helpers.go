@@ -254,7 +254,6 @@ func FindingToProto(f *finding.Finding) *proto.Finding {
 		Severity:      severityToProto(f.Severity),
 		Confidence:    f.Confidence,
 		Status:        statusToProto(f.Status),
-		Remediation:   f.Remediation,
 		References:    f.References,
 		TargetId:      f.TargetID,
 		Technique:     f.Technique,
@@ -263,14 +262,26 @@ func FindingToProto(f *finding.Finding) *proto.Finding {
 		UpdatedAt:     f.UpdatedAt.UnixMilli(),
 	}
 
-	// Convert CVSS score
+	// Convert CVSS score and vector
 	if f.CVSSScore != nil {
 		protoFinding.CvssScore = *f.CVSSScore
 	}
+	if f.CVSS != nil {
+		protoFinding.CvssVector = f.CVSS.Vector
+	}
 
 	// Risk score
 	protoFinding.RiskScore = f.RiskScore
 
+	// Convert remediation; the summary is also sent on its own for older consumers
+	if f.Remediation != nil {
+		protoFinding.Remediation = f.Remediation.Summary
+		protoFinding.RemediationDetail = remediationToProto(f.Remediation)
+	}
+
+	// Convert relations
+	protoFinding.Relations = relationsToProto(f.Relations)
+
 	// Convert MITRE mappings
 	if f.MitreAttack != nil {
 		protoFinding.MitreAttack = mitreToProto(f.MitreAttack)
@@ -328,18 +339,27 @@ func FindingFromProto(pf *proto.Finding) *finding.Finding {
 		Confidence:    pf.Confidence,
 		Status:        statusFromProto(pf.Status),
 		RiskScore:     pf.RiskScore,
-		Remediation:   pf.Remediation,
+		Remediation:   remediationFromProto(pf.RemediationDetail, pf.Remediation),
 		References:    pf.References,
 		TargetID:      pf.TargetId,
 		Technique:     pf.Technique,
 		Tags:          pf.Tags,
+		Relations:     relationsFromProto(pf.Relations),
 	}
 
-	// Convert CVSS score
+	// Convert CVSS score and vector; an invalid vector is kept for
+	// Finding.Validate to report
 	if pf.CvssScore != 0 {
 		score := pf.CvssScore
 		f.CVSSScore = &score
 	}
+	if pf.CvssVector != "" {
+		cvss, err := finding.ParseCVSS(pf.CvssVector)
+		if err != nil {
+			cvss = &finding.CVSS{Vector: pf.CvssVector}
+		}
+		f.CVSS = cvss
+	}
 
 	// Convert timestamps
 	if pf.CreatedAt != 0 {
@@ -519,6 +539,69 @@ func mitreFromProto(m *proto.MitreMapping) *finding.MitreMapping {
 	}
 }
 
+func remediationToProto(r *finding.Remediation) *proto.FindingRemediation {
+	if r == nil {
+		return nil
+	}
+
+	return &proto.FindingRemediation{
+		Summary:    r.Summary,
+		Steps:      r.Steps,
+		References: r.References,
+		Effort:     string(r.Effort),
+	}
+}
+
+// remediationFromProto converts the structured remediation, falling back to
+// the plain summary sent by older producers.
+func remediationFromProto(r *proto.FindingRemediation, summary string) *finding.Remediation {
+	if r == nil {
+		if summary == "" {
+			return nil
+		}
+		return &finding.Remediation{Summary: summary}
+	}
+
+	return &finding.Remediation{
+		Summary:    r.Summary,
+		Steps:      r.Steps,
+		References: r.References,
+		Effort:     finding.Effort(r.Effort),
+	}
+}
+
+func relationsToProto(relations []finding.FindingRelation) []*proto.FindingRelation {
+	if len(relations) == 0 {
+		return nil
+	}
+
+	result := make([]*proto.FindingRelation, len(relations))
+	for i, rel := range relations {
+		result[i] = &proto.FindingRelation{
+			Type:            string(rel.Type),
+			TargetFindingId: rel.TargetFindingID,
+			Note:            rel.Note,
+		}
+	}
+	return result
+}
+
+func relationsFromProto(relations []*proto.FindingRelation) []finding.FindingRelation {
+	if len(relations) == 0 {
+		return nil
+	}
+
+	result := make([]finding.FindingRelation, len(relations))
+	for i, rel := range relations {
+		result[i] = finding.FindingRelation{
+			Type:            finding.RelationType(rel.Type),
+			TargetFindingID: rel.TargetFindingId,
+			Note:            rel.Note,
+		}
+	}
+	return result
+}
+
 // Result status conversions
 
 func resultStatusToProto(s agent.ResultStatus) proto.ResultStatus {
@@ -533,6 +616,8 @@ func resultStatusToProto(s agent.ResultStatus) proto.ResultStatus {
 		return proto.ResultStatus_RESULT_STATUS_CANCELLED
 	case agent.StatusTimeout:
 		return proto.ResultStatus_RESULT_STATUS_TIMEOUT
+	case agent.StatusRefused:
+		return proto.ResultStatus_RESULT_STATUS_REFUSED
 	default:
 		return proto.ResultStatus_RESULT_STATUS_UNSPECIFIED
 	}
@@ -550,6 +635,8 @@ func resultStatusFromProto(s proto.ResultStatus) agent.ResultStatus {
 		return agent.StatusCancelled
 	case proto.ResultStatus_RESULT_STATUS_TIMEOUT:
 		return agent.StatusTimeout
+	case proto.ResultStatus_RESULT_STATUS_REFUSED:
+		return agent.StatusRefused
 	default:
 		return agent.StatusFailed
 	}
@@ -581,6 +668,7 @@ func ProtoToTask(pt *proto.Task) agent.Task {
 			AllowedTools: pt.GetConstraints().GetAllowedTools(),
 			BlockedTools: pt.GetConstraints().GetBlockedTools(),
 		},
+		IdempotencyKey: pt.GetIdempotencyKey(),
 	}
 }
 
@@ -597,6 +685,7 @@ func TaskToProto(t agent.Task) *proto.Task {
 			AllowedTools: t.Constraints.AllowedTools,
 			BlockedTools: t.Constraints.BlockedTools,
 		},
+		IdempotencyKey: t.IdempotencyKey,
 	}
 }
 
@@ -607,10 +696,11 @@ func ProtoToResult(pr *proto.Result) agent.Result {
 	}
 
 	result := agent.Result{
-		Status:   ProtoToResultStatus(pr.GetStatus()),
-		Output:   FromTypedValue(pr.GetOutput()),
-		Findings: pr.GetFindingIds(),
-		Metadata: FromTypedMap(pr.GetMetadata()),
+		Status:        ProtoToResultStatus(pr.GetStatus()),
+		Output:        FromTypedValue(pr.GetOutput()),
+		Findings:      pr.GetFindingIds(),
+		Metadata:      FromTypedMap(pr.GetMetadata()),
+		RefusalReason: pr.GetRefusalReason(),
 	}
 
 	// Convert error if present
@@ -635,10 +725,11 @@ func ProtoToResult(pr *proto.Result) agent.Result {
 // ResultToProto converts SDK agent.Result to proto Result.
 func ResultToProto(r agent.Result) *proto.Result {
 	result := &proto.Result{
-		Status:     ResultStatusToProto(r.Status),
-		Output:     ToTypedValue(r.Output),
-		FindingIds: r.Findings,
-		Metadata:   ToTypedMap(r.Metadata),
+		Status:        ResultStatusToProto(r.Status),
+		Output:        ToTypedValue(r.Output),
+		FindingIds:    r.Findings,
+		Metadata:      ToTypedMap(r.Metadata),
+		RefusalReason: r.RefusalReason,
 	}
 
 	// Convert ErrorInfo if present
@@ -652,7 +743,7 @@ func ResultToProto(r agent.Result) *proto.Result {
 			Code:      StringToProtoErrorCode(r.ErrorInfo.Code),
 			Message:   r.ErrorInfo.Message,
 			Details:   details,
-			Retryable: r.ErrorInfo.Retryable,
+			Retryable: r.ErrorInfo.IsRetryable(),
 		}
 	}
 
@@ -672,6 +763,8 @@ func ProtoToResultStatus(ps proto.ResultStatus) agent.ResultStatus {
 		return agent.StatusCancelled
 	case proto.ResultStatus_RESULT_STATUS_TIMEOUT:
 		return agent.StatusTimeout
+	case proto.ResultStatus_RESULT_STATUS_REFUSED:
+		return agent.StatusRefused
 	default:
 		return agent.StatusFailed
 	}
@@ -690,109 +783,75 @@ func ResultStatusToProto(s agent.ResultStatus) proto.ResultStatus {
 		return proto.ResultStatus_RESULT_STATUS_CANCELLED
 	case agent.StatusTimeout:
 		return proto.ResultStatus_RESULT_STATUS_TIMEOUT
+	case agent.StatusRefused:
+		return proto.ResultStatus_RESULT_STATUS_REFUSED
 	default:
 		return proto.ResultStatus_RESULT_STATUS_FAILED
 	}
 }
 
+// errorCodes maps proto ErrorCode values to the agent error code strings.
+// Generic codes without an agent constant use the enum name without its
+// prefix.
+var errorCodes = map[proto.ErrorCode]string{
+	proto.ErrorCode_ERROR_CODE_INTERNAL:             agent.ErrCodeInternal,
+	proto.ErrorCode_ERROR_CODE_INVALID_ARGUMENT:     "INVALID_ARGUMENT",
+	proto.ErrorCode_ERROR_CODE_NOT_FOUND:            "NOT_FOUND",
+	proto.ErrorCode_ERROR_CODE_TIMEOUT:              "TIMEOUT",
+	proto.ErrorCode_ERROR_CODE_UNAVAILABLE:          "UNAVAILABLE",
+	proto.ErrorCode_ERROR_CODE_PERMISSION_DENIED:    "PERMISSION_DENIED",
+	proto.ErrorCode_ERROR_CODE_ALREADY_EXISTS:       "ALREADY_EXISTS",
+	proto.ErrorCode_ERROR_CODE_RESOURCE_EXHAUSTED:   "RESOURCE_EXHAUSTED",
+	proto.ErrorCode_ERROR_CODE_CANCELLED:            "CANCELLED",
+	proto.ErrorCode_ERROR_CODE_AGENT_TIMEOUT:        agent.ErrCodeAgentTimeout,
+	proto.ErrorCode_ERROR_CODE_AGENT_PANIC:          agent.ErrCodeAgentPanic,
+	proto.ErrorCode_ERROR_CODE_AGENT_INIT_FAILED:    agent.ErrCodeAgentInitFailed,
+	proto.ErrorCode_ERROR_CODE_LLM_RATE_LIMITED:     agent.ErrCodeLLMRateLimited,
+	proto.ErrorCode_ERROR_CODE_LLM_CONTEXT_EXCEEDED: agent.ErrCodeLLMContextExceeded,
+	proto.ErrorCode_ERROR_CODE_LLM_API_ERROR:        agent.ErrCodeLLMAPIError,
+	proto.ErrorCode_ERROR_CODE_LLM_PARSE_ERROR:      agent.ErrCodeLLMParseError,
+	proto.ErrorCode_ERROR_CODE_TOOL_NOT_FOUND:       agent.ErrCodeToolNotFound,
+	proto.ErrorCode_ERROR_CODE_TOOL_TIMEOUT:         agent.ErrCodeToolTimeout,
+	proto.ErrorCode_ERROR_CODE_TOOL_EXEC_FAILED:     agent.ErrCodeToolExecFailed,
+	proto.ErrorCode_ERROR_CODE_NETWORK_TIMEOUT:      agent.ErrCodeNetworkTimeout,
+	proto.ErrorCode_ERROR_CODE_NETWORK_UNREACHABLE:  agent.ErrCodeNetworkUnreachable,
+	proto.ErrorCode_ERROR_CODE_TLS_ERROR:            agent.ErrCodeTLSError,
+	proto.ErrorCode_ERROR_CODE_DELEGATION_FAILED:    agent.ErrCodeDelegationFailed,
+	proto.ErrorCode_ERROR_CODE_CHILD_AGENT_FAILED:   agent.ErrCodeChildAgentFailed,
+	proto.ErrorCode_ERROR_CODE_CONFIG_ERROR:         agent.ErrCodeConfigError,
+	proto.ErrorCode_ERROR_CODE_LLM_UNAVAILABLE:      agent.ErrCodeLLMUnavailable,
+	proto.ErrorCode_ERROR_CODE_TOOL_FAILURE:         agent.ErrCodeToolFailure,
+	proto.ErrorCode_ERROR_CODE_CONSTRAINT_VIOLATED:  agent.ErrCodeConstraintViolated,
+	proto.ErrorCode_ERROR_CODE_TARGET_UNREACHABLE:   agent.ErrCodeTargetUnreachable,
+}
+
+// protoErrorCodes is the inverse of errorCodes.
+var protoErrorCodes = func() map[string]proto.ErrorCode {
+	m := make(map[string]proto.ErrorCode, len(errorCodes))
+	for pc, code := range errorCodes {
+		m[code] = pc
+	}
+	return m
+}()
+
 // ProtoErrorCodeToString converts proto ErrorCode to string.
+// ERROR_CODE_UNSPECIFIED and values this SDK does not know map to
+// agent.ErrCodeUnknown.
 func ProtoErrorCodeToString(code proto.ErrorCode) string {
-	switch code {
-	case proto.ErrorCode_ERROR_CODE_INTERNAL:
-		return agent.ErrCodeInternalError
-	case proto.ErrorCode_ERROR_CODE_INVALID_ARGUMENT:
-		return "INVALID_ARGUMENT"
-	case proto.ErrorCode_ERROR_CODE_NOT_FOUND:
-		return agent.ErrCodeToolNotFound
-	case proto.ErrorCode_ERROR_CODE_TIMEOUT:
-		return agent.ErrCodeAgentTimeout
-	case proto.ErrorCode_ERROR_CODE_UNAVAILABLE:
-		return "UNAVAILABLE"
-	case proto.ErrorCode_ERROR_CODE_PERMISSION_DENIED:
-		return "PERMISSION_DENIED"
-	case proto.ErrorCode_ERROR_CODE_ALREADY_EXISTS:
-		return "ALREADY_EXISTS"
-	case proto.ErrorCode_ERROR_CODE_RESOURCE_EXHAUSTED:
-		return "RESOURCE_EXHAUSTED"
-	case proto.ErrorCode_ERROR_CODE_CANCELLED:
-		return "CANCELLED"
-	case proto.ErrorCode_ERROR_CODE_AGENT_TIMEOUT:
-		return agent.ErrCodeAgentTimeout
-	case proto.ErrorCode_ERROR_CODE_AGENT_PANIC:
-		return agent.ErrCodeAgentPanic
-	case proto.ErrorCode_ERROR_CODE_AGENT_INIT_FAILED:
-		return agent.ErrCodeAgentInitFailed
-	case proto.ErrorCode_ERROR_CODE_LLM_RATE_LIMITED:
-		return agent.ErrCodeLLMRateLimited
-	case proto.ErrorCode_ERROR_CODE_LLM_CONTEXT_EXCEEDED:
-		return agent.ErrCodeLLMContextExceeded
-	case proto.ErrorCode_ERROR_CODE_LLM_API_ERROR:
-		return agent.ErrCodeLLMAPIError
-	case proto.ErrorCode_ERROR_CODE_LLM_PARSE_ERROR:
-		return agent.ErrCodeLLMParseError
-	case proto.ErrorCode_ERROR_CODE_TOOL_NOT_FOUND:
-		return agent.ErrCodeToolNotFound
-	case proto.ErrorCode_ERROR_CODE_TOOL_TIMEOUT:
-		return agent.ErrCodeToolTimeout
-	case proto.ErrorCode_ERROR_CODE_TOOL_EXEC_FAILED:
-		return agent.ErrCodeToolExecFailed
-	case proto.ErrorCode_ERROR_CODE_NETWORK_TIMEOUT:
-		return agent.ErrCodeNetworkTimeout
-	case proto.ErrorCode_ERROR_CODE_NETWORK_UNREACHABLE:
-		return agent.ErrCodeNetworkUnreachable
-	case proto.ErrorCode_ERROR_CODE_TLS_ERROR:
-		return agent.ErrCodeTLSError
-	case proto.ErrorCode_ERROR_CODE_DELEGATION_FAILED:
-		return agent.ErrCodeDelegationFailed
-	case proto.ErrorCode_ERROR_CODE_CHILD_AGENT_FAILED:
-		return agent.ErrCodeChildAgentFailed
-	case proto.ErrorCode_ERROR_CODE_CONFIG_ERROR:
-		return agent.ErrCodeConfigError
-	default:
-		return "UNKNOWN"
+	if s, ok := errorCodes[code]; ok {
+		return s
 	}
+	return agent.ErrCodeUnknown
 }
 
 // StringToProtoErrorCode converts string error code to proto ErrorCode.
+// It is the inverse of ProtoErrorCodeToString; codes without a proto value,
+// including agent.ErrCodeUnknown, map to ERROR_CODE_UNSPECIFIED.
 func StringToProtoErrorCode(code string) proto.ErrorCode {
-	switch code {
-	case agent.ErrCodeInternalError:
-		return proto.ErrorCode_ERROR_CODE_INTERNAL
-	case agent.ErrCodeAgentTimeout:
-		return proto.ErrorCode_ERROR_CODE_AGENT_TIMEOUT
-	case agent.ErrCodeAgentPanic:
-		return proto.ErrorCode_ERROR_CODE_AGENT_PANIC
-	case agent.ErrCodeAgentInitFailed:
-		return proto.ErrorCode_ERROR_CODE_AGENT_INIT_FAILED
-	case agent.ErrCodeLLMRateLimited:
-		return proto.ErrorCode_ERROR_CODE_LLM_RATE_LIMITED
-	case agent.ErrCodeLLMContextExceeded:
-		return proto.ErrorCode_ERROR_CODE_LLM_CONTEXT_EXCEEDED
-	case agent.ErrCodeLLMAPIError:
-		return proto.ErrorCode_ERROR_CODE_LLM_API_ERROR
-	case agent.ErrCodeLLMParseError:
-		return proto.ErrorCode_ERROR_CODE_LLM_PARSE_ERROR
-	case agent.ErrCodeToolNotFound:
-		return proto.ErrorCode_ERROR_CODE_TOOL_NOT_FOUND
-	case agent.ErrCodeToolTimeout:
-		return proto.ErrorCode_ERROR_CODE_TOOL_TIMEOUT
-	case agent.ErrCodeToolExecFailed:
-		return proto.ErrorCode_ERROR_CODE_TOOL_EXEC_FAILED
-	case agent.ErrCodeNetworkTimeout:
-		return proto.ErrorCode_ERROR_CODE_NETWORK_TIMEOUT
-	case agent.ErrCodeNetworkUnreachable:
-		return proto.ErrorCode_ERROR_CODE_NETWORK_UNREACHABLE
-	case agent.ErrCodeTLSError:
-		return proto.ErrorCode_ERROR_CODE_TLS_ERROR
-	case agent.ErrCodeDelegationFailed:
-		return proto.ErrorCode_ERROR_CODE_DELEGATION_FAILED
-	case agent.ErrCodeChildAgentFailed:
-		return proto.ErrorCode_ERROR_CODE_CHILD_AGENT_FAILED
-	case agent.ErrCodeConfigError:
-		return proto.ErrorCode_ERROR_CODE_CONFIG_ERROR
-	default:
-		return proto.ErrorCode_ERROR_CODE_INTERNAL
+	if pc, ok := protoErrorCodes[code]; ok {
+		return pc
 	}
+	return proto.ErrorCode_ERROR_CODE_UNSPECIFIED
 }
 
 // ProtoToMissionContext converts proto TypedMap to types.MissionContext.
@@ -814,6 +873,8 @@ func ProtoToMissionContext(tm *proto.TypedMap) types.MissionContext {
 	if name, ok := m["name"].(string); ok {
 		ctx.Name = name
 	}
+	ctx.Constraints.AllowedTechniques = techniquesFromAny(m["allowed_techniques"])
+	ctx.Constraints.BlockedTechniques = techniquesFromAny(m["blocked_techniques"])
 	// Add other fields as needed
 	return ctx
 }
@@ -824,11 +885,33 @@ func MissionContextToProto(mc types.MissionContext) *proto.TypedMap {
 		"id":   mc.ID,
 		"name": mc.Name,
 	}
+	if len(mc.Constraints.AllowedTechniques) > 0 {
+		m["allowed_techniques"] = mc.Constraints.AllowedTechniques
+	}
+	if len(mc.Constraints.BlockedTechniques) > 0 {
+		m["blocked_techniques"] = mc.Constraints.BlockedTechniques
+	}
 	return &proto.TypedMap{
 		Entries: ToTypedMap(m),
 	}
 }
 
+// techniquesFromAny converts a decoded TypedValue array to technique types,
+// skipping non-string items.
+func techniquesFromAny(v any) []types.TechniqueType {
+	items, ok := v.([]any)
+	if !ok || len(items) == 0 {
+		return nil
+	}
+	techniques := make([]types.TechniqueType, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			techniques = append(techniques, types.TechniqueType(s))
+		}
+	}
+	return techniques
+}
+
 // ProtoToTargetInfo converts proto TypedMap to types.TargetInfo.
 func ProtoToTargetInfo(tm *proto.TypedMap) types.TargetInfo {
 	if tm == nil {
@@ -860,6 +943,13 @@ func ProtoToTargetInfo(tm *proto.TypedMap) types.TargetInfo {
 	if metadata, ok := m["metadata"].(map[string]any); ok {
 		info.Metadata = metadata
 	}
+	if transport, ok := m["transport"].(map[string]any); ok {
+		// the hints travel as their JSON form; malformed hints are dropped
+		var hints types.TransportHints
+		if data, err := json.Marshal(transport); err == nil && json.Unmarshal(data, &hints) == nil {
+			info.Transport = &hints
+		}
+	}
 	return info
 }
 
@@ -873,11 +963,36 @@ func TargetInfoToProto(ti types.TargetInfo) *proto.TypedMap {
 		"connection": ti.Connection,
 		"metadata":   ti.Metadata,
 	}
+	if ti.Transport != nil {
+		var transport map[string]any
+		if data, err := json.Marshal(ti.Transport); err == nil && json.Unmarshal(data, &transport) == nil {
+			m["transport"] = transport
+		}
+	}
 	return &proto.TypedMap{
 		Entries: ToTypedMap(m),
 	}
 }
 
+// TargetSchemasToProto converts target schemas to their proto form, with
+// each JSON schema serialized to SchemaJson.
+func TargetSchemasToProto(schemas []types.TargetSchema) ([]*proto.TargetSchemaProto, error) {
+	out := make([]*proto.TargetSchemaProto, len(schemas))
+	for i, ts := range schemas {
+		data, err := json.Marshal(ts.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %q target schema: %w", ts.Type, err)
+		}
+		out[i] = &proto.TargetSchemaProto{
+			Type:        ts.Type,
+			Version:     ts.Version,
+			SchemaJson:  string(data),
+			Description: ts.Description,
+		}
+	}
+	return out, nil
+}
+
 // GraphQueryToProto converts SDK graphrag.Query to proto GraphQuery.
 func GraphQueryToProto(q graphrag.Query) *proto.GraphQuery {
 	protoQuery := &proto.GraphQuery{
@@ -899,6 +1014,15 @@ func GraphQueryToProto(q graphrag.Query) *proto.GraphQuery {
 		// Add any additional filters as needed
 	}
 
+	// Convert property predicates
+	for _, f := range q.PropertyFilters {
+		protoQuery.PropertyFilters = append(protoQuery.PropertyFilters, &proto.PropertyFilter{
+			Key:   f.Key,
+			Op:    f.Op,
+			Value: ToTypedValue(f.Value),
+		})
+	}
+
 	return protoQuery
 }
 
@@ -920,9 +1044,91 @@ func ProtoToGraphQuery(pq *proto.GraphQuery) graphrag.Query {
 		GraphWeight:  pq.GetGraphWeight(),
 	}
 
+	for _, f := range pq.GetPropertyFilters() {
+		query.PropertyFilters = append(query.PropertyFilters, graphrag.PropertyFilter{
+			Key:   f.GetKey(),
+			Op:    f.GetOp(),
+			Value: FromTypedValue(f.GetValue()),
+		})
+	}
+
 	return query
 }
 
+// ProtoToQueryPlan converts a proto GraphRAGQueryPlan to an SDK graphrag.QueryPlan.
+func ProtoToQueryPlan(pp *proto.GraphRAGQueryPlan) graphrag.QueryPlan {
+	plan := graphrag.QueryPlan{
+		Route:        pp.GetRoute(),
+		TopK:         int(pp.GetTopK()),
+		MaxHops:      int(pp.GetMaxHops()),
+		MinScore:     pp.GetMinScore(),
+		VectorWeight: pp.GetVectorWeight(),
+		GraphWeight:  pp.GetGraphWeight(),
+		NodeTypes:    pp.GetNodeTypes(),
+		MissionID:    pp.GetMissionId(),
+		MissionRunID: pp.GetMissionRunId(),
+		Operations:   pp.GetOperations(),
+		Errors:       pp.GetErrors(),
+	}
+
+	for _, f := range pp.GetPropertyFilters() {
+		plan.PropertyFilters = append(plan.PropertyFilters, graphrag.PropertyFilter{
+			Key:   f.GetKey(),
+			Op:    f.GetOp(),
+			Value: FromTypedValue(f.GetValue()),
+		})
+	}
+
+	return plan
+}
+
+// ProtoToGraphStats converts a proto GraphRAGStats to an SDK graphrag.GraphStats.
+// The average degree is derived from the counts when the daemon omits it.
+func ProtoToGraphStats(ps *proto.GraphRAGStats) *graphrag.GraphStats {
+	stats := &graphrag.GraphStats{
+		MissionID:           ps.GetMissionId(),
+		NodeCount:           ps.GetNodeCount(),
+		RelationshipCount:   ps.GetRelationshipCount(),
+		NodesByType:         ps.GetNodesByType(),
+		RelationshipsByType: ps.GetRelationshipsByType(),
+		AverageDegree:       ps.GetAverageDegree(),
+	}
+
+	if stats.AverageDegree == 0 {
+		stats.AverageDegree = graphrag.ComputeAverageDegree(stats.NodeCount, stats.RelationshipCount)
+	}
+
+	return stats
+}
+
+// PathOptionsToProto converts SDK graphrag.PathOptions to proto PathOptions.
+func PathOptionsToProto(opts graphrag.PathOptions) *proto.PathOptions {
+	return &proto.PathOptions{
+		MaxDepth:          int32(opts.MaxDepth),
+		RelationshipTypes: opts.RelationshipTypes,
+		Direction:         opts.Direction,
+		WeightProperty:    opts.WeightProperty,
+	}
+}
+
+// ProtoToPathEdges converts proto PathEdges to SDK graphrag.PathEdges.
+func ProtoToPathEdges(pe []*proto.PathEdge) []graphrag.PathEdge {
+	edges := make([]graphrag.PathEdge, len(pe))
+	for i, e := range pe {
+		edges[i] = graphrag.PathEdge{
+			FromID:   e.GetFromId(),
+			ToID:     e.GetToId(),
+			Type:     e.GetType(),
+			Weight:   e.GetWeight(),
+			Reversed: e.GetReversed(),
+		}
+		if len(e.GetProperties()) > 0 {
+			edges[i].Properties = FromTypedMap(e.GetProperties())
+		}
+	}
+	return edges
+}
+
 // Helper functions for float conversion
 func convertFloat64ToFloat32(f64 []float64) []float32 {
 	if f64 == nil {
@@ -1039,6 +1245,17 @@ func JSONSchemaToProtoNode(schema map[string]any) *proto.JSONSchemaNode {
 		node.MaxLength = &maxLenInt
 	}
 
+	// Input guards
+	if guards, ok := schema["x-guards"].([]any); ok {
+		for _, g := range guards {
+			if s, ok := g.(string); ok {
+				node.Guards = append(node.Guards, s)
+			}
+		}
+	} else if guards, ok := schema["x-guards"].([]string); ok {
+		node.Guards = guards
+	}
+
 	// Array constraints
 	if minItems, ok := schema["minItems"].(float64); ok {
 		minItemsInt := int32(minItems)
@@ -1,10 +1,19 @@
 package serve
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zero-day-ai/sdk/agent"
+	"github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/finding"
+	"github.com/zero-day-ai/sdk/graphrag"
+	"github.com/zero-day-ai/sdk/schema"
+	"github.com/zero-day-ai/sdk/types"
 )
 
 func TestSanitizeUTF8_ValidString(t *testing.T) {
@@ -144,3 +153,219 @@ func TestToTypedValue_ArrayWithInvalidStrings(t *testing.T) {
 	assert.Contains(t, arrayValue.Items[1].GetStringValue(), "\uFFFD")
 	assert.Contains(t, arrayValue.Items[2].GetStringValue(), "\uFFFD")
 }
+
+func TestGraphQueryToProto_PropertyFilters(t *testing.T) {
+	q := graphrag.NewStructuredQuery().
+		WithNodeTypes("finding").
+		WithPropertyFilter("severity", graphrag.FilterOpEq, "high").
+		WithPropertyFilter("confidence", graphrag.FilterOpGte, 0.8)
+
+	pq := GraphQueryToProto(*q)
+	require.Len(t, pq.PropertyFilters, 2)
+	assert.Equal(t, "severity", pq.PropertyFilters[0].Key)
+	assert.Equal(t, "eq", pq.PropertyFilters[0].Op)
+	assert.Equal(t, "high", pq.PropertyFilters[0].Value.GetStringValue())
+	assert.Equal(t, "gte", pq.PropertyFilters[1].Op)
+	assert.Equal(t, 0.8, pq.PropertyFilters[1].Value.GetDoubleValue())
+
+	// Round trip back to the SDK query
+	back := ProtoToGraphQuery(pq)
+	require.Len(t, back.PropertyFilters, 2)
+	assert.Equal(t, graphrag.PropertyFilter{Key: "severity", Op: "eq", Value: "high"}, back.PropertyFilters[0])
+	assert.Equal(t, graphrag.PropertyFilter{Key: "confidence", Op: "gte", Value: 0.8}, back.PropertyFilters[1])
+}
+
+func TestJSONSchemaToProtoNode_Guards(t *testing.T) {
+	s := schema.Object(map[string]schema.JSON{
+		"host": {Type: "string", Guards: []string{schema.GuardHostnameOnly, schema.GuardPrivateIPForbidden}},
+		"path": {Type: "string"},
+	}, "host")
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(data, &m))
+
+	node := JSONSchemaToProtoNode(m)
+	require.Contains(t, node.Properties, "host")
+	assert.Equal(t, []string{"hostname_only", "private_ip_forbidden"}, node.Properties["host"].Guards)
+	assert.Empty(t, node.Properties["path"].Guards)
+
+	// Guards survive the conversion back so served tools enforce them
+	back := protoToSchema(node)
+	assert.Equal(t, []string{"hostname_only", "private_ip_forbidden"}, back.Properties["host"].Guards)
+	assert.Error(t, back.Validate(map[string]any{"host": "127.0.0.1"}))
+	assert.NoError(t, back.Validate(map[string]any{"host": "example.com"}))
+}
+
+func TestFindingProto_Remediation(t *testing.T) {
+	f := finding.NewFinding("mission-1", "sqli-agent", "SQL injection", "desc", finding.CategoryDataExtraction, finding.SeverityHigh)
+	f.Remediation = &finding.Remediation{
+		Summary:    "Use parameterized queries",
+		Steps:      []string{"Replace string concatenation"},
+		References: []string{"https://owasp.org"},
+		Effort:     finding.EffortLow,
+	}
+
+	pf := FindingToProto(f)
+	assert.Equal(t, "Use parameterized queries", pf.Remediation, "the summary is kept for older consumers")
+	require.NotNil(t, pf.RemediationDetail)
+
+	back := FindingFromProto(pf)
+	assert.Equal(t, f.Remediation, back.Remediation)
+
+	// Older producers only send the summary
+	legacy := FindingFromProto(&proto.Finding{Id: "f-1", Remediation: "Upgrade the library"})
+	assert.Equal(t, &finding.Remediation{Summary: "Upgrade the library"}, legacy.Remediation)
+
+	assert.Nil(t, FindingFromProto(&proto.Finding{Id: "f-2"}).Remediation)
+}
+
+func TestFindingProto_Relations(t *testing.T) {
+	f := finding.NewFinding("mission-1", "sqli-agent", "SQL injection", "desc", finding.CategoryDataExtraction, finding.SeverityHigh)
+	require.NoError(t, f.AddDuplicateOf("f-older", "same login form"))
+	require.NoError(t, f.AddRelatedTo("f-xss", ""))
+
+	pf := FindingToProto(f)
+	require.Len(t, pf.Relations, 2)
+	assert.Equal(t, "duplicate_of", pf.Relations[0].Type)
+
+	back := FindingFromProto(pf)
+	assert.Equal(t, f.Relations, back.Relations)
+
+	assert.Nil(t, FindingFromProto(&proto.Finding{Id: "f-2"}).Relations)
+}
+
+func TestFindingProto_CVSS(t *testing.T) {
+	f := finding.NewFinding("mission-1", "sqli-agent", "SQL injection", "desc", finding.CategoryDataExtraction, finding.SeverityHigh)
+	cvss, err := finding.ParseCVSS("CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N")
+	require.NoError(t, err)
+	require.NoError(t, f.SetCVSS(cvss))
+
+	pf := FindingToProto(f)
+	assert.Equal(t, cvss.Vector, pf.CvssVector)
+	assert.Equal(t, 9.3, pf.CvssScore)
+
+	back := FindingFromProto(pf)
+	assert.Equal(t, cvss, back.CVSS)
+
+	// An invalid vector is kept so that validation reports it
+	invalid := FindingFromProto(&proto.Finding{Id: "f-1", CvssVector: "CVSS:3.1/AV:X"})
+	require.NotNil(t, invalid.CVSS)
+	assert.Error(t, invalid.CVSS.Validate())
+
+	assert.Nil(t, FindingFromProto(&proto.Finding{Id: "f-2"}).CVSS)
+}
+
+func TestTaskProto_IdempotencyKey(t *testing.T) {
+	task := agent.Task{ID: "task-1", Goal: "scan", IdempotencyKey: "mission-1/recon/attempt"}
+
+	pt := TaskToProto(task)
+	assert.Equal(t, "mission-1/recon/attempt", pt.GetIdempotencyKey())
+	assert.Equal(t, task.IdempotencyKey, ProtoToTask(pt).IdempotencyKey)
+}
+
+func TestMissionContextProto_Techniques(t *testing.T) {
+	mc := types.MissionContext{
+		ID:          "mission-1",
+		Name:        "recon",
+		Constraints: types.NewMissionConstraints().WithAllowedTechniques("jailbreak").WithBlockedTechniques("dos"),
+	}
+
+	got := ProtoToMissionContext(MissionContextToProto(mc))
+	assert.Equal(t, []types.TechniqueType{"jailbreak"}, got.Constraints.AllowedTechniques)
+	assert.Equal(t, []types.TechniqueType{"dos"}, got.Constraints.BlockedTechniques)
+	assert.False(t, got.Constraints.PermitsTechnique("dos"))
+
+	plain := ProtoToMissionContext(MissionContextToProto(types.MissionContext{ID: "mission-2"}))
+	assert.Nil(t, plain.Constraints.BlockedTechniques)
+}
+
+func TestTargetInfoProto_Transport(t *testing.T) {
+	hints := &types.TransportHints{
+		MaxIdleConnsPerHost: 32,
+		IdleConnTimeout:     90 * time.Second,
+		MinTLSVersion:       "1.2",
+		ProxyURL:            "http://proxy.internal:3128",
+	}
+	ti := types.TargetInfo{ID: "t1", Type: "http_api", Transport: hints}
+
+	got := ProtoToTargetInfo(TargetInfoToProto(ti))
+	assert.Equal(t, hints, got.Transport)
+
+	plain := ProtoToTargetInfo(TargetInfoToProto(types.TargetInfo{ID: "t2"}))
+	assert.Nil(t, plain.Transport)
+}
+
+// TestFromTypedMap_GraphNodeAccessors tests that node properties read the
+// same after a round trip through TypedMap as before it.
+func TestFromTypedMap_GraphNodeAccessors(t *testing.T) {
+	props := map[string]any{
+		"severity":   "critical",
+		"cvss_score": 9.8,
+		"port":       443,
+		"verified":   true,
+		"tags":       []string{"web", "sqli"},
+		"seen_at":    "2026-03-01T12:00:00Z",
+		"http":       map[string]any{"status": 500},
+	}
+	node := graphrag.NewGraphNode("Finding").WithProperties(FromTypedMap(ToTypedMap(props)))
+
+	assert.Equal(t, "critical", node.GetString("severity", ""))
+	assert.Equal(t, 9.8, node.GetFloat("cvss_score", 0))
+	assert.Equal(t, 443, node.GetInt("port", 0))
+	assert.Equal(t, 443.0, node.GetFloat("port", 0))
+	assert.True(t, node.GetBool("verified", false))
+	assert.Equal(t, []string{"web", "sqli"}, node.GetStringSlice("tags"))
+	assert.Equal(t, 2026, node.GetTime("seen_at", "").Year())
+	assert.Equal(t, 500, node.GetInt("http.status", 0))
+	assert.True(t, node.HasProperty("http.status"))
+	assert.False(t, node.HasProperty("http.method"))
+}
+
+func TestErrorCodeConversion(t *testing.T) {
+	for value, name := range proto.ErrorCode_name {
+		pc := proto.ErrorCode(value)
+		t.Run(name, func(t *testing.T) {
+			code := ProtoErrorCodeToString(pc)
+			if pc == proto.ErrorCode_ERROR_CODE_UNSPECIFIED {
+				assert.Equal(t, agent.ErrCodeUnknown, code)
+			} else {
+				assert.NotEqual(t, agent.ErrCodeUnknown, code, "every enum value has a code")
+			}
+			assert.Equal(t, pc, StringToProtoErrorCode(code))
+		})
+	}
+
+	t.Run("result error codes", func(t *testing.T) {
+		for _, code := range []string{
+			agent.ErrCodeLLMUnavailable,
+			agent.ErrCodeToolFailure,
+			agent.ErrCodeConstraintViolated,
+			agent.ErrCodeTargetUnreachable,
+			agent.ErrCodeInternal,
+		} {
+			assert.Equal(t, code, ProtoErrorCodeToString(StringToProtoErrorCode(code)), code)
+		}
+	})
+
+	t.Run("unknown fallback", func(t *testing.T) {
+		assert.Equal(t, proto.ErrorCode_ERROR_CODE_UNSPECIFIED, StringToProtoErrorCode("NO_SUCH_CODE"))
+		assert.Equal(t, proto.ErrorCode_ERROR_CODE_UNSPECIFIED, StringToProtoErrorCode(agent.ErrCodeUnknown))
+		assert.Equal(t, agent.ErrCodeUnknown, ProtoErrorCodeToString(proto.ErrorCode(999)))
+	})
+}
+
+func TestResultToProto_ErrorRetryable(t *testing.T) {
+	result := agent.NewFailedResult(errors.New("connection refused"), agent.ErrCodeTargetUnreachable)
+
+	pr := ResultToProto(result)
+	require.NotNil(t, pr.GetError())
+	assert.Equal(t, proto.ErrorCode_ERROR_CODE_TARGET_UNREACHABLE, pr.GetError().GetCode())
+	assert.True(t, pr.GetError().GetRetryable(), "code default is sent")
+
+	back := ProtoToResult(pr)
+	require.NotNil(t, back.ErrorInfo)
+	assert.Equal(t, agent.ErrCodeTargetUnreachable, back.ErrorInfo.Code)
+	assert.True(t, back.ErrorInfo.IsRetryable())
+}
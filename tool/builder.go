@@ -3,11 +3,21 @@ package tool
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/zero-day-ai/sdk/types"
 	"google.golang.org/protobuf/proto"
 )
 
+// ErrPostProcess is wrapped by errors returned from a post-process function
+// configured with SetPostProcess, so callers can tell output shaping failures
+// apart from failures of the tool's core logic.
+var ErrPostProcess = errors.New("output post-processing failed")
+
+// PostProcessFunc transforms a tool's output after execution.
+type PostProcessFunc func(ctx context.Context, output proto.Message) (proto.Message, error)
+
 // Config holds the configuration for building a Tool.
 type Config struct {
 	name              string
@@ -17,13 +27,23 @@ type Config struct {
 	inputMessageType  string
 	outputMessageType string
 	executeProtoFunc  func(ctx context.Context, input proto.Message) (proto.Message, error)
+	inputGuards       map[string][]string
+	postProcess       PostProcessFunc
+	examples          []ToolExample
+	migrations        []schemaMigration
+	statsWindow       int
+	slowThreshold     time.Duration
+	onSlow            SlowExecutionFunc
+	maxErrorRate      float64
+	maxP95            time.Duration
 }
 
 // NewConfig creates a new Config with default values.
 func NewConfig() *Config {
 	return &Config{
-		version: "1.0.0",
-		tags:    []string{},
+		version:     "1.0.0",
+		tags:        []string{},
+		statsWindow: DefaultStatsWindow,
 	}
 }
 
@@ -69,6 +89,95 @@ func (c *Config) SetExecuteProtoFunc(fn func(ctx context.Context, input proto.Me
 	return c
 }
 
+// SetInputGuards sets input guards enforced on the proto input before the
+// execute function runs. Keys are field paths (e.g. "target" or
+// "options.output_file") and values are guard names such as
+// schema.GuardShellSafe. Use this when a tool has no JSON schema to annotate.
+// See CheckInputGuards for path semantics.
+//
+// Example:
+//
+//	cfg.SetInputGuards(map[string][]string{
+//	    "target":   {schema.GuardHostnameOnly, schema.GuardPrivateIPForbidden},
+//	    "wordlist": {schema.GuardNoPathTraversal},
+//	})
+func (c *Config) SetInputGuards(guards map[string][]string) *Config {
+	c.inputGuards = guards
+	return c
+}
+
+// SetPostProcess sets a function that transforms the output after the execute
+// function succeeds and before it is returned. Use it to keep normalization,
+// such as denormalizing enum values or sorting repeated fields for
+// deterministic output, out of the execute function. The function may modify
+// the output in place or return a new message. Its errors wrap ErrPostProcess.
+//
+// Example:
+//
+//	cfg.SetPostProcess(func(ctx context.Context, output proto.Message) (proto.Message, error) {
+//	    resp := output.(*pb.ScanResponse)
+//	    sort.Slice(resp.Hosts, func(i, j int) bool { return resp.Hosts[i].Ip < resp.Hosts[j].Ip })
+//	    return resp, nil
+//	})
+func (c *Config) SetPostProcess(fn PostProcessFunc) *Config {
+	c.postProcess = fn
+	return c
+}
+
+// AddExample adds a sample invocation, surfaced in the tool's Descriptor.
+// Input and output are the protojson forms of the input and output messages;
+// output may be nil. When the message types are registered, New checks that
+// the example decodes into them.
+//
+// Example:
+//
+//	cfg.AddExample("Service scan of a single host",
+//	    map[string]any{"targets": []any{"10.0.0.5"}, "args": []any{"-sV"}},
+//	    map[string]any{"totalHosts": 1, "hostsUp": 1},
+//	)
+func (c *Config) AddExample(description string, input, output map[string]any) *Config {
+	c.examples = append(c.examples, ToolExample{
+		Description: description,
+		Input:       input,
+		Output:      output,
+	})
+	return c
+}
+
+// SetStatsWindow sets the number of most recent executions kept for the
+// tool's execution statistics. Defaults to DefaultStatsWindow.
+func (c *Config) SetStatsWindow(size int) *Config {
+	c.statsWindow = size
+	return c
+}
+
+// SetSlowThreshold sets a function called after each execution that takes
+// longer than d, with the redacted input and the execution's duration. The
+// function runs on the executing goroutine, before ExecuteProto returns, so
+// it should hand off any slow work such as exporting a trace.
+//
+// Example:
+//
+//	cfg.SetSlowThreshold(30*time.Second, func(ctx context.Context, input map[string]any, dur time.Duration) {
+//	    logger.WarnContext(ctx, "slow scan", "input", input, "duration", dur)
+//	})
+func (c *Config) SetSlowThreshold(d time.Duration, onSlow SlowExecutionFunc) *Config {
+	c.slowThreshold = d
+	c.onSlow = onSlow
+	return c
+}
+
+// SetHealthBounds sets the bounds on the tool's execution statistics beyond
+// which Health reports the tool degraded, with its statistics in the status
+// details. maxErrorRate is a fraction between 0 and 1. A zero bound is not
+// checked. Bounds are checked once the statistics window holds at least ten
+// executions.
+func (c *Config) SetHealthBounds(maxErrorRate float64, maxP95 time.Duration) *Config {
+	c.maxErrorRate = maxErrorRate
+	c.maxP95 = maxP95
+	return c
+}
+
 // sdkTool is the internal implementation of the Tool interface.
 type sdkTool struct {
 	name              string
@@ -78,10 +187,21 @@ type sdkTool struct {
 	inputMessageType  string
 	outputMessageType string
 	executeProtoFunc  func(ctx context.Context, input proto.Message) (proto.Message, error)
+	inputGuards       map[string][]string
+	postProcess       PostProcessFunc
+	examples          []ToolExample
+	migrations        []schemaMigration
+	stats             *statsWindow
+	slowThreshold     time.Duration
+	onSlow            SlowExecutionFunc
+	maxErrorRate      float64
+	maxP95            time.Duration
 }
 
 // New creates a new Tool from the provided Config.
-// Returns an error if required fields (name) are missing.
+// Returns an error if required fields (name) are missing, an input guard
+// is unknown, an example does not match the message types, or a schema
+// migration is invalid, or the statistics settings are out of range.
 func New(cfg *Config) (Tool, error) {
 	if cfg == nil {
 		return nil, errors.New("config cannot be nil")
@@ -91,6 +211,26 @@ func New(cfg *Config) (Tool, error) {
 		return nil, errors.New("tool name is required")
 	}
 
+	if err := validateInputGuards(cfg.inputGuards); err != nil {
+		return nil, err
+	}
+
+	if err := validateExamples(cfg.examples, cfg.inputMessageType, cfg.outputMessageType); err != nil {
+		return nil, err
+	}
+
+	if err := validateMigrations(cfg.migrations); err != nil {
+		return nil, err
+	}
+
+	if cfg.statsWindow <= 0 {
+		return nil, errors.New("stats window must be positive")
+	}
+
+	if cfg.maxErrorRate < 0 || cfg.maxErrorRate > 1 || cfg.maxP95 < 0 {
+		return nil, errors.New("health bounds must be non-negative and the error rate at most 1")
+	}
+
 	return &sdkTool{
 		name:              cfg.name,
 		version:           cfg.version,
@@ -99,6 +239,15 @@ func New(cfg *Config) (Tool, error) {
 		inputMessageType:  cfg.inputMessageType,
 		outputMessageType: cfg.outputMessageType,
 		executeProtoFunc:  cfg.executeProtoFunc,
+		inputGuards:       cfg.inputGuards,
+		postProcess:       cfg.postProcess,
+		examples:          cfg.examples,
+		migrations:        cfg.migrations,
+		stats:             newStatsWindow(cfg.statsWindow),
+		slowThreshold:     cfg.slowThreshold,
+		onSlow:            cfg.onSlow,
+		maxErrorRate:      cfg.maxErrorRate,
+		maxP95:            cfg.maxP95,
 	}, nil
 }
 
@@ -132,16 +281,47 @@ func (t *sdkTool) OutputMessageType() string {
 	return t.outputMessageType
 }
 
+// Examples returns the tool's sample invocations.
+func (t *sdkTool) Examples() []ToolExample {
+	return t.examples
+}
+
 // ExecuteProto runs the tool with proto message input/output.
+// Configured input guards are checked before the execute function is called,
+// and the post-process function, if any, runs on its output. Each execution
+// is recorded in the tool's statistics.
 func (t *sdkTool) ExecuteProto(ctx context.Context, input proto.Message) (proto.Message, error) {
+	start := time.Now()
+	output, err := t.executeProto(ctx, input)
+	t.recordExecution(ctx, input, time.Since(start), err)
+	return output, err
+}
+
+// executeProto checks input guards, runs the execute function and
+// post-processes its output.
+func (t *sdkTool) executeProto(ctx context.Context, input proto.Message) (proto.Message, error) {
 	if t.executeProtoFunc == nil {
 		return nil, errors.New("proto execution not configured for this tool")
 	}
-	return t.executeProtoFunc(ctx, input)
+	if err := CheckInputGuards(input, t.inputGuards); err != nil {
+		return nil, err
+	}
+
+	output, err := t.executeProtoFunc(ctx, input)
+	if err != nil || t.postProcess == nil || output == nil {
+		return output, err
+	}
+
+	processed, err := t.postProcess(ctx, output)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPostProcess, err)
+	}
+	return processed, nil
 }
 
 // Health returns the health status of the tool.
-// By default, tools are always healthy unless they implement custom health checks.
+// Tools are healthy unless their execution statistics exceed the bounds set
+// with SetHealthBounds, in which case they are degraded.
 func (t *sdkTool) Health(ctx context.Context) types.HealthStatus {
-	return types.NewHealthyStatus("tool is operational")
+	return t.statsHealth()
 }
@@ -3,6 +3,7 @@ package tool
 import (
 	"context"
 	"errors"
+	"sort"
 	"testing"
 
 	"github.com/zero-day-ai/sdk/types"
@@ -397,3 +398,70 @@ func TestSdkTool_Health(t *testing.T) {
 func TestSdkTool_InterfaceCompliance(t *testing.T) {
 	var _ Tool = (*sdkTool)(nil)
 }
+
+func TestSdkTool_ExecuteProto_PostProcess(t *testing.T) {
+	execute := func(ctx context.Context, input protolib.Message) (protolib.Message, error) {
+		return structpb.NewStruct(map[string]any{"hosts": []any{"10.0.0.9", "10.0.0.2"}})
+	}
+	sortHosts := func(ctx context.Context, output protolib.Message) (protolib.Message, error) {
+		st := output.(*structpb.Struct)
+		hosts := st.Fields["hosts"].GetListValue().GetValues()
+		sort.Slice(hosts, func(i, j int) bool { return hosts[i].GetStringValue() < hosts[j].GetStringValue() })
+		return st, nil
+	}
+
+	t.Run("output is post-processed", func(t *testing.T) {
+		tool, err := New(NewConfig().SetName("scanner").SetExecuteProtoFunc(execute).SetPostProcess(sortHosts))
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+
+		got, err := tool.ExecuteProto(context.Background(), &structpb.Struct{})
+		if err != nil {
+			t.Fatalf("ExecuteProto() error = %v", err)
+		}
+		hosts := got.(*structpb.Struct).Fields["hosts"].GetListValue().AsSlice()
+		if hosts[0] != "10.0.0.2" || hosts[1] != "10.0.0.9" {
+			t.Errorf("ExecuteProto() hosts = %v, want sorted", hosts)
+		}
+	})
+
+	t.Run("post-process error is distinct", func(t *testing.T) {
+		processErr := errors.New("unexpected output shape")
+		tool, err := New(NewConfig().SetName("scanner").SetExecuteProtoFunc(execute).
+			SetPostProcess(func(ctx context.Context, output protolib.Message) (protolib.Message, error) {
+				return nil, processErr
+			}))
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+
+		_, err = tool.ExecuteProto(context.Background(), &structpb.Struct{})
+		if !errors.Is(err, ErrPostProcess) || !errors.Is(err, processErr) {
+			t.Errorf("ExecuteProto() error = %v, want ErrPostProcess wrapping the cause", err)
+		}
+	})
+
+	t.Run("skipped when execution fails", func(t *testing.T) {
+		called := false
+		tool, err := New(NewConfig().SetName("scanner").
+			SetExecuteProtoFunc(func(ctx context.Context, input protolib.Message) (protolib.Message, error) {
+				return nil, errors.New("scan failed")
+			}).
+			SetPostProcess(func(ctx context.Context, output protolib.Message) (protolib.Message, error) {
+				called = true
+				return output, nil
+			}))
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+
+		_, err = tool.ExecuteProto(context.Background(), &structpb.Struct{})
+		if err == nil || errors.Is(err, ErrPostProcess) {
+			t.Errorf("ExecuteProto() error = %v, want the execution error", err)
+		}
+		if called {
+			t.Error("post-process ran after a failed execution")
+		}
+	})
+}
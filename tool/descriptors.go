@@ -0,0 +1,132 @@
+package tool
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// ErrNoProtoDescriptors is returned when a tool's input and output message
+// descriptors are not available.
+var ErrNoProtoDescriptors = errors.New("tool proto descriptors not available")
+
+// FileDescriptorSet returns the files that define the input and output
+// messages of t, together with all their transitive dependencies, ordered
+// so that every file follows its dependencies. The message types must be
+// registered, which happens when their generated Go package is imported.
+// It returns ErrNoProtoDescriptors if t declares no message types.
+func FileDescriptorSet(t Tool) (*descriptorpb.FileDescriptorSet, error) {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+
+	for _, typeName := range []string{t.InputMessageType(), t.OutputMessageType()} {
+		if typeName == "" {
+			continue
+		}
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(typeName))
+		if err != nil {
+			return nil, fmt.Errorf("tool %s: message type %q: %w", t.Name(), typeName, err)
+		}
+		add(mt.Descriptor().ParentFile())
+	}
+
+	if len(set.File) == 0 {
+		return nil, fmt.Errorf("tool %s: %w", t.Name(), ErrNoProtoDescriptors)
+	}
+	return set, nil
+}
+
+// ProtoDescriptors are the proto descriptors of a tool's input and output
+// messages. Clients that do not link the tool's generated Go package use
+// them to build requests and decode responses dynamically.
+type ProtoDescriptors struct {
+	// InputMessageType is the fully-qualified name of the input message.
+	InputMessageType string
+
+	// OutputMessageType is the fully-qualified name of the output message.
+	OutputMessageType string
+
+	// Files holds the files that define both messages and their
+	// dependencies.
+	Files *protoregistry.Files
+}
+
+// ParseProtoDescriptors builds ProtoDescriptors from a serialized
+// google.protobuf.FileDescriptorSet, as returned by a tool's DescribeTool
+// RPC. Both message types must be defined by the set.
+func ParseProtoDescriptors(inputType, outputType string, fileDescriptorSet []byte) (*ProtoDescriptors, error) {
+	if len(fileDescriptorSet) == 0 {
+		return nil, ErrNoProtoDescriptors
+	}
+
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(fileDescriptorSet, &set); err != nil {
+		return nil, fmt.Errorf("parse file descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("parse file descriptor set: %w", err)
+	}
+
+	d := &ProtoDescriptors{
+		InputMessageType:  inputType,
+		OutputMessageType: outputType,
+		Files:             files,
+	}
+	for _, typeName := range []string{inputType, outputType} {
+		if _, err := d.messageDescriptor(typeName); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// NewInput returns an empty dynamic instance of the input message.
+func (d *ProtoDescriptors) NewInput() (*dynamicpb.Message, error) {
+	md, err := d.messageDescriptor(d.InputMessageType)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicpb.NewMessage(md), nil
+}
+
+// NewOutput returns an empty dynamic instance of the output message.
+func (d *ProtoDescriptors) NewOutput() (*dynamicpb.Message, error) {
+	md, err := d.messageDescriptor(d.OutputMessageType)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicpb.NewMessage(md), nil
+}
+
+// messageDescriptor finds a message by its fully-qualified name.
+func (d *ProtoDescriptors) messageDescriptor(typeName string) (protoreflect.MessageDescriptor, error) {
+	desc, err := d.Files.FindDescriptorByName(protoreflect.FullName(typeName))
+	if err != nil {
+		return nil, fmt.Errorf("message type %q: %w", typeName, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("message type %q: not a message", typeName)
+	}
+	return md, nil
+}
@@ -0,0 +1,58 @@
+package tool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "github.com/zero-day-ai/sdk/api/gen/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFileDescriptorSet(t *testing.T) {
+	set, err := FileDescriptorSet(manifestTestTool(t, "gibson.types.Task", "gibson.types.Result"))
+	require.NoError(t, err)
+
+	index := make(map[string]int)
+	for i, f := range set.File {
+		index[f.GetName()] = i
+	}
+	require.Contains(t, index, "types.proto")
+	for _, f := range set.File {
+		for _, dep := range f.GetDependency() {
+			require.Contains(t, index, dep, "dependency of %s", f.GetName())
+			assert.Less(t, index[dep], index[f.GetName()], "%s precedes %s", dep, f.GetName())
+		}
+	}
+
+	_, err = FileDescriptorSet(manifestTestTool(t, "", ""))
+	assert.ErrorIs(t, err, ErrNoProtoDescriptors)
+
+	_, err = FileDescriptorSet(manifestTestTool(t, "gibson.types.Missing", ""))
+	assert.Error(t, err)
+}
+
+func TestParseProtoDescriptors(t *testing.T) {
+	set, err := FileDescriptorSet(manifestTestTool(t, "gibson.types.Task", "gibson.types.Result"))
+	require.NoError(t, err)
+	data, err := proto.Marshal(set)
+	require.NoError(t, err)
+
+	d, err := ParseProtoDescriptors("gibson.types.Task", "gibson.types.Result", data)
+	require.NoError(t, err)
+
+	in, err := d.NewInput()
+	require.NoError(t, err)
+	goal := in.Descriptor().Fields().ByName("goal")
+	require.NotNil(t, goal)
+
+	out, err := d.NewOutput()
+	require.NoError(t, err)
+	assert.Equal(t, "gibson.types.Result", string(out.Descriptor().FullName()))
+
+	_, err = ParseProtoDescriptors("gibson.types.Missing", "gibson.types.Result", data)
+	assert.Error(t, err)
+
+	_, err = ParseProtoDescriptors("gibson.types.Task", "gibson.types.Result", nil)
+	assert.ErrorIs(t, err, ErrNoProtoDescriptors)
+}
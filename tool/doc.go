@@ -101,6 +101,86 @@
 //   - Output validation occurs after execute function completes
 //   - Validation errors are returned to the caller
 //
+// # Input Guards
+//
+// Tools that pass agent-supplied values to the filesystem, network, or a shell
+// can reject common injection payloads before execution. Guards are configured
+// per input field and checked before the execute function runs:
+//
+//	cfg := tool.NewConfig().
+//		SetName("dirscan").
+//		SetInputGuards(map[string][]string{
+//			"target":   {schema.GuardHostnameOnly, schema.GuardPrivateIPForbidden},
+//			"wordlist": {schema.GuardNoPathTraversal},
+//		})
+//
+// Schemas can carry the same guards in schema.JSON.Guards, which are enforced by
+// schema validation. Custom Tool implementations can call CheckInputGuards.
+//
+// # Output Post-Processing
+//
+// Normalization of tool output, such as sorting results for determinism, can
+// be declared separately from the execute function with SetPostProcess. It
+// runs after a successful execution, and its errors wrap ErrPostProcess so
+// they are distinguishable from execution failures:
+//
+//	cfg.SetPostProcess(func(ctx context.Context, output proto.Message) (proto.Message, error) {
+//	    resp := output.(*pb.ScanResponse)
+//	    sort.Slice(resp.Hosts, func(i, j int) bool { return resp.Hosts[i].Ip < resp.Hosts[j].Ip })
+//	    return resp, nil
+//	})
+//
+// # Examples
+//
+// Sample invocations help discovery UIs and LLM tool selection. Add them with
+// AddExample, as the protojson forms of the input and output messages; they
+// appear in the tool's Descriptor. New rejects examples that do not decode
+// into the registered message types:
+//
+//	cfg.AddExample("Service scan of a single host",
+//	    map[string]any{"targets": []any{"10.0.0.5"}, "args": []any{"-sV"}},
+//	    nil,
+//	)
+//
+// # Manifests
+//
+// ExportManifest writes a tool's descriptor together with JSON schemas of its
+// input and output messages and the manifest format version. Committing the
+// manifest lets CI compare it against the next build with
+// CheckManifestCompatibility and fail on input changes that break existing
+// callers.
+//
+// FileDescriptorSet collects the proto files that define a tool's input and
+// output messages, which tool servers hand to clients. ParseProtoDescriptors
+// turns a serialized set back into descriptors whose NewInput and NewOutput
+// return dynamic messages.
+//
+// # Input Schema Migrations
+//
+// When a new major version changes the input shape, AddSchemaMigration keeps
+// callers pinned to the old shape working. Callers mark legacy inputs with
+// "_schema_version", and tool servers and workers run the migrations on the
+// JSON input before it is decoded into the input message:
+//
+//	cfg.AddSchemaMigration("1", func(in map[string]any) map[string]any {
+//	    in["targets"] = []any{in["host"]}
+//	    delete(in, "host")
+//	    return in
+//	})
+//
+// # Execution Statistics
+//
+// Tools built with New implement StatsProvider: Stats summarizes the most
+// recent executions (DefaultStatsWindow by default) with their count, errors
+// and duration percentiles. SetSlowThreshold reports executions exceeding a
+// duration, with secrets removed from the input, and SetHealthBounds makes
+// Health report the tool degraded, with its statistics, when the error rate
+// or p95 duration exceeds a bound:
+//
+//	cfg.SetSlowThreshold(30*time.Second, func(ctx context.Context, input map[string]any, dur time.Duration) {
+//	    logger.WarnContext(ctx, "slow scan", "input", input, "duration", dur)
+//	}).SetHealthBounds(0.25, 2*time.Minute)
+//
 // # Context Support
 //
 // All tool operations accept a context.Context parameter, enabling:
@@ -111,6 +191,7 @@
 //
 // # Thread Safety
 //
-// Tool instances are immutable after creation and safe for concurrent use.
+// Tool instances are immutable after creation, apart from their execution
+// statistics, and safe for concurrent use.
 // Multiple goroutines can safely call Execute on the same tool instance.
 package tool
@@ -0,0 +1,67 @@
+package tool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ExampleProvider is an optional interface for tools that declare sample
+// invocations. Tools built with New implement it; ToDescriptor includes the
+// examples of any tool that does.
+type ExampleProvider interface {
+	// Examples returns sample invocations of the tool.
+	Examples() []ToolExample
+}
+
+// examplesOf returns the examples of t, or nil if it declares none.
+func examplesOf(t Tool) []ToolExample {
+	if p, ok := t.(ExampleProvider); ok {
+		return p.Examples()
+	}
+	return nil
+}
+
+// validateExamples checks that each example decodes into the input and
+// output message types. Types that are not set or not registered are not
+// checked.
+func validateExamples(examples []ToolExample, inputType, outputType string) error {
+	for i, ex := range examples {
+		if ex.Input == nil {
+			return fmt.Errorf("example %d: input is required", i)
+		}
+		if err := checkExampleMessage(ex.Input, inputType); err != nil {
+			return fmt.Errorf("example %d: input: %w", i, err)
+		}
+		if ex.Output == nil {
+			continue
+		}
+		if err := checkExampleMessage(ex.Output, outputType); err != nil {
+			return fmt.Errorf("example %d: output: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// checkExampleMessage decodes value into the named message type.
+func checkExampleMessage(value map[string]any, typeName string) error {
+	if typeName == "" {
+		return nil
+	}
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(typeName))
+	if err != nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(data, msgType.New().Interface()); err != nil {
+		return fmt.Errorf("does not match %s: %w", typeName, err)
+	}
+	return nil
+}
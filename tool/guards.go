@@ -0,0 +1,183 @@
+package tool
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zero-day-ai/sdk/schema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// CheckInputGuards applies input guards to the string fields of a proto input
+// message. Guards are keyed by field path: field names separated by dots
+// (e.g. "target" or "options.output_file"), matching either the proto field
+// name or its JSON name. For google.protobuf.Struct inputs, path segments are
+// struct keys.
+//
+// Repeated fields and map values are checked element by element, and unset
+// fields are skipped. Paths that do not resolve to string fields are reported
+// as errors so a typo never silently disables a guard. See schema.CheckGuard
+// for the available guards.
+//
+// Tools built with NewConfig enforce guards configured with SetInputGuards
+// automatically; custom Tool implementations can call this from ExecuteProto.
+func CheckInputGuards(input proto.Message, guards map[string][]string) error {
+	if len(guards) == 0 {
+		return nil
+	}
+	if input == nil {
+		return fmt.Errorf("input guards: input is nil")
+	}
+
+	// Check paths in a stable order so errors are deterministic
+	paths := make([]string, 0, len(guards))
+	for path := range guards {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		values, err := guardedValues(input.ProtoReflect(), strings.Split(path, "."))
+		if err != nil {
+			return fmt.Errorf("input field %q: %w", path, err)
+		}
+		for _, value := range values {
+			for _, guard := range guards[path] {
+				if err := schema.CheckGuard(guard, value); err != nil {
+					return fmt.Errorf("input field %q: %w", path, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateInputGuards checks that every configured guard name is known.
+func validateInputGuards(guards map[string][]string) error {
+	for path, names := range guards {
+		if path == "" {
+			return fmt.Errorf("input guard path cannot be empty")
+		}
+		for _, name := range names {
+			if !schema.IsKnownGuard(name) {
+				return fmt.Errorf("input guard for field %q: unknown guard %q", path, name)
+			}
+		}
+	}
+	return nil
+}
+
+// guardedValues collects the string values at path within msg.
+func guardedValues(msg protoreflect.Message, path []string) ([]string, error) {
+	if s, ok := msg.Interface().(*structpb.Struct); ok {
+		v, ok := s.GetFields()[path[0]]
+		if !ok {
+			return nil, nil
+		}
+		return structValues(v, path[1:])
+	}
+
+	fd := findField(msg.Descriptor(), path[0])
+	if fd == nil {
+		return nil, fmt.Errorf("no field %q in %s", path[0], msg.Descriptor().FullName())
+	}
+	if !msg.Has(fd) {
+		return nil, nil
+	}
+	value := msg.Get(fd)
+
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Kind() != protoreflect.StringKind || len(path) > 1 {
+			return nil, fmt.Errorf("field %q is not a string map", path[0])
+		}
+		var values []string
+		value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+			values = append(values, v.String())
+			return true
+		})
+		return values, nil
+
+	case fd.IsList():
+		list := value.List()
+		var values []string
+		for i := 0; i < list.Len(); i++ {
+			vs, err := fieldValues(fd, list.Get(i), path)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, vs...)
+		}
+		return values, nil
+
+	default:
+		return fieldValues(fd, value, path)
+	}
+}
+
+// fieldValues resolves the remainder of path for a single (non-list) value.
+func fieldValues(fd protoreflect.FieldDescriptor, value protoreflect.Value, path []string) ([]string, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if len(path) > 1 {
+			return nil, fmt.Errorf("field %q is a string and has no field %q", path[0], path[1])
+		}
+		return []string{value.String()}, nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if len(path) == 1 {
+			if s, ok := value.Message().Interface().(*structpb.Value); ok {
+				return structValues(s, nil)
+			}
+			return nil, fmt.Errorf("field %q is a message, not a string", path[0])
+		}
+		return guardedValues(value.Message(), path[1:])
+	default:
+		return nil, fmt.Errorf("field %q is %s, not a string", path[0], fd.Kind())
+	}
+}
+
+// structValues collects the string values at path within a structpb.Value.
+func structValues(v *structpb.Value, path []string) ([]string, error) {
+	if len(path) > 0 {
+		s := v.GetStructValue()
+		if s == nil {
+			return nil, nil
+		}
+		next, ok := s.GetFields()[path[0]]
+		if !ok {
+			return nil, nil
+		}
+		return structValues(next, path[1:])
+	}
+
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return []string{kind.StringValue}, nil
+	case *structpb.Value_ListValue:
+		var values []string
+		for _, item := range kind.ListValue.GetValues() {
+			vs, err := structValues(item, nil)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, vs...)
+		}
+		return values, nil
+	case *structpb.Value_NullValue:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("value is not a string")
+	}
+}
+
+// findField looks up a field by proto name or JSON name.
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return fields.ByJSONName(name)
+}
@@ -0,0 +1,149 @@
+package tool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/api/gen/toolspb"
+	"github.com/zero-day-ai/sdk/schema"
+	protolib "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCheckInputGuards_TypedMessage(t *testing.T) {
+	guards := map[string][]string{
+		"targets":    {schema.GuardPrivateIPForbidden},
+		"matchRegex": {schema.GuardShellSafe},
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		input := &toolspb.HttpxRequest{Targets: []string{"https://example.com", "93.184.216.34"}}
+		assert.NoError(t, CheckInputGuards(input, guards))
+	})
+
+	t.Run("repeated element rejected", func(t *testing.T) {
+		input := &toolspb.HttpxRequest{Targets: []string{"https://example.com", "http://[::1]:8080/"}}
+		err := CheckInputGuards(input, guards)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `input field "targets": guard private_ip_forbidden`)
+	})
+
+	t.Run("json name rejected", func(t *testing.T) {
+		input := &toolspb.HttpxRequest{MatchRegex: "admin$(id)"}
+		err := CheckInputGuards(input, guards)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `input field "matchRegex": guard shell_safe`)
+	})
+
+	t.Run("unset fields skipped", func(t *testing.T) {
+		assert.NoError(t, CheckInputGuards(&toolspb.HttpxRequest{}, guards))
+	})
+}
+
+func TestCheckInputGuards_NestedAndMapFields(t *testing.T) {
+	t.Run("nested message path", func(t *testing.T) {
+		guards := map[string][]string{"query.node_types": {schema.GuardShellSafe}}
+		input := &pb.GraphRAGQueryRequest{Query: &pb.GraphQuery{NodeTypes: []string{"host", "port;rm"}}}
+
+		err := CheckInputGuards(input, guards)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `input field "query.node_types"`)
+	})
+
+	t.Run("map values", func(t *testing.T) {
+		guards := map[string][]string{"parameters": {schema.GuardNoPathTraversal}}
+		input := &toolspb.PayloadExecuteRequest{Parameters: map[string]string{"file": "%2e%2e/etc/passwd"}}
+
+		err := CheckInputGuards(input, guards)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no_path_traversal")
+	})
+}
+
+func TestCheckInputGuards_Struct(t *testing.T) {
+	guards := map[string][]string{
+		"host":         {schema.GuardHostnameOnly},
+		"options.file": {schema.GuardNoPathTraversal},
+	}
+
+	input, err := structpb.NewStruct(map[string]any{
+		"host":    "example.com",
+		"options": map[string]any{"file": "report.txt"},
+	})
+	require.NoError(t, err)
+	assert.NoError(t, CheckInputGuards(input, guards))
+
+	input, err = structpb.NewStruct(map[string]any{
+		"host":    "example.com",
+		"options": map[string]any{"file": "../../etc/shadow"},
+	})
+	require.NoError(t, err)
+	err = CheckInputGuards(input, guards)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `input field "options.file"`)
+
+	input, err = structpb.NewStruct(map[string]any{"host": "localhost; curl evil"})
+	require.NoError(t, err)
+	assert.Error(t, CheckInputGuards(input, guards))
+}
+
+func TestCheckInputGuards_InvalidPaths(t *testing.T) {
+	input := &toolspb.HttpxRequest{Timeout: 5, Targets: []string{"example.com"}}
+
+	tests := []struct {
+		name   string
+		path   string
+		errMsg string
+	}{
+		{"unknown field", "target", `no field "target"`},
+		{"non-string field", "timeout", "not a string"},
+		{"path through string", "targets.host", "is a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckInputGuards(input, map[string][]string{tt.path: {schema.GuardShellSafe}})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestSetInputGuards(t *testing.T) {
+	executed := false
+	cfg := NewConfig().
+		SetName("guarded-tool").
+		SetInputGuards(map[string][]string{"host": {schema.GuardHostnameOnly}}).
+		SetExecuteProtoFunc(func(ctx context.Context, input protolib.Message) (protolib.Message, error) {
+			executed = true
+			return input, nil
+		})
+
+	tl, err := New(cfg)
+	require.NoError(t, err)
+
+	ok, _ := structpb.NewStruct(map[string]any{"host": "example.com"})
+	_, err = tl.ExecuteProto(context.Background(), ok)
+	require.NoError(t, err)
+	assert.True(t, executed)
+
+	executed = false
+	bad, _ := structpb.NewStruct(map[string]any{"host": "example.com && id"})
+	_, err = tl.ExecuteProto(context.Background(), bad)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hostname_only")
+	assert.False(t, executed, "execute function must not run when a guard rejects input")
+}
+
+func TestSetInputGuards_UnknownGuard(t *testing.T) {
+	cfg := NewConfig().
+		SetName("guarded-tool").
+		SetInputGuards(map[string][]string{"host": {"hostname"}})
+
+	_, err := New(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown guard "hostname"`)
+}
@@ -0,0 +1,197 @@
+package tool
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/zero-day-ai/sdk/schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ManifestSchemaVersion is the version of the manifest format written by
+// ExportManifest. CheckManifestCompatibility rejects manifests with a
+// different version.
+const ManifestSchemaVersion = 1
+
+// Manifest is the exported description of a tool: its descriptor plus JSON
+// schemas of its input and output messages in their protojson form. Tool
+// repositories commit the manifest so CI can detect schema changes that
+// break existing callers.
+type Manifest struct {
+	// SchemaVersion is the manifest format version, ManifestSchemaVersion
+	// when written by ExportManifest.
+	SchemaVersion int `json:"schema_version"`
+
+	Descriptor
+
+	// InputSchema describes the input message. Empty if the tool declares
+	// no input message type.
+	InputSchema schema.JSON `json:"input_schema"`
+
+	// OutputSchema describes the output message. Empty if the tool declares
+	// no output message type.
+	OutputSchema schema.JSON `json:"output_schema"`
+}
+
+// ExportManifest returns the manifest of t as indented JSON. The input and
+// output message types must be registered, which happens when their
+// generated Go package is imported.
+func ExportManifest(t Tool) ([]byte, error) {
+	desc := ToDescriptor(t)
+	input, err := messageTypeSchema(desc.InputMessageType)
+	if err != nil {
+		return nil, fmt.Errorf("export manifest for %s: input: %w", desc.Name, err)
+	}
+	output, err := messageTypeSchema(desc.OutputMessageType)
+	if err != nil {
+		return nil, fmt.Errorf("export manifest for %s: output: %w", desc.Name, err)
+	}
+
+	return json.MarshalIndent(Manifest{
+		SchemaVersion: ManifestSchemaVersion,
+		Descriptor:    desc,
+		InputSchema:   input,
+		OutputSchema:  output,
+	}, "", "  ")
+}
+
+// CheckManifestCompatibility compares two exported manifests of the same tool
+// and reports whether inputs written against the old one are still accepted
+// by the new one, with the changes that reduce compatibility. Only the input
+// schema is compared, since it decides whether existing call sites break.
+//
+// It is meant for CI gates in tool repositories:
+//
+//	level, reasons, err := tool.CheckManifestCompatibility(committed, current)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if level == schema.CompatBreaking {
+//	    for _, c := range reasons {
+//	        fmt.Println(c)
+//	    }
+//	    os.Exit(1)
+//	}
+func CheckManifestCompatibility(oldManifest, newManifest []byte) (schema.CompatLevel, []schema.Change, error) {
+	oldM, err := parseManifest(oldManifest)
+	if err != nil {
+		return schema.CompatBreaking, nil, fmt.Errorf("old manifest: %w", err)
+	}
+	newM, err := parseManifest(newManifest)
+	if err != nil {
+		return schema.CompatBreaking, nil, fmt.Errorf("new manifest: %w", err)
+	}
+	if oldM.Name != newM.Name {
+		return schema.CompatBreaking, nil, fmt.Errorf("manifests describe different tools: %q and %q", oldM.Name, newM.Name)
+	}
+
+	level, reasons := schema.Compatibility(oldM.InputSchema, newM.InputSchema)
+	return level, reasons, nil
+}
+
+// parseManifest decodes a manifest and checks its format version.
+func parseManifest(data []byte) (Manifest, error) {
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return Manifest{}, fmt.Errorf("invalid manifest: %w", err)
+	}
+	if m.SchemaVersion != ManifestSchemaVersion {
+		return Manifest{}, fmt.Errorf("unsupported manifest schema version %d (supported: %d)", m.SchemaVersion, ManifestSchemaVersion)
+	}
+	return m, nil
+}
+
+// messageTypeSchema returns the JSON schema of a registered message type, or
+// an empty schema if typeName is empty.
+func messageTypeSchema(typeName string) (schema.JSON, error) {
+	if typeName == "" {
+		return schema.JSON{}, nil
+	}
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(typeName))
+	if err != nil {
+		return schema.JSON{}, fmt.Errorf("message type %s is not registered: %w", typeName, err)
+	}
+	return messageSchema(msgType.Descriptor(), make(map[protoreflect.FullName]bool)), nil
+}
+
+// messageSchema describes a message as a JSON object keyed by the protojson
+// field names. Proto3 has no required fields, so none are listed. Recursive
+// messages are described as untyped objects past the first level.
+func messageSchema(md protoreflect.MessageDescriptor, visiting map[protoreflect.FullName]bool) schema.JSON {
+	if strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+		return wellKnownSchema(md.FullName())
+	}
+	if visiting[md.FullName()] {
+		return schema.JSON{Type: "object"}
+	}
+	visiting[md.FullName()] = true
+	defer delete(visiting, md.FullName())
+
+	props := make(map[string]schema.JSON)
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		props[fd.JSONName()] = fieldSchema(fd, visiting)
+	}
+	return schema.JSON{Type: "object", Properties: props}
+}
+
+// fieldSchema describes a single field, including repeated and map fields.
+func fieldSchema(fd protoreflect.FieldDescriptor, visiting map[protoreflect.FullName]bool) schema.JSON {
+	if fd.IsMap() {
+		return schema.JSON{Type: "object"}
+	}
+	s := kindSchema(fd, visiting)
+	if fd.IsList() {
+		return schema.Array(s)
+	}
+	return s
+}
+
+func kindSchema(fd protoreflect.FieldDescriptor, visiting map[protoreflect.FullName]bool) schema.JSON {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return schema.Bool()
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return schema.String()
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return schema.Number()
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]any, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return schema.JSON{Type: "string", Enum: names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageSchema(fd.Message(), visiting)
+	default:
+		// All remaining kinds are integers of some width and signedness.
+		return schema.Int()
+	}
+}
+
+// wellKnownSchema describes the google.protobuf types by their protojson
+// form.
+func wellKnownSchema(name protoreflect.FullName) schema.JSON {
+	switch name {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return schema.String()
+	case "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return schema.String()
+	case "google.protobuf.BoolValue":
+		return schema.Bool()
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue":
+		return schema.Number()
+	case "google.protobuf.Int32Value", "google.protobuf.Int64Value", "google.protobuf.UInt32Value", "google.protobuf.UInt64Value":
+		return schema.Int()
+	case "google.protobuf.Struct":
+		return schema.JSON{Type: "object"}
+	case "google.protobuf.ListValue":
+		return schema.Array(schema.Any())
+	default:
+		return schema.Any()
+	}
+}
@@ -0,0 +1,99 @@
+package tool
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "github.com/zero-day-ai/sdk/api/gen/proto"
+	"github.com/zero-day-ai/sdk/schema"
+)
+
+func manifestTestTool(t *testing.T, input, output string) Tool {
+	t.Helper()
+	tl, err := New(NewConfig().
+		SetName("task-runner").
+		SetDescription("runs tasks").
+		SetInputMessageType(input).
+		SetOutputMessageType(output))
+	require.NoError(t, err)
+	return tl
+}
+
+func TestExportManifest(t *testing.T) {
+	data, err := ExportManifest(manifestTestTool(t, "gibson.types.Task", "gibson.types.Result"))
+	require.NoError(t, err)
+
+	var m Manifest
+	require.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, ManifestSchemaVersion, m.SchemaVersion)
+	assert.Equal(t, "task-runner", m.Name)
+	assert.Equal(t, "gibson.types.Task", m.InputMessageType)
+
+	in := m.InputSchema
+	assert.Equal(t, "object", in.Type)
+	assert.Equal(t, "string", in.Properties["goal"].Type)
+	assert.Equal(t, "string", in.Properties["idempotencyKey"].Type)
+	assert.Equal(t, "object", in.Properties["context"].Type, "map fields are objects")
+
+	constraints := in.Properties["constraints"]
+	assert.Equal(t, "object", constraints.Type)
+	assert.Equal(t, "integer", constraints.Properties["maxTurns"].Type)
+	require.NotNil(t, constraints.Properties["allowedTools"].Items)
+	assert.Equal(t, "array", constraints.Properties["allowedTools"].Type)
+	assert.Equal(t, "string", constraints.Properties["allowedTools"].Items.Type)
+
+	status := m.OutputSchema.Properties["status"]
+	assert.Equal(t, "string", status.Type)
+	assert.Contains(t, status.Enum, "RESULT_STATUS_SUCCESS")
+}
+
+func TestExportManifest_UnregisteredType(t *testing.T) {
+	_, err := ExportManifest(manifestTestTool(t, "gibson.types.NoSuchMessage", ""))
+	assert.ErrorContains(t, err, "not registered")
+}
+
+func TestCheckManifestCompatibility(t *testing.T) {
+	manifest := func(t *testing.T, name string, input schema.JSON) []byte {
+		t.Helper()
+		data, err := json.Marshal(Manifest{
+			SchemaVersion: ManifestSchemaVersion,
+			Descriptor:    Descriptor{Name: name},
+			InputSchema:   input,
+		})
+		require.NoError(t, err)
+		return data
+	}
+	base := schema.Object(map[string]schema.JSON{"target": schema.String()})
+
+	t.Run("same manifest", func(t *testing.T) {
+		data, err := ExportManifest(manifestTestTool(t, "gibson.types.Task", "gibson.types.Result"))
+		require.NoError(t, err)
+
+		level, reasons, err := CheckManifestCompatibility(data, data)
+		require.NoError(t, err)
+		assert.Equal(t, schema.CompatFull, level)
+		assert.Empty(t, reasons)
+	})
+
+	t.Run("breaking change", func(t *testing.T) {
+		next := schema.Object(map[string]schema.JSON{"target": schema.String(), "scope": schema.String()}, "scope")
+
+		level, reasons, err := CheckManifestCompatibility(manifest(t, "scanner", base), manifest(t, "scanner", next))
+		require.NoError(t, err)
+		assert.Equal(t, schema.CompatBreaking, level)
+		require.Len(t, reasons, 1)
+		assert.Equal(t, schema.ChangeRequiredAdded, reasons[0].Kind)
+	})
+
+	t.Run("different tools", func(t *testing.T) {
+		_, _, err := CheckManifestCompatibility(manifest(t, "scanner", base), manifest(t, "crawler", base))
+		assert.ErrorContains(t, err, "different tools")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, _, err := CheckManifestCompatibility([]byte(`{"schema_version":99,"name":"scanner"}`), manifest(t, "scanner", base))
+		assert.ErrorContains(t, err, "unsupported manifest schema version")
+	})
+}
@@ -0,0 +1,166 @@
+package tool
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SchemaVersionKey is the optional input field that names the version of
+// the input schema a caller used. See Config.AddSchemaMigration.
+const SchemaVersionKey = "_schema_version"
+
+// ErrSchemaMigration is wrapped by errors returned when a legacy input
+// cannot be migrated to the current schema.
+var ErrSchemaMigration = errors.New("input schema migration failed")
+
+// SchemaMigrationFunc upgrades an input from one schema version to the next.
+type SchemaMigrationFunc func(input map[string]any) map[string]any
+
+// InputMigrator is an optional interface for tools that accept inputs in
+// older schema versions. Servers call MigrateInput on the JSON input before
+// decoding it into the input message. Tools built with New implement it.
+type InputMigrator interface {
+	// MigrateInput upgrades input to the current schema. input is the
+	// decoded JSON object, with numbers as json.Number.
+	MigrateInput(input map[string]any) (map[string]any, error)
+}
+
+// schemaMigration upgrades inputs of one schema version.
+type schemaMigration struct {
+	fromVersion string
+	migrate     SchemaMigrationFunc
+}
+
+// AddSchemaMigration registers a function that upgrades inputs sent with
+// "_schema_version": fromVersion to the next schema version. Register
+// migrations from the oldest version to the newest: an input runs through
+// the migration for its version and every migration registered after it, so
+// a v1 input passes through the 1→2 and 2→3 migrations in turn. Inputs
+// without a version, or with a version no migration is registered for, are
+// taken to be current. The version field is removed before the input is
+// decoded.
+//
+// Numbers in the input map are json.Number values; the input package's
+// accessors handle them.
+//
+// Example:
+//
+//	// v2 renamed "host" to "targets" and made it a list
+//	cfg.AddSchemaMigration("1", func(in map[string]any) map[string]any {
+//	    if host, ok := in["host"]; ok {
+//	        in["targets"] = []any{host}
+//	        delete(in, "host")
+//	    }
+//	    return in
+//	})
+func (c *Config) AddSchemaMigration(fromVersion string, migrate SchemaMigrationFunc) *Config {
+	c.migrations = append(c.migrations, schemaMigration{
+		fromVersion: fromVersion,
+		migrate:     migrate,
+	})
+	return c
+}
+
+// validateMigrations checks that every migration has a version and a
+// function, and that no version is registered twice.
+func validateMigrations(migrations []schemaMigration) error {
+	seen := make(map[string]bool, len(migrations))
+	for _, m := range migrations {
+		if m.fromVersion == "" {
+			return errors.New("schema migration requires a from version")
+		}
+		if m.migrate == nil {
+			return fmt.Errorf("schema migration from version %q has no function", m.fromVersion)
+		}
+		if seen[m.fromVersion] {
+			return fmt.Errorf("duplicate schema migration from version %q", m.fromVersion)
+		}
+		seen[m.fromVersion] = true
+	}
+	return nil
+}
+
+// MigrateInput upgrades input from the schema version it declares in
+// SchemaVersionKey to the current schema.
+func (t *sdkTool) MigrateInput(input map[string]any) (map[string]any, error) {
+	raw, ok := input[SchemaVersionKey]
+	if !ok {
+		return input, nil
+	}
+	delete(input, SchemaVersionKey)
+
+	version, ok := schemaVersionString(raw)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be a string or number, got %T", ErrSchemaMigration, SchemaVersionKey, raw)
+	}
+
+	start := -1
+	for i, m := range t.migrations {
+		if m.fromVersion == version {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return input, nil
+	}
+
+	for _, m := range t.migrations[start:] {
+		input = m.migrate(input)
+		if input == nil {
+			return nil, fmt.Errorf("%w: migration from version %q returned no input", ErrSchemaMigration, m.fromVersion)
+		}
+		delete(input, SchemaVersionKey)
+	}
+	return input, nil
+}
+
+// schemaVersionString formats a decoded SchemaVersionKey value.
+func schemaVersionString(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case float64:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+// MigrateInputJSON upgrades a JSON input to t's current input schema if t
+// implements InputMigrator and the input declares a schema version. Other
+// inputs are returned unchanged. Errors wrap ErrSchemaMigration.
+func MigrateInputJSON(t Tool, inputJSON string) (string, error) {
+	migrator, ok := t.(InputMigrator)
+	if !ok || !strings.Contains(inputJSON, SchemaVersionKey) {
+		return inputJSON, nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(inputJSON))
+	dec.UseNumber()
+	var input map[string]any
+	if err := dec.Decode(&input); err != nil || input == nil {
+		// Not a JSON object; leave it for the message decoder to reject
+		return inputJSON, nil
+	}
+	if _, ok := input[SchemaVersionKey]; !ok {
+		return inputJSON, nil
+	}
+
+	migrated, err := migrator.MigrateInput(input)
+	if err != nil {
+		if !errors.Is(err, ErrSchemaMigration) {
+			err = fmt.Errorf("%w: %w", ErrSchemaMigration, err)
+		}
+		return "", err
+	}
+	out, err := json.Marshal(migrated)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrSchemaMigration, err)
+	}
+	return string(out), nil
+}
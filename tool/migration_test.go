@@ -0,0 +1,150 @@
+package tool
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// migratingTool has a v3 input schema: v1 sent "host", v2 renamed it to
+// "target", and v3 made it the list "targets".
+func migratingTool(t *testing.T) Tool {
+	t.Helper()
+	tl, err := New(NewConfig().
+		SetName("scanner").
+		SetVersion("3.0.0").
+		AddSchemaMigration("1", func(in map[string]any) map[string]any {
+			in["target"] = in["host"]
+			delete(in, "host")
+			return in
+		}).
+		AddSchemaMigration("2", func(in map[string]any) map[string]any {
+			in["targets"] = []any{in["target"]}
+			delete(in, "target")
+			return in
+		}))
+	require.NoError(t, err)
+	return tl
+}
+
+func TestMigrateInputJSON(t *testing.T) {
+	tl := migratingTool(t)
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "from v1 through every migration",
+			input: `{"_schema_version":"1","host":"10.0.0.5","ports":[22,443]}`,
+			want:  `{"targets":["10.0.0.5"],"ports":[22,443]}`,
+		},
+		{
+			name:  "from v2",
+			input: `{"_schema_version":"2","target":"10.0.0.5"}`,
+			want:  `{"targets":["10.0.0.5"]}`,
+		},
+		{
+			name:  "numeric version",
+			input: `{"_schema_version":1,"host":"10.0.0.5"}`,
+			want:  `{"targets":["10.0.0.5"]}`,
+		},
+		{
+			name:  "current version",
+			input: `{"_schema_version":"3","targets":["10.0.0.5"]}`,
+			want:  `{"targets":["10.0.0.5"]}`,
+		},
+		{
+			name:  "no version",
+			input: `{"targets":["10.0.0.5"]}`,
+			want:  `{"targets":["10.0.0.5"]}`,
+		},
+		{
+			name:  "large integers survive",
+			input: `{"_schema_version":"2","target":"h","seed":9007199254740993}`,
+			want:  `{"targets":["h"],"seed":9007199254740993}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MigrateInputJSON(tl, tt.input)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, got)
+		})
+	}
+
+	t.Run("not an object", func(t *testing.T) {
+		got, err := MigrateInputJSON(tl, `["_schema_version"]`)
+		require.NoError(t, err)
+		assert.Equal(t, `["_schema_version"]`, got)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := MigrateInputJSON(tl, `{"_schema_version":true}`)
+		assert.ErrorIs(t, err, ErrSchemaMigration)
+	})
+
+	t.Run("tool without migrations", func(t *testing.T) {
+		input := `{"_schema_version":"1","host":"10.0.0.5"}`
+		got, err := MigrateInputJSON(&migratorFreeTool{Tool: tl}, input)
+		require.NoError(t, err)
+		assert.Equal(t, input, got)
+	})
+}
+
+// migratorFreeTool hides the InputMigrator implementation of a tool.
+type migratorFreeTool struct{ Tool }
+
+// failingMigrator is an InputMigrator whose migration fails.
+type failingMigrator struct{ Tool }
+
+func (failingMigrator) MigrateInput(map[string]any) (map[string]any, error) {
+	return nil, errors.New("unsupported shape")
+}
+
+func TestMigrateInputJSON_Errors(t *testing.T) {
+	t.Run("migration returns nil", func(t *testing.T) {
+		tl, err := New(NewConfig().
+			SetName("scanner").
+			AddSchemaMigration("1", func(map[string]any) map[string]any { return nil }))
+		require.NoError(t, err)
+
+		_, err = MigrateInputJSON(tl, `{"_schema_version":"1"}`)
+		assert.ErrorIs(t, err, ErrSchemaMigration)
+	})
+
+	t.Run("custom migrator", func(t *testing.T) {
+		_, err := MigrateInputJSON(failingMigrator{}, `{"_schema_version":"1"}`)
+		assert.ErrorIs(t, err, ErrSchemaMigration)
+		assert.ErrorContains(t, err, "unsupported shape")
+	})
+}
+
+func TestNew_InvalidSchemaMigration(t *testing.T) {
+	identity := func(in map[string]any) map[string]any { return in }
+
+	_, err := New(NewConfig().SetName("scanner").AddSchemaMigration("", identity))
+	assert.ErrorContains(t, err, "requires a from version")
+
+	_, err = New(NewConfig().SetName("scanner").AddSchemaMigration("1", nil))
+	assert.ErrorContains(t, err, "has no function")
+
+	_, err = New(NewConfig().SetName("scanner").
+		AddSchemaMigration("1", identity).
+		AddSchemaMigration("1", identity))
+	assert.ErrorContains(t, err, "duplicate")
+}
+
+func TestSDKTool_MigrateInput(t *testing.T) {
+	migrator, ok := migratingTool(t).(InputMigrator)
+	require.True(t, ok)
+
+	got, err := migrator.MigrateInput(map[string]any{SchemaVersionKey: json.Number("1"), "host": "a"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"targets": []any{"a"}}, got)
+}
@@ -0,0 +1,212 @@
+package tool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zero-day-ai/sdk/toolerr"
+	"github.com/zero-day-ai/sdk/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultStatsWindow is the number of most recent executions a tool built
+// with New keeps for its execution statistics.
+const DefaultStatsWindow = 256
+
+// minHealthSamples is the number of executions a tool's statistics window
+// must hold before its health bounds are checked, so that a single early
+// failure does not mark the tool degraded.
+const minHealthSamples = 10
+
+// SlowExecutionFunc is called when an execution takes longer than the slow
+// threshold set with SetSlowThreshold. Input is the JSON form of the input
+// message with secrets removed by toolerr.RedactFields.
+type SlowExecutionFunc func(ctx context.Context, input map[string]any, dur time.Duration)
+
+// ExecStats summarizes the most recent executions of a tool.
+type ExecStats struct {
+	// Count is the number of executions in the window
+	Count int `json:"count"`
+
+	// ErrorCount is the number of executions in the window that failed
+	ErrorCount int `json:"error_count"`
+
+	// P50 is the median execution duration
+	P50 time.Duration `json:"p50"`
+
+	// P95 is the 95th percentile execution duration
+	P95 time.Duration `json:"p95"`
+
+	// Max is the longest execution duration
+	Max time.Duration `json:"max"`
+
+	// MaxInputSize is the largest input in the window, in bytes of its wire
+	// encoding
+	MaxInputSize int `json:"max_input_size"`
+}
+
+// ErrorRate returns the fraction of executions in the window that failed,
+// or 0 if the window is empty.
+func (s ExecStats) ErrorRate() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.ErrorCount) / float64(s.Count)
+}
+
+// details returns s in the form reported in health status details.
+func (s ExecStats) details() map[string]any {
+	return map[string]any{
+		"executions":     s.Count,
+		"errors":         s.ErrorCount,
+		"error_rate":     s.ErrorRate(),
+		"p50":            s.P50.String(),
+		"p95":            s.P95.String(),
+		"max":            s.Max.String(),
+		"max_input_size": s.MaxInputSize,
+	}
+}
+
+// StatsProvider is an optional interface for tools that keep execution
+// statistics. Tools built with New implement it.
+type StatsProvider interface {
+	// Stats returns statistics over the tool's most recent executions.
+	Stats() ExecStats
+}
+
+// execSample is a single execution recorded in a statsWindow.
+type execSample struct {
+	duration  time.Duration
+	failed    bool
+	inputSize int
+}
+
+// statsWindow is a fixed-size ring of the most recent execution samples.
+// It is safe for concurrent use.
+type statsWindow struct {
+	mu      sync.Mutex
+	samples []execSample
+	next    int
+	full    bool
+}
+
+// newStatsWindow returns a window holding up to size samples.
+func newStatsWindow(size int) *statsWindow {
+	return &statsWindow{samples: make([]execSample, size)}
+}
+
+// record adds a sample, replacing the oldest one once the window is full.
+func (w *statsWindow) record(sample execSample) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.samples[w.next] = sample
+	w.next++
+	if w.next == len(w.samples) {
+		w.next = 0
+		w.full = true
+	}
+}
+
+// stats summarizes the samples in the window.
+func (w *statsWindow) stats() ExecStats {
+	w.mu.Lock()
+	n := w.next
+	if w.full {
+		n = len(w.samples)
+	}
+	samples := slices.Clone(w.samples[:n])
+	w.mu.Unlock()
+
+	var stats ExecStats
+	if n == 0 {
+		return stats
+	}
+
+	durations := make([]time.Duration, n)
+	for i, s := range samples {
+		durations[i] = s.duration
+		if s.failed {
+			stats.ErrorCount++
+		}
+		stats.MaxInputSize = max(stats.MaxInputSize, s.inputSize)
+	}
+	slices.Sort(durations)
+
+	stats.Count = n
+	stats.P50 = percentile(durations, 0.50)
+	stats.P95 = percentile(durations, 0.95)
+	stats.Max = durations[n-1]
+	return stats
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted, which must
+// not be empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// redactedInput returns the JSON form of input with secrets removed, or nil
+// if it cannot be encoded.
+func redactedInput(input proto.Message) map[string]any {
+	if input == nil {
+		return nil
+	}
+	data, err := protojson.Marshal(input)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	return toolerr.RedactFields(fields)
+}
+
+// Stats returns statistics over the tool's most recent executions.
+func (t *sdkTool) Stats() ExecStats {
+	return t.stats.stats()
+}
+
+// recordExecution adds an execution to the tool's statistics and reports it
+// to the slow execution function if it exceeded the slow threshold.
+func (t *sdkTool) recordExecution(ctx context.Context, input proto.Message, dur time.Duration, err error) {
+	t.stats.record(execSample{
+		duration:  dur,
+		failed:    err != nil,
+		inputSize: proto.Size(input),
+	})
+
+	if t.onSlow != nil && t.slowThreshold > 0 && dur > t.slowThreshold {
+		t.onSlow(ctx, redactedInput(input), dur)
+	}
+}
+
+// statsHealth returns a degraded status if the tool's statistics exceed the
+// configured health bounds, or a healthy status otherwise.
+func (t *sdkTool) statsHealth() types.HealthStatus {
+	stats := t.Stats()
+	if stats.Count < minHealthSamples {
+		return types.NewHealthyStatus("tool is operational")
+	}
+
+	var problems []string
+	if t.maxErrorRate > 0 && stats.ErrorRate() > t.maxErrorRate {
+		problems = append(problems, fmt.Sprintf("error rate %.0f%% exceeds %.0f%%", stats.ErrorRate()*100, t.maxErrorRate*100))
+	}
+	if t.maxP95 > 0 && stats.P95 > t.maxP95 {
+		problems = append(problems, fmt.Sprintf("p95 latency %s exceeds %s", stats.P95, t.maxP95))
+	}
+	if len(problems) == 0 {
+		return types.NewHealthyStatus("tool is operational")
+	}
+	return types.NewDegradedStatus(strings.Join(problems, "; "), stats.details())
+}
@@ -0,0 +1,265 @@
+package tool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zero-day-ai/sdk/toolerr"
+	"github.com/zero-day-ai/sdk/types"
+	protolib "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStatsWindow_Empty(t *testing.T) {
+	stats := newStatsWindow(4).stats()
+	if stats != (ExecStats{}) {
+		t.Errorf("stats() = %+v, want zero", stats)
+	}
+	if stats.ErrorRate() != 0 {
+		t.Errorf("ErrorRate() = %v, want 0", stats.ErrorRate())
+	}
+}
+
+func TestStatsWindow_Percentiles(t *testing.T) {
+	w := newStatsWindow(100)
+	// record 1ms..100ms out of order
+	for i := 100; i >= 1; i-- {
+		w.record(execSample{duration: time.Duration(i) * time.Millisecond, failed: i%10 == 0, inputSize: i})
+	}
+
+	stats := w.stats()
+	want := ExecStats{
+		Count:        100,
+		ErrorCount:   10,
+		P50:          50 * time.Millisecond,
+		P95:          95 * time.Millisecond,
+		Max:          100 * time.Millisecond,
+		MaxInputSize: 100,
+	}
+	if stats != want {
+		t.Errorf("stats() = %+v, want %+v", stats, want)
+	}
+	if stats.ErrorRate() != 0.1 {
+		t.Errorf("ErrorRate() = %v, want 0.1", stats.ErrorRate())
+	}
+}
+
+func TestStatsWindow_SmallSample(t *testing.T) {
+	w := newStatsWindow(8)
+	w.record(execSample{duration: 3 * time.Millisecond})
+
+	stats := w.stats()
+	if stats.Count != 1 || stats.P50 != 3*time.Millisecond || stats.P95 != 3*time.Millisecond || stats.Max != 3*time.Millisecond {
+		t.Errorf("stats() = %+v, want a single 3ms sample", stats)
+	}
+}
+
+func TestStatsWindow_Rolls(t *testing.T) {
+	w := newStatsWindow(4)
+	// two slow failures followed by six fast successes: only the last four remain
+	w.record(execSample{duration: time.Second, failed: true, inputSize: 1000})
+	w.record(execSample{duration: time.Second, failed: true, inputSize: 1000})
+	for i := 1; i <= 6; i++ {
+		w.record(execSample{duration: time.Duration(i) * time.Millisecond, inputSize: 10})
+	}
+
+	stats := w.stats()
+	if stats.Count != 4 {
+		t.Errorf("Count = %d, want 4", stats.Count)
+	}
+	if stats.ErrorCount != 0 {
+		t.Errorf("ErrorCount = %d, want 0", stats.ErrorCount)
+	}
+	if stats.Max != 6*time.Millisecond {
+		t.Errorf("Max = %v, want 6ms", stats.Max)
+	}
+	if stats.P50 != 4*time.Millisecond {
+		t.Errorf("P50 = %v, want 4ms", stats.P50)
+	}
+	if stats.MaxInputSize != 10 {
+		t.Errorf("MaxInputSize = %d, want 10", stats.MaxInputSize)
+	}
+	if len(w.samples) != 4 {
+		t.Errorf("window grew to %d samples, want 4", len(w.samples))
+	}
+}
+
+func TestStatsWindow_Concurrent(t *testing.T) {
+	w := newStatsWindow(64)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				w.record(execSample{duration: time.Duration(i) * time.Microsecond, failed: g%2 == 0})
+				if i%50 == 0 {
+					_ = w.stats()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	stats := w.stats()
+	if stats.Count != 64 {
+		t.Errorf("Count = %d, want 64", stats.Count)
+	}
+	if stats.P50 > stats.P95 || stats.P95 > stats.Max {
+		t.Errorf("percentiles out of order: %+v", stats)
+	}
+}
+
+// newStatsTool builds a tool whose executions fail when the input has a
+// "fail" field and sleep for its "sleep_ms" field.
+func newStatsTool(t *testing.T, cfg *Config) Tool {
+	t.Helper()
+	cfg.SetName("stats-tool").SetExecuteProtoFunc(func(ctx context.Context, input protolib.Message) (protolib.Message, error) {
+		fields := input.(*structpb.Struct).GetFields()
+		if ms := fields["sleep_ms"].GetNumberValue(); ms > 0 {
+			time.Sleep(time.Duration(ms) * time.Millisecond)
+		}
+		if fields["fail"] != nil {
+			return nil, errors.New("execution failed")
+		}
+		return input, nil
+	})
+
+	tool, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return tool
+}
+
+func TestSdkTool_Stats(t *testing.T) {
+	tool := newStatsTool(t, NewConfig())
+	ctx := context.Background()
+
+	ok, _ := structpb.NewStruct(map[string]any{"target": "10.0.0.1"})
+	failing, _ := structpb.NewStruct(map[string]any{"fail": true})
+	for i := 0; i < 3; i++ {
+		if _, err := tool.ExecuteProto(ctx, ok); err != nil {
+			t.Fatalf("ExecuteProto() error = %v", err)
+		}
+	}
+	if _, err := tool.ExecuteProto(ctx, failing); err == nil {
+		t.Fatal("ExecuteProto() expected error")
+	}
+
+	provider, isProvider := tool.(StatsProvider)
+	if !isProvider {
+		t.Fatal("tool built with New does not implement StatsProvider")
+	}
+	stats := provider.Stats()
+	if stats.Count != 4 || stats.ErrorCount != 1 {
+		t.Errorf("Stats() = %+v, want 4 executions and 1 error", stats)
+	}
+	if stats.MaxInputSize != protolib.Size(ok) {
+		t.Errorf("MaxInputSize = %d, want %d", stats.MaxInputSize, protolib.Size(ok))
+	}
+}
+
+func TestSdkTool_SlowThreshold(t *testing.T) {
+	var mu sync.Mutex
+	var slow []map[string]any
+	cfg := NewConfig().SetSlowThreshold(20*time.Millisecond, func(ctx context.Context, input map[string]any, dur time.Duration) {
+		if dur <= 20*time.Millisecond {
+			t.Errorf("onSlow called for %v execution", dur)
+		}
+		mu.Lock()
+		slow = append(slow, input)
+		mu.Unlock()
+	})
+	tool := newStatsTool(t, cfg)
+	ctx := context.Background()
+
+	fast, _ := structpb.NewStruct(map[string]any{"apiKey": "fast-secret"})
+	slowInput, _ := structpb.NewStruct(map[string]any{"apiKey": "slow-secret", "target": "10.0.0.1", "sleep_ms": 40})
+	tool.ExecuteProto(ctx, fast)
+	tool.ExecuteProto(ctx, slowInput)
+
+	if len(slow) != 1 {
+		t.Fatalf("onSlow called %d times, want 1", len(slow))
+	}
+	if slow[0]["apiKey"] != toolerr.Redacted {
+		t.Errorf("onSlow input apiKey = %v, want redacted", slow[0]["apiKey"])
+	}
+	if slow[0]["target"] != "10.0.0.1" {
+		t.Errorf("onSlow input target = %v, want 10.0.0.1", slow[0]["target"])
+	}
+}
+
+func TestSdkTool_HealthBounds(t *testing.T) {
+	ctx := context.Background()
+	ok, _ := structpb.NewStruct(map[string]any{})
+	failing, _ := structpb.NewStruct(map[string]any{"fail": true})
+
+	tool := newStatsTool(t, NewConfig().SetHealthBounds(0.2, time.Minute))
+
+	// too few executions to judge
+	for i := 0; i < minHealthSamples-1; i++ {
+		tool.ExecuteProto(ctx, failing)
+	}
+	if status := tool.Health(ctx); status.Status != types.StatusHealthy {
+		t.Errorf("Health() status = %v before %d executions, want healthy", status.Status, minHealthSamples)
+	}
+
+	tool.ExecuteProto(ctx, failing)
+	status := tool.Health(ctx)
+	if status.Status != types.StatusDegraded {
+		t.Fatalf("Health() status = %v, want degraded", status.Status)
+	}
+	if status.Details["errors"] != minHealthSamples || status.Details["error_rate"] != 1.0 {
+		t.Errorf("Health() details = %v, want stats", status.Details)
+	}
+
+	// the failures roll out of the window
+	for i := 0; i < DefaultStatsWindow; i++ {
+		tool.ExecuteProto(ctx, ok)
+	}
+	if status := tool.Health(ctx); status.Status != types.StatusHealthy {
+		t.Errorf("Health() status = %v after recovery, want healthy", status.Status)
+	}
+}
+
+func TestSdkTool_HealthBoundsLatency(t *testing.T) {
+	ctx := context.Background()
+	slowInput, _ := structpb.NewStruct(map[string]any{"sleep_ms": 2})
+
+	tool := newStatsTool(t, NewConfig().SetStatsWindow(minHealthSamples).SetHealthBounds(0, time.Millisecond))
+	for i := 0; i < minHealthSamples; i++ {
+		tool.ExecuteProto(ctx, slowInput)
+	}
+
+	status := tool.Health(ctx)
+	if status.Status != types.StatusDegraded {
+		t.Fatalf("Health() status = %v, want degraded", status.Status)
+	}
+	if status.Details["executions"] != minHealthSamples {
+		t.Errorf("Health() details = %v, want stats", status.Details)
+	}
+}
+
+func TestNew_StatsSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+	}{
+		{name: "zero window", cfg: NewConfig().SetName("t").SetStatsWindow(0)},
+		{name: "error rate above 1", cfg: NewConfig().SetName("t").SetHealthBounds(1.5, 0)},
+		{name: "negative p95", cfg: NewConfig().SetName("t").SetHealthBounds(0, -time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.cfg); err == nil {
+				t.Error("New() expected error")
+			}
+		})
+	}
+}
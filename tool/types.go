@@ -20,6 +20,24 @@ type Descriptor struct {
 
 	// OutputMessageType is the fully-qualified proto message type name for output.
 	OutputMessageType string `json:"output_message_type"`
+
+	// Examples are sample invocations of the tool, for discovery UIs and
+	// LLM tool selection.
+	Examples []ToolExample `json:"examples,omitempty"`
+}
+
+// ToolExample is a sample invocation of a tool. Input and Output are the
+// protojson forms of the tool's input and output messages.
+type ToolExample struct {
+	// Description explains what the example demonstrates.
+	Description string `json:"description,omitempty"`
+
+	// Input is the example input message.
+	Input map[string]any `json:"input"`
+
+	// Output is a representative output message. It need not be exact, as
+	// real output depends on the target.
+	Output map[string]any `json:"output,omitempty"`
 }
 
 // ToDescriptor converts a Tool to its Descriptor.
@@ -32,5 +50,6 @@ func ToDescriptor(t Tool) Descriptor {
 		Tags:              t.Tags(),
 		InputMessageType:  t.InputMessageType(),
 		OutputMessageType: t.OutputMessageType(),
+		Examples:          examplesOf(t),
 	}
 }
@@ -2,8 +2,10 @@ package tool
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	_ "github.com/zero-day-ai/sdk/api/gen/toolspb" // registers the nmap messages used by the example tests
 	protolib "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -136,3 +138,98 @@ func TestDescriptor_Serialization(t *testing.T) {
 		t.Error("Descriptor Tags should not be empty")
 	}
 }
+
+func TestToDescriptor_Examples(t *testing.T) {
+	cfg := NewConfig().
+		SetName("nmap").
+		SetInputMessageType("gibson.tools.NmapRequest").
+		SetOutputMessageType("gibson.tools.NmapResponse").
+		AddExample("Service scan of a single host",
+			map[string]any{"targets": []any{"10.0.0.5"}, "args": []any{"-sV"}},
+			map[string]any{"total_hosts": 1, "hostsUp": 1},
+		).
+		AddExample("Ping sweep", map[string]any{"targets": []any{"10.0.0.0/24"}, "args": []any{"-sn"}}, nil)
+
+	tool, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	desc := ToDescriptor(tool)
+	if len(desc.Examples) != 2 {
+		t.Fatalf("ToDescriptor() Examples length = %d, want 2", len(desc.Examples))
+	}
+	if desc.Examples[0].Description != "Service scan of a single host" {
+		t.Errorf("Examples[0].Description = %q", desc.Examples[0].Description)
+	}
+	if desc.Examples[1].Output != nil {
+		t.Errorf("Examples[1].Output = %v, want nil", desc.Examples[1].Output)
+	}
+}
+
+func TestNew_ExampleValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   map[string]any
+		output  map[string]any
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			input: map[string]any{"targets": []any{"10.0.0.5"}},
+		},
+		{
+			name:    "missing input",
+			wantErr: "example 0: input is required",
+		},
+		{
+			name:    "unknown input field",
+			input:   map[string]any{"target": "10.0.0.5"},
+			wantErr: "example 0: input: does not match gibson.tools.NmapRequest",
+		},
+		{
+			name:    "wrong input field type",
+			input:   map[string]any{"targets": "10.0.0.5"},
+			wantErr: "example 0: input: does not match gibson.tools.NmapRequest",
+		},
+		{
+			name:    "wrong output field type",
+			input:   map[string]any{"targets": []any{"10.0.0.5"}},
+			output:  map[string]any{"total_hosts": "one"},
+			wantErr: "example 0: output: does not match gibson.tools.NmapResponse",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewConfig().
+				SetName("nmap").
+				SetInputMessageType("gibson.tools.NmapRequest").
+				SetOutputMessageType("gibson.tools.NmapResponse").
+				AddExample(tt.name, tt.input, tt.output)
+
+			_, err := New(cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("New() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("New() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNew_ExampleUnregisteredType(t *testing.T) {
+	// Examples for message types that are not registered are not checked
+	cfg := NewConfig().
+		SetName("custom").
+		SetInputMessageType("custom.v1.Unknown").
+		AddExample("anything", map[string]any{"whatever": true}, nil)
+
+	if _, err := New(cfg); err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+}
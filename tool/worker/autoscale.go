@@ -0,0 +1,216 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/zero-day-ai/sdk/queue"
+)
+
+// AutoScale configures adaptive concurrency. The worker periodically samples
+// the depth of its queue and the average execution time of recent work
+// items, adds workers while the backlog would take longer than one Interval
+// to drain, and removes them once the queue has stayed empty for
+// ScaleDownDelay. The worker count changes by at most Step per Interval and
+// always stays between MinWorkers and MaxWorkers.
+type AutoScale struct {
+	// MinWorkers is the number of workers kept when the queue is empty.
+	// If 0, defaults to 1.
+	MinWorkers int
+
+	// MaxWorkers is the ceiling on workers, sized to the resources the
+	// tool may use. If 0, defaults to four times Concurrency.
+	MaxWorkers int
+
+	// Interval is how often the queue is sampled and the worker count
+	// adjusted. If 0, defaults to 5s.
+	Interval time.Duration
+
+	// Step is the most workers added or removed per Interval.
+	// If 0, defaults to 2.
+	Step int
+
+	// ScaleDownDelay is how long the queue must stay empty before workers
+	// are removed. If 0, defaults to 30s.
+	ScaleDownDelay time.Duration
+}
+
+// withDefaults returns a with zero fields set to their defaults.
+func (a AutoScale) withDefaults(concurrency int) AutoScale {
+	if a.MinWorkers <= 0 {
+		a.MinWorkers = 1
+	}
+	if a.MaxWorkers <= 0 {
+		a.MaxWorkers = 4 * concurrency
+	}
+	if a.MaxWorkers < a.MinWorkers {
+		a.MaxWorkers = a.MinWorkers
+	}
+	if a.Interval <= 0 {
+		a.Interval = 5 * time.Second
+	}
+	if a.Step <= 0 {
+		a.Step = 2
+	}
+	if a.ScaleDownDelay <= 0 {
+		a.ScaleDownDelay = 30 * time.Second
+	}
+	return a
+}
+
+// desiredWorkers returns the worker count for the next interval, given the
+// current count, the queue depth, the average execution time (0 if no item
+// has completed yet), and how long the queue has been empty.
+func (a AutoScale) desiredWorkers(current int, depth int64, avgExec time.Duration, drainedFor time.Duration) int {
+	target := current
+	switch {
+	case depth > 0:
+		// Workers needed to drain the backlog within one interval; without
+		// timings yet, any backlog warrants another step
+		needed := current + a.Step
+		if avgExec > 0 {
+			needed = int((time.Duration(depth)*avgExec + a.Interval - 1) / a.Interval)
+		}
+		if needed > current {
+			target = min(current+a.Step, needed)
+		}
+	case drainedFor >= a.ScaleDownDelay:
+		target = current - a.Step
+	}
+	return max(a.MinWorkers, min(target, a.MaxWorkers))
+}
+
+// execStats tracks the average execution time of work items.
+type execStats struct {
+	mu  sync.Mutex
+	avg time.Duration
+}
+
+// record adds the execution time of a work item to an exponentially
+// weighted average, so the average follows changes in the workload.
+func (s *execStats) record(d time.Duration) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.avg == 0 {
+		s.avg = d
+		return
+	}
+	s.avg += (d - s.avg) / 5
+}
+
+// average returns the average execution time, or 0 if none was recorded.
+func (s *execStats) average() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.avg
+}
+
+// workerPool runs a resizable set of worker goroutines.
+type workerPool struct {
+	ctx context.Context
+	run func(stop context.Context, workerNum int)
+	wg  sync.WaitGroup
+
+	mu    sync.Mutex
+	stops []context.CancelFunc
+	next  int
+}
+
+// newWorkerPool creates a pool whose workers call run until stop is done.
+// Every worker's stop context is derived from ctx.
+func newWorkerPool(ctx context.Context, run func(stop context.Context, workerNum int)) *workerPool {
+	return &workerPool{ctx: ctx, run: run}
+}
+
+// resize starts or stops workers until n are running. Stopped workers finish
+// the item they are processing before they exit.
+func (p *workerPool) resize(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.stops) < n {
+		stop, cancel := context.WithCancel(p.ctx)
+		p.stops = append(p.stops, cancel)
+		workerNum := p.next
+		p.next++
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.run(stop, workerNum)
+		}()
+	}
+	for len(p.stops) > n {
+		last := len(p.stops) - 1
+		p.stops[last]()
+		p.stops = p.stops[:last]
+	}
+}
+
+// size returns the number of running workers.
+func (p *workerPool) size() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.stops)
+}
+
+// wait blocks until every worker has exited.
+func (p *workerPool) wait() {
+	p.wg.Wait()
+}
+
+// runAutoScaler resizes pool every cfg.Interval according to the depth of
+// queueName and stats, until ctx is cancelled.
+func runAutoScaler(ctx context.Context, pool *workerPool, stats *execStats, client queue.Client, queueName string, cfg AutoScale, logger *slog.Logger) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	var drainedSince time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		depth, err := client.QueueDepth(ctx, queueName)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Debug("failed to sample queue depth", "error", err)
+			continue
+		}
+
+		now := time.Now()
+		var drainedFor time.Duration
+		if depth > 0 {
+			drainedSince = time.Time{}
+		} else {
+			if drainedSince.IsZero() {
+				drainedSince = now
+			}
+			drainedFor = now.Sub(drainedSince)
+		}
+
+		avgExec := stats.average()
+		current := pool.size()
+		target := cfg.desiredWorkers(current, depth, avgExec, drainedFor)
+		if target == current {
+			continue
+		}
+
+		logger.Info("scaling workers",
+			"from", current,
+			"to", target,
+			"queue_depth", depth,
+			"avg_exec_ms", avgExec.Milliseconds(),
+		)
+		pool.resize(target)
+	}
+}
@@ -0,0 +1,177 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zero-day-ai/sdk/queue"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestAutoScale_Defaults(t *testing.T) {
+	got := AutoScale{}.withDefaults(4)
+	want := AutoScale{MinWorkers: 1, MaxWorkers: 16, Interval: 5 * time.Second, Step: 2, ScaleDownDelay: 30 * time.Second}
+	if got != want {
+		t.Errorf("withDefaults() = %+v, want %+v", got, want)
+	}
+
+	got = AutoScale{MinWorkers: 8, MaxWorkers: 2}.withDefaults(4)
+	if got.MaxWorkers != 8 {
+		t.Errorf("MaxWorkers = %d, want it raised to MinWorkers 8", got.MaxWorkers)
+	}
+}
+
+func TestAutoScale_DesiredWorkers(t *testing.T) {
+	cfg := AutoScale{MinWorkers: 1, MaxWorkers: 6, Interval: time.Second, Step: 2, ScaleDownDelay: 10 * time.Second}
+
+	tests := []struct {
+		name       string
+		current    int
+		depth      int64
+		avgExec    time.Duration
+		drainedFor time.Duration
+		want       int
+	}{
+		{name: "backlog scales up by one step", current: 1, depth: 100, avgExec: time.Second, want: 3},
+		{name: "backlog without timings scales up", current: 2, depth: 5, want: 4},
+		{name: "capped at max workers", current: 5, depth: 100, avgExec: time.Second, want: 6},
+		{name: "backlog drained within interval keeps count", current: 3, depth: 10, avgExec: 100 * time.Millisecond, want: 3},
+		{name: "scales up only to what the backlog needs", current: 2, depth: 3, avgExec: time.Second, want: 3},
+		{name: "briefly empty queue keeps count", current: 4, drainedFor: 5 * time.Second, want: 4},
+		{name: "drained queue scales down by one step", current: 4, drainedFor: 10 * time.Second, want: 2},
+		{name: "never below min workers", current: 2, drainedFor: time.Minute, want: 1},
+		{name: "raised to min workers", current: 0, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.desiredWorkers(tt.current, tt.depth, tt.avgExec, tt.drainedFor); got != tt.want {
+				t.Errorf("desiredWorkers() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecStats_Average(t *testing.T) {
+	var nilStats *execStats
+	nilStats.record(time.Second) // no-op
+
+	stats := &execStats{}
+	if got := stats.average(); got != 0 {
+		t.Errorf("average() = %v before any item, want 0", got)
+	}
+	stats.record(100 * time.Millisecond)
+	stats.record(600 * time.Millisecond)
+	if got := stats.average(); got != 200*time.Millisecond {
+		t.Errorf("average() = %v, want 200ms", got)
+	}
+}
+
+func TestWorkerPool_Resize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var running atomic.Int32
+	pool := newWorkerPool(ctx, func(stop context.Context, workerNum int) {
+		running.Add(1)
+		defer running.Add(-1)
+		<-stop.Done()
+	})
+
+	pool.resize(3)
+	waitFor(t, func() bool { return running.Load() == 3 })
+	pool.resize(1)
+	waitFor(t, func() bool { return running.Load() == 1 })
+	if got := pool.size(); got != 1 {
+		t.Errorf("size() = %d, want 1", got)
+	}
+
+	cancel()
+	pool.wait()
+	if got := running.Load(); got != 0 {
+		t.Errorf("%d workers still running after cancel", got)
+	}
+}
+
+func TestAutoScaler_BurstAndDrain(t *testing.T) {
+	s, redisURL := setupTestRedis(t)
+	defer s.Close()
+
+	var execCount, current, maxConcurrent atomic.Int32
+	mockT := &mockTool{
+		name:        "bursty-tool",
+		version:     "1.0.0",
+		description: "Bursty test tool",
+		executeFunc: func(ctx context.Context, input proto.Message) (proto.Message, error) {
+			n := current.Add(1)
+			for {
+				m := maxConcurrent.Load()
+				if n <= m || maxConcurrent.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			current.Add(-1)
+			execCount.Add(1)
+			return wrapperspb.String("ok"), nil
+		},
+	}
+
+	client, err := queue.NewRedisClient(queue.RedisOptions{URL: redisURL})
+	if err != nil {
+		t.Fatalf("Failed to create Redis client: %v", err)
+	}
+	defer client.Close()
+
+	queueName := fmt.Sprintf("tool:%s:queue", mockT.Name())
+	const numItems = 60
+	for i := 0; i < numItems; i++ {
+		inputJSON, _ := protojson.Marshal(wrapperspb.String(fmt.Sprintf("item-%d", i)))
+		item := queue.WorkItem{
+			JobID:      "burst-job",
+			Index:      i,
+			Total:      numItems,
+			Tool:       mockT.Name(),
+			InputJSON:  string(inputJSON),
+			InputType:  mockT.InputMessageType(),
+			OutputType: mockT.OutputMessageType(),
+		}
+		if err := client.Push(context.Background(), queueName, item); err != nil {
+			t.Fatalf("Failed to push work item: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := AutoScale{MinWorkers: 1, MaxWorkers: 4, Interval: 20 * time.Millisecond, Step: 1, ScaleDownDelay: 60 * time.Millisecond}
+	stats := &execStats{}
+	pool := newWorkerPool(ctx, func(stop context.Context, workerNum int) {
+		runWorker(ctx, stop, workerNum, mockT, client, queueName, "test-worker", newTestLogger(), nil, stats)
+	})
+	pool.resize(cfg.MinWorkers)
+	go runAutoScaler(ctx, pool, stats, client, queueName, cfg, newTestLogger())
+
+	waitFor(t, func() bool { return execCount.Load() == numItems })
+	if got := maxConcurrent.Load(); got < 2 || got > int32(cfg.MaxWorkers) {
+		t.Errorf("max concurrent executions = %d, want between 2 and %d", got, cfg.MaxWorkers)
+	}
+
+	waitFor(t, func() bool { return pool.size() == cfg.MinWorkers })
+}
+
+// waitFor polls cond until it holds, failing the test after five seconds.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within 5s")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -44,6 +44,18 @@
 //   - Resource constraints (CPU, memory, file descriptors)
 //   - Queue depth (more queued work = benefit from higher concurrency)
 //
+// For bursty workloads, set Options.AutoScale instead of tuning Concurrency
+// per deployment. The worker then samples the queue depth and the average
+// execution time every AutoScale.Interval, adds workers while the backlog
+// would take longer than an interval to drain, and removes them once the
+// queue has stayed empty for AutoScale.ScaleDownDelay. The count changes by
+// at most AutoScale.Step per interval and stays between MinWorkers and
+// MaxWorkers; a removed worker finishes its current item before exiting:
+//
+//	opts := worker.Options{
+//	    AutoScale: &worker.AutoScale{MinWorkers: 1, MaxWorkers: 16},
+//	}
+//
 // # Graceful Shutdown
 //
 // Workers handle SIGTERM and SIGINT signals gracefully:
@@ -55,6 +67,23 @@
 //
 // This ensures work items are not left in an inconsistent state during shutdown.
 //
+// # Observability
+//
+// Every log line emitted while processing a work item carries the item's
+// job_id, tool, and index attributes, so a failure can be correlated with the
+// daemon-side mission without grepping. Supply a configured logger through
+// Options.Logger. Tools can log with the same attributes by using the logger
+// attached to the execution context:
+//
+//	func (t *MyTool) ExecuteProto(ctx context.Context, input proto.Message) (proto.Message, error) {
+//	    worker.LoggerFromContext(ctx).Info("scanning", "target", target)
+//	    ...
+//	}
+//
+// Each item's execution is wrapped in an OpenTelemetry span that continues the
+// trace identified by the work item's TraceID and SpanID. Set Options.Tracer to
+// use a specific tracer; otherwise the global tracer provider is used.
+//
 // # Redis Queue Schema
 //
 // Workers interact with Redis using the following key patterns:
@@ -63,6 +92,8 @@
 //   - tool:<name>:health - Key with TTL for health checks
 //   - tool:<name>:workers - Counter for active worker count
 //   - results:<jobID> - Pub/sub channel for result delivery
+//   - results:<jobID>:stream - Stream for result delivery when
+//     Options.ResultDelivery is queue.ResultDeliveryStream
 //
 // # Error Handling
 //
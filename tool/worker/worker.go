@@ -2,11 +2,12 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
-	"sync"
 	"syscall"
 	"time"
 
@@ -14,6 +15,11 @@ import (
 	"github.com/zero-day-ai/sdk/component"
 	"github.com/zero-day-ai/sdk/queue"
 	"github.com/zero-day-ai/sdk/tool"
+	"github.com/zero-day-ai/sdk/toolerr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
@@ -24,18 +30,36 @@ type Options struct {
 	// RedisURL is the Redis connection string (e.g., "redis://localhost:6379")
 	RedisURL string
 
+	// ResultDelivery selects how results are delivered to the daemon:
+	// queue.ResultDeliveryPubSub (default) or queue.ResultDeliveryStream,
+	// which lets replicated daemons share results through a consumer group.
+	ResultDelivery queue.ResultDelivery
+
 	// Concurrency is the number of worker goroutines to start.
 	// If 0, uses value from component.yaml or default (4).
 	Concurrency int
 
+	// AutoScale enables adaptive concurrency: the number of worker
+	// goroutines follows the queue's backlog between AutoScale.MinWorkers
+	// and AutoScale.MaxWorkers instead of staying at Concurrency.
+	// If nil, the worker runs Concurrency goroutines.
+	AutoScale *AutoScale
+
 	// ShutdownTimeout is the time to wait for graceful shutdown.
 	// If 0, uses value from component.yaml or default (30s).
 	ShutdownTimeout time.Duration
 
 	// Logger is the structured logger for worker operations.
+	// Every line logged while processing a work item carries the item's
+	// job_id, tool, and index attributes for correlation with the daemon.
 	// If nil, a default logger will be created.
 	Logger *slog.Logger
 
+	// Tracer creates a span around each work item's execution, parented to
+	// the trace context carried by the work item.
+	// If nil, the global OpenTelemetry tracer provider is used.
+	Tracer trace.Tracer
+
 	// ComponentConfig is the parsed component.yaml configuration.
 	// If nil, the worker will attempt to load it from the current directory.
 	// Set to an empty config to skip component.yaml loading.
@@ -93,6 +117,9 @@ func Run(t tool.Tool, opts Options) error {
 			Level: slog.LevelInfo,
 		}))
 	}
+	if opts.Tracer == nil {
+		opts.Tracer = otel.Tracer(tracerName)
+	}
 
 	// Generate unique worker ID (hostname + PID + UUID)
 	workerID := generateWorkerID()
@@ -110,7 +137,8 @@ func Run(t tool.Tool, opts Options) error {
 
 	// Connect to Redis
 	redisClient, err := queue.NewRedisClient(queue.RedisOptions{
-		URL: opts.RedisURL,
+		URL:            opts.RedisURL,
+		ResultDelivery: opts.ResultDelivery,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to connect to Redis: %w", err)
@@ -172,19 +200,30 @@ func Run(t tool.Tool, opts Options) error {
 	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
 
 	// Start worker goroutines
-	var wg sync.WaitGroup
 	queueName := fmt.Sprintf("tool:%s:queue", t.Name())
+	var stats *execStats
+	if opts.AutoScale != nil {
+		stats = &execStats{}
+	}
+	pool := newWorkerPool(ctx, func(stop context.Context, workerNum int) {
+		runWorker(ctx, stop, workerNum, t, redisClient, queueName, workerID, logger, opts.Tracer, stats)
+	})
 
-	for i := 0; i < opts.Concurrency; i++ {
-		wg.Add(1)
-		go func(workerNum int) {
-			defer wg.Done()
-			workerLoop(ctx, workerNum, t, redisClient, queueName, workerID, logger)
-		}(i)
+	workers := opts.Concurrency
+	if opts.AutoScale != nil {
+		scale := opts.AutoScale.withDefaults(opts.Concurrency)
+		workers = scale.MinWorkers
+		go runAutoScaler(ctx, pool, stats, redisClient, queueName, scale, logger)
+		logger.Info("autoscaling enabled",
+			"min_workers", scale.MinWorkers,
+			"max_workers", scale.MaxWorkers,
+			"interval", scale.Interval,
+		)
 	}
+	pool.resize(workers)
 
 	logger.Info("worker started",
-		"workers", opts.Concurrency,
+		"workers", workers,
 		"queue", queueName,
 	)
 
@@ -198,7 +237,7 @@ func Run(t tool.Tool, opts Options) error {
 	// Wait for workers to finish with timeout
 	doneChan := make(chan struct{})
 	go func() {
-		wg.Wait()
+		pool.wait()
 		close(doneChan)
 	}()
 
@@ -237,24 +276,36 @@ func runHeartbeat(ctx context.Context, client queue.Client, toolName string, log
 // workerLoop is the main loop for a single worker goroutine.
 // It continuously pops work items from the queue, processes them,
 // and publishes results until the context is cancelled.
-func workerLoop(ctx context.Context, workerNum int, t tool.Tool, client queue.Client, queueName, workerID string, logger *slog.Logger) {
+// If tracer is nil, the global OpenTelemetry tracer provider is used.
+func workerLoop(ctx context.Context, workerNum int, t tool.Tool, client queue.Client, queueName, workerID string, logger *slog.Logger, tracer trace.Tracer) {
+	runWorker(ctx, ctx, workerNum, t, client, queueName, workerID, logger, tracer, nil)
+}
+
+// runWorker is workerLoop for a worker that can be stopped on its own: it
+// pops no more work items once stop is done, but processes and publishes
+// the current item under ctx. Execution times are recorded in stats if it
+// is not nil.
+func runWorker(ctx, stop context.Context, workerNum int, t tool.Tool, client queue.Client, queueName, workerID string, logger *slog.Logger, tracer trace.Tracer, stats *execStats) {
 	logger = logger.With("worker_num", workerNum)
+	if tracer == nil {
+		tracer = otel.Tracer(tracerName)
+	}
 	logger.Debug("worker loop started", "queue", queueName)
 
 	for {
 		// Check if context is cancelled before popping
 		select {
-		case <-ctx.Done():
+		case <-stop.Done():
 			logger.Debug("worker loop stopped", "reason", "context_cancelled")
 			return
 		default:
 		}
 
 		// Pop work item from queue (blocking with context)
-		item, err := client.Pop(ctx, queueName)
+		item, err := client.Pop(stop, queueName)
 		if err != nil {
 			// Check if context was cancelled during Pop
-			if ctx.Err() != nil {
+			if stop.Err() != nil {
 				logger.Debug("worker loop stopped", "reason", "context_error")
 				return
 			}
@@ -268,29 +319,88 @@ func workerLoop(ctx context.Context, workerNum int, t tool.Tool, client queue.Cl
 			continue
 		}
 
-		logger.Info("received work item",
-			"job_id", item.JobID,
-			"index", item.Index,
-			"total", item.Total,
-			"tool", item.Tool,
-		)
+		itemLogger := withItemAttrs(logger, *item)
+		itemLogger.Info("received work item", "total", item.Total)
 
-		// Process work item
-		result := processWorkItem(ctx, t, *item, workerID, logger)
+		// Process work item inside a span parented to the daemon's trace
+		itemCtx, span := startItemSpan(ctx, tracer, *item, workerID)
+		execStart := time.Now()
+		result := processWorkItem(itemCtx, t, *item, workerID, itemLogger)
+		stats.record(time.Since(execStart))
+		if result.Error != "" {
+			span.SetStatus(codes.Error, result.Error)
+		}
+		span.End()
 
-		// Publish result to job-specific channel
-		resultChannel := fmt.Sprintf("results:%s", item.JobID)
-		if err := client.Publish(ctx, resultChannel, result); err != nil {
-			logger.Error("failed to publish result", "error", err)
+		// Publish result to the job's channel or stream
+		if err := client.PublishResult(ctx, item.JobID, result); err != nil {
+			itemLogger.Error("failed to publish result", "error", err)
 		}
 	}
 }
 
+// tracerName is the instrumentation scope for worker spans.
+const tracerName = "github.com/zero-day-ai/sdk/tool/worker"
+
+// loggerKey is the context key for the per-item logger.
+type loggerKey struct{}
+
+// LoggerFromContext returns the work item logger stored in ctx by the worker.
+// Tools can use it inside ExecuteProto so their log lines carry the same
+// job_id, tool, and index attributes as the worker's own logs.
+// Returns slog.Default() if ctx carries no logger.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok && logger != nil {
+		return logger
+	}
+	return slog.Default()
+}
+
+// withItemAttrs returns a logger annotated with the work item's correlation IDs.
+// The tool name is not repeated here because Run already attaches it to the
+// worker logger, and duplicate keys would appear twice in JSON output.
+func withItemAttrs(logger *slog.Logger, item queue.WorkItem) *slog.Logger {
+	return logger.With(
+		"job_id", item.JobID,
+		"index", item.Index,
+	)
+}
+
+// startItemSpan starts a span around a work item's execution. If the item
+// carries a valid trace context, the span continues the submitter's trace.
+func startItemSpan(ctx context.Context, tracer trace.Tracer, item queue.WorkItem, workerID string) (context.Context, trace.Span) {
+	traceID, traceErr := trace.TraceIDFromHex(item.TraceID)
+	spanID, spanErr := trace.SpanIDFromHex(item.SpanID)
+	if traceErr == nil && spanErr == nil {
+		parent := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		})
+		ctx = trace.ContextWithRemoteSpanContext(ctx, parent)
+	}
+
+	return tracer.Start(ctx, "worker.process_item",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("gibson.worker.job_id", item.JobID),
+			attribute.String("gibson.tool.name", item.Tool),
+			attribute.Int("gibson.worker.index", item.Index),
+			attribute.Int("gibson.worker.total", item.Total),
+			attribute.String("gibson.worker.id", workerID),
+		),
+	)
+}
+
 // processWorkItem processes a single work item and returns a result.
 // It handles all errors at each step and ensures a result is always returned.
 func processWorkItem(ctx context.Context, t tool.Tool, item queue.WorkItem, workerID string, logger *slog.Logger) queue.Result {
 	startedAt := time.Now().UnixMilli()
 
+	// Expose the item logger so the tool's own log lines are correlated too
+	ctx = context.WithValue(ctx, loggerKey{}, logger)
+
 	result := queue.Result{
 		JobID:       item.JobID,
 		Index:       item.Index,
@@ -312,8 +422,17 @@ func processWorkItem(ctx context.Context, t tool.Tool, item queue.WorkItem, work
 	// Create a new instance of the input message
 	inputMsg := inputMsgType.New().Interface()
 
+	// Upgrade inputs queued in an older schema version
+	inputJSON, err := tool.MigrateInputJSON(t, item.InputJSON)
+	if err != nil {
+		result.Error = err.Error()
+		result.CompletedAt = time.Now().UnixMilli()
+		logger.Error("failed to migrate input", "error", err)
+		return result
+	}
+
 	// Unmarshal JSON to proto
-	if err := protojson.Unmarshal([]byte(item.InputJSON), inputMsg); err != nil {
+	if err := protojson.Unmarshal([]byte(inputJSON), inputMsg); err != nil {
 		result.Error = fmt.Sprintf("failed to unmarshal input: %v", err)
 		result.CompletedAt = time.Now().UnixMilli()
 		logger.Error("failed to unmarshal input", "error", err)
@@ -321,10 +440,25 @@ func processWorkItem(ctx context.Context, t tool.Tool, item queue.WorkItem, work
 	}
 
 	// Execute tool
+	execStart := time.Now()
 	outputMsg, err := t.ExecuteProto(ctx, inputMsg)
 	if err != nil {
 		result.Error = err.Error()
 		result.CompletedAt = time.Now().UnixMilli()
+
+		// Attach the structured context of tool errors for debugging
+		var toolErr *toolerr.Error
+		if errors.As(err, &toolErr) {
+			if toolErr.Context == nil || toolErr.Context.Duration == 0 {
+				toolErr.WithDuration(time.Since(execStart))
+			}
+			if detail, marshalErr := json.Marshal(toolErr); marshalErr == nil {
+				result.ErrorDetail = detail
+			}
+			logger.Error("tool execution failed", "error", err, "error_detail", string(result.ErrorDetail))
+			return result
+		}
+
 		logger.Error("tool execution failed", "error", err)
 		return result
 	}
@@ -342,8 +476,6 @@ func processWorkItem(ctx context.Context, t tool.Tool, item queue.WorkItem, work
 	result.CompletedAt = time.Now().UnixMilli()
 
 	logger.Info("work item completed",
-		"job_id", item.JobID,
-		"index", item.Index,
 		"duration_ms", result.CompletedAt-result.StartedAt,
 	)
 
@@ -1,11 +1,14 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -13,7 +16,10 @@ import (
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/zero-day-ai/sdk/queue"
+	"github.com/zero-day-ai/sdk/toolerr"
 	"github.com/zero-day-ai/sdk/types"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -154,7 +160,7 @@ func TestWorkerLoop_BasicExecution(t *testing.T) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		workerLoop(ctx, 0, mockT, client, queueName, "test-worker-1", newTestLogger())
+		workerLoop(ctx, 0, mockT, client, queueName, "test-worker-1", newTestLogger(), nil)
 	}()
 
 	// Collect results
@@ -256,7 +262,7 @@ func TestWorkerLoop_ToolExecutionError(t *testing.T) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		workerLoop(ctx, 0, mockT, client, queueName, "test-worker-1", newTestLogger())
+		workerLoop(ctx, 0, mockT, client, queueName, "test-worker-1", newTestLogger(), nil)
 	}()
 
 	// Wait for result
@@ -342,7 +348,7 @@ func TestWorkerLoop_GracefulShutdown(t *testing.T) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		workerLoop(ctx, 0, mockT, client, queueName, "test-worker-1", newTestLogger())
+		workerLoop(ctx, 0, mockT, client, queueName, "test-worker-1", newTestLogger(), nil)
 	}()
 
 	// Wait for execution to start
@@ -458,7 +464,7 @@ func TestWorkerLoop_ConcurrentWorkers(t *testing.T) {
 		wg.Add(1)
 		go func(workerNum int) {
 			defer wg.Done()
-			workerLoop(ctx, workerNum, mockT, client, queueName, fmt.Sprintf("test-worker-%d", workerNum), newTestLogger())
+			workerLoop(ctx, workerNum, mockT, client, queueName, fmt.Sprintf("test-worker-%d", workerNum), newTestLogger(), nil)
 		}(i)
 	}
 
@@ -680,6 +686,66 @@ func TestProcessWorkItem_WorkerID(t *testing.T) {
 	}
 }
 
+func TestProcessWorkItem_ToolErrorDetail(t *testing.T) {
+	mockT := &mockTool{
+		name: "test-tool",
+		executeFunc: func(ctx context.Context, input proto.Message) (proto.Message, error) {
+			time.Sleep(10 * time.Millisecond)
+			return nil, toolerr.New("test-tool", "scan", toolerr.ErrCodeExecutionFailed, "scan failed").
+				WithCommand("scanner", []string{"--token", "s3cret"}).
+				WithExitCode(3).
+				WithStderrTail([]byte("fatal: target unreachable"), 0)
+		},
+	}
+
+	item := queue.WorkItem{
+		JobID:      "test-job",
+		Tool:       mockT.Name(),
+		InputJSON:  string(mustMarshal(wrapperspb.String("test"))),
+		InputType:  mockT.InputMessageType(),
+		OutputType: mockT.OutputMessageType(),
+	}
+
+	result := processWorkItem(context.Background(), mockT, item, "test-worker", newTestLogger())
+	if result.Error == "" {
+		t.Fatal("Expected result to have error")
+	}
+
+	var detail struct {
+		Code    string `json:"code"`
+		Context struct {
+			Args       []string `json:"args"`
+			ExitCode   int      `json:"exit_code"`
+			DurationMS int64    `json:"duration_ms"`
+			StderrTail string   `json:"stderr_tail"`
+		} `json:"context"`
+	}
+	if err := json.Unmarshal(result.ErrorDetail, &detail); err != nil {
+		t.Fatalf("ErrorDetail is not valid JSON: %v (%s)", err, result.ErrorDetail)
+	}
+	if detail.Code != toolerr.ErrCodeExecutionFailed || detail.Context.ExitCode != 3 {
+		t.Errorf("unexpected error detail: %s", result.ErrorDetail)
+	}
+	if detail.Context.StderrTail != "fatal: target unreachable" {
+		t.Errorf("StderrTail = %q", detail.Context.StderrTail)
+	}
+	if detail.Context.DurationMS < 10 {
+		t.Errorf("DurationMS = %d, want the execution time filled in", detail.Context.DurationMS)
+	}
+	if strings.Contains(string(result.ErrorDetail), "s3cret") {
+		t.Errorf("ErrorDetail leaks a secret: %s", result.ErrorDetail)
+	}
+
+	// Plain errors carry no detail
+	mockT.executeFunc = func(ctx context.Context, input proto.Message) (proto.Message, error) {
+		return nil, errors.New("boom")
+	}
+	result = processWorkItem(context.Background(), mockT, item, "test-worker", newTestLogger())
+	if result.ErrorDetail != nil {
+		t.Errorf("ErrorDetail = %s, want none for plain errors", result.ErrorDetail)
+	}
+}
+
 func TestWorkerLoop_ContextCancellation(t *testing.T) {
 	s, redisURL := setupTestRedis(t)
 	defer s.Close()
@@ -709,7 +775,7 @@ func TestWorkerLoop_ContextCancellation(t *testing.T) {
 	finished := make(chan struct{})
 	go func() {
 		defer wg.Done()
-		workerLoop(ctx, 0, mockT, client, queueName, "test-worker", newTestLogger())
+		workerLoop(ctx, 0, mockT, client, queueName, "test-worker", newTestLogger(), nil)
 		close(finished)
 	}()
 
@@ -784,7 +850,7 @@ func TestRun_Integration(t *testing.T) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		workerLoop(ctx, 0, mockT, client, queueName, "integration-worker", newTestLogger())
+		workerLoop(ctx, 0, mockT, client, queueName, "integration-worker", newTestLogger(), nil)
 	}()
 
 	// Give worker time to start
@@ -985,3 +1051,196 @@ func init() {
 		panic("google.protobuf.StringValue type is nil")
 	}
 }
+
+// syncBuffer is a goroutine-safe buffer for capturing log output.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Lines() []map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var lines []map[string]any
+	for _, raw := range bytes.Split(b.buf.Bytes(), []byte("\n")) {
+		if len(raw) == 0 {
+			continue
+		}
+		var line map[string]any
+		if err := json.Unmarshal(raw, &line); err == nil {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestWorkerLoop_LogCorrelation(t *testing.T) {
+	s, redisURL := setupTestRedis(t)
+	defer s.Close()
+
+	mockT := &mockTool{
+		name:    "test-tool",
+		version: "1.0.0",
+		executeFunc: func(ctx context.Context, input proto.Message) (proto.Message, error) {
+			LoggerFromContext(ctx).Info("tool says hello")
+			return wrapperspb.String("ok"), nil
+		},
+	}
+
+	client, err := queue.NewRedisClient(queue.RedisOptions{URL: redisURL})
+	if err != nil {
+		t.Fatalf("Failed to create Redis client: %v", err)
+	}
+	defer client.Close()
+
+	queueName := fmt.Sprintf("tool:%s:queue", mockT.Name())
+	jobID := "correlated-job"
+	inputJSON, _ := protojson.Marshal(wrapperspb.String("input"))
+	item := queue.WorkItem{
+		JobID:      jobID,
+		Index:      3,
+		Total:      4,
+		Tool:       mockT.Name(),
+		InputJSON:  string(inputJSON),
+		InputType:  mockT.InputMessageType(),
+		OutputType: mockT.OutputMessageType(),
+	}
+	if err := client.Push(context.Background(), queueName, item); err != nil {
+		t.Fatalf("Failed to push work item: %v", err)
+	}
+
+	resultsChan, err := client.Subscribe(context.Background(), fmt.Sprintf("results:%s", jobID))
+	if err != nil {
+		t.Fatalf("Failed to subscribe to results: %v", err)
+	}
+
+	// Mirror Run: the worker logger already carries the tool name
+	out := &syncBuffer{}
+	logger := slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug})).
+		With("tool", mockT.Name())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		workerLoop(ctx, 0, mockT, client, queueName, "test-worker", logger, nil)
+	}()
+
+	select {
+	case <-resultsChan:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for result")
+	}
+	cancel()
+	wg.Wait()
+
+	itemMessages := map[string]bool{
+		"received work item":  false,
+		"tool says hello":     false,
+		"work item completed": false,
+	}
+	for _, line := range out.Lines() {
+		msg, _ := line["msg"].(string)
+		if _, ok := itemMessages[msg]; !ok {
+			continue
+		}
+		itemMessages[msg] = true
+
+		if line["job_id"] != jobID {
+			t.Errorf("%q: expected job_id %q, got %v", msg, jobID, line["job_id"])
+		}
+		if line["tool"] != mockT.Name() {
+			t.Errorf("%q: expected tool %q, got %v", msg, mockT.Name(), line["tool"])
+		}
+		if line["index"] != float64(3) {
+			t.Errorf("%q: expected index 3, got %v", msg, line["index"])
+		}
+	}
+	for msg, seen := range itemMessages {
+		if !seen {
+			t.Errorf("Expected log line %q", msg)
+		}
+	}
+}
+
+func TestLoggerFromContext_Default(t *testing.T) {
+	if LoggerFromContext(context.Background()) != slog.Default() {
+		t.Error("Expected slog.Default() for context without logger")
+	}
+}
+
+func TestStartItemSpan_PropagatesTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer provider.Shutdown(context.Background())
+	tracer := provider.Tracer("test")
+
+	item := queue.WorkItem{
+		JobID:   "job-1",
+		Index:   2,
+		Total:   5,
+		Tool:    "nmap",
+		TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:  "00f067aa0ba902b7",
+	}
+
+	_, span := startItemSpan(context.Background(), tracer, item, "worker-1")
+	span.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+
+	got := spans[0]
+	if got.SpanContext().TraceID().String() != item.TraceID {
+		t.Errorf("Expected trace ID %s, got %s", item.TraceID, got.SpanContext().TraceID())
+	}
+	if got.Parent().SpanID().String() != item.SpanID {
+		t.Errorf("Expected parent span ID %s, got %s", item.SpanID, got.Parent().SpanID())
+	}
+	if !got.Parent().IsRemote() {
+		t.Error("Expected parent span context to be remote")
+	}
+
+	attrs := make(map[string]any)
+	for _, kv := range got.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	if attrs["gibson.worker.job_id"] != "job-1" {
+		t.Errorf("Expected job_id attribute, got %v", attrs["gibson.worker.job_id"])
+	}
+	if attrs["gibson.tool.name"] != "nmap" {
+		t.Errorf("Expected tool attribute, got %v", attrs["gibson.tool.name"])
+	}
+	if attrs["gibson.worker.index"] != int64(2) {
+		t.Errorf("Expected index attribute, got %v", attrs["gibson.worker.index"])
+	}
+}
+
+func TestStartItemSpan_WithoutTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer provider.Shutdown(context.Background())
+
+	_, span := startItemSpan(context.Background(), provider.Tracer("test"), queue.WorkItem{JobID: "job-1"}, "worker-1")
+	span.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Parent().IsValid() {
+		t.Error("Expected root span when work item carries no trace context")
+	}
+}
@@ -0,0 +1,195 @@
+package toolerr
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// Size bounds for captured error context.
+const (
+	// DefaultStderrTailBytes is the stderr tail kept when WithStderrTail is
+	// given no limit.
+	DefaultStderrTailBytes = 4096
+
+	// MaxArgs is the number of command arguments kept by WithCommand.
+	MaxArgs = 64
+
+	// MaxArgBytes is the length at which WithCommand truncates an argument.
+	MaxArgBytes = 512
+)
+
+// ErrorContext describes the command execution behind an error, for
+// debugging failures without access to the worker. Values are redacted
+// when they are set.
+type ErrorContext struct {
+	// Command is the command that was run
+	Command string
+
+	// Args are the command-line arguments, with secrets redacted
+	Args []string
+
+	// StderrTail is the end of the command's stderr, with secrets redacted
+	StderrTail string
+
+	// StderrTruncated is the number of stderr bytes dropped before
+	// StderrTail
+	StderrTruncated int
+
+	// Duration is how long the command ran
+	Duration time.Duration
+
+	// ExitCode is the command's exit code, or nil if it did not exit
+	ExitCode *int
+}
+
+// context returns the error's context, creating it if needed.
+func (e *Error) context() *ErrorContext {
+	if e.Context == nil {
+		e.Context = &ErrorContext{}
+	}
+	return e.Context
+}
+
+// WithCommand records the command line that failed. Secrets are redacted
+// and the arguments are bounded by MaxArgs and MaxArgBytes.
+// This method returns the same error instance for method chaining.
+//
+// Example:
+//
+//	err := toolerr.New("sqlmap", "scan", toolerr.ErrCodeExecutionFailed, "scan failed").
+//	    WithCommand("sqlmap", []string{"-u", target, "--batch"})
+func (e *Error) WithCommand(cmd string, args []string) *Error {
+	ctx := e.context()
+	ctx.Command = Redact(cmd)
+
+	kept := args
+	if len(kept) > MaxArgs {
+		kept = kept[:MaxArgs]
+	}
+	ctx.Args = redactArgs(kept)
+	for i, arg := range ctx.Args {
+		ctx.Args[i] = truncateHead(arg, MaxArgBytes)
+	}
+	if len(args) > MaxArgs {
+		ctx.Args = append(ctx.Args, fmt.Sprintf("... (%d more)", len(args)-MaxArgs))
+	}
+	return e
+}
+
+// WithStderrTail records the last maxBytes of the command's stderr, or
+// DefaultStderrTailBytes if maxBytes is not positive. Secrets are redacted
+// before truncation, and StderrTruncated counts the bytes dropped.
+// This method returns the same error instance for method chaining.
+//
+// Example:
+//
+//	err := toolerr.New("nmap", "scan", toolerr.ErrCodeExecutionFailed, "scan failed").
+//	    WithStderrTail(result.Stderr, 2048)
+func (e *Error) WithStderrTail(b []byte, maxBytes int) *Error {
+	if maxBytes <= 0 {
+		maxBytes = DefaultStderrTailBytes
+	}
+	stderr := Redact(string(b))
+
+	ctx := e.context()
+	ctx.StderrTail = truncateTail(stderr, maxBytes)
+	ctx.StderrTruncated = len(stderr) - len(ctx.StderrTail)
+	return e
+}
+
+// WithDuration records how long the failed command ran.
+// This method returns the same error instance for method chaining.
+func (e *Error) WithDuration(d time.Duration) *Error {
+	e.context().Duration = d
+	return e
+}
+
+// WithExitCode records the failed command's exit code.
+// This method returns the same error instance for method chaining.
+func (e *Error) WithExitCode(code int) *Error {
+	e.context().ExitCode = &code
+	return e
+}
+
+// errorJSON is the JSON shape of an Error.
+type errorJSON struct {
+	Tool      string            `json:"tool"`
+	Operation string            `json:"operation"`
+	Code      string            `json:"code"`
+	Message   string            `json:"message,omitempty"`
+	Class     ErrorClass        `json:"class,omitempty"`
+	Cause     string            `json:"cause,omitempty"`
+	Details   map[string]any    `json:"details,omitempty"`
+	Hints     []RecoveryHint    `json:"hints,omitempty"`
+	Context   *errorContextJSON `json:"context,omitempty"`
+}
+
+// errorContextJSON is the JSON shape of an ErrorContext.
+type errorContextJSON struct {
+	Command         string   `json:"command,omitempty"`
+	Args            []string `json:"args,omitempty"`
+	ExitCode        *int     `json:"exit_code,omitempty"`
+	DurationMS      int64    `json:"duration_ms,omitempty"`
+	StderrTail      string   `json:"stderr_tail,omitempty"`
+	StderrTruncated int      `json:"stderr_truncated_bytes,omitempty"`
+}
+
+// MarshalJSON encodes the error in a stable shape for log pipelines: snake
+// case keys, the cause as its message, and empty fields omitted.
+//
+// Example output:
+//
+//	{"tool":"nmap","operation":"scan","code":"EXECUTION_FAILED","message":"scan failed",
+//	 "context":{"command":"nmap","args":["-sV","10.0.0.1"],"exit_code":1,"duration_ms":1200,"stderr_tail":"..."}}
+func (e *Error) MarshalJSON() ([]byte, error) {
+	out := errorJSON{
+		Tool:      e.Tool,
+		Operation: e.Operation,
+		Code:      e.Code,
+		Message:   e.Message,
+		Class:     e.Class,
+		Details:   e.Details,
+		Hints:     e.Hints,
+	}
+	if e.Cause != nil {
+		out.Cause = e.Cause.Error()
+	}
+	if c := e.Context; c != nil {
+		out.Context = &errorContextJSON{
+			Command:         c.Command,
+			Args:            c.Args,
+			ExitCode:        c.ExitCode,
+			DurationMS:      c.Duration.Milliseconds(),
+			StderrTail:      c.StderrTail,
+			StderrTruncated: c.StderrTruncated,
+		}
+	}
+	return json.Marshal(out)
+}
+
+// truncateTail returns the last maxBytes of s, starting at a rune boundary.
+func truncateTail(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	start := len(s) - maxBytes
+	for start < len(s) && !utf8.RuneStart(s[start]) {
+		start++
+	}
+	return s[start:]
+}
+
+// truncateHead returns s cut to at most maxBytes at a rune boundary, marked
+// with a trailing ellipsis.
+func truncateHead(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	end := maxBytes
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + "..."
+}
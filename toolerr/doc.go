@@ -50,6 +50,21 @@
 //	        toolErr.Tool, toolErr.Operation, toolErr.Code)
 //	}
 //
+// # Execution Context
+//
+// Record the failed command line, exit code, duration, and stderr tail so
+// the failure can be debugged without access to the worker. Secrets matching
+// RedactionPatterns are redacted and the stderr tail is bounded:
+//
+//	err := toolerr.New("nmap", "scan", toolerr.ErrCodeExecutionFailed, "scan failed").
+//	    WithCommand(cfg.Command, cfg.Args).
+//	    WithExitCode(result.ExitCode).
+//	    WithDuration(result.Duration).
+//	    WithStderrTail(result.Stderr, 4096)
+//
+// FromExec does this for the result of exec.Run. Errors marshal to JSON in a
+// stable shape, which the tool worker attaches to failed results.
+//
 // # Integration with errors package
 //
 // The Error type implements:
@@ -65,6 +65,9 @@ type Error struct {
 
 	// Hints provides recovery suggestions for this error
 	Hints []RecoveryHint `json:"hints,omitempty"`
+
+	// Context describes the command execution that failed, if any
+	Context *ErrorContext `json:"context,omitempty"`
 }
 
 // New creates a new structured tool error.
@@ -193,12 +193,10 @@ func Example_jsonSerialization() {
 	fmt.Println(string(data))
 	// Output:
 	// {
-	//   "Tool": "kubectl",
-	//   "Operation": "apply",
-	//   "Code": "TIMEOUT",
-	//   "Message": "operation timed out",
-	//   "Details": null,
-	//   "Cause": null,
+	//   "tool": "kubectl",
+	//   "operation": "apply",
+	//   "code": "TIMEOUT",
+	//   "message": "operation timed out",
 	//   "class": "transient",
 	//   "hints": [
 	//     {
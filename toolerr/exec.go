@@ -0,0 +1,56 @@
+package toolerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	osexec "os/exec"
+
+	"github.com/zero-day-ai/sdk/exec"
+)
+
+// FromExec builds an error for a failed exec.Run, with the command line,
+// exit code, duration, and stderr tail recorded in its Context. It returns
+// nil if the command ran and exited zero.
+//
+// The code is ErrCodeTimeout if the command timed out, ErrCodeBinaryNotFound
+// if the binary could not be found, and ErrCodeExecutionFailed otherwise.
+//
+// Example:
+//
+//	cfg := exec.Config{Command: "nmap", Args: args, Timeout: 5 * time.Minute}
+//	result, err := exec.Run(ctx, cfg)
+//	if terr := toolerr.FromExec("nmap", "scan", cfg, result, err); terr != nil {
+//	    return nil, terr
+//	}
+func FromExec(tool, operation string, cfg exec.Config, result *exec.Result, err error) *Error {
+	if err == nil && (result == nil || result.ExitCode == 0) {
+		return nil
+	}
+
+	var e *Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		e = New(tool, operation, ErrCodeTimeout, fmt.Sprintf("%s timed out", cfg.Command)).
+			WithCause(err).
+			WithClass(ErrorClassTransient)
+	case errors.Is(err, osexec.ErrNotFound):
+		e = New(tool, operation, ErrCodeBinaryNotFound, fmt.Sprintf("%s not found in PATH", cfg.Command)).
+			WithCause(err).
+			WithClass(ErrorClassInfrastructure)
+	case err != nil:
+		e = New(tool, operation, ErrCodeExecutionFailed, fmt.Sprintf("%s failed to run", cfg.Command)).
+			WithCause(err)
+	default:
+		e = New(tool, operation, ErrCodeExecutionFailed, fmt.Sprintf("%s exited with code %d", cfg.Command, result.ExitCode))
+	}
+
+	e.WithCommand(cfg.Command, cfg.Args)
+	if result != nil {
+		e.WithDuration(result.Duration).WithStderrTail(result.Stderr, DefaultStderrTailBytes)
+		if err == nil {
+			e.WithExitCode(result.ExitCode)
+		}
+	}
+	return e
+}
@@ -0,0 +1,142 @@
+package toolerr
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secrets removed by Redact.
+const Redacted = "[REDACTED]"
+
+// RedactionPattern matches a secret in command lines and tool output.
+type RedactionPattern struct {
+	// Name identifies the kind of secret
+	Name string
+
+	// Pattern matches the secret
+	Pattern *regexp.Regexp
+
+	// Replacement replaces each match and may refer to submatches, as in
+	// regexp.Regexp.ReplaceAllString
+	Replacement string
+}
+
+// RedactionPatterns are the secret patterns Redact removes. Tools with their
+// own secret formats may append to this list during initialization.
+var RedactionPatterns = []RedactionPattern{
+	{
+		Name:        "private_key",
+		Pattern:     regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?(-----END [A-Z ]*PRIVATE KEY-----|$)`),
+		Replacement: Redacted,
+	},
+	{
+		Name:        "url_credentials",
+		Pattern:     regexp.MustCompile(`(://[^/\s:@]+:)[^/\s@]+@`),
+		Replacement: "${1}" + Redacted + "@",
+	},
+	{
+		Name:        "authorization",
+		Pattern:     regexp.MustCompile(`(?i)\b(bearer|basic|token)\s+[A-Za-z0-9._~+/=-]{8,}`),
+		Replacement: "${1} " + Redacted,
+	},
+	{
+		Name:        "key_value",
+		Pattern:     regexp.MustCompile(`(?i)([\w-]*(?:password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key|credential)[\w-]*["']?\s*[=:]\s*)("[^"]*"|'[^']*'|[^\s&,;]+)`),
+		Replacement: "${1}" + Redacted,
+	},
+	{
+		Name:        "aws_access_key",
+		Pattern:     regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+		Replacement: Redacted,
+	},
+	{
+		Name:        "github_token",
+		Pattern:     regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`),
+		Replacement: Redacted,
+	},
+	{
+		Name:        "jwt",
+		Pattern:     regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`),
+		Replacement: Redacted,
+	},
+}
+
+// secretFlag matches command-line flags whose value, in the next argument,
+// is a secret, such as "--password" or "-token".
+var secretFlag = regexp.MustCompile(`(?i)^--?[\w-]*(password|passwd|secret|token|api[_-]?key|access[_-]?key|credential)[\w-]*$`)
+
+// secretField matches field names whose value is a secret, such as
+// "password" or "apiKey".
+var secretField = regexp.MustCompile(`(?i)(password|passwd|secret|token|api[_-]?key|access[_-]?key|credential)`)
+
+// Redact removes secrets matching RedactionPatterns from s.
+//
+// Example:
+//
+//	toolerr.Redact("mysql --password=hunter2 -h db.internal")
+//	// "mysql --password=[REDACTED] -h db.internal"
+func Redact(s string) string {
+	for _, p := range RedactionPatterns {
+		s = p.Pattern.ReplaceAllString(s, p.Replacement)
+	}
+	return s
+}
+
+// redactArgs returns a copy of args with secrets removed. Besides the
+// patterns applied by Redact, the argument after a secret flag such as
+// "--password" is redacted entirely.
+func redactArgs(args []string) []string {
+	if args == nil {
+		return nil
+	}
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		if i > 0 && secretFlag.MatchString(args[i-1]) && !strings.HasPrefix(arg, "-") {
+			redacted[i] = Redacted
+			continue
+		}
+		redacted[i] = Redact(arg)
+	}
+	return redacted
+}
+
+// RedactFields returns a copy of fields, such as the JSON form of a tool's
+// input, with secrets removed. String values of fields whose name looks
+// secret, such as "password" or "apiKey", are redacted entirely; other
+// strings are passed through Redact. Nested objects and arrays are redacted
+// recursively.
+func RedactFields(fields map[string]any) map[string]any {
+	if fields == nil {
+		return nil
+	}
+	redacted := make(map[string]any, len(fields))
+	for name, value := range fields {
+		if s, ok := value.(string); ok && secretField.MatchString(name) {
+			if s != "" {
+				s = Redacted
+			}
+			redacted[name] = s
+			continue
+		}
+		redacted[name] = redactValue(value)
+	}
+	return redacted
+}
+
+// redactValue removes secrets from a JSON value.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return Redact(v)
+	case map[string]any:
+		return RedactFields(v)
+	case []any:
+		redacted := make([]any, len(v))
+		for i, elem := range v {
+			redacted[i] = redactValue(elem)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
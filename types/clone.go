@@ -0,0 +1,149 @@
+package types
+
+import (
+	"reflect"
+	"slices"
+)
+
+// Clone returns a deep copy of the mission context. The metadata map and
+// the values nested in it, and the technique lists of the constraints, are
+// copied, so the clone can be modified without affecting the original.
+func (m MissionContext) Clone() MissionContext {
+	clone := m
+	clone.Metadata = cloneMap(m.Metadata)
+	clone.Constraints = m.Constraints.Clone()
+	return clone
+}
+
+// Clone returns a copy of the constraints with their own technique lists.
+func (c MissionConstraints) Clone() MissionConstraints {
+	clone := c
+	clone.AllowedTechniques = slices.Clone(c.AllowedTechniques)
+	clone.BlockedTechniques = slices.Clone(c.BlockedTechniques)
+	return clone
+}
+
+// Clone returns a deep copy of the target info. The connection and metadata
+// maps and the values nested in them, such as Connection["headers"], and the
+// transport hints are copied, so the clone can be modified without affecting
+// the original.
+//
+// Example:
+//
+//	authed := harness.Target().Clone()
+//	authed.SetHeader("Authorization", "Bearer "+token)
+func (t TargetInfo) Clone() TargetInfo {
+	clone := t
+	clone.Connection = cloneMap(t.Connection)
+	clone.Metadata = cloneMap(t.Metadata)
+	if t.Transport != nil {
+		hints := *t.Transport
+		clone.Transport = &hints
+	}
+	return clone
+}
+
+// cloneMap deep-copies a map of arbitrary values. A nil map stays nil.
+func cloneMap(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	clone := make(map[string]any, len(m))
+	for k, v := range m {
+		clone[k] = cloneValue(v)
+	}
+	return clone
+}
+
+// cloneValue deep-copies the maps, slices, arrays and pointers in v. The
+// JSON-shaped values found in metadata take a fast path; other types are
+// copied by reflection. Struct values are copied field by field when all
+// their fields are exported and by assignment otherwise.
+func cloneValue(v any) any {
+	switch v := v.(type) {
+	case nil, string, bool, int, int32, int64, float32, float64:
+		return v
+	case map[string]any:
+		return cloneMap(v)
+	case []any:
+		if v == nil {
+			return v
+		}
+		clone := make([]any, len(v))
+		for i, e := range v {
+			clone[i] = cloneValue(e)
+		}
+		return clone
+	case map[string]string:
+		if v == nil {
+			return v
+		}
+		clone := make(map[string]string, len(v))
+		for k, e := range v {
+			clone[k] = e
+		}
+		return clone
+	case []string:
+		return slices.Clone(v)
+	}
+	return cloneReflect(reflect.ValueOf(v)).Interface()
+}
+
+// cloneReflect deep-copies v by reflection.
+func cloneReflect(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			clone.SetMapIndex(iter.Key(), cloneReflect(iter.Value()))
+		}
+		return clone
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			clone.Index(i).Set(cloneReflect(v.Index(i)))
+		}
+		return clone
+	case reflect.Array:
+		clone := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			clone.Index(i).Set(cloneReflect(v.Index(i)))
+		}
+		return clone
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.New(v.Type().Elem())
+		clone.Elem().Set(cloneReflect(v.Elem()))
+		return clone
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.New(v.Type()).Elem()
+		clone.Set(cloneReflect(v.Elem()))
+		return clone
+	case reflect.Struct:
+		clone := reflect.New(v.Type()).Elem()
+		clone.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				return clone
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			clone.Field(i).Set(cloneReflect(v.Field(i)))
+		}
+		return clone
+	default:
+		return v
+	}
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cloneNested struct {
+	Tags  []string
+	Limit *int
+}
+
+func TestTargetInfoClone(t *testing.T) {
+	limit := 10
+	original := TargetInfo{
+		ID:   "t1",
+		Name: "api",
+		Type: "http_api",
+		Connection: map[string]any{
+			"url":     "https://example.com",
+			"headers": map[string]any{"X-Env": "prod"},
+			"ports":   []any{80, 443},
+			"cookies": map[string]string{"session": "abc"},
+			"scopes":  []string{"read"},
+			"nested":  cloneNested{Tags: []string{"a"}, Limit: &limit},
+			"limits":  map[string][]int{"rps": {5}},
+		},
+		Metadata: map[string]any{"model": map[string]any{"version": "1"}},
+	}
+
+	clone := original.Clone()
+	require.Equal(t, original, clone)
+
+	clone.SetHeader("X-Env", "staging")
+	clone.SetHeader("Authorization", "Bearer token")
+	clone.Connection["ports"].([]any)[0] = 8080
+	clone.Connection["cookies"].(map[string]string)["session"] = "xyz"
+	clone.Connection["scopes"].([]string)[0] = "write"
+	clone.Connection["nested"].(cloneNested).Tags[0] = "b"
+	*clone.Connection["nested"].(cloneNested).Limit = 20
+	clone.Connection["limits"].(map[string][]int)["rps"][0] = 50
+	clone.Metadata["model"].(map[string]any)["version"] = "2"
+	clone.SetMetadata("extra", true)
+
+	assert.Equal(t, "prod", original.GetHeader("X-Env"))
+	assert.Empty(t, original.GetHeader("Authorization"))
+	assert.Equal(t, 80, original.Connection["ports"].([]any)[0])
+	assert.Equal(t, "abc", original.Connection["cookies"].(map[string]string)["session"])
+	assert.Equal(t, "read", original.Connection["scopes"].([]string)[0])
+	assert.Equal(t, "a", original.Connection["nested"].(cloneNested).Tags[0])
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 5, original.Connection["limits"].(map[string][]int)["rps"][0])
+	assert.Equal(t, "1", original.Metadata["model"].(map[string]any)["version"])
+	assert.NotContains(t, original.Metadata, "extra")
+}
+
+func TestTargetInfoClone_NilMaps(t *testing.T) {
+	clone := TargetInfo{ID: "t1"}.Clone()
+	assert.Nil(t, clone.Connection)
+	assert.Nil(t, clone.Metadata)
+}
+
+func TestMissionContextClone(t *testing.T) {
+	original := MissionContext{
+		ID:   "m1",
+		Name: "mission",
+		Constraints: NewMissionConstraints().
+			WithMaxDuration(time.Hour).
+			WithAllowedTechniques("T1190", "T1059").
+			WithBlockedTechniques("T1485"),
+		Metadata: map[string]any{"scope": []any{"10.0.0.0/24"}},
+	}
+
+	clone := original.Clone()
+	require.Equal(t, original, clone)
+
+	clone.Constraints.AllowedTechniques[0] = "T1110"
+	clone.Constraints.BlockedTechniques = append(clone.Constraints.BlockedTechniques[:0], "T1499")
+	clone.Metadata["scope"].([]any)[0] = "0.0.0.0/0"
+	clone.SetMetadata("phase", "exploit")
+
+	assert.Equal(t, TechniqueType("T1190"), original.Constraints.AllowedTechniques[0])
+	assert.Equal(t, TechniqueType("T1485"), original.Constraints.BlockedTechniques[0])
+	assert.Equal(t, "10.0.0.0/24", original.Metadata["scope"].([]any)[0])
+	assert.NotContains(t, original.Metadata, "phase")
+}
@@ -38,24 +38,39 @@
 //   - TargetTypeAgent: Autonomous AI agent systems
 //   - TargetTypeCopilot: AI coding assistants
 //
+// Transport hints tune the connections agents open to a target. HTTPClient
+// returns a client honoring them that is shared by targets with equal hints,
+// so requests reuse pooled connections instead of dialing each time:
+//
+//	target.Transport = &types.TransportHints{
+//	    MaxIdleConnsPerHost: 32,
+//	    MinTLSVersion:       "1.2",
+//	    ProxyURL:            "http://proxy.internal:3128",
+//	}
+//	resp, err := target.HTTPClient().Do(req)
+//
 // # Technique Types
 //
-// Technique types categorize security testing approaches:
+// Technique types describe security testing approaches. External IDs link a
+// technique to canonical MITRE ATT&CK or ATLAS techniques:
 //
 //	technique := &types.TechniqueInfo{
-//	    Type:        types.TechniquePromptInjection,
+//	    Type:        "prompt_injection",
 //	    Name:        "System Prompt Override",
 //	    Description: "Attempts to override system instructions",
 //	}
+//	technique.AddExternalID(types.FrameworkATLAS, "AML.T0051")
 //	technique.AddTag("high-risk")
 //	technique.SetMetadata("success_rate", 0.85)
 //
-// Supported technique types:
-//   - TechniquePromptInjection: Malicious instruction injection
-//   - TechniqueJailbreak: Safety guardrail bypass
-//   - TechniqueDataExtraction: Training data or sensitive info extraction
-//   - TechniqueModelManipulation: Behavior or output alteration
-//   - TechniqueDOS: Denial-of-service attacks
+// Validate checks the ID format of each framework (T1059 or T1059.001 for
+// ATT&CK, AML.T0051 for ATLAS). Warnings cross-checks names given on external
+// IDs against a small bundled table of the techniques behind the SDK's
+// capabilities, which LookupTechnique also exposes:
+//
+//	if s, ok := types.LookupTechnique(types.FrameworkATLAS, "AML.T0054"); ok {
+//	    fmt.Println(s.Name, s.Tactic) // LLM Jailbreak Privilege Escalation
+//	}
 //
 // # Mission Types
 //
@@ -77,6 +92,16 @@
 //	    // Stop execution
 //	}
 //
+// Rules of engagement that forbid techniques, such as denial-of-service
+// testing, are expressed with blocked or allowed technique types. Agents
+// check PermitsTechnique before attempting a technique, and the callback
+// harness rejects findings whose technique the mission does not permit:
+//
+//	constraints = constraints.WithBlockedTechniques("dos")
+//	if !mission.Constraints.PermitsTechnique("dos") {
+//	    // Skip the technique
+//	}
+//
 // # Validation
 //
 // All major types support validation:
@@ -106,4 +131,11 @@
 //	if err := json.Unmarshal(data, &loaded); err != nil {
 //	    log.Fatal(err)
 //	}
+//
+// TargetInfo and MissionContext are versioned so they can be stored durably
+// and reloaded after an SDK upgrade. Marshaling stamps CurrentSchemaVersion
+// into the schema_version field, and unmarshaling migrates data written by
+// older versions automatically. Use Migrate to upgrade stored JSON in place:
+//
+//	upgraded, err := types.Migrate(stored)
 package types
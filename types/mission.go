@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"slices"
 	"time"
 )
 
@@ -26,6 +27,10 @@ type MissionContext struct {
 	// Metadata stores additional mission-specific information.
 	// This can include start time, objectives, priorities, team assignments, etc.
 	Metadata map[string]any `json:"metadata,omitempty"`
+
+	// SchemaVersion is the serialization schema version. Marshaling sets it
+	// to CurrentSchemaVersion.
+	SchemaVersion int `json:"schema_version,omitempty"`
 }
 
 // MissionConstraints defines operational limits for mission execution.
@@ -45,6 +50,14 @@ type MissionConstraints struct {
 
 	// RequireEvidence indicates whether findings must include proof-of-concept evidence.
 	RequireEvidence bool `json:"require_evidence"`
+
+	// AllowedTechniques lists the technique types the mission may use.
+	// Empty means all techniques not blocked are allowed.
+	AllowedTechniques []TechniqueType `json:"allowed_techniques,omitempty"`
+
+	// BlockedTechniques lists the technique types the rules of engagement
+	// forbid, such as "dos". This takes precedence over AllowedTechniques.
+	BlockedTechniques []TechniqueType `json:"blocked_techniques,omitempty"`
 }
 
 // Validate checks if the MissionContext has all required fields.
@@ -60,13 +73,27 @@ func (m *MissionContext) Validate() error {
 	return nil
 }
 
+// MarshalJSON stamps the current schema version.
+func (m MissionContext) MarshalJSON() ([]byte, error) {
+	type Alias MissionContext
+	alias := Alias(m)
+	alias.SchemaVersion = CurrentSchemaVersion
+	return json.Marshal(alias)
+}
+
 // UnmarshalJSON implements custom unmarshaling for MissionContext.
+// Data written by older SDK versions is migrated first (see Migrate).
 // This handles the case where the 'constraints' field can be either:
 // - A MissionConstraints struct (SDK native format)
 // - An array of strings (Gibson's harness.MissionContext format)
 // When constraints is an array of strings, it is ignored and an empty
 // MissionConstraints struct is used instead.
 func (m *MissionContext) UnmarshalJSON(data []byte) error {
+	data, err := migrateForUnmarshal(data)
+	if err != nil {
+		return err
+	}
+
 	// Use an alias to avoid infinite recursion
 	type Alias MissionContext
 	aux := &struct {
@@ -184,6 +211,21 @@ func (m *MissionConstraints) MeetsSeverityThreshold(severity string) bool {
 	return level >= threshold
 }
 
+// PermitsTechnique checks if a technique is allowed by the constraints.
+// Agents call it before attempting a technique.
+func (m *MissionConstraints) PermitsTechnique(technique TechniqueType) bool {
+	if slices.Contains(m.BlockedTechniques, technique) {
+		return false
+	}
+
+	// If no allowed techniques specified, all techniques are allowed (except blocked)
+	if len(m.AllowedTechniques) == 0 {
+		return true
+	}
+
+	return slices.Contains(m.AllowedTechniques, technique)
+}
+
 // NewMissionContext creates a new mission context with default values.
 func NewMissionContext(id, name string) *MissionContext {
 	return &MissionContext{
@@ -224,6 +266,18 @@ func (c MissionConstraints) WithRequireEvidence(require bool) MissionConstraints
 	return c
 }
 
+// WithAllowedTechniques sets the technique types the mission may use.
+func (c MissionConstraints) WithAllowedTechniques(techniques ...TechniqueType) MissionConstraints {
+	c.AllowedTechniques = techniques
+	return c
+}
+
+// WithBlockedTechniques sets the technique types the mission must not use.
+func (c MissionConstraints) WithBlockedTechniques(techniques ...TechniqueType) MissionConstraints {
+	c.BlockedTechniques = techniques
+	return c
+}
+
 // MissionExecutionContext extends mission tracking with run history and execution state.
 // It supports resumable missions, run continuity, and accumulated metrics across multiple executions.
 type MissionExecutionContext struct {
@@ -284,6 +284,52 @@ func TestMissionConstraints_MeetsSeverityThreshold(t *testing.T) {
 	}
 }
 
+func TestMissionConstraints_PermitsTechnique(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowed   []TechniqueType
+		blocked   []TechniqueType
+		technique TechniqueType
+		want      bool
+	}{
+		{"no lists", nil, nil, "dos", true},
+		{"blocked", nil, []TechniqueType{"dos"}, "dos", false},
+		{"not blocked", nil, []TechniqueType{"dos"}, "jailbreak", true},
+		{"allowed", []TechniqueType{"jailbreak"}, nil, "jailbreak", true},
+		{"not allowed", []TechniqueType{"jailbreak"}, nil, "dos", false},
+		{"blocked wins over allowed", []TechniqueType{"dos"}, []TechniqueType{"dos"}, "dos", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraints := NewMissionConstraints().
+				WithAllowedTechniques(tt.allowed...).
+				WithBlockedTechniques(tt.blocked...)
+
+			if got := constraints.PermitsTechnique(tt.technique); got != tt.want {
+				t.Errorf("PermitsTechnique(%v) = %v, want %v", tt.technique, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissionConstraints_TechniquesJSON(t *testing.T) {
+	constraints := NewMissionConstraints().WithBlockedTechniques("dos")
+
+	data, err := json.Marshal(constraints)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got MissionConstraints
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got.PermitsTechnique("dos") {
+		t.Errorf("blocked technique permitted after round trip: %s", data)
+	}
+}
+
 func TestNewMissionContext(t *testing.T) {
 	id := "mission-1"
 	name := "Test Mission"
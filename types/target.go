@@ -1,6 +1,10 @@
 package types
 
-import "github.com/zero-day-ai/sdk/input"
+import (
+	"encoding/json"
+
+	"github.com/zero-day-ai/sdk/input"
+)
 
 // TargetInfo contains detailed information about a target system.
 // It provides all necessary context for agents to interact with and test the target.
@@ -27,6 +31,34 @@ type TargetInfo struct {
 	// Metadata stores additional target-specific information and context.
 	// This can include model versions, capabilities, rate limits, etc.
 	Metadata map[string]any `json:"metadata,omitempty"`
+
+	// Transport holds optional hints for the connections agents open to the
+	// target, such as pooling limits, TLS settings and a proxy. HTTPClient
+	// builds a client honoring them.
+	Transport *TransportHints `json:"transport,omitempty"`
+
+	// SchemaVersion is the serialization schema version. Marshaling sets it
+	// to CurrentSchemaVersion.
+	SchemaVersion int `json:"schema_version,omitempty"`
+}
+
+// MarshalJSON stamps the current schema version.
+func (t TargetInfo) MarshalJSON() ([]byte, error) {
+	type Alias TargetInfo
+	alias := Alias(t)
+	alias.SchemaVersion = CurrentSchemaVersion
+	return json.Marshal(alias)
+}
+
+// UnmarshalJSON migrates data written by older SDK versions before decoding.
+func (t *TargetInfo) UnmarshalJSON(data []byte) error {
+	data, err := migrateForUnmarshal(data)
+	if err != nil {
+		return err
+	}
+
+	type Alias TargetInfo
+	return json.Unmarshal(data, (*Alias)(t))
 }
 
 // Validate checks if the TargetInfo has all required fields.
@@ -47,6 +79,12 @@ func (t *TargetInfo) Validate() error {
 		return &ValidationError{Field: "Connection", Message: "target must have Connection parameters"}
 	}
 
+	if t.Transport != nil {
+		if err := t.Transport.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
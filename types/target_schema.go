@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/zero-day-ai/sdk/schema"
 )
@@ -250,3 +252,97 @@ func (ts *TargetSchema) Clone() TargetSchema {
 
 	return cloned
 }
+
+// TargetConfigError reports every problem found validating a target's
+// configuration against the target schemas an agent declares.
+type TargetConfigError struct {
+	// TargetType is the type of the rejected target.
+	TargetType string
+
+	// Fields lists the problems, one per offending field. Fields are named
+	// "type" for an unsupported target type and "connection.<name>" for
+	// connection parameters.
+	Fields []ValidationError
+}
+
+// Error implements the error interface.
+func (e *TargetConfigError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return fmt.Sprintf("invalid %q target configuration: %s", e.TargetType, strings.Join(msgs, "; "))
+}
+
+// ValidateTargetConfig validates a target against the schemas of the target
+// types an agent supports, so that a mismatched configuration is rejected
+// before the agent runs. The schema is chosen by target.Type. Unlike
+// TargetSchema.ValidateConnection, it reports every missing or invalid
+// connection parameter rather than only the first.
+//
+// It returns nil when schemas is empty, since an agent that declares no
+// schemas accepts any target. Problems with the target are returned as a
+// *TargetConfigError; an invalid schema is returned as its own error.
+//
+// Example:
+//
+//	if err := types.ValidateTargetConfig(target, agent.TargetSchemas()); err != nil {
+//		var cfgErr *types.TargetConfigError
+//		if errors.As(err, &cfgErr) {
+//			for _, f := range cfgErr.Fields {
+//				log.Printf("%s: %s", f.Field, f.Message)
+//			}
+//		}
+//	}
+func ValidateTargetConfig(target TargetInfo, schemas []TargetSchema) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	var ts *TargetSchema
+	supported := make([]string, len(schemas))
+	for i := range schemas {
+		supported[i] = schemas[i].Type
+		if schemas[i].Type == target.Type {
+			ts = &schemas[i]
+		}
+	}
+	if ts == nil {
+		return &TargetConfigError{
+			TargetType: target.Type,
+			Fields: []ValidationError{{
+				Field:   "type",
+				Message: fmt.Sprintf("unsupported target type %q, expected one of %v", target.Type, supported),
+			}},
+		}
+	}
+	if err := ts.Validate(); err != nil {
+		return fmt.Errorf("invalid %q target schema: %w", ts.Type, err)
+	}
+
+	var fields []ValidationError
+	for _, name := range ts.Schema.Required {
+		if _, ok := target.Connection[name]; !ok {
+			fields = append(fields, ValidationError{Field: "connection." + name, Message: "is required"})
+		}
+	}
+	names := make([]string, 0, len(target.Connection))
+	for name := range target.Connection {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		prop, ok := ts.Schema.Properties[name]
+		if !ok {
+			continue
+		}
+		if err := prop.Validate(target.Connection[name]); err != nil {
+			fields = append(fields, ValidationError{Field: "connection." + name, Message: err.Error()})
+		}
+	}
+
+	if len(fields) > 0 {
+		return &TargetConfigError{TargetType: target.Type, Fields: fields}
+	}
+	return nil
+}
@@ -287,3 +287,54 @@ func TestTargetSchema_ValidateConnection_ComplexSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTargetConfig(t *testing.T) {
+	k8sSchema := TargetSchema{
+		Type:        "kubernetes",
+		Version:     "1.0",
+		Description: "Kubernetes cluster target",
+		Schema: schema.Object(map[string]schema.JSON{
+			"cluster":   schema.String(),
+			"namespace": schema.String(),
+			"replicas":  schema.Int(),
+		}, "cluster", "namespace"),
+	}
+	schemas := []TargetSchema{k8sSchema}
+
+	t.Run("valid", func(t *testing.T) {
+		target := TargetInfo{Type: "kubernetes", Connection: map[string]any{"cluster": "prod", "namespace": "default"}}
+		assert.NoError(t, ValidateTargetConfig(target, schemas))
+	})
+
+	t.Run("reports every invalid field", func(t *testing.T) {
+		target := TargetInfo{Type: "kubernetes", Connection: map[string]any{"replicas": "three"}}
+		err := ValidateTargetConfig(target, schemas)
+		var cfgErr *TargetConfigError
+		require.ErrorAs(t, err, &cfgErr)
+		assert.Equal(t, "kubernetes", cfgErr.TargetType)
+		require.Len(t, cfgErr.Fields, 3)
+		assert.Equal(t, ValidationError{Field: "connection.cluster", Message: "is required"}, cfgErr.Fields[0])
+		assert.Equal(t, "connection.namespace", cfgErr.Fields[1].Field)
+		assert.Equal(t, "connection.replicas", cfgErr.Fields[2].Field)
+		assert.Contains(t, err.Error(), `invalid "kubernetes" target configuration: connection.cluster: is required`)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		err := ValidateTargetConfig(TargetInfo{Type: "http_api"}, schemas)
+		var cfgErr *TargetConfigError
+		require.ErrorAs(t, err, &cfgErr)
+		require.Len(t, cfgErr.Fields, 1)
+		assert.Equal(t, "type", cfgErr.Fields[0].Field)
+		assert.Contains(t, cfgErr.Fields[0].Message, "[kubernetes]")
+	})
+
+	t.Run("no schemas accepts any target", func(t *testing.T) {
+		assert.NoError(t, ValidateTargetConfig(TargetInfo{Type: "anything"}, nil))
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		err := ValidateTargetConfig(TargetInfo{Type: "broken"}, []TargetSchema{{Type: "broken"}})
+		require.Error(t, err)
+		assert.NotErrorAs(t, err, new(*TargetConfigError))
+	})
+}
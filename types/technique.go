@@ -0,0 +1,194 @@
+package types
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Frameworks that technique external IDs can refer to.
+const (
+	// FrameworkATTACK is MITRE ATT&CK. IDs look like T1059 or T1059.001.
+	FrameworkATTACK = "attack"
+
+	// FrameworkATLAS is MITRE ATLAS. IDs look like AML.T0051 or AML.T0051.000.
+	FrameworkATLAS = "atlas"
+)
+
+// externalIDPatterns are the ID formats accepted for each framework.
+var externalIDPatterns = map[string]*regexp.Regexp{
+	FrameworkATTACK: regexp.MustCompile(`^T\d{4}(\.\d{3})?$`),
+	FrameworkATLAS:  regexp.MustCompile(`^AML\.T\d{4}(\.\d{3})?$`),
+}
+
+// TechniqueType names a category of testing technique, as used in
+// TechniqueInfo.Type and agent technique declarations (e.g.
+// "prompt_injection", "dos").
+type TechniqueType string
+
+// TechniqueInfo describes a security testing technique used by an agent.
+type TechniqueInfo struct {
+	// Type categorizes the technique (e.g., "prompt_injection", "jailbreak").
+	Type string `json:"type"`
+
+	// Name is a human-readable name for the technique.
+	Name string `json:"name"`
+
+	// Description explains what the technique does.
+	Description string `json:"description,omitempty"`
+
+	// ExternalIDs links the technique to canonical MITRE ATT&CK or ATLAS
+	// techniques, so it can be cross-referenced with graph attack patterns
+	// and finding mappings without string matching.
+	ExternalIDs []ExternalRef `json:"external_ids,omitempty"`
+
+	// Tags are arbitrary labels for categorization.
+	Tags []string `json:"tags,omitempty"`
+
+	// Metadata stores additional technique-specific information.
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// ExternalRef identifies a technique in an external framework.
+type ExternalRef struct {
+	// Framework is FrameworkATTACK or FrameworkATLAS.
+	Framework string `json:"framework"`
+
+	// ID is the technique ID in that framework, e.g. "AML.T0051".
+	ID string `json:"id"`
+
+	// Name is the technique name as the author knows it. Optional; when set,
+	// it is cross-checked against the canonical name.
+	Name string `json:"name,omitempty"`
+}
+
+// TechniqueSummary is the canonical name and tactic of an external technique.
+type TechniqueSummary struct {
+	// Framework is FrameworkATTACK or FrameworkATLAS.
+	Framework string `json:"framework"`
+
+	// ID is the technique ID.
+	ID string `json:"id"`
+
+	// Name is the canonical technique name.
+	Name string `json:"name"`
+
+	// Tactic is the primary tactic the technique belongs to.
+	Tactic string `json:"tactic"`
+}
+
+// TechniqueWarning reports a problem that does not make a TechniqueInfo
+// invalid, such as a name that differs from the canonical one.
+type TechniqueWarning struct {
+	// Message describes the problem.
+	Message string `json:"message"`
+
+	// Details holds the values involved, e.g. "name" and "canonical_name".
+	Details map[string]any `json:"details,omitempty"`
+}
+
+//go:embed data/techniques.json
+var techniquesData []byte
+
+// techniqueTable indexes the bundled techniques by framework and ID.
+var techniqueTable = func() map[ExternalRef]TechniqueSummary {
+	var summaries []TechniqueSummary
+	if err := json.Unmarshal(techniquesData, &summaries); err != nil {
+		panic(fmt.Sprintf("types: invalid bundled technique data: %v", err))
+	}
+	table := make(map[ExternalRef]TechniqueSummary, len(summaries))
+	for _, s := range summaries {
+		table[ExternalRef{Framework: s.Framework, ID: s.ID}] = s
+	}
+	return table
+}()
+
+// LookupTechnique returns the canonical name and tactic of an ATT&CK or ATLAS
+// technique. The bundled table covers the techniques behind the SDK's
+// capabilities, not the full frameworks, so a miss does not mean the ID is
+// invalid.
+func LookupTechnique(framework, id string) (TechniqueSummary, bool) {
+	s, ok := techniqueTable[ExternalRef{Framework: framework, ID: id}]
+	return s, ok
+}
+
+// Validate checks that the external reference names a known framework and
+// that the ID has that framework's format.
+func (r ExternalRef) Validate() error {
+	pattern, ok := externalIDPatterns[r.Framework]
+	if !ok {
+		return &ValidationError{Field: "Framework", Message: fmt.Sprintf("unknown framework %q (expected %q or %q)", r.Framework, FrameworkATTACK, FrameworkATLAS)}
+	}
+	if !pattern.MatchString(r.ID) {
+		return &ValidationError{Field: "ID", Message: fmt.Sprintf("%q is not a valid %s technique ID", r.ID, r.Framework)}
+	}
+	return nil
+}
+
+// Validate checks if the TechniqueInfo has all required fields and
+// well-formed external IDs. Names that differ from the canonical ones are
+// not errors; see Warnings.
+func (t *TechniqueInfo) Validate() error {
+	if t.Type == "" {
+		return &ValidationError{Field: "Type", Message: "technique type is required"}
+	}
+	if t.Name == "" {
+		return &ValidationError{Field: "Name", Message: "technique name is required"}
+	}
+	for i, ref := range t.ExternalIDs {
+		if err := ref.Validate(); err != nil {
+			return &ValidationError{Field: fmt.Sprintf("ExternalIDs[%d]", i), Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+// Warnings cross-checks the external IDs against the bundled lookup table
+// and reports each reference whose name differs from the canonical one.
+func (t *TechniqueInfo) Warnings() []TechniqueWarning {
+	var warnings []TechniqueWarning
+	for _, ref := range t.ExternalIDs {
+		if ref.Name == "" {
+			continue
+		}
+		canonical, ok := LookupTechnique(ref.Framework, ref.ID)
+		if !ok || strings.EqualFold(strings.TrimSpace(ref.Name), canonical.Name) {
+			continue
+		}
+		warnings = append(warnings, TechniqueWarning{
+			Message: fmt.Sprintf("%s %s is named %q, not %q", ref.Framework, ref.ID, canonical.Name, ref.Name),
+			Details: map[string]any{
+				"framework":      ref.Framework,
+				"id":             ref.ID,
+				"name":           ref.Name,
+				"canonical_name": canonical.Name,
+			},
+		})
+	}
+	return warnings
+}
+
+// AddExternalID links the technique to an ATT&CK or ATLAS technique.
+func (t *TechniqueInfo) AddExternalID(framework, id string) {
+	t.ExternalIDs = append(t.ExternalIDs, ExternalRef{Framework: framework, ID: id})
+}
+
+// AddTag adds a tag if it is not already present.
+func (t *TechniqueInfo) AddTag(tag string) {
+	for _, existing := range t.Tags {
+		if existing == tag {
+			return
+		}
+	}
+	t.Tags = append(t.Tags, tag)
+}
+
+// SetMetadata sets a metadata value.
+func (t *TechniqueInfo) SetMetadata(key string, value any) {
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]any)
+	}
+	t.Metadata[key] = value
+}
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExternalRef_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     ExternalRef
+		wantErr bool
+	}{
+		{"attack technique", ExternalRef{Framework: FrameworkATTACK, ID: "T1059"}, false},
+		{"attack sub-technique", ExternalRef{Framework: FrameworkATTACK, ID: "T1059.001"}, false},
+		{"atlas technique", ExternalRef{Framework: FrameworkATLAS, ID: "AML.T0051"}, false},
+		{"atlas sub-technique", ExternalRef{Framework: FrameworkATLAS, ID: "AML.T0051.000"}, false},
+		{"attack short id", ExternalRef{Framework: FrameworkATTACK, ID: "T159"}, true},
+		{"attack lowercase", ExternalRef{Framework: FrameworkATTACK, ID: "t1059"}, true},
+		{"attack bad sub-technique", ExternalRef{Framework: FrameworkATTACK, ID: "T1059.1"}, true},
+		{"atlas id under attack", ExternalRef{Framework: FrameworkATTACK, ID: "AML.T0051"}, true},
+		{"attack id under atlas", ExternalRef{Framework: FrameworkATLAS, ID: "T1059"}, true},
+		{"unknown framework", ExternalRef{Framework: "capec", ID: "CAPEC-66"}, true},
+		{"empty id", ExternalRef{Framework: FrameworkATLAS}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ref.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTechniqueInfo_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		technique TechniqueInfo
+		wantField string
+	}{
+		{
+			name: "valid with external IDs",
+			technique: TechniqueInfo{
+				Type: "prompt_injection",
+				Name: "System Prompt Override",
+				ExternalIDs: []ExternalRef{
+					{Framework: FrameworkATLAS, ID: "AML.T0051"},
+					{Framework: FrameworkATTACK, ID: "T1190"},
+				},
+			},
+		},
+		{
+			name:      "missing type",
+			technique: TechniqueInfo{Name: "System Prompt Override"},
+			wantField: "Type",
+		},
+		{
+			name:      "missing name",
+			technique: TechniqueInfo{Type: "jailbreak"},
+			wantField: "Name",
+		},
+		{
+			name: "malformed external ID",
+			technique: TechniqueInfo{
+				Type:        "jailbreak",
+				Name:        "DAN",
+				ExternalIDs: []ExternalRef{{Framework: FrameworkATLAS, ID: "AML.T0054"}, {Framework: FrameworkATLAS, ID: "AML-T0054"}},
+			},
+			wantField: "ExternalIDs[1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.technique.Validate()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			verr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("Validate() error = %v, want *ValidationError", err)
+			}
+			if verr.Field != tt.wantField {
+				t.Errorf("Validate() field = %q, want %q", verr.Field, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestTechniqueInfo_Warnings(t *testing.T) {
+	technique := TechniqueInfo{
+		Type: "prompt_injection",
+		Name: "Indirect injection",
+		ExternalIDs: []ExternalRef{
+			{Framework: FrameworkATLAS, ID: "AML.T0051", Name: "llm prompt injection"},
+			{Framework: FrameworkATLAS, ID: "AML.T0054", Name: "Prompt Injection"},
+			{Framework: FrameworkATTACK, ID: "T1190"},
+			{Framework: FrameworkATTACK, ID: "T9999", Name: "Not in the table"},
+		},
+	}
+
+	if err := technique.Validate(); err != nil {
+		t.Fatalf("name mismatches must not fail validation: %v", err)
+	}
+
+	warnings := technique.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("Warnings() = %v, want 1 warning", warnings)
+	}
+	w := warnings[0]
+	if w.Details["id"] != "AML.T0054" || w.Details["canonical_name"] != "LLM Jailbreak" || w.Details["name"] != "Prompt Injection" {
+		t.Errorf("warning details = %v", w.Details)
+	}
+	if !strings.Contains(w.Message, "LLM Jailbreak") {
+		t.Errorf("warning message = %q, want canonical name", w.Message)
+	}
+}
+
+func TestLookupTechnique(t *testing.T) {
+	got, ok := LookupTechnique(FrameworkATLAS, "AML.T0051")
+	if !ok {
+		t.Fatal("LookupTechnique(atlas, AML.T0051) not found")
+	}
+	want := TechniqueSummary{Framework: FrameworkATLAS, ID: "AML.T0051", Name: "LLM Prompt Injection", Tactic: "Initial Access"}
+	if got != want {
+		t.Errorf("LookupTechnique() = %+v, want %+v", got, want)
+	}
+
+	if s, ok := LookupTechnique(FrameworkATTACK, "T1499"); !ok || s.Tactic != "Impact" {
+		t.Errorf("LookupTechnique(attack, T1499) = %+v, %v", s, ok)
+	}
+	if _, ok := LookupTechnique(FrameworkATTACK, "AML.T0051"); ok {
+		t.Error("lookup must be scoped to the framework")
+	}
+	if _, ok := LookupTechnique(FrameworkATLAS, "AML.T9999"); ok {
+		t.Error("unknown technique should not be found")
+	}
+}
+
+func TestLookupTechnique_BundledDataIsValid(t *testing.T) {
+	if len(techniqueTable) == 0 {
+		t.Fatal("bundled technique table is empty")
+	}
+	for ref, s := range techniqueTable {
+		if err := ref.Validate(); err != nil {
+			t.Errorf("bundled technique %s/%s: %v", ref.Framework, ref.ID, err)
+		}
+		if s.Name == "" || s.Tactic == "" {
+			t.Errorf("bundled technique %s/%s is missing name or tactic", ref.Framework, ref.ID)
+		}
+	}
+}
+
+func TestTechniqueInfo_JSONRoundTrip(t *testing.T) {
+	original := TechniqueInfo{
+		Type:        "prompt_injection",
+		Name:        "System Prompt Override",
+		Description: "Attempts to override system instructions",
+		ExternalIDs: []ExternalRef{
+			{Framework: FrameworkATLAS, ID: "AML.T0051.000", Name: "Direct"},
+			{Framework: FrameworkATTACK, ID: "T1190"},
+		},
+		Tags:     []string{"high-risk"},
+		Metadata: map[string]any{"success_rate": 0.85},
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"external_ids":[{"framework":"atlas","id":"AML.T0051.000","name":"Direct"},{"framework":"attack","id":"T1190"}]`) {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+
+	var decoded TechniqueInfo
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(original, decoded) {
+		t.Errorf("round trip = %+v, want %+v", decoded, original)
+	}
+}
+
+func TestTechniqueInfo_Helpers(t *testing.T) {
+	var technique TechniqueInfo
+	technique.AddTag("high-risk")
+	technique.AddTag("high-risk")
+	technique.AddExternalID(FrameworkATLAS, "AML.T0054")
+	technique.SetMetadata("success_rate", 0.85)
+
+	if len(technique.Tags) != 1 {
+		t.Errorf("Tags = %v, want one tag", technique.Tags)
+	}
+	if len(technique.ExternalIDs) != 1 || technique.ExternalIDs[0].ID != "AML.T0054" {
+		t.Errorf("ExternalIDs = %v", technique.ExternalIDs)
+	}
+	if technique.Metadata["success_rate"] != 0.85 {
+		t.Errorf("Metadata = %v", technique.Metadata)
+	}
+}
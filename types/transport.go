@@ -0,0 +1,172 @@
+package types
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultMaxIdleConnsPerHost is the number of idle connections per host
+// HTTPClient keeps when TransportHints.MaxIdleConnsPerHost is not set. The
+// net/http default of 2 is too low for agents sending many requests to one
+// target.
+const DefaultMaxIdleConnsPerHost = 16
+
+// TLS versions accepted by TransportHints.MinTLSVersion.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TransportHints tune the connections agents open to a target. All fields
+// are optional; zero values keep the defaults of HTTPClient.
+type TransportHints struct {
+	// MaxIdleConns limits idle connections across all hosts.
+	MaxIdleConns int `json:"max_idle_conns,omitempty"`
+
+	// MaxIdleConnsPerHost limits idle connections kept per host.
+	// Defaults to DefaultMaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int `json:"max_idle_conns_per_host,omitempty"`
+
+	// MaxConnsPerHost limits connections per host, including those in use.
+	// Zero means no limit.
+	MaxConnsPerHost int `json:"max_conns_per_host,omitempty"`
+
+	// IdleConnTimeout is how long an idle connection is kept open.
+	IdleConnTimeout time.Duration `json:"idle_conn_timeout,omitempty"`
+
+	// KeepAlive is the TCP keep-alive period. Negative disables TCP
+	// keep-alives.
+	KeepAlive time.Duration `json:"keep_alive,omitempty"`
+
+	// DisableKeepAlives disables HTTP keep-alives, so each connection
+	// serves a single request.
+	DisableKeepAlives bool `json:"disable_keep_alives,omitempty"`
+
+	// Timeout limits each request, including reading the response body.
+	// Zero means no limit.
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// InsecureSkipVerify disables verification of the target's TLS
+	// certificate, for targets with self-signed certificates.
+	InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty"`
+
+	// ServerName overrides the name the TLS certificate is verified against.
+	ServerName string `json:"server_name,omitempty"`
+
+	// MinTLSVersion is the lowest accepted TLS version: "1.0", "1.1",
+	// "1.2", or "1.3".
+	MinTLSVersion string `json:"min_tls_version,omitempty"`
+
+	// ProxyURL routes requests through a proxy. When empty, the proxy is
+	// taken from the environment (HTTP_PROXY, HTTPS_PROXY, NO_PROXY).
+	ProxyURL string `json:"proxy_url,omitempty"`
+
+	// NoProxy connects directly, ignoring proxies set in the environment.
+	NoProxy bool `json:"no_proxy,omitempty"`
+}
+
+// Validate checks that the hints are well-formed.
+func (h *TransportHints) Validate() error {
+	if h.MaxIdleConns < 0 || h.MaxIdleConnsPerHost < 0 || h.MaxConnsPerHost < 0 {
+		return &ValidationError{Field: "Transport", Message: "connection limits must not be negative"}
+	}
+	if h.IdleConnTimeout < 0 || h.Timeout < 0 {
+		return &ValidationError{Field: "Transport", Message: "timeouts must not be negative"}
+	}
+	if _, ok := tlsVersions[h.MinTLSVersion]; h.MinTLSVersion != "" && !ok {
+		return &ValidationError{Field: "Transport.MinTLSVersion", Message: "unknown TLS version " + h.MinTLSVersion}
+	}
+	if h.ProxyURL != "" {
+		if h.NoProxy {
+			return &ValidationError{Field: "Transport.ProxyURL", Message: "proxy URL set with NoProxy"}
+		}
+		if u, err := url.Parse(h.ProxyURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return &ValidationError{Field: "Transport.ProxyURL", Message: "invalid proxy URL " + h.ProxyURL}
+		}
+	}
+	return nil
+}
+
+var (
+	httpClientsMu sync.Mutex
+	httpClients   = make(map[TransportHints]*http.Client)
+)
+
+// HTTPClient returns an HTTP client tuned by the target's transport hints.
+// Clients are shared: targets with equal hints get the same client, so
+// their connections are pooled and reused across calls. Agents should call
+// HTTPClient rather than creating their own clients, and must not modify
+// the returned client.
+//
+// Targets without hints get a client with the net/http defaults and
+// DefaultMaxIdleConnsPerHost idle connections per host. Invalid hints, which
+// Validate reports, are ignored.
+//
+// Example:
+//
+//	client := harness.Target().HTTPClient()
+//	resp, err := client.Do(req)
+func (t *TargetInfo) HTTPClient() *http.Client {
+	var hints TransportHints
+	if t.Transport != nil {
+		hints = *t.Transport
+	}
+
+	httpClientsMu.Lock()
+	defer httpClientsMu.Unlock()
+	client, ok := httpClients[hints]
+	if !ok {
+		client = newHTTPClient(hints)
+		httpClients[hints] = client
+	}
+	return client
+}
+
+// newHTTPClient builds a client from http.DefaultTransport tuned by hints.
+func newHTTPClient(h TransportHints) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if h.MaxIdleConns > 0 {
+		transport.MaxIdleConns = h.MaxIdleConns
+	}
+	transport.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	if h.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = h.MaxIdleConnsPerHost
+	}
+	if h.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = h.MaxConnsPerHost
+	}
+	if h.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = h.IdleConnTimeout
+	}
+	transport.DisableKeepAlives = h.DisableKeepAlives
+	if h.KeepAlive != 0 {
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: h.KeepAlive}
+		transport.DialContext = dialer.DialContext
+	}
+
+	if h.InsecureSkipVerify || h.ServerName != "" || h.MinTLSVersion != "" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: h.InsecureSkipVerify,
+			ServerName:         h.ServerName,
+			MinVersion:         tlsVersions[h.MinTLSVersion],
+		}
+	}
+
+	switch {
+	case h.NoProxy:
+		transport.Proxy = nil
+	case h.ProxyURL != "":
+		if u, err := url.Parse(h.ProxyURL); err == nil && u.Scheme != "" && u.Host != "" {
+			transport.Proxy = http.ProxyURL(u)
+		}
+	}
+
+	return &http.Client{Transport: transport, Timeout: h.Timeout}
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransportHints_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		hints TransportHints
+		field string
+	}{
+		{name: "empty", hints: TransportHints{}},
+		{name: "valid", hints: TransportHints{MaxIdleConnsPerHost: 8, MinTLSVersion: "1.3", ProxyURL: "http://proxy:3128"}},
+		{name: "negative limit", hints: TransportHints{MaxConnsPerHost: -1}, field: "Transport"},
+		{name: "negative timeout", hints: TransportHints{Timeout: -time.Second}, field: "Transport"},
+		{name: "unknown TLS version", hints: TransportHints{MinTLSVersion: "1.4"}, field: "Transport.MinTLSVersion"},
+		{name: "relative proxy", hints: TransportHints{ProxyURL: "proxy:3128"}, field: "Transport.ProxyURL"},
+		{name: "proxy with NoProxy", hints: TransportHints{ProxyURL: "http://proxy:3128", NoProxy: true}, field: "Transport.ProxyURL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.hints.Validate()
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.field, verr.Field)
+		})
+	}
+}
+
+func TestTargetInfo_ValidateTransport(t *testing.T) {
+	target := TargetInfo{ID: "t1", Name: "api", Type: "http_api", Connection: map[string]any{"url": "https://example.com"}}
+	require.NoError(t, target.Validate())
+
+	target.Transport = &TransportHints{MinTLSVersion: "ssl3"}
+	assert.Error(t, target.Validate())
+}
+
+func TestTargetInfo_HTTPClient(t *testing.T) {
+	target := TargetInfo{
+		ID: "t1",
+		Transport: &TransportHints{
+			MaxIdleConnsPerHost: 32,
+			MaxConnsPerHost:     64,
+			IdleConnTimeout:     time.Minute,
+			Timeout:             5 * time.Second,
+			ServerName:          "api.internal",
+			MinTLSVersion:       "1.2",
+			NoProxy:             true,
+		},
+	}
+
+	client := target.HTTPClient()
+	assert.Equal(t, 5*time.Second, client.Timeout)
+	transport := client.Transport.(*http.Transport)
+	assert.Equal(t, 32, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 64, transport.MaxConnsPerHost)
+	assert.Equal(t, time.Minute, transport.IdleConnTimeout)
+	assert.Nil(t, transport.Proxy)
+	require.NotNil(t, transport.TLSClientConfig)
+	assert.Equal(t, "api.internal", transport.TLSClientConfig.ServerName)
+	assert.Equal(t, uint16(tls.VersionTLS12), transport.TLSClientConfig.MinVersion)
+
+	// equal hints share a client, and so a connection pool
+	clone := target.Clone()
+	assert.Same(t, client, clone.HTTPClient())
+	clone.Transport.Timeout = time.Second
+	assert.NotSame(t, client, clone.HTTPClient())
+}
+
+func TestTargetInfo_HTTPClientDefaults(t *testing.T) {
+	client := (&TargetInfo{ID: "t1"}).HTTPClient()
+	assert.Same(t, client, (&TargetInfo{ID: "t2", Transport: &TransportHints{}}).HTTPClient())
+
+	transport := client.Transport.(*http.Transport)
+	assert.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	assert.NotNil(t, transport.Proxy)
+	assert.Zero(t, client.Timeout)
+}
+
+func TestTargetInfo_HTTPClientReusesConnections(t *testing.T) {
+	var conns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	target := TargetInfo{ID: "t1", Transport: &TransportHints{MaxIdleConnsPerHost: 4, NoProxy: true}}
+	for i := 0; i < 10; i++ {
+		resp, err := target.HTTPClient().Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, int32(1), conns.Load())
+}
+
+func TestTargetInfo_TransportJSON(t *testing.T) {
+	target := TargetInfo{ID: "t1", Transport: &TransportHints{KeepAlive: 15 * time.Second, InsecureSkipVerify: true}}
+
+	data, err := json.Marshal(target)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"transport":{"keep_alive":15000000000,"insecure_skip_verify":true}`)
+
+	var loaded TargetInfo
+	require.NoError(t, json.Unmarshal(data, &loaded))
+	assert.Equal(t, target.Transport, loaded.Transport)
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// CurrentSchemaVersion is the serialization schema version of TargetInfo and
+// MissionContext. Marshaling stamps it into the schema_version field; data
+// without the field predates versioning and has version 0.
+const CurrentSchemaVersion = 1
+
+// schemaVersionField is the JSON field holding the schema version.
+const schemaVersionField = "schema_version"
+
+// migrations[v] upgrades a serialized object from version v to v+1. Each
+// migration recognizes the types it applies to by their fields and leaves
+// other objects unchanged.
+var migrations = []func(obj map[string]any){
+	migrateV0,
+}
+
+// Migrate upgrades a serialized TargetInfo or MissionContext written by an
+// older SDK to the current schema: renamed fields are moved, fields that
+// changed shape are converted, and schema_version is set to
+// CurrentSchemaVersion. Data already at the current version is returned
+// unchanged. Data from a newer SDK returns an error; it can still be
+// unmarshaled directly, with unknown fields ignored.
+//
+// Unmarshaling these types migrates older data automatically, so Migrate is
+// only needed to upgrade stored JSON in place.
+func Migrate(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Keep numbers exact, e.g. durations in nanoseconds
+	dec.UseNumber()
+
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("types: decode for migration: %w", err)
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("types: cannot migrate null")
+	}
+
+	version, err := schemaVersionOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	if version > CurrentSchemaVersion {
+		return nil, fmt.Errorf("types: schema version %d is newer than supported version %d", version, CurrentSchemaVersion)
+	}
+	if version == CurrentSchemaVersion {
+		return data, nil
+	}
+
+	for v := version; v < CurrentSchemaVersion; v++ {
+		migrations[v](obj)
+	}
+	obj[schemaVersionField] = CurrentSchemaVersion
+
+	return json.Marshal(obj)
+}
+
+// schemaVersionOf returns the schema version of a decoded object.
+func schemaVersionOf(obj map[string]any) (int, error) {
+	raw, ok := obj[schemaVersionField]
+	if !ok || raw == nil {
+		return 0, nil
+	}
+	num, ok := raw.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("types: schema_version must be a number, got %T", raw)
+	}
+	version, err := num.Int64()
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("types: invalid schema_version %s", num)
+	}
+	return int(version), nil
+}
+
+// migrateForUnmarshal returns data migrated to the current schema if it is
+// older. Newer data is returned unchanged so that it decodes best-effort.
+func migrateForUnmarshal(data []byte) ([]byte, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return data, nil
+	}
+	var peek struct {
+		SchemaVersion int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(data, &peek); err != nil {
+		return nil, err
+	}
+	if peek.SchemaVersion >= CurrentSchemaVersion {
+		return data, nil
+	}
+	return Migrate(data)
+}
+
+// migrateV0 upgrades unversioned data:
+//   - TargetInfo: the top-level url and headers fields move into connection
+//   - MissionContext: constraints given as a list of labels (the harness
+//     format) are replaced by empty constraints
+func migrateV0(obj map[string]any) {
+	connection, _ := obj["connection"].(map[string]any)
+	for _, key := range []string{"url", "headers"} {
+		value, ok := obj[key]
+		if !ok {
+			continue
+		}
+		delete(obj, key)
+		if connection == nil {
+			connection = make(map[string]any)
+			obj["connection"] = connection
+		}
+		// A value already in connection wins
+		if _, exists := connection[key]; !exists {
+			connection[key] = value
+		}
+	}
+
+	if _, isList := obj["constraints"].([]any); isList {
+		obj["constraints"] = map[string]any{}
+	}
+}
@@ -0,0 +1,199 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMigrate_TargetInfoV0(t *testing.T) {
+	legacy := `{
+		"id": "target-1",
+		"name": "Legacy API",
+		"type": "http_api",
+		"url": "https://api.example.com",
+		"headers": {"Authorization": "Bearer token"}
+	}`
+
+	data, err := Migrate([]byte(legacy))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		t.Fatalf("unmarshal migrated data: %v", err)
+	}
+	if _, ok := obj["url"]; ok {
+		t.Error("top-level url was not removed")
+	}
+	if obj["schema_version"] != float64(CurrentSchemaVersion) {
+		t.Errorf("schema_version = %v, want %d", obj["schema_version"], CurrentSchemaVersion)
+	}
+
+	var target TargetInfo
+	if err := json.Unmarshal(data, &target); err != nil {
+		t.Fatalf("unmarshal TargetInfo: %v", err)
+	}
+	if got := target.URL(); got != "https://api.example.com" {
+		t.Errorf("URL() = %q, want https://api.example.com", got)
+	}
+	if got := target.GetHeader("Authorization"); got != "Bearer token" {
+		t.Errorf("GetHeader() = %q, want Bearer token", got)
+	}
+}
+
+func TestMigrate_ConnectionWins(t *testing.T) {
+	legacy := `{"id": "t", "url": "https://old", "connection": {"url": "https://new"}}`
+
+	data, err := Migrate([]byte(legacy))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	var target TargetInfo
+	if err := json.Unmarshal(data, &target); err != nil {
+		t.Fatalf("unmarshal TargetInfo: %v", err)
+	}
+	if got := target.URL(); got != "https://new" {
+		t.Errorf("URL() = %q, want https://new", got)
+	}
+}
+
+func TestMigrate_MissionContextV0(t *testing.T) {
+	legacy := `{
+		"id": "mission-1",
+		"name": "Legacy Mission",
+		"constraints": ["no-dos", "business-hours"],
+		"metadata": {"max_duration_ns": 9007199254740993}
+	}`
+
+	data, err := Migrate([]byte(legacy))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"constraints":{}`) {
+		t.Errorf("constraints list not replaced: %s", data)
+	}
+	if !strings.Contains(string(data), "9007199254740993") {
+		t.Errorf("large number not preserved exactly: %s", data)
+	}
+}
+
+func TestMigrate_CurrentVersionUnchanged(t *testing.T) {
+	current := `{"id":"t","url":"kept","schema_version":1}`
+
+	data, err := Migrate([]byte(current))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	if string(data) != current {
+		t.Errorf("Migrate() = %s, want input unchanged", data)
+	}
+}
+
+func TestMigrate_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"newer version", `{"id":"t","schema_version":99}`},
+		{"non-numeric version", `{"id":"t","schema_version":"1"}`},
+		{"negative version", `{"id":"t","schema_version":-1}`},
+		{"not an object", `["a","b"]`},
+		{"null", `null`},
+		{"invalid json", `{`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Migrate([]byte(tt.data)); err == nil {
+				t.Error("Migrate() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestSchemaVersion_MarshalStampsVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"TargetInfo", TargetInfo{ID: "t", Name: "target"}},
+		{"*TargetInfo", &TargetInfo{ID: "t", Name: "target", SchemaVersion: 0}},
+		{"MissionContext", MissionContext{ID: "m", Name: "mission"}},
+		{"*MissionContext", NewMissionContext("m", "mission")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var peek struct {
+				SchemaVersion int `json:"schema_version"`
+			}
+			if err := json.Unmarshal(data, &peek); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if peek.SchemaVersion != CurrentSchemaVersion {
+				t.Errorf("schema_version = %d, want %d", peek.SchemaVersion, CurrentSchemaVersion)
+			}
+		})
+	}
+}
+
+func TestSchemaVersion_UnmarshalMigrates(t *testing.T) {
+	var target TargetInfo
+	if err := json.Unmarshal([]byte(`{"id":"t","name":"n","url":"https://legacy"}`), &target); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := target.URL(); got != "https://legacy" {
+		t.Errorf("URL() = %q, want https://legacy", got)
+	}
+	if target.SchemaVersion != CurrentSchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", target.SchemaVersion, CurrentSchemaVersion)
+	}
+
+	// Nested values are migrated too
+	var wrapper struct {
+		Target  TargetInfo     `json:"target"`
+		Mission MissionContext `json:"mission"`
+	}
+	nested := `{
+		"target": {"id":"t","url":"https://nested"},
+		"mission": {"id":"m","name":"n","constraints":{"max_duration":3600000000000}}
+	}`
+	if err := json.Unmarshal([]byte(nested), &wrapper); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := wrapper.Target.URL(); got != "https://nested" {
+		t.Errorf("nested URL() = %q, want https://nested", got)
+	}
+	if got := wrapper.Mission.Constraints.MaxDuration; got != time.Hour {
+		t.Errorf("MaxDuration = %v, want 1h", got)
+	}
+}
+
+func TestSchemaVersion_UnmarshalNewerVersion(t *testing.T) {
+	// Data from a newer SDK decodes best-effort, ignoring unknown fields
+	var target TargetInfo
+	data := `{"id":"t","name":"n","schema_version":99,"future_field":true}`
+	if err := json.Unmarshal([]byte(data), &target); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if target.ID != "t" || target.SchemaVersion != 99 {
+		t.Errorf("got ID=%q SchemaVersion=%d", target.ID, target.SchemaVersion)
+	}
+}
+
+func TestSchemaVersion_UnmarshalNull(t *testing.T) {
+	var wrapper struct {
+		Target TargetInfo `json:"target"`
+	}
+	if err := json.Unmarshal([]byte(`{"target":null}`), &wrapper); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+}